@@ -0,0 +1,221 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	core "github.com/iden3/go-iden3-core"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/db/schema"
+	"github.com/polygonid/sh-id-platform/internal/kms"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/network"
+	"github.com/polygonid/sh-id-platform/internal/redis"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+
+	_ "github.com/lib/pq"
+)
+
+type status string
+
+const (
+	statusOK      status = "ok"
+	statusFailed  status = "failed"
+	statusSkipped status = "skipped"
+)
+
+// result is the outcome of a check. Fix says what to change when it fails.
+type result struct {
+	Name    string `json:"name"`
+	Status  status `json:"status"`
+	Message string `json:"message,omitempty"`
+	Fix     string `json:"fix,omitempty"`
+}
+
+type report struct {
+	OK     bool     `json:"ok"`
+	Checks []result `json:"checks"`
+}
+
+// check validates the configuration of the node and connects to the services it depends on: postgres, redis, the key
+// store and the RPC of every network. The configuration errors otherwise only show up as 500 errors of the API. It
+// prints a report with the result of every check and what to fix, as JSON with -json, and exits with 1 if any fails.
+func main() {
+	jsonOutput := flag.Bool("json", false, "print the report as JSON")
+	timeout := flag.Duration("timeout", 10*time.Second, "time every service has to answer")
+	flag.Parse()
+
+	rep := &report{OK: true}
+	cfg, err := config.Load("")
+	if rep.add("config", err, "fix the configuration file or the ISSUER_* environment variables") {
+		// the report is the output, the logs of the checks go to stderr
+		ctx := log.NewContext(context.Background(), cfg.Log.Level, cfg.Log.Mode, os.Stderr)
+		run(ctx, cfg, rep, *timeout)
+	}
+
+	if *jsonOutput {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		_ = encoder.Encode(rep)
+	} else {
+		rep.print()
+	}
+	if !rep.OK {
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, cfg *config.Configuration, rep *report, timeout time.Duration) {
+	network.RegisterDIDNetworks()
+
+	rep.add("server url", cfg.Sanitize(), "set ISSUER_SERVER_URL to the absolute url the API is reached at")
+	uiOK := false
+	if cfg.APIUI.ServerPort == 0 && cfg.APIUI.Issuer == "" {
+		rep.skip("ui api", "the UI API is not configured")
+	} else {
+		uiOK = rep.add("ui api", cfg.SanitizeAPIUI(), "set ISSUER_API_UI_SERVER_PORT, ISSUER_API_UI_SERVER_URL, ISSUER_API_UI_ISSUER_DID and ISSUER_CACHE_SESSION_STORE")
+	}
+	publishing := domain.PublishingPolicy{
+		Mode:             domain.PublishingMode(cfg.Publishing.Mode),
+		Interval:         cfg.Publishing.Interval,
+		Threshold:        cfg.Publishing.Threshold,
+		RevocationWindow: cfg.Publishing.RevocationWindow,
+	}
+	rep.add("publishing policy", publishing.Validate(), "set ISSUER_PUBLISHING_MODE and the ISSUER_PUBLISHING_* values its mode needs")
+	_, err := domain.NewProofPolicy(cfg.ProofPolicy.Default, cfg.ProofPolicy.Allowed)
+	rep.add("proof policy", err, "set ISSUER_PROOF_POLICY_DEFAULT and ISSUER_PROOF_POLICY_ALLOWED to known proof types")
+
+	storage, err := db.NewStorage(cfg.Database.URL, config.Database{})
+	if err == nil {
+		defer func() { _ = storage.Close() }()
+		err = ping(ctx, timeout, storage.Pgx.Ping)
+	}
+	postgresOK := rep.add("postgres", err, "check ISSUER_DATABASE_URL and that postgres accepts connections from this host")
+	switch {
+	case cfg.Database.ReplicaURL == "":
+		rep.skip("postgres replica", "there is no read replica")
+	case !postgresOK:
+		rep.skip("postgres replica", "postgres does not answer")
+	default:
+		err := storage.ConnectReplica(cfg.Database.ReplicaURL, config.Database{})
+		if err == nil {
+			err = ping(ctx, timeout, storage.Replica.Ping)
+		}
+		rep.add("postgres replica", err, "check ISSUER_DATABASE_REPLICA_URL and that the replica accepts connections from this host")
+	}
+	if !postgresOK {
+		rep.skip("migrations", "postgres does not answer")
+	} else {
+		pending, err := schema.Pending(cfg.Database.URL)
+		if err == nil && len(pending) > 0 {
+			err = fmt.Errorf("%d migrations are not applied, the first one is %d", len(pending), pending[0])
+		}
+		rep.add("migrations", err, "run the migrate command")
+	}
+
+	rdb, err := redis.Open(cfg.Cache.RedisUrl)
+	if err == nil {
+		_ = rdb.Close()
+	}
+	rep.add("redis", err, "check ISSUER_REDIS_URL and that redis accepts connections from this host")
+
+	keyStore, err := kms.OpenKeyStore(ctx, cfg.KeyStore)
+	keyStoreOK := rep.add("key store", err, fmt.Sprintf("check the ISSUER_KEY_STORE_* settings of the %q provider and that it answers", cfg.KeyStore.Provider))
+
+	resolver, err := network.NewResolver(ctx, cfg)
+	if rep.add("networks", err, "check ISSUER_ETHEREUM_URL, ISSUER_ETHEREUM_CONTRACT_ADDRESS, ISSUER_ETHEREUM_CHAIN_ID and the networks file") {
+		for _, key := range resolver.Networks() {
+			err := ping(ctx, timeout, func(ctx context.Context) error { return resolver.Check(ctx, key) })
+			rep.add("network "+key, err, "check the url, contract address and chain id of the network")
+		}
+	}
+
+	switch {
+	case !uiOK:
+		rep.skip("issuer did", "there is no valid issuer DID")
+	case !postgresOK || !keyStoreOK:
+		rep.skip("issuer did", "postgres or the key store do not answer")
+	default:
+		err := ping(ctx, timeout, func(ctx context.Context) error {
+			return checkIssuer(ctx, cfg.APIUI.IssuerDID, storage, keyStore, resolver)
+		})
+		rep.add("issuer did", err, "create the identity with the issuer_initializer command and set it in ISSUER_API_UI_ISSUER_DID, or point ISSUER_KEY_STORE_* to the key store it was created with")
+	}
+}
+
+// checkIssuer fails if the issuer is not an identity of the node, its keys are not in the key store or its network is
+// not configured
+func checkIssuer(ctx context.Context, did core.DID, storage *db.Storage, keyStore *kms.KMS, resolver *network.Resolver) error {
+	if _, err := repositories.NewIdentity().GetByID(ctx, storage.Pgx, did); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("%s is not an identity of the node", did.String())
+		}
+		return err
+	}
+	keys, err := keyStore.KeysByIdentity(ctx, did)
+	if err != nil {
+		return fmt.Errorf("listing the keys of %s: %w", did.String(), err)
+	}
+	bjj := false
+	for _, key := range keys {
+		bjj = bjj || key.Type == kms.KeyTypeBabyJubJub
+	}
+	if !bjj {
+		return fmt.Errorf("the key store has no baby jubjub key of %s", did.String())
+	}
+	if resolver == nil {
+		return nil
+	}
+	_, err = resolver.Settings(network.Key(did))
+	return err
+}
+
+// ping calls fn with a context that is cancelled after timeout
+func ping(ctx context.Context, timeout time.Duration, fn func(ctx context.Context) error) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	return fn(ctx)
+}
+
+// add records the result of a check and returns true if it passed
+func (r *report) add(name string, err error, fix string) bool {
+	if err != nil {
+		r.OK = false
+		r.Checks = append(r.Checks, result{Name: name, Status: statusFailed, Message: err.Error(), Fix: fix})
+		return false
+	}
+	r.Checks = append(r.Checks, result{Name: name, Status: statusOK})
+	return true
+}
+
+// skip records a check that is not run, because it does not apply or depends on a failed one
+func (r *report) skip(name, reason string) {
+	r.Checks = append(r.Checks, result{Name: name, Status: statusSkipped, Message: reason})
+}
+
+func (r *report) print() {
+	for _, c := range r.Checks {
+		line := fmt.Sprintf("%-8s %s", c.Status, c.Name)
+		if c.Message != "" {
+			line += ": " + c.Message
+		}
+		fmt.Println(line)
+		if c.Fix != "" {
+			fmt.Printf("%-8s fix: %s\n", "", c.Fix)
+		}
+	}
+	if r.OK {
+		fmt.Println("the node is ready to start")
+	} else {
+		fmt.Println("fix the failed checks before starting the node")
+	}
+}
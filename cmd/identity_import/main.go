@@ -0,0 +1,102 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/pkg/client"
+)
+
+// identity_import imports in an issuer node the archive of an issuer exported from another node with
+// POST /v1/admin/export. The archive is decrypted here with ISSUER_EXPORT_PASSPHRASE, so the passphrase never leaves
+// the operator machine, and the export is sent to the import endpoint of the target node, which verifies it.
+func main() {
+	archive := flag.String("archive", "", "path of the archive returned by the export endpoint")
+	target := flag.String("target", "", "core API URL of the node the issuer is imported in")
+	targetUser := flag.String("target-user", "", "basic auth user of the target node")
+	targetPassword := flag.String("target-password", "", "basic auth password of the target node")
+	keys := flag.String("keys", "", "comma separated exported=target key IDs, for the keys imported with another ID in the target node")
+	flag.Parse()
+
+	ctx := log.NewContext(context.Background(), log.LevelInfo, log.OutputText, os.Stdout)
+
+	passphrase := os.Getenv("ISSUER_EXPORT_PASSPHRASE")
+	if *archive == "" || *target == "" || passphrase == "" {
+		log.Error(ctx, "archive, target and the ISSUER_EXPORT_PASSPHRASE environment variable are required")
+		os.Exit(1)
+	}
+	keyMap, err := parseKeys(*keys)
+	if err != nil {
+		log.Error(ctx, "invalid keys", "err", err)
+		os.Exit(1)
+	}
+
+	data, err := os.ReadFile(*archive)
+	if err != nil {
+		log.Error(ctx, "cannot read the archive", "err", err)
+		os.Exit(1)
+	}
+	export, err := domain.OpenIdentityExport(data, passphrase)
+	if err != nil {
+		log.Error(ctx, "cannot open the archive", "err", err)
+		os.Exit(1)
+	}
+	log.Info(ctx, "archive opened", "identifier", export.Identifier, "exportedAt", export.ExportedAt, "keys", len(export.Keys))
+
+	targetCli, err := client.New(*target, *targetUser, *targetPassword)
+	if err != nil {
+		log.Error(ctx, "cannot create target client", "err", err)
+		os.Exit(1)
+	}
+	if err := importIdentity(ctx, targetCli, export, keyMap); err != nil {
+		log.Error(ctx, "identity import failed", "err", err)
+		os.Exit(1)
+	}
+	log.Info(ctx, "identity imported", "identifier", export.Identifier, "target", *target)
+}
+
+func importIdentity(ctx context.Context, target *client.ClientWithResponses, export *domain.IdentityExport, keys map[string]string) error {
+	// the export has the json format of the api
+	data, err := json.Marshal(export)
+	if err != nil {
+		return err
+	}
+	body := client.ImportIdentityRequest{}
+	if err := json.Unmarshal(data, &body.Export); err != nil {
+		return err
+	}
+	if len(keys) > 0 {
+		body.Keys = &keys
+	}
+
+	imported, err := target.ImportIdentityWithResponse(ctx, body)
+	if err != nil {
+		return err
+	}
+	if imported.JSON201 == nil {
+		return fmt.Errorf("cannot import identity: %s %s", imported.Status(), imported.Body)
+	}
+	log.Info(ctx, "identity imported and verified", "rows", imported.JSON201.Rows, "keys", imported.JSON201.Keys, "state", imported.JSON201.State)
+	return nil
+}
+
+func parseKeys(keys string) (map[string]string, error) {
+	keyMap := make(map[string]string)
+	for _, pair := range strings.Split(keys, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+		exported, target, ok := strings.Cut(pair, "=")
+		if !ok || exported == "" || target == "" {
+			return nil, fmt.Errorf("%q is not exported=target", pair)
+		}
+		keyMap[strings.TrimSpace(exported)] = strings.TrimSpace(target)
+	}
+	return keyMap, nil
+}
@@ -0,0 +1,157 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/pkg/client"
+)
+
+// identity_migration moves an identity between two issuer nodes through their core APIs:
+// the identity is made read only in the source node, exported once its pending transactions are confirmed, imported
+// and verified in the target node and finally marked as migrated in the source node.
+// If any step fails before the import the migration is aborted, so the identity is writable again in the source node.
+func main() {
+	source := flag.String("source", "", "core API URL of the node that holds the identity")
+	sourceUser := flag.String("source-user", "", "basic auth user of the source node")
+	sourcePassword := flag.String("source-password", "", "basic auth password of the source node")
+	target := flag.String("target", "", "core API URL of the node the identity is moved to")
+	targetUser := flag.String("target-user", "", "basic auth user of the target node")
+	targetPassword := flag.String("target-password", "", "basic auth password of the target node")
+	identifier := flag.String("identifier", "", "DID of the identity")
+	keys := flag.String("keys", "", "comma separated exported=target key IDs, for the keys imported with another ID in the target node")
+	wait := flag.Duration("wait", 10*time.Minute, "maximum time to wait for the pending transactions of the identity")
+	flag.Parse()
+
+	ctx := log.NewContext(context.Background(), log.LevelInfo, log.OutputText, os.Stdout)
+
+	if *source == "" || *target == "" || *identifier == "" {
+		log.Error(ctx, "source, target and identifier are required")
+		os.Exit(1)
+	}
+	keyMap, err := parseKeys(*keys)
+	if err != nil {
+		log.Error(ctx, "invalid keys", "err", err)
+		os.Exit(1)
+	}
+
+	sourceCli, err := client.New(*source, *sourceUser, *sourcePassword)
+	if err != nil {
+		log.Error(ctx, "cannot create source client", "err", err)
+		os.Exit(1)
+	}
+	targetCli, err := client.New(*target, *targetUser, *targetPassword)
+	if err != nil {
+		log.Error(ctx, "cannot create target client", "err", err)
+		os.Exit(1)
+	}
+
+	if err := migrate(ctx, sourceCli, targetCli, *identifier, *target, keyMap, *wait); err != nil {
+		log.Error(ctx, "identity migration failed", "err", err)
+		os.Exit(1)
+	}
+	log.Info(ctx, "identity migrated", "identifier", *identifier, "target", *target)
+}
+
+func migrate(ctx context.Context, source, target *client.ClientWithResponses, identifier, targetURL string, keys map[string]string, wait time.Duration) error {
+	started, err := source.StartIdentityMigrationWithResponse(ctx, identifier, client.StartIdentityMigrationRequest{Target: &targetURL},
+		client.WithIdempotencyKey(client.NewIdempotencyKey()))
+	if err != nil {
+		return err
+	}
+	if started.JSON200 == nil {
+		return fmt.Errorf("cannot start migration: %s %s", started.Status(), started.Body)
+	}
+	log.Info(ctx, "identity is read only in the source node")
+
+	export, err := exportIdentity(ctx, source, identifier, wait)
+	if err != nil {
+		abort(ctx, source, identifier)
+		return err
+	}
+	log.Info(ctx, "identity exported", "keys", len(export.Keys), "merkleTrees", len(export.MerkleTrees))
+
+	body := client.ImportIdentityRequest{Export: *export}
+	if len(keys) > 0 {
+		body.Keys = &keys
+	}
+	imported, err := target.ImportIdentityWithResponse(ctx, body)
+	if err != nil {
+		// the import may have been committed, the migration is left open to check the target node
+		return fmt.Errorf("cannot import identity, check the target node before completing or aborting the migration: %w", err)
+	}
+	if imported.JSON201 == nil {
+		abort(ctx, source, identifier)
+		return fmt.Errorf("cannot import identity: %s %s", imported.Status(), imported.Body)
+	}
+	log.Info(ctx, "identity imported and verified", "rows", imported.JSON201.Rows, "keys", imported.JSON201.Keys, "state", imported.JSON201.State)
+
+	completed, err := source.CompleteIdentityMigrationWithResponse(ctx, identifier, client.WithIdempotencyKey(client.NewIdempotencyKey()))
+	if err != nil {
+		return err
+	}
+	if completed.JSON200 == nil {
+		return fmt.Errorf("cannot complete migration: %s %s", completed.Status(), completed.Body)
+	}
+	return nil
+}
+
+// exportIdentity exports the identity, waiting while it has transactions in progress
+func exportIdentity(ctx context.Context, source *client.ClientWithResponses, identifier string, wait time.Duration) (*client.IdentityExport, error) {
+	ctx, cancel := context.WithTimeout(ctx, wait)
+	defer cancel()
+	ticker := time.NewTicker(5 * time.Second)
+	defer ticker.Stop()
+	for {
+		resp, err := source.ExportIdentityWithResponse(ctx, identifier)
+		if err != nil {
+			return nil, err
+		}
+		if resp.JSON200 != nil {
+			return resp.JSON200, nil
+		}
+		if resp.StatusCode() != http.StatusConflict {
+			return nil, fmt.Errorf("cannot export identity: %s %s", resp.Status(), resp.Body)
+		}
+		log.Info(ctx, "waiting for the pending transactions of the identity", "reason", resp.JSON409.Message)
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return nil, fmt.Errorf("identity still has pending transactions: %w", ctx.Err())
+		}
+	}
+}
+
+func abort(ctx context.Context, source *client.ClientWithResponses, identifier string) {
+	resp, err := source.AbortIdentityMigrationWithResponse(ctx, identifier)
+	if err != nil {
+		log.Error(ctx, "cannot abort migration", "err", err)
+		return
+	}
+	if resp.JSON200 == nil {
+		log.Error(ctx, "cannot abort migration", "status", resp.Status(), "body", string(resp.Body))
+		return
+	}
+	log.Info(ctx, "migration aborted, the identity is writable in the source node")
+}
+
+func parseKeys(keys string) (map[string]string, error) {
+	keyMap := make(map[string]string)
+	for _, pair := range strings.Split(keys, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+		exported, target, ok := strings.Cut(pair, "=")
+		if !ok || exported == "" || target == "" {
+			return nil, fmt.Errorf("%q is not exported=target", pair)
+		}
+		keyMap[strings.TrimSpace(exported)] = strings.TrimSpace(target)
+	}
+	return keyMap, nil
+}
@@ -10,7 +10,7 @@ import (
 	"github.com/polygonid/sh-id-platform/internal/db"
 	"github.com/polygonid/sh-id-platform/internal/kms"
 	"github.com/polygonid/sh-id-platform/internal/log"
-	"github.com/polygonid/sh-id-platform/internal/providers"
+	"github.com/polygonid/sh-id-platform/internal/network"
 	"github.com/polygonid/sh-id-platform/internal/repositories"
 	"github.com/polygonid/sh-id-platform/pkg/pubsub"
 )
@@ -24,24 +24,20 @@ func main() {
 
 	ctx := log.NewContext(context.Background(), cfg.Log.Level, cfg.Log.Mode, os.Stdout)
 
-	storage, err := db.NewStorage(cfg.Database.URL)
+	storage, err := db.NewStorage(cfg.Database.URL, cfg.Database)
 	if err != nil {
 		log.Error(ctx, "cannot connect to database", "err", err)
 		return
 	}
 
-	vaultCli, err := providers.NewVaultClient(cfg.KeyStore.Address, cfg.KeyStore.Token)
-	if err != nil {
-		log.Error(ctx, "cannot init vault client: ", "err", err)
-		return
-	}
-
-	keyStore, err := kms.Open(cfg.KeyStore.PluginIden3MountPath, vaultCli)
+	keyStore, err := kms.OpenKeyStore(ctx, cfg.KeyStore)
 	if err != nil {
 		log.Error(ctx, "cannot initialize kms", "err", err)
 		return
 	}
 
+	network.RegisterDIDNetworks()
+
 	// repositories initialization
 	identityRepository := repositories.NewIdentity()
 	claimsRepository := repositories.NewClaims()
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/hex"
+	"flag"
+	"os"
+	"strings"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/kms"
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+// kms_import stores a private key read from the standard input in the encrypted file key store.
+// By default it imports the Ethereum key that publishes the states, under the configured publishing key path.
+func main() {
+	keyID := flag.String("key-id", "", "key ID of the imported key. Defaults to ISSUER_PUBLISH_KEY_PATH")
+	keyType := flag.String("key-type", string(kms.KeyTypeEthereum), "key type: ETH or BJJ")
+	flag.Parse()
+
+	cfg, err := config.Load("")
+	if err != nil {
+		log.Error(context.Background(), "cannot load config", "err", err)
+		return
+	}
+
+	ctx := log.NewContext(context.Background(), cfg.Log.Level, cfg.Log.Mode, os.Stdout)
+
+	if cfg.KeyStore.Provider != config.KeyStoreProviderFile {
+		log.Error(ctx, "keys can only be imported into the file key store", "provider", cfg.KeyStore.Provider)
+		return
+	}
+	if *keyID == "" {
+		*keyID = cfg.PublishingKeyPath
+	}
+
+	line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && line == "" {
+		log.Error(ctx, "cannot read private key", "err", err)
+		return
+	}
+	privKey, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(line), "0x"))
+	if err != nil {
+		log.Error(ctx, "private key is not hex encoded", "err", err)
+		return
+	}
+
+	err = kms.ImportFileKey(kms.FileConfig{Dir: cfg.KeyStore.FilePath, Passphrase: cfg.KeyStore.FilePassphrase},
+		kms.KeyID{Type: kms.KeyType(*keyType), ID: *keyID}, privKey)
+	if err != nil {
+		log.Error(ctx, "cannot import key", "err", err)
+		return
+	}
+
+	log.Info(ctx, "key imported successfully", "keyID", *keyID)
+}
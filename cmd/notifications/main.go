@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"fmt"
+	netHTTP "net/http"
 	"os"
 	"os/signal"
 	"syscall"
@@ -14,15 +15,18 @@ import (
 	"github.com/polygonid/sh-id-platform/internal/db"
 	"github.com/polygonid/sh-id-platform/internal/gateways"
 	"github.com/polygonid/sh-id-platform/internal/kms"
+	"github.com/polygonid/sh-id-platform/internal/leader"
 	"github.com/polygonid/sh-id-platform/internal/loader"
 	"github.com/polygonid/sh-id-platform/internal/log"
-	"github.com/polygonid/sh-id-platform/internal/providers"
+	"github.com/polygonid/sh-id-platform/internal/network"
 	"github.com/polygonid/sh-id-platform/internal/redis"
 	"github.com/polygonid/sh-id-platform/internal/repositories"
+	"github.com/polygonid/sh-id-platform/internal/standby"
 	"github.com/polygonid/sh-id-platform/pkg/cache"
 	"github.com/polygonid/sh-id-platform/pkg/http"
 	"github.com/polygonid/sh-id-platform/pkg/pubsub"
 	"github.com/polygonid/sh-id-platform/pkg/reverse_hash"
+	"github.com/polygonid/sh-id-platform/pkg/schema"
 )
 
 func main() {
@@ -32,6 +36,7 @@ func main() {
 		return
 	}
 
+	log.SetSensitiveFields(cfg.PII.Fields)
 	ctx, cancel := context.WithCancel(log.NewContext(context.Background(), cfg.Log.Level, cfg.Log.Mode, os.Stdout))
 	defer cancel()
 
@@ -40,18 +45,27 @@ func main() {
 		return
 	}
 
+	network.RegisterDIDNetworks()
+
 	rdb, err := redis.Open(cfg.Cache.RedisUrl)
 	if err != nil {
 		log.Error(ctx, "cannot connect to redis", "err", err, "host", cfg.Cache.RedisUrl)
 		return
 	}
 
-	storage, err := db.NewStorage(cfg.Database.URL)
+	storage, err := db.NewStorage(cfg.Database.URL, cfg.Database)
 	if err != nil {
 		log.Error(ctx, "cannot connect to database", "err", err)
 		return
 	}
 
+	node, err := standby.New(ctx, storage, cfg.Standby.Enabled)
+	if err != nil {
+		log.Error(ctx, "cannot get the node role", "err", err)
+		return
+	}
+	node.Run(ctx, cfg.Standby.CheckFrequency)
+
 	ps := pubsub.NewRedis(rdb)
 	ps.WithLogger(log.Error)
 	cachex := cache.NewRedisCache(rdb)
@@ -59,14 +73,22 @@ func main() {
 	connectionsRepository := repositories.NewConnections()
 
 	connectionsService := services.NewConnection(connectionsRepository, storage)
-	credentialsService, err := newCredentialsService(cfg, storage, cachex, ps)
+	credentialsService, err := newCredentialsService(ctx, cfg, storage, cachex, ps)
 	if err != nil {
 		log.Error(ctx, "cannot initialize the credential service", "err", err)
 		return
 	}
 
 	notificationGateway := gateways.NewPushNotificationClient(http.DefaultHTTPClientWithRetry)
-	notificationService := services.NewNotification(notificationGateway, connectionsService, credentialsService)
+	var didResolver ports.DIDResolverGateway
+	if cfg.DIDResolver.URL != "" {
+		didResolver = gateways.NewDIDResolverClient(cfg.DIDResolver.URL, cfg.DIDResolver.Timeout)
+	}
+	notificationService := services.NewNotification(notificationGateway, connectionsService, credentialsService, services.NotificationCfg{
+		Resolver: didResolver,
+		Cache:    cachex,
+		CacheTTL: cfg.DIDResolver.CacheTTL,
+	})
 	ctxCancel, cancel := context.WithCancel(ctx)
 	defer func() {
 		log.Info(ctx, "Shutting down...")
@@ -76,27 +98,42 @@ func main() {
 		}
 	}()
 
-	ps.Subscribe(ctxCancel, event.CreateCredentialEvent, notificationService.SendCreateCredentialNotification)
-	ps.Subscribe(ctxCancel, event.CreateConnectionEvent, notificationService.SendCreateConnectionNotification)
-
 	gracefulShutdown := make(chan os.Signal, 1)
 	signal.Notify(gracefulShutdown, syscall.SIGINT, syscall.SIGTERM)
 
+	// redis replicas receive the events published in the primary, a standby sends no notifications until it is promoted
+	select {
+	case <-node.Active():
+	case <-gracefulShutdown:
+		return
+	}
+
+	// every replica receives the events, only the one holding the lease sends the notifications
+	elector := leader.New(rdb, "notifications", cfg.LeaderElection.LeaseTTL)
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		elector.Run(ctxCancel, func(ctx context.Context) {
+			ps.Subscribe(ctx, event.CreateCredentialEvent, notificationService.SendCreateCredentialNotification)
+			ps.Subscribe(ctx, event.CreateConnectionEvent, notificationService.SendCreateConnectionNotification)
+			ps.Subscribe(ctx, event.CredentialOfferEvent, notificationService.SendCredentialOfferNotification)
+			<-ctx.Done()
+		})
+	}()
+
 	<-gracefulShutdown
+	// the lease is released before closing redis
+	cancel()
+	<-stopped
 }
 
-func newCredentialsService(cfg *config.Configuration, storage *db.Storage, cachex cache.Cache, ps pubsub.Client) (ports.ClaimsService, error) {
-	vaultCli, err := providers.NewVaultClient(cfg.KeyStore.Address, cfg.KeyStore.Token)
-	if err != nil {
-		return nil, fmt.Errorf("cannot init vault client: err %s", err.Error())
-	}
-
+func newCredentialsService(ctx context.Context, cfg *config.Configuration, storage *db.Storage, cachex cache.Cache, ps pubsub.Client) (ports.ClaimsService, error) {
 	identityRepository := repositories.NewIdentity()
 	claimsRepository := repositories.NewClaims()
 	mtRepository := repositories.NewIdentityMerkleTreeRepository()
 	identityStateRepository := repositories.NewIdentityState()
 	revocationRepository := repositories.NewRevocation()
-	keyStore, err := kms.Open(cfg.KeyStore.PluginIden3MountPath, vaultCli)
+	keyStore, err := kms.OpenKeyStore(ctx, cfg.KeyStore)
 	if err != nil {
 		return nil, fmt.Errorf("cannot initialize kms: err %s", err.Error())
 	}
@@ -104,13 +141,23 @@ func newCredentialsService(cfg *config.Configuration, storage *db.Storage, cache
 	rhsp := reverse_hash.NewRhsPublisher(nil, false)
 	var schemaLoader loader.Factory
 	if cfg.SchemaCache == nil || !*cfg.SchemaCache {
-		schemaLoader = loader.HTTPFactory
+		schemaLoader = loader.IPFSFactory(cfg.IPFS.GatewayURL, loader.HTTPFactory)
 	} else {
-		schemaLoader = loader.CachedFactory(loader.HTTPFactory, cachex)
+		documentCache := schema.NewDocumentCache(cachex, cfg.SchemaCacheTTL, netHTTP.DefaultTransport)
+		// the JSON-LD contexts are loaded with the default client by the validation and merklization of the credentials
+		netHTTP.DefaultClient.Transport = documentCache
+		schemaLoader = loader.IPFSFactory(cfg.IPFS.GatewayURL, documentCache.Factory)
 	}
 
 	mtService := services.NewIdentityMerkleTrees(mtRepository)
 	identityService := services.NewIdentity(keyStore, identityRepository, mtRepository, identityStateRepository, mtService, claimsRepository, revocationRepository, nil, storage, rhsp, nil, nil, ps)
+	featureFlagService := services.NewFeatureFlags(repositories.NewFeatureFlag(), storage, services.FeatureFlagsCfg{
+		RHS:           cfg.ReverseHashService.Enabled,
+		AsyncIssuance: cfg.FeatureFlags.AsyncIssuance,
+		OID4VCI:       cfg.FeatureFlags.OID4VCI,
+		TestMode:      cfg.FeatureFlags.TestMode,
+		StatusList:    cfg.FeatureFlags.StatusList,
+	})
 	claimsService := services.NewClaim(
 		claimsRepository,
 		identityService,
@@ -122,8 +169,9 @@ func newCredentialsService(cfg *config.Configuration, storage *db.Storage, cache
 			RHSEnabled: cfg.ReverseHashService.Enabled,
 			RHSUrl:     cfg.ReverseHashService.URL,
 			Host:       cfg.ServerUrl,
+			OfferTTL:   cfg.CredentialOfferTTL,
+			Features:   featureFlagService,
 		},
-		ps,
 	)
 
 	return claimsService, nil
@@ -2,27 +2,27 @@ package main
 
 import (
 	"context"
-	"math/big"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
 	"time"
 
-	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/ethclient"
-
 	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
 	"github.com/polygonid/sh-id-platform/internal/core/ports"
 	"github.com/polygonid/sh-id-platform/internal/core/services"
 	"github.com/polygonid/sh-id-platform/internal/db"
 	"github.com/polygonid/sh-id-platform/internal/gateways"
 	"github.com/polygonid/sh-id-platform/internal/kms"
+	"github.com/polygonid/sh-id-platform/internal/leader"
 	"github.com/polygonid/sh-id-platform/internal/loader"
 	"github.com/polygonid/sh-id-platform/internal/log"
-	"github.com/polygonid/sh-id-platform/internal/providers"
+	"github.com/polygonid/sh-id-platform/internal/network"
 	"github.com/polygonid/sh-id-platform/internal/redis"
 	"github.com/polygonid/sh-id-platform/internal/repositories"
-	"github.com/polygonid/sh-id-platform/pkg/blockchain/eth"
+	"github.com/polygonid/sh-id-platform/internal/standby"
+	"github.com/polygonid/sh-id-platform/pkg/cache"
 	"github.com/polygonid/sh-id-platform/pkg/loaders"
 	"github.com/polygonid/sh-id-platform/pkg/pubsub"
 	"github.com/polygonid/sh-id-platform/pkg/reverse_hash"
@@ -36,6 +36,7 @@ func main() {
 	}
 
 	// Context with log
+	log.SetSensitiveFields(cfg.PII.Fields)
 	ctx, cancel := context.WithCancel(log.NewContext(context.Background(), cfg.Log.Level, cfg.Log.Mode, os.Stdout))
 
 	rdb, err := redis.Open(cfg.Cache.RedisUrl)
@@ -46,7 +47,7 @@ func main() {
 	ps := pubsub.NewRedis(rdb)
 	ps.WithLogger(log.Error)
 
-	storage, err := db.NewStorage(cfg.Database.URL)
+	storage, err := db.NewStorage(cfg.Database.URL, cfg.Database)
 	if err != nil {
 		log.Error(ctx, "cannot connect to database", "err", err)
 		panic(err)
@@ -59,34 +60,16 @@ func main() {
 		}
 	}(storage)
 
-	vaultCli, err := providers.NewVaultClient(cfg.KeyStore.Address, cfg.KeyStore.Token)
-	if err != nil {
-		log.Error(ctx, "cannot init vault client: ", "err", err)
-		panic(err)
-	}
-
-	bjjKeyProvider, err := kms.NewVaultPluginIden3KeyProvider(vaultCli, cfg.KeyStore.PluginIden3MountPath, kms.KeyTypeBabyJubJub)
+	node, err := standby.New(ctx, storage, cfg.Standby.Enabled)
 	if err != nil {
-		log.Error(ctx, "cannot create BabyJubJub key provider", "err", err)
+		log.Error(ctx, "cannot get the node role", "err", err)
 		panic(err)
 	}
+	node.Run(ctx, cfg.Standby.CheckFrequency)
 
-	ethKeyProvider, err := kms.NewVaultPluginIden3KeyProvider(vaultCli, cfg.KeyStore.PluginIden3MountPath, kms.KeyTypeEthereum)
+	keyStore, err := kms.OpenKeyStore(ctx, cfg.KeyStore)
 	if err != nil {
-		log.Error(ctx, "cannot create Ethereum key provider", "err", err)
-		panic(err)
-	}
-
-	keyStore := kms.NewKMS()
-	err = keyStore.RegisterKeyProvider(kms.KeyTypeBabyJubJub, bjjKeyProvider)
-	if err != nil {
-		log.Error(ctx, "cannot register BabyJubJub key provider", "err", err)
-		panic(err)
-	}
-
-	err = keyStore.RegisterKeyProvider(kms.KeyTypeEthereum, ethKeyProvider)
-	if err != nil {
-		log.Error(ctx, "cannot register Ethereum key provider", "err", err)
+		log.Error(ctx, "cannot initialize kms", "err", err)
 		panic(err)
 	}
 
@@ -100,71 +83,322 @@ func main() {
 	rhsp := reverse_hash.NewRhsPublisher(nil, false)
 	connectionsRepository := repositories.NewConnections()
 	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, claimsRepo, revocationRepository, connectionsRepository, storage, rhsp, nil, nil, pubsub.NewMock())
+	featureFlagService := services.NewFeatureFlags(repositories.NewFeatureFlag(), storage, services.FeatureFlagsCfg{
+		RHS:           cfg.ReverseHashService.Enabled,
+		AsyncIssuance: cfg.FeatureFlags.AsyncIssuance,
+		OID4VCI:       cfg.FeatureFlags.OID4VCI,
+		TestMode:      cfg.FeatureFlags.TestMode,
+		StatusList:    cfg.FeatureFlags.StatusList,
+	})
+	settingsService := services.NewSettings(repositories.NewSettings(), storage, services.ConfiguredSettings(cfg))
+	config.WatchReload(ctx, "", func(cfg *config.Configuration) {
+		settingsService.Reload(ctx, services.ConfiguredSettings(cfg))
+	})
 	claimsService := services.NewClaim(
 		claimsRepo,
 		identityService,
 		mtService,
 		identityStateRepo,
-		loader.HTTPFactory,
+		loader.IPFSFactory(cfg.IPFS.GatewayURL, loader.HTTPFactory),
 		storage,
 		services.ClaimCfg{
 			RHSEnabled: cfg.ReverseHashService.Enabled,
 			RHSUrl:     cfg.ReverseHashService.URL,
 			Host:       cfg.ServerUrl,
+			OfferTTL:   cfg.CredentialOfferTTL,
+			Features:   featureFlagService,
+			// the states confirmed here are removed from the cache of the API
+			StateCache: cache.NewRedisCache(rdb),
+			Settings:   settingsService,
 		},
-		ps,
 	)
 
-	commonClient, err := ethclient.Dial(cfg.Ethereum.URL)
+	connectionsService := services.NewConnection(connectionsRepository, storage)
+	agentInboxService := services.NewAgentInbox(repositories.NewAgentInbox(), storage)
+
+	networkResolver, err := network.NewResolver(ctx, cfg)
 	if err != nil {
-		panic("Error dialing with ethclient: " + err.Error())
-	}
-
-	cl := eth.NewClient(commonClient, &eth.ClientConfig{
-		DefaultGasLimit:        cfg.Ethereum.DefaultGasLimit,
-		ConfirmationTimeout:    cfg.Ethereum.ConfirmationTimeout,
-		ConfirmationBlockCount: cfg.Ethereum.ConfirmationBlockCount,
-		ReceiptTimeout:         cfg.Ethereum.ReceiptTimeout,
-		MinGasPrice:            big.NewInt(int64(cfg.Ethereum.MinGasPrice)),
-		MaxGasPrice:            big.NewInt(int64(cfg.Ethereum.MaxGasPrice)),
-		RPCResponseTimeout:     cfg.Ethereum.RPCResponseTimeout,
-		WaitReceiptCycleTime:   cfg.Ethereum.WaitReceiptCycleTime,
-		WaitBlockCycleTime:     cfg.Ethereum.WaitBlockCycleTime,
-	})
+		log.Error(ctx, "failed init networks", "err", err)
+		panic(err)
+	}
 
 	circuitsLoaderService := loaders.NewCircuits(cfg.Circuit.Path)
 	proofService := initProofService(ctx, cfg, circuitsLoaderService)
 
-	transactionService, err := gateways.NewTransaction(cl, cfg.Ethereum.ConfirmationBlockCount)
+	transactionMonitor, err := gateways.NewTransactionMonitor(storage, repositories.NewStateTransaction(), networkResolver, keyStore, gateways.TransactionMonitorConfig{
+		StuckTimeout:   cfg.TransactionMonitor.StuckTimeout,
+		GasBumpPercent: cfg.TransactionMonitor.GasBumpPercent,
+		MaxAttempts:    cfg.TransactionMonitor.MaxAttempts,
+	})
 	if err != nil {
-		log.Error(ctx, "error creating transaction service", "err", err)
-		panic("error creating transaction service")
+		log.Error(ctx, "error creating transaction monitor", "err", err)
+		panic("error creating transaction monitor")
 	}
-	publisherGateway, err := gateways.NewPublisherEthGateway(cl, common.HexToAddress(cfg.Ethereum.ContractAddress), keyStore, cfg.PublishingKeyPath)
+	networkPublishers, err := gateways.NewNetworkPublishers(networkResolver, keyStore, cfg.PublishingKeyPath, transactionMonitor)
 	if err != nil {
-		log.Error(ctx, "error creating publish gateway", "err", err)
-		panic("error creating publish gateway")
+		log.Error(ctx, "error creating network publishers", "err", err)
+		panic("error creating network publishers")
+	}
+	jobQueueService := services.NewJobQueue(repositories.NewJob(), storage, services.JobQueueCfg{
+		MaxAttempts: cfg.Jobs.MaxAttempts,
+		LockTimeout: cfg.Jobs.LockTimeout,
+		Retention:   cfg.Jobs.Retention,
+	})
+	publisher := gateways.NewPublisher(storage, identityService, claimsService, mtService, keyStore, proofService, networkPublishers, transactionMonitor, jobQueueService)
+	jobQueueService.Register(domain.JobKindStateConfirmation, publisher.ConfirmState)
+	keyRotationService := services.NewKeyRotation(keyStore, claimsRepo, repositories.NewKeyRotation(), claimsService, publisher, storage, cfg.ServerUrl)
+	publishingPolicyService, err := services.NewPublishingPolicy(repositories.NewPublishingPolicy(), publisher, storage, services.PublishingPolicyCfg{
+		Mode:             domain.PublishingMode(cfg.Publishing.Mode),
+		Interval:         cfg.Publishing.Interval,
+		Threshold:        cfg.Publishing.Threshold,
+		RevocationWindow: cfg.Publishing.RevocationWindow,
+		Settings:         settingsService,
+	})
+	if err != nil {
+		log.Error(ctx, "invalid publishing policy", "err", err)
+		panic(err)
 	}
-	publisher := gateways.NewPublisher(storage, identityService, claimsService, mtService, keyStore, transactionService, proofService, publisherGateway, cfg.Ethereum.ConfirmationTimeout, ps)
 
-	quit := make(chan os.Signal, 1)
-	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
+	var revocationDecisionService ports.RevocationDecisionService
+	if cfg.RevocationDecisions.URL != "" {
+		revocationDecisionService = services.NewRevocationDecision(repositories.NewRevocationDecision(), claimsRepo, claimsService, identityService, storage, services.RevocationDecisionCfg{
+			Gateway: gateways.NewRevocationDecisionsClient(cfg.RevocationDecisions.URL, cfg.RevocationDecisions.Token, cfg.RevocationDecisions.Timeout),
+			Source:  cfg.RevocationDecisions.Source,
+		})
+	}
+
+	eventBroker, err := gateways.NewEventBroker(cfg.EventBroker.Type, cfg.EventBroker.URL)
+	if err != nil {
+		log.Error(ctx, "cannot connect to the event broker", "err", err)
+		panic(err)
+	}
+	if eventBroker != nil {
+		defer func() {
+			if err := eventBroker.Close(); err != nil {
+				log.Error(ctx, "closing the event broker", "err", err)
+			}
+		}()
+	}
+	eventRelayService := services.NewEventRelay(repositories.NewEventOutbox(), eventBroker, ps, storage, services.EventRelayCfg{
+		TopicPrefix: cfg.EventBroker.TopicPrefix,
+		BatchSize:   cfg.Outbox.BatchSize,
+		Retention:   cfg.Outbox.Retention,
+	})
+
+	var reportService ports.ReportService
+	if cfg.Reports.Enabled {
+		reportService = services.NewReport(
+			repositories.NewStats(),
+			gateways.NewSMTPClient(gateways.SMTPConfig{
+				Host:     cfg.SMTP.Host,
+				Port:     cfg.SMTP.Port,
+				User:     cfg.SMTP.User,
+				Password: cfg.SMTP.Password,
+				From:     cfg.SMTP.From,
+			}),
+			storage,
+			services.ReportCfg{
+				Frequency:  domain.ReportFrequency(cfg.Reports.Frequency),
+				Format:     domain.ReportFormat(cfg.Reports.Format),
+				Recipients: cfg.Reports.Recipients,
+				Settings:   settingsService,
+			},
+		)
+	}
+
+	// workers runs the background workers until ctx is done and waits for them to finish
+	workers := func(ctx context.Context) {
+		var wg sync.WaitGroup
+		run := func(worker func(ctx context.Context)) {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				worker(ctx)
+			}()
+		}
+		defer wg.Wait()
+
+		run(func(ctx context.Context) {
+			ticker := time.NewTicker(cfg.OnChainCheckStatusFrequency)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ticker.C:
+					publisher.CheckTransactionStatus(ctx)
+					if err := keyRotationService.ProcessPending(ctx); err != nil {
+						log.Error(ctx, "processing pending key rotations", "err", err)
+					}
+				case <-ctx.Done():
+					log.Info(ctx, "finishing check transaction status job")
+					return
+				}
+			}
+		})
+
+		run(func(ctx context.Context) {
+			ticker := time.NewTicker(cfg.Publishing.CheckFrequency)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ticker.C:
+					if err := publishingPolicyService.PublishDue(ctx); err != nil {
+						log.Error(ctx, "publishing scheduled states", "err", err)
+					}
+				case <-ctx.Done():
+					log.Info(ctx, "finishing scheduled publishing job")
+					return
+				}
+			}
+		})
+
+		if cfg.ExpirationCheckFrequency > 0 {
+			run(func(ctx context.Context) {
+				ticker := time.NewTicker(cfg.ExpirationCheckFrequency)
+				defer ticker.Stop()
+				for {
+					select {
+					case <-ticker.C:
+						if err := claimsService.ProcessExpired(ctx); err != nil {
+							log.Error(ctx, "processing expired credentials", "err", err)
+						}
+					case <-ctx.Done():
+						log.Info(ctx, "finishing credential expiration job")
+						return
+					}
+				}
+			})
+		}
 
-	go func(ctx context.Context) {
-		ticker := time.NewTicker(cfg.OnChainCheckStatusFrequency)
-		for {
-			select {
-			case <-ticker.C:
-				publisher.CheckTransactionStatus(ctx)
-			case <-ctx.Done():
-				log.Info(ctx, "finishing check transaction status job")
+		if revocationDecisionService != nil {
+			run(func(ctx context.Context) {
+				ticker := time.NewTicker(cfg.RevocationDecisions.PollFrequency)
+				defer ticker.Stop()
+				for {
+					select {
+					case <-ticker.C:
+						if err := revocationDecisionService.Poll(ctx); err != nil {
+							log.Error(ctx, "polling revocation decisions", "err", err)
+						}
+					case <-ctx.Done():
+						log.Info(ctx, "finishing revocation decisions job")
+						return
+					}
+				}
+			})
+		}
+
+		run(func(ctx context.Context) {
+			ticker := time.NewTicker(cfg.Jobs.CheckFrequency)
+			defer ticker.Stop()
+			purge := time.NewTicker(time.Hour)
+			defer purge.Stop()
+			for {
+				select {
+				case <-ticker.C:
+					if _, err := jobQueueService.Work(ctx); err != nil {
+						log.Error(ctx, "running background jobs", "err", err)
+					}
+				case <-purge.C:
+					if err := jobQueueService.Purge(ctx); err != nil {
+						log.Error(ctx, "purging background jobs", "err", err)
+					}
+				case <-ctx.Done():
+					log.Info(ctx, "finishing background jobs worker")
+					return
+				}
+			}
+		})
+
+		run(func(ctx context.Context) {
+			ticker := time.NewTicker(cfg.Outbox.CheckFrequency)
+			defer ticker.Stop()
+			purge := time.NewTicker(time.Hour)
+			defer purge.Stop()
+			for {
+				select {
+				case <-ticker.C:
+					if _, err := eventRelayService.Relay(ctx); err != nil {
+						log.Error(ctx, "sending outbox events", "err", err)
+					}
+				case <-purge.C:
+					if err := eventRelayService.Purge(ctx); err != nil {
+						log.Error(ctx, "purging outbox events", "err", err)
+					}
+				case <-ctx.Done():
+					log.Info(ctx, "finishing outbox events job")
+					return
+				}
 			}
+		})
+
+		run(func(ctx context.Context) {
+			ticker := time.NewTicker(time.Hour)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ticker.C:
+					if err := claimsService.PurgeDeleted(ctx, cfg.DeletedRetention); err != nil {
+						log.Error(ctx, "purging deleted credentials", "err", err)
+					}
+					if err := connectionsService.PurgeDeleted(ctx, cfg.DeletedRetention); err != nil {
+						log.Error(ctx, "purging deleted connections", "err", err)
+					}
+					if err := agentInboxService.Purge(ctx, cfg.AgentInboxRetention); err != nil {
+						log.Error(ctx, "purging agent inbox", "err", err)
+					}
+				case <-ctx.Done():
+					log.Info(ctx, "finishing deleted items purge job")
+					return
+				}
+			}
+		})
+
+		if reportService != nil {
+			run(func(ctx context.Context) {
+				frequency := domain.ReportFrequency(cfg.Reports.Frequency)
+				for {
+					next := frequency.Next(time.Now(), cfg.Reports.Hour)
+					log.Info(ctx, "next report scheduled", "at", next)
+					timer := time.NewTimer(time.Until(next))
+					select {
+					case <-timer.C:
+						if err := reportService.Send(ctx, next); err != nil {
+							log.Error(ctx, "sending scheduled report", "err", err)
+						}
+					case <-ctx.Done():
+						timer.Stop()
+						log.Info(ctx, "finishing scheduled reports job")
+						return
+					}
+				}
+			})
 		}
-	}(ctx)
+	}
+
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
+
+	// a standby does not publish states nor run jobs, the primary does, until its database is promoted
+	select {
+	case <-node.Active():
+	case <-quit:
+		log.Info(ctx, "finishing app")
+		cancel()
+		return
+	}
+
+	// with several replicas only the one holding the lease runs the workers, another one takes over if it stops
+	elector := leader.New(rdb, "pending_publisher", cfg.LeaderElection.LeaseTTL)
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		elector.Run(ctx, workers)
+	}()
 
 	<-quit
 	log.Info(ctx, "finishing app")
 	cancel()
+	<-stopped
 	log.Info(ctx, "Finished")
 }
 
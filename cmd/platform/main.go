@@ -3,19 +3,29 @@ package main
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
-	"github.com/ethereum/go-ethereum/common"
 	"github.com/go-chi/chi/v5"
 	chiMiddleware "github.com/go-chi/chi/v5/middleware"
-	"github.com/go-chi/cors"
 	redis2 "github.com/go-redis/redis/v8"
+	auth "github.com/iden3/go-iden3-auth"
+	authLoaders "github.com/iden3/go-iden3-auth/loaders"
+	core "github.com/iden3/go-iden3-core"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 
 	"github.com/polygonid/sh-id-platform/internal/api"
+	"github.com/polygonid/sh-id-platform/internal/api_grpc"
 	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
 	"github.com/polygonid/sh-id-platform/internal/core/services"
 	"github.com/polygonid/sh-id-platform/internal/db"
 	"github.com/polygonid/sh-id-platform/internal/errors"
@@ -24,17 +34,25 @@ import (
 	"github.com/polygonid/sh-id-platform/internal/kms"
 	"github.com/polygonid/sh-id-platform/internal/loader"
 	"github.com/polygonid/sh-id-platform/internal/log"
-	"github.com/polygonid/sh-id-platform/internal/providers"
-	"github.com/polygonid/sh-id-platform/internal/providers/blockchain"
+	"github.com/polygonid/sh-id-platform/internal/middleware"
+	"github.com/polygonid/sh-id-platform/internal/network"
 	"github.com/polygonid/sh-id-platform/internal/redis"
 	"github.com/polygonid/sh-id-platform/internal/repositories"
+	"github.com/polygonid/sh-id-platform/internal/standby"
 	"github.com/polygonid/sh-id-platform/pkg/cache"
+	client "github.com/polygonid/sh-id-platform/pkg/http"
 	"github.com/polygonid/sh-id-platform/pkg/loaders"
 	"github.com/polygonid/sh-id-platform/pkg/protocol"
 	"github.com/polygonid/sh-id-platform/pkg/pubsub"
+	"github.com/polygonid/sh-id-platform/pkg/ratelimit"
 	"github.com/polygonid/sh-id-platform/pkg/reverse_hash"
+	"github.com/polygonid/sh-id-platform/pkg/schema"
 )
 
+// readOnlyRetryAfter is the time clients are asked to wait before retrying a request rejected because the identity is
+// being migrated or the node is a standby
+const readOnlyRetryAfter = 30 * time.Second
+
 func main() {
 	cfg, err := config.Load("")
 	if err != nil {
@@ -42,6 +60,7 @@ func main() {
 		return
 	}
 
+	log.SetSensitiveFields(cfg.PII.Fields)
 	ctx, cancel := context.WithCancel(log.NewContext(context.Background(), cfg.Log.Level, cfg.Log.Mode, os.Stdout))
 	defer cancel()
 
@@ -50,11 +69,28 @@ func main() {
 		return
 	}
 
-	storage, err := db.NewStorage(cfg.Database.URL)
+	storage, err := db.NewStorage(cfg.Database.URL, cfg.Database)
 	if err != nil {
 		log.Error(ctx, "cannot connect to database", "err", err)
 		return
 	}
+	if cfg.Database.ReplicaURL != "" {
+		if err := storage.ConnectReplica(cfg.Database.ReplicaURL, cfg.Database); err != nil {
+			log.Error(ctx, "cannot connect to database replica", "err", err)
+			return
+		}
+	}
+	if err := storage.RegisterMetrics(prometheus.DefaultRegisterer); err != nil {
+		log.Error(ctx, "cannot register database metrics", "err", err)
+		return
+	}
+
+	node, err := standby.New(ctx, storage, cfg.Standby.Enabled)
+	if err != nil {
+		log.Error(ctx, "cannot get the node role", "err", err)
+		return
+	}
+	node.Run(ctx, cfg.Standby.CheckFrequency)
 
 	// Redis cache
 	rdb, err := redis.Open(cfg.Cache.RedisUrl)
@@ -65,40 +101,25 @@ func main() {
 	ps := pubsub.NewRedis(rdb)
 	ps.WithLogger(log.Error)
 	cachex := cache.NewRedisCache(rdb)
+	documentCache := schema.NewDocumentCache(cachex, cfg.SchemaCacheTTL, http.DefaultTransport)
 	var schemaLoader loader.Factory
 	if cfg.SchemaCache == nil || !*cfg.SchemaCache {
-		schemaLoader = loader.HTTPFactory
+		schemaLoader = loader.IPFSFactory(cfg.IPFS.GatewayURL, loader.HTTPFactory)
 	} else {
-		schemaLoader = loader.CachedFactory(loader.HTTPFactory, cachex)
+		// the JSON-LD contexts are loaded with the default client by the validation and merklization of the credentials
+		http.DefaultClient.Transport = documentCache
+		schemaLoader = loader.IPFSFactory(cfg.IPFS.GatewayURL, documentCache.Factory)
 	}
 
-	vaultCli, err := providers.NewVaultClient(cfg.KeyStore.Address, cfg.KeyStore.Token)
-	if err != nil {
-		log.Error(ctx, "cannot init vault client: ", "err", err)
-		return
-	}
-
-	keyStore, err := kms.Open(cfg.KeyStore.PluginIden3MountPath, vaultCli)
+	keyStore, err := kms.OpenKeyStore(ctx, cfg.KeyStore)
 	if err != nil {
 		log.Error(ctx, "cannot initialize kms", "err", err)
 		return
 	}
 
-	ethereumClient, err := blockchain.Open(cfg)
-	if err != nil {
-		log.Error(ctx, "error dialing with ethereum client", "err", err)
-		return
-	}
-
-	stateContract, err := blockchain.InitEthClient(cfg.Ethereum.URL, cfg.Ethereum.ContractAddress)
+	networkResolver, err := network.NewResolver(ctx, cfg)
 	if err != nil {
-		log.Error(ctx, "failed init ethereum client", "err", err)
-		return
-	}
-
-	ethConn, err := blockchain.InitEthConnect(cfg.Ethereum)
-	if err != nil {
-		log.Error(ctx, "failed init ethereum connect", "err", err)
+		log.Error(ctx, "failed init networks", "err", err)
 		return
 	}
 
@@ -112,10 +133,42 @@ func main() {
 	mtRepository := repositories.NewIdentityMerkleTreeRepository()
 	identityStateRepository := repositories.NewIdentityState()
 	revocationRepository := repositories.NewRevocation()
+	heldCredentialRepository := repositories.NewHeldCredential()
+	schemaRepository := repositories.NewSchema(*storage)
 
 	// services initialization
 	mtService := services.NewIdentityMerkleTrees(mtRepository)
+	settingsService := services.NewSettings(repositories.NewSettings(), storage, services.ConfiguredSettings(cfg))
+	config.WatchReload(ctx, "", func(cfg *config.Configuration) {
+		settingsService.Reload(ctx, services.ConfiguredSettings(cfg))
+	})
 	identityService := services.NewIdentity(keyStore, identityRepository, mtRepository, identityStateRepository, mtService, claimsRepository, revocationRepository, nil, storage, rhsp, nil, nil, ps)
+	featureFlagService := services.NewFeatureFlags(repositories.NewFeatureFlag(), storage, services.FeatureFlagsCfg{
+		RHS:           cfg.ReverseHashService.Enabled,
+		AsyncIssuance: cfg.FeatureFlags.AsyncIssuance,
+		OID4VCI:       cfg.FeatureFlags.OID4VCI,
+		TestMode:      cfg.FeatureFlags.TestMode,
+		StatusList:    cfg.FeatureFlags.StatusList,
+	})
+	credentialValidationService := services.NewCredentialValidation(schemaRepository, gateways.NewValidationWebhookClient(cfg.ValidationWebhook.Timeout), cachex, services.CredentialValidationCfg{
+		ApprovalTTL: cfg.ValidationWebhook.ApprovalTTL,
+	})
+	var didResolver ports.DIDResolverGateway
+	if cfg.DIDResolver.URL != "" {
+		didResolver = gateways.NewDIDResolverClient(cfg.DIDResolver.URL, cfg.DIDResolver.Timeout)
+	}
+	subjectDIDService := services.NewSubjectDID(didResolver, cachex, services.SubjectDIDCfg{
+		CacheTTL: cfg.DIDResolver.CacheTTL,
+	})
+	proofPolicy, err := domain.NewProofPolicy(cfg.ProofPolicy.Default, cfg.ProofPolicy.Allowed)
+	if err != nil {
+		log.Error(ctx, "invalid proof policy", "err", err)
+		return
+	}
+	issuerProfileService := services.NewIssuerProfile(repositories.NewIssuerProfile(), storage, services.IssuerProfileCfg{
+		DisplayName: cfg.APIUI.IssuerName,
+		LogoURL:     cfg.APIUI.IssuerLogo,
+	})
 	claimsService := services.NewClaim(
 		claimsRepository,
 		identityService,
@@ -124,61 +177,189 @@ func main() {
 		schemaLoader,
 		storage,
 		services.ClaimCfg{
-			RHSEnabled: cfg.ReverseHashService.Enabled,
-			RHSUrl:     cfg.ReverseHashService.URL,
-			Host:       cfg.ServerUrl,
+			RHSEnabled:        cfg.ReverseHashService.Enabled,
+			RHSUrl:            cfg.ReverseHashService.URL,
+			Host:              cfg.ServerUrl,
+			OfferTTL:          cfg.CredentialOfferTTL,
+			AgentReplayWindow: cfg.AgentReplayWindow,
+			Features:          featureFlagService,
+			Validation:        credentialValidationService,
+			Schemas:           schemaRepository,
+			ProofPolicy:       proofPolicy,
+			SubjectDIDs:       subjectDIDService,
+			Profiles:          issuerProfileService,
+			StateCache:        cachex,
+			StateCacheTTL:     cfg.Cache.StateTTL,
+			RevNonceStrategy:  domain.RevNonceStrategy(cfg.RevNonce.Strategy),
+			Settings:          settingsService,
 		},
-		ps,
 	)
 	proofService := gateways.NewProver(ctx, cfg, circuitsLoaderService)
-	revocationService := services.NewRevocationService(ethConn, common.HexToAddress(cfg.Ethereum.ContractAddress))
-	zkProofService := services.NewProofService(claimsService, revocationService, identityService, mtService, claimsRepository, keyStore, storage, stateContract, schemaLoader)
-	transactionService, err := gateways.NewTransaction(ethereumClient, cfg.Ethereum.ConfirmationBlockCount)
+	revocationService := services.NewRevocationService(networkResolver)
+	zkProofService := services.NewProofService(claimsService, revocationService, identityService, mtService, claimsRepository, heldCredentialRepository, keyStore, storage, networkResolver, schemaLoader)
+	transactionMonitor, err := gateways.NewTransactionMonitor(storage, repositories.NewStateTransaction(), networkResolver, keyStore, gateways.TransactionMonitorConfig{
+		StuckTimeout:   cfg.TransactionMonitor.StuckTimeout,
+		GasBumpPercent: cfg.TransactionMonitor.GasBumpPercent,
+		MaxAttempts:    cfg.TransactionMonitor.MaxAttempts,
+	})
 	if err != nil {
-		log.Error(ctx, "error creating transaction service", "err", err)
+		log.Error(ctx, "error creating transaction monitor", "err", err)
 		return
 	}
-
-	publisherGateway, err := gateways.NewPublisherEthGateway(ethereumClient, common.HexToAddress(cfg.Ethereum.ContractAddress), keyStore, cfg.PublishingKeyPath)
+	networkPublishers, err := gateways.NewNetworkPublishers(networkResolver, keyStore, cfg.PublishingKeyPath, transactionMonitor)
 	if err != nil {
-		log.Error(ctx, "error creating publish gateway", "err", err)
+		log.Error(ctx, "error creating network publishers", "err", err)
 		return
 	}
 
-	publisher := gateways.NewPublisher(storage, identityService, claimsService, mtService, keyStore, transactionService, proofService, publisherGateway, cfg.Ethereum.ConfirmationTimeout, ps)
+	jobQueueService := services.NewJobQueue(repositories.NewJob(), storage, services.JobQueueCfg{
+		MaxAttempts: cfg.Jobs.MaxAttempts,
+		LockTimeout: cfg.Jobs.LockTimeout,
+		Retention:   cfg.Jobs.Retention,
+	})
+	publisher := gateways.NewPublisher(storage, identityService, claimsService, mtService, keyStore, proofService, networkPublishers, transactionMonitor, jobQueueService)
 
-	packageManager, err := protocol.InitPackageManager(ctx, stateContract, zkProofService, cfg.Circuit.Path)
+	packageManager, err := protocol.InitPackageManager(ctx, networkResolver.StateContracts(), zkProofService, cfg.Circuit.Path)
 	if err != nil {
 		log.Error(ctx, "failed init package protocol", "err", err)
 		return
 	}
+	var decrypter *protocol.Decrypter
+	if cfg.EncryptionKeys != "" {
+		encryptionKeys, err := protocol.LoadEncryptionKeys(cfg.EncryptionKeys)
+		if err != nil {
+			log.Error(ctx, "failed loading the encryption keys", "err", err)
+			return
+		}
+		decrypter = protocol.NewDecrypter(encryptionKeys)
+	}
 
-	serverHealth := health.New(health.Monitors{
+	keyRotationService := services.NewKeyRotation(keyStore, claimsRepository, repositories.NewKeyRotation(), claimsService, publisher, storage, cfg.ServerUrl)
+	identityMigrationService := services.NewIdentityMigration(repositories.NewIdentityMigration(), mtService, keyStore, storage)
+	publishingPolicyService, err := services.NewPublishingPolicy(repositories.NewPublishingPolicy(), publisher, storage, services.PublishingPolicyCfg{
+		Mode:             domain.PublishingMode(cfg.Publishing.Mode),
+		Interval:         cfg.Publishing.Interval,
+		Threshold:        cfg.Publishing.Threshold,
+		RevocationWindow: cfg.Publishing.RevocationWindow,
+		Settings:         settingsService,
+	})
+	if err != nil {
+		log.Error(ctx, "invalid publishing policy", "err", err)
+		return
+	}
+	revocationDecisionService := services.NewRevocationDecision(repositories.NewRevocationDecision(), claimsRepository, claimsService, identityService, storage, services.RevocationDecisionCfg{})
+	verifier := auth.NewVerifier(loaders.NewVerificationKeys(cfg.Circuit.Path), authLoaders.DefaultSchemaLoader{IpfsURL: "ipfs.io"}, networkResolver.StateResolvers())
+	verificationService := services.NewVerification(repositories.NewVerification(), repositories.NewConnections(), identityService, verifier, storage, services.VerificationCfg{
+		Host:            cfg.ServerUrl,
+		TransitionDelay: 5 * time.Minute,
+	})
+	didDocumentService := services.NewDIDDocument(repositories.NewDIDService(), identityService, storage, networkResolver, services.DIDDocumentCfg{
+		ServerURL: cfg.ServerUrl,
+	})
+	treeIntegrityService := services.NewTreeIntegrity(mtService, identityStateRepository, networkResolver, storage)
+	agentInboxService := services.NewAgentInbox(repositories.NewAgentInbox(), storage)
+	// the wallets see the node as a single issuer, the one of the UI, if there is one
+	var displayIssuer *core.DID
+	if cfg.APIUI.Issuer != "" {
+		if displayIssuer, err = core.ParseDID(cfg.APIUI.Issuer); err != nil {
+			log.Error(ctx, "invalid issuer did of the UI", "err", err)
+			return
+		}
+	}
+	oid4vciService := services.NewOID4VCI(repositories.NewOID4VCI(), claimsService, identityService, storage, services.OID4VCICfg{
+		Host:            cfg.ServerUrl,
+		OfferExpiration: cfg.OID4VCI.OfferExpiration,
+		TokenExpiration: cfg.OID4VCI.TokenExpiration,
+		DisplayIssuer:   displayIssuer,
+		Profiles:        issuerProfileService,
+	})
+	ipfsPinner, err := gateways.NewIPFSPinner(cfg.IPFS)
+	if err != nil {
+		log.Error(ctx, "cannot initialize the ipfs pinner", "err", err)
+		return
+	}
+	schemaService := services.NewSchema(schemaRepository, schemaLoader, cfg.APIUI.ServerURL, ipfsPinner)
+	connectionsService := services.NewConnection(repositories.NewConnections(), storage)
+	jwtCredentialService := services.NewJWTCredential(claimsService, identityService, repositories.NewStatusList(), storage, keyStore, services.JWTCredentialCfg{
+		Algorithm: cfg.JWTCredential.Algorithm,
+		Host:      cfg.ServerUrl,
+		Features:  featureFlagService,
+		Cache:     cachex,
+		CacheTTL:  cfg.Cache.StateTTL,
+	})
+	walletService := services.NewWallet(heldCredentialRepository, identityService, zkProofService, proofService, packageManager, client.DefaultHTTPClientWithRetry, storage)
+
+	monitors := health.Monitors{
 		"postgres": storage.Ping,
 		"redis": func(rdb *redis2.Client) health.Pinger {
 			return func(ctx context.Context) error { return rdb.Ping(ctx).Err() }
 		}(rdb),
-	})
+	}
+	backlogThresholds := domain.BacklogThresholds{
+		MaxUnpublishedClaims: cfg.Backlog.MaxUnpublishedClaims,
+		MaxPendingRetries:    cfg.Backlog.MaxPendingRetries,
+		MaxPendingAge:        cfg.Backlog.MaxPendingAge,
+	}
+	if backlogThresholds.Enabled() {
+		var emailGateway ports.EmailGateway
+		if len(cfg.Backlog.AlertRecipients) > 0 {
+			emailGateway = gateways.NewSMTPClient(gateways.SMTPConfig{
+				Host:     cfg.SMTP.Host,
+				Port:     cfg.SMTP.Port,
+				User:     cfg.SMTP.User,
+				Password: cfg.SMTP.Password,
+				From:     cfg.SMTP.From,
+			})
+		}
+		monitors["backlog"] = services.NewBacklog(repositories.NewStats(), emailGateway, storage, services.BacklogCfg{
+			Thresholds:      backlogThresholds,
+			AlertRecipients: cfg.Backlog.AlertRecipients,
+			Settings:        settingsService,
+		}).Check
+	}
+	serverHealth := health.New(monitors)
 	serverHealth.Run(ctx, health.DefaultPingPeriod)
 
+	if cfg.WarmUp.Enabled {
+		serverHealth.NotReady()
+		go func() {
+			warmUpCtx, cancelWarmUp := context.WithTimeout(ctx, cfg.WarmUp.Timeout)
+			defer cancelWarmUp()
+			if err := services.NewWarmUp(identityService, schemaRepository, schemaLoader, keyStore).Run(warmUpCtx); err != nil {
+				log.Warn(ctx, "warm-up did not complete", "err", err)
+			}
+			serverHealth.SetReady()
+			log.Info(ctx, "warm-up finished, accepting traffic")
+		}()
+	}
+
+	idempotencyService := services.NewIdempotency(repositories.NewIdempotencyKey(), storage, cfg.IdempotencyKeyTTL)
+
 	mux := chi.NewRouter()
 	mux.Use(
 		chiMiddleware.RequestID,
 		log.ChiMiddleware(ctx),
 		chiMiddleware.Recoverer,
-		cors.Handler(cors.Options{AllowedOrigins: []string{"*"}}),
+		middleware.CORS(cfg.CORS),
+		serverHealth.ReadinessGate("/status"),
+		middleware.SecurityHeaders(cfg.SecurityHeaders),
+		middleware.RateLimit(ctx, ratelimit.NewRedisLimiter(rdb), middleware.SettingsRateLimits(settingsService, cfg.RateLimit), cfg.HTTPBasicAuth, "/status"),
 		chiMiddleware.NoCache,
+		middleware.Timeout(ctx, cfg.RequestTimeout, "/status"),
+		middleware.DecryptEnvelopes(ctx, decrypter),
+		middleware.Idempotency(ctx, idempotencyService, cfg.HTTPBasicAuth, "/v1/*/claims", "/v1/*/claims/revoke/*"),
 	)
 	api.HandlerFromMux(
 		api.NewStrictHandlerWithOptions(
-			api.NewServer(cfg, identityService, claimsService, publisher, packageManager, serverHealth),
-			middlewares(ctx, cfg.HTTPBasicAuth),
+			api.NewServer(cfg, identityService, claimsService, walletService, keyRotationService, featureFlagService, identityMigrationService, publishingPolicyService, revocationDecisionService, verificationService, oid4vciService, jwtCredentialService, issuerProfileService, didDocumentService, treeIntegrityService, documentCache, agentInboxService, settingsService, publisher, packageManager, networkResolver, serverHealth),
+			middlewares(ctx, cfg.HTTPBasicAuth, identityMigrationService, node),
 			api.StrictHTTPServerOptions{
 				RequestErrorHandlerFunc:  errors.RequestErrorHandlerFunc,
 				ResponseErrorHandlerFunc: errors.ResponseErrorHandlerFunc,
 			}),
 		mux)
 	api.RegisterStatic(mux)
+	mux.Handle("/metrics", promhttp.Handler())
 
 	server := &http.Server{
 		Addr:    fmt.Sprintf(":%d", cfg.ServerPort),
@@ -194,13 +375,57 @@ func main() {
 		}
 	}()
 
+	if cfg.GRPC.Port != 0 {
+		grpcServer, err := newGRPCServer(cfg, identityMigrationService, node)
+		if err != nil {
+			log.Error(ctx, "cannot initialize the grpc server", "err", err)
+			return
+		}
+		api_grpc.NewServer(cfg, claimsService, connectionsService, schemaService, publisher).Register(grpcServer)
+		lis, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.GRPC.Port))
+		if err != nil {
+			log.Error(ctx, "cannot listen on the grpc port", "err", err)
+			return
+		}
+		go func() {
+			log.Info(ctx, "grpc server started", "port", cfg.GRPC.Port)
+			if err := grpcServer.Serve(lis); err != nil {
+				log.Error(ctx, "starting grpc server", "err", err)
+			}
+		}()
+		defer grpcServer.GracefulStop()
+	}
+
 	<-quit
 	log.Info(ctx, "Shutting down")
 }
 
-func middlewares(ctx context.Context, auth config.HTTPBasicAuth) []api.StrictMiddlewareFunc {
+// newGRPCServer returns a gRPC server with the TLS credentials of the configuration, that checks the credentials of the
+// admin API and rejects the changes of the identities that are read only, like the admin API
+func newGRPCServer(cfg *config.Configuration, migration ports.IdentityMigrationService, node *standby.Node) (*grpc.Server, error) {
+	creds, err := credentials.NewServerTLSFromFile(cfg.GRPC.CertFile, cfg.GRPC.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+	metrics, err := api_grpc.MetricsInterceptor(prometheus.DefaultRegisterer)
+	if err != nil {
+		return nil, err
+	}
+	return grpc.NewServer(
+		grpc.Creds(creds),
+		grpc.ChainUnaryInterceptor(
+			metrics,
+			api_grpc.AuthInterceptor(cfg.HTTPBasicAuth.User, cfg.HTTPBasicAuth.Password),
+			api_grpc.ReadOnlyInterceptor(migration, node),
+		),
+	), nil
+}
+
+func middlewares(ctx context.Context, auth config.HTTPBasicAuth, migration ports.IdentityMigrationService, node *standby.Node) []api.StrictMiddlewareFunc {
 	return []api.StrictMiddlewareFunc{
 		api.LogMiddleware(ctx),
+		api.ReadOnlyMiddleware(migration, readOnlyRetryAfter),
+		api.StandbyMiddleware(node, readOnlyRetryAfter),
 		api.BasicAuthMiddleware(ctx, auth.User, auth.Password),
 	}
 }
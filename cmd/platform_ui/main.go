@@ -8,20 +8,18 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
-	"github.com/ethereum/go-ethereum/common"
 	"github.com/go-chi/chi/v5"
 	chiMiddleware "github.com/go-chi/chi/v5/middleware"
-	"github.com/go-chi/cors"
 	redis2 "github.com/go-redis/redis/v8"
 	auth "github.com/iden3/go-iden3-auth"
 	authLoaders "github.com/iden3/go-iden3-auth/loaders"
-	"github.com/iden3/go-iden3-auth/pubsignals"
-	"github.com/iden3/go-iden3-auth/state"
 	core "github.com/iden3/go-iden3-core"
 
 	"github.com/polygonid/sh-id-platform/internal/api_ui"
 	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
 	"github.com/polygonid/sh-id-platform/internal/core/ports"
 	"github.com/polygonid/sh-id-platform/internal/core/services"
 	"github.com/polygonid/sh-id-platform/internal/db"
@@ -31,17 +29,26 @@ import (
 	"github.com/polygonid/sh-id-platform/internal/kms"
 	"github.com/polygonid/sh-id-platform/internal/loader"
 	"github.com/polygonid/sh-id-platform/internal/log"
-	"github.com/polygonid/sh-id-platform/internal/providers"
-	"github.com/polygonid/sh-id-platform/internal/providers/blockchain"
+	"github.com/polygonid/sh-id-platform/internal/middleware"
+	"github.com/polygonid/sh-id-platform/internal/network"
 	"github.com/polygonid/sh-id-platform/internal/redis"
 	"github.com/polygonid/sh-id-platform/internal/repositories"
+	"github.com/polygonid/sh-id-platform/internal/standby"
 	"github.com/polygonid/sh-id-platform/pkg/cache"
+	"github.com/polygonid/sh-id-platform/pkg/emails"
 	"github.com/polygonid/sh-id-platform/pkg/loaders"
 	"github.com/polygonid/sh-id-platform/pkg/protocol"
 	"github.com/polygonid/sh-id-platform/pkg/pubsub"
+	"github.com/polygonid/sh-id-platform/pkg/ratelimit"
 	"github.com/polygonid/sh-id-platform/pkg/reverse_hash"
+	"github.com/polygonid/sh-id-platform/pkg/schema"
+	"github.com/polygonid/sh-id-platform/pkg/walletlinks"
 )
 
+// readOnlyRetryAfter is the time clients are asked to wait before retrying a request rejected because the identity is
+// being migrated or the node is a standby
+const readOnlyRetryAfter = 30 * time.Second
+
 func main() {
 	cfg, err := config.Load("")
 	if err != nil {
@@ -49,6 +56,7 @@ func main() {
 		return
 	}
 
+	log.SetSensitiveFields(cfg.PII.Fields)
 	ctx, cancel := context.WithCancel(log.NewContext(context.Background(), cfg.Log.Level, cfg.Log.Mode, os.Stdout))
 	defer cancel()
 
@@ -57,11 +65,24 @@ func main() {
 		return
 	}
 
-	storage, err := db.NewStorage(cfg.Database.URL)
+	storage, err := db.NewStorage(cfg.Database.URL, cfg.Database)
 	if err != nil {
 		log.Error(ctx, "cannot connect to database", "err", err)
 		return
 	}
+	if cfg.Database.ReplicaURL != "" {
+		if err := storage.ConnectReplica(cfg.Database.ReplicaURL, cfg.Database); err != nil {
+			log.Error(ctx, "cannot connect to database replica", "err", err)
+			return
+		}
+	}
+
+	node, err := standby.New(ctx, storage, cfg.Standby.Enabled)
+	if err != nil {
+		log.Error(ctx, "cannot get the node role", "err", err)
+		return
+	}
+	node.Run(ctx, cfg.Standby.CheckFrequency)
 
 	// Redis cache
 	rdb, err := redis.Open(cfg.Cache.RedisUrl)
@@ -75,50 +96,28 @@ func main() {
 
 	var schemaLoader loader.Factory
 	if cfg.APIUI.SchemaCache == nil || !*cfg.APIUI.SchemaCache {
-		schemaLoader = loader.HTTPFactory
+		schemaLoader = loader.IPFSFactory(cfg.IPFS.GatewayURL, loader.HTTPFactory)
 	} else {
-		schemaLoader = loader.CachedFactory(loader.HTTPFactory, cachex)
+		documentCache := schema.NewDocumentCache(cachex, cfg.SchemaCacheTTL, http.DefaultTransport)
+		// the JSON-LD contexts are loaded with the default client by the validation and merklization of the credentials
+		http.DefaultClient.Transport = documentCache
+		schemaLoader = loader.IPFSFactory(cfg.IPFS.GatewayURL, documentCache.Factory)
 	}
 
-	vaultCli, err := providers.NewVaultClient(cfg.KeyStore.Address, cfg.KeyStore.Token)
-	if err != nil {
-		log.Error(ctx, "cannot init vault client: ", "err", err)
-		return
-	}
-
-	keyStore, err := kms.Open(cfg.KeyStore.PluginIden3MountPath, vaultCli)
+	keyStore, err := kms.OpenKeyStore(ctx, cfg.KeyStore)
 	if err != nil {
 		log.Error(ctx, "cannot initialize kms", "err", err)
 		return
 	}
 
-	ethereumClient, err := blockchain.Open(cfg)
-	if err != nil {
-		log.Error(ctx, "error dialing with ethereum client", "err", err)
-		return
-	}
-
-	stateContract, err := blockchain.InitEthClient(cfg.Ethereum.URL, cfg.Ethereum.ContractAddress)
+	networkResolver, err := network.NewResolver(ctx, cfg)
 	if err != nil {
-		log.Error(ctx, "failed init ethereum client", "err", err)
-		return
-	}
-
-	ethConn, err := blockchain.InitEthConnect(cfg.Ethereum)
-	if err != nil {
-		log.Error(ctx, "failed init ethereum connect", "err", err)
+		log.Error(ctx, "failed init networks", "err", err)
 		return
 	}
 
 	verificationKeyLoader := &authLoaders.FSKeyLoader{Dir: cfg.Circuit.Path + "/authV2"}
-	resolvers := map[string]pubsignals.StateResolver{
-		cfg.Ethereum.ResolverPrefix: state.ETHResolver{
-			RPCUrl:          cfg.Ethereum.URL,
-			ContractAddress: common.HexToAddress(cfg.Ethereum.ContractAddress),
-		},
-	}
-
-	verifier := auth.NewVerifier(verificationKeyLoader, authLoaders.DefaultSchemaLoader{IpfsURL: "ipfs.io"}, resolvers)
+	verifier := auth.NewVerifier(verificationKeyLoader, authLoaders.DefaultSchemaLoader{IpfsURL: "ipfs.io"}, networkResolver.StateResolvers())
 
 	circuitsLoaderService := loaders.NewCircuits(cfg.Circuit.Path)
 
@@ -130,15 +129,57 @@ func main() {
 	mtRepository := repositories.NewIdentityMerkleTreeRepository()
 	identityStateRepository := repositories.NewIdentityState()
 	revocationRepository := repositories.NewRevocation()
+	heldCredentialRepository := repositories.NewHeldCredential()
 	connectionsRepository := repositories.NewConnections()
-	sessionRepository := repositories.NewSessionCached(cachex)
+	var sessionRepository ports.SessionRepository
+	if cfg.Cache.SessionStore == config.SessionStoreMemory {
+		log.Warn(ctx, "the auth sessions are kept in memory, the callbacks must land on the node that created them")
+		sessionRepository = repositories.NewSessionCached(cache.NewMemoryCache(), cfg.Cache.SessionTTL)
+	} else {
+		sessionRepository = repositories.NewSessionRedis(rdb, cfg.Cache.SessionTTL)
+	}
 	linkRepository := repositories.NewLink(*storage)
 	schemaRepository := repositories.NewSchema(*storage)
 
 	// services initialization
 	mtService := services.NewIdentityMerkleTrees(mtRepository)
+	settingsService := services.NewSettings(repositories.NewSettings(), storage, services.ConfiguredSettings(cfg))
+	config.WatchReload(ctx, "", func(cfg *config.Configuration) {
+		settingsService.Reload(ctx, services.ConfiguredSettings(cfg))
+	})
 	identityService := services.NewIdentity(keyStore, identityRepository, mtRepository, identityStateRepository, mtService, claimsRepository, revocationRepository, connectionsRepository, storage, rhsp, verifier, sessionRepository, ps)
-	schemaService := services.NewSchema(schemaRepository, schemaLoader)
+	ipfsPinner, err := gateways.NewIPFSPinner(cfg.IPFS)
+	if err != nil {
+		log.Error(ctx, "cannot initialize the ipfs pinner", "err", err)
+		return
+	}
+	schemaService := services.NewSchema(schemaRepository, schemaLoader, cfg.APIUI.ServerURL, ipfsPinner)
+	featureFlagService := services.NewFeatureFlags(repositories.NewFeatureFlag(), storage, services.FeatureFlagsCfg{
+		RHS:           cfg.ReverseHashService.Enabled,
+		AsyncIssuance: cfg.FeatureFlags.AsyncIssuance,
+		OID4VCI:       cfg.FeatureFlags.OID4VCI,
+		TestMode:      cfg.FeatureFlags.TestMode,
+		StatusList:    cfg.FeatureFlags.StatusList,
+	})
+	credentialValidationService := services.NewCredentialValidation(schemaRepository, gateways.NewValidationWebhookClient(cfg.ValidationWebhook.Timeout), cachex, services.CredentialValidationCfg{
+		ApprovalTTL: cfg.ValidationWebhook.ApprovalTTL,
+	})
+	var didResolver ports.DIDResolverGateway
+	if cfg.DIDResolver.URL != "" {
+		didResolver = gateways.NewDIDResolverClient(cfg.DIDResolver.URL, cfg.DIDResolver.Timeout)
+	}
+	subjectDIDService := services.NewSubjectDID(didResolver, cachex, services.SubjectDIDCfg{
+		CacheTTL: cfg.DIDResolver.CacheTTL,
+	})
+	proofPolicy, err := domain.NewProofPolicy(cfg.ProofPolicy.Default, cfg.ProofPolicy.Allowed)
+	if err != nil {
+		log.Error(ctx, "invalid proof policy", "err", err)
+		return
+	}
+	issuerProfileService := services.NewIssuerProfile(repositories.NewIssuerProfile(), storage, services.IssuerProfileCfg{
+		DisplayName: cfg.APIUI.IssuerName,
+		LogoURL:     cfg.APIUI.IssuerLogo,
+	})
 	claimsService := services.NewClaim(
 		claimsRepository,
 		identityService,
@@ -147,62 +188,153 @@ func main() {
 		schemaLoader,
 		storage,
 		services.ClaimCfg{
-			RHSEnabled: cfg.ReverseHashService.Enabled,
-			RHSUrl:     cfg.ReverseHashService.URL,
-			Host:       cfg.APIUI.ServerURL,
+			RHSEnabled:        cfg.ReverseHashService.Enabled,
+			RHSUrl:            cfg.ReverseHashService.URL,
+			Host:              cfg.APIUI.ServerURL,
+			OfferTTL:          cfg.CredentialOfferTTL,
+			AgentReplayWindow: cfg.AgentReplayWindow,
+			Features:          featureFlagService,
+			Validation:        credentialValidationService,
+			Schemas:           schemaRepository,
+			ProofPolicy:       proofPolicy,
+			SubjectDIDs:       subjectDIDService,
+			Profiles:          issuerProfileService,
+			StateCache:        cachex,
+			StateCacheTTL:     cfg.Cache.StateTTL,
+			RevNonceStrategy:  domain.RevNonceStrategy(cfg.RevNonce.Strategy),
+			Settings:          settingsService,
 		},
-		ps,
 	)
 	connectionsService := services.NewConnection(connectionsRepository, storage)
 	linkService := services.NewLinkService(storage, claimsService, claimsRepository, linkRepository, schemaRepository, schemaLoader, sessionRepository, ps)
+	credentialTemplateService := services.NewCredentialTemplate(repositories.NewCredentialTemplate(), schemaRepository, claimsService, storage)
+	jobQueueService := services.NewJobQueue(repositories.NewJob(), storage, services.JobQueueCfg{
+		MaxAttempts: cfg.Jobs.MaxAttempts,
+		LockTimeout: cfg.Jobs.LockTimeout,
+		Retention:   cfg.Jobs.Retention,
+	})
+	importService := services.NewImport(repositories.NewImportJob(), jobQueueService, schemaRepository, claimsService, linkService, schemaLoader, storage)
+	jobQueueService.Register(domain.JobKindImport, importService.Process)
+	changesService := services.NewChanges(repositories.NewChange(), storage)
+	var offerEmailGateway ports.EmailGateway
+	var offerEmailTemplates *emails.OfferTemplates
+	if cfg.OfferEmails.Enabled {
+		offerEmailGateway = newOfferEmailGateway(cfg)
+		if offerEmailTemplates, err = emails.NewOfferTemplates(cfg.OfferEmails.Subject, cfg.OfferEmails.Template); err != nil {
+			log.Error(ctx, "error loading the offer email templates", "err", err)
+			return
+		}
+	}
+	offerEmailService := services.NewOfferEmail(claimsService, issuerProfileService, repositories.NewOfferEmail(), offerEmailGateway, offerEmailTemplates, ratelimit.NewRedisLimiter(rdb), storage, services.OfferEmailCfg{
+		ServerURL:           cfg.APIUI.ServerURL,
+		ClaimPageURL:        cfg.OfferEmails.ClaimPageURL,
+		PerRecipientPerHour: cfg.OfferEmails.PerRecipientPerHour,
+		WalletLinks:         walletlinks.NewLinker(cfg.WalletLinks.DeepLink, cfg.WalletLinks.UniversalLink),
+		Settings:            settingsService,
+	})
+	agentInboxService := services.NewAgentInbox(repositories.NewAgentInbox(), storage)
+	jwtCredentialService := services.NewJWTCredential(claimsService, identityService, repositories.NewStatusList(), storage, keyStore, services.JWTCredentialCfg{
+		Algorithm: cfg.JWTCredential.Algorithm,
+		Host:      cfg.ServerUrl,
+		Features:  featureFlagService,
+		Cache:     cachex,
+		CacheTTL:  cfg.Cache.StateTTL,
+	})
 	proofService := gateways.NewProver(ctx, cfg, circuitsLoaderService)
-	revocationService := services.NewRevocationService(ethConn, common.HexToAddress(cfg.Ethereum.ContractAddress))
-	zkProofService := services.NewProofService(claimsService, revocationService, identityService, mtService, claimsRepository, keyStore, storage, stateContract, schemaLoader)
-	transactionService, err := gateways.NewTransaction(ethereumClient, cfg.Ethereum.ConfirmationBlockCount)
+	revocationService := services.NewRevocationService(networkResolver)
+	zkProofService := services.NewProofService(claimsService, revocationService, identityService, mtService, claimsRepository, heldCredentialRepository, keyStore, storage, networkResolver, schemaLoader)
+	transactionMonitor, err := gateways.NewTransactionMonitor(storage, repositories.NewStateTransaction(), networkResolver, keyStore, gateways.TransactionMonitorConfig{
+		StuckTimeout:   cfg.TransactionMonitor.StuckTimeout,
+		GasBumpPercent: cfg.TransactionMonitor.GasBumpPercent,
+		MaxAttempts:    cfg.TransactionMonitor.MaxAttempts,
+	})
 	if err != nil {
-		log.Error(ctx, "error creating transaction service", "err", err)
+		log.Error(ctx, "error creating transaction monitor", "err", err)
 		return
 	}
-
-	publisherGateway, err := gateways.NewPublisherEthGateway(ethereumClient, common.HexToAddress(cfg.Ethereum.ContractAddress), keyStore, cfg.PublishingKeyPath)
+	networkPublishers, err := gateways.NewNetworkPublishers(networkResolver, keyStore, cfg.PublishingKeyPath, transactionMonitor)
 	if err != nil {
-		log.Error(ctx, "error creating publish gateway", "err", err)
+		log.Error(ctx, "error creating network publishers", "err", err)
 		return
 	}
 
-	publisher := gateways.NewPublisher(storage, identityService, claimsService, mtService, keyStore, transactionService, proofService, publisherGateway, cfg.Ethereum.ConfirmationTimeout, ps)
+	identityMigrationService := services.NewIdentityMigration(repositories.NewIdentityMigration(), mtService, keyStore, storage)
+	publisher := gateways.NewPublisher(storage, identityService, claimsService, mtService, keyStore, proofService, networkPublishers, transactionMonitor, jobQueueService)
 
-	packageManager, err := protocol.InitPackageManager(ctx, stateContract, zkProofService, cfg.Circuit.Path)
+	packageManager, err := protocol.InitPackageManager(ctx, networkResolver.StateContracts(), zkProofService, cfg.Circuit.Path)
 	if err != nil {
 		log.Error(ctx, "failed init package protocol", "err", err)
 		return
 	}
+	var decrypter *protocol.Decrypter
+	if cfg.EncryptionKeys != "" {
+		encryptionKeys, err := protocol.LoadEncryptionKeys(cfg.EncryptionKeys)
+		if err != nil {
+			log.Error(ctx, "failed loading the encryption keys", "err", err)
+			return
+		}
+		decrypter = protocol.NewDecrypter(encryptionKeys)
+	}
 
-	serverHealth := health.New(health.Monitors{
+	monitors := health.Monitors{
 		"postgres": storage.Ping,
 		"redis": func(rdb *redis2.Client) health.Pinger {
 			return func(ctx context.Context) error { return rdb.Ping(ctx).Err() }
 		}(rdb),
-	})
+	}
+	backlogThresholds := domain.BacklogThresholds{
+		MaxUnpublishedClaims: cfg.Backlog.MaxUnpublishedClaims,
+		MaxPendingRetries:    cfg.Backlog.MaxPendingRetries,
+		MaxPendingAge:        cfg.Backlog.MaxPendingAge,
+	}
+	if backlogThresholds.Enabled() {
+		// The alerts are emailed by the admin API server, this one only reports and logs them
+		monitors["backlog"] = services.NewBacklog(repositories.NewStats(), nil, storage, services.BacklogCfg{
+			Thresholds:      backlogThresholds,
+			AlertRecipients: cfg.Backlog.AlertRecipients,
+		}).Check
+	}
+	serverHealth := health.New(monitors)
 	serverHealth.Run(ctx, health.DefaultPingPeriod)
 
+	if cfg.WarmUp.Enabled {
+		serverHealth.NotReady()
+		go func() {
+			warmUpCtx, cancelWarmUp := context.WithTimeout(ctx, cfg.WarmUp.Timeout)
+			defer cancelWarmUp()
+			if err := services.NewWarmUp(identityService, schemaRepository, schemaLoader, keyStore).Run(warmUpCtx, cfg.APIUI.IssuerDID); err != nil {
+				log.Warn(ctx, "warm-up did not complete", "err", err)
+			}
+			serverHealth.SetReady()
+			log.Info(ctx, "warm-up finished, accepting traffic")
+		}()
+	}
+
 	if !identifierExists(ctx, &cfg.APIUI.IssuerDID, identityService) {
 		log.Error(ctx, "issuer DID must exist")
 		return
 	}
 
+	idempotencyService := services.NewIdempotency(repositories.NewIdempotencyKey(), storage, cfg.IdempotencyKeyTTL)
+
 	mux := chi.NewRouter()
 	mux.Use(
 		chiMiddleware.RequestID,
 		log.ChiMiddleware(ctx),
 		chiMiddleware.Recoverer,
-		cors.AllowAll().Handler,
+		middleware.CORS(cfg.CORS),
+		serverHealth.ReadinessGate("/status"),
+		middleware.SecurityHeaders(cfg.SecurityHeaders),
+		middleware.RateLimit(ctx, ratelimit.NewRedisLimiter(rdb), middleware.SettingsRateLimits(settingsService, cfg.RateLimit), config.HTTPBasicAuth(cfg.APIUI.APIUIAuth), "/status"),
 		chiMiddleware.NoCache,
+		middleware.Timeout(ctx, cfg.RequestTimeout, "/status"),
+		middleware.DecryptEnvelopes(ctx, decrypter),
+		middleware.Idempotency(ctx, idempotencyService, config.HTTPBasicAuth(cfg.APIUI.APIUIAuth), "/v1/credentials", "/v1/credentials/revoke/*", "/v1/connections/*/credentials/revoke"),
 	)
 	api_ui.HandlerWithOptions(
 		api_ui.NewStrictHandlerWithOptions(
-			api_ui.NewServer(cfg, identityService, claimsService, schemaService, connectionsService, linkService, publisher, packageManager, serverHealth),
-			middlewares(ctx, cfg.APIUI.APIUIAuth),
+			api_ui.NewServer(cfg, identityService, claimsService, schemaService, connectionsService, linkService, credentialTemplateService, importService, changesService, jobQueueService, jwtCredentialService, issuerProfileService, offerEmailService, agentInboxService, publisher, packageManager, serverHealth),
+			middlewares(ctx, cfg.APIUI.APIUIAuth, cfg.APIUI.IssuerDID, identityMigrationService, node),
 			api_ui.StrictHTTPServerOptions{
 				RequestErrorHandlerFunc:  errors.RequestErrorHandlerFunc,
 				ResponseErrorHandlerFunc: errors.ResponseErrorHandlerFunc,
@@ -213,6 +345,10 @@ func main() {
 		},
 	)
 	api_ui.RegisterStatic(mux)
+	api_ui.RegisterSessionEvents(ctx, mux, cfg.APIUI.APIUIAuth, sessionRepository, ps)
+	api_ui.RegisterPages(ctx, mux, cfg, linkService, claimsService, issuerProfileService)
+	api_ui.RegisterStreams(ctx, mux, cfg.APIUI.IssuerDID, cfg.APIUI.APIUIAuth, claimsService, connectionsService)
+	api_ui.RegisterGraphQL(ctx, mux, cfg.APIUI.IssuerDID, cfg.APIUI.APIUIAuth, identityService, claimsService, connectionsService, schemaService)
 
 	server := &http.Server{
 		Addr:    fmt.Sprintf(":%d", cfg.APIUI.ServerPort),
@@ -228,6 +364,28 @@ func main() {
 		}
 	}()
 
+	// the imports are run by the server that starts them. A standby runs them once it is promoted.
+	go func(ctx context.Context) {
+		select {
+		case <-node.Active():
+		case <-ctx.Done():
+			return
+		}
+		ticker := time.NewTicker(cfg.Jobs.CheckFrequency)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if _, err := jobQueueService.Work(ctx); err != nil {
+					log.Error(ctx, "running background jobs", "err", err)
+				}
+			case <-ctx.Done():
+				log.Info(ctx, "finishing background jobs worker")
+				return
+			}
+		}
+	}(ctx)
+
 	<-quit
 	log.Info(ctx, "Shutting down")
 }
@@ -237,9 +395,28 @@ func identifierExists(ctx context.Context, did *core.DID, service ports.Identity
 	return err == nil
 }
 
-func middlewares(ctx context.Context, auth config.APIUIAuth) []api_ui.StrictMiddlewareFunc {
+// newOfferEmailGateway returns the gateway of the configured offer email provider
+func newOfferEmailGateway(cfg *config.Configuration) ports.EmailGateway {
+	if cfg.OfferEmails.Provider == "sendgrid" {
+		return gateways.NewSendGridClient(gateways.SendGridConfig{
+			APIKey: cfg.OfferEmails.SendGridAPIKey,
+			From:   cfg.SMTP.From,
+		})
+	}
+	return gateways.NewSMTPClient(gateways.SMTPConfig{
+		Host:     cfg.SMTP.Host,
+		Port:     cfg.SMTP.Port,
+		User:     cfg.SMTP.User,
+		Password: cfg.SMTP.Password,
+		From:     cfg.SMTP.From,
+	})
+}
+
+func middlewares(ctx context.Context, auth config.APIUIAuth, issuerDID core.DID, migration ports.IdentityMigrationService, node *standby.Node) []api_ui.StrictMiddlewareFunc {
 	return []api_ui.StrictMiddlewareFunc{
 		api_ui.LogMiddleware(ctx),
+		api_ui.ReadOnlyMiddleware(issuerDID, migration, readOnlyRetryAfter),
+		api_ui.StandbyMiddleware(node, readOnlyRetryAfter),
 		api_ui.BasicAuthMiddleware(ctx, auth.User, auth.Password),
 	}
 }
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/redis"
+	"github.com/polygonid/sh-id-platform/internal/standby"
+)
+
+// standby_promote promotes the databases of a standby node when the primary fails. It is run with the configuration
+// of the standby node: the postgres replica becomes a primary and the redis replica stops following the primary one.
+// The standby processes become active on their own once they see the promoted database.
+func main() {
+	wait := flag.Duration("wait", time.Minute, "maximum time to wait for the database to replay the received WAL")
+	flag.Parse()
+
+	cfg, err := config.Load("")
+	if err != nil {
+		log.Error(context.Background(), "cannot load config", "err", err)
+		os.Exit(1)
+	}
+
+	ctx := log.NewContext(context.Background(), cfg.Log.Level, cfg.Log.Mode, os.Stdout)
+
+	if err := promote(ctx, cfg, *wait); err != nil {
+		log.Error(ctx, "cannot promote standby", "err", err)
+		os.Exit(1)
+	}
+	log.Info(ctx, "standby promoted, point the traffic to this node")
+}
+
+func promote(ctx context.Context, cfg *config.Configuration, wait time.Duration) error {
+	storage, err := db.NewStorage(cfg.Database.URL, config.Database{})
+	if err != nil {
+		return fmt.Errorf("connecting to database: %w", err)
+	}
+	defer func() { _ = storage.Close() }()
+
+	rdb, err := redis.Open(cfg.Cache.RedisUrl)
+	if err != nil {
+		return fmt.Errorf("connecting to redis: %w", err)
+	}
+	defer func() { _ = rdb.Close() }()
+
+	return standby.Promote(ctx, storage, rdb, wait)
+}
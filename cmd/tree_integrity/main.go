@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	core "github.com/iden3/go-iden3-core"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/network"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+// tree_integrity verifies the merkle trees of the identities of the node against their stored and published states,
+// after a database restore or a suspected corruption. It reads the database directly, so it can be run before the
+// node is started, and exits with 1 if a discrepancy is found.
+func main() {
+	identifier := flag.String("identifier", "", "DID of the identity to verify, every identity of the node by default")
+	offline := flag.Bool("offline", false, "do not compare the states with the ones published in the state contracts")
+	flag.Parse()
+
+	cfg, err := config.Load("")
+	if err != nil {
+		log.Error(context.Background(), "cannot load config", "err", err)
+		os.Exit(1)
+	}
+
+	ctx := log.NewContext(context.Background(), cfg.Log.Level, cfg.Log.Mode, os.Stdout)
+
+	ok, err := verify(ctx, cfg, *identifier, *offline)
+	if err != nil {
+		log.Error(ctx, "cannot verify identity trees", "err", err)
+		os.Exit(1)
+	}
+	if !ok {
+		log.Error(ctx, "discrepancies found in the identity trees")
+		os.Exit(1)
+	}
+	log.Info(ctx, "identity trees verified")
+}
+
+func verify(ctx context.Context, cfg *config.Configuration, identifier string, offline bool) (bool, error) {
+	storage, err := db.NewStorage(cfg.Database.URL, config.Database{})
+	if err != nil {
+		return false, fmt.Errorf("connecting to database: %w", err)
+	}
+	defer func() { _ = storage.Close() }()
+
+	var networkResolver *network.Resolver
+	if offline {
+		network.RegisterDIDNetworks()
+	} else if networkResolver, err = network.NewResolver(ctx, cfg); err != nil {
+		return false, fmt.Errorf("connecting to networks: %w", err)
+	}
+
+	mtService := services.NewIdentityMerkleTrees(repositories.NewIdentityMerkleTreeRepository())
+	treeIntegrity := services.NewTreeIntegrity(mtService, repositories.NewIdentityState(), networkResolver, storage)
+
+	identifiers := []string{identifier}
+	if identifier == "" {
+		if identifiers, err = repositories.NewIdentity().Get(ctx, storage.Pgx); err != nil {
+			return false, err
+		}
+	}
+
+	ok := true
+	for _, id := range identifiers {
+		identityOK, err := verifyIdentity(ctx, treeIntegrity, id)
+		if err != nil {
+			return false, fmt.Errorf("%s: %w", id, err)
+		}
+		ok = ok && identityOK
+	}
+	return ok, nil
+}
+
+func verifyIdentity(ctx context.Context, treeIntegrity ports.TreeIntegrityService, identifier string) (bool, error) {
+	did, err := core.ParseDID(identifier)
+	if err != nil {
+		return false, err
+	}
+	report, err := treeIntegrity.Verify(ctx, *did)
+	if err != nil {
+		return false, err
+	}
+
+	for _, tree := range report.Trees {
+		log.Info(ctx, "tree verified", "identifier", identifier, "type", tree.Type, "root", tree.Root, "nodes", tree.Nodes, "leaves", tree.Leaves)
+	}
+	for _, d := range report.Discrepancies {
+		args := []interface{}{"identifier", identifier, "kind", d.Kind, "message", d.Message}
+		if d.Tree != nil {
+			args = append(args, "type", *d.Tree)
+		}
+		if d.Node != nil {
+			args = append(args, "node", *d.Node)
+		}
+		log.Warn(ctx, "discrepancy", args...)
+	}
+	return report.OK(), nil
+}
@@ -13,12 +13,205 @@ import (
 
 	"github.com/deepmap/oapi-codegen/pkg/runtime"
 	"github.com/go-chi/chi/v5"
+	uuid "github.com/google/uuid"
+	verifiable "github.com/iden3/go-schema-processor/verifiable"
 )
 
 const (
 	BasicAuthScopes = "basicAuth.Scopes"
 )
 
+// Defines values for AgentInboxMessageStatus.
+const (
+	AgentInboxMessageStatusFailed    AgentInboxMessageStatus = "failed"
+	AgentInboxMessageStatusProcessed AgentInboxMessageStatus = "processed"
+	AgentInboxMessageStatusRejected  AgentInboxMessageStatus = "rejected"
+)
+
+// Defines values for AuthKeyStatus.
+const (
+	AuthKeyStatusActive  AuthKeyStatus = "active"
+	AuthKeyStatusPending AuthKeyStatus = "pending"
+	AuthKeyStatusRevoked AuthKeyStatus = "revoked"
+)
+
+// Defines values for CreateIdentityRequestDidMetadataType.
+const (
+	BJJ CreateIdentityRequestDidMetadataType = "BJJ"
+	ETH CreateIdentityRequestDidMetadataType = "ETH"
+)
+
+// Defines values for FeatureFlagFeature.
+const (
+	AsyncIssuance FeatureFlagFeature = "async_issuance"
+	Oid4vci       FeatureFlagFeature = "oid4vci"
+	Rhs           FeatureFlagFeature = "rhs"
+	StatusList    FeatureFlagFeature = "status_list"
+	TestMode      FeatureFlagFeature = "test_mode"
+)
+
+// Defines values for IdentityMigrationStatus.
+const (
+	Completed IdentityMigrationStatus = "completed"
+	ReadOnly  IdentityMigrationStatus = "read_only"
+)
+
+// Defines values for PublishingPolicyMode.
+const (
+	PublishingPolicyModeImmediate PublishingPolicyMode = "immediate"
+	PublishingPolicyModeInterval  PublishingPolicyMode = "interval"
+	PublishingPolicyModeManual    PublishingPolicyMode = "manual"
+	PublishingPolicyModeThreshold PublishingPolicyMode = "threshold"
+)
+
+// Defines values for RevocationDecisionStatus.
+const (
+	RevocationDecisionStatusApplied  RevocationDecisionStatus = "applied"
+	RevocationDecisionStatusRejected RevocationDecisionStatus = "rejected"
+)
+
+// Defines values for RevocationReasonCode.
+const (
+	AffiliationChanged   RevocationReasonCode = "affiliationChanged"
+	CessationOfOperation RevocationReasonCode = "cessationOfOperation"
+	Expired              RevocationReasonCode = "expired"
+	KeyCompromise        RevocationReasonCode = "keyCompromise"
+	PrivilegeWithdrawn   RevocationReasonCode = "privilegeWithdrawn"
+	Superseded           RevocationReasonCode = "superseded"
+	Unspecified          RevocationReasonCode = "unspecified"
+)
+
+// Defines values for RotateAuthKeyResponseStatus.
+const (
+	RotateAuthKeyResponseStatusCompleted RotateAuthKeyResponseStatus = "completed"
+	RotateAuthKeyResponseStatusPending   RotateAuthKeyResponseStatus = "pending"
+)
+
+// Defines values for SetPublishingPolicyRequestMode.
+const (
+	SetPublishingPolicyRequestModeImmediate SetPublishingPolicyRequestMode = "immediate"
+	SetPublishingPolicyRequestModeInterval  SetPublishingPolicyRequestMode = "interval"
+	SetPublishingPolicyRequestModeManual    SetPublishingPolicyRequestMode = "manual"
+	SetPublishingPolicyRequestModeThreshold SetPublishingPolicyRequestMode = "threshold"
+)
+
+// Defines values for StateTransactionStatus.
+const (
+	StateTransactionStatusFailed  StateTransactionStatus = "failed"
+	StateTransactionStatusMined   StateTransactionStatus = "mined"
+	StateTransactionStatusPending StateTransactionStatus = "pending"
+)
+
+// Defines values for TreeDiscrepancyKind.
+const (
+	HashMismatch           TreeDiscrepancyKind = "hash_mismatch"
+	InvalidNode            TreeDiscrepancyKind = "invalid_node"
+	MisplacedLeaf          TreeDiscrepancyKind = "misplaced_leaf"
+	MissingNode            TreeDiscrepancyKind = "missing_node"
+	MissingRoot            TreeDiscrepancyKind = "missing_root"
+	PublishedStateMismatch TreeDiscrepancyKind = "published_state_mismatch"
+	StateMismatch          TreeDiscrepancyKind = "state_mismatch"
+)
+
+// Defines values for TreeDiscrepancyTree.
+const (
+	TreeDiscrepancyTreeClaims      TreeDiscrepancyTree = "claims"
+	TreeDiscrepancyTreeRevocations TreeDiscrepancyTree = "revocations"
+	TreeDiscrepancyTreeRoots       TreeDiscrepancyTree = "roots"
+)
+
+// Defines values for TreeIntegrityType.
+const (
+	TreeIntegrityTypeClaims      TreeIntegrityType = "claims"
+	TreeIntegrityTypeRevocations TreeIntegrityType = "revocations"
+	TreeIntegrityTypeRoots       TreeIntegrityType = "roots"
+)
+
+// Defines values for VerificationQueryCircuitId.
+const (
+	CredentialAtomicQueryMTPV2 VerificationQueryCircuitId = "credentialAtomicQueryMTPV2"
+	CredentialAtomicQuerySigV2 VerificationQueryCircuitId = "credentialAtomicQuerySigV2"
+)
+
+// Defines values for GetRevocationListParamsFormat.
+const (
+	Bitstring GetRevocationListParamsFormat = "bitstring"
+	List      GetRevocationListParamsFormat = "list"
+)
+
+// Defines values for GetAgentMessagesParamsStatus.
+const (
+	GetAgentMessagesParamsStatusFailed    GetAgentMessagesParamsStatus = "failed"
+	GetAgentMessagesParamsStatusProcessed GetAgentMessagesParamsStatus = "processed"
+	GetAgentMessagesParamsStatusRejected  GetAgentMessagesParamsStatus = "rejected"
+)
+
+// Defines values for GetRevocationDecisionsReportParamsStatus.
+const (
+	Applied  GetRevocationDecisionsReportParamsStatus = "applied"
+	Rejected GetRevocationDecisionsReportParamsStatus = "rejected"
+)
+
+// AgentCapabilities defines model for AgentCapabilities.
+type AgentCapabilities struct {
+	Accepts AgentMessages `json:"accepts"`
+
+	// Agent URL of the agent endpoint
+	Agent string `json:"agent"`
+
+	// Circuits Circuits to pack the messages for the agent and to prove the issued credentials
+	Circuits    []string               `json:"circuits"`
+	Credentials CredentialCapabilities `json:"credentials"`
+
+	// Networks Blockchains and networks of the identities of the node
+	Networks []string      `json:"networks"`
+	Sends    AgentMessages `json:"sends"`
+}
+
+// AgentInboxMessage defines model for AgentInboxMessage.
+type AgentInboxMessage struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Error Why the message failed or was rejected
+	Error     *string   `json:"error,omitempty"`
+	Id        uuid.UUID `json:"id"`
+	MediaType string    `json:"mediaType"`
+	MessageID string    `json:"messageID"`
+
+	// ResponseType Type of the message the agent answered with
+	ResponseType *string `json:"responseType,omitempty"`
+
+	// Sender DID of the sender, empty if the message could not be unpacked
+	Sender   string                  `json:"sender"`
+	Status   AgentInboxMessageStatus `json:"status"`
+	ThreadID string                  `json:"threadID"`
+	Type     string                  `json:"type"`
+}
+
+// AgentInboxMessageStatus defines model for AgentInboxMessage.Status.
+type AgentInboxMessageStatus string
+
+// AgentMessages defines model for AgentMessages.
+type AgentMessages struct {
+	MediaTypes []string `json:"mediaTypes"`
+	Messages   []string `json:"messages"`
+}
+
+// AgentProblemReport The error of a failed agent request. When the request was unpacked, it is a problem-report message addressed
+// to the sender, in the thread of the request, with the code and the description of the problem. The message
+// is the description, for the clients that do not read problem reports.
+type AgentProblemReport struct {
+	Body           *ProblemReportBody `json:"body,omitempty"`
+	From           *string            `json:"from,omitempty"`
+	Id             *string            `json:"id,omitempty"`
+	Message        string             `json:"message"`
+	ParentThreadID *string            `json:"parentThreadID,omitempty"`
+	ThreadID       *string            `json:"threadID,omitempty"`
+	To             *string            `json:"to,omitempty"`
+	Typ            *string            `json:"typ,omitempty"`
+	Type           *string            `json:"type,omitempty"`
+}
+
 // AgentResponse defines model for AgentResponse.
 type AgentResponse struct {
 	Body     interface{} `json:"body"`
@@ -30,16 +223,108 @@ type AgentResponse struct {
 	Type     string      `json:"type"`
 }
 
+// ApplyRevocationDecisionsRequest defines model for ApplyRevocationDecisionsRequest.
+type ApplyRevocationDecisionsRequest struct {
+	Decisions []RevocationDecisionRequest `json:"decisions"`
+
+	// Source Name of the external system that took the decisions
+	Source string `json:"source"`
+}
+
+// AuthKey defines model for AuthKey.
+type AuthKey struct {
+	// Id ID of the auth claim of the key
+	Id       uuid.UUID     `json:"id"`
+	RevNonce uint64        `json:"revNonce"`
+	Status   AuthKeyStatus `json:"status"`
+	X        string        `json:"x"`
+	Y        string        `json:"y"`
+}
+
+// AuthKeyStatus defines model for AuthKey.Status.
+type AuthKeyStatus string
+
+// AuthorizationRequest defines model for AuthorizationRequest.
+type AuthorizationRequest struct {
+	Body struct {
+		CallbackUrl string `json:"callbackUrl"`
+		Reason      string `json:"reason"`
+		Scope       []struct {
+			CircuitId string                 `json:"circuitId"`
+			Id        uint32                 `json:"id"`
+			Query     map[string]interface{} `json:"query"`
+		} `json:"scope"`
+	} `json:"body"`
+	From string `json:"from"`
+	Id   string `json:"id"`
+	Thid string `json:"thid"`
+	Typ  string `json:"typ"`
+	Type string `json:"type"`
+}
+
+// AuthorizationRequestMessage defines model for AuthorizationRequestMessage.
+type AuthorizationRequestMessage struct {
+	Body struct {
+		CallbackUrl string  `json:"callbackUrl"`
+		Message     *string `json:"message,omitempty"`
+		Reason      *string `json:"reason,omitempty"`
+		Scope       []struct {
+			CircuitId string                 `json:"circuitId"`
+			Id        uint32                 `json:"id"`
+			Optional  *bool                  `json:"optional,omitempty"`
+			Query     map[string]interface{} `json:"query"`
+		} `json:"scope"`
+	} `json:"body"`
+	From string  `json:"from"`
+	Id   string  `json:"id"`
+	Thid *string `json:"thid,omitempty"`
+	To   *string `json:"to,omitempty"`
+	Typ  string  `json:"typ"`
+	Type string  `json:"type"`
+}
+
+// CachedDocument defines model for CachedDocument.
+type CachedDocument struct {
+	ContentType string `json:"contentType"`
+
+	// Etag ETag of the document, used to revalidate it once it is older than ISSUER_SCHEMA_CACHE_TTL
+	Etag      string    `json:"etag"`
+	FetchedAt time.Time `json:"fetchedAt"`
+	Size      int       `json:"size"`
+	Url       string    `json:"url"`
+}
+
 // CreateClaimRequest defines model for CreateClaimRequest.
 type CreateClaimRequest struct {
-	CredentialSchema      string                 `json:"credentialSchema"`
-	CredentialSubject     map[string]interface{} `json:"credentialSubject"`
-	Expiration            *int64                 `json:"expiration,omitempty"`
-	MerklizedRootPosition *string                `json:"merklizedRootPosition,omitempty"`
-	RevNonce              *uint64                `json:"revNonce,omitempty"`
-	SubjectPosition       *string                `json:"subjectPosition,omitempty"`
-	Type                  string                 `json:"type"`
-	Version               *uint32                `json:"version,omitempty"`
+	CredentialSchema  string                 `json:"credentialSchema"`
+	CredentialSubject map[string]interface{} `json:"credentialSubject"`
+
+	// Expiration Expiration of the credential, in seconds since the epoch. If the schema imported by the identity has a
+	// maximum validity, it cannot be later, and the credentials created without expiration expire at the end of it.
+	Expiration *int64 `json:"expiration,omitempty"`
+
+	// ExpirationDays Expires the credential the given days after it is issued. It cannot be set with expiration.
+	ExpirationDays *int `json:"expirationDays,omitempty"`
+
+	// ExtraContexts JSON-LD contexts added to the @context of the credential, after the ones of the schema. They must be loadable.
+	ExtraContexts *[]string `json:"extraContexts,omitempty"`
+
+	// ExtraTypes Types added to the type of the credential, after the ones of the schema. They should be defined in the contexts.
+	ExtraTypes            *[]string `json:"extraTypes,omitempty"`
+	MerklizedRootPosition *string   `json:"merklizedRootPosition,omitempty"`
+
+	// MtProof Issue the credential with an Iden3SparseMerkleTreeProof. If neither proof is set, the default proof types of the schema, or the ones of the proof policy, apply.
+	MtProof *bool `json:"mtProof,omitempty"`
+
+	// RefreshService Adds a refresh service pointing at the agent of the node, where the holder gets a new credential, valid for the same period, when this one expires.
+	RefreshService *bool   `json:"refreshService,omitempty"`
+	RevNonce       *uint64 `json:"revNonce,omitempty"`
+
+	// SignatureProof Issue the credential with a BJJSignature2021 proof. If neither proof is set, the default proof types of the schema, or the ones of the proof policy, apply.
+	SignatureProof  *bool   `json:"signatureProof,omitempty"`
+	SubjectPosition *string `json:"subjectPosition,omitempty"`
+	Type            string  `json:"type"`
+	Version         *uint32 `json:"version,omitempty"`
 }
 
 // CreateClaimResponse defines model for CreateClaimResponse.
@@ -50,29 +335,161 @@ type CreateClaimResponse struct {
 // CreateIdentityRequest defines model for CreateIdentityRequest.
 type CreateIdentityRequest struct {
 	DidMetadata struct {
+		// Blockchain Blockchain of the identity, for example polygon, eth or privado. The node must have the chain configured
 		Blockchain string `json:"blockchain"`
-		Method     string `json:"method"`
-		Network    string `json:"network"`
+
+		// Method DID method, polygonid or iden3
+		Method string `json:"method"`
+
+		// Network Network of the blockchain, for example main, mumbai, amoy, sepolia or test
+		Network string `json:"network"`
+
+		// Type Key that controls the identity state. BJJ (default) identities publish their states with a proof signed with their auth key.
+		// ETH identities are built from the address of a new Ethereum key of the node and publish their states with transactions signed with it,
+		// so the address needs funds in the network. Their credentials can be verified once the first state is published.
+		Type *CreateIdentityRequestDidMetadataType `json:"type,omitempty"`
 	} `json:"didMetadata"`
 }
 
+// CreateIdentityRequestDidMetadataType Key that controls the identity state. BJJ (default) identities publish their states with a proof signed with their auth key.
+// ETH identities are built from the address of a new Ethereum key of the node and publish their states with transactions signed with it,
+// so the address needs funds in the network. Their credentials can be verified once the first state is published.
+type CreateIdentityRequestDidMetadataType string
+
 // CreateIdentityResponse defines model for CreateIdentityResponse.
 type CreateIdentityResponse struct {
+	// Address Ethereum address that controls the identity, only for ETH identities
+	Address    *string        `json:"address,omitempty"`
 	Identifier *string        `json:"identifier,omitempty"`
 	State      *IdentityState `json:"state,omitempty"`
 }
 
+// CreateOID4VCIOfferRequest defines model for CreateOID4VCIOfferRequest.
+type CreateOID4VCIOfferRequest struct {
+	CredentialSchema string `json:"credentialSchema"`
+
+	// CredentialSubject The id is optional, it must be an iden3 DID if set
+	CredentialSubject map[string]interface{} `json:"credentialSubject"`
+	Expiration        *int64                 `json:"expiration,omitempty"`
+
+	// MtProof Adds an Iden3SparseMerkleTreeProof, which is only valid once the state is published
+	MtProof *bool  `json:"mtProof,omitempty"`
+	Type    string `json:"type"`
+}
+
+// CreateVerificationRequest defines model for CreateVerificationRequest.
+type CreateVerificationRequest struct {
+	// ExpiresAt Time after which the request can no longer be answered
+	ExpiresAt *time.Time          `json:"expiresAt,omitempty"`
+	Reason    *string             `json:"reason,omitempty"`
+	Scope     []VerificationQuery `json:"scope"`
+}
+
+// CredentialCapabilities defines model for CredentialCapabilities.
+type CredentialCapabilities struct {
+	Formats     []string `json:"formats"`
+	ProofTypes  []string `json:"proofTypes"`
+	SchemaTypes []string `json:"schemaTypes"`
+	StatusTypes []string `json:"statusTypes"`
+}
+
+// CredentialErrorMessage defines model for CredentialErrorMessage.
+type CredentialErrorMessage struct {
+	Errors  *[]CredentialSubjectError `json:"errors,omitempty"`
+	Message string                    `json:"message"`
+}
+
 // CredentialSchema defines model for CredentialSchema.
 type CredentialSchema struct {
 	Id   string `json:"id"`
 	Type string `json:"type"`
 }
 
+// CredentialSubjectError defines model for CredentialSubjectError.
+type CredentialSubjectError struct {
+	// Field Dot separated path of the attribute in the credential subject
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// DIDDocument defines model for DIDDocument.
+type DIDDocument struct {
+	Context            []string             `json:"@context"`
+	Id                 string               `json:"id"`
+	Service            []DIDDocumentService `json:"service"`
+	VerificationMethod []DIDStateInfo       `json:"verificationMethod"`
+}
+
+// DIDDocumentService defines model for DIDDocumentService.
+type DIDDocumentService struct {
+	Id              string `json:"id"`
+	ServiceEndpoint string `json:"serviceEndpoint"`
+	Type            string `json:"type"`
+}
+
+// DIDResolution defines model for DIDResolution.
+type DIDResolution struct {
+	Context               string                 `json:"@context"`
+	DidDocument           DIDDocument            `json:"didDocument"`
+	DidDocumentMetadata   map[string]interface{} `json:"didDocumentMetadata"`
+	DidResolutionMetadata struct {
+		ContentType string `json:"contentType"`
+	} `json:"didResolutionMetadata"`
+}
+
+// DIDService defines model for DIDService.
+type DIDService struct {
+	Id              string     `json:"id"`
+	Overridden      bool       `json:"overridden"`
+	ServiceEndpoint string     `json:"serviceEndpoint"`
+	Type            string     `json:"type"`
+	UpdatedAt       *time.Time `json:"updatedAt,omitempty"`
+}
+
+// DIDStateInfo defines model for DIDStateInfo.
+type DIDStateInfo struct {
+	Controller           string               `json:"controller"`
+	Id                   string               `json:"id"`
+	Info                 *DIDStateInfoDetails `json:"info,omitempty"`
+	Published            bool                 `json:"published"`
+	StateContractAddress string               `json:"stateContractAddress"`
+	Type                 string               `json:"type"`
+}
+
+// DIDStateInfoDetails defines model for DIDStateInfoDetails.
+type DIDStateInfoDetails struct {
+	CreatedAtBlock     *string `json:"createdAtBlock,omitempty"`
+	CreatedAtTimestamp *string `json:"createdAtTimestamp,omitempty"`
+	Id                 string  `json:"id"`
+	State              string  `json:"state"`
+}
+
+// ExportIssuerRequest defines model for ExportIssuerRequest.
+type ExportIssuerRequest struct {
+	Identifier string `json:"identifier"`
+}
+
+// FeatureFlag defines model for FeatureFlag.
+type FeatureFlag struct {
+	Enabled    bool               `json:"enabled"`
+	Feature    FeatureFlagFeature `json:"feature"`
+	Overridden bool               `json:"overridden"`
+	UpdatedAt  *time.Time         `json:"updatedAt,omitempty"`
+}
+
+// FeatureFlagFeature defines model for FeatureFlag.Feature.
+type FeatureFlagFeature string
+
 // GenericErrorMessage defines model for GenericErrorMessage.
 type GenericErrorMessage struct {
 	Message string `json:"message"`
 }
 
+// GenericMessage defines model for GenericMessage.
+type GenericMessage struct {
+	Message string `json:"message"`
+}
+
 // GetClaimQrCodeResponse defines model for GetClaimQrCodeResponse.
 type GetClaimQrCodeResponse struct {
 	Body struct {
@@ -80,14 +497,20 @@ type GetClaimQrCodeResponse struct {
 			Description string `json:"description"`
 			Id          string `json:"id"`
 		} `json:"credentials"`
-		Url string `json:"url"`
+
+		// Issuer Profile of the issuer the wallets show with the offer
+		Issuer *IssuerDescription `json:"issuer,omitempty"`
+		Url    string             `json:"url"`
 	} `json:"body"`
-	From string `json:"from"`
-	Id   string `json:"id"`
-	Thid string `json:"thid"`
-	To   string `json:"to"`
-	Typ  string `json:"typ"`
-	Type string `json:"type"`
+
+	// ExpiresTime Unix time in seconds after which the offer can not be used to fetch the credential. The offer can be used only once.
+	ExpiresTime *int64 `json:"expires_time,omitempty"`
+	From        string `json:"from"`
+	Id          string `json:"id"`
+	Thid        string `json:"thid"`
+	To          string `json:"to"`
+	Typ         string `json:"typ"`
+	Type        string `json:"type"`
 }
 
 // GetClaimResponse defines model for GetClaimResponse.
@@ -101,15 +524,79 @@ type GetClaimResponse struct {
 	IssuanceDate      *time.Time             `json:"issuanceDate,omitempty"`
 	Issuer            string                 `json:"issuer"`
 	Proof             interface{}            `json:"proof"`
+	RefreshService    *RefreshService        `json:"refreshService,omitempty"`
 	Type              []string               `json:"type"`
 }
 
 // GetClaimsResponse defines model for GetClaimsResponse.
 type GetClaimsResponse = []GetClaimResponse
 
+// GetHeldCredentialsResponse defines model for GetHeldCredentialsResponse.
+type GetHeldCredentialsResponse = []HeldCredential
+
 // Health defines model for Health.
 type Health map[string]bool
 
+// HeldCredential defines model for HeldCredential.
+type HeldCredential struct {
+	CreatedAt    time.Time        `json:"createdAt"`
+	Credential   GetClaimResponse `json:"credential"`
+	CredentialId string           `json:"credentialId"`
+	ExpiresAt    *time.Time       `json:"expiresAt,omitempty"`
+	Id           uuid.UUID        `json:"id"`
+	Issuer       string           `json:"issuer"`
+	Revoked      bool             `json:"revoked"`
+	SchemaType   string           `json:"schemaType"`
+	SchemaUrl    string           `json:"schemaUrl"`
+}
+
+// IdentityExport defines model for IdentityExport.
+type IdentityExport struct {
+	ExportedAt    time.Time                  `json:"exportedAt"`
+	Identifier    string                     `json:"identifier"`
+	Keys          []IdentityExportKey        `json:"keys"`
+	MerkleTrees   []IdentityExportMerkleTree `json:"merkleTrees"`
+	Roots         IdentityRoots              `json:"roots"`
+	SchemaVersion int64                      `json:"schemaVersion"`
+
+	// Tables Rows of the identity by table
+	Tables  map[string]json.RawMessage `json:"tables"`
+	Version int                        `json:"version"`
+}
+
+// IdentityExportKey defines model for IdentityExportKey.
+type IdentityExportKey struct {
+	Id        string `json:"id"`
+	PublicKey string `json:"publicKey"`
+	Type      string `json:"type"`
+}
+
+// IdentityExportMerkleTree defines model for IdentityExportMerkleTree.
+type IdentityExportMerkleTree struct {
+	Nodes json.RawMessage `json:"nodes"`
+	Roots json.RawMessage `json:"roots"`
+	Type  uint16          `json:"type"`
+}
+
+// IdentityMigration defines model for IdentityMigration.
+type IdentityMigration struct {
+	CompletedAt *time.Time              `json:"completedAt,omitempty"`
+	CreatedAt   time.Time               `json:"createdAt"`
+	Identifier  string                  `json:"identifier"`
+	Status      IdentityMigrationStatus `json:"status"`
+	Target      *string                 `json:"target,omitempty"`
+}
+
+// IdentityMigrationStatus defines model for IdentityMigration.Status.
+type IdentityMigrationStatus string
+
+// IdentityRoots defines model for IdentityRoots.
+type IdentityRoots struct {
+	Claims      string `json:"claims"`
+	Revocations string `json:"revocations"`
+	Roots       string `json:"roots"`
+}
+
 // IdentityState defines model for IdentityState.
 type IdentityState struct {
 	BlockNumber        *int      `json:"blockNumber,omitempty"`
@@ -127,6 +614,124 @@ type IdentityState struct {
 	TxID               *string   `json:"txID,omitempty"`
 }
 
+// ImportIdentityRequest defines model for ImportIdentityRequest.
+type ImportIdentityRequest struct {
+	Export IdentityExport `json:"export"`
+
+	// Keys Key IDs in this node by exported key ID, for the keys imported with another ID
+	Keys *map[string]string `json:"keys,omitempty"`
+}
+
+// ImportIdentityResponse defines model for ImportIdentityResponse.
+type ImportIdentityResponse struct {
+	Identifier string        `json:"identifier"`
+	Keys       []string      `json:"keys"`
+	Roots      IdentityRoots `json:"roots"`
+
+	// Rows Number of rows imported by table
+	Rows  map[string]int `json:"rows"`
+	State *string        `json:"state,omitempty"`
+}
+
+// IssuerDescription Profile of the issuer the wallets show with the offer
+type IssuerDescription struct {
+	BackgroundColor *string `json:"backgroundColor,omitempty"`
+	ContactEmail    *string `json:"contactEmail,omitempty"`
+	ContactURL      *string `json:"contactURL,omitempty"`
+	DisplayName     string  `json:"displayName"`
+	Logo            string  `json:"logo"`
+	TextColor       *string `json:"textColor,omitempty"`
+}
+
+// JSONWebKeySet defines model for JSONWebKeySet.
+type JSONWebKeySet struct {
+	Keys []map[string]interface{} `json:"keys"`
+}
+
+// NotificationSettings defines model for NotificationSettings.
+type NotificationSettings struct {
+	BacklogAlerts bool `json:"backlogAlerts"`
+	OfferEmails   bool `json:"offerEmails"`
+	Reports       bool `json:"reports"`
+}
+
+// OID4VCICredentialRequest defines model for OID4VCICredentialRequest.
+type OID4VCICredentialRequest struct {
+	Format string `json:"format"`
+	Proof  *struct {
+		Jwt       *string `json:"jwt,omitempty"`
+		ProofType string  `json:"proof_type"`
+	} `json:"proof,omitempty"`
+}
+
+// OID4VCICredentialResponse defines model for OID4VCICredentialResponse.
+type OID4VCICredentialResponse struct {
+	CNonce          string                   `json:"c_nonce"`
+	CNonceExpiresIn int                      `json:"c_nonce_expires_in"`
+	Credential      verifiable.W3CCredential `json:"credential"`
+	Format          string                   `json:"format"`
+}
+
+// OID4VCIError defines model for OID4VCIError.
+type OID4VCIError struct {
+	CNonce           *string `json:"c_nonce,omitempty"`
+	CNonceExpiresIn  *int    `json:"c_nonce_expires_in,omitempty"`
+	Error            string  `json:"error"`
+	ErrorDescription *string `json:"error_description,omitempty"`
+}
+
+// OID4VCIIssuerDisplay defines model for OID4VCIIssuerDisplay.
+type OID4VCIIssuerDisplay struct {
+	Logo *struct {
+		AltText *string `json:"alt_text,omitempty"`
+		Url     string  `json:"url"`
+	} `json:"logo,omitempty"`
+	Name string `json:"name"`
+}
+
+// OID4VCIIssuerMetadata defines model for OID4VCIIssuerMetadata.
+type OID4VCIIssuerMetadata struct {
+	CredentialEndpoint   string                   `json:"credential_endpoint"`
+	CredentialIssuer     string                   `json:"credential_issuer"`
+	CredentialsSupported []map[string]interface{} `json:"credentials_supported"`
+
+	// Display Name and logo of the issuer of the node, from its profile
+	Display       *[]OID4VCIIssuerDisplay `json:"display,omitempty"`
+	TokenEndpoint string                  `json:"token_endpoint"`
+}
+
+// OID4VCIOffer defines model for OID4VCIOffer.
+type OID4VCIOffer struct {
+	// CredentialOffer Credential offer with the pre-authorized code, as defined by OpenID4VCI
+	CredentialOffer map[string]interface{} `json:"credentialOffer"`
+
+	// CredentialOfferUri The credential offer, in the uri the wallets scan
+	CredentialOfferUri string    `json:"credentialOfferUri"`
+	ExpiresAt          time.Time `json:"expiresAt"`
+	Id                 uuid.UUID `json:"id"`
+}
+
+// OID4VCITokenRequest defines model for OID4VCITokenRequest.
+type OID4VCITokenRequest struct {
+	GrantType         string `json:"grant_type"`
+	PreAuthorizedCode string `json:"pre-authorized_code"`
+}
+
+// OID4VCITokenResponse defines model for OID4VCITokenResponse.
+type OID4VCITokenResponse struct {
+	AccessToken     string `json:"access_token"`
+	CNonce          string `json:"c_nonce"`
+	CNonceExpiresIn int    `json:"c_nonce_expires_in"`
+	ExpiresIn       int    `json:"expires_in"`
+	TokenType       string `json:"token_type"`
+}
+
+// ProblemReportBody defines model for ProblemReportBody.
+type ProblemReportBody struct {
+	Code    string  `json:"code"`
+	Comment *string `json:"comment,omitempty"`
+}
+
 // PublishIdentityStateResponse defines model for PublishIdentityStateResponse.
 type PublishIdentityStateResponse struct {
 	ClaimsTreeRoot     *string `json:"claimsTreeRoot,omitempty"`
@@ -136,6 +741,105 @@ type PublishIdentityStateResponse struct {
 	TxID               *string `json:"txID,omitempty"`
 }
 
+// PublishingPolicy defines model for PublishingPolicy.
+type PublishingPolicy struct {
+	IntervalMinutes         *int                 `json:"intervalMinutes,omitempty"`
+	Mode                    PublishingPolicyMode `json:"mode"`
+	Overridden              bool                 `json:"overridden"`
+	RevocationWindowMinutes *int                 `json:"revocationWindowMinutes,omitempty"`
+	Threshold               *int                 `json:"threshold,omitempty"`
+	UpdatedAt               *time.Time           `json:"updatedAt,omitempty"`
+}
+
+// PublishingPolicyMode defines model for PublishingPolicy.Mode.
+type PublishingPolicyMode string
+
+// RateLimitBucket defines model for RateLimitBucket.
+type RateLimitBucket struct {
+	Burst             int `json:"burst"`
+	RequestsPerMinute int `json:"requestsPerMinute"`
+}
+
+// RateLimitSettings defines model for RateLimitSettings.
+type RateLimitSettings struct {
+	Anonymous     RateLimitBucket `json:"anonymous"`
+	Authenticated RateLimitBucket `json:"authenticated"`
+	Enabled       bool            `json:"enabled"`
+}
+
+// RefreshCachedDocumentRequest defines model for RefreshCachedDocumentRequest.
+type RefreshCachedDocumentRequest struct {
+	Url string `json:"url"`
+}
+
+// RefreshService defines model for RefreshService.
+type RefreshService struct {
+	Id   string `json:"id"`
+	Type string `json:"type"`
+}
+
+// RenewCredentialOfferRequest defines model for RenewCredentialOfferRequest.
+type RenewCredentialOfferRequest struct {
+	// Notify Pushes the new offer to the wallet of the holder
+	Notify *bool `json:"notify,omitempty"`
+}
+
+// RevocationDecision defines model for RevocationDecision.
+type RevocationDecision struct {
+	CreatedAt    time.Time  `json:"createdAt"`
+	CredentialId *uuid.UUID `json:"credentialId,omitempty"`
+	DecidedAt    time.Time  `json:"decidedAt"`
+
+	// Error Why the decision was rejected
+	Error           *string                  `json:"error,omitempty"`
+	ExternalId      string                   `json:"externalId"`
+	Id              uuid.UUID                `json:"id"`
+	Reason          string                   `json:"reason"`
+	RevocationNonce *uint64                  `json:"revocationNonce,omitempty"`
+	Source          string                   `json:"source"`
+	Status          RevocationDecisionStatus `json:"status"`
+}
+
+// RevocationDecisionStatus defines model for RevocationDecision.Status.
+type RevocationDecisionStatus string
+
+// RevocationDecisionRequest defines model for RevocationDecisionRequest.
+type RevocationDecisionRequest struct {
+	CredentialId *uuid.UUID `json:"credentialId,omitempty"`
+
+	// DecidedAt When the decision was taken, the time it is received if not set
+	DecidedAt *time.Time `json:"decidedAt,omitempty"`
+
+	// Id ID of the decision in the source
+	Id              string  `json:"id"`
+	Reason          *string `json:"reason,omitempty"`
+	RevocationNonce *uint64 `json:"revocationNonce,omitempty"`
+}
+
+// RevocationDecisionsReport defines model for RevocationDecisionsReport.
+type RevocationDecisionsReport struct {
+	Applied   int                  `json:"applied"`
+	Decisions []RevocationDecision `json:"decisions"`
+	Rejected  int                  `json:"rejected"`
+}
+
+// RevocationList defines model for RevocationList.
+type RevocationList struct {
+	// EncodedList GZIP compressed and base64url encoded bitstring of the revoked nonces, only in the bitstring format
+	EncodedList *string `json:"encodedList,omitempty"`
+	Issuer      string  `json:"issuer"`
+
+	// Nonces Revoked nonces in ascending order, only in the list format
+	Nonces *[]uint64  `json:"nonces,omitempty"`
+	Since  *time.Time `json:"since,omitempty"`
+
+	// SyncedAt Time of the list, the next sync can pass it as since
+	SyncedAt time.Time `json:"syncedAt"`
+}
+
+// RevocationReasonCode defines model for RevocationReasonCode.
+type RevocationReasonCode string
+
 // RevocationStatusResponse defines model for RevocationStatusResponse.
 type RevocationStatusResponse struct {
 	Issuer struct {
@@ -159,47 +863,283 @@ type RevokeClaimResponse struct {
 	Message string `json:"message"`
 }
 
-// PathClaim defines model for pathClaim.
-type PathClaim = string
+// RotateAuthKeyResponse defines model for RotateAuthKeyResponse.
+type RotateAuthKeyResponse struct {
+	Id     uuid.UUID                   `json:"id"`
+	Key    AuthKey                     `json:"key"`
+	Status RotateAuthKeyResponseStatus `json:"status"`
+}
 
-// PathIdentifier defines model for pathIdentifier.
-type PathIdentifier = string
+// RotateAuthKeyResponseStatus defines model for RotateAuthKeyResponse.Status.
+type RotateAuthKeyResponseStatus string
 
-// PathNonce defines model for pathNonce.
-type PathNonce = int64
+// SetDIDServiceRequest defines model for SetDIDServiceRequest.
+type SetDIDServiceRequest struct {
+	ServiceEndpoint string `json:"serviceEndpoint"`
+	Type            string `json:"type"`
+}
 
-// N400 defines model for 400.
-type N400 = GenericErrorMessage
+// SetFeatureFlagRequest defines model for SetFeatureFlagRequest.
+type SetFeatureFlagRequest struct {
+	Enabled bool `json:"enabled"`
+}
 
-// N401 defines model for 401.
-type N401 = GenericErrorMessage
+// SetPublishingPolicyRequest defines model for SetPublishingPolicyRequest.
+type SetPublishingPolicyRequest struct {
+	IntervalMinutes         *int                           `json:"intervalMinutes,omitempty"`
+	Mode                    SetPublishingPolicyRequestMode `json:"mode"`
+	RevocationWindowMinutes *int                           `json:"revocationWindowMinutes,omitempty"`
+	Threshold               *int                           `json:"threshold,omitempty"`
+}
 
-// N404 defines model for 404.
-type N404 = GenericErrorMessage
+// SetPublishingPolicyRequestMode defines model for SetPublishingPolicyRequest.Mode.
+type SetPublishingPolicyRequestMode string
 
-// N422 defines model for 422.
-type N422 = GenericErrorMessage
+// Settings defines model for Settings.
+type Settings struct {
+	Notifications NotificationSettings `json:"notifications"`
 
-// N500 defines model for 500.
-type N500 = GenericErrorMessage
+	// Overridden Names of the overridden settings
+	Overridden []string          `json:"overridden"`
+	Publishing PublishingPolicy  `json:"publishing"`
+	RateLimit  RateLimitSettings `json:"rateLimit"`
+	RhsUrl     string            `json:"rhsUrl"`
+	UpdatedAt  *time.Time        `json:"updatedAt,omitempty"`
+}
 
-// N500CreateIdentity defines model for 500-CreateIdentity.
-type N500CreateIdentity struct {
-	Code      *int    `json:"code,omitempty"`
-	Error     *string `json:"error,omitempty"`
-	RequestID *string `json:"requestID,omitempty"`
+// StartIdentityMigrationRequest defines model for StartIdentityMigrationRequest.
+type StartIdentityMigrationRequest struct {
+	// Target Node the identity is moved to, for the record
+	Target *string `json:"target,omitempty"`
 }
 
-// AgentTextBody defines parameters for Agent.
-type AgentTextBody = string
+// StateIntegrityReport defines model for StateIntegrityReport.
+type StateIntegrityReport struct {
+	CheckedAt     time.Time         `json:"checkedAt"`
+	Discrepancies []TreeDiscrepancy `json:"discrepancies"`
+	Identifier    string            `json:"identifier"`
+	Ok            bool              `json:"ok"`
 
-// GetClaimsParams defines parameters for GetClaims.
-type GetClaimsParams struct {
-	// SchemaType Filter per schema type. Example - KYCAgeCredential
-	SchemaType *string `form:"schemaType,omitempty" json:"schemaType,omitempty"`
+	// PublishedState latest state in the state contract, missing if the identity has not published a state
+	PublishedState *string `json:"publishedState,omitempty"`
 
-	// SchemaHash Filter per schema hash. Example - c9b2370371b7fa8b3dab2a5ba81b6838
-	SchemaHash *string `form:"schemaHash,omitempty" json:"schemaHash,omitempty"`
+	// State latest confirmed state, or the genesis state
+	State *string         `json:"state,omitempty"`
+	Trees []TreeIntegrity `json:"trees"`
+}
+
+// StateTransaction defines model for StateTransaction.
+type StateTransaction struct {
+	Attempts  int       `json:"attempts"`
+	CreatedAt time.Time `json:"createdAt"`
+	Error     *string   `json:"error,omitempty"`
+
+	// GasFeeCap max fee per gas in wei, or the gas price of legacy transactions
+	GasFeeCap string `json:"gasFeeCap"`
+
+	// GasTipCap max priority fee per gas in wei, legacy transactions have none
+	GasTipCap   *string                `json:"gasTipCap,omitempty"`
+	Id          uuid.UUID              `json:"id"`
+	Nonce       uint64                 `json:"nonce"`
+	State       string                 `json:"state"`
+	Status      StateTransactionStatus `json:"status"`
+	SubmittedAt time.Time              `json:"submittedAt"`
+	TxHashes    []string               `json:"txHashes"`
+	TxID        string                 `json:"txID"`
+}
+
+// StateTransactionStatus defines model for StateTransaction.Status.
+type StateTransactionStatus string
+
+// TreeDiscrepancy defines model for TreeDiscrepancy.
+type TreeDiscrepancy struct {
+	Kind    TreeDiscrepancyKind  `json:"kind"`
+	Message string               `json:"message"`
+	Node    *string              `json:"node,omitempty"`
+	Tree    *TreeDiscrepancyTree `json:"tree,omitempty"`
+}
+
+// TreeDiscrepancyKind defines model for TreeDiscrepancy.Kind.
+type TreeDiscrepancyKind string
+
+// TreeDiscrepancyTree defines model for TreeDiscrepancy.Tree.
+type TreeDiscrepancyTree string
+
+// TreeIntegrity defines model for TreeIntegrity.
+type TreeIntegrity struct {
+	Leaves int    `json:"leaves"`
+	Nodes  int    `json:"nodes"`
+	Root   string `json:"root"`
+
+	// StateRoot root of the tree in the latest confirmed state
+	StateRoot *string           `json:"stateRoot,omitempty"`
+	Type      TreeIntegrityType `json:"type"`
+}
+
+// TreeIntegrityType defines model for TreeIntegrity.Type.
+type TreeIntegrityType string
+
+// UpdateSettingsRequest The publishing policy is replaced as a whole, the rate limit and notification settings one by one
+type UpdateSettingsRequest struct {
+	Notifications *struct {
+		BacklogAlerts *bool `json:"backlogAlerts,omitempty"`
+		OfferEmails   *bool `json:"offerEmails,omitempty"`
+		Reports       *bool `json:"reports,omitempty"`
+	} `json:"notifications,omitempty"`
+	Publishing *SetPublishingPolicyRequest `json:"publishing,omitempty"`
+	RateLimit  *struct {
+		Anonymous     *RateLimitBucket `json:"anonymous,omitempty"`
+		Authenticated *RateLimitBucket `json:"authenticated,omitempty"`
+		Enabled       *bool            `json:"enabled,omitempty"`
+	} `json:"rateLimit,omitempty"`
+
+	// RhsUrl Url of the reverse hash service in the revocation status of the new credentials
+	RhsUrl *string `json:"rhsUrl,omitempty"`
+}
+
+// VerificationQuery defines model for VerificationQuery.
+type VerificationQuery struct {
+	// AllowedIssuers Issuers of the credentials accepted, any if not set
+	AllowedIssuers *[]string                  `json:"allowedIssuers,omitempty"`
+	CircuitId      VerificationQueryCircuitId `json:"circuitId"`
+	Context        string                     `json:"context"`
+
+	// CredentialSubject Predicate on a field of the credential subject. A field without operator asks to disclose it.
+	CredentialSubject *map[string]interface{} `json:"credentialSubject,omitempty"`
+
+	// Id Id of the query in the request, its position if not set
+	Id                       *uint32 `json:"id,omitempty"`
+	SkipClaimRevocationCheck *bool   `json:"skipClaimRevocationCheck,omitempty"`
+	Type                     string  `json:"type"`
+}
+
+// VerificationQueryCircuitId defines model for VerificationQuery.CircuitId.
+type VerificationQueryCircuitId string
+
+// VerificationRequest defines model for VerificationRequest.
+type VerificationRequest struct {
+	CreatedAt time.Time           `json:"createdAt"`
+	ExpiresAt *time.Time          `json:"expiresAt,omitempty"`
+	Id        uuid.UUID           `json:"id"`
+	Reason    string              `json:"reason"`
+	Scope     []VerificationQuery `json:"scope"`
+}
+
+// VerificationResponse defines model for VerificationResponse.
+type VerificationResponse struct {
+	ConnectionId *uuid.UUID `json:"connectionId,omitempty"`
+	CreatedAt    time.Time  `json:"createdAt"`
+
+	// Error Why the proofs are not valid
+	Error *string   `json:"error,omitempty"`
+	Id    uuid.UUID `json:"id"`
+
+	// Proofs Proofs of the queries sent by the holder
+	Proofs    *[]map[string]interface{} `json:"proofs,omitempty"`
+	RequestId uuid.UUID                 `json:"requestId"`
+	UserDID   string                    `json:"userDID"`
+	Verified  bool                      `json:"verified"`
+}
+
+// WalletLinks Links that open the message of a QR code in the wallet of the holder
+type WalletLinks struct {
+	DeepLink      string `json:"deepLink"`
+	UniversalLink string `json:"universalLink"`
+}
+
+// IdempotencyKey defines model for idempotencyKey.
+type IdempotencyKey = string
+
+// PathAgentMessage defines model for pathAgentMessage.
+type PathAgentMessage = uuid.UUID
+
+// PathClaim defines model for pathClaim.
+type PathClaim = string
+
+// PathDIDServiceID defines model for pathDIDServiceID.
+type PathDIDServiceID = string
+
+// PathFeature defines model for pathFeature.
+type PathFeature = string
+
+// PathHeldCredential defines model for pathHeldCredential.
+type PathHeldCredential = uuid.UUID
+
+// PathIdentifier defines model for pathIdentifier.
+type PathIdentifier = string
+
+// PathNonce defines model for pathNonce.
+type PathNonce = int64
+
+// PathVerificationRequest defines model for pathVerificationRequest.
+type PathVerificationRequest = uuid.UUID
+
+// PublishNow defines model for publishNow.
+type PublishNow = bool
+
+// RevocationDescription defines model for revocationDescription.
+type RevocationDescription = string
+
+// RevocationReason defines model for revocationReason.
+type RevocationReason = RevocationReasonCode
+
+// N400 defines model for 400.
+type N400 = GenericErrorMessage
+
+// N401 defines model for 401.
+type N401 = GenericErrorMessage
+
+// N404 defines model for 404.
+type N404 = GenericErrorMessage
+
+// N409 defines model for 409.
+type N409 = GenericErrorMessage
+
+// N410 defines model for 410.
+type N410 = GenericErrorMessage
+
+// N422 defines model for 422.
+type N422 = GenericErrorMessage
+
+// N500 defines model for 500.
+type N500 = GenericErrorMessage
+
+// N500CreateIdentity defines model for 500-CreateIdentity.
+type N500CreateIdentity struct {
+	Code      *int    `json:"code,omitempty"`
+	Error     *string `json:"error,omitempty"`
+	RequestID *string `json:"requestID,omitempty"`
+}
+
+// AgentTextBody defines parameters for Agent.
+type AgentTextBody = string
+
+// OID4VCICredentialParams defines parameters for OID4VCICredential.
+type OID4VCICredentialParams struct {
+	Authorization string `json:"Authorization"`
+}
+
+// PurgeCachedDocumentParams defines parameters for PurgeCachedDocument.
+type PurgeCachedDocumentParams struct {
+	// Url Url of the document
+	Url string `form:"url" json:"url"`
+}
+
+// VerificationCallbackTextBody defines parameters for VerificationCallback.
+type VerificationCallbackTextBody = string
+
+// VerificationCallbackParams defines parameters for VerificationCallback.
+type VerificationCallbackParams struct {
+	RequestID uuid.UUID `form:"requestID" json:"requestID"`
+}
+
+// GetClaimsParams defines parameters for GetClaims.
+type GetClaimsParams struct {
+	// SchemaType Filter per schema type. Example - KYCAgeCredential
+	SchemaType *string `form:"schemaType,omitempty" json:"schemaType,omitempty"`
+
+	// SchemaHash Filter per schema hash. Example - c9b2370371b7fa8b3dab2a5ba81b6838
+	SchemaHash *string `form:"schemaHash,omitempty" json:"schemaHash,omitempty"`
 
 	// Subject Filter per subject. Example - did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ
 	Subject *string `form:"subject,omitempty" json:"subject,omitempty"`
@@ -217,20 +1157,174 @@ type GetClaimsParams struct {
 	QueryValue *string `form:"query_value,omitempty" json:"query_value,omitempty"`
 }
 
+// CreateClaimParams defines parameters for CreateClaim.
+type CreateClaimParams struct {
+	// IdempotencyKey Unique key of the request, up to 255 characters. A retry with the same key gets the response of the first
+	// request instead of being processed again, for 24h by default. A retry that arrives while the first request is
+	// being processed gets a 409, and a key used with another request gets a 422.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// RevokeClaimParams defines parameters for RevokeClaim.
+type RevokeClaimParams struct {
+	// PublishNow Publish the state right after the revocation, without waiting for the publishing policy
+	PublishNow *PublishNow `form:"publishNow,omitempty" json:"publishNow,omitempty"`
+
+	// Reason Reason code of the revocation, unspecified by default
+	Reason *RevocationReason `form:"reason,omitempty" json:"reason,omitempty"`
+
+	// Description Free text description of the revocation
+	Description *RevocationDescription `form:"description,omitempty" json:"description,omitempty"`
+
+	// IdempotencyKey Unique key of the request, up to 255 characters. A retry with the same key gets the response of the first
+	// request instead of being processed again, for 24h by default. A retry that arrives while the first request is
+	// being processed gets a 409, and a key used with another request gets a 422.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// GetRevocationListParams defines parameters for GetRevocationList.
+type GetRevocationListParams struct {
+	// Since Returns only the nonces revoked at this time or later
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+
+	// Format Format of the list, list by default
+	Format *GetRevocationListParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetRevocationListParamsFormat defines parameters for GetRevocationList.
+type GetRevocationListParamsFormat string
+
+// GetAgentMessagesParams defines parameters for GetAgentMessages.
+type GetAgentMessagesParams struct {
+	// Sender Only the messages from this DID.
+	Sender *string `form:"sender,omitempty" json:"sender,omitempty"`
+
+	// ThreadID Only the messages of this thread, like the thread of a credential offer.
+	ThreadID *string `form:"threadID,omitempty" json:"threadID,omitempty"`
+
+	// Type Only the messages of this type.
+	Type *string `form:"type,omitempty" json:"type,omitempty"`
+
+	// Status Only the messages with this outcome.
+	Status *GetAgentMessagesParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Limit Maximum number of messages returned.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetAgentMessagesParamsStatus defines parameters for GetAgentMessages.
+type GetAgentMessagesParamsStatus string
+
+// GetRevocationDecisionsReportParams defines parameters for GetRevocationDecisionsReport.
+type GetRevocationDecisionsReportParams struct {
+	// Source Only the decisions of this source
+	Source *string `form:"source,omitempty" json:"source,omitempty"`
+
+	// Status Only the decisions with this outcome
+	Status *GetRevocationDecisionsReportParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// From Only the decisions received at or after this time
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Only the decisions received before this time
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// GetRevocationDecisionsReportParamsStatus defines parameters for GetRevocationDecisionsReport.
+type GetRevocationDecisionsReportParamsStatus string
+
+// GetVerificationResponsesParams defines parameters for GetVerificationResponses.
+type GetVerificationResponsesParams struct {
+	// RequestId Only the responses to this request
+	RequestId *uuid.UUID `form:"requestId,omitempty" json:"requestId,omitempty"`
+
+	// ConnectionId Only the responses of the holder of this connection
+	ConnectionId *uuid.UUID `form:"connectionId,omitempty" json:"connectionId,omitempty"`
+
+	// UserDID Only the responses of this holder
+	UserDID *string `form:"userDID,omitempty" json:"userDID,omitempty"`
+}
+
+// GetHeldCredentialsParams defines parameters for GetHeldCredentials.
+type GetHeldCredentialsParams struct {
+	// SchemaType Filter by the credential schema type, as context#type
+	SchemaType *string `form:"schemaType,omitempty" json:"schemaType,omitempty"`
+
+	// Revoked Filter by revocation status
+	Revoked *bool `form:"revoked,omitempty" json:"revoked,omitempty"`
+}
+
+// ExportIssuerJSONRequestBody defines body for ExportIssuer for application/json ContentType.
+type ExportIssuerJSONRequestBody = ExportIssuerRequest
+
+// UpdateSettingsJSONRequestBody defines body for UpdateSettings for application/json ContentType.
+type UpdateSettingsJSONRequestBody = UpdateSettingsRequest
+
 // AgentTextRequestBody defines body for Agent for text/plain ContentType.
 type AgentTextRequestBody = AgentTextBody
 
 // CreateIdentityJSONRequestBody defines body for CreateIdentity for application/json ContentType.
 type CreateIdentityJSONRequestBody = CreateIdentityRequest
 
+// ImportIdentityJSONRequestBody defines body for ImportIdentity for application/json ContentType.
+type ImportIdentityJSONRequestBody = ImportIdentityRequest
+
+// OID4VCICredentialJSONRequestBody defines body for OID4VCICredential for application/json ContentType.
+type OID4VCICredentialJSONRequestBody = OID4VCICredentialRequest
+
+// OID4VCITokenFormdataRequestBody defines body for OID4VCIToken for application/x-www-form-urlencoded ContentType.
+type OID4VCITokenFormdataRequestBody = OID4VCITokenRequest
+
+// RefreshCachedDocumentJSONRequestBody defines body for RefreshCachedDocument for application/json ContentType.
+type RefreshCachedDocumentJSONRequestBody = RefreshCachedDocumentRequest
+
+// VerificationCallbackTextRequestBody defines body for VerificationCallback for text/plain ContentType.
+type VerificationCallbackTextRequestBody = VerificationCallbackTextBody
+
 // CreateClaimJSONRequestBody defines body for CreateClaim for application/json ContentType.
 type CreateClaimJSONRequestBody = CreateClaimRequest
 
+// RenewClaimOfferJSONRequestBody defines body for RenewClaimOffer for application/json ContentType.
+type RenewClaimOfferJSONRequestBody = RenewCredentialOfferRequest
+
+// SetDIDServiceJSONRequestBody defines body for SetDIDService for application/json ContentType.
+type SetDIDServiceJSONRequestBody = SetDIDServiceRequest
+
+// SetFeatureFlagJSONRequestBody defines body for SetFeatureFlag for application/json ContentType.
+type SetFeatureFlagJSONRequestBody = SetFeatureFlagRequest
+
+// StartIdentityMigrationJSONRequestBody defines body for StartIdentityMigration for application/json ContentType.
+type StartIdentityMigrationJSONRequestBody = StartIdentityMigrationRequest
+
+// CreateOID4VCIOfferJSONRequestBody defines body for CreateOID4VCIOffer for application/json ContentType.
+type CreateOID4VCIOfferJSONRequestBody = CreateOID4VCIOfferRequest
+
+// SetPublishingPolicyJSONRequestBody defines body for SetPublishingPolicy for application/json ContentType.
+type SetPublishingPolicyJSONRequestBody = SetPublishingPolicyRequest
+
+// ApplyRevocationDecisionsJSONRequestBody defines body for ApplyRevocationDecisions for application/json ContentType.
+type ApplyRevocationDecisionsJSONRequestBody = ApplyRevocationDecisionsRequest
+
+// CreateVerificationRequestJSONRequestBody defines body for CreateVerificationRequest for application/json ContentType.
+type CreateVerificationRequestJSONRequestBody = CreateVerificationRequest
+
+// AcceptCredentialOfferJSONRequestBody defines body for AcceptCredentialOffer for application/json ContentType.
+type AcceptCredentialOfferJSONRequestBody = GetClaimQrCodeResponse
+
+// PresentCredentialsJSONRequestBody defines body for PresentCredentials for application/json ContentType.
+type PresentCredentialsJSONRequestBody = AuthorizationRequestMessage
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// Get the documentation
 	// (GET /)
 	GetDocumentation(w http.ResponseWriter, r *http.Request)
+	// Agent Capabilities
+	// (GET /.well-known/agent-capabilities)
+	GetAgentCapabilities(w http.ResponseWriter, r *http.Request)
+	// OpenID4VCI Issuer Metadata
+	// (GET /.well-known/openid-credential-issuer)
+	GetOID4VCIIssuerMetadata(w http.ResponseWriter, r *http.Request)
 	// Gets the favicon
 	// (GET /favicon.ico)
 	GetFavicon(w http.ResponseWriter, r *http.Request)
@@ -240,6 +1334,18 @@ type ServerInterface interface {
 	// Healthcheck
 	// (GET /status)
 	Health(w http.ResponseWriter, r *http.Request)
+	// Export Issuer
+	// (POST /v1/admin/export)
+	ExportIssuer(w http.ResponseWriter, r *http.Request)
+	// Reset Settings
+	// (DELETE /v1/admin/settings)
+	ResetSettings(w http.ResponseWriter, r *http.Request)
+	// Get Settings
+	// (GET /v1/admin/settings)
+	GetSettings(w http.ResponseWriter, r *http.Request)
+	// Update Settings
+	// (PATCH /v1/admin/settings)
+	UpdateSettings(w http.ResponseWriter, r *http.Request)
 	// Agent
 	// (POST /v1/agent)
 	Agent(w http.ResponseWriter, r *http.Request)
@@ -249,27 +1355,162 @@ type ServerInterface interface {
 	// Create Identity
 	// (POST /v1/identities)
 	CreateIdentity(w http.ResponseWriter, r *http.Request)
+	// Import Identity
+	// (POST /v1/migration/import)
+	ImportIdentity(w http.ResponseWriter, r *http.Request)
+	// OpenID4VCI Credential
+	// (POST /v1/oid4vci/credential)
+	OID4VCICredential(w http.ResponseWriter, r *http.Request, params OID4VCICredentialParams)
+	// OpenID4VCI Token
+	// (POST /v1/oid4vci/token)
+	OID4VCIToken(w http.ResponseWriter, r *http.Request)
+	// Purge Cached Document
+	// (DELETE /v1/schema-cache)
+	PurgeCachedDocument(w http.ResponseWriter, r *http.Request, params PurgeCachedDocumentParams)
+	// Refresh Cached Document
+	// (POST /v1/schema-cache/refresh)
+	RefreshCachedDocument(w http.ResponseWriter, r *http.Request)
+	// Verification Callback
+	// (POST /v1/verification/callback)
+	VerificationCallback(w http.ResponseWriter, r *http.Request, params VerificationCallbackParams)
 	// Get Claims
 	// (GET /v1/{identifier}/claims)
 	GetClaims(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetClaimsParams)
 	// Create Claim
 	// (POST /v1/{identifier}/claims)
-	CreateClaim(w http.ResponseWriter, r *http.Request, identifier PathIdentifier)
+	CreateClaim(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params CreateClaimParams)
+	// Get Claim By Revocation Nonce
+	// (GET /v1/{identifier}/claims/nonce/{nonce})
+	GetClaimByNonce(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, nonce PathNonce)
 	// Get Revocation Status
 	// (GET /v1/{identifier}/claims/revocation/status/{nonce})
 	GetRevocationStatus(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, nonce PathNonce)
 	// Revoke Claim
 	// (POST /v1/{identifier}/claims/revoke/{nonce})
-	RevokeClaim(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, nonce PathNonce)
+	RevokeClaim(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, nonce PathNonce, params RevokeClaimParams)
+	// Get Revocation List
+	// (GET /v1/{identifier}/claims/revoked)
+	GetRevocationList(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetRevocationListParams)
 	// Get Claim
 	// (GET /v1/{identifier}/claims/{id})
 	GetClaim(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id PathClaim)
+	// Renew Claim Offer
+	// (POST /v1/{identifier}/claims/{id}/offer)
+	RenewClaimOffer(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id PathClaim)
 	// Get Claim QR code
 	// (GET /v1/{identifier}/claims/{id}/qrcode)
 	GetClaimQrCode(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id PathClaim)
+	// Get Claim Wallet Links
+	// (GET /v1/{identifier}/claims/{id}/wallet-links)
+	GetClaimWalletLinks(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id PathClaim)
+	// Resolve DID Document
+	// (GET /v1/{identifier}/did-document)
+	ResolveDIDDocument(w http.ResponseWriter, r *http.Request, identifier PathIdentifier)
+	// Get DID Services
+	// (GET /v1/{identifier}/did-document/services)
+	GetDIDServices(w http.ResponseWriter, r *http.Request, identifier PathIdentifier)
+	// Delete DID Service
+	// (DELETE /v1/{identifier}/did-document/services/{serviceId})
+	DeleteDIDService(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, serviceId PathDIDServiceID)
+	// Set DID Service
+	// (PUT /v1/{identifier}/did-document/services/{serviceId})
+	SetDIDService(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, serviceId PathDIDServiceID)
+	// Get Feature Flags
+	// (GET /v1/{identifier}/features)
+	GetFeatureFlags(w http.ResponseWriter, r *http.Request, identifier PathIdentifier)
+	// Reset Feature Flag
+	// (DELETE /v1/{identifier}/features/{feature})
+	ResetFeatureFlag(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, feature PathFeature)
+	// Set Feature Flag
+	// (PUT /v1/{identifier}/features/{feature})
+	SetFeatureFlag(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, feature PathFeature)
+	// Get JWT Credential Keys
+	// (GET /v1/{identifier}/jwks)
+	GetJWTCredentialKeys(w http.ResponseWriter, r *http.Request, identifier PathIdentifier)
+	// Get Auth Keys
+	// (GET /v1/{identifier}/keys)
+	GetAuthKeys(w http.ResponseWriter, r *http.Request, identifier PathIdentifier)
+	// Rotate Auth Key
+	// (POST /v1/{identifier}/keys)
+	RotateAuthKey(w http.ResponseWriter, r *http.Request, identifier PathIdentifier)
+	// Get Agent Messages
+	// (GET /v1/{identifier}/messages)
+	GetAgentMessages(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetAgentMessagesParams)
+	// Get Agent Message Envelope
+	// (GET /v1/{identifier}/messages/{id}/envelope)
+	GetAgentMessageEnvelope(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id PathAgentMessage)
+	// Abort Identity Migration
+	// (DELETE /v1/{identifier}/migration)
+	AbortIdentityMigration(w http.ResponseWriter, r *http.Request, identifier PathIdentifier)
+	// Get Identity Migration
+	// (GET /v1/{identifier}/migration)
+	GetIdentityMigration(w http.ResponseWriter, r *http.Request, identifier PathIdentifier)
+	// Start Identity Migration
+	// (POST /v1/{identifier}/migration)
+	StartIdentityMigration(w http.ResponseWriter, r *http.Request, identifier PathIdentifier)
+	// Complete Identity Migration
+	// (POST /v1/{identifier}/migration/complete)
+	CompleteIdentityMigration(w http.ResponseWriter, r *http.Request, identifier PathIdentifier)
+	// Export Identity
+	// (GET /v1/{identifier}/migration/export)
+	ExportIdentity(w http.ResponseWriter, r *http.Request, identifier PathIdentifier)
+	// Create OpenID4VCI Offer
+	// (POST /v1/{identifier}/oid4vci/offers)
+	CreateOID4VCIOffer(w http.ResponseWriter, r *http.Request, identifier PathIdentifier)
+	// Reset Publishing Policy
+	// (DELETE /v1/{identifier}/publishing-policy)
+	ResetPublishingPolicy(w http.ResponseWriter, r *http.Request, identifier PathIdentifier)
+	// Get Publishing Policy
+	// (GET /v1/{identifier}/publishing-policy)
+	GetPublishingPolicy(w http.ResponseWriter, r *http.Request, identifier PathIdentifier)
+	// Set Publishing Policy
+	// (PUT /v1/{identifier}/publishing-policy)
+	SetPublishingPolicy(w http.ResponseWriter, r *http.Request, identifier PathIdentifier)
+	// Apply Revocation Decisions
+	// (POST /v1/{identifier}/revocation-decisions)
+	ApplyRevocationDecisions(w http.ResponseWriter, r *http.Request, identifier PathIdentifier)
+	// Get Revocation Decisions Report
+	// (GET /v1/{identifier}/revocation-decisions/report)
+	GetRevocationDecisionsReport(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetRevocationDecisionsReportParams)
+	// Verify Identity Trees
+	// (GET /v1/{identifier}/state/integrity)
+	GetStateIntegrity(w http.ResponseWriter, r *http.Request, identifier PathIdentifier)
 	// Publish Identity State
 	// (POST /v1/{identifier}/state/publish)
 	PublishIdentityState(w http.ResponseWriter, r *http.Request, identifier PathIdentifier)
+	// Get State Transactions
+	// (GET /v1/{identifier}/state/transactions)
+	GetStateTransactions(w http.ResponseWriter, r *http.Request, identifier PathIdentifier)
+	// Get Status List Credential
+	// (GET /v1/{identifier}/status-list)
+	GetStatusListCredential(w http.ResponseWriter, r *http.Request, identifier PathIdentifier)
+	// Create Verification Request
+	// (POST /v1/{identifier}/verification/requests)
+	CreateVerificationRequest(w http.ResponseWriter, r *http.Request, identifier PathIdentifier)
+	// Get Verification Request
+	// (GET /v1/{identifier}/verification/requests/{id})
+	GetVerificationRequest(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id PathVerificationRequest)
+	// Get Verification Request QR Code
+	// (GET /v1/{identifier}/verification/requests/{id}/qrcode)
+	GetVerificationRequestQrCode(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id PathVerificationRequest)
+	// Get Verification Responses
+	// (GET /v1/{identifier}/verification/responses)
+	GetVerificationResponses(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetVerificationResponsesParams)
+	// Get Held Credentials
+	// (GET /v1/{identifier}/wallet/credentials)
+	GetHeldCredentials(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetHeldCredentialsParams)
+	// Delete Held Credential
+	// (DELETE /v1/{identifier}/wallet/credentials/{id})
+	DeleteHeldCredential(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id PathHeldCredential)
+	// Get Held Credential
+	// (GET /v1/{identifier}/wallet/credentials/{id})
+	GetHeldCredential(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id PathHeldCredential)
+	// Accept Credential Offer
+	// (POST /v1/{identifier}/wallet/offers)
+	AcceptCredentialOffer(w http.ResponseWriter, r *http.Request, identifier PathIdentifier)
+	// Present Credentials
+	// (POST /v1/{identifier}/wallet/presentations)
+	PresentCredentials(w http.ResponseWriter, r *http.Request, identifier PathIdentifier)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -296,6 +1537,36 @@ func (siw *ServerInterfaceWrapper) GetDocumentation(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
+// GetAgentCapabilities operation middleware
+func (siw *ServerInterfaceWrapper) GetAgentCapabilities(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAgentCapabilities(w, r)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetOID4VCIIssuerMetadata operation middleware
+func (siw *ServerInterfaceWrapper) GetOID4VCIIssuerMetadata(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetOID4VCIIssuerMetadata(w, r)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 // GetFavicon operation middleware
 func (siw *ServerInterfaceWrapper) GetFavicon(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -341,6 +1612,74 @@ func (siw *ServerInterfaceWrapper) Health(w http.ResponseWriter, r *http.Request
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
+// ExportIssuer operation middleware
+func (siw *ServerInterfaceWrapper) ExportIssuer(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportIssuer(w, r)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ResetSettings operation middleware
+func (siw *ServerInterfaceWrapper) ResetSettings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ResetSettings(w, r)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetSettings operation middleware
+func (siw *ServerInterfaceWrapper) GetSettings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSettings(w, r)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// UpdateSettings operation middleware
+func (siw *ServerInterfaceWrapper) UpdateSettings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateSettings(w, r)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 // Agent operation middleware
 func (siw *ServerInterfaceWrapper) Agent(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -390,44 +1729,210 @@ func (siw *ServerInterfaceWrapper) CreateIdentity(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetClaims operation middleware
-func (siw *ServerInterfaceWrapper) GetClaims(w http.ResponseWriter, r *http.Request) {
+// ImportIdentity operation middleware
+func (siw *ServerInterfaceWrapper) ImportIdentity(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	var err error
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
 
-	// ------------- Path parameter "identifier" -------------
-	var identifier PathIdentifier
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportIdentity(w, r)
+	})
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "identifier", runtime.ParamLocationPath, chi.URLParam(r, "identifier"), &identifier)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// OID4VCICredential operation middleware
+func (siw *ServerInterfaceWrapper) OID4VCICredential(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetClaimsParams
+	var params OID4VCICredentialParams
 
-	// ------------- Optional query parameter "schemaType" -------------
+	headers := r.Header
 
-	err = runtime.BindQueryParameter("form", true, false, "schemaType", r.URL.Query(), &params.SchemaType)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "schemaType", Err: err})
-		return
-	}
+	// ------------- Required header parameter "Authorization" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Authorization")]; found {
+		var Authorization string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Authorization", Count: n})
+			return
+		}
 
-	// ------------- Optional query parameter "schemaHash" -------------
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, valueList[0], &Authorization)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Authorization", Err: err})
+			return
+		}
 
-	err = runtime.BindQueryParameter("form", true, false, "schemaHash", r.URL.Query(), &params.SchemaHash)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "schemaHash", Err: err})
+		params.Authorization = Authorization
+
+	} else {
+		err := fmt.Errorf("Header parameter Authorization is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Authorization", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "subject" -------------
-
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.OID4VCICredential(w, r, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// OID4VCIToken operation middleware
+func (siw *ServerInterfaceWrapper) OID4VCIToken(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.OID4VCIToken(w, r)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PurgeCachedDocument operation middleware
+func (siw *ServerInterfaceWrapper) PurgeCachedDocument(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PurgeCachedDocumentParams
+
+	// ------------- Required query parameter "url" -------------
+
+	if paramValue := r.URL.Query().Get("url"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "url"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "url", r.URL.Query(), &params.Url)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "url", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PurgeCachedDocument(w, r, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// RefreshCachedDocument operation middleware
+func (siw *ServerInterfaceWrapper) RefreshCachedDocument(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RefreshCachedDocument(w, r)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// VerificationCallback operation middleware
+func (siw *ServerInterfaceWrapper) VerificationCallback(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params VerificationCallbackParams
+
+	// ------------- Required query parameter "requestID" -------------
+
+	if paramValue := r.URL.Query().Get("requestID"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "requestID"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "requestID", r.URL.Query(), &params.RequestID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "requestID", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.VerificationCallback(w, r, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetClaims operation middleware
+func (siw *ServerInterfaceWrapper) GetClaims(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "identifier", runtime.ParamLocationPath, chi.URLParam(r, "identifier"), &identifier)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetClaimsParams
+
+	// ------------- Optional query parameter "schemaType" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "schemaType", r.URL.Query(), &params.SchemaType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "schemaType", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "schemaHash" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "schemaHash", r.URL.Query(), &params.SchemaHash)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "schemaHash", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "subject" -------------
+
 	err = runtime.BindQueryParameter("form", true, false, "subject", r.URL.Query(), &params.Subject)
 	if err != nil {
 		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "subject", Err: err})
@@ -494,8 +1999,69 @@ func (siw *ServerInterfaceWrapper) CreateClaim(w http.ResponseWriter, r *http.Re
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateClaimParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, valueList[0], &IdempotencyKey)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateClaim(w, r, identifier, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetClaimByNonce operation middleware
+func (siw *ServerInterfaceWrapper) GetClaimByNonce(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "identifier", runtime.ParamLocationPath, chi.URLParam(r, "identifier"), &identifier)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "nonce" -------------
+	var nonce PathNonce
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "nonce", runtime.ParamLocationPath, chi.URLParam(r, "nonce"), &nonce)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nonce", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
+
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateClaim(w, r, identifier)
+		siw.Handler.GetClaimByNonce(w, r, identifier, nonce)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -566,8 +2132,101 @@ func (siw *ServerInterfaceWrapper) RevokeClaim(w http.ResponseWriter, r *http.Re
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RevokeClaimParams
+
+	// ------------- Optional query parameter "publishNow" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "publishNow", r.URL.Query(), &params.PublishNow)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "publishNow", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "reason" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "reason", r.URL.Query(), &params.Reason)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "reason", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "description" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "description", r.URL.Query(), &params.Description)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "description", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, valueList[0], &IdempotencyKey)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevokeClaim(w, r, identifier, nonce, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetRevocationList operation middleware
+func (siw *ServerInterfaceWrapper) GetRevocationList(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "identifier", runtime.ParamLocationPath, chi.URLParam(r, "identifier"), &identifier)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetRevocationListParams
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", r.URL.Query(), &params.Since)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.RevokeClaim(w, r, identifier, nonce)
+		siw.Handler.GetRevocationList(w, r, identifier, params)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -614,8 +2273,8 @@ func (siw *ServerInterfaceWrapper) GetClaim(w http.ResponseWriter, r *http.Reque
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetClaimQrCode operation middleware
-func (siw *ServerInterfaceWrapper) GetClaimQrCode(w http.ResponseWriter, r *http.Request) {
+// RenewClaimOffer operation middleware
+func (siw *ServerInterfaceWrapper) RenewClaimOffer(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -641,7 +2300,7 @@ func (siw *ServerInterfaceWrapper) GetClaimQrCode(w http.ResponseWriter, r *http
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
 
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetClaimQrCode(w, r, identifier, id)
+		siw.Handler.RenewClaimOffer(w, r, identifier, id)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -651,8 +2310,8 @@ func (siw *ServerInterfaceWrapper) GetClaimQrCode(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PublishIdentityState operation middleware
-func (siw *ServerInterfaceWrapper) PublishIdentityState(w http.ResponseWriter, r *http.Request) {
+// GetClaimQrCode operation middleware
+func (siw *ServerInterfaceWrapper) GetClaimQrCode(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -666,10 +2325,19 @@ func (siw *ServerInterfaceWrapper) PublishIdentityState(w http.ResponseWriter, r
 		return
 	}
 
+	// ------------- Path parameter "id" -------------
+	var id PathClaim
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
 
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PublishIdentityState(w, r, identifier)
+		siw.Handler.GetClaimQrCode(w, r, identifier, id)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -679,798 +2347,6385 @@ func (siw *ServerInterfaceWrapper) PublishIdentityState(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
+// GetClaimWalletLinks operation middleware
+func (siw *ServerInterfaceWrapper) GetClaimWalletLinks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
-
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+	var err error
 
-type UnmarshallingParamError struct {
-	ParamName string
-	Err       error
-}
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
 
-func (e *UnmarshallingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshalling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "identifier", runtime.ParamLocationPath, chi.URLParam(r, "identifier"), &identifier)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
 
-func (e *UnmarshallingParamError) Unwrap() error {
-	return e.Err
-}
+	// ------------- Path parameter "id" -------------
+	var id PathClaim
 
-type RequiredParamError struct {
-	ParamName string
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
-}
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetClaimWalletLinks(w, r, identifier, id)
+	})
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
-}
+// ResolveDIDDocument operation middleware
+func (siw *ServerInterfaceWrapper) ResolveDIDDocument(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+	var err error
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
-}
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "identifier", runtime.ParamLocationPath, chi.URLParam(r, "identifier"), &identifier)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
-}
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ResolveDIDDocument(w, r, identifier)
+	})
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{})
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type ChiServerOptions struct {
-	BaseURL          string
-	BaseRouter       chi.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseRouter: r,
-	})
-}
+// GetDIDServices operation middleware
+func (siw *ServerInterfaceWrapper) GetDIDServices(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
-}
+	var err error
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
 
-	if r == nil {
-		r = chi.NewRouter()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
-	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	err = runtime.BindStyledParameterWithLocation("simple", false, "identifier", runtime.ParamLocationPath, chi.URLParam(r, "identifier"), &identifier)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
 	}
 
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/", wrapper.GetDocumentation)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/favicon.ico", wrapper.GetFavicon)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/static/docs/api/api.yaml", wrapper.GetYaml)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/status", wrapper.Health)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v1/agent", wrapper.Agent)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v1/identities", wrapper.GetIdentities)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v1/identities", wrapper.CreateIdentity)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v1/{identifier}/claims", wrapper.GetClaims)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v1/{identifier}/claims", wrapper.CreateClaim)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v1/{identifier}/claims/revocation/status/{nonce}", wrapper.GetRevocationStatus)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v1/{identifier}/claims/revoke/{nonce}", wrapper.RevokeClaim)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v1/{identifier}/claims/{id}", wrapper.GetClaim)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v1/{identifier}/claims/{id}/qrcode", wrapper.GetClaimQrCode)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v1/{identifier}/state/publish", wrapper.PublishIdentityState)
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetDIDServices(w, r, identifier)
 	})
 
-	return r
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type N400JSONResponse GenericErrorMessage
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
 
-type N401JSONResponse GenericErrorMessage
+// DeleteDIDService operation middleware
+func (siw *ServerInterfaceWrapper) DeleteDIDService(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-type N404JSONResponse GenericErrorMessage
+	var err error
 
-type N422JSONResponse GenericErrorMessage
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
 
-type N500JSONResponse GenericErrorMessage
+	err = runtime.BindStyledParameterWithLocation("simple", false, "identifier", runtime.ParamLocationPath, chi.URLParam(r, "identifier"), &identifier)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
 
-type N500CreateIdentityJSONResponse struct {
-	Code      *int    `json:"code,omitempty"`
-	Error     *string `json:"error,omitempty"`
-	RequestID *string `json:"requestID,omitempty"`
-}
+	// ------------- Path parameter "serviceId" -------------
+	var serviceId PathDIDServiceID
 
-type GetDocumentationRequestObject struct {
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "serviceId", runtime.ParamLocationPath, chi.URLParam(r, "serviceId"), &serviceId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "serviceId", Err: err})
+		return
+	}
 
-type GetDocumentationResponseObject interface {
-	VisitGetDocumentationResponse(w http.ResponseWriter) error
-}
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
 
-type GetDocumentation200Response struct {
-}
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteDIDService(w, r, identifier, serviceId)
+	})
 
-func (response GetDocumentation200Response) VisitGetDocumentationResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetFaviconRequestObject struct {
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type GetFaviconResponseObject interface {
-	VisitGetFaviconResponse(w http.ResponseWriter) error
-}
+// SetDIDService operation middleware
+func (siw *ServerInterfaceWrapper) SetDIDService(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-type GetFavicon200Response struct {
-}
+	var err error
 
-func (response GetFavicon200Response) VisitGetFaviconResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
 
-type GetYamlRequestObject struct {
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "identifier", runtime.ParamLocationPath, chi.URLParam(r, "identifier"), &identifier)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
 
-type GetYamlResponseObject interface {
-	VisitGetYamlResponse(w http.ResponseWriter) error
-}
+	// ------------- Path parameter "serviceId" -------------
+	var serviceId PathDIDServiceID
 
-type GetYaml200Response struct {
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "serviceId", runtime.ParamLocationPath, chi.URLParam(r, "serviceId"), &serviceId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "serviceId", Err: err})
+		return
+	}
 
-func (response GetYaml200Response) VisitGetYamlResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
 
-type HealthRequestObject struct {
-}
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetDIDService(w, r, identifier, serviceId)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetFeatureFlags operation middleware
+func (siw *ServerInterfaceWrapper) GetFeatureFlags(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "identifier", runtime.ParamLocationPath, chi.URLParam(r, "identifier"), &identifier)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetFeatureFlags(w, r, identifier)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ResetFeatureFlag operation middleware
+func (siw *ServerInterfaceWrapper) ResetFeatureFlag(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "identifier", runtime.ParamLocationPath, chi.URLParam(r, "identifier"), &identifier)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "feature" -------------
+	var feature PathFeature
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "feature", runtime.ParamLocationPath, chi.URLParam(r, "feature"), &feature)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "feature", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ResetFeatureFlag(w, r, identifier, feature)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// SetFeatureFlag operation middleware
+func (siw *ServerInterfaceWrapper) SetFeatureFlag(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "identifier", runtime.ParamLocationPath, chi.URLParam(r, "identifier"), &identifier)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "feature" -------------
+	var feature PathFeature
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "feature", runtime.ParamLocationPath, chi.URLParam(r, "feature"), &feature)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "feature", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetFeatureFlag(w, r, identifier, feature)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetJWTCredentialKeys operation middleware
+func (siw *ServerInterfaceWrapper) GetJWTCredentialKeys(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "identifier", runtime.ParamLocationPath, chi.URLParam(r, "identifier"), &identifier)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetJWTCredentialKeys(w, r, identifier)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetAuthKeys operation middleware
+func (siw *ServerInterfaceWrapper) GetAuthKeys(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "identifier", runtime.ParamLocationPath, chi.URLParam(r, "identifier"), &identifier)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAuthKeys(w, r, identifier)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// RotateAuthKey operation middleware
+func (siw *ServerInterfaceWrapper) RotateAuthKey(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "identifier", runtime.ParamLocationPath, chi.URLParam(r, "identifier"), &identifier)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RotateAuthKey(w, r, identifier)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetAgentMessages operation middleware
+func (siw *ServerInterfaceWrapper) GetAgentMessages(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "identifier", runtime.ParamLocationPath, chi.URLParam(r, "identifier"), &identifier)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAgentMessagesParams
+
+	// ------------- Optional query parameter "sender" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sender", r.URL.Query(), &params.Sender)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sender", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "threadID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "threadID", r.URL.Query(), &params.ThreadID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "threadID", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAgentMessages(w, r, identifier, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetAgentMessageEnvelope operation middleware
+func (siw *ServerInterfaceWrapper) GetAgentMessageEnvelope(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "identifier", runtime.ParamLocationPath, chi.URLParam(r, "identifier"), &identifier)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id PathAgentMessage
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAgentMessageEnvelope(w, r, identifier, id)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// AbortIdentityMigration operation middleware
+func (siw *ServerInterfaceWrapper) AbortIdentityMigration(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "identifier", runtime.ParamLocationPath, chi.URLParam(r, "identifier"), &identifier)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AbortIdentityMigration(w, r, identifier)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetIdentityMigration operation middleware
+func (siw *ServerInterfaceWrapper) GetIdentityMigration(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "identifier", runtime.ParamLocationPath, chi.URLParam(r, "identifier"), &identifier)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetIdentityMigration(w, r, identifier)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// StartIdentityMigration operation middleware
+func (siw *ServerInterfaceWrapper) StartIdentityMigration(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "identifier", runtime.ParamLocationPath, chi.URLParam(r, "identifier"), &identifier)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StartIdentityMigration(w, r, identifier)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CompleteIdentityMigration operation middleware
+func (siw *ServerInterfaceWrapper) CompleteIdentityMigration(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "identifier", runtime.ParamLocationPath, chi.URLParam(r, "identifier"), &identifier)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CompleteIdentityMigration(w, r, identifier)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ExportIdentity operation middleware
+func (siw *ServerInterfaceWrapper) ExportIdentity(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "identifier", runtime.ParamLocationPath, chi.URLParam(r, "identifier"), &identifier)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportIdentity(w, r, identifier)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CreateOID4VCIOffer operation middleware
+func (siw *ServerInterfaceWrapper) CreateOID4VCIOffer(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "identifier", runtime.ParamLocationPath, chi.URLParam(r, "identifier"), &identifier)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateOID4VCIOffer(w, r, identifier)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ResetPublishingPolicy operation middleware
+func (siw *ServerInterfaceWrapper) ResetPublishingPolicy(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "identifier", runtime.ParamLocationPath, chi.URLParam(r, "identifier"), &identifier)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ResetPublishingPolicy(w, r, identifier)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetPublishingPolicy operation middleware
+func (siw *ServerInterfaceWrapper) GetPublishingPolicy(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "identifier", runtime.ParamLocationPath, chi.URLParam(r, "identifier"), &identifier)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPublishingPolicy(w, r, identifier)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// SetPublishingPolicy operation middleware
+func (siw *ServerInterfaceWrapper) SetPublishingPolicy(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "identifier", runtime.ParamLocationPath, chi.URLParam(r, "identifier"), &identifier)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetPublishingPolicy(w, r, identifier)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ApplyRevocationDecisions operation middleware
+func (siw *ServerInterfaceWrapper) ApplyRevocationDecisions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "identifier", runtime.ParamLocationPath, chi.URLParam(r, "identifier"), &identifier)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ApplyRevocationDecisions(w, r, identifier)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetRevocationDecisionsReport operation middleware
+func (siw *ServerInterfaceWrapper) GetRevocationDecisionsReport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "identifier", runtime.ParamLocationPath, chi.URLParam(r, "identifier"), &identifier)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetRevocationDecisionsReportParams
+
+	// ------------- Optional query parameter "source" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "source", r.URL.Query(), &params.Source)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "source", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRevocationDecisionsReport(w, r, identifier, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetStateIntegrity operation middleware
+func (siw *ServerInterfaceWrapper) GetStateIntegrity(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "identifier", runtime.ParamLocationPath, chi.URLParam(r, "identifier"), &identifier)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStateIntegrity(w, r, identifier)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PublishIdentityState operation middleware
+func (siw *ServerInterfaceWrapper) PublishIdentityState(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "identifier", runtime.ParamLocationPath, chi.URLParam(r, "identifier"), &identifier)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PublishIdentityState(w, r, identifier)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetStateTransactions operation middleware
+func (siw *ServerInterfaceWrapper) GetStateTransactions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "identifier", runtime.ParamLocationPath, chi.URLParam(r, "identifier"), &identifier)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStateTransactions(w, r, identifier)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetStatusListCredential operation middleware
+func (siw *ServerInterfaceWrapper) GetStatusListCredential(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "identifier", runtime.ParamLocationPath, chi.URLParam(r, "identifier"), &identifier)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatusListCredential(w, r, identifier)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CreateVerificationRequest operation middleware
+func (siw *ServerInterfaceWrapper) CreateVerificationRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "identifier", runtime.ParamLocationPath, chi.URLParam(r, "identifier"), &identifier)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateVerificationRequest(w, r, identifier)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetVerificationRequest operation middleware
+func (siw *ServerInterfaceWrapper) GetVerificationRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "identifier", runtime.ParamLocationPath, chi.URLParam(r, "identifier"), &identifier)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id PathVerificationRequest
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetVerificationRequest(w, r, identifier, id)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetVerificationRequestQrCode operation middleware
+func (siw *ServerInterfaceWrapper) GetVerificationRequestQrCode(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "identifier", runtime.ParamLocationPath, chi.URLParam(r, "identifier"), &identifier)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id PathVerificationRequest
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetVerificationRequestQrCode(w, r, identifier, id)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetVerificationResponses operation middleware
+func (siw *ServerInterfaceWrapper) GetVerificationResponses(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "identifier", runtime.ParamLocationPath, chi.URLParam(r, "identifier"), &identifier)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetVerificationResponsesParams
+
+	// ------------- Optional query parameter "requestId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "requestId", r.URL.Query(), &params.RequestId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "requestId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "connectionId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "connectionId", r.URL.Query(), &params.ConnectionId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "connectionId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "userDID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "userDID", r.URL.Query(), &params.UserDID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userDID", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetVerificationResponses(w, r, identifier, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetHeldCredentials operation middleware
+func (siw *ServerInterfaceWrapper) GetHeldCredentials(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "identifier", runtime.ParamLocationPath, chi.URLParam(r, "identifier"), &identifier)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetHeldCredentialsParams
+
+	// ------------- Optional query parameter "schemaType" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "schemaType", r.URL.Query(), &params.SchemaType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "schemaType", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "revoked" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "revoked", r.URL.Query(), &params.Revoked)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "revoked", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetHeldCredentials(w, r, identifier, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteHeldCredential operation middleware
+func (siw *ServerInterfaceWrapper) DeleteHeldCredential(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "identifier", runtime.ParamLocationPath, chi.URLParam(r, "identifier"), &identifier)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id PathHeldCredential
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteHeldCredential(w, r, identifier, id)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetHeldCredential operation middleware
+func (siw *ServerInterfaceWrapper) GetHeldCredential(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "identifier", runtime.ParamLocationPath, chi.URLParam(r, "identifier"), &identifier)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id PathHeldCredential
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetHeldCredential(w, r, identifier, id)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// AcceptCredentialOffer operation middleware
+func (siw *ServerInterfaceWrapper) AcceptCredentialOffer(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "identifier", runtime.ParamLocationPath, chi.URLParam(r, "identifier"), &identifier)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AcceptCredentialOffer(w, r, identifier)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PresentCredentials operation middleware
+func (siw *ServerInterfaceWrapper) PresentCredentials(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "identifier", runtime.ParamLocationPath, chi.URLParam(r, "identifier"), &identifier)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PresentCredentials(w, r, identifier)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshallingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshallingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshalling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshallingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/", wrapper.GetDocumentation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/.well-known/agent-capabilities", wrapper.GetAgentCapabilities)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/.well-known/openid-credential-issuer", wrapper.GetOID4VCIIssuerMetadata)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/favicon.ico", wrapper.GetFavicon)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/static/docs/api/api.yaml", wrapper.GetYaml)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/status", wrapper.Health)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/admin/export", wrapper.ExportIssuer)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v1/admin/settings", wrapper.ResetSettings)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/admin/settings", wrapper.GetSettings)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/v1/admin/settings", wrapper.UpdateSettings)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/agent", wrapper.Agent)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/identities", wrapper.GetIdentities)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/identities", wrapper.CreateIdentity)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/migration/import", wrapper.ImportIdentity)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/oid4vci/credential", wrapper.OID4VCICredential)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/oid4vci/token", wrapper.OID4VCIToken)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v1/schema-cache", wrapper.PurgeCachedDocument)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/schema-cache/refresh", wrapper.RefreshCachedDocument)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/verification/callback", wrapper.VerificationCallback)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/{identifier}/claims", wrapper.GetClaims)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/{identifier}/claims", wrapper.CreateClaim)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/{identifier}/claims/nonce/{nonce}", wrapper.GetClaimByNonce)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/{identifier}/claims/revocation/status/{nonce}", wrapper.GetRevocationStatus)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/{identifier}/claims/revoke/{nonce}", wrapper.RevokeClaim)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/{identifier}/claims/revoked", wrapper.GetRevocationList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/{identifier}/claims/{id}", wrapper.GetClaim)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/{identifier}/claims/{id}/offer", wrapper.RenewClaimOffer)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/{identifier}/claims/{id}/qrcode", wrapper.GetClaimQrCode)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/{identifier}/claims/{id}/wallet-links", wrapper.GetClaimWalletLinks)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/{identifier}/did-document", wrapper.ResolveDIDDocument)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/{identifier}/did-document/services", wrapper.GetDIDServices)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v1/{identifier}/did-document/services/{serviceId}", wrapper.DeleteDIDService)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/v1/{identifier}/did-document/services/{serviceId}", wrapper.SetDIDService)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/{identifier}/features", wrapper.GetFeatureFlags)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v1/{identifier}/features/{feature}", wrapper.ResetFeatureFlag)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/v1/{identifier}/features/{feature}", wrapper.SetFeatureFlag)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/{identifier}/jwks", wrapper.GetJWTCredentialKeys)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/{identifier}/keys", wrapper.GetAuthKeys)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/{identifier}/keys", wrapper.RotateAuthKey)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/{identifier}/messages", wrapper.GetAgentMessages)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/{identifier}/messages/{id}/envelope", wrapper.GetAgentMessageEnvelope)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v1/{identifier}/migration", wrapper.AbortIdentityMigration)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/{identifier}/migration", wrapper.GetIdentityMigration)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/{identifier}/migration", wrapper.StartIdentityMigration)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/{identifier}/migration/complete", wrapper.CompleteIdentityMigration)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/{identifier}/migration/export", wrapper.ExportIdentity)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/{identifier}/oid4vci/offers", wrapper.CreateOID4VCIOffer)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v1/{identifier}/publishing-policy", wrapper.ResetPublishingPolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/{identifier}/publishing-policy", wrapper.GetPublishingPolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/v1/{identifier}/publishing-policy", wrapper.SetPublishingPolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/{identifier}/revocation-decisions", wrapper.ApplyRevocationDecisions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/{identifier}/revocation-decisions/report", wrapper.GetRevocationDecisionsReport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/{identifier}/state/integrity", wrapper.GetStateIntegrity)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/{identifier}/state/publish", wrapper.PublishIdentityState)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/{identifier}/state/transactions", wrapper.GetStateTransactions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/{identifier}/status-list", wrapper.GetStatusListCredential)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/{identifier}/verification/requests", wrapper.CreateVerificationRequest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/{identifier}/verification/requests/{id}", wrapper.GetVerificationRequest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/{identifier}/verification/requests/{id}/qrcode", wrapper.GetVerificationRequestQrCode)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/{identifier}/verification/responses", wrapper.GetVerificationResponses)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/{identifier}/wallet/credentials", wrapper.GetHeldCredentials)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v1/{identifier}/wallet/credentials/{id}", wrapper.DeleteHeldCredential)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/{identifier}/wallet/credentials/{id}", wrapper.GetHeldCredential)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/{identifier}/wallet/offers", wrapper.AcceptCredentialOffer)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/{identifier}/wallet/presentations", wrapper.PresentCredentials)
+	})
+
+	return r
+}
+
+type N400JSONResponse GenericErrorMessage
+
+type N401JSONResponse GenericErrorMessage
+
+type N404JSONResponse GenericErrorMessage
+
+type N409JSONResponse GenericErrorMessage
+
+type N410JSONResponse GenericErrorMessage
+
+type N422JSONResponse GenericErrorMessage
+
+type N500JSONResponse GenericErrorMessage
+
+type N500CreateIdentityJSONResponse struct {
+	Code      *int    `json:"code,omitempty"`
+	Error     *string `json:"error,omitempty"`
+	RequestID *string `json:"requestID,omitempty"`
+}
+
+type GetDocumentationRequestObject struct {
+}
+
+type GetDocumentationResponseObject interface {
+	VisitGetDocumentationResponse(w http.ResponseWriter) error
+}
+
+type GetDocumentation200Response struct {
+}
+
+func (response GetDocumentation200Response) VisitGetDocumentationResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetAgentCapabilitiesRequestObject struct {
+}
+
+type GetAgentCapabilitiesResponseObject interface {
+	VisitGetAgentCapabilitiesResponse(w http.ResponseWriter) error
+}
+
+type GetAgentCapabilities200JSONResponse AgentCapabilities
+
+func (response GetAgentCapabilities200JSONResponse) VisitGetAgentCapabilitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAgentCapabilities500JSONResponse struct{ N500JSONResponse }
+
+func (response GetAgentCapabilities500JSONResponse) VisitGetAgentCapabilitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOID4VCIIssuerMetadataRequestObject struct {
+}
+
+type GetOID4VCIIssuerMetadataResponseObject interface {
+	VisitGetOID4VCIIssuerMetadataResponse(w http.ResponseWriter) error
+}
+
+type GetOID4VCIIssuerMetadata200JSONResponse OID4VCIIssuerMetadata
+
+func (response GetOID4VCIIssuerMetadata200JSONResponse) VisitGetOID4VCIIssuerMetadataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOID4VCIIssuerMetadata500JSONResponse struct{ N500JSONResponse }
+
+func (response GetOID4VCIIssuerMetadata500JSONResponse) VisitGetOID4VCIIssuerMetadataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetFaviconRequestObject struct {
+}
+
+type GetFaviconResponseObject interface {
+	VisitGetFaviconResponse(w http.ResponseWriter) error
+}
+
+type GetFavicon200Response struct {
+}
+
+func (response GetFavicon200Response) VisitGetFaviconResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetYamlRequestObject struct {
+}
+
+type GetYamlResponseObject interface {
+	VisitGetYamlResponse(w http.ResponseWriter) error
+}
+
+type GetYaml200Response struct {
+}
+
+func (response GetYaml200Response) VisitGetYamlResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type HealthRequestObject struct {
+}
 
 type HealthResponseObject interface {
 	VisitHealthResponse(w http.ResponseWriter) error
 }
 
-type Health200JSONResponse Health
+type Health200JSONResponse Health
+
+func (response Health200JSONResponse) VisitHealthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Health500JSONResponse struct{ N500JSONResponse }
+
+func (response Health500JSONResponse) VisitHealthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Health503JSONResponse Health
+
+func (response Health503JSONResponse) VisitHealthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportIssuerRequestObject struct {
+	Body *ExportIssuerJSONRequestBody
+}
+
+type ExportIssuerResponseObject interface {
+	VisitExportIssuerResponse(w http.ResponseWriter) error
+}
+
+type ExportIssuer200ResponseHeaders struct {
+	ContentDisposition string
+}
+
+type ExportIssuer200ApplicationoctetStreamResponse struct {
+	Body          io.Reader
+	Headers       ExportIssuer200ResponseHeaders
+	ContentLength int64
+}
+
+func (response ExportIssuer200ApplicationoctetStreamResponse) VisitExportIssuerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.Header().Set("Content-Type", "application/octet-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ExportIssuer400JSONResponse struct{ N400JSONResponse }
+
+func (response ExportIssuer400JSONResponse) VisitExportIssuerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportIssuer401JSONResponse struct{ N401JSONResponse }
+
+func (response ExportIssuer401JSONResponse) VisitExportIssuerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportIssuer404JSONResponse struct{ N404JSONResponse }
+
+func (response ExportIssuer404JSONResponse) VisitExportIssuerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportIssuer409JSONResponse struct{ N409JSONResponse }
+
+func (response ExportIssuer409JSONResponse) VisitExportIssuerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportIssuer500JSONResponse struct{ N500JSONResponse }
+
+func (response ExportIssuer500JSONResponse) VisitExportIssuerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResetSettingsRequestObject struct {
+}
+
+type ResetSettingsResponseObject interface {
+	VisitResetSettingsResponse(w http.ResponseWriter) error
+}
+
+type ResetSettings200JSONResponse Settings
+
+func (response ResetSettings200JSONResponse) VisitResetSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResetSettings401JSONResponse struct{ N401JSONResponse }
+
+func (response ResetSettings401JSONResponse) VisitResetSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResetSettings500JSONResponse struct{ N500JSONResponse }
+
+func (response ResetSettings500JSONResponse) VisitResetSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSettingsRequestObject struct {
+}
+
+type GetSettingsResponseObject interface {
+	VisitGetSettingsResponse(w http.ResponseWriter) error
+}
+
+type GetSettings200JSONResponse Settings
+
+func (response GetSettings200JSONResponse) VisitGetSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSettings401JSONResponse struct{ N401JSONResponse }
+
+func (response GetSettings401JSONResponse) VisitGetSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSettings500JSONResponse struct{ N500JSONResponse }
+
+func (response GetSettings500JSONResponse) VisitGetSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSettingsRequestObject struct {
+	Body *UpdateSettingsJSONRequestBody
+}
+
+type UpdateSettingsResponseObject interface {
+	VisitUpdateSettingsResponse(w http.ResponseWriter) error
+}
+
+type UpdateSettings200JSONResponse Settings
+
+func (response UpdateSettings200JSONResponse) VisitUpdateSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSettings400JSONResponse struct{ N400JSONResponse }
+
+func (response UpdateSettings400JSONResponse) VisitUpdateSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSettings401JSONResponse struct{ N401JSONResponse }
+
+func (response UpdateSettings401JSONResponse) VisitUpdateSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSettings500JSONResponse struct{ N500JSONResponse }
+
+func (response UpdateSettings500JSONResponse) VisitUpdateSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AgentRequestObject struct {
+	Body *AgentTextRequestBody
+}
+
+type AgentResponseObject interface {
+	VisitAgentResponse(w http.ResponseWriter) error
+}
+
+type Agent200JSONResponse AgentResponse
+
+func (response Agent200JSONResponse) VisitAgentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Agent202Response struct {
+}
+
+func (response Agent202Response) VisitAgentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(202)
+	return nil
+}
+
+type Agent400JSONResponse AgentProblemReport
+
+func (response Agent400JSONResponse) VisitAgentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Agent401JSONResponse AgentProblemReport
+
+func (response Agent401JSONResponse) VisitAgentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Agent409JSONResponse AgentProblemReport
+
+func (response Agent409JSONResponse) VisitAgentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Agent410JSONResponse AgentProblemReport
+
+func (response Agent410JSONResponse) VisitAgentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(410)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Agent500JSONResponse struct{ N500JSONResponse }
+
+func (response Agent500JSONResponse) VisitAgentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetIdentitiesRequestObject struct {
+}
+
+type GetIdentitiesResponseObject interface {
+	VisitGetIdentitiesResponse(w http.ResponseWriter) error
+}
+
+type GetIdentities200JSONResponse []string
+
+func (response GetIdentities200JSONResponse) VisitGetIdentitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetIdentities401JSONResponse struct{ N401JSONResponse }
+
+func (response GetIdentities401JSONResponse) VisitGetIdentitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetIdentities500JSONResponse struct{ N500JSONResponse }
+
+func (response GetIdentities500JSONResponse) VisitGetIdentitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateIdentityRequestObject struct {
+	Body *CreateIdentityJSONRequestBody
+}
+
+type CreateIdentityResponseObject interface {
+	VisitCreateIdentityResponse(w http.ResponseWriter) error
+}
+
+type CreateIdentity201JSONResponse CreateIdentityResponse
+
+func (response CreateIdentity201JSONResponse) VisitCreateIdentityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateIdentity400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateIdentity400JSONResponse) VisitCreateIdentityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateIdentity401JSONResponse struct{ N401JSONResponse }
+
+func (response CreateIdentity401JSONResponse) VisitCreateIdentityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateIdentity500JSONResponse struct{ N500CreateIdentityJSONResponse }
+
+func (response CreateIdentity500JSONResponse) VisitCreateIdentityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportIdentityRequestObject struct {
+	Body *ImportIdentityJSONRequestBody
+}
+
+type ImportIdentityResponseObject interface {
+	VisitImportIdentityResponse(w http.ResponseWriter) error
+}
+
+type ImportIdentity201JSONResponse ImportIdentityResponse
+
+func (response ImportIdentity201JSONResponse) VisitImportIdentityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportIdentity400JSONResponse struct{ N400JSONResponse }
+
+func (response ImportIdentity400JSONResponse) VisitImportIdentityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportIdentity401JSONResponse struct{ N401JSONResponse }
+
+func (response ImportIdentity401JSONResponse) VisitImportIdentityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportIdentity409JSONResponse struct{ N409JSONResponse }
+
+func (response ImportIdentity409JSONResponse) VisitImportIdentityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportIdentity422JSONResponse struct{ N422JSONResponse }
+
+func (response ImportIdentity422JSONResponse) VisitImportIdentityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportIdentity500JSONResponse struct{ N500JSONResponse }
+
+func (response ImportIdentity500JSONResponse) VisitImportIdentityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type OID4VCICredentialRequestObject struct {
+	Params OID4VCICredentialParams
+	Body   *OID4VCICredentialJSONRequestBody
+}
+
+type OID4VCICredentialResponseObject interface {
+	VisitOID4VCICredentialResponse(w http.ResponseWriter) error
+}
+
+type OID4VCICredential200JSONResponse OID4VCICredentialResponse
+
+func (response OID4VCICredential200JSONResponse) VisitOID4VCICredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type OID4VCICredential400JSONResponse OID4VCIError
+
+func (response OID4VCICredential400JSONResponse) VisitOID4VCICredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type OID4VCICredential401JSONResponse OID4VCIError
+
+func (response OID4VCICredential401JSONResponse) VisitOID4VCICredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type OID4VCICredential500JSONResponse struct{ N500JSONResponse }
+
+func (response OID4VCICredential500JSONResponse) VisitOID4VCICredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type OID4VCITokenRequestObject struct {
+	Body *OID4VCITokenFormdataRequestBody
+}
+
+type OID4VCITokenResponseObject interface {
+	VisitOID4VCITokenResponse(w http.ResponseWriter) error
+}
+
+type OID4VCIToken200JSONResponse OID4VCITokenResponse
+
+func (response OID4VCIToken200JSONResponse) VisitOID4VCITokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type OID4VCIToken400JSONResponse OID4VCIError
+
+func (response OID4VCIToken400JSONResponse) VisitOID4VCITokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type OID4VCIToken500JSONResponse struct{ N500JSONResponse }
+
+func (response OID4VCIToken500JSONResponse) VisitOID4VCITokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PurgeCachedDocumentRequestObject struct {
+	Params PurgeCachedDocumentParams
+}
+
+type PurgeCachedDocumentResponseObject interface {
+	VisitPurgeCachedDocumentResponse(w http.ResponseWriter) error
+}
+
+type PurgeCachedDocument200JSONResponse GenericMessage
+
+func (response PurgeCachedDocument200JSONResponse) VisitPurgeCachedDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PurgeCachedDocument400JSONResponse struct{ N400JSONResponse }
+
+func (response PurgeCachedDocument400JSONResponse) VisitPurgeCachedDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PurgeCachedDocument401JSONResponse struct{ N401JSONResponse }
+
+func (response PurgeCachedDocument401JSONResponse) VisitPurgeCachedDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PurgeCachedDocument404JSONResponse struct{ N404JSONResponse }
+
+func (response PurgeCachedDocument404JSONResponse) VisitPurgeCachedDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PurgeCachedDocument500JSONResponse struct{ N500JSONResponse }
+
+func (response PurgeCachedDocument500JSONResponse) VisitPurgeCachedDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RefreshCachedDocumentRequestObject struct {
+	Body *RefreshCachedDocumentJSONRequestBody
+}
+
+type RefreshCachedDocumentResponseObject interface {
+	VisitRefreshCachedDocumentResponse(w http.ResponseWriter) error
+}
+
+type RefreshCachedDocument200JSONResponse CachedDocument
+
+func (response RefreshCachedDocument200JSONResponse) VisitRefreshCachedDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RefreshCachedDocument400JSONResponse struct{ N400JSONResponse }
+
+func (response RefreshCachedDocument400JSONResponse) VisitRefreshCachedDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RefreshCachedDocument401JSONResponse struct{ N401JSONResponse }
+
+func (response RefreshCachedDocument401JSONResponse) VisitRefreshCachedDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RefreshCachedDocument422JSONResponse struct{ N422JSONResponse }
+
+func (response RefreshCachedDocument422JSONResponse) VisitRefreshCachedDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RefreshCachedDocument500JSONResponse struct{ N500JSONResponse }
+
+func (response RefreshCachedDocument500JSONResponse) VisitRefreshCachedDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerificationCallbackRequestObject struct {
+	Params VerificationCallbackParams
+	Body   *VerificationCallbackTextRequestBody
+}
+
+type VerificationCallbackResponseObject interface {
+	VisitVerificationCallbackResponse(w http.ResponseWriter) error
+}
+
+type VerificationCallback200JSONResponse VerificationResponse
+
+func (response VerificationCallback200JSONResponse) VisitVerificationCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerificationCallback400JSONResponse struct{ N400JSONResponse }
+
+func (response VerificationCallback400JSONResponse) VisitVerificationCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerificationCallback404JSONResponse struct{ N404JSONResponse }
+
+func (response VerificationCallback404JSONResponse) VisitVerificationCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerificationCallback410JSONResponse struct{ N410JSONResponse }
+
+func (response VerificationCallback410JSONResponse) VisitVerificationCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(410)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerificationCallback500JSONResponse struct{ N500JSONResponse }
+
+func (response VerificationCallback500JSONResponse) VisitVerificationCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClaimsRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Params     GetClaimsParams
+}
+
+type GetClaimsResponseObject interface {
+	VisitGetClaimsResponse(w http.ResponseWriter) error
+}
+
+type GetClaims200JSONResponse GetClaimsResponse
+
+func (response GetClaims200JSONResponse) VisitGetClaimsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClaims400JSONResponse struct{ N400JSONResponse }
+
+func (response GetClaims400JSONResponse) VisitGetClaimsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClaims401JSONResponse struct{ N401JSONResponse }
+
+func (response GetClaims401JSONResponse) VisitGetClaimsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClaims500JSONResponse struct{ N500JSONResponse }
+
+func (response GetClaims500JSONResponse) VisitGetClaimsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateClaimRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Params     CreateClaimParams
+	Body       *CreateClaimJSONRequestBody
+}
+
+type CreateClaimResponseObject interface {
+	VisitCreateClaimResponse(w http.ResponseWriter) error
+}
+
+type CreateClaim201JSONResponse CreateClaimResponse
+
+func (response CreateClaim201JSONResponse) VisitCreateClaimResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateClaim400JSONResponse CredentialErrorMessage
+
+func (response CreateClaim400JSONResponse) VisitCreateClaimResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateClaim401JSONResponse struct{ N401JSONResponse }
+
+func (response CreateClaim401JSONResponse) VisitCreateClaimResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateClaim409JSONResponse struct{ N409JSONResponse }
+
+func (response CreateClaim409JSONResponse) VisitCreateClaimResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateClaim422JSONResponse struct{ N422JSONResponse }
+
+func (response CreateClaim422JSONResponse) VisitCreateClaimResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateClaim500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateClaim500JSONResponse) VisitCreateClaimResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClaimByNonceRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Nonce      PathNonce      `json:"nonce"`
+}
+
+type GetClaimByNonceResponseObject interface {
+	VisitGetClaimByNonceResponse(w http.ResponseWriter) error
+}
+
+type GetClaimByNonce200JSONResponse GetClaimResponse
+
+func (response GetClaimByNonce200JSONResponse) VisitGetClaimByNonceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClaimByNonce400JSONResponse struct{ N400JSONResponse }
+
+func (response GetClaimByNonce400JSONResponse) VisitGetClaimByNonceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClaimByNonce401JSONResponse struct{ N401JSONResponse }
+
+func (response GetClaimByNonce401JSONResponse) VisitGetClaimByNonceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClaimByNonce404JSONResponse struct{ N404JSONResponse }
+
+func (response GetClaimByNonce404JSONResponse) VisitGetClaimByNonceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClaimByNonce500JSONResponse struct{ N500JSONResponse }
+
+func (response GetClaimByNonce500JSONResponse) VisitGetClaimByNonceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRevocationStatusRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Nonce      PathNonce      `json:"nonce"`
+}
+
+type GetRevocationStatusResponseObject interface {
+	VisitGetRevocationStatusResponse(w http.ResponseWriter) error
+}
+
+type GetRevocationStatus200JSONResponse RevocationStatusResponse
+
+func (response GetRevocationStatus200JSONResponse) VisitGetRevocationStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRevocationStatus400JSONResponse struct{ N400JSONResponse }
+
+func (response GetRevocationStatus400JSONResponse) VisitGetRevocationStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRevocationStatus500JSONResponse struct{ N500JSONResponse }
+
+func (response GetRevocationStatus500JSONResponse) VisitGetRevocationStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeClaimRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Nonce      PathNonce      `json:"nonce"`
+	Params     RevokeClaimParams
+}
+
+type RevokeClaimResponseObject interface {
+	VisitRevokeClaimResponse(w http.ResponseWriter) error
+}
+
+type RevokeClaim202JSONResponse RevokeClaimResponse
+
+func (response RevokeClaim202JSONResponse) VisitRevokeClaimResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeClaim400JSONResponse struct{ N400JSONResponse }
+
+func (response RevokeClaim400JSONResponse) VisitRevokeClaimResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeClaim401JSONResponse struct{ N401JSONResponse }
+
+func (response RevokeClaim401JSONResponse) VisitRevokeClaimResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeClaim404JSONResponse struct{ N404JSONResponse }
+
+func (response RevokeClaim404JSONResponse) VisitRevokeClaimResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeClaim409JSONResponse struct{ N409JSONResponse }
+
+func (response RevokeClaim409JSONResponse) VisitRevokeClaimResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeClaim422JSONResponse struct{ N422JSONResponse }
+
+func (response RevokeClaim422JSONResponse) VisitRevokeClaimResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeClaim500JSONResponse struct{ N500JSONResponse }
+
+func (response RevokeClaim500JSONResponse) VisitRevokeClaimResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRevocationListRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Params     GetRevocationListParams
+}
+
+type GetRevocationListResponseObject interface {
+	VisitGetRevocationListResponse(w http.ResponseWriter) error
+}
+
+type GetRevocationList200JSONResponse RevocationList
+
+func (response GetRevocationList200JSONResponse) VisitGetRevocationListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRevocationList400JSONResponse struct{ N400JSONResponse }
+
+func (response GetRevocationList400JSONResponse) VisitGetRevocationListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRevocationList422JSONResponse struct{ N422JSONResponse }
+
+func (response GetRevocationList422JSONResponse) VisitGetRevocationListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRevocationList500JSONResponse struct{ N500JSONResponse }
+
+func (response GetRevocationList500JSONResponse) VisitGetRevocationListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClaimRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         PathClaim      `json:"id"`
+}
+
+type GetClaimResponseObject interface {
+	VisitGetClaimResponse(w http.ResponseWriter) error
+}
+
+type GetClaim200JSONResponse GetClaimResponse
+
+func (response GetClaim200JSONResponse) VisitGetClaimResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClaim400JSONResponse struct{ N400JSONResponse }
+
+func (response GetClaim400JSONResponse) VisitGetClaimResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClaim401JSONResponse struct{ N401JSONResponse }
+
+func (response GetClaim401JSONResponse) VisitGetClaimResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClaim404JSONResponse struct{ N404JSONResponse }
+
+func (response GetClaim404JSONResponse) VisitGetClaimResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClaim500JSONResponse struct{ N500JSONResponse }
+
+func (response GetClaim500JSONResponse) VisitGetClaimResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RenewClaimOfferRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         PathClaim      `json:"id"`
+	Body       *RenewClaimOfferJSONRequestBody
+}
+
+type RenewClaimOfferResponseObject interface {
+	VisitRenewClaimOfferResponse(w http.ResponseWriter) error
+}
+
+type RenewClaimOffer201JSONResponse GetClaimQrCodeResponse
+
+func (response RenewClaimOffer201JSONResponse) VisitRenewClaimOfferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RenewClaimOffer400JSONResponse struct{ N400JSONResponse }
+
+func (response RenewClaimOffer400JSONResponse) VisitRenewClaimOfferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RenewClaimOffer401JSONResponse struct{ N401JSONResponse }
+
+func (response RenewClaimOffer401JSONResponse) VisitRenewClaimOfferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RenewClaimOffer404JSONResponse struct{ N404JSONResponse }
+
+func (response RenewClaimOffer404JSONResponse) VisitRenewClaimOfferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RenewClaimOffer500JSONResponse struct{ N500JSONResponse }
+
+func (response RenewClaimOffer500JSONResponse) VisitRenewClaimOfferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClaimQrCodeRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         PathClaim      `json:"id"`
+}
+
+type GetClaimQrCodeResponseObject interface {
+	VisitGetClaimQrCodeResponse(w http.ResponseWriter) error
+}
+
+type GetClaimQrCode200JSONResponse GetClaimQrCodeResponse
+
+func (response GetClaimQrCode200JSONResponse) VisitGetClaimQrCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClaimQrCode400JSONResponse struct{ N400JSONResponse }
+
+func (response GetClaimQrCode400JSONResponse) VisitGetClaimQrCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClaimQrCode404JSONResponse struct{ N404JSONResponse }
+
+func (response GetClaimQrCode404JSONResponse) VisitGetClaimQrCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClaimQrCode500JSONResponse struct{ N500JSONResponse }
+
+func (response GetClaimQrCode500JSONResponse) VisitGetClaimQrCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClaimWalletLinksRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         PathClaim      `json:"id"`
+}
+
+type GetClaimWalletLinksResponseObject interface {
+	VisitGetClaimWalletLinksResponse(w http.ResponseWriter) error
+}
+
+type GetClaimWalletLinks200JSONResponse WalletLinks
+
+func (response GetClaimWalletLinks200JSONResponse) VisitGetClaimWalletLinksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClaimWalletLinks400JSONResponse struct{ N400JSONResponse }
+
+func (response GetClaimWalletLinks400JSONResponse) VisitGetClaimWalletLinksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClaimWalletLinks404JSONResponse struct{ N404JSONResponse }
+
+func (response GetClaimWalletLinks404JSONResponse) VisitGetClaimWalletLinksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClaimWalletLinks500JSONResponse struct{ N500JSONResponse }
+
+func (response GetClaimWalletLinks500JSONResponse) VisitGetClaimWalletLinksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResolveDIDDocumentRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+}
+
+type ResolveDIDDocumentResponseObject interface {
+	VisitResolveDIDDocumentResponse(w http.ResponseWriter) error
+}
+
+type ResolveDIDDocument200JSONResponse DIDResolution
+
+func (response ResolveDIDDocument200JSONResponse) VisitResolveDIDDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResolveDIDDocument400JSONResponse struct{ N400JSONResponse }
+
+func (response ResolveDIDDocument400JSONResponse) VisitResolveDIDDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResolveDIDDocument404JSONResponse struct{ N404JSONResponse }
+
+func (response ResolveDIDDocument404JSONResponse) VisitResolveDIDDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResolveDIDDocument500JSONResponse struct{ N500JSONResponse }
+
+func (response ResolveDIDDocument500JSONResponse) VisitResolveDIDDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDIDServicesRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+}
+
+type GetDIDServicesResponseObject interface {
+	VisitGetDIDServicesResponse(w http.ResponseWriter) error
+}
+
+type GetDIDServices200JSONResponse []DIDService
+
+func (response GetDIDServices200JSONResponse) VisitGetDIDServicesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDIDServices400JSONResponse struct{ N400JSONResponse }
+
+func (response GetDIDServices400JSONResponse) VisitGetDIDServicesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDIDServices401JSONResponse struct{ N401JSONResponse }
+
+func (response GetDIDServices401JSONResponse) VisitGetDIDServicesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDIDServices404JSONResponse struct{ N404JSONResponse }
+
+func (response GetDIDServices404JSONResponse) VisitGetDIDServicesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDIDServices500JSONResponse struct{ N500JSONResponse }
+
+func (response GetDIDServices500JSONResponse) VisitGetDIDServicesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteDIDServiceRequestObject struct {
+	Identifier PathIdentifier   `json:"identifier"`
+	ServiceId  PathDIDServiceID `json:"serviceId"`
+}
+
+type DeleteDIDServiceResponseObject interface {
+	VisitDeleteDIDServiceResponse(w http.ResponseWriter) error
+}
+
+type DeleteDIDService200JSONResponse GenericMessage
+
+func (response DeleteDIDService200JSONResponse) VisitDeleteDIDServiceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteDIDService400JSONResponse struct{ N400JSONResponse }
+
+func (response DeleteDIDService400JSONResponse) VisitDeleteDIDServiceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteDIDService401JSONResponse struct{ N401JSONResponse }
+
+func (response DeleteDIDService401JSONResponse) VisitDeleteDIDServiceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteDIDService404JSONResponse struct{ N404JSONResponse }
+
+func (response DeleteDIDService404JSONResponse) VisitDeleteDIDServiceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteDIDService500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteDIDService500JSONResponse) VisitDeleteDIDServiceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetDIDServiceRequestObject struct {
+	Identifier PathIdentifier   `json:"identifier"`
+	ServiceId  PathDIDServiceID `json:"serviceId"`
+	Body       *SetDIDServiceJSONRequestBody
+}
+
+type SetDIDServiceResponseObject interface {
+	VisitSetDIDServiceResponse(w http.ResponseWriter) error
+}
+
+type SetDIDService200JSONResponse DIDService
+
+func (response SetDIDService200JSONResponse) VisitSetDIDServiceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetDIDService400JSONResponse struct{ N400JSONResponse }
+
+func (response SetDIDService400JSONResponse) VisitSetDIDServiceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetDIDService401JSONResponse struct{ N401JSONResponse }
+
+func (response SetDIDService401JSONResponse) VisitSetDIDServiceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetDIDService404JSONResponse struct{ N404JSONResponse }
+
+func (response SetDIDService404JSONResponse) VisitSetDIDServiceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetDIDService500JSONResponse struct{ N500JSONResponse }
+
+func (response SetDIDService500JSONResponse) VisitSetDIDServiceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetFeatureFlagsRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+}
+
+type GetFeatureFlagsResponseObject interface {
+	VisitGetFeatureFlagsResponse(w http.ResponseWriter) error
+}
+
+type GetFeatureFlags200JSONResponse []FeatureFlag
+
+func (response GetFeatureFlags200JSONResponse) VisitGetFeatureFlagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetFeatureFlags400JSONResponse struct{ N400JSONResponse }
+
+func (response GetFeatureFlags400JSONResponse) VisitGetFeatureFlagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetFeatureFlags401JSONResponse struct{ N401JSONResponse }
+
+func (response GetFeatureFlags401JSONResponse) VisitGetFeatureFlagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetFeatureFlags500JSONResponse struct{ N500JSONResponse }
+
+func (response GetFeatureFlags500JSONResponse) VisitGetFeatureFlagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResetFeatureFlagRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Feature    PathFeature    `json:"feature"`
+}
+
+type ResetFeatureFlagResponseObject interface {
+	VisitResetFeatureFlagResponse(w http.ResponseWriter) error
+}
+
+type ResetFeatureFlag200JSONResponse FeatureFlag
+
+func (response ResetFeatureFlag200JSONResponse) VisitResetFeatureFlagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResetFeatureFlag400JSONResponse struct{ N400JSONResponse }
+
+func (response ResetFeatureFlag400JSONResponse) VisitResetFeatureFlagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResetFeatureFlag401JSONResponse struct{ N401JSONResponse }
+
+func (response ResetFeatureFlag401JSONResponse) VisitResetFeatureFlagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResetFeatureFlag500JSONResponse struct{ N500JSONResponse }
+
+func (response ResetFeatureFlag500JSONResponse) VisitResetFeatureFlagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetFeatureFlagRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Feature    PathFeature    `json:"feature"`
+	Body       *SetFeatureFlagJSONRequestBody
+}
+
+type SetFeatureFlagResponseObject interface {
+	VisitSetFeatureFlagResponse(w http.ResponseWriter) error
+}
+
+type SetFeatureFlag200JSONResponse FeatureFlag
+
+func (response SetFeatureFlag200JSONResponse) VisitSetFeatureFlagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetFeatureFlag400JSONResponse struct{ N400JSONResponse }
+
+func (response SetFeatureFlag400JSONResponse) VisitSetFeatureFlagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetFeatureFlag401JSONResponse struct{ N401JSONResponse }
+
+func (response SetFeatureFlag401JSONResponse) VisitSetFeatureFlagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetFeatureFlag404JSONResponse struct{ N404JSONResponse }
+
+func (response SetFeatureFlag404JSONResponse) VisitSetFeatureFlagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetFeatureFlag500JSONResponse struct{ N500JSONResponse }
+
+func (response SetFeatureFlag500JSONResponse) VisitSetFeatureFlagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetJWTCredentialKeysRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+}
+
+type GetJWTCredentialKeysResponseObject interface {
+	VisitGetJWTCredentialKeysResponse(w http.ResponseWriter) error
+}
+
+type GetJWTCredentialKeys200JSONResponse JSONWebKeySet
+
+func (response GetJWTCredentialKeys200JSONResponse) VisitGetJWTCredentialKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetJWTCredentialKeys400JSONResponse struct{ N400JSONResponse }
+
+func (response GetJWTCredentialKeys400JSONResponse) VisitGetJWTCredentialKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetJWTCredentialKeys404JSONResponse struct{ N404JSONResponse }
+
+func (response GetJWTCredentialKeys404JSONResponse) VisitGetJWTCredentialKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetJWTCredentialKeys500JSONResponse struct{ N500JSONResponse }
+
+func (response GetJWTCredentialKeys500JSONResponse) VisitGetJWTCredentialKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuthKeysRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+}
+
+type GetAuthKeysResponseObject interface {
+	VisitGetAuthKeysResponse(w http.ResponseWriter) error
+}
+
+type GetAuthKeys200JSONResponse []AuthKey
+
+func (response GetAuthKeys200JSONResponse) VisitGetAuthKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuthKeys400JSONResponse struct{ N400JSONResponse }
+
+func (response GetAuthKeys400JSONResponse) VisitGetAuthKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuthKeys401JSONResponse struct{ N401JSONResponse }
+
+func (response GetAuthKeys401JSONResponse) VisitGetAuthKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuthKeys404JSONResponse struct{ N404JSONResponse }
+
+func (response GetAuthKeys404JSONResponse) VisitGetAuthKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuthKeys500JSONResponse struct{ N500JSONResponse }
+
+func (response GetAuthKeys500JSONResponse) VisitGetAuthKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RotateAuthKeyRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+}
+
+type RotateAuthKeyResponseObject interface {
+	VisitRotateAuthKeyResponse(w http.ResponseWriter) error
+}
+
+type RotateAuthKey202JSONResponse RotateAuthKeyResponse
+
+func (response RotateAuthKey202JSONResponse) VisitRotateAuthKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RotateAuthKey400JSONResponse struct{ N400JSONResponse }
+
+func (response RotateAuthKey400JSONResponse) VisitRotateAuthKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RotateAuthKey401JSONResponse struct{ N401JSONResponse }
+
+func (response RotateAuthKey401JSONResponse) VisitRotateAuthKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RotateAuthKey404JSONResponse struct{ N404JSONResponse }
+
+func (response RotateAuthKey404JSONResponse) VisitRotateAuthKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RotateAuthKey409JSONResponse struct{ N409JSONResponse }
+
+func (response RotateAuthKey409JSONResponse) VisitRotateAuthKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RotateAuthKey500JSONResponse struct{ N500JSONResponse }
+
+func (response RotateAuthKey500JSONResponse) VisitRotateAuthKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAgentMessagesRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Params     GetAgentMessagesParams
+}
+
+type GetAgentMessagesResponseObject interface {
+	VisitGetAgentMessagesResponse(w http.ResponseWriter) error
+}
+
+type GetAgentMessages200JSONResponse []AgentInboxMessage
+
+func (response GetAgentMessages200JSONResponse) VisitGetAgentMessagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAgentMessages400JSONResponse struct{ N400JSONResponse }
+
+func (response GetAgentMessages400JSONResponse) VisitGetAgentMessagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAgentMessages401JSONResponse struct{ N401JSONResponse }
+
+func (response GetAgentMessages401JSONResponse) VisitGetAgentMessagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAgentMessages500JSONResponse struct{ N500JSONResponse }
+
+func (response GetAgentMessages500JSONResponse) VisitGetAgentMessagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAgentMessageEnvelopeRequestObject struct {
+	Identifier PathIdentifier   `json:"identifier"`
+	Id         PathAgentMessage `json:"id"`
+}
+
+type GetAgentMessageEnvelopeResponseObject interface {
+	VisitGetAgentMessageEnvelopeResponse(w http.ResponseWriter) error
+}
+
+type GetAgentMessageEnvelope200ResponseHeaders struct {
+	ContentDisposition string
+}
+
+type GetAgentMessageEnvelope200ApplicationoctetStreamResponse struct {
+	Body          io.Reader
+	Headers       GetAgentMessageEnvelope200ResponseHeaders
+	ContentLength int64
+}
+
+func (response GetAgentMessageEnvelope200ApplicationoctetStreamResponse) VisitGetAgentMessageEnvelopeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.Header().Set("Content-Type", "application/octet-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetAgentMessageEnvelope400JSONResponse struct{ N400JSONResponse }
+
+func (response GetAgentMessageEnvelope400JSONResponse) VisitGetAgentMessageEnvelopeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAgentMessageEnvelope401JSONResponse struct{ N401JSONResponse }
+
+func (response GetAgentMessageEnvelope401JSONResponse) VisitGetAgentMessageEnvelopeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAgentMessageEnvelope404JSONResponse struct{ N404JSONResponse }
+
+func (response GetAgentMessageEnvelope404JSONResponse) VisitGetAgentMessageEnvelopeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAgentMessageEnvelope500JSONResponse struct{ N500JSONResponse }
+
+func (response GetAgentMessageEnvelope500JSONResponse) VisitGetAgentMessageEnvelopeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AbortIdentityMigrationRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+}
+
+type AbortIdentityMigrationResponseObject interface {
+	VisitAbortIdentityMigrationResponse(w http.ResponseWriter) error
+}
+
+type AbortIdentityMigration200JSONResponse GenericMessage
+
+func (response AbortIdentityMigration200JSONResponse) VisitAbortIdentityMigrationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AbortIdentityMigration400JSONResponse struct{ N400JSONResponse }
+
+func (response AbortIdentityMigration400JSONResponse) VisitAbortIdentityMigrationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AbortIdentityMigration401JSONResponse struct{ N401JSONResponse }
+
+func (response AbortIdentityMigration401JSONResponse) VisitAbortIdentityMigrationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AbortIdentityMigration404JSONResponse struct{ N404JSONResponse }
+
+func (response AbortIdentityMigration404JSONResponse) VisitAbortIdentityMigrationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AbortIdentityMigration409JSONResponse struct{ N409JSONResponse }
+
+func (response AbortIdentityMigration409JSONResponse) VisitAbortIdentityMigrationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AbortIdentityMigration500JSONResponse struct{ N500JSONResponse }
+
+func (response AbortIdentityMigration500JSONResponse) VisitAbortIdentityMigrationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetIdentityMigrationRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+}
+
+type GetIdentityMigrationResponseObject interface {
+	VisitGetIdentityMigrationResponse(w http.ResponseWriter) error
+}
+
+type GetIdentityMigration200JSONResponse IdentityMigration
+
+func (response GetIdentityMigration200JSONResponse) VisitGetIdentityMigrationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetIdentityMigration400JSONResponse struct{ N400JSONResponse }
+
+func (response GetIdentityMigration400JSONResponse) VisitGetIdentityMigrationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetIdentityMigration401JSONResponse struct{ N401JSONResponse }
+
+func (response GetIdentityMigration401JSONResponse) VisitGetIdentityMigrationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetIdentityMigration404JSONResponse struct{ N404JSONResponse }
+
+func (response GetIdentityMigration404JSONResponse) VisitGetIdentityMigrationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetIdentityMigration500JSONResponse struct{ N500JSONResponse }
+
+func (response GetIdentityMigration500JSONResponse) VisitGetIdentityMigrationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartIdentityMigrationRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Body       *StartIdentityMigrationJSONRequestBody
+}
+
+type StartIdentityMigrationResponseObject interface {
+	VisitStartIdentityMigrationResponse(w http.ResponseWriter) error
+}
+
+type StartIdentityMigration200JSONResponse IdentityMigration
+
+func (response StartIdentityMigration200JSONResponse) VisitStartIdentityMigrationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartIdentityMigration400JSONResponse struct{ N400JSONResponse }
+
+func (response StartIdentityMigration400JSONResponse) VisitStartIdentityMigrationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartIdentityMigration401JSONResponse struct{ N401JSONResponse }
+
+func (response StartIdentityMigration401JSONResponse) VisitStartIdentityMigrationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartIdentityMigration404JSONResponse struct{ N404JSONResponse }
+
+func (response StartIdentityMigration404JSONResponse) VisitStartIdentityMigrationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartIdentityMigration409JSONResponse struct{ N409JSONResponse }
+
+func (response StartIdentityMigration409JSONResponse) VisitStartIdentityMigrationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartIdentityMigration500JSONResponse struct{ N500JSONResponse }
+
+func (response StartIdentityMigration500JSONResponse) VisitStartIdentityMigrationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CompleteIdentityMigrationRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+}
+
+type CompleteIdentityMigrationResponseObject interface {
+	VisitCompleteIdentityMigrationResponse(w http.ResponseWriter) error
+}
+
+type CompleteIdentityMigration200JSONResponse IdentityMigration
+
+func (response CompleteIdentityMigration200JSONResponse) VisitCompleteIdentityMigrationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CompleteIdentityMigration400JSONResponse struct{ N400JSONResponse }
+
+func (response CompleteIdentityMigration400JSONResponse) VisitCompleteIdentityMigrationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CompleteIdentityMigration401JSONResponse struct{ N401JSONResponse }
+
+func (response CompleteIdentityMigration401JSONResponse) VisitCompleteIdentityMigrationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CompleteIdentityMigration404JSONResponse struct{ N404JSONResponse }
+
+func (response CompleteIdentityMigration404JSONResponse) VisitCompleteIdentityMigrationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CompleteIdentityMigration500JSONResponse struct{ N500JSONResponse }
+
+func (response CompleteIdentityMigration500JSONResponse) VisitCompleteIdentityMigrationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportIdentityRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+}
+
+type ExportIdentityResponseObject interface {
+	VisitExportIdentityResponse(w http.ResponseWriter) error
+}
+
+type ExportIdentity200JSONResponse IdentityExport
+
+func (response ExportIdentity200JSONResponse) VisitExportIdentityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportIdentity400JSONResponse struct{ N400JSONResponse }
+
+func (response ExportIdentity400JSONResponse) VisitExportIdentityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportIdentity401JSONResponse struct{ N401JSONResponse }
+
+func (response ExportIdentity401JSONResponse) VisitExportIdentityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportIdentity404JSONResponse struct{ N404JSONResponse }
+
+func (response ExportIdentity404JSONResponse) VisitExportIdentityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportIdentity409JSONResponse struct{ N409JSONResponse }
+
+func (response ExportIdentity409JSONResponse) VisitExportIdentityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportIdentity500JSONResponse struct{ N500JSONResponse }
+
+func (response ExportIdentity500JSONResponse) VisitExportIdentityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOID4VCIOfferRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Body       *CreateOID4VCIOfferJSONRequestBody
+}
+
+type CreateOID4VCIOfferResponseObject interface {
+	VisitCreateOID4VCIOfferResponse(w http.ResponseWriter) error
+}
+
+type CreateOID4VCIOffer201JSONResponse OID4VCIOffer
+
+func (response CreateOID4VCIOffer201JSONResponse) VisitCreateOID4VCIOfferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOID4VCIOffer400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateOID4VCIOffer400JSONResponse) VisitCreateOID4VCIOfferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOID4VCIOffer401JSONResponse struct{ N401JSONResponse }
+
+func (response CreateOID4VCIOffer401JSONResponse) VisitCreateOID4VCIOfferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOID4VCIOffer404JSONResponse struct{ N404JSONResponse }
+
+func (response CreateOID4VCIOffer404JSONResponse) VisitCreateOID4VCIOfferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOID4VCIOffer500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateOID4VCIOffer500JSONResponse) VisitCreateOID4VCIOfferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResetPublishingPolicyRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+}
+
+type ResetPublishingPolicyResponseObject interface {
+	VisitResetPublishingPolicyResponse(w http.ResponseWriter) error
+}
+
+type ResetPublishingPolicy200JSONResponse PublishingPolicy
+
+func (response ResetPublishingPolicy200JSONResponse) VisitResetPublishingPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResetPublishingPolicy400JSONResponse struct{ N400JSONResponse }
+
+func (response ResetPublishingPolicy400JSONResponse) VisitResetPublishingPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResetPublishingPolicy401JSONResponse struct{ N401JSONResponse }
+
+func (response ResetPublishingPolicy401JSONResponse) VisitResetPublishingPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResetPublishingPolicy500JSONResponse struct{ N500JSONResponse }
+
+func (response ResetPublishingPolicy500JSONResponse) VisitResetPublishingPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPublishingPolicyRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+}
+
+type GetPublishingPolicyResponseObject interface {
+	VisitGetPublishingPolicyResponse(w http.ResponseWriter) error
+}
+
+type GetPublishingPolicy200JSONResponse PublishingPolicy
+
+func (response GetPublishingPolicy200JSONResponse) VisitGetPublishingPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPublishingPolicy400JSONResponse struct{ N400JSONResponse }
+
+func (response GetPublishingPolicy400JSONResponse) VisitGetPublishingPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPublishingPolicy401JSONResponse struct{ N401JSONResponse }
+
+func (response GetPublishingPolicy401JSONResponse) VisitGetPublishingPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPublishingPolicy500JSONResponse struct{ N500JSONResponse }
+
+func (response GetPublishingPolicy500JSONResponse) VisitGetPublishingPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetPublishingPolicyRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Body       *SetPublishingPolicyJSONRequestBody
+}
+
+type SetPublishingPolicyResponseObject interface {
+	VisitSetPublishingPolicyResponse(w http.ResponseWriter) error
+}
+
+type SetPublishingPolicy200JSONResponse PublishingPolicy
+
+func (response SetPublishingPolicy200JSONResponse) VisitSetPublishingPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetPublishingPolicy400JSONResponse struct{ N400JSONResponse }
+
+func (response SetPublishingPolicy400JSONResponse) VisitSetPublishingPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetPublishingPolicy401JSONResponse struct{ N401JSONResponse }
+
+func (response SetPublishingPolicy401JSONResponse) VisitSetPublishingPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetPublishingPolicy404JSONResponse struct{ N404JSONResponse }
+
+func (response SetPublishingPolicy404JSONResponse) VisitSetPublishingPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetPublishingPolicy500JSONResponse struct{ N500JSONResponse }
+
+func (response SetPublishingPolicy500JSONResponse) VisitSetPublishingPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApplyRevocationDecisionsRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Body       *ApplyRevocationDecisionsJSONRequestBody
+}
+
+type ApplyRevocationDecisionsResponseObject interface {
+	VisitApplyRevocationDecisionsResponse(w http.ResponseWriter) error
+}
+
+type ApplyRevocationDecisions200JSONResponse RevocationDecisionsReport
+
+func (response ApplyRevocationDecisions200JSONResponse) VisitApplyRevocationDecisionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApplyRevocationDecisions400JSONResponse struct{ N400JSONResponse }
+
+func (response ApplyRevocationDecisions400JSONResponse) VisitApplyRevocationDecisionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApplyRevocationDecisions401JSONResponse struct{ N401JSONResponse }
+
+func (response ApplyRevocationDecisions401JSONResponse) VisitApplyRevocationDecisionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApplyRevocationDecisions404JSONResponse struct{ N404JSONResponse }
+
+func (response ApplyRevocationDecisions404JSONResponse) VisitApplyRevocationDecisionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApplyRevocationDecisions500JSONResponse struct{ N500JSONResponse }
+
+func (response ApplyRevocationDecisions500JSONResponse) VisitApplyRevocationDecisionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRevocationDecisionsReportRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Params     GetRevocationDecisionsReportParams
+}
+
+type GetRevocationDecisionsReportResponseObject interface {
+	VisitGetRevocationDecisionsReportResponse(w http.ResponseWriter) error
+}
+
+type GetRevocationDecisionsReport200JSONResponse RevocationDecisionsReport
+
+func (response GetRevocationDecisionsReport200JSONResponse) VisitGetRevocationDecisionsReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRevocationDecisionsReport400JSONResponse struct{ N400JSONResponse }
+
+func (response GetRevocationDecisionsReport400JSONResponse) VisitGetRevocationDecisionsReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRevocationDecisionsReport401JSONResponse struct{ N401JSONResponse }
+
+func (response GetRevocationDecisionsReport401JSONResponse) VisitGetRevocationDecisionsReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRevocationDecisionsReport500JSONResponse struct{ N500JSONResponse }
+
+func (response GetRevocationDecisionsReport500JSONResponse) VisitGetRevocationDecisionsReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStateIntegrityRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+}
+
+type GetStateIntegrityResponseObject interface {
+	VisitGetStateIntegrityResponse(w http.ResponseWriter) error
+}
+
+type GetStateIntegrity200JSONResponse StateIntegrityReport
+
+func (response GetStateIntegrity200JSONResponse) VisitGetStateIntegrityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStateIntegrity400JSONResponse struct{ N400JSONResponse }
+
+func (response GetStateIntegrity400JSONResponse) VisitGetStateIntegrityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStateIntegrity401JSONResponse struct{ N401JSONResponse }
+
+func (response GetStateIntegrity401JSONResponse) VisitGetStateIntegrityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStateIntegrity404JSONResponse struct{ N404JSONResponse }
+
+func (response GetStateIntegrity404JSONResponse) VisitGetStateIntegrityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStateIntegrity500JSONResponse struct{ N500JSONResponse }
+
+func (response GetStateIntegrity500JSONResponse) VisitGetStateIntegrityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PublishIdentityStateRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+}
+
+type PublishIdentityStateResponseObject interface {
+	VisitPublishIdentityStateResponse(w http.ResponseWriter) error
+}
+
+type PublishIdentityState200JSONResponse GenericErrorMessage
+
+func (response PublishIdentityState200JSONResponse) VisitPublishIdentityStateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PublishIdentityState202JSONResponse PublishIdentityStateResponse
+
+func (response PublishIdentityState202JSONResponse) VisitPublishIdentityStateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PublishIdentityState400JSONResponse struct{ N400JSONResponse }
+
+func (response PublishIdentityState400JSONResponse) VisitPublishIdentityStateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PublishIdentityState401JSONResponse struct{ N401JSONResponse }
+
+func (response PublishIdentityState401JSONResponse) VisitPublishIdentityStateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PublishIdentityState500JSONResponse struct{ N500JSONResponse }
+
+func (response PublishIdentityState500JSONResponse) VisitPublishIdentityStateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStateTransactionsRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+}
+
+type GetStateTransactionsResponseObject interface {
+	VisitGetStateTransactionsResponse(w http.ResponseWriter) error
+}
+
+type GetStateTransactions200JSONResponse []StateTransaction
+
+func (response GetStateTransactions200JSONResponse) VisitGetStateTransactionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStateTransactions400JSONResponse struct{ N400JSONResponse }
+
+func (response GetStateTransactions400JSONResponse) VisitGetStateTransactionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStateTransactions401JSONResponse struct{ N401JSONResponse }
+
+func (response GetStateTransactions401JSONResponse) VisitGetStateTransactionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStateTransactions500JSONResponse struct{ N500JSONResponse }
+
+func (response GetStateTransactions500JSONResponse) VisitGetStateTransactionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatusListCredentialRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+}
+
+type GetStatusListCredentialResponseObject interface {
+	VisitGetStatusListCredentialResponse(w http.ResponseWriter) error
+}
+
+type GetStatusListCredential200ApplicationvcJwtResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetStatusListCredential200ApplicationvcJwtResponse) VisitGetStatusListCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/vc+jwt")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetStatusListCredential400JSONResponse struct{ N400JSONResponse }
+
+func (response GetStatusListCredential400JSONResponse) VisitGetStatusListCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatusListCredential404JSONResponse struct{ N404JSONResponse }
+
+func (response GetStatusListCredential404JSONResponse) VisitGetStatusListCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatusListCredential500JSONResponse struct{ N500JSONResponse }
+
+func (response GetStatusListCredential500JSONResponse) VisitGetStatusListCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateVerificationRequestRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Body       *CreateVerificationRequestJSONRequestBody
+}
+
+type CreateVerificationRequestResponseObject interface {
+	VisitCreateVerificationRequestResponse(w http.ResponseWriter) error
+}
+
+type CreateVerificationRequest201JSONResponse VerificationRequest
+
+func (response CreateVerificationRequest201JSONResponse) VisitCreateVerificationRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateVerificationRequest400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateVerificationRequest400JSONResponse) VisitCreateVerificationRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateVerificationRequest401JSONResponse struct{ N401JSONResponse }
+
+func (response CreateVerificationRequest401JSONResponse) VisitCreateVerificationRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateVerificationRequest404JSONResponse struct{ N404JSONResponse }
+
+func (response CreateVerificationRequest404JSONResponse) VisitCreateVerificationRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateVerificationRequest500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateVerificationRequest500JSONResponse) VisitCreateVerificationRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVerificationRequestRequestObject struct {
+	Identifier PathIdentifier          `json:"identifier"`
+	Id         PathVerificationRequest `json:"id"`
+}
+
+type GetVerificationRequestResponseObject interface {
+	VisitGetVerificationRequestResponse(w http.ResponseWriter) error
+}
+
+type GetVerificationRequest200JSONResponse VerificationRequest
+
+func (response GetVerificationRequest200JSONResponse) VisitGetVerificationRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVerificationRequest400JSONResponse struct{ N400JSONResponse }
+
+func (response GetVerificationRequest400JSONResponse) VisitGetVerificationRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVerificationRequest401JSONResponse struct{ N401JSONResponse }
+
+func (response GetVerificationRequest401JSONResponse) VisitGetVerificationRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVerificationRequest404JSONResponse struct{ N404JSONResponse }
+
+func (response GetVerificationRequest404JSONResponse) VisitGetVerificationRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVerificationRequest500JSONResponse struct{ N500JSONResponse }
+
+func (response GetVerificationRequest500JSONResponse) VisitGetVerificationRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVerificationRequestQrCodeRequestObject struct {
+	Identifier PathIdentifier          `json:"identifier"`
+	Id         PathVerificationRequest `json:"id"`
+}
+
+type GetVerificationRequestQrCodeResponseObject interface {
+	VisitGetVerificationRequestQrCodeResponse(w http.ResponseWriter) error
+}
+
+type GetVerificationRequestQrCode200JSONResponse AuthorizationRequest
+
+func (response GetVerificationRequestQrCode200JSONResponse) VisitGetVerificationRequestQrCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVerificationRequestQrCode400JSONResponse struct{ N400JSONResponse }
+
+func (response GetVerificationRequestQrCode400JSONResponse) VisitGetVerificationRequestQrCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVerificationRequestQrCode401JSONResponse struct{ N401JSONResponse }
+
+func (response GetVerificationRequestQrCode401JSONResponse) VisitGetVerificationRequestQrCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVerificationRequestQrCode404JSONResponse struct{ N404JSONResponse }
+
+func (response GetVerificationRequestQrCode404JSONResponse) VisitGetVerificationRequestQrCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVerificationRequestQrCode410JSONResponse struct{ N410JSONResponse }
+
+func (response GetVerificationRequestQrCode410JSONResponse) VisitGetVerificationRequestQrCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(410)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVerificationRequestQrCode500JSONResponse struct{ N500JSONResponse }
+
+func (response GetVerificationRequestQrCode500JSONResponse) VisitGetVerificationRequestQrCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVerificationResponsesRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Params     GetVerificationResponsesParams
+}
+
+type GetVerificationResponsesResponseObject interface {
+	VisitGetVerificationResponsesResponse(w http.ResponseWriter) error
+}
+
+type GetVerificationResponses200JSONResponse []VerificationResponse
+
+func (response GetVerificationResponses200JSONResponse) VisitGetVerificationResponsesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVerificationResponses400JSONResponse struct{ N400JSONResponse }
+
+func (response GetVerificationResponses400JSONResponse) VisitGetVerificationResponsesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVerificationResponses401JSONResponse struct{ N401JSONResponse }
+
+func (response GetVerificationResponses401JSONResponse) VisitGetVerificationResponsesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVerificationResponses500JSONResponse struct{ N500JSONResponse }
+
+func (response GetVerificationResponses500JSONResponse) VisitGetVerificationResponsesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHeldCredentialsRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Params     GetHeldCredentialsParams
+}
+
+type GetHeldCredentialsResponseObject interface {
+	VisitGetHeldCredentialsResponse(w http.ResponseWriter) error
+}
+
+type GetHeldCredentials200JSONResponse GetHeldCredentialsResponse
+
+func (response GetHeldCredentials200JSONResponse) VisitGetHeldCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHeldCredentials400JSONResponse struct{ N400JSONResponse }
+
+func (response GetHeldCredentials400JSONResponse) VisitGetHeldCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHeldCredentials401JSONResponse struct{ N401JSONResponse }
+
+func (response GetHeldCredentials401JSONResponse) VisitGetHeldCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHeldCredentials500JSONResponse struct{ N500JSONResponse }
+
+func (response GetHeldCredentials500JSONResponse) VisitGetHeldCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteHeldCredentialRequestObject struct {
+	Identifier PathIdentifier     `json:"identifier"`
+	Id         PathHeldCredential `json:"id"`
+}
+
+type DeleteHeldCredentialResponseObject interface {
+	VisitDeleteHeldCredentialResponse(w http.ResponseWriter) error
+}
+
+type DeleteHeldCredential200JSONResponse GenericErrorMessage
+
+func (response DeleteHeldCredential200JSONResponse) VisitDeleteHeldCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteHeldCredential400JSONResponse struct{ N400JSONResponse }
+
+func (response DeleteHeldCredential400JSONResponse) VisitDeleteHeldCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteHeldCredential401JSONResponse struct{ N401JSONResponse }
+
+func (response DeleteHeldCredential401JSONResponse) VisitDeleteHeldCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteHeldCredential404JSONResponse struct{ N404JSONResponse }
+
+func (response DeleteHeldCredential404JSONResponse) VisitDeleteHeldCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteHeldCredential500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteHeldCredential500JSONResponse) VisitDeleteHeldCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHeldCredentialRequestObject struct {
+	Identifier PathIdentifier     `json:"identifier"`
+	Id         PathHeldCredential `json:"id"`
+}
+
+type GetHeldCredentialResponseObject interface {
+	VisitGetHeldCredentialResponse(w http.ResponseWriter) error
+}
+
+type GetHeldCredential200JSONResponse HeldCredential
+
+func (response GetHeldCredential200JSONResponse) VisitGetHeldCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHeldCredential400JSONResponse struct{ N400JSONResponse }
+
+func (response GetHeldCredential400JSONResponse) VisitGetHeldCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHeldCredential401JSONResponse struct{ N401JSONResponse }
+
+func (response GetHeldCredential401JSONResponse) VisitGetHeldCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHeldCredential404JSONResponse struct{ N404JSONResponse }
+
+func (response GetHeldCredential404JSONResponse) VisitGetHeldCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHeldCredential500JSONResponse struct{ N500JSONResponse }
+
+func (response GetHeldCredential500JSONResponse) VisitGetHeldCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptCredentialOfferRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Body       *AcceptCredentialOfferJSONRequestBody
+}
+
+type AcceptCredentialOfferResponseObject interface {
+	VisitAcceptCredentialOfferResponse(w http.ResponseWriter) error
+}
+
+type AcceptCredentialOffer201JSONResponse GetHeldCredentialsResponse
+
+func (response AcceptCredentialOffer201JSONResponse) VisitAcceptCredentialOfferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptCredentialOffer400JSONResponse struct{ N400JSONResponse }
+
+func (response AcceptCredentialOffer400JSONResponse) VisitAcceptCredentialOfferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptCredentialOffer401JSONResponse struct{ N401JSONResponse }
+
+func (response AcceptCredentialOffer401JSONResponse) VisitAcceptCredentialOfferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptCredentialOffer404JSONResponse struct{ N404JSONResponse }
+
+func (response AcceptCredentialOffer404JSONResponse) VisitAcceptCredentialOfferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptCredentialOffer500JSONResponse struct{ N500JSONResponse }
+
+func (response AcceptCredentialOffer500JSONResponse) VisitAcceptCredentialOfferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PresentCredentialsRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Body       *PresentCredentialsJSONRequestBody
+}
+
+type PresentCredentialsResponseObject interface {
+	VisitPresentCredentialsResponse(w http.ResponseWriter) error
+}
+
+type PresentCredentials200JSONResponse GenericErrorMessage
+
+func (response PresentCredentials200JSONResponse) VisitPresentCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PresentCredentials400JSONResponse struct{ N400JSONResponse }
+
+func (response PresentCredentials400JSONResponse) VisitPresentCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PresentCredentials401JSONResponse struct{ N401JSONResponse }
+
+func (response PresentCredentials401JSONResponse) VisitPresentCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PresentCredentials404JSONResponse struct{ N404JSONResponse }
+
+func (response PresentCredentials404JSONResponse) VisitPresentCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PresentCredentials500JSONResponse struct{ N500JSONResponse }
+
+func (response PresentCredentials500JSONResponse) VisitPresentCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Get the documentation
+	// (GET /)
+	GetDocumentation(ctx context.Context, request GetDocumentationRequestObject) (GetDocumentationResponseObject, error)
+	// Agent Capabilities
+	// (GET /.well-known/agent-capabilities)
+	GetAgentCapabilities(ctx context.Context, request GetAgentCapabilitiesRequestObject) (GetAgentCapabilitiesResponseObject, error)
+	// OpenID4VCI Issuer Metadata
+	// (GET /.well-known/openid-credential-issuer)
+	GetOID4VCIIssuerMetadata(ctx context.Context, request GetOID4VCIIssuerMetadataRequestObject) (GetOID4VCIIssuerMetadataResponseObject, error)
+	// Gets the favicon
+	// (GET /favicon.ico)
+	GetFavicon(ctx context.Context, request GetFaviconRequestObject) (GetFaviconResponseObject, error)
+	// Get the documentation yaml file
+	// (GET /static/docs/api/api.yaml)
+	GetYaml(ctx context.Context, request GetYamlRequestObject) (GetYamlResponseObject, error)
+	// Healthcheck
+	// (GET /status)
+	Health(ctx context.Context, request HealthRequestObject) (HealthResponseObject, error)
+	// Export Issuer
+	// (POST /v1/admin/export)
+	ExportIssuer(ctx context.Context, request ExportIssuerRequestObject) (ExportIssuerResponseObject, error)
+	// Reset Settings
+	// (DELETE /v1/admin/settings)
+	ResetSettings(ctx context.Context, request ResetSettingsRequestObject) (ResetSettingsResponseObject, error)
+	// Get Settings
+	// (GET /v1/admin/settings)
+	GetSettings(ctx context.Context, request GetSettingsRequestObject) (GetSettingsResponseObject, error)
+	// Update Settings
+	// (PATCH /v1/admin/settings)
+	UpdateSettings(ctx context.Context, request UpdateSettingsRequestObject) (UpdateSettingsResponseObject, error)
+	// Agent
+	// (POST /v1/agent)
+	Agent(ctx context.Context, request AgentRequestObject) (AgentResponseObject, error)
+	// Get Identities
+	// (GET /v1/identities)
+	GetIdentities(ctx context.Context, request GetIdentitiesRequestObject) (GetIdentitiesResponseObject, error)
+	// Create Identity
+	// (POST /v1/identities)
+	CreateIdentity(ctx context.Context, request CreateIdentityRequestObject) (CreateIdentityResponseObject, error)
+	// Import Identity
+	// (POST /v1/migration/import)
+	ImportIdentity(ctx context.Context, request ImportIdentityRequestObject) (ImportIdentityResponseObject, error)
+	// OpenID4VCI Credential
+	// (POST /v1/oid4vci/credential)
+	OID4VCICredential(ctx context.Context, request OID4VCICredentialRequestObject) (OID4VCICredentialResponseObject, error)
+	// OpenID4VCI Token
+	// (POST /v1/oid4vci/token)
+	OID4VCIToken(ctx context.Context, request OID4VCITokenRequestObject) (OID4VCITokenResponseObject, error)
+	// Purge Cached Document
+	// (DELETE /v1/schema-cache)
+	PurgeCachedDocument(ctx context.Context, request PurgeCachedDocumentRequestObject) (PurgeCachedDocumentResponseObject, error)
+	// Refresh Cached Document
+	// (POST /v1/schema-cache/refresh)
+	RefreshCachedDocument(ctx context.Context, request RefreshCachedDocumentRequestObject) (RefreshCachedDocumentResponseObject, error)
+	// Verification Callback
+	// (POST /v1/verification/callback)
+	VerificationCallback(ctx context.Context, request VerificationCallbackRequestObject) (VerificationCallbackResponseObject, error)
+	// Get Claims
+	// (GET /v1/{identifier}/claims)
+	GetClaims(ctx context.Context, request GetClaimsRequestObject) (GetClaimsResponseObject, error)
+	// Create Claim
+	// (POST /v1/{identifier}/claims)
+	CreateClaim(ctx context.Context, request CreateClaimRequestObject) (CreateClaimResponseObject, error)
+	// Get Claim By Revocation Nonce
+	// (GET /v1/{identifier}/claims/nonce/{nonce})
+	GetClaimByNonce(ctx context.Context, request GetClaimByNonceRequestObject) (GetClaimByNonceResponseObject, error)
+	// Get Revocation Status
+	// (GET /v1/{identifier}/claims/revocation/status/{nonce})
+	GetRevocationStatus(ctx context.Context, request GetRevocationStatusRequestObject) (GetRevocationStatusResponseObject, error)
+	// Revoke Claim
+	// (POST /v1/{identifier}/claims/revoke/{nonce})
+	RevokeClaim(ctx context.Context, request RevokeClaimRequestObject) (RevokeClaimResponseObject, error)
+	// Get Revocation List
+	// (GET /v1/{identifier}/claims/revoked)
+	GetRevocationList(ctx context.Context, request GetRevocationListRequestObject) (GetRevocationListResponseObject, error)
+	// Get Claim
+	// (GET /v1/{identifier}/claims/{id})
+	GetClaim(ctx context.Context, request GetClaimRequestObject) (GetClaimResponseObject, error)
+	// Renew Claim Offer
+	// (POST /v1/{identifier}/claims/{id}/offer)
+	RenewClaimOffer(ctx context.Context, request RenewClaimOfferRequestObject) (RenewClaimOfferResponseObject, error)
+	// Get Claim QR code
+	// (GET /v1/{identifier}/claims/{id}/qrcode)
+	GetClaimQrCode(ctx context.Context, request GetClaimQrCodeRequestObject) (GetClaimQrCodeResponseObject, error)
+	// Get Claim Wallet Links
+	// (GET /v1/{identifier}/claims/{id}/wallet-links)
+	GetClaimWalletLinks(ctx context.Context, request GetClaimWalletLinksRequestObject) (GetClaimWalletLinksResponseObject, error)
+	// Resolve DID Document
+	// (GET /v1/{identifier}/did-document)
+	ResolveDIDDocument(ctx context.Context, request ResolveDIDDocumentRequestObject) (ResolveDIDDocumentResponseObject, error)
+	// Get DID Services
+	// (GET /v1/{identifier}/did-document/services)
+	GetDIDServices(ctx context.Context, request GetDIDServicesRequestObject) (GetDIDServicesResponseObject, error)
+	// Delete DID Service
+	// (DELETE /v1/{identifier}/did-document/services/{serviceId})
+	DeleteDIDService(ctx context.Context, request DeleteDIDServiceRequestObject) (DeleteDIDServiceResponseObject, error)
+	// Set DID Service
+	// (PUT /v1/{identifier}/did-document/services/{serviceId})
+	SetDIDService(ctx context.Context, request SetDIDServiceRequestObject) (SetDIDServiceResponseObject, error)
+	// Get Feature Flags
+	// (GET /v1/{identifier}/features)
+	GetFeatureFlags(ctx context.Context, request GetFeatureFlagsRequestObject) (GetFeatureFlagsResponseObject, error)
+	// Reset Feature Flag
+	// (DELETE /v1/{identifier}/features/{feature})
+	ResetFeatureFlag(ctx context.Context, request ResetFeatureFlagRequestObject) (ResetFeatureFlagResponseObject, error)
+	// Set Feature Flag
+	// (PUT /v1/{identifier}/features/{feature})
+	SetFeatureFlag(ctx context.Context, request SetFeatureFlagRequestObject) (SetFeatureFlagResponseObject, error)
+	// Get JWT Credential Keys
+	// (GET /v1/{identifier}/jwks)
+	GetJWTCredentialKeys(ctx context.Context, request GetJWTCredentialKeysRequestObject) (GetJWTCredentialKeysResponseObject, error)
+	// Get Auth Keys
+	// (GET /v1/{identifier}/keys)
+	GetAuthKeys(ctx context.Context, request GetAuthKeysRequestObject) (GetAuthKeysResponseObject, error)
+	// Rotate Auth Key
+	// (POST /v1/{identifier}/keys)
+	RotateAuthKey(ctx context.Context, request RotateAuthKeyRequestObject) (RotateAuthKeyResponseObject, error)
+	// Get Agent Messages
+	// (GET /v1/{identifier}/messages)
+	GetAgentMessages(ctx context.Context, request GetAgentMessagesRequestObject) (GetAgentMessagesResponseObject, error)
+	// Get Agent Message Envelope
+	// (GET /v1/{identifier}/messages/{id}/envelope)
+	GetAgentMessageEnvelope(ctx context.Context, request GetAgentMessageEnvelopeRequestObject) (GetAgentMessageEnvelopeResponseObject, error)
+	// Abort Identity Migration
+	// (DELETE /v1/{identifier}/migration)
+	AbortIdentityMigration(ctx context.Context, request AbortIdentityMigrationRequestObject) (AbortIdentityMigrationResponseObject, error)
+	// Get Identity Migration
+	// (GET /v1/{identifier}/migration)
+	GetIdentityMigration(ctx context.Context, request GetIdentityMigrationRequestObject) (GetIdentityMigrationResponseObject, error)
+	// Start Identity Migration
+	// (POST /v1/{identifier}/migration)
+	StartIdentityMigration(ctx context.Context, request StartIdentityMigrationRequestObject) (StartIdentityMigrationResponseObject, error)
+	// Complete Identity Migration
+	// (POST /v1/{identifier}/migration/complete)
+	CompleteIdentityMigration(ctx context.Context, request CompleteIdentityMigrationRequestObject) (CompleteIdentityMigrationResponseObject, error)
+	// Export Identity
+	// (GET /v1/{identifier}/migration/export)
+	ExportIdentity(ctx context.Context, request ExportIdentityRequestObject) (ExportIdentityResponseObject, error)
+	// Create OpenID4VCI Offer
+	// (POST /v1/{identifier}/oid4vci/offers)
+	CreateOID4VCIOffer(ctx context.Context, request CreateOID4VCIOfferRequestObject) (CreateOID4VCIOfferResponseObject, error)
+	// Reset Publishing Policy
+	// (DELETE /v1/{identifier}/publishing-policy)
+	ResetPublishingPolicy(ctx context.Context, request ResetPublishingPolicyRequestObject) (ResetPublishingPolicyResponseObject, error)
+	// Get Publishing Policy
+	// (GET /v1/{identifier}/publishing-policy)
+	GetPublishingPolicy(ctx context.Context, request GetPublishingPolicyRequestObject) (GetPublishingPolicyResponseObject, error)
+	// Set Publishing Policy
+	// (PUT /v1/{identifier}/publishing-policy)
+	SetPublishingPolicy(ctx context.Context, request SetPublishingPolicyRequestObject) (SetPublishingPolicyResponseObject, error)
+	// Apply Revocation Decisions
+	// (POST /v1/{identifier}/revocation-decisions)
+	ApplyRevocationDecisions(ctx context.Context, request ApplyRevocationDecisionsRequestObject) (ApplyRevocationDecisionsResponseObject, error)
+	// Get Revocation Decisions Report
+	// (GET /v1/{identifier}/revocation-decisions/report)
+	GetRevocationDecisionsReport(ctx context.Context, request GetRevocationDecisionsReportRequestObject) (GetRevocationDecisionsReportResponseObject, error)
+	// Verify Identity Trees
+	// (GET /v1/{identifier}/state/integrity)
+	GetStateIntegrity(ctx context.Context, request GetStateIntegrityRequestObject) (GetStateIntegrityResponseObject, error)
+	// Publish Identity State
+	// (POST /v1/{identifier}/state/publish)
+	PublishIdentityState(ctx context.Context, request PublishIdentityStateRequestObject) (PublishIdentityStateResponseObject, error)
+	// Get State Transactions
+	// (GET /v1/{identifier}/state/transactions)
+	GetStateTransactions(ctx context.Context, request GetStateTransactionsRequestObject) (GetStateTransactionsResponseObject, error)
+	// Get Status List Credential
+	// (GET /v1/{identifier}/status-list)
+	GetStatusListCredential(ctx context.Context, request GetStatusListCredentialRequestObject) (GetStatusListCredentialResponseObject, error)
+	// Create Verification Request
+	// (POST /v1/{identifier}/verification/requests)
+	CreateVerificationRequest(ctx context.Context, request CreateVerificationRequestRequestObject) (CreateVerificationRequestResponseObject, error)
+	// Get Verification Request
+	// (GET /v1/{identifier}/verification/requests/{id})
+	GetVerificationRequest(ctx context.Context, request GetVerificationRequestRequestObject) (GetVerificationRequestResponseObject, error)
+	// Get Verification Request QR Code
+	// (GET /v1/{identifier}/verification/requests/{id}/qrcode)
+	GetVerificationRequestQrCode(ctx context.Context, request GetVerificationRequestQrCodeRequestObject) (GetVerificationRequestQrCodeResponseObject, error)
+	// Get Verification Responses
+	// (GET /v1/{identifier}/verification/responses)
+	GetVerificationResponses(ctx context.Context, request GetVerificationResponsesRequestObject) (GetVerificationResponsesResponseObject, error)
+	// Get Held Credentials
+	// (GET /v1/{identifier}/wallet/credentials)
+	GetHeldCredentials(ctx context.Context, request GetHeldCredentialsRequestObject) (GetHeldCredentialsResponseObject, error)
+	// Delete Held Credential
+	// (DELETE /v1/{identifier}/wallet/credentials/{id})
+	DeleteHeldCredential(ctx context.Context, request DeleteHeldCredentialRequestObject) (DeleteHeldCredentialResponseObject, error)
+	// Get Held Credential
+	// (GET /v1/{identifier}/wallet/credentials/{id})
+	GetHeldCredential(ctx context.Context, request GetHeldCredentialRequestObject) (GetHeldCredentialResponseObject, error)
+	// Accept Credential Offer
+	// (POST /v1/{identifier}/wallet/offers)
+	AcceptCredentialOffer(ctx context.Context, request AcceptCredentialOfferRequestObject) (AcceptCredentialOfferResponseObject, error)
+	// Present Credentials
+	// (POST /v1/{identifier}/wallet/presentations)
+	PresentCredentials(ctx context.Context, request PresentCredentialsRequestObject) (PresentCredentialsResponseObject, error)
+}
+
+type StrictHandlerFunc func(ctx context.Context, w http.ResponseWriter, r *http.Request, args interface{}) (interface{}, error)
+
+type StrictMiddlewareFunc func(f StrictHandlerFunc, operationID string) StrictHandlerFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// GetDocumentation operation middleware
+func (sh *strictHandler) GetDocumentation(w http.ResponseWriter, r *http.Request) {
+	var request GetDocumentationRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDocumentation(ctx, request.(GetDocumentationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDocumentation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetDocumentationResponseObject); ok {
+		if err := validResponse.VisitGetDocumentationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// GetAgentCapabilities operation middleware
+func (sh *strictHandler) GetAgentCapabilities(w http.ResponseWriter, r *http.Request) {
+	var request GetAgentCapabilitiesRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAgentCapabilities(ctx, request.(GetAgentCapabilitiesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAgentCapabilities")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAgentCapabilitiesResponseObject); ok {
+		if err := validResponse.VisitGetAgentCapabilitiesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// GetOID4VCIIssuerMetadata operation middleware
+func (sh *strictHandler) GetOID4VCIIssuerMetadata(w http.ResponseWriter, r *http.Request) {
+	var request GetOID4VCIIssuerMetadataRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetOID4VCIIssuerMetadata(ctx, request.(GetOID4VCIIssuerMetadataRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetOID4VCIIssuerMetadata")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetOID4VCIIssuerMetadataResponseObject); ok {
+		if err := validResponse.VisitGetOID4VCIIssuerMetadataResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// GetFavicon operation middleware
+func (sh *strictHandler) GetFavicon(w http.ResponseWriter, r *http.Request) {
+	var request GetFaviconRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetFavicon(ctx, request.(GetFaviconRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetFavicon")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetFaviconResponseObject); ok {
+		if err := validResponse.VisitGetFaviconResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// GetYaml operation middleware
+func (sh *strictHandler) GetYaml(w http.ResponseWriter, r *http.Request) {
+	var request GetYamlRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetYaml(ctx, request.(GetYamlRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetYaml")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetYamlResponseObject); ok {
+		if err := validResponse.VisitGetYamlResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// Health operation middleware
+func (sh *strictHandler) Health(w http.ResponseWriter, r *http.Request) {
+	var request HealthRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.Health(ctx, request.(HealthRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "Health")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(HealthResponseObject); ok {
+		if err := validResponse.VisitHealthResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// ExportIssuer operation middleware
+func (sh *strictHandler) ExportIssuer(w http.ResponseWriter, r *http.Request) {
+	var request ExportIssuerRequestObject
+
+	var body ExportIssuerJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ExportIssuer(ctx, request.(ExportIssuerRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ExportIssuer")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ExportIssuerResponseObject); ok {
+		if err := validResponse.VisitExportIssuerResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// ResetSettings operation middleware
+func (sh *strictHandler) ResetSettings(w http.ResponseWriter, r *http.Request) {
+	var request ResetSettingsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ResetSettings(ctx, request.(ResetSettingsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ResetSettings")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ResetSettingsResponseObject); ok {
+		if err := validResponse.VisitResetSettingsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// GetSettings operation middleware
+func (sh *strictHandler) GetSettings(w http.ResponseWriter, r *http.Request) {
+	var request GetSettingsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSettings(ctx, request.(GetSettingsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSettings")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetSettingsResponseObject); ok {
+		if err := validResponse.VisitGetSettingsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// UpdateSettings operation middleware
+func (sh *strictHandler) UpdateSettings(w http.ResponseWriter, r *http.Request) {
+	var request UpdateSettingsRequestObject
+
+	var body UpdateSettingsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateSettings(ctx, request.(UpdateSettingsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateSettings")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateSettingsResponseObject); ok {
+		if err := validResponse.VisitUpdateSettingsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// Agent operation middleware
+func (sh *strictHandler) Agent(w http.ResponseWriter, r *http.Request) {
+	var request AgentRequestObject
 
-func (response Health200JSONResponse) VisitHealthResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't read body: %w", err))
+		return
+	}
+	body := AgentTextRequestBody(data)
+	request.Body = &body
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.Agent(ctx, request.(AgentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "Agent")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AgentResponseObject); ok {
+		if err := validResponse.VisitAgentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
 }
 
-type Health500JSONResponse struct{ N500JSONResponse }
+// GetIdentities operation middleware
+func (sh *strictHandler) GetIdentities(w http.ResponseWriter, r *http.Request) {
+	var request GetIdentitiesRequestObject
 
-func (response Health500JSONResponse) VisitHealthResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetIdentities(ctx, request.(GetIdentitiesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetIdentities")
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetIdentitiesResponseObject); ok {
+		if err := validResponse.VisitGetIdentitiesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
 }
 
-type AgentRequestObject struct {
-	Body *AgentTextRequestBody
+// CreateIdentity operation middleware
+func (sh *strictHandler) CreateIdentity(w http.ResponseWriter, r *http.Request) {
+	var request CreateIdentityRequestObject
+
+	var body CreateIdentityJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateIdentity(ctx, request.(CreateIdentityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateIdentity")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateIdentityResponseObject); ok {
+		if err := validResponse.VisitCreateIdentityResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
 }
 
-type AgentResponseObject interface {
-	VisitAgentResponse(w http.ResponseWriter) error
+// ImportIdentity operation middleware
+func (sh *strictHandler) ImportIdentity(w http.ResponseWriter, r *http.Request) {
+	var request ImportIdentityRequestObject
+
+	var body ImportIdentityJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ImportIdentity(ctx, request.(ImportIdentityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ImportIdentity")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ImportIdentityResponseObject); ok {
+		if err := validResponse.VisitImportIdentityResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
 }
 
-type Agent200JSONResponse AgentResponse
+// OID4VCICredential operation middleware
+func (sh *strictHandler) OID4VCICredential(w http.ResponseWriter, r *http.Request, params OID4VCICredentialParams) {
+	var request OID4VCICredentialRequestObject
+
+	request.Params = params
+
+	var body OID4VCICredentialJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.OID4VCICredential(ctx, request.(OID4VCICredentialRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "OID4VCICredential")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(OID4VCICredentialResponseObject); ok {
+		if err := validResponse.VisitOID4VCICredentialResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// OID4VCIToken operation middleware
+func (sh *strictHandler) OID4VCIToken(w http.ResponseWriter, r *http.Request) {
+	var request OID4VCITokenRequestObject
+
+	if err := r.ParseForm(); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode formdata: %w", err))
+		return
+	}
+	var body OID4VCITokenFormdataRequestBody
+	if err := runtime.BindForm(&body, r.Form, nil, nil); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't bind formdata: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.OID4VCIToken(ctx, request.(OID4VCITokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "OID4VCIToken")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(OID4VCITokenResponseObject); ok {
+		if err := validResponse.VisitOID4VCITokenResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// PurgeCachedDocument operation middleware
+func (sh *strictHandler) PurgeCachedDocument(w http.ResponseWriter, r *http.Request, params PurgeCachedDocumentParams) {
+	var request PurgeCachedDocumentRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PurgeCachedDocument(ctx, request.(PurgeCachedDocumentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PurgeCachedDocument")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PurgeCachedDocumentResponseObject); ok {
+		if err := validResponse.VisitPurgeCachedDocumentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// RefreshCachedDocument operation middleware
+func (sh *strictHandler) RefreshCachedDocument(w http.ResponseWriter, r *http.Request) {
+	var request RefreshCachedDocumentRequestObject
+
+	var body RefreshCachedDocumentJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RefreshCachedDocument(ctx, request.(RefreshCachedDocumentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RefreshCachedDocument")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RefreshCachedDocumentResponseObject); ok {
+		if err := validResponse.VisitRefreshCachedDocumentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// VerificationCallback operation middleware
+func (sh *strictHandler) VerificationCallback(w http.ResponseWriter, r *http.Request, params VerificationCallbackParams) {
+	var request VerificationCallbackRequestObject
+
+	request.Params = params
+
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't read body: %w", err))
+		return
+	}
+	body := VerificationCallbackTextRequestBody(data)
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.VerificationCallback(ctx, request.(VerificationCallbackRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "VerificationCallback")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(VerificationCallbackResponseObject); ok {
+		if err := validResponse.VisitVerificationCallbackResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// GetClaims operation middleware
+func (sh *strictHandler) GetClaims(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetClaimsParams) {
+	var request GetClaimsRequestObject
+
+	request.Identifier = identifier
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetClaims(ctx, request.(GetClaimsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetClaims")
+	}
 
-func (response Agent200JSONResponse) VisitAgentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetClaimsResponseObject); ok {
+		if err := validResponse.VisitGetClaimsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
 }
 
-type Agent400JSONResponse struct{ N400JSONResponse }
+// CreateClaim operation middleware
+func (sh *strictHandler) CreateClaim(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params CreateClaimParams) {
+	var request CreateClaimRequestObject
 
-func (response Agent400JSONResponse) VisitAgentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.Identifier = identifier
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body CreateClaimJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type Agent500JSONResponse struct{ N500JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateClaim(ctx, request.(CreateClaimRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateClaim")
+	}
 
-func (response Agent500JSONResponse) VisitAgentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateClaimResponseObject); ok {
+		if err := validResponse.VisitCreateClaimResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
 }
 
-type GetIdentitiesRequestObject struct {
-}
+// GetClaimByNonce operation middleware
+func (sh *strictHandler) GetClaimByNonce(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, nonce PathNonce) {
+	var request GetClaimByNonceRequestObject
 
-type GetIdentitiesResponseObject interface {
-	VisitGetIdentitiesResponse(w http.ResponseWriter) error
-}
+	request.Identifier = identifier
+	request.Nonce = nonce
 
-type GetIdentities200JSONResponse []string
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetClaimByNonce(ctx, request.(GetClaimByNonceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetClaimByNonce")
+	}
 
-func (response GetIdentities200JSONResponse) VisitGetIdentitiesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetClaimByNonceResponseObject); ok {
+		if err := validResponse.VisitGetClaimByNonceResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
 }
 
-type GetIdentities401JSONResponse struct{ N401JSONResponse }
-
-func (response GetIdentities401JSONResponse) VisitGetIdentitiesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// GetRevocationStatus operation middleware
+func (sh *strictHandler) GetRevocationStatus(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, nonce PathNonce) {
+	var request GetRevocationStatusRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Identifier = identifier
+	request.Nonce = nonce
 
-type GetIdentities500JSONResponse struct{ N500JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetRevocationStatus(ctx, request.(GetRevocationStatusRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetRevocationStatus")
+	}
 
-func (response GetIdentities500JSONResponse) VisitGetIdentitiesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetRevocationStatusResponseObject); ok {
+		if err := validResponse.VisitGetRevocationStatusResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
 }
 
-type CreateIdentityRequestObject struct {
-	Body *CreateIdentityJSONRequestBody
-}
+// RevokeClaim operation middleware
+func (sh *strictHandler) RevokeClaim(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, nonce PathNonce, params RevokeClaimParams) {
+	var request RevokeClaimRequestObject
 
-type CreateIdentityResponseObject interface {
-	VisitCreateIdentityResponse(w http.ResponseWriter) error
-}
+	request.Identifier = identifier
+	request.Nonce = nonce
+	request.Params = params
 
-type CreateIdentity201JSONResponse CreateIdentityResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RevokeClaim(ctx, request.(RevokeClaimRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RevokeClaim")
+	}
 
-func (response CreateIdentity201JSONResponse) VisitCreateIdentityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RevokeClaimResponseObject); ok {
+		if err := validResponse.VisitRevokeClaimResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
 }
 
-type CreateIdentity400JSONResponse struct{ N400JSONResponse }
-
-func (response CreateIdentity400JSONResponse) VisitCreateIdentityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// GetRevocationList operation middleware
+func (sh *strictHandler) GetRevocationList(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetRevocationListParams) {
+	var request GetRevocationListRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Identifier = identifier
+	request.Params = params
 
-type CreateIdentity401JSONResponse struct{ N401JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetRevocationList(ctx, request.(GetRevocationListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetRevocationList")
+	}
 
-func (response CreateIdentity401JSONResponse) VisitCreateIdentityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetRevocationListResponseObject); ok {
+		if err := validResponse.VisitGetRevocationListResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
 }
 
-type CreateIdentity500JSONResponse struct{ N500CreateIdentityJSONResponse }
+// GetClaim operation middleware
+func (sh *strictHandler) GetClaim(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id PathClaim) {
+	var request GetClaimRequestObject
 
-func (response CreateIdentity500JSONResponse) VisitCreateIdentityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Identifier = identifier
+	request.Id = id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetClaim(ctx, request.(GetClaimRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetClaim")
+	}
 
-type GetClaimsRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Params     GetClaimsParams
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetClaimsResponseObject interface {
-	VisitGetClaimsResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetClaimResponseObject); ok {
+		if err := validResponse.VisitGetClaimResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
 }
 
-type GetClaims200JSONResponse GetClaimsResponse
+// RenewClaimOffer operation middleware
+func (sh *strictHandler) RenewClaimOffer(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id PathClaim) {
+	var request RenewClaimOfferRequestObject
 
-func (response GetClaims200JSONResponse) VisitGetClaimsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.Identifier = identifier
+	request.Id = id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body RenewClaimOfferJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetClaims400JSONResponse struct{ N400JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RenewClaimOffer(ctx, request.(RenewClaimOfferRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RenewClaimOffer")
+	}
 
-func (response GetClaims400JSONResponse) VisitGetClaimsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RenewClaimOfferResponseObject); ok {
+		if err := validResponse.VisitRenewClaimOfferResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
 }
 
-type GetClaims401JSONResponse struct{ N401JSONResponse }
+// GetClaimQrCode operation middleware
+func (sh *strictHandler) GetClaimQrCode(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id PathClaim) {
+	var request GetClaimQrCodeRequestObject
 
-func (response GetClaims401JSONResponse) VisitGetClaimsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	request.Identifier = identifier
+	request.Id = id
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetClaimQrCode(ctx, request.(GetClaimQrCodeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetClaimQrCode")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetClaimQrCodeResponseObject); ok {
+		if err := validResponse.VisitGetClaimQrCodeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
 }
 
-type GetClaims500JSONResponse struct{ N500JSONResponse }
+// GetClaimWalletLinks operation middleware
+func (sh *strictHandler) GetClaimWalletLinks(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id PathClaim) {
+	var request GetClaimWalletLinksRequestObject
 
-func (response GetClaims500JSONResponse) VisitGetClaimsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Identifier = identifier
+	request.Id = id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetClaimWalletLinks(ctx, request.(GetClaimWalletLinksRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetClaimWalletLinks")
+	}
 
-type CreateClaimRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Body       *CreateClaimJSONRequestBody
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type CreateClaimResponseObject interface {
-	VisitCreateClaimResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetClaimWalletLinksResponseObject); ok {
+		if err := validResponse.VisitGetClaimWalletLinksResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
 }
 
-type CreateClaim201JSONResponse CreateClaimResponse
-
-func (response CreateClaim201JSONResponse) VisitCreateClaimResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+// ResolveDIDDocument operation middleware
+func (sh *strictHandler) ResolveDIDDocument(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request ResolveDIDDocumentRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Identifier = identifier
 
-type CreateClaim400JSONResponse struct{ N400JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ResolveDIDDocument(ctx, request.(ResolveDIDDocumentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ResolveDIDDocument")
+	}
 
-func (response CreateClaim400JSONResponse) VisitCreateClaimResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ResolveDIDDocumentResponseObject); ok {
+		if err := validResponse.VisitResolveDIDDocumentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
 }
 
-type CreateClaim401JSONResponse struct{ N401JSONResponse }
-
-func (response CreateClaim401JSONResponse) VisitCreateClaimResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// GetDIDServices operation middleware
+func (sh *strictHandler) GetDIDServices(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request GetDIDServicesRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Identifier = identifier
 
-type CreateClaim422JSONResponse struct{ N422JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDIDServices(ctx, request.(GetDIDServicesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDIDServices")
+	}
 
-func (response CreateClaim422JSONResponse) VisitCreateClaimResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(422)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetDIDServicesResponseObject); ok {
+		if err := validResponse.VisitGetDIDServicesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
 }
 
-type CreateClaim500JSONResponse struct{ N500JSONResponse }
+// DeleteDIDService operation middleware
+func (sh *strictHandler) DeleteDIDService(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, serviceId PathDIDServiceID) {
+	var request DeleteDIDServiceRequestObject
 
-func (response CreateClaim500JSONResponse) VisitCreateClaimResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Identifier = identifier
+	request.ServiceId = serviceId
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteDIDService(ctx, request.(DeleteDIDServiceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteDIDService")
+	}
 
-type GetRevocationStatusRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Nonce      PathNonce      `json:"nonce"`
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetRevocationStatusResponseObject interface {
-	VisitGetRevocationStatusResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteDIDServiceResponseObject); ok {
+		if err := validResponse.VisitDeleteDIDServiceResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
 }
 
-type GetRevocationStatus200JSONResponse RevocationStatusResponse
+// SetDIDService operation middleware
+func (sh *strictHandler) SetDIDService(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, serviceId PathDIDServiceID) {
+	var request SetDIDServiceRequestObject
 
-func (response GetRevocationStatus200JSONResponse) VisitGetRevocationStatusResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.Identifier = identifier
+	request.ServiceId = serviceId
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body SetDIDServiceJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetRevocationStatus400JSONResponse struct{ N400JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetDIDService(ctx, request.(SetDIDServiceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetDIDService")
+	}
 
-func (response GetRevocationStatus400JSONResponse) VisitGetRevocationStatusResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetDIDServiceResponseObject); ok {
+		if err := validResponse.VisitSetDIDServiceResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
 }
 
-type GetRevocationStatus500JSONResponse struct{ N500JSONResponse }
+// GetFeatureFlags operation middleware
+func (sh *strictHandler) GetFeatureFlags(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request GetFeatureFlagsRequestObject
 
-func (response GetRevocationStatus500JSONResponse) VisitGetRevocationStatusResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Identifier = identifier
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetFeatureFlags(ctx, request.(GetFeatureFlagsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetFeatureFlags")
+	}
 
-type RevokeClaimRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Nonce      PathNonce      `json:"nonce"`
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type RevokeClaimResponseObject interface {
-	VisitRevokeClaimResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetFeatureFlagsResponseObject); ok {
+		if err := validResponse.VisitGetFeatureFlagsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
 }
 
-type RevokeClaim202JSONResponse RevokeClaimResponse
-
-func (response RevokeClaim202JSONResponse) VisitRevokeClaimResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(202)
+// ResetFeatureFlag operation middleware
+func (sh *strictHandler) ResetFeatureFlag(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, feature PathFeature) {
+	var request ResetFeatureFlagRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Identifier = identifier
+	request.Feature = feature
 
-type RevokeClaim400JSONResponse struct{ N400JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ResetFeatureFlag(ctx, request.(ResetFeatureFlagRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ResetFeatureFlag")
+	}
 
-func (response RevokeClaim400JSONResponse) VisitRevokeClaimResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ResetFeatureFlagResponseObject); ok {
+		if err := validResponse.VisitResetFeatureFlagResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
 }
 
-type RevokeClaim401JSONResponse struct{ N401JSONResponse }
+// SetFeatureFlag operation middleware
+func (sh *strictHandler) SetFeatureFlag(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, feature PathFeature) {
+	var request SetFeatureFlagRequestObject
 
-func (response RevokeClaim401JSONResponse) VisitRevokeClaimResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	request.Identifier = identifier
+	request.Feature = feature
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body SetFeatureFlagJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type RevokeClaim404JSONResponse struct{ N404JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetFeatureFlag(ctx, request.(SetFeatureFlagRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetFeatureFlag")
+	}
 
-func (response RevokeClaim404JSONResponse) VisitRevokeClaimResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetFeatureFlagResponseObject); ok {
+		if err := validResponse.VisitSetFeatureFlagResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
 }
 
-type RevokeClaim500JSONResponse struct{ N500JSONResponse }
+// GetJWTCredentialKeys operation middleware
+func (sh *strictHandler) GetJWTCredentialKeys(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request GetJWTCredentialKeysRequestObject
 
-func (response RevokeClaim500JSONResponse) VisitRevokeClaimResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Identifier = identifier
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetJWTCredentialKeys(ctx, request.(GetJWTCredentialKeysRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetJWTCredentialKeys")
+	}
 
-type GetClaimRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Id         PathClaim      `json:"id"`
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetClaimResponseObject interface {
-	VisitGetClaimResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetJWTCredentialKeysResponseObject); ok {
+		if err := validResponse.VisitGetJWTCredentialKeysResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
 }
 
-type GetClaim200JSONResponse GetClaimResponse
+// GetAuthKeys operation middleware
+func (sh *strictHandler) GetAuthKeys(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request GetAuthKeysRequestObject
 
-func (response GetClaim200JSONResponse) VisitGetClaimResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.Identifier = identifier
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAuthKeys(ctx, request.(GetAuthKeysRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAuthKeys")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAuthKeysResponseObject); ok {
+		if err := validResponse.VisitGetAuthKeysResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
 }
 
-type GetClaim400JSONResponse struct{ N400JSONResponse }
-
-func (response GetClaim400JSONResponse) VisitGetClaimResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// RotateAuthKey operation middleware
+func (sh *strictHandler) RotateAuthKey(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request RotateAuthKeyRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Identifier = identifier
 
-type GetClaim401JSONResponse struct{ N401JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RotateAuthKey(ctx, request.(RotateAuthKeyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RotateAuthKey")
+	}
 
-func (response GetClaim401JSONResponse) VisitGetClaimResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RotateAuthKeyResponseObject); ok {
+		if err := validResponse.VisitRotateAuthKeyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
 }
 
-type GetClaim404JSONResponse struct{ N404JSONResponse }
-
-func (response GetClaim404JSONResponse) VisitGetClaimResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// GetAgentMessages operation middleware
+func (sh *strictHandler) GetAgentMessages(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetAgentMessagesParams) {
+	var request GetAgentMessagesRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Identifier = identifier
+	request.Params = params
 
-type GetClaim500JSONResponse struct{ N500JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAgentMessages(ctx, request.(GetAgentMessagesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAgentMessages")
+	}
 
-func (response GetClaim500JSONResponse) VisitGetClaimResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAgentMessagesResponseObject); ok {
+		if err := validResponse.VisitGetAgentMessagesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
 }
 
-type GetClaimQrCodeRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Id         PathClaim      `json:"id"`
-}
+// GetAgentMessageEnvelope operation middleware
+func (sh *strictHandler) GetAgentMessageEnvelope(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id PathAgentMessage) {
+	var request GetAgentMessageEnvelopeRequestObject
 
-type GetClaimQrCodeResponseObject interface {
-	VisitGetClaimQrCodeResponse(w http.ResponseWriter) error
-}
+	request.Identifier = identifier
+	request.Id = id
 
-type GetClaimQrCode200JSONResponse GetClaimQrCodeResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAgentMessageEnvelope(ctx, request.(GetAgentMessageEnvelopeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAgentMessageEnvelope")
+	}
 
-func (response GetClaimQrCode200JSONResponse) VisitGetClaimQrCodeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAgentMessageEnvelopeResponseObject); ok {
+		if err := validResponse.VisitGetAgentMessageEnvelopeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
 }
 
-type GetClaimQrCode400JSONResponse struct{ N400JSONResponse }
-
-func (response GetClaimQrCode400JSONResponse) VisitGetClaimQrCodeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// AbortIdentityMigration operation middleware
+func (sh *strictHandler) AbortIdentityMigration(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request AbortIdentityMigrationRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Identifier = identifier
 
-type GetClaimQrCode404JSONResponse struct{ N404JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AbortIdentityMigration(ctx, request.(AbortIdentityMigrationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AbortIdentityMigration")
+	}
 
-func (response GetClaimQrCode404JSONResponse) VisitGetClaimQrCodeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AbortIdentityMigrationResponseObject); ok {
+		if err := validResponse.VisitAbortIdentityMigrationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
 }
 
-type GetClaimQrCode500JSONResponse struct{ N500JSONResponse }
+// GetIdentityMigration operation middleware
+func (sh *strictHandler) GetIdentityMigration(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request GetIdentityMigrationRequestObject
 
-func (response GetClaimQrCode500JSONResponse) VisitGetClaimQrCodeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Identifier = identifier
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetIdentityMigration(ctx, request.(GetIdentityMigrationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetIdentityMigration")
+	}
 
-type PublishIdentityStateRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type PublishIdentityStateResponseObject interface {
-	VisitPublishIdentityStateResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetIdentityMigrationResponseObject); ok {
+		if err := validResponse.VisitGetIdentityMigrationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
 }
 
-type PublishIdentityState200JSONResponse GenericErrorMessage
+// StartIdentityMigration operation middleware
+func (sh *strictHandler) StartIdentityMigration(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request StartIdentityMigrationRequestObject
 
-func (response PublishIdentityState200JSONResponse) VisitPublishIdentityStateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.Identifier = identifier
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body StartIdentityMigrationJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type PublishIdentityState202JSONResponse PublishIdentityStateResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.StartIdentityMigration(ctx, request.(StartIdentityMigrationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StartIdentityMigration")
+	}
 
-func (response PublishIdentityState202JSONResponse) VisitPublishIdentityStateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(202)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(StartIdentityMigrationResponseObject); ok {
+		if err := validResponse.VisitStartIdentityMigrationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
 }
 
-type PublishIdentityState400JSONResponse struct{ N400JSONResponse }
+// CompleteIdentityMigration operation middleware
+func (sh *strictHandler) CompleteIdentityMigration(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request CompleteIdentityMigrationRequestObject
 
-func (response PublishIdentityState400JSONResponse) VisitPublishIdentityStateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.Identifier = identifier
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CompleteIdentityMigration(ctx, request.(CompleteIdentityMigrationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CompleteIdentityMigration")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CompleteIdentityMigrationResponseObject); ok {
+		if err := validResponse.VisitCompleteIdentityMigrationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
 }
 
-type PublishIdentityState401JSONResponse struct{ N401JSONResponse }
+// ExportIdentity operation middleware
+func (sh *strictHandler) ExportIdentity(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request ExportIdentityRequestObject
 
-func (response PublishIdentityState401JSONResponse) VisitPublishIdentityStateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	request.Identifier = identifier
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ExportIdentity(ctx, request.(ExportIdentityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ExportIdentity")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ExportIdentityResponseObject); ok {
+		if err := validResponse.VisitExportIdentityResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
 }
 
-type PublishIdentityState500JSONResponse struct{ N500JSONResponse }
+// CreateOID4VCIOffer operation middleware
+func (sh *strictHandler) CreateOID4VCIOffer(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request CreateOID4VCIOfferRequestObject
 
-func (response PublishIdentityState500JSONResponse) VisitPublishIdentityStateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Identifier = identifier
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body CreateOID4VCIOfferJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Get the documentation
-	// (GET /)
-	GetDocumentation(ctx context.Context, request GetDocumentationRequestObject) (GetDocumentationResponseObject, error)
-	// Gets the favicon
-	// (GET /favicon.ico)
-	GetFavicon(ctx context.Context, request GetFaviconRequestObject) (GetFaviconResponseObject, error)
-	// Get the documentation yaml file
-	// (GET /static/docs/api/api.yaml)
-	GetYaml(ctx context.Context, request GetYamlRequestObject) (GetYamlResponseObject, error)
-	// Healthcheck
-	// (GET /status)
-	Health(ctx context.Context, request HealthRequestObject) (HealthResponseObject, error)
-	// Agent
-	// (POST /v1/agent)
-	Agent(ctx context.Context, request AgentRequestObject) (AgentResponseObject, error)
-	// Get Identities
-	// (GET /v1/identities)
-	GetIdentities(ctx context.Context, request GetIdentitiesRequestObject) (GetIdentitiesResponseObject, error)
-	// Create Identity
-	// (POST /v1/identities)
-	CreateIdentity(ctx context.Context, request CreateIdentityRequestObject) (CreateIdentityResponseObject, error)
-	// Get Claims
-	// (GET /v1/{identifier}/claims)
-	GetClaims(ctx context.Context, request GetClaimsRequestObject) (GetClaimsResponseObject, error)
-	// Create Claim
-	// (POST /v1/{identifier}/claims)
-	CreateClaim(ctx context.Context, request CreateClaimRequestObject) (CreateClaimResponseObject, error)
-	// Get Revocation Status
-	// (GET /v1/{identifier}/claims/revocation/status/{nonce})
-	GetRevocationStatus(ctx context.Context, request GetRevocationStatusRequestObject) (GetRevocationStatusResponseObject, error)
-	// Revoke Claim
-	// (POST /v1/{identifier}/claims/revoke/{nonce})
-	RevokeClaim(ctx context.Context, request RevokeClaimRequestObject) (RevokeClaimResponseObject, error)
-	// Get Claim
-	// (GET /v1/{identifier}/claims/{id})
-	GetClaim(ctx context.Context, request GetClaimRequestObject) (GetClaimResponseObject, error)
-	// Get Claim QR code
-	// (GET /v1/{identifier}/claims/{id}/qrcode)
-	GetClaimQrCode(ctx context.Context, request GetClaimQrCodeRequestObject) (GetClaimQrCodeResponseObject, error)
-	// Publish Identity State
-	// (POST /v1/{identifier}/state/publish)
-	PublishIdentityState(ctx context.Context, request PublishIdentityStateRequestObject) (PublishIdentityStateResponseObject, error)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateOID4VCIOffer(ctx, request.(CreateOID4VCIOfferRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateOID4VCIOffer")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateOID4VCIOfferResponseObject); ok {
+		if err := validResponse.VisitCreateOID4VCIOfferResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
 }
 
-type StrictHandlerFunc func(ctx context.Context, w http.ResponseWriter, r *http.Request, args interface{}) (interface{}, error)
+// ResetPublishingPolicy operation middleware
+func (sh *strictHandler) ResetPublishingPolicy(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request ResetPublishingPolicyRequestObject
 
-type StrictMiddlewareFunc func(f StrictHandlerFunc, operationID string) StrictHandlerFunc
+	request.Identifier = identifier
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ResetPublishingPolicy(ctx, request.(ResetPublishingPolicyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ResetPublishingPolicy")
+	}
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
-}
+	response, err := handler(r.Context(), w, r, request)
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ResetPublishingPolicyResponseObject); ok {
+		if err := validResponse.VisitResetPublishingPolicyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+// GetPublishingPolicy operation middleware
+func (sh *strictHandler) GetPublishingPolicy(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request GetPublishingPolicyRequestObject
+
+	request.Identifier = identifier
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPublishingPolicy(ctx, request.(GetPublishingPolicyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPublishingPolicy")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPublishingPolicyResponseObject); ok {
+		if err := validResponse.VisitGetPublishingPolicyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
 }
 
-// GetDocumentation operation middleware
-func (sh *strictHandler) GetDocumentation(w http.ResponseWriter, r *http.Request) {
-	var request GetDocumentationRequestObject
+// SetPublishingPolicy operation middleware
+func (sh *strictHandler) SetPublishingPolicy(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request SetPublishingPolicyRequestObject
+
+	request.Identifier = identifier
+
+	var body SetPublishingPolicyJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetDocumentation(ctx, request.(GetDocumentationRequestObject))
+		return sh.ssi.SetPublishingPolicy(ctx, request.(SetPublishingPolicyRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetDocumentation")
+		handler = middleware(handler, "SetPublishingPolicy")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetDocumentationResponseObject); ok {
-		if err := validResponse.VisitGetDocumentationResponse(w); err != nil {
+	} else if validResponse, ok := response.(SetPublishingPolicyResponseObject); ok {
+		if err := validResponse.VisitSetPublishingPolicyResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1478,23 +8733,32 @@ func (sh *strictHandler) GetDocumentation(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// GetFavicon operation middleware
-func (sh *strictHandler) GetFavicon(w http.ResponseWriter, r *http.Request) {
-	var request GetFaviconRequestObject
+// ApplyRevocationDecisions operation middleware
+func (sh *strictHandler) ApplyRevocationDecisions(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request ApplyRevocationDecisionsRequestObject
+
+	request.Identifier = identifier
+
+	var body ApplyRevocationDecisionsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetFavicon(ctx, request.(GetFaviconRequestObject))
+		return sh.ssi.ApplyRevocationDecisions(ctx, request.(ApplyRevocationDecisionsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetFavicon")
+		handler = middleware(handler, "ApplyRevocationDecisions")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetFaviconResponseObject); ok {
-		if err := validResponse.VisitGetFaviconResponse(w); err != nil {
+	} else if validResponse, ok := response.(ApplyRevocationDecisionsResponseObject); ok {
+		if err := validResponse.VisitApplyRevocationDecisionsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1502,23 +8766,26 @@ func (sh *strictHandler) GetFavicon(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetYaml operation middleware
-func (sh *strictHandler) GetYaml(w http.ResponseWriter, r *http.Request) {
-	var request GetYamlRequestObject
+// GetRevocationDecisionsReport operation middleware
+func (sh *strictHandler) GetRevocationDecisionsReport(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetRevocationDecisionsReportParams) {
+	var request GetRevocationDecisionsReportRequestObject
+
+	request.Identifier = identifier
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetYaml(ctx, request.(GetYamlRequestObject))
+		return sh.ssi.GetRevocationDecisionsReport(ctx, request.(GetRevocationDecisionsReportRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetYaml")
+		handler = middleware(handler, "GetRevocationDecisionsReport")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetYamlResponseObject); ok {
-		if err := validResponse.VisitGetYamlResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetRevocationDecisionsReportResponseObject); ok {
+		if err := validResponse.VisitGetRevocationDecisionsReportResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1526,23 +8793,25 @@ func (sh *strictHandler) GetYaml(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// Health operation middleware
-func (sh *strictHandler) Health(w http.ResponseWriter, r *http.Request) {
-	var request HealthRequestObject
+// GetStateIntegrity operation middleware
+func (sh *strictHandler) GetStateIntegrity(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request GetStateIntegrityRequestObject
+
+	request.Identifier = identifier
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.Health(ctx, request.(HealthRequestObject))
+		return sh.ssi.GetStateIntegrity(ctx, request.(GetStateIntegrityRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "Health")
+		handler = middleware(handler, "GetStateIntegrity")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(HealthResponseObject); ok {
-		if err := validResponse.VisitHealthResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetStateIntegrityResponseObject); ok {
+		if err := validResponse.VisitGetStateIntegrityResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1550,31 +8819,51 @@ func (sh *strictHandler) Health(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// Agent operation middleware
-func (sh *strictHandler) Agent(w http.ResponseWriter, r *http.Request) {
-	var request AgentRequestObject
+// PublishIdentityState operation middleware
+func (sh *strictHandler) PublishIdentityState(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request PublishIdentityStateRequestObject
+
+	request.Identifier = identifier
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PublishIdentityState(ctx, request.(PublishIdentityStateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PublishIdentityState")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
 
-	data, err := io.ReadAll(r.Body)
 	if err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't read body: %w", err))
-		return
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PublishIdentityStateResponseObject); ok {
+		if err := validResponse.VisitPublishIdentityStateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
 	}
-	body := AgentTextRequestBody(data)
-	request.Body = &body
+}
+
+// GetStateTransactions operation middleware
+func (sh *strictHandler) GetStateTransactions(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request GetStateTransactionsRequestObject
+
+	request.Identifier = identifier
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.Agent(ctx, request.(AgentRequestObject))
+		return sh.ssi.GetStateTransactions(ctx, request.(GetStateTransactionsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "Agent")
+		handler = middleware(handler, "GetStateTransactions")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(AgentResponseObject); ok {
-		if err := validResponse.VisitAgentResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetStateTransactionsResponseObject); ok {
+		if err := validResponse.VisitGetStateTransactionsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1582,23 +8871,25 @@ func (sh *strictHandler) Agent(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetIdentities operation middleware
-func (sh *strictHandler) GetIdentities(w http.ResponseWriter, r *http.Request) {
-	var request GetIdentitiesRequestObject
+// GetStatusListCredential operation middleware
+func (sh *strictHandler) GetStatusListCredential(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request GetStatusListCredentialRequestObject
+
+	request.Identifier = identifier
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetIdentities(ctx, request.(GetIdentitiesRequestObject))
+		return sh.ssi.GetStatusListCredential(ctx, request.(GetStatusListCredentialRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetIdentities")
+		handler = middleware(handler, "GetStatusListCredential")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetIdentitiesResponseObject); ok {
-		if err := validResponse.VisitGetIdentitiesResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetStatusListCredentialResponseObject); ok {
+		if err := validResponse.VisitGetStatusListCredentialResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1606,11 +8897,13 @@ func (sh *strictHandler) GetIdentities(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// CreateIdentity operation middleware
-func (sh *strictHandler) CreateIdentity(w http.ResponseWriter, r *http.Request) {
-	var request CreateIdentityRequestObject
+// CreateVerificationRequest operation middleware
+func (sh *strictHandler) CreateVerificationRequest(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request CreateVerificationRequestRequestObject
 
-	var body CreateIdentityJSONRequestBody
+	request.Identifier = identifier
+
+	var body CreateVerificationRequestJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1618,18 +8911,18 @@ func (sh *strictHandler) CreateIdentity(w http.ResponseWriter, r *http.Request)
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateIdentity(ctx, request.(CreateIdentityRequestObject))
+		return sh.ssi.CreateVerificationRequest(ctx, request.(CreateVerificationRequestRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateIdentity")
+		handler = middleware(handler, "CreateVerificationRequest")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateIdentityResponseObject); ok {
-		if err := validResponse.VisitCreateIdentityResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateVerificationRequestResponseObject); ok {
+		if err := validResponse.VisitCreateVerificationRequestResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1637,26 +8930,26 @@ func (sh *strictHandler) CreateIdentity(w http.ResponseWriter, r *http.Request)
 	}
 }
 
-// GetClaims operation middleware
-func (sh *strictHandler) GetClaims(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetClaimsParams) {
-	var request GetClaimsRequestObject
+// GetVerificationRequest operation middleware
+func (sh *strictHandler) GetVerificationRequest(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id PathVerificationRequest) {
+	var request GetVerificationRequestRequestObject
 
 	request.Identifier = identifier
-	request.Params = params
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetClaims(ctx, request.(GetClaimsRequestObject))
+		return sh.ssi.GetVerificationRequest(ctx, request.(GetVerificationRequestRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetClaims")
+		handler = middleware(handler, "GetVerificationRequest")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetClaimsResponseObject); ok {
-		if err := validResponse.VisitGetClaimsResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetVerificationRequestResponseObject); ok {
+		if err := validResponse.VisitGetVerificationRequestResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1664,32 +8957,53 @@ func (sh *strictHandler) GetClaims(w http.ResponseWriter, r *http.Request, ident
 	}
 }
 
-// CreateClaim operation middleware
-func (sh *strictHandler) CreateClaim(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
-	var request CreateClaimRequestObject
+// GetVerificationRequestQrCode operation middleware
+func (sh *strictHandler) GetVerificationRequestQrCode(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id PathVerificationRequest) {
+	var request GetVerificationRequestQrCodeRequestObject
 
 	request.Identifier = identifier
+	request.Id = id
 
-	var body CreateClaimJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetVerificationRequestQrCode(ctx, request.(GetVerificationRequestQrCodeRequestObject))
 	}
-	request.Body = &body
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetVerificationRequestQrCode")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetVerificationRequestQrCodeResponseObject); ok {
+		if err := validResponse.VisitGetVerificationRequestQrCodeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// GetVerificationResponses operation middleware
+func (sh *strictHandler) GetVerificationResponses(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetVerificationResponsesParams) {
+	var request GetVerificationResponsesRequestObject
+
+	request.Identifier = identifier
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateClaim(ctx, request.(CreateClaimRequestObject))
+		return sh.ssi.GetVerificationResponses(ctx, request.(GetVerificationResponsesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateClaim")
+		handler = middleware(handler, "GetVerificationResponses")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateClaimResponseObject); ok {
-		if err := validResponse.VisitCreateClaimResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetVerificationResponsesResponseObject); ok {
+		if err := validResponse.VisitGetVerificationResponsesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1697,26 +9011,26 @@ func (sh *strictHandler) CreateClaim(w http.ResponseWriter, r *http.Request, ide
 	}
 }
 
-// GetRevocationStatus operation middleware
-func (sh *strictHandler) GetRevocationStatus(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, nonce PathNonce) {
-	var request GetRevocationStatusRequestObject
+// GetHeldCredentials operation middleware
+func (sh *strictHandler) GetHeldCredentials(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetHeldCredentialsParams) {
+	var request GetHeldCredentialsRequestObject
 
 	request.Identifier = identifier
-	request.Nonce = nonce
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetRevocationStatus(ctx, request.(GetRevocationStatusRequestObject))
+		return sh.ssi.GetHeldCredentials(ctx, request.(GetHeldCredentialsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetRevocationStatus")
+		handler = middleware(handler, "GetHeldCredentials")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetRevocationStatusResponseObject); ok {
-		if err := validResponse.VisitGetRevocationStatusResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetHeldCredentialsResponseObject); ok {
+		if err := validResponse.VisitGetHeldCredentialsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1724,26 +9038,26 @@ func (sh *strictHandler) GetRevocationStatus(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// RevokeClaim operation middleware
-func (sh *strictHandler) RevokeClaim(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, nonce PathNonce) {
-	var request RevokeClaimRequestObject
+// DeleteHeldCredential operation middleware
+func (sh *strictHandler) DeleteHeldCredential(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id PathHeldCredential) {
+	var request DeleteHeldCredentialRequestObject
 
 	request.Identifier = identifier
-	request.Nonce = nonce
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.RevokeClaim(ctx, request.(RevokeClaimRequestObject))
+		return sh.ssi.DeleteHeldCredential(ctx, request.(DeleteHeldCredentialRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "RevokeClaim")
+		handler = middleware(handler, "DeleteHeldCredential")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(RevokeClaimResponseObject); ok {
-		if err := validResponse.VisitRevokeClaimResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteHeldCredentialResponseObject); ok {
+		if err := validResponse.VisitDeleteHeldCredentialResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1751,26 +9065,26 @@ func (sh *strictHandler) RevokeClaim(w http.ResponseWriter, r *http.Request, ide
 	}
 }
 
-// GetClaim operation middleware
-func (sh *strictHandler) GetClaim(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id PathClaim) {
-	var request GetClaimRequestObject
+// GetHeldCredential operation middleware
+func (sh *strictHandler) GetHeldCredential(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id PathHeldCredential) {
+	var request GetHeldCredentialRequestObject
 
 	request.Identifier = identifier
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetClaim(ctx, request.(GetClaimRequestObject))
+		return sh.ssi.GetHeldCredential(ctx, request.(GetHeldCredentialRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetClaim")
+		handler = middleware(handler, "GetHeldCredential")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetClaimResponseObject); ok {
-		if err := validResponse.VisitGetClaimResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetHeldCredentialResponseObject); ok {
+		if err := validResponse.VisitGetHeldCredentialResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1778,26 +9092,32 @@ func (sh *strictHandler) GetClaim(w http.ResponseWriter, r *http.Request, identi
 	}
 }
 
-// GetClaimQrCode operation middleware
-func (sh *strictHandler) GetClaimQrCode(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id PathClaim) {
-	var request GetClaimQrCodeRequestObject
+// AcceptCredentialOffer operation middleware
+func (sh *strictHandler) AcceptCredentialOffer(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request AcceptCredentialOfferRequestObject
 
 	request.Identifier = identifier
-	request.Id = id
+
+	var body AcceptCredentialOfferJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetClaimQrCode(ctx, request.(GetClaimQrCodeRequestObject))
+		return sh.ssi.AcceptCredentialOffer(ctx, request.(AcceptCredentialOfferRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetClaimQrCode")
+		handler = middleware(handler, "AcceptCredentialOffer")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetClaimQrCodeResponseObject); ok {
-		if err := validResponse.VisitGetClaimQrCodeResponse(w); err != nil {
+	} else if validResponse, ok := response.(AcceptCredentialOfferResponseObject); ok {
+		if err := validResponse.VisitAcceptCredentialOfferResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1805,25 +9125,32 @@ func (sh *strictHandler) GetClaimQrCode(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// PublishIdentityState operation middleware
-func (sh *strictHandler) PublishIdentityState(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
-	var request PublishIdentityStateRequestObject
+// PresentCredentials operation middleware
+func (sh *strictHandler) PresentCredentials(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request PresentCredentialsRequestObject
 
 	request.Identifier = identifier
 
+	var body PresentCredentialsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PublishIdentityState(ctx, request.(PublishIdentityStateRequestObject))
+		return sh.ssi.PresentCredentials(ctx, request.(PresentCredentialsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PublishIdentityState")
+		handler = middleware(handler, "PresentCredentials")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PublishIdentityStateResponseObject); ok {
-		if err := validResponse.VisitPublishIdentityStateResponse(w); err != nil {
+	} else if validResponse, ok := response.(PresentCredentialsResponseObject); ok {
+		if err := validResponse.VisitPresentCredentialsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5,11 +5,16 @@ import (
 	"crypto/subtle"
 	"errors"
 	"net/http"
+	"time"
 
+	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
+	core "github.com/iden3/go-iden3-core"
 
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
 	apiErrors "github.com/polygonid/sh-id-platform/internal/errors"
 	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/standby"
 )
 
 // LogMiddleware returns a middleware that adds general log configuration to each context request
@@ -45,3 +50,42 @@ func BasicAuthMiddleware(ctx context.Context, user, pass string) StrictMiddlewar
 		}
 	}
 }
+
+// migrationOperations are the operations that manage the migration of an identity, allowed while it is read only
+var migrationOperations = map[string]bool{
+	"StartIdentityMigration":    true,
+	"GetIdentityMigration":      true,
+	"AbortIdentityMigration":    true,
+	"ExportIdentity":            true,
+	"CompleteIdentityMigration": true,
+}
+
+// ReadOnlyMiddleware returns a middleware that rejects the requests that would change an identity while it is being
+// migrated to another node. Clients are asked to retry after the given duration.
+func ReadOnlyMiddleware(migration ports.IdentityMigrationService, retryAfter time.Duration) StrictMiddlewareFunc {
+	return func(f StrictHandlerFunc, operationID string) StrictHandlerFunc {
+		return func(ctxReq context.Context, w http.ResponseWriter, r *http.Request, args interface{}) (interface{}, error) {
+			if r.Method == http.MethodGet || migrationOperations[operationID] {
+				return f(ctxReq, w, r, args)
+			}
+			did, err := core.ParseDID(chi.URLParam(r, "identifier"))
+			if err == nil && migration.IsReadOnly(ctxReq, *did) {
+				return nil, apiErrors.ReadOnlyError{Err: errors.New("identity is being migrated"), RetryAfter: retryAfter}
+			}
+			return f(ctxReq, w, r, args)
+		}
+	}
+}
+
+// StandbyMiddleware returns a middleware that rejects every request but the GET ones while the node is a standby,
+// as its database is a read only replica of the primary one.
+func StandbyMiddleware(node *standby.Node, retryAfter time.Duration) StrictMiddlewareFunc {
+	return func(f StrictHandlerFunc, operationID string) StrictHandlerFunc {
+		return func(ctxReq context.Context, w http.ResponseWriter, r *http.Request, args interface{}) (interface{}, error) {
+			if r.Method != http.MethodGet && node.Standby() {
+				return nil, apiErrors.ReadOnlyError{Err: errors.New("node is a standby"), RetryAfter: retryAfter}
+			}
+			return f(ctxReq, w, r, args)
+		}
+	}
+}
@@ -1,16 +1,22 @@
 package api
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
+	"github.com/iden3/go-circuits"
 	core "github.com/iden3/go-iden3-core"
 	"github.com/iden3/go-schema-processor/verifiable"
 	"github.com/iden3/iden3comm"
@@ -22,11 +28,16 @@ import (
 	"github.com/polygonid/sh-id-platform/internal/core/domain"
 	"github.com/polygonid/sh-id-platform/internal/core/ports"
 	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/db"
 	"github.com/polygonid/sh-id-platform/internal/gateways"
 	"github.com/polygonid/sh-id-platform/internal/health"
+	"github.com/polygonid/sh-id-platform/internal/jsonschema"
 	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/network"
+	"github.com/polygonid/sh-id-platform/internal/pii"
 	"github.com/polygonid/sh-id-platform/internal/repositories"
 	"github.com/polygonid/sh-id-platform/pkg/schema"
+	"github.com/polygonid/sh-id-platform/pkg/walletlinks"
 )
 
 // Server implements StrictServerInterface and holds the implementation of all API controllers
@@ -35,25 +46,68 @@ type Server struct {
 	cfg              *config.Configuration
 	identityService  ports.IdentityService
 	claimService     ports.ClaimsService
+	walletService    ports.WalletService
+	keyRotation      ports.KeyRotationService
+	featureFlags     ports.FeatureFlagService
+	migration        ports.IdentityMigrationService
+	publishing       ports.PublishingPolicyService
+	decisions        ports.RevocationDecisionService
+	verification     ports.VerificationService
+	oid4vci          ports.OID4VCIService
+	jwtCredentials   ports.JWTCredentialService
+	profiles         ports.IssuerProfileService
+	didDocuments     ports.DIDDocumentService
+	treeIntegrity    ports.TreeIntegrityService
+	schemaCache      ports.SchemaDocumentCache
+	agentInbox       ports.AgentInboxService
+	settings         ports.SettingsService
 	publisherGateway ports.Publisher
 	packageManager   *iden3comm.PackageManager
+	networkResolver  *network.Resolver
 	health           *health.Status
+	listingPII       pii.Fields // fields masked in the responses that list claims
+	walletLinks      *walletlinks.Linker
 }
 
 // NewServer is a Server constructor
-func NewServer(cfg *config.Configuration, identityService ports.IdentityService, claimsService ports.ClaimsService, publisherGateway ports.Publisher, packageManager *iden3comm.PackageManager, health *health.Status) *Server {
+func NewServer(cfg *config.Configuration, identityService ports.IdentityService, claimsService ports.ClaimsService, walletService ports.WalletService, keyRotation ports.KeyRotationService, featureFlags ports.FeatureFlagService, migration ports.IdentityMigrationService, publishing ports.PublishingPolicyService, decisions ports.RevocationDecisionService, verification ports.VerificationService, oid4vci ports.OID4VCIService, jwtCredentials ports.JWTCredentialService, profiles ports.IssuerProfileService, didDocuments ports.DIDDocumentService, treeIntegrity ports.TreeIntegrityService, schemaCache ports.SchemaDocumentCache, agentInbox ports.AgentInboxService, settings ports.SettingsService, publisherGateway ports.Publisher, packageManager *iden3comm.PackageManager, networkResolver *network.Resolver, health *health.Status) *Server {
+	var listingPII pii.Fields
+	if cfg.PII.MaskListings {
+		listingPII = pii.NewFields(cfg.PII.Fields)
+	}
 	return &Server{
 		cfg:              cfg,
 		identityService:  identityService,
 		claimService:     claimsService,
+		walletService:    walletService,
+		keyRotation:      keyRotation,
+		featureFlags:     featureFlags,
+		migration:        migration,
+		publishing:       publishing,
+		decisions:        decisions,
+		verification:     verification,
+		oid4vci:          oid4vci,
+		jwtCredentials:   jwtCredentials,
+		profiles:         profiles,
+		didDocuments:     didDocuments,
+		treeIntegrity:    treeIntegrity,
+		schemaCache:      schemaCache,
+		agentInbox:       agentInbox,
+		settings:         settings,
 		publisherGateway: publisherGateway,
 		packageManager:   packageManager,
+		networkResolver:  networkResolver,
 		health:           health,
+		listingPII:       listingPII,
+		walletLinks:      walletlinks.NewLinker(cfg.WalletLinks.DeepLink, cfg.WalletLinks.UniversalLink),
 	}
 }
 
-// Health is a method
+// Health is a method. It answers with a 503 while the server is warming up, as the rest of the endpoints do.
 func (s *Server) Health(_ context.Context, _ HealthRequestObject) (HealthResponseObject, error) {
+	if !s.health.Ready() {
+		return Health503JSONResponse(s.health.Status()), nil
+	}
 	var resp Health200JSONResponse = s.health.Status()
 
 	return resp, nil
@@ -78,9 +132,28 @@ func (s *Server) GetYaml(_ context.Context, _ GetYamlRequestObject) (GetYamlResp
 func (s *Server) CreateIdentity(ctx context.Context, request CreateIdentityRequestObject) (CreateIdentityResponseObject, error) {
 	method := request.Body.DidMetadata.Method
 	blockchain := request.Body.DidMetadata.Blockchain
-	network := request.Body.DidMetadata.Network
+	networkID := request.Body.DidMetadata.Network
+
+	if s.networkResolver != nil && !s.networkResolver.Supported(core.Blockchain(blockchain), core.NetworkID(networkID)) {
+		return CreateIdentity400JSONResponse{
+			N400JSONResponse{
+				Message: fmt.Sprintf("network %s is not supported, use one of %s", network.NewKey(core.Blockchain(blockchain), core.NetworkID(networkID)), strings.Join(s.networkResolver.Networks(), ", ")),
+			},
+		}, nil
+	}
+
+	create := s.identityService.Create
+	if request.Body.DidMetadata.Type != nil {
+		switch *request.Body.DidMetadata.Type {
+		case BJJ:
+		case ETH:
+			create = s.identityService.CreateEthIdentity
+		default:
+			return CreateIdentity400JSONResponse{N400JSONResponse{Message: "type must be BJJ or ETH"}}, nil
+		}
+	}
 
-	identity, err := s.identityService.Create(ctx, method, blockchain, network, s.cfg.ServerUrl)
+	identity, err := create(ctx, method, blockchain, networkID, s.cfg.ServerUrl)
 	if err != nil {
 		if errors.Is(err, services.ErrWrongDIDMetada) {
 			return CreateIdentity400JSONResponse{
@@ -94,6 +167,7 @@ func (s *Server) CreateIdentity(ctx context.Context, request CreateIdentityReque
 
 	return CreateIdentity201JSONResponse{
 		Identifier: &identity.Identifier,
+		Address:    identity.Address,
 		State: &IdentityState{
 			BlockNumber:        identity.State.BlockNumber,
 			BlockTimestamp:     identity.State.BlockTimestamp,
@@ -114,37 +188,68 @@ func (s *Server) CreateIdentity(ctx context.Context, request CreateIdentityReque
 func (s *Server) CreateClaim(ctx context.Context, request CreateClaimRequestObject) (CreateClaimResponseObject, error) {
 	did, err := core.ParseDID(request.Identifier)
 	if err != nil {
-		return CreateClaim400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		return CreateClaim400JSONResponse{Message: err.Error()}, nil
 	}
 	var expiration *time.Time
 	if request.Body.Expiration != nil {
 		expiration = common.ToPointer(time.Unix(*request.Body.Expiration, 0))
 	}
 
-	req := ports.NewCreateClaimRequest(did, request.Body.CredentialSchema, request.Body.CredentialSubject, expiration, request.Body.Type, request.Body.Version, request.Body.SubjectPosition, request.Body.MerklizedRootPosition, common.ToPointer(true), common.ToPointer(true), nil, false)
+	req := ports.NewCreateClaimRequest(did, request.Body.CredentialSchema, request.Body.CredentialSubject, expiration, request.Body.Type, request.Body.Version, request.Body.SubjectPosition, request.Body.MerklizedRootPosition, request.Body.SignatureProof, request.Body.MtProof, nil, false)
+	if request.Body.ExtraContexts != nil {
+		req.ExtraContexts = *request.Body.ExtraContexts
+	}
+	if request.Body.ExtraTypes != nil {
+		req.ExtraTypes = *request.Body.ExtraTypes
+	}
+	if request.Body.RefreshService != nil {
+		req.RefreshService = *request.Body.RefreshService
+	}
+	req.ExpirationDays = request.Body.ExpirationDays
 
 	resp, err := s.claimService.Save(ctx, req)
 	if err != nil {
 		if errors.Is(err, services.ErrJSONLdContext) {
-			return CreateClaim400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+			return CreateClaim400JSONResponse{Message: err.Error()}, nil
 		}
 		if errors.Is(err, services.ErrProcessSchema) {
-			return CreateClaim400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+			return CreateClaim400JSONResponse{Message: err.Error()}, nil
 		}
 		if errors.Is(err, services.ErrLoadingSchema) {
 			return CreateClaim422JSONResponse{N422JSONResponse{Message: err.Error()}}, nil
 		}
 		if errors.Is(err, services.ErrMalformedURL) {
-			return CreateClaim400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+			return CreateClaim400JSONResponse{Message: err.Error()}, nil
 		}
 		if errors.Is(err, services.ErrParseClaim) {
-			return CreateClaim400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+			return CreateClaim400JSONResponse{Message: err.Error()}, nil
 		}
 		if errors.Is(err, services.ErrInvalidCredentialSubject) {
-			return CreateClaim400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+			return CreateClaim400JSONResponse{Message: err.Error(), Errors: credentialSubjectErrors(err)}, nil
 		}
 		if errors.Is(err, services.ErrLoadingSchema) {
-			return CreateClaim400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+			return CreateClaim400JSONResponse{Message: err.Error()}, nil
+		}
+		if errors.Is(err, services.ErrInvalidCredentialContext) || errors.Is(err, services.ErrInvalidCredentialType) {
+			return CreateClaim400JSONResponse{Message: err.Error()}, nil
+		}
+		if errors.Is(err, domain.ErrProofPolicy) {
+			return CreateClaim400JSONResponse{Message: err.Error()}, nil
+		}
+		if errors.Is(err, services.ErrSchemaDeprecated) {
+			return CreateClaim400JSONResponse{Message: err.Error()}, nil
+		}
+		if errors.Is(err, services.ErrInvalidExpiration) || errors.Is(err, domain.ErrMaxValidity) {
+			return CreateClaim400JSONResponse{Message: err.Error()}, nil
+		}
+		if errors.Is(err, domain.ErrInvalidSubjectDID) {
+			return CreateClaim400JSONResponse{Message: err.Error()}, nil
+		}
+		if errors.Is(err, services.ErrCredentialRejected) {
+			return CreateClaim422JSONResponse{N422JSONResponse{Message: err.Error()}}, nil
+		}
+		if errors.Is(err, services.ErrValidationWebhookUnavailable) || errors.Is(err, services.ErrDIDResolverUnavailable) {
+			log.Error(ctx, "claim not validated", "err", err)
 		}
 		return CreateClaim500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
 	}
@@ -159,7 +264,19 @@ func (s *Server) RevokeClaim(ctx context.Context, request RevokeClaimRequestObje
 		return RevokeClaim400JSONResponse{N400JSONResponse{err.Error()}}, nil
 	}
 
-	if err := s.claimService.Revoke(ctx, *did, uint64(request.Nonce), ""); err != nil {
+	var code, description string
+	if request.Params.Reason != nil {
+		code = string(*request.Params.Reason)
+	}
+	if request.Params.Description != nil {
+		description = *request.Params.Description
+	}
+	reason, err := domain.NewRevocationReason(code)
+	if err != nil {
+		return RevokeClaim400JSONResponse{N400JSONResponse{err.Error()}}, nil
+	}
+
+	if err := s.claimService.Revoke(ctx, *did, uint64(request.Nonce), reason, description); err != nil {
 		if errors.Is(err, repositories.ErrClaimDoesNotExist) {
 			return RevokeClaim404JSONResponse{N404JSONResponse{
 				Message: "the claim does not exist",
@@ -168,6 +285,11 @@ func (s *Server) RevokeClaim(ctx context.Context, request RevokeClaimRequestObje
 
 		return RevokeClaim500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
 	}
+	if request.Params.PublishNow != nil && *request.Params.PublishNow {
+		return RevokeClaim202JSONResponse{
+			Message: publishRevocation(ctx, s.publisherGateway, did, "claim revocation request sent"),
+		}, nil
+	}
 	return RevokeClaim202JSONResponse{
 		Message: "claim revocation request sent",
 	}, nil
@@ -220,6 +342,54 @@ func (s *Server) GetRevocationStatus(ctx context.Context, request GetRevocationS
 	return response, err
 }
 
+// GetRevocationList returns the revoked nonces of the identity, as a list or as a bitstring, so the relying parties can sync the revocations in bulk. This endpoint must be public available
+func (s *Server) GetRevocationList(ctx context.Context, request GetRevocationListRequestObject) (GetRevocationListResponseObject, error) {
+	did, err := core.ParseDID(request.Identifier)
+	if err != nil {
+		return GetRevocationList400JSONResponse{N400JSONResponse{"invalid did"}}, nil
+	}
+
+	format := List
+	if request.Params.Format != nil {
+		format = *request.Params.Format
+	}
+	if format != List && format != Bitstring {
+		return GetRevocationList400JSONResponse{N400JSONResponse{"invalid format, it must be list or bitstring"}}, nil
+	}
+	if format == Bitstring && request.Params.Since != nil {
+		return GetRevocationList400JSONResponse{N400JSONResponse{"the bitstring format can not be combined with since"}}, nil
+	}
+
+	list, err := s.identityService.GetRevocationList(ctx, *did, request.Params.Since)
+	if err != nil {
+		return GetRevocationList500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+
+	response := RevocationList{
+		Issuer:   list.IssuerDID.String(),
+		Since:    list.Since,
+		SyncedAt: list.SyncedAt,
+	}
+	if format == List {
+		nonces := make([]uint64, len(list.Nonces))
+		for i, nonce := range list.Nonces {
+			nonces[i] = uint64(nonce)
+		}
+		response.Nonces = &nonces
+		return GetRevocationList200JSONResponse(response), nil
+	}
+
+	encoded, err := list.Bitstring()
+	if err != nil {
+		if errors.Is(err, domain.ErrRevocationBitstringTooLarge) {
+			return GetRevocationList422JSONResponse{N422JSONResponse{err.Error()}}, nil
+		}
+		return GetRevocationList500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	response.EncodedList = &encoded
+	return GetRevocationList200JSONResponse(response), nil
+}
+
 // GetClaim is the controller to get a client.
 func (s *Server) GetClaim(ctx context.Context, request GetClaimRequestObject) (GetClaimResponseObject, error) {
 	if request.Identifier == "" {
@@ -253,7 +423,37 @@ func (s *Server) GetClaim(ctx context.Context, request GetClaimRequestObject) (G
 		return GetClaim500JSONResponse{N500JSONResponse{"invalid claim format"}}, nil
 	}
 
-	return GetClaim200JSONResponse(toGetClaim200Response(w3c)), nil
+	resp := toGetClaim200Response(w3c)
+	resp.RefreshService = toRefreshServiceResponse(claim.GetRefreshService())
+	return GetClaim200JSONResponse(resp), nil
+}
+
+// GetClaimByNonce is the controller to get the claim of an identity with a revocation nonce
+func (s *Server) GetClaimByNonce(ctx context.Context, request GetClaimByNonceRequestObject) (GetClaimByNonceResponseObject, error) {
+	did, err := core.ParseDID(request.Identifier)
+	if err != nil {
+		return GetClaimByNonce400JSONResponse{N400JSONResponse{"invalid did"}}, nil
+	}
+	if request.Nonce < 0 {
+		return GetClaimByNonce400JSONResponse{N400JSONResponse{"invalid nonce, it cannot be negative"}}, nil
+	}
+
+	claim, err := s.claimService.GetByRevocationNonce(ctx, did, uint64(request.Nonce))
+	if err != nil {
+		if errors.Is(err, services.ErrClaimNotFound) {
+			return GetClaimByNonce404JSONResponse{N404JSONResponse{err.Error()}}, nil
+		}
+		return GetClaimByNonce500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+
+	w3c, err := schema.FromClaimModelToW3CCredential(*claim)
+	if err != nil {
+		return GetClaimByNonce500JSONResponse{N500JSONResponse{"invalid claim format"}}, nil
+	}
+
+	resp := toGetClaim200Response(w3c)
+	resp.RefreshService = toRefreshServiceResponse(claim.GetRefreshService())
+	return GetClaimByNonce200JSONResponse(resp), nil
 }
 
 // GetClaims is the controller to get multiple claims of a determined identity
@@ -280,6 +480,9 @@ func (s *Server) GetClaims(ctx context.Context, request GetClaimsRequestObject)
 	}
 
 	claims, err := s.claimService.GetAll(ctx, *did, filter)
+	if errors.Is(err, db.ErrQueryTimeout) {
+		return nil, err
+	}
 	if err != nil && !errors.Is(err, services.ErrClaimNotFound) {
 		return GetClaims500JSONResponse{N500JSONResponse{"there was an internal error trying to retrieve claims for the requested identifier"}}, nil
 	}
@@ -289,7 +492,133 @@ func (s *Server) GetClaims(ctx context.Context, request GetClaimsRequestObject)
 		return GetClaims500JSONResponse{N500JSONResponse{"there was an internal error parsing the claims"}}, nil
 	}
 
-	return toGetClaims200Response(w3Claims), nil
+	resp := toGetClaims200Response(w3Claims)
+	for i := range resp {
+		resp[i].CredentialSubject = s.listingPII.MaskSubject(resp[i].CredentialSubject)
+		resp[i].RefreshService = toRefreshServiceResponse(claims[i].GetRefreshService())
+	}
+	return resp, nil
+}
+
+// GetJWTCredentialKeys returns the public keys that verify the JWT credentials of an identity
+func (s *Server) GetJWTCredentialKeys(ctx context.Context, request GetJWTCredentialKeysRequestObject) (GetJWTCredentialKeysResponseObject, error) {
+	did, err := core.ParseDID(request.Identifier)
+	if err != nil {
+		return GetJWTCredentialKeys400JSONResponse{N400JSONResponse{"invalid did"}}, nil
+	}
+
+	keys, err := s.jwtCredentials.PublicKeys(ctx, *did)
+	if err != nil {
+		if errors.Is(err, services.ErrJWTCredentialIdentityNotFound) {
+			return GetJWTCredentialKeys404JSONResponse{N404JSONResponse{err.Error()}}, nil
+		}
+		log.Error(ctx, "loading jwt credential keys", "err", err, "did", request.Identifier)
+		return GetJWTCredentialKeys500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+
+	var resp GetJWTCredentialKeys200JSONResponse
+	if err := convertMessage(keys, &resp); err != nil {
+		return GetJWTCredentialKeys500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	return resp, nil
+}
+
+// GetStatusListCredential returns the StatusList2021 credential of an identity as a JWT VC, this endpoint must be public available
+func (s *Server) GetStatusListCredential(ctx context.Context, request GetStatusListCredentialRequestObject) (GetStatusListCredentialResponseObject, error) {
+	did, err := core.ParseDID(request.Identifier)
+	if err != nil {
+		return GetStatusListCredential400JSONResponse{N400JSONResponse{"invalid did"}}, nil
+	}
+
+	token, err := s.jwtCredentials.StatusList(ctx, *did)
+	if err != nil {
+		if errors.Is(err, services.ErrJWTCredentialIdentityNotFound) {
+			return GetStatusListCredential404JSONResponse{N404JSONResponse{err.Error()}}, nil
+		}
+		log.Error(ctx, "loading status list credential", "err", err, "did", request.Identifier)
+		return GetStatusListCredential500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	return GetStatusListCredential200ApplicationvcJwtResponse{Body: strings.NewReader(token), ContentLength: int64(len(token))}, nil
+}
+
+// ResolveDIDDocument returns the DID resolution of an identity, with its service entries
+func (s *Server) ResolveDIDDocument(ctx context.Context, request ResolveDIDDocumentRequestObject) (ResolveDIDDocumentResponseObject, error) {
+	did, err := core.ParseDID(request.Identifier)
+	if err != nil {
+		return ResolveDIDDocument400JSONResponse{N400JSONResponse{"invalid did"}}, nil
+	}
+
+	doc, err := s.didDocuments.Get(ctx, *did)
+	if err != nil {
+		if errors.Is(err, services.ErrDIDDocumentIdentityNotFound) {
+			return ResolveDIDDocument404JSONResponse{N404JSONResponse{err.Error()}}, nil
+		}
+		log.Error(ctx, "resolving did document", "err", err, "did", request.Identifier)
+		return ResolveDIDDocument500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	return ResolveDIDDocument200JSONResponse(didResolutionResponse(doc)), nil
+}
+
+// GetDIDServices returns the service entries of the DID document of an identity
+func (s *Server) GetDIDServices(ctx context.Context, request GetDIDServicesRequestObject) (GetDIDServicesResponseObject, error) {
+	did, err := core.ParseDID(request.Identifier)
+	if err != nil {
+		return GetDIDServices400JSONResponse{N400JSONResponse{"invalid did"}}, nil
+	}
+
+	didServices, err := s.didDocuments.GetServices(ctx, *did)
+	if err != nil {
+		if errors.Is(err, services.ErrDIDDocumentIdentityNotFound) {
+			return GetDIDServices404JSONResponse{N404JSONResponse{err.Error()}}, nil
+		}
+		return GetDIDServices500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+
+	resp := make(GetDIDServices200JSONResponse, len(didServices))
+	for i, service := range didServices {
+		resp[i] = didServiceResponse(service)
+	}
+	return resp, nil
+}
+
+// SetDIDService adds a service entry to the DID document of an identity or replaces the one with the same id
+func (s *Server) SetDIDService(ctx context.Context, request SetDIDServiceRequestObject) (SetDIDServiceResponseObject, error) {
+	did, err := core.ParseDID(request.Identifier)
+	if err != nil {
+		return SetDIDService400JSONResponse{N400JSONResponse{"invalid did"}}, nil
+	}
+
+	service, err := s.didDocuments.SaveService(ctx, *did, &domain.DIDService{
+		ID:              request.ServiceId,
+		Type:            request.Body.Type,
+		ServiceEndpoint: request.Body.ServiceEndpoint,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrInvalidDIDService):
+			return SetDIDService400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		case errors.Is(err, services.ErrDIDDocumentIdentityNotFound):
+			return SetDIDService404JSONResponse{N404JSONResponse{err.Error()}}, nil
+		}
+		return SetDIDService500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	return SetDIDService200JSONResponse(didServiceResponse(service)), nil
+}
+
+// DeleteDIDService removes a service entry from the DID document of an identity
+func (s *Server) DeleteDIDService(ctx context.Context, request DeleteDIDServiceRequestObject) (DeleteDIDServiceResponseObject, error) {
+	did, err := core.ParseDID(request.Identifier)
+	if err != nil {
+		return DeleteDIDService400JSONResponse{N400JSONResponse{"invalid did"}}, nil
+	}
+
+	if err := s.didDocuments.DeleteService(ctx, *did, request.ServiceId); err != nil {
+		if errors.Is(err, services.ErrDIDServiceNotFound) {
+			return DeleteDIDService404JSONResponse{N404JSONResponse{err.Error()}}, nil
+		}
+		return DeleteDIDService500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	return DeleteDIDService200JSONResponse{Message: "did service deleted"}, nil
 }
 
 // GetClaimQrCode returns a GetClaimQrCodeResponseObject that can be used with any QR generator to create a QR and
@@ -320,7 +649,88 @@ func (s *Server) GetClaimQrCode(ctx context.Context, request GetClaimQrCodeReque
 		}
 		return GetClaimQrCode500JSONResponse{N500JSONResponse{err.Error()}}, nil
 	}
-	return toGetClaimQrCode200JSONResponse(claim, s.cfg.ServerUrl), nil
+
+	offer, err := s.claimService.CreateOffer(ctx, *did, claim.ID)
+	if err != nil {
+		return GetClaimQrCode500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	profile, err := s.profiles.Get(ctx, *did)
+	if err != nil {
+		log.Error(ctx, "loading issuer profile", "err", err, "did", did.String())
+		return GetClaimQrCode500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	return toGetClaimQrCode200JSONResponse(claim, offer, profile, s.cfg.ServerUrl), nil
+}
+
+// RenewClaimOffer creates a new offer of the claim and returns the message of its QR code, optionally pushing it to the holder
+func (s *Server) RenewClaimOffer(ctx context.Context, request RenewClaimOfferRequestObject) (RenewClaimOfferResponseObject, error) {
+	did, err := core.ParseDID(request.Identifier)
+	if err != nil {
+		return RenewClaimOffer400JSONResponse{N400JSONResponse{"invalid did"}}, nil
+	}
+	claimID, err := uuid.Parse(request.Id)
+	if err != nil {
+		return RenewClaimOffer400JSONResponse{N400JSONResponse{"invalid claim id"}}, nil
+	}
+
+	notify := request.Body.Notify != nil && *request.Body.Notify
+	offer, err := s.claimService.RenewOffer(ctx, *did, claimID, notify)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrClaimNotFound):
+			return RenewClaimOffer404JSONResponse{N404JSONResponse{err.Error()}}, nil
+		case errors.Is(err, services.ErrCredentialRevoked), errors.Is(err, services.ErrCredentialWithoutHolder):
+			return RenewClaimOffer400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		}
+		return RenewClaimOffer500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+
+	claim, err := s.claimService.GetByID(ctx, did, claimID)
+	if err != nil {
+		return RenewClaimOffer500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	profile, err := s.profiles.Get(ctx, *did)
+	if err != nil {
+		log.Error(ctx, "loading issuer profile", "err", err, "did", did.String())
+		return RenewClaimOffer500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	return RenewClaimOffer201JSONResponse(*toGetClaimQrCode200JSONResponse(claim, offer, profile, s.cfg.ServerUrl)), nil
+}
+
+// GetClaimWalletLinks returns the wallet links of a new offer of the claim
+func (s *Server) GetClaimWalletLinks(ctx context.Context, request GetClaimWalletLinksRequestObject) (GetClaimWalletLinksResponseObject, error) {
+	did, err := core.ParseDID(request.Identifier)
+	if err != nil {
+		return GetClaimWalletLinks400JSONResponse{N400JSONResponse{"invalid did"}}, nil
+	}
+	claimID, err := uuid.Parse(request.Id)
+	if err != nil {
+		return GetClaimWalletLinks400JSONResponse{N400JSONResponse{"invalid claim id"}}, nil
+	}
+
+	claim, err := s.claimService.GetByID(ctx, did, claimID)
+	if err != nil {
+		if errors.Is(err, services.ErrClaimNotFound) {
+			return GetClaimWalletLinks404JSONResponse{N404JSONResponse{err.Error()}}, nil
+		}
+		return GetClaimWalletLinks500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+
+	offer, err := s.claimService.CreateOffer(ctx, *did, claim.ID)
+	if err != nil {
+		return GetClaimWalletLinks500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	profile, err := s.profiles.Get(ctx, *did)
+	if err != nil {
+		log.Error(ctx, "loading issuer profile", "err", err, "did", did.String())
+		return GetClaimWalletLinks500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	links, err := s.walletLinks.Links(toGetClaimQrCode200JSONResponse(claim, offer, profile, s.cfg.ServerUrl))
+	if err != nil {
+		log.Error(ctx, "building the wallet links", "err", err, "id", claimID)
+		return GetClaimWalletLinks500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	return GetClaimWalletLinks200JSONResponse{DeepLink: links.DeepLink, UniversalLink: links.UniversalLink}, nil
 }
 
 // GetIdentities is the controller to get identities
@@ -341,25 +751,58 @@ func (s *Server) GetIdentities(ctx context.Context, request GetIdentitiesRequest
 func (s *Server) Agent(ctx context.Context, request AgentRequestObject) (AgentResponseObject, error) {
 	if request.Body == nil || *request.Body == "" {
 		log.Debug(ctx, "agent empty request")
-		return Agent400JSONResponse{N400JSONResponse{"cannot proceed with an empty request"}}, nil
+		return Agent400JSONResponse{Message: "cannot proceed with an empty request"}, nil
 	}
-	basicMessage, err := s.packageManager.UnpackWithType(packers.MediaTypeZKPMessage, []byte(*request.Body))
+	envelope := []byte(*request.Body)
+	basicMessage, mediaType, err := s.packageManager.Unpack(envelope)
 	if err != nil {
-		log.Debug(ctx, "agent bad request", "err", err, "body", *request.Body)
-		return Agent400JSONResponse{N400JSONResponse{"cannot proceed with the given request"}}, nil
+		log.Debug(ctx, "agent bad request", "err", err, "mediaType", mediaType, "body", *request.Body)
+		return Agent400JSONResponse{Message: "cannot proceed with the given request"}, nil
+	}
+
+	// the node serves many identities, the messages are only recorded when their recipient is known
+	var inbound *domain.InboxMessage
+	if issuerDID, err := core.ParseDID(basicMessage.To); err == nil {
+		inbound = domain.NewInboxMessage(*issuerDID, envelope, basicMessage, mediaType)
 	}
 
-	req, err := ports.NewAgentRequest(basicMessage)
+	req, err := ports.NewAgentRequest(basicMessage, mediaType)
 	if err != nil {
 		log.Error(ctx, "agent parsing request", "err", err)
-		return Agent400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		if inbound != nil {
+			inbound.Reject(err)
+			s.recordAgentMessage(ctx, inbound)
+		}
+		return Agent400JSONResponse{Message: err.Error()}, nil
 	}
 
 	agent, err := s.claimService.Agent(ctx, req)
 	if err != nil {
 		log.Error(ctx, "agent error", "err", err)
-		return Agent400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		report := s.claimService.ReportProblem(ctx, req, err)
+		if inbound != nil {
+			inbound.Fail(err, report)
+			s.recordAgentMessage(ctx, inbound)
+		}
+		response := agentProblemReportResponse(err, report)
+		switch {
+		case errors.Is(err, services.ErrAgentSenderNotVerified):
+			return Agent401JSONResponse(response), nil
+		case errors.Is(err, services.ErrCredentialOfferConsumed), errors.Is(err, services.ErrAgentMessageInProgress):
+			return Agent409JSONResponse(response), nil
+		case errors.Is(err, services.ErrCredentialOfferExpired):
+			return Agent410JSONResponse(response), nil
+		}
+		return Agent400JSONResponse(response), nil
 	}
+	if inbound != nil {
+		inbound.Process(agent)
+		s.recordAgentMessage(ctx, inbound)
+	}
+	if agent == nil {
+		return Agent202Response{}, nil
+	}
+
 	return Agent200JSONResponse{
 		Body:     agent.Body,
 		From:     agent.From,
@@ -371,6 +814,168 @@ func (s *Server) Agent(ctx context.Context, request AgentRequestObject) (AgentRe
 	}, nil
 }
 
+// agentProblemReportResponse returns the problem report that answers a failed agent request, with the error as the
+// message for the clients that do not read problem reports
+func agentProblemReportResponse(err error, report *domain.Agent) AgentProblemReport {
+	body, _ := report.Body.(ports.ProblemReportMessageBody)
+	return AgentProblemReport{
+		Message:        err.Error(),
+		Id:             common.ToPointer(report.ID),
+		Typ:            common.ToPointer(string(report.Typ)),
+		Type:           common.ToPointer(string(report.Type)),
+		ThreadID:       common.ToPointer(report.ThreadID),
+		ParentThreadID: common.ToPointer(report.ParentThreadID),
+		Body:           &ProblemReportBody{Code: body.Code, Comment: common.ToPointer(body.Comment)},
+		From:           common.ToPointer(report.From),
+		To:             common.ToPointer(report.To),
+	}
+}
+
+// recordAgentMessage keeps the message received by the agent in the inbox of its recipient. The answer to the wallet
+// does not depend on it, so a failure is only logged.
+func (s *Server) recordAgentMessage(ctx context.Context, message *domain.InboxMessage) {
+	if err := s.agentInbox.Record(ctx, message); err != nil {
+		log.Error(ctx, "recording agent message", "err", err, "id", message.MessageID, "thid", message.ThreadID)
+	}
+}
+
+// GetAgentMessages returns the newest messages received by the agent for the identity
+func (s *Server) GetAgentMessages(ctx context.Context, request GetAgentMessagesRequestObject) (GetAgentMessagesResponseObject, error) {
+	did, err := core.ParseDID(request.Identifier)
+	if err != nil {
+		return GetAgentMessages400JSONResponse{N400JSONResponse{"invalid did"}}, nil
+	}
+	var filter ports.InboxFilter
+	if request.Params.Sender != nil {
+		filter.Sender = *request.Params.Sender
+	}
+	if request.Params.ThreadID != nil {
+		filter.ThreadID = *request.Params.ThreadID
+	}
+	if request.Params.Type != nil {
+		filter.Type = *request.Params.Type
+	}
+	if request.Params.Status != nil {
+		status := domain.InboxMessageStatus(*request.Params.Status)
+		filter.Status = &status
+	}
+	if request.Params.Limit != nil {
+		if *request.Params.Limit < 1 || *request.Params.Limit > 1000 {
+			return GetAgentMessages400JSONResponse{N400JSONResponse{"limit must be between 1 and 1000"}}, nil
+		}
+		filter.Limit = *request.Params.Limit
+	}
+
+	messages, err := s.agentInbox.GetAll(ctx, *did, filter)
+	if errors.Is(err, db.ErrQueryTimeout) {
+		return nil, err
+	}
+	if err != nil {
+		log.Error(ctx, "getting agent messages", "err", err, "did", request.Identifier)
+		return GetAgentMessages500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	response := make(GetAgentMessages200JSONResponse, len(messages))
+	for i, message := range messages {
+		response[i] = agentInboxMessageResponse(message)
+	}
+	return response, nil
+}
+
+// GetAgentMessageEnvelope downloads a message received by the agent for the identity as it was received
+func (s *Server) GetAgentMessageEnvelope(ctx context.Context, request GetAgentMessageEnvelopeRequestObject) (GetAgentMessageEnvelopeResponseObject, error) {
+	did, err := core.ParseDID(request.Identifier)
+	if err != nil {
+		return GetAgentMessageEnvelope400JSONResponse{N400JSONResponse{"invalid did"}}, nil
+	}
+	message, err := s.agentInbox.GetByID(ctx, *did, request.Id)
+	if err != nil {
+		if errors.Is(err, services.ErrInboxMessageNotFound) {
+			return GetAgentMessageEnvelope404JSONResponse{N404JSONResponse{err.Error()}}, nil
+		}
+		log.Error(ctx, "getting agent message", "err", err, "id", request.Id)
+		return GetAgentMessageEnvelope500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	return GetAgentMessageEnvelope200ApplicationoctetStreamResponse{
+		Body:          bytes.NewReader(message.Envelope),
+		ContentLength: int64(len(message.Envelope)),
+		Headers:       GetAgentMessageEnvelope200ResponseHeaders{ContentDisposition: fmt.Sprintf(`attachment; filename="%s.envelope"`, message.ID)},
+	}, nil
+}
+
+func agentInboxMessageResponse(message *domain.InboxMessage) AgentInboxMessage {
+	response := AgentInboxMessage{
+		Id:        message.ID,
+		Sender:    message.Sender,
+		MessageID: message.MessageID,
+		ThreadID:  message.ThreadID,
+		Type:      message.Type,
+		MediaType: message.MediaType,
+		Status:    AgentInboxMessageStatus(message.Status),
+		CreatedAt: message.CreatedAt,
+	}
+	if message.Error != "" {
+		response.Error = common.ToPointer(message.Error)
+	}
+	if message.ResponseType != "" {
+		response.ResponseType = common.ToPointer(message.ResponseType)
+	}
+	return response
+}
+
+// GetAgentCapabilities returns what the agent of the node supports, for wallets and partner agents to negotiate
+// before sending messages
+func (s *Server) GetAgentCapabilities(ctx context.Context, _ GetAgentCapabilitiesRequestObject) (GetAgentCapabilitiesResponseObject, error) {
+	accepts := make([]string, len(ports.AgentMessageTypes))
+	for i, typ := range ports.AgentMessageTypes {
+		accepts[i] = string(typ)
+	}
+	// identities can enable the reverse hash service with the rhs feature flag when it is not enabled for every identity
+	statusTypes := []string{string(verifiable.SparseMerkleTreeProof)}
+	rhsURL := s.cfg.ReverseHashService.URL
+	if s.settings != nil {
+		rhsURL = s.settings.Current(ctx).RHSURL
+	}
+	if s.cfg.ReverseHashService.Enabled || rhsURL != "" {
+		statusTypes = append(statusTypes, string(verifiable.Iden3ReverseSparseMerkleTreeProof))
+	}
+	networks := []string{}
+	if s.networkResolver != nil {
+		networks = s.networkResolver.Networks()
+	}
+	// the plain messages can only ask for public information, see ports.AgentAuthenticatedMediaTypes. The encrypted
+	// envelopes are opened by the middleware, when the node has encryption keys
+	mediaTypes := []string{string(packers.MediaTypeZKPMessage), string(packers.MediaTypePlainMessage)}
+	if s.cfg.EncryptionKeys != "" {
+		mediaTypes = append(mediaTypes, string(packers.MediaTypeEncryptedMessage))
+	}
+
+	return GetAgentCapabilities200JSONResponse{
+		Agent: fmt.Sprintf("%s/v1/agent", strings.TrimSuffix(s.cfg.ServerUrl, "/")),
+		Accepts: AgentMessages{
+			MediaTypes: mediaTypes,
+			Messages:   accepts,
+		},
+		Sends: AgentMessages{
+			MediaTypes: []string{string(packers.MediaTypePlainMessage)},
+			Messages: []string{
+				string(protocol.CredentialOfferMessageType),
+				string(protocol.CredentialIssuanceResponseMessageType),
+				string(protocol.RevocationStatusResponseMessageType),
+				string(ports.CredentialProposalMessageType),
+				string(ports.ProblemReportMessageType),
+			},
+		},
+		Circuits: []string{string(circuits.AuthV2CircuitID), string(circuits.AtomicQuerySigV2CircuitID), string(circuits.AtomicQueryMTPV2CircuitID)},
+		Credentials: CredentialCapabilities{
+			Formats:     []string{verifiable.TypeW3CVerifiableCredential},
+			SchemaTypes: []string{verifiable.JSONSchemaValidator2018},
+			ProofTypes:  []string{string(verifiable.BJJSignatureProofType), string(verifiable.Iden3SparseMerkleTreeProofType)},
+			StatusTypes: statusTypes,
+		},
+		Networks: networks,
+	}, nil
+}
+
 // PublishIdentityState - publish identity state on chain
 func (s *Server) PublishIdentityState(ctx context.Context, request PublishIdentityStateRequestObject) (PublishIdentityStateResponseObject, error) {
 	did, err := core.ParseDID(request.Identifier)
@@ -395,23 +1000,1142 @@ func (s *Server) PublishIdentityState(ctx context.Context, request PublishIdenti
 	}, nil
 }
 
-// RegisterStatic add method to the mux that are not documented in the API.
-func RegisterStatic(mux *chi.Mux) {
-	mux.Get("/", documentation)
-	mux.Get("/static/docs/api/api.yaml", swagger)
-	mux.Get("/favicon.ico", favicon)
+// GetStateTransactions - returns the latest state transactions sent for the identity
+func (s *Server) GetStateTransactions(ctx context.Context, request GetStateTransactionsRequestObject) (GetStateTransactionsResponseObject, error) {
+	did, err := core.ParseDID(request.Identifier)
+	if err != nil {
+		return GetStateTransactions400JSONResponse{N400JSONResponse{"invalid did"}}, nil
+	}
+
+	txs, err := s.publisherGateway.GetStateTransactions(ctx, did)
+	if err != nil {
+		log.Error(ctx, "getting state transactions", "err", err, "did", did.String())
+		return GetStateTransactions500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+
+	resp := make(GetStateTransactions200JSONResponse, 0, len(txs))
+	for _, tx := range txs {
+		resp = append(resp, stateTransactionResponse(tx))
+	}
+	return resp, nil
 }
 
-func toGetClaims200Response(claims []*verifiable.W3CCredential) GetClaims200JSONResponse {
-	response := make(GetClaims200JSONResponse, len(claims))
-	for i := range claims {
-		response[i] = toGetClaim200Response(claims[i])
+// GetStateIntegrity - verifies the merkle trees of the identity against its stored and published states
+func (s *Server) GetStateIntegrity(ctx context.Context, request GetStateIntegrityRequestObject) (GetStateIntegrityResponseObject, error) {
+	did, err := core.ParseDID(request.Identifier)
+	if err != nil {
+		return GetStateIntegrity400JSONResponse{N400JSONResponse{"invalid did"}}, nil
 	}
 
-	return response
+	report, err := s.treeIntegrity.Verify(ctx, *did)
+	if errors.Is(err, services.ErrTreeIntegrityIdentityNotFound) {
+		return GetStateIntegrity404JSONResponse{N404JSONResponse{err.Error()}}, nil
+	}
+	if err != nil {
+		log.Error(ctx, "verifying identity trees", "err", err, "did", did.String())
+		return GetStateIntegrity500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	return GetStateIntegrity200JSONResponse(stateIntegrityResponse(report)), nil
 }
 
-func toGetClaim200Response(claim *verifiable.W3CCredential) GetClaimResponse {
+// RotateAuthKey - starts the rotation of the identity auth key
+func (s *Server) RotateAuthKey(ctx context.Context, request RotateAuthKeyRequestObject) (RotateAuthKeyResponseObject, error) {
+	did, err := core.ParseDID(request.Identifier)
+	if err != nil {
+		return RotateAuthKey400JSONResponse{N400JSONResponse{"invalid did"}}, nil
+	}
+
+	rotation, key, err := s.keyRotation.Rotate(ctx, *did)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrAuthKeyNotFound):
+			return RotateAuthKey404JSONResponse{N404JSONResponse{err.Error()}}, nil
+		case errors.Is(err, services.ErrKeyRotationPending):
+			return RotateAuthKey409JSONResponse{N409JSONResponse{err.Error()}}, nil
+		}
+		return RotateAuthKey500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+
+	return RotateAuthKey202JSONResponse{
+		Id:     rotation.ID,
+		Status: RotateAuthKeyResponseStatus(rotation.Status),
+		Key:    authKeyResponse(key),
+	}, nil
+}
+
+// GetAuthKeys - returns the auth keys of the identity with their status
+func (s *Server) GetAuthKeys(ctx context.Context, request GetAuthKeysRequestObject) (GetAuthKeysResponseObject, error) {
+	did, err := core.ParseDID(request.Identifier)
+	if err != nil {
+		return GetAuthKeys400JSONResponse{N400JSONResponse{"invalid did"}}, nil
+	}
+
+	keys, err := s.keyRotation.GetAuthKeys(ctx, *did)
+	if err != nil {
+		if errors.Is(err, services.ErrAuthKeyNotFound) {
+			return GetAuthKeys404JSONResponse{N404JSONResponse{err.Error()}}, nil
+		}
+		return GetAuthKeys500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+
+	resp := make(GetAuthKeys200JSONResponse, len(keys))
+	for i, key := range keys {
+		resp[i] = authKeyResponse(key)
+	}
+	return resp, nil
+}
+
+// GetFeatureFlags - returns the value of every feature for the identity
+func (s *Server) GetFeatureFlags(ctx context.Context, request GetFeatureFlagsRequestObject) (GetFeatureFlagsResponseObject, error) {
+	did, err := core.ParseDID(request.Identifier)
+	if err != nil {
+		return GetFeatureFlags400JSONResponse{N400JSONResponse{"invalid did"}}, nil
+	}
+
+	flags, err := s.featureFlags.GetAll(ctx, *did)
+	if err != nil {
+		return GetFeatureFlags500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+
+	resp := make(GetFeatureFlags200JSONResponse, len(flags))
+	for i, flag := range flags {
+		resp[i] = featureFlagResponse(flag)
+	}
+	return resp, nil
+}
+
+// SetFeatureFlag - overrides the value of the feature for the identity
+func (s *Server) SetFeatureFlag(ctx context.Context, request SetFeatureFlagRequestObject) (SetFeatureFlagResponseObject, error) {
+	did, err := core.ParseDID(request.Identifier)
+	if err != nil {
+		return SetFeatureFlag400JSONResponse{N400JSONResponse{"invalid did"}}, nil
+	}
+
+	flag, err := s.featureFlags.Set(ctx, *did, domain.Feature(request.Feature), request.Body.Enabled)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrUnknownFeature):
+			return SetFeatureFlag400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		case errors.Is(err, services.ErrFeatureFlagIdentityNotFound):
+			return SetFeatureFlag404JSONResponse{N404JSONResponse{err.Error()}}, nil
+		}
+		return SetFeatureFlag500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	return SetFeatureFlag200JSONResponse(featureFlagResponse(flag)), nil
+}
+
+// ResetFeatureFlag - removes the override of the feature for the identity
+func (s *Server) ResetFeatureFlag(ctx context.Context, request ResetFeatureFlagRequestObject) (ResetFeatureFlagResponseObject, error) {
+	did, err := core.ParseDID(request.Identifier)
+	if err != nil {
+		return ResetFeatureFlag400JSONResponse{N400JSONResponse{"invalid did"}}, nil
+	}
+
+	flag, err := s.featureFlags.Reset(ctx, *did, domain.Feature(request.Feature))
+	if err != nil {
+		if errors.Is(err, services.ErrUnknownFeature) {
+			return ResetFeatureFlag400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		}
+		return ResetFeatureFlag500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	return ResetFeatureFlag200JSONResponse(featureFlagResponse(flag)), nil
+}
+
+// GetPublishingPolicy - returns when the pending changes of the identity are published
+func (s *Server) GetPublishingPolicy(ctx context.Context, request GetPublishingPolicyRequestObject) (GetPublishingPolicyResponseObject, error) {
+	did, err := core.ParseDID(request.Identifier)
+	if err != nil {
+		return GetPublishingPolicy400JSONResponse{N400JSONResponse{"invalid did"}}, nil
+	}
+
+	policy, err := s.publishing.Get(ctx, *did)
+	if err != nil {
+		return GetPublishingPolicy500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	return GetPublishingPolicy200JSONResponse(publishingPolicyResponse(policy)), nil
+}
+
+// SetPublishingPolicy - sets the publishing policy of the identity
+func (s *Server) SetPublishingPolicy(ctx context.Context, request SetPublishingPolicyRequestObject) (SetPublishingPolicyResponseObject, error) {
+	did, err := core.ParseDID(request.Identifier)
+	if err != nil {
+		return SetPublishingPolicy400JSONResponse{N400JSONResponse{"invalid did"}}, nil
+	}
+
+	policy, err := s.publishing.Set(ctx, *did, publishingPolicyRequest(request.Body))
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrInvalidPublishingPolicy):
+			return SetPublishingPolicy400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		case errors.Is(err, services.ErrPublishingPolicyIdentityNotFound):
+			return SetPublishingPolicy404JSONResponse{N404JSONResponse{err.Error()}}, nil
+		}
+		return SetPublishingPolicy500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	return SetPublishingPolicy200JSONResponse(publishingPolicyResponse(policy)), nil
+}
+
+// ResetPublishingPolicy - removes the publishing policy of the identity
+func (s *Server) ResetPublishingPolicy(ctx context.Context, request ResetPublishingPolicyRequestObject) (ResetPublishingPolicyResponseObject, error) {
+	did, err := core.ParseDID(request.Identifier)
+	if err != nil {
+		return ResetPublishingPolicy400JSONResponse{N400JSONResponse{"invalid did"}}, nil
+	}
+
+	policy, err := s.publishing.Reset(ctx, *did)
+	if err != nil {
+		return ResetPublishingPolicy500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	return ResetPublishingPolicy200JSONResponse(publishingPolicyResponse(policy)), nil
+}
+
+// GetSettings - returns the operational settings that apply without a restart
+func (s *Server) GetSettings(ctx context.Context, _ GetSettingsRequestObject) (GetSettingsResponseObject, error) {
+	settings, err := s.settings.Get(ctx)
+	if err != nil {
+		log.Error(ctx, "getting settings", "err", err)
+		return GetSettings500JSONResponse{N500JSONResponse{"error getting the settings"}}, nil
+	}
+	return GetSettings200JSONResponse(settingsResponse(settings)), nil
+}
+
+// UpdateSettings - overrides the given settings
+func (s *Server) UpdateSettings(ctx context.Context, request UpdateSettingsRequestObject) (UpdateSettingsResponseObject, error) {
+	overrides := &domain.SettingsOverrides{RHSURL: request.Body.RhsUrl}
+	if request.Body.Publishing != nil {
+		overrides.OverridePublishing(*publishingPolicyRequest(request.Body.Publishing))
+	}
+	if rateLimit := request.Body.RateLimit; rateLimit != nil {
+		overrides.RateLimitEnabled = rateLimit.Enabled
+		if rateLimit.Authenticated != nil {
+			overrides.RateLimitAuthenticated = &domain.RateLimitBucket{RequestsPerMinute: rateLimit.Authenticated.RequestsPerMinute, Burst: rateLimit.Authenticated.Burst}
+		}
+		if rateLimit.Anonymous != nil {
+			overrides.RateLimitAnonymous = &domain.RateLimitBucket{RequestsPerMinute: rateLimit.Anonymous.RequestsPerMinute, Burst: rateLimit.Anonymous.Burst}
+		}
+	}
+	if notifications := request.Body.Notifications; notifications != nil {
+		overrides.OfferEmails = notifications.OfferEmails
+		overrides.Reports = notifications.Reports
+		overrides.BacklogAlerts = notifications.BacklogAlerts
+	}
+
+	settings, err := s.settings.Update(ctx, overrides)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidSettings) {
+			return UpdateSettings400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		}
+		log.Error(ctx, "updating settings", "err", err)
+		return UpdateSettings500JSONResponse{N500JSONResponse{"error updating the settings"}}, nil
+	}
+	return UpdateSettings200JSONResponse(settingsResponse(settings)), nil
+}
+
+// ResetSettings - removes the overridden settings
+func (s *Server) ResetSettings(ctx context.Context, _ ResetSettingsRequestObject) (ResetSettingsResponseObject, error) {
+	settings, err := s.settings.Reset(ctx)
+	if err != nil {
+		log.Error(ctx, "resetting settings", "err", err)
+		return ResetSettings500JSONResponse{N500JSONResponse{"error resetting the settings"}}, nil
+	}
+	return ResetSettings200JSONResponse(settingsResponse(settings)), nil
+}
+
+// ApplyRevocationDecisions - revokes the credentials as decided by an external system
+func (s *Server) ApplyRevocationDecisions(ctx context.Context, request ApplyRevocationDecisionsRequestObject) (ApplyRevocationDecisionsResponseObject, error) {
+	did, err := core.ParseDID(request.Identifier)
+	if err != nil {
+		return ApplyRevocationDecisions400JSONResponse{N400JSONResponse{"invalid did"}}, nil
+	}
+
+	requests := make([]ports.RevocationDecisionRequest, len(request.Body.Decisions))
+	for i, decision := range request.Body.Decisions {
+		requests[i] = ports.RevocationDecisionRequest{
+			ID:              decision.Id,
+			CredentialID:    decision.CredentialId,
+			RevocationNonce: decision.RevocationNonce,
+			DecidedAt:       decision.DecidedAt,
+		}
+		if decision.Reason != nil {
+			requests[i].Reason = *decision.Reason
+		}
+	}
+	decisions, err := s.decisions.Apply(ctx, *did, request.Body.Source, requests)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrInvalidRevocationDecisions):
+			return ApplyRevocationDecisions400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		case errors.Is(err, services.ErrRevocationDecisionsIdentityNotFound):
+			return ApplyRevocationDecisions404JSONResponse{N404JSONResponse{err.Error()}}, nil
+		}
+		log.Error(ctx, "applying revocation decisions", "err", err, "did", request.Identifier, "source", request.Body.Source)
+		return ApplyRevocationDecisions500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	return ApplyRevocationDecisions200JSONResponse(revocationDecisionsReportResponse(domain.NewRevocationDecisionsReport(decisions))), nil
+}
+
+// GetRevocationDecisionsReport - returns the applied and rejected revocation decisions of the period
+func (s *Server) GetRevocationDecisionsReport(ctx context.Context, request GetRevocationDecisionsReportRequestObject) (GetRevocationDecisionsReportResponseObject, error) {
+	did, err := core.ParseDID(request.Identifier)
+	if err != nil {
+		return GetRevocationDecisionsReport400JSONResponse{N400JSONResponse{"invalid did"}}, nil
+	}
+
+	filter := &ports.RevocationDecisionsFilter{From: request.Params.From, To: request.Params.To}
+	if request.Params.Source != nil {
+		filter.Source = *request.Params.Source
+	}
+	if request.Params.Status != nil {
+		status := domain.RevocationDecisionStatus(*request.Params.Status)
+		if status != domain.RevocationDecisionApplied && status != domain.RevocationDecisionRejected {
+			return GetRevocationDecisionsReport400JSONResponse{N400JSONResponse{"invalid status"}}, nil
+		}
+		filter.Status = &status
+	}
+	report, err := s.decisions.Report(ctx, *did, filter)
+	if err != nil {
+		return GetRevocationDecisionsReport500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	return GetRevocationDecisionsReport200JSONResponse(revocationDecisionsReportResponse(report)), nil
+}
+
+// CreateVerificationRequest - creates a request of zero knowledge proofs for the holders
+func (s *Server) CreateVerificationRequest(ctx context.Context, request CreateVerificationRequestRequestObject) (CreateVerificationRequestResponseObject, error) {
+	did, err := core.ParseDID(request.Identifier)
+	if err != nil {
+		return CreateVerificationRequest400JSONResponse{N400JSONResponse{"invalid did"}}, nil
+	}
+
+	verificationRequest := &domain.VerificationRequest{
+		IssuerDID: *did,
+		Scope:     make([]domain.VerificationQuery, len(request.Body.Scope)),
+		ExpiresAt: request.Body.ExpiresAt,
+	}
+	if request.Body.Reason != nil {
+		verificationRequest.Reason = *request.Body.Reason
+	}
+	for i, query := range request.Body.Scope {
+		verificationRequest.Scope[i] = verificationQuery(query)
+	}
+	created, err := s.verification.CreateRequest(ctx, verificationRequest)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrInvalidVerificationRequest):
+			return CreateVerificationRequest400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		case errors.Is(err, services.ErrVerificationIdentityNotFound):
+			return CreateVerificationRequest404JSONResponse{N404JSONResponse{err.Error()}}, nil
+		}
+		log.Error(ctx, "creating verification request", "err", err, "did", request.Identifier)
+		return CreateVerificationRequest500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	return CreateVerificationRequest201JSONResponse(verificationRequestResponse(created)), nil
+}
+
+// GetVerificationRequest - returns the verification request
+func (s *Server) GetVerificationRequest(ctx context.Context, request GetVerificationRequestRequestObject) (GetVerificationRequestResponseObject, error) {
+	did, err := core.ParseDID(request.Identifier)
+	if err != nil {
+		return GetVerificationRequest400JSONResponse{N400JSONResponse{"invalid did"}}, nil
+	}
+
+	verificationRequest, err := s.verification.GetRequest(ctx, *did, request.Id)
+	if err != nil {
+		if errors.Is(err, services.ErrVerificationRequestNotFound) {
+			return GetVerificationRequest404JSONResponse{N404JSONResponse{err.Error()}}, nil
+		}
+		return GetVerificationRequest500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	return GetVerificationRequest200JSONResponse(verificationRequestResponse(verificationRequest)), nil
+}
+
+// GetVerificationRequestQrCode - returns the authorization request the holders scan to answer the verification request
+func (s *Server) GetVerificationRequestQrCode(ctx context.Context, request GetVerificationRequestQrCodeRequestObject) (GetVerificationRequestQrCodeResponseObject, error) {
+	did, err := core.ParseDID(request.Identifier)
+	if err != nil {
+		return GetVerificationRequestQrCode400JSONResponse{N400JSONResponse{"invalid did"}}, nil
+	}
+
+	message, err := s.verification.GetAuthorizationRequest(ctx, *did, request.Id)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrVerificationRequestNotFound):
+			return GetVerificationRequestQrCode404JSONResponse{N404JSONResponse{err.Error()}}, nil
+		case errors.Is(err, services.ErrVerificationRequestExpired):
+			return GetVerificationRequestQrCode410JSONResponse{N410JSONResponse{err.Error()}}, nil
+		}
+		return GetVerificationRequestQrCode500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	var resp GetVerificationRequestQrCode200JSONResponse
+	if err := convertMessage(message, &resp); err != nil {
+		return GetVerificationRequestQrCode500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	return resp, nil
+}
+
+// VerificationCallback - verifies the proofs a holder sends in answer to a verification request
+func (s *Server) VerificationCallback(ctx context.Context, request VerificationCallbackRequestObject) (VerificationCallbackResponseObject, error) {
+	if request.Body == nil || *request.Body == "" {
+		return VerificationCallback400JSONResponse{N400JSONResponse{"empty token"}}, nil
+	}
+
+	response, err := s.verification.Verify(ctx, request.Params.RequestID, string(*request.Body))
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrVerificationRequestNotFound):
+			return VerificationCallback404JSONResponse{N404JSONResponse{err.Error()}}, nil
+		case errors.Is(err, services.ErrVerificationRequestExpired):
+			return VerificationCallback410JSONResponse{N410JSONResponse{err.Error()}}, nil
+		case errors.Is(err, services.ErrInvalidVerificationResponse):
+			return VerificationCallback400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		}
+		log.Error(ctx, "verifying proofs", "err", err, "request", request.Params.RequestID)
+		return VerificationCallback500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	return VerificationCallback200JSONResponse(verificationResponse(response)), nil
+}
+
+// GetVerificationResponses - returns the outcome of the verifications of the identity
+func (s *Server) GetVerificationResponses(ctx context.Context, request GetVerificationResponsesRequestObject) (GetVerificationResponsesResponseObject, error) {
+	did, err := core.ParseDID(request.Identifier)
+	if err != nil {
+		return GetVerificationResponses400JSONResponse{N400JSONResponse{"invalid did"}}, nil
+	}
+
+	filter := &ports.VerificationResponsesFilter{RequestID: request.Params.RequestId, ConnectionID: request.Params.ConnectionId}
+	if request.Params.UserDID != nil {
+		if filter.UserDID, err = core.ParseDID(*request.Params.UserDID); err != nil {
+			return GetVerificationResponses400JSONResponse{N400JSONResponse{"invalid userDID"}}, nil
+		}
+	}
+	responses, err := s.verification.GetResponses(ctx, *did, filter)
+	if errors.Is(err, db.ErrQueryTimeout) {
+		return nil, err
+	}
+	if err != nil {
+		return GetVerificationResponses500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	resp := make(GetVerificationResponses200JSONResponse, len(responses))
+	for i, response := range responses {
+		resp[i] = verificationResponse(response)
+	}
+	return resp, nil
+}
+
+// CreateOID4VCIOffer - offers a credential to an OpenID4VCI wallet with the pre-authorized code flow
+func (s *Server) CreateOID4VCIOffer(ctx context.Context, request CreateOID4VCIOfferRequestObject) (CreateOID4VCIOfferResponseObject, error) {
+	did, err := core.ParseDID(request.Identifier)
+	if err != nil {
+		return CreateOID4VCIOffer400JSONResponse{N400JSONResponse{"invalid did"}}, nil
+	}
+
+	credential := domain.OID4VCICredential{
+		Schema:            request.Body.CredentialSchema,
+		Type:              request.Body.Type,
+		CredentialSubject: request.Body.CredentialSubject,
+	}
+	if request.Body.Expiration != nil {
+		credential.Expiration = common.ToPointer(time.Unix(*request.Body.Expiration, 0))
+	}
+	if request.Body.MtProof != nil {
+		credential.MTProof = *request.Body.MtProof
+	}
+	offer, err := s.oid4vci.CreateOffer(ctx, *did, credential)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrInvalidOID4VCIOffer):
+			return CreateOID4VCIOffer400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		case errors.Is(err, services.ErrOID4VCIIdentityNotFound):
+			return CreateOID4VCIOffer404JSONResponse{N404JSONResponse{err.Error()}}, nil
+		}
+		log.Error(ctx, "creating openid4vci offer", "err", err, "did", request.Identifier)
+		return CreateOID4VCIOffer500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	resp, err := oid4vciOfferResponse(offer)
+	if err != nil {
+		return CreateOID4VCIOffer500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	return CreateOID4VCIOffer201JSONResponse(resp), nil
+}
+
+// GetOID4VCIIssuerMetadata - returns the metadata the OpenID4VCI wallets discover the endpoints of the node with
+func (s *Server) GetOID4VCIIssuerMetadata(ctx context.Context, _ GetOID4VCIIssuerMetadataRequestObject) (GetOID4VCIIssuerMetadataResponseObject, error) {
+	var resp GetOID4VCIIssuerMetadata200JSONResponse
+	if err := convertMessage(s.oid4vci.Metadata(ctx), &resp); err != nil {
+		return GetOID4VCIIssuerMetadata500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	return resp, nil
+}
+
+// OID4VCIToken - exchanges the pre-authorized code of an offer for an access token
+func (s *Server) OID4VCIToken(ctx context.Context, request OID4VCITokenRequestObject) (OID4VCITokenResponseObject, error) {
+	token, err := s.oid4vci.Token(ctx, request.Body.GrantType, request.Body.PreAuthorizedCode)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrOID4VCIUnsupportedGrantType), errors.Is(err, services.ErrOID4VCIInvalidGrant):
+			return OID4VCIToken400JSONResponse{Error: err.Error()}, nil
+		}
+		log.Error(ctx, "exchanging openid4vci pre-authorized code", "err", err)
+		return OID4VCIToken500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	return OID4VCIToken200JSONResponse{
+		AccessToken:     token.AccessToken,
+		TokenType:       "bearer",
+		ExpiresIn:       token.ExpiresIn,
+		CNonce:          token.CNonce,
+		CNonceExpiresIn: token.CNonceExpiresIn,
+	}, nil
+}
+
+// OID4VCICredential - issues the credential of the offer of the access token to the wallet
+func (s *Server) OID4VCICredential(ctx context.Context, request OID4VCICredentialRequestObject) (OID4VCICredentialResponseObject, error) {
+	accessToken, ok := strings.CutPrefix(request.Params.Authorization, "Bearer ")
+	if !ok || accessToken == "" {
+		return OID4VCICredential401JSONResponse{Error: services.ErrOID4VCIInvalidToken.Error()}, nil
+	}
+	req := &ports.OID4VCICredentialRequest{Format: request.Body.Format}
+	if request.Body.Proof != nil {
+		req.ProofType = request.Body.Proof.ProofType
+		if request.Body.Proof.Jwt != nil {
+			req.ProofJWT = *request.Body.Proof.Jwt
+		}
+	}
+
+	issued, err := s.oid4vci.Credential(ctx, accessToken, req)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrOID4VCIInvalidToken):
+			return OID4VCICredential401JSONResponse{Error: err.Error()}, nil
+		case errors.Is(err, services.ErrOID4VCIUnsupportedCredentialFormat):
+			return OID4VCICredential400JSONResponse{Error: err.Error()}, nil
+		case errors.Is(err, services.ErrOID4VCIInvalidProof):
+			return OID4VCICredential400JSONResponse{
+				Error:            services.ErrOID4VCIInvalidProof.Error(),
+				ErrorDescription: common.ToPointer(err.Error()),
+				CNonce:           &issued.CNonce,
+				CNonceExpiresIn:  &issued.CNonceExpiresIn,
+			}, nil
+		}
+		log.Error(ctx, "issuing openid4vci credential", "err", err)
+		return OID4VCICredential500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	return OID4VCICredential200JSONResponse{
+		Format:          domain.OID4VCIFormat,
+		Credential:      *issued.Credential,
+		CNonce:          issued.CNonce,
+		CNonceExpiresIn: issued.CNonceExpiresIn,
+	}, nil
+}
+
+// StartIdentityMigration - makes the identity read only so it can be moved to another node
+func (s *Server) StartIdentityMigration(ctx context.Context, request StartIdentityMigrationRequestObject) (StartIdentityMigrationResponseObject, error) {
+	did, err := core.ParseDID(request.Identifier)
+	if err != nil {
+		return StartIdentityMigration400JSONResponse{N400JSONResponse{"invalid did"}}, nil
+	}
+
+	migration, err := s.migration.Start(ctx, *did, request.Body.Target)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrIdentityMigrationIdentityNotFound):
+			return StartIdentityMigration404JSONResponse{N404JSONResponse{err.Error()}}, nil
+		case errors.Is(err, services.ErrIdentityMigrated):
+			return StartIdentityMigration409JSONResponse{N409JSONResponse{err.Error()}}, nil
+		}
+		return StartIdentityMigration500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	return StartIdentityMigration200JSONResponse(identityMigrationResponse(migration)), nil
+}
+
+// GetIdentityMigration - returns the migration of the identity
+func (s *Server) GetIdentityMigration(ctx context.Context, request GetIdentityMigrationRequestObject) (GetIdentityMigrationResponseObject, error) {
+	did, err := core.ParseDID(request.Identifier)
+	if err != nil {
+		return GetIdentityMigration400JSONResponse{N400JSONResponse{"invalid did"}}, nil
+	}
+
+	migration, err := s.migration.Get(ctx, *did)
+	if err != nil {
+		if errors.Is(err, services.ErrIdentityMigrationNotFound) {
+			return GetIdentityMigration404JSONResponse{N404JSONResponse{err.Error()}}, nil
+		}
+		return GetIdentityMigration500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	return GetIdentityMigration200JSONResponse(identityMigrationResponse(migration)), nil
+}
+
+// AbortIdentityMigration - makes the identity writable again
+func (s *Server) AbortIdentityMigration(ctx context.Context, request AbortIdentityMigrationRequestObject) (AbortIdentityMigrationResponseObject, error) {
+	did, err := core.ParseDID(request.Identifier)
+	if err != nil {
+		return AbortIdentityMigration400JSONResponse{N400JSONResponse{"invalid did"}}, nil
+	}
+
+	if err := s.migration.Abort(ctx, *did); err != nil {
+		switch {
+		case errors.Is(err, services.ErrIdentityMigrationNotFound):
+			return AbortIdentityMigration404JSONResponse{N404JSONResponse{err.Error()}}, nil
+		case errors.Is(err, services.ErrIdentityMigrated):
+			return AbortIdentityMigration409JSONResponse{N409JSONResponse{err.Error()}}, nil
+		}
+		return AbortIdentityMigration500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	return AbortIdentityMigration200JSONResponse{Message: "identity migration aborted"}, nil
+}
+
+// ExportIdentity - returns the data of an identity that is being migrated
+func (s *Server) ExportIdentity(ctx context.Context, request ExportIdentityRequestObject) (ExportIdentityResponseObject, error) {
+	did, err := core.ParseDID(request.Identifier)
+	if err != nil {
+		return ExportIdentity400JSONResponse{N400JSONResponse{"invalid did"}}, nil
+	}
+
+	export, err := s.migration.Export(ctx, *did)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrIdentityMigrationNotFound):
+			return ExportIdentity404JSONResponse{N404JSONResponse{err.Error()}}, nil
+		case errors.Is(err, services.ErrIdentityMigrationPending):
+			return ExportIdentity409JSONResponse{N409JSONResponse{err.Error()}}, nil
+		}
+		return ExportIdentity500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	return ExportIdentity200JSONResponse(identityExportResponse(export)), nil
+}
+
+// ExportIssuer - returns the encrypted archive of an issuer, to import it in another node
+func (s *Server) ExportIssuer(ctx context.Context, request ExportIssuerRequestObject) (ExportIssuerResponseObject, error) {
+	if s.cfg.ExportPassphrase == "" {
+		return ExportIssuer400JSONResponse{N400JSONResponse{"exports are disabled, ISSUER_EXPORT_PASSPHRASE is not set"}}, nil
+	}
+	did, err := core.ParseDID(request.Body.Identifier)
+	if err != nil {
+		return ExportIssuer400JSONResponse{N400JSONResponse{"invalid did"}}, nil
+	}
+
+	export, err := s.migration.Backup(ctx, *did)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrIdentityMigrationIdentityNotFound):
+			return ExportIssuer404JSONResponse{N404JSONResponse{err.Error()}}, nil
+		case errors.Is(err, services.ErrIdentityMigrationPending):
+			return ExportIssuer409JSONResponse{N409JSONResponse{err.Error()}}, nil
+		}
+		log.Error(ctx, "exporting issuer", "err", err, "did", did.String())
+		return ExportIssuer500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	archive, err := domain.SealIdentityExport(export, s.cfg.ExportPassphrase)
+	if err != nil {
+		log.Error(ctx, "encrypting issuer export", "err", err, "did", did.String())
+		return ExportIssuer500JSONResponse{N500JSONResponse{"cannot encrypt the export"}}, nil
+	}
+	log.Info(ctx, "issuer exported", "did", did.String(), "size", len(archive))
+	return ExportIssuer200ApplicationoctetStreamResponse{
+		Body:          bytes.NewReader(archive),
+		ContentLength: int64(len(archive)),
+		Headers:       ExportIssuer200ResponseHeaders{ContentDisposition: `attachment; filename="issuer.export"`},
+	}, nil
+}
+
+// CompleteIdentityMigration - marks the migration of the identity as completed
+func (s *Server) CompleteIdentityMigration(ctx context.Context, request CompleteIdentityMigrationRequestObject) (CompleteIdentityMigrationResponseObject, error) {
+	did, err := core.ParseDID(request.Identifier)
+	if err != nil {
+		return CompleteIdentityMigration400JSONResponse{N400JSONResponse{"invalid did"}}, nil
+	}
+
+	migration, err := s.migration.Complete(ctx, *did)
+	if err != nil {
+		if errors.Is(err, services.ErrIdentityMigrationNotFound) {
+			return CompleteIdentityMigration404JSONResponse{N404JSONResponse{err.Error()}}, nil
+		}
+		return CompleteIdentityMigration500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	return CompleteIdentityMigration200JSONResponse(identityMigrationResponse(migration)), nil
+}
+
+// ImportIdentity - imports an identity exported by another node
+func (s *Server) ImportIdentity(ctx context.Context, request ImportIdentityRequestObject) (ImportIdentityResponseObject, error) {
+	var keys map[string]string
+	if request.Body.Keys != nil {
+		keys = *request.Body.Keys
+	}
+
+	result, err := s.migration.Import(ctx, identityExportRequest(request.Body.Export), keys)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrIdentityExportIncompatible):
+			return ImportIdentity400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		case errors.Is(err, services.ErrIdentityImportExists):
+			return ImportIdentity409JSONResponse{N409JSONResponse{err.Error()}}, nil
+		case errors.Is(err, services.ErrIdentityImportKey), errors.Is(err, services.ErrIdentityImportVerification):
+			return ImportIdentity422JSONResponse{N422JSONResponse{err.Error()}}, nil
+		}
+		return ImportIdentity500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	return ImportIdentity201JSONResponse{
+		Identifier: result.Identifier,
+		Rows:       result.Rows,
+		Keys:       result.Keys,
+		Roots:      IdentityRoots(result.Roots),
+		State:      result.State,
+	}, nil
+}
+
+// PurgeCachedDocument removes a schema or context from the document cache
+func (s *Server) PurgeCachedDocument(ctx context.Context, request PurgeCachedDocumentRequestObject) (PurgeCachedDocumentResponseObject, error) {
+	if !isDocumentURL(request.Params.Url) {
+		return PurgeCachedDocument400JSONResponse{N400JSONResponse{Message: "url must be an absolute http or https url"}}, nil
+	}
+	if err := s.schemaCache.Purge(ctx, request.Params.Url); err != nil {
+		if errors.Is(err, schema.ErrDocumentNotCached) {
+			return PurgeCachedDocument404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "purging cached document", "err", err, "url", request.Params.Url)
+		return PurgeCachedDocument500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return PurgeCachedDocument200JSONResponse{Message: "document purged"}, nil
+}
+
+// RefreshCachedDocument fetches a schema or context from its host and replaces the cached one
+func (s *Server) RefreshCachedDocument(ctx context.Context, request RefreshCachedDocumentRequestObject) (RefreshCachedDocumentResponseObject, error) {
+	if !isDocumentURL(request.Body.Url) {
+		return RefreshCachedDocument400JSONResponse{N400JSONResponse{Message: "url must be an absolute http or https url"}}, nil
+	}
+	doc, err := s.schemaCache.Refresh(ctx, request.Body.Url)
+	if err != nil {
+		log.Warn(ctx, "refreshing cached document", "err", err, "url", request.Body.Url)
+		return RefreshCachedDocument422JSONResponse{N422JSONResponse{Message: fmt.Sprintf("cannot fetch the document: %s", err)}}, nil
+	}
+	return RefreshCachedDocument200JSONResponse{
+		Url:         doc.URL,
+		ContentType: doc.ContentType,
+		Etag:        doc.ETag,
+		Size:        doc.Size,
+		FetchedAt:   doc.FetchedAt,
+	}, nil
+}
+
+func isDocumentURL(s string) bool {
+	u, err := url.Parse(s)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+func identityMigrationResponse(migration *domain.IdentityMigration) IdentityMigration {
+	return IdentityMigration{
+		Identifier:  migration.Identifier,
+		Status:      IdentityMigrationStatus(migration.Status),
+		Target:      migration.Target,
+		CreatedAt:   migration.CreatedAt,
+		CompletedAt: migration.CompletedAt,
+	}
+}
+
+func identityExportResponse(export *domain.IdentityExport) IdentityExport {
+	resp := IdentityExport{
+		Version:       export.Version,
+		SchemaVersion: export.SchemaVersion,
+		Identifier:    export.Identifier,
+		ExportedAt:    export.ExportedAt,
+		Roots:         IdentityRoots(export.Roots),
+		Keys:          make([]IdentityExportKey, len(export.Keys)),
+		Tables:        export.Tables,
+		MerkleTrees:   make([]IdentityExportMerkleTree, len(export.MerkleTrees)),
+	}
+	for i, key := range export.Keys {
+		resp.Keys[i] = IdentityExportKey{Type: key.Type, Id: key.ID, PublicKey: key.PublicKey}
+	}
+	for i, tree := range export.MerkleTrees {
+		resp.MerkleTrees[i] = IdentityExportMerkleTree{Type: tree.Type, Nodes: tree.Nodes, Roots: tree.Roots}
+	}
+	return resp
+}
+
+func identityExportRequest(export IdentityExport) *domain.IdentityExport {
+	req := &domain.IdentityExport{
+		Version:       export.Version,
+		SchemaVersion: export.SchemaVersion,
+		Identifier:    export.Identifier,
+		ExportedAt:    export.ExportedAt,
+		Roots:         domain.IdentityExportRoots(export.Roots),
+		Keys:          make([]domain.IdentityExportKey, len(export.Keys)),
+		Tables:        export.Tables,
+		MerkleTrees:   make([]domain.IdentityExportMerkleTree, len(export.MerkleTrees)),
+	}
+	for i, key := range export.Keys {
+		req.Keys[i] = domain.IdentityExportKey{Type: key.Type, ID: key.Id, PublicKey: key.PublicKey}
+	}
+	for i, tree := range export.MerkleTrees {
+		req.MerkleTrees[i] = domain.IdentityExportMerkleTree{Type: tree.Type, Nodes: tree.Nodes, Roots: tree.Roots}
+	}
+	return req
+}
+
+func featureFlagResponse(flag *domain.FeatureFlag) FeatureFlag {
+	return FeatureFlag{
+		Feature:    FeatureFlagFeature(flag.Feature),
+		Enabled:    flag.Enabled,
+		Overridden: flag.Overridden,
+		UpdatedAt:  flag.UpdatedAt,
+	}
+}
+
+func didServiceResponse(service *domain.DIDService) DIDService {
+	return DIDService{
+		Id:              service.ID,
+		Type:            service.Type,
+		ServiceEndpoint: service.ServiceEndpoint,
+		Overridden:      service.Overridden,
+		UpdatedAt:       service.UpdatedAt,
+	}
+}
+
+func didResolutionResponse(doc *domain.DIDDocument) DIDResolution {
+	stateInfo := DIDStateInfo{
+		Id:                   doc.Identifier + "#stateInfo",
+		Type:                 "Iden3StateInfo2023",
+		Controller:           doc.Identifier,
+		StateContractAddress: doc.StateContractAddress,
+		Published:            doc.Published(),
+	}
+	if doc.Published() && doc.State.State != nil {
+		stateInfo.Info = &DIDStateInfoDetails{Id: doc.Identifier, State: *doc.State.State}
+		if doc.State.BlockTimestamp != nil {
+			stateInfo.Info.CreatedAtTimestamp = common.ToPointer(strconv.Itoa(*doc.State.BlockTimestamp))
+		}
+		if doc.State.BlockNumber != nil {
+			stateInfo.Info.CreatedAtBlock = common.ToPointer(strconv.Itoa(*doc.State.BlockNumber))
+		}
+	}
+
+	didServices := make([]DIDDocumentService, len(doc.Services))
+	for i, service := range doc.Services {
+		didServices[i] = DIDDocumentService{
+			Id:              doc.Identifier + "#" + service.ID,
+			Type:            service.Type,
+			ServiceEndpoint: service.ServiceEndpoint,
+		}
+	}
+
+	resp := DIDResolution{
+		Context: "https://w3id.org/did-resolution/v1",
+		DidDocument: DIDDocument{
+			Context:            []string{"https://www.w3.org/ns/did/v1", "https://schema.iden3.io/core/jsonld/auth.jsonld"},
+			Id:                 doc.Identifier,
+			VerificationMethod: []DIDStateInfo{stateInfo},
+			Service:            didServices,
+		},
+		DidDocumentMetadata: map[string]interface{}{},
+	}
+	resp.DidResolutionMetadata.ContentType = "application/did+ld+json"
+	return resp
+}
+
+// publishRevocation publishes the state of the identity right after a revocation and returns the message of the
+// response. The revocation is already saved, so if the state cannot be published it goes in the next one.
+func publishRevocation(ctx context.Context, publisher ports.Publisher, did *core.DID, message string) string {
+	if _, err := publisher.PublishState(ctx, did); err != nil {
+		log.Warn(ctx, "cannot publish the state after the revocation", "err", err, "did", did.String())
+		return message + ", the state will be published later: " + err.Error()
+	}
+	return message + " and state published"
+}
+
+func publishingPolicyRequest(req *SetPublishingPolicyRequest) *domain.PublishingPolicy {
+	policy := &domain.PublishingPolicy{Mode: domain.PublishingMode(req.Mode)}
+	if req.IntervalMinutes != nil {
+		policy.Interval = time.Duration(*req.IntervalMinutes) * time.Minute
+	}
+	if req.Threshold != nil {
+		policy.Threshold = *req.Threshold
+	}
+	if req.RevocationWindowMinutes != nil {
+		policy.RevocationWindow = time.Duration(*req.RevocationWindowMinutes) * time.Minute
+	}
+	return policy
+}
+
+func settingsResponse(settings *domain.Settings) Settings {
+	return Settings{
+		Publishing: publishingPolicyResponse(&settings.Publishing),
+		RateLimit: RateLimitSettings{
+			Enabled:       settings.RateLimit.Enabled,
+			Authenticated: RateLimitBucket{RequestsPerMinute: settings.RateLimit.Authenticated.RequestsPerMinute, Burst: settings.RateLimit.Authenticated.Burst},
+			Anonymous:     RateLimitBucket{RequestsPerMinute: settings.RateLimit.Anonymous.RequestsPerMinute, Burst: settings.RateLimit.Anonymous.Burst},
+		},
+		Notifications: NotificationSettings{
+			OfferEmails:   settings.Notifications.OfferEmails,
+			Reports:       settings.Notifications.Reports,
+			BacklogAlerts: settings.Notifications.BacklogAlerts,
+		},
+		RhsUrl:     settings.RHSURL,
+		Overridden: settings.Overridden,
+		UpdatedAt:  settings.UpdatedAt,
+	}
+}
+
+func publishingPolicyResponse(policy *domain.PublishingPolicy) PublishingPolicy {
+	resp := PublishingPolicy{
+		Mode:       PublishingPolicyMode(policy.Mode),
+		Overridden: policy.Overridden,
+		UpdatedAt:  policy.UpdatedAt,
+	}
+	if policy.Interval > 0 {
+		resp.IntervalMinutes = common.ToPointer(int(policy.Interval.Minutes()))
+	}
+	if policy.Threshold > 0 {
+		resp.Threshold = common.ToPointer(policy.Threshold)
+	}
+	if policy.RevocationWindow > 0 {
+		resp.RevocationWindowMinutes = common.ToPointer(int(policy.RevocationWindow.Minutes()))
+	}
+	return resp
+}
+
+func revocationDecisionsReportResponse(report *domain.RevocationDecisionsReport) RevocationDecisionsReport {
+	resp := RevocationDecisionsReport{
+		Applied:   report.Applied,
+		Rejected:  report.Rejected,
+		Decisions: make([]RevocationDecision, len(report.Decisions)),
+	}
+	for i, decision := range report.Decisions {
+		resp.Decisions[i] = RevocationDecision{
+			Id:           decision.ID,
+			Source:       decision.Source,
+			ExternalId:   decision.ExternalID,
+			CredentialId: decision.CredentialID,
+			Reason:       decision.Reason,
+			Status:       RevocationDecisionStatus(decision.Status),
+			Error:        decision.Error,
+			DecidedAt:    decision.DecidedAt,
+			CreatedAt:    decision.CreatedAt,
+		}
+		if decision.RevNonce != nil {
+			resp.Decisions[i].RevocationNonce = common.ToPointer(uint64(*decision.RevNonce))
+		}
+	}
+	return resp
+}
+
+func verificationQuery(query VerificationQuery) domain.VerificationQuery {
+	resp := domain.VerificationQuery{
+		CircuitID: string(query.CircuitId),
+		Context:   query.Context,
+		Type:      query.Type,
+	}
+	if query.Id != nil {
+		resp.ID = *query.Id
+	}
+	if query.AllowedIssuers != nil {
+		resp.AllowedIssuers = *query.AllowedIssuers
+	}
+	if query.CredentialSubject != nil {
+		resp.CredentialSubject = *query.CredentialSubject
+	}
+	if query.SkipClaimRevocationCheck != nil {
+		resp.SkipClaimRevocationCheck = *query.SkipClaimRevocationCheck
+	}
+	return resp
+}
+
+func verificationRequestResponse(request *domain.VerificationRequest) VerificationRequest {
+	resp := VerificationRequest{
+		Id:        request.ID,
+		Reason:    request.Reason,
+		Scope:     make([]VerificationQuery, len(request.Scope)),
+		CreatedAt: request.CreatedAt,
+		ExpiresAt: request.ExpiresAt,
+	}
+	for i, query := range request.Scope {
+		resp.Scope[i] = VerificationQuery{
+			Id:        common.ToPointer(query.ID),
+			CircuitId: VerificationQueryCircuitId(query.CircuitID),
+			Context:   query.Context,
+			Type:      query.Type,
+		}
+		if len(query.AllowedIssuers) > 0 {
+			resp.Scope[i].AllowedIssuers = common.ToPointer(query.AllowedIssuers)
+		}
+		if len(query.CredentialSubject) > 0 {
+			resp.Scope[i].CredentialSubject = common.ToPointer(query.CredentialSubject)
+		}
+		if query.SkipClaimRevocationCheck {
+			resp.Scope[i].SkipClaimRevocationCheck = common.ToPointer(true)
+		}
+	}
+	return resp
+}
+
+func verificationResponse(response *domain.VerificationResponse) VerificationResponse {
+	resp := VerificationResponse{
+		Id:           response.ID,
+		RequestId:    response.RequestID,
+		UserDID:      response.UserDID.String(),
+		ConnectionId: response.ConnectionID,
+		Verified:     response.Verified,
+		Error:        response.Error,
+		CreatedAt:    response.CreatedAt,
+	}
+	var proofs []map[string]interface{}
+	if len(response.Proofs) > 0 && json.Unmarshal(response.Proofs, &proofs) == nil {
+		resp.Proofs = &proofs
+	}
+	return resp
+}
+
+func oid4vciOfferResponse(offer *ports.OID4VCICredentialOffer) (OID4VCIOffer, error) {
+	message, err := json.Marshal(offer.Message)
+	if err != nil {
+		return OID4VCIOffer{}, err
+	}
+	resp := OID4VCIOffer{
+		Id:                 offer.Offer.ID,
+		ExpiresAt:          offer.Offer.ExpiresAt,
+		CredentialOfferUri: "openid-credential-offer://?credential_offer=" + url.QueryEscape(string(message)),
+	}
+	if err := json.Unmarshal(message, &resp.CredentialOffer); err != nil {
+		return OID4VCIOffer{}, err
+	}
+	return resp, nil
+}
+
+func authKeyResponse(key *domain.AuthKey) AuthKey {
+	return AuthKey{
+		Id:       key.ClaimID,
+		X:        key.X,
+		Y:        key.Y,
+		RevNonce: key.RevNonce,
+		Status:   AuthKeyStatus(key.Status),
+	}
+}
+
+// AcceptCredentialOffer - fetches the credentials offered to the identity by another issuer and stores them in its wallet
+func (s *Server) AcceptCredentialOffer(ctx context.Context, request AcceptCredentialOfferRequestObject) (AcceptCredentialOfferResponseObject, error) {
+	did, err := core.ParseDID(request.Identifier)
+	if err != nil {
+		return AcceptCredentialOffer400JSONResponse{N400JSONResponse{"invalid did"}}, nil
+	}
+
+	var offer protocol.CredentialsOfferMessage
+	if err := convertMessage(request.Body, &offer); err != nil {
+		return AcceptCredentialOffer400JSONResponse{N400JSONResponse{"invalid credential offer"}}, nil
+	}
+
+	credentials, err := s.walletService.AcceptOffer(ctx, *did, &offer)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrHolderNotFound):
+			return AcceptCredentialOffer404JSONResponse{N404JSONResponse{err.Error()}}, nil
+		case errors.Is(err, services.ErrInvalidWalletMessage),
+			errors.Is(err, services.ErrHeldCredentialWrongSubject),
+			errors.Is(err, services.ErrHeldCredentialWrongIssuer):
+			return AcceptCredentialOffer400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		}
+		return AcceptCredentialOffer500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+
+	return AcceptCredentialOffer201JSONResponse(toHeldCredentialsResponse(credentials)), nil
+}
+
+// GetHeldCredentials - returns the credentials issued to the identity by other issuers
+func (s *Server) GetHeldCredentials(ctx context.Context, request GetHeldCredentialsRequestObject) (GetHeldCredentialsResponseObject, error) {
+	did, err := core.ParseDID(request.Identifier)
+	if err != nil {
+		return GetHeldCredentials400JSONResponse{N400JSONResponse{"invalid did"}}, nil
+	}
+
+	filter := &ports.HeldCredentialsFilter{Revoked: request.Params.Revoked}
+	if request.Params.SchemaType != nil {
+		filter.SchemaType = *request.Params.SchemaType
+	}
+
+	credentials, err := s.walletService.GetAll(ctx, *did, filter)
+	if errors.Is(err, db.ErrQueryTimeout) {
+		return nil, err
+	}
+	if err != nil {
+		return GetHeldCredentials500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+
+	return GetHeldCredentials200JSONResponse(toHeldCredentialsResponse(credentials)), nil
+}
+
+// GetHeldCredential - returns a credential issued to the identity by another issuer
+func (s *Server) GetHeldCredential(ctx context.Context, request GetHeldCredentialRequestObject) (GetHeldCredentialResponseObject, error) {
+	did, err := core.ParseDID(request.Identifier)
+	if err != nil {
+		return GetHeldCredential400JSONResponse{N400JSONResponse{"invalid did"}}, nil
+	}
+
+	credential, err := s.walletService.GetByID(ctx, *did, request.Id)
+	if err != nil {
+		if errors.Is(err, services.ErrHeldCredentialNotFound) {
+			return GetHeldCredential404JSONResponse{N404JSONResponse{err.Error()}}, nil
+		}
+		return GetHeldCredential500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+
+	return GetHeldCredential200JSONResponse(toHeldCredentialResponse(credential)), nil
+}
+
+// DeleteHeldCredential - removes a credential from the identity wallet
+func (s *Server) DeleteHeldCredential(ctx context.Context, request DeleteHeldCredentialRequestObject) (DeleteHeldCredentialResponseObject, error) {
+	did, err := core.ParseDID(request.Identifier)
+	if err != nil {
+		return DeleteHeldCredential400JSONResponse{N400JSONResponse{"invalid did"}}, nil
+	}
+
+	if err := s.walletService.Delete(ctx, *did, request.Id); err != nil {
+		if errors.Is(err, services.ErrHeldCredentialNotFound) {
+			return DeleteHeldCredential404JSONResponse{N404JSONResponse{err.Error()}}, nil
+		}
+		return DeleteHeldCredential500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+
+	return DeleteHeldCredential200JSONResponse{Message: "held credential deleted"}, nil
+}
+
+// PresentCredentials - answers a verifier authorization request with the credentials of the identity
+func (s *Server) PresentCredentials(ctx context.Context, request PresentCredentialsRequestObject) (PresentCredentialsResponseObject, error) {
+	did, err := core.ParseDID(request.Identifier)
+	if err != nil {
+		return PresentCredentials400JSONResponse{N400JSONResponse{"invalid did"}}, nil
+	}
+
+	var authRequest protocol.AuthorizationRequestMessage
+	if err := convertMessage(request.Body, &authRequest); err != nil {
+		return PresentCredentials400JSONResponse{N400JSONResponse{"invalid authorization request"}}, nil
+	}
+
+	if err := s.walletService.Present(ctx, *did, &authRequest); err != nil {
+		switch {
+		case errors.Is(err, services.ErrHolderNotFound):
+			return PresentCredentials404JSONResponse{N404JSONResponse{err.Error()}}, nil
+		case errors.Is(err, services.ErrInvalidWalletMessage):
+			return PresentCredentials400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		}
+		return PresentCredentials500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+
+	return PresentCredentials200JSONResponse{Message: "authorization response sent"}, nil
+}
+
+// RegisterStatic add method to the mux that are not documented in the API.
+func RegisterStatic(mux *chi.Mux) {
+	mux.Get("/", documentation)
+	mux.Get("/static/docs/api/api.yaml", swagger)
+	mux.Get("/favicon.ico", favicon)
+}
+
+func toGetClaims200Response(claims []*verifiable.W3CCredential) GetClaims200JSONResponse {
+	response := make(GetClaims200JSONResponse, len(claims))
+	for i := range claims {
+		response[i] = toGetClaim200Response(claims[i])
+	}
+
+	return response
+}
+
+func toGetClaim200Response(claim *verifiable.W3CCredential) GetClaimResponse {
 	return GetClaimResponse{
 		Context: claim.Context,
 		CredentialSchema: CredentialSchema{
@@ -429,15 +2153,54 @@ func toGetClaim200Response(claim *verifiable.W3CCredential) GetClaimResponse {
 	}
 }
 
-func toGetClaimQrCode200JSONResponse(claim *domain.Claim, hostURL string) *GetClaimQrCode200JSONResponse {
-	id := uuid.New()
+func toRefreshServiceResponse(refreshService *domain.RefreshService) *RefreshService {
+	if refreshService == nil {
+		return nil
+	}
+	return &RefreshService{Id: refreshService.ID, Type: refreshService.Type}
+}
+
+func toHeldCredentialsResponse(credentials []*domain.HeldCredential) GetHeldCredentialsResponse {
+	response := make(GetHeldCredentialsResponse, len(credentials))
+	for i := range credentials {
+		response[i] = toHeldCredentialResponse(credentials[i])
+	}
+	return response
+}
+
+func toHeldCredentialResponse(credential *domain.HeldCredential) HeldCredential {
+	return HeldCredential{
+		Id:           credential.ID,
+		CredentialId: credential.CredentialID,
+		Issuer:       credential.IssuerDID.String(),
+		SchemaType:   credential.SchemaType,
+		SchemaUrl:    credential.SchemaURL,
+		ExpiresAt:    credential.ExpiresAt,
+		Revoked:      credential.Revoked,
+		CreatedAt:    credential.CreatedAt,
+		Credential:   toGetClaim200Response(&credential.Credential),
+	}
+}
+
+// convertMessage converts an iden3comm message received in a request body to its protocol type
+func convertMessage(src interface{}, dst interface{}) error {
+	raw, err := json.Marshal(src)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(raw, dst)
+}
+
+func toGetClaimQrCode200JSONResponse(claim *domain.Claim, offer *domain.CredentialOffer, profile *domain.IssuerProfile, hostURL string) *GetClaimQrCode200JSONResponse {
+	id := offer.ID
 	return &GetClaimQrCode200JSONResponse{
 		Body: struct {
 			Credentials []struct {
 				Description string `json:"description"`
 				Id          string `json:"id"`
 			} `json:"credentials"`
-			Url string `json:"url"`
+			Issuer *IssuerDescription `json:"issuer,omitempty"`
+			Url    string             `json:"url"`
 		}{
 			Credentials: []struct {
 				Description string `json:"description"`
@@ -448,17 +2211,36 @@ func toGetClaimQrCode200JSONResponse(claim *domain.Claim, hostURL string) *GetCl
 					Id:          claim.ID.String(),
 				},
 			},
-			Url: fmt.Sprintf("%s/v1/agent", strings.TrimSuffix(hostURL, "/")),
+			Issuer: issuerDescriptionResponse(profile),
+			Url:    fmt.Sprintf("%s/v1/agent", strings.TrimSuffix(hostURL, "/")),
 		},
-		From: claim.Issuer,
-		Id:   id.String(),
-		Thid: id.String(),
-		To:   claim.OtherIdentifier,
-		Typ:  string(packers.MediaTypePlainMessage),
-		Type: string(protocol.CredentialOfferMessageType),
+		From:        claim.Issuer,
+		Id:          id.String(),
+		Thid:        id.String(),
+		To:          claim.OtherIdentifier,
+		Typ:         string(packers.MediaTypePlainMessage),
+		Type:        string(protocol.CredentialOfferMessageType),
+		ExpiresTime: common.ToPointer(offer.ExpiresAt.Unix()),
 	}
 }
 
+func issuerDescriptionResponse(profile *domain.IssuerProfile) *IssuerDescription {
+	resp := &IssuerDescription{DisplayName: profile.DisplayName, Logo: profile.LogoURL}
+	if profile.BackgroundColor != "" {
+		resp.BackgroundColor = &profile.BackgroundColor
+	}
+	if profile.TextColor != "" {
+		resp.TextColor = &profile.TextColor
+	}
+	if profile.ContactEmail != "" {
+		resp.ContactEmail = &profile.ContactEmail
+	}
+	if profile.ContactURL != "" {
+		resp.ContactURL = &profile.ContactURL
+	}
+	return resp
+}
+
 func documentation(w http.ResponseWriter, _ *http.Request) {
 	writeFile("api/spec.html", "text/html; charset=UTF-8", w)
 }
@@ -481,3 +2263,77 @@ func writeFile(path string, mimeType string, w http.ResponseWriter) {
 	w.WriteHeader(http.StatusOK)
 	_, _ = w.Write(f)
 }
+
+func stateTransactionResponse(tx *domain.StateTransaction) StateTransaction {
+	resp := StateTransaction{
+		Id:          tx.ID,
+		State:       tx.State,
+		TxID:        tx.TxID,
+		TxHashes:    tx.TxHashes,
+		Nonce:       tx.Nonce,
+		GasFeeCap:   tx.GasFeeCap.String(),
+		Attempts:    tx.Attempts,
+		Status:      StateTransactionStatus(tx.Status),
+		Error:       tx.Error,
+		CreatedAt:   tx.CreatedAt,
+		SubmittedAt: tx.SubmittedAt,
+	}
+	if tx.TxType != types.LegacyTxType {
+		resp.GasTipCap = common.ToPointer(tx.GasTipCap.String())
+	}
+	return resp
+}
+
+func stateIntegrityResponse(report *domain.TreeIntegrityReport) StateIntegrityReport {
+	resp := StateIntegrityReport{
+		Identifier:     report.Identifier,
+		Ok:             report.OK(),
+		CheckedAt:      report.CheckedAt,
+		State:          report.State,
+		PublishedState: report.PublishedState,
+		Trees:          make([]TreeIntegrity, 0, len(report.Trees)),
+		Discrepancies:  make([]TreeDiscrepancy, 0, len(report.Discrepancies)),
+	}
+	for _, tree := range report.Trees {
+		resp.Trees = append(resp.Trees, TreeIntegrity{
+			Type:      TreeIntegrityType(treeTypeNames[tree.Type]),
+			Root:      tree.Root,
+			StateRoot: tree.StateRoot,
+			Nodes:     tree.Nodes,
+			Leaves:    tree.Leaves,
+		})
+	}
+	for _, d := range report.Discrepancies {
+		discrepancy := TreeDiscrepancy{
+			Kind:    TreeDiscrepancyKind(d.Kind),
+			Node:    d.Node,
+			Message: d.Message,
+		}
+		if d.Tree != nil {
+			discrepancy.Tree = common.ToPointer(TreeDiscrepancyTree(treeTypeNames[*d.Tree]))
+		}
+		resp.Discrepancies = append(resp.Discrepancies, discrepancy)
+	}
+	return resp
+}
+
+// treeTypeNames are the names of the merkle tree types in the api
+var treeTypeNames = map[uint16]string{
+	domain.MerkleTreeTypeClaims:      "claims",
+	domain.MerkleTreeTypeRevocations: "revocations",
+	domain.MerkleTreeTypeRoots:       "roots",
+}
+
+// credentialSubjectErrors returns the attributes of the credential subject that do not match the schema, if the error
+// has them
+func credentialSubjectErrors(err error) *[]CredentialSubjectError {
+	var subjectErr *jsonschema.SubjectError
+	if !errors.As(err, &subjectErr) {
+		return nil
+	}
+	fields := make([]CredentialSubjectError, len(subjectErr.Fields))
+	for i, f := range subjectErr.Fields {
+		fields[i] = CredentialSubjectError{Field: f.Field, Message: f.Message}
+	}
+	return &fields
+}
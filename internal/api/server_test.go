@@ -2,8 +2,13 @@ package api
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	cryptoRand "crypto/rand"
 	"encoding/json"
 	"fmt"
+	"math/big"
+	"math/rand"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -13,15 +18,20 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	auth "github.com/iden3/go-iden3-auth"
+	authLoaders "github.com/iden3/go-iden3-auth/loaders"
 	core "github.com/iden3/go-iden3-core"
 	"github.com/iden3/go-schema-processor/verifiable"
+	"github.com/iden3/iden3comm"
 	"github.com/iden3/iden3comm/packers"
 	"github.com/iden3/iden3comm/protocol"
 	"github.com/mitchellh/mapstructure"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/square/go-jose.v2"
 
 	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/config"
 	"github.com/polygonid/sh-id-platform/internal/core/domain"
 	"github.com/polygonid/sh-id-platform/internal/core/event"
 	"github.com/polygonid/sh-id-platform/internal/core/ports"
@@ -30,8 +40,11 @@ import (
 	"github.com/polygonid/sh-id-platform/internal/loader"
 	"github.com/polygonid/sh-id-platform/internal/log"
 	"github.com/polygonid/sh-id-platform/internal/repositories"
+	"github.com/polygonid/sh-id-platform/pkg/cache"
+	"github.com/polygonid/sh-id-platform/pkg/loaders"
 	"github.com/polygonid/sh-id-platform/pkg/pubsub"
 	"github.com/polygonid/sh-id-platform/pkg/reverse_hash"
+	"github.com/polygonid/sh-id-platform/pkg/schema"
 )
 
 func TestServer_CreateIdentity(t *testing.T) {
@@ -54,9 +67,9 @@ func TestServer_CreateIdentity(t *testing.T) {
 		RHSEnabled: false,
 		Host:       "host",
 	}
-	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf, pubsub.NewMock())
+	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf)
 
-	server := NewServer(&cfg, identityService, claimsService, NewPublisherMock(), NewPackageManagerMock(), nil)
+	server := NewServer(&cfg, identityService, claimsService, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, NewPublisherMock(), NewPackageManagerMock(), nil, nil)
 	handler := getHandler(context.Background(), server)
 
 	type expected struct {
@@ -83,9 +96,10 @@ func TestServer_CreateIdentity(t *testing.T) {
 			auth: authOk,
 			input: CreateIdentityRequest{
 				DidMetadata: struct {
-					Blockchain string `json:"blockchain"`
-					Method     string `json:"method"`
-					Network    string `json:"network"`
+					Blockchain string                                `json:"blockchain"`
+					Method     string                                `json:"method"`
+					Network    string                                `json:"network"`
+					Type       *CreateIdentityRequestDidMetadataType `json:"type,omitempty"`
 				}{Blockchain: blockchain, Method: method, Network: network},
 			},
 			expected: expected{
@@ -93,14 +107,31 @@ func TestServer_CreateIdentity(t *testing.T) {
 				message:  nil,
 			},
 		},
+		{
+			name: "should return an error wrong key type",
+			auth: authOk,
+			input: CreateIdentityRequest{
+				DidMetadata: struct {
+					Blockchain string                                `json:"blockchain"`
+					Method     string                                `json:"method"`
+					Network    string                                `json:"network"`
+					Type       *CreateIdentityRequestDidMetadataType `json:"type,omitempty"`
+				}{Blockchain: blockchain, Method: method, Network: network, Type: common.ToPointer(CreateIdentityRequestDidMetadataType("RSA"))},
+			},
+			expected: expected{
+				httpCode: 400,
+				message:  common.ToPointer("type must be BJJ or ETH"),
+			},
+		},
 		{
 			name: "should return an error wrong network",
 			auth: authOk,
 			input: CreateIdentityRequest{
 				DidMetadata: struct {
-					Blockchain string `json:"blockchain"`
-					Method     string `json:"method"`
-					Network    string `json:"network"`
+					Blockchain string                                `json:"blockchain"`
+					Method     string                                `json:"method"`
+					Network    string                                `json:"network"`
+					Type       *CreateIdentityRequestDidMetadataType `json:"type,omitempty"`
 				}{Blockchain: blockchain, Method: method, Network: "mynetwork"},
 			},
 			expected: expected{
@@ -113,9 +144,10 @@ func TestServer_CreateIdentity(t *testing.T) {
 			auth: authOk,
 			input: CreateIdentityRequest{
 				DidMetadata: struct {
-					Blockchain string `json:"blockchain"`
-					Method     string `json:"method"`
-					Network    string `json:"network"`
+					Blockchain string                                `json:"blockchain"`
+					Method     string                                `json:"method"`
+					Network    string                                `json:"network"`
+					Type       *CreateIdentityRequestDidMetadataType `json:"type,omitempty"`
 				}{Blockchain: blockchain, Method: "my method", Network: network},
 			},
 			expected: expected{
@@ -128,9 +160,10 @@ func TestServer_CreateIdentity(t *testing.T) {
 			auth: authOk,
 			input: CreateIdentityRequest{
 				DidMetadata: struct {
-					Blockchain string `json:"blockchain"`
-					Method     string `json:"method"`
-					Network    string `json:"network"`
+					Blockchain string                                `json:"blockchain"`
+					Method     string                                `json:"method"`
+					Network    string                                `json:"network"`
+					Type       *CreateIdentityRequestDidMetadataType `json:"type,omitempty"`
 				}{Blockchain: "my blockchain", Method: method, Network: network},
 			},
 			expected: expected{
@@ -183,9 +216,9 @@ func TestServer_RevokeClaim(t *testing.T) {
 		RHSEnabled: false,
 		Host:       "host",
 	}
-	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf, pubsub.NewMock())
+	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf)
 
-	server := NewServer(&cfg, identityService, claimsService, NewPublisherMock(), NewPackageManagerMock(), nil)
+	server := NewServer(&cfg, identityService, claimsService, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, NewPublisherMock(), NewPackageManagerMock(), nil, nil)
 
 	idStr := "did:polygonid:polygon:mumbai:2qM77fA6NGGWL9QEeb1dv2VA6wz5svcohgv61LZ7wB"
 	identity := &domain.Identity{
@@ -313,6 +346,346 @@ func TestServer_RevokeClaim(t *testing.T) {
 	}
 }
 
+func TestServer_RevocationDecisions(t *testing.T) {
+	identityRepo := repositories.NewIdentity()
+	claimsRepo := repositories.NewClaims()
+	identityStateRepo := repositories.NewIdentityState()
+	mtRepo := repositories.NewIdentityMerkleTreeRepository()
+	mtService := services.NewIdentityMerkleTrees(mtRepo)
+	rhsp := reverse_hash.NewRhsPublisher(nil, false)
+	identityService := services.NewIdentity(&KMSMock{}, identityRepo, mtRepo, identityStateRepo, mtService, claimsRepo, repositories.NewRevocation(), repositories.NewConnections(), storage, rhsp, nil, nil, pubsub.NewMock())
+	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, loader.CachedFactory(loader.HTTPFactory, cachex), storage, services.ClaimCfg{Host: "host"})
+	decisionService := services.NewRevocationDecision(repositories.NewRevocationDecision(), claimsRepo, claimsService, identityService, storage, services.RevocationDecisionCfg{})
+
+	server := NewServer(&cfg, identityService, claimsService, nil, nil, nil, nil, nil, decisionService, nil, nil, nil, nil, nil, nil, nil, nil, nil, NewPublisherMock(), NewPackageManagerMock(), nil, nil)
+	handler := getHandler(context.Background(), server)
+
+	typ, err := core.BuildDIDType(core.DIDMethodPolygonID, core.Polygon, core.Mumbai)
+	require.NoError(t, err)
+	id, err := core.IdGenesisFromIdenState(typ, big.NewInt(rand.Int63()))
+	require.NoError(t, err)
+	did, err := core.ParseDIDFromID(*id)
+	require.NoError(t, err)
+	idStr := did.String()
+
+	fixture := tests.NewFixture(storage)
+	fixture.CreateIdentity(t, &domain.Identity{Identifier: idStr})
+	fixture.ExecQuery(t, tests.ExecQueryParams{
+		Query:     `INSERT INTO identity_mts (identifier, type) VALUES ($1, 0), ($1, 1), ($1, 2), ($1, 3)`,
+		Arguments: []interface{}{idStr},
+	})
+	nonce := uint64(rand.Int63())
+	claimID := uuid.New()
+	fixture.CreateClaim(t, &domain.Claim{
+		ID:         claimID,
+		Identifier: &idStr,
+		Issuer:     idStr,
+		SchemaHash: "ca938857241db9451ea329256b9c06e5",
+		SchemaURL:  "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json-ld/auth.json-ld",
+		SchemaType: "AuthBJJCredential",
+		RevNonce:   domain.RevNonceUint64(nonce),
+	})
+
+	apply := func(did string, body string) *httptest.ResponseRecorder {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("/v1/%s/revocation-decisions", did), strings.NewReader(body))
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	body := fmt.Sprintf(`{"source": "fraud-engine", "decisions": [
+		{"id": "case-1", "revocationNonce": %d, "reason": "fraud"},
+		{"id": "case-2", "credentialId": "%s"},
+		{"id": "case-3"}
+	]}`, nonce, uuid.New())
+	rr := apply(idStr, body)
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	var response RevocationDecisionsReport
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+	assert.Equal(t, 1, response.Applied)
+	assert.Equal(t, 2, response.Rejected)
+	require.Len(t, response.Decisions, 3)
+	assert.Equal(t, RevocationDecisionStatus("applied"), response.Decisions[0].Status)
+	assert.Equal(t, &claimID, response.Decisions[0].CredentialId)
+	require.NotNil(t, response.Decisions[1].Error)
+	assert.Equal(t, "the credential does not exist", *response.Decisions[1].Error)
+
+	claim, err := claimsRepo.GetByIdAndIssuer(context.Background(), storage.Pgx, did, claimID)
+	require.NoError(t, err)
+	assert.True(t, claim.Revoked)
+
+	// a retry returns the recorded outcome, another decision for the same credential is rejected
+	rr = apply(idStr, fmt.Sprintf(`{"source": "fraud-engine", "decisions": [{"id": "case-1"}, {"id": "case-4", "credentialId": "%s"}]}`, claimID))
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+	require.Len(t, response.Decisions, 2)
+	assert.Equal(t, RevocationDecisionStatus("applied"), response.Decisions[0].Status)
+	assert.Equal(t, "fraud", response.Decisions[0].Reason)
+	require.NotNil(t, response.Decisions[1].Error)
+	assert.Equal(t, "the credential is already revoked", *response.Decisions[1].Error)
+
+	assert.Equal(t, http.StatusBadRequest, apply(idStr, `{"source": "", "decisions": [{"id": "case-5"}]}`).Code)
+	assert.Equal(t, http.StatusBadRequest, apply(idStr, `{"source": "hr", "decisions": [{"id": ""}]}`).Code)
+	assert.Equal(t, http.StatusNotFound, apply("did:polygonid:polygon:mumbai:2qPUUYXa98tQWZKSaRidf2QTDyZicFFxkTWNWjk2HJ", `{"source": "hr", "decisions": []}`).Code)
+
+	rr = httptest.NewRecorder()
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/v1/%s/revocation-decisions/report?source=fraud-engine&status=rejected", idStr), nil)
+	require.NoError(t, err)
+	req.SetBasicAuth(authOk())
+	handler.ServeHTTP(rr, req)
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+	assert.Equal(t, 0, response.Applied)
+	assert.Equal(t, 3, response.Rejected)
+	require.Len(t, response.Decisions, 3)
+	assert.Equal(t, "case-2", response.Decisions[0].ExternalId)
+	assert.Equal(t, "case-4", response.Decisions[2].ExternalId)
+}
+
+func TestServer_VerificationRequests(t *testing.T) {
+	identityRepo := repositories.NewIdentity()
+	claimsRepo := repositories.NewClaims()
+	identityStateRepo := repositories.NewIdentityState()
+	mtRepo := repositories.NewIdentityMerkleTreeRepository()
+	mtService := services.NewIdentityMerkleTrees(mtRepo)
+	rhsp := reverse_hash.NewRhsPublisher(nil, false)
+	connectionsRepo := repositories.NewConnections()
+	identityService := services.NewIdentity(&KMSMock{}, identityRepo, mtRepo, identityStateRepo, mtService, claimsRepo, repositories.NewRevocation(), connectionsRepo, storage, rhsp, nil, nil, pubsub.NewMock())
+	verifier := auth.NewVerifier(loaders.NewVerificationKeys("../../pkg/credentials/circuits"), authLoaders.DefaultSchemaLoader{IpfsURL: "ipfs.io"}, nil)
+	verificationService := services.NewVerification(repositories.NewVerification(), connectionsRepo, identityService, verifier, storage, services.VerificationCfg{Host: "https://issuer.example.com", TransitionDelay: 5 * time.Minute})
+
+	server := NewServer(&cfg, identityService, nil, nil, nil, nil, nil, nil, nil, verificationService, nil, nil, nil, nil, nil, nil, nil, nil, NewPublisherMock(), NewPackageManagerMock(), nil, nil)
+	handler := getHandler(context.Background(), server)
+
+	typ, err := core.BuildDIDType(core.DIDMethodPolygonID, core.Polygon, core.Mumbai)
+	require.NoError(t, err)
+	id, err := core.IdGenesisFromIdenState(typ, big.NewInt(rand.Int63()))
+	require.NoError(t, err)
+	did, err := core.ParseDIDFromID(*id)
+	require.NoError(t, err)
+	idStr := did.String()
+
+	fixture := tests.NewFixture(storage)
+	fixture.CreateIdentity(t, &domain.Identity{Identifier: idStr})
+
+	do := func(method string, url string, body string, withAuth bool) *httptest.ResponseRecorder {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(method, url, strings.NewReader(body))
+		require.NoError(t, err)
+		if withAuth {
+			req.SetBasicAuth(authOk())
+		}
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	body := `{"reason": "age check", "scope": [{
+		"circuitId": "credentialAtomicQuerySigV2",
+		"context": "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json-ld/kyc-v3.json-ld",
+		"type": "KYCAgeCredential",
+		"credentialSubject": {"birthday": {"$lt": 20000101}}
+	}]}`
+	rr := do(http.MethodPost, fmt.Sprintf("/v1/%s/verification/requests", idStr), body, false)
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	rr = do(http.MethodPost, fmt.Sprintf("/v1/%s/verification/requests", idStr), body, true)
+	require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+	var created VerificationRequest
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &created))
+	assert.Equal(t, "age check", created.Reason)
+	require.Len(t, created.Scope, 1)
+	assert.Equal(t, common.ToPointer(uint32(1)), created.Scope[0].Id)
+
+	invalid := `{"scope": [{"circuitId": "authV2", "context": "https://example.com", "type": "KYCAgeCredential"}]}`
+	assert.Equal(t, http.StatusBadRequest, do(http.MethodPost, fmt.Sprintf("/v1/%s/verification/requests", idStr), invalid, true).Code)
+	invalid = `{"scope": [{"circuitId": "credentialAtomicQueryMTPV2", "context": "https://example.com", "type": "KYCAgeCredential", "credentialSubject": {"birthday": {"$between": [1, 2]}}}]}`
+	assert.Equal(t, http.StatusBadRequest, do(http.MethodPost, fmt.Sprintf("/v1/%s/verification/requests", idStr), invalid, true).Code)
+	assert.Equal(t, http.StatusNotFound, do(http.MethodPost, "/v1/did:polygonid:polygon:mumbai:2qPUUYXa98tQWZKSaRidf2QTDyZicFFxkTWNWjk2HJ/verification/requests", body, true).Code)
+
+	rr = do(http.MethodGet, fmt.Sprintf("/v1/%s/verification/requests/%s", idStr, created.Id), "", true)
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	var got VerificationRequest
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &got))
+	assert.Equal(t, created.Id, got.Id)
+	assert.Equal(t, created.Scope, got.Scope)
+	assert.Equal(t, http.StatusNotFound, do(http.MethodGet, fmt.Sprintf("/v1/%s/verification/requests/%s", idStr, uuid.New()), "", true).Code)
+
+	rr = do(http.MethodGet, fmt.Sprintf("/v1/%s/verification/requests/%s/qrcode", idStr, created.Id), "", true)
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	var qr AuthorizationRequest
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &qr))
+	assert.Equal(t, created.Id.String(), qr.Thid)
+	assert.Equal(t, idStr, qr.From)
+	assert.Equal(t, fmt.Sprintf("https://issuer.example.com/v1/verification/callback?requestID=%s", created.Id), qr.Body.CallbackUrl)
+	require.Len(t, qr.Body.Scope, 1)
+	assert.Equal(t, "credentialAtomicQuerySigV2", qr.Body.Scope[0].CircuitId)
+
+	expired := `{"expiresAt": "2020-01-01T00:00:00Z", "scope": [{"circuitId": "credentialAtomicQuerySigV2", "context": "https://example.com", "type": "KYCAgeCredential"}]}`
+	rr = do(http.MethodPost, fmt.Sprintf("/v1/%s/verification/requests", idStr), expired, true)
+	require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+	var expiredRequest VerificationRequest
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &expiredRequest))
+	assert.Equal(t, http.StatusGone, do(http.MethodGet, fmt.Sprintf("/v1/%s/verification/requests/%s/qrcode", idStr, expiredRequest.Id), "", true).Code)
+
+	// the callback is called by the wallets, without credentials
+	assert.Equal(t, http.StatusBadRequest, do(http.MethodPost, fmt.Sprintf("/v1/verification/callback?requestID=%s", created.Id), "not-a-token", false).Code)
+	assert.Equal(t, http.StatusNotFound, do(http.MethodPost, fmt.Sprintf("/v1/verification/callback?requestID=%s", uuid.New()), "not-a-token", false).Code)
+	assert.Equal(t, http.StatusGone, do(http.MethodPost, fmt.Sprintf("/v1/verification/callback?requestID=%s", expiredRequest.Id), "not-a-token", false).Code)
+
+	rr = do(http.MethodGet, fmt.Sprintf("/v1/%s/verification/responses?requestId=%s", idStr, created.Id), "", true)
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	var responses []VerificationResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &responses))
+	assert.Empty(t, responses)
+	assert.Equal(t, http.StatusBadRequest, do(http.MethodGet, fmt.Sprintf("/v1/%s/verification/responses?userDID=invalid", idStr), "", true).Code)
+}
+
+func TestServer_OID4VCI(t *testing.T) {
+	const host = "https://issuer.example.com"
+	ctx := log.NewContext(context.Background(), log.LevelDebug, log.OutputText, os.Stdout)
+	identityRepo := repositories.NewIdentity()
+	claimsRepo := repositories.NewClaims()
+	identityStateRepo := repositories.NewIdentityState()
+	mtRepo := repositories.NewIdentityMerkleTreeRepository()
+	mtService := services.NewIdentityMerkleTrees(mtRepo)
+	rhsp := reverse_hash.NewRhsPublisher(nil, false)
+	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, claimsRepo, repositories.NewRevocation(), repositories.NewConnections(), storage, rhsp, nil, nil, pubsub.NewMock())
+	schemaLoader := loader.CachedFactory(loader.HTTPFactory, cachex)
+	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, services.ClaimCfg{Host: host})
+	iden, err := identityService.Create(ctx, "polygonid", "polygon", "mumbai", "polygon-test")
+	require.NoError(t, err)
+	did := iden.Identifier
+	issuerDID, err := core.ParseDID(did)
+	require.NoError(t, err)
+	issuerProfileService := services.NewIssuerProfile(repositories.NewIssuerProfile(), storage, services.IssuerProfileCfg{DisplayName: "my issuer", LogoURL: "https://issuer.example.com/logo.png"})
+	oid4vciService := services.NewOID4VCI(repositories.NewOID4VCI(), claimsService, identityService, storage, services.OID4VCICfg{
+		Host:            host,
+		OfferExpiration: time.Hour,
+		TokenExpiration: 10 * time.Minute,
+		DisplayIssuer:   issuerDID,
+		Profiles:        issuerProfileService,
+	})
+
+	server := NewServer(&cfg, identityService, claimsService, nil, nil, nil, nil, nil, nil, nil, oid4vciService, nil, issuerProfileService, nil, nil, nil, nil, nil, NewPublisherMock(), NewPackageManagerMock(), nil, nil)
+	handler := getHandler(ctx, server)
+
+	do := func(method string, url string, contentType string, body string, header map[string]string, withAuth bool) *httptest.ResponseRecorder {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(method, url, strings.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", contentType)
+		for k, v := range header {
+			req.Header.Set(k, v)
+		}
+		if withAuth {
+			req.SetBasicAuth(authOk())
+		}
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	offerBody := `{
+		"credentialSchema": "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json",
+		"type": "KYCAgeCredential",
+		"credentialSubject": {"id": "did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ", "birthday": 19960424, "documentType": 2}
+	}`
+	rr := do(http.MethodPost, fmt.Sprintf("/v1/%s/oid4vci/offers", did), "application/json", offerBody, nil, false)
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	invalid := `{"credentialSchema": "wrong url", "type": "KYCAgeCredential", "credentialSubject": {}}`
+	assert.Equal(t, http.StatusBadRequest, do(http.MethodPost, fmt.Sprintf("/v1/%s/oid4vci/offers", did), "application/json", invalid, nil, true).Code)
+	rr = do(http.MethodPost, fmt.Sprintf("/v1/%s/oid4vci/offers", did), "application/json", offerBody, nil, true)
+	require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+	var offer OID4VCIOffer
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &offer))
+	assert.True(t, strings.HasPrefix(offer.CredentialOfferUri, "openid-credential-offer://?credential_offer="))
+	var message ports.OID4VCICredentialOfferMessage
+	require.NoError(t, convertMessage(offer.CredentialOffer, &message))
+	assert.Equal(t, host, message.CredentialIssuer)
+	require.Len(t, message.Credentials, 1)
+	assert.Equal(t, []string{verifiable.TypeW3CVerifiableCredential, "KYCAgeCredential"}, message.Credentials[0].CredentialDefinition.Types)
+	code := message.Grants[domain.OID4VCIPreAuthorizedCodeGrant].PreAuthorizedCode
+	require.NotEmpty(t, code)
+
+	rr = do(http.MethodGet, "/.well-known/openid-credential-issuer", "", "", nil, false)
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	var metadata OID4VCIIssuerMetadata
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &metadata))
+	assert.Equal(t, host+"/v1/oid4vci/token", metadata.TokenEndpoint)
+	assert.Equal(t, host+"/v1/oid4vci/credential", metadata.CredentialEndpoint)
+	require.NotNil(t, metadata.Display)
+	require.Len(t, *metadata.Display, 1)
+	assert.Equal(t, "my issuer", (*metadata.Display)[0].Name)
+	require.NotNil(t, (*metadata.Display)[0].Logo)
+	assert.Equal(t, "https://issuer.example.com/logo.png", (*metadata.Display)[0].Logo.Url)
+
+	tokenForm := func(grantType string, code string) string {
+		return url.Values{"grant_type": {grantType}, "pre-authorized_code": {code}}.Encode()
+	}
+	const form = "application/x-www-form-urlencoded"
+	rr = do(http.MethodPost, "/v1/oid4vci/token", form, tokenForm("authorization_code", code), nil, false)
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	assert.Contains(t, rr.Body.String(), "unsupported_grant_type")
+	assert.Equal(t, http.StatusBadRequest, do(http.MethodPost, "/v1/oid4vci/token", form, tokenForm(domain.OID4VCIPreAuthorizedCodeGrant, "wrong"), nil, false).Code)
+	rr = do(http.MethodPost, "/v1/oid4vci/token", form, tokenForm(domain.OID4VCIPreAuthorizedCodeGrant, code), nil, false)
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	var token OID4VCITokenResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &token))
+	assert.Equal(t, "bearer", token.TokenType)
+	assert.Equal(t, 600, token.ExpiresIn)
+	// a pre-authorized code can only be exchanged once
+	assert.Equal(t, http.StatusBadRequest, do(http.MethodPost, "/v1/oid4vci/token", form, tokenForm(domain.OID4VCIPreAuthorizedCodeGrant, code), nil, false).Code)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), cryptoRand.Reader)
+	require.NoError(t, err)
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.ES256, Key: key}, (&jose.SignerOptions{EmbedJWK: true}).WithType(domain.OID4VCIProofJWTType))
+	require.NoError(t, err)
+	proof := func(nonce string) string {
+		payload, err := json.Marshal(map[string]any{"aud": host, "nonce": nonce, "iat": time.Now().Unix()})
+		require.NoError(t, err)
+		jws, err := signer.Sign(payload)
+		require.NoError(t, err)
+		jwt, err := jws.CompactSerialize()
+		require.NoError(t, err)
+		return jwt
+	}
+	credentialBody := func(jwt string) string {
+		return fmt.Sprintf(`{"format": "ldp_vc", "proof": {"proof_type": "jwt", "jwt": %q}}`, jwt)
+	}
+	bearer := map[string]string{"Authorization": "Bearer " + token.AccessToken}
+
+	rr = do(http.MethodPost, "/v1/oid4vci/credential", "application/json", credentialBody(proof(token.CNonce)), map[string]string{"Authorization": "Bearer wrong"}, false)
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	rr = do(http.MethodPost, "/v1/oid4vci/credential", "application/json", `{"format": "jwt_vc_json"}`, bearer, false)
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	assert.Contains(t, rr.Body.String(), "unsupported_credential_format")
+
+	rr = do(http.MethodPost, "/v1/oid4vci/credential", "application/json", credentialBody(proof("wrong nonce")), bearer, false)
+	require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+	var proofErr OID4VCIError
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &proofErr))
+	assert.Equal(t, "invalid_proof", proofErr.Error)
+	require.NotNil(t, proofErr.CNonce)
+	// the rejected request replaced the nonce of the token
+	assert.Equal(t, http.StatusBadRequest, do(http.MethodPost, "/v1/oid4vci/credential", "application/json", credentialBody(proof(token.CNonce)), bearer, false).Code)
+
+	rr = do(http.MethodPost, "/v1/oid4vci/credential", "application/json", credentialBody(proof(*proofErr.CNonce)), bearer, false)
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	var issued OID4VCICredentialResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &issued))
+	assert.Equal(t, "ldp_vc", issued.Format)
+	assert.Equal(t, did, issued.Credential.Issuer)
+	assert.Equal(t, []string{verifiable.TypeW3CVerifiableCredential, "KYCAgeCredential"}, issued.Credential.Type)
+	assert.Equal(t, "did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ", issued.Credential.CredentialSubject["id"])
+
+	// later requests get the same credential
+	rr = do(http.MethodPost, "/v1/oid4vci/credential", "application/json", credentialBody(proof(issued.CNonce)), bearer, false)
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	var again OID4VCICredentialResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &again))
+	assert.Equal(t, issued.Credential.ID, again.Credential.ID)
+}
+
 func TestServer_CreateClaim(t *testing.T) {
 	const (
 		method     = "polygonid"
@@ -334,10 +707,10 @@ func TestServer_CreateClaim(t *testing.T) {
 		RHSEnabled: false,
 		Host:       "http://host",
 	}
-	pubSub := pubsub.NewMock()
-	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf, pubSub)
+	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf)
+	fixture := tests.NewFixture(storage)
 
-	server := NewServer(&cfg, identityService, claimsService, NewPublisherMock(), NewPackageManagerMock(), nil)
+	server := NewServer(&cfg, identityService, claimsService, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, NewPublisherMock(), NewPackageManagerMock(), nil, nil)
 	handler := getHandler(ctx, server)
 
 	iden, err := identityService.Create(ctx, method, blockchain, network, "polygon-test")
@@ -401,7 +774,7 @@ func TestServer_CreateClaim(t *testing.T) {
 				Expiration: common.ToPointer(time.Now().Unix()),
 			},
 			expected: expected{
-				response: CreateClaim400JSONResponse{N400JSONResponse{Message: "malformed url"}},
+				response: CreateClaim400JSONResponse{Message: "malformed url"},
 				httpCode: http.StatusBadRequest,
 			},
 		},
@@ -424,9 +797,45 @@ func TestServer_CreateClaim(t *testing.T) {
 				httpCode: http.StatusUnprocessableEntity,
 			},
 		},
+		{
+			name: "Subject did of an unsupported method",
+			auth: authOk,
+			did:  did,
+			body: CreateClaimRequest{
+				CredentialSchema: "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json",
+				Type:             "KYCAgeCredential",
+				CredentialSubject: map[string]any{
+					"id":           "did:ethr:0xb9c5714089478a327f09197987f16f9e5d936e8a",
+					"birthday":     19960424,
+					"documentType": 2,
+				},
+			},
+			expected: expected{
+				response: CreateClaim400JSONResponse{Message: "invalid subject did: the did method ethr is not supported, use one of did:polygonid, did:iden3, did:key, did:web"},
+				httpCode: http.StatusBadRequest,
+			},
+		},
+		{
+			name: "Subject did that is not an iden3 identity",
+			auth: authOk,
+			did:  did,
+			body: CreateClaimRequest{
+				CredentialSchema: "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json",
+				Type:             "KYCAgeCredential",
+				CredentialSubject: map[string]any{
+					"id":           "did:key:z6MkhaXgBZDvotDkL5257faiztiGiC2QtKLGpbnnEGta2doK",
+					"birthday":     19960424,
+					"documentType": 2,
+				},
+			},
+			expected: expected{
+				response: CreateClaim400JSONResponse{Message: "invalid subject did: did:key:z6MkhaXgBZDvotDkL5257faiztiGiC2QtKLGpbnnEGta2doK is a did:key, the subject of the iden3 credentials must be a did:polygonid or did:iden3 identity"},
+				httpCode: http.StatusBadRequest,
+			},
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
-			pubSub.Clear(event.CreateCredentialEvent)
+			notifications := fixture.CountNotifications(t, event.CreateCredentialEvent, did)
 			rr := httptest.NewRecorder()
 			url := fmt.Sprintf("/v1/%s/claims", tc.did)
 
@@ -438,7 +847,7 @@ func TestServer_CreateClaim(t *testing.T) {
 
 			require.Equal(t, tc.expected.httpCode, rr.Code)
 
-			assert.Equal(t, tc.expected.createCredentialEventsCount, len(pubSub.AllPublishedEvents(event.CreateCredentialEvent)))
+			assert.Equal(t, tc.expected.createCredentialEventsCount, fixture.CountNotifications(t, event.CreateCredentialEvent, did)-notifications)
 
 			switch tc.expected.httpCode {
 			case http.StatusCreated:
@@ -474,8 +883,8 @@ func TestServer_GetIdentities(t *testing.T) {
 		RHSEnabled: false,
 		Host:       "host",
 	}
-	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf, pubsub.NewMock())
-	server := NewServer(&cfg, identityService, claimsService, NewPublisherMock(), NewPackageManagerMock(), nil)
+	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf)
+	server := NewServer(&cfg, identityService, claimsService, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, NewPublisherMock(), NewPackageManagerMock(), nil, nil)
 	handler := getHandler(context.Background(), server)
 
 	idStr1 := "did:polygonid:polygon:mumbai:2qE1ZT16aqEWhh9mX9aqM2pe2ZwV995dTkReeKwCaQ"
@@ -551,7 +960,7 @@ func TestServer_GetClaimQrCode(t *testing.T) {
 	idStr := "did:polygonid:polygon:mumbai:2qPrv5Yx8s1qAmEnPym68LfT7gTbASGampiGU7TseL"
 	idNoClaims := "did:polygonid:polygon:mumbai:2qGjTUuxZKqKS4Q8UmxHUPw55g15QgEVGnj6Wkq8Vk"
 
-	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf, pubsub.NewMock())
+	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf)
 
 	identity := &domain.Identity{
 		Identifier: idStr,
@@ -563,7 +972,13 @@ func TestServer_GetClaimQrCode(t *testing.T) {
 	claim := fixture.NewClaim(t, identity.Identifier)
 	fixture.CreateClaim(t, claim)
 
-	server := NewServer(&cfg, identityService, claimsService, NewPublisherMock(), NewPackageManagerMock(), nil)
+	issuerProfileService := services.NewIssuerProfile(repositories.NewIssuerProfile(), storage, services.IssuerProfileCfg{DisplayName: "my issuer"})
+	did, err := core.ParseDID(idStr)
+	require.NoError(t, err)
+	_, err = issuerProfileService.Update(context.Background(), *did, &ports.IssuerProfileUpdate{BackgroundColor: common.ToPointer("#1a2b3c")})
+	require.NoError(t, err)
+
+	server := NewServer(&cfg, identityService, claimsService, nil, nil, nil, nil, nil, nil, nil, nil, nil, issuerProfileService, nil, nil, nil, nil, nil, NewPublisherMock(), NewPackageManagerMock(), nil, nil)
 	handler := getHandler(context.Background(), server)
 
 	type expected struct {
@@ -662,6 +1077,9 @@ func TestServer_GetClaimQrCode(t *testing.T) {
 				_, err = uuid.Parse(response.Body.Credentials[0].Id)
 				assert.NoError(t, err)
 				assert.Equal(t, claim.SchemaType, response.Body.Credentials[0].Description)
+				require.NotNil(t, response.Body.Issuer)
+				assert.Equal(t, "my issuer", response.Body.Issuer.DisplayName)
+				assert.Equal(t, common.ToPointer("#1a2b3c"), response.Body.Issuer.BackgroundColor)
 
 			case GetClaimQrCode400JSONResponse:
 				var response GetClaimQrCode400JSONResponse
@@ -696,9 +1114,9 @@ func TestServer_GetClaim(t *testing.T) {
 		RHSEnabled: false,
 		Host:       "host",
 	}
-	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf, pubsub.NewMock())
+	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf)
 
-	server := NewServer(&cfg, identityService, claimsService, NewPublisherMock(), NewPackageManagerMock(), nil)
+	server := NewServer(&cfg, identityService, claimsService, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, NewPublisherMock(), NewPackageManagerMock(), nil, nil)
 
 	idStr := "did:polygonid:polygon:mumbai:2qLduMv2z7hnuhzkcTWesCUuJKpRVDEThztM4tsJUj"
 	idStrWithoutClaims := "did:polygonid:polygon:mumbai:2qGjTUuxZKqKS4Q8UmxHUPw55g15QgEVGnj6Wkq8Vk"
@@ -868,10 +1286,10 @@ func TestServer_GetClaims(t *testing.T) {
 		RHSEnabled: false,
 		Host:       "host",
 	}
-	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf, pubsub.NewMock())
+	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf)
 
 	fixture := tests.NewFixture(storage)
-	server := NewServer(&cfg, identityService, claimsService, NewPublisherMock(), NewPackageManagerMock(), nil)
+	server := NewServer(&cfg, identityService, claimsService, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, NewPublisherMock(), NewPackageManagerMock(), nil, nil)
 
 	ctx := context.Background()
 	identityMultipleClaims, err := server.identityService.Create(ctx, method, blockchain, network, "https://localhost.com")
@@ -1199,8 +1617,8 @@ func TestServer_GetRevocationStatus(t *testing.T) {
 
 	identity, err := identityService.Create(ctx, method, blockchain, network, "http://localhost:3001")
 	assert.NoError(t, err)
-	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf, pubsub.NewMock())
-	server := NewServer(&cfg, identityService, claimsService, NewPublisherMock(), NewPackageManagerMock(), nil)
+	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf)
+	server := NewServer(&cfg, identityService, claimsService, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, NewPublisherMock(), NewPackageManagerMock(), nil, nil)
 	handler := getHandler(context.Background(), server)
 
 	schema := "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json"
@@ -1348,3 +1766,201 @@ func createGetClaimsURL(did string, schemaHash *string, schemaType *string, subj
 	tURL.RawQuery = q.Encode()
 	return tURL.String()
 }
+
+func TestServer_SchemaCache(t *testing.T) {
+	const kycContext = `{"@context":{"KYCAgeCredential":{"@id":"https://example.com/kyc#KYCAgeCredential"}}}`
+	host := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/kyc.jsonld" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/ld+json")
+		w.Header().Set("ETag", `"v1"`)
+		_, _ = w.Write([]byte(kycContext))
+	}))
+	defer host.Close()
+
+	documentCache := schema.NewDocumentCache(cache.NewMemoryCache(), time.Hour, http.DefaultTransport)
+	server := NewServer(&cfg, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, documentCache, nil, nil, NewPublisherMock(), NewPackageManagerMock(), nil, nil)
+	handler := getHandler(context.Background(), server)
+
+	refresh := func(auth func() (string, string), u string) *httptest.ResponseRecorder {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodPost, "/v1/schema-cache/refresh", strings.NewReader(fmt.Sprintf(`{"url":%q}`, u)))
+		require.NoError(t, err)
+		req.SetBasicAuth(auth())
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+	purge := func(u string) *httptest.ResponseRecorder {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodDelete, "/v1/schema-cache?url="+url.QueryEscape(u), nil)
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	assert.Equal(t, http.StatusUnauthorized, refresh(authWrong, host.URL+"/kyc.jsonld").Code)
+	assert.Equal(t, http.StatusBadRequest, refresh(authOk, "ipfs://QmSchemaCID").Code)
+	assert.Equal(t, http.StatusUnprocessableEntity, refresh(authOk, host.URL+"/missing.jsonld").Code)
+
+	rr := refresh(authOk, host.URL+"/kyc.jsonld")
+	require.Equal(t, http.StatusOK, rr.Code)
+	var doc RefreshCachedDocument200JSONResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &doc))
+	assert.Equal(t, host.URL+"/kyc.jsonld", doc.Url)
+	assert.Equal(t, "application/ld+json", doc.ContentType)
+	assert.Equal(t, `"v1"`, doc.Etag)
+	assert.Equal(t, len(kycContext), doc.Size)
+
+	assert.Equal(t, http.StatusOK, purge(host.URL+"/kyc.jsonld").Code)
+	assert.Equal(t, http.StatusNotFound, purge(host.URL+"/kyc.jsonld").Code)
+}
+
+func TestServer_GetAgentCapabilities(t *testing.T) {
+	agentCfg := cfg
+	agentCfg.ServerUrl = "https://issuer.example.com/"
+	agentCfg.ReverseHashService = config.ReverseHashService{URL: "https://rhs.example.com"}
+	agentCfg.EncryptionKeys = "agent-keys.json"
+	server := NewServer(&agentCfg, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, NewPublisherMock(), NewPackageManagerMock(), nil, nil)
+	handler := getHandler(context.Background(), server)
+
+	rr := httptest.NewRecorder()
+	req, err := http.NewRequest(http.MethodGet, "/.well-known/agent-capabilities", nil)
+	require.NoError(t, err)
+	handler.ServeHTTP(rr, req)
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	var capabilities AgentCapabilities
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &capabilities))
+	assert.Equal(t, "https://issuer.example.com/v1/agent", capabilities.Agent)
+	assert.Equal(t, []string{"application/iden3-zkp-json", "application/iden3comm-plain-json", "application/iden3comm-encrypted-json"}, capabilities.Accepts.MediaTypes)
+	assert.Equal(t, []string{
+		"https://iden3-communication.io/credentials/1.0/fetch-request",
+		"https://iden3-communication.io/credentials/1.0/refresh",
+		"https://iden3-communication.io/revocation/1.0/request-status",
+		"https://iden3-communication.io/credentials/0.1/proposal-request",
+	}, capabilities.Accepts.Messages)
+	assert.Contains(t, capabilities.Sends.Messages, "https://iden3-communication.io/credentials/1.0/offer")
+	assert.Contains(t, capabilities.Sends.Messages, "https://iden3-communication.io/revocation/1.0/status")
+	assert.Contains(t, capabilities.Circuits, "authV2")
+	assert.Equal(t, []string{"SparseMerkleTreeProof", "Iden3ReverseSparseMerkleTreeProof"}, capabilities.Credentials.StatusTypes)
+	assert.Equal(t, []string{}, capabilities.Networks)
+}
+
+func TestServer_DIDDocument(t *testing.T) {
+	const host = "https://issuer.example.com"
+	ctx := log.NewContext(context.Background(), log.LevelDebug, log.OutputText, os.Stdout)
+	identityRepo := repositories.NewIdentity()
+	claimsRepo := repositories.NewClaims()
+	identityStateRepo := repositories.NewIdentityState()
+	mtRepo := repositories.NewIdentityMerkleTreeRepository()
+	mtService := services.NewIdentityMerkleTrees(mtRepo)
+	rhsp := reverse_hash.NewRhsPublisher(nil, false)
+	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, claimsRepo, repositories.NewRevocation(), repositories.NewConnections(), storage, rhsp, nil, nil, pubsub.NewMock())
+	didDocumentService := services.NewDIDDocument(repositories.NewDIDService(), identityService, storage, nil, services.DIDDocumentCfg{ServerURL: host})
+
+	server := NewServer(&cfg, identityService, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, didDocumentService, nil, nil, nil, nil, NewPublisherMock(), NewPackageManagerMock(), nil, nil)
+	handler := getHandler(ctx, server)
+
+	iden, err := identityService.Create(ctx, "polygonid", "polygon", "mumbai", "polygon-test")
+	require.NoError(t, err)
+	did := iden.Identifier
+
+	do := func(method, path, body string, withAuth bool) *httptest.ResponseRecorder {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(method, path, strings.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		if withAuth {
+			req.SetBasicAuth(authOk())
+		}
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+	resolve := func() DIDDocument {
+		rr := do(http.MethodGet, fmt.Sprintf("/v1/%s/did-document", did), "", false)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var resolution DIDResolution
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resolution))
+		assert.Equal(t, "application/did+ld+json", resolution.DidResolutionMetadata.ContentType)
+		return resolution.DidDocument
+	}
+
+	assert.Equal(t, http.StatusBadRequest, do(http.MethodGet, "/v1/wrong/did-document", "", false).Code)
+	assert.Equal(t, http.StatusNotFound, do(http.MethodGet, "/v1/did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ/did-document", "", false).Code)
+
+	doc := resolve()
+	assert.Equal(t, did, doc.Id)
+	require.Len(t, doc.VerificationMethod, 1)
+	assert.Equal(t, did+"#stateInfo", doc.VerificationMethod[0].Id)
+	assert.False(t, doc.VerificationMethod[0].Published)
+	assert.Equal(t, []DIDDocumentService{{Id: did + "#iden3-communication", Type: verifiable.Iden3CommServiceType, ServiceEndpoint: host + "/v1/agent"}}, doc.Service)
+
+	servicesPath := fmt.Sprintf("/v1/%s/did-document/services", did)
+	pushBody := `{"type": "push-notification", "serviceEndpoint": "https://push.issuer.example.com/api/v1"}`
+	assert.Equal(t, http.StatusUnauthorized, do(http.MethodPut, servicesPath+"/push", pushBody, false).Code)
+	assert.Equal(t, http.StatusBadRequest, do(http.MethodPut, servicesPath+"/push", `{"type": "push-notification", "serviceEndpoint": "push"}`, true).Code)
+	rr := do(http.MethodPut, servicesPath+"/push", pushBody, true)
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	rr = do(http.MethodPut, servicesPath+"/iden3-communication", `{"type": "iden3-communication", "serviceEndpoint": "https://agent.issuer.example.com/v1/agent"}`, true)
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+	rr = do(http.MethodGet, servicesPath, "", true)
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	var list []DIDService
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &list))
+	require.Len(t, list, 2)
+	assert.Equal(t, "iden3-communication", list[0].Id)
+	assert.True(t, list[0].Overridden)
+	assert.Equal(t, "push", list[1].Id)
+
+	doc = resolve()
+	require.Len(t, doc.Service, 2)
+	assert.Equal(t, "https://agent.issuer.example.com/v1/agent", doc.Service[0].ServiceEndpoint)
+	assert.Equal(t, DIDDocumentService{Id: did + "#push", Type: "push-notification", ServiceEndpoint: "https://push.issuer.example.com/api/v1"}, doc.Service[1])
+
+	// removing the replacement of the agent publishes the agent of the node again
+	require.Equal(t, http.StatusOK, do(http.MethodDelete, servicesPath+"/iden3-communication", "", true).Code)
+	assert.Equal(t, http.StatusNotFound, do(http.MethodDelete, servicesPath+"/iden3-communication", "", true).Code)
+	doc = resolve()
+	require.Len(t, doc.Service, 2)
+	assert.Equal(t, host+"/v1/agent", doc.Service[0].ServiceEndpoint)
+}
+
+type agentInboxRecorder struct {
+	ports.AgentInboxService
+	recorded []*domain.InboxMessage
+}
+
+func (r *agentInboxRecorder) Record(_ context.Context, message *domain.InboxMessage) error {
+	r.recorded = append(r.recorded, message)
+	return nil
+}
+
+func TestServer_AgentUnknownRecipient(t *testing.T) {
+	packageManager := iden3comm.NewPackageManager()
+	require.NoError(t, packageManager.RegisterPackers(&packers.PlainMessagePacker{}))
+	inbox := &agentInboxRecorder{}
+	server := NewServer(&cfg, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, inbox, nil, NewPublisherMock(), packageManager, nil, nil)
+	handler := getHandler(context.Background(), server)
+
+	body := `{
+		"id": "` + uuid.NewString() + `",
+		"typ": "application/iden3comm-plain-json",
+		"type": "https://iden3-communication.io/revocation/1.0/request-status",
+		"thid": "` + uuid.NewString() + `",
+		"body": {"revocation_nonce": 1},
+		"from": "did:polygonid:polygon:mumbai:2qFXmNqGWPrLqDowKz37Gq2FETk4yQwVUVUqeBLmf9",
+		"to": "not-a-did"
+	}`
+	rr := httptest.NewRecorder()
+	req, err := http.NewRequest(http.MethodPost, "/v1/agent", strings.NewReader(body))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "text/plain")
+	handler.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	assert.Empty(t, inbox.recorded, "the messages without a known recipient are not recorded")
+}
@@ -0,0 +1,115 @@
+package api_grpc
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/base64"
+	"path"
+	"strings"
+	"time"
+
+	core "github.com/iden3/go-iden3-core"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/standby"
+)
+
+// readMethods are the methods that do not change the identities, allowed on read only identities and standby nodes
+var readMethods = map[string]bool{
+	"GetClaim":              true,
+	"ListClaims":            true,
+	"GetConnection":         true,
+	"ListConnections":       true,
+	"GetSchema":             true,
+	"ListSchemas":           true,
+	"ListStateTransactions": true,
+}
+
+// AuthInterceptor returns an interceptor that checks the basic auth credentials of the admin API in the
+// authorization metadata of the requests. Requests are not checked if the credentials are not configured.
+func AuthInterceptor(user, pass string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if user == "" || pass == "" {
+			return handler(ctx, req)
+		}
+		md, _ := metadata.FromIncomingContext(ctx)
+		auth := md.Get("authorization")
+		if len(auth) == 0 {
+			return nil, status.Error(codes.Unauthenticated, "unauthorized")
+		}
+		userReq, passReq, ok := parseBasicAuth(auth[0])
+		if !ok || subtle.ConstantTimeCompare([]byte(user), []byte(userReq)) != 1 || subtle.ConstantTimeCompare([]byte(pass), []byte(passReq)) != 1 {
+			return nil, status.Error(codes.Unauthenticated, "unauthorized")
+		}
+		return handler(ctx, req)
+	}
+}
+
+// ReadOnlyInterceptor returns an interceptor that rejects the requests that would change an identity while it is
+// being migrated to another node, or any identity while the node is a standby, as the admin API does.
+func ReadOnlyInterceptor(migration ports.IdentityMigrationService, node *standby.Node) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if readMethods[path.Base(info.FullMethod)] {
+			return handler(ctx, req)
+		}
+		if node.Standby() {
+			return nil, status.Error(codes.Unavailable, "node is a standby")
+		}
+		if r, ok := req.(interface{ GetIdentifier() string }); ok {
+			did, err := core.ParseDID(r.GetIdentifier())
+			if err == nil && migration.IsReadOnly(ctx, *did) {
+				return nil, status.Error(codes.Unavailable, "identity is being migrated")
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+// MetricsInterceptor returns an interceptor that counts the requests by method and code, and measures their
+// duration, in the given registry
+func MetricsInterceptor(reg prometheus.Registerer) (grpc.UnaryServerInterceptor, error) {
+	requests := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "issuer",
+		Subsystem: "grpc",
+		Name:      "requests_total",
+		Help:      "Number of gRPC requests by method and code.",
+	}, []string{"method", "code"})
+	duration := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "issuer",
+		Subsystem: "grpc",
+		Name:      "request_duration_seconds",
+		Help:      "Duration of the gRPC requests by method.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method"})
+	for _, c := range []prometheus.Collector{requests, duration} {
+		if err := reg.Register(c); err != nil {
+			return nil, err
+		}
+	}
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		duration.WithLabelValues(info.FullMethod).Observe(time.Since(start).Seconds())
+		requests.WithLabelValues(info.FullMethod, status.Code(err).String()).Inc()
+		return resp, err
+	}, nil
+}
+
+// parseBasicAuth parses the value of a basic auth authorization header
+func parseBasicAuth(auth string) (user, pass string, ok bool) {
+	const prefix = "Basic "
+	if len(auth) < len(prefix) || !strings.EqualFold(auth[:len(prefix)], prefix) {
+		return "", "", false
+	}
+	decoded, err := base64.StdEncoding.DecodeString(auth[len(prefix):])
+	if err != nil {
+		return "", "", false
+	}
+	return strings.Cut(string(decoded), ":")
+}
@@ -0,0 +1,687 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             (unknown)
+// source: issuer.proto
+
+package api_grpc
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ClaimsServiceClient is the client API for ClaimsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ClaimsServiceClient interface {
+	CreateClaim(ctx context.Context, in *CreateClaimRequest, opts ...grpc.CallOption) (*CreateClaimResponse, error)
+	GetClaim(ctx context.Context, in *GetClaimRequest, opts ...grpc.CallOption) (*Claim, error)
+	ListClaims(ctx context.Context, in *ListClaimsRequest, opts ...grpc.CallOption) (*ListClaimsResponse, error)
+	RevokeClaim(ctx context.Context, in *RevokeClaimRequest, opts ...grpc.CallOption) (*RevokeClaimResponse, error)
+}
+
+type claimsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewClaimsServiceClient(cc grpc.ClientConnInterface) ClaimsServiceClient {
+	return &claimsServiceClient{cc}
+}
+
+func (c *claimsServiceClient) CreateClaim(ctx context.Context, in *CreateClaimRequest, opts ...grpc.CallOption) (*CreateClaimResponse, error) {
+	out := new(CreateClaimResponse)
+	err := c.cc.Invoke(ctx, "/issuer.v1.ClaimsService/CreateClaim", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *claimsServiceClient) GetClaim(ctx context.Context, in *GetClaimRequest, opts ...grpc.CallOption) (*Claim, error) {
+	out := new(Claim)
+	err := c.cc.Invoke(ctx, "/issuer.v1.ClaimsService/GetClaim", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *claimsServiceClient) ListClaims(ctx context.Context, in *ListClaimsRequest, opts ...grpc.CallOption) (*ListClaimsResponse, error) {
+	out := new(ListClaimsResponse)
+	err := c.cc.Invoke(ctx, "/issuer.v1.ClaimsService/ListClaims", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *claimsServiceClient) RevokeClaim(ctx context.Context, in *RevokeClaimRequest, opts ...grpc.CallOption) (*RevokeClaimResponse, error) {
+	out := new(RevokeClaimResponse)
+	err := c.cc.Invoke(ctx, "/issuer.v1.ClaimsService/RevokeClaim", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ClaimsServiceServer is the server API for ClaimsService service.
+// All implementations must embed UnimplementedClaimsServiceServer
+// for forward compatibility
+type ClaimsServiceServer interface {
+	CreateClaim(context.Context, *CreateClaimRequest) (*CreateClaimResponse, error)
+	GetClaim(context.Context, *GetClaimRequest) (*Claim, error)
+	ListClaims(context.Context, *ListClaimsRequest) (*ListClaimsResponse, error)
+	RevokeClaim(context.Context, *RevokeClaimRequest) (*RevokeClaimResponse, error)
+	mustEmbedUnimplementedClaimsServiceServer()
+}
+
+// UnimplementedClaimsServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedClaimsServiceServer struct {
+}
+
+func (UnimplementedClaimsServiceServer) CreateClaim(context.Context, *CreateClaimRequest) (*CreateClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateClaim not implemented")
+}
+func (UnimplementedClaimsServiceServer) GetClaim(context.Context, *GetClaimRequest) (*Claim, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClaim not implemented")
+}
+func (UnimplementedClaimsServiceServer) ListClaims(context.Context, *ListClaimsRequest) (*ListClaimsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClaims not implemented")
+}
+func (UnimplementedClaimsServiceServer) RevokeClaim(context.Context, *RevokeClaimRequest) (*RevokeClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeClaim not implemented")
+}
+func (UnimplementedClaimsServiceServer) mustEmbedUnimplementedClaimsServiceServer() {}
+
+// UnsafeClaimsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ClaimsServiceServer will
+// result in compilation errors.
+type UnsafeClaimsServiceServer interface {
+	mustEmbedUnimplementedClaimsServiceServer()
+}
+
+func RegisterClaimsServiceServer(s grpc.ServiceRegistrar, srv ClaimsServiceServer) {
+	s.RegisterService(&ClaimsService_ServiceDesc, srv)
+}
+
+func _ClaimsService_CreateClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateClaimRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClaimsServiceServer).CreateClaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/issuer.v1.ClaimsService/CreateClaim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClaimsServiceServer).CreateClaim(ctx, req.(*CreateClaimRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClaimsService_GetClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClaimRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClaimsServiceServer).GetClaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/issuer.v1.ClaimsService/GetClaim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClaimsServiceServer).GetClaim(ctx, req.(*GetClaimRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClaimsService_ListClaims_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClaimsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClaimsServiceServer).ListClaims(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/issuer.v1.ClaimsService/ListClaims",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClaimsServiceServer).ListClaims(ctx, req.(*ListClaimsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClaimsService_RevokeClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeClaimRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClaimsServiceServer).RevokeClaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/issuer.v1.ClaimsService/RevokeClaim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClaimsServiceServer).RevokeClaim(ctx, req.(*RevokeClaimRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ClaimsService_ServiceDesc is the grpc.ServiceDesc for ClaimsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ClaimsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "issuer.v1.ClaimsService",
+	HandlerType: (*ClaimsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateClaim",
+			Handler:    _ClaimsService_CreateClaim_Handler,
+		},
+		{
+			MethodName: "GetClaim",
+			Handler:    _ClaimsService_GetClaim_Handler,
+		},
+		{
+			MethodName: "ListClaims",
+			Handler:    _ClaimsService_ListClaims_Handler,
+		},
+		{
+			MethodName: "RevokeClaim",
+			Handler:    _ClaimsService_RevokeClaim_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "issuer.proto",
+}
+
+// ConnectionsServiceClient is the client API for ConnectionsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ConnectionsServiceClient interface {
+	GetConnection(ctx context.Context, in *GetConnectionRequest, opts ...grpc.CallOption) (*Connection, error)
+	ListConnections(ctx context.Context, in *ListConnectionsRequest, opts ...grpc.CallOption) (*ListConnectionsResponse, error)
+	DeleteConnection(ctx context.Context, in *DeleteConnectionRequest, opts ...grpc.CallOption) (*DeleteConnectionResponse, error)
+}
+
+type connectionsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewConnectionsServiceClient(cc grpc.ClientConnInterface) ConnectionsServiceClient {
+	return &connectionsServiceClient{cc}
+}
+
+func (c *connectionsServiceClient) GetConnection(ctx context.Context, in *GetConnectionRequest, opts ...grpc.CallOption) (*Connection, error) {
+	out := new(Connection)
+	err := c.cc.Invoke(ctx, "/issuer.v1.ConnectionsService/GetConnection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *connectionsServiceClient) ListConnections(ctx context.Context, in *ListConnectionsRequest, opts ...grpc.CallOption) (*ListConnectionsResponse, error) {
+	out := new(ListConnectionsResponse)
+	err := c.cc.Invoke(ctx, "/issuer.v1.ConnectionsService/ListConnections", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *connectionsServiceClient) DeleteConnection(ctx context.Context, in *DeleteConnectionRequest, opts ...grpc.CallOption) (*DeleteConnectionResponse, error) {
+	out := new(DeleteConnectionResponse)
+	err := c.cc.Invoke(ctx, "/issuer.v1.ConnectionsService/DeleteConnection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ConnectionsServiceServer is the server API for ConnectionsService service.
+// All implementations must embed UnimplementedConnectionsServiceServer
+// for forward compatibility
+type ConnectionsServiceServer interface {
+	GetConnection(context.Context, *GetConnectionRequest) (*Connection, error)
+	ListConnections(context.Context, *ListConnectionsRequest) (*ListConnectionsResponse, error)
+	DeleteConnection(context.Context, *DeleteConnectionRequest) (*DeleteConnectionResponse, error)
+	mustEmbedUnimplementedConnectionsServiceServer()
+}
+
+// UnimplementedConnectionsServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedConnectionsServiceServer struct {
+}
+
+func (UnimplementedConnectionsServiceServer) GetConnection(context.Context, *GetConnectionRequest) (*Connection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConnection not implemented")
+}
+func (UnimplementedConnectionsServiceServer) ListConnections(context.Context, *ListConnectionsRequest) (*ListConnectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConnections not implemented")
+}
+func (UnimplementedConnectionsServiceServer) DeleteConnection(context.Context, *DeleteConnectionRequest) (*DeleteConnectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteConnection not implemented")
+}
+func (UnimplementedConnectionsServiceServer) mustEmbedUnimplementedConnectionsServiceServer() {}
+
+// UnsafeConnectionsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ConnectionsServiceServer will
+// result in compilation errors.
+type UnsafeConnectionsServiceServer interface {
+	mustEmbedUnimplementedConnectionsServiceServer()
+}
+
+func RegisterConnectionsServiceServer(s grpc.ServiceRegistrar, srv ConnectionsServiceServer) {
+	s.RegisterService(&ConnectionsService_ServiceDesc, srv)
+}
+
+func _ConnectionsService_GetConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConnectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConnectionsServiceServer).GetConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/issuer.v1.ConnectionsService/GetConnection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConnectionsServiceServer).GetConnection(ctx, req.(*GetConnectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConnectionsService_ListConnections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConnectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConnectionsServiceServer).ListConnections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/issuer.v1.ConnectionsService/ListConnections",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConnectionsServiceServer).ListConnections(ctx, req.(*ListConnectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConnectionsService_DeleteConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteConnectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConnectionsServiceServer).DeleteConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/issuer.v1.ConnectionsService/DeleteConnection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConnectionsServiceServer).DeleteConnection(ctx, req.(*DeleteConnectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ConnectionsService_ServiceDesc is the grpc.ServiceDesc for ConnectionsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ConnectionsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "issuer.v1.ConnectionsService",
+	HandlerType: (*ConnectionsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetConnection",
+			Handler:    _ConnectionsService_GetConnection_Handler,
+		},
+		{
+			MethodName: "ListConnections",
+			Handler:    _ConnectionsService_ListConnections_Handler,
+		},
+		{
+			MethodName: "DeleteConnection",
+			Handler:    _ConnectionsService_DeleteConnection_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "issuer.proto",
+}
+
+// SchemaAdminServiceClient is the client API for SchemaAdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SchemaAdminServiceClient interface {
+	ImportSchema(ctx context.Context, in *ImportSchemaRequest, opts ...grpc.CallOption) (*Schema, error)
+	GetSchema(ctx context.Context, in *GetSchemaRequest, opts ...grpc.CallOption) (*Schema, error)
+	ListSchemas(ctx context.Context, in *ListSchemasRequest, opts ...grpc.CallOption) (*ListSchemasResponse, error)
+}
+
+type schemaAdminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSchemaAdminServiceClient(cc grpc.ClientConnInterface) SchemaAdminServiceClient {
+	return &schemaAdminServiceClient{cc}
+}
+
+func (c *schemaAdminServiceClient) ImportSchema(ctx context.Context, in *ImportSchemaRequest, opts ...grpc.CallOption) (*Schema, error) {
+	out := new(Schema)
+	err := c.cc.Invoke(ctx, "/issuer.v1.SchemaAdminService/ImportSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schemaAdminServiceClient) GetSchema(ctx context.Context, in *GetSchemaRequest, opts ...grpc.CallOption) (*Schema, error) {
+	out := new(Schema)
+	err := c.cc.Invoke(ctx, "/issuer.v1.SchemaAdminService/GetSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schemaAdminServiceClient) ListSchemas(ctx context.Context, in *ListSchemasRequest, opts ...grpc.CallOption) (*ListSchemasResponse, error) {
+	out := new(ListSchemasResponse)
+	err := c.cc.Invoke(ctx, "/issuer.v1.SchemaAdminService/ListSchemas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SchemaAdminServiceServer is the server API for SchemaAdminService service.
+// All implementations must embed UnimplementedSchemaAdminServiceServer
+// for forward compatibility
+type SchemaAdminServiceServer interface {
+	ImportSchema(context.Context, *ImportSchemaRequest) (*Schema, error)
+	GetSchema(context.Context, *GetSchemaRequest) (*Schema, error)
+	ListSchemas(context.Context, *ListSchemasRequest) (*ListSchemasResponse, error)
+	mustEmbedUnimplementedSchemaAdminServiceServer()
+}
+
+// UnimplementedSchemaAdminServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedSchemaAdminServiceServer struct {
+}
+
+func (UnimplementedSchemaAdminServiceServer) ImportSchema(context.Context, *ImportSchemaRequest) (*Schema, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportSchema not implemented")
+}
+func (UnimplementedSchemaAdminServiceServer) GetSchema(context.Context, *GetSchemaRequest) (*Schema, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchema not implemented")
+}
+func (UnimplementedSchemaAdminServiceServer) ListSchemas(context.Context, *ListSchemasRequest) (*ListSchemasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSchemas not implemented")
+}
+func (UnimplementedSchemaAdminServiceServer) mustEmbedUnimplementedSchemaAdminServiceServer() {}
+
+// UnsafeSchemaAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SchemaAdminServiceServer will
+// result in compilation errors.
+type UnsafeSchemaAdminServiceServer interface {
+	mustEmbedUnimplementedSchemaAdminServiceServer()
+}
+
+func RegisterSchemaAdminServiceServer(s grpc.ServiceRegistrar, srv SchemaAdminServiceServer) {
+	s.RegisterService(&SchemaAdminService_ServiceDesc, srv)
+}
+
+func _SchemaAdminService_ImportSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchemaAdminServiceServer).ImportSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/issuer.v1.SchemaAdminService/ImportSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchemaAdminServiceServer).ImportSchema(ctx, req.(*ImportSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchemaAdminService_GetSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchemaAdminServiceServer).GetSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/issuer.v1.SchemaAdminService/GetSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchemaAdminServiceServer).GetSchema(ctx, req.(*GetSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchemaAdminService_ListSchemas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSchemasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchemaAdminServiceServer).ListSchemas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/issuer.v1.SchemaAdminService/ListSchemas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchemaAdminServiceServer).ListSchemas(ctx, req.(*ListSchemasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SchemaAdminService_ServiceDesc is the grpc.ServiceDesc for SchemaAdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SchemaAdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "issuer.v1.SchemaAdminService",
+	HandlerType: (*SchemaAdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ImportSchema",
+			Handler:    _SchemaAdminService_ImportSchema_Handler,
+		},
+		{
+			MethodName: "GetSchema",
+			Handler:    _SchemaAdminService_GetSchema_Handler,
+		},
+		{
+			MethodName: "ListSchemas",
+			Handler:    _SchemaAdminService_ListSchemas_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "issuer.proto",
+}
+
+// PublisherServiceClient is the client API for PublisherService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PublisherServiceClient interface {
+	PublishState(ctx context.Context, in *PublishStateRequest, opts ...grpc.CallOption) (*PublishedState, error)
+	RetryPublishState(ctx context.Context, in *PublishStateRequest, opts ...grpc.CallOption) (*PublishedState, error)
+	ListStateTransactions(ctx context.Context, in *ListStateTransactionsRequest, opts ...grpc.CallOption) (*ListStateTransactionsResponse, error)
+}
+
+type publisherServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPublisherServiceClient(cc grpc.ClientConnInterface) PublisherServiceClient {
+	return &publisherServiceClient{cc}
+}
+
+func (c *publisherServiceClient) PublishState(ctx context.Context, in *PublishStateRequest, opts ...grpc.CallOption) (*PublishedState, error) {
+	out := new(PublishedState)
+	err := c.cc.Invoke(ctx, "/issuer.v1.PublisherService/PublishState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publisherServiceClient) RetryPublishState(ctx context.Context, in *PublishStateRequest, opts ...grpc.CallOption) (*PublishedState, error) {
+	out := new(PublishedState)
+	err := c.cc.Invoke(ctx, "/issuer.v1.PublisherService/RetryPublishState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publisherServiceClient) ListStateTransactions(ctx context.Context, in *ListStateTransactionsRequest, opts ...grpc.CallOption) (*ListStateTransactionsResponse, error) {
+	out := new(ListStateTransactionsResponse)
+	err := c.cc.Invoke(ctx, "/issuer.v1.PublisherService/ListStateTransactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PublisherServiceServer is the server API for PublisherService service.
+// All implementations must embed UnimplementedPublisherServiceServer
+// for forward compatibility
+type PublisherServiceServer interface {
+	PublishState(context.Context, *PublishStateRequest) (*PublishedState, error)
+	RetryPublishState(context.Context, *PublishStateRequest) (*PublishedState, error)
+	ListStateTransactions(context.Context, *ListStateTransactionsRequest) (*ListStateTransactionsResponse, error)
+	mustEmbedUnimplementedPublisherServiceServer()
+}
+
+// UnimplementedPublisherServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedPublisherServiceServer struct {
+}
+
+func (UnimplementedPublisherServiceServer) PublishState(context.Context, *PublishStateRequest) (*PublishedState, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishState not implemented")
+}
+func (UnimplementedPublisherServiceServer) RetryPublishState(context.Context, *PublishStateRequest) (*PublishedState, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryPublishState not implemented")
+}
+func (UnimplementedPublisherServiceServer) ListStateTransactions(context.Context, *ListStateTransactionsRequest) (*ListStateTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStateTransactions not implemented")
+}
+func (UnimplementedPublisherServiceServer) mustEmbedUnimplementedPublisherServiceServer() {}
+
+// UnsafePublisherServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PublisherServiceServer will
+// result in compilation errors.
+type UnsafePublisherServiceServer interface {
+	mustEmbedUnimplementedPublisherServiceServer()
+}
+
+func RegisterPublisherServiceServer(s grpc.ServiceRegistrar, srv PublisherServiceServer) {
+	s.RegisterService(&PublisherService_ServiceDesc, srv)
+}
+
+func _PublisherService_PublishState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublisherServiceServer).PublishState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/issuer.v1.PublisherService/PublishState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublisherServiceServer).PublishState(ctx, req.(*PublishStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PublisherService_RetryPublishState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublisherServiceServer).RetryPublishState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/issuer.v1.PublisherService/RetryPublishState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublisherServiceServer).RetryPublishState(ctx, req.(*PublishStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PublisherService_ListStateTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStateTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublisherServiceServer).ListStateTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/issuer.v1.PublisherService/ListStateTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublisherServiceServer).ListStateTransactions(ctx, req.(*ListStateTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PublisherService_ServiceDesc is the grpc.ServiceDesc for PublisherService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PublisherService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "issuer.v1.PublisherService",
+	HandlerType: (*PublisherServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PublishState",
+			Handler:    _PublisherService_PublishState_Handler,
+		},
+		{
+			MethodName: "RetryPublishState",
+			Handler:    _PublisherService_RetryPublishState_Handler,
+		},
+		{
+			MethodName: "ListStateTransactions",
+			Handler:    _PublisherService_ListStateTransactions_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "issuer.proto",
+}
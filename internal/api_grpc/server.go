@@ -0,0 +1,461 @@
+package api_grpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+	"github.com/iden3/go-schema-processor/verifiable"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/gateways"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/pii"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+	"github.com/polygonid/sh-id-platform/pkg/schema"
+)
+
+// Server implements the gRPC services of the issuer on top of the same ports as the HTTP APIs. Every request names
+// the issuer in its identifier, as in the admin API.
+type Server struct {
+	UnimplementedClaimsServiceServer
+	UnimplementedConnectionsServiceServer
+	UnimplementedSchemaAdminServiceServer
+	UnimplementedPublisherServiceServer
+
+	claimService       ports.ClaimsService
+	connectionsService ports.ConnectionsService
+	schemaService      ports.SchemaService
+	publisherGateway   ports.Publisher
+	listingPII         pii.Fields // fields masked in the responses that list claims
+}
+
+// NewServer is a Server constructor
+func NewServer(cfg *config.Configuration, claimsService ports.ClaimsService, connectionsService ports.ConnectionsService, schemaService ports.SchemaService, publisherGateway ports.Publisher) *Server {
+	var listingPII pii.Fields
+	if cfg.PII.MaskListings {
+		listingPII = pii.NewFields(cfg.PII.Fields)
+	}
+	return &Server{
+		claimService:       claimsService,
+		connectionsService: connectionsService,
+		schemaService:      schemaService,
+		publisherGateway:   publisherGateway,
+		listingPII:         listingPII,
+	}
+}
+
+// Register registers the services of the server in a gRPC server
+func (s *Server) Register(srv *grpc.Server) {
+	RegisterClaimsServiceServer(srv, s)
+	RegisterConnectionsServiceServer(srv, s)
+	RegisterSchemaAdminServiceServer(srv, s)
+	RegisterPublisherServiceServer(srv, s)
+}
+
+// CreateClaim creates a credential
+func (s *Server) CreateClaim(ctx context.Context, req *CreateClaimRequest) (*CreateClaimResponse, error) {
+	did, err := core.ParseDID(req.Identifier)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	var expiration *time.Time
+	if req.Expiration != nil {
+		expiration = common.ToPointer(time.Unix(*req.Expiration, 0))
+	}
+
+	claimReq := ports.NewCreateClaimRequest(did, req.CredentialSchema, req.CredentialSubject.AsMap(), expiration, req.Type, req.Version, req.SubjectPosition, req.MerklizedRootPosition, nil, nil, nil, false)
+	claimReq.ExtraContexts = req.ExtraContexts
+	claimReq.ExtraTypes = req.ExtraTypes
+	claimReq.RefreshService = req.RefreshService
+
+	claim, err := s.claimService.Save(ctx, claimReq)
+	if err != nil {
+		if errors.Is(err, services.ErrValidationWebhookUnavailable) || errors.Is(err, services.ErrDIDResolverUnavailable) {
+			log.Error(ctx, "claim not validated", "err", err)
+		}
+		return nil, status.Error(createClaimCode(err), err.Error())
+	}
+	return &CreateClaimResponse{Id: claim.ID.String()}, nil
+}
+
+// GetClaim returns a credential of the issuer
+func (s *Server) GetClaim(ctx context.Context, req *GetClaimRequest) (*Claim, error) {
+	did, err := core.ParseDID(req.Identifier)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid did")
+	}
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid claim id")
+	}
+
+	claim, err := s.claimService.GetByID(ctx, did, id)
+	if err != nil {
+		if errors.Is(err, services.ErrClaimNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	resp, err := claimResponse(claim, nil)
+	if err != nil {
+		log.Error(ctx, "creating claim response", "err", err, "id", req.Id)
+		return nil, status.Error(codes.Internal, "invalid claim format")
+	}
+	return resp, nil
+}
+
+// ListClaims returns the credentials of the issuer that match the request
+func (s *Server) ListClaims(ctx context.Context, req *ListClaimsRequest) (*ListClaimsResponse, error) {
+	did, err := core.ParseDID(req.Identifier)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid did")
+	}
+	filter, err := ports.NewClaimsFilter(req.SchemaHash, req.SchemaType, req.Subject, req.QueryField, req.QueryValue, req.Self, req.Revoked)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	filter.Limit, filter.Offset = int(req.Limit), int(req.Offset)
+
+	claims, err := s.claimService.GetAll(ctx, *did, filter)
+	if err != nil && !errors.Is(err, services.ErrClaimNotFound) {
+		log.Error(ctx, "loading claims", "err", err, "did", req.Identifier)
+		return nil, status.Error(codes.Internal, "there was an internal error trying to retrieve claims for the requested identifier")
+	}
+
+	resp := &ListClaimsResponse{Claims: make([]*Claim, len(claims))}
+	for i, claim := range claims {
+		resp.Claims[i], err = claimResponse(claim, s.listingPII)
+		if err != nil {
+			log.Error(ctx, "creating claim response", "err", err, "id", claim.ID)
+			return nil, status.Error(codes.Internal, "there was an internal error parsing the claims")
+		}
+	}
+	return resp, nil
+}
+
+// RevokeClaim revokes the credential of the issuer with the given revocation nonce
+func (s *Server) RevokeClaim(ctx context.Context, req *RevokeClaimRequest) (*RevokeClaimResponse, error) {
+	did, err := core.ParseDID(req.Identifier)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := s.claimService.Revoke(ctx, *did, req.Nonce, domain.RevocationUnspecified, ""); err != nil {
+		if errors.Is(err, repositories.ErrClaimDoesNotExist) {
+			return nil, status.Error(codes.NotFound, "the claim does not exist")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &RevokeClaimResponse{Message: "claim revocation request sent"}, nil
+}
+
+// GetConnection returns a connection of the issuer
+func (s *Server) GetConnection(ctx context.Context, req *GetConnectionRequest) (*Connection, error) {
+	did, err := core.ParseDID(req.Identifier)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid did")
+	}
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid connection id")
+	}
+
+	conn, err := s.connectionsService.GetByIDAndIssuerID(ctx, id, *did)
+	if err != nil {
+		if errors.Is(err, services.ErrConnectionDoesNotExist) {
+			return nil, status.Error(codes.NotFound, "the given connection does not exist")
+		}
+		log.Error(ctx, "loading connection", "err", err, "id", req.Id)
+		return nil, status.Error(codes.Internal, "there was an error retrieving the connection")
+	}
+	return connectionResponse(conn), nil
+}
+
+// ListConnections returns the connections of the issuer that match the query and have all the tags
+func (s *Server) ListConnections(ctx context.Context, req *ListConnectionsRequest) (*ListConnectionsResponse, error) {
+	did, err := core.ParseDID(req.Identifier)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid did")
+	}
+	getAll := ports.NewGetAllRequest(nil, req.Query, &req.Tags)
+	conns, err := s.connectionsService.GetAllByIssuerID(ctx, *did, &ports.ConnectionsFilter{Query: getAll.Query, Tags: getAll.Tags}, false)
+	if err != nil {
+		log.Error(ctx, "loading connections", "err", err, "did", req.Identifier)
+		return nil, status.Error(codes.Internal, "unexpected error while retrieving connections")
+	}
+
+	resp := &ListConnectionsResponse{Connections: make([]*Connection, len(conns))}
+	for i, conn := range conns {
+		resp.Connections[i] = connectionResponse(conn)
+	}
+	return resp, nil
+}
+
+// DeleteConnection deletes a connection of the issuer, and optionally revokes or deletes its credentials
+func (s *Server) DeleteConnection(ctx context.Context, req *DeleteConnectionRequest) (*DeleteConnectionResponse, error) {
+	did, err := core.ParseDID(req.Identifier)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid did")
+	}
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid connection id")
+	}
+
+	if req.RevokeCredentials {
+		if err := s.claimService.RevokeAllFromConnection(ctx, id, *did); err != nil {
+			log.Error(ctx, "delete connection, revoking credentials", "err", err, "id", req.Id)
+			return nil, status.Error(codes.Internal, "there was an error revoking the credentials of the given connection")
+		}
+	}
+	if err := s.connectionsService.Delete(ctx, id, req.DeleteCredentials, *did); err != nil {
+		if errors.Is(err, services.ErrConnectionDoesNotExist) {
+			return nil, status.Error(codes.NotFound, "the given connection does not exist")
+		}
+		log.Error(ctx, "delete connection", "err", err, "id", req.Id)
+		return nil, status.Error(codes.Internal, "there was an error deleting the connection")
+	}
+	return &DeleteConnectionResponse{Message: "connection deleted"}, nil
+}
+
+// ImportSchema imports the schema of the given url
+func (s *Server) ImportSchema(ctx context.Context, req *ImportSchemaRequest) (*Schema, error) {
+	did, err := core.ParseDID(req.Identifier)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid did")
+	}
+	if strings.TrimSpace(req.SchemaType) == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty type")
+	}
+	if _, err := url.ParseRequestURI(req.Url); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "parsing url: %s", err)
+	}
+
+	imported, err := s.schemaService.ImportSchema(ctx, *did, req.Url, req.SchemaType)
+	if err != nil {
+		log.Error(ctx, "importing schema", "err", err, "url", req.Url)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return schemaResponse(imported), nil
+}
+
+// GetSchema returns a schema of the issuer
+func (s *Server) GetSchema(ctx context.Context, req *GetSchemaRequest) (*Schema, error) {
+	did, err := core.ParseDID(req.Identifier)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid did")
+	}
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid schema id")
+	}
+
+	found, err := s.schemaService.GetByID(ctx, *did, id)
+	if err != nil {
+		if errors.Is(err, services.ErrSchemaNotFound) {
+			return nil, status.Error(codes.NotFound, "schema not found")
+		}
+		log.Error(ctx, "loading schema", "err", err, "id", req.Id)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return schemaResponse(found), nil
+}
+
+// ListSchemas returns the schemas of the issuer that match the query
+func (s *Server) ListSchemas(ctx context.Context, req *ListSchemasRequest) (*ListSchemasResponse, error) {
+	did, err := core.ParseDID(req.Identifier)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid did")
+	}
+
+	schemas, err := s.schemaService.GetAll(ctx, *did, &ports.SchemasFilter{Query: req.Query})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	resp := &ListSchemasResponse{Schemas: make([]*Schema, len(schemas))}
+	for i := range schemas {
+		resp.Schemas[i] = schemaResponse(&schemas[i])
+	}
+	return resp, nil
+}
+
+// PublishState publishes the latest state of the issuer
+func (s *Server) PublishState(ctx context.Context, req *PublishStateRequest) (*PublishedState, error) {
+	did, err := core.ParseDID(req.Identifier)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid did")
+	}
+	published, err := s.publisherGateway.PublishState(ctx, did)
+	if err != nil {
+		return nil, status.Error(publishCode(err), err.Error())
+	}
+	return publishedStateResponse(published), nil
+}
+
+// RetryPublishState publishes again the latest state of the issuer, whose publication failed
+func (s *Server) RetryPublishState(ctx context.Context, req *PublishStateRequest) (*PublishedState, error) {
+	did, err := core.ParseDID(req.Identifier)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid did")
+	}
+	published, err := s.publisherGateway.RetryPublishState(ctx, did)
+	if err != nil {
+		return nil, status.Error(publishCode(err), err.Error())
+	}
+	return publishedStateResponse(published), nil
+}
+
+// ListStateTransactions returns the latest state transactions sent for the issuer
+func (s *Server) ListStateTransactions(ctx context.Context, req *ListStateTransactionsRequest) (*ListStateTransactionsResponse, error) {
+	did, err := core.ParseDID(req.Identifier)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid did")
+	}
+	txs, err := s.publisherGateway.GetStateTransactions(ctx, did)
+	if err != nil {
+		log.Error(ctx, "getting state transactions", "err", err, "did", req.Identifier)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	resp := &ListStateTransactionsResponse{Transactions: make([]*StateTransaction, len(txs))}
+	for i, tx := range txs {
+		resp.Transactions[i] = stateTransactionResponse(tx)
+	}
+	return resp, nil
+}
+
+// createClaimCode returns the code of the errors of the creation of a claim. They are the 400 and 422 responses of
+// the admin API.
+func createClaimCode(err error) codes.Code {
+	switch {
+	case errors.Is(err, services.ErrLoadingSchema), errors.Is(err, services.ErrCredentialRejected):
+		return codes.FailedPrecondition
+	case errors.Is(err, services.ErrJSONLdContext),
+		errors.Is(err, services.ErrProcessSchema),
+		errors.Is(err, services.ErrMalformedURL),
+		errors.Is(err, services.ErrParseClaim),
+		errors.Is(err, services.ErrInvalidCredentialSubject),
+		errors.Is(err, services.ErrInvalidCredentialContext),
+		errors.Is(err, services.ErrInvalidCredentialType),
+		errors.Is(err, domain.ErrProofPolicy),
+		errors.Is(err, services.ErrSchemaDeprecated),
+		errors.Is(err, services.ErrInvalidExpiration),
+		errors.Is(err, domain.ErrMaxValidity),
+		errors.Is(err, domain.ErrInvalidSubjectDID):
+		return codes.InvalidArgument
+	}
+	return codes.Internal
+}
+
+func publishCode(err error) codes.Code {
+	if errors.Is(err, gateways.ErrNoStatesToProcess) || errors.Is(err, gateways.ErrStateIsBeingProcessed) {
+		return codes.FailedPrecondition
+	}
+	return codes.Internal
+}
+
+func claimResponse(claim *domain.Claim, listingPII pii.Fields) (*Claim, error) {
+	w3c, err := schema.FromClaimModelToW3CCredential(*claim)
+	if err != nil {
+		return nil, err
+	}
+	w3c.CredentialSubject = listingPII.MaskSubject(w3c.CredentialSubject)
+	credential, err := credentialStruct(w3c)
+	if err != nil {
+		return nil, err
+	}
+	return &Claim{Id: claim.ID.String(), Credential: credential, Revoked: claim.Revoked}, nil
+}
+
+// credentialStruct returns the credential as a protobuf struct with the fields of its JSON representation
+func credentialStruct(w3c *verifiable.W3CCredential) (*structpb.Struct, error) {
+	b, err := json.Marshal(w3c)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]any
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return nil, err
+	}
+	return structpb.NewStruct(fields)
+}
+
+func connectionResponse(conn *domain.Connection) *Connection {
+	resp := &Connection{
+		Id:         conn.ID.String(),
+		IssuerId:   conn.IssuerDID.String(),
+		UserId:     conn.UserDID.String(),
+		CreatedAt:  timestamppb.New(conn.CreatedAt),
+		ModifiedAt: timestamppb.New(conn.ModifiedAt),
+	}
+	if conn.Metadata != nil {
+		resp.Metadata = &ConnectionMetadata{
+			DisplayName: conn.Metadata.DisplayName,
+			Notes:       conn.Metadata.Notes,
+			Tags:        conn.Metadata.Tags,
+		}
+	}
+	return resp
+}
+
+func schemaResponse(s *domain.Schema) *Schema {
+	hash, _ := s.Hash.MarshalText()
+	return &Schema{
+		Id:                     s.ID.String(),
+		Url:                    s.URL,
+		Type:                   s.Type,
+		Hash:                   string(hash),
+		BigInt:                 s.Hash.BigInt().String(),
+		CreatedAt:              timestamppb.New(s.CreatedAt),
+		Version:                int32(s.Version),
+		SchemaVersion:          int32(s.SchemaVersion),
+		Status:                 string(s.Status()),
+		AutoRevokeOnExpiration: s.AutoRevokeOnExpiration,
+		ExtraContexts:          s.ExtraContexts,
+		ExtraTypes:             s.ExtraTypes,
+	}
+}
+
+func publishedStateResponse(published *domain.PublishedState) *PublishedState {
+	return &PublishedState{
+		TxId:               published.TxID,
+		State:              published.State,
+		ClaimsTreeRoot:     published.ClaimsTreeRoot,
+		RevocationTreeRoot: published.RevocationTreeRoot,
+		RootOfRoots:        published.RootOfRoots,
+	}
+}
+
+func stateTransactionResponse(tx *domain.StateTransaction) *StateTransaction {
+	resp := &StateTransaction{
+		Id:          tx.ID.String(),
+		State:       tx.State,
+		TxId:        tx.TxID,
+		TxHashes:    tx.TxHashes,
+		Nonce:       tx.Nonce,
+		GasFeeCap:   tx.GasFeeCap.String(),
+		Attempts:    int32(tx.Attempts),
+		Status:      string(tx.Status),
+		Error:       tx.Error,
+		CreatedAt:   timestamppb.New(tx.CreatedAt),
+		SubmittedAt: timestamppb.New(tx.SubmittedAt),
+	}
+	if tx.TxType != types.LegacyTxType {
+		resp.GasTipCap = common.ToPointer(tx.GasTipCap.String())
+	}
+	return resp
+}
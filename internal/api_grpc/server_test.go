@@ -0,0 +1,142 @@
+package api_grpc
+
+import (
+	"context"
+	"encoding/base64"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/standby"
+)
+
+const (
+	issuerDID    = "did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ"
+	migratingDID = "did:polygonid:polygon:mumbai:2qH7XAwYQzCp9VfhpNgeLtK2iCehDDrfMWUCEg5ig5"
+)
+
+type claimsMock struct {
+	ports.ClaimsService
+}
+
+func (claimsMock) GetByID(context.Context, *core.DID, uuid.UUID) (*domain.Claim, error) {
+	return nil, services.ErrClaimNotFound
+}
+
+type connectionsMock struct {
+	ports.ConnectionsService
+	conns []*domain.Connection
+}
+
+func (m connectionsMock) GetAllByIssuerID(context.Context, core.DID, *ports.ConnectionsFilter, bool) ([]*domain.Connection, error) {
+	return m.conns, nil
+}
+
+type migrationMock struct {
+	ports.IdentityMigrationService
+}
+
+func (migrationMock) IsReadOnly(_ context.Context, did core.DID) bool {
+	return did.String() == migratingDID
+}
+
+func TestServer(t *testing.T) {
+	ctx := context.Background()
+	did, err := core.ParseDID(issuerDID)
+	require.NoError(t, err)
+	conn := &domain.Connection{
+		ID:        uuid.New(),
+		IssuerDID: *did,
+		UserDID:   *did,
+		CreatedAt: time.Now().UTC(),
+		Metadata:  &domain.ConnectionMetadata{DisplayName: "Alice", Tags: []string{"kyc"}},
+	}
+
+	node, err := standby.New(ctx, nil, false)
+	require.NoError(t, err)
+	reg := prometheus.NewRegistry()
+	metrics, err := MetricsInterceptor(reg)
+	require.NoError(t, err)
+	srv := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		metrics,
+		AuthInterceptor("user", "password"),
+		ReadOnlyInterceptor(migrationMock{}, node),
+	))
+	NewServer(&config.Configuration{}, claimsMock{}, connectionsMock{conns: []*domain.Connection{conn}}, nil, nil).Register(srv)
+
+	lis := bufconn.Listen(1024 * 1024)
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+	cc, err := grpc.DialContext(ctx, "bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = cc.Close() })
+	claims := NewClaimsServiceClient(cc)
+	connections := NewConnectionsServiceClient(cc)
+
+	basicAuth := func(user, pass string) context.Context {
+		auth := "Basic " + base64.StdEncoding.EncodeToString([]byte(user+":"+pass))
+		return metadata.AppendToOutgoingContext(ctx, "authorization", auth)
+	}
+	authCtx := basicAuth("user", "password")
+
+	_, err = claims.GetClaim(ctx, &GetClaimRequest{Identifier: issuerDID, Id: uuid.NewString()})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err), "no credentials")
+	_, err = claims.GetClaim(basicAuth("user", "wrong"), &GetClaimRequest{Identifier: issuerDID, Id: uuid.NewString()})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err), "wrong password")
+
+	_, err = claims.GetClaim(authCtx, &GetClaimRequest{Identifier: issuerDID, Id: "not-a-uuid"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = claims.GetClaim(authCtx, &GetClaimRequest{Identifier: issuerDID, Id: uuid.NewString()})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = claims.RevokeClaim(authCtx, &RevokeClaimRequest{Identifier: migratingDID, Nonce: 1})
+	assert.Equal(t, codes.Unavailable, status.Code(err), "identities being migrated are read only")
+
+	resp, err := connections.ListConnections(authCtx, &ListConnectionsRequest{Identifier: issuerDID})
+	require.NoError(t, err)
+	require.Len(t, resp.Connections, 1)
+	assert.Equal(t, conn.ID.String(), resp.Connections[0].Id)
+	assert.Equal(t, issuerDID, resp.Connections[0].UserId)
+	assert.True(t, conn.CreatedAt.Equal(resp.Connections[0].CreatedAt.AsTime()))
+	require.NotNil(t, resp.Connections[0].Metadata)
+	assert.Equal(t, "Alice", resp.Connections[0].Metadata.DisplayName)
+	assert.Equal(t, []string{"kyc"}, resp.Connections[0].Metadata.Tags)
+
+	expected := `
+# HELP issuer_grpc_requests_total Number of gRPC requests by method and code.
+# TYPE issuer_grpc_requests_total counter
+issuer_grpc_requests_total{code="InvalidArgument",method="/issuer.v1.ClaimsService/GetClaim"} 1
+issuer_grpc_requests_total{code="NotFound",method="/issuer.v1.ClaimsService/GetClaim"} 1
+issuer_grpc_requests_total{code="OK",method="/issuer.v1.ConnectionsService/ListConnections"} 1
+issuer_grpc_requests_total{code="Unauthenticated",method="/issuer.v1.ClaimsService/GetClaim"} 2
+issuer_grpc_requests_total{code="Unavailable",method="/issuer.v1.ClaimsService/RevokeClaim"} 1
+`
+	assert.NoError(t, testutil.GatherAndCompare(reg, strings.NewReader(expected), "issuer_grpc_requests_total"))
+}
+
+func TestAuthInterceptor_NoCredentials(t *testing.T) {
+	handler := func(context.Context, interface{}) (interface{}, error) { return "ok", nil }
+	resp, err := AuthInterceptor("", "")(context.Background(), nil, &grpc.UnaryServerInfo{}, handler)
+	require.NoError(t, err, "requests are not checked without configured credentials")
+	assert.Equal(t, "ok", resp)
+}
@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"mime/multipart"
 	"net/http"
 	"time"
 
@@ -21,6 +22,79 @@ const (
 	BasicAuthScopes = "basicAuth.Scopes"
 )
 
+// Defines values for AgentInboxMessageStatus.
+const (
+	AgentInboxMessageStatusFailed    AgentInboxMessageStatus = "failed"
+	AgentInboxMessageStatusProcessed AgentInboxMessageStatus = "processed"
+	AgentInboxMessageStatusRejected  AgentInboxMessageStatus = "rejected"
+)
+
+// Defines values for ChangeEntity.
+const (
+	ChangeEntityConnection ChangeEntity = "connection"
+	ChangeEntityCredential ChangeEntity = "credential"
+)
+
+// Defines values for ChangeOperation.
+const (
+	ChangeOperationCreated  ChangeOperation = "created"
+	ChangeOperationDeleted  ChangeOperation = "deleted"
+	ChangeOperationRestored ChangeOperation = "restored"
+	ChangeOperationRevoked  ChangeOperation = "revoked"
+	ChangeOperationUpdated  ChangeOperation = "updated"
+)
+
+// Defines values for CredentialEventType.
+const (
+	CredentialEventTypeIssued              CredentialEventType = "issued"
+	CredentialEventTypeOfferFetched        CredentialEventType = "offer.fetched"
+	CredentialEventTypeOffered             CredentialEventType = "offered"
+	CredentialEventTypeRevocationPublished CredentialEventType = "revocation.published"
+	CredentialEventTypeRevoked             CredentialEventType = "revoked"
+	CredentialEventTypeStateIncluded       CredentialEventType = "state.included"
+	CredentialEventTypeStatePublished      CredentialEventType = "state.published"
+)
+
+// Defines values for CredentialRevocationReason.
+const (
+	CredentialRevocationReasonAffiliationChanged   CredentialRevocationReason = "affiliationChanged"
+	CredentialRevocationReasonCessationOfOperation CredentialRevocationReason = "cessationOfOperation"
+	CredentialRevocationReasonExpired              CredentialRevocationReason = "expired"
+	CredentialRevocationReasonKeyCompromise        CredentialRevocationReason = "keyCompromise"
+	CredentialRevocationReasonPrivilegeWithdrawn   CredentialRevocationReason = "privilegeWithdrawn"
+	CredentialRevocationReasonSuperseded           CredentialRevocationReason = "superseded"
+	CredentialRevocationReasonUnspecified          CredentialRevocationReason = "unspecified"
+)
+
+// Defines values for ImportCredentialsRequestMode.
+const (
+	ImportCredentialsRequestModeClaims ImportCredentialsRequestMode = "claims"
+	ImportCredentialsRequestModeLinks  ImportCredentialsRequestMode = "links"
+)
+
+// Defines values for ImportJobMode.
+const (
+	ImportJobModeClaims ImportJobMode = "claims"
+	ImportJobModeLinks  ImportJobMode = "links"
+)
+
+// Defines values for ImportJobStatus.
+const (
+	ImportJobStatusCompleted ImportJobStatus = "completed"
+	ImportJobStatusFailed    ImportJobStatus = "failed"
+	ImportJobStatusPending   ImportJobStatus = "pending"
+	ImportJobStatusRunning   ImportJobStatus = "running"
+)
+
+// Defines values for JobStatus.
+const (
+	JobStatusCancelled JobStatus = "cancelled"
+	JobStatusCompleted JobStatus = "completed"
+	JobStatusDead      JobStatus = "dead"
+	JobStatusPending   JobStatus = "pending"
+	JobStatusRunning   JobStatus = "running"
+)
+
 // Defines values for LinkStatus.
 const (
 	LinkStatusActive   LinkStatus = "active"
@@ -28,19 +102,76 @@ const (
 	LinkStatusInactive LinkStatus = "inactive"
 )
 
+// Defines values for LinkAnalyticsInterval.
+const (
+	LinkAnalyticsIntervalDay  LinkAnalyticsInterval = "day"
+	LinkAnalyticsIntervalHour LinkAnalyticsInterval = "hour"
+	LinkAnalyticsIntervalWeek LinkAnalyticsInterval = "week"
+)
+
+// Defines values for OfferEmailStatus.
+const (
+	OfferEmailStatusFailed  OfferEmailStatus = "failed"
+	OfferEmailStatusPending OfferEmailStatus = "pending"
+	OfferEmailStatusSent    OfferEmailStatus = "sent"
+)
+
+// Defines values for ProblemReportDirection.
+const (
+	Received ProblemReportDirection = "received"
+	Sent     ProblemReportDirection = "sent"
+)
+
+// Defines values for PublicationStatus.
+const (
+	PublicationStatusFailed         PublicationStatus = "failed"
+	PublicationStatusNotRequired    PublicationStatus = "not-required"
+	PublicationStatusPendingPublish PublicationStatus = "pending-publish"
+	PublicationStatusPublished      PublicationStatus = "published"
+)
+
+// Defines values for SchemaAttributeDefinitionType.
+const (
+	Boolean SchemaAttributeDefinitionType = "boolean"
+	Date    SchemaAttributeDefinitionType = "date"
+	Integer SchemaAttributeDefinitionType = "integer"
+	Number  SchemaAttributeDefinitionType = "number"
+	String  SchemaAttributeDefinitionType = "string"
+)
+
+// Defines values for SchemaStatus.
+const (
+	SchemaStatusActive     SchemaStatus = "active"
+	SchemaStatusDeprecated SchemaStatus = "deprecated"
+)
+
 // Defines values for StateTransactionStatus.
 const (
-	Created   StateTransactionStatus = "created"
-	Failed    StateTransactionStatus = "failed"
-	Pending   StateTransactionStatus = "pending"
-	Published StateTransactionStatus = "published"
+	StateTransactionStatusCreated    StateTransactionStatus = "created"
+	StateTransactionStatusFailed     StateTransactionStatus = "failed"
+	StateTransactionStatusPending    StateTransactionStatus = "pending"
+	StateTransactionStatusPublished  StateTransactionStatus = "published"
+	StateTransactionStatusTransacted StateTransactionStatus = "transacted"
+)
+
+// Defines values for VerificationQueryCircuitId.
+const (
+	CredentialAtomicQueryMTPV2 VerificationQueryCircuitId = "credentialAtomicQueryMTPV2"
+	CredentialAtomicQuerySigV2 VerificationQueryCircuitId = "credentialAtomicQuerySigV2"
+)
+
+// Defines values for GetConnectionCredentialsParamsStatus.
+const (
+	GetConnectionCredentialsParamsStatusAll     GetConnectionCredentialsParamsStatus = "all"
+	GetConnectionCredentialsParamsStatusExpired GetConnectionCredentialsParamsStatus = "expired"
+	GetConnectionCredentialsParamsStatusRevoked GetConnectionCredentialsParamsStatus = "revoked"
 )
 
 // Defines values for GetCredentialsParamsStatus.
 const (
-	All     GetCredentialsParamsStatus = "all"
-	Expired GetCredentialsParamsStatus = "expired"
-	Revoked GetCredentialsParamsStatus = "revoked"
+	GetCredentialsParamsStatusAll     GetCredentialsParamsStatus = "all"
+	GetCredentialsParamsStatusExpired GetCredentialsParamsStatus = "expired"
+	GetCredentialsParamsStatusRevoked GetCredentialsParamsStatus = "revoked"
 )
 
 // Defines values for GetLinksParamsStatus.
@@ -51,6 +182,79 @@ const (
 	GetLinksParamsStatusInactive GetLinksParamsStatus = "inactive"
 )
 
+// Defines values for GetLinkAnalyticsParamsInterval.
+const (
+	GetLinkAnalyticsParamsIntervalDay  GetLinkAnalyticsParamsInterval = "day"
+	GetLinkAnalyticsParamsIntervalHour GetLinkAnalyticsParamsInterval = "hour"
+	GetLinkAnalyticsParamsIntervalWeek GetLinkAnalyticsParamsInterval = "week"
+)
+
+// Defines values for GetRevocationListParamsFormat.
+const (
+	Bitstring GetRevocationListParamsFormat = "bitstring"
+	List      GetRevocationListParamsFormat = "list"
+)
+
+// Defines values for GetJobsParamsKind.
+const (
+	Import            GetJobsParamsKind = "import"
+	StateConfirmation GetJobsParamsKind = "state.confirmation"
+)
+
+// Defines values for GetJobsParamsStatus.
+const (
+	Cancelled GetJobsParamsStatus = "cancelled"
+	Completed GetJobsParamsStatus = "completed"
+	Dead      GetJobsParamsStatus = "dead"
+	Pending   GetJobsParamsStatus = "pending"
+	Running   GetJobsParamsStatus = "running"
+)
+
+// Defines values for GetAgentMessagesParamsStatus.
+const (
+	Failed    GetAgentMessagesParamsStatus = "failed"
+	Processed GetAgentMessagesParamsStatus = "processed"
+	Rejected  GetAgentMessagesParamsStatus = "rejected"
+)
+
+// AgentInboxMessage defines model for AgentInboxMessage.
+type AgentInboxMessage struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Error Why the message failed or was rejected
+	Error     *string   `json:"error,omitempty"`
+	Id        uuid.UUID `json:"id"`
+	MediaType string    `json:"mediaType"`
+	MessageID string    `json:"messageID"`
+
+	// ResponseType Type of the message the agent answered with
+	ResponseType *string `json:"responseType,omitempty"`
+
+	// Sender DID of the sender, empty if the message could not be unpacked
+	Sender   string                  `json:"sender"`
+	Status   AgentInboxMessageStatus `json:"status"`
+	ThreadID string                  `json:"threadID"`
+	Type     string                  `json:"type"`
+}
+
+// AgentInboxMessageStatus defines model for AgentInboxMessage.Status.
+type AgentInboxMessageStatus string
+
+// AgentProblemReport The error of a failed agent request. When the request was unpacked, it is a problem-report message addressed
+// to the sender, in the thread of the request, with the code and the description of the problem. The message
+// is the description, for the clients that do not read problem reports.
+type AgentProblemReport struct {
+	Body           *ProblemReportBody `json:"body,omitempty"`
+	From           *string            `json:"from,omitempty"`
+	Id             *string            `json:"id,omitempty"`
+	Message        string             `json:"message"`
+	ParentThreadID *string            `json:"parentThreadID,omitempty"`
+	ThreadID       *string            `json:"threadID,omitempty"`
+	To             *string            `json:"to,omitempty"`
+	Typ            *string            `json:"typ,omitempty"`
+	Type           *string            `json:"type,omitempty"`
+}
+
 // AgentResponse defines model for AgentResponse.
 type AgentResponse struct {
 	Body     interface{} `json:"body"`
@@ -76,41 +280,177 @@ type AuthenticationQrCodeResponse struct {
 	Type string `json:"type"`
 }
 
+// BuildSchemaRequest defines model for BuildSchemaRequest.
+type BuildSchemaRequest struct {
+	Attributes  []SchemaAttributeDefinition `json:"attributes"`
+	Description *string                     `json:"description,omitempty"`
+
+	// PinToIPFS Pin the documents to IPFS. It fails if the node has no IPFS pinner.
+	PinToIPFS *bool `json:"pinToIPFS,omitempty"`
+
+	// SchemaType Credential type, it must start with a letter and have only letters, digits and underscores
+	SchemaType string  `json:"schemaType"`
+	Title      *string `json:"title,omitempty"`
+}
+
+// Change defines model for Change.
+type Change struct {
+	CreatedAt time.Time       `json:"createdAt"`
+	Cursor    string          `json:"cursor"`
+	Entity    ChangeEntity    `json:"entity"`
+	Id        uuid.UUID       `json:"id"`
+	Operation ChangeOperation `json:"operation"`
+}
+
+// ChangeEntity defines model for Change.Entity.
+type ChangeEntity string
+
+// ChangeOperation defines model for Change.Operation.
+type ChangeOperation string
+
+// ConnectionMetadata defines model for ConnectionMetadata.
+type ConnectionMetadata struct {
+	DisplayName string    `json:"displayName"`
+	ModifiedAt  time.Time `json:"modifiedAt"`
+	Notes       string    `json:"notes"`
+	Tags        []string  `json:"tags"`
+}
+
+// CreateCredentialFromTemplateRequest defines model for CreateCredentialFromTemplateRequest.
+type CreateCredentialFromTemplateRequest struct {
+	CredentialSubject CredentialSubject `json:"credentialSubject"`
+	Expiration        *time.Time        `json:"expiration,omitempty"`
+}
+
 // CreateCredentialRequest defines model for CreateCredentialRequest.
 type CreateCredentialRequest struct {
 	CredentialSchema  string                 `json:"credentialSchema"`
 	CredentialSubject map[string]interface{} `json:"credentialSubject"`
-	Expiration        *time.Time             `json:"expiration,omitempty"`
-	MtProof           *bool                  `json:"mtProof,omitempty"`
-	SignatureProof    *bool                  `json:"signatureProof,omitempty"`
-	Type              string                 `json:"type"`
+
+	// Expiration Expiration of the credential. If the schema has a maximum validity, it cannot be later, and the credentials
+	// created without expiration expire at the end of it.
+	Expiration *time.Time `json:"expiration,omitempty"`
+
+	// ExpirationDays Expires the credential the given days after it is issued. It cannot be set with expiration.
+	ExpirationDays *int `json:"expirationDays,omitempty"`
+
+	// ExtraContexts JSON-LD contexts added to the @context of the credential, after the ones of the schema. They must be loadable.
+	ExtraContexts *[]string `json:"extraContexts,omitempty"`
+
+	// ExtraTypes Types added to the type of the credential, after the ones of the schema. They should be defined in the contexts.
+	ExtraTypes *[]string `json:"extraTypes,omitempty"`
+
+	// MtProof Issue the credential with an Iden3SparseMerkleTreeProof. If neither proof is set, the default proof types of the schema, or the ones of the proof policy, apply.
+	MtProof *bool `json:"mtProof,omitempty"`
+
+	// RefreshService Adds a refresh service pointing at the agent of the node, where the holder gets a new credential, valid for the same period, when this one expires.
+	RefreshService *bool `json:"refreshService,omitempty"`
+
+	// SignatureProof Issue the credential with a BJJSignature2021 proof. If neither proof is set, the default proof types of the schema, or the ones of the proof policy, apply.
+	SignatureProof *bool `json:"signatureProof,omitempty"`
+
+	// SubjectExternalID Issues the credential to the holder DID the placeholder subject is bound to, when the id of the credentialSubject is not known. The placeholder must be bound by claiming a link first.
+	SubjectExternalID *string `json:"subjectExternalID,omitempty"`
+	Type              string  `json:"type"`
+}
+
+// CreateCredentialTemplateRequest defines model for CreateCredentialTemplateRequest.
+type CreateCredentialTemplateRequest struct {
+	CredentialSubject *CredentialSubject `json:"credentialSubject"`
+
+	// ExpirationDays Days the credentials issued from the template are valid. They do not expire if it is not set.
+	ExpirationDays *int      `json:"expirationDays,omitempty"`
+	MtProof        bool      `json:"mtProof"`
+	Name           string    `json:"name"`
+	SchemaID       uuid.UUID `json:"schemaID"`
+	SignatureProof bool      `json:"signatureProof"`
 }
 
 // CreateLinkRequest defines model for CreateLinkRequest.
 type CreateLinkRequest struct {
+	// AllowedDIDs The only holders that can claim the credential. Any holder can claim it if it is not set.
+	AllowedDIDs *[]string `json:"allowedDIDs,omitempty"`
+
+	// CodeProtected The holders must enter one of the one-time codes of the link, created with the codes endpoint, to claim the credential.
+	CodeProtected        *bool               `json:"codeProtected,omitempty"`
 	CredentialExpiration *openapi_types.Date `json:"credentialExpiration,omitempty"`
 	CredentialSubject    CredentialSubject   `json:"credentialSubject"`
 	Expiration           *time.Time          `json:"expiration,omitempty"`
 	LimitedClaims        *int                `json:"limitedClaims"`
 	MtProof              bool                `json:"mtProof"`
-	SchemaID             uuid.UUID           `json:"schemaID"`
-	SignatureProof       bool                `json:"signatureProof"`
+
+	// ProofScope Queries the holder must prove, e.g. with another credential, before the credential is issued
+	ProofScope     *[]VerificationQuery `json:"proofScope,omitempty"`
+	SchemaID       uuid.UUID            `json:"schemaID"`
+	SignatureProof bool                 `json:"signatureProof"`
+
+	// SubjectExternalID Placeholder subject, e.g. an email, for holders without a wallet yet. It is bound to the DID of the holder that claims the credential, and the link cannot be claimed by another holder once it is bound.
+	SubjectExternalID *string `json:"subjectExternalID,omitempty"`
 }
 
 // Credential defines model for Credential.
 type Credential struct {
+	// CoreClaim Hex encoded core claim of the credential
+	CoreClaim         string                 `json:"coreClaim"`
 	CreatedAt         time.Time              `json:"createdAt"`
 	CredentialSubject map[string]interface{} `json:"credentialSubject"`
-	Expired           bool                   `json:"expired"`
-	ExpiresAt         *time.Time             `json:"expiresAt"`
-	Id                uuid.UUID              `json:"id"`
-	ProofTypes        []string               `json:"proofTypes"`
-	RevNonce          uint64                 `json:"revNonce"`
-	Revoked           bool                   `json:"revoked"`
-	SchemaHash        string                 `json:"schemaHash"`
-	SchemaType        string                 `json:"schemaType"`
-	SchemaUrl         string                 `json:"schemaUrl"`
-	UserID            string                 `json:"userID"`
+
+	// DeletedAt Set on the deleted credentials, returned when includeDeleted is set
+	DeletedAt *time.Time `json:"deletedAt,omitempty"`
+	Expired   bool       `json:"expired"`
+	ExpiresAt *time.Time `json:"expiresAt"`
+
+	// HIndex Index hash of the core claim, its position in the claims tree of the issuer
+	HIndex     string    `json:"hIndex"`
+	Id         uuid.UUID `json:"id"`
+	ProofTypes []string  `json:"proofTypes"`
+
+	// PublicationStatus Publication of the state of a credential issued with an Iden3SparseMerkleTreeProof:
+	//   * `not-required` - The credential has no Iden3SparseMerkleTreeProof
+	//   * `pending-publish` - The state is not published yet, or its transaction is not confirmed
+	//   * `published` - The state is confirmed on chain, the proof can be verified
+	//   * `failed` - The transaction of the state failed. It is sent again when the state is retried
+	PublicationStatus PublicationStatus `json:"publicationStatus"`
+
+	// PublishRequired The credential has an Iden3SparseMerkleTreeProof that cannot be verified until its state is published
+	PublishRequired bool `json:"publishRequired"`
+
+	// Published The credential is in a state of the issuer confirmed on chain, so its Iden3SparseMerkleTreeProof can be verified
+	Published bool   `json:"published"`
+	RevNonce  uint64 `json:"revNonce"`
+
+	// Revocation Reason of the revocation of a revoked credential
+	Revocation *CredentialRevocation `json:"revocation,omitempty"`
+	Revoked    bool                  `json:"revoked"`
+	SchemaHash string                `json:"schemaHash"`
+	SchemaType string                `json:"schemaType"`
+	SchemaUrl  string                `json:"schemaUrl"`
+	UserID     string                `json:"userID"`
+}
+
+// CredentialErrorMessage defines model for CredentialErrorMessage.
+type CredentialErrorMessage struct {
+	Errors  *[]CredentialSubjectError `json:"errors,omitempty"`
+	Message string                    `json:"message"`
+}
+
+// CredentialEvent defines model for CredentialEvent.
+type CredentialEvent struct {
+	Description *string                     `json:"description,omitempty"`
+	OfferID     *uuid.UUID                  `json:"offerID,omitempty"`
+	Reason      *CredentialRevocationReason `json:"reason,omitempty"`
+	State       *string                     `json:"state,omitempty"`
+	Time        time.Time                   `json:"time"`
+	TxID        *string                     `json:"txID,omitempty"`
+	Type        CredentialEventType         `json:"type"`
+}
+
+// CredentialEventType defines model for CredentialEvent.Type.
+type CredentialEventType string
+
+// CredentialJWT defines model for CredentialJWT.
+type CredentialJWT struct {
+	Jwt string `json:"jwt"`
 }
 
 // CredentialLinkQrCodeResponse defines model for CredentialLinkQrCodeResponse.
@@ -119,11 +459,55 @@ type CredentialLinkQrCodeResponse struct {
 	LinkDetail LinkSimple                   `json:"linkDetail"`
 	QrCode     AuthenticationQrCodeResponse `json:"qrCode"`
 	SessionID  string                       `json:"sessionID"`
+
+	// WalletLinks Links that open the message of a QR code in the wallet of the holder
+	WalletLinks *WalletLinks `json:"walletLinks,omitempty"`
+}
+
+// CredentialRevocation Reason of the revocation of a revoked credential
+type CredentialRevocation struct {
+	Description string                     `json:"description"`
+	Reason      CredentialRevocationReason `json:"reason"`
+	RevokedAt   time.Time                  `json:"revokedAt"`
 }
 
+// CredentialRevocationReason defines model for CredentialRevocationReason.
+type CredentialRevocationReason string
+
 // CredentialSubject defines model for CredentialSubject.
 type CredentialSubject = map[string]interface{}
 
+// CredentialSubjectError defines model for CredentialSubjectError.
+type CredentialSubjectError struct {
+	// Field Dot separated path of the attribute in the credential subject
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// CredentialTemplate defines model for CredentialTemplate.
+type CredentialTemplate struct {
+	CreatedAt         time.Time         `json:"createdAt"`
+	CredentialSubject CredentialSubject `json:"credentialSubject"`
+
+	// ExpirationDays Days the credentials issued from the template are valid. Null if they do not expire.
+	ExpirationDays *int      `json:"expirationDays"`
+	Id             uuid.UUID `json:"id"`
+	MtProof        bool      `json:"mtProof"`
+	Name           string    `json:"name"`
+	SchemaID       uuid.UUID `json:"schemaID"`
+	SchemaType     string    `json:"schemaType"`
+	SchemaUrl      string    `json:"schemaUrl"`
+	SignatureProof bool      `json:"signatureProof"`
+}
+
+// CredentialsSummary defines model for CredentialsSummary.
+type CredentialsSummary struct {
+	// Expired Credentials past their expiration date. A credential can be both revoked and expired.
+	Expired int `json:"expired"`
+	Revoked int `json:"revoked"`
+	Total   int `json:"total"`
+}
+
 // GenericErrorMessage defines model for GenericErrorMessage.
 type GenericErrorMessage struct {
 	Message string `json:"message"`
@@ -134,13 +518,36 @@ type GenericMessage struct {
 	Message string `json:"message"`
 }
 
+// GetChangesResponse defines model for GetChangesResponse.
+type GetChangesResponse struct {
+	Changes []Change `json:"changes"`
+
+	// Cursor Cursor to send in the next request. It is the one sent when there are no new changes.
+	Cursor string `json:"cursor"`
+}
+
+// GetConnectionCredentialsResponse defines model for GetConnectionCredentialsResponse.
+type GetConnectionCredentialsResponse struct {
+	Credentials []Credential `json:"credentials"`
+	Limit       int          `json:"limit"`
+	Page        int          `json:"page"`
+
+	// Total Number of credentials that match the filters, in all the pages
+	Total int `json:"total"`
+}
+
 // GetConnectionResponse defines model for GetConnectionResponse.
 type GetConnectionResponse struct {
-	CreatedAt   time.Time    `json:"createdAt"`
-	Credentials []Credential `json:"credentials"`
-	Id          string       `json:"id"`
-	IssuerID    string       `json:"issuerID"`
-	UserID      string       `json:"userID"`
+	CreatedAt          time.Time           `json:"createdAt"`
+	Credentials        []Credential        `json:"credentials"`
+	CredentialsSummary *CredentialsSummary `json:"credentialsSummary,omitempty"`
+
+	// DeletedAt Set on the deleted connections, returned when includeDeleted is set
+	DeletedAt *time.Time          `json:"deletedAt,omitempty"`
+	Id        string              `json:"id"`
+	IssuerID  string              `json:"issuerID"`
+	Metadata  *ConnectionMetadata `json:"metadata,omitempty"`
+	UserID    string              `json:"userID"`
 }
 
 // GetConnectionsResponse defines model for GetConnectionsResponse.
@@ -151,26 +558,128 @@ type GetLinkQrCodeResponse struct {
 	LinkDetail LinkSimple      `json:"linkDetail"`
 	QrCode     *QrCodeResponse `json:"qrCode,omitempty"`
 	Status     *string         `json:"status,omitempty"`
+
+	// WalletLinks Links that open the message of a QR code in the wallet of the holder
+	WalletLinks *WalletLinks `json:"walletLinks,omitempty"`
 }
 
 // Health defines model for Health.
 type Health map[string]bool
 
+// ImportCredentialsRequest defines model for ImportCredentialsRequest.
+type ImportCredentialsRequest struct {
+	CredentialExpiration *time.Time `json:"credentialExpiration,omitempty"`
+
+	// File CSV file, up to 10000 rows
+	File openapi_types.File `json:"file"`
+
+	// Mapping JSON object with the schema attribute of the columns that are not named as it
+	Mapping *string                      `json:"mapping,omitempty"`
+	Mode    ImportCredentialsRequestMode `json:"mode"`
+	MtProof *bool                        `json:"mtProof,omitempty"`
+
+	// ReferenceColumn Column with the identifier of every row. reference if it is not set.
+	ReferenceColumn *string   `json:"referenceColumn,omitempty"`
+	SchemaID        uuid.UUID `json:"schemaID"`
+	SignatureProof  *bool     `json:"signatureProof,omitempty"`
+}
+
+// ImportCredentialsRequestMode defines model for ImportCredentialsRequest.Mode.
+type ImportCredentialsRequestMode string
+
+// ImportJob defines model for ImportJob.
+type ImportJob struct {
+	CreatedAt            time.Time  `json:"createdAt"`
+	CredentialExpiration *time.Time `json:"credentialExpiration,omitempty"`
+
+	// Error Why the job stopped before processing every row
+	Error         *string       `json:"error,omitempty"`
+	FailedRows    int           `json:"failedRows"`
+	FinishedAt    *time.Time    `json:"finishedAt,omitempty"`
+	Id            uuid.UUID     `json:"id"`
+	Mode          ImportJobMode `json:"mode"`
+	MtProof       bool          `json:"mtProof"`
+	ProcessedRows int           `json:"processedRows"`
+
+	// Progress Percentage of processed rows
+	Progress       int             `json:"progress"`
+	SchemaID       uuid.UUID       `json:"schemaID"`
+	SignatureProof bool            `json:"signatureProof"`
+	Status         ImportJobStatus `json:"status"`
+	TotalRows      int             `json:"totalRows"`
+}
+
+// ImportJobMode defines model for ImportJob.Mode.
+type ImportJobMode string
+
+// ImportJobStatus defines model for ImportJob.Status.
+type ImportJobStatus string
+
 // ImportSchemaRequest defines model for ImportSchemaRequest.
 type ImportSchemaRequest struct {
 	SchemaType string `json:"schemaType"`
-	Url        string `json:"url"`
+
+	// Url http, https or ipfs url of the JSON Schema. ipfs urls are fetched from the IPFS gateway of the node.
+	Url string `json:"url"`
 }
 
 // IssuerDescription defines model for IssuerDescription.
 type IssuerDescription struct {
-	DisplayName string `json:"displayName"`
-	Logo        string `json:"logo"`
+	BackgroundColor *string `json:"backgroundColor,omitempty"`
+	ContactEmail    *string `json:"contactEmail,omitempty"`
+	ContactURL      *string `json:"contactURL,omitempty"`
+	DisplayName     string  `json:"displayName"`
+	Logo            string  `json:"logo"`
+	TextColor       *string `json:"textColor,omitempty"`
+}
+
+// IssuerProfile defines model for IssuerProfile.
+type IssuerProfile struct {
+	// BackgroundColor Hex color of the background of the issuer cards and pages
+	BackgroundColor string `json:"backgroundColor"`
+	ContactEmail    string `json:"contactEmail"`
+	ContactURL      string `json:"contactURL"`
+	DisplayName     string `json:"displayName"`
+	LogoURL         string `json:"logoURL"`
+
+	// Overridden The profile was updated, it no longer takes the name and the logo of the configuration
+	Overridden bool `json:"overridden"`
+
+	// TextColor Hex color of the text over the background color
+	TextColor string     `json:"textColor"`
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+}
+
+// Job defines model for Job.
+type Job struct {
+	Attempts   int        `json:"attempts"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+	Id         uuid.UUID  `json:"id"`
+	Kind       string     `json:"kind"`
+
+	// LastError Error of the last failed attempt
+	LastError   *string `json:"lastError,omitempty"`
+	MaxAttempts int     `json:"maxAttempts"`
+
+	// RunAt When the job runs next, if it is pending
+	RunAt     time.Time `json:"runAt"`
+	Status    JobStatus `json:"status"`
+	UpdatedAt time.Time `json:"updatedAt"`
 }
 
+// JobStatus defines model for Job.Status.
+type JobStatus string
+
 // Link defines model for Link.
 type Link struct {
-	Active               bool                `json:"active"`
+	Active bool `json:"active"`
+
+	// AllowedDIDs The only holders that can claim the credential, any holder if it is not set
+	AllowedDIDs *[]string `json:"allowedDIDs,omitempty"`
+
+	// CodeProtected The holders claim the credential with one of the one-time codes of the link
+	CodeProtected        bool                `json:"codeProtected"`
 	CreatedAt            time.Time           `json:"createdAt"`
 	CredentialExpiration *openapi_types.Date `json:"credentialExpiration"`
 	CredentialSubject    CredentialSubject   `json:"credentialSubject"`
@@ -178,16 +687,85 @@ type Link struct {
 	Id                   uuid.UUID           `json:"id"`
 	IssuedClaims         int                 `json:"issuedClaims"`
 	MaxIssuance          *int                `json:"maxIssuance"`
-	ProofTypes           []string            `json:"proofTypes"`
-	SchemaHash           string              `json:"schemaHash"`
-	SchemaType           string              `json:"schemaType"`
-	SchemaUrl            string              `json:"schemaUrl"`
-	Status               LinkStatus          `json:"status"`
+
+	// ProofScope Queries the holder must prove before the credential is issued
+	ProofScope *[]VerificationQuery `json:"proofScope,omitempty"`
+	ProofTypes []string             `json:"proofTypes"`
+	SchemaHash string               `json:"schemaHash"`
+	SchemaType string               `json:"schemaType"`
+	SchemaUrl  string               `json:"schemaUrl"`
+	Status     LinkStatus           `json:"status"`
+
+	// SubjectExternalID Placeholder subject bound to the DID of the holder that claims the credential
+	SubjectExternalID *string `json:"subjectExternalID,omitempty"`
+
+	// Version Incremented on every update of the link. It is the value of the ETag header.
+	Version int `json:"version"`
 }
 
 // LinkStatus defines model for Link.Status.
 type LinkStatus string
 
+// LinkAnalytics defines model for LinkAnalytics.
+type LinkAnalytics struct {
+	Buckets []LinkAnalyticsBucket `json:"buckets"`
+
+	// From Start of the first bucket
+	From     time.Time             `json:"from"`
+	Interval LinkAnalyticsInterval `json:"interval"`
+	LinkID   uuid.UUID             `json:"linkID"`
+	To       time.Time             `json:"to"`
+}
+
+// LinkAnalyticsInterval defines model for LinkAnalytics.Interval.
+type LinkAnalyticsInterval string
+
+// LinkAnalyticsBucket defines model for LinkAnalyticsBucket.
+type LinkAnalyticsBucket struct {
+	// Authenticated Holders that authenticated with the wallet
+	Authenticated int `json:"authenticated"`
+
+	// Errors Failed steps
+	Errors int `json:"errors"`
+
+	// Issued Credentials fetched by the wallets
+	Issued int `json:"issued"`
+
+	// QrDisplayed Authentication QR codes displayed
+	QrDisplayed int       `json:"qrDisplayed"`
+	Start       time.Time `json:"start"`
+}
+
+// LinkCode defines model for LinkCode.
+type LinkCode struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	// HolderDID Holder that claimed the credential with the code
+	HolderDID *string    `json:"holderDID,omitempty"`
+	Id        uuid.UUID  `json:"id"`
+	Used      bool       `json:"used"`
+	UsedAt    *time.Time `json:"usedAt,omitempty"`
+}
+
+// LinkFunnel defines model for LinkFunnel.
+type LinkFunnel struct {
+	// AuthCompleted Sessions where the holder authenticated with the wallet
+	AuthCompleted int `json:"authCompleted"`
+
+	// CredentialDelivered Credentials fetched by the wallets
+	CredentialDelivered int `json:"credentialDelivered"`
+
+	// Failures Failed steps by reason
+	Failures map[string]int `json:"failures"`
+	LinkID   uuid.UUID      `json:"linkID"`
+
+	// OfferFetched Sessions that got the credential offer
+	OfferFetched int `json:"offerFetched"`
+
+	// QrFetched Sessions that got the authentication QR code
+	QrFetched int `json:"qrFetched"`
+}
+
 // LinkSimple defines model for LinkSimple.
 type LinkSimple struct {
 	Id         uuid.UUID `json:"id"`
@@ -197,6 +775,64 @@ type LinkSimple struct {
 	SchemaUrl  string    `json:"schemaUrl"`
 }
 
+// OfferEmail defines model for OfferEmail.
+type OfferEmail struct {
+	// ClaimedAt First time the wallet fetched the credential after the email was sent
+	ClaimedAt *time.Time `json:"claimedAt,omitempty"`
+
+	// ClickedAt First time the claim link was followed
+	ClickedAt    *time.Time `json:"clickedAt,omitempty"`
+	CreatedAt    time.Time  `json:"createdAt"`
+	CredentialID uuid.UUID  `json:"credentialID"`
+
+	// Error Why the email provider rejected the email
+	Error *string   `json:"error,omitempty"`
+	Id    uuid.UUID `json:"id"`
+
+	// OpenedAt First time the email was opened. Email clients that block the images are only tracked when the link is followed.
+	OpenedAt  *time.Time       `json:"openedAt,omitempty"`
+	Recipient string           `json:"recipient"`
+	SentAt    *time.Time       `json:"sentAt,omitempty"`
+	Status    OfferEmailStatus `json:"status"`
+}
+
+// OfferEmailStatus defines model for OfferEmail.Status.
+type OfferEmailStatus string
+
+// ProblemReport defines model for ProblemReport.
+type ProblemReport struct {
+	Code         string     `json:"code"`
+	Comment      *string    `json:"comment,omitempty"`
+	ConnectionID *uuid.UUID `json:"connectionID,omitempty"`
+	CreatedAt    time.Time  `json:"createdAt"`
+	CredentialID *uuid.UUID `json:"credentialID,omitempty"`
+
+	// Direction sent when the agent answered a failed request with the report, received when the holder reported it
+	Direction ProblemReportDirection `json:"direction"`
+	Holder    string                 `json:"holder"`
+	Id        uuid.UUID              `json:"id"`
+	MessageID string                 `json:"messageID"`
+
+	// ThreadID Thread the problem is about, like the one of a credential offer
+	ThreadID string `json:"threadID"`
+}
+
+// ProblemReportDirection sent when the agent answered a failed request with the report, received when the holder reported it
+type ProblemReportDirection string
+
+// ProblemReportBody defines model for ProblemReportBody.
+type ProblemReportBody struct {
+	Code    string  `json:"code"`
+	Comment *string `json:"comment,omitempty"`
+}
+
+// PublicationStatus Publication of the state of a credential issued with an Iden3SparseMerkleTreeProof:
+//   - `not-required` - The credential has no Iden3SparseMerkleTreeProof
+//   - `pending-publish` - The state is not published yet, or its transaction is not confirmed
+//   - `published` - The state is confirmed on chain, the proof can be verified
+//   - `failed` - The transaction of the state failed. It is sent again when the state is retried
+type PublicationStatus string
+
 // PublishIdentityStateResponse defines model for PublishIdentityStateResponse.
 type PublishIdentityStateResponse struct {
 	ClaimsTreeRoot     *string `json:"claimsTreeRoot,omitempty"`
@@ -206,9 +842,17 @@ type PublishIdentityStateResponse struct {
 	TxID               *string `json:"txID,omitempty"`
 }
 
+// PublishingCost Cost of publishing the state now. The cost, in wei, is the gas limit times the max fee per gas, so the actual cost is at most that.
+type PublishingCost struct {
+	Cost         string `json:"cost"`
+	GasLimit     uint64 `json:"gasLimit"`
+	MaxFeePerGas string `json:"maxFeePerGas"`
+}
+
 // QrCodeBodyResponse defines model for QrCodeBodyResponse.
 type QrCodeBodyResponse struct {
 	Credentials []QrCodeCredentialResponse `json:"credentials"`
+	Issuer      *IssuerDescription         `json:"issuer,omitempty"`
 	Url         string                     `json:"url"`
 }
 
@@ -221,12 +865,35 @@ type QrCodeCredentialResponse struct {
 // QrCodeResponse defines model for QrCodeResponse.
 type QrCodeResponse struct {
 	Body QrCodeBodyResponse `json:"body"`
-	From string             `json:"from"`
-	Id   string             `json:"id"`
-	Thid string             `json:"thid"`
-	To   string             `json:"to"`
-	Typ  string             `json:"typ"`
-	Type string             `json:"type"`
+
+	// ExpiresTime Unix time in seconds after which the offer can not be used to fetch the credential. The offer can be used only once.
+	ExpiresTime *int64 `json:"expires_time,omitempty"`
+	From        string `json:"from"`
+	Id          string `json:"id"`
+	Thid        string `json:"thid"`
+	To          string `json:"to"`
+	Typ         string `json:"typ"`
+	Type        string `json:"type"`
+}
+
+// RenewCredentialOfferRequest defines model for RenewCredentialOfferRequest.
+type RenewCredentialOfferRequest struct {
+	// Notify Pushes the new offer to the wallet of the holder
+	Notify *bool `json:"notify,omitempty"`
+}
+
+// RevocationList defines model for RevocationList.
+type RevocationList struct {
+	// EncodedList GZIP compressed and base64url encoded bitstring of the revoked nonces, only in the bitstring format
+	EncodedList *string `json:"encodedList,omitempty"`
+	Issuer      string  `json:"issuer"`
+
+	// Nonces Revoked nonces in ascending order, only in the list format
+	Nonces *[]uint64  `json:"nonces,omitempty"`
+	Since  *time.Time `json:"since,omitempty"`
+
+	// SyncedAt Time of the list, the next sync can pass it as since
+	SyncedAt time.Time `json:"syncedAt"`
 }
 
 // RevocationStatusResponse defines model for RevocationStatusResponse.
@@ -254,17 +921,72 @@ type RevokeCredentialResponse struct {
 
 // Schema defines model for Schema.
 type Schema struct {
-	BigInt    string    `json:"bigInt"`
-	CreatedAt time.Time `json:"createdAt"`
-	Hash      string    `json:"hash"`
-	Id        string    `json:"id"`
-	Type      string    `json:"type"`
-	Url       string    `json:"url"`
+	AutoRevokeOnExpiration bool      `json:"autoRevokeOnExpiration"`
+	BigInt                 string    `json:"bigInt"`
+	CreatedAt              time.Time `json:"createdAt"`
+
+	// DefaultProofTypes Proof types of the credentials of the schema created without signatureProof and mtProof. If not set, the default proof types of the proof policy apply.
+	DefaultProofTypes *[]string  `json:"defaultProofTypes,omitempty"`
+	DeprecatedAt      *time.Time `json:"deprecatedAt,omitempty"`
+	ExtraContexts     *[]string  `json:"extraContexts,omitempty"`
+	ExtraTypes        *[]string  `json:"extraTypes,omitempty"`
+	Hash              string     `json:"hash"`
+	Id                string     `json:"id"`
+
+	// IpfsCid CID of the JSON Schema of a built schema pinned to IPFS
+	IpfsCid *string `json:"ipfsCid,omitempty"`
+
+	// IpfsContextCid CID of the JSON-LD context of a built schema pinned to IPFS
+	IpfsContextCid *string `json:"ipfsContextCid,omitempty"`
+
+	// MaxValidityDays Longest validity, in days since they are issued, of the credentials of the schema
+	MaxValidityDays *int `json:"maxValidityDays,omitempty"`
+
+	// SchemaVersion Version of the schema among the schemas of the same type. Importing a type again creates a new version.
+	SchemaVersion int `json:"schemaVersion"`
+
+	// Status Deprecated schemas keep their credentials, but new credentials and links cannot be created with them.
+	Status               SchemaStatus `json:"status"`
+	Type                 string       `json:"type"`
+	Url                  string       `json:"url"`
+	ValidationWebhookUrl *string      `json:"validationWebhookUrl,omitempty"`
+
+	// Version Incremented on every update of the schema. It is the value of the ETag header.
+	Version int `json:"version"`
+}
+
+// SchemaAttributeDefinition defines model for SchemaAttributeDefinition.
+type SchemaAttributeDefinition struct {
+	Description *string                       `json:"description,omitempty"`
+	Name        string                        `json:"name"`
+	Required    *bool                         `json:"required,omitempty"`
+	Title       *string                       `json:"title,omitempty"`
+	Type        SchemaAttributeDefinitionType `json:"type"`
+}
+
+// SchemaAttributeDefinitionType defines model for SchemaAttributeDefinition.Type.
+type SchemaAttributeDefinitionType string
+
+// SchemaStatus Deprecated schemas keep their credentials, but new credentials and links cannot be created with them.
+type SchemaStatus string
+
+// SendCredentialOfferRequest defines model for SendCredentialOfferRequest.
+type SendCredentialOfferRequest struct {
+	Email string `json:"email"`
 }
 
 // StateStatusResponse defines model for StateStatusResponse.
 type StateStatusResponse struct {
-	PendingActions bool `json:"pendingActions"`
+	// EstimatedCost Cost of publishing the state now. The cost, in wei, is the gas limit times the max fee per gas, so the actual cost is at most that.
+	EstimatedCost *PublishingCost `json:"estimatedCost,omitempty"`
+
+	// LastPublishedState Latest state sent to the blockchain
+	LastPublishedState *string                 `json:"lastPublishedState,omitempty"`
+	LastStatus         *StateTransactionStatus `json:"lastStatus,omitempty"`
+
+	// LastTxID Transaction of the latest state sent to the blockchain
+	LastTxID       *string `json:"lastTxID,omitempty"`
+	PendingActions bool    `json:"pendingActions"`
 }
 
 // StateTransaction defines model for StateTransaction.
@@ -276,26 +998,132 @@ type StateTransaction struct {
 	TxID        string                 `json:"txID"`
 }
 
-// StateTransactionStatus defines model for StateTransaction.Status.
+// StateTransactionStatus defines model for StateTransactionStatus.
 type StateTransactionStatus string
 
 // StateTransactionsResponse defines model for StateTransactionsResponse.
 type StateTransactionsResponse = []StateTransaction
 
+// SubjectBinding defines model for SubjectBinding.
+type SubjectBinding struct {
+	CreatedAt  time.Time `json:"createdAt"`
+	ExternalID string    `json:"externalID"`
+	HolderDID  string    `json:"holderDID"`
+
+	// LinkID Link claimed by the holder. It is not set if the link was deleted.
+	LinkID *uuid.UUID `json:"linkID,omitempty"`
+}
+
 // UUIDResponse defines model for UUIDResponse.
 type UUIDResponse struct {
 	Id string `json:"id"`
 }
 
+// UpdateConnectionMetadataRequest defines model for UpdateConnectionMetadataRequest.
+type UpdateConnectionMetadataRequest struct {
+	// DisplayName Up to 100 characters. An empty string removes it.
+	DisplayName *string `json:"displayName,omitempty"`
+
+	// Notes Up to 2000 characters. An empty string removes them.
+	Notes *string `json:"notes,omitempty"`
+
+	// Tags Replaces the tags of the connection, up to 20 tags of 50 characters without spaces or commas. An empty list removes them.
+	Tags *[]string `json:"tags,omitempty"`
+}
+
+// UpdateIssuerProfileRequest defines model for UpdateIssuerProfileRequest.
+type UpdateIssuerProfileRequest struct {
+	BackgroundColor *string `json:"backgroundColor,omitempty"`
+	ContactEmail    *string `json:"contactEmail,omitempty"`
+	ContactURL      *string `json:"contactURL,omitempty"`
+	DisplayName     *string `json:"displayName,omitempty"`
+	LogoURL         *string `json:"logoURL,omitempty"`
+	TextColor       *string `json:"textColor,omitempty"`
+}
+
+// UpdateSchemaRequest defines model for UpdateSchemaRequest.
+type UpdateSchemaRequest struct {
+	// AutoRevokeOnExpiration Revoke the credentials of this schema once they expire
+	AutoRevokeOnExpiration *bool `json:"autoRevokeOnExpiration,omitempty"`
+
+	// DefaultProofTypes Replaces the proof types of the credentials of the schema created without signatureProof and mtProof. An
+	// empty list removes them, so the default proof types of the proof policy apply.
+	DefaultProofTypes *[]string `json:"defaultProofTypes,omitempty"`
+
+	// Deprecated Deprecates the schema, so no new credentials are issued with it, or makes it active again
+	Deprecated *bool `json:"deprecated,omitempty"`
+
+	// ExtraContexts Replaces the JSON-LD contexts added to every credential of the schema. They must be loadable. An empty list removes them.
+	ExtraContexts *[]string `json:"extraContexts,omitempty"`
+
+	// ExtraTypes Replaces the types added to every credential of the schema. An empty list removes them.
+	ExtraTypes *[]string `json:"extraTypes,omitempty"`
+
+	// MaxValidityDays Replaces the maximum validity of the credentials of the schema. Longer expirations are rejected, and the
+	// credentials created without expiration expire at the end of it. Zero removes it.
+	MaxValidityDays   *int               `json:"maxValidityDays,omitempty"`
+	ValidationWebhook *ValidationWebhook `json:"validationWebhook,omitempty"`
+}
+
+// ValidationWebhook defines model for ValidationWebhook.
+type ValidationWebhook struct {
+	// Secret Key of the HMAC-SHA256 of the request body sent in the X-Issuer-Signature-256 header
+	Secret *string `json:"secret,omitempty"`
+
+	// Url Receives a POST with the issuer, schema, type, credentialSubject and expiration of the credential and answers
+	// {"approved": bool, "reason": string}. An empty url removes the webhook.
+	Url string `json:"url"`
+}
+
+// VerificationQuery defines model for VerificationQuery.
+type VerificationQuery struct {
+	// AllowedIssuers Issuers of the credentials accepted, any if not set
+	AllowedIssuers *[]string                  `json:"allowedIssuers,omitempty"`
+	CircuitId      VerificationQueryCircuitId `json:"circuitId"`
+	Context        string                     `json:"context"`
+
+	// CredentialSubject Predicate on a field of the credential subject. A field without operator asks to disclose it.
+	CredentialSubject *map[string]interface{} `json:"credentialSubject,omitempty"`
+
+	// Id Id of the query, its position if not set
+	Id                       *uint32 `json:"id,omitempty"`
+	SkipClaimRevocationCheck *bool   `json:"skipClaimRevocationCheck,omitempty"`
+	Type                     string  `json:"type"`
+}
+
+// VerificationQueryCircuitId defines model for VerificationQuery.CircuitId.
+type VerificationQueryCircuitId string
+
+// WalletLinks Links that open the message of a QR code in the wallet of the holder
+type WalletLinks struct {
+	DeepLink      string `json:"deepLink"`
+	UniversalLink string `json:"universalLink"`
+}
+
 // Id defines model for id.
 type Id = uuid.UUID
 
+// IdempotencyKey defines model for idempotencyKey.
+type IdempotencyKey = string
+
+// IfMatch defines model for ifMatch.
+type IfMatch = string
+
 // LinkID defines model for linkID.
 type LinkID = uuid.UUID
 
 // PathNonce defines model for pathNonce.
 type PathNonce = int64
 
+// PublishNow defines model for publishNow.
+type PublishNow = bool
+
+// RevocationDescription defines model for revocationDescription.
+type RevocationDescription = string
+
+// RevocationReason defines model for revocationReason.
+type RevocationReason = CredentialRevocationReason
+
 // SessionID defines model for sessionID.
 type SessionID = uuid.UUID
 
@@ -305,12 +1133,24 @@ type N400 = GenericErrorMessage
 // N401 defines model for 401.
 type N401 = GenericErrorMessage
 
+// N403 defines model for 403.
+type N403 = GenericErrorMessage
+
 // N404 defines model for 404.
 type N404 = GenericErrorMessage
 
+// N409 defines model for 409.
+type N409 = GenericErrorMessage
+
+// N412 defines model for 412.
+type N412 = GenericErrorMessage
+
 // N422 defines model for 422.
 type N422 = GenericErrorMessage
 
+// N429 defines model for 429.
+type N429 = GenericErrorMessage
+
 // N500 defines model for 500.
 type N500 = GenericErrorMessage
 
@@ -326,13 +1166,28 @@ type AuthCallbackParams struct {
 	SessionID SessionID `form:"sessionID" json:"sessionID"`
 }
 
+// GetChangesParams defines parameters for GetChanges.
+type GetChangesParams struct {
+	// Since Cursor returned by the previous request. Changes after it are returned.
+	Since *string `form:"since,omitempty" json:"since,omitempty"`
+
+	// Limit Maximum number of changes returned.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // GetConnectionsParams defines parameters for GetConnections.
 type GetConnectionsParams struct {
-	// Query Query string to do full text search in connections.
+	// Query Search query. It matches the connections with any of its words in their DID, display name or, with credentials=true, the schemas of their credentials, and that have a credential with all its field:value terms, like `schema:KYCAgeCredential birthday:1990*`.
 	Query *string `form:"query,omitempty" json:"query,omitempty"`
 
+	// Tags Comma separated tags. Only the connections that have all of them are returned.
+	Tags *[]string `form:"tags,omitempty" json:"tags,omitempty"`
+
 	// Credentials credentials=true to include the connection credentials.
 	Credentials *bool `form:"credentials,omitempty" json:"credentials,omitempty"`
+
+	// IncludeDeleted includeDeleted=true to include the deleted connections and credentials, until they are purged.
+	IncludeDeleted *bool `form:"includeDeleted,omitempty" json:"includeDeleted,omitempty"`
 }
 
 // DeleteConnectionParams defines parameters for DeleteConnection.
@@ -344,6 +1199,45 @@ type DeleteConnectionParams struct {
 	DeleteCredentials *bool `form:"deleteCredentials,omitempty" json:"deleteCredentials,omitempty"`
 }
 
+// UpdateConnectionMetadataParams defines parameters for UpdateConnectionMetadata.
+type UpdateConnectionMetadataParams struct {
+	// IfMatch ETag of the resource returned when it was read, e.g: "1". The update fails with 412 if the resource has been
+	// modified since then.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// GetConnectionCredentialsParams defines parameters for GetConnectionCredentials.
+type GetConnectionCredentialsParams struct {
+	// Status Credential status:
+	//   * `all` - All credentials. (default value)
+	//   * `revoked` - Only revoked credentials
+	//   * `expired` - Only expired credentials
+	Status *GetConnectionCredentialsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Query Search query. It matches the credentials with any of its words in their schema, subject values or holder DID, and with all its field:value terms, like `schema:KYCAgeCredential birthday:1990*`. The schema field is the schema type and the rest are credential subject fields. A term ending in * matches the values that begin with it, and values with spaces are quoted, like `name:"John Smith"`.
+	Query *string `form:"query,omitempty" json:"query,omitempty"`
+
+	// Page Page to return, starting at 1.
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Maximum number of credentials in a page.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetConnectionCredentialsParamsStatus defines parameters for GetConnectionCredentials.
+type GetConnectionCredentialsParamsStatus string
+
+// RevokeConnectionCredentialsParams defines parameters for RevokeConnectionCredentials.
+type RevokeConnectionCredentialsParams struct {
+	// PublishNow Publish the state right after the revocation, without waiting for the publishing policy
+	PublishNow *PublishNow `form:"publishNow,omitempty" json:"publishNow,omitempty"`
+
+	// IdempotencyKey Unique key of the request, up to 255 characters. A retry with the same key gets the response of the first
+	// request instead of being processed again, for 24h by default. A retry that arrives while the first request is
+	// being processed gets a 409, and a key used with another request gets a 422.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
 // GetCredentialsParams defines parameters for GetCredentials.
 type GetCredentialsParams struct {
 	Did *string `form:"did,omitempty" json:"did,omitempty"`
@@ -354,13 +1248,33 @@ type GetCredentialsParams struct {
 	//   * `expired` - Only expired schemas
 	Status *GetCredentialsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
 
-	// Query Query string to do full text search
+	// Query Search query. It matches the credentials with any of its words in their schema, subject values or holder DID, and with all its field:value terms, like `schema:KYCAgeCredential birthday:1990*`. The schema field is the schema type and the rest are credential subject fields. A term ending in * matches the values that begin with it, and values with spaces are quoted, like `name:"John Smith"`.
 	Query *string `form:"query,omitempty" json:"query,omitempty"`
+
+	// IncludeDeleted includeDeleted=true to include the deleted credentials, until they are purged.
+	IncludeDeleted *bool `form:"includeDeleted,omitempty" json:"includeDeleted,omitempty"`
+
+	// Publication Only the credentials with this publication status, like pending-publish for the ones waiting for the publisher.
+	Publication *PublicationStatus `form:"publication,omitempty" json:"publication,omitempty"`
 }
 
 // GetCredentialsParamsStatus defines parameters for GetCredentials.
 type GetCredentialsParamsStatus string
 
+// CreateCredentialParams defines parameters for CreateCredential.
+type CreateCredentialParams struct {
+	// IdempotencyKey Unique key of the request, up to 255 characters. A retry with the same key gets the response of the first
+	// request instead of being processed again, for 24h by default. A retry that arrives while the first request is
+	// being processed gets a 409, and a key used with another request gets a 422.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// GetImportReportParams defines parameters for GetImportReport.
+type GetImportReportParams struct {
+	// OnlyErrors Return only the failed rows
+	OnlyErrors *bool `form:"onlyErrors,omitempty" json:"onlyErrors,omitempty"`
+}
+
 // GetLinksParams defines parameters for GetLinks.
 type GetLinksParams struct {
 	// Query Query string to do full text search in schema types and attributes.
@@ -389,21 +1303,150 @@ type CreateLinkQrCodeCallbackParams struct {
 	LinkID LinkID `form:"linkID" json:"linkID"`
 }
 
+// ExportLinksFunnelParams defines parameters for ExportLinksFunnel.
+type ExportLinksFunnelParams struct {
+	// LinkID Export only the sessions of this link
+	LinkID *uuid.UUID `form:"linkID,omitempty" json:"linkID,omitempty"`
+
+	// From Export the steps made from this date time
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Export the steps made before this date time
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+}
+
 // AcivateLinkJSONBody defines parameters for AcivateLink.
 type AcivateLinkJSONBody struct {
 	Active bool `json:"active"`
 }
 
+// AcivateLinkParams defines parameters for AcivateLink.
+type AcivateLinkParams struct {
+	// IfMatch ETag of the resource returned when it was read, e.g: "1". The update fails with 412 if the resource has been
+	// modified since then.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// GetLinkAnalyticsParams defines parameters for GetLinkAnalytics.
+type GetLinkAnalyticsParams struct {
+	// Interval Length of the buckets, day by default. Weeks start on Monday.
+	Interval *GetLinkAnalyticsParamsInterval `form:"interval,omitempty" json:"interval,omitempty"`
+
+	// From Count the events from the bucket of this date time
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Count the events before this date time, now by default
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// GetLinkAnalyticsParamsInterval defines parameters for GetLinkAnalytics.
+type GetLinkAnalyticsParamsInterval string
+
+// CreateLinkCodesJSONBody defines parameters for CreateLinkCodes.
+type CreateLinkCodesJSONBody struct {
+	Count int `json:"count"`
+}
+
 // GetLinkQRCodeParams defines parameters for GetLinkQRCode.
 type GetLinkQRCodeParams struct {
 	// SessionID Session ID e.g: 89d298fa-15a6-4a1d-ab13-d1069467eedd
 	SessionID SessionID `form:"sessionID" json:"sessionID"`
+
+	// Wait Maximum number of seconds to wait for the session to leave the pending status. Capped at 60.
+	Wait *int `form:"wait,omitempty" json:"wait,omitempty"`
+}
+
+// CreateLinkQrCodeParams defines parameters for CreateLinkQrCode.
+type CreateLinkQrCodeParams struct {
+	// XLinkCode One-time code of the holder, for the code protected links
+	XLinkCode *string `json:"X-Link-Code,omitempty"`
+}
+
+// RevokeCredentialParams defines parameters for RevokeCredential.
+type RevokeCredentialParams struct {
+	// PublishNow Publish the state right after the revocation, without waiting for the publishing policy
+	PublishNow *PublishNow `form:"publishNow,omitempty" json:"publishNow,omitempty"`
+
+	// Reason Reason code of the revocation, unspecified by default
+	Reason *RevocationReason `form:"reason,omitempty" json:"reason,omitempty"`
+
+	// Description Free text description of the revocation
+	Description *RevocationDescription `form:"description,omitempty" json:"description,omitempty"`
+
+	// IdempotencyKey Unique key of the request, up to 255 characters. A retry with the same key gets the response of the first
+	// request instead of being processed again, for 24h by default. A retry that arrives while the first request is
+	// being processed gets a 409, and a key used with another request gets a 422.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// GetRevocationListParams defines parameters for GetRevocationList.
+type GetRevocationListParams struct {
+	// Since Returns only the nonces revoked at this time or later
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+
+	// Format Format of the list, list by default
+	Format *GetRevocationListParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetRevocationListParamsFormat defines parameters for GetRevocationList.
+type GetRevocationListParamsFormat string
+
+// GetJobsParams defines parameters for GetJobs.
+type GetJobsParams struct {
+	// Kind Only the jobs of this kind.
+	Kind *GetJobsParamsKind `form:"kind,omitempty" json:"kind,omitempty"`
+
+	// Status Only the jobs in this status.
+	Status *GetJobsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Limit Maximum number of jobs returned.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetJobsParamsKind defines parameters for GetJobs.
+type GetJobsParamsKind string
+
+// GetJobsParamsStatus defines parameters for GetJobs.
+type GetJobsParamsStatus string
+
+// GetAgentMessagesParams defines parameters for GetAgentMessages.
+type GetAgentMessagesParams struct {
+	// Sender Only the messages from this DID.
+	Sender *string `form:"sender,omitempty" json:"sender,omitempty"`
+
+	// ThreadID Only the messages of this thread, like the thread of a credential offer.
+	ThreadID *string `form:"threadID,omitempty" json:"threadID,omitempty"`
+
+	// Type Only the messages of this type.
+	Type *string `form:"type,omitempty" json:"type,omitempty"`
+
+	// Status Only the messages with this outcome.
+	Status *GetAgentMessagesParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Limit Maximum number of messages returned.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
+// GetAgentMessagesParamsStatus defines parameters for GetAgentMessages.
+type GetAgentMessagesParamsStatus string
+
 // GetSchemasParams defines parameters for GetSchemas.
 type GetSchemasParams struct {
 	// Query Query string to do full text search in schema types and attributes.
 	Query *string `form:"query,omitempty" json:"query,omitempty"`
+
+	// Status Only the schemas with this status.
+	Status *SchemaStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// SchemaVersion Only the schemas with this version of their type.
+	SchemaVersion *int `form:"schemaVersion,omitempty" json:"schemaVersion,omitempty"`
+}
+
+// UpdateSchemaParams defines parameters for UpdateSchema.
+type UpdateSchemaParams struct {
+	// IfMatch ETag of the resource returned when it was read, e.g: "1". The update fails with 412 if the resource has been
+	// modified since then.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
 }
 
 // AgentTextRequestBody defines body for Agent for text/plain ContentType.
@@ -412,9 +1455,18 @@ type AgentTextRequestBody = AgentTextBody
 // AuthCallbackTextRequestBody defines body for AuthCallback for text/plain ContentType.
 type AuthCallbackTextRequestBody = AuthCallbackTextBody
 
+// UpdateConnectionMetadataJSONRequestBody defines body for UpdateConnectionMetadata for application/json ContentType.
+type UpdateConnectionMetadataJSONRequestBody = UpdateConnectionMetadataRequest
+
 // CreateCredentialJSONRequestBody defines body for CreateCredential for application/json ContentType.
 type CreateCredentialJSONRequestBody = CreateCredentialRequest
 
+// CreateCredentialFromTemplateJSONRequestBody defines body for CreateCredentialFromTemplate for application/json ContentType.
+type CreateCredentialFromTemplateJSONRequestBody = CreateCredentialFromTemplateRequest
+
+// ImportCredentialsMultipartRequestBody defines body for ImportCredentials for multipart/form-data ContentType.
+type ImportCredentialsMultipartRequestBody = ImportCredentialsRequest
+
 // CreateLinkJSONRequestBody defines body for CreateLink for application/json ContentType.
 type CreateLinkJSONRequestBody = CreateLinkRequest
 
@@ -424,9 +1476,30 @@ type CreateLinkQrCodeCallbackTextRequestBody = CreateLinkQrCodeCallbackTextBody
 // AcivateLinkJSONRequestBody defines body for AcivateLink for application/json ContentType.
 type AcivateLinkJSONRequestBody AcivateLinkJSONBody
 
+// CreateLinkCodesJSONRequestBody defines body for CreateLinkCodes for application/json ContentType.
+type CreateLinkCodesJSONRequestBody CreateLinkCodesJSONBody
+
+// CreateCredentialTemplateJSONRequestBody defines body for CreateCredentialTemplate for application/json ContentType.
+type CreateCredentialTemplateJSONRequestBody = CreateCredentialTemplateRequest
+
+// RenewCredentialOfferJSONRequestBody defines body for RenewCredentialOffer for application/json ContentType.
+type RenewCredentialOfferJSONRequestBody = RenewCredentialOfferRequest
+
+// SendCredentialOfferJSONRequestBody defines body for SendCredentialOffer for application/json ContentType.
+type SendCredentialOfferJSONRequestBody = SendCredentialOfferRequest
+
+// UpdateIssuerProfileJSONRequestBody defines body for UpdateIssuerProfile for application/json ContentType.
+type UpdateIssuerProfileJSONRequestBody = UpdateIssuerProfileRequest
+
 // ImportSchemaJSONRequestBody defines body for ImportSchema for application/json ContentType.
 type ImportSchemaJSONRequestBody = ImportSchemaRequest
 
+// BuildSchemaJSONRequestBody defines body for BuildSchema for application/json ContentType.
+type BuildSchemaJSONRequestBody = BuildSchemaRequest
+
+// UpdateSchemaJSONRequestBody defines body for UpdateSchema for application/json ContentType.
+type UpdateSchemaJSONRequestBody = UpdateSchemaRequest
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// Get the documentation
@@ -450,6 +1523,9 @@ type ServerInterface interface {
 	// Get Connection QRCode
 	// (GET /v1/authentication/qrcode)
 	AuthQRCode(w http.ResponseWriter, r *http.Request)
+	// Get Changes
+	// (GET /v1/changes)
+	GetChanges(w http.ResponseWriter, r *http.Request, params GetChangesParams)
 	// Get Connections
 	// (GET /v1/connections)
 	GetConnections(w http.ResponseWriter, r *http.Request, params GetConnectionsParams)
@@ -459,18 +1535,42 @@ type ServerInterface interface {
 	// Get Connection
 	// (GET /v1/connections/{id})
 	GetConnection(w http.ResponseWriter, r *http.Request, id Id)
+	// Update Connection Metadata
+	// (PATCH /v1/connections/{id})
+	UpdateConnectionMetadata(w http.ResponseWriter, r *http.Request, id Id, params UpdateConnectionMetadataParams)
 	// Delete Connection Credentials
 	// (DELETE /v1/connections/{id}/credentials)
 	DeleteConnectionCredentials(w http.ResponseWriter, r *http.Request, id Id)
+	// Get Connection Credentials
+	// (GET /v1/connections/{id}/credentials)
+	GetConnectionCredentials(w http.ResponseWriter, r *http.Request, id Id, params GetConnectionCredentialsParams)
 	// Revoke Connection Credentials
 	// (POST /v1/connections/{id}/credentials/revoke)
-	RevokeConnectionCredentials(w http.ResponseWriter, r *http.Request, id Id)
+	RevokeConnectionCredentials(w http.ResponseWriter, r *http.Request, id Id, params RevokeConnectionCredentialsParams)
+	// Get Connection Problem Reports
+	// (GET /v1/connections/{id}/problem-reports)
+	GetConnectionProblemReports(w http.ResponseWriter, r *http.Request, id Id)
+	// Restore Connection
+	// (POST /v1/connections/{id}/restore)
+	RestoreConnection(w http.ResponseWriter, r *http.Request, id Id)
 	// Get Credentials
 	// (GET /v1/credentials)
 	GetCredentials(w http.ResponseWriter, r *http.Request, params GetCredentialsParams)
 	// Create Credential
 	// (POST /v1/credentials)
-	CreateCredential(w http.ResponseWriter, r *http.Request)
+	CreateCredential(w http.ResponseWriter, r *http.Request, params CreateCredentialParams)
+	// Create Credential From Template
+	// (POST /v1/credentials/from-template/{templateId})
+	CreateCredentialFromTemplate(w http.ResponseWriter, r *http.Request, templateId uuid.UUID)
+	// Import Credentials
+	// (POST /v1/credentials/imports)
+	ImportCredentials(w http.ResponseWriter, r *http.Request)
+	// Get Import Job
+	// (GET /v1/credentials/imports/{id})
+	GetImportJob(w http.ResponseWriter, r *http.Request, id Id)
+	// Get Import Report
+	// (GET /v1/credentials/imports/{id}/report)
+	GetImportReport(w http.ResponseWriter, r *http.Request, id Id, params GetImportReportParams)
 	// Get Links
 	// (GET /v1/credentials/links)
 	GetLinks(w http.ResponseWriter, r *http.Request, params GetLinksParams)
@@ -480,6 +1580,9 @@ type ServerInterface interface {
 	// Create Link QR Code Callback
 	// (POST /v1/credentials/links/callback)
 	CreateLinkQrCodeCallback(w http.ResponseWriter, r *http.Request, params CreateLinkQrCodeCallbackParams)
+	// Export Link Claim Funnel
+	// (GET /v1/credentials/links/funnel)
+	ExportLinksFunnel(w http.ResponseWriter, r *http.Request, params ExportLinksFunnelParams)
 	// Delete Link
 	// (DELETE /v1/credentials/links/{id})
 	DeleteLink(w http.ResponseWriter, r *http.Request, id Id)
@@ -488,37 +1591,133 @@ type ServerInterface interface {
 	GetLink(w http.ResponseWriter, r *http.Request, id Id)
 	// Activate | Deactivate Link
 	// (PATCH /v1/credentials/links/{id})
-	AcivateLink(w http.ResponseWriter, r *http.Request, id Id)
+	AcivateLink(w http.ResponseWriter, r *http.Request, id Id, params AcivateLinkParams)
+	// Get Link Analytics
+	// (GET /v1/credentials/links/{id}/analytics)
+	GetLinkAnalytics(w http.ResponseWriter, r *http.Request, id Id, params GetLinkAnalyticsParams)
+	// Get Link Codes
+	// (GET /v1/credentials/links/{id}/codes)
+	GetLinkCodes(w http.ResponseWriter, r *http.Request, id Id)
+	// Create Link Codes
+	// (POST /v1/credentials/links/{id}/codes)
+	CreateLinkCodes(w http.ResponseWriter, r *http.Request, id Id)
+	// Get Link Claim Funnel
+	// (GET /v1/credentials/links/{id}/funnel)
+	GetLinkFunnel(w http.ResponseWriter, r *http.Request, id Id)
 	// Get Credential Link QRCode
 	// (GET /v1/credentials/links/{id}/qrcode)
 	GetLinkQRCode(w http.ResponseWriter, r *http.Request, id Id, params GetLinkQRCodeParams)
 	// Create Authentication Link QRCode
 	// (POST /v1/credentials/links/{id}/qrcode)
-	CreateLinkQrCode(w http.ResponseWriter, r *http.Request, id Id)
+	CreateLinkQrCode(w http.ResponseWriter, r *http.Request, id Id, params CreateLinkQrCodeParams)
+	// Get Credential By Revocation Nonce
+	// (GET /v1/credentials/nonce/{nonce})
+	GetCredentialByNonce(w http.ResponseWriter, r *http.Request, nonce PathNonce)
 	// Get Revocation Status
 	// (GET /v1/credentials/revocation/status/{nonce})
 	GetRevocationStatus(w http.ResponseWriter, r *http.Request, nonce PathNonce)
 	// Revoke Credential
 	// (POST /v1/credentials/revoke/{nonce})
-	RevokeCredential(w http.ResponseWriter, r *http.Request, nonce PathNonce)
+	RevokeCredential(w http.ResponseWriter, r *http.Request, nonce PathNonce, params RevokeCredentialParams)
+	// Get Revocation List
+	// (GET /v1/credentials/revoked)
+	GetRevocationList(w http.ResponseWriter, r *http.Request, params GetRevocationListParams)
+	// Get Credential Templates
+	// (GET /v1/credentials/templates)
+	GetCredentialTemplates(w http.ResponseWriter, r *http.Request)
+	// Create Credential Template
+	// (POST /v1/credentials/templates)
+	CreateCredentialTemplate(w http.ResponseWriter, r *http.Request)
+	// Delete Credential Template
+	// (DELETE /v1/credentials/templates/{id})
+	DeleteCredentialTemplate(w http.ResponseWriter, r *http.Request, id Id)
+	// Get Credential Template
+	// (GET /v1/credentials/templates/{id})
+	GetCredentialTemplate(w http.ResponseWriter, r *http.Request, id Id)
 	// Delete Credential
 	// (DELETE /v1/credentials/{id})
 	DeleteCredential(w http.ResponseWriter, r *http.Request, id Id)
 	// Get Credential
 	// (GET /v1/credentials/{id})
 	GetCredential(w http.ResponseWriter, r *http.Request, id Id)
+	// Get Credential History
+	// (GET /v1/credentials/{id}/history)
+	GetCredentialHistory(w http.ResponseWriter, r *http.Request, id Id)
+	// Get Credential JWT
+	// (GET /v1/credentials/{id}/jwt)
+	GetCredentialJWT(w http.ResponseWriter, r *http.Request, id Id)
+	// Renew Credential Offer
+	// (POST /v1/credentials/{id}/offer)
+	RenewCredentialOffer(w http.ResponseWriter, r *http.Request, id Id)
+	// Get Credential Offer Emails
+	// (GET /v1/credentials/{id}/offer-emails)
+	GetCredentialOfferEmails(w http.ResponseWriter, r *http.Request, id Id)
+	// Get Credential Problem Reports
+	// (GET /v1/credentials/{id}/problem-reports)
+	GetCredentialProblemReports(w http.ResponseWriter, r *http.Request, id Id)
 	// Get Credential QR code
 	// (GET /v1/credentials/{id}/qrcode)
 	GetCredentialQrCode(w http.ResponseWriter, r *http.Request, id Id)
+	// Restore Credential
+	// (POST /v1/credentials/{id}/restore)
+	RestoreCredential(w http.ResponseWriter, r *http.Request, id Id)
+	// Send Credential Offer By Email
+	// (POST /v1/credentials/{id}/send-offer)
+	SendCredentialOffer(w http.ResponseWriter, r *http.Request, id Id)
+	// Get Credential Wallet Links
+	// (GET /v1/credentials/{id}/wallet-links)
+	GetCredentialWalletLinks(w http.ResponseWriter, r *http.Request, id Id)
+	// Get Issuer Profile
+	// (GET /v1/issuer-profile)
+	GetIssuerProfile(w http.ResponseWriter, r *http.Request)
+	// Update Issuer Profile
+	// (PATCH /v1/issuer-profile)
+	UpdateIssuerProfile(w http.ResponseWriter, r *http.Request)
+	// Get Jobs
+	// (GET /v1/jobs)
+	GetJobs(w http.ResponseWriter, r *http.Request, params GetJobsParams)
+	// Get Job
+	// (GET /v1/jobs/{id})
+	GetJob(w http.ResponseWriter, r *http.Request, id Id)
+	// Cancel Job
+	// (POST /v1/jobs/{id}/cancel)
+	CancelJob(w http.ResponseWriter, r *http.Request, id Id)
+	// Retry Job
+	// (POST /v1/jobs/{id}/retry)
+	RetryJob(w http.ResponseWriter, r *http.Request, id Id)
+	// Get Agent Messages
+	// (GET /v1/messages)
+	GetAgentMessages(w http.ResponseWriter, r *http.Request, params GetAgentMessagesParams)
+	// Get Agent Message Envelope
+	// (GET /v1/messages/{id}/envelope)
+	GetAgentMessageEnvelope(w http.ResponseWriter, r *http.Request, id Id)
+	// Claim Offer Email
+	// (GET /v1/offer-emails/{id}/claim)
+	ClaimOfferEmail(w http.ResponseWriter, r *http.Request, id Id)
+	// Track Offer Email Open
+	// (GET /v1/offer-emails/{id}/open)
+	OpenOfferEmail(w http.ResponseWriter, r *http.Request, id Id)
 	// Get Schemas
 	// (GET /v1/schemas)
 	GetSchemas(w http.ResponseWriter, r *http.Request, params GetSchemasParams)
 	// Import JSON schema
 	// (POST /v1/schemas)
 	ImportSchema(w http.ResponseWriter, r *http.Request)
+	// Build schema
+	// (POST /v1/schemas/build)
+	BuildSchema(w http.ResponseWriter, r *http.Request)
 	// Get Schema
 	// (GET /v1/schemas/{id})
 	GetSchema(w http.ResponseWriter, r *http.Request, id Id)
+	// Update Schema
+	// (PATCH /v1/schemas/{id})
+	UpdateSchema(w http.ResponseWriter, r *http.Request, id Id, params UpdateSchemaParams)
+	// Get JSON-LD context
+	// (GET /v1/schemas/{id}/context.jsonld)
+	GetSchemaContext(w http.ResponseWriter, r *http.Request, id Id)
+	// Get JSON Schema
+	// (GET /v1/schemas/{id}/schema.json)
+	GetSchemaJSON(w http.ResponseWriter, r *http.Request, id Id)
 	// Publish Identity State
 	// (POST /v1/state/publish)
 	PublishState(w http.ResponseWriter, r *http.Request)
@@ -531,6 +1730,9 @@ type ServerInterface interface {
 	// Get Identity State Transactions
 	// (GET /v1/state/transactions)
 	GetStateTransactions(w http.ResponseWriter, r *http.Request)
+	// Get Subject Binding
+	// (GET /v1/subjects/{externalID})
+	GetSubjectBinding(w http.ResponseWriter, r *http.Request, externalID string)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -667,6 +1869,44 @@ func (siw *ServerInterfaceWrapper) AuthQRCode(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
+// GetChanges operation middleware
+func (siw *ServerInterfaceWrapper) GetChanges(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetChangesParams
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", r.URL.Query(), &params.Since)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetChanges(w, r, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 // GetConnections operation middleware
 func (siw *ServerInterfaceWrapper) GetConnections(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -686,6 +1926,14 @@ func (siw *ServerInterfaceWrapper) GetConnections(w http.ResponseWriter, r *http
 		return
 	}
 
+	// ------------- Optional query parameter "tags" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "tags", r.URL.Query(), &params.Tags)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tags", Err: err})
+		return
+	}
+
 	// ------------- Optional query parameter "credentials" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "credentials", r.URL.Query(), &params.Credentials)
@@ -694,6 +1942,14 @@ func (siw *ServerInterfaceWrapper) GetConnections(w http.ResponseWriter, r *http
 		return
 	}
 
+	// ------------- Optional query parameter "includeDeleted" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "includeDeleted", r.URL.Query(), &params.IncludeDeleted)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "includeDeleted", Err: err})
+		return
+	}
+
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetConnections(w, r, params)
 	})
@@ -780,8 +2036,8 @@ func (siw *ServerInterfaceWrapper) GetConnection(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeleteConnectionCredentials operation middleware
-func (siw *ServerInterfaceWrapper) DeleteConnectionCredentials(w http.ResponseWriter, r *http.Request) {
+// UpdateConnectionMetadata operation middleware
+func (siw *ServerInterfaceWrapper) UpdateConnectionMetadata(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -797,8 +2053,32 @@ func (siw *ServerInterfaceWrapper) DeleteConnectionCredentials(w http.ResponseWr
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateConnectionMetadataParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteConnectionCredentials(w, r, id)
+		siw.Handler.UpdateConnectionMetadata(w, r, id, params)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -808,8 +2088,8 @@ func (siw *ServerInterfaceWrapper) DeleteConnectionCredentials(w http.ResponseWr
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// RevokeConnectionCredentials operation middleware
-func (siw *ServerInterfaceWrapper) RevokeConnectionCredentials(w http.ResponseWriter, r *http.Request) {
+// DeleteConnectionCredentials operation middleware
+func (siw *ServerInterfaceWrapper) DeleteConnectionCredentials(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -826,7 +2106,7 @@ func (siw *ServerInterfaceWrapper) RevokeConnectionCredentials(w http.ResponseWr
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
 
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.RevokeConnectionCredentials(w, r, id)
+		siw.Handler.DeleteConnectionCredentials(w, r, id)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -836,25 +2116,26 @@ func (siw *ServerInterfaceWrapper) RevokeConnectionCredentials(w http.ResponseWr
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetCredentials operation middleware
-func (siw *ServerInterfaceWrapper) GetCredentials(w http.ResponseWriter, r *http.Request) {
+// GetConnectionCredentials operation middleware
+func (siw *ServerInterfaceWrapper) GetConnectionCredentials(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetCredentialsParams
-
-	// ------------- Optional query parameter "did" -------------
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-	err = runtime.BindQueryParameter("form", true, false, "did", r.URL.Query(), &params.Did)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "did", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetConnectionCredentialsParams
+
 	// ------------- Optional query parameter "status" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
@@ -871,63 +2152,24 @@ func (siw *ServerInterfaceWrapper) GetCredentials(w http.ResponseWriter, r *http
 		return
 	}
 
-	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetCredentials(w, r, params)
-	})
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
-
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
-
-// CreateCredential operation middleware
-func (siw *ServerInterfaceWrapper) CreateCredential(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
-
-	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateCredential(w, r)
-	})
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
-
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
-
-// GetLinks operation middleware
-func (siw *ServerInterfaceWrapper) GetLinks(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-
-	var err error
-
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetLinksParams
-
-	// ------------- Optional query parameter "query" -------------
+	// ------------- Optional query parameter "page" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "query", r.URL.Query(), &params.Query)
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "query", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "status" -------------
+	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
 		return
 	}
 
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetLinks(w, r, params)
+		siw.Handler.GetConnectionCredentials(w, r, id, params)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -937,64 +2179,57 @@ func (siw *ServerInterfaceWrapper) GetLinks(w http.ResponseWriter, r *http.Reque
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// CreateLink operation middleware
-func (siw *ServerInterfaceWrapper) CreateLink(w http.ResponseWriter, r *http.Request) {
+// RevokeConnectionCredentials operation middleware
+func (siw *ServerInterfaceWrapper) RevokeConnectionCredentials(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
+	var err error
 
-	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateLink(w, r)
-	})
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
-
-// CreateLinkQrCodeCallback operation middleware
-func (siw *ServerInterfaceWrapper) CreateLinkQrCodeCallback(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-
-	var err error
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params CreateLinkQrCodeCallbackParams
-
-	// ------------- Required query parameter "sessionID" -------------
-
-	if paramValue := r.URL.Query().Get("sessionID"); paramValue != "" {
+	var params RevokeConnectionCredentialsParams
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "sessionID"})
-		return
-	}
+	// ------------- Optional query parameter "publishNow" -------------
 
-	err = runtime.BindQueryParameter("form", true, true, "sessionID", r.URL.Query(), &params.SessionID)
+	err = runtime.BindQueryParameter("form", true, false, "publishNow", r.URL.Query(), &params.PublishNow)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sessionID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "publishNow", Err: err})
 		return
 	}
 
-	// ------------- Required query parameter "linkID" -------------
+	headers := r.Header
 
-	if paramValue := r.URL.Query().Get("linkID"); paramValue != "" {
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "linkID"})
-		return
-	}
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, valueList[0], &IdempotencyKey)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
 
-	err = runtime.BindQueryParameter("form", true, true, "linkID", r.URL.Query(), &params.LinkID)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "linkID", Err: err})
-		return
 	}
 
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateLinkQrCodeCallback(w, r, params)
+		siw.Handler.RevokeConnectionCredentials(w, r, id, params)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1004,8 +2239,8 @@ func (siw *ServerInterfaceWrapper) CreateLinkQrCodeCallback(w http.ResponseWrite
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeleteLink operation middleware
-func (siw *ServerInterfaceWrapper) DeleteLink(w http.ResponseWriter, r *http.Request) {
+// GetConnectionProblemReports operation middleware
+func (siw *ServerInterfaceWrapper) GetConnectionProblemReports(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1022,7 +2257,7 @@ func (siw *ServerInterfaceWrapper) DeleteLink(w http.ResponseWriter, r *http.Req
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
 
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteLink(w, r, id)
+		siw.Handler.GetConnectionProblemReports(w, r, id)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1032,8 +2267,8 @@ func (siw *ServerInterfaceWrapper) DeleteLink(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetLink operation middleware
-func (siw *ServerInterfaceWrapper) GetLink(w http.ResponseWriter, r *http.Request) {
+// RestoreConnection operation middleware
+func (siw *ServerInterfaceWrapper) RestoreConnection(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1050,7 +2285,7 @@ func (siw *ServerInterfaceWrapper) GetLink(w http.ResponseWriter, r *http.Reques
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
 
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetLink(w, r, id)
+		siw.Handler.RestoreConnection(w, r, id)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1060,69 +2295,59 @@ func (siw *ServerInterfaceWrapper) GetLink(w http.ResponseWriter, r *http.Reques
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// AcivateLink operation middleware
-func (siw *ServerInterfaceWrapper) AcivateLink(w http.ResponseWriter, r *http.Request) {
+// GetCredentials operation middleware
+func (siw *ServerInterfaceWrapper) GetCredentials(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id Id
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCredentialsParams
+
+	// ------------- Optional query parameter "did" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "did", r.URL.Query(), &params.Did)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "did", Err: err})
 		return
 	}
 
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
-
-	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AcivateLink(w, r, id)
-	})
+	// ------------- Optional query parameter "status" -------------
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
-
-// GetLinkQRCode operation middleware
-func (siw *ServerInterfaceWrapper) GetLinkQRCode(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-
-	var err error
-
-	// ------------- Path parameter "id" -------------
-	var id Id
+	// ------------- Optional query parameter "query" -------------
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	err = runtime.BindQueryParameter("form", true, false, "query", r.URL.Query(), &params.Query)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "query", Err: err})
 		return
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetLinkQRCodeParams
-
-	// ------------- Required query parameter "sessionID" -------------
-
-	if paramValue := r.URL.Query().Get("sessionID"); paramValue != "" {
+	// ------------- Optional query parameter "includeDeleted" -------------
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "sessionID"})
+	err = runtime.BindQueryParameter("form", true, false, "includeDeleted", r.URL.Query(), &params.IncludeDeleted)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "includeDeleted", Err: err})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "sessionID", r.URL.Query(), &params.SessionID)
+	// ------------- Optional query parameter "publication" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "publication", r.URL.Query(), &params.Publication)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sessionID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "publication", Err: err})
 		return
 	}
 
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetLinkQRCode(w, r, id, params)
+		siw.Handler.GetCredentials(w, r, params)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1132,23 +2357,40 @@ func (siw *ServerInterfaceWrapper) GetLinkQRCode(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// CreateLinkQrCode operation middleware
-func (siw *ServerInterfaceWrapper) CreateLinkQrCode(w http.ResponseWriter, r *http.Request) {
+// CreateCredential operation middleware
+func (siw *ServerInterfaceWrapper) CreateCredential(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id Id
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateCredentialParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, valueList[0], &IdempotencyKey)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
 	}
 
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateLinkQrCode(w, r, id)
+		siw.Handler.CreateCredential(w, r, params)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1158,23 +2400,25 @@ func (siw *ServerInterfaceWrapper) CreateLinkQrCode(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetRevocationStatus operation middleware
-func (siw *ServerInterfaceWrapper) GetRevocationStatus(w http.ResponseWriter, r *http.Request) {
+// CreateCredentialFromTemplate operation middleware
+func (siw *ServerInterfaceWrapper) CreateCredentialFromTemplate(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "nonce" -------------
-	var nonce PathNonce
+	// ------------- Path parameter "templateId" -------------
+	var templateId uuid.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "nonce", runtime.ParamLocationPath, chi.URLParam(r, "nonce"), &nonce)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "templateId", runtime.ParamLocationPath, chi.URLParam(r, "templateId"), &templateId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nonce", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "templateId", Err: err})
 		return
 	}
 
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
+
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetRevocationStatus(w, r, nonce)
+		siw.Handler.CreateCredentialFromTemplate(w, r, templateId)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1184,25 +2428,14 @@ func (siw *ServerInterfaceWrapper) GetRevocationStatus(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// RevokeCredential operation middleware
-func (siw *ServerInterfaceWrapper) RevokeCredential(w http.ResponseWriter, r *http.Request) {
+// ImportCredentials operation middleware
+func (siw *ServerInterfaceWrapper) ImportCredentials(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	var err error
-
-	// ------------- Path parameter "nonce" -------------
-	var nonce PathNonce
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "nonce", runtime.ParamLocationPath, chi.URLParam(r, "nonce"), &nonce)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nonce", Err: err})
-		return
-	}
-
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
 
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.RevokeCredential(w, r, nonce)
+		siw.Handler.ImportCredentials(w, r)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1212,8 +2445,8 @@ func (siw *ServerInterfaceWrapper) RevokeCredential(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeleteCredential operation middleware
-func (siw *ServerInterfaceWrapper) DeleteCredential(w http.ResponseWriter, r *http.Request) {
+// GetImportJob operation middleware
+func (siw *ServerInterfaceWrapper) GetImportJob(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1230,7 +2463,7 @@ func (siw *ServerInterfaceWrapper) DeleteCredential(w http.ResponseWriter, r *ht
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
 
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteCredential(w, r, id)
+		siw.Handler.GetImportJob(w, r, id)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1240,8 +2473,8 @@ func (siw *ServerInterfaceWrapper) DeleteCredential(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetCredential operation middleware
-func (siw *ServerInterfaceWrapper) GetCredential(w http.ResponseWriter, r *http.Request) {
+// GetImportReport operation middleware
+func (siw *ServerInterfaceWrapper) GetImportReport(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1257,34 +2490,19 @@ func (siw *ServerInterfaceWrapper) GetCredential(w http.ResponseWriter, r *http.
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
 
-	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetCredential(w, r, id)
-	})
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
-
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
-
-// GetCredentialQrCode operation middleware
-func (siw *ServerInterfaceWrapper) GetCredentialQrCode(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-
-	var err error
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetImportReportParams
 
-	// ------------- Path parameter "id" -------------
-	var id Id
+	// ------------- Optional query parameter "onlyErrors" -------------
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	err = runtime.BindQueryParameter("form", true, false, "onlyErrors", r.URL.Query(), &params.OnlyErrors)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "onlyErrors", Err: err})
 		return
 	}
 
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetCredentialQrCode(w, r, id)
+		siw.Handler.GetImportReport(w, r, id, params)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1294,8 +2512,8 @@ func (siw *ServerInterfaceWrapper) GetCredentialQrCode(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetSchemas operation middleware
-func (siw *ServerInterfaceWrapper) GetSchemas(w http.ResponseWriter, r *http.Request) {
+// GetLinks operation middleware
+func (siw *ServerInterfaceWrapper) GetLinks(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1303,7 +2521,7 @@ func (siw *ServerInterfaceWrapper) GetSchemas(w http.ResponseWriter, r *http.Req
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetSchemasParams
+	var params GetLinksParams
 
 	// ------------- Optional query parameter "query" -------------
 
@@ -1313,8 +2531,16 @@ func (siw *ServerInterfaceWrapper) GetSchemas(w http.ResponseWriter, r *http.Req
 		return
 	}
 
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetSchemas(w, r, params)
+		siw.Handler.GetLinks(w, r, params)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1324,14 +2550,14 @@ func (siw *ServerInterfaceWrapper) GetSchemas(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ImportSchema operation middleware
-func (siw *ServerInterfaceWrapper) ImportSchema(w http.ResponseWriter, r *http.Request) {
+// CreateLink operation middleware
+func (siw *ServerInterfaceWrapper) CreateLink(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
 
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ImportSchema(w, r)
+		siw.Handler.CreateLink(w, r)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1341,25 +2567,47 @@ func (siw *ServerInterfaceWrapper) ImportSchema(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetSchema operation middleware
-func (siw *ServerInterfaceWrapper) GetSchema(w http.ResponseWriter, r *http.Request) {
+// CreateLinkQrCodeCallback operation middleware
+func (siw *ServerInterfaceWrapper) CreateLinkQrCodeCallback(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id Id
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateLinkQrCodeCallbackParams
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	// ------------- Required query parameter "sessionID" -------------
+
+	if paramValue := r.URL.Query().Get("sessionID"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "sessionID"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "sessionID", r.URL.Query(), &params.SessionID)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sessionID", Err: err})
 		return
 	}
 
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
+	// ------------- Required query parameter "linkID" -------------
+
+	if paramValue := r.URL.Query().Get("linkID"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "linkID"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "linkID", r.URL.Query(), &params.LinkID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "linkID", Err: err})
+		return
+	}
 
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetSchema(w, r, id)
+		siw.Handler.CreateLinkQrCodeCallback(w, r, params)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1369,31 +2617,43 @@ func (siw *ServerInterfaceWrapper) GetSchema(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PublishState operation middleware
-func (siw *ServerInterfaceWrapper) PublishState(w http.ResponseWriter, r *http.Request) {
+// ExportLinksFunnel operation middleware
+func (siw *ServerInterfaceWrapper) ExportLinksFunnel(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
 
-	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PublishState(w, r)
-	})
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportLinksFunnelParams
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	// ------------- Optional query parameter "linkID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "linkID", r.URL.Query(), &params.LinkID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "linkID", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
+	// ------------- Optional query parameter "from" -------------
 
-// RetryPublishState operation middleware
-func (siw *ServerInterfaceWrapper) RetryPublishState(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
 
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
 
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.RetryPublishState(w, r)
+		siw.Handler.ExportLinksFunnel(w, r, params)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1403,14 +2663,25 @@ func (siw *ServerInterfaceWrapper) RetryPublishState(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetStateStatus operation middleware
-func (siw *ServerInterfaceWrapper) GetStateStatus(w http.ResponseWriter, r *http.Request) {
+// DeleteLink operation middleware
+func (siw *ServerInterfaceWrapper) DeleteLink(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
 
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetStateStatus(w, r)
+		siw.Handler.DeleteLink(w, r, id)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1420,14 +2691,25 @@ func (siw *ServerInterfaceWrapper) GetStateStatus(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetStateTransactions operation middleware
-func (siw *ServerInterfaceWrapper) GetStateTransactions(w http.ResponseWriter, r *http.Request) {
+// GetLink operation middleware
+func (siw *ServerInterfaceWrapper) GetLink(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
 
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetStateTransactions(w, r)
+		siw.Handler.GetLink(w, r, id)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1437,1405 +2719,5204 @@ func (siw *ServerInterfaceWrapper) GetStateTransactions(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
+// AcivateLink operation middleware
+func (siw *ServerInterfaceWrapper) AcivateLink(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+	var err error
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-type UnmarshallingParamError struct {
-	ParamName string
-	Err       error
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (e *UnmarshallingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshalling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
-}
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
 
-func (e *UnmarshallingParamError) Unwrap() error {
-	return e.Err
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AcivateLinkParams
 
-type RequiredParamError struct {
-	ParamName string
-}
+	headers := r.Header
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
-}
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
-}
+		params.IfMatch = &IfMatch
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
-}
+	}
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
-}
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AcivateLink(w, r, id, params)
+	})
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
-}
+// GetLinkAnalytics operation middleware
+func (siw *ServerInterfaceWrapper) GetLinkAnalytics(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
-}
+	var err error
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{})
-}
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-type ChiServerOptions struct {
-	BaseURL          string
-	BaseRouter       chi.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseRouter: r,
-	})
-}
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLinkAnalyticsParams
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
+	// ------------- Optional query parameter "interval" -------------
 
-	if r == nil {
-		r = chi.NewRouter()
+	err = runtime.BindQueryParameter("form", true, false, "interval", r.URL.Query(), &params.Interval)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "interval", Err: err})
+		return
 	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
 	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
 	}
 
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/", wrapper.GetDocumentation)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/favicon.ico", wrapper.GetFavicon)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/static/docs/api_ui/api.yaml", wrapper.GetYaml)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/status", wrapper.Health)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v1/agent", wrapper.Agent)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v1/authentication/callback", wrapper.AuthCallback)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v1/authentication/qrcode", wrapper.AuthQRCode)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v1/connections", wrapper.GetConnections)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/v1/connections/{id}", wrapper.DeleteConnection)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v1/connections/{id}", wrapper.GetConnection)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/v1/connections/{id}/credentials", wrapper.DeleteConnectionCredentials)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v1/connections/{id}/credentials/revoke", wrapper.RevokeConnectionCredentials)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v1/credentials", wrapper.GetCredentials)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v1/credentials", wrapper.CreateCredential)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v1/credentials/links", wrapper.GetLinks)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v1/credentials/links", wrapper.CreateLink)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v1/credentials/links/callback", wrapper.CreateLinkQrCodeCallback)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/v1/credentials/links/{id}", wrapper.DeleteLink)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v1/credentials/links/{id}", wrapper.GetLink)
-	})
-	r.Group(func(r chi.Router) {
-		r.Patch(options.BaseURL+"/v1/credentials/links/{id}", wrapper.AcivateLink)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v1/credentials/links/{id}/qrcode", wrapper.GetLinkQRCode)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v1/credentials/links/{id}/qrcode", wrapper.CreateLinkQrCode)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v1/credentials/revocation/status/{nonce}", wrapper.GetRevocationStatus)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v1/credentials/revoke/{nonce}", wrapper.RevokeCredential)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/v1/credentials/{id}", wrapper.DeleteCredential)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v1/credentials/{id}", wrapper.GetCredential)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v1/credentials/{id}/qrcode", wrapper.GetCredentialQrCode)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v1/schemas", wrapper.GetSchemas)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v1/schemas", wrapper.ImportSchema)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v1/schemas/{id}", wrapper.GetSchema)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v1/state/publish", wrapper.PublishState)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v1/state/retry", wrapper.RetryPublishState)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v1/state/status", wrapper.GetStateStatus)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v1/state/transactions", wrapper.GetStateTransactions)
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLinkAnalytics(w, r, id, params)
 	})
 
-	return r
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type N400JSONResponse GenericErrorMessage
+// GetLinkCodes operation middleware
+func (siw *ServerInterfaceWrapper) GetLinkCodes(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-type N401JSONResponse GenericErrorMessage
+	var err error
 
-type N404JSONResponse GenericErrorMessage
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-type N422JSONResponse GenericErrorMessage
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type N500JSONResponse GenericErrorMessage
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
 
-type GetDocumentationRequestObject struct {
-}
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLinkCodes(w, r, id)
+	})
 
-type GetDocumentationResponseObject interface {
-	VisitGetDocumentationResponse(w http.ResponseWriter) error
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetDocumentation200Response struct {
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-func (response GetDocumentation200Response) VisitGetDocumentationResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
-
-type GetFaviconRequestObject struct {
-}
+// CreateLinkCodes operation middleware
+func (siw *ServerInterfaceWrapper) CreateLinkCodes(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-type GetFaviconResponseObject interface {
-	VisitGetFaviconResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type GetFavicon200Response struct {
-}
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-func (response GetFavicon200Response) VisitGetFaviconResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type GetYamlRequestObject struct {
-}
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
 
-type GetYamlResponseObject interface {
-	VisitGetYamlResponse(w http.ResponseWriter) error
-}
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateLinkCodes(w, r, id)
+	})
 
-type GetYaml200Response struct {
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (response GetYaml200Response) VisitGetYamlResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type HealthRequestObject struct {
-}
+// GetLinkFunnel operation middleware
+func (siw *ServerInterfaceWrapper) GetLinkFunnel(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-type HealthResponseObject interface {
-	VisitHealthResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type Health200JSONResponse Health
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-func (response Health200JSONResponse) VisitHealthResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
 
-type Health500JSONResponse struct{ N500JSONResponse }
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLinkFunnel(w, r, id)
+	})
 
-func (response Health500JSONResponse) VisitHealthResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type AgentRequestObject struct {
-	Body *AgentTextRequestBody
-}
+// GetLinkQRCode operation middleware
+func (siw *ServerInterfaceWrapper) GetLinkQRCode(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-type AgentResponseObject interface {
-	VisitAgentResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type Agent200JSONResponse AgentResponse
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-func (response Agent200JSONResponse) VisitAgentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLinkQRCodeParams
 
-type Agent400JSONResponse struct{ N400JSONResponse }
+	// ------------- Required query parameter "sessionID" -------------
 
-func (response Agent400JSONResponse) VisitAgentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	if paramValue := r.URL.Query().Get("sessionID"); paramValue != "" {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "sessionID"})
+		return
+	}
 
-type Agent500JSONResponse struct{ N500JSONResponse }
+	err = runtime.BindQueryParameter("form", true, true, "sessionID", r.URL.Query(), &params.SessionID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sessionID", Err: err})
+		return
+	}
 
-func (response Agent500JSONResponse) VisitAgentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// ------------- Optional query parameter "wait" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "wait", r.URL.Query(), &params.Wait)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "wait", Err: err})
+		return
+	}
 
-type AuthCallbackRequestObject struct {
-	Params AuthCallbackParams
-	Body   *AuthCallbackTextRequestBody
-}
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLinkQRCode(w, r, id, params)
+	})
 
-type AuthCallbackResponseObject interface {
-	VisitAuthCallbackResponse(w http.ResponseWriter) error
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type AuthCallback200Response struct {
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-func (response AuthCallback200Response) VisitAuthCallbackResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+// CreateLinkQrCode operation middleware
+func (siw *ServerInterfaceWrapper) CreateLinkQrCode(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-type AuthCallback400JSONResponse struct{ N400JSONResponse }
+	var err error
 
-func (response AuthCallback400JSONResponse) VisitAuthCallbackResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type AuthCallback500JSONResponse struct{ N500JSONResponse }
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateLinkQrCodeParams
 
-func (response AuthCallback500JSONResponse) VisitAuthCallbackResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	headers := r.Header
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional header parameter "X-Link-Code" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Link-Code")]; found {
+		var XLinkCode string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Link-Code", Count: n})
+			return
+		}
 
-type AuthQRCodeRequestObject struct {
-}
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Link-Code", runtime.ParamLocationHeader, valueList[0], &XLinkCode)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Link-Code", Err: err})
+			return
+		}
 
-type AuthQRCodeResponseObject interface {
-	VisitAuthQRCodeResponse(w http.ResponseWriter) error
-}
+		params.XLinkCode = &XLinkCode
 
-type AuthQRCode200JSONResponse AuthenticationQrCodeResponse
+	}
 
-func (response AuthQRCode200JSONResponse) VisitAuthQRCodeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateLinkQrCode(w, r, id, params)
+	})
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type AuthQRCode500JSONResponse struct{ N500JSONResponse }
+// GetCredentialByNonce operation middleware
+func (siw *ServerInterfaceWrapper) GetCredentialByNonce(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response AuthQRCode500JSONResponse) VisitAuthQRCodeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "nonce" -------------
+	var nonce PathNonce
 
-type GetConnectionsRequestObject struct {
-	Params GetConnectionsParams
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "nonce", runtime.ParamLocationPath, chi.URLParam(r, "nonce"), &nonce)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nonce", Err: err})
+		return
+	}
 
-type GetConnectionsResponseObject interface {
-	VisitGetConnectionsResponse(w http.ResponseWriter) error
-}
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
 
-type GetConnections200JSONResponse GetConnectionsResponse
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCredentialByNonce(w, r, nonce)
+	})
 
-func (response GetConnections200JSONResponse) VisitGetConnectionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type GetConnections400JSONResponse struct{ N400JSONResponse }
-
-func (response GetConnections400JSONResponse) VisitGetConnectionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// GetRevocationStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetRevocationStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type GetConnections500JSONResponse struct{ N500JSONResponse }
+	// ------------- Path parameter "nonce" -------------
+	var nonce PathNonce
 
-func (response GetConnections500JSONResponse) VisitGetConnectionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "nonce", runtime.ParamLocationPath, chi.URLParam(r, "nonce"), &nonce)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nonce", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRevocationStatus(w, r, nonce)
+	})
 
-type DeleteConnectionRequestObject struct {
-	Id     Id `json:"id"`
-	Params DeleteConnectionParams
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type DeleteConnectionResponseObject interface {
-	VisitDeleteConnectionResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type DeleteConnection200JSONResponse GenericMessage
+// RevokeCredential operation middleware
+func (siw *ServerInterfaceWrapper) RevokeCredential(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response DeleteConnection200JSONResponse) VisitDeleteConnectionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "nonce" -------------
+	var nonce PathNonce
 
-type DeleteConnection400JSONResponse struct{ N400JSONResponse }
+	err = runtime.BindStyledParameterWithLocation("simple", false, "nonce", runtime.ParamLocationPath, chi.URLParam(r, "nonce"), &nonce)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nonce", Err: err})
+		return
+	}
 
-func (response DeleteConnection400JSONResponse) VisitDeleteConnectionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RevokeCredentialParams
 
-type DeleteConnection500JSONResponse struct{ N500JSONResponse }
+	// ------------- Optional query parameter "publishNow" -------------
 
-func (response DeleteConnection500JSONResponse) VisitDeleteConnectionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	err = runtime.BindQueryParameter("form", true, false, "publishNow", r.URL.Query(), &params.PublishNow)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "publishNow", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "reason" -------------
 
-type GetConnectionRequestObject struct {
-	Id Id `json:"id"`
-}
+	err = runtime.BindQueryParameter("form", true, false, "reason", r.URL.Query(), &params.Reason)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "reason", Err: err})
+		return
+	}
 
-type GetConnectionResponseObject interface {
-	VisitGetConnectionResponse(w http.ResponseWriter) error
-}
+	// ------------- Optional query parameter "description" -------------
 
-type GetConnection200JSONResponse GetConnectionResponse
+	err = runtime.BindQueryParameter("form", true, false, "description", r.URL.Query(), &params.Description)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "description", Err: err})
+		return
+	}
 
-func (response GetConnection200JSONResponse) VisitGetConnectionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	headers := r.Header
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
 
-type GetConnection400JSONResponse struct{ N400JSONResponse }
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, valueList[0], &IdempotencyKey)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
 
-func (response GetConnection400JSONResponse) VisitGetConnectionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+		params.IdempotencyKey = &IdempotencyKey
 
-	return json.NewEncoder(w).Encode(response)
-}
+	}
 
-type GetConnection500JSONResponse struct{ N500JSONResponse }
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevokeCredential(w, r, nonce, params)
+	})
 
-func (response GetConnection500JSONResponse) VisitGetConnectionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type DeleteConnectionCredentialsRequestObject struct {
-	Id Id `json:"id"`
-}
+// GetRevocationList operation middleware
+func (siw *ServerInterfaceWrapper) GetRevocationList(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-type DeleteConnectionCredentialsResponseObject interface {
-	VisitDeleteConnectionCredentialsResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type DeleteConnectionCredentials200JSONResponse GenericMessage
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetRevocationListParams
 
-func (response DeleteConnectionCredentials200JSONResponse) VisitDeleteConnectionCredentialsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Optional query parameter "since" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "since", r.URL.Query(), &params.Since)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
+		return
+	}
 
-type DeleteConnectionCredentials500JSONResponse struct{ N500JSONResponse }
+	// ------------- Optional query parameter "format" -------------
 
-func (response DeleteConnectionCredentials500JSONResponse) VisitDeleteConnectionCredentialsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRevocationList(w, r, params)
+	})
 
-type RevokeConnectionCredentialsRequestObject struct {
-	Id Id `json:"id"`
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type RevokeConnectionCredentialsResponseObject interface {
-	VisitRevokeConnectionCredentialsResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type RevokeConnectionCredentials202JSONResponse GenericMessage
-
-func (response RevokeConnectionCredentials202JSONResponse) VisitRevokeConnectionCredentialsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(202)
+// GetCredentialTemplates operation middleware
+func (siw *ServerInterfaceWrapper) GetCredentialTemplates(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
 
-type RevokeConnectionCredentials400JSONResponse struct{ N400JSONResponse }
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCredentialTemplates(w, r)
+	})
 
-func (response RevokeConnectionCredentials400JSONResponse) VisitRevokeConnectionCredentialsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type RevokeConnectionCredentials500JSONResponse struct{ N500JSONResponse }
+// CreateCredentialTemplate operation middleware
+func (siw *ServerInterfaceWrapper) CreateCredentialTemplate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response RevokeConnectionCredentials500JSONResponse) VisitRevokeConnectionCredentialsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateCredentialTemplate(w, r)
+	})
 
-type GetCredentialsRequestObject struct {
-	Params GetCredentialsParams
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetCredentialsResponseObject interface {
-	VisitGetCredentialsResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type GetCredentials200JSONResponse []Credential
-
-func (response GetCredentials200JSONResponse) VisitGetCredentialsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// DeleteCredentialTemplate operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCredentialTemplate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type GetCredentials400JSONResponse struct{ N400JSONResponse }
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-func (response GetCredentials400JSONResponse) VisitGetCredentialsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
 
-type GetCredentials404JSONResponse struct{ N404JSONResponse }
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteCredentialTemplate(w, r, id)
+	})
 
-func (response GetCredentials404JSONResponse) VisitGetCredentialsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type GetCredentials500JSONResponse struct{ N500JSONResponse }
+// GetCredentialTemplate operation middleware
+func (siw *ServerInterfaceWrapper) GetCredentialTemplate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response GetCredentials500JSONResponse) VisitGetCredentialsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-type CreateCredentialRequestObject struct {
-	Body *CreateCredentialJSONRequestBody
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type CreateCredentialResponseObject interface {
-	VisitCreateCredentialResponse(w http.ResponseWriter) error
-}
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
 
-type CreateCredential201JSONResponse UUIDResponse
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCredentialTemplate(w, r, id)
+	})
 
-func (response CreateCredential201JSONResponse) VisitCreateCredentialResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type CreateCredential400JSONResponse struct{ N400JSONResponse }
-
-func (response CreateCredential400JSONResponse) VisitCreateCredentialResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// DeleteCredential operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCredential(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type CreateCredential401JSONResponse struct{ N401JSONResponse }
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-func (response CreateCredential401JSONResponse) VisitCreateCredentialResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
 
-type CreateCredential422JSONResponse struct{ N422JSONResponse }
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteCredential(w, r, id)
+	})
 
-func (response CreateCredential422JSONResponse) VisitCreateCredentialResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(422)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type CreateCredential500JSONResponse struct{ N500JSONResponse }
+// GetCredential operation middleware
+func (siw *ServerInterfaceWrapper) GetCredential(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response CreateCredential500JSONResponse) VisitCreateCredentialResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-type GetLinksRequestObject struct {
-	Params GetLinksParams
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type GetLinksResponseObject interface {
-	VisitGetLinksResponse(w http.ResponseWriter) error
-}
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
 
-type GetLinks200JSONResponse []Link
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCredential(w, r, id)
+	})
 
-func (response GetLinks200JSONResponse) VisitGetLinksResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type GetLinks400JSONResponse struct{ N400JSONResponse }
+// GetCredentialHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetCredentialHistory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response GetLinks400JSONResponse) VisitGetLinksResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-type GetLinks404JSONResponse struct{ N404JSONResponse }
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (response GetLinks404JSONResponse) VisitGetLinksResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
 
-	return json.NewEncoder(w).Encode(response)
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCredentialHistory(w, r, id)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type GetLinks500JSONResponse struct{ N500JSONResponse }
+// GetCredentialJWT operation middleware
+func (siw *ServerInterfaceWrapper) GetCredentialJWT(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response GetLinks500JSONResponse) VisitGetLinksResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-type CreateLinkRequestObject struct {
-	Body *CreateLinkJSONRequestBody
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type CreateLinkResponseObject interface {
-	VisitCreateLinkResponse(w http.ResponseWriter) error
-}
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
 
-type CreateLink201JSONResponse UUIDResponse
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCredentialJWT(w, r, id)
+	})
 
-func (response CreateLink201JSONResponse) VisitCreateLinkResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type CreateLink400JSONResponse struct{ N400JSONResponse }
+// RenewCredentialOffer operation middleware
+func (siw *ServerInterfaceWrapper) RenewCredentialOffer(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response CreateLink400JSONResponse) VisitCreateLinkResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-type CreateLink500JSONResponse struct{ N500JSONResponse }
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (response CreateLink500JSONResponse) VisitCreateLinkResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RenewCredentialOffer(w, r, id)
+	})
 
-type CreateLinkQrCodeCallbackRequestObject struct {
-	Params CreateLinkQrCodeCallbackParams
-	Body   *CreateLinkQrCodeCallbackTextRequestBody
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type CreateLinkQrCodeCallbackResponseObject interface {
-	VisitCreateLinkQrCodeCallbackResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type CreateLinkQrCodeCallback200Response struct {
-}
+// GetCredentialOfferEmails operation middleware
+func (siw *ServerInterfaceWrapper) GetCredentialOfferEmails(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response CreateLinkQrCodeCallback200Response) VisitCreateLinkQrCodeCallbackResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	var err error
 
-type CreateLinkQrCodeCallback400JSONResponse struct{ N400JSONResponse }
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-func (response CreateLinkQrCodeCallback400JSONResponse) VisitCreateLinkQrCodeCallbackResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
 
-type CreateLinkQrCodeCallback500JSONResponse struct{ N500JSONResponse }
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCredentialOfferEmails(w, r, id)
+	})
 
-func (response CreateLinkQrCodeCallback500JSONResponse) VisitCreateLinkQrCodeCallbackResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type DeleteLinkRequestObject struct {
-	Id Id `json:"id"`
-}
+// GetCredentialProblemReports operation middleware
+func (siw *ServerInterfaceWrapper) GetCredentialProblemReports(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-type DeleteLinkResponseObject interface {
-	VisitDeleteLinkResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type DeleteLink200JSONResponse GenericMessage
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-func (response DeleteLink200JSONResponse) VisitDeleteLinkResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
 
-type DeleteLink400JSONResponse struct{ N400JSONResponse }
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCredentialProblemReports(w, r, id)
+	})
 
-func (response DeleteLink400JSONResponse) VisitDeleteLinkResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type DeleteLink500JSONResponse struct{ N500JSONResponse }
-
-func (response DeleteLink500JSONResponse) VisitDeleteLinkResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// GetCredentialQrCode operation middleware
+func (siw *ServerInterfaceWrapper) GetCredentialQrCode(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type GetLinkRequestObject struct {
-	Id Id `json:"id"`
-}
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-type GetLinkResponseObject interface {
-	VisitGetLinkResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type GetLink200JSONResponse Link
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCredentialQrCode(w, r, id)
+	})
 
-func (response GetLink200JSONResponse) VisitGetLinkResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type GetLink404JSONResponse struct{ N404JSONResponse }
+// RestoreCredential operation middleware
+func (siw *ServerInterfaceWrapper) RestoreCredential(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response GetLink404JSONResponse) VisitGetLinkResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-type GetLink500JSONResponse struct{ N500JSONResponse }
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (response GetLink500JSONResponse) VisitGetLinkResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
 
-	return json.NewEncoder(w).Encode(response)
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RestoreCredential(w, r, id)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type AcivateLinkRequestObject struct {
-	Id   Id `json:"id"`
-	Body *AcivateLinkJSONRequestBody
-}
+// SendCredentialOffer operation middleware
+func (siw *ServerInterfaceWrapper) SendCredentialOffer(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-type AcivateLinkResponseObject interface {
-	VisitAcivateLinkResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type AcivateLink200JSONResponse GenericMessage
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-func (response AcivateLink200JSONResponse) VisitAcivateLinkResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
 
-type AcivateLink400JSONResponse struct{ N400JSONResponse }
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SendCredentialOffer(w, r, id)
+	})
 
-func (response AcivateLink400JSONResponse) VisitAcivateLinkResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type AcivateLink500JSONResponse struct{ N500JSONResponse }
-
-func (response AcivateLink500JSONResponse) VisitAcivateLinkResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// GetCredentialWalletLinks operation middleware
+func (siw *ServerInterfaceWrapper) GetCredentialWalletLinks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type GetLinkQRCodeRequestObject struct {
-	Id     Id `json:"id"`
-	Params GetLinkQRCodeParams
-}
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-type GetLinkQRCodeResponseObject interface {
-	VisitGetLinkQRCodeResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type GetLinkQRCode200JSONResponse GetLinkQrCodeResponse
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCredentialWalletLinks(w, r, id)
+	})
 
-func (response GetLinkQRCode200JSONResponse) VisitGetLinkQRCodeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type GetLinkQRCode400JSONResponse struct{ N400JSONResponse }
-
-func (response GetLinkQRCode400JSONResponse) VisitGetLinkQRCodeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// GetIssuerProfile operation middleware
+func (siw *ServerInterfaceWrapper) GetIssuerProfile(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
 
-type GetLinkQRCode404JSONResponse GenericErrorMessage
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetIssuerProfile(w, r)
+	})
 
-func (response GetLinkQRCode404JSONResponse) VisitGetLinkQRCodeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type GetLinkQRCode500JSONResponse struct{ N500JSONResponse }
+// UpdateIssuerProfile operation middleware
+func (siw *ServerInterfaceWrapper) UpdateIssuerProfile(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response GetLinkQRCode500JSONResponse) VisitGetLinkQRCodeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateIssuerProfile(w, r)
+	})
 
-type CreateLinkQrCodeRequestObject struct {
-	Id Id `json:"id"`
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type CreateLinkQrCodeResponseObject interface {
-	VisitCreateLinkQrCodeResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type CreateLinkQrCode200JSONResponse CredentialLinkQrCodeResponse
+// GetJobs operation middleware
+func (siw *ServerInterfaceWrapper) GetJobs(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response CreateLinkQrCode200JSONResponse) VisitCreateLinkQrCodeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
 
-type CreateLinkQrCode400JSONResponse struct{ N400JSONResponse }
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetJobsParams
 
-func (response CreateLinkQrCode400JSONResponse) VisitCreateLinkQrCodeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// ------------- Optional query parameter "kind" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "kind", r.URL.Query(), &params.Kind)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "kind", Err: err})
+		return
+	}
 
-type CreateLinkQrCode404JSONResponse struct{ N404JSONResponse }
+	// ------------- Optional query parameter "status" -------------
 
-func (response CreateLinkQrCode404JSONResponse) VisitCreateLinkQrCodeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "limit" -------------
 
-type CreateLinkQrCode500JSONResponse struct{ N500JSONResponse }
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
-func (response CreateLinkQrCode500JSONResponse) VisitCreateLinkQrCodeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetJobs(w, r, params)
+	})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetRevocationStatusRequestObject struct {
-	Nonce PathNonce `json:"nonce"`
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type GetRevocationStatusResponseObject interface {
-	VisitGetRevocationStatusResponse(w http.ResponseWriter) error
-}
+// GetJob operation middleware
+func (siw *ServerInterfaceWrapper) GetJob(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-type GetRevocationStatus200JSONResponse RevocationStatusResponse
+	var err error
 
-func (response GetRevocationStatus200JSONResponse) VisitGetRevocationStatusResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type GetRevocationStatus400JSONResponse struct{ N400JSONResponse }
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
 
-func (response GetRevocationStatus400JSONResponse) VisitGetRevocationStatusResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetJob(w, r, id)
+	})
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type GetRevocationStatus500JSONResponse struct{ N500JSONResponse }
+// CancelJob operation middleware
+func (siw *ServerInterfaceWrapper) CancelJob(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response GetRevocationStatus500JSONResponse) VisitGetRevocationStatusResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-type RevokeCredentialRequestObject struct {
-	Nonce PathNonce `json:"nonce"`
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type RevokeCredentialResponseObject interface {
-	VisitRevokeCredentialResponse(w http.ResponseWriter) error
-}
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
 
-type RevokeCredential202JSONResponse RevokeCredentialResponse
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CancelJob(w, r, id)
+	})
 
-func (response RevokeCredential202JSONResponse) VisitRevokeCredentialResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(202)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type RevokeCredential401JSONResponse struct{ N401JSONResponse }
-
-func (response RevokeCredential401JSONResponse) VisitRevokeCredentialResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// RetryJob operation middleware
+func (siw *ServerInterfaceWrapper) RetryJob(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type RevokeCredential404JSONResponse struct{ N404JSONResponse }
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-func (response RevokeCredential404JSONResponse) VisitRevokeCredentialResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
 
-type RevokeCredential500JSONResponse struct{ N500JSONResponse }
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RetryJob(w, r, id)
+	})
 
-func (response RevokeCredential500JSONResponse) VisitRevokeCredentialResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type DeleteCredentialRequestObject struct {
-	Id Id `json:"id"`
-}
+// GetAgentMessages operation middleware
+func (siw *ServerInterfaceWrapper) GetAgentMessages(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-type DeleteCredentialResponseObject interface {
-	VisitDeleteCredentialResponse(w http.ResponseWriter) error
-}
-
-type DeleteCredential200JSONResponse GenericMessage
-
-func (response DeleteCredential200JSONResponse) VisitDeleteCredentialResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
 
-type DeleteCredential400JSONResponse struct{ N400JSONResponse }
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAgentMessagesParams
 
-func (response DeleteCredential400JSONResponse) VisitDeleteCredentialResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// ------------- Optional query parameter "sender" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "sender", r.URL.Query(), &params.Sender)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sender", Err: err})
+		return
+	}
 
-type DeleteCredential401JSONResponse struct{ N401JSONResponse }
+	// ------------- Optional query parameter "threadID" -------------
 
-func (response DeleteCredential401JSONResponse) VisitDeleteCredentialResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	err = runtime.BindQueryParameter("form", true, false, "threadID", r.URL.Query(), &params.ThreadID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "threadID", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "type" -------------
 
-type DeleteCredential500JSONResponse struct{ N500JSONResponse }
+	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		return
+	}
 
-func (response DeleteCredential500JSONResponse) VisitDeleteCredentialResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// ------------- Optional query parameter "status" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
 
-type GetCredentialRequestObject struct {
-	Id Id `json:"id"`
-}
+	// ------------- Optional query parameter "limit" -------------
 
-type GetCredentialResponseObject interface {
-	VisitGetCredentialResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
-type GetCredential200JSONResponse Credential
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAgentMessages(w, r, params)
+	})
 
-func (response GetCredential200JSONResponse) VisitGetCredentialResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type GetCredential400JSONResponse struct{ N400JSONResponse }
+// GetAgentMessageEnvelope operation middleware
+func (siw *ServerInterfaceWrapper) GetAgentMessageEnvelope(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response GetCredential400JSONResponse) VisitGetCredentialResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-type GetCredential500JSONResponse struct{ N500JSONResponse }
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (response GetCredential500JSONResponse) VisitGetCredentialResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAgentMessageEnvelope(w, r, id)
+	})
 
-type GetCredentialQrCodeRequestObject struct {
-	Id Id `json:"id"`
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetCredentialQrCodeResponseObject interface {
-	VisitGetCredentialQrCodeResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type GetCredentialQrCode200JSONResponse QrCodeResponse
+// ClaimOfferEmail operation middleware
+func (siw *ServerInterfaceWrapper) ClaimOfferEmail(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response GetCredentialQrCode200JSONResponse) VisitGetCredentialQrCodeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-type GetCredentialQrCode400JSONResponse struct{ N400JSONResponse }
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (response GetCredentialQrCode400JSONResponse) VisitGetCredentialQrCodeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ClaimOfferEmail(w, r, id)
+	})
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type GetCredentialQrCode404JSONResponse struct{ N404JSONResponse }
+// OpenOfferEmail operation middleware
+func (siw *ServerInterfaceWrapper) OpenOfferEmail(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response GetCredentialQrCode404JSONResponse) VisitGetCredentialQrCodeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-type GetCredentialQrCode500JSONResponse struct{ N500JSONResponse }
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (response GetCredentialQrCode500JSONResponse) VisitGetCredentialQrCodeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.OpenOfferEmail(w, r, id)
+	})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetSchemasRequestObject struct {
-	Params GetSchemasParams
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type GetSchemasResponseObject interface {
-	VisitGetSchemasResponse(w http.ResponseWriter) error
-}
+// GetSchemas operation middleware
+func (siw *ServerInterfaceWrapper) GetSchemas(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-type GetSchemas200JSONResponse []Schema
+	var err error
 
-func (response GetSchemas200JSONResponse) VisitGetSchemasResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSchemasParams
 
-type GetSchemas400JSONResponse struct{ N400JSONResponse }
+	// ------------- Optional query parameter "query" -------------
 
-func (response GetSchemas400JSONResponse) VisitGetSchemasResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	err = runtime.BindQueryParameter("form", true, false, "query", r.URL.Query(), &params.Query)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "query", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "status" -------------
 
-type GetSchemas500JSONResponse struct{ N500JSONResponse }
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
 
-func (response GetSchemas500JSONResponse) VisitGetSchemasResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// ------------- Optional query parameter "schemaVersion" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "schemaVersion", r.URL.Query(), &params.SchemaVersion)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "schemaVersion", Err: err})
+		return
+	}
 
-type ImportSchemaRequestObject struct {
-	Body *ImportSchemaJSONRequestBody
-}
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSchemas(w, r, params)
+	})
 
-type ImportSchemaResponseObject interface {
-	VisitImportSchemaResponse(w http.ResponseWriter) error
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type ImportSchema201JSONResponse UUIDResponse
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
 
-func (response ImportSchema201JSONResponse) VisitImportSchemaResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+// ImportSchema operation middleware
+func (siw *ServerInterfaceWrapper) ImportSchema(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
 
-type ImportSchema400JSONResponse struct{ N400JSONResponse }
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportSchema(w, r)
+	})
 
-func (response ImportSchema400JSONResponse) VisitImportSchemaResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type ImportSchema500JSONResponse struct{ N500JSONResponse }
+// BuildSchema operation middleware
+func (siw *ServerInterfaceWrapper) BuildSchema(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response ImportSchema500JSONResponse) VisitImportSchemaResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.BuildSchema(w, r)
+	})
 
-type GetSchemaRequestObject struct {
-	Id Id `json:"id"`
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetSchemaResponseObject interface {
-	VisitGetSchemaResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type GetSchema200JSONResponse Schema
+// GetSchema operation middleware
+func (siw *ServerInterfaceWrapper) GetSchema(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response GetSchema200JSONResponse) VisitGetSchemaResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-type GetSchema400JSONResponse struct{ N400JSONResponse }
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (response GetSchema400JSONResponse) VisitGetSchemaResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
 
-	return json.NewEncoder(w).Encode(response)
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSchema(w, r, id)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type GetSchema404JSONResponse struct{ N404JSONResponse }
+// UpdateSchema operation middleware
+func (siw *ServerInterfaceWrapper) UpdateSchema(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response GetSchema404JSONResponse) VisitGetSchemaResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-type GetSchema500JSONResponse struct{ N500JSONResponse }
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (response GetSchema500JSONResponse) VisitGetSchemaResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateSchemaParams
 
-type PublishStateRequestObject struct {
-}
+	headers := r.Header
 
-type PublishStateResponseObject interface {
-	VisitPublishStateResponse(w http.ResponseWriter) error
-}
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
 
-type PublishState202JSONResponse PublishIdentityStateResponse
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
 
-func (response PublishState202JSONResponse) VisitPublishStateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(202)
+		params.IfMatch = &IfMatch
 
-	return json.NewEncoder(w).Encode(response)
-}
+	}
 
-type PublishState400JSONResponse struct{ N400JSONResponse }
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateSchema(w, r, id, params)
+	})
 
-func (response PublishState400JSONResponse) VisitPublishStateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type PublishState500JSONResponse struct{ N500JSONResponse }
+// GetSchemaContext operation middleware
+func (siw *ServerInterfaceWrapper) GetSchemaContext(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response PublishState500JSONResponse) VisitPublishStateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-type RetryPublishStateRequestObject struct {
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type RetryPublishStateResponseObject interface {
-	VisitRetryPublishStateResponse(w http.ResponseWriter) error
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSchemaContext(w, r, id)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type RetryPublishState202JSONResponse PublishIdentityStateResponse
+// GetSchemaJSON operation middleware
+func (siw *ServerInterfaceWrapper) GetSchemaJSON(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response RetryPublishState202JSONResponse) VisitRetryPublishStateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(202)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-type RetryPublishState400JSONResponse struct{ N400JSONResponse }
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (response RetryPublishState400JSONResponse) VisitRetryPublishStateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSchemaJSON(w, r, id)
+	})
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type RetryPublishState500JSONResponse struct{ N500JSONResponse }
+// PublishState operation middleware
+func (siw *ServerInterfaceWrapper) PublishState(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response RetryPublishState500JSONResponse) VisitRetryPublishStateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
 
-	return json.NewEncoder(w).Encode(response)
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PublishState(w, r)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type GetStateStatusRequestObject struct {
+// RetryPublishState operation middleware
+func (siw *ServerInterfaceWrapper) RetryPublishState(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RetryPublishState(w, r)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type GetStateStatusResponseObject interface {
-	VisitGetStateStatusResponse(w http.ResponseWriter) error
+// GetStateStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetStateStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStateStatus(w, r)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type GetStateStatus200JSONResponse StateStatusResponse
+// GetStateTransactions operation middleware
+func (siw *ServerInterfaceWrapper) GetStateTransactions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response GetStateStatus200JSONResponse) VisitGetStateStatusResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
 
-	return json.NewEncoder(w).Encode(response)
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStateTransactions(w, r)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type GetStateStatus500JSONResponse struct{ N500JSONResponse }
+// GetSubjectBinding operation middleware
+func (siw *ServerInterfaceWrapper) GetSubjectBinding(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response GetStateStatus500JSONResponse) VisitGetStateStatusResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
+	// ------------- Path parameter "externalID" -------------
+	var externalID string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "externalID", runtime.ParamLocationPath, chi.URLParam(r, "externalID"), &externalID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "externalID", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSubjectBinding(w, r, externalID)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type GetStateTransactionsRequestObject struct {
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
 }
 
-type GetStateTransactionsResponseObject interface {
-	VisitGetStateTransactionsResponse(w http.ResponseWriter) error
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
 }
 
-type GetStateTransactions200JSONResponse StateTransactionsResponse
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
 
-func (response GetStateTransactions200JSONResponse) VisitGetStateTransactionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+type UnmarshallingParamError struct {
+	ParamName string
+	Err       error
+}
 
-	return json.NewEncoder(w).Encode(response)
+func (e *UnmarshallingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshalling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
 }
 
-type GetStateTransactions500JSONResponse struct{ N500JSONResponse }
+func (e *UnmarshallingParamError) Unwrap() error {
+	return e.Err
+}
 
-func (response GetStateTransactions500JSONResponse) VisitGetStateTransactionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+type RequiredParamError struct {
+	ParamName string
+}
 
-	return json.NewEncoder(w).Encode(response)
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/", wrapper.GetDocumentation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/favicon.ico", wrapper.GetFavicon)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/static/docs/api_ui/api.yaml", wrapper.GetYaml)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/status", wrapper.Health)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/agent", wrapper.Agent)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/authentication/callback", wrapper.AuthCallback)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/authentication/qrcode", wrapper.AuthQRCode)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/changes", wrapper.GetChanges)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/connections", wrapper.GetConnections)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v1/connections/{id}", wrapper.DeleteConnection)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/connections/{id}", wrapper.GetConnection)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/v1/connections/{id}", wrapper.UpdateConnectionMetadata)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v1/connections/{id}/credentials", wrapper.DeleteConnectionCredentials)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/connections/{id}/credentials", wrapper.GetConnectionCredentials)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/connections/{id}/credentials/revoke", wrapper.RevokeConnectionCredentials)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/connections/{id}/problem-reports", wrapper.GetConnectionProblemReports)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/connections/{id}/restore", wrapper.RestoreConnection)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/credentials", wrapper.GetCredentials)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/credentials", wrapper.CreateCredential)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/credentials/from-template/{templateId}", wrapper.CreateCredentialFromTemplate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/credentials/imports", wrapper.ImportCredentials)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/credentials/imports/{id}", wrapper.GetImportJob)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/credentials/imports/{id}/report", wrapper.GetImportReport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/credentials/links", wrapper.GetLinks)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/credentials/links", wrapper.CreateLink)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/credentials/links/callback", wrapper.CreateLinkQrCodeCallback)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/credentials/links/funnel", wrapper.ExportLinksFunnel)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v1/credentials/links/{id}", wrapper.DeleteLink)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/credentials/links/{id}", wrapper.GetLink)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/v1/credentials/links/{id}", wrapper.AcivateLink)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/credentials/links/{id}/analytics", wrapper.GetLinkAnalytics)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/credentials/links/{id}/codes", wrapper.GetLinkCodes)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/credentials/links/{id}/codes", wrapper.CreateLinkCodes)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/credentials/links/{id}/funnel", wrapper.GetLinkFunnel)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/credentials/links/{id}/qrcode", wrapper.GetLinkQRCode)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/credentials/links/{id}/qrcode", wrapper.CreateLinkQrCode)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/credentials/nonce/{nonce}", wrapper.GetCredentialByNonce)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/credentials/revocation/status/{nonce}", wrapper.GetRevocationStatus)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/credentials/revoke/{nonce}", wrapper.RevokeCredential)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/credentials/revoked", wrapper.GetRevocationList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/credentials/templates", wrapper.GetCredentialTemplates)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/credentials/templates", wrapper.CreateCredentialTemplate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v1/credentials/templates/{id}", wrapper.DeleteCredentialTemplate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/credentials/templates/{id}", wrapper.GetCredentialTemplate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v1/credentials/{id}", wrapper.DeleteCredential)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/credentials/{id}", wrapper.GetCredential)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/credentials/{id}/history", wrapper.GetCredentialHistory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/credentials/{id}/jwt", wrapper.GetCredentialJWT)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/credentials/{id}/offer", wrapper.RenewCredentialOffer)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/credentials/{id}/offer-emails", wrapper.GetCredentialOfferEmails)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/credentials/{id}/problem-reports", wrapper.GetCredentialProblemReports)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/credentials/{id}/qrcode", wrapper.GetCredentialQrCode)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/credentials/{id}/restore", wrapper.RestoreCredential)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/credentials/{id}/send-offer", wrapper.SendCredentialOffer)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/credentials/{id}/wallet-links", wrapper.GetCredentialWalletLinks)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/issuer-profile", wrapper.GetIssuerProfile)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/v1/issuer-profile", wrapper.UpdateIssuerProfile)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/jobs", wrapper.GetJobs)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/jobs/{id}", wrapper.GetJob)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/jobs/{id}/cancel", wrapper.CancelJob)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/jobs/{id}/retry", wrapper.RetryJob)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/messages", wrapper.GetAgentMessages)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/messages/{id}/envelope", wrapper.GetAgentMessageEnvelope)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/offer-emails/{id}/claim", wrapper.ClaimOfferEmail)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/offer-emails/{id}/open", wrapper.OpenOfferEmail)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/schemas", wrapper.GetSchemas)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/schemas", wrapper.ImportSchema)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/schemas/build", wrapper.BuildSchema)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/schemas/{id}", wrapper.GetSchema)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/v1/schemas/{id}", wrapper.UpdateSchema)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/schemas/{id}/context.jsonld", wrapper.GetSchemaContext)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/schemas/{id}/schema.json", wrapper.GetSchemaJSON)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/state/publish", wrapper.PublishState)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/state/retry", wrapper.RetryPublishState)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/state/status", wrapper.GetStateStatus)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/state/transactions", wrapper.GetStateTransactions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/subjects/{externalID}", wrapper.GetSubjectBinding)
+	})
+
+	return r
+}
+
+type N400JSONResponse GenericErrorMessage
+
+type N401JSONResponse GenericErrorMessage
+
+type N403JSONResponse GenericErrorMessage
+
+type N404JSONResponse GenericErrorMessage
+
+type N409JSONResponse GenericErrorMessage
+
+type N412JSONResponse GenericErrorMessage
+
+type N422JSONResponse GenericErrorMessage
+
+type N429JSONResponse GenericErrorMessage
+
+type N500JSONResponse GenericErrorMessage
+
+type GetDocumentationRequestObject struct {
+}
+
+type GetDocumentationResponseObject interface {
+	VisitGetDocumentationResponse(w http.ResponseWriter) error
+}
+
+type GetDocumentation200Response struct {
+}
+
+func (response GetDocumentation200Response) VisitGetDocumentationResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetFaviconRequestObject struct {
+}
+
+type GetFaviconResponseObject interface {
+	VisitGetFaviconResponse(w http.ResponseWriter) error
+}
+
+type GetFavicon200Response struct {
+}
+
+func (response GetFavicon200Response) VisitGetFaviconResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetYamlRequestObject struct {
+}
+
+type GetYamlResponseObject interface {
+	VisitGetYamlResponse(w http.ResponseWriter) error
+}
+
+type GetYaml200Response struct {
+}
+
+func (response GetYaml200Response) VisitGetYamlResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type HealthRequestObject struct {
+}
+
+type HealthResponseObject interface {
+	VisitHealthResponse(w http.ResponseWriter) error
+}
+
+type Health200JSONResponse Health
+
+func (response Health200JSONResponse) VisitHealthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Health500JSONResponse struct{ N500JSONResponse }
+
+func (response Health500JSONResponse) VisitHealthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Health503JSONResponse Health
+
+func (response Health503JSONResponse) VisitHealthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AgentRequestObject struct {
+	Body *AgentTextRequestBody
+}
+
+type AgentResponseObject interface {
+	VisitAgentResponse(w http.ResponseWriter) error
+}
+
+type Agent200JSONResponse AgentResponse
+
+func (response Agent200JSONResponse) VisitAgentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Agent202Response struct {
+}
+
+func (response Agent202Response) VisitAgentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(202)
+	return nil
+}
+
+type Agent400JSONResponse AgentProblemReport
+
+func (response Agent400JSONResponse) VisitAgentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Agent401JSONResponse AgentProblemReport
+
+func (response Agent401JSONResponse) VisitAgentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Agent409JSONResponse AgentProblemReport
+
+func (response Agent409JSONResponse) VisitAgentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Agent410JSONResponse AgentProblemReport
+
+func (response Agent410JSONResponse) VisitAgentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(410)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Agent500JSONResponse struct{ N500JSONResponse }
+
+func (response Agent500JSONResponse) VisitAgentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AuthCallbackRequestObject struct {
+	Params AuthCallbackParams
+	Body   *AuthCallbackTextRequestBody
+}
+
+type AuthCallbackResponseObject interface {
+	VisitAuthCallbackResponse(w http.ResponseWriter) error
+}
+
+type AuthCallback200Response struct {
+}
+
+func (response AuthCallback200Response) VisitAuthCallbackResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type AuthCallback400JSONResponse struct{ N400JSONResponse }
+
+func (response AuthCallback400JSONResponse) VisitAuthCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AuthCallback409JSONResponse struct{ N409JSONResponse }
+
+func (response AuthCallback409JSONResponse) VisitAuthCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AuthCallback500JSONResponse struct{ N500JSONResponse }
+
+func (response AuthCallback500JSONResponse) VisitAuthCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AuthQRCodeRequestObject struct {
+}
+
+type AuthQRCodeResponseObject interface {
+	VisitAuthQRCodeResponse(w http.ResponseWriter) error
+}
+
+type AuthQRCode200JSONResponse AuthenticationQrCodeResponse
+
+func (response AuthQRCode200JSONResponse) VisitAuthQRCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AuthQRCode500JSONResponse struct{ N500JSONResponse }
+
+func (response AuthQRCode500JSONResponse) VisitAuthQRCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetChangesRequestObject struct {
+	Params GetChangesParams
+}
+
+type GetChangesResponseObject interface {
+	VisitGetChangesResponse(w http.ResponseWriter) error
+}
+
+type GetChanges200JSONResponse GetChangesResponse
+
+func (response GetChanges200JSONResponse) VisitGetChangesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetChanges400JSONResponse struct{ N400JSONResponse }
+
+func (response GetChanges400JSONResponse) VisitGetChangesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetChanges500JSONResponse struct{ N500JSONResponse }
+
+func (response GetChanges500JSONResponse) VisitGetChangesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetConnectionsRequestObject struct {
+	Params GetConnectionsParams
+}
+
+type GetConnectionsResponseObject interface {
+	VisitGetConnectionsResponse(w http.ResponseWriter) error
+}
+
+type GetConnections200JSONResponse GetConnectionsResponse
+
+func (response GetConnections200JSONResponse) VisitGetConnectionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetConnections400JSONResponse struct{ N400JSONResponse }
+
+func (response GetConnections400JSONResponse) VisitGetConnectionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetConnections500JSONResponse struct{ N500JSONResponse }
+
+func (response GetConnections500JSONResponse) VisitGetConnectionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteConnectionRequestObject struct {
+	Id     Id `json:"id"`
+	Params DeleteConnectionParams
+}
+
+type DeleteConnectionResponseObject interface {
+	VisitDeleteConnectionResponse(w http.ResponseWriter) error
+}
+
+type DeleteConnection200JSONResponse GenericMessage
+
+func (response DeleteConnection200JSONResponse) VisitDeleteConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteConnection400JSONResponse struct{ N400JSONResponse }
+
+func (response DeleteConnection400JSONResponse) VisitDeleteConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteConnection500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteConnection500JSONResponse) VisitDeleteConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetConnectionRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type GetConnectionResponseObject interface {
+	VisitGetConnectionResponse(w http.ResponseWriter) error
+}
+
+type GetConnection200ResponseHeaders struct {
+	ETag string
+}
+
+type GetConnection200JSONResponse struct {
+	Body    GetConnectionResponse
+	Headers GetConnection200ResponseHeaders
+}
+
+func (response GetConnection200JSONResponse) VisitGetConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetConnection400JSONResponse struct{ N400JSONResponse }
+
+func (response GetConnection400JSONResponse) VisitGetConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetConnection500JSONResponse struct{ N500JSONResponse }
+
+func (response GetConnection500JSONResponse) VisitGetConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateConnectionMetadataRequestObject struct {
+	Id     Id `json:"id"`
+	Params UpdateConnectionMetadataParams
+	Body   *UpdateConnectionMetadataJSONRequestBody
+}
+
+type UpdateConnectionMetadataResponseObject interface {
+	VisitUpdateConnectionMetadataResponse(w http.ResponseWriter) error
+}
+
+type UpdateConnectionMetadata200ResponseHeaders struct {
+	ETag string
+}
+
+type UpdateConnectionMetadata200JSONResponse struct {
+	Body    ConnectionMetadata
+	Headers UpdateConnectionMetadata200ResponseHeaders
+}
+
+func (response UpdateConnectionMetadata200JSONResponse) VisitUpdateConnectionMetadataResponse(w http.ResponseWriter) error {
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateConnectionMetadata400JSONResponse struct{ N400JSONResponse }
+
+func (response UpdateConnectionMetadata400JSONResponse) VisitUpdateConnectionMetadataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateConnectionMetadata404JSONResponse struct{ N404JSONResponse }
+
+func (response UpdateConnectionMetadata404JSONResponse) VisitUpdateConnectionMetadataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateConnectionMetadata412JSONResponse struct{ N412JSONResponse }
+
+func (response UpdateConnectionMetadata412JSONResponse) VisitUpdateConnectionMetadataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateConnectionMetadata500JSONResponse struct{ N500JSONResponse }
+
+func (response UpdateConnectionMetadata500JSONResponse) VisitUpdateConnectionMetadataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteConnectionCredentialsRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type DeleteConnectionCredentialsResponseObject interface {
+	VisitDeleteConnectionCredentialsResponse(w http.ResponseWriter) error
+}
+
+type DeleteConnectionCredentials200JSONResponse GenericMessage
+
+func (response DeleteConnectionCredentials200JSONResponse) VisitDeleteConnectionCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteConnectionCredentials500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteConnectionCredentials500JSONResponse) VisitDeleteConnectionCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetConnectionCredentialsRequestObject struct {
+	Id     Id `json:"id"`
+	Params GetConnectionCredentialsParams
+}
+
+type GetConnectionCredentialsResponseObject interface {
+	VisitGetConnectionCredentialsResponse(w http.ResponseWriter) error
+}
+
+type GetConnectionCredentials200JSONResponse GetConnectionCredentialsResponse
+
+func (response GetConnectionCredentials200JSONResponse) VisitGetConnectionCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetConnectionCredentials400JSONResponse struct{ N400JSONResponse }
+
+func (response GetConnectionCredentials400JSONResponse) VisitGetConnectionCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetConnectionCredentials404JSONResponse struct{ N404JSONResponse }
+
+func (response GetConnectionCredentials404JSONResponse) VisitGetConnectionCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetConnectionCredentials500JSONResponse struct{ N500JSONResponse }
+
+func (response GetConnectionCredentials500JSONResponse) VisitGetConnectionCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeConnectionCredentialsRequestObject struct {
+	Id     Id `json:"id"`
+	Params RevokeConnectionCredentialsParams
+}
+
+type RevokeConnectionCredentialsResponseObject interface {
+	VisitRevokeConnectionCredentialsResponse(w http.ResponseWriter) error
+}
+
+type RevokeConnectionCredentials202JSONResponse GenericMessage
+
+func (response RevokeConnectionCredentials202JSONResponse) VisitRevokeConnectionCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeConnectionCredentials400JSONResponse struct{ N400JSONResponse }
+
+func (response RevokeConnectionCredentials400JSONResponse) VisitRevokeConnectionCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeConnectionCredentials409JSONResponse struct{ N409JSONResponse }
+
+func (response RevokeConnectionCredentials409JSONResponse) VisitRevokeConnectionCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeConnectionCredentials422JSONResponse struct{ N422JSONResponse }
+
+func (response RevokeConnectionCredentials422JSONResponse) VisitRevokeConnectionCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeConnectionCredentials500JSONResponse struct{ N500JSONResponse }
+
+func (response RevokeConnectionCredentials500JSONResponse) VisitRevokeConnectionCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetConnectionProblemReportsRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type GetConnectionProblemReportsResponseObject interface {
+	VisitGetConnectionProblemReportsResponse(w http.ResponseWriter) error
+}
+
+type GetConnectionProblemReports200JSONResponse []ProblemReport
+
+func (response GetConnectionProblemReports200JSONResponse) VisitGetConnectionProblemReportsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetConnectionProblemReports401JSONResponse struct{ N401JSONResponse }
+
+func (response GetConnectionProblemReports401JSONResponse) VisitGetConnectionProblemReportsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetConnectionProblemReports404JSONResponse struct{ N404JSONResponse }
+
+func (response GetConnectionProblemReports404JSONResponse) VisitGetConnectionProblemReportsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetConnectionProblemReports500JSONResponse struct{ N500JSONResponse }
+
+func (response GetConnectionProblemReports500JSONResponse) VisitGetConnectionProblemReportsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreConnectionRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type RestoreConnectionResponseObject interface {
+	VisitRestoreConnectionResponse(w http.ResponseWriter) error
+}
+
+type RestoreConnection200JSONResponse GenericMessage
+
+func (response RestoreConnection200JSONResponse) VisitRestoreConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreConnection401JSONResponse struct{ N401JSONResponse }
+
+func (response RestoreConnection401JSONResponse) VisitRestoreConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreConnection404JSONResponse struct{ N404JSONResponse }
+
+func (response RestoreConnection404JSONResponse) VisitRestoreConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreConnection500JSONResponse struct{ N500JSONResponse }
+
+func (response RestoreConnection500JSONResponse) VisitRestoreConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialsRequestObject struct {
+	Params GetCredentialsParams
+}
+
+type GetCredentialsResponseObject interface {
+	VisitGetCredentialsResponse(w http.ResponseWriter) error
+}
+
+type GetCredentials200JSONResponse []Credential
+
+func (response GetCredentials200JSONResponse) VisitGetCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentials400JSONResponse struct{ N400JSONResponse }
+
+func (response GetCredentials400JSONResponse) VisitGetCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentials404JSONResponse struct{ N404JSONResponse }
+
+func (response GetCredentials404JSONResponse) VisitGetCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentials500JSONResponse struct{ N500JSONResponse }
+
+func (response GetCredentials500JSONResponse) VisitGetCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCredentialRequestObject struct {
+	Params CreateCredentialParams
+	Body   *CreateCredentialJSONRequestBody
+}
+
+type CreateCredentialResponseObject interface {
+	VisitCreateCredentialResponse(w http.ResponseWriter) error
+}
+
+type CreateCredential201JSONResponse UUIDResponse
+
+func (response CreateCredential201JSONResponse) VisitCreateCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCredential400JSONResponse CredentialErrorMessage
+
+func (response CreateCredential400JSONResponse) VisitCreateCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCredential401JSONResponse struct{ N401JSONResponse }
+
+func (response CreateCredential401JSONResponse) VisitCreateCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCredential409JSONResponse struct{ N409JSONResponse }
+
+func (response CreateCredential409JSONResponse) VisitCreateCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCredential422JSONResponse struct{ N422JSONResponse }
+
+func (response CreateCredential422JSONResponse) VisitCreateCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCredential500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateCredential500JSONResponse) VisitCreateCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCredentialFromTemplateRequestObject struct {
+	TemplateId uuid.UUID `json:"templateId"`
+	Body       *CreateCredentialFromTemplateJSONRequestBody
+}
+
+type CreateCredentialFromTemplateResponseObject interface {
+	VisitCreateCredentialFromTemplateResponse(w http.ResponseWriter) error
+}
+
+type CreateCredentialFromTemplate201JSONResponse UUIDResponse
+
+func (response CreateCredentialFromTemplate201JSONResponse) VisitCreateCredentialFromTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCredentialFromTemplate400JSONResponse CredentialErrorMessage
+
+func (response CreateCredentialFromTemplate400JSONResponse) VisitCreateCredentialFromTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCredentialFromTemplate401JSONResponse struct{ N401JSONResponse }
+
+func (response CreateCredentialFromTemplate401JSONResponse) VisitCreateCredentialFromTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCredentialFromTemplate404JSONResponse struct{ N404JSONResponse }
+
+func (response CreateCredentialFromTemplate404JSONResponse) VisitCreateCredentialFromTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCredentialFromTemplate422JSONResponse struct{ N422JSONResponse }
+
+func (response CreateCredentialFromTemplate422JSONResponse) VisitCreateCredentialFromTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCredentialFromTemplate500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateCredentialFromTemplate500JSONResponse) VisitCreateCredentialFromTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportCredentialsRequestObject struct {
+	Body *multipart.Reader
+}
+
+type ImportCredentialsResponseObject interface {
+	VisitImportCredentialsResponse(w http.ResponseWriter) error
+}
+
+type ImportCredentials202JSONResponse ImportJob
+
+func (response ImportCredentials202JSONResponse) VisitImportCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportCredentials400JSONResponse struct{ N400JSONResponse }
+
+func (response ImportCredentials400JSONResponse) VisitImportCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportCredentials401JSONResponse struct{ N401JSONResponse }
+
+func (response ImportCredentials401JSONResponse) VisitImportCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportCredentials404JSONResponse struct{ N404JSONResponse }
+
+func (response ImportCredentials404JSONResponse) VisitImportCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportCredentials500JSONResponse struct{ N500JSONResponse }
+
+func (response ImportCredentials500JSONResponse) VisitImportCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetImportJobRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type GetImportJobResponseObject interface {
+	VisitGetImportJobResponse(w http.ResponseWriter) error
+}
+
+type GetImportJob200JSONResponse ImportJob
+
+func (response GetImportJob200JSONResponse) VisitGetImportJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetImportJob401JSONResponse struct{ N401JSONResponse }
+
+func (response GetImportJob401JSONResponse) VisitGetImportJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetImportJob404JSONResponse struct{ N404JSONResponse }
+
+func (response GetImportJob404JSONResponse) VisitGetImportJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetImportJob500JSONResponse struct{ N500JSONResponse }
+
+func (response GetImportJob500JSONResponse) VisitGetImportJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetImportReportRequestObject struct {
+	Id     Id `json:"id"`
+	Params GetImportReportParams
+}
+
+type GetImportReportResponseObject interface {
+	VisitGetImportReportResponse(w http.ResponseWriter) error
+}
+
+type GetImportReport200TextcsvResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetImportReport200TextcsvResponse) VisitGetImportReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetImportReport401JSONResponse struct{ N401JSONResponse }
+
+func (response GetImportReport401JSONResponse) VisitGetImportReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetImportReport404JSONResponse struct{ N404JSONResponse }
+
+func (response GetImportReport404JSONResponse) VisitGetImportReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetImportReport500JSONResponse struct{ N500JSONResponse }
+
+func (response GetImportReport500JSONResponse) VisitGetImportReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLinksRequestObject struct {
+	Params GetLinksParams
+}
+
+type GetLinksResponseObject interface {
+	VisitGetLinksResponse(w http.ResponseWriter) error
+}
+
+type GetLinks200JSONResponse []Link
+
+func (response GetLinks200JSONResponse) VisitGetLinksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLinks400JSONResponse struct{ N400JSONResponse }
+
+func (response GetLinks400JSONResponse) VisitGetLinksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLinks404JSONResponse struct{ N404JSONResponse }
+
+func (response GetLinks404JSONResponse) VisitGetLinksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLinks500JSONResponse struct{ N500JSONResponse }
+
+func (response GetLinks500JSONResponse) VisitGetLinksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLinkRequestObject struct {
+	Body *CreateLinkJSONRequestBody
+}
+
+type CreateLinkResponseObject interface {
+	VisitCreateLinkResponse(w http.ResponseWriter) error
+}
+
+type CreateLink201JSONResponse UUIDResponse
+
+func (response CreateLink201JSONResponse) VisitCreateLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLink400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateLink400JSONResponse) VisitCreateLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLink500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateLink500JSONResponse) VisitCreateLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLinkQrCodeCallbackRequestObject struct {
+	Params CreateLinkQrCodeCallbackParams
+	Body   *CreateLinkQrCodeCallbackTextRequestBody
+}
+
+type CreateLinkQrCodeCallbackResponseObject interface {
+	VisitCreateLinkQrCodeCallbackResponse(w http.ResponseWriter) error
+}
+
+type CreateLinkQrCodeCallback200Response struct {
+}
+
+func (response CreateLinkQrCodeCallback200Response) VisitCreateLinkQrCodeCallbackResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type CreateLinkQrCodeCallback400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateLinkQrCodeCallback400JSONResponse) VisitCreateLinkQrCodeCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLinkQrCodeCallback403JSONResponse struct{ N403JSONResponse }
+
+func (response CreateLinkQrCodeCallback403JSONResponse) VisitCreateLinkQrCodeCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLinkQrCodeCallback409JSONResponse struct{ N409JSONResponse }
+
+func (response CreateLinkQrCodeCallback409JSONResponse) VisitCreateLinkQrCodeCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLinkQrCodeCallback500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateLinkQrCodeCallback500JSONResponse) VisitCreateLinkQrCodeCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportLinksFunnelRequestObject struct {
+	Params ExportLinksFunnelParams
+}
+
+type ExportLinksFunnelResponseObject interface {
+	VisitExportLinksFunnelResponse(w http.ResponseWriter) error
+}
+
+type ExportLinksFunnel200TextcsvResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response ExportLinksFunnel200TextcsvResponse) VisitExportLinksFunnelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ExportLinksFunnel401JSONResponse struct{ N401JSONResponse }
+
+func (response ExportLinksFunnel401JSONResponse) VisitExportLinksFunnelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportLinksFunnel500JSONResponse struct{ N500JSONResponse }
+
+func (response ExportLinksFunnel500JSONResponse) VisitExportLinksFunnelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteLinkRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type DeleteLinkResponseObject interface {
+	VisitDeleteLinkResponse(w http.ResponseWriter) error
+}
+
+type DeleteLink200JSONResponse GenericMessage
+
+func (response DeleteLink200JSONResponse) VisitDeleteLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteLink400JSONResponse struct{ N400JSONResponse }
+
+func (response DeleteLink400JSONResponse) VisitDeleteLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteLink500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteLink500JSONResponse) VisitDeleteLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLinkRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type GetLinkResponseObject interface {
+	VisitGetLinkResponse(w http.ResponseWriter) error
+}
+
+type GetLink200ResponseHeaders struct {
+	ETag string
+}
+
+type GetLink200JSONResponse struct {
+	Body    Link
+	Headers GetLink200ResponseHeaders
+}
+
+func (response GetLink200JSONResponse) VisitGetLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetLink404JSONResponse struct{ N404JSONResponse }
+
+func (response GetLink404JSONResponse) VisitGetLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLink500JSONResponse struct{ N500JSONResponse }
+
+func (response GetLink500JSONResponse) VisitGetLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcivateLinkRequestObject struct {
+	Id     Id `json:"id"`
+	Params AcivateLinkParams
+	Body   *AcivateLinkJSONRequestBody
+}
+
+type AcivateLinkResponseObject interface {
+	VisitAcivateLinkResponse(w http.ResponseWriter) error
+}
+
+type AcivateLink200ResponseHeaders struct {
+	ETag string
+}
+
+type AcivateLink200JSONResponse struct {
+	Body    GenericMessage
+	Headers AcivateLink200ResponseHeaders
+}
+
+func (response AcivateLink200JSONResponse) VisitAcivateLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type AcivateLink400JSONResponse struct{ N400JSONResponse }
+
+func (response AcivateLink400JSONResponse) VisitAcivateLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcivateLink412JSONResponse struct{ N412JSONResponse }
+
+func (response AcivateLink412JSONResponse) VisitAcivateLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcivateLink500JSONResponse struct{ N500JSONResponse }
+
+func (response AcivateLink500JSONResponse) VisitAcivateLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLinkAnalyticsRequestObject struct {
+	Id     Id `json:"id"`
+	Params GetLinkAnalyticsParams
+}
+
+type GetLinkAnalyticsResponseObject interface {
+	VisitGetLinkAnalyticsResponse(w http.ResponseWriter) error
+}
+
+type GetLinkAnalytics200JSONResponse LinkAnalytics
+
+func (response GetLinkAnalytics200JSONResponse) VisitGetLinkAnalyticsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLinkAnalytics400JSONResponse struct{ N400JSONResponse }
+
+func (response GetLinkAnalytics400JSONResponse) VisitGetLinkAnalyticsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLinkAnalytics401JSONResponse struct{ N401JSONResponse }
+
+func (response GetLinkAnalytics401JSONResponse) VisitGetLinkAnalyticsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLinkAnalytics404JSONResponse struct{ N404JSONResponse }
+
+func (response GetLinkAnalytics404JSONResponse) VisitGetLinkAnalyticsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLinkAnalytics500JSONResponse struct{ N500JSONResponse }
+
+func (response GetLinkAnalytics500JSONResponse) VisitGetLinkAnalyticsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLinkCodesRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type GetLinkCodesResponseObject interface {
+	VisitGetLinkCodesResponse(w http.ResponseWriter) error
+}
+
+type GetLinkCodes200JSONResponse []LinkCode
+
+func (response GetLinkCodes200JSONResponse) VisitGetLinkCodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLinkCodes401JSONResponse struct{ N401JSONResponse }
+
+func (response GetLinkCodes401JSONResponse) VisitGetLinkCodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLinkCodes404JSONResponse struct{ N404JSONResponse }
+
+func (response GetLinkCodes404JSONResponse) VisitGetLinkCodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLinkCodes500JSONResponse struct{ N500JSONResponse }
+
+func (response GetLinkCodes500JSONResponse) VisitGetLinkCodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLinkCodesRequestObject struct {
+	Id   Id `json:"id"`
+	Body *CreateLinkCodesJSONRequestBody
+}
+
+type CreateLinkCodesResponseObject interface {
+	VisitCreateLinkCodesResponse(w http.ResponseWriter) error
+}
+
+type CreateLinkCodes201JSONResponse struct {
+	Codes []string `json:"codes"`
+}
+
+func (response CreateLinkCodes201JSONResponse) VisitCreateLinkCodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLinkCodes400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateLinkCodes400JSONResponse) VisitCreateLinkCodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLinkCodes401JSONResponse struct{ N401JSONResponse }
+
+func (response CreateLinkCodes401JSONResponse) VisitCreateLinkCodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLinkCodes404JSONResponse struct{ N404JSONResponse }
+
+func (response CreateLinkCodes404JSONResponse) VisitCreateLinkCodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLinkCodes500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateLinkCodes500JSONResponse) VisitCreateLinkCodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLinkFunnelRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type GetLinkFunnelResponseObject interface {
+	VisitGetLinkFunnelResponse(w http.ResponseWriter) error
+}
+
+type GetLinkFunnel200JSONResponse LinkFunnel
+
+func (response GetLinkFunnel200JSONResponse) VisitGetLinkFunnelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLinkFunnel401JSONResponse struct{ N401JSONResponse }
+
+func (response GetLinkFunnel401JSONResponse) VisitGetLinkFunnelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLinkFunnel404JSONResponse struct{ N404JSONResponse }
+
+func (response GetLinkFunnel404JSONResponse) VisitGetLinkFunnelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLinkFunnel500JSONResponse struct{ N500JSONResponse }
+
+func (response GetLinkFunnel500JSONResponse) VisitGetLinkFunnelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLinkQRCodeRequestObject struct {
+	Id     Id `json:"id"`
+	Params GetLinkQRCodeParams
+}
+
+type GetLinkQRCodeResponseObject interface {
+	VisitGetLinkQRCodeResponse(w http.ResponseWriter) error
+}
+
+type GetLinkQRCode200JSONResponse GetLinkQrCodeResponse
+
+func (response GetLinkQRCode200JSONResponse) VisitGetLinkQRCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLinkQRCode400JSONResponse struct{ N400JSONResponse }
+
+func (response GetLinkQRCode400JSONResponse) VisitGetLinkQRCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLinkQRCode404JSONResponse GenericErrorMessage
+
+func (response GetLinkQRCode404JSONResponse) VisitGetLinkQRCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLinkQRCode500JSONResponse struct{ N500JSONResponse }
+
+func (response GetLinkQRCode500JSONResponse) VisitGetLinkQRCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLinkQrCodeRequestObject struct {
+	Id     Id `json:"id"`
+	Params CreateLinkQrCodeParams
+}
+
+type CreateLinkQrCodeResponseObject interface {
+	VisitCreateLinkQrCodeResponse(w http.ResponseWriter) error
+}
+
+type CreateLinkQrCode200JSONResponse CredentialLinkQrCodeResponse
+
+func (response CreateLinkQrCode200JSONResponse) VisitCreateLinkQrCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLinkQrCode400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateLinkQrCode400JSONResponse) VisitCreateLinkQrCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLinkQrCode403JSONResponse struct{ N403JSONResponse }
+
+func (response CreateLinkQrCode403JSONResponse) VisitCreateLinkQrCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLinkQrCode404JSONResponse struct{ N404JSONResponse }
+
+func (response CreateLinkQrCode404JSONResponse) VisitCreateLinkQrCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLinkQrCode429JSONResponse struct{ N429JSONResponse }
+
+func (response CreateLinkQrCode429JSONResponse) VisitCreateLinkQrCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLinkQrCode500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateLinkQrCode500JSONResponse) VisitCreateLinkQrCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialByNonceRequestObject struct {
+	Nonce PathNonce `json:"nonce"`
+}
+
+type GetCredentialByNonceResponseObject interface {
+	VisitGetCredentialByNonceResponse(w http.ResponseWriter) error
+}
+
+type GetCredentialByNonce200JSONResponse Credential
+
+func (response GetCredentialByNonce200JSONResponse) VisitGetCredentialByNonceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialByNonce400JSONResponse struct{ N400JSONResponse }
+
+func (response GetCredentialByNonce400JSONResponse) VisitGetCredentialByNonceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialByNonce401JSONResponse struct{ N401JSONResponse }
+
+func (response GetCredentialByNonce401JSONResponse) VisitGetCredentialByNonceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialByNonce404JSONResponse struct{ N404JSONResponse }
+
+func (response GetCredentialByNonce404JSONResponse) VisitGetCredentialByNonceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialByNonce500JSONResponse struct{ N500JSONResponse }
+
+func (response GetCredentialByNonce500JSONResponse) VisitGetCredentialByNonceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRevocationStatusRequestObject struct {
+	Nonce PathNonce `json:"nonce"`
+}
+
+type GetRevocationStatusResponseObject interface {
+	VisitGetRevocationStatusResponse(w http.ResponseWriter) error
+}
+
+type GetRevocationStatus200JSONResponse RevocationStatusResponse
+
+func (response GetRevocationStatus200JSONResponse) VisitGetRevocationStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRevocationStatus400JSONResponse struct{ N400JSONResponse }
+
+func (response GetRevocationStatus400JSONResponse) VisitGetRevocationStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRevocationStatus500JSONResponse struct{ N500JSONResponse }
+
+func (response GetRevocationStatus500JSONResponse) VisitGetRevocationStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeCredentialRequestObject struct {
+	Nonce  PathNonce `json:"nonce"`
+	Params RevokeCredentialParams
+}
+
+type RevokeCredentialResponseObject interface {
+	VisitRevokeCredentialResponse(w http.ResponseWriter) error
+}
+
+type RevokeCredential202JSONResponse RevokeCredentialResponse
+
+func (response RevokeCredential202JSONResponse) VisitRevokeCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeCredential400JSONResponse struct{ N400JSONResponse }
+
+func (response RevokeCredential400JSONResponse) VisitRevokeCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeCredential401JSONResponse struct{ N401JSONResponse }
+
+func (response RevokeCredential401JSONResponse) VisitRevokeCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeCredential404JSONResponse struct{ N404JSONResponse }
+
+func (response RevokeCredential404JSONResponse) VisitRevokeCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeCredential409JSONResponse struct{ N409JSONResponse }
+
+func (response RevokeCredential409JSONResponse) VisitRevokeCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeCredential422JSONResponse struct{ N422JSONResponse }
+
+func (response RevokeCredential422JSONResponse) VisitRevokeCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeCredential500JSONResponse struct{ N500JSONResponse }
+
+func (response RevokeCredential500JSONResponse) VisitRevokeCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRevocationListRequestObject struct {
+	Params GetRevocationListParams
+}
+
+type GetRevocationListResponseObject interface {
+	VisitGetRevocationListResponse(w http.ResponseWriter) error
+}
+
+type GetRevocationList200JSONResponse RevocationList
+
+func (response GetRevocationList200JSONResponse) VisitGetRevocationListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRevocationList400JSONResponse struct{ N400JSONResponse }
+
+func (response GetRevocationList400JSONResponse) VisitGetRevocationListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRevocationList422JSONResponse struct{ N422JSONResponse }
+
+func (response GetRevocationList422JSONResponse) VisitGetRevocationListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRevocationList500JSONResponse struct{ N500JSONResponse }
+
+func (response GetRevocationList500JSONResponse) VisitGetRevocationListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialTemplatesRequestObject struct {
+}
+
+type GetCredentialTemplatesResponseObject interface {
+	VisitGetCredentialTemplatesResponse(w http.ResponseWriter) error
+}
+
+type GetCredentialTemplates200JSONResponse []CredentialTemplate
+
+func (response GetCredentialTemplates200JSONResponse) VisitGetCredentialTemplatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialTemplates401JSONResponse struct{ N401JSONResponse }
+
+func (response GetCredentialTemplates401JSONResponse) VisitGetCredentialTemplatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialTemplates500JSONResponse struct{ N500JSONResponse }
+
+func (response GetCredentialTemplates500JSONResponse) VisitGetCredentialTemplatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCredentialTemplateRequestObject struct {
+	Body *CreateCredentialTemplateJSONRequestBody
+}
+
+type CreateCredentialTemplateResponseObject interface {
+	VisitCreateCredentialTemplateResponse(w http.ResponseWriter) error
+}
+
+type CreateCredentialTemplate201JSONResponse CredentialTemplate
+
+func (response CreateCredentialTemplate201JSONResponse) VisitCreateCredentialTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCredentialTemplate400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateCredentialTemplate400JSONResponse) VisitCreateCredentialTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCredentialTemplate401JSONResponse struct{ N401JSONResponse }
+
+func (response CreateCredentialTemplate401JSONResponse) VisitCreateCredentialTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCredentialTemplate404JSONResponse struct{ N404JSONResponse }
+
+func (response CreateCredentialTemplate404JSONResponse) VisitCreateCredentialTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCredentialTemplate409JSONResponse struct{ N409JSONResponse }
+
+func (response CreateCredentialTemplate409JSONResponse) VisitCreateCredentialTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCredentialTemplate500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateCredentialTemplate500JSONResponse) VisitCreateCredentialTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCredentialTemplateRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type DeleteCredentialTemplateResponseObject interface {
+	VisitDeleteCredentialTemplateResponse(w http.ResponseWriter) error
+}
+
+type DeleteCredentialTemplate200JSONResponse GenericMessage
+
+func (response DeleteCredentialTemplate200JSONResponse) VisitDeleteCredentialTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCredentialTemplate401JSONResponse struct{ N401JSONResponse }
+
+func (response DeleteCredentialTemplate401JSONResponse) VisitDeleteCredentialTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCredentialTemplate404JSONResponse struct{ N404JSONResponse }
+
+func (response DeleteCredentialTemplate404JSONResponse) VisitDeleteCredentialTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCredentialTemplate500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteCredentialTemplate500JSONResponse) VisitDeleteCredentialTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialTemplateRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type GetCredentialTemplateResponseObject interface {
+	VisitGetCredentialTemplateResponse(w http.ResponseWriter) error
+}
+
+type GetCredentialTemplate200JSONResponse CredentialTemplate
+
+func (response GetCredentialTemplate200JSONResponse) VisitGetCredentialTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialTemplate401JSONResponse struct{ N401JSONResponse }
+
+func (response GetCredentialTemplate401JSONResponse) VisitGetCredentialTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialTemplate404JSONResponse struct{ N404JSONResponse }
+
+func (response GetCredentialTemplate404JSONResponse) VisitGetCredentialTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialTemplate500JSONResponse struct{ N500JSONResponse }
+
+func (response GetCredentialTemplate500JSONResponse) VisitGetCredentialTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCredentialRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type DeleteCredentialResponseObject interface {
+	VisitDeleteCredentialResponse(w http.ResponseWriter) error
+}
+
+type DeleteCredential200JSONResponse GenericMessage
+
+func (response DeleteCredential200JSONResponse) VisitDeleteCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCredential400JSONResponse struct{ N400JSONResponse }
+
+func (response DeleteCredential400JSONResponse) VisitDeleteCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCredential401JSONResponse struct{ N401JSONResponse }
+
+func (response DeleteCredential401JSONResponse) VisitDeleteCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCredential500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteCredential500JSONResponse) VisitDeleteCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type GetCredentialResponseObject interface {
+	VisitGetCredentialResponse(w http.ResponseWriter) error
+}
+
+type GetCredential200JSONResponse Credential
+
+func (response GetCredential200JSONResponse) VisitGetCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredential400JSONResponse struct{ N400JSONResponse }
+
+func (response GetCredential400JSONResponse) VisitGetCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredential500JSONResponse struct{ N500JSONResponse }
+
+func (response GetCredential500JSONResponse) VisitGetCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialHistoryRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type GetCredentialHistoryResponseObject interface {
+	VisitGetCredentialHistoryResponse(w http.ResponseWriter) error
+}
+
+type GetCredentialHistory200JSONResponse []CredentialEvent
+
+func (response GetCredentialHistory200JSONResponse) VisitGetCredentialHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialHistory400JSONResponse struct{ N400JSONResponse }
+
+func (response GetCredentialHistory400JSONResponse) VisitGetCredentialHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialHistory401JSONResponse struct{ N401JSONResponse }
+
+func (response GetCredentialHistory401JSONResponse) VisitGetCredentialHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialHistory404JSONResponse struct{ N404JSONResponse }
+
+func (response GetCredentialHistory404JSONResponse) VisitGetCredentialHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialHistory500JSONResponse struct{ N500JSONResponse }
+
+func (response GetCredentialHistory500JSONResponse) VisitGetCredentialHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialJWTRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type GetCredentialJWTResponseObject interface {
+	VisitGetCredentialJWTResponse(w http.ResponseWriter) error
+}
+
+type GetCredentialJWT200JSONResponse CredentialJWT
+
+func (response GetCredentialJWT200JSONResponse) VisitGetCredentialJWTResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialJWT400JSONResponse struct{ N400JSONResponse }
+
+func (response GetCredentialJWT400JSONResponse) VisitGetCredentialJWTResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialJWT401JSONResponse struct{ N401JSONResponse }
+
+func (response GetCredentialJWT401JSONResponse) VisitGetCredentialJWTResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialJWT404JSONResponse struct{ N404JSONResponse }
+
+func (response GetCredentialJWT404JSONResponse) VisitGetCredentialJWTResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialJWT500JSONResponse struct{ N500JSONResponse }
+
+func (response GetCredentialJWT500JSONResponse) VisitGetCredentialJWTResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RenewCredentialOfferRequestObject struct {
+	Id   Id `json:"id"`
+	Body *RenewCredentialOfferJSONRequestBody
+}
+
+type RenewCredentialOfferResponseObject interface {
+	VisitRenewCredentialOfferResponse(w http.ResponseWriter) error
+}
+
+type RenewCredentialOffer201JSONResponse QrCodeResponse
+
+func (response RenewCredentialOffer201JSONResponse) VisitRenewCredentialOfferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RenewCredentialOffer400JSONResponse struct{ N400JSONResponse }
+
+func (response RenewCredentialOffer400JSONResponse) VisitRenewCredentialOfferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RenewCredentialOffer401JSONResponse struct{ N401JSONResponse }
+
+func (response RenewCredentialOffer401JSONResponse) VisitRenewCredentialOfferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RenewCredentialOffer404JSONResponse struct{ N404JSONResponse }
+
+func (response RenewCredentialOffer404JSONResponse) VisitRenewCredentialOfferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RenewCredentialOffer500JSONResponse struct{ N500JSONResponse }
+
+func (response RenewCredentialOffer500JSONResponse) VisitRenewCredentialOfferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialOfferEmailsRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type GetCredentialOfferEmailsResponseObject interface {
+	VisitGetCredentialOfferEmailsResponse(w http.ResponseWriter) error
+}
+
+type GetCredentialOfferEmails200JSONResponse []OfferEmail
+
+func (response GetCredentialOfferEmails200JSONResponse) VisitGetCredentialOfferEmailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialOfferEmails401JSONResponse struct{ N401JSONResponse }
+
+func (response GetCredentialOfferEmails401JSONResponse) VisitGetCredentialOfferEmailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialOfferEmails404JSONResponse struct{ N404JSONResponse }
+
+func (response GetCredentialOfferEmails404JSONResponse) VisitGetCredentialOfferEmailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialOfferEmails500JSONResponse struct{ N500JSONResponse }
+
+func (response GetCredentialOfferEmails500JSONResponse) VisitGetCredentialOfferEmailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialProblemReportsRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type GetCredentialProblemReportsResponseObject interface {
+	VisitGetCredentialProblemReportsResponse(w http.ResponseWriter) error
+}
+
+type GetCredentialProblemReports200JSONResponse []ProblemReport
+
+func (response GetCredentialProblemReports200JSONResponse) VisitGetCredentialProblemReportsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialProblemReports401JSONResponse struct{ N401JSONResponse }
+
+func (response GetCredentialProblemReports401JSONResponse) VisitGetCredentialProblemReportsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialProblemReports404JSONResponse struct{ N404JSONResponse }
+
+func (response GetCredentialProblemReports404JSONResponse) VisitGetCredentialProblemReportsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialProblemReports500JSONResponse struct{ N500JSONResponse }
+
+func (response GetCredentialProblemReports500JSONResponse) VisitGetCredentialProblemReportsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialQrCodeRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type GetCredentialQrCodeResponseObject interface {
+	VisitGetCredentialQrCodeResponse(w http.ResponseWriter) error
+}
+
+type GetCredentialQrCode200JSONResponse QrCodeResponse
+
+func (response GetCredentialQrCode200JSONResponse) VisitGetCredentialQrCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialQrCode400JSONResponse struct{ N400JSONResponse }
+
+func (response GetCredentialQrCode400JSONResponse) VisitGetCredentialQrCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialQrCode404JSONResponse struct{ N404JSONResponse }
+
+func (response GetCredentialQrCode404JSONResponse) VisitGetCredentialQrCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialQrCode500JSONResponse struct{ N500JSONResponse }
+
+func (response GetCredentialQrCode500JSONResponse) VisitGetCredentialQrCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreCredentialRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type RestoreCredentialResponseObject interface {
+	VisitRestoreCredentialResponse(w http.ResponseWriter) error
+}
+
+type RestoreCredential200JSONResponse GenericMessage
+
+func (response RestoreCredential200JSONResponse) VisitRestoreCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreCredential401JSONResponse struct{ N401JSONResponse }
+
+func (response RestoreCredential401JSONResponse) VisitRestoreCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreCredential404JSONResponse struct{ N404JSONResponse }
+
+func (response RestoreCredential404JSONResponse) VisitRestoreCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreCredential500JSONResponse struct{ N500JSONResponse }
+
+func (response RestoreCredential500JSONResponse) VisitRestoreCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SendCredentialOfferRequestObject struct {
+	Id   Id `json:"id"`
+	Body *SendCredentialOfferJSONRequestBody
+}
+
+type SendCredentialOfferResponseObject interface {
+	VisitSendCredentialOfferResponse(w http.ResponseWriter) error
+}
+
+type SendCredentialOffer201JSONResponse OfferEmail
+
+func (response SendCredentialOffer201JSONResponse) VisitSendCredentialOfferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SendCredentialOffer400JSONResponse struct{ N400JSONResponse }
+
+func (response SendCredentialOffer400JSONResponse) VisitSendCredentialOfferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SendCredentialOffer401JSONResponse struct{ N401JSONResponse }
+
+func (response SendCredentialOffer401JSONResponse) VisitSendCredentialOfferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SendCredentialOffer404JSONResponse struct{ N404JSONResponse }
+
+func (response SendCredentialOffer404JSONResponse) VisitSendCredentialOfferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SendCredentialOffer429JSONResponse struct{ N429JSONResponse }
+
+func (response SendCredentialOffer429JSONResponse) VisitSendCredentialOfferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SendCredentialOffer500JSONResponse struct{ N500JSONResponse }
+
+func (response SendCredentialOffer500JSONResponse) VisitSendCredentialOfferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialWalletLinksRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type GetCredentialWalletLinksResponseObject interface {
+	VisitGetCredentialWalletLinksResponse(w http.ResponseWriter) error
+}
+
+type GetCredentialWalletLinks200JSONResponse WalletLinks
+
+func (response GetCredentialWalletLinks200JSONResponse) VisitGetCredentialWalletLinksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialWalletLinks400JSONResponse struct{ N400JSONResponse }
+
+func (response GetCredentialWalletLinks400JSONResponse) VisitGetCredentialWalletLinksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialWalletLinks404JSONResponse struct{ N404JSONResponse }
+
+func (response GetCredentialWalletLinks404JSONResponse) VisitGetCredentialWalletLinksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialWalletLinks500JSONResponse struct{ N500JSONResponse }
+
+func (response GetCredentialWalletLinks500JSONResponse) VisitGetCredentialWalletLinksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetIssuerProfileRequestObject struct {
+}
+
+type GetIssuerProfileResponseObject interface {
+	VisitGetIssuerProfileResponse(w http.ResponseWriter) error
+}
+
+type GetIssuerProfile200JSONResponse IssuerProfile
+
+func (response GetIssuerProfile200JSONResponse) VisitGetIssuerProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetIssuerProfile500JSONResponse struct{ N500JSONResponse }
+
+func (response GetIssuerProfile500JSONResponse) VisitGetIssuerProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateIssuerProfileRequestObject struct {
+	Body *UpdateIssuerProfileJSONRequestBody
+}
+
+type UpdateIssuerProfileResponseObject interface {
+	VisitUpdateIssuerProfileResponse(w http.ResponseWriter) error
+}
+
+type UpdateIssuerProfile200JSONResponse IssuerProfile
+
+func (response UpdateIssuerProfile200JSONResponse) VisitUpdateIssuerProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateIssuerProfile400JSONResponse struct{ N400JSONResponse }
+
+func (response UpdateIssuerProfile400JSONResponse) VisitUpdateIssuerProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateIssuerProfile500JSONResponse struct{ N500JSONResponse }
+
+func (response UpdateIssuerProfile500JSONResponse) VisitUpdateIssuerProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetJobsRequestObject struct {
+	Params GetJobsParams
+}
+
+type GetJobsResponseObject interface {
+	VisitGetJobsResponse(w http.ResponseWriter) error
+}
+
+type GetJobs200JSONResponse []Job
+
+func (response GetJobs200JSONResponse) VisitGetJobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetJobs400JSONResponse struct{ N400JSONResponse }
+
+func (response GetJobs400JSONResponse) VisitGetJobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetJobs401JSONResponse struct{ N401JSONResponse }
+
+func (response GetJobs401JSONResponse) VisitGetJobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetJobs500JSONResponse struct{ N500JSONResponse }
+
+func (response GetJobs500JSONResponse) VisitGetJobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetJobRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type GetJobResponseObject interface {
+	VisitGetJobResponse(w http.ResponseWriter) error
+}
+
+type GetJob200JSONResponse Job
+
+func (response GetJob200JSONResponse) VisitGetJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetJob401JSONResponse struct{ N401JSONResponse }
+
+func (response GetJob401JSONResponse) VisitGetJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetJob404JSONResponse struct{ N404JSONResponse }
+
+func (response GetJob404JSONResponse) VisitGetJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetJob500JSONResponse struct{ N500JSONResponse }
+
+func (response GetJob500JSONResponse) VisitGetJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelJobRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type CancelJobResponseObject interface {
+	VisitCancelJobResponse(w http.ResponseWriter) error
+}
+
+type CancelJob200JSONResponse Job
+
+func (response CancelJob200JSONResponse) VisitCancelJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelJob401JSONResponse struct{ N401JSONResponse }
+
+func (response CancelJob401JSONResponse) VisitCancelJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelJob404JSONResponse struct{ N404JSONResponse }
+
+func (response CancelJob404JSONResponse) VisitCancelJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelJob409JSONResponse struct{ N409JSONResponse }
+
+func (response CancelJob409JSONResponse) VisitCancelJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelJob500JSONResponse struct{ N500JSONResponse }
+
+func (response CancelJob500JSONResponse) VisitCancelJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RetryJobRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type RetryJobResponseObject interface {
+	VisitRetryJobResponse(w http.ResponseWriter) error
+}
+
+type RetryJob200JSONResponse Job
+
+func (response RetryJob200JSONResponse) VisitRetryJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RetryJob401JSONResponse struct{ N401JSONResponse }
+
+func (response RetryJob401JSONResponse) VisitRetryJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RetryJob404JSONResponse struct{ N404JSONResponse }
+
+func (response RetryJob404JSONResponse) VisitRetryJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RetryJob409JSONResponse struct{ N409JSONResponse }
+
+func (response RetryJob409JSONResponse) VisitRetryJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RetryJob500JSONResponse struct{ N500JSONResponse }
+
+func (response RetryJob500JSONResponse) VisitRetryJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAgentMessagesRequestObject struct {
+	Params GetAgentMessagesParams
+}
+
+type GetAgentMessagesResponseObject interface {
+	VisitGetAgentMessagesResponse(w http.ResponseWriter) error
+}
+
+type GetAgentMessages200JSONResponse []AgentInboxMessage
+
+func (response GetAgentMessages200JSONResponse) VisitGetAgentMessagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAgentMessages400JSONResponse struct{ N400JSONResponse }
+
+func (response GetAgentMessages400JSONResponse) VisitGetAgentMessagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAgentMessages401JSONResponse struct{ N401JSONResponse }
+
+func (response GetAgentMessages401JSONResponse) VisitGetAgentMessagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAgentMessages500JSONResponse struct{ N500JSONResponse }
+
+func (response GetAgentMessages500JSONResponse) VisitGetAgentMessagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAgentMessageEnvelopeRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type GetAgentMessageEnvelopeResponseObject interface {
+	VisitGetAgentMessageEnvelopeResponse(w http.ResponseWriter) error
+}
+
+type GetAgentMessageEnvelope200ResponseHeaders struct {
+	ContentDisposition string
+}
+
+type GetAgentMessageEnvelope200ApplicationoctetStreamResponse struct {
+	Body          io.Reader
+	Headers       GetAgentMessageEnvelope200ResponseHeaders
+	ContentLength int64
+}
+
+func (response GetAgentMessageEnvelope200ApplicationoctetStreamResponse) VisitGetAgentMessageEnvelopeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.Header().Set("Content-Type", "application/octet-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetAgentMessageEnvelope401JSONResponse struct{ N401JSONResponse }
+
+func (response GetAgentMessageEnvelope401JSONResponse) VisitGetAgentMessageEnvelopeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAgentMessageEnvelope404JSONResponse struct{ N404JSONResponse }
+
+func (response GetAgentMessageEnvelope404JSONResponse) VisitGetAgentMessageEnvelopeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAgentMessageEnvelope500JSONResponse struct{ N500JSONResponse }
+
+func (response GetAgentMessageEnvelope500JSONResponse) VisitGetAgentMessageEnvelopeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ClaimOfferEmailRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type ClaimOfferEmailResponseObject interface {
+	VisitClaimOfferEmailResponse(w http.ResponseWriter) error
+}
+
+type ClaimOfferEmail302ResponseHeaders struct {
+	Location string
+}
+
+type ClaimOfferEmail302Response struct {
+	Headers ClaimOfferEmail302ResponseHeaders
+}
+
+func (response ClaimOfferEmail302Response) VisitClaimOfferEmailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(302)
+	return nil
+}
+
+type ClaimOfferEmail404JSONResponse struct{ N404JSONResponse }
+
+func (response ClaimOfferEmail404JSONResponse) VisitClaimOfferEmailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ClaimOfferEmail500JSONResponse struct{ N500JSONResponse }
+
+func (response ClaimOfferEmail500JSONResponse) VisitClaimOfferEmailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type OpenOfferEmailRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type OpenOfferEmailResponseObject interface {
+	VisitOpenOfferEmailResponse(w http.ResponseWriter) error
+}
+
+type OpenOfferEmail200ImagegifResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response OpenOfferEmail200ImagegifResponse) VisitOpenOfferEmailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "image/gif")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetSchemasRequestObject struct {
+	Params GetSchemasParams
+}
+
+type GetSchemasResponseObject interface {
+	VisitGetSchemasResponse(w http.ResponseWriter) error
+}
+
+type GetSchemas200JSONResponse []Schema
+
+func (response GetSchemas200JSONResponse) VisitGetSchemasResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSchemas400JSONResponse struct{ N400JSONResponse }
+
+func (response GetSchemas400JSONResponse) VisitGetSchemasResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSchemas500JSONResponse struct{ N500JSONResponse }
+
+func (response GetSchemas500JSONResponse) VisitGetSchemasResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportSchemaRequestObject struct {
+	Body *ImportSchemaJSONRequestBody
+}
+
+type ImportSchemaResponseObject interface {
+	VisitImportSchemaResponse(w http.ResponseWriter) error
+}
+
+type ImportSchema201JSONResponse UUIDResponse
+
+func (response ImportSchema201JSONResponse) VisitImportSchemaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportSchema400JSONResponse struct{ N400JSONResponse }
+
+func (response ImportSchema400JSONResponse) VisitImportSchemaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportSchema500JSONResponse struct{ N500JSONResponse }
+
+func (response ImportSchema500JSONResponse) VisitImportSchemaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BuildSchemaRequestObject struct {
+	Body *BuildSchemaJSONRequestBody
+}
+
+type BuildSchemaResponseObject interface {
+	VisitBuildSchemaResponse(w http.ResponseWriter) error
+}
+
+type BuildSchema201JSONResponse UUIDResponse
+
+func (response BuildSchema201JSONResponse) VisitBuildSchemaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BuildSchema400JSONResponse struct{ N400JSONResponse }
+
+func (response BuildSchema400JSONResponse) VisitBuildSchemaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BuildSchema500JSONResponse struct{ N500JSONResponse }
+
+func (response BuildSchema500JSONResponse) VisitBuildSchemaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSchemaRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type GetSchemaResponseObject interface {
+	VisitGetSchemaResponse(w http.ResponseWriter) error
+}
+
+type GetSchema200ResponseHeaders struct {
+	ETag string
+}
+
+type GetSchema200JSONResponse struct {
+	Body    Schema
+	Headers GetSchema200ResponseHeaders
+}
+
+func (response GetSchema200JSONResponse) VisitGetSchemaResponse(w http.ResponseWriter) error {
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetSchema400JSONResponse struct{ N400JSONResponse }
+
+func (response GetSchema400JSONResponse) VisitGetSchemaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSchema404JSONResponse struct{ N404JSONResponse }
+
+func (response GetSchema404JSONResponse) VisitGetSchemaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSchema500JSONResponse struct{ N500JSONResponse }
+
+func (response GetSchema500JSONResponse) VisitGetSchemaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSchemaRequestObject struct {
+	Id     Id `json:"id"`
+	Params UpdateSchemaParams
+	Body   *UpdateSchemaJSONRequestBody
+}
+
+type UpdateSchemaResponseObject interface {
+	VisitUpdateSchemaResponse(w http.ResponseWriter) error
+}
+
+type UpdateSchema200ResponseHeaders struct {
+	ETag string
+}
+
+type UpdateSchema200JSONResponse struct {
+	Body    Schema
+	Headers UpdateSchema200ResponseHeaders
+}
+
+func (response UpdateSchema200JSONResponse) VisitUpdateSchemaResponse(w http.ResponseWriter) error {
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateSchema400JSONResponse struct{ N400JSONResponse }
+
+func (response UpdateSchema400JSONResponse) VisitUpdateSchemaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSchema404JSONResponse struct{ N404JSONResponse }
+
+func (response UpdateSchema404JSONResponse) VisitUpdateSchemaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSchema412JSONResponse struct{ N412JSONResponse }
+
+func (response UpdateSchema412JSONResponse) VisitUpdateSchemaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSchema500JSONResponse struct{ N500JSONResponse }
+
+func (response UpdateSchema500JSONResponse) VisitUpdateSchemaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSchemaContextRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type GetSchemaContextResponseObject interface {
+	VisitGetSchemaContextResponse(w http.ResponseWriter) error
+}
+
+type GetSchemaContext200JSONResponse map[string]interface{}
+
+func (response GetSchemaContext200JSONResponse) VisitGetSchemaContextResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/ld+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSchemaContext404JSONResponse struct{ N404JSONResponse }
+
+func (response GetSchemaContext404JSONResponse) VisitGetSchemaContextResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSchemaContext500JSONResponse struct{ N500JSONResponse }
+
+func (response GetSchemaContext500JSONResponse) VisitGetSchemaContextResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSchemaJSONRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type GetSchemaJSONResponseObject interface {
+	VisitGetSchemaJSONResponse(w http.ResponseWriter) error
+}
+
+type GetSchemaJSON200JSONResponse map[string]interface{}
+
+func (response GetSchemaJSON200JSONResponse) VisitGetSchemaJSONResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/schema+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSchemaJSON404JSONResponse struct{ N404JSONResponse }
+
+func (response GetSchemaJSON404JSONResponse) VisitGetSchemaJSONResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSchemaJSON500JSONResponse struct{ N500JSONResponse }
+
+func (response GetSchemaJSON500JSONResponse) VisitGetSchemaJSONResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PublishStateRequestObject struct {
+}
+
+type PublishStateResponseObject interface {
+	VisitPublishStateResponse(w http.ResponseWriter) error
+}
+
+type PublishState202JSONResponse PublishIdentityStateResponse
+
+func (response PublishState202JSONResponse) VisitPublishStateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PublishState400JSONResponse struct{ N400JSONResponse }
+
+func (response PublishState400JSONResponse) VisitPublishStateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PublishState500JSONResponse struct{ N500JSONResponse }
+
+func (response PublishState500JSONResponse) VisitPublishStateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RetryPublishStateRequestObject struct {
+}
+
+type RetryPublishStateResponseObject interface {
+	VisitRetryPublishStateResponse(w http.ResponseWriter) error
+}
+
+type RetryPublishState202JSONResponse PublishIdentityStateResponse
+
+func (response RetryPublishState202JSONResponse) VisitRetryPublishStateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RetryPublishState400JSONResponse struct{ N400JSONResponse }
+
+func (response RetryPublishState400JSONResponse) VisitRetryPublishStateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RetryPublishState500JSONResponse struct{ N500JSONResponse }
+
+func (response RetryPublishState500JSONResponse) VisitRetryPublishStateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStateStatusRequestObject struct {
+}
+
+type GetStateStatusResponseObject interface {
+	VisitGetStateStatusResponse(w http.ResponseWriter) error
+}
+
+type GetStateStatus200JSONResponse StateStatusResponse
+
+func (response GetStateStatus200JSONResponse) VisitGetStateStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStateStatus500JSONResponse struct{ N500JSONResponse }
+
+func (response GetStateStatus500JSONResponse) VisitGetStateStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStateTransactionsRequestObject struct {
+}
+
+type GetStateTransactionsResponseObject interface {
+	VisitGetStateTransactionsResponse(w http.ResponseWriter) error
+}
+
+type GetStateTransactions200JSONResponse StateTransactionsResponse
+
+func (response GetStateTransactions200JSONResponse) VisitGetStateTransactionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStateTransactions500JSONResponse struct{ N500JSONResponse }
+
+func (response GetStateTransactions500JSONResponse) VisitGetStateTransactionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubjectBindingRequestObject struct {
+	ExternalID string `json:"externalID"`
+}
+
+type GetSubjectBindingResponseObject interface {
+	VisitGetSubjectBindingResponse(w http.ResponseWriter) error
+}
+
+type GetSubjectBinding200JSONResponse SubjectBinding
+
+func (response GetSubjectBinding200JSONResponse) VisitGetSubjectBindingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubjectBinding400JSONResponse struct{ N400JSONResponse }
+
+func (response GetSubjectBinding400JSONResponse) VisitGetSubjectBindingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubjectBinding404JSONResponse struct{ N404JSONResponse }
+
+func (response GetSubjectBinding404JSONResponse) VisitGetSubjectBindingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubjectBinding500JSONResponse struct{ N500JSONResponse }
+
+func (response GetSubjectBinding500JSONResponse) VisitGetSubjectBindingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
 // StrictServerInterface represents all server handlers.
@@ -2861,6 +7942,9 @@ type StrictServerInterface interface {
 	// Get Connection QRCode
 	// (GET /v1/authentication/qrcode)
 	AuthQRCode(ctx context.Context, request AuthQRCodeRequestObject) (AuthQRCodeResponseObject, error)
+	// Get Changes
+	// (GET /v1/changes)
+	GetChanges(ctx context.Context, request GetChangesRequestObject) (GetChangesResponseObject, error)
 	// Get Connections
 	// (GET /v1/connections)
 	GetConnections(ctx context.Context, request GetConnectionsRequestObject) (GetConnectionsResponseObject, error)
@@ -2870,18 +7954,42 @@ type StrictServerInterface interface {
 	// Get Connection
 	// (GET /v1/connections/{id})
 	GetConnection(ctx context.Context, request GetConnectionRequestObject) (GetConnectionResponseObject, error)
+	// Update Connection Metadata
+	// (PATCH /v1/connections/{id})
+	UpdateConnectionMetadata(ctx context.Context, request UpdateConnectionMetadataRequestObject) (UpdateConnectionMetadataResponseObject, error)
 	// Delete Connection Credentials
 	// (DELETE /v1/connections/{id}/credentials)
 	DeleteConnectionCredentials(ctx context.Context, request DeleteConnectionCredentialsRequestObject) (DeleteConnectionCredentialsResponseObject, error)
+	// Get Connection Credentials
+	// (GET /v1/connections/{id}/credentials)
+	GetConnectionCredentials(ctx context.Context, request GetConnectionCredentialsRequestObject) (GetConnectionCredentialsResponseObject, error)
 	// Revoke Connection Credentials
 	// (POST /v1/connections/{id}/credentials/revoke)
 	RevokeConnectionCredentials(ctx context.Context, request RevokeConnectionCredentialsRequestObject) (RevokeConnectionCredentialsResponseObject, error)
+	// Get Connection Problem Reports
+	// (GET /v1/connections/{id}/problem-reports)
+	GetConnectionProblemReports(ctx context.Context, request GetConnectionProblemReportsRequestObject) (GetConnectionProblemReportsResponseObject, error)
+	// Restore Connection
+	// (POST /v1/connections/{id}/restore)
+	RestoreConnection(ctx context.Context, request RestoreConnectionRequestObject) (RestoreConnectionResponseObject, error)
 	// Get Credentials
 	// (GET /v1/credentials)
 	GetCredentials(ctx context.Context, request GetCredentialsRequestObject) (GetCredentialsResponseObject, error)
 	// Create Credential
 	// (POST /v1/credentials)
 	CreateCredential(ctx context.Context, request CreateCredentialRequestObject) (CreateCredentialResponseObject, error)
+	// Create Credential From Template
+	// (POST /v1/credentials/from-template/{templateId})
+	CreateCredentialFromTemplate(ctx context.Context, request CreateCredentialFromTemplateRequestObject) (CreateCredentialFromTemplateResponseObject, error)
+	// Import Credentials
+	// (POST /v1/credentials/imports)
+	ImportCredentials(ctx context.Context, request ImportCredentialsRequestObject) (ImportCredentialsResponseObject, error)
+	// Get Import Job
+	// (GET /v1/credentials/imports/{id})
+	GetImportJob(ctx context.Context, request GetImportJobRequestObject) (GetImportJobResponseObject, error)
+	// Get Import Report
+	// (GET /v1/credentials/imports/{id}/report)
+	GetImportReport(ctx context.Context, request GetImportReportRequestObject) (GetImportReportResponseObject, error)
 	// Get Links
 	// (GET /v1/credentials/links)
 	GetLinks(ctx context.Context, request GetLinksRequestObject) (GetLinksResponseObject, error)
@@ -2891,6 +7999,9 @@ type StrictServerInterface interface {
 	// Create Link QR Code Callback
 	// (POST /v1/credentials/links/callback)
 	CreateLinkQrCodeCallback(ctx context.Context, request CreateLinkQrCodeCallbackRequestObject) (CreateLinkQrCodeCallbackResponseObject, error)
+	// Export Link Claim Funnel
+	// (GET /v1/credentials/links/funnel)
+	ExportLinksFunnel(ctx context.Context, request ExportLinksFunnelRequestObject) (ExportLinksFunnelResponseObject, error)
 	// Delete Link
 	// (DELETE /v1/credentials/links/{id})
 	DeleteLink(ctx context.Context, request DeleteLinkRequestObject) (DeleteLinkResponseObject, error)
@@ -2900,36 +8011,132 @@ type StrictServerInterface interface {
 	// Activate | Deactivate Link
 	// (PATCH /v1/credentials/links/{id})
 	AcivateLink(ctx context.Context, request AcivateLinkRequestObject) (AcivateLinkResponseObject, error)
+	// Get Link Analytics
+	// (GET /v1/credentials/links/{id}/analytics)
+	GetLinkAnalytics(ctx context.Context, request GetLinkAnalyticsRequestObject) (GetLinkAnalyticsResponseObject, error)
+	// Get Link Codes
+	// (GET /v1/credentials/links/{id}/codes)
+	GetLinkCodes(ctx context.Context, request GetLinkCodesRequestObject) (GetLinkCodesResponseObject, error)
+	// Create Link Codes
+	// (POST /v1/credentials/links/{id}/codes)
+	CreateLinkCodes(ctx context.Context, request CreateLinkCodesRequestObject) (CreateLinkCodesResponseObject, error)
+	// Get Link Claim Funnel
+	// (GET /v1/credentials/links/{id}/funnel)
+	GetLinkFunnel(ctx context.Context, request GetLinkFunnelRequestObject) (GetLinkFunnelResponseObject, error)
 	// Get Credential Link QRCode
 	// (GET /v1/credentials/links/{id}/qrcode)
 	GetLinkQRCode(ctx context.Context, request GetLinkQRCodeRequestObject) (GetLinkQRCodeResponseObject, error)
 	// Create Authentication Link QRCode
 	// (POST /v1/credentials/links/{id}/qrcode)
 	CreateLinkQrCode(ctx context.Context, request CreateLinkQrCodeRequestObject) (CreateLinkQrCodeResponseObject, error)
+	// Get Credential By Revocation Nonce
+	// (GET /v1/credentials/nonce/{nonce})
+	GetCredentialByNonce(ctx context.Context, request GetCredentialByNonceRequestObject) (GetCredentialByNonceResponseObject, error)
 	// Get Revocation Status
 	// (GET /v1/credentials/revocation/status/{nonce})
 	GetRevocationStatus(ctx context.Context, request GetRevocationStatusRequestObject) (GetRevocationStatusResponseObject, error)
 	// Revoke Credential
 	// (POST /v1/credentials/revoke/{nonce})
 	RevokeCredential(ctx context.Context, request RevokeCredentialRequestObject) (RevokeCredentialResponseObject, error)
+	// Get Revocation List
+	// (GET /v1/credentials/revoked)
+	GetRevocationList(ctx context.Context, request GetRevocationListRequestObject) (GetRevocationListResponseObject, error)
+	// Get Credential Templates
+	// (GET /v1/credentials/templates)
+	GetCredentialTemplates(ctx context.Context, request GetCredentialTemplatesRequestObject) (GetCredentialTemplatesResponseObject, error)
+	// Create Credential Template
+	// (POST /v1/credentials/templates)
+	CreateCredentialTemplate(ctx context.Context, request CreateCredentialTemplateRequestObject) (CreateCredentialTemplateResponseObject, error)
+	// Delete Credential Template
+	// (DELETE /v1/credentials/templates/{id})
+	DeleteCredentialTemplate(ctx context.Context, request DeleteCredentialTemplateRequestObject) (DeleteCredentialTemplateResponseObject, error)
+	// Get Credential Template
+	// (GET /v1/credentials/templates/{id})
+	GetCredentialTemplate(ctx context.Context, request GetCredentialTemplateRequestObject) (GetCredentialTemplateResponseObject, error)
 	// Delete Credential
 	// (DELETE /v1/credentials/{id})
 	DeleteCredential(ctx context.Context, request DeleteCredentialRequestObject) (DeleteCredentialResponseObject, error)
 	// Get Credential
 	// (GET /v1/credentials/{id})
 	GetCredential(ctx context.Context, request GetCredentialRequestObject) (GetCredentialResponseObject, error)
+	// Get Credential History
+	// (GET /v1/credentials/{id}/history)
+	GetCredentialHistory(ctx context.Context, request GetCredentialHistoryRequestObject) (GetCredentialHistoryResponseObject, error)
+	// Get Credential JWT
+	// (GET /v1/credentials/{id}/jwt)
+	GetCredentialJWT(ctx context.Context, request GetCredentialJWTRequestObject) (GetCredentialJWTResponseObject, error)
+	// Renew Credential Offer
+	// (POST /v1/credentials/{id}/offer)
+	RenewCredentialOffer(ctx context.Context, request RenewCredentialOfferRequestObject) (RenewCredentialOfferResponseObject, error)
+	// Get Credential Offer Emails
+	// (GET /v1/credentials/{id}/offer-emails)
+	GetCredentialOfferEmails(ctx context.Context, request GetCredentialOfferEmailsRequestObject) (GetCredentialOfferEmailsResponseObject, error)
+	// Get Credential Problem Reports
+	// (GET /v1/credentials/{id}/problem-reports)
+	GetCredentialProblemReports(ctx context.Context, request GetCredentialProblemReportsRequestObject) (GetCredentialProblemReportsResponseObject, error)
 	// Get Credential QR code
 	// (GET /v1/credentials/{id}/qrcode)
 	GetCredentialQrCode(ctx context.Context, request GetCredentialQrCodeRequestObject) (GetCredentialQrCodeResponseObject, error)
+	// Restore Credential
+	// (POST /v1/credentials/{id}/restore)
+	RestoreCredential(ctx context.Context, request RestoreCredentialRequestObject) (RestoreCredentialResponseObject, error)
+	// Send Credential Offer By Email
+	// (POST /v1/credentials/{id}/send-offer)
+	SendCredentialOffer(ctx context.Context, request SendCredentialOfferRequestObject) (SendCredentialOfferResponseObject, error)
+	// Get Credential Wallet Links
+	// (GET /v1/credentials/{id}/wallet-links)
+	GetCredentialWalletLinks(ctx context.Context, request GetCredentialWalletLinksRequestObject) (GetCredentialWalletLinksResponseObject, error)
+	// Get Issuer Profile
+	// (GET /v1/issuer-profile)
+	GetIssuerProfile(ctx context.Context, request GetIssuerProfileRequestObject) (GetIssuerProfileResponseObject, error)
+	// Update Issuer Profile
+	// (PATCH /v1/issuer-profile)
+	UpdateIssuerProfile(ctx context.Context, request UpdateIssuerProfileRequestObject) (UpdateIssuerProfileResponseObject, error)
+	// Get Jobs
+	// (GET /v1/jobs)
+	GetJobs(ctx context.Context, request GetJobsRequestObject) (GetJobsResponseObject, error)
+	// Get Job
+	// (GET /v1/jobs/{id})
+	GetJob(ctx context.Context, request GetJobRequestObject) (GetJobResponseObject, error)
+	// Cancel Job
+	// (POST /v1/jobs/{id}/cancel)
+	CancelJob(ctx context.Context, request CancelJobRequestObject) (CancelJobResponseObject, error)
+	// Retry Job
+	// (POST /v1/jobs/{id}/retry)
+	RetryJob(ctx context.Context, request RetryJobRequestObject) (RetryJobResponseObject, error)
+	// Get Agent Messages
+	// (GET /v1/messages)
+	GetAgentMessages(ctx context.Context, request GetAgentMessagesRequestObject) (GetAgentMessagesResponseObject, error)
+	// Get Agent Message Envelope
+	// (GET /v1/messages/{id}/envelope)
+	GetAgentMessageEnvelope(ctx context.Context, request GetAgentMessageEnvelopeRequestObject) (GetAgentMessageEnvelopeResponseObject, error)
+	// Claim Offer Email
+	// (GET /v1/offer-emails/{id}/claim)
+	ClaimOfferEmail(ctx context.Context, request ClaimOfferEmailRequestObject) (ClaimOfferEmailResponseObject, error)
+	// Track Offer Email Open
+	// (GET /v1/offer-emails/{id}/open)
+	OpenOfferEmail(ctx context.Context, request OpenOfferEmailRequestObject) (OpenOfferEmailResponseObject, error)
 	// Get Schemas
 	// (GET /v1/schemas)
 	GetSchemas(ctx context.Context, request GetSchemasRequestObject) (GetSchemasResponseObject, error)
 	// Import JSON schema
 	// (POST /v1/schemas)
 	ImportSchema(ctx context.Context, request ImportSchemaRequestObject) (ImportSchemaResponseObject, error)
+	// Build schema
+	// (POST /v1/schemas/build)
+	BuildSchema(ctx context.Context, request BuildSchemaRequestObject) (BuildSchemaResponseObject, error)
 	// Get Schema
 	// (GET /v1/schemas/{id})
 	GetSchema(ctx context.Context, request GetSchemaRequestObject) (GetSchemaResponseObject, error)
+	// Update Schema
+	// (PATCH /v1/schemas/{id})
+	UpdateSchema(ctx context.Context, request UpdateSchemaRequestObject) (UpdateSchemaResponseObject, error)
+	// Get JSON-LD context
+	// (GET /v1/schemas/{id}/context.jsonld)
+	GetSchemaContext(ctx context.Context, request GetSchemaContextRequestObject) (GetSchemaContextResponseObject, error)
+	// Get JSON Schema
+	// (GET /v1/schemas/{id}/schema.json)
+	GetSchemaJSON(ctx context.Context, request GetSchemaJSONRequestObject) (GetSchemaJSONResponseObject, error)
 	// Publish Identity State
 	// (POST /v1/state/publish)
 	PublishState(ctx context.Context, request PublishStateRequestObject) (PublishStateResponseObject, error)
@@ -2942,55 +8149,1141 @@ type StrictServerInterface interface {
 	// Get Identity State Transactions
 	// (GET /v1/state/transactions)
 	GetStateTransactions(ctx context.Context, request GetStateTransactionsRequestObject) (GetStateTransactionsResponseObject, error)
+	// Get Subject Binding
+	// (GET /v1/subjects/{externalID})
+	GetSubjectBinding(ctx context.Context, request GetSubjectBindingRequestObject) (GetSubjectBindingResponseObject, error)
+}
+
+type StrictHandlerFunc func(ctx context.Context, w http.ResponseWriter, r *http.Request, args interface{}) (interface{}, error)
+
+type StrictMiddlewareFunc func(f StrictHandlerFunc, operationID string) StrictHandlerFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// GetDocumentation operation middleware
+func (sh *strictHandler) GetDocumentation(w http.ResponseWriter, r *http.Request) {
+	var request GetDocumentationRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDocumentation(ctx, request.(GetDocumentationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDocumentation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetDocumentationResponseObject); ok {
+		if err := validResponse.VisitGetDocumentationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// GetFavicon operation middleware
+func (sh *strictHandler) GetFavicon(w http.ResponseWriter, r *http.Request) {
+	var request GetFaviconRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetFavicon(ctx, request.(GetFaviconRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetFavicon")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetFaviconResponseObject); ok {
+		if err := validResponse.VisitGetFaviconResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// GetYaml operation middleware
+func (sh *strictHandler) GetYaml(w http.ResponseWriter, r *http.Request) {
+	var request GetYamlRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetYaml(ctx, request.(GetYamlRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetYaml")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetYamlResponseObject); ok {
+		if err := validResponse.VisitGetYamlResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// Health operation middleware
+func (sh *strictHandler) Health(w http.ResponseWriter, r *http.Request) {
+	var request HealthRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.Health(ctx, request.(HealthRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "Health")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(HealthResponseObject); ok {
+		if err := validResponse.VisitHealthResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// Agent operation middleware
+func (sh *strictHandler) Agent(w http.ResponseWriter, r *http.Request) {
+	var request AgentRequestObject
+
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't read body: %w", err))
+		return
+	}
+	body := AgentTextRequestBody(data)
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.Agent(ctx, request.(AgentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "Agent")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AgentResponseObject); ok {
+		if err := validResponse.VisitAgentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// AuthCallback operation middleware
+func (sh *strictHandler) AuthCallback(w http.ResponseWriter, r *http.Request, params AuthCallbackParams) {
+	var request AuthCallbackRequestObject
+
+	request.Params = params
+
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't read body: %w", err))
+		return
+	}
+	body := AuthCallbackTextRequestBody(data)
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AuthCallback(ctx, request.(AuthCallbackRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AuthCallback")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AuthCallbackResponseObject); ok {
+		if err := validResponse.VisitAuthCallbackResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// AuthQRCode operation middleware
+func (sh *strictHandler) AuthQRCode(w http.ResponseWriter, r *http.Request) {
+	var request AuthQRCodeRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AuthQRCode(ctx, request.(AuthQRCodeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AuthQRCode")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AuthQRCodeResponseObject); ok {
+		if err := validResponse.VisitAuthQRCodeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// GetChanges operation middleware
+func (sh *strictHandler) GetChanges(w http.ResponseWriter, r *http.Request, params GetChangesParams) {
+	var request GetChangesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetChanges(ctx, request.(GetChangesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetChanges")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetChangesResponseObject); ok {
+		if err := validResponse.VisitGetChangesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// GetConnections operation middleware
+func (sh *strictHandler) GetConnections(w http.ResponseWriter, r *http.Request, params GetConnectionsParams) {
+	var request GetConnectionsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetConnections(ctx, request.(GetConnectionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetConnections")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetConnectionsResponseObject); ok {
+		if err := validResponse.VisitGetConnectionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// DeleteConnection operation middleware
+func (sh *strictHandler) DeleteConnection(w http.ResponseWriter, r *http.Request, id Id, params DeleteConnectionParams) {
+	var request DeleteConnectionRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteConnection(ctx, request.(DeleteConnectionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteConnection")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteConnectionResponseObject); ok {
+		if err := validResponse.VisitDeleteConnectionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// GetConnection operation middleware
+func (sh *strictHandler) GetConnection(w http.ResponseWriter, r *http.Request, id Id) {
+	var request GetConnectionRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetConnection(ctx, request.(GetConnectionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetConnection")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetConnectionResponseObject); ok {
+		if err := validResponse.VisitGetConnectionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// UpdateConnectionMetadata operation middleware
+func (sh *strictHandler) UpdateConnectionMetadata(w http.ResponseWriter, r *http.Request, id Id, params UpdateConnectionMetadataParams) {
+	var request UpdateConnectionMetadataRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	var body UpdateConnectionMetadataJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateConnectionMetadata(ctx, request.(UpdateConnectionMetadataRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateConnectionMetadata")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateConnectionMetadataResponseObject); ok {
+		if err := validResponse.VisitUpdateConnectionMetadataResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// DeleteConnectionCredentials operation middleware
+func (sh *strictHandler) DeleteConnectionCredentials(w http.ResponseWriter, r *http.Request, id Id) {
+	var request DeleteConnectionCredentialsRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteConnectionCredentials(ctx, request.(DeleteConnectionCredentialsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteConnectionCredentials")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteConnectionCredentialsResponseObject); ok {
+		if err := validResponse.VisitDeleteConnectionCredentialsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// GetConnectionCredentials operation middleware
+func (sh *strictHandler) GetConnectionCredentials(w http.ResponseWriter, r *http.Request, id Id, params GetConnectionCredentialsParams) {
+	var request GetConnectionCredentialsRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetConnectionCredentials(ctx, request.(GetConnectionCredentialsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetConnectionCredentials")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetConnectionCredentialsResponseObject); ok {
+		if err := validResponse.VisitGetConnectionCredentialsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// RevokeConnectionCredentials operation middleware
+func (sh *strictHandler) RevokeConnectionCredentials(w http.ResponseWriter, r *http.Request, id Id, params RevokeConnectionCredentialsParams) {
+	var request RevokeConnectionCredentialsRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RevokeConnectionCredentials(ctx, request.(RevokeConnectionCredentialsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RevokeConnectionCredentials")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RevokeConnectionCredentialsResponseObject); ok {
+		if err := validResponse.VisitRevokeConnectionCredentialsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// GetConnectionProblemReports operation middleware
+func (sh *strictHandler) GetConnectionProblemReports(w http.ResponseWriter, r *http.Request, id Id) {
+	var request GetConnectionProblemReportsRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetConnectionProblemReports(ctx, request.(GetConnectionProblemReportsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetConnectionProblemReports")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetConnectionProblemReportsResponseObject); ok {
+		if err := validResponse.VisitGetConnectionProblemReportsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// RestoreConnection operation middleware
+func (sh *strictHandler) RestoreConnection(w http.ResponseWriter, r *http.Request, id Id) {
+	var request RestoreConnectionRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RestoreConnection(ctx, request.(RestoreConnectionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RestoreConnection")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RestoreConnectionResponseObject); ok {
+		if err := validResponse.VisitRestoreConnectionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// GetCredentials operation middleware
+func (sh *strictHandler) GetCredentials(w http.ResponseWriter, r *http.Request, params GetCredentialsParams) {
+	var request GetCredentialsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCredentials(ctx, request.(GetCredentialsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCredentials")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCredentialsResponseObject); ok {
+		if err := validResponse.VisitGetCredentialsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// CreateCredential operation middleware
+func (sh *strictHandler) CreateCredential(w http.ResponseWriter, r *http.Request, params CreateCredentialParams) {
+	var request CreateCredentialRequestObject
+
+	request.Params = params
+
+	var body CreateCredentialJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateCredential(ctx, request.(CreateCredentialRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateCredential")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateCredentialResponseObject); ok {
+		if err := validResponse.VisitCreateCredentialResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// CreateCredentialFromTemplate operation middleware
+func (sh *strictHandler) CreateCredentialFromTemplate(w http.ResponseWriter, r *http.Request, templateId uuid.UUID) {
+	var request CreateCredentialFromTemplateRequestObject
+
+	request.TemplateId = templateId
+
+	var body CreateCredentialFromTemplateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateCredentialFromTemplate(ctx, request.(CreateCredentialFromTemplateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateCredentialFromTemplate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateCredentialFromTemplateResponseObject); ok {
+		if err := validResponse.VisitCreateCredentialFromTemplateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// ImportCredentials operation middleware
+func (sh *strictHandler) ImportCredentials(w http.ResponseWriter, r *http.Request) {
+	var request ImportCredentialsRequestObject
+
+	if reader, err := r.MultipartReader(); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode multipart body: %w", err))
+		return
+	} else {
+		request.Body = reader
+	}
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ImportCredentials(ctx, request.(ImportCredentialsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ImportCredentials")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ImportCredentialsResponseObject); ok {
+		if err := validResponse.VisitImportCredentialsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// GetImportJob operation middleware
+func (sh *strictHandler) GetImportJob(w http.ResponseWriter, r *http.Request, id Id) {
+	var request GetImportJobRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetImportJob(ctx, request.(GetImportJobRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetImportJob")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetImportJobResponseObject); ok {
+		if err := validResponse.VisitGetImportJobResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// GetImportReport operation middleware
+func (sh *strictHandler) GetImportReport(w http.ResponseWriter, r *http.Request, id Id, params GetImportReportParams) {
+	var request GetImportReportRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetImportReport(ctx, request.(GetImportReportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetImportReport")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetImportReportResponseObject); ok {
+		if err := validResponse.VisitGetImportReportResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// GetLinks operation middleware
+func (sh *strictHandler) GetLinks(w http.ResponseWriter, r *http.Request, params GetLinksParams) {
+	var request GetLinksRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetLinks(ctx, request.(GetLinksRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetLinks")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetLinksResponseObject); ok {
+		if err := validResponse.VisitGetLinksResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// CreateLink operation middleware
+func (sh *strictHandler) CreateLink(w http.ResponseWriter, r *http.Request) {
+	var request CreateLinkRequestObject
+
+	var body CreateLinkJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateLink(ctx, request.(CreateLinkRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateLink")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateLinkResponseObject); ok {
+		if err := validResponse.VisitCreateLinkResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// CreateLinkQrCodeCallback operation middleware
+func (sh *strictHandler) CreateLinkQrCodeCallback(w http.ResponseWriter, r *http.Request, params CreateLinkQrCodeCallbackParams) {
+	var request CreateLinkQrCodeCallbackRequestObject
+
+	request.Params = params
+
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't read body: %w", err))
+		return
+	}
+	body := CreateLinkQrCodeCallbackTextRequestBody(data)
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateLinkQrCodeCallback(ctx, request.(CreateLinkQrCodeCallbackRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateLinkQrCodeCallback")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateLinkQrCodeCallbackResponseObject); ok {
+		if err := validResponse.VisitCreateLinkQrCodeCallbackResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// ExportLinksFunnel operation middleware
+func (sh *strictHandler) ExportLinksFunnel(w http.ResponseWriter, r *http.Request, params ExportLinksFunnelParams) {
+	var request ExportLinksFunnelRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ExportLinksFunnel(ctx, request.(ExportLinksFunnelRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ExportLinksFunnel")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ExportLinksFunnelResponseObject); ok {
+		if err := validResponse.VisitExportLinksFunnelResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// DeleteLink operation middleware
+func (sh *strictHandler) DeleteLink(w http.ResponseWriter, r *http.Request, id Id) {
+	var request DeleteLinkRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteLink(ctx, request.(DeleteLinkRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteLink")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteLinkResponseObject); ok {
+		if err := validResponse.VisitDeleteLinkResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// GetLink operation middleware
+func (sh *strictHandler) GetLink(w http.ResponseWriter, r *http.Request, id Id) {
+	var request GetLinkRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetLink(ctx, request.(GetLinkRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetLink")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetLinkResponseObject); ok {
+		if err := validResponse.VisitGetLinkResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// AcivateLink operation middleware
+func (sh *strictHandler) AcivateLink(w http.ResponseWriter, r *http.Request, id Id, params AcivateLinkParams) {
+	var request AcivateLinkRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	var body AcivateLinkJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AcivateLink(ctx, request.(AcivateLinkRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AcivateLink")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AcivateLinkResponseObject); ok {
+		if err := validResponse.VisitAcivateLinkResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// GetLinkAnalytics operation middleware
+func (sh *strictHandler) GetLinkAnalytics(w http.ResponseWriter, r *http.Request, id Id, params GetLinkAnalyticsParams) {
+	var request GetLinkAnalyticsRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetLinkAnalytics(ctx, request.(GetLinkAnalyticsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetLinkAnalytics")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetLinkAnalyticsResponseObject); ok {
+		if err := validResponse.VisitGetLinkAnalyticsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// GetLinkCodes operation middleware
+func (sh *strictHandler) GetLinkCodes(w http.ResponseWriter, r *http.Request, id Id) {
+	var request GetLinkCodesRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetLinkCodes(ctx, request.(GetLinkCodesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetLinkCodes")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetLinkCodesResponseObject); ok {
+		if err := validResponse.VisitGetLinkCodesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// CreateLinkCodes operation middleware
+func (sh *strictHandler) CreateLinkCodes(w http.ResponseWriter, r *http.Request, id Id) {
+	var request CreateLinkCodesRequestObject
+
+	request.Id = id
+
+	var body CreateLinkCodesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateLinkCodes(ctx, request.(CreateLinkCodesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateLinkCodes")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateLinkCodesResponseObject); ok {
+		if err := validResponse.VisitCreateLinkCodesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// GetLinkFunnel operation middleware
+func (sh *strictHandler) GetLinkFunnel(w http.ResponseWriter, r *http.Request, id Id) {
+	var request GetLinkFunnelRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetLinkFunnel(ctx, request.(GetLinkFunnelRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetLinkFunnel")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetLinkFunnelResponseObject); ok {
+		if err := validResponse.VisitGetLinkFunnelResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
 }
 
-type StrictHandlerFunc func(ctx context.Context, w http.ResponseWriter, r *http.Request, args interface{}) (interface{}, error)
+// GetLinkQRCode operation middleware
+func (sh *strictHandler) GetLinkQRCode(w http.ResponseWriter, r *http.Request, id Id, params GetLinkQRCodeParams) {
+	var request GetLinkQRCodeRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetLinkQRCode(ctx, request.(GetLinkQRCodeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetLinkQRCode")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetLinkQRCodeResponseObject); ok {
+		if err := validResponse.VisitGetLinkQRCodeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// CreateLinkQrCode operation middleware
+func (sh *strictHandler) CreateLinkQrCode(w http.ResponseWriter, r *http.Request, id Id, params CreateLinkQrCodeParams) {
+	var request CreateLinkQrCodeRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateLinkQrCode(ctx, request.(CreateLinkQrCodeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateLinkQrCode")
+	}
 
-type StrictMiddlewareFunc func(f StrictHandlerFunc, operationID string) StrictHandlerFunc
+	response, err := handler(r.Context(), w, r, request)
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateLinkQrCodeResponseObject); ok {
+		if err := validResponse.VisitCreateLinkQrCodeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
 }
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
+// GetCredentialByNonce operation middleware
+func (sh *strictHandler) GetCredentialByNonce(w http.ResponseWriter, r *http.Request, nonce PathNonce) {
+	var request GetCredentialByNonceRequestObject
+
+	request.Nonce = nonce
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCredentialByNonce(ctx, request.(GetCredentialByNonceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCredentialByNonce")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCredentialByNonceResponseObject); ok {
+		if err := validResponse.VisitGetCredentialByNonceResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
 }
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+// GetRevocationStatus operation middleware
+func (sh *strictHandler) GetRevocationStatus(w http.ResponseWriter, r *http.Request, nonce PathNonce) {
+	var request GetRevocationStatusRequestObject
+
+	request.Nonce = nonce
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetRevocationStatus(ctx, request.(GetRevocationStatusRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetRevocationStatus")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetRevocationStatusResponseObject); ok {
+		if err := validResponse.VisitGetRevocationStatusResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+// RevokeCredential operation middleware
+func (sh *strictHandler) RevokeCredential(w http.ResponseWriter, r *http.Request, nonce PathNonce, params RevokeCredentialParams) {
+	var request RevokeCredentialRequestObject
+
+	request.Nonce = nonce
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RevokeCredential(ctx, request.(RevokeCredentialRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RevokeCredential")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RevokeCredentialResponseObject); ok {
+		if err := validResponse.VisitRevokeCredentialResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
 }
 
-// GetDocumentation operation middleware
-func (sh *strictHandler) GetDocumentation(w http.ResponseWriter, r *http.Request) {
-	var request GetDocumentationRequestObject
+// GetRevocationList operation middleware
+func (sh *strictHandler) GetRevocationList(w http.ResponseWriter, r *http.Request, params GetRevocationListParams) {
+	var request GetRevocationListRequestObject
+
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetDocumentation(ctx, request.(GetDocumentationRequestObject))
+		return sh.ssi.GetRevocationList(ctx, request.(GetRevocationListRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetDocumentation")
+		handler = middleware(handler, "GetRevocationList")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetDocumentationResponseObject); ok {
-		if err := validResponse.VisitGetDocumentationResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetRevocationListResponseObject); ok {
+		if err := validResponse.VisitGetRevocationListResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2998,23 +9291,23 @@ func (sh *strictHandler) GetDocumentation(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// GetFavicon operation middleware
-func (sh *strictHandler) GetFavicon(w http.ResponseWriter, r *http.Request) {
-	var request GetFaviconRequestObject
+// GetCredentialTemplates operation middleware
+func (sh *strictHandler) GetCredentialTemplates(w http.ResponseWriter, r *http.Request) {
+	var request GetCredentialTemplatesRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetFavicon(ctx, request.(GetFaviconRequestObject))
+		return sh.ssi.GetCredentialTemplates(ctx, request.(GetCredentialTemplatesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetFavicon")
+		handler = middleware(handler, "GetCredentialTemplates")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetFaviconResponseObject); ok {
-		if err := validResponse.VisitGetFaviconResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetCredentialTemplatesResponseObject); ok {
+		if err := validResponse.VisitGetCredentialTemplatesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3022,23 +9315,30 @@ func (sh *strictHandler) GetFavicon(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetYaml operation middleware
-func (sh *strictHandler) GetYaml(w http.ResponseWriter, r *http.Request) {
-	var request GetYamlRequestObject
+// CreateCredentialTemplate operation middleware
+func (sh *strictHandler) CreateCredentialTemplate(w http.ResponseWriter, r *http.Request) {
+	var request CreateCredentialTemplateRequestObject
+
+	var body CreateCredentialTemplateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetYaml(ctx, request.(GetYamlRequestObject))
+		return sh.ssi.CreateCredentialTemplate(ctx, request.(CreateCredentialTemplateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetYaml")
+		handler = middleware(handler, "CreateCredentialTemplate")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetYamlResponseObject); ok {
-		if err := validResponse.VisitGetYamlResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateCredentialTemplateResponseObject); ok {
+		if err := validResponse.VisitCreateCredentialTemplateResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3046,23 +9346,25 @@ func (sh *strictHandler) GetYaml(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// Health operation middleware
-func (sh *strictHandler) Health(w http.ResponseWriter, r *http.Request) {
-	var request HealthRequestObject
+// DeleteCredentialTemplate operation middleware
+func (sh *strictHandler) DeleteCredentialTemplate(w http.ResponseWriter, r *http.Request, id Id) {
+	var request DeleteCredentialTemplateRequestObject
+
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.Health(ctx, request.(HealthRequestObject))
+		return sh.ssi.DeleteCredentialTemplate(ctx, request.(DeleteCredentialTemplateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "Health")
+		handler = middleware(handler, "DeleteCredentialTemplate")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(HealthResponseObject); ok {
-		if err := validResponse.VisitHealthResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteCredentialTemplateResponseObject); ok {
+		if err := validResponse.VisitDeleteCredentialTemplateResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3070,31 +9372,51 @@ func (sh *strictHandler) Health(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// Agent operation middleware
-func (sh *strictHandler) Agent(w http.ResponseWriter, r *http.Request) {
-	var request AgentRequestObject
+// GetCredentialTemplate operation middleware
+func (sh *strictHandler) GetCredentialTemplate(w http.ResponseWriter, r *http.Request, id Id) {
+	var request GetCredentialTemplateRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCredentialTemplate(ctx, request.(GetCredentialTemplateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCredentialTemplate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
 
-	data, err := io.ReadAll(r.Body)
 	if err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't read body: %w", err))
-		return
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCredentialTemplateResponseObject); ok {
+		if err := validResponse.VisitGetCredentialTemplateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
 	}
-	body := AgentTextRequestBody(data)
-	request.Body = &body
+}
+
+// DeleteCredential operation middleware
+func (sh *strictHandler) DeleteCredential(w http.ResponseWriter, r *http.Request, id Id) {
+	var request DeleteCredentialRequestObject
+
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.Agent(ctx, request.(AgentRequestObject))
+		return sh.ssi.DeleteCredential(ctx, request.(DeleteCredentialRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "Agent")
+		handler = middleware(handler, "DeleteCredential")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(AgentResponseObject); ok {
-		if err := validResponse.VisitAgentResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteCredentialResponseObject); ok {
+		if err := validResponse.VisitDeleteCredentialResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3102,33 +9424,51 @@ func (sh *strictHandler) Agent(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// AuthCallback operation middleware
-func (sh *strictHandler) AuthCallback(w http.ResponseWriter, r *http.Request, params AuthCallbackParams) {
-	var request AuthCallbackRequestObject
+// GetCredential operation middleware
+func (sh *strictHandler) GetCredential(w http.ResponseWriter, r *http.Request, id Id) {
+	var request GetCredentialRequestObject
 
-	request.Params = params
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCredential(ctx, request.(GetCredentialRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCredential")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
 
-	data, err := io.ReadAll(r.Body)
 	if err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't read body: %w", err))
-		return
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCredentialResponseObject); ok {
+		if err := validResponse.VisitGetCredentialResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
 	}
-	body := AuthCallbackTextRequestBody(data)
-	request.Body = &body
+}
+
+// GetCredentialHistory operation middleware
+func (sh *strictHandler) GetCredentialHistory(w http.ResponseWriter, r *http.Request, id Id) {
+	var request GetCredentialHistoryRequestObject
+
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.AuthCallback(ctx, request.(AuthCallbackRequestObject))
+		return sh.ssi.GetCredentialHistory(ctx, request.(GetCredentialHistoryRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AuthCallback")
+		handler = middleware(handler, "GetCredentialHistory")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(AuthCallbackResponseObject); ok {
-		if err := validResponse.VisitAuthCallbackResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetCredentialHistoryResponseObject); ok {
+		if err := validResponse.VisitGetCredentialHistoryResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3136,23 +9476,25 @@ func (sh *strictHandler) AuthCallback(w http.ResponseWriter, r *http.Request, pa
 	}
 }
 
-// AuthQRCode operation middleware
-func (sh *strictHandler) AuthQRCode(w http.ResponseWriter, r *http.Request) {
-	var request AuthQRCodeRequestObject
+// GetCredentialJWT operation middleware
+func (sh *strictHandler) GetCredentialJWT(w http.ResponseWriter, r *http.Request, id Id) {
+	var request GetCredentialJWTRequestObject
+
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.AuthQRCode(ctx, request.(AuthQRCodeRequestObject))
+		return sh.ssi.GetCredentialJWT(ctx, request.(GetCredentialJWTRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AuthQRCode")
+		handler = middleware(handler, "GetCredentialJWT")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(AuthQRCodeResponseObject); ok {
-		if err := validResponse.VisitAuthQRCodeResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetCredentialJWTResponseObject); ok {
+		if err := validResponse.VisitGetCredentialJWTResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3160,25 +9502,32 @@ func (sh *strictHandler) AuthQRCode(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetConnections operation middleware
-func (sh *strictHandler) GetConnections(w http.ResponseWriter, r *http.Request, params GetConnectionsParams) {
-	var request GetConnectionsRequestObject
+// RenewCredentialOffer operation middleware
+func (sh *strictHandler) RenewCredentialOffer(w http.ResponseWriter, r *http.Request, id Id) {
+	var request RenewCredentialOfferRequestObject
 
-	request.Params = params
+	request.Id = id
+
+	var body RenewCredentialOfferJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetConnections(ctx, request.(GetConnectionsRequestObject))
+		return sh.ssi.RenewCredentialOffer(ctx, request.(RenewCredentialOfferRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetConnections")
+		handler = middleware(handler, "RenewCredentialOffer")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetConnectionsResponseObject); ok {
-		if err := validResponse.VisitGetConnectionsResponse(w); err != nil {
+	} else if validResponse, ok := response.(RenewCredentialOfferResponseObject); ok {
+		if err := validResponse.VisitRenewCredentialOfferResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3186,26 +9535,25 @@ func (sh *strictHandler) GetConnections(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// DeleteConnection operation middleware
-func (sh *strictHandler) DeleteConnection(w http.ResponseWriter, r *http.Request, id Id, params DeleteConnectionParams) {
-	var request DeleteConnectionRequestObject
+// GetCredentialOfferEmails operation middleware
+func (sh *strictHandler) GetCredentialOfferEmails(w http.ResponseWriter, r *http.Request, id Id) {
+	var request GetCredentialOfferEmailsRequestObject
 
 	request.Id = id
-	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteConnection(ctx, request.(DeleteConnectionRequestObject))
+		return sh.ssi.GetCredentialOfferEmails(ctx, request.(GetCredentialOfferEmailsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteConnection")
+		handler = middleware(handler, "GetCredentialOfferEmails")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteConnectionResponseObject); ok {
-		if err := validResponse.VisitDeleteConnectionResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetCredentialOfferEmailsResponseObject); ok {
+		if err := validResponse.VisitGetCredentialOfferEmailsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3213,25 +9561,25 @@ func (sh *strictHandler) DeleteConnection(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// GetConnection operation middleware
-func (sh *strictHandler) GetConnection(w http.ResponseWriter, r *http.Request, id Id) {
-	var request GetConnectionRequestObject
+// GetCredentialProblemReports operation middleware
+func (sh *strictHandler) GetCredentialProblemReports(w http.ResponseWriter, r *http.Request, id Id) {
+	var request GetCredentialProblemReportsRequestObject
 
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetConnection(ctx, request.(GetConnectionRequestObject))
+		return sh.ssi.GetCredentialProblemReports(ctx, request.(GetCredentialProblemReportsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetConnection")
+		handler = middleware(handler, "GetCredentialProblemReports")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetConnectionResponseObject); ok {
-		if err := validResponse.VisitGetConnectionResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetCredentialProblemReportsResponseObject); ok {
+		if err := validResponse.VisitGetCredentialProblemReportsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3239,25 +9587,25 @@ func (sh *strictHandler) GetConnection(w http.ResponseWriter, r *http.Request, i
 	}
 }
 
-// DeleteConnectionCredentials operation middleware
-func (sh *strictHandler) DeleteConnectionCredentials(w http.ResponseWriter, r *http.Request, id Id) {
-	var request DeleteConnectionCredentialsRequestObject
+// GetCredentialQrCode operation middleware
+func (sh *strictHandler) GetCredentialQrCode(w http.ResponseWriter, r *http.Request, id Id) {
+	var request GetCredentialQrCodeRequestObject
 
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteConnectionCredentials(ctx, request.(DeleteConnectionCredentialsRequestObject))
+		return sh.ssi.GetCredentialQrCode(ctx, request.(GetCredentialQrCodeRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteConnectionCredentials")
+		handler = middleware(handler, "GetCredentialQrCode")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteConnectionCredentialsResponseObject); ok {
-		if err := validResponse.VisitDeleteConnectionCredentialsResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetCredentialQrCodeResponseObject); ok {
+		if err := validResponse.VisitGetCredentialQrCodeResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3265,25 +9613,25 @@ func (sh *strictHandler) DeleteConnectionCredentials(w http.ResponseWriter, r *h
 	}
 }
 
-// RevokeConnectionCredentials operation middleware
-func (sh *strictHandler) RevokeConnectionCredentials(w http.ResponseWriter, r *http.Request, id Id) {
-	var request RevokeConnectionCredentialsRequestObject
+// RestoreCredential operation middleware
+func (sh *strictHandler) RestoreCredential(w http.ResponseWriter, r *http.Request, id Id) {
+	var request RestoreCredentialRequestObject
 
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.RevokeConnectionCredentials(ctx, request.(RevokeConnectionCredentialsRequestObject))
+		return sh.ssi.RestoreCredential(ctx, request.(RestoreCredentialRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "RevokeConnectionCredentials")
+		handler = middleware(handler, "RestoreCredential")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(RevokeConnectionCredentialsResponseObject); ok {
-		if err := validResponse.VisitRevokeConnectionCredentialsResponse(w); err != nil {
+	} else if validResponse, ok := response.(RestoreCredentialResponseObject); ok {
+		if err := validResponse.VisitRestoreCredentialResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3291,25 +9639,32 @@ func (sh *strictHandler) RevokeConnectionCredentials(w http.ResponseWriter, r *h
 	}
 }
 
-// GetCredentials operation middleware
-func (sh *strictHandler) GetCredentials(w http.ResponseWriter, r *http.Request, params GetCredentialsParams) {
-	var request GetCredentialsRequestObject
+// SendCredentialOffer operation middleware
+func (sh *strictHandler) SendCredentialOffer(w http.ResponseWriter, r *http.Request, id Id) {
+	var request SendCredentialOfferRequestObject
 
-	request.Params = params
+	request.Id = id
+
+	var body SendCredentialOfferJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCredentials(ctx, request.(GetCredentialsRequestObject))
+		return sh.ssi.SendCredentialOffer(ctx, request.(SendCredentialOfferRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCredentials")
+		handler = middleware(handler, "SendCredentialOffer")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetCredentialsResponseObject); ok {
-		if err := validResponse.VisitGetCredentialsResponse(w); err != nil {
+	} else if validResponse, ok := response.(SendCredentialOfferResponseObject); ok {
+		if err := validResponse.VisitSendCredentialOfferResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3317,30 +9672,25 @@ func (sh *strictHandler) GetCredentials(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// CreateCredential operation middleware
-func (sh *strictHandler) CreateCredential(w http.ResponseWriter, r *http.Request) {
-	var request CreateCredentialRequestObject
+// GetCredentialWalletLinks operation middleware
+func (sh *strictHandler) GetCredentialWalletLinks(w http.ResponseWriter, r *http.Request, id Id) {
+	var request GetCredentialWalletLinksRequestObject
 
-	var body CreateCredentialJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateCredential(ctx, request.(CreateCredentialRequestObject))
+		return sh.ssi.GetCredentialWalletLinks(ctx, request.(GetCredentialWalletLinksRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateCredential")
+		handler = middleware(handler, "GetCredentialWalletLinks")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateCredentialResponseObject); ok {
-		if err := validResponse.VisitCreateCredentialResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetCredentialWalletLinksResponseObject); ok {
+		if err := validResponse.VisitGetCredentialWalletLinksResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3348,25 +9698,23 @@ func (sh *strictHandler) CreateCredential(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// GetLinks operation middleware
-func (sh *strictHandler) GetLinks(w http.ResponseWriter, r *http.Request, params GetLinksParams) {
-	var request GetLinksRequestObject
-
-	request.Params = params
+// GetIssuerProfile operation middleware
+func (sh *strictHandler) GetIssuerProfile(w http.ResponseWriter, r *http.Request) {
+	var request GetIssuerProfileRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetLinks(ctx, request.(GetLinksRequestObject))
+		return sh.ssi.GetIssuerProfile(ctx, request.(GetIssuerProfileRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetLinks")
+		handler = middleware(handler, "GetIssuerProfile")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetLinksResponseObject); ok {
-		if err := validResponse.VisitGetLinksResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetIssuerProfileResponseObject); ok {
+		if err := validResponse.VisitGetIssuerProfileResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3374,11 +9722,11 @@ func (sh *strictHandler) GetLinks(w http.ResponseWriter, r *http.Request, params
 	}
 }
 
-// CreateLink operation middleware
-func (sh *strictHandler) CreateLink(w http.ResponseWriter, r *http.Request) {
-	var request CreateLinkRequestObject
+// UpdateIssuerProfile operation middleware
+func (sh *strictHandler) UpdateIssuerProfile(w http.ResponseWriter, r *http.Request) {
+	var request UpdateIssuerProfileRequestObject
 
-	var body CreateLinkJSONRequestBody
+	var body UpdateIssuerProfileJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -3386,18 +9734,18 @@ func (sh *strictHandler) CreateLink(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateLink(ctx, request.(CreateLinkRequestObject))
+		return sh.ssi.UpdateIssuerProfile(ctx, request.(UpdateIssuerProfileRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateLink")
+		handler = middleware(handler, "UpdateIssuerProfile")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateLinkResponseObject); ok {
-		if err := validResponse.VisitCreateLinkResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateIssuerProfileResponseObject); ok {
+		if err := validResponse.VisitUpdateIssuerProfileResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3405,33 +9753,25 @@ func (sh *strictHandler) CreateLink(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// CreateLinkQrCodeCallback operation middleware
-func (sh *strictHandler) CreateLinkQrCodeCallback(w http.ResponseWriter, r *http.Request, params CreateLinkQrCodeCallbackParams) {
-	var request CreateLinkQrCodeCallbackRequestObject
+// GetJobs operation middleware
+func (sh *strictHandler) GetJobs(w http.ResponseWriter, r *http.Request, params GetJobsParams) {
+	var request GetJobsRequestObject
 
 	request.Params = params
 
-	data, err := io.ReadAll(r.Body)
-	if err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't read body: %w", err))
-		return
-	}
-	body := CreateLinkQrCodeCallbackTextRequestBody(data)
-	request.Body = &body
-
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateLinkQrCodeCallback(ctx, request.(CreateLinkQrCodeCallbackRequestObject))
+		return sh.ssi.GetJobs(ctx, request.(GetJobsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateLinkQrCodeCallback")
+		handler = middleware(handler, "GetJobs")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateLinkQrCodeCallbackResponseObject); ok {
-		if err := validResponse.VisitCreateLinkQrCodeCallbackResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetJobsResponseObject); ok {
+		if err := validResponse.VisitGetJobsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3439,25 +9779,25 @@ func (sh *strictHandler) CreateLinkQrCodeCallback(w http.ResponseWriter, r *http
 	}
 }
 
-// DeleteLink operation middleware
-func (sh *strictHandler) DeleteLink(w http.ResponseWriter, r *http.Request, id Id) {
-	var request DeleteLinkRequestObject
+// GetJob operation middleware
+func (sh *strictHandler) GetJob(w http.ResponseWriter, r *http.Request, id Id) {
+	var request GetJobRequestObject
 
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteLink(ctx, request.(DeleteLinkRequestObject))
+		return sh.ssi.GetJob(ctx, request.(GetJobRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteLink")
+		handler = middleware(handler, "GetJob")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteLinkResponseObject); ok {
-		if err := validResponse.VisitDeleteLinkResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetJobResponseObject); ok {
+		if err := validResponse.VisitGetJobResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3465,25 +9805,25 @@ func (sh *strictHandler) DeleteLink(w http.ResponseWriter, r *http.Request, id I
 	}
 }
 
-// GetLink operation middleware
-func (sh *strictHandler) GetLink(w http.ResponseWriter, r *http.Request, id Id) {
-	var request GetLinkRequestObject
+// CancelJob operation middleware
+func (sh *strictHandler) CancelJob(w http.ResponseWriter, r *http.Request, id Id) {
+	var request CancelJobRequestObject
 
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetLink(ctx, request.(GetLinkRequestObject))
+		return sh.ssi.CancelJob(ctx, request.(CancelJobRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetLink")
+		handler = middleware(handler, "CancelJob")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetLinkResponseObject); ok {
-		if err := validResponse.VisitGetLinkResponse(w); err != nil {
+	} else if validResponse, ok := response.(CancelJobResponseObject); ok {
+		if err := validResponse.VisitCancelJobResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3491,32 +9831,25 @@ func (sh *strictHandler) GetLink(w http.ResponseWriter, r *http.Request, id Id)
 	}
 }
 
-// AcivateLink operation middleware
-func (sh *strictHandler) AcivateLink(w http.ResponseWriter, r *http.Request, id Id) {
-	var request AcivateLinkRequestObject
+// RetryJob operation middleware
+func (sh *strictHandler) RetryJob(w http.ResponseWriter, r *http.Request, id Id) {
+	var request RetryJobRequestObject
 
 	request.Id = id
 
-	var body AcivateLinkJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
-
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.AcivateLink(ctx, request.(AcivateLinkRequestObject))
+		return sh.ssi.RetryJob(ctx, request.(RetryJobRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AcivateLink")
+		handler = middleware(handler, "RetryJob")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(AcivateLinkResponseObject); ok {
-		if err := validResponse.VisitAcivateLinkResponse(w); err != nil {
+	} else if validResponse, ok := response.(RetryJobResponseObject); ok {
+		if err := validResponse.VisitRetryJobResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3524,26 +9857,25 @@ func (sh *strictHandler) AcivateLink(w http.ResponseWriter, r *http.Request, id
 	}
 }
 
-// GetLinkQRCode operation middleware
-func (sh *strictHandler) GetLinkQRCode(w http.ResponseWriter, r *http.Request, id Id, params GetLinkQRCodeParams) {
-	var request GetLinkQRCodeRequestObject
+// GetAgentMessages operation middleware
+func (sh *strictHandler) GetAgentMessages(w http.ResponseWriter, r *http.Request, params GetAgentMessagesParams) {
+	var request GetAgentMessagesRequestObject
 
-	request.Id = id
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetLinkQRCode(ctx, request.(GetLinkQRCodeRequestObject))
+		return sh.ssi.GetAgentMessages(ctx, request.(GetAgentMessagesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetLinkQRCode")
+		handler = middleware(handler, "GetAgentMessages")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetLinkQRCodeResponseObject); ok {
-		if err := validResponse.VisitGetLinkQRCodeResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetAgentMessagesResponseObject); ok {
+		if err := validResponse.VisitGetAgentMessagesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3551,25 +9883,25 @@ func (sh *strictHandler) GetLinkQRCode(w http.ResponseWriter, r *http.Request, i
 	}
 }
 
-// CreateLinkQrCode operation middleware
-func (sh *strictHandler) CreateLinkQrCode(w http.ResponseWriter, r *http.Request, id Id) {
-	var request CreateLinkQrCodeRequestObject
+// GetAgentMessageEnvelope operation middleware
+func (sh *strictHandler) GetAgentMessageEnvelope(w http.ResponseWriter, r *http.Request, id Id) {
+	var request GetAgentMessageEnvelopeRequestObject
 
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateLinkQrCode(ctx, request.(CreateLinkQrCodeRequestObject))
+		return sh.ssi.GetAgentMessageEnvelope(ctx, request.(GetAgentMessageEnvelopeRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateLinkQrCode")
+		handler = middleware(handler, "GetAgentMessageEnvelope")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateLinkQrCodeResponseObject); ok {
-		if err := validResponse.VisitCreateLinkQrCodeResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetAgentMessageEnvelopeResponseObject); ok {
+		if err := validResponse.VisitGetAgentMessageEnvelopeResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3577,25 +9909,25 @@ func (sh *strictHandler) CreateLinkQrCode(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// GetRevocationStatus operation middleware
-func (sh *strictHandler) GetRevocationStatus(w http.ResponseWriter, r *http.Request, nonce PathNonce) {
-	var request GetRevocationStatusRequestObject
+// ClaimOfferEmail operation middleware
+func (sh *strictHandler) ClaimOfferEmail(w http.ResponseWriter, r *http.Request, id Id) {
+	var request ClaimOfferEmailRequestObject
 
-	request.Nonce = nonce
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetRevocationStatus(ctx, request.(GetRevocationStatusRequestObject))
+		return sh.ssi.ClaimOfferEmail(ctx, request.(ClaimOfferEmailRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetRevocationStatus")
+		handler = middleware(handler, "ClaimOfferEmail")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetRevocationStatusResponseObject); ok {
-		if err := validResponse.VisitGetRevocationStatusResponse(w); err != nil {
+	} else if validResponse, ok := response.(ClaimOfferEmailResponseObject); ok {
+		if err := validResponse.VisitClaimOfferEmailResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3603,25 +9935,25 @@ func (sh *strictHandler) GetRevocationStatus(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// RevokeCredential operation middleware
-func (sh *strictHandler) RevokeCredential(w http.ResponseWriter, r *http.Request, nonce PathNonce) {
-	var request RevokeCredentialRequestObject
+// OpenOfferEmail operation middleware
+func (sh *strictHandler) OpenOfferEmail(w http.ResponseWriter, r *http.Request, id Id) {
+	var request OpenOfferEmailRequestObject
 
-	request.Nonce = nonce
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.RevokeCredential(ctx, request.(RevokeCredentialRequestObject))
+		return sh.ssi.OpenOfferEmail(ctx, request.(OpenOfferEmailRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "RevokeCredential")
+		handler = middleware(handler, "OpenOfferEmail")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(RevokeCredentialResponseObject); ok {
-		if err := validResponse.VisitRevokeCredentialResponse(w); err != nil {
+	} else if validResponse, ok := response.(OpenOfferEmailResponseObject); ok {
+		if err := validResponse.VisitOpenOfferEmailResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3629,25 +9961,25 @@ func (sh *strictHandler) RevokeCredential(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// DeleteCredential operation middleware
-func (sh *strictHandler) DeleteCredential(w http.ResponseWriter, r *http.Request, id Id) {
-	var request DeleteCredentialRequestObject
+// GetSchemas operation middleware
+func (sh *strictHandler) GetSchemas(w http.ResponseWriter, r *http.Request, params GetSchemasParams) {
+	var request GetSchemasRequestObject
 
-	request.Id = id
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteCredential(ctx, request.(DeleteCredentialRequestObject))
+		return sh.ssi.GetSchemas(ctx, request.(GetSchemasRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteCredential")
+		handler = middleware(handler, "GetSchemas")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteCredentialResponseObject); ok {
-		if err := validResponse.VisitDeleteCredentialResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetSchemasResponseObject); ok {
+		if err := validResponse.VisitGetSchemasResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3655,25 +9987,30 @@ func (sh *strictHandler) DeleteCredential(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// GetCredential operation middleware
-func (sh *strictHandler) GetCredential(w http.ResponseWriter, r *http.Request, id Id) {
-	var request GetCredentialRequestObject
+// ImportSchema operation middleware
+func (sh *strictHandler) ImportSchema(w http.ResponseWriter, r *http.Request) {
+	var request ImportSchemaRequestObject
 
-	request.Id = id
+	var body ImportSchemaJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCredential(ctx, request.(GetCredentialRequestObject))
+		return sh.ssi.ImportSchema(ctx, request.(ImportSchemaRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCredential")
+		handler = middleware(handler, "ImportSchema")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetCredentialResponseObject); ok {
-		if err := validResponse.VisitGetCredentialResponse(w); err != nil {
+	} else if validResponse, ok := response.(ImportSchemaResponseObject); ok {
+		if err := validResponse.VisitImportSchemaResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3681,25 +10018,30 @@ func (sh *strictHandler) GetCredential(w http.ResponseWriter, r *http.Request, i
 	}
 }
 
-// GetCredentialQrCode operation middleware
-func (sh *strictHandler) GetCredentialQrCode(w http.ResponseWriter, r *http.Request, id Id) {
-	var request GetCredentialQrCodeRequestObject
+// BuildSchema operation middleware
+func (sh *strictHandler) BuildSchema(w http.ResponseWriter, r *http.Request) {
+	var request BuildSchemaRequestObject
 
-	request.Id = id
+	var body BuildSchemaJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCredentialQrCode(ctx, request.(GetCredentialQrCodeRequestObject))
+		return sh.ssi.BuildSchema(ctx, request.(BuildSchemaRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCredentialQrCode")
+		handler = middleware(handler, "BuildSchema")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetCredentialQrCodeResponseObject); ok {
-		if err := validResponse.VisitGetCredentialQrCodeResponse(w); err != nil {
+	} else if validResponse, ok := response.(BuildSchemaResponseObject); ok {
+		if err := validResponse.VisitBuildSchemaResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3707,25 +10049,25 @@ func (sh *strictHandler) GetCredentialQrCode(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// GetSchemas operation middleware
-func (sh *strictHandler) GetSchemas(w http.ResponseWriter, r *http.Request, params GetSchemasParams) {
-	var request GetSchemasRequestObject
+// GetSchema operation middleware
+func (sh *strictHandler) GetSchema(w http.ResponseWriter, r *http.Request, id Id) {
+	var request GetSchemaRequestObject
 
-	request.Params = params
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetSchemas(ctx, request.(GetSchemasRequestObject))
+		return sh.ssi.GetSchema(ctx, request.(GetSchemaRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetSchemas")
+		handler = middleware(handler, "GetSchema")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetSchemasResponseObject); ok {
-		if err := validResponse.VisitGetSchemasResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetSchemaResponseObject); ok {
+		if err := validResponse.VisitGetSchemaResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3733,11 +10075,14 @@ func (sh *strictHandler) GetSchemas(w http.ResponseWriter, r *http.Request, para
 	}
 }
 
-// ImportSchema operation middleware
-func (sh *strictHandler) ImportSchema(w http.ResponseWriter, r *http.Request) {
-	var request ImportSchemaRequestObject
+// UpdateSchema operation middleware
+func (sh *strictHandler) UpdateSchema(w http.ResponseWriter, r *http.Request, id Id, params UpdateSchemaParams) {
+	var request UpdateSchemaRequestObject
 
-	var body ImportSchemaJSONRequestBody
+	request.Id = id
+	request.Params = params
+
+	var body UpdateSchemaJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -3745,18 +10090,18 @@ func (sh *strictHandler) ImportSchema(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ImportSchema(ctx, request.(ImportSchemaRequestObject))
+		return sh.ssi.UpdateSchema(ctx, request.(UpdateSchemaRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ImportSchema")
+		handler = middleware(handler, "UpdateSchema")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ImportSchemaResponseObject); ok {
-		if err := validResponse.VisitImportSchemaResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateSchemaResponseObject); ok {
+		if err := validResponse.VisitUpdateSchemaResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3764,25 +10109,51 @@ func (sh *strictHandler) ImportSchema(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetSchema operation middleware
-func (sh *strictHandler) GetSchema(w http.ResponseWriter, r *http.Request, id Id) {
-	var request GetSchemaRequestObject
+// GetSchemaContext operation middleware
+func (sh *strictHandler) GetSchemaContext(w http.ResponseWriter, r *http.Request, id Id) {
+	var request GetSchemaContextRequestObject
 
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetSchema(ctx, request.(GetSchemaRequestObject))
+		return sh.ssi.GetSchemaContext(ctx, request.(GetSchemaContextRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetSchema")
+		handler = middleware(handler, "GetSchemaContext")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetSchemaResponseObject); ok {
-		if err := validResponse.VisitGetSchemaResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetSchemaContextResponseObject); ok {
+		if err := validResponse.VisitGetSchemaContextResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// GetSchemaJSON operation middleware
+func (sh *strictHandler) GetSchemaJSON(w http.ResponseWriter, r *http.Request, id Id) {
+	var request GetSchemaJSONRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSchemaJSON(ctx, request.(GetSchemaJSONRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSchemaJSON")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetSchemaJSONResponseObject); ok {
+		if err := validResponse.VisitGetSchemaJSONResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3885,3 +10256,29 @@ func (sh *strictHandler) GetStateTransactions(w http.ResponseWriter, r *http.Req
 		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
 	}
 }
+
+// GetSubjectBinding operation middleware
+func (sh *strictHandler) GetSubjectBinding(w http.ResponseWriter, r *http.Request, externalID string) {
+	var request GetSubjectBindingRequestObject
+
+	request.ExternalID = externalID
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSubjectBinding(ctx, request.(GetSubjectBindingRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSubjectBinding")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetSubjectBindingResponseObject); ok {
+		if err := validResponse.VisitGetSubjectBindingResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
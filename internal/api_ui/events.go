@@ -0,0 +1,139 @@
+package api_ui
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/event"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+	"github.com/polygonid/sh-id-platform/pkg/pubsub"
+)
+
+const (
+	sessionEventsMaxDuration = 5 * time.Minute  // sessionEventsMaxDuration is the lifetime of a session in the session store
+	sessionEventsKeepAlive   = 15 * time.Second // sessionEventsKeepAlive is how often a comment is sent to keep idle connections open
+)
+
+// RegisterSessionEvents adds the server-sent events endpoints that stream the state transitions of
+// credential link and authentication sessions.
+// These endpoints are not part of the strict server because they need to write and flush the response
+// while the request is alive, so they check the basic auth of the UI API themselves.
+func RegisterSessionEvents(ctx context.Context, mux *chi.Mux, auth config.APIUIAuth, sessions ports.SessionRepository, subscriber pubsub.Subscriber) {
+	mux.Get("/v1/credentials/links/{sessionID}/events", basicAuth(auth.User, auth.Password, sessionEvents(ctx, sessions, subscriber, domain.SessionStatusIssued, domain.SessionStatusFailed)))
+	mux.Get("/v1/authentication/sessions/{sessionID}/events", basicAuth(auth.User, auth.Password, sessionEvents(ctx, sessions, subscriber, domain.SessionStatusAuthenticated, domain.SessionStatusFailed)))
+}
+
+// sessionEvents streams the session state until it reaches one of the final statuses, the client goes away or
+// the session expires.
+func sessionEvents(ctx context.Context, sessions ports.SessionRepository, subscriber pubsub.Subscriber, final ...domain.SessionStatus) http.HandlerFunc {
+	isFinal := func(status domain.SessionStatus) bool {
+		for _, f := range final {
+			if status == f {
+				return true
+			}
+		}
+		return false
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := log.CopyFromContext(ctx, r.Context())
+		sessionID, err := uuid.Parse(chi.URLParam(r, "sessionID"))
+		if err != nil {
+			writeJSONError(w, http.StatusBadRequest, "invalid sessionID")
+			return
+		}
+
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			writeJSONError(w, http.StatusInternalServerError, "streaming is not supported")
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, sessionEventsMaxDuration)
+		defer cancel()
+
+		// Subscribe before reading the current state so no transition is lost in between
+		updates := make(chan domain.SessionState, 1)
+		subscriber.Subscribe(ctx, event.SessionStateTopic(sessionID.String()), func(ctx context.Context, msg pubsub.Message) error {
+			var ev event.SessionState
+			if err := ev.Unmarshal(msg); err != nil {
+				return err
+			}
+			select {
+			case updates <- domain.NewSessionState(sessionID, domain.SessionStatus(ev.Status), ev.Message):
+			case <-ctx.Done():
+			}
+			return nil
+		})
+
+		state, err := sessions.GetState(ctx, sessionID)
+		if err != nil {
+			if errors.Is(err, repositories.ErrSessionStateNotFound) {
+				writeJSONError(w, http.StatusNotFound, "session not found")
+				return
+			}
+			log.Error(ctx, "getting session state", "err", err, "sessionID", sessionID)
+			writeJSONError(w, http.StatusInternalServerError, "cannot get the session state")
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("Connection", "keep-alive")
+		w.WriteHeader(http.StatusOK)
+
+		keepAlive := time.NewTicker(sessionEventsKeepAlive)
+		defer keepAlive.Stop()
+		for {
+			if err := writeSessionEvent(w, state); err != nil {
+				log.Debug(ctx, "writing session event", "err", err, "sessionID", sessionID)
+				return
+			}
+			flusher.Flush()
+			if isFinal(state.Status) {
+				return
+			}
+
+			next := state
+			for next.Status == state.Status {
+				select {
+				case <-ctx.Done():
+					return
+				case <-keepAlive.C:
+					if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+						return
+					}
+					flusher.Flush()
+				case next = <-updates:
+				}
+			}
+			state = next
+		}
+	}
+}
+
+func writeSessionEvent(w http.ResponseWriter, state domain.SessionState) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", state.Status, data)
+	return err
+}
+
+func writeJSONError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(GenericErrorMessage{Message: message})
+}
@@ -0,0 +1,130 @@
+package api_ui
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/event"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+	"github.com/polygonid/sh-id-platform/pkg/cache"
+	"github.com/polygonid/sh-id-platform/pkg/pubsub"
+)
+
+type subscriberMock struct {
+	mu       sync.Mutex
+	handlers map[string]pubsub.EventHandler
+}
+
+func (s *subscriberMock) Subscribe(_ context.Context, topic string, callback pubsub.EventHandler) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handlers[topic] = callback
+}
+
+func (s *subscriberMock) publish(ctx context.Context, topic string, ev pubsub.Event) error {
+	s.mu.Lock()
+	handler := s.handlers[topic]
+	s.mu.Unlock()
+	msg, err := ev.Marshal()
+	if err != nil {
+		return err
+	}
+	return handler(ctx, msg)
+}
+
+func TestSessionEvents(t *testing.T) {
+	ctx := context.Background()
+	sessions := repositories.NewSessionCached(cache.NewMemoryCache(), 0)
+	subscriber := &subscriberMock{handlers: map[string]pubsub.EventHandler{}}
+	mux := chi.NewRouter()
+	RegisterSessionEvents(ctx, mux, config.APIUIAuth{User: "user", Password: "password"}, sessions, subscriber)
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	get := func(t *testing.T, url string) *http.Response {
+		t.Helper()
+		req, err := http.NewRequest(http.MethodGet, server.URL+url, nil)
+		require.NoError(t, err)
+		req.SetBasicAuth("user", "password")
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		return resp
+	}
+
+	t.Run("unauthorized", func(t *testing.T) {
+		sessionID := uuid.New()
+		require.NoError(t, sessions.SetState(ctx, domain.NewSessionState(sessionID, domain.SessionStatusAuthenticated, "")))
+		for _, url := range []string{"/v1/credentials/links/" + sessionID.String() + "/events", "/v1/authentication/sessions/" + sessionID.String() + "/events"} {
+			resp, err := http.Get(server.URL + url)
+			require.NoError(t, err)
+			_ = resp.Body.Close()
+			assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+		}
+	})
+
+	t.Run("invalid session id", func(t *testing.T) {
+		resp := get(t, "/v1/credentials/links/wrong/events")
+		defer func() { _ = resp.Body.Close() }()
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("unknown session", func(t *testing.T) {
+		resp := get(t, "/v1/credentials/links/"+uuid.NewString()+"/events")
+		defer func() { _ = resp.Body.Close() }()
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("final state closes the stream", func(t *testing.T) {
+		sessionID := uuid.New()
+		require.NoError(t, sessions.SetState(ctx, domain.NewSessionState(sessionID, domain.SessionStatusAuthenticated, "")))
+		resp := get(t, "/v1/authentication/sessions/"+sessionID.String()+"/events")
+		defer func() { _ = resp.Body.Close() }()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+		assert.Equal(t, []string{"authenticated"}, readEventNames(t, bufio.NewScanner(resp.Body), -1))
+	})
+
+	t.Run("link session transitions", func(t *testing.T) {
+		sessionID := uuid.New()
+		require.NoError(t, sessions.SetState(ctx, domain.NewSessionState(sessionID, domain.SessionStatusPending, "")))
+		resp := get(t, "/v1/credentials/links/"+sessionID.String()+"/events")
+		defer func() { _ = resp.Body.Close() }()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		scanner := bufio.NewScanner(resp.Body)
+		assert.Equal(t, []string{"pending"}, readEventNames(t, scanner, 1))
+
+		topic := event.SessionStateTopic(sessionID.String())
+		require.NoError(t, subscriber.publish(ctx, topic, &event.SessionState{SessionID: sessionID.String(), Status: string(domain.SessionStatusAuthenticated)}))
+		assert.Equal(t, []string{"authenticated"}, readEventNames(t, scanner, 1))
+
+		// authenticated is not final for link sessions
+		require.NoError(t, subscriber.publish(ctx, topic, &event.SessionState{SessionID: sessionID.String(), Status: string(domain.SessionStatusIssued)}))
+		assert.Equal(t, []string{"issued"}, readEventNames(t, scanner, -1))
+	})
+}
+
+// readEventNames reads n events from the stream, or until the stream is closed if n is negative
+func readEventNames(t *testing.T, scanner *bufio.Scanner, n int) []string {
+	t.Helper()
+	var names []string
+	for (n < 0 || len(names) < n) && scanner.Scan() {
+		if name, found := strings.CutPrefix(scanner.Text(), "event: "); found {
+			names = append(names, name)
+		}
+	}
+	require.NoError(t, scanner.Err())
+	return names
+}
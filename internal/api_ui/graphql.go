@@ -0,0 +1,480 @@
+package api_ui
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+	core "github.com/iden3/go-iden3-core"
+	"github.com/iden3/go-schema-processor/verifiable"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/pkg/schema"
+)
+
+const (
+	graphQLMaxDepth       = 6  // graphQLMaxDepth limits the nesting of the queries
+	graphQLMaxParallelism = 10 // graphQLMaxParallelism is the number of fields resolved at once in a request
+)
+
+// graphQLSchema is read only: connections, credentials and schemas are changed with the REST endpoints
+const graphQLSchema = `
+schema {
+	query: Query
+}
+
+scalar Time
+
+type Query {
+	connections(query: String, tags: [String!]): [Connection!]!
+	connection(id: ID!): Connection
+	credentials(did: String, status: String, query: String): [Credential!]!
+	credential(id: ID!): Credential
+	schemas(query: String): [Schema!]!
+	schema(id: ID!): Schema
+}
+
+type Connection {
+	id: ID!
+	userID: String!
+	createdAt: Time!
+	displayName: String
+	tags: [String!]!
+	credentials: [Credential!]!
+}
+
+type Credential {
+	id: ID!
+	userID: String!
+	schemaType: String!
+	schemaURL: String!
+	schemaHash: String!
+	createdAt: Time!
+	expiresAt: Time
+	expired: Boolean!
+	revoked: Boolean!
+	revNonce: String!
+	credentialSubject: String!
+	schema: Schema
+	revocation: Revocation
+}
+
+type Schema {
+	id: ID!
+	url: String!
+	type: String!
+	hash: String!
+	version: Int!
+	deprecated: Boolean!
+	createdAt: Time!
+}
+
+type Revocation {
+	nonce: String!
+	published: Boolean!
+	reason: String
+}
+`
+
+// RegisterGraphQL adds the read only GraphQL endpoint, that fetches connections with their credentials, and the schema
+// and revocation of every credential, in a single request. The nested fields are loaded in batches per request, so a
+// query costs one database query per level instead of one per item.
+func RegisterGraphQL(ctx context.Context, mux *chi.Mux, issuerDID core.DID, auth config.APIUIAuth, identityService ports.IdentityService, claimService ports.ClaimsService, connectionsService ports.ConnectionsService, schemaService ports.SchemaService) {
+	mux.Post("/v1/graphql", basicAuth(auth.User, auth.Password, graphQLHandler(ctx, issuerDID, identityService, claimService, connectionsService, schemaService)))
+}
+
+func graphQLHandler(ctx context.Context, issuerDID core.DID, identityService ports.IdentityService, claimService ports.ClaimsService, connectionsService ports.ConnectionsService, schemaService ports.SchemaService) http.HandlerFunc {
+	resolver := &graphQLResolver{issuerDID: issuerDID, identity: identityService, claims: claimService, connections: connectionsService, schemas: schemaService}
+	handler := &relay.Handler{Schema: graphql.MustParseSchema(graphQLSchema, resolver,
+		graphql.MaxDepth(graphQLMaxDepth),
+		graphql.MaxParallelism(graphQLMaxParallelism),
+	)}
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := log.CopyFromContext(ctx, r.Context())
+		ctx = context.WithValue(ctx, graphQLLoadersKey{}, resolver.newLoaders())
+		handler.ServeHTTP(w, r.WithContext(ctx))
+	}
+}
+
+type graphQLLoadersKey struct{}
+
+// graphQLLoaders are the batch loaders of a request
+type graphQLLoaders struct {
+	credentials *batchLoader[string, []*domain.Claim]                   // by holder DID
+	schemas     *batchLoader[string, *domain.Schema]                    // by url
+	revocations *batchLoader[domain.RevNonceUint64, *domain.Revocation] // by revocation nonce
+}
+
+func loadersFromContext(ctx context.Context) *graphQLLoaders {
+	return ctx.Value(graphQLLoadersKey{}).(*graphQLLoaders)
+}
+
+type graphQLResolver struct {
+	issuerDID   core.DID
+	identity    ports.IdentityService
+	claims      ports.ClaimsService
+	connections ports.ConnectionsService
+	schemas     ports.SchemaService
+}
+
+func (r *graphQLResolver) newLoaders() *graphQLLoaders {
+	loaders := &graphQLLoaders{}
+	loaders.credentials = newBatchLoader(func(ctx context.Context, holders []string) (map[string][]*domain.Claim, error) {
+		credentials, err := r.claims.GetAll(ctx, r.issuerDID, &ports.ClaimsFilter{Subjects: holders})
+		if err != nil && !errors.Is(err, services.ErrClaimNotFound) {
+			return nil, err
+		}
+		byHolder := make(map[string][]*domain.Claim, len(holders))
+		for _, credential := range credentials {
+			byHolder[credential.OtherIdentifier] = append(byHolder[credential.OtherIdentifier], credential)
+		}
+		loaders.prime(credentials)
+		return byHolder, nil
+	})
+	loaders.schemas = newBatchLoader(func(ctx context.Context, urls []string) (map[string]*domain.Schema, error) {
+		schemas, err := r.schemas.GetAll(ctx, r.issuerDID, &ports.SchemasFilter{URLs: urls})
+		if err != nil {
+			return nil, err
+		}
+		byURL := make(map[string]*domain.Schema, len(urls))
+		for i := range schemas {
+			// Schemas are sorted newest first, so the latest import of an url is kept
+			if _, ok := byURL[schemas[i].URL]; !ok {
+				byURL[schemas[i].URL] = &schemas[i]
+			}
+		}
+		return byURL, nil
+	})
+	loaders.revocations = newBatchLoader(func(ctx context.Context, nonces []domain.RevNonceUint64) (map[domain.RevNonceUint64]*domain.Revocation, error) {
+		revocations, err := r.identity.GetRevocations(ctx, r.issuerDID, nonces)
+		if err != nil {
+			return nil, err
+		}
+		byNonce := make(map[domain.RevNonceUint64]*domain.Revocation, len(revocations))
+		for _, revocation := range revocations {
+			byNonce[revocation.Nonce] = revocation
+		}
+		return byNonce, nil
+	})
+	return loaders
+}
+
+// prime adds the schemas and revocations of the credentials to the next batches
+func (l *graphQLLoaders) prime(credentials []*domain.Claim) {
+	for _, credential := range credentials {
+		l.schemas.prime(credential.SchemaURL)
+		if credential.Revoked {
+			l.revocations.prime(credential.RevNonce)
+		}
+	}
+}
+
+func (r *graphQLResolver) Connections(ctx context.Context, args struct {
+	Query *string
+	Tags  *[]string
+},
+) ([]*connectionResolver, error) {
+	filter := &ports.ConnectionsFilter{}
+	if args.Query != nil {
+		filter.Query = *args.Query
+	}
+	if args.Tags != nil {
+		filter.Tags = *args.Tags
+	}
+	conns, err := r.connections.GetAllByIssuerID(ctx, r.issuerDID, filter, false)
+	if err != nil {
+		log.Error(ctx, "graphql connections", "err", err)
+		return nil, errors.New("unexpected error while getting connections")
+	}
+	loaders := loadersFromContext(ctx)
+	resp := make([]*connectionResolver, len(conns))
+	for i, conn := range conns {
+		loaders.credentials.prime(conn.UserDID.String())
+		resp[i] = &connectionResolver{conn: conn}
+	}
+	return resp, nil
+}
+
+func (r *graphQLResolver) Connection(ctx context.Context, args struct{ ID graphql.ID }) (*connectionResolver, error) {
+	id, err := uuid.Parse(string(args.ID))
+	if err != nil {
+		return nil, errors.New("invalid connection id")
+	}
+	conn, err := r.connections.GetByIDAndIssuerID(ctx, id, r.issuerDID)
+	if errors.Is(err, services.ErrConnectionDoesNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		log.Error(ctx, "graphql connection", "err", err, "id", id)
+		return nil, errors.New("unexpected error while getting the connection")
+	}
+	return &connectionResolver{conn: conn}, nil
+}
+
+func (r *graphQLResolver) Credentials(ctx context.Context, args struct {
+	Did    *string
+	Status *string
+	Query  *string
+},
+) ([]*credentialResolver, error) {
+	filter, err := getCredentialsFilter(ctx, args.Did, (*GetCredentialsParamsStatus)(args.Status), args.Query)
+	if err != nil {
+		return nil, err
+	}
+	credentials, err := r.claims.GetAll(ctx, r.issuerDID, filter)
+	if err != nil && !errors.Is(err, services.ErrClaimNotFound) {
+		log.Error(ctx, "graphql credentials", "err", err)
+		return nil, errors.New("unexpected error while getting credentials")
+	}
+	loadersFromContext(ctx).prime(credentials)
+	return credentialResolvers(credentials)
+}
+
+func (r *graphQLResolver) Credential(ctx context.Context, args struct{ ID graphql.ID }) (*credentialResolver, error) {
+	id, err := uuid.Parse(string(args.ID))
+	if err != nil {
+		return nil, errors.New("invalid credential id")
+	}
+	credential, err := r.claims.GetByID(ctx, &r.issuerDID, id)
+	if errors.Is(err, services.ErrClaimNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		log.Error(ctx, "graphql credential", "err", err, "id", id)
+		return nil, errors.New("unexpected error while getting the credential")
+	}
+	return newCredentialResolver(credential)
+}
+
+func (r *graphQLResolver) Schemas(ctx context.Context, args struct{ Query *string }) ([]*schemaResolver, error) {
+	schemas, err := r.schemas.GetAll(ctx, r.issuerDID, &ports.SchemasFilter{Query: args.Query})
+	if err != nil {
+		log.Error(ctx, "graphql schemas", "err", err)
+		return nil, errors.New("unexpected error while getting schemas")
+	}
+	resp := make([]*schemaResolver, len(schemas))
+	for i := range schemas {
+		resp[i] = &schemaResolver{schema: &schemas[i]}
+	}
+	return resp, nil
+}
+
+func (r *graphQLResolver) Schema(ctx context.Context, args struct{ ID graphql.ID }) (*schemaResolver, error) {
+	id, err := uuid.Parse(string(args.ID))
+	if err != nil {
+		return nil, errors.New("invalid schema id")
+	}
+	s, err := r.schemas.GetByID(ctx, r.issuerDID, id)
+	if errors.Is(err, services.ErrSchemaNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		log.Error(ctx, "graphql schema", "err", err, "id", id)
+		return nil, errors.New("unexpected error while getting the schema")
+	}
+	return &schemaResolver{schema: s}, nil
+}
+
+type connectionResolver struct {
+	conn *domain.Connection
+}
+
+func (c *connectionResolver) ID() graphql.ID {
+	return graphql.ID(c.conn.ID.String())
+}
+
+func (c *connectionResolver) UserID() string {
+	return c.conn.UserDID.String()
+}
+
+func (c *connectionResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: c.conn.CreatedAt}
+}
+
+func (c *connectionResolver) DisplayName() *string {
+	if c.conn.Metadata == nil || c.conn.Metadata.DisplayName == "" {
+		return nil
+	}
+	return &c.conn.Metadata.DisplayName
+}
+
+func (c *connectionResolver) Tags() []string {
+	if c.conn.Metadata == nil || c.conn.Metadata.Tags == nil {
+		return []string{}
+	}
+	return c.conn.Metadata.Tags
+}
+
+func (c *connectionResolver) Credentials(ctx context.Context) ([]*credentialResolver, error) {
+	credentials, err := loadersFromContext(ctx).credentials.load(ctx, c.conn.UserDID.String())
+	if err != nil {
+		log.Error(ctx, "graphql connection credentials", "err", err, "connection", c.conn.ID)
+		return nil, errors.New("unexpected error while getting the credentials of the connection")
+	}
+	return credentialResolvers(credentials)
+}
+
+type credentialResolver struct {
+	credential *domain.Claim
+	w3c        *verifiable.W3CCredential
+}
+
+func newCredentialResolver(credential *domain.Claim) (*credentialResolver, error) {
+	w3c, err := schema.FromClaimModelToW3CCredential(*credential)
+	if err != nil {
+		return nil, errors.New("invalid claim format")
+	}
+	return &credentialResolver{credential: credential, w3c: w3c}, nil
+}
+
+func credentialResolvers(credentials []*domain.Claim) ([]*credentialResolver, error) {
+	resp := make([]*credentialResolver, len(credentials))
+	for i, credential := range credentials {
+		var err error
+		if resp[i], err = newCredentialResolver(credential); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+func (c *credentialResolver) ID() graphql.ID {
+	return graphql.ID(c.credential.ID.String())
+}
+
+func (c *credentialResolver) UserID() string {
+	return c.credential.OtherIdentifier
+}
+
+func (c *credentialResolver) SchemaType() string {
+	return shortType(c.credential.SchemaType)
+}
+
+func (c *credentialResolver) SchemaURL() string {
+	return c.credential.SchemaURL
+}
+
+func (c *credentialResolver) SchemaHash() string {
+	return c.credential.SchemaHash
+}
+
+func (c *credentialResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: *c.w3c.IssuanceDate}
+}
+
+func (c *credentialResolver) ExpiresAt() *graphql.Time {
+	if c.w3c.Expiration == nil {
+		return nil
+	}
+	return &graphql.Time{Time: *c.w3c.Expiration}
+}
+
+func (c *credentialResolver) Expired() bool {
+	return c.w3c.Expiration != nil && time.Now().UTC().After(c.w3c.Expiration.UTC())
+}
+
+func (c *credentialResolver) Revoked() bool {
+	return c.credential.Revoked
+}
+
+func (c *credentialResolver) RevNonce() string {
+	return strconv.FormatUint(uint64(c.credential.RevNonce), 10)
+}
+
+// CredentialSubject is the credential subject as a JSON document, as its attributes depend on the schema
+func (c *credentialResolver) CredentialSubject() (string, error) {
+	subject, err := json.Marshal(c.w3c.CredentialSubject)
+	return string(subject), err
+}
+
+func (c *credentialResolver) Schema(ctx context.Context) (*schemaResolver, error) {
+	s, err := loadersFromContext(ctx).schemas.load(ctx, c.credential.SchemaURL)
+	if err != nil {
+		log.Error(ctx, "graphql credential schema", "err", err, "credential", c.credential.ID)
+		return nil, errors.New("unexpected error while getting the schema of the credential")
+	}
+	if s == nil {
+		return nil, nil
+	}
+	return &schemaResolver{schema: s}, nil
+}
+
+// Revocation is null for the credentials that are not revoked
+func (c *credentialResolver) Revocation(ctx context.Context) (*revocationResolver, error) {
+	if !c.credential.Revoked {
+		return nil, nil
+	}
+	revocation, err := loadersFromContext(ctx).revocations.load(ctx, c.credential.RevNonce)
+	if err != nil {
+		log.Error(ctx, "graphql credential revocation", "err", err, "credential", c.credential.ID)
+		return nil, errors.New("unexpected error while getting the revocation of the credential")
+	}
+	if revocation == nil {
+		revocation = &domain.Revocation{Nonce: c.credential.RevNonce, Status: domain.RevPending}
+	}
+	return &revocationResolver{revocation: revocation}, nil
+}
+
+type schemaResolver struct {
+	schema *domain.Schema
+}
+
+func (s *schemaResolver) ID() graphql.ID {
+	return graphql.ID(s.schema.ID.String())
+}
+
+func (s *schemaResolver) URL() string {
+	return s.schema.URL
+}
+
+func (s *schemaResolver) Type() string {
+	return s.schema.Type
+}
+
+func (s *schemaResolver) Hash() string {
+	hash, _ := s.schema.Hash.MarshalText()
+	return string(hash)
+}
+
+func (s *schemaResolver) Version() int32 {
+	return int32(s.schema.SchemaVersion)
+}
+
+func (s *schemaResolver) Deprecated() bool {
+	return s.schema.DeprecatedAt != nil
+}
+
+func (s *schemaResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: s.schema.CreatedAt}
+}
+
+type revocationResolver struct {
+	revocation *domain.Revocation
+}
+
+func (r *revocationResolver) Nonce() string {
+	return strconv.FormatUint(uint64(r.revocation.Nonce), 10)
+}
+
+// Published is true once the state with the revocation is published on chain
+func (r *revocationResolver) Published() bool {
+	return r.revocation.Status == domain.RevPublished
+}
+
+func (r *revocationResolver) Reason() *string {
+	if r.revocation.Description == "" {
+		return nil
+	}
+	return &r.revocation.Description
+}
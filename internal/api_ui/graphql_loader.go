@@ -0,0 +1,65 @@
+package api_ui
+
+import (
+	"context"
+	"sync"
+)
+
+// batchLoader loads values by key in batches, so the fields of the items of a list are resolved with one query
+// instead of one per item. The keys are primed when the list is resolved, and the first load of any of them
+// fetches all the keys primed so far at once. Loaded values are kept for the rest of the request.
+type batchLoader[K comparable, V any] struct {
+	mu      sync.Mutex
+	fetch   func(ctx context.Context, keys []K) (map[K]V, error)
+	pending []K
+	primed  map[K]bool
+	values  map[K]V
+	errs    map[K]error
+}
+
+func newBatchLoader[K comparable, V any](fetch func(ctx context.Context, keys []K) (map[K]V, error)) *batchLoader[K, V] {
+	return &batchLoader[K, V]{
+		fetch:  fetch,
+		primed: make(map[K]bool),
+		values: make(map[K]V),
+		errs:   make(map[K]error),
+	}
+}
+
+// prime adds the keys to the next batch
+func (l *batchLoader[K, V]) prime(keys ...K) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, key := range keys {
+		if !l.primed[key] {
+			l.primed[key] = true
+			l.pending = append(l.pending, key)
+		}
+	}
+}
+
+// load returns the value of the key, fetching it with the rest of the pending keys if it was not loaded yet.
+// Keys that the fetch does not return have the zero value.
+func (l *batchLoader[K, V]) load(ctx context.Context, key K) (V, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if !l.primed[key] {
+		l.primed[key] = true
+		l.pending = append(l.pending, key)
+	}
+	_, loaded := l.values[key]
+	_, failed := l.errs[key]
+	if !loaded && !failed {
+		keys := l.pending
+		l.pending = nil
+		values, err := l.fetch(ctx, keys)
+		for _, k := range keys {
+			if err != nil {
+				l.errs[k] = err
+				continue
+			}
+			l.values[k] = values[k]
+		}
+	}
+	return l.values[key], l.errs[key]
+}
@@ -0,0 +1,188 @@
+package api_ui
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+	"github.com/iden3/go-schema-processor/verifiable"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+)
+
+type graphQLConnectionsMock struct {
+	ports.ConnectionsService
+	conns []*domain.Connection
+}
+
+func (m *graphQLConnectionsMock) GetAllByIssuerID(context.Context, core.DID, *ports.ConnectionsFilter, bool) ([]*domain.Connection, error) {
+	return m.conns, nil
+}
+
+type graphQLClaimsMock struct {
+	ports.ClaimsService
+	claims  []*domain.Claim
+	filters []*ports.ClaimsFilter
+}
+
+func (m *graphQLClaimsMock) GetAll(_ context.Context, _ core.DID, filter *ports.ClaimsFilter) ([]*domain.Claim, error) {
+	m.filters = append(m.filters, filter)
+	return m.claims, nil
+}
+
+type graphQLSchemasMock struct {
+	ports.SchemaService
+	schemas []domain.Schema
+	filters []*ports.SchemasFilter
+}
+
+func (m *graphQLSchemasMock) GetAll(_ context.Context, _ core.DID, filter *ports.SchemasFilter) ([]domain.Schema, error) {
+	m.filters = append(m.filters, filter)
+	return m.schemas, nil
+}
+
+type graphQLIdentityMock struct {
+	ports.IdentityService
+	revocations []*domain.Revocation
+	nonces      [][]domain.RevNonceUint64
+}
+
+func (m *graphQLIdentityMock) GetRevocations(_ context.Context, _ core.DID, nonces []domain.RevNonceUint64) ([]*domain.Revocation, error) {
+	m.nonces = append(m.nonces, nonces)
+	return m.revocations, nil
+}
+
+func TestGraphQL_ConnectionsWithCredentials(t *testing.T) {
+	issuerDID, err := core.ParseDID("did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ")
+	require.NoError(t, err)
+	aliceDID, err := core.ParseDID("did:polygonid:polygon:mumbai:2qFDkNkWePjd6URt6kGQX14a7wVKhBZt8bpy7HZJZi")
+	require.NoError(t, err)
+	bobDID, err := core.ParseDID("did:polygonid:polygon:mumbai:2qH7XAwYQzCp9VfhpNgeLtK2iCehDDrfMWUCEg5ig5")
+	require.NoError(t, err)
+	auth := config.APIUIAuth{User: "user", Password: "password"}
+	const schemaURL = "https://example.com/kyc.json"
+
+	connections := &graphQLConnectionsMock{conns: []*domain.Connection{
+		{ID: uuid.New(), IssuerDID: *issuerDID, UserDID: *aliceDID, CreatedAt: time.Now()},
+		{ID: uuid.New(), IssuerDID: *issuerDID, UserDID: *bobDID, CreatedAt: time.Now()},
+	}}
+	claims := &graphQLClaimsMock{claims: []*domain.Claim{
+		graphQLTestClaim(t, aliceDID, schemaURL, 1, false),
+		graphQLTestClaim(t, aliceDID, schemaURL, 2, true),
+		graphQLTestClaim(t, bobDID, schemaURL, 3, true),
+	}}
+	schemas := &graphQLSchemasMock{schemas: []domain.Schema{{ID: uuid.New(), URL: schemaURL, Type: "KYCAgeCredential", SchemaVersion: 1}}}
+	identity := &graphQLIdentityMock{revocations: []*domain.Revocation{{Nonce: 2, Status: domain.RevPublished, Description: "lost device"}}}
+
+	mux := chi.NewRouter()
+	RegisterGraphQL(context.Background(), mux, *issuerDID, auth, identity, claims, connections, schemas)
+
+	query := `{ connections { userID credentials { revNonce schema { type } revocation { published reason } } } }`
+	body, err := json.Marshal(map[string]string{"query": query})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/graphql", bytes.NewReader(body))
+	rr := httptest.NewRecorder()
+	mux.ServeHTTP(rr, req)
+	require.Equal(t, http.StatusUnauthorized, rr.Code)
+
+	req = httptest.NewRequest(http.MethodPost, "/v1/graphql", bytes.NewReader(body))
+	req.SetBasicAuth(auth.User, auth.Password)
+	rr = httptest.NewRecorder()
+	mux.ServeHTTP(rr, req)
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	type revocation struct {
+		Published bool
+		Reason    *string
+	}
+	var resp struct {
+		Errors []interface{}
+		Data   struct {
+			Connections []struct {
+				UserID      string
+				Credentials []struct {
+					RevNonce   string
+					Schema     *struct{ Type string }
+					Revocation *revocation
+				}
+			}
+		}
+	}
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+	require.Empty(t, resp.Errors)
+	require.Len(t, resp.Data.Connections, 2)
+
+	alice := resp.Data.Connections[0]
+	assert.Equal(t, aliceDID.String(), alice.UserID)
+	require.Len(t, alice.Credentials, 2)
+	assert.Equal(t, "1", alice.Credentials[0].RevNonce)
+	require.NotNil(t, alice.Credentials[0].Schema)
+	assert.Equal(t, "KYCAgeCredential", alice.Credentials[0].Schema.Type)
+	assert.Nil(t, alice.Credentials[0].Revocation, "not revoked")
+	require.NotNil(t, alice.Credentials[1].Revocation)
+	assert.True(t, alice.Credentials[1].Revocation.Published)
+	assert.Equal(t, "lost device", *alice.Credentials[1].Revocation.Reason)
+
+	bob := resp.Data.Connections[1]
+	require.Len(t, bob.Credentials, 1)
+	require.NotNil(t, bob.Credentials[0].Revocation)
+	assert.False(t, bob.Credentials[0].Revocation.Published, "revoked but not in the revocations yet")
+
+	// Every level of the query is loaded with a single call
+	require.Len(t, claims.filters, 1)
+	assert.ElementsMatch(t, []string{aliceDID.String(), bobDID.String()}, claims.filters[0].Subjects)
+	require.Len(t, schemas.filters, 1)
+	assert.Equal(t, []string{schemaURL}, schemas.filters[0].URLs)
+	require.Len(t, identity.nonces, 1)
+	assert.ElementsMatch(t, []domain.RevNonceUint64{2, 3}, identity.nonces[0])
+}
+
+func TestGraphQL_ReadOnly(t *testing.T) {
+	mux := chi.NewRouter()
+	RegisterGraphQL(context.Background(), mux, core.DID{}, config.APIUIAuth{}, nil, nil, nil, nil)
+
+	body, err := json.Marshal(map[string]string{"query": `mutation { deleteConnection(id: "1") }`})
+	require.NoError(t, err)
+	req := httptest.NewRequest(http.MethodPost, "/v1/graphql", bytes.NewReader(body))
+	rr := httptest.NewRecorder()
+	mux.ServeHTTP(rr, req)
+
+	var resp struct{ Errors []interface{} }
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+	assert.NotEmpty(t, resp.Errors)
+}
+
+func graphQLTestClaim(t *testing.T, holder *core.DID, schemaURL string, nonce uint64, revoked bool) *domain.Claim {
+	t.Helper()
+	issuanceDate := time.Now().UTC()
+	w3c := verifiable.W3CCredential{
+		ID:                uuid.NewString(),
+		IssuanceDate:      &issuanceDate,
+		CredentialSubject: map[string]interface{}{"id": holder.String()},
+	}
+	claim := &domain.Claim{
+		ID:              uuid.New(),
+		OtherIdentifier: holder.String(),
+		SchemaURL:       schemaURL,
+		SchemaType:      "https://example.com/kyc.jsonld#KYCAgeCredential",
+		RevNonce:        domain.RevNonceUint64(nonce),
+		Revoked:         revoked,
+	}
+	require.NoError(t, claim.Data.Set(w3c))
+	require.NoError(t, claim.CredentialStatus.Set(verifiable.CredentialStatus{Type: verifiable.SparseMerkleTreeProof}))
+	require.NoError(t, claim.SignatureProof.Set(nil))
+	require.NoError(t, claim.MTPProof.Set(nil))
+	return claim
+}
@@ -3,6 +3,7 @@ package api_ui
 import (
 	"context"
 	"encoding/json"
+	"math/big"
 	"net/http"
 	"os"
 	"testing"
@@ -13,13 +14,16 @@ import (
 	"github.com/iden3/iden3comm"
 
 	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
 	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
 	"github.com/polygonid/sh-id-platform/internal/db"
 	"github.com/polygonid/sh-id-platform/internal/db/tests"
 	"github.com/polygonid/sh-id-platform/internal/errors"
 	"github.com/polygonid/sh-id-platform/internal/kms"
 	"github.com/polygonid/sh-id-platform/internal/log"
 	"github.com/polygonid/sh-id-platform/internal/providers"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
 	"github.com/polygonid/sh-id-platform/pkg/cache"
 )
 
@@ -168,8 +172,21 @@ func NewPackageManagerMock() *iden3comm.PackageManager {
 	return &iden3comm.PackageManager{}
 }
 
+// publisherMock estimates a fixed publishing cost and publishes empty states. Any other method panics.
+type publisherMock struct {
+	ports.Publisher
+}
+
+func (p *publisherMock) EstimatePublishingCost(_ context.Context, _ *core.DID) (*domain.PublishingCost, error) {
+	return &domain.PublishingCost{GasLimit: 600000, MaxFeePerGas: big.NewInt(100), Cost: big.NewInt(60000000)}, nil
+}
+
+func (p *publisherMock) PublishState(_ context.Context, _ *core.DID) (*domain.PublishedState, error) {
+	return &domain.PublishedState{}, nil
+}
+
 func NewPublisherMock() ports.Publisher {
-	return nil
+	return &publisherMock{}
 }
 
 func NewIdentityMock() ports.IdentityService {
@@ -191,3 +208,36 @@ func NewConnectionsMock() ports.ConnectionsService {
 func NewLinkMock() ports.LinkService {
 	return nil
 }
+
+func NewCredentialTemplateMock() ports.CredentialTemplateService {
+	return nil
+}
+
+func NewImportMock() ports.ImportService {
+	return nil
+}
+
+func NewChangesMock() ports.ChangeService {
+	return nil
+}
+
+func NewJobQueueMock() ports.JobQueueService {
+	return nil
+}
+
+func NewJWTCredentialMock() ports.JWTCredentialService {
+	return nil
+}
+
+func NewIssuerProfileMock() ports.IssuerProfileService {
+	return nil
+}
+
+func NewOfferEmailMock() ports.OfferEmailService {
+	return nil
+}
+
+// NewAgentInboxMock records the agent messages in the test database, the agent endpoint records every message
+func NewAgentInboxMock() ports.AgentInboxService {
+	return services.NewAgentInbox(repositories.NewAgentInbox(), storage)
+}
@@ -5,11 +5,15 @@ import (
 	"crypto/subtle"
 	"errors"
 	"net/http"
+	"time"
 
 	"github.com/go-chi/chi/v5/middleware"
+	core "github.com/iden3/go-iden3-core"
 
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
 	apiErrors "github.com/polygonid/sh-id-platform/internal/errors"
 	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/standby"
 )
 
 // LogMiddleware returns a middleware that adds general log configuration to each context request
@@ -32,16 +36,61 @@ func LogMiddleware(ctx context.Context) StrictMiddlewareFunc {
 func BasicAuthMiddleware(ctx context.Context, user, pass string) StrictMiddlewareFunc {
 	return func(f StrictHandlerFunc, operationID string) StrictHandlerFunc {
 		return func(ctxReq context.Context, w http.ResponseWriter, r *http.Request, args interface{}) (interface{}, error) {
-			if ctxReq.Value(BasicAuthScopes) != nil && user != "" && pass != "" {
-				userReq, passReq, ok := r.BasicAuth()
-				if !ok {
-					return nil, apiErrors.AuthError{Err: errors.New("unauthorized")}
-				}
-				if subtle.ConstantTimeCompare([]byte(user), []byte(userReq)) != 1 || subtle.ConstantTimeCompare([]byte(pass), []byte(passReq)) != 1 {
-					return nil, apiErrors.AuthError{Err: errors.New("unauthorized")}
-				}
+			if ctxReq.Value(BasicAuthScopes) != nil && !validBasicAuth(r, user, pass) {
+				return nil, apiErrors.AuthError{Err: errors.New("unauthorized")}
 			}
 			return f(ctx, w, r, args)
 		}
 	}
 }
+
+// ReadOnlyMiddleware returns a middleware that rejects every request but the GET ones while the issuer identity is
+// being migrated to another node, including the wallet callbacks. Clients are asked to retry after the given duration,
+// by then the identity is served by the new node.
+func ReadOnlyMiddleware(issuerDID core.DID, migration ports.IdentityMigrationService, retryAfter time.Duration) StrictMiddlewareFunc {
+	return func(f StrictHandlerFunc, operationID string) StrictHandlerFunc {
+		return func(ctxReq context.Context, w http.ResponseWriter, r *http.Request, args interface{}) (interface{}, error) {
+			if r.Method != http.MethodGet && migration.IsReadOnly(ctxReq, issuerDID) {
+				return nil, apiErrors.ReadOnlyError{Err: errors.New("issuer identity is being migrated"), RetryAfter: retryAfter}
+			}
+			return f(ctxReq, w, r, args)
+		}
+	}
+}
+
+// StandbyMiddleware returns a middleware that rejects every request but the GET ones while the node is a standby,
+// as its database is a read only replica of the primary one.
+func StandbyMiddleware(node *standby.Node, retryAfter time.Duration) StrictMiddlewareFunc {
+	return func(f StrictHandlerFunc, operationID string) StrictHandlerFunc {
+		return func(ctxReq context.Context, w http.ResponseWriter, r *http.Request, args interface{}) (interface{}, error) {
+			if r.Method != http.MethodGet && node.Standby() {
+				return nil, apiErrors.ReadOnlyError{Err: errors.New("node is a standby"), RetryAfter: retryAfter}
+			}
+			return f(ctxReq, w, r, args)
+		}
+	}
+}
+
+// basicAuth is the BasicAuthMiddleware counterpart for the endpoints that are registered out of the strict server
+func basicAuth(user, pass string, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !validBasicAuth(r, user, pass) {
+			w.Header().Set("WWW-Authenticate", `Basic realm="restricted", charset="UTF-8"`)
+			writeJSONError(w, http.StatusUnauthorized, "unauthorized")
+			return
+		}
+		next(w, r)
+	}
+}
+
+// validBasicAuth returns true if the request has the configured credentials or if there are no credentials configured
+func validBasicAuth(r *http.Request, user, pass string) bool {
+	if user == "" || pass == "" {
+		return true
+	}
+	userReq, passReq, ok := r.BasicAuth()
+	if !ok {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(user), []byte(userReq)) == 1 && subtle.ConstantTimeCompare([]byte(pass), []byte(passReq)) == 1
+}
@@ -0,0 +1,418 @@
+package api_ui
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html/template"
+	"net/http"
+	"net/url"
+	"sort"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	link_state "github.com/polygonid/sh-id-platform/pkg/link"
+	"github.com/polygonid/sh-id-platform/pkg/qrcode"
+	"github.com/polygonid/sh-id-platform/pkg/walletlinks"
+)
+
+// pageRefresh is how often the pages waiting for the issuance of a credential reload
+const pageRefresh = 5 * time.Second
+
+// page is a landing page of a credential link or offer. Step is the part of the claim shown: authenticate, offer,
+// waiting or error.
+type page struct {
+	Issuer         *domain.IssuerProfile
+	CredentialType string
+	Attributes     []pageAttribute
+	Expiration     string
+	Step           string
+	Message        string
+	QRCode         template.HTML
+	DeepLink       template.URL
+	UniversalLink  template.URL
+	StatusURL      string // StatusURL long polls the session status, the page goes to NextURL when the session is not pending
+	NextURL        string
+	Refresh        int
+}
+
+type pageAttribute struct {
+	Name  string
+	Value string
+}
+
+type pages struct {
+	cfg         *config.Configuration
+	links       ports.LinkService
+	claims      ports.ClaimsService
+	profiles    ports.IssuerProfileService
+	walletLinks *walletlinks.Linker
+}
+
+// RegisterPages adds the public landing pages of the credential links and offers. They show the issuer branding, a
+// preview of the credential, its QR code and wallet links, so the credentials can be handed out without a frontend.
+// The pages are HTML, so they are not part of the strict server.
+func RegisterPages(ctx context.Context, mux *chi.Mux, cfg *config.Configuration, links ports.LinkService, claims ports.ClaimsService, profiles ports.IssuerProfileService) {
+	p := &pages{
+		cfg:         cfg,
+		links:       links,
+		claims:      claims,
+		profiles:    profiles,
+		walletLinks: walletlinks.NewLinker(cfg.WalletLinks.DeepLink, cfg.WalletLinks.UniversalLink),
+	}
+	mux.Get("/links/{id}/page", p.linkPage(ctx))
+	mux.Post("/links/{id}/page", p.linkPage(ctx))
+	mux.Get("/credentials/{id}/page", p.credentialPage(ctx))
+}
+
+// linkPage starts a claim session of the link and shows its authentication QR code. The page goes to the session
+// page when the holder authenticates, which shows the credential offer once the credential is issued. The code
+// protected links ask for the code of the holder first, and the form posts it to this page.
+func (p *pages) linkPage(ctx context.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := log.CopyFromContext(ctx, r.Context())
+		linkID, err := uuid.Parse(chi.URLParam(r, "id"))
+		if err != nil {
+			p.render(ctx, w, http.StatusNotFound, &page{Step: "error", Message: "This link does not exist."})
+			return
+		}
+		issuer := p.issuer(ctx)
+
+		if sessionID := r.URL.Query().Get("sessionID"); sessionID != "" {
+			p.linkSessionPage(ctx, w, issuer, linkID, sessionID)
+			return
+		}
+
+		// the code protected links post the code of the holder from the form of the page
+		var code *string
+		if r.Method == http.MethodPost {
+			code = common.ToPointer(r.PostFormValue("code"))
+		}
+		resp, err := p.links.CreateQRCode(ctx, p.cfg.APIUI.IssuerDID, linkID, p.cfg.APIUI.ServerURL, code)
+		if err != nil {
+			switch {
+			case errors.Is(err, services.ErrLinkCodeRequired), errors.Is(err, services.ErrInvalidLinkCode), errors.Is(err, services.ErrLinkCodeAttemptsExceeded):
+				p.codePage(ctx, w, issuer, linkID, err)
+			case errors.Is(err, services.ErrLinkNotFound):
+				p.render(ctx, w, http.StatusNotFound, &page{Issuer: issuer, Step: "error", Message: "This link does not exist."})
+			case errors.Is(err, services.ErrLinkAlreadyExpired), errors.Is(err, services.ErrLinkMaxExceeded), errors.Is(err, services.ErrLinkInactive):
+				p.render(ctx, w, http.StatusGone, &page{Issuer: issuer, Step: "error", Message: "This link cannot be claimed anymore."})
+			default:
+				log.Error(ctx, "creating the link page qr code", "err", err, "id", linkID)
+				p.render(ctx, w, http.StatusInternalServerError, &page{Issuer: issuer, Step: "error", Message: "Something went wrong, try again later."})
+			}
+			return
+		}
+
+		pg := linkPreview(issuer, resp.Link)
+		pg.Step = "authenticate"
+		pg.StatusURL = fmt.Sprintf("/v1/credentials/links/%s/qrcode?sessionID=%s&wait=%d", linkID, url.QueryEscape(resp.SessionID), int(maxLinkQRCodeWait.Seconds()))
+		pg.NextURL = fmt.Sprintf("/links/%s/page?sessionID=%s", linkID, url.QueryEscape(resp.SessionID))
+		p.renderQRCode(ctx, w, pg, AuthenticationQrCodeResponse{
+			Body: struct {
+				CallbackUrl string        `json:"callbackUrl"`
+				Reason      string        `json:"reason"`
+				Scope       []interface{} `json:"scope"`
+			}{
+				CallbackUrl: resp.QrCode.Body.CallbackURL,
+				Reason:      resp.QrCode.Body.Reason,
+				Scope:       []interface{}{},
+			},
+			From: resp.QrCode.From,
+			Id:   resp.QrCode.ID,
+			Thid: resp.QrCode.ThreadID,
+			Typ:  string(resp.QrCode.Typ),
+			Type: string(resp.QrCode.Type),
+		})
+	}
+}
+
+// codePage asks the holder for the one-time code of a code protected link, with the error of the code entered before
+func (p *pages) codePage(ctx context.Context, w http.ResponseWriter, issuer *domain.IssuerProfile, linkID uuid.UUID, err error) {
+	link, getErr := p.links.GetByID(ctx, p.cfg.APIUI.IssuerDID, linkID)
+	if getErr != nil {
+		log.Error(ctx, "loading the link of the code page", "err", getErr, "id", linkID)
+		p.render(ctx, w, http.StatusInternalServerError, &page{Issuer: issuer, Step: "error", Message: "Something went wrong, try again later."})
+		return
+	}
+	pg := linkPreview(issuer, link)
+	pg.Step = "code"
+	status := http.StatusOK
+	switch {
+	case errors.Is(err, services.ErrInvalidLinkCode):
+		status = http.StatusForbidden
+		pg.Message = "The code is not valid or has already been used."
+	case errors.Is(err, services.ErrLinkCodeAttemptsExceeded):
+		status = http.StatusTooManyRequests
+		pg.Message = "Too many wrong codes, try again later."
+	}
+	p.render(ctx, w, status, pg)
+}
+
+// linkSessionPage shows the credential offer of the session, or waits until the credential is issued
+func (p *pages) linkSessionPage(ctx context.Context, w http.ResponseWriter, issuer *domain.IssuerProfile, linkID uuid.UUID, session string) {
+	sessionID, err := uuid.Parse(session)
+	if err != nil {
+		p.render(ctx, w, http.StatusNotFound, &page{Issuer: issuer, Step: "error", Message: "This session does not exist."})
+		return
+	}
+	resp, err := p.links.GetQRCode(ctx, sessionID, p.cfg.APIUI.IssuerDID, linkID)
+	if err != nil {
+		if errors.Is(err, services.ErrLinkNotFound) {
+			p.render(ctx, w, http.StatusNotFound, &page{Issuer: issuer, Step: "error", Message: "This link does not exist."})
+			return
+		}
+		p.render(ctx, w, http.StatusNotFound, &page{Issuer: issuer, Step: "error", Message: "This session has expired, open the link again."})
+		return
+	}
+
+	pg := linkPreview(issuer, resp.Link)
+	switch resp.State.Status {
+	case link_state.StatusDone:
+		qrCode := getLinkQrCodeResponse(resp.State.QRCode)
+		if qrCode == nil {
+			break
+		}
+		qrCode.Body.Issuer = common.ToPointer(issuerDescriptionResponse(issuer))
+		pg.Step = "offer"
+		p.renderQRCode(ctx, w, pg, qrCode)
+		return
+	case link_state.StatusError:
+		pg.Step = "error"
+		pg.Message = resp.State.Message
+		p.render(ctx, w, http.StatusOK, pg)
+		return
+	}
+	pg.Step = "waiting"
+	pg.Refresh = int(pageRefresh.Seconds())
+	p.render(ctx, w, http.StatusOK, pg)
+}
+
+// credentialPage creates an offer of the credential and shows its QR code. The attributes of the credential are not
+// shown, the page is public.
+func (p *pages) credentialPage(ctx context.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := log.CopyFromContext(ctx, r.Context())
+		issuer := p.issuer(ctx)
+		credentialID, err := uuid.Parse(chi.URLParam(r, "id"))
+		if err != nil {
+			p.render(ctx, w, http.StatusNotFound, &page{Issuer: issuer, Step: "error", Message: "This credential does not exist."})
+			return
+		}
+
+		credential, err := p.claims.GetByID(ctx, &p.cfg.APIUI.IssuerDID, credentialID)
+		if err != nil {
+			if errors.Is(err, services.ErrClaimNotFound) {
+				p.render(ctx, w, http.StatusNotFound, &page{Issuer: issuer, Step: "error", Message: "This credential does not exist."})
+				return
+			}
+			log.Error(ctx, "loading the credential of the page", "err", err, "id", credentialID)
+			p.render(ctx, w, http.StatusInternalServerError, &page{Issuer: issuer, Step: "error", Message: "Something went wrong, try again later."})
+			return
+		}
+		pg := &page{Issuer: issuer, CredentialType: getCredentialType(credential.SchemaType), Step: "offer"}
+		if credential.Revoked {
+			pg.Step = "error"
+			pg.Message = "This credential has been revoked."
+			p.render(ctx, w, http.StatusGone, pg)
+			return
+		}
+		if credential.Expiration > 0 {
+			pg.Expiration = time.Unix(credential.Expiration, 0).UTC().Format("January 2, 2006")
+		}
+
+		offer, err := p.claims.CreateOffer(ctx, p.cfg.APIUI.IssuerDID, credential.ID)
+		if err != nil {
+			log.Error(ctx, "creating the offer of the page", "err", err, "id", credentialID)
+			p.render(ctx, w, http.StatusInternalServerError, &page{Issuer: issuer, Step: "error", Message: "Something went wrong, try again later."})
+			return
+		}
+		p.renderQRCode(ctx, w, pg, getCredentialQrCodeResponse(credential, offer, issuer, p.cfg.APIUI.ServerURL))
+	}
+}
+
+// issuer returns the profile of the issuer, or one with its DID if it cannot be loaded
+func (p *pages) issuer(ctx context.Context) *domain.IssuerProfile {
+	profile, err := p.profiles.Get(ctx, p.cfg.APIUI.IssuerDID)
+	if err != nil {
+		log.Error(ctx, "loading the issuer profile of the page", "err", err)
+		return &domain.IssuerProfile{Identifier: p.cfg.APIUI.IssuerDID.String(), DisplayName: p.cfg.APIUI.IssuerDID.String()}
+	}
+	return profile
+}
+
+func linkPreview(issuer *domain.IssuerProfile, link *domain.Link) *page {
+	pg := &page{Issuer: issuer}
+	if link == nil {
+		return pg
+	}
+	if link.Schema != nil {
+		pg.CredentialType = link.Schema.Type
+	}
+	for name, value := range link.CredentialSubject {
+		if name == "id" {
+			continue
+		}
+		pg.Attributes = append(pg.Attributes, pageAttribute{Name: name, Value: fmt.Sprint(value)})
+	}
+	sort.Slice(pg.Attributes, func(i, j int) bool { return pg.Attributes[i].Name < pg.Attributes[j].Name })
+	if link.CredentialExpiration != nil {
+		pg.Expiration = link.CredentialExpiration.UTC().Format("January 2, 2006")
+	}
+	return pg
+}
+
+// renderQRCode renders the page with the QR code and the wallet links of the message
+func (p *pages) renderQRCode(ctx context.Context, w http.ResponseWriter, pg *page, message any) {
+	raw, err := json.Marshal(message)
+	if err == nil {
+		var code *qrcode.Code
+		if code, err = qrcode.Encode(raw, qrcode.Medium); err == nil {
+			pg.QRCode = template.HTML(code.SVG(4)) //nolint:gosec // the svg is generated, not user input
+		}
+	}
+	if err != nil {
+		log.Error(ctx, "encoding the qr code of the page", "err", err)
+		p.render(ctx, w, http.StatusInternalServerError, &page{Issuer: pg.Issuer, Step: "error", Message: "Something went wrong, try again later."})
+		return
+	}
+
+	links, err := p.walletLinks.Links(message)
+	if err != nil {
+		log.Error(ctx, "building the wallet links of the page", "err", err)
+	} else {
+		pg.DeepLink = template.URL(links.DeepLink)           //nolint:gosec // the links are built from the configuration
+		pg.UniversalLink = template.URL(links.UniversalLink) //nolint:gosec // the links are built from the configuration
+	}
+	p.render(ctx, w, http.StatusOK, pg)
+}
+
+func (p *pages) render(ctx context.Context, w http.ResponseWriter, status int, pg *page) {
+	if pg.Issuer == nil {
+		pg.Issuer = p.issuer(ctx)
+	}
+	w.Header().Set("Content-Type", "text/html; charset=UTF-8")
+	w.WriteHeader(status)
+	if err := pageTemplate.Execute(w, pg); err != nil {
+		log.Error(ctx, "rendering the page", "err", err)
+	}
+}
+
+var pageTemplate = template.Must(template.New("page").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+{{- if .Refresh}}
+<meta http-equiv="refresh" content="{{.Refresh}}">
+{{- end}}
+<title>{{.Issuer.DisplayName}}</title>
+<style>
+body { margin: 0; font-family: -apple-system, BlinkMacSystemFont, "Segoe UI", Roboto, Helvetica, Arial, sans-serif; background: #f5f5f5; color: #1d1d1f; }
+main { max-width: 480px; margin: 0 auto; padding: 24px 16px; }
+header { display: flex; align-items: center; gap: 12px; padding: 16px; border-radius: 12px; background: {{with .Issuer.BackgroundColor}}{{.}}{{else}}#ffffff{{end}}; color: {{with .Issuer.TextColor}}{{.}}{{else}}#1d1d1f{{end}}; }
+header img { width: 48px; height: 48px; border-radius: 8px; object-fit: contain; }
+header h1 { margin: 0; font-size: 20px; }
+section { margin-top: 16px; padding: 16px; border-radius: 12px; background: #ffffff; }
+h2 { margin: 0 0 8px; font-size: 18px; }
+dl { display: grid; grid-template-columns: auto 1fr; gap: 4px 12px; margin: 0; }
+dt { color: #6e6e73; }
+dd { margin: 0; word-break: break-word; }
+.qr { max-width: 320px; margin: 0 auto; }
+.button { display: block; margin-top: 12px; padding: 12px; border-radius: 8px; background: #6f4fe8; color: #ffffff; text-align: center; text-decoration: none; font-weight: 600; }
+.button.secondary { background: #ffffff; color: #6f4fe8; border: 1px solid #6f4fe8; }
+.hint { color: #6e6e73; font-size: 14px; }
+.code { box-sizing: border-box; width: 100%; padding: 12px; border: 1px solid #d2d2d7; border-radius: 8px; font-size: 18px; text-align: center; letter-spacing: 2px; }
+button.button { width: 100%; border: 0; font-size: 16px; cursor: pointer; }
+footer { margin-top: 16px; text-align: center; color: #6e6e73; font-size: 14px; }
+footer a { color: inherit; }
+</style>
+</head>
+<body>
+<main>
+<header>
+{{- with .Issuer.LogoURL}}
+<img src="{{.}}" alt="">
+{{- end}}
+<h1>{{.Issuer.DisplayName}}</h1>
+</header>
+{{- if .CredentialType}}
+<section>
+<h2>{{.CredentialType}}</h2>
+{{- if or .Attributes .Expiration}}
+<dl>
+{{- range .Attributes}}
+<dt>{{.Name}}</dt><dd>{{.Value}}</dd>
+{{- end}}
+{{- with .Expiration}}
+<dt>Expires</dt><dd>{{.}}</dd>
+{{- end}}
+</dl>
+{{- end}}
+</section>
+{{- end}}
+<section>
+{{- if eq .Step "authenticate"}}
+<h2>Claim your credential</h2>
+<p class="hint">Scan the QR code with your Polygon ID wallet to log in, or open the wallet from this device.</p>
+{{- else if eq .Step "code"}}
+<h2>Enter your code</h2>
+<p class="hint">Enter the one-time code you received to claim the credential.</p>
+<form method="post">
+<input class="code" name="code" autocomplete="one-time-code" autocapitalize="characters" required>
+<button class="button" type="submit">Continue</button>
+</form>
+{{- with .Message}}
+<p class="hint">{{.}}</p>
+{{- end}}
+{{- else if eq .Step "offer"}}
+<h2>Your credential is ready</h2>
+<p class="hint">Scan the QR code with your Polygon ID wallet to add the credential, or open the wallet from this device.</p>
+{{- else if eq .Step "waiting"}}
+<h2>Issuing your credential</h2>
+<p class="hint">This page refreshes when the credential is ready.</p>
+{{- else}}
+<h2>This credential cannot be claimed</h2>
+<p class="hint">{{.Message}}</p>
+{{- end}}
+{{- with .QRCode}}
+<div class="qr">{{.}}</div>
+{{- end}}
+{{- with .UniversalLink}}
+<a class="button" href="{{.}}">Open in wallet</a>
+{{- end}}
+{{- with .DeepLink}}
+<a class="button secondary" href="{{.}}">Open in another wallet</a>
+{{- end}}
+</section>
+{{- if or .Issuer.ContactEmail .Issuer.ContactURL}}
+<footer>
+{{- with .Issuer.ContactURL}}<a href="{{.}}">{{.}}</a>{{end}}
+{{- if and .Issuer.ContactEmail .Issuer.ContactURL}} · {{end}}
+{{- with .Issuer.ContactEmail}}<a href="mailto:{{.}}">{{.}}</a>{{end}}
+</footer>
+{{- end}}
+</main>
+{{- with .StatusURL}}
+<script>
+var poll = function () {
+  fetch({{.}}).then(function (resp) { return resp.ok ? resp.json() : {}; }).then(function (body) {
+    if (body.status === "pending") { poll(); return; }
+    window.location.assign({{$.NextURL}});
+  }, function () { setTimeout(poll, 5000); });
+};
+poll();
+</script>
+{{- end}}
+</body>
+</html>
+`))
@@ -1,17 +1,21 @@
 package api_ui
 
 import (
+	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
 	openapi_types "github.com/deepmap/oapi-codegen/pkg/types"
-	"github.com/google/uuid"
 	"github.com/iden3/go-schema-processor/verifiable"
 	"github.com/iden3/iden3comm/packers"
 	"github.com/iden3/iden3comm/protocol"
 
+	"github.com/polygonid/sh-id-platform/internal/common"
 	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/jsonschema"
 	link_state "github.com/polygonid/sh-id-platform/pkg/link"
 	"github.com/polygonid/sh-id-platform/pkg/schema"
 )
@@ -22,6 +26,28 @@ const (
 
 func schemaResponse(s *domain.Schema) Schema {
 	hash, _ := s.Hash.MarshalText()
+	var webhookURL *string
+	if s.ValidationWebhook != nil {
+		webhookURL = common.ToPointer(s.ValidationWebhook.URL)
+	}
+	var extraContexts, extraTypes *[]string
+	if len(s.ExtraContexts) > 0 {
+		extraContexts = &s.ExtraContexts
+	}
+	if len(s.ExtraTypes) > 0 {
+		extraTypes = &s.ExtraTypes
+	}
+	var defaultProofTypes *[]string
+	if s.DefaultProofTypes != nil {
+		defaultProofTypes = common.ToPointer(s.DefaultProofTypes.Names())
+	}
+	var ipfsCID, ipfsContextCID *string
+	if s.IPFSCID != "" {
+		ipfsCID = common.ToPointer(s.IPFSCID)
+	}
+	if s.IPFSContextCID != "" {
+		ipfsContextCID = common.ToPointer(s.IPFSContextCID)
+	}
 	return Schema{
 		Id:        s.ID.String(),
 		Type:      s.Type,
@@ -29,9 +55,27 @@ func schemaResponse(s *domain.Schema) Schema {
 		BigInt:    s.Hash.BigInt().String(),
 		Hash:      string(hash),
 		CreatedAt: s.CreatedAt,
+
+		AutoRevokeOnExpiration: s.AutoRevokeOnExpiration,
+		Version:                s.Version,
+		ValidationWebhookUrl:   webhookURL,
+		ExtraContexts:          extraContexts,
+		ExtraTypes:             extraTypes,
+		DefaultProofTypes:      defaultProofTypes,
+		MaxValidityDays:        s.MaxValidityDays,
+		IpfsCid:                ipfsCID,
+		IpfsContextCid:         ipfsContextCID,
+		SchemaVersion:          s.SchemaVersion,
+		Status:                 SchemaStatus(s.Status()),
+		DeprecatedAt:           s.DeprecatedAt,
 	}
 }
 
+// etag returns the ETag header of a resource version
+func etag(version int) string {
+	return strconv.Quote(strconv.Itoa(version))
+}
+
 func schemaCollectionResponse(schemas []domain.Schema) []Schema {
 	res := make([]Schema, len(schemas))
 	for i, s := range schemas {
@@ -49,6 +93,11 @@ func credentialResponse(w3c *verifiable.W3CCredential, credential *domain.Claim)
 	}
 
 	proofs := getProofs(credential)
+	coreClaim, _ := credential.CoreClaim.Get().Hex()
+	var hIndex string
+	if h, err := credential.CoreClaim.Get().HIndex(); err == nil {
+		hIndex = h.String()
+	}
 
 	return Credential{
 		CredentialSubject: w3c.CredentialSubject,
@@ -63,9 +112,72 @@ func credentialResponse(w3c *verifiable.W3CCredential, credential *domain.Claim)
 		SchemaType:        shortType(credential.SchemaType),
 		SchemaUrl:         credential.SchemaURL,
 		UserID:            credential.OtherIdentifier,
+		CoreClaim:         coreClaim,
+		HIndex:            hIndex,
+		Published:         credential.Status != nil && *credential.Status == domain.StatusConfirmed,
+		PublicationStatus: PublicationStatus(credential.PublicationStatus()),
+		PublishRequired:   credential.PublicationStatus().PublishRequired(),
+		DeletedAt:         credential.DeletedAt,
 	}
 }
 
+// credentialRowsResponse returns the listed credentials from their display, without converting them to W3C
+func credentialRowsResponse(credentials []*domain.CredentialRow) []Credential {
+	now := time.Now().UTC()
+	response := make([]Credential, len(credentials))
+	for i, credential := range credentials {
+		var createdAt time.Time
+		if credential.Display.IssuanceDate != nil {
+			createdAt = *credential.Display.IssuanceDate
+		}
+		response[i] = Credential{
+			CredentialSubject: credential.Display.Subject,
+			CreatedAt:         createdAt,
+			Expired:           credential.Expired(now),
+			ExpiresAt:         credential.Display.Expiration,
+			Id:                credential.ID,
+			ProofTypes:        credential.ProofTypes(),
+			RevNonce:          uint64(credential.RevNonce),
+			Revoked:           credential.Revoked,
+			SchemaHash:        credential.SchemaHash,
+			SchemaType:        shortType(credential.SchemaType),
+			SchemaUrl:         credential.Display.SchemaURL,
+			UserID:            credential.HolderDID,
+			CoreClaim:         credential.Display.CoreClaim,
+			HIndex:            credential.Display.HIndex,
+			Published:         credential.Status != nil && *credential.Status == domain.StatusConfirmed,
+			PublicationStatus: PublicationStatus(credential.PublicationStatus()),
+			PublishRequired:   credential.PublicationStatus().PublishRequired(),
+			DeletedAt:         credential.DeletedAt,
+		}
+	}
+	return response
+}
+
+func credentialRevocationResponse(revocation *domain.Revocation) *CredentialRevocation {
+	return &CredentialRevocation{
+		Reason:      CredentialRevocationReason(revocation.Reason),
+		Description: revocation.Description,
+		RevokedAt:   revocation.CreatedAt,
+	}
+}
+
+func credentialHistoryResponse(events []domain.CredentialEvent) []CredentialEvent {
+	resp := make([]CredentialEvent, len(events))
+	for i, ev := range events {
+		resp[i] = CredentialEvent{
+			Type:        CredentialEventType(ev.Type),
+			Time:        ev.Time,
+			OfferID:     ev.OfferID,
+			State:       ev.State,
+			TxID:        ev.TxID,
+			Reason:      (*CredentialRevocationReason)(ev.Reason),
+			Description: ev.Description,
+		}
+	}
+	return resp
+}
+
 func shortType(id string) string {
 	parts := strings.Split(id, "#")
 	l := len(parts)
@@ -115,12 +227,40 @@ func connectionResponse(conn *domain.Connection, w3cs []*verifiable.W3CCredentia
 		}
 	}
 
+	var metadata *ConnectionMetadata
+	if conn.Metadata != nil {
+		metadata = common.ToPointer(connectionMetadataResponse(conn.Metadata))
+	}
+
 	return GetConnectionResponse{
 		CreatedAt:   conn.CreatedAt,
 		Id:          conn.ID.String(),
 		UserID:      conn.UserDID.String(),
 		IssuerID:    conn.IssuerDID.String(),
 		Credentials: credResp,
+		Metadata:    metadata,
+		DeletedAt:   conn.DeletedAt,
+	}
+}
+
+// connectionVersion is the version of the metadata of the connection, 0 while it has none
+func connectionVersion(conn *domain.Connection) int {
+	if conn.Metadata == nil {
+		return 0
+	}
+	return conn.Metadata.Version
+}
+
+func connectionMetadataResponse(m *domain.ConnectionMetadata) ConnectionMetadata {
+	tags := m.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+	return ConnectionMetadata{
+		DisplayName: m.DisplayName,
+		Notes:       m.Notes,
+		Tags:        tags,
+		ModifiedAt:  m.ModifiedAt,
 	}
 }
 
@@ -132,6 +272,41 @@ func stateTransactionsResponse(states []domain.IdentityState) StateTransactionsR
 	return stateTransactions
 }
 
+func changesResponse(changes []domain.Change, cursor domain.ChangeCursor) GetChangesResponse {
+	resp := GetChangesResponse{Changes: make([]Change, len(changes)), Cursor: cursor.String()}
+	for i, change := range changes {
+		resp.Changes[i] = Change{
+			Cursor:    change.Cursor.String(),
+			Entity:    ChangeEntity(change.Entity),
+			Id:        change.EntityID,
+			Operation: ChangeOperation(change.Operation),
+			CreatedAt: change.CreatedAt,
+		}
+	}
+	return resp
+}
+
+// stateStatusResponse reports the latest of the states sent to the blockchain, the ones with a transaction
+func stateStatusResponse(pendingActions bool, states []domain.IdentityState, cost *domain.PublishingCost) StateStatusResponse {
+	resp := StateStatusResponse{PendingActions: pendingActions}
+	for i := len(states) - 1; i >= 0; i-- {
+		if states[i].TxID == nil {
+			continue
+		}
+		status := getTransactionStatus(states[i].Status)
+		resp.LastPublishedState, resp.LastTxID, resp.LastStatus = states[i].State, states[i].TxID, &status
+		break
+	}
+	if cost != nil {
+		resp.EstimatedCost = &PublishingCost{
+			GasLimit:     cost.GasLimit,
+			MaxFeePerGas: cost.MaxFeePerGas.String(),
+			Cost:         cost.Cost.String(),
+		}
+	}
+	return resp
+}
+
 func toStateTransaction(state domain.IdentityState) StateTransaction {
 	var stateTran, txID string
 	if state.State != nil {
@@ -207,6 +382,187 @@ func getLinkResponse(link domain.Link) Link {
 		CreatedAt:            link.CreatedAt,
 		Expiration:           link.ValidUntil,
 		CredentialExpiration: date,
+		Version:              link.Version,
+		ProofScope:           verificationQueriesResponse(link.ProofScope),
+		SubjectExternalID:    link.SubjectExternalID,
+		AllowedDIDs:          allowedDIDsResponse(link.AllowedDIDs),
+		CodeProtected:        link.CodeProtected,
+	}
+}
+
+func linkCodesResponse(codes []*domain.LinkCode) []LinkCode {
+	resp := make([]LinkCode, len(codes))
+	for i, code := range codes {
+		resp[i] = LinkCode{
+			Id:        code.ID,
+			CreatedAt: code.CreatedAt,
+			Used:      code.Used(),
+			UsedAt:    code.UsedAt,
+			HolderDID: code.HolderDID,
+		}
+	}
+	return resp
+}
+
+func allowedDIDsResponse(allowedDIDs []string) *[]string {
+	if len(allowedDIDs) == 0 {
+		return nil
+	}
+	return &allowedDIDs
+}
+
+func subjectBindingResponse(binding *domain.SubjectBinding) SubjectBinding {
+	return SubjectBinding{
+		ExternalID: binding.ExternalID,
+		HolderDID:  binding.HolderDID.String(),
+		LinkID:     binding.LinkID,
+		CreatedAt:  binding.CreatedAt,
+	}
+}
+
+func verificationQuery(query VerificationQuery) domain.VerificationQuery {
+	resp := domain.VerificationQuery{
+		CircuitID: string(query.CircuitId),
+		Context:   query.Context,
+		Type:      query.Type,
+	}
+	if query.Id != nil {
+		resp.ID = *query.Id
+	}
+	if query.AllowedIssuers != nil {
+		resp.AllowedIssuers = *query.AllowedIssuers
+	}
+	if query.CredentialSubject != nil {
+		resp.CredentialSubject = *query.CredentialSubject
+	}
+	if query.SkipClaimRevocationCheck != nil {
+		resp.SkipClaimRevocationCheck = *query.SkipClaimRevocationCheck
+	}
+	return resp
+}
+
+func verificationQueriesResponse(scope []domain.VerificationQuery) *[]VerificationQuery {
+	if len(scope) == 0 {
+		return nil
+	}
+	resp := make([]VerificationQuery, len(scope))
+	for i, query := range scope {
+		resp[i] = VerificationQuery{
+			Id:        common.ToPointer(query.ID),
+			CircuitId: VerificationQueryCircuitId(query.CircuitID),
+			Context:   query.Context,
+			Type:      query.Type,
+		}
+		if len(query.AllowedIssuers) > 0 {
+			resp[i].AllowedIssuers = common.ToPointer(query.AllowedIssuers)
+		}
+		if len(query.CredentialSubject) > 0 {
+			resp[i].CredentialSubject = common.ToPointer(query.CredentialSubject)
+		}
+		if query.SkipClaimRevocationCheck {
+			resp[i].SkipClaimRevocationCheck = common.ToPointer(true)
+		}
+	}
+	return &resp
+}
+
+func credentialTemplateResponse(template *domain.CredentialTemplate) CredentialTemplate {
+	return CredentialTemplate{
+		Id:                template.ID,
+		Name:              template.Name,
+		SchemaID:          template.SchemaID,
+		SchemaUrl:         template.Schema.URL,
+		SchemaType:        template.Schema.Type,
+		CredentialSubject: CredentialSubject(template.CredentialSubject),
+		ExpirationDays:    template.ExpirationDays,
+		SignatureProof:    template.SignatureProof,
+		MtProof:           template.MTProof,
+		CreatedAt:         template.CreatedAt,
+	}
+}
+
+func importJobResponse(job *domain.ImportJob) ImportJob {
+	return ImportJob{
+		Id:                   job.ID,
+		SchemaID:             job.SchemaID,
+		Mode:                 ImportJobMode(job.Mode),
+		Status:               ImportJobStatus(job.Status),
+		SignatureProof:       job.SignatureProof,
+		MtProof:              job.MTProof,
+		CredentialExpiration: job.CredentialExpiration,
+		TotalRows:            job.TotalRows,
+		ProcessedRows:        job.ProcessedRows,
+		FailedRows:           job.FailedRows,
+		Progress:             job.Progress(),
+		Error:                job.Error,
+		CreatedAt:            job.CreatedAt,
+		FinishedAt:           job.FinishedAt,
+	}
+}
+
+func jobResponse(job *domain.Job) Job {
+	return Job{
+		Id:          job.ID,
+		Kind:        job.Kind,
+		Status:      JobStatus(job.Status),
+		Attempts:    job.Attempts,
+		MaxAttempts: job.MaxAttempts,
+		LastError:   job.LastError,
+		RunAt:       job.RunAt,
+		CreatedAt:   job.CreatedAt,
+		UpdatedAt:   job.UpdatedAt,
+		FinishedAt:  job.FinishedAt,
+	}
+}
+
+func agentInboxMessageResponse(message *domain.InboxMessage) AgentInboxMessage {
+	response := AgentInboxMessage{
+		Id:        message.ID,
+		Sender:    message.Sender,
+		MessageID: message.MessageID,
+		ThreadID:  message.ThreadID,
+		Type:      message.Type,
+		MediaType: message.MediaType,
+		Status:    AgentInboxMessageStatus(message.Status),
+		CreatedAt: message.CreatedAt,
+	}
+	if message.Error != "" {
+		response.Error = common.ToPointer(message.Error)
+	}
+	if message.ResponseType != "" {
+		response.ResponseType = common.ToPointer(message.ResponseType)
+	}
+	return response
+}
+
+func linkFunnelResponse(funnel *domain.LinkFunnel) LinkFunnel {
+	return LinkFunnel{
+		LinkID:              funnel.LinkID,
+		QrFetched:           funnel.Steps[domain.LinkFunnelQRFetched],
+		AuthCompleted:       funnel.Steps[domain.LinkFunnelAuthCompleted],
+		OfferFetched:        funnel.Steps[domain.LinkFunnelOfferFetched],
+		CredentialDelivered: funnel.Steps[domain.LinkFunnelCredentialDelivered],
+		Failures:            funnel.Failures,
+	}
+}
+
+func linkAnalyticsResponse(analytics *domain.LinkAnalytics) LinkAnalytics {
+	buckets := make([]LinkAnalyticsBucket, len(analytics.Buckets))
+	for i, bucket := range analytics.Buckets {
+		buckets[i] = LinkAnalyticsBucket{
+			Start:         bucket.Start,
+			QrDisplayed:   bucket.QRDisplayed,
+			Authenticated: bucket.Authenticated,
+			Issued:        bucket.Issued,
+			Errors:        bucket.Errors,
+		}
+	}
+	return LinkAnalytics{
+		LinkID:   analytics.LinkID,
+		Interval: LinkAnalyticsInterval(analytics.Interval),
+		From:     analytics.From,
+		To:       analytics.To,
+		Buckets:  buckets,
 	}
 }
 
@@ -265,11 +621,12 @@ func getLinkQrCodeResponse(linkQrCode *link_state.QRCodeMessage) *QrCodeResponse
 			Url:         linkQrCode.Body.URL,
 			Credentials: credentials,
 		},
+		ExpiresTime: linkQrCode.ExpiresTime,
 	}
 }
 
-func getCredentialQrCodeResponse(credential *domain.Claim, hostURL string) QrCodeResponse {
-	id := uuid.NewString()
+func getCredentialQrCodeResponse(credential *domain.Claim, offer *domain.CredentialOffer, profile *domain.IssuerProfile, hostURL string) QrCodeResponse {
+	id := offer.ID.String()
 	return QrCodeResponse{
 		Body: QrCodeBodyResponse{
 			Credentials: []QrCodeCredentialResponse{
@@ -278,17 +635,73 @@ func getCredentialQrCodeResponse(credential *domain.Claim, hostURL string) QrCod
 					Id:          credential.ID.String(),
 				},
 			},
-			Url: getAgentEndpoint(hostURL),
+			Url:    getAgentEndpoint(hostURL),
+			Issuer: common.ToPointer(issuerDescriptionResponse(profile)),
 		},
-		From: credential.Issuer,
-		Id:   id,
-		Thid: id,
-		To:   credential.OtherIdentifier,
-		Typ:  string(packers.MediaTypePlainMessage),
-		Type: string(protocol.CredentialOfferMessageType),
+		From:        credential.Issuer,
+		Id:          id,
+		Thid:        id,
+		To:          credential.OtherIdentifier,
+		Typ:         string(packers.MediaTypePlainMessage),
+		Type:        string(protocol.CredentialOfferMessageType),
+		ExpiresTime: common.ToPointer(offer.ExpiresAt.Unix()),
+	}
+}
+
+// trackingPixel is a transparent 1x1 gif
+var trackingPixel = []byte{
+	0x47, 0x49, 0x46, 0x38, 0x39, 0x61, 0x01, 0x00, 0x01, 0x00, 0x80, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0xff, 0xff, 0xff, 0x21, 0xf9, 0x04, 0x01, 0x00, 0x00, 0x00, 0x00, 0x2c, 0x00, 0x00, 0x00, 0x00,
+	0x01, 0x00, 0x01, 0x00, 0x00, 0x02, 0x02, 0x44, 0x01, 0x00, 0x3b,
+}
+
+func offerEmailResponse(email *domain.OfferEmail) OfferEmail {
+	return OfferEmail{
+		Id:           email.ID,
+		CredentialID: email.CredentialID,
+		Recipient:    email.Recipient,
+		Status:       OfferEmailStatus(email.Status),
+		Error:        email.Error,
+		SentAt:       email.SentAt,
+		OpenedAt:     email.OpenedAt,
+		ClickedAt:    email.ClickedAt,
+		ClaimedAt:    email.ClaimedAt,
+		CreatedAt:    email.CreatedAt,
+	}
+}
+
+func issuerDescriptionResponse(profile *domain.IssuerProfile) IssuerDescription {
+	return IssuerDescription{
+		DisplayName:     profile.DisplayName,
+		Logo:            profile.LogoURL,
+		BackgroundColor: nonEmpty(profile.BackgroundColor),
+		TextColor:       nonEmpty(profile.TextColor),
+		ContactEmail:    nonEmpty(profile.ContactEmail),
+		ContactURL:      nonEmpty(profile.ContactURL),
+	}
+}
+
+func issuerProfileResponse(profile *domain.IssuerProfile) IssuerProfile {
+	return IssuerProfile{
+		DisplayName:     profile.DisplayName,
+		LogoURL:         profile.LogoURL,
+		BackgroundColor: profile.BackgroundColor,
+		TextColor:       profile.TextColor,
+		ContactEmail:    profile.ContactEmail,
+		ContactURL:      profile.ContactURL,
+		Overridden:      profile.Overridden,
+		UpdatedAt:       profile.UpdatedAt,
 	}
 }
 
+// nonEmpty returns nil for the empty string, so the optional fields that are not set are left out
+func nonEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
 func getCredentialType(credentialType string) string {
 	parse := strings.Split(credentialType, "#")
 	if len(parse) != schemaParts {
@@ -333,3 +746,52 @@ func getRevocationStatusResponse(rs *verifiable.RevocationStatus) RevocationStat
 func getAgentEndpoint(hostURL string) string {
 	return fmt.Sprintf("%s/v1/agent", strings.TrimSuffix(hostURL, "/"))
 }
+
+// credentialSubjectErrors returns the attributes of the credential subject that do not match the schema, if the error
+// has them
+func credentialSubjectErrors(err error) *[]CredentialSubjectError {
+	var subjectErr *jsonschema.SubjectError
+	if !errors.As(err, &subjectErr) {
+		return nil
+	}
+	fields := make([]CredentialSubjectError, len(subjectErr.Fields))
+	for i, f := range subjectErr.Fields {
+		fields[i] = CredentialSubjectError{Field: f.Field, Message: f.Message}
+	}
+	return &fields
+}
+
+// agentProblemReportResponse returns the problem report that answers a failed agent request, with the error as the
+// message for the clients that do not read problem reports
+func agentProblemReportResponse(err error, report *domain.Agent) AgentProblemReport {
+	body, _ := report.Body.(ports.ProblemReportMessageBody)
+	return AgentProblemReport{
+		Message:        err.Error(),
+		Id:             common.ToPointer(report.ID),
+		Typ:            common.ToPointer(string(report.Typ)),
+		Type:           common.ToPointer(string(report.Type)),
+		ThreadID:       common.ToPointer(report.ThreadID),
+		ParentThreadID: common.ToPointer(report.ParentThreadID),
+		Body:           &ProblemReportBody{Code: body.Code, Comment: common.ToPointer(body.Comment)},
+		From:           common.ToPointer(report.From),
+		To:             common.ToPointer(report.To),
+	}
+}
+
+func problemReportResponse(report *domain.ProblemReport) ProblemReport {
+	resp := ProblemReport{
+		Id:           report.ID,
+		Direction:    ProblemReportDirection(report.Direction),
+		Holder:       report.Holder,
+		MessageID:    report.MessageID,
+		ThreadID:     report.ThreadID,
+		Code:         report.Code,
+		CredentialID: report.CredentialID,
+		ConnectionID: report.ConnectionID,
+		CreatedAt:    report.CreatedAt,
+	}
+	if report.Comment != "" {
+		resp.Comment = &report.Comment
+	}
+	return resp
+}
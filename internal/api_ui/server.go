@@ -1,31 +1,52 @@
 package api_ui
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"mime/multipart"
 	"net/http"
 	"net/url"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
 	core "github.com/iden3/go-iden3-core"
 	"github.com/iden3/iden3comm"
-	"github.com/iden3/iden3comm/packers"
 
 	"github.com/polygonid/sh-id-platform/internal/common"
 	"github.com/polygonid/sh-id-platform/internal/config"
 	"github.com/polygonid/sh-id-platform/internal/core/domain"
 	"github.com/polygonid/sh-id-platform/internal/core/ports"
 	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/db"
 	"github.com/polygonid/sh-id-platform/internal/gateways"
 	"github.com/polygonid/sh-id-platform/internal/health"
 	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/pii"
 	"github.com/polygonid/sh-id-platform/internal/repositories"
 	link_state "github.com/polygonid/sh-id-platform/pkg/link"
+	"github.com/polygonid/sh-id-platform/pkg/reports"
 	"github.com/polygonid/sh-id-platform/pkg/schema"
+	"github.com/polygonid/sh-id-platform/pkg/walletlinks"
+)
+
+// maxLinkQRCodeWait is the longest a GetLinkQRCode request can be held waiting for a link session to complete
+const maxLinkQRCodeWait = 60 * time.Second
+
+// maxImportFileSize is the largest CSV file that can be imported
+const maxImportFileSize = 10 << 20
+
+// Page size of the credentials of a connection
+const (
+	defaultConnectionCredentialsLimit = 50
+	maxConnectionCredentialsLimit     = 1000
 )
 
 // Server implements StrictServerInterface and holds the implementation of all API controllers
@@ -37,13 +58,27 @@ type Server struct {
 	schemaService      ports.SchemaService
 	connectionsService ports.ConnectionsService
 	linkService        ports.LinkService
+	templateService    ports.CredentialTemplateService
+	importService      ports.ImportService
+	changesService     ports.ChangeService
+	jobQueue           ports.JobQueueService
+	jwtCredentials     ports.JWTCredentialService
+	profiles           ports.IssuerProfileService
+	offerEmails        ports.OfferEmailService
+	agentInbox         ports.AgentInboxService
 	publisherGateway   ports.Publisher
 	packageManager     *iden3comm.PackageManager
 	health             *health.Status
+	listingPII         pii.Fields // fields masked in the responses that list credentials and links
+	walletLinks        *walletlinks.Linker
 }
 
 // NewServer is a Server constructor
-func NewServer(cfg *config.Configuration, identityService ports.IdentityService, claimsService ports.ClaimsService, schemaService ports.SchemaService, connectionsService ports.ConnectionsService, linkService ports.LinkService, publisherGateway ports.Publisher, packageManager *iden3comm.PackageManager, health *health.Status) *Server {
+func NewServer(cfg *config.Configuration, identityService ports.IdentityService, claimsService ports.ClaimsService, schemaService ports.SchemaService, connectionsService ports.ConnectionsService, linkService ports.LinkService, templateService ports.CredentialTemplateService, importService ports.ImportService, changesService ports.ChangeService, jobQueue ports.JobQueueService, jwtCredentials ports.JWTCredentialService, profiles ports.IssuerProfileService, offerEmails ports.OfferEmailService, agentInbox ports.AgentInboxService, publisherGateway ports.Publisher, packageManager *iden3comm.PackageManager, health *health.Status) *Server {
+	var listingPII pii.Fields
+	if cfg.PII.MaskListings {
+		listingPII = pii.NewFields(cfg.PII.Fields)
+	}
 	return &Server{
 		cfg:                cfg,
 		identityService:    identityService,
@@ -51,9 +86,19 @@ func NewServer(cfg *config.Configuration, identityService ports.IdentityService,
 		schemaService:      schemaService,
 		connectionsService: connectionsService,
 		linkService:        linkService,
+		templateService:    templateService,
+		importService:      importService,
+		changesService:     changesService,
+		jobQueue:           jobQueue,
+		jwtCredentials:     jwtCredentials,
+		profiles:           profiles,
+		offerEmails:        offerEmails,
+		agentInbox:         agentInbox,
 		publisherGateway:   publisherGateway,
 		packageManager:     packageManager,
 		health:             health,
+		listingPII:         listingPII,
+		walletLinks:        walletlinks.NewLinker(cfg.WalletLinks.DeepLink, cfg.WalletLinks.UniversalLink),
 	}
 }
 
@@ -67,20 +112,76 @@ func (s *Server) GetSchema(ctx context.Context, request GetSchemaRequestObject)
 	if err != nil {
 		log.Error(ctx, "loading schema", "err", err, "id", request.Id)
 	}
-	return GetSchema200JSONResponse(schemaResponse(schema)), nil
+	return GetSchema200JSONResponse{Body: schemaResponse(schema), Headers: GetSchema200ResponseHeaders{ETag: etag(schema.Version)}}, nil
 }
 
-// GetSchemas returns the list of schemas that match the request.Params.Query filter. If param query is nil it will return all
+// UpdateSchema changes the issuance policies of an imported schema
+func (s *Server) UpdateSchema(ctx context.Context, request UpdateSchemaRequestObject) (UpdateSchemaResponseObject, error) {
+	if request.Body == nil {
+		return UpdateSchema400JSONResponse{N400JSONResponse{Message: "bad request: empty body"}}, nil
+	}
+	version, err := ifMatchVersion(request.Params.IfMatch)
+	if err != nil {
+		return UpdateSchema400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+	}
+	var webhook *domain.SchemaWebhook
+	if request.Body.ValidationWebhook != nil {
+		webhook = &domain.SchemaWebhook{URL: request.Body.ValidationWebhook.Url}
+		if request.Body.ValidationWebhook.Secret != nil {
+			webhook.Secret = *request.Body.ValidationWebhook.Secret
+		}
+	}
+	schema, err := s.schemaService.Update(ctx, s.cfg.APIUI.IssuerDID, request.Id, &ports.UpdateSchemaRequest{
+		AutoRevokeOnExpiration: request.Body.AutoRevokeOnExpiration,
+		ValidationWebhook:      webhook,
+		ExtraContexts:          request.Body.ExtraContexts,
+		ExtraTypes:             request.Body.ExtraTypes,
+		DefaultProofTypes:      request.Body.DefaultProofTypes,
+		MaxValidityDays:        request.Body.MaxValidityDays,
+		Deprecated:             request.Body.Deprecated,
+		Version:                version,
+	})
+	if errors.Is(err, services.ErrSchemaNotFound) {
+		log.Debug(ctx, "schema not found", "id", request.Id)
+		return UpdateSchema404JSONResponse{N404JSONResponse{Message: "schema not found"}}, nil
+	}
+	if errors.Is(err, services.ErrVersionMismatch) {
+		log.Debug(ctx, "schema modified concurrently", "id", request.Id)
+		return UpdateSchema412JSONResponse{N412JSONResponse{Message: err.Error()}}, nil
+	}
+	if errors.Is(err, services.ErrInvalidValidationWebhook) || errors.Is(err, services.ErrInvalidCredentialContext) || errors.Is(err, services.ErrInvalidCredentialType) ||
+		errors.Is(err, services.ErrInvalidProofTypes) || errors.Is(err, services.ErrInvalidMaxValidity) {
+		return UpdateSchema400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+	}
+	if err != nil {
+		log.Error(ctx, "updating schema", "err", err, "id", request.Id)
+		return UpdateSchema500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return UpdateSchema200JSONResponse{Body: schemaResponse(schema), Headers: UpdateSchema200ResponseHeaders{ETag: etag(schema.Version)}}, nil
+}
+
+// GetSchemas returns the list of schemas that match the request.Params.Query filter and have the requested status and
+// version. If no param is given it will return all
 func (s *Server) GetSchemas(ctx context.Context, request GetSchemasRequestObject) (GetSchemasResponseObject, error) {
-	col, err := s.schemaService.GetAll(ctx, s.cfg.APIUI.IssuerDID, request.Params.Query)
+	filter := &ports.SchemasFilter{Query: request.Params.Query, SchemaVersion: request.Params.SchemaVersion}
+	if request.Params.Status != nil {
+		filter.Status = domain.SchemaStatus(*request.Params.Status)
+		if filter.Status != domain.SchemaStatusActive && filter.Status != domain.SchemaStatusDeprecated {
+			return GetSchemas400JSONResponse{N400JSONResponse{Message: "wrong status value. Allowed values: [active, deprecated]"}}, nil
+		}
+	}
+	col, err := s.schemaService.GetAll(ctx, s.cfg.APIUI.IssuerDID, filter)
 	if err != nil {
 		return GetSchemas500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
 	}
 	return GetSchemas200JSONResponse(schemaCollectionResponse(col)), nil
 }
 
-// Health is a method
+// Health is a method. It answers with a 503 while the server is warming up, as the rest of the endpoints do.
 func (s *Server) Health(_ context.Context, _ HealthRequestObject) (HealthResponseObject, error) {
+	if !s.health.Ready() {
+		return Health503JSONResponse(s.health.Status()), nil
+	}
 	var resp Health200JSONResponse = s.health.Status()
 
 	return resp, nil
@@ -101,6 +202,56 @@ func (s *Server) ImportSchema(ctx context.Context, request ImportSchemaRequestOb
 	return ImportSchema201JSONResponse{Id: schema.ID.String()}, nil
 }
 
+// BuildSchema is the UI endpoint to generate a schema from its attributes and import it
+func (s *Server) BuildSchema(ctx context.Context, request BuildSchemaRequestObject) (BuildSchemaResponseObject, error) {
+	pin := request.Body.PinToIPFS != nil && *request.Body.PinToIPFS
+	schema, err := s.schemaService.BuildSchema(ctx, s.cfg.APIUI.IssuerDID, toSchemaDefinition(request.Body), pin)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidSchemaDefinition) || errors.Is(err, services.ErrIPFSPinningDisabled) {
+			return BuildSchema400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "building schema", "err", err)
+		return BuildSchema500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return BuildSchema201JSONResponse{Id: schema.ID.String()}, nil
+}
+
+// GetSchemaJSON returns the JSON Schema of a schema built in the node
+func (s *Server) GetSchemaJSON(ctx context.Context, request GetSchemaJSONRequestObject) (GetSchemaJSONResponseObject, error) {
+	documents, err := s.schemaService.GetDocuments(ctx, request.Id)
+	if err != nil {
+		if errors.Is(err, services.ErrSchemaNotFound) {
+			return GetSchemaJSON404JSONResponse{N404JSONResponse{Message: "schema not found"}}, nil
+		}
+		log.Error(ctx, "loading schema documents", "err", err, "id", request.Id)
+		return GetSchemaJSON500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	var resp GetSchemaJSON200JSONResponse
+	if err := json.Unmarshal(documents.JSONSchema, &resp); err != nil {
+		log.Error(ctx, "decoding json schema", "err", err, "id", request.Id)
+		return GetSchemaJSON500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return resp, nil
+}
+
+// GetSchemaContext returns the JSON-LD context of a schema built in the node
+func (s *Server) GetSchemaContext(ctx context.Context, request GetSchemaContextRequestObject) (GetSchemaContextResponseObject, error) {
+	documents, err := s.schemaService.GetDocuments(ctx, request.Id)
+	if err != nil {
+		if errors.Is(err, services.ErrSchemaNotFound) {
+			return GetSchemaContext404JSONResponse{N404JSONResponse{Message: "schema not found"}}, nil
+		}
+		log.Error(ctx, "loading schema documents", "err", err, "id", request.Id)
+		return GetSchemaContext500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	var resp GetSchemaContext200JSONResponse
+	if err := json.Unmarshal(documents.JSONLDContext, &resp); err != nil {
+		log.Error(ctx, "decoding json-ld context", "err", err, "id", request.Id)
+		return GetSchemaContext500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return resp, nil
+}
+
 func guardImportSchemaReq(req *ImportSchemaJSONRequestBody) error {
 	if req == nil {
 		return errors.New("empty body")
@@ -117,6 +268,29 @@ func guardImportSchemaReq(req *ImportSchemaJSONRequestBody) error {
 	return nil
 }
 
+func toSchemaDefinition(req *BuildSchemaRequest) *domain.SchemaDefinition {
+	def := &domain.SchemaDefinition{Type: req.SchemaType, Attributes: make([]domain.SchemaAttributeDefinition, len(req.Attributes))}
+	if req.Title != nil {
+		def.Title = *req.Title
+	}
+	if req.Description != nil {
+		def.Description = *req.Description
+	}
+	for i, attr := range req.Attributes {
+		def.Attributes[i] = domain.SchemaAttributeDefinition{Name: attr.Name, Type: string(attr.Type)}
+		if attr.Title != nil {
+			def.Attributes[i].Title = *attr.Title
+		}
+		if attr.Description != nil {
+			def.Attributes[i].Description = *attr.Description
+		}
+		if attr.Required != nil {
+			def.Attributes[i].Required = *attr.Required
+		}
+	}
+	return def
+}
+
 // GetDocumentation this method will be overridden in the main function
 func (s *Server) GetDocumentation(_ context.Context, _ GetDocumentationRequestObject) (GetDocumentationResponseObject, error) {
 	return nil, nil
@@ -137,6 +311,9 @@ func (s *Server) AuthCallback(ctx context.Context, request AuthCallbackRequestOb
 	_, err := s.identityService.Authenticate(ctx, *request.Body, request.Params.SessionID, s.cfg.APIUI.ServerURL, s.cfg.APIUI.IssuerDID)
 	if err != nil {
 		log.Debug(ctx, "error authenticating", err.Error())
+		if errors.Is(err, services.ErrSessionAlreadyUsed) {
+			return AuthCallback409JSONResponse{N409JSONResponse{err.Error()}}, nil
+		}
 		return AuthCallback500JSONResponse{}, nil
 	}
 
@@ -182,25 +359,103 @@ func (s *Server) GetConnection(ctx context.Context, request GetConnectionRequest
 	filter := &ports.ClaimsFilter{
 		Subject: conn.UserDID.String(),
 	}
-	credentials, err := s.claimService.GetAll(ctx, s.cfg.APIUI.IssuerDID, filter)
-	if err != nil && !errors.Is(err, services.ErrClaimNotFound) {
-		log.Debug(ctx, "get connection internal server error retrieving credentials", "err", err, "req", request)
+	summary, err := s.claimService.GetSummary(ctx, s.cfg.APIUI.IssuerDID, filter)
+	if err != nil {
+		log.Debug(ctx, "get connection internal server error counting credentials", "err", err, "req", request)
 		return GetConnection500JSONResponse{N500JSONResponse{"There was an error retrieving the connection"}}, nil
 	}
 
-	w3credentials, err := schema.FromClaimsModelToW3CCredential(credentials)
+	resp := connectionResponse(conn, nil, nil)
+	resp.CredentialsSummary = &CredentialsSummary{Total: summary.Total, Revoked: summary.Revoked, Expired: summary.Expired}
+	return GetConnection200JSONResponse{Body: resp, Headers: GetConnection200ResponseHeaders{ETag: etag(connectionVersion(conn))}}, nil
+}
+
+// GetConnectionProblemReports returns the problem reports exchanged with the holder of a connection
+func (s *Server) GetConnectionProblemReports(ctx context.Context, request GetConnectionProblemReportsRequestObject) (GetConnectionProblemReportsResponseObject, error) {
+	_, err := s.connectionsService.GetByIDAndIssuerID(ctx, request.Id, s.cfg.APIUI.IssuerDID)
+	if errors.Is(err, services.ErrConnectionDoesNotExist) {
+		return GetConnectionProblemReports404JSONResponse{N404JSONResponse{"The given connection does not exist"}}, nil
+	}
 	if err != nil {
-		log.Debug(ctx, "get connection internal server error converting credentials to w3c", "err", err, "req", request)
-		return GetConnection500JSONResponse{N500JSONResponse{"There was an error parsing the credential of the given connection"}}, nil
+		log.Error(ctx, "get connection problem reports, loading connection", "err", err, "id", request.Id)
+		return GetConnectionProblemReports500JSONResponse{N500JSONResponse{"There was an error retrieving the connection"}}, nil
 	}
 
-	return GetConnection200JSONResponse(connectionResponse(conn, w3credentials, credentials)), nil
+	reports, err := s.claimService.GetProblemReports(ctx, s.cfg.APIUI.IssuerDID, ports.ProblemReportsFilter{ConnectionID: &request.Id})
+	if err != nil {
+		log.Error(ctx, "loading connection problem reports", "err", err, "id", request.Id)
+		return GetConnectionProblemReports500JSONResponse{N500JSONResponse{"error getting the problem reports"}}, nil
+	}
+	resp := make(GetConnectionProblemReports200JSONResponse, len(reports))
+	for i, report := range reports {
+		resp[i] = problemReportResponse(report)
+	}
+	return resp, nil
+}
+
+// GetConnectionCredentials returns a page of the credentials of the holder of a connection
+func (s *Server) GetConnectionCredentials(ctx context.Context, request GetConnectionCredentialsRequestObject) (GetConnectionCredentialsResponseObject, error) {
+	conn, err := s.connectionsService.GetByIDAndIssuerID(ctx, request.Id, s.cfg.APIUI.IssuerDID)
+	if errors.Is(err, services.ErrConnectionDoesNotExist) {
+		return GetConnectionCredentials404JSONResponse{N404JSONResponse{"The given connection does not exist"}}, nil
+	}
+	if err != nil {
+		log.Error(ctx, "get connection credentials, loading connection", "err", err, "id", request.Id)
+		return GetConnectionCredentials500JSONResponse{N500JSONResponse{"There was an error retrieving the connection"}}, nil
+	}
+
+	page, limit := 1, defaultConnectionCredentialsLimit
+	if request.Params.Page != nil {
+		page = *request.Params.Page
+	}
+	if request.Params.Limit != nil {
+		limit = *request.Params.Limit
+	}
+	if page < 1 || limit < 1 || limit > maxConnectionCredentialsLimit {
+		return GetConnectionCredentials400JSONResponse{N400JSONResponse{fmt.Sprintf("page must be 1 or greater and limit between 1 and %d", maxConnectionCredentialsLimit)}}, nil
+	}
+
+	userDID := conn.UserDID.String()
+	filter, err := getCredentialsFilter(ctx, &userDID, (*GetCredentialsParamsStatus)(request.Params.Status), request.Params.Query)
+	if err != nil {
+		return GetConnectionCredentials400JSONResponse{N400JSONResponse{err.Error()}}, nil
+	}
+	summary, err := s.claimService.GetSummary(ctx, s.cfg.APIUI.IssuerDID, filter)
+	if errors.Is(err, db.ErrQueryTimeout) {
+		return nil, err
+	}
+	if err != nil {
+		log.Error(ctx, "get connection credentials, counting", "err", err, "id", request.Id)
+		return GetConnectionCredentials500JSONResponse{N500JSONResponse{"There was an error retrieving the credentials of the connection"}}, nil
+	}
+
+	filter.Limit, filter.Offset = limit, (page-1)*limit
+	credentials, err := s.claimService.GetAllDisplay(ctx, s.cfg.APIUI.IssuerDID, filter)
+	if errors.Is(err, db.ErrQueryTimeout) {
+		return nil, err
+	}
+	if err != nil {
+		log.Error(ctx, "get connection credentials", "err", err, "id", request.Id)
+		return GetConnectionCredentials500JSONResponse{N500JSONResponse{"There was an error retrieving the credentials of the connection"}}, nil
+	}
+	response := credentialRowsResponse(credentials)
+	if err := s.addRevocations(ctx, response); err != nil {
+		log.Error(ctx, "get connection credentials, loading revocations", "err", err, "id", request.Id)
+		return GetConnectionCredentials500JSONResponse{N500JSONResponse{"There was an error retrieving the credentials of the connection"}}, nil
+	}
+	s.maskCredentials(response)
+
+	return GetConnectionCredentials200JSONResponse{Credentials: response, Total: summary.Total, Page: page, Limit: limit}, nil
 }
 
 // GetConnections returns the list of credentials of a determined issuer
 func (s *Server) GetConnections(ctx context.Context, request GetConnectionsRequestObject) (GetConnectionsResponseObject, error) {
-	req := ports.NewGetAllRequest(request.Params.Credentials, request.Params.Query)
-	conns, err := s.connectionsService.GetAllByIssuerID(ctx, s.cfg.APIUI.IssuerDID, req.Query, req.WithCredentials)
+	req := ports.NewGetAllRequest(request.Params.Credentials, request.Params.Query, request.Params.Tags)
+	filter := &ports.ConnectionsFilter{Query: req.Query, Tags: req.Tags, IncludeDeleted: request.Params.IncludeDeleted != nil && *request.Params.IncludeDeleted}
+	conns, err := s.connectionsService.GetAllByIssuerID(ctx, s.cfg.APIUI.IssuerDID, filter, req.WithCredentials)
+	if errors.Is(err, db.ErrQueryTimeout) {
+		return nil, err
+	}
 	if err != nil {
 		log.Error(ctx, "get connection request", "err", err)
 		return GetConnections500JSONResponse{N500JSONResponse{"Unexpected error while retrieving connections"}}, nil
@@ -212,6 +467,9 @@ func (s *Server) GetConnections(ctx context.Context, request GetConnectionsReque
 		return GetConnections500JSONResponse{N500JSONResponse{"Unexpected error while retrieving connections"}}, nil
 
 	}
+	for i := range resp {
+		s.maskCredentials(resp[i].Credentials)
+	}
 
 	return GetConnections200JSONResponse(resp), nil
 }
@@ -240,6 +498,51 @@ func (s *Server) DeleteConnection(ctx context.Context, request DeleteConnectionR
 	return DeleteConnection200JSONResponse{Message: deleteConnectionResponse(req.DeleteCredentials, req.RevokeCredentials)}, nil
 }
 
+// RestoreConnection restores a deleted connection that was not purged yet
+func (s *Server) RestoreConnection(ctx context.Context, request RestoreConnectionRequestObject) (RestoreConnectionResponseObject, error) {
+	if err := s.connectionsService.Restore(ctx, request.Id, s.cfg.APIUI.IssuerDID); err != nil {
+		if errors.Is(err, services.ErrConnectionDoesNotExist) {
+			return RestoreConnection404JSONResponse{N404JSONResponse{"The given connection is not deleted or was already purged"}}, nil
+		}
+		log.Error(ctx, "restoring connection", "err", err, "id", request.Id)
+		return RestoreConnection500JSONResponse{N500JSONResponse{"There was an error restoring the connection"}}, nil
+	}
+	return RestoreConnection200JSONResponse{Message: "Connection successfully restored"}, nil
+}
+
+// UpdateConnectionMetadata changes the display name, notes and tags of a connection
+func (s *Server) UpdateConnectionMetadata(ctx context.Context, request UpdateConnectionMetadataRequestObject) (UpdateConnectionMetadataResponseObject, error) {
+	if request.Body == nil {
+		return UpdateConnectionMetadata400JSONResponse{N400JSONResponse{Message: "bad request: empty body"}}, nil
+	}
+	version, err := ifMatchVersion(request.Params.IfMatch)
+	if err != nil {
+		return UpdateConnectionMetadata400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+	}
+	conn, err := s.connectionsService.UpdateMetadata(ctx, request.Id, s.cfg.APIUI.IssuerDID, &ports.UpdateConnectionMetadataRequest{
+		DisplayName: request.Body.DisplayName,
+		Notes:       request.Body.Notes,
+		Tags:        request.Body.Tags,
+		Version:     version,
+	})
+	if errors.Is(err, services.ErrConnectionDoesNotExist) {
+		log.Debug(ctx, "connection not found", "id", request.Id)
+		return UpdateConnectionMetadata404JSONResponse{N404JSONResponse{Message: "The given connection does not exist"}}, nil
+	}
+	if errors.Is(err, services.ErrInvalidConnectionMetadata) {
+		return UpdateConnectionMetadata400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+	}
+	if errors.Is(err, services.ErrVersionMismatch) {
+		log.Debug(ctx, "connection metadata modified concurrently", "id", request.Id)
+		return UpdateConnectionMetadata412JSONResponse{N412JSONResponse{Message: err.Error()}}, nil
+	}
+	if err != nil {
+		log.Error(ctx, "updating connection metadata", "err", err, "id", request.Id)
+		return UpdateConnectionMetadata500JSONResponse{N500JSONResponse{Message: "There was an error updating the connection"}}, nil
+	}
+	return UpdateConnectionMetadata200JSONResponse{Body: connectionMetadataResponse(conn.Metadata), Headers: UpdateConnectionMetadata200ResponseHeaders{ETag: etag(connectionVersion(conn))}}, nil
+}
+
 // DeleteConnectionCredentials deletes all the credentials of the given connection
 func (s *Server) DeleteConnectionCredentials(ctx context.Context, request DeleteConnectionCredentialsRequestObject) (DeleteConnectionCredentialsResponseObject, error) {
 	err := s.connectionsService.DeleteCredentials(ctx, request.Id, s.cfg.APIUI.IssuerDID)
@@ -266,7 +569,65 @@ func (s *Server) GetCredential(ctx context.Context, request GetCredentialRequest
 		return GetCredential500JSONResponse{N500JSONResponse{"Invalid claim format"}}, nil
 	}
 
-	return GetCredential200JSONResponse(credentialResponse(w3c, credential)), nil
+	response := []Credential{credentialResponse(w3c, credential)}
+	if err := s.addRevocations(ctx, response); err != nil {
+		log.Error(ctx, "loading credential revocation", "err", err, "id", request.Id)
+		return GetCredential500JSONResponse{N500JSONResponse{"There was an error trying to retrieve the credential information"}}, nil
+	}
+	return GetCredential200JSONResponse(response[0]), nil
+}
+
+// GetCredentialByNonce returns the credential with the given revocation nonce
+func (s *Server) GetCredentialByNonce(ctx context.Context, request GetCredentialByNonceRequestObject) (GetCredentialByNonceResponseObject, error) {
+	if request.Nonce < 0 {
+		return GetCredentialByNonce400JSONResponse{N400JSONResponse{"invalid nonce, it cannot be negative"}}, nil
+	}
+	credential, err := s.claimService.GetByRevocationNonce(ctx, &s.cfg.APIUI.IssuerDID, uint64(request.Nonce))
+	if err != nil {
+		if errors.Is(err, services.ErrClaimNotFound) {
+			return GetCredentialByNonce404JSONResponse{N404JSONResponse{"There is no credential with the given revocation nonce"}}, nil
+		}
+		log.Error(ctx, "loading credential by revocation nonce", "err", err, "nonce", request.Nonce)
+		return GetCredentialByNonce500JSONResponse{N500JSONResponse{"There was an error trying to retrieve the credential information"}}, nil
+	}
+
+	w3c, err := schema.FromClaimModelToW3CCredential(*credential)
+	if err != nil {
+		return GetCredentialByNonce500JSONResponse{N500JSONResponse{"Invalid claim format"}}, nil
+	}
+
+	response := []Credential{credentialResponse(w3c, credential)}
+	if err := s.addRevocations(ctx, response); err != nil {
+		log.Error(ctx, "loading credential revocation", "err", err, "nonce", request.Nonce)
+		return GetCredentialByNonce500JSONResponse{N500JSONResponse{"There was an error trying to retrieve the credential information"}}, nil
+	}
+	return GetCredentialByNonce200JSONResponse(response[0]), nil
+}
+
+// GetCredentialHistory returns the events of a credential, from its issuance to its revocation
+func (s *Server) GetCredentialHistory(ctx context.Context, request GetCredentialHistoryRequestObject) (GetCredentialHistoryResponseObject, error) {
+	events, err := s.claimService.GetHistory(ctx, s.cfg.APIUI.IssuerDID, request.Id)
+	if err != nil {
+		if errors.Is(err, services.ErrClaimNotFound) {
+			return GetCredentialHistory404JSONResponse{N404JSONResponse{"The given credential id does not exist"}}, nil
+		}
+		log.Error(ctx, "loading credential history", "err", err, "id", request.Id)
+		return GetCredentialHistory500JSONResponse{N500JSONResponse{"There was an error trying to retrieve the credential history"}}, nil
+	}
+	return GetCredentialHistory200JSONResponse(credentialHistoryResponse(events)), nil
+}
+
+// GetCredentialJWT returns the credential serialized as a JWT VC signed by the issuer.
+func (s *Server) GetCredentialJWT(ctx context.Context, request GetCredentialJWTRequestObject) (GetCredentialJWTResponseObject, error) {
+	token, err := s.jwtCredentials.Serialize(ctx, s.cfg.APIUI.IssuerDID, request.Id)
+	if err != nil {
+		if errors.Is(err, services.ErrClaimNotFound) {
+			return GetCredentialJWT404JSONResponse{N404JSONResponse{"The given credential id does not exist"}}, nil
+		}
+		log.Error(ctx, "serializing jwt credential", "err", err, "id", request.Id)
+		return GetCredentialJWT500JSONResponse{N500JSONResponse{"There was an error trying to serialize the credential"}}, nil
+	}
+	return GetCredentialJWT200JSONResponse{Jwt: token}, nil
 }
 
 // GetCredentials returns a collection of credentials that matches the request.
@@ -275,20 +636,24 @@ func (s *Server) GetCredentials(ctx context.Context, request GetCredentialsReque
 	if err != nil {
 		return GetCredentials400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
 	}
-	credentials, err := s.claimService.GetAll(ctx, s.cfg.APIUI.IssuerDID, filter)
+	filter.IncludeDeleted = request.Params.IncludeDeleted != nil && *request.Params.IncludeDeleted
+	if filter.Publication, err = publicationFilter(request.Params.Publication); err != nil {
+		return GetCredentials400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+	}
+	credentials, err := s.claimService.GetAllDisplay(ctx, s.cfg.APIUI.IssuerDID, filter)
+	if errors.Is(err, db.ErrQueryTimeout) {
+		return nil, err
+	}
 	if err != nil {
 		log.Error(ctx, "loading credentials", "err", err, "req", request)
 		return GetCredentials500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
 	}
-	response := make([]Credential, len(credentials))
-	for i, credential := range credentials {
-		w3c, err := schema.FromClaimModelToW3CCredential(*credential)
-		if err != nil {
-			log.Error(ctx, "creating credentials response", "err", err, "req", request)
-			return GetCredentials500JSONResponse{N500JSONResponse{"Invalid claim format"}}, nil
-		}
-		response[i] = credentialResponse(w3c, credential)
+	response := credentialRowsResponse(credentials)
+	if err := s.addRevocations(ctx, response); err != nil {
+		log.Error(ctx, "loading credentials revocations", "err", err, "req", request)
+		return GetCredentials500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
 	}
+	s.maskCredentials(response)
 	return GetCredentials200JSONResponse(response), nil
 }
 
@@ -305,6 +670,18 @@ func (s *Server) DeleteCredential(ctx context.Context, request DeleteCredentialR
 	return DeleteCredential200JSONResponse{Message: "Credential successfully deleted"}, nil
 }
 
+// RestoreCredential restores a deleted credential that was not purged yet
+func (s *Server) RestoreCredential(ctx context.Context, request RestoreCredentialRequestObject) (RestoreCredentialResponseObject, error) {
+	if err := s.claimService.Restore(ctx, s.cfg.APIUI.IssuerDID, request.Id); err != nil {
+		if errors.Is(err, services.ErrClaimNotFound) {
+			return RestoreCredential404JSONResponse{N404JSONResponse{"The given credential is not deleted or was already purged"}}, nil
+		}
+		log.Error(ctx, "restoring credential", "err", err, "id", request.Id)
+		return RestoreCredential500JSONResponse{N500JSONResponse{"There was an error restoring the credential"}}, nil
+	}
+	return RestoreCredential200JSONResponse{Message: "Credential successfully restored"}, nil
+}
+
 // GetYaml this method will be overridden in the main function
 func (s *Server) GetYaml(_ context.Context, _ GetYamlRequestObject) (GetYamlResponseObject, error) {
 	return nil, nil
@@ -312,41 +689,267 @@ func (s *Server) GetYaml(_ context.Context, _ GetYamlRequestObject) (GetYamlResp
 
 // CreateCredential - creates a new credential
 func (s *Server) CreateCredential(ctx context.Context, request CreateCredentialRequestObject) (CreateCredentialResponseObject, error) {
-	if request.Body.SignatureProof == nil && request.Body.MtProof == nil {
-		return CreateCredential400JSONResponse{N400JSONResponse{Message: "you must to provide at least one proof type"}}, nil
+	if request.Body.SubjectExternalID != nil {
+		binding, err := s.linkService.GetSubjectBinding(ctx, s.cfg.APIUI.IssuerDID, *request.Body.SubjectExternalID)
+		if err != nil {
+			if errors.Is(err, services.ErrSubjectNotBound) || errors.Is(err, domain.ErrInvalidSubjectExternalID) {
+				return CreateCredential400JSONResponse{Message: err.Error()}, nil
+			}
+			log.Error(ctx, "loading the subject binding", "err", err)
+			return CreateCredential500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+		}
+		if request.Body.CredentialSubject == nil {
+			request.Body.CredentialSubject = map[string]interface{}{}
+		}
+		if id, ok := request.Body.CredentialSubject["id"]; ok && id != binding.HolderDID.String() {
+			return CreateCredential400JSONResponse{Message: "the id of the credential subject is not the DID the subject is bound to"}, nil
+		}
+		request.Body.CredentialSubject["id"] = binding.HolderDID.String()
 	}
 	req := ports.NewCreateClaimRequest(&s.cfg.APIUI.IssuerDID, request.Body.CredentialSchema, request.Body.CredentialSubject, request.Body.Expiration, request.Body.Type, nil, nil, nil, request.Body.SignatureProof, request.Body.MtProof, nil, true)
+	if request.Body.ExtraContexts != nil {
+		req.ExtraContexts = *request.Body.ExtraContexts
+	}
+	if request.Body.ExtraTypes != nil {
+		req.ExtraTypes = *request.Body.ExtraTypes
+	}
+	if request.Body.RefreshService != nil {
+		req.RefreshService = *request.Body.RefreshService
+	}
+	req.ExpirationDays = request.Body.ExpirationDays
 	resp, err := s.claimService.Save(ctx, req)
 	if err != nil {
 		if errors.Is(err, services.ErrJSONLdContext) {
-			return CreateCredential400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+			return CreateCredential400JSONResponse{Message: err.Error()}, nil
 		}
 		if errors.Is(err, services.ErrProcessSchema) {
-			return CreateCredential400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+			return CreateCredential400JSONResponse{Message: err.Error()}, nil
 		}
 		if errors.Is(err, services.ErrLoadingSchema) {
 			return CreateCredential422JSONResponse{N422JSONResponse{Message: err.Error()}}, nil
 		}
 		if errors.Is(err, services.ErrParseClaim) {
-			return CreateCredential400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+			return CreateCredential400JSONResponse{Message: err.Error()}, nil
 		}
 		if errors.Is(err, services.ErrInvalidCredentialSubject) {
-			return CreateCredential400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+			return CreateCredential400JSONResponse{Message: err.Error(), Errors: credentialSubjectErrors(err)}, nil
 		}
 		if errors.Is(err, services.ErrLoadingSchema) {
-			return CreateCredential400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+			return CreateCredential400JSONResponse{Message: err.Error()}, nil
 		}
 		if errors.Is(err, services.ErrMalformedURL) {
-			return CreateCredential400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+			return CreateCredential400JSONResponse{Message: err.Error()}, nil
+		}
+		if errors.Is(err, services.ErrInvalidCredentialContext) || errors.Is(err, services.ErrInvalidCredentialType) {
+			return CreateCredential400JSONResponse{Message: err.Error()}, nil
+		}
+		if errors.Is(err, domain.ErrProofPolicy) {
+			return CreateCredential400JSONResponse{Message: err.Error()}, nil
+		}
+		if errors.Is(err, services.ErrSchemaDeprecated) {
+			return CreateCredential400JSONResponse{Message: err.Error()}, nil
+		}
+		if errors.Is(err, services.ErrInvalidExpiration) || errors.Is(err, domain.ErrMaxValidity) {
+			return CreateCredential400JSONResponse{Message: err.Error()}, nil
+		}
+		if errors.Is(err, domain.ErrInvalidSubjectDID) {
+			return CreateCredential400JSONResponse{Message: err.Error()}, nil
+		}
+		if errors.Is(err, services.ErrCredentialRejected) {
+			return CreateCredential422JSONResponse{N422JSONResponse{Message: err.Error()}}, nil
+		}
+		if errors.Is(err, services.ErrValidationWebhookUnavailable) || errors.Is(err, services.ErrDIDResolverUnavailable) {
+			log.Error(ctx, "credential not validated", "err", err)
 		}
 		return CreateCredential500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
 	}
 	return CreateCredential201JSONResponse{Id: resp.ID.String()}, nil
 }
 
+// CreateCredentialTemplate - stores a credential template of an imported schema
+func (s *Server) CreateCredentialTemplate(ctx context.Context, request CreateCredentialTemplateRequestObject) (CreateCredentialTemplateResponseObject, error) {
+	var credentialSubject domain.CredentialSubject
+	if request.Body.CredentialSubject != nil {
+		credentialSubject = domain.CredentialSubject(*request.Body.CredentialSubject)
+	}
+	template := domain.NewCredentialTemplate(s.cfg.APIUI.IssuerDID, request.Body.Name, request.Body.SchemaID, credentialSubject, request.Body.ExpirationDays, request.Body.SignatureProof, request.Body.MtProof)
+	template, err := s.templateService.Create(ctx, template)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrInvalidCredentialTemplate), errors.Is(err, services.ErrSchemaDeprecated):
+			return CreateCredentialTemplate400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		case errors.Is(err, services.ErrSchemaNotFound):
+			return CreateCredentialTemplate404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		case errors.Is(err, services.ErrCredentialTemplateDuplicated):
+			return CreateCredentialTemplate409JSONResponse{N409JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "creating credential template", "err", err)
+		return CreateCredentialTemplate500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return CreateCredentialTemplate201JSONResponse(credentialTemplateResponse(template)), nil
+}
+
+// GetCredentialTemplates - returns the credential templates of the issuer
+func (s *Server) GetCredentialTemplates(ctx context.Context, _ GetCredentialTemplatesRequestObject) (GetCredentialTemplatesResponseObject, error) {
+	templates, err := s.templateService.GetAll(ctx, s.cfg.APIUI.IssuerDID)
+	if err != nil {
+		log.Error(ctx, "getting credential templates", "err", err)
+		return GetCredentialTemplates500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	resp := make(GetCredentialTemplates200JSONResponse, len(templates))
+	for i, template := range templates {
+		resp[i] = credentialTemplateResponse(template)
+	}
+	return resp, nil
+}
+
+// GetCredentialTemplate - returns a credential template
+func (s *Server) GetCredentialTemplate(ctx context.Context, request GetCredentialTemplateRequestObject) (GetCredentialTemplateResponseObject, error) {
+	template, err := s.templateService.GetByID(ctx, s.cfg.APIUI.IssuerDID, request.Id)
+	if err != nil {
+		if errors.Is(err, services.ErrCredentialTemplateNotFound) {
+			return GetCredentialTemplate404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "getting credential template", "err", err, "id", request.Id)
+		return GetCredentialTemplate500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return GetCredentialTemplate200JSONResponse(credentialTemplateResponse(template)), nil
+}
+
+// DeleteCredentialTemplate - removes a credential template
+func (s *Server) DeleteCredentialTemplate(ctx context.Context, request DeleteCredentialTemplateRequestObject) (DeleteCredentialTemplateResponseObject, error) {
+	if err := s.templateService.Delete(ctx, s.cfg.APIUI.IssuerDID, request.Id); err != nil {
+		if errors.Is(err, services.ErrCredentialTemplateNotFound) {
+			return DeleteCredentialTemplate404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "deleting credential template", "err", err, "id", request.Id)
+		return DeleteCredentialTemplate500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return DeleteCredentialTemplate200JSONResponse{Message: "credential template deleted"}, nil
+}
+
+// CreateCredentialFromTemplate - creates a credential from a template with the variable fields of the request
+func (s *Server) CreateCredentialFromTemplate(ctx context.Context, request CreateCredentialFromTemplateRequestObject) (CreateCredentialFromTemplateResponseObject, error) {
+	claim, err := s.templateService.Issue(ctx, s.cfg.APIUI.IssuerDID, request.TemplateId, domain.CredentialSubject(request.Body.CredentialSubject), request.Body.Expiration)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrCredentialTemplateNotFound):
+			return CreateCredentialFromTemplate404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		case errors.Is(err, services.ErrInvalidCredentialSubject):
+			return CreateCredentialFromTemplate400JSONResponse{Message: err.Error(), Errors: credentialSubjectErrors(err)}, nil
+		case errors.Is(err, services.ErrLoadingSchema), errors.Is(err, services.ErrCredentialRejected):
+			return CreateCredentialFromTemplate422JSONResponse{N422JSONResponse{Message: err.Error()}}, nil
+		case errors.Is(err, services.ErrJSONLdContext),
+			errors.Is(err, services.ErrProcessSchema),
+			errors.Is(err, services.ErrParseClaim),
+			errors.Is(err, services.ErrMalformedURL),
+			errors.Is(err, services.ErrInvalidCredentialContext),
+			errors.Is(err, services.ErrInvalidCredentialType),
+			errors.Is(err, domain.ErrProofPolicy),
+			errors.Is(err, services.ErrSchemaDeprecated),
+			errors.Is(err, domain.ErrMaxValidity),
+			errors.Is(err, domain.ErrInvalidSubjectDID):
+			return CreateCredentialFromTemplate400JSONResponse{Message: err.Error()}, nil
+		case errors.Is(err, services.ErrValidationWebhookUnavailable), errors.Is(err, services.ErrDIDResolverUnavailable):
+			log.Error(ctx, "credential not validated", "err", err)
+		}
+		return CreateCredentialFromTemplate500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return CreateCredentialFromTemplate201JSONResponse{Id: claim.ID.String()}, nil
+}
+
+// ImportCredentials - starts a job that issues the credentials, or creates the credential links, of the rows of a CSV file
+func (s *Server) ImportCredentials(ctx context.Context, request ImportCredentialsRequestObject) (ImportCredentialsResponseObject, error) {
+	form, file, err := readImportForm(request.Body)
+	if err != nil {
+		return ImportCredentials400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+	}
+	schemaID, err := uuid.Parse(form["schemaID"])
+	if err != nil {
+		return ImportCredentials400JSONResponse{N400JSONResponse{Message: "invalid schemaID"}}, nil
+	}
+	var signatureProof, mtProof bool
+	if signatureProof, err = formBool(form, "signatureProof"); err != nil {
+		return ImportCredentials400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+	}
+	if mtProof, err = formBool(form, "mtProof"); err != nil {
+		return ImportCredentials400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+	}
+	var expiration *time.Time
+	if value := form["credentialExpiration"]; value != "" {
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return ImportCredentials400JSONResponse{N400JSONResponse{Message: "invalid credentialExpiration, it must be a RFC3339 date time"}}, nil
+		}
+		expiration = &t
+	}
+	var mapping map[string]string
+	if value := form["mapping"]; value != "" {
+		if err := json.Unmarshal([]byte(value), &mapping); err != nil {
+			return ImportCredentials400JSONResponse{N400JSONResponse{Message: "invalid mapping, it must be a JSON object with the attribute of every column"}}, nil
+		}
+	}
+
+	job := domain.NewImportJob(s.cfg.APIUI.IssuerDID, schemaID, domain.ImportMode(form["mode"]), signatureProof, mtProof, expiration)
+	job, err = s.importService.Start(ctx, job, bytes.NewReader(file), mapping, form["referenceColumn"])
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrInvalidImport), errors.Is(err, services.ErrSchemaDeprecated):
+			return ImportCredentials400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		case errors.Is(err, services.ErrSchemaNotFound):
+			return ImportCredentials404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "starting import job", "err", err)
+		return ImportCredentials500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return ImportCredentials202JSONResponse(importJobResponse(job)), nil
+}
+
+// GetImportJob - returns the progress of an import job
+func (s *Server) GetImportJob(ctx context.Context, request GetImportJobRequestObject) (GetImportJobResponseObject, error) {
+	job, err := s.importService.GetByID(ctx, s.cfg.APIUI.IssuerDID, request.Id)
+	if err != nil {
+		if errors.Is(err, services.ErrImportJobNotFound) {
+			return GetImportJob404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "getting import job", "err", err, "id", request.Id)
+		return GetImportJob500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return GetImportJob200JSONResponse(importJobResponse(job)), nil
+}
+
+// GetImportReport - returns the processed rows of an import job as a CSV file
+func (s *Server) GetImportReport(ctx context.Context, request GetImportReportRequestObject) (GetImportReportResponseObject, error) {
+	rows, err := s.importService.GetRows(ctx, s.cfg.APIUI.IssuerDID, request.Id, request.Params.OnlyErrors != nil && *request.Params.OnlyErrors)
+	if err != nil {
+		if errors.Is(err, services.ErrImportJobNotFound) {
+			return GetImportReport404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "getting import job rows", "err", err, "id", request.Id)
+		return GetImportReport500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	content, err := reports.ImportCSV(rows)
+	if err != nil {
+		log.Error(ctx, "rendering import report", "err", err, "id", request.Id)
+		return GetImportReport500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return GetImportReport200TextcsvResponse{Body: bytes.NewReader(content), ContentLength: int64(len(content))}, nil
+}
+
 // RevokeCredential - revokes a credential per a given nonce
 func (s *Server) RevokeCredential(ctx context.Context, request RevokeCredentialRequestObject) (RevokeCredentialResponseObject, error) {
-	if err := s.claimService.Revoke(ctx, s.cfg.APIUI.IssuerDID, uint64(request.Nonce), ""); err != nil {
+	var code, description string
+	if request.Params.Reason != nil {
+		code = string(*request.Params.Reason)
+	}
+	if request.Params.Description != nil {
+		description = *request.Params.Description
+	}
+	reason, err := domain.NewRevocationReason(code)
+	if err != nil {
+		return RevokeCredential400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+	}
+	if err := s.claimService.Revoke(ctx, s.cfg.APIUI.IssuerDID, uint64(request.Nonce), reason, description); err != nil {
 		if errors.Is(err, repositories.ErrClaimDoesNotExist) {
 			return RevokeCredential404JSONResponse{N404JSONResponse{
 				Message: "the claim does not exist",
@@ -355,6 +958,11 @@ func (s *Server) RevokeCredential(ctx context.Context, request RevokeCredentialR
 		log.Error(ctx, "revoke credential", "err", err, "req", request)
 		return RevokeCredential500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
 	}
+	if request.Params.PublishNow != nil && *request.Params.PublishNow {
+		return RevokeCredential202JSONResponse{
+			Message: s.publishRevocation(ctx, "claim revocation request sent"),
+		}, nil
+	}
 	return RevokeCredential202JSONResponse{
 		Message: "claim revocation request sent",
 	}, nil
@@ -372,6 +980,51 @@ func (s *Server) GetRevocationStatus(ctx context.Context, request GetRevocationS
 	return GetRevocationStatus200JSONResponse(getRevocationStatusResponse(rs)), err
 }
 
+// GetRevocationList returns the revoked nonces of the issuer, as a list or as a bitstring, so the relying parties can sync the revocations in bulk. This endpoint must be public available
+func (s *Server) GetRevocationList(ctx context.Context, request GetRevocationListRequestObject) (GetRevocationListResponseObject, error) {
+	format := List
+	if request.Params.Format != nil {
+		format = *request.Params.Format
+	}
+	if format != List && format != Bitstring {
+		return GetRevocationList400JSONResponse{N400JSONResponse{"invalid format, it must be list or bitstring"}}, nil
+	}
+	if format == Bitstring && request.Params.Since != nil {
+		return GetRevocationList400JSONResponse{N400JSONResponse{"the bitstring format can not be combined with since"}}, nil
+	}
+
+	list, err := s.identityService.GetRevocationList(ctx, s.cfg.APIUI.IssuerDID, request.Params.Since)
+	if err != nil {
+		log.Error(ctx, "loading revocation list", "err", err)
+		return GetRevocationList500JSONResponse{N500JSONResponse{"There was an error trying to retrieve the revocation list"}}, nil
+	}
+
+	response := RevocationList{
+		Issuer:   list.IssuerDID.String(),
+		Since:    list.Since,
+		SyncedAt: list.SyncedAt,
+	}
+	if format == List {
+		nonces := make([]uint64, len(list.Nonces))
+		for i, nonce := range list.Nonces {
+			nonces[i] = uint64(nonce)
+		}
+		response.Nonces = &nonces
+		return GetRevocationList200JSONResponse(response), nil
+	}
+
+	encoded, err := list.Bitstring()
+	if err != nil {
+		if errors.Is(err, domain.ErrRevocationBitstringTooLarge) {
+			return GetRevocationList422JSONResponse{N422JSONResponse{err.Error()}}, nil
+		}
+		log.Error(ctx, "encoding revocation list", "err", err)
+		return GetRevocationList500JSONResponse{N500JSONResponse{"There was an error trying to encode the revocation list"}}, nil
+	}
+	response.EncodedList = &encoded
+	return GetRevocationList200JSONResponse(response), nil
+}
+
 // PublishState - pubish the state onchange
 func (s *Server) PublishState(ctx context.Context, request PublishStateRequestObject) (PublishStateResponseObject, error) {
 	publishedState, err := s.publisherGateway.PublishState(ctx, &s.cfg.APIUI.IssuerDID)
@@ -419,7 +1072,19 @@ func (s *Server) GetStateStatus(ctx context.Context, _ GetStateStatusRequestObje
 		return GetStateStatus500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
 	}
 
-	return GetStateStatus200JSONResponse{PendingActions: pendingActions}, nil
+	states, err := s.identityService.GetStates(ctx, s.cfg.APIUI.IssuerDID)
+	if err != nil {
+		log.Error(ctx, "get state status", "err", err)
+		return GetStateStatus500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+
+	// the estimation needs the blockchain, so the status is still returned if it is not available
+	cost, err := s.publisherGateway.EstimatePublishingCost(ctx, &s.cfg.APIUI.IssuerDID)
+	if err != nil {
+		log.Warn(ctx, "estimating publishing cost", "err", err)
+	}
+
+	return GetStateStatus200JSONResponse(stateStatusResponse(pendingActions, states, cost)), nil
 }
 
 // GetStateTransactions - get the state transactions
@@ -433,6 +1098,106 @@ func (s *Server) GetStateTransactions(ctx context.Context, _ GetStateTransaction
 	return GetStateTransactions200JSONResponse(stateTransactionsResponse(states)), nil
 }
 
+// GetChanges returns the changes of the credentials and connections of the issuer after the given cursor
+func (s *Server) GetChanges(ctx context.Context, request GetChangesRequestObject) (GetChangesResponseObject, error) {
+	var since string
+	if request.Params.Since != nil {
+		since = *request.Params.Since
+	}
+	var limit int
+	if request.Params.Limit != nil {
+		limit = *request.Params.Limit
+	}
+
+	changes, cursor, err := s.changesService.GetSince(ctx, s.cfg.APIUI.IssuerDID, since, limit)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidChangeCursor) {
+			return GetChanges400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "get changes", "err", err, "since", since)
+		return GetChanges500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+
+	return GetChanges200JSONResponse(changesResponse(changes, cursor)), nil
+}
+
+// GetJobs returns the newest background jobs of the issuer. The dead letter is listed with the dead status.
+func (s *Server) GetJobs(ctx context.Context, request GetJobsRequestObject) (GetJobsResponseObject, error) {
+	var filter ports.JobsFilter
+	if request.Params.Kind != nil {
+		filter.Kind = string(*request.Params.Kind)
+	}
+	if request.Params.Status != nil {
+		status := domain.JobStatus(*request.Params.Status)
+		filter.Status = &status
+	}
+	if request.Params.Limit != nil {
+		if *request.Params.Limit < 1 || *request.Params.Limit > 1000 {
+			return GetJobs400JSONResponse{N400JSONResponse{Message: "limit must be between 1 and 1000"}}, nil
+		}
+		filter.Limit = *request.Params.Limit
+	}
+
+	jobs, err := s.jobQueue.GetAll(ctx, s.cfg.APIUI.IssuerDID, filter)
+	if errors.Is(err, db.ErrQueryTimeout) {
+		return nil, err
+	}
+	if err != nil {
+		log.Error(ctx, "getting jobs", "err", err)
+		return GetJobs500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	response := make(GetJobs200JSONResponse, len(jobs))
+	for i, job := range jobs {
+		response[i] = jobResponse(job)
+	}
+	return response, nil
+}
+
+// GetJob returns a background job of the issuer
+func (s *Server) GetJob(ctx context.Context, request GetJobRequestObject) (GetJobResponseObject, error) {
+	job, err := s.jobQueue.GetByID(ctx, s.cfg.APIUI.IssuerDID, request.Id)
+	if err != nil {
+		if errors.Is(err, services.ErrJobNotFound) {
+			return GetJob404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "getting job", "err", err, "id", request.Id)
+		return GetJob500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return GetJob200JSONResponse(jobResponse(job)), nil
+}
+
+// RetryJob sends a dead or cancelled job back to the queue
+func (s *Server) RetryJob(ctx context.Context, request RetryJobRequestObject) (RetryJobResponseObject, error) {
+	job, err := s.jobQueue.Retry(ctx, s.cfg.APIUI.IssuerDID, request.Id)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrJobNotFound):
+			return RetryJob404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		case errors.Is(err, services.ErrJobConflict):
+			return RetryJob409JSONResponse{N409JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "retrying job", "err", err, "id", request.Id)
+		return RetryJob500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return RetryJob200JSONResponse(jobResponse(job)), nil
+}
+
+// CancelJob cancels a pending job or removes a dead one from the dead letter
+func (s *Server) CancelJob(ctx context.Context, request CancelJobRequestObject) (CancelJobResponseObject, error) {
+	job, err := s.jobQueue.Cancel(ctx, s.cfg.APIUI.IssuerDID, request.Id)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrJobNotFound):
+			return CancelJob404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		case errors.Is(err, services.ErrJobConflict):
+			return CancelJob409JSONResponse{N409JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "cancelling job", "err", err, "id", request.Id)
+		return CancelJob500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return CancelJob200JSONResponse(jobResponse(job)), nil
+}
+
 // RevokeConnectionCredentials revoke all the non revoked credentials of the given connection
 func (s *Server) RevokeConnectionCredentials(ctx context.Context, request RevokeConnectionCredentialsRequestObject) (RevokeConnectionCredentialsResponseObject, error) {
 	err := s.claimService.RevokeAllFromConnection(ctx, request.Id, s.cfg.APIUI.IssuerDID)
@@ -441,9 +1206,22 @@ func (s *Server) RevokeConnectionCredentials(ctx context.Context, request Revoke
 		return RevokeConnectionCredentials500JSONResponse{N500JSONResponse{"There was an error revoking the credentials of the given connection"}}, nil
 	}
 
+	if request.Params.PublishNow != nil && *request.Params.PublishNow {
+		return RevokeConnectionCredentials202JSONResponse{Message: s.publishRevocation(ctx, "Credentials revocation request sent")}, nil
+	}
 	return RevokeConnectionCredentials202JSONResponse{Message: "Credentials revocation request sent"}, nil
 }
 
+// publishRevocation publishes the state of the issuer right after a revocation and returns the message of the
+// response. The revocation is already saved, so if the state cannot be published it goes in the next one.
+func (s *Server) publishRevocation(ctx context.Context, message string) string {
+	if _, err := s.publisherGateway.PublishState(ctx, &s.cfg.APIUI.IssuerDID); err != nil {
+		log.Warn(ctx, "cannot publish the state after the revocation", "err", err)
+		return message + ", the state will be published later: " + err.Error()
+	}
+	return message + " and state published"
+}
+
 // CreateLink - creates a link for issuing a credential
 func (s *Server) CreateLink(ctx context.Context, request CreateLinkRequestObject) (CreateLinkResponseObject, error) {
 	if request.Body.Expiration != nil {
@@ -474,7 +1252,21 @@ func (s *Server) CreateLink(ctx context.Context, request CreateLinkRequestObject
 		expirationDate = &request.Body.CredentialExpiration.Time
 	}
 
-	createdLink, err := s.linkService.Save(ctx, s.cfg.APIUI.IssuerDID, request.Body.LimitedClaims, request.Body.Expiration, request.Body.SchemaID, expirationDate, request.Body.SignatureProof, request.Body.MtProof, credSubject)
+	var proofScope []domain.VerificationQuery
+	if request.Body.ProofScope != nil {
+		proofScope = make([]domain.VerificationQuery, len(*request.Body.ProofScope))
+		for i, query := range *request.Body.ProofScope {
+			proofScope[i] = verificationQuery(query)
+		}
+	}
+
+	var allowedDIDs []string
+	if request.Body.AllowedDIDs != nil {
+		allowedDIDs = *request.Body.AllowedDIDs
+	}
+	codeProtected := request.Body.CodeProtected != nil && *request.Body.CodeProtected
+
+	createdLink, err := s.linkService.Save(ctx, s.cfg.APIUI.IssuerDID, request.Body.LimitedClaims, request.Body.Expiration, request.Body.SchemaID, expirationDate, request.Body.SignatureProof, request.Body.MtProof, credSubject, proofScope, request.Body.SubjectExternalID, allowedDIDs, codeProtected)
 	if err != nil {
 		log.Error(ctx, "error saving the link", "err", err.Error())
 		if errors.Is(err, services.ErrLoadingSchema) {
@@ -496,7 +1288,23 @@ func (s *Server) GetLink(ctx context.Context, request GetLinkRequestObject) (Get
 		return GetLink500JSONResponse{N500JSONResponse{Message: "error getting link"}}, nil
 	}
 
-	return GetLink200JSONResponse(getLinkResponse(*link)), nil
+	return GetLink200JSONResponse{Body: getLinkResponse(*link), Headers: GetLink200ResponseHeaders{ETag: etag(link.Version)}}, nil
+}
+
+// GetSubjectBinding returns the holder DID the placeholder subject of the links is bound to
+func (s *Server) GetSubjectBinding(ctx context.Context, request GetSubjectBindingRequestObject) (GetSubjectBindingResponseObject, error) {
+	binding, err := s.linkService.GetSubjectBinding(ctx, s.cfg.APIUI.IssuerDID, request.ExternalID)
+	if err != nil {
+		if errors.Is(err, services.ErrSubjectNotBound) {
+			return GetSubjectBinding404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		}
+		if errors.Is(err, domain.ErrInvalidSubjectExternalID) {
+			return GetSubjectBinding400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "loading the subject binding", "err", err)
+		return GetSubjectBinding500JSONResponse{N500JSONResponse{Message: "error getting the subject binding"}}, nil
+	}
+	return GetSubjectBinding200JSONResponse(subjectBindingResponse(binding)), nil
 }
 
 // GetLinks - Returns a list of links based on a search criteria.
@@ -510,24 +1318,105 @@ func (s *Server) GetLinks(ctx context.Context, request GetLinksRequestObject) (G
 		}
 	}
 	links, err := s.linkService.GetAll(ctx, s.cfg.APIUI.IssuerDID, status, request.Params.Query)
+	if errors.Is(err, db.ErrQueryTimeout) {
+		return nil, err
+	}
 	if err != nil {
 		log.Error(ctx, "getting links", "err", err, "req", request)
 	}
 
-	return GetLinks200JSONResponse(getLinkResponses(links)), err
+	resp := getLinkResponses(links)
+	for i := range resp {
+		resp[i].CredentialSubject = s.listingPII.MaskSubject(resp[i].CredentialSubject)
+	}
+	return GetLinks200JSONResponse(resp), err
+}
+
+// GetIssuerProfile - returns the profile of the issuer shown to the holders
+func (s *Server) GetIssuerProfile(ctx context.Context, _ GetIssuerProfileRequestObject) (GetIssuerProfileResponseObject, error) {
+	profile, err := s.profiles.Get(ctx, s.cfg.APIUI.IssuerDID)
+	if err != nil {
+		log.Error(ctx, "loading issuer profile", "err", err)
+		return GetIssuerProfile500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return GetIssuerProfile200JSONResponse(issuerProfileResponse(profile)), nil
+}
+
+// UpdateIssuerProfile - changes the given fields of the profile of the issuer
+func (s *Server) UpdateIssuerProfile(ctx context.Context, request UpdateIssuerProfileRequestObject) (UpdateIssuerProfileResponseObject, error) {
+	if request.Body == nil {
+		return UpdateIssuerProfile400JSONResponse{N400JSONResponse{Message: "bad request: empty body"}}, nil
+	}
+	profile, err := s.profiles.Update(ctx, s.cfg.APIUI.IssuerDID, &ports.IssuerProfileUpdate{
+		DisplayName:     request.Body.DisplayName,
+		LogoURL:         request.Body.LogoURL,
+		BackgroundColor: request.Body.BackgroundColor,
+		TextColor:       request.Body.TextColor,
+		ContactEmail:    request.Body.ContactEmail,
+		ContactURL:      request.Body.ContactURL,
+	})
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidIssuerProfile) {
+			return UpdateIssuerProfile400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "updating issuer profile", "err", err)
+		return UpdateIssuerProfile500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return UpdateIssuerProfile200JSONResponse(issuerProfileResponse(profile)), nil
+}
+
+// addRevocations adds the reason of the revocation to the responses of the revoked credentials
+func (s *Server) addRevocations(ctx context.Context, response []Credential) error {
+	nonces := make([]domain.RevNonceUint64, 0)
+	for _, credential := range response {
+		if credential.Revoked {
+			nonces = append(nonces, domain.RevNonceUint64(credential.RevNonce))
+		}
+	}
+	if len(nonces) == 0 {
+		return nil
+	}
+	revocations, err := s.identityService.GetRevocations(ctx, s.cfg.APIUI.IssuerDID, nonces)
+	if err != nil {
+		return err
+	}
+	byNonce := make(map[domain.RevNonceUint64]*domain.Revocation, len(revocations))
+	for _, revocation := range revocations {
+		byNonce[revocation.Nonce] = revocation
+	}
+	for i, credential := range response {
+		if revocation, ok := byNonce[domain.RevNonceUint64(credential.RevNonce)]; ok && credential.Revoked {
+			response[i].Revocation = credentialRevocationResponse(revocation)
+		}
+	}
+	return nil
+}
+
+// maskCredentials masks the sensitive fields of the subjects of the listed credentials
+func (s *Server) maskCredentials(credentials []Credential) {
+	for i := range credentials {
+		credentials[i].CredentialSubject = s.listingPII.MaskSubject(credentials[i].CredentialSubject)
+	}
 }
 
 // AcivateLink - Activates or deactivates a link
 func (s *Server) AcivateLink(ctx context.Context, request AcivateLinkRequestObject) (AcivateLinkResponseObject, error) {
-	err := s.linkService.Activate(ctx, s.cfg.APIUI.IssuerDID, request.Id, request.Body.Active)
+	version, err := ifMatchVersion(request.Params.IfMatch)
+	if err != nil {
+		return AcivateLink400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+	}
+	link, err := s.linkService.Activate(ctx, s.cfg.APIUI.IssuerDID, request.Id, request.Body.Active, version)
 	if err != nil {
 		if errors.Is(err, repositories.ErrLinkDoesNotExist) || errors.Is(err, services.ErrLinkAlreadyActive) || errors.Is(err, services.ErrLinkAlreadyInactive) {
 			return AcivateLink400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
 		}
+		if errors.Is(err, services.ErrVersionMismatch) {
+			return AcivateLink412JSONResponse{N412JSONResponse{Message: err.Error()}}, nil
+		}
 		log.Error(ctx, "error activating or deactivating link", err.Error(), "id", request.Id)
 		return AcivateLink500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
 	}
-	return AcivateLink200JSONResponse{Message: "Link updated"}, nil
+	return AcivateLink200JSONResponse{Body: GenericMessage{Message: "Link updated"}, Headers: AcivateLink200ResponseHeaders{ETag: etag(link.Version)}}, nil
 }
 
 // DeleteLink - delete a link
@@ -541,24 +1430,113 @@ func (s *Server) DeleteLink(ctx context.Context, request DeleteLinkRequestObject
 	return DeleteLink200JSONResponse{Message: "link deleted"}, nil
 }
 
+// GetLinkFunnel - returns how many claim sessions of a link reached every step
+func (s *Server) GetLinkFunnel(ctx context.Context, request GetLinkFunnelRequestObject) (GetLinkFunnelResponseObject, error) {
+	funnel, err := s.linkService.GetFunnel(ctx, s.cfg.APIUI.IssuerDID, request.Id)
+	if err != nil {
+		if errors.Is(err, services.ErrLinkNotFound) {
+			return GetLinkFunnel404JSONResponse{N404JSONResponse{Message: "link not found"}}, nil
+		}
+		log.Error(ctx, "getting link funnel", "err", err, "id", request.Id)
+		return GetLinkFunnel500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return GetLinkFunnel200JSONResponse(linkFunnelResponse(funnel)), nil
+}
+
+// GetLinkAnalytics - returns the events of a link counted over time
+func (s *Server) GetLinkAnalytics(ctx context.Context, request GetLinkAnalyticsRequestObject) (GetLinkAnalyticsResponseObject, error) {
+	interval := domain.LinkAnalyticsDay
+	if request.Params.Interval != nil {
+		interval = domain.LinkAnalyticsInterval(*request.Params.Interval)
+	}
+	analytics, err := s.linkService.GetAnalytics(ctx, s.cfg.APIUI.IssuerDID, request.Id, interval, request.Params.From, request.Params.To)
+	if err != nil {
+		if errors.Is(err, services.ErrLinkAnalyticsRange) {
+			return GetLinkAnalytics400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		if errors.Is(err, services.ErrLinkNotFound) {
+			return GetLinkAnalytics404JSONResponse{N404JSONResponse{Message: "link not found"}}, nil
+		}
+		log.Error(ctx, "getting link analytics", "err", err, "id", request.Id)
+		return GetLinkAnalytics500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return GetLinkAnalytics200JSONResponse(linkAnalyticsResponse(analytics)), nil
+}
+
+// ExportLinksFunnel - returns the steps of the claim sessions of the links as a CSV file
+func (s *Server) ExportLinksFunnel(ctx context.Context, request ExportLinksFunnelRequestObject) (ExportLinksFunnelResponseObject, error) {
+	events, err := s.linkService.ExportFunnel(ctx, s.cfg.APIUI.IssuerDID, ports.LinkFunnelFilter{
+		LinkID: request.Params.LinkID,
+		From:   request.Params.From,
+		To:     request.Params.To,
+	})
+	if err != nil {
+		log.Error(ctx, "exporting links funnel", "err", err)
+		return ExportLinksFunnel500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	content, err := reports.LinkFunnelCSV(events)
+	if err != nil {
+		log.Error(ctx, "rendering links funnel", "err", err)
+		return ExportLinksFunnel500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return ExportLinksFunnel200TextcsvResponse{Body: bytes.NewReader(content), ContentLength: int64(len(content))}, nil
+}
+
+// CreateLinkCodes - creates one-time codes of a code protected link
+func (s *Server) CreateLinkCodes(ctx context.Context, request CreateLinkCodesRequestObject) (CreateLinkCodesResponseObject, error) {
+	codes, err := s.linkService.CreateCodes(ctx, s.cfg.APIUI.IssuerDID, request.Id, request.Body.Count)
+	if err != nil {
+		if errors.Is(err, services.ErrLinkNotFound) {
+			return CreateLinkCodes404JSONResponse{N404JSONResponse{Message: "link not found"}}, nil
+		}
+		if errors.Is(err, services.ErrLinkNotCodeProtected) || errors.Is(err, services.ErrInvalidLinkCodeCount) {
+			return CreateLinkCodes400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "creating link codes", "err", err, "id", request.Id)
+		return CreateLinkCodes500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return CreateLinkCodes201JSONResponse{Codes: codes}, nil
+}
+
+// GetLinkCodes - returns the one-time codes of a link, without the codes themselves
+func (s *Server) GetLinkCodes(ctx context.Context, request GetLinkCodesRequestObject) (GetLinkCodesResponseObject, error) {
+	codes, err := s.linkService.GetCodes(ctx, s.cfg.APIUI.IssuerDID, request.Id)
+	if err != nil {
+		if errors.Is(err, services.ErrLinkNotFound) {
+			return GetLinkCodes404JSONResponse{N404JSONResponse{Message: "link not found"}}, nil
+		}
+		log.Error(ctx, "getting link codes", "err", err, "id", request.Id)
+		return GetLinkCodes500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return GetLinkCodes200JSONResponse(linkCodesResponse(codes)), nil
+}
+
 // CreateLinkQrCode - Creates a link QrCode
 func (s *Server) CreateLinkQrCode(ctx context.Context, request CreateLinkQrCodeRequestObject) (CreateLinkQrCodeResponseObject, error) {
-	createLinkQrCodeResponse, err := s.linkService.CreateQRCode(ctx, s.cfg.APIUI.IssuerDID, request.Id, s.cfg.APIUI.ServerURL)
+	createLinkQrCodeResponse, err := s.linkService.CreateQRCode(ctx, s.cfg.APIUI.IssuerDID, request.Id, s.cfg.APIUI.ServerURL, request.Params.XLinkCode)
 	if err != nil {
 		if errors.Is(err, services.ErrLinkNotFound) {
 			return CreateLinkQrCode404JSONResponse{N404JSONResponse{Message: "error: link not found"}}, nil
 		}
+		if errors.Is(err, services.ErrLinkCodeRequired) || errors.Is(err, services.ErrInvalidLinkCode) {
+			return CreateLinkQrCode403JSONResponse{N403JSONResponse{Message: err.Error()}}, nil
+		}
+		if errors.Is(err, services.ErrLinkCodeAttemptsExceeded) {
+			return CreateLinkQrCode429JSONResponse{N429JSONResponse{Message: err.Error()}}, nil
+		}
 		if errors.Is(err, services.ErrLinkAlreadyExpired) || errors.Is(err, services.ErrLinkMaxExceeded) || errors.Is(err, services.ErrLinkInactive) {
 			return CreateLinkQrCode404JSONResponse{N404JSONResponse{Message: "error: " + err.Error()}}, nil
 		}
 		log.Error(ctx, "Unexpected error while creating qr code", "err", err)
 		return CreateLinkQrCode500JSONResponse{N500JSONResponse{"Unexpected error while creating qr code"}}, nil
 	}
-	return CreateLinkQrCode200JSONResponse{
-		Issuer: IssuerDescription{
-			DisplayName: s.cfg.APIUI.IssuerName,
-			Logo:        s.cfg.APIUI.IssuerLogo,
-		},
+	profile, err := s.profiles.Get(ctx, s.cfg.APIUI.IssuerDID)
+	if err != nil {
+		log.Error(ctx, "loading issuer profile", "err", err)
+		return CreateLinkQrCode500JSONResponse{N500JSONResponse{"Unexpected error while creating qr code"}}, nil
+	}
+	resp := CreateLinkQrCode200JSONResponse{
+		Issuer: issuerDescriptionResponse(profile),
 		QrCode: AuthenticationQrCodeResponse{
 			Body: struct {
 				CallbackUrl string        `json:"callbackUrl"`
@@ -577,7 +1555,9 @@ func (s *Server) CreateLinkQrCode(ctx context.Context, request CreateLinkQrCodeR
 		},
 		SessionID:  createLinkQrCodeResponse.SessionID,
 		LinkDetail: getLinkSimpleResponse(*createLinkQrCodeResponse.Link),
-	}, nil
+	}
+	resp.WalletLinks = s.walletLinksResponse(ctx, resp.QrCode)
+	return resp, nil
 }
 
 // GetCredentialQrCode - returns a QR Code for fetching the credential
@@ -590,7 +1570,154 @@ func (s *Server) GetCredentialQrCode(ctx context.Context, request GetCredentialQ
 		return GetCredentialQrCode500JSONResponse{N500JSONResponse{err.Error()}}, nil
 	}
 
-	return GetCredentialQrCode200JSONResponse(getCredentialQrCodeResponse(credential, s.cfg.APIUI.ServerURL)), nil
+	offer, err := s.claimService.CreateOffer(ctx, s.cfg.APIUI.IssuerDID, credential.ID)
+	if err != nil {
+		return GetCredentialQrCode500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	profile, err := s.profiles.Get(ctx, s.cfg.APIUI.IssuerDID)
+	if err != nil {
+		log.Error(ctx, "loading issuer profile", "err", err)
+		return GetCredentialQrCode500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+
+	return GetCredentialQrCode200JSONResponse(getCredentialQrCodeResponse(credential, offer, profile, s.cfg.APIUI.ServerURL)), nil
+}
+
+// RenewCredentialOffer - creates a new offer of the credential and returns the message of its QR code, optionally pushing it to the holder
+func (s *Server) RenewCredentialOffer(ctx context.Context, request RenewCredentialOfferRequestObject) (RenewCredentialOfferResponseObject, error) {
+	notify := request.Body.Notify != nil && *request.Body.Notify
+	offer, err := s.claimService.RenewOffer(ctx, s.cfg.APIUI.IssuerDID, request.Id, notify)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrClaimNotFound):
+			return RenewCredentialOffer404JSONResponse{N404JSONResponse{"Credential not found"}}, nil
+		case errors.Is(err, services.ErrCredentialRevoked), errors.Is(err, services.ErrCredentialWithoutHolder):
+			return RenewCredentialOffer400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		}
+		return RenewCredentialOffer500JSONResponse{N500JSONResponse{"There was an error trying to renew the credential offer"}}, nil
+	}
+
+	credential, err := s.claimService.GetByID(ctx, &s.cfg.APIUI.IssuerDID, request.Id)
+	if err != nil {
+		return RenewCredentialOffer500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	profile, err := s.profiles.Get(ctx, s.cfg.APIUI.IssuerDID)
+	if err != nil {
+		log.Error(ctx, "loading issuer profile", "err", err)
+		return RenewCredentialOffer500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	return RenewCredentialOffer201JSONResponse(getCredentialQrCodeResponse(credential, offer, profile, s.cfg.APIUI.ServerURL)), nil
+}
+
+// GetCredentialWalletLinks - returns the wallet links of a new offer of the credential
+func (s *Server) GetCredentialWalletLinks(ctx context.Context, request GetCredentialWalletLinksRequestObject) (GetCredentialWalletLinksResponseObject, error) {
+	credential, err := s.claimService.GetByID(ctx, &s.cfg.APIUI.IssuerDID, request.Id)
+	if err != nil {
+		if errors.Is(err, services.ErrClaimNotFound) {
+			return GetCredentialWalletLinks404JSONResponse{N404JSONResponse{"Credential not found"}}, nil
+		}
+		return GetCredentialWalletLinks500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+
+	offer, err := s.claimService.CreateOffer(ctx, s.cfg.APIUI.IssuerDID, credential.ID)
+	if err != nil {
+		return GetCredentialWalletLinks500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+	profile, err := s.profiles.Get(ctx, s.cfg.APIUI.IssuerDID)
+	if err != nil {
+		log.Error(ctx, "loading issuer profile", "err", err)
+		return GetCredentialWalletLinks500JSONResponse{N500JSONResponse{err.Error()}}, nil
+	}
+
+	links := s.walletLinksResponse(ctx, getCredentialQrCodeResponse(credential, offer, profile, s.cfg.APIUI.ServerURL))
+	if links == nil {
+		return GetCredentialWalletLinks500JSONResponse{N500JSONResponse{"error building the wallet links"}}, nil
+	}
+	return GetCredentialWalletLinks200JSONResponse(*links), nil
+}
+
+// walletLinksResponse returns the wallet links of the message of a QR code, or nil if they cannot be built
+func (s *Server) walletLinksResponse(ctx context.Context, message any) *WalletLinks {
+	links, err := s.walletLinks.Links(message)
+	if err != nil {
+		log.Error(ctx, "building the wallet links", "err", err)
+		return nil
+	}
+	return &WalletLinks{DeepLink: links.DeepLink, UniversalLink: links.UniversalLink}
+}
+
+// SendCredentialOffer emails the offer of the credential to the holder
+func (s *Server) SendCredentialOffer(ctx context.Context, request SendCredentialOfferRequestObject) (SendCredentialOfferResponseObject, error) {
+	email, err := s.offerEmails.Send(ctx, s.cfg.APIUI.IssuerDID, request.Id, request.Body.Email)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrClaimNotFound):
+			return SendCredentialOffer404JSONResponse{N404JSONResponse{Message: "credential not found"}}, nil
+		case errors.Is(err, services.ErrOfferEmailThrottled):
+			return SendCredentialOffer429JSONResponse{N429JSONResponse{Message: err.Error()}}, nil
+		case errors.Is(err, domain.ErrInvalidOfferRecipient), errors.Is(err, services.ErrOfferRevokedCredential), errors.Is(err, services.ErrOfferEmailsDisabled), errors.Is(err, services.ErrOfferEmailsPaused):
+			return SendCredentialOffer400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "sending credential offer email", "err", err, "id", request.Id)
+		return SendCredentialOffer500JSONResponse{N500JSONResponse{Message: "error sending the offer email"}}, nil
+	}
+	return SendCredentialOffer201JSONResponse(offerEmailResponse(email)), nil
+}
+
+// GetCredentialOfferEmails returns the offer emails of the credential
+func (s *Server) GetCredentialOfferEmails(ctx context.Context, request GetCredentialOfferEmailsRequestObject) (GetCredentialOfferEmailsResponseObject, error) {
+	emails, err := s.offerEmails.GetByCredential(ctx, s.cfg.APIUI.IssuerDID, request.Id)
+	if err != nil {
+		if errors.Is(err, services.ErrClaimNotFound) {
+			return GetCredentialOfferEmails404JSONResponse{N404JSONResponse{Message: "credential not found"}}, nil
+		}
+		log.Error(ctx, "loading credential offer emails", "err", err, "id", request.Id)
+		return GetCredentialOfferEmails500JSONResponse{N500JSONResponse{Message: "error getting the offer emails"}}, nil
+	}
+	resp := make(GetCredentialOfferEmails200JSONResponse, len(emails))
+	for i, email := range emails {
+		resp[i] = offerEmailResponse(email)
+	}
+	return resp, nil
+}
+
+// GetCredentialProblemReports returns the problem reports about a credential
+func (s *Server) GetCredentialProblemReports(ctx context.Context, request GetCredentialProblemReportsRequestObject) (GetCredentialProblemReportsResponseObject, error) {
+	reports, err := s.claimService.GetProblemReports(ctx, s.cfg.APIUI.IssuerDID, ports.ProblemReportsFilter{CredentialID: &request.Id})
+	if err != nil {
+		if errors.Is(err, services.ErrClaimNotFound) {
+			return GetCredentialProblemReports404JSONResponse{N404JSONResponse{Message: "credential not found"}}, nil
+		}
+		log.Error(ctx, "loading credential problem reports", "err", err, "id", request.Id)
+		return GetCredentialProblemReports500JSONResponse{N500JSONResponse{Message: "error getting the problem reports"}}, nil
+	}
+	resp := make(GetCredentialProblemReports200JSONResponse, len(reports))
+	for i, report := range reports {
+		resp[i] = problemReportResponse(report)
+	}
+	return resp, nil
+}
+
+// OpenOfferEmail records that an offer email was opened. The image is returned even if the email is not found, so
+// the email clients don't show a broken image.
+func (s *Server) OpenOfferEmail(ctx context.Context, request OpenOfferEmailRequestObject) (OpenOfferEmailResponseObject, error) {
+	if err := s.offerEmails.Open(ctx, request.Id); err != nil && !errors.Is(err, services.ErrOfferEmailNotFound) {
+		log.Error(ctx, "tracking offer email open", "err", err, "id", request.Id)
+	}
+	return OpenOfferEmail200ImagegifResponse{Body: bytes.NewReader(trackingPixel), ContentLength: int64(len(trackingPixel))}, nil
+}
+
+// ClaimOfferEmail records that the claim link of an offer email was followed and redirects the holder to claim the credential
+func (s *Server) ClaimOfferEmail(ctx context.Context, request ClaimOfferEmailRequestObject) (ClaimOfferEmailResponseObject, error) {
+	location, err := s.offerEmails.Claim(ctx, request.Id)
+	if err != nil {
+		if errors.Is(err, services.ErrOfferEmailNotFound) || errors.Is(err, services.ErrClaimNotFound) {
+			return ClaimOfferEmail404JSONResponse{N404JSONResponse{Message: "offer not found"}}, nil
+		}
+		log.Error(ctx, "claiming offer email", "err", err, "id", request.Id)
+		return ClaimOfferEmail500JSONResponse{N500JSONResponse{Message: "error claiming the offer"}}, nil
+	}
+	return ClaimOfferEmail302Response{Headers: ClaimOfferEmail302ResponseHeaders{Location: location}}, nil
 }
 
 // CreateLinkQrCodeCallback - Callback endpoint for the link qr code creation.
@@ -603,6 +1730,9 @@ func (s *Server) CreateLinkQrCodeCallback(ctx context.Context, request CreateLin
 	arm, err := s.identityService.Authenticate(ctx, *request.Body, request.Params.SessionID, s.cfg.APIUI.ServerURL, s.cfg.APIUI.IssuerDID)
 	if err != nil {
 		log.Debug(ctx, "error authenticating", err.Error())
+		if errors.Is(err, services.ErrSessionAlreadyUsed) {
+			return CreateLinkQrCodeCallback409JSONResponse{N409JSONResponse{err.Error()}}, nil
+		}
 		return CreateLinkQrCodeCallback500JSONResponse{}, nil
 	}
 
@@ -615,6 +1745,9 @@ func (s *Server) CreateLinkQrCodeCallback(ctx context.Context, request CreateLin
 	err = s.linkService.IssueClaim(ctx, request.Params.SessionID.String(), s.cfg.APIUI.IssuerDID, *userDID, request.Params.LinkID, s.cfg.APIUI.ServerURL)
 	if err != nil {
 		log.Debug(ctx, "error issuing the claim", "error", err)
+		if errors.Is(err, services.ErrHolderNotAllowed) {
+			return CreateLinkQrCodeCallback403JSONResponse{N403JSONResponse{err.Error()}}, nil
+		}
 		return CreateLinkQrCodeCallback500JSONResponse{}, nil
 	}
 
@@ -623,7 +1756,19 @@ func (s *Server) CreateLinkQrCodeCallback(ctx context.Context, request CreateLin
 
 // GetLinkQRCode - returns te qr code for adding the credential
 func (s *Server) GetLinkQRCode(ctx context.Context, request GetLinkQRCodeRequestObject) (GetLinkQRCodeResponseObject, error) {
-	getQRCodeResponse, err := s.linkService.GetQRCode(ctx, request.Params.SessionID, s.cfg.APIUI.IssuerDID, request.Id)
+	var getQRCodeResponse *ports.GetQRCodeResponse
+	var err error
+	if request.Params.Wait != nil && *request.Params.Wait > 0 {
+		wait := time.Duration(*request.Params.Wait) * time.Second
+		if wait > maxLinkQRCodeWait {
+			wait = maxLinkQRCodeWait
+		}
+		waitCtx, cancel := context.WithTimeout(ctx, wait)
+		defer cancel()
+		getQRCodeResponse, err = s.linkService.WaitQRCode(waitCtx, request.Params.SessionID, s.cfg.APIUI.IssuerDID, request.Id)
+	} else {
+		getQRCodeResponse, err = s.linkService.GetQRCode(ctx, request.Params.SessionID, s.cfg.APIUI.IssuerDID, request.Id)
+	}
 	if err != nil {
 		if errors.Is(services.ErrLinkNotFound, err) {
 			return GetLinkQRCode404JSONResponse{Message: "error: link not found"}, nil
@@ -632,11 +1777,24 @@ func (s *Server) GetLinkQRCode(ctx context.Context, request GetLinkQRCodeRequest
 	}
 
 	if getQRCodeResponse.State.Status == link_state.StatusPending || getQRCodeResponse.State.Status == link_state.StatusDone || getQRCodeResponse.State.Status == link_state.StatusPendingPublish {
-		return GetLinkQRCode200JSONResponse{
+		qrCode := getLinkQrCodeResponse(getQRCodeResponse.State.QRCode)
+		if qrCode != nil {
+			profile, err := s.profiles.Get(ctx, s.cfg.APIUI.IssuerDID)
+			if err != nil {
+				log.Error(ctx, "loading issuer profile", "err", err)
+				return GetLinkQRCode500JSONResponse{N500JSONResponse{Message: "error loading the issuer profile"}}, nil
+			}
+			qrCode.Body.Issuer = common.ToPointer(issuerDescriptionResponse(profile))
+		}
+		resp := GetLinkQRCode200JSONResponse{
 			Status:     common.ToPointer(getQRCodeResponse.State.Status),
-			QrCode:     getLinkQrCodeResponse(getQRCodeResponse.State.QRCode),
+			QrCode:     qrCode,
 			LinkDetail: getLinkSimpleResponse(*getQRCodeResponse.Link),
-		}, nil
+		}
+		if qrCode != nil {
+			resp.WalletLinks = s.walletLinksResponse(ctx, qrCode)
+		}
+		return resp, nil
 	}
 
 	return GetLinkQRCode400JSONResponse{N400JSONResponse{
@@ -648,24 +1806,47 @@ func (s *Server) GetLinkQRCode(ctx context.Context, request GetLinkQRCodeRequest
 func (s *Server) Agent(ctx context.Context, request AgentRequestObject) (AgentResponseObject, error) {
 	if request.Body == nil || *request.Body == "" {
 		log.Debug(ctx, "agent empty request")
-		return Agent400JSONResponse{N400JSONResponse{"cannot proceed with an empty request"}}, nil
+		return Agent400JSONResponse{Message: "cannot proceed with an empty request"}, nil
 	}
-	basicMessage, err := s.packageManager.UnpackWithType(packers.MediaTypeZKPMessage, []byte(*request.Body))
+	envelope := []byte(*request.Body)
+	basicMessage, mediaType, err := s.packageManager.Unpack(envelope)
+	inbound := domain.NewInboxMessage(s.cfg.APIUI.IssuerDID, envelope, basicMessage, mediaType)
 	if err != nil {
-		log.Debug(ctx, "agent bad request", "err", err, "body", *request.Body)
-		return Agent400JSONResponse{N400JSONResponse{"cannot proceed with the given request"}}, nil
+		log.Debug(ctx, "agent bad request", "err", err, "mediaType", mediaType, "body", *request.Body)
+		inbound.Reject(err)
+		s.recordAgentMessage(ctx, inbound)
+		return Agent400JSONResponse{Message: "cannot proceed with the given request"}, nil
 	}
 
-	req, err := ports.NewAgentRequest(basicMessage)
+	req, err := ports.NewAgentRequest(basicMessage, mediaType)
 	if err != nil {
 		log.Error(ctx, "agent parsing request", "err", err)
-		return Agent400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		inbound.Reject(err)
+		s.recordAgentMessage(ctx, inbound)
+		return Agent400JSONResponse{Message: err.Error()}, nil
 	}
 
 	agent, err := s.claimService.Agent(ctx, req)
 	if err != nil {
 		log.Error(ctx, "agent error", "err", err)
-		return Agent400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		report := s.claimService.ReportProblem(ctx, req, err)
+		inbound.Fail(err, report)
+		s.recordAgentMessage(ctx, inbound)
+		response := agentProblemReportResponse(err, report)
+		switch {
+		case errors.Is(err, services.ErrAgentSenderNotVerified):
+			return Agent401JSONResponse(response), nil
+		case errors.Is(err, services.ErrCredentialOfferConsumed), errors.Is(err, services.ErrAgentMessageInProgress):
+			return Agent409JSONResponse(response), nil
+		case errors.Is(err, services.ErrCredentialOfferExpired):
+			return Agent410JSONResponse(response), nil
+		}
+		return Agent400JSONResponse(response), nil
+	}
+	inbound.Process(agent)
+	s.recordAgentMessage(ctx, inbound)
+	if agent == nil {
+		return Agent202Response{}, nil
 	}
 
 	return Agent200JSONResponse{
@@ -679,6 +1860,69 @@ func (s *Server) Agent(ctx context.Context, request AgentRequestObject) (AgentRe
 	}, nil
 }
 
+// recordAgentMessage keeps the message received by the agent in the inbox. The answer to the wallet does not depend
+// on it, so a failure is only logged.
+func (s *Server) recordAgentMessage(ctx context.Context, message *domain.InboxMessage) {
+	if err := s.agentInbox.Record(ctx, message); err != nil {
+		log.Error(ctx, "recording agent message", "err", err, "id", message.MessageID, "thid", message.ThreadID)
+	}
+}
+
+// GetAgentMessages returns the newest messages received by the agent of the issuer
+func (s *Server) GetAgentMessages(ctx context.Context, request GetAgentMessagesRequestObject) (GetAgentMessagesResponseObject, error) {
+	var filter ports.InboxFilter
+	if request.Params.Sender != nil {
+		filter.Sender = *request.Params.Sender
+	}
+	if request.Params.ThreadID != nil {
+		filter.ThreadID = *request.Params.ThreadID
+	}
+	if request.Params.Type != nil {
+		filter.Type = *request.Params.Type
+	}
+	if request.Params.Status != nil {
+		status := domain.InboxMessageStatus(*request.Params.Status)
+		filter.Status = &status
+	}
+	if request.Params.Limit != nil {
+		if *request.Params.Limit < 1 || *request.Params.Limit > 1000 {
+			return GetAgentMessages400JSONResponse{N400JSONResponse{Message: "limit must be between 1 and 1000"}}, nil
+		}
+		filter.Limit = *request.Params.Limit
+	}
+
+	messages, err := s.agentInbox.GetAll(ctx, s.cfg.APIUI.IssuerDID, filter)
+	if errors.Is(err, db.ErrQueryTimeout) {
+		return nil, err
+	}
+	if err != nil {
+		log.Error(ctx, "getting agent messages", "err", err)
+		return GetAgentMessages500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	response := make(GetAgentMessages200JSONResponse, len(messages))
+	for i, message := range messages {
+		response[i] = agentInboxMessageResponse(message)
+	}
+	return response, nil
+}
+
+// GetAgentMessageEnvelope downloads a message received by the agent of the issuer as it was received
+func (s *Server) GetAgentMessageEnvelope(ctx context.Context, request GetAgentMessageEnvelopeRequestObject) (GetAgentMessageEnvelopeResponseObject, error) {
+	message, err := s.agentInbox.GetByID(ctx, s.cfg.APIUI.IssuerDID, request.Id)
+	if err != nil {
+		if errors.Is(err, services.ErrInboxMessageNotFound) {
+			return GetAgentMessageEnvelope404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "getting agent message", "err", err, "id", request.Id)
+		return GetAgentMessageEnvelope500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return GetAgentMessageEnvelope200ApplicationoctetStreamResponse{
+		Body:          bytes.NewReader(message.Envelope),
+		ContentLength: int64(len(message.Envelope)),
+		Headers:       GetAgentMessageEnvelope200ResponseHeaders{ContentDisposition: fmt.Sprintf(`attachment; filename="%s.envelope"`, message.ID)},
+	}, nil
+}
+
 func getCredentialsFilter(ctx context.Context, userDID *string, status *GetCredentialsParamsStatus, query *string) (*ports.ClaimsFilter, error) {
 	filter := &ports.ClaimsFilter{}
 	if userDID != nil {
@@ -691,11 +1935,11 @@ func getCredentialsFilter(ctx context.Context, userDID *string, status *GetCrede
 	}
 	if status != nil {
 		switch GetCredentialsParamsStatus(strings.ToLower(string(*status))) {
-		case Revoked:
+		case GetCredentialsParamsStatusRevoked:
 			filter.Revoked = common.ToPointer(true)
-		case Expired:
+		case GetCredentialsParamsStatusExpired:
 			filter.ExpiredOn = common.ToPointer(time.Now())
-		case All:
+		case GetCredentialsParamsStatusAll:
 			// Nothing to be done
 		default:
 			return nil, errors.New("wrong type value. Allowed values: [all, revoked, expired]")
@@ -707,11 +1951,79 @@ func getCredentialsFilter(ctx context.Context, userDID *string, status *GetCrede
 	return filter, nil
 }
 
+// publicationFilter returns the publication status of the credentials to list, empty for all of them
+func publicationFilter(status *PublicationStatus) (domain.PublicationStatus, error) {
+	if status == nil {
+		return "", nil
+	}
+	switch *status {
+	case PublicationStatusNotRequired, PublicationStatusPendingPublish, PublicationStatusPublished, PublicationStatusFailed:
+		return domain.PublicationStatus(*status), nil
+	}
+	return "", errors.New("wrong publication value. Allowed values: [not-required, pending-publish, published, failed]")
+}
+
+// ifMatchVersion returns the resource version of the If-Match header. A missing header or * matches any version.
+func ifMatchVersion(ifMatch *IfMatch) (*int, error) {
+	if ifMatch == nil || strings.TrimSpace(*ifMatch) == "*" {
+		return nil, nil
+	}
+	tag := strings.TrimPrefix(strings.TrimSpace(*ifMatch), "W/")
+	version, err := strconv.Atoi(strings.Trim(tag, `"`))
+	if err != nil {
+		return nil, fmt.Errorf("invalid If-Match header: %s", *ifMatch)
+	}
+	return &version, nil
+}
+
 func isBeforeNow(t time.Time) bool {
 	today := time.Now().UTC()
 	return t.Before(today)
 }
 
+// readImportForm returns the values and the file of an import request
+func readImportForm(reader *multipart.Reader) (map[string]string, []byte, error) {
+	form := make(map[string]string)
+	var file []byte
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid multipart form: %w", err)
+		}
+		content, err := io.ReadAll(io.LimitReader(part, maxImportFileSize+1))
+		if err != nil {
+			return nil, nil, fmt.Errorf("reading %s: %w", part.FormName(), err)
+		}
+		if len(content) > maxImportFileSize {
+			return nil, nil, fmt.Errorf("%s is larger than %d bytes", part.FormName(), maxImportFileSize)
+		}
+		if part.FormName() == "file" {
+			file = content
+		} else {
+			form[part.FormName()] = string(content)
+		}
+	}
+	if file == nil {
+		return nil, nil, errors.New("missing file")
+	}
+	return form, file, nil
+}
+
+func formBool(form map[string]string, name string) (bool, error) {
+	value, ok := form[name]
+	if !ok || value == "" {
+		return false, nil
+	}
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("invalid %s, it must be true or false", name)
+	}
+	return b, nil
+}
+
 // RegisterStatic add method to the mux that are not documented in the API.
 func RegisterStatic(mux *chi.Mux) {
 	mux.Get("/", documentation)
@@ -1,25 +1,41 @@
 package api_ui
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	cryptoRand "crypto/rand"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"math/big"
+	"math/rand"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"os"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/deepmap/oapi-codegen/pkg/types"
+	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
 	core "github.com/iden3/go-iden3-core"
 	"github.com/iden3/go-schema-processor/utils"
+	"github.com/iden3/iden3comm"
+	"github.com/iden3/iden3comm/packers"
+	"github.com/iden3/iden3comm/protocol"
 	"github.com/mitchellh/mapstructure"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/square/go-jose.v2"
 
 	"github.com/polygonid/sh-id-platform/internal/common"
 	"github.com/polygonid/sh-id-platform/internal/config"
@@ -29,10 +45,14 @@ import (
 	"github.com/polygonid/sh-id-platform/internal/core/services"
 	"github.com/polygonid/sh-id-platform/internal/db/tests"
 	"github.com/polygonid/sh-id-platform/internal/health"
+	"github.com/polygonid/sh-id-platform/internal/kms"
 	"github.com/polygonid/sh-id-platform/internal/loader"
 	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/middleware"
 	"github.com/polygonid/sh-id-platform/internal/repositories"
+	"github.com/polygonid/sh-id-platform/pkg/cache"
 	linkState "github.com/polygonid/sh-id-platform/pkg/link"
+	protocolPkg "github.com/polygonid/sh-id-platform/pkg/protocol"
 	"github.com/polygonid/sh-id-platform/pkg/pubsub"
 	"github.com/polygonid/sh-id-platform/pkg/reverse_hash"
 )
@@ -48,15 +68,16 @@ func TestServer_CheckStatus(t *testing.T) {
 	connectionsRepository := repositories.NewConnections()
 	identityService := services.NewIdentity(&KMSMock{}, identityRepo, mtRepo, identityStateRepo, mtService, claimsRepo, revocationRepository, connectionsRepository, storage, rhsp, nil, nil, pubsub.NewMock())
 	schemaLoader := loader.CachedFactory(loader.HTTPFactory, cachex)
-	schemaService := services.NewSchema(repositories.NewSchema(*storage), loader.HTTPFactory)
+	schemaService := services.NewSchema(repositories.NewSchema(*storage), loader.HTTPFactory, "http://localhost", nil)
 
 	claimsConf := services.ClaimCfg{
 		RHSEnabled: false,
 		Host:       "host",
 	}
-	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf, pubsub.NewMock())
+	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf)
+	serverHealth := health.New(health.Monitors{})
 
-	server := NewServer(&cfg, identityService, claimsService, schemaService, NewConnectionsMock(), NewLinkMock(), NewPublisherMock(), NewPackageManagerMock(), &health.Status{})
+	server := NewServer(&cfg, identityService, claimsService, schemaService, NewConnectionsMock(), NewLinkMock(), NewCredentialTemplateMock(), NewImportMock(), NewChangesMock(), NewJobQueueMock(), NewJWTCredentialMock(), NewIssuerProfileMock(), NewOfferEmailMock(), NewAgentInboxMock(), NewPublisherMock(), NewPackageManagerMock(), serverHealth)
 	handler := getHandler(context.Background(), server)
 
 	t.Run("should return 200", func(t *testing.T) {
@@ -69,10 +90,24 @@ func TestServer_CheckStatus(t *testing.T) {
 		var response Health200JSONResponse
 		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
 	})
+
+	t.Run("should return 503 while warming up", func(t *testing.T) {
+		serverHealth.NotReady()
+		t.Cleanup(serverHealth.SetReady)
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest("GET", "/status", nil)
+		require.NoError(t, err)
+
+		handler.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusServiceUnavailable, rr.Code)
+		var response Health503JSONResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		assert.False(t, response["ready"])
+	})
 }
 
 func TestServer_AuthCallback(t *testing.T) {
-	server := NewServer(&cfg, NewIdentityMock(), NewClaimsMock(), NewSchemaMock(), NewConnectionsMock(), NewLinkMock(), NewPublisherMock(), NewPackageManagerMock(), nil)
+	server := NewServer(&cfg, NewIdentityMock(), NewClaimsMock(), NewSchemaMock(), NewConnectionsMock(), NewLinkMock(), NewCredentialTemplateMock(), NewImportMock(), NewChangesMock(), NewJobQueueMock(), NewJWTCredentialMock(), NewIssuerProfileMock(), NewOfferEmailMock(), NewAgentInboxMock(), NewPublisherMock(), NewPackageManagerMock(), nil)
 	handler := getHandler(context.Background(), server)
 
 	type expected struct {
@@ -129,10 +164,10 @@ func TestServer_AuthQRCode(t *testing.T) {
 	revocationRepository := repositories.NewRevocation()
 	rhsp := reverse_hash.NewRhsPublisher(nil, false)
 	connectionsRepository := repositories.NewConnections()
-	sessionRepository := repositories.NewSessionCached(cachex)
+	sessionRepository := repositories.NewSessionCached(cachex, 0)
 
 	identityService := services.NewIdentity(&KMSMock{}, identityRepo, mtRepo, identityStateRepo, mtService, claimsRepo, revocationRepository, connectionsRepository, storage, rhsp, nil, sessionRepository, pubsub.NewMock())
-	server := NewServer(&cfg, identityService, NewClaimsMock(), NewSchemaMock(), NewConnectionsMock(), NewLinkMock(), NewPublisherMock(), NewPackageManagerMock(), nil)
+	server := NewServer(&cfg, identityService, NewClaimsMock(), NewSchemaMock(), NewConnectionsMock(), NewLinkMock(), NewCredentialTemplateMock(), NewImportMock(), NewChangesMock(), NewJobQueueMock(), NewJWTCredentialMock(), NewIssuerProfileMock(), NewOfferEmailMock(), NewAgentInboxMock(), NewPublisherMock(), NewPackageManagerMock(), nil)
 	issuerDID, err := core.ParseDID("did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ")
 	require.NoError(t, err)
 	server.cfg.APIUI.IssuerDID = *issuerDID
@@ -195,8 +230,8 @@ func TestServer_AuthQRCode(t *testing.T) {
 
 func TestServer_GetSchema(t *testing.T) {
 	ctx := context.Background()
-	schemaSrv := services.NewSchema(repositories.NewSchema(*storage), loader.HTTPFactory)
-	server := NewServer(&cfg, NewIdentityMock(), NewClaimsMock(), schemaSrv, NewConnectionsMock(), NewLinkMock(), NewPublisherMock(), NewPackageManagerMock(), nil)
+	schemaSrv := services.NewSchema(repositories.NewSchema(*storage), loader.HTTPFactory, "http://localhost", nil)
+	server := NewServer(&cfg, NewIdentityMock(), NewClaimsMock(), schemaSrv, NewConnectionsMock(), NewLinkMock(), NewCredentialTemplateMock(), NewImportMock(), NewChangesMock(), NewJobQueueMock(), NewJWTCredentialMock(), NewIssuerProfileMock(), NewOfferEmailMock(), NewAgentInboxMock(), NewPublisherMock(), NewPackageManagerMock(), nil)
 	issuerDID, err := core.ParseDID("did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ")
 	require.NoError(t, err)
 	server.cfg.APIUI.IssuerDID = *issuerDID
@@ -282,7 +317,7 @@ func TestServer_GetSchema(t *testing.T) {
 			require.Equal(t, tc.expected.httpCode, rr.Code)
 			switch tc.expected.httpCode {
 			case http.StatusOK:
-				var response GetSchema200JSONResponse
+				var response Schema
 				assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
 				assert.Equal(t, tc.expected.schema.Id, response.Id)
 				assert.Equal(t, tc.expected.schema.BigInt, response.BigInt)
@@ -315,8 +350,8 @@ func TestServer_GetSchemas(t *testing.T) {
 	require.NoError(t, err)
 	defer teardown()
 
-	schemaSrv := services.NewSchema(repositories.NewSchema(*storage), loader.HTTPFactory)
-	server := NewServer(&cfg, NewIdentityMock(), NewClaimsMock(), schemaSrv, NewConnectionsMock(), NewLinkMock(), NewPublisherMock(), NewPackageManagerMock(), nil)
+	schemaSrv := services.NewSchema(repositories.NewSchema(*storage), loader.HTTPFactory, "http://localhost", nil)
+	server := NewServer(&cfg, NewIdentityMock(), NewClaimsMock(), schemaSrv, NewConnectionsMock(), NewLinkMock(), NewCredentialTemplateMock(), NewImportMock(), NewChangesMock(), NewJobQueueMock(), NewJWTCredentialMock(), NewIssuerProfileMock(), NewOfferEmailMock(), NewAgentInboxMock(), NewPublisherMock(), NewPackageManagerMock(), nil)
 	issuerDID, err := core.ParseDID("did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ")
 	require.NoError(t, err)
 	server.cfg.APIUI.IssuerDID = *issuerDID
@@ -345,6 +380,8 @@ func TestServer_GetSchemas(t *testing.T) {
 	}
 	s.Hash = utils.CreateSchemaHash([]byte(s.URL + "#" + s.Type))
 	fixture.CreateSchema(t, ctx, s)
+	_, err = schemaSrv.Update(ctx, *issuerDID, s.ID, &ports.UpdateSchemaRequest{Deprecated: common.ToPointer(true)})
+	require.NoError(t, err)
 
 	handler := getHandler(ctx, server)
 	type expected struct {
@@ -352,10 +389,12 @@ func TestServer_GetSchemas(t *testing.T) {
 		count    int
 	}
 	type testConfig struct {
-		name     string
-		auth     func() (string, string)
-		query    *string
-		expected expected
+		name          string
+		auth          func() (string, string)
+		query         *string
+		status        *string
+		schemaVersion *int
+		expected      expected
 	}
 	for _, tc := range []testConfig{
 		{
@@ -410,12 +449,58 @@ func TestServer_GetSchemas(t *testing.T) {
 				count:    1,
 			},
 		},
+		{
+			name:   "Deprecated schemas",
+			auth:   authOk,
+			status: common.ToPointer("deprecated"),
+			expected: expected{
+				httpCode: http.StatusOK,
+				count:    1,
+			},
+		},
+		{
+			name:   "Active schemas matching the query",
+			auth:   authOk,
+			query:  common.ToPointer("attr1"),
+			status: common.ToPointer("active"),
+			expected: expected{
+				httpCode: http.StatusOK,
+				count:    20,
+			},
+		},
+		{
+			name:          "Second versions",
+			auth:          authOk,
+			schemaVersion: common.ToPointer(2),
+			expected: expected{
+				httpCode: http.StatusOK,
+				count:    0,
+			},
+		},
+		{
+			name:   "Wrong status",
+			auth:   authOk,
+			status: common.ToPointer("retired"),
+			expected: expected{
+				httpCode: http.StatusBadRequest,
+			},
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			rr := httptest.NewRecorder()
-			endpoint := "/v1/schemas"
+			params := url.Values{}
 			if tc.query != nil {
-				endpoint = endpoint + "?query=" + url.QueryEscape(*tc.query)
+				params.Set("query", *tc.query)
+			}
+			if tc.status != nil {
+				params.Set("status", *tc.status)
+			}
+			if tc.schemaVersion != nil {
+				params.Set("schemaVersion", strconv.Itoa(*tc.schemaVersion))
+			}
+			endpoint := "/v1/schemas"
+			if len(params) > 0 {
+				endpoint = endpoint + "?" + params.Encode()
 			}
 			req, err := http.NewRequest("GET", endpoint, nil)
 			req.SetBasicAuth(tc.auth())
@@ -438,8 +523,8 @@ func TestServer_ImportSchema(t *testing.T) {
 	const url = "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json"
 	const schemaType = "KYCCountryOfResidenceCredential"
 	ctx := context.Background()
-	schemaSrv := services.NewSchema(repositories.NewSchema(*storage), loader.HTTPFactory)
-	server := NewServer(&cfg, NewIdentityMock(), NewClaimsMock(), schemaSrv, NewConnectionsMock(), NewLinkMock(), NewPublisherMock(), NewPackageManagerMock(), nil)
+	schemaSrv := services.NewSchema(repositories.NewSchema(*storage), loader.HTTPFactory, "http://localhost", nil)
+	server := NewServer(&cfg, NewIdentityMock(), NewClaimsMock(), schemaSrv, NewConnectionsMock(), NewLinkMock(), NewCredentialTemplateMock(), NewImportMock(), NewChangesMock(), NewJobQueueMock(), NewJWTCredentialMock(), NewIssuerProfileMock(), NewOfferEmailMock(), NewAgentInboxMock(), NewPublisherMock(), NewPackageManagerMock(), nil)
 	issuerDID, err := core.ParseDID("did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ")
 	require.NoError(t, err)
 	server.cfg.APIUI.IssuerDID = *issuerDID
@@ -545,7 +630,7 @@ func TestServer_DeleteConnection(t *testing.T) {
 		RHSEnabled: false,
 		Host:       "http://host",
 	}
-	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf, pubsub.NewMock())
+	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf)
 	connectionsService := services.NewConnection(connectionsRepository, storage)
 
 	iden, err := identityService.Create(ctx, method, blockchain, network, "polygon-test")
@@ -554,7 +639,7 @@ func TestServer_DeleteConnection(t *testing.T) {
 	issuerDID, err := core.ParseDID(iden.Identifier)
 	require.NoError(t, err)
 
-	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), connectionsService, NewLinkMock(), NewPublisherMock(), NewPackageManagerMock(), nil)
+	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), connectionsService, NewLinkMock(), NewCredentialTemplateMock(), NewImportMock(), NewChangesMock(), NewJobQueueMock(), NewJWTCredentialMock(), NewIssuerProfileMock(), NewOfferEmailMock(), NewAgentInboxMock(), NewPublisherMock(), NewPackageManagerMock(), nil)
 	server.cfg.APIUI.IssuerDID = *issuerDID
 	handler := getHandler(context.Background(), server)
 
@@ -596,7 +681,7 @@ func TestServer_DeleteConnection(t *testing.T) {
 		OtherIdentifier: userDID2.String(),
 		Expiration:      0,
 		Version:         0,
-		RevNonce:        0,
+		RevNonce:        domain.RevNonceUint64(rand.Int63()),
 		CoreClaim:       domain.CoreClaim{},
 		Status:          nil,
 	})
@@ -691,7 +776,7 @@ func TestServer_DeleteConnectionCredentials(t *testing.T) {
 	connectionsRepository := repositories.NewConnections()
 
 	connectionsService := services.NewConnection(connectionsRepository, storage)
-	server := NewServer(&cfg, NewIdentityMock(), NewClaimsMock(), NewSchemaMock(), connectionsService, NewLinkMock(), NewPublisherMock(), NewPackageManagerMock(), nil)
+	server := NewServer(&cfg, NewIdentityMock(), NewClaimsMock(), NewSchemaMock(), connectionsService, NewLinkMock(), NewCredentialTemplateMock(), NewImportMock(), NewChangesMock(), NewJobQueueMock(), NewJWTCredentialMock(), NewIssuerProfileMock(), NewOfferEmailMock(), NewAgentInboxMock(), NewPublisherMock(), NewPackageManagerMock(), nil)
 	handler := getHandler(context.Background(), server)
 
 	fixture := tests.NewFixture(storage)
@@ -712,6 +797,7 @@ func TestServer_DeleteConnectionCredentials(t *testing.T) {
 	})
 
 	_ = fixture.CreateClaim(t, &domain.Claim{
+		RevNonce:        domain.RevNonceUint64(rand.Int63()),
 		Identifier:      common.ToPointer(issuerDID.String()),
 		Issuer:          issuerDID.String(),
 		OtherIdentifier: userDID.String(),
@@ -719,6 +805,7 @@ func TestServer_DeleteConnectionCredentials(t *testing.T) {
 	})
 
 	_ = fixture.CreateClaim(t, &domain.Claim{
+		RevNonce:        domain.RevNonceUint64(rand.Int63()),
 		Identifier:      common.ToPointer(issuerDID.String()),
 		Issuer:          issuerDID.String(),
 		OtherIdentifier: userDID.String(),
@@ -797,7 +884,7 @@ func TestServer_RevokeConnectionCredentials(t *testing.T) {
 		RHSEnabled: false,
 		Host:       "http://host",
 	}
-	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf, pubsub.NewMock())
+	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf)
 	connectionsService := services.NewConnection(connectionsRepository, storage)
 
 	iden, err := identityService.Create(ctx, method, blockchain, network, "polygon-test")
@@ -806,7 +893,7 @@ func TestServer_RevokeConnectionCredentials(t *testing.T) {
 	issuerDID, err := core.ParseDID(iden.Identifier)
 	require.NoError(t, err)
 
-	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), connectionsService, NewLinkMock(), NewPublisherMock(), NewPackageManagerMock(), nil)
+	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), connectionsService, NewLinkMock(), NewCredentialTemplateMock(), NewImportMock(), NewChangesMock(), NewJobQueueMock(), NewJWTCredentialMock(), NewIssuerProfileMock(), NewOfferEmailMock(), NewAgentInboxMock(), NewPublisherMock(), NewPackageManagerMock(), nil)
 	server.cfg.APIUI.IssuerDID = *issuerDID
 	handler := getHandler(context.Background(), server)
 
@@ -835,7 +922,7 @@ func TestServer_RevokeConnectionCredentials(t *testing.T) {
 		OtherIdentifier: userDID.String(),
 		Expiration:      0,
 		Version:         0,
-		RevNonce:        0,
+		RevNonce:        domain.RevNonceUint64(rand.Int63()),
 		CoreClaim:       domain.CoreClaim{},
 		Status:          nil,
 	})
@@ -910,10 +997,14 @@ func TestServer_CreateCredential(t *testing.T) {
 	claimsConf := services.ClaimCfg{
 		RHSEnabled: false,
 		Host:       "http://host",
+		ProofPolicy: &domain.ProofPolicy{
+			Default: domain.ProofTypes{Signature: true},
+			Allowed: map[string]domain.ProofTypes{"KYCAgeCredential": {Signature: true}},
+		},
 	}
-	pubSub := pubsub.NewMock()
-	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf, pubSub)
+	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf)
 	connectionsService := services.NewConnection(connectionsRepository, storage)
+	fixture := tests.NewFixture(storage)
 	iden, err := identityService.Create(ctx, method, blockchain, network, "polygon-test")
 	require.NoError(t, err)
 
@@ -921,7 +1012,7 @@ func TestServer_CreateCredential(t *testing.T) {
 	require.NoError(t, err)
 
 	cfg.APIUI.IssuerDID = *did
-	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), connectionsService, NewLinkMock(), NewPublisherMock(), NewPackageManagerMock(), nil)
+	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), connectionsService, NewLinkMock(), NewCredentialTemplateMock(), NewImportMock(), NewChangesMock(), NewJobQueueMock(), NewJWTCredentialMock(), NewIssuerProfileMock(), NewOfferEmailMock(), NewAgentInboxMock(), NewPublisherMock(), NewPackageManagerMock(), nil)
 
 	handler := getHandler(ctx, server)
 
@@ -966,7 +1057,26 @@ func TestServer_CreateCredential(t *testing.T) {
 			},
 		},
 		{
-			name: "Wrong request - no proof provided",
+			name: "No proof provided, the default ones apply",
+			auth: authOk,
+			body: CreateCredentialRequest{
+				CredentialSchema: "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json",
+				Type:             "KYCAgeCredential",
+				CredentialSubject: map[string]any{
+					"id":           "did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ",
+					"birthday":     19960424,
+					"documentType": 2,
+				},
+				Expiration: common.ToPointer(time.Now()),
+			},
+			expected: expected{
+				response:                    CreateCredential201JSONResponse{},
+				httpCode:                    http.StatusCreated,
+				createCredentialEventsCount: 1,
+			},
+		},
+		{
+			name: "Wrong request - proof not allowed by the policy",
 			auth: authOk,
 			body: CreateCredentialRequest{
 				CredentialSchema: "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json",
@@ -977,9 +1087,10 @@ func TestServer_CreateCredential(t *testing.T) {
 					"documentType": 2,
 				},
 				Expiration: common.ToPointer(time.Now()),
+				MtProof:    common.ToPointer(true),
 			},
 			expected: expected{
-				response: CreateCredential400JSONResponse{N400JSONResponse{Message: "you must to provide at least one proof type"}},
+				response: CreateCredential400JSONResponse{Message: "proof types not allowed by the proof policy: the credentials of KYCAgeCredential can only have BJJSignature2021 proofs"},
 				httpCode: http.StatusBadRequest,
 			},
 		},
@@ -998,7 +1109,28 @@ func TestServer_CreateCredential(t *testing.T) {
 				SignatureProof: common.ToPointer(true),
 			},
 			expected: expected{
-				response: CreateCredential400JSONResponse{N400JSONResponse{Message: "malformed url"}},
+				response: CreateCredential400JSONResponse{Message: "malformed url"},
+				httpCode: http.StatusBadRequest,
+			},
+		},
+		{
+			name: "Credential subject without a required attribute",
+			auth: authOk,
+			body: CreateCredentialRequest{
+				CredentialSchema: "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json",
+				Type:             "KYCAgeCredential",
+				CredentialSubject: map[string]any{
+					"id":       "did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ",
+					"birthday": 19960424,
+				},
+				Expiration:     common.ToPointer(time.Now()),
+				SignatureProof: common.ToPointer(true),
+			},
+			expected: expected{
+				response: CreateCredential400JSONResponse{
+					Message: "credential subject does not match the provided schema: documentType: is required",
+					Errors:  &[]CredentialSubjectError{{Field: "documentType", Message: "is required"}},
+				},
 				httpCode: http.StatusBadRequest,
 			},
 		},
@@ -1023,7 +1155,7 @@ func TestServer_CreateCredential(t *testing.T) {
 		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
-			pubSub.Clear(event.CreateCredentialEvent)
+			notifications := fixture.CountNotifications(t, event.CreateCredentialEvent, did.String())
 
 			rr := httptest.NewRecorder()
 			url := "/v1/credentials"
@@ -1036,7 +1168,7 @@ func TestServer_CreateCredential(t *testing.T) {
 
 			require.Equal(t, tc.expected.httpCode, rr.Code)
 
-			assert.Equal(t, tc.expected.createCredentialEventsCount, len(pubSub.AllPublishedEvents(event.CreateCredentialEvent)))
+			assert.Equal(t, tc.expected.createCredentialEventsCount, fixture.CountNotifications(t, event.CreateCredentialEvent, did.String())-notifications)
 
 			switch tc.expected.httpCode {
 			case http.StatusCreated:
@@ -1072,9 +1204,9 @@ func TestServer_DeleteCredential(t *testing.T) {
 		RHSEnabled: false,
 		Host:       "http://host",
 	}
-	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf, pubsub.NewMock())
+	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf)
 
-	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), NewConnectionsMock(), NewLinkMock(), NewPublisherMock(), NewPackageManagerMock(), nil)
+	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), NewConnectionsMock(), NewLinkMock(), NewCredentialTemplateMock(), NewImportMock(), NewChangesMock(), NewJobQueueMock(), NewJWTCredentialMock(), NewIssuerProfileMock(), NewOfferEmailMock(), NewAgentInboxMock(), NewPublisherMock(), NewPackageManagerMock(), nil)
 	handler := getHandler(context.Background(), server)
 
 	fixture := tests.NewFixture(storage)
@@ -1178,7 +1310,7 @@ func TestServer_GetCredential(t *testing.T) {
 		RHSEnabled: false,
 		Host:       "http://host",
 	}
-	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf, pubsub.NewMock())
+	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf)
 	connectionsService := services.NewConnection(connectionsRepository, storage)
 	iden, err := identityService.Create(ctx, method, blockchain, network, "polygon-test")
 	require.NoError(t, err)
@@ -1186,7 +1318,7 @@ func TestServer_GetCredential(t *testing.T) {
 	did, err := core.ParseDID(iden.Identifier)
 	require.NoError(t, err)
 	cfg.APIUI.IssuerDID = *did
-	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), connectionsService, NewLinkMock(), NewPublisherMock(), NewPackageManagerMock(), nil)
+	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), connectionsService, NewLinkMock(), NewCredentialTemplateMock(), NewImportMock(), NewChangesMock(), NewJobQueueMock(), NewJWTCredentialMock(), NewIssuerProfileMock(), NewOfferEmailMock(), NewAgentInboxMock(), NewPublisherMock(), NewPackageManagerMock(), nil)
 
 	credentialSubject := map[string]any{
 		"id":           "did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ",
@@ -1264,6 +1396,9 @@ func TestServer_GetCredential(t *testing.T) {
 					SchemaType: typeC,
 					SchemaUrl:  schema,
 					UserID:     createdClaim1.OtherIdentifier,
+					CoreClaim:  coreClaimHex(t, createdClaim1),
+					HIndex:     createdClaim1.HIndex,
+					Published:  false,
 				},
 				httpCode: http.StatusOK,
 			},
@@ -1292,6 +1427,9 @@ func TestServer_GetCredential(t *testing.T) {
 					SchemaType: typeC,
 					SchemaUrl:  schema,
 					UserID:     createdClaim2.OtherIdentifier,
+					CoreClaim:  coreClaimHex(t, createdClaim2),
+					HIndex:     createdClaim2.HIndex,
+					Published:  false,
 				},
 				httpCode: http.StatusOK,
 			},
@@ -1320,6 +1458,9 @@ func TestServer_GetCredential(t *testing.T) {
 					SchemaType: typeC,
 					SchemaUrl:  schema,
 					UserID:     createdClaim3.OtherIdentifier,
+					CoreClaim:  coreClaimHex(t, createdClaim3),
+					HIndex:     createdClaim3.HIndex,
+					Published:  false,
 				},
 				httpCode: http.StatusOK,
 			},
@@ -1342,6 +1483,9 @@ func TestServer_GetCredential(t *testing.T) {
 				var response Credential
 				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
 				validateCredential(t, tc.expected.response, response)
+				assert.Equal(t, tc.expected.response.CoreClaim, response.CoreClaim)
+				assert.Equal(t, tc.expected.response.HIndex, response.HIndex)
+				assert.Equal(t, tc.expected.response.Published, response.Published)
 			case http.StatusBadRequest:
 				var response GetCredential400JSONResponse
 				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
@@ -1351,7 +1495,7 @@ func TestServer_GetCredential(t *testing.T) {
 	}
 }
 
-func TestServer_GetCredentials(t *testing.T) {
+func TestServer_GetCredentialByNonce(t *testing.T) {
 	const (
 		method     = "polygonid"
 		blockchain = "polygon"
@@ -1364,17 +1508,16 @@ func TestServer_GetCredentials(t *testing.T) {
 	mtRepo := repositories.NewIdentityMerkleTreeRepository()
 	mtService := services.NewIdentityMerkleTrees(mtRepo)
 	revocationRepository := repositories.NewRevocation()
-	schemaRepository := repositories.NewSchema(*storage)
 	rhsp := reverse_hash.NewRhsPublisher(nil, false)
 	connectionsRepository := repositories.NewConnections()
 	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, claimsRepo, revocationRepository, connectionsRepository, storage, rhsp, nil, nil, pubsub.NewMock())
 	schemaLoader := loader.CachedFactory(loader.HTTPFactory, cachex)
 	claimsConf := services.ClaimCfg{
-		RHSEnabled: false,
-		Host:       "http://host",
+		RHSEnabled:       false,
+		Host:             "http://host",
+		RevNonceStrategy: domain.RevNonceSequential,
 	}
-	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf, pubsub.NewMock())
-	schemaService := services.NewSchema(schemaRepository, schemaLoader)
+	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf)
 	connectionsService := services.NewConnection(connectionsRepository, storage)
 	iden, err := identityService.Create(ctx, method, blockchain, network, "polygon-test")
 	require.NoError(t, err)
@@ -1382,7 +1525,8 @@ func TestServer_GetCredentials(t *testing.T) {
 	did, err := core.ParseDID(iden.Identifier)
 	require.NoError(t, err)
 	cfg.APIUI.IssuerDID = *did
-	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), connectionsService, NewLinkMock(), NewPublisherMock(), NewPackageManagerMock(), nil)
+	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), connectionsService, NewLinkMock(), NewCredentialTemplateMock(), NewImportMock(), NewChangesMock(), NewJobQueueMock(), NewJWTCredentialMock(), NewIssuerProfileMock(), NewOfferEmailMock(), NewAgentInboxMock(), NewPublisherMock(), NewPackageManagerMock(), nil)
+	handler := getHandler(ctx, server)
 
 	credentialSubject := map[string]any{
 		"id":           "did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ",
@@ -1390,154 +1534,415 @@ func TestServer_GetCredentials(t *testing.T) {
 		"documentType": 2,
 	}
 	typeC := "KYCAgeCredential"
-	merklizedRootPosition := "index"
-	schemaURL := "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json"
-	future := time.Now().Add(1000 * time.Hour)
-	past := time.Now().Add(-1000 * time.Hour)
-	_, err = schemaService.ImportSchema(ctx, *did, schemaURL, typeC)
+	schema := "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json"
+	first, err := claimsService.Save(ctx, ports.NewCreateClaimRequest(did, schema, credentialSubject, nil, typeC, nil, nil, nil, common.ToPointer(true), common.ToPointer(false), nil, false))
 	require.NoError(t, err)
-	// Never expires
-	_, err = claimsService.Save(ctx, ports.NewCreateClaimRequest(did, schemaURL, credentialSubject, nil, typeC, nil, nil, &merklizedRootPosition, common.ToPointer(true), common.ToPointer(true), nil, false))
+	second, err := claimsService.Save(ctx, ports.NewCreateClaimRequest(did, schema, credentialSubject, nil, typeC, nil, nil, nil, common.ToPointer(true), common.ToPointer(false), nil, false))
 	require.NoError(t, err)
+	// the sequence of a new issuer starts at 1, the nonce of its auth claim is 0
+	assert.Equal(t, domain.RevNonceUint64(1), first.RevNonce)
+	assert.Equal(t, domain.RevNonceUint64(2), second.RevNonce)
 
-	// Expires in future
-	_, err = claimsService.Save(ctx, ports.NewCreateClaimRequest(did, schemaURL, credentialSubject, &future, typeC, nil, nil, &merklizedRootPosition, common.ToPointer(true), common.ToPointer(false), nil, false))
-	require.NoError(t, err)
+	for _, tc := range []struct {
+		name     string
+		auth     func() (string, string)
+		nonce    int64
+		httpCode int
+	}{
+		{name: "No auth header", auth: authWrong, nonce: 2, httpCode: http.StatusUnauthorized},
+		{name: "unknown nonce", auth: authOk, nonce: 99, httpCode: http.StatusNotFound},
+		{name: "negative nonce", auth: authOk, nonce: -1, httpCode: http.StatusBadRequest},
+		{name: "happy path", auth: authOk, nonce: 2, httpCode: http.StatusOK},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/v1/credentials/nonce/%d", tc.nonce), nil)
+			require.NoError(t, err)
+			req.SetBasicAuth(tc.auth())
 
-	// Expired
-	claim, err := claimsService.Save(ctx, ports.NewCreateClaimRequest(did, schemaURL, credentialSubject, &past, typeC, nil, nil, &merklizedRootPosition, common.ToPointer(true), common.ToPointer(false), nil, false))
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.httpCode, rr.Code)
+			if tc.httpCode == http.StatusOK {
+				var response Credential
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+				assert.Equal(t, second.ID, response.Id)
+				assert.Equal(t, uint64(2), response.RevNonce)
+			}
+		})
+	}
+}
+
+func TestServer_GetCredentialJWT(t *testing.T) {
+	const (
+		method     = "polygonid"
+		blockchain = "polygon"
+		network    = "mumbai"
+	)
+	ctx := log.NewContext(context.Background(), log.LevelDebug, log.OutputText, os.Stdout)
+	identityRepo := repositories.NewIdentity()
+	claimsRepo := repositories.NewClaims()
+	identityStateRepo := repositories.NewIdentityState()
+	mtRepo := repositories.NewIdentityMerkleTreeRepository()
+	mtService := services.NewIdentityMerkleTrees(mtRepo)
+	revocationRepository := repositories.NewRevocation()
+	rhsp := reverse_hash.NewRhsPublisher(nil, false)
+	connectionsRepository := repositories.NewConnections()
+	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, claimsRepo, revocationRepository, connectionsRepository, storage, rhsp, nil, nil, pubsub.NewMock())
+	schemaLoader := loader.CachedFactory(loader.HTTPFactory, cachex)
+	stateCache := cache.NewMemoryCache()
+	claimsConf := services.ClaimCfg{
+		RHSEnabled:    false,
+		Host:          "http://host",
+		StateCache:    stateCache,
+		StateCacheTTL: time.Hour,
+	}
+	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf)
+	iden, err := identityService.Create(ctx, method, blockchain, network, "polygon-test")
 	require.NoError(t, err)
 
-	// non expired, but revoked
-	revoked, err := claimsService.Save(ctx, ports.NewCreateClaimRequest(did, schemaURL, credentialSubject, &future, typeC, nil, nil, &merklizedRootPosition, common.ToPointer(false), common.ToPointer(true), nil, false))
+	did, err := core.ParseDID(iden.Identifier)
 	require.NoError(t, err)
+	cfg.APIUI.IssuerDID = *did
 
-	id, err := core.ParseDID(*revoked.Identifier)
+	jwtKeyStore := kms.NewKMS()
+	require.NoError(t, jwtKeyStore.RegisterKeyProvider(kms.KeyTypeBabyJubJub, bjjKeyProvider))
+	p256KeyProvider, err := kms.NewFileKeyProvider(kms.FileConfig{Dir: t.TempDir(), Passphrase: "secret"}, kms.KeyTypeP256)
 	require.NoError(t, err)
-	require.NoError(t, claimsService.Revoke(ctx, *id, uint64(revoked.RevNonce), "because I can"))
+	require.NoError(t, jwtKeyStore.RegisterKeyProvider(kms.KeyTypeP256, p256KeyProvider))
+	features := services.NewFeatureFlags(repositories.NewFeatureFlag(), storage, services.FeatureFlagsCfg{StatusList: true})
+	jwtCredentialService := services.NewJWTCredential(claimsService, identityService, repositories.NewStatusList(), storage, jwtKeyStore, services.JWTCredentialCfg{
+		Algorithm: kms.JWSAlgorithmES256,
+		Host:      "http://host",
+		Features:  features,
+		Cache:     stateCache,
+		CacheTTL:  time.Hour,
+	})
 
+	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), NewConnectionsMock(), NewLinkMock(), NewCredentialTemplateMock(), NewImportMock(), NewChangesMock(), NewJobQueueMock(), jwtCredentialService, NewIssuerProfileMock(), NewOfferEmailMock(), NewAgentInboxMock(), NewPublisherMock(), NewPackageManagerMock(), nil)
 	handler := getHandler(ctx, server)
 
+	credentialSubject := map[string]any{
+		"id":           "did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ",
+		"birthday":     19960424,
+		"documentType": 2,
+	}
+	typeC := "KYCAgeCredential"
+	merklizedRootPosition := "index"
+	schema := "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json"
+	createdClaim, err := claimsService.Save(ctx, ports.NewCreateClaimRequest(did, schema, credentialSubject, nil, typeC, nil, nil, &merklizedRootPosition, common.ToPointer(true), common.ToPointer(false), nil, false))
+	require.NoError(t, err)
+
 	type expected struct {
-		count    int
+		message  *string
 		httpCode int
-		errorMsg string
 	}
 
 	type testConfig struct {
 		name     string
 		auth     func() (string, string)
-		did      *string
-		query    *string
-		status   *string
+		request  GetCredentialJWTRequestObject
 		expected expected
 	}
 	for _, tc := range []testConfig{
 		{
-			name: "Not authorized",
+			name: "No auth header",
 			auth: authWrong,
-			expected: expected{
-				httpCode: http.StatusUnauthorized,
-			},
-		},
-		{
-			name:   "Wrong status",
-			auth:   authOk,
-			status: common.ToPointer("wrong"),
-			expected: expected{
-				httpCode: http.StatusBadRequest,
-				errorMsg: "wrong type value. Allowed values: [all, revoked, expired]",
+			request: GetCredentialJWTRequestObject{
+				Id: createdClaim.ID,
 			},
-		},
-		{
-			name: "wrong did",
-			auth: authOk,
-			did:  common.ToPointer("wrongdid:"),
 			expected: expected{
-				httpCode: http.StatusBadRequest,
-				errorMsg: "cannot parse did parameter: wrong format",
+				httpCode: http.StatusUnauthorized,
 			},
 		},
 		{
-			name: "Get all implicit",
+			name: "should return an error, claim not found",
 			auth: authOk,
-			expected: expected{
-				httpCode: http.StatusOK,
-				count:    4,
-			},
-		},
-		{
-			name:   "Get all explicit",
-			auth:   authOk,
-			status: common.ToPointer("all"),
-			expected: expected{
-				httpCode: http.StatusOK,
-				count:    4,
-			},
-		},
-		{
-			name:   "Get all from existing did",
-			auth:   authOk,
-			status: common.ToPointer("all"),
-			did:    &claim.OtherIdentifier,
-			expected: expected{
-				httpCode: http.StatusOK,
-				count:    4,
-			},
-		},
-		{
-			name:   "Get all from non existing did. Expecting empty list",
-			auth:   authOk,
-			status: common.ToPointer("all"),
-			did:    common.ToPointer("did:iden3:tJU7z1dbKyKYLiaopZ5tN6Zjsspq7QhYayiR31RFa"),
-			expected: expected{
-				httpCode: http.StatusOK,
-				count:    0,
-			},
-		},
-		{
-			name:   "Revoked",
-			auth:   authOk,
-			status: common.ToPointer("revoked"),
-			expected: expected{
-				httpCode: http.StatusOK,
-				count:    1,
+			request: GetCredentialJWTRequestObject{
+				Id: uuid.New(),
 			},
-		},
-		{
-			name:   "REVOKED",
-			auth:   authOk,
-			status: common.ToPointer("REVOKED"),
 			expected: expected{
-				httpCode: http.StatusOK,
-				count:    1,
+				message:  common.ToPointer("The given credential id does not exist"),
+				httpCode: http.StatusNotFound,
 			},
 		},
 		{
-			name:   "Expired",
-			auth:   authOk,
-			status: common.ToPointer("expired"),
-			expected: expected{
-				httpCode: http.StatusOK,
-				count:    1,
+			name: "happy path",
+			auth: authOk,
+			request: GetCredentialJWTRequestObject{
+				Id: createdClaim.ID,
 			},
-		},
-		{
-			name:  "Search by did and other words in query params:",
-			auth:  authOk,
-			query: common.ToPointer("some words and " + revoked.OtherIdentifier),
 			expected: expected{
 				httpCode: http.StatusOK,
-				count:    4,
 			},
 		},
-		{
-			name:  "Search by partial did and other words in query params:",
-			auth:  authOk,
-			query: common.ToPointer("some words and " + revoked.OtherIdentifier[9:14]),
-			expected: expected{
-				httpCode: http.StatusOK,
-				count:    4,
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			url := fmt.Sprintf("/v1/credentials/%s/jwt", tc.request.Id.String())
+
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+			req.SetBasicAuth(tc.auth())
+			require.NoError(t, err)
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.expected.httpCode, rr.Code)
+
+			switch tc.expected.httpCode {
+			case http.StatusOK:
+				var response CredentialJWT
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+				jws, err := jose.ParseSigned(response.Jwt)
+				require.NoError(t, err)
+				require.Len(t, jws.Signatures, 1)
+				assert.Equal(t, kms.JWSAlgorithmES256, jws.Signatures[0].Header.Algorithm)
+
+				keys, err := jwtCredentialService.PublicKeys(ctx, *did)
+				require.NoError(t, err)
+				verificationKeys := keys.Key(jws.Signatures[0].Header.KeyID)
+				require.Len(t, verificationKeys, 1)
+				payload, err := jws.Verify(verificationKeys[0])
+				require.NoError(t, err)
+
+				var claims map[string]any
+				require.NoError(t, json.Unmarshal(payload, &claims))
+				assert.Equal(t, did.String(), claims["iss"])
+				assert.Equal(t, credentialSubject["id"], claims["sub"])
+				vc, ok := claims["vc"].(map[string]any)
+				require.True(t, ok)
+				assert.Equal(t, claims["jti"], vc["id"])
+				assert.Nil(t, vc["proof"])
+				status, ok := vc["credentialStatus"].(map[string]any)
+				require.True(t, ok)
+				assert.Equal(t, domain.StatusList2021EntryType, status["type"])
+				assert.Equal(t, "0", status["statusListIndex"])
+				assert.Equal(t, fmt.Sprintf("http://host/v1/%s/status-list", did.String()), status["statusListCredential"])
+			case http.StatusNotFound:
+				var response GetCredentialJWT404JSONResponse
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+				assert.Equal(t, *tc.expected.message, response.Message)
+			}
+		})
+	}
+
+	// the bit of the credential is set in the status list once it is revoked
+	statusListBit := func() byte {
+		token, err := jwtCredentialService.StatusList(ctx, *did)
+		require.NoError(t, err)
+		jws, err := jose.ParseSigned(token)
+		require.NoError(t, err)
+		var claims struct {
+			VC domain.StatusListCredential `json:"vc"`
+		}
+		require.NoError(t, json.Unmarshal(jws.UnsafePayloadWithoutVerification(), &claims))
+		assert.Equal(t, domain.StatusPurposeRevocation, claims.VC.CredentialSubject.StatusPurpose)
+		compressed, err := base64.RawURLEncoding.DecodeString(claims.VC.CredentialSubject.EncodedList)
+		require.NoError(t, err)
+		reader, err := gzip.NewReader(bytes.NewReader(compressed))
+		require.NoError(t, err)
+		bits, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		return bits[0] & 0x80
+	}
+	assert.Zero(t, statusListBit())
+	// the signed status list is cached until it changes
+	first, err := jwtCredentialService.StatusList(ctx, *did)
+	require.NoError(t, err)
+	second, err := jwtCredentialService.StatusList(ctx, *did)
+	require.NoError(t, err)
+	assert.Equal(t, first, second)
+	require.NoError(t, claimsService.Revoke(ctx, *did, uint64(createdClaim.RevNonce), domain.RevocationUnspecified, ""))
+	assert.NotZero(t, statusListBit())
+}
+
+func TestServer_GetCredentials(t *testing.T) {
+	const (
+		method     = "polygonid"
+		blockchain = "polygon"
+		network    = "mumbai"
+	)
+	ctx := log.NewContext(context.Background(), log.LevelDebug, log.OutputText, os.Stdout)
+	identityRepo := repositories.NewIdentity()
+	claimsRepo := repositories.NewClaims()
+	identityStateRepo := repositories.NewIdentityState()
+	mtRepo := repositories.NewIdentityMerkleTreeRepository()
+	mtService := services.NewIdentityMerkleTrees(mtRepo)
+	revocationRepository := repositories.NewRevocation()
+	schemaRepository := repositories.NewSchema(*storage)
+	rhsp := reverse_hash.NewRhsPublisher(nil, false)
+	connectionsRepository := repositories.NewConnections()
+	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, claimsRepo, revocationRepository, connectionsRepository, storage, rhsp, nil, nil, pubsub.NewMock())
+	schemaLoader := loader.CachedFactory(loader.HTTPFactory, cachex)
+	claimsConf := services.ClaimCfg{
+		RHSEnabled: false,
+		Host:       "http://host",
+	}
+	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf)
+	schemaService := services.NewSchema(schemaRepository, schemaLoader, "http://localhost", nil)
+	connectionsService := services.NewConnection(connectionsRepository, storage)
+	iden, err := identityService.Create(ctx, method, blockchain, network, "polygon-test")
+	require.NoError(t, err)
+
+	did, err := core.ParseDID(iden.Identifier)
+	require.NoError(t, err)
+	cfg.APIUI.IssuerDID = *did
+	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), connectionsService, NewLinkMock(), NewCredentialTemplateMock(), NewImportMock(), NewChangesMock(), NewJobQueueMock(), NewJWTCredentialMock(), NewIssuerProfileMock(), NewOfferEmailMock(), NewAgentInboxMock(), NewPublisherMock(), NewPackageManagerMock(), nil)
+
+	credentialSubject := map[string]any{
+		"id":           "did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ",
+		"birthday":     19960424,
+		"documentType": 2,
+	}
+	typeC := "KYCAgeCredential"
+	merklizedRootPosition := "index"
+	schemaURL := "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json"
+	future := time.Now().Add(1000 * time.Hour)
+	past := time.Now().Add(-1000 * time.Hour)
+	_, err = schemaService.ImportSchema(ctx, *did, schemaURL, typeC)
+	require.NoError(t, err)
+	// Never expires
+	_, err = claimsService.Save(ctx, ports.NewCreateClaimRequest(did, schemaURL, credentialSubject, nil, typeC, nil, nil, &merklizedRootPosition, common.ToPointer(true), common.ToPointer(true), nil, false))
+	require.NoError(t, err)
+
+	// Expires in future
+	_, err = claimsService.Save(ctx, ports.NewCreateClaimRequest(did, schemaURL, credentialSubject, &future, typeC, nil, nil, &merklizedRootPosition, common.ToPointer(true), common.ToPointer(false), nil, false))
+	require.NoError(t, err)
+
+	// Expired
+	claim, err := claimsService.Save(ctx, ports.NewCreateClaimRequest(did, schemaURL, credentialSubject, &past, typeC, nil, nil, &merklizedRootPosition, common.ToPointer(true), common.ToPointer(false), nil, false))
+	require.NoError(t, err)
+
+	// non expired, but revoked
+	revoked, err := claimsService.Save(ctx, ports.NewCreateClaimRequest(did, schemaURL, credentialSubject, &future, typeC, nil, nil, &merklizedRootPosition, common.ToPointer(false), common.ToPointer(true), nil, false))
+	require.NoError(t, err)
+
+	id, err := core.ParseDID(*revoked.Identifier)
+	require.NoError(t, err)
+	require.NoError(t, claimsService.Revoke(ctx, *id, uint64(revoked.RevNonce), domain.RevocationAffiliationChanged, "because I can"))
+
+	handler := getHandler(ctx, server)
+
+	type expected struct {
+		count    int
+		httpCode int
+		errorMsg string
+	}
+
+	type testConfig struct {
+		name     string
+		auth     func() (string, string)
+		did      *string
+		query    *string
+		status   *string
+		expected expected
+	}
+	for _, tc := range []testConfig{
+		{
+			name: "Not authorized",
+			auth: authWrong,
+			expected: expected{
+				httpCode: http.StatusUnauthorized,
+			},
+		},
+		{
+			name:   "Wrong status",
+			auth:   authOk,
+			status: common.ToPointer("wrong"),
+			expected: expected{
+				httpCode: http.StatusBadRequest,
+				errorMsg: "wrong type value. Allowed values: [all, revoked, expired]",
+			},
+		},
+		{
+			name: "wrong did",
+			auth: authOk,
+			did:  common.ToPointer("wrongdid:"),
+			expected: expected{
+				httpCode: http.StatusBadRequest,
+				errorMsg: "cannot parse did parameter: wrong format",
+			},
+		},
+		{
+			name: "Get all implicit",
+			auth: authOk,
+			expected: expected{
+				httpCode: http.StatusOK,
+				count:    4,
+			},
+		},
+		{
+			name:   "Get all explicit",
+			auth:   authOk,
+			status: common.ToPointer("all"),
+			expected: expected{
+				httpCode: http.StatusOK,
+				count:    4,
+			},
+		},
+		{
+			name:   "Get all from existing did",
+			auth:   authOk,
+			status: common.ToPointer("all"),
+			did:    &claim.OtherIdentifier,
+			expected: expected{
+				httpCode: http.StatusOK,
+				count:    4,
+			},
+		},
+		{
+			name:   "Get all from non existing did. Expecting empty list",
+			auth:   authOk,
+			status: common.ToPointer("all"),
+			did:    common.ToPointer("did:iden3:tJU7z1dbKyKYLiaopZ5tN6Zjsspq7QhYayiR31RFa"),
+			expected: expected{
+				httpCode: http.StatusOK,
+				count:    0,
+			},
+		},
+		{
+			name:   "Revoked",
+			auth:   authOk,
+			status: common.ToPointer("revoked"),
+			expected: expected{
+				httpCode: http.StatusOK,
+				count:    1,
+			},
+		},
+		{
+			name:   "REVOKED",
+			auth:   authOk,
+			status: common.ToPointer("REVOKED"),
+			expected: expected{
+				httpCode: http.StatusOK,
+				count:    1,
+			},
+		},
+		{
+			name:   "Expired",
+			auth:   authOk,
+			status: common.ToPointer("expired"),
+			expected: expected{
+				httpCode: http.StatusOK,
+				count:    1,
+			},
+		},
+		{
+			name:  "Search by did and other words in query params:",
+			auth:  authOk,
+			query: common.ToPointer("some words and " + revoked.OtherIdentifier),
+			expected: expected{
+				httpCode: http.StatusOK,
+				count:    4,
+			},
+		},
+		{
+			name:  "Search by partial did and other words in query params:",
+			auth:  authOk,
+			query: common.ToPointer("some words and " + revoked.OtherIdentifier[9:14]),
+			expected: expected{
+				httpCode: http.StatusOK,
+				count:    4,
 			},
 		},
 		{
@@ -1634,7 +2039,7 @@ func TestServer_GetCredentialQrCode(t *testing.T) {
 		RHSEnabled: false,
 		Host:       "http://host",
 	}
-	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf, pubsub.NewMock())
+	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf)
 	connectionsService := services.NewConnection(connectionsRepository, storage)
 	iden, err := identityService.Create(ctx, method, blockchain, network, "polygon-test")
 	require.NoError(t, err)
@@ -1642,7 +2047,8 @@ func TestServer_GetCredentialQrCode(t *testing.T) {
 	did, err := core.ParseDID(iden.Identifier)
 	require.NoError(t, err)
 	cfg.APIUI.IssuerDID = *did
-	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), connectionsService, NewLinkMock(), NewPublisherMock(), NewPackageManagerMock(), nil)
+	issuerProfileService := services.NewIssuerProfile(repositories.NewIssuerProfile(), storage, services.IssuerProfileCfg{DisplayName: "my issuer"})
+	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), connectionsService, NewLinkMock(), NewCredentialTemplateMock(), NewImportMock(), NewChangesMock(), NewJobQueueMock(), NewJWTCredentialMock(), issuerProfileService, NewOfferEmailMock(), NewAgentInboxMock(), NewPublisherMock(), NewPackageManagerMock(), nil)
 	handler := getHandler(ctx, server)
 
 	credentialSubject := map[string]any{
@@ -1719,6 +2125,8 @@ func TestServer_GetCredentialQrCode(t *testing.T) {
 				require.Equal(t, tc.expected.response.From, response.From)
 				require.Equal(t, tc.expected.response.To, response.To)
 				require.Equal(t, len(tc.expected.response.Body.Credentials), len(response.Body.Credentials))
+				require.NotNil(t, response.Body.Issuer)
+				assert.Equal(t, "my issuer", response.Body.Issuer.DisplayName)
 			case http.StatusBadRequest:
 				var response GetCredential400JSONResponse
 				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
@@ -1728,7 +2136,7 @@ func TestServer_GetCredentialQrCode(t *testing.T) {
 	}
 }
 
-func TestServer_GetConnection(t *testing.T) {
+func TestServer_GetCredentialWalletLinks(t *testing.T) {
 	const (
 		method     = "polygonid"
 		blockchain = "polygon"
@@ -1740,42 +2148,298 @@ func TestServer_GetConnection(t *testing.T) {
 	identityStateRepo := repositories.NewIdentityState()
 	mtRepo := repositories.NewIdentityMerkleTreeRepository()
 	mtService := services.NewIdentityMerkleTrees(mtRepo)
-	revocationRepository := repositories.NewRevocation()
-	rhsp := reverse_hash.NewRhsPublisher(nil, false)
 	connectionsRepository := repositories.NewConnections()
-	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, claimsRepo, revocationRepository, connectionsRepository, storage, rhsp, nil, nil, pubsub.NewMock())
-	schemaLoader := loader.CachedFactory(loader.HTTPFactory, cachex)
-	claimsConf := services.ClaimCfg{
-		RHSEnabled: false,
-		Host:       "http://host",
-	}
-	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf, pubsub.NewMock())
-	connectionsService := services.NewConnection(connectionsRepository, storage)
-
+	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, claimsRepo, repositories.NewRevocation(), connectionsRepository, storage, reverse_hash.NewRhsPublisher(nil, false), nil, nil, pubsub.NewMock())
+	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, loader.CachedFactory(loader.HTTPFactory, cachex), storage, services.ClaimCfg{Host: "http://host"})
 	iden, err := identityService.Create(ctx, method, blockchain, network, "polygon-test")
 	require.NoError(t, err)
 
 	did, err := core.ParseDID(iden.Identifier)
 	require.NoError(t, err)
 	cfg.APIUI.IssuerDID = *did
-	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), connectionsService, NewLinkMock(), NewPublisherMock(), NewPackageManagerMock(), nil)
+	issuerProfileService := services.NewIssuerProfile(repositories.NewIssuerProfile(), storage, services.IssuerProfileCfg{DisplayName: "my issuer"})
+	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), services.NewConnection(connectionsRepository, storage), NewLinkMock(), NewCredentialTemplateMock(), NewImportMock(), NewChangesMock(), NewJobQueueMock(), NewJWTCredentialMock(), issuerProfileService, NewOfferEmailMock(), NewAgentInboxMock(), NewPublisherMock(), NewPackageManagerMock(), nil)
+	handler := getHandler(ctx, server)
 
-	fixture := tests.NewFixture(storage)
-	claim := fixture.NewClaim(t, did.String())
-	fixture.CreateClaim(t, claim)
+	credentialSubject := map[string]any{
+		"id":           "did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ",
+		"birthday":     19960424,
+		"documentType": 2,
+	}
+	schema := "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json"
+	createdClaim, err := claimsService.Save(ctx, ports.NewCreateClaimRequest(did, schema, credentialSubject, nil, "KYCAgeCredential", nil, nil, common.ToPointer("index"), common.ToPointer(true), common.ToPointer(true), nil, false))
+	require.NoError(t, err)
 
-	usrDID, err := core.ParseDID("did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ")
+	rr := httptest.NewRecorder()
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/v1/credentials/%s/wallet-links", uuid.New()), nil)
 	require.NoError(t, err)
+	handler.ServeHTTP(rr, req)
+	require.Equal(t, http.StatusNotFound, rr.Code)
 
-	usrDID2, err := core.ParseDID("did:polygonid:polygon:mumbai:2qFBp1sRF1bFbTybVHHZQRgSWE2nKrdWeAxyZ67PdG")
+	rr = httptest.NewRecorder()
+	req, err = http.NewRequest(http.MethodGet, fmt.Sprintf("/v1/credentials/%s/wallet-links", createdClaim.ID), nil)
+	require.NoError(t, err)
+	handler.ServeHTTP(rr, req)
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	var response WalletLinks
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+	require.True(t, strings.HasPrefix(response.DeepLink, "iden3comm://?i_m="))
+	require.True(t, strings.HasPrefix(response.UniversalLink, "https://wallet.polygonid.com/#i_m="))
+	encoded, err := url.QueryUnescape(strings.TrimPrefix(response.DeepLink, "iden3comm://?i_m="))
 	require.NoError(t, err)
+	raw, err := base64.StdEncoding.DecodeString(encoded)
+	require.NoError(t, err)
+	var offer QrCodeResponse
+	require.NoError(t, json.Unmarshal(raw, &offer))
+	assert.Equal(t, did.String(), offer.From)
+	assert.Equal(t, createdClaim.OtherIdentifier, offer.To)
+	require.Len(t, offer.Body.Credentials, 1)
+	assert.Equal(t, createdClaim.ID.String(), offer.Body.Credentials[0].Id)
+}
 
-	connID := fixture.CreateConnection(t, &domain.Connection{
-		ID:         uuid.New(),
-		IssuerDID:  *did,
-		UserDID:    *usrDID,
-		IssuerDoc:  nil,
-		UserDoc:    nil,
+func TestServer_RenewCredentialOffer(t *testing.T) {
+	const (
+		method     = "polygonid"
+		blockchain = "polygon"
+		network    = "mumbai"
+	)
+	ctx := log.NewContext(context.Background(), log.LevelDebug, log.OutputText, os.Stdout)
+	identityRepo := repositories.NewIdentity()
+	claimsRepo := repositories.NewClaims()
+	identityStateRepo := repositories.NewIdentityState()
+	mtRepo := repositories.NewIdentityMerkleTreeRepository()
+	mtService := services.NewIdentityMerkleTrees(mtRepo)
+	revocationRepository := repositories.NewRevocation()
+	rhsp := reverse_hash.NewRhsPublisher(nil, false)
+	connectionsRepository := repositories.NewConnections()
+	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, claimsRepo, revocationRepository, connectionsRepository, storage, rhsp, nil, nil, pubsub.NewMock())
+	schemaLoader := loader.CachedFactory(loader.HTTPFactory, cachex)
+	claimsConf := services.ClaimCfg{
+		RHSEnabled: false,
+		Host:       "http://host",
+	}
+	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf)
+	iden, err := identityService.Create(ctx, method, blockchain, network, "polygon-test")
+	require.NoError(t, err)
+
+	did, err := core.ParseDID(iden.Identifier)
+	require.NoError(t, err)
+	cfg.APIUI.IssuerDID = *did
+	issuerProfileService := services.NewIssuerProfile(repositories.NewIssuerProfile(), storage, services.IssuerProfileCfg{DisplayName: "my issuer"})
+	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), NewConnectionsMock(), NewLinkMock(), NewCredentialTemplateMock(), NewImportMock(), NewChangesMock(), NewJobQueueMock(), NewJWTCredentialMock(), issuerProfileService, NewOfferEmailMock(), NewAgentInboxMock(), NewPublisherMock(), NewPackageManagerMock(), nil)
+	handler := getHandler(ctx, server)
+
+	credentialSubject := map[string]any{
+		"id":           "did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ",
+		"birthday":     19960424,
+		"documentType": 2,
+	}
+	typeC := "KYCAgeCredential"
+	merklizedRootPosition := "index"
+	schema := "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json"
+	createdClaim, err := claimsService.Save(ctx, ports.NewCreateClaimRequest(did, schema, credentialSubject, nil, typeC, nil, nil, &merklizedRootPosition, common.ToPointer(true), common.ToPointer(true), nil, false))
+	require.NoError(t, err)
+	revokedClaim, err := claimsService.Save(ctx, ports.NewCreateClaimRequest(did, schema, credentialSubject, nil, typeC, nil, nil, &merklizedRootPosition, common.ToPointer(true), common.ToPointer(true), nil, false))
+	require.NoError(t, err)
+	require.NoError(t, claimsService.Revoke(ctx, *did, uint64(revokedClaim.RevNonce), domain.RevocationUnspecified, ""))
+
+	type expected struct {
+		message  *string
+		httpCode int
+	}
+
+	type testConfig struct {
+		name     string
+		auth     func() (string, string)
+		id       uuid.UUID
+		body     RenewCredentialOfferRequest
+		expected expected
+	}
+	threadIDs := map[string]bool{}
+	for _, tc := range []testConfig{
+		{
+			name: "No auth header",
+			auth: authWrong,
+			id:   createdClaim.ID,
+			expected: expected{
+				httpCode: http.StatusUnauthorized,
+			},
+		},
+		{
+			name: "should return an error, credential not found",
+			auth: authOk,
+			id:   uuid.New(),
+			expected: expected{
+				message:  common.ToPointer("Credential not found"),
+				httpCode: http.StatusNotFound,
+			},
+		},
+		{
+			name: "should return an error, revoked credential",
+			auth: authOk,
+			id:   revokedClaim.ID,
+			expected: expected{
+				message:  common.ToPointer(services.ErrCredentialRevoked.Error()),
+				httpCode: http.StatusBadRequest,
+			},
+		},
+		{
+			name: "happy path",
+			auth: authOk,
+			id:   createdClaim.ID,
+			expected: expected{
+				httpCode: http.StatusCreated,
+			},
+		},
+		{
+			name: "happy path, with a push notification",
+			auth: authOk,
+			id:   createdClaim.ID,
+			body: RenewCredentialOfferRequest{Notify: common.ToPointer(true)},
+			expected: expected{
+				httpCode: http.StatusCreated,
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			url := fmt.Sprintf("/v1/credentials/%s/offer", tc.id.String())
+
+			req, err := http.NewRequest(http.MethodPost, url, tests.JSONBody(t, tc.body))
+			req.SetBasicAuth(tc.auth())
+			require.NoError(t, err)
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.expected.httpCode, rr.Code)
+
+			switch tc.expected.httpCode {
+			case http.StatusCreated:
+				var response QrCodeResponse
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+				assert.Equal(t, did.String(), response.From)
+				assert.Equal(t, createdClaim.OtherIdentifier, response.To)
+				require.Len(t, response.Body.Credentials, 1)
+				assert.Equal(t, createdClaim.ID.String(), response.Body.Credentials[0].Id)
+				// every offer has a new thread id
+				assert.False(t, threadIDs[response.Thid])
+				threadIDs[response.Thid] = true
+			case http.StatusBadRequest:
+				var response RenewCredentialOffer400JSONResponse
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+				assert.Equal(t, *tc.expected.message, response.Message)
+			case http.StatusNotFound:
+				var response RenewCredentialOffer404JSONResponse
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+				assert.Equal(t, *tc.expected.message, response.Message)
+			}
+		})
+	}
+
+	var events int
+	require.NoError(t, storage.Pgx.QueryRow(ctx,
+		`SELECT count(*) FROM event_outbox WHERE event_type = $1 AND issuer_id = $2`, event.CredentialOfferEvent, did.String()).Scan(&events))
+	assert.Equal(t, 1, events)
+}
+
+func TestServer_GetCredentialPage(t *testing.T) {
+	ctx := log.NewContext(context.Background(), log.LevelDebug, log.OutputText, os.Stdout)
+	claimsRepo := repositories.NewClaims()
+	identityStateRepo := repositories.NewIdentityState()
+	mtRepo := repositories.NewIdentityMerkleTreeRepository()
+	mtService := services.NewIdentityMerkleTrees(mtRepo)
+	identityService := services.NewIdentity(keyStore, repositories.NewIdentity(), mtRepo, identityStateRepo, mtService, claimsRepo, repositories.NewRevocation(), repositories.NewConnections(), storage, reverse_hash.NewRhsPublisher(nil, false), nil, nil, pubsub.NewMock())
+	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, loader.CachedFactory(loader.HTTPFactory, cachex), storage, services.ClaimCfg{Host: "http://host"})
+	iden, err := identityService.Create(ctx, "polygonid", "polygon", "mumbai", "polygon-test")
+	require.NoError(t, err)
+
+	did, err := core.ParseDID(iden.Identifier)
+	require.NoError(t, err)
+	cfg.APIUI.IssuerDID = *did
+	issuerProfileService := services.NewIssuerProfile(repositories.NewIssuerProfile(), storage, services.IssuerProfileCfg{DisplayName: "my issuer"})
+	mux := chi.NewRouter()
+	RegisterPages(ctx, mux, &cfg, NewLinkMock(), claimsService, issuerProfileService)
+
+	credentialSubject := map[string]any{
+		"id":           "did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ",
+		"birthday":     19960424,
+		"documentType": 2,
+	}
+	schema := "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json"
+	createdClaim, err := claimsService.Save(ctx, ports.NewCreateClaimRequest(did, schema, credentialSubject, nil, "KYCAgeCredential", nil, nil, common.ToPointer("index"), common.ToPointer(true), common.ToPointer(true), nil, false))
+	require.NoError(t, err)
+
+	rr := httptest.NewRecorder()
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/credentials/%s/page", uuid.New()), nil)
+	require.NoError(t, err)
+	mux.ServeHTTP(rr, req)
+	require.Equal(t, http.StatusNotFound, rr.Code)
+	assert.Contains(t, rr.Body.String(), "This credential does not exist.")
+
+	rr = httptest.NewRecorder()
+	req, err = http.NewRequest(http.MethodGet, fmt.Sprintf("/credentials/%s/page", createdClaim.ID), nil)
+	require.NoError(t, err)
+	mux.ServeHTTP(rr, req)
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "text/html; charset=UTF-8", rr.Header().Get("Content-Type"))
+	body := rr.Body.String()
+	assert.Contains(t, body, "<h1>my issuer</h1>")
+	assert.Contains(t, body, "<svg")
+	assert.Contains(t, body, `href="iden3comm://?i_m=`)
+	assert.Contains(t, body, `href="https://wallet.polygonid.com/#i_m=`)
+	assert.NotContains(t, body, "19960424")
+}
+
+func TestServer_GetConnection(t *testing.T) {
+	const (
+		method     = "polygonid"
+		blockchain = "polygon"
+		network    = "mumbai"
+	)
+	ctx := log.NewContext(context.Background(), log.LevelDebug, log.OutputText, os.Stdout)
+	identityRepo := repositories.NewIdentity()
+	claimsRepo := repositories.NewClaims()
+	identityStateRepo := repositories.NewIdentityState()
+	mtRepo := repositories.NewIdentityMerkleTreeRepository()
+	mtService := services.NewIdentityMerkleTrees(mtRepo)
+	revocationRepository := repositories.NewRevocation()
+	rhsp := reverse_hash.NewRhsPublisher(nil, false)
+	connectionsRepository := repositories.NewConnections()
+	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, claimsRepo, revocationRepository, connectionsRepository, storage, rhsp, nil, nil, pubsub.NewMock())
+	schemaLoader := loader.CachedFactory(loader.HTTPFactory, cachex)
+	claimsConf := services.ClaimCfg{
+		RHSEnabled: false,
+		Host:       "http://host",
+	}
+	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf)
+	connectionsService := services.NewConnection(connectionsRepository, storage)
+
+	iden, err := identityService.Create(ctx, method, blockchain, network, "polygon-test")
+	require.NoError(t, err)
+
+	did, err := core.ParseDID(iden.Identifier)
+	require.NoError(t, err)
+	cfg.APIUI.IssuerDID = *did
+	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), connectionsService, NewLinkMock(), NewCredentialTemplateMock(), NewImportMock(), NewChangesMock(), NewJobQueueMock(), NewJWTCredentialMock(), NewIssuerProfileMock(), NewOfferEmailMock(), NewAgentInboxMock(), NewPublisherMock(), NewPackageManagerMock(), nil)
+
+	fixture := tests.NewFixture(storage)
+	claim := fixture.NewClaim(t, did.String())
+	claim.SchemaType = "KYCAgeCredential"
+	fixture.CreateClaim(t, claim)
+
+	usrDID, err := core.ParseDID("did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ")
+	require.NoError(t, err)
+
+	usrDID2, err := core.ParseDID("did:polygonid:polygon:mumbai:2qFBp1sRF1bFbTybVHHZQRgSWE2nKrdWeAxyZ67PdG")
+	require.NoError(t, err)
+
+	connID := fixture.CreateConnection(t, &domain.Connection{
+		ID:         uuid.New(),
+		IssuerDID:  *did,
+		UserDID:    *usrDID,
+		IssuerDoc:  nil,
+		UserDoc:    nil,
 		CreatedAt:  time.Now(),
 		ModifiedAt: time.Now(),
 	})
@@ -1794,7 +2458,7 @@ func TestServer_GetConnection(t *testing.T) {
 
 	type expected struct {
 		message  *string
-		response GetConnection200JSONResponse
+		response GetConnectionResponse
 		httpCode int
 	}
 
@@ -1833,31 +2497,13 @@ func TestServer_GetConnection(t *testing.T) {
 				Id: connID,
 			},
 			expected: expected{
-				response: GetConnection200JSONResponse{
-					CreatedAt: time.Now(),
-					Id:        connID.String(),
-					IssuerID:  did.String(),
-					UserID:    usrDID.String(),
-					Credentials: []Credential{
-						{
-							CredentialSubject: map[string]interface{}{
-								"id":           "did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ",
-								"birthday":     19960424,
-								"documentType": 2,
-							},
-							CreatedAt:  time.Now().UTC(),
-							Expired:    false,
-							ExpiresAt:  nil,
-							Id:         claim.ID,
-							ProofTypes: []string{},
-							RevNonce:   uint64(claim.RevNonce),
-							Revoked:    claim.Revoked,
-							SchemaHash: claim.SchemaHash,
-							SchemaType: claim.SchemaType,
-							SchemaUrl:  claim.SchemaURL,
-							UserID:     claim.OtherIdentifier,
-						},
-					},
+				response: GetConnectionResponse{
+					CreatedAt:          time.Now(),
+					Id:                 connID.String(),
+					IssuerID:           did.String(),
+					UserID:             usrDID.String(),
+					Credentials:        []Credential{},
+					CredentialsSummary: &CredentialsSummary{Total: 1},
 				},
 				httpCode: http.StatusOK,
 			},
@@ -1869,12 +2515,13 @@ func TestServer_GetConnection(t *testing.T) {
 				Id: connID2,
 			},
 			expected: expected{
-				response: GetConnection200JSONResponse{
-					CreatedAt:   time.Now(),
-					Id:          connID2.String(),
-					IssuerID:    did.String(),
-					UserID:      usrDID2.String(),
-					Credentials: []Credential{},
+				response: GetConnectionResponse{
+					CreatedAt:          time.Now(),
+					Id:                 connID2.String(),
+					IssuerID:           did.String(),
+					UserID:             usrDID2.String(),
+					Credentials:        []Credential{},
+					CredentialsSummary: &CredentialsSummary{},
 				},
 				httpCode: http.StatusOK,
 			},
@@ -1894,7 +2541,7 @@ func TestServer_GetConnection(t *testing.T) {
 
 			switch tc.expected.httpCode {
 			case http.StatusOK:
-				var response GetConnection200JSONResponse
+				var response GetConnectionResponse
 				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
 				if tc.expected.response.Credentials != nil {
 					require.NotNil(t, response.Credentials)
@@ -1906,7 +2553,9 @@ func TestServer_GetConnection(t *testing.T) {
 				assert.Equal(t, tc.expected.response.Id, response.Id)
 				assert.Equal(t, tc.expected.response.IssuerID, response.IssuerID)
 				assert.Equal(t, tc.expected.response.UserID, response.UserID)
+				assert.Equal(t, tc.expected.response.CredentialsSummary, response.CredentialsSummary)
 				assert.InDelta(t, tc.expected.response.CreatedAt.Unix(), response.CreatedAt.Unix(), 10)
+				assert.Equal(t, etag(0), rr.Header().Get("ETag"), "connections without metadata are at version 0")
 			case http.StatusBadRequest:
 				var response GetConnection400JSONResponse
 				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
@@ -1916,6 +2565,85 @@ func TestServer_GetConnection(t *testing.T) {
 	}
 }
 
+func TestServer_GetConnectionCredentials(t *testing.T) {
+	ctx := log.NewContext(context.Background(), log.LevelDebug, log.OutputText, os.Stdout)
+	claimsRepo := repositories.NewClaims()
+	connectionsRepository := repositories.NewConnections()
+	identityService := services.NewIdentity(keyStore, repositories.NewIdentity(), repositories.NewIdentityMerkleTreeRepository(), repositories.NewIdentityState(), services.NewIdentityMerkleTrees(repositories.NewIdentityMerkleTreeRepository()), claimsRepo, repositories.NewRevocation(), connectionsRepository, storage, reverse_hash.NewRhsPublisher(nil, false), nil, nil, pubsub.NewMock())
+	claimsService := services.NewClaim(claimsRepo, identityService, services.NewIdentityMerkleTrees(repositories.NewIdentityMerkleTreeRepository()), repositories.NewIdentityState(), loader.CachedFactory(loader.HTTPFactory, cachex), storage, services.ClaimCfg{Host: "http://host"})
+	connectionsService := services.NewConnection(connectionsRepository, storage)
+
+	iden, err := identityService.Create(ctx, "polygonid", "polygon", "mumbai", "polygon-test")
+	require.NoError(t, err)
+	did, err := core.ParseDID(iden.Identifier)
+	require.NoError(t, err)
+	cfg.APIUI.IssuerDID = *did
+	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), connectionsService, NewLinkMock(), NewCredentialTemplateMock(), NewImportMock(), NewChangesMock(), NewJobQueueMock(), NewJWTCredentialMock(), NewIssuerProfileMock(), NewOfferEmailMock(), NewAgentInboxMock(), NewPublisherMock(), NewPackageManagerMock(), nil)
+	handler := getHandler(ctx, server)
+
+	fixture := tests.NewFixture(storage)
+	usrDID, err := core.ParseDID("did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ")
+	require.NoError(t, err)
+	connID := fixture.CreateConnection(t, &domain.Connection{ID: uuid.New(), IssuerDID: *did, UserDID: *usrDID, CreatedAt: time.Now(), ModifiedAt: time.Now()})
+
+	for i := 0; i < 5; i++ {
+		claim := fixture.NewClaim(t, did.String())
+		claim.SchemaType = "KYCAgeCredential"
+		claim.RevNonce = domain.RevNonceUint64(rand.Int63())
+		claim.Revoked = i == 0
+		if i == 1 {
+			claim.Expiration = time.Now().Add(-time.Hour).Unix()
+		}
+		fixture.CreateClaim(t, claim)
+	}
+
+	get := func(id uuid.UUID, params string) *httptest.ResponseRecorder {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/v1/connections/%s/credentials?%s", id, params), nil)
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	assert.Equal(t, http.StatusNotFound, get(uuid.New(), "").Code)
+	assert.Equal(t, http.StatusBadRequest, get(connID, "limit=0").Code)
+	assert.Equal(t, http.StatusBadRequest, get(connID, "page=0").Code)
+	assert.Equal(t, http.StatusBadRequest, get(connID, "status=pending").Code)
+
+	for _, tc := range []struct {
+		params   string
+		total    int
+		returned int
+	}{
+		{params: "", total: 5, returned: 5},
+		{params: "limit=2", total: 5, returned: 2},
+		{params: "limit=2&page=3", total: 5, returned: 1},
+		{params: "limit=2&page=4", total: 5, returned: 0},
+		{params: "status=revoked", total: 1, returned: 1},
+		{params: "status=expired", total: 1, returned: 1},
+	} {
+		t.Run(tc.params, func(t *testing.T) {
+			rr := get(connID, tc.params)
+			require.Equal(t, http.StatusOK, rr.Code)
+			var response GetConnectionCredentialsResponse
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+			assert.Equal(t, tc.total, response.Total)
+			assert.Len(t, response.Credentials, tc.returned)
+		})
+	}
+
+	rr := httptest.NewRecorder()
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/v1/connections/%s", connID), nil)
+	require.NoError(t, err)
+	req.SetBasicAuth(authOk())
+	handler.ServeHTTP(rr, req)
+	require.Equal(t, http.StatusOK, rr.Code)
+	var connection GetConnectionResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &connection))
+	assert.Equal(t, &CredentialsSummary{Total: 5, Revoked: 1, Expired: 1}, connection.CredentialsSummary)
+}
+
 func TestServer_GetConnections(t *testing.T) {
 	const (
 		method     = "polygonid"
@@ -1937,7 +2665,7 @@ func TestServer_GetConnections(t *testing.T) {
 		RHSEnabled: false,
 		Host:       "http://host",
 	}
-	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf, pubsub.NewMock())
+	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf)
 	connectionsService := services.NewConnection(connectionsRepository, storage)
 
 	iden, err := identityService.Create(ctx, method, blockchain, network, "polygon-test")
@@ -1946,7 +2674,7 @@ func TestServer_GetConnections(t *testing.T) {
 	did, err := core.ParseDID(iden.Identifier)
 	require.NoError(t, err)
 	cfg.APIUI.IssuerDID = *did
-	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), connectionsService, NewLinkMock(), NewPublisherMock(), NewPackageManagerMock(), nil)
+	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), connectionsService, NewLinkMock(), NewCredentialTemplateMock(), NewImportMock(), NewChangesMock(), NewJobQueueMock(), NewJWTCredentialMock(), NewIssuerProfileMock(), NewOfferEmailMock(), NewAgentInboxMock(), NewPublisherMock(), NewPackageManagerMock(), nil)
 
 	fixture := tests.NewFixture(storage)
 
@@ -2347,43 +3075,186 @@ func TestServer_GetConnections(t *testing.T) {
 	}
 }
 
-func validateCredential(t *testing.T, tc Credential, response Credential) {
-	type credentialKYCSubject struct {
-		Id           string `json:"id"`
-		Birthday     uint64 `json:"birthday"`
-		DocumentType uint64 `json:"documentType"`
-	}
+func TestServer_UpdateConnectionMetadata(t *testing.T) {
+	ctx := log.NewContext(context.Background(), log.LevelDebug, log.OutputText, os.Stdout)
+	connectionsService := services.NewConnection(repositories.NewConnections(), storage)
 
-	assert.Equal(t, tc.Id, response.Id)
-	assert.Equal(t, tc.SchemaType, response.SchemaType)
-	assert.Equal(t, tc.SchemaHash, response.SchemaHash)
-	assert.Equal(t, tc.Revoked, response.Revoked)
-	assert.Equal(t, tc.RevNonce, response.RevNonce)
-	assert.InDelta(t, tc.CreatedAt.Unix(), response.CreatedAt.Unix(), 10)
-	if response.ExpiresAt != nil && tc.ExpiresAt != nil {
-		assert.InDelta(t, tc.ExpiresAt.Unix(), response.ExpiresAt.Unix(), 10)
+	typ, err := core.BuildDIDType(core.DIDMethodPolygonID, core.Polygon, core.Mumbai)
+	require.NoError(t, err)
+	newDID := func() *core.DID {
+		id, err := core.IdGenesisFromIdenState(typ, big.NewInt(rand.Int63()))
+		require.NoError(t, err)
+		did, err := core.ParseDIDFromID(*id)
+		require.NoError(t, err)
+		return did
 	}
-	assert.Equal(t, tc.Expired, response.Expired)
-	var respAttributes, tcCredentialSubject credentialKYCSubject
-	assert.NoError(t, mapstructure.Decode(tc.CredentialSubject, &tcCredentialSubject))
-	assert.NoError(t, mapstructure.Decode(response.CredentialSubject, &respAttributes))
-	assert.EqualValues(t, respAttributes, tcCredentialSubject)
-	assert.EqualValues(t, tc.ProofTypes, response.ProofTypes)
-	assert.Equal(t, tc.UserID, response.UserID)
-}
+	did := newDID()
+	cfg.APIUI.IssuerDID = *did
+	server := NewServer(&cfg, NewIdentityMock(), NewClaimsMock(), NewSchemaMock(), connectionsService, NewLinkMock(), NewCredentialTemplateMock(), NewImportMock(), NewChangesMock(), NewJobQueueMock(), NewJWTCredentialMock(), NewIssuerProfileMock(), NewOfferEmailMock(), NewAgentInboxMock(), NewPublisherMock(), NewPackageManagerMock(), nil)
+	handler := getHandler(ctx, server)
 
-func TestServer_RevokeCredential(t *testing.T) {
-	const (
-		method     = "polygonid"
-		blockchain = "polygon"
-		network    = "mumbai"
-	)
-	ctx := log.NewContext(context.Background(), log.LevelDebug, log.OutputText, os.Stdout)
-	identityRepo := repositories.NewIdentity()
-	claimsRepo := repositories.NewClaims()
-	identityStateRepo := repositories.NewIdentityState()
-	mtRepo := repositories.NewIdentityMerkleTreeRepository()
-	mtService := services.NewIdentityMerkleTrees(mtRepo)
+	fixture := tests.NewFixture(storage)
+	connID := fixture.CreateConnection(t, &domain.Connection{ID: uuid.New(), IssuerDID: *did, UserDID: *newDID(), CreatedAt: time.Now(), ModifiedAt: time.Now()})
+
+	type expected struct {
+		httpCode int
+		response *ConnectionMetadata
+		etag     string
+	}
+	for _, tc := range []struct {
+		name     string
+		auth     func() (string, string)
+		id       uuid.UUID
+		ifMatch  string
+		body     any
+		expected expected
+	}{
+		{
+			name:     "No auth header",
+			auth:     authWrong,
+			id:       connID,
+			body:     UpdateConnectionMetadataRequest{},
+			expected: expected{httpCode: http.StatusUnauthorized},
+		},
+		{
+			name:     "should return 404, connection not found",
+			auth:     authOk,
+			id:       uuid.New(),
+			body:     UpdateConnectionMetadataRequest{DisplayName: common.ToPointer("Alice")},
+			expected: expected{httpCode: http.StatusNotFound},
+		},
+		{
+			name:     "should return 400, too many characters",
+			auth:     authOk,
+			id:       connID,
+			body:     UpdateConnectionMetadataRequest{DisplayName: common.ToPointer(strings.Repeat("a", domain.MaxConnectionDisplayNameLength+1))},
+			expected: expected{httpCode: http.StatusBadRequest},
+		},
+		{
+			name: "should set the metadata, normalizing the tags",
+			auth: authOk,
+			id:   connID,
+			body: UpdateConnectionMetadataRequest{
+				DisplayName: common.ToPointer("Alice Smith"),
+				Notes:       common.ToPointer("met at the conference"),
+				Tags:        &[]string{"VIP", "employee", "vip"},
+			},
+			expected: expected{
+				httpCode: http.StatusOK,
+				response: &ConnectionMetadata{DisplayName: "Alice Smith", Notes: "met at the conference", Tags: []string{"vip", "employee"}},
+				etag:     `"1"`,
+			},
+		},
+		{
+			name:     "should return 412, the metadata was modified",
+			auth:     authOk,
+			id:       connID,
+			ifMatch:  `"0"`,
+			body:     UpdateConnectionMetadataRequest{DisplayName: common.ToPointer("Bob")},
+			expected: expected{httpCode: http.StatusPreconditionFailed},
+		},
+		{
+			name:     "should return 400, invalid If-Match",
+			auth:     authOk,
+			id:       connID,
+			ifMatch:  "one",
+			body:     UpdateConnectionMetadataRequest{DisplayName: common.ToPointer("Bob")},
+			expected: expected{httpCode: http.StatusBadRequest},
+		},
+		{
+			name:    "should keep the fields that are not given",
+			auth:    authOk,
+			id:      connID,
+			ifMatch: `"1"`,
+			body:    UpdateConnectionMetadataRequest{Notes: common.ToPointer("")},
+			expected: expected{
+				httpCode: http.StatusOK,
+				response: &ConnectionMetadata{DisplayName: "Alice Smith", Notes: "", Tags: []string{"vip", "employee"}},
+				etag:     `"2"`,
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			req, err := http.NewRequest(http.MethodPatch, fmt.Sprintf("/v1/connections/%s", tc.id), tests.JSONBody(t, tc.body))
+			require.NoError(t, err)
+			req.SetBasicAuth(tc.auth())
+			if tc.ifMatch != "" {
+				req.Header.Set("If-Match", tc.ifMatch)
+			}
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.expected.httpCode, rr.Code)
+			assert.Equal(t, tc.expected.etag, rr.Header().Get("ETag"))
+			if tc.expected.response != nil {
+				var response ConnectionMetadata
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+				assert.Equal(t, tc.expected.response.DisplayName, response.DisplayName)
+				assert.Equal(t, tc.expected.response.Notes, response.Notes)
+				assert.Equal(t, tc.expected.response.Tags, response.Tags)
+			}
+		})
+	}
+
+	rr := httptest.NewRecorder()
+	req, err := http.NewRequest(http.MethodGet, "/v1/connections?tags=employee,VIP&query=smith", nil)
+	require.NoError(t, err)
+	req.SetBasicAuth(authOk())
+	handler.ServeHTTP(rr, req)
+	require.Equal(t, http.StatusOK, rr.Code)
+	var conns GetConnectionsResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &conns))
+	require.Len(t, conns, 1)
+	assert.Equal(t, connID.String(), conns[0].Id)
+	require.NotNil(t, conns[0].Metadata)
+	assert.Equal(t, "Alice Smith", conns[0].Metadata.DisplayName)
+}
+
+func coreClaimHex(t *testing.T, credential *domain.Claim) string {
+	t.Helper()
+	coreClaim, err := credential.CoreClaim.Get().Hex()
+	require.NoError(t, err)
+	return coreClaim
+}
+
+func validateCredential(t *testing.T, tc Credential, response Credential) {
+	type credentialKYCSubject struct {
+		Id           string `json:"id"`
+		Birthday     uint64 `json:"birthday"`
+		DocumentType uint64 `json:"documentType"`
+	}
+
+	assert.Equal(t, tc.Id, response.Id)
+	assert.Equal(t, tc.SchemaType, response.SchemaType)
+	assert.Equal(t, tc.SchemaHash, response.SchemaHash)
+	assert.Equal(t, tc.Revoked, response.Revoked)
+	assert.Equal(t, tc.RevNonce, response.RevNonce)
+	assert.InDelta(t, tc.CreatedAt.Unix(), response.CreatedAt.Unix(), 10)
+	if response.ExpiresAt != nil && tc.ExpiresAt != nil {
+		assert.InDelta(t, tc.ExpiresAt.Unix(), response.ExpiresAt.Unix(), 10)
+	}
+	assert.Equal(t, tc.Expired, response.Expired)
+	var respAttributes, tcCredentialSubject credentialKYCSubject
+	assert.NoError(t, mapstructure.Decode(tc.CredentialSubject, &tcCredentialSubject))
+	assert.NoError(t, mapstructure.Decode(response.CredentialSubject, &respAttributes))
+	assert.EqualValues(t, respAttributes, tcCredentialSubject)
+	assert.EqualValues(t, tc.ProofTypes, response.ProofTypes)
+	assert.Equal(t, tc.UserID, response.UserID)
+}
+
+func TestServer_RevokeCredential(t *testing.T) {
+	const (
+		method     = "polygonid"
+		blockchain = "polygon"
+		network    = "mumbai"
+	)
+	ctx := log.NewContext(context.Background(), log.LevelDebug, log.OutputText, os.Stdout)
+	identityRepo := repositories.NewIdentity()
+	claimsRepo := repositories.NewClaims()
+	identityStateRepo := repositories.NewIdentityState()
+	mtRepo := repositories.NewIdentityMerkleTreeRepository()
+	mtService := services.NewIdentityMerkleTrees(mtRepo)
 	revocationRepository := repositories.NewRevocation()
 	rhsp := reverse_hash.NewRhsPublisher(nil, false)
 	connectionsRepository := repositories.NewConnections()
@@ -2394,7 +3265,7 @@ func TestServer_RevokeCredential(t *testing.T) {
 		RHSEnabled: false,
 		Host:       "host",
 	}
-	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf, pubsub.NewMock())
+	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf)
 
 	fixture := tests.NewFixture(storage)
 	connectionsService := services.NewConnection(connectionsRepository, storage)
@@ -2406,7 +3277,7 @@ func TestServer_RevokeCredential(t *testing.T) {
 
 	cfg.APIUI.IssuerDID = *did
 
-	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), connectionsService, NewLinkMock(), NewPublisherMock(), NewPackageManagerMock(), nil)
+	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), connectionsService, NewLinkMock(), NewCredentialTemplateMock(), NewImportMock(), NewChangesMock(), NewJobQueueMock(), NewJWTCredentialMock(), NewIssuerProfileMock(), NewOfferEmailMock(), NewAgentInboxMock(), NewPublisherMock(), NewPackageManagerMock(), nil)
 
 	idClaim, err := uuid.NewUUID()
 	require.NoError(t, err)
@@ -2426,6 +3297,17 @@ func TestServer_RevokeCredential(t *testing.T) {
 		CoreClaim:       domain.CoreClaim{},
 		Status:          nil,
 	})
+	publishedNonce := int64(124)
+	fixture.CreateClaim(t, &domain.Claim{
+		ID:         uuid.New(),
+		Identifier: common.ToPointer(did.String()),
+		Issuer:     did.String(),
+		SchemaHash: "ca938857241db9451ea329256b9c06e5",
+		SchemaURL:  "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json-ld/auth.json-ld",
+		SchemaType: "AuthBJJCredential",
+		RevNonce:   domain.RevNonceUint64(publishedNonce),
+		CoreClaim:  domain.CoreClaim{},
+	})
 
 	handler := getHandler(context.Background(), server)
 
@@ -2435,10 +3317,12 @@ func TestServer_RevokeCredential(t *testing.T) {
 	}
 
 	type testConfig struct {
-		name     string
-		auth     func() (string, string)
-		nonce    int64
-		expected expected
+		name       string
+		auth       func() (string, string)
+		nonce      int64
+		publishNow bool
+		query      string
+		expected   expected
 	}
 
 	for _, tc := range []testConfig{
@@ -2450,10 +3334,23 @@ func TestServer_RevokeCredential(t *testing.T) {
 				httpCode: http.StatusUnauthorized,
 			},
 		},
+		{
+			name:  "should get an error - invalid reason",
+			auth:  authOk,
+			nonce: nonce,
+			query: "reason=lost",
+			expected: expected{
+				httpCode: 400,
+				response: RevokeCredential400JSONResponse{N400JSONResponse{
+					Message: "invalid revocation reason: lost",
+				}},
+			},
+		},
 		{
 			name:  "should revoke the claim",
 			auth:  authOk,
 			nonce: nonce,
+			query: "reason=affiliationChanged&description=left%20the%20company",
 			expected: expected{
 				httpCode: 202,
 				response: RevokeCredential202JSONResponse{
@@ -2461,6 +3358,18 @@ func TestServer_RevokeCredential(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:       "should revoke the claim and publish the state",
+			auth:       authOk,
+			nonce:      publishedNonce,
+			publishNow: true,
+			expected: expected{
+				httpCode: 202,
+				response: RevokeCredential202JSONResponse{
+					Message: "claim revocation request sent and state published",
+				},
+			},
+		},
 		{
 			name:  "should get an error wrong nonce",
 			auth:  authOk,
@@ -2487,6 +3396,12 @@ func TestServer_RevokeCredential(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			rr := httptest.NewRecorder()
 			url := fmt.Sprintf("/v1/credentials/revoke/%d", tc.nonce)
+			if tc.publishNow {
+				url += "?publishNow=true"
+			}
+			if tc.query != "" {
+				url += "?" + tc.query
+			}
 			req, err := http.NewRequest(http.MethodPost, url, nil)
 			req.SetBasicAuth(tc.auth())
 			require.NoError(t, err)
@@ -2498,6 +3413,10 @@ func TestServer_RevokeCredential(t *testing.T) {
 				var response RevokeCredential202JSONResponse
 				assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
 				assert.Equal(t, response.Message, v.Message)
+			case RevokeCredential400JSONResponse:
+				var response RevokeCredential400JSONResponse
+				assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+				assert.Equal(t, response.Message, v.Message)
 			case RevokeCredential404JSONResponse:
 				var response RevokeCredential404JSONResponse
 				assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
@@ -2530,7 +3449,7 @@ func TestServer_CreateLink(t *testing.T) {
 	connectionsRepository := repositories.NewConnections()
 	linkRepository := repositories.NewLink(*storage)
 	schemaRespository := repositories.NewSchema(*storage)
-	sessionRepository := repositories.NewSessionCached(cachex)
+	sessionRepository := repositories.NewSessionCached(cachex, 0)
 	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, claimsRepo, revocationRepository, connectionsRepository, storage, rhsp, nil, nil, pubsub.NewMock())
 	schemaLoader := loader.CachedFactory(loader.HTTPFactory, cachex)
 	claimsConf := services.ClaimCfg{
@@ -2538,7 +3457,7 @@ func TestServer_CreateLink(t *testing.T) {
 		Host:       "http://host",
 	}
 	pubSub := pubsub.NewMock()
-	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf, pubSub)
+	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf)
 	connectionsService := services.NewConnection(connectionsRepository, storage)
 	linkService := services.NewLinkService(storage, claimsService, claimsRepo, linkRepository, schemaRespository, loader.HTTPFactory, sessionRepository, pubSub)
 	iden, err := identityService.Create(ctx, method, blockchain, network, "polygon-test")
@@ -2547,12 +3466,12 @@ func TestServer_CreateLink(t *testing.T) {
 	did, err := core.ParseDID(iden.Identifier)
 	require.NoError(t, err)
 
-	schemaSrv := services.NewSchema(repositories.NewSchema(*storage), loader.HTTPFactory)
+	schemaSrv := services.NewSchema(repositories.NewSchema(*storage), loader.HTTPFactory, "http://localhost", nil)
 	importedSchema, err := schemaSrv.ImportSchema(ctx, *did, url, schemaType)
 	assert.NoError(t, err)
 
 	cfg.APIUI.IssuerDID = *did
-	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), connectionsService, linkService, NewPublisherMock(), NewPackageManagerMock(), nil)
+	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), connectionsService, linkService, NewCredentialTemplateMock(), NewImportMock(), NewChangesMock(), NewJobQueueMock(), NewJWTCredentialMock(), NewIssuerProfileMock(), NewOfferEmailMock(), NewAgentInboxMock(), NewPublisherMock(), NewPackageManagerMock(), nil)
 
 	handler := getHandler(ctx, server)
 
@@ -2694,6 +3613,45 @@ func TestServer_CreateLink(t *testing.T) {
 				httpCode: http.StatusBadRequest,
 			},
 		},
+		{
+			name: "Happy path with proof scope",
+			auth: authOk,
+			body: CreateLinkRequest{
+				SchemaID:          importedSchema.ID,
+				LimitedClaims:     common.ToPointer(10),
+				CredentialSubject: CredentialSubject{"birthday": 19790911, "documentType": 12},
+				SignatureProof:    true,
+				ProofScope: &[]VerificationQuery{{
+					CircuitId:         "credentialAtomicQuerySigV2",
+					Context:           "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json-ld/kyc-v3.json-ld",
+					Type:              "KYCAgeCredential",
+					CredentialSubject: &map[string]interface{}{"birthday": map[string]interface{}{"$lt": 20050101}},
+				}},
+			},
+			expected: expected{
+				response: CreateLink201JSONResponse{},
+				httpCode: http.StatusCreated,
+			},
+		},
+		{
+			name: "Claim link proof scope with unsupported circuit",
+			auth: authOk,
+			body: CreateLinkRequest{
+				SchemaID:          importedSchema.ID,
+				LimitedClaims:     common.ToPointer(10),
+				CredentialSubject: CredentialSubject{"birthday": 19790911, "documentType": 12},
+				SignatureProof:    true,
+				ProofScope: &[]VerificationQuery{{
+					CircuitId: "authV2",
+					Context:   "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json-ld/kyc-v3.json-ld",
+					Type:      "KYCAgeCredential",
+				}},
+			},
+			expected: expected{
+				response: CreateLink400JSONResponse{N400JSONResponse{Message: `invalid proof scope: query 1: circuit "authV2" is not supported`}},
+				httpCode: http.StatusBadRequest,
+			},
+		},
 		{
 			name: "Claim link wrong schema id",
 			auth: authOk,
@@ -2737,6 +3695,47 @@ func TestServer_CreateLink(t *testing.T) {
 			}
 		})
 	}
+
+	t.Run("Placeholder subject is stored and bound to the holder that claims the link", func(t *testing.T) {
+		body := CreateLinkRequest{
+			SchemaID:          importedSchema.ID,
+			LimitedClaims:     common.ToPointer(10),
+			CredentialSubject: CredentialSubject{"birthday": 19790911, "documentType": 12},
+			SignatureProof:    true,
+			SubjectExternalID: common.ToPointer(" jane@example.com "),
+		}
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodPost, "/v1/credentials/links", tests.JSONBody(t, body))
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusCreated, rr.Code)
+
+		var response UUIDResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		linkID, err := uuid.Parse(response.Id)
+		require.NoError(t, err)
+		link, err := linkService.GetByID(ctx, *did, linkID)
+		require.NoError(t, err)
+		assert.Equal(t, common.ToPointer("jane@example.com"), link.SubjectExternalID)
+
+		holderDID := core.DID{}
+		require.NoError(t, holderDID.SetString("did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ"))
+		require.NoError(t, linkService.IssueClaim(ctx, uuid.NewString(), *did, holderDID, linkID, "host_url"))
+
+		rr = httptest.NewRecorder()
+		req, err = http.NewRequest(http.MethodGet, "/v1/subjects/jane@example.com", nil)
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var binding SubjectBinding
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &binding))
+		assert.Equal(t, "jane@example.com", binding.ExternalID)
+		assert.Equal(t, holderDID.String(), binding.HolderDID)
+		assert.Equal(t, &linkID, binding.LinkID)
+	})
 }
 
 func TestServer_ActivateLink(t *testing.T) {
@@ -2758,14 +3757,14 @@ func TestServer_ActivateLink(t *testing.T) {
 	connectionsRepository := repositories.NewConnections()
 	linkRepository := repositories.NewLink(*storage)
 	schemaRepository := repositories.NewSchema(*storage)
-	sessionRepository := repositories.NewSessionCached(cachex)
+	sessionRepository := repositories.NewSessionCached(cachex, 0)
 	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, claimsRepo, revocationRepository, connectionsRepository, storage, rhsp, nil, nil, pubsub.NewMock())
 	schemaLoader := loader.CachedFactory(loader.HTTPFactory, cachex)
 	claimsConf := services.ClaimCfg{
 		RHSEnabled: false,
 		Host:       "http://host",
 	}
-	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf, pubsub.NewMock())
+	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf)
 	connectionsService := services.NewConnection(connectionsRepository, storage)
 	linkService := services.NewLinkService(storage, claimsService, claimsRepo, linkRepository, schemaRepository, loader.HTTPFactory, sessionRepository, pubsub.NewMock())
 	iden, err := identityService.Create(ctx, method, blockchain, network, "polygon-test")
@@ -2774,15 +3773,15 @@ func TestServer_ActivateLink(t *testing.T) {
 	did, err := core.ParseDID(iden.Identifier)
 	require.NoError(t, err)
 
-	schemaSrv := services.NewSchema(repositories.NewSchema(*storage), loader.HTTPFactory)
+	schemaSrv := services.NewSchema(repositories.NewSchema(*storage), loader.HTTPFactory, "http://localhost", nil)
 	importedSchema, err := schemaSrv.ImportSchema(ctx, *did, url, schemaType)
 	assert.NoError(t, err)
 
 	cfg.APIUI.IssuerDID = *did
-	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), connectionsService, linkService, NewPublisherMock(), NewPackageManagerMock(), nil)
+	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), connectionsService, linkService, NewCredentialTemplateMock(), NewImportMock(), NewChangesMock(), NewJobQueueMock(), NewJWTCredentialMock(), NewIssuerProfileMock(), NewOfferEmailMock(), NewAgentInboxMock(), NewPublisherMock(), NewPackageManagerMock(), nil)
 
 	tomorrow := time.Now().Add(24 * time.Hour)
-	link, err := linkService.Save(ctx, *did, common.ToPointer(10), &tomorrow, importedSchema.ID, nil, true, true, CredentialSubject{"birthday": 19790911, "documentType": 12})
+	link, err := linkService.Save(ctx, *did, common.ToPointer(10), &tomorrow, importedSchema.ID, nil, true, true, CredentialSubject{"birthday": 19790911, "documentType": 12}, nil, nil, nil, false)
 	require.NoError(t, err)
 
 	handler := getHandler(ctx, server)
@@ -2790,12 +3789,14 @@ func TestServer_ActivateLink(t *testing.T) {
 	type expected struct {
 		response AcivateLinkResponseObject
 		httpCode int
+		etag     string
 	}
 
 	type testConfig struct {
 		name     string
 		id       uuid.UUID
 		auth     func() (string, string)
+		ifMatch  string
 		body     AcivateLinkJSONBody
 		expected expected
 	}
@@ -2834,15 +3835,43 @@ func TestServer_ActivateLink(t *testing.T) {
 			},
 		},
 		{
-			name: "Happy path",
-			auth: authOk,
-			id:   link.ID,
+			name:    "Invalid If-Match header",
+			auth:    authOk,
+			id:      link.ID,
+			ifMatch: "latest",
+			body: AcivateLinkJSONBody{
+				Active: false,
+			},
+			expected: expected{
+				response: AcivateLink400JSONResponse{N400JSONResponse{Message: "invalid If-Match header: latest"}},
+				httpCode: http.StatusBadRequest,
+			},
+		},
+		{
+			name:    "Link modified since it was read",
+			auth:    authOk,
+			id:      link.ID,
+			ifMatch: `"7"`,
+			body: AcivateLinkJSONBody{
+				Active: false,
+			},
+			expected: expected{
+				response: AcivateLink412JSONResponse{N412JSONResponse{Message: "the resource has been modified, fetch it again before updating it"}},
+				httpCode: http.StatusPreconditionFailed,
+			},
+		},
+		{
+			name:    "Happy path",
+			auth:    authOk,
+			id:      link.ID,
+			ifMatch: `"1"`,
 			body: AcivateLinkJSONBody{
 				Active: false,
 			},
 			expected: expected{
-				response: AcivateLink200JSONResponse{Message: "Link updated"},
+				response: AcivateLink200JSONResponse{Body: GenericMessage{Message: "Link updated"}},
 				httpCode: http.StatusOK,
+				etag:     `"2"`,
 			},
 		},
 		{
@@ -2865,6 +3894,9 @@ func TestServer_ActivateLink(t *testing.T) {
 			req, err := http.NewRequest(http.MethodPatch, url, tests.JSONBody(t, tc.body))
 			req.SetBasicAuth(tc.auth())
 			require.NoError(t, err)
+			if tc.ifMatch != "" {
+				req.Header.Set("If-Match", tc.ifMatch)
+			}
 
 			handler.ServeHTTP(rr, req)
 
@@ -2876,12 +3908,18 @@ func TestServer_ActivateLink(t *testing.T) {
 				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
 				expected, ok := tc.expected.response.(AcivateLink200JSONResponse)
 				assert.True(t, ok)
-				assert.Equal(t, expected.Message, response.Message)
+				assert.Equal(t, expected.Body.Message, response.Message)
+				assert.Equal(t, tc.expected.etag, rr.Header().Get("ETag"))
 
 			case http.StatusBadRequest:
 				var response AcivateLink400JSONResponse
 				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
 				assert.EqualValues(t, tc.expected.response, response)
+
+			case http.StatusPreconditionFailed:
+				var response AcivateLink412JSONResponse
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+				assert.EqualValues(t, tc.expected.response, response)
 			}
 		})
 	}
@@ -2909,14 +3947,14 @@ func TestServer_GetLink(t *testing.T) {
 	connectionsRepository := repositories.NewConnections()
 	linkRepository := repositories.NewLink(*storage)
 	schemaRepository := repositories.NewSchema(*storage)
-	sessionRepository := repositories.NewSessionCached(cachex)
+	sessionRepository := repositories.NewSessionCached(cachex, 0)
 	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, claimsRepo, revocationRepository, connectionsRepository, storage, rhsp, nil, nil, pubsub.NewMock())
 	schemaLoader := loader.CachedFactory(loader.HTTPFactory, cachex)
 	claimsConf := services.ClaimCfg{
 		RHSEnabled: false,
 		Host:       "http://host",
 	}
-	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf, pubsub.NewMock())
+	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf)
 	connectionsService := services.NewConnection(connectionsRepository, storage)
 	linkService := services.NewLinkService(storage, claimsService, claimsRepo, linkRepository, schemaRepository, loader.HTTPFactory, sessionRepository, pubsub.NewMock())
 	iden, err := identityService.Create(ctx, method, blockchain, network, "polygon-test")
@@ -2925,21 +3963,21 @@ func TestServer_GetLink(t *testing.T) {
 	did, err := core.ParseDID(iden.Identifier)
 	require.NoError(t, err)
 
-	schemaSrv := services.NewSchema(repositories.NewSchema(*storage), loader.HTTPFactory)
+	schemaSrv := services.NewSchema(repositories.NewSchema(*storage), loader.HTTPFactory, "http://localhost", nil)
 	importedSchema, err := schemaSrv.ImportSchema(ctx, *did, url, schemaType)
 	assert.NoError(t, err)
 
 	cfg.APIUI.IssuerDID = *did
-	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), connectionsService, linkService, NewPublisherMock(), NewPackageManagerMock(), nil)
+	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), connectionsService, linkService, NewCredentialTemplateMock(), NewImportMock(), NewChangesMock(), NewJobQueueMock(), NewJWTCredentialMock(), NewIssuerProfileMock(), NewOfferEmailMock(), NewAgentInboxMock(), NewPublisherMock(), NewPackageManagerMock(), nil)
 
 	tomorrow := time.Now().Add(24 * time.Hour)
 	yesterday := time.Now().Add(-24 * time.Hour)
 
-	link, err := linkService.Save(ctx, *did, common.ToPointer(10), &tomorrow, importedSchema.ID, nil, true, true, domain.CredentialSubject{"birthday": 19791109, "documentType": 12})
+	link, err := linkService.Save(ctx, *did, common.ToPointer(10), &tomorrow, importedSchema.ID, nil, true, true, domain.CredentialSubject{"birthday": 19791109, "documentType": 12}, nil, nil, nil, false)
 	require.NoError(t, err)
 	hash, _ := link.Schema.Hash.MarshalText()
 
-	linkExpired, err := linkService.Save(ctx, *did, common.ToPointer(10), &yesterday, importedSchema.ID, nil, true, true, domain.CredentialSubject{"birthday": 19791109, "documentType": 12})
+	linkExpired, err := linkService.Save(ctx, *did, common.ToPointer(10), &yesterday, importedSchema.ID, nil, true, true, domain.CredentialSubject{"birthday": 19791109, "documentType": 12}, nil, nil, nil, false)
 	require.NoError(t, err)
 
 	handler := getHandler(ctx, server)
@@ -2980,7 +4018,7 @@ func TestServer_GetLink(t *testing.T) {
 			id:   link.ID,
 			expected: expected{
 				httpCode: http.StatusOK,
-				response: GetLink200JSONResponse{
+				response: GetLink200JSONResponse{Body: Link{
 					Active:            link.Active,
 					CredentialSubject: CredentialSubject{"birthday": 19791109, "documentType": 12, "type": schemaType, "id": "did:polygonid:polygon:mumbai:2qDDDKmo436EZGCBAvkqZjADYoNRJszkG7UymZeCHQ"},
 					Expiration:        link.ValidUntil,
@@ -2993,7 +4031,8 @@ func TestServer_GetLink(t *testing.T) {
 					ProofTypes:        []string{"SparseMerkleTreeProof", "BJJSignature2021"},
 					CreatedAt:         link.CreatedAt,
 					SchemaHash:        string(hash),
-				},
+					Version:           1,
+				}},
 			},
 		},
 		{
@@ -3002,7 +4041,7 @@ func TestServer_GetLink(t *testing.T) {
 			id:   linkExpired.ID,
 			expected: expected{
 				httpCode: http.StatusOK,
-				response: GetLink200JSONResponse{
+				response: GetLink200JSONResponse{Body: Link{
 					Active:            linkExpired.Active,
 					CredentialSubject: CredentialSubject{"birthday": 19791109, "documentType": 12, "type": schemaType, "id": "did:polygonid:polygon:mumbai:2qDDDKmo436EZGCBAvkqZjADYoNRJszkG7UymZeCHQ"},
 					Expiration:        linkExpired.ValidUntil,
@@ -3013,7 +4052,8 @@ func TestServer_GetLink(t *testing.T) {
 					SchemaUrl:         linkExpired.Schema.URL,
 					Status:            LinkStatusExceeded,
 					ProofTypes:        []string{"SparseMerkleTreeProof", "BJJSignature2021"},
-				},
+					Version:           1,
+				}},
 			},
 		},
 	} {
@@ -3031,10 +4071,13 @@ func TestServer_GetLink(t *testing.T) {
 
 			switch tc.expected.httpCode {
 			case http.StatusOK:
-				var response GetLink200JSONResponse
-				expected, ok := tc.expected.response.(GetLink200JSONResponse)
+				var response Link
+				expectedResponse, ok := tc.expected.response.(GetLink200JSONResponse)
 				require.True(t, ok)
+				expected := expectedResponse.Body
 				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+				assert.Equal(t, expected.Version, response.Version)
+				assert.Equal(t, etag(expected.Version), rr.Header().Get("ETag"))
 				assert.Equal(t, expected.Active, response.Active)
 				assert.Equal(t, expected.MaxIssuance, response.MaxIssuance)
 				assert.Equal(t, expected.Status, response.Status)
@@ -3080,14 +4123,14 @@ func TestServer_GetAllLinks(t *testing.T) {
 	connectionsRepository := repositories.NewConnections()
 	linkRepository := repositories.NewLink(*storage)
 	schemaRepository := repositories.NewSchema(*storage)
-	sessionRepository := repositories.NewSessionCached(cachex)
+	sessionRepository := repositories.NewSessionCached(cachex, 0)
 	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, claimsRepo, revocationRepository, connectionsRepository, storage, rhsp, nil, nil, pubsub.NewMock())
 	schemaLoader := loader.CachedFactory(loader.HTTPFactory, cachex)
 	claimsConf := services.ClaimCfg{
 		RHSEnabled: false,
 		Host:       "http://host",
 	}
-	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf, pubsub.NewMock())
+	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf)
 	connectionsService := services.NewConnection(connectionsRepository, storage)
 	linkService := services.NewLinkService(storage, claimsService, claimsRepo, linkRepository, schemaRepository, loader.HTTPFactory, sessionRepository, pubsub.NewMock())
 	iden, err := identityService.Create(ctx, method, blockchain, network, "polygon-test")
@@ -3096,32 +4139,33 @@ func TestServer_GetAllLinks(t *testing.T) {
 	did, err := core.ParseDID(iden.Identifier)
 	require.NoError(t, err)
 
-	schemaSrv := services.NewSchema(repositories.NewSchema(*storage), loader.HTTPFactory)
+	schemaSrv := services.NewSchema(repositories.NewSchema(*storage), loader.HTTPFactory, "http://localhost", nil)
 	importedSchema, err := schemaSrv.ImportSchema(ctx, *did, sUrl, schemaType)
 	assert.NoError(t, err)
 
 	cfg.APIUI.IssuerDID = *did
-	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), connectionsService, linkService, NewPublisherMock(), NewPackageManagerMock(), nil)
+	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), connectionsService, linkService, NewCredentialTemplateMock(), NewImportMock(), NewChangesMock(), NewJobQueueMock(), NewJWTCredentialMock(), NewIssuerProfileMock(), NewOfferEmailMock(), NewAgentInboxMock(), NewPublisherMock(), NewPackageManagerMock(), nil)
 
 	tomorrow := time.Now().Add(24 * time.Hour)
 	yesterday := time.Now().Add(-24 * time.Hour)
 
-	link1, err := linkService.Save(ctx, *did, common.ToPointer(10), &tomorrow, importedSchema.ID, nil, true, true, domain.CredentialSubject{"birthday": 19791109, "documentType": 12})
+	link1, err := linkService.Save(ctx, *did, common.ToPointer(10), &tomorrow, importedSchema.ID, nil, true, true, domain.CredentialSubject{"birthday": 19791109, "documentType": 12}, nil, nil, nil, false)
 	require.NoError(t, err)
 	linkActive := getLinkResponse(*link1)
 
 	time.Sleep(10 * time.Millisecond)
 
-	link2, err := linkService.Save(ctx, *did, common.ToPointer(10), &yesterday, importedSchema.ID, nil, true, true, domain.CredentialSubject{"birthday": 19791109, "documentType": 12})
+	link2, err := linkService.Save(ctx, *did, common.ToPointer(10), &yesterday, importedSchema.ID, nil, true, true, domain.CredentialSubject{"birthday": 19791109, "documentType": 12}, nil, nil, nil, false)
 	require.NoError(t, err)
 	linkExpired := getLinkResponse(*link2)
 	require.NoError(t, err)
 	time.Sleep(10 * time.Millisecond)
 
-	link3, err := linkService.Save(ctx, *did, common.ToPointer(10), &yesterday, importedSchema.ID, nil, true, true, domain.CredentialSubject{"birthday": 19791109, "documentType": 12})
+	link3, err := linkService.Save(ctx, *did, common.ToPointer(10), &yesterday, importedSchema.ID, nil, true, true, domain.CredentialSubject{"birthday": 19791109, "documentType": 12}, nil, nil, nil, false)
 	link3.Active = false
 	require.NoError(t, err)
-	require.NoError(t, linkService.Activate(ctx, *did, link3.ID, false))
+	link3, err = linkService.Activate(ctx, *did, link3.ID, false, nil)
+	require.NoError(t, err)
 	linkInactive := getLinkResponse(*link3)
 	require.NoError(t, err)
 	time.Sleep(10 * time.Millisecond)
@@ -3294,14 +4338,14 @@ func TestServer_DeleteLink(t *testing.T) {
 	rhsp := reverse_hash.NewRhsPublisher(nil, false)
 	connectionsRepository := repositories.NewConnections()
 	linkRepository := repositories.NewLink(*storage)
-	sessionRepository := repositories.NewSessionCached(cachex)
+	sessionRepository := repositories.NewSessionCached(cachex, 0)
 	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, claimsRepo, revocationRepository, connectionsRepository, storage, rhsp, nil, nil, pubsub.NewMock())
 	schemaLoader := loader.CachedFactory(loader.HTTPFactory, cachex)
 	claimsConf := services.ClaimCfg{
 		RHSEnabled: false,
 		Host:       "http://host",
 	}
-	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf, pubsub.NewMock())
+	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf)
 	connectionsService := services.NewConnection(connectionsRepository, storage)
 	linkService := services.NewLinkService(storage, claimsService, claimsRepo, linkRepository, schemaRepository, loader.HTTPFactory, sessionRepository, pubsub.NewMock())
 	iden, err := identityService.Create(ctx, method, blockchain, network, "polygon-test")
@@ -3310,16 +4354,16 @@ func TestServer_DeleteLink(t *testing.T) {
 	did, err := core.ParseDID(iden.Identifier)
 	require.NoError(t, err)
 
-	schemaSrv := services.NewSchema(repositories.NewSchema(*storage), loader.HTTPFactory)
+	schemaSrv := services.NewSchema(repositories.NewSchema(*storage), loader.HTTPFactory, "http://localhost", nil)
 	importedSchema, err := schemaSrv.ImportSchema(ctx, *did, url, schemaType)
 	assert.NoError(t, err)
 
 	cfg.APIUI.IssuerDID = *did
-	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), connectionsService, linkService, NewPublisherMock(), NewPackageManagerMock(), nil)
+	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), connectionsService, linkService, NewCredentialTemplateMock(), NewImportMock(), NewChangesMock(), NewJobQueueMock(), NewJWTCredentialMock(), NewIssuerProfileMock(), NewOfferEmailMock(), NewAgentInboxMock(), NewPublisherMock(), NewPackageManagerMock(), nil)
 
 	validUntil := common.ToPointer(time.Date(2023, 8, 15, 14, 30, 45, 100, time.Local))
 	credentialExpiration := common.ToPointer(time.Date(2025, 8, 15, 14, 30, 45, 100, time.Local))
-	link, err := linkService.Save(ctx, *did, common.ToPointer(10), validUntil, importedSchema.ID, credentialExpiration, true, true, domain.CredentialSubject{"birthday": 19791109, "documentType": 12})
+	link, err := linkService.Save(ctx, *did, common.ToPointer(10), validUntil, importedSchema.ID, credentialExpiration, true, true, domain.CredentialSubject{"birthday": 19791109, "documentType": 12}, nil, nil, nil, false)
 	assert.NoError(t, err)
 	handler := getHandler(ctx, server)
 
@@ -3411,14 +4455,14 @@ func TestServer_DeleteLinkForDifferentDID(t *testing.T) {
 	connectionsRepository := repositories.NewConnections()
 	linkRepository := repositories.NewLink(*storage)
 	schemaRepository := repositories.NewSchema(*storage)
-	sessionRepository := repositories.NewSessionCached(cachex)
+	sessionRepository := repositories.NewSessionCached(cachex, 0)
 	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, claimsRepo, revocationRepository, connectionsRepository, storage, rhsp, nil, nil, pubsub.NewMock())
 	schemaLoader := loader.CachedFactory(loader.HTTPFactory, cachex)
 	claimsConf := services.ClaimCfg{
 		RHSEnabled: false,
 		Host:       "http://host",
 	}
-	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf, pubsub.NewMock())
+	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf)
 	connectionsService := services.NewConnection(connectionsRepository, storage)
 	linkService := services.NewLinkService(storage, claimsService, claimsRepo, linkRepository, schemaRepository, loader.HTTPFactory, sessionRepository, pubsub.NewMock())
 	iden, err := identityService.Create(ctx, method, blockchain, network, "polygon-test")
@@ -3430,7 +4474,7 @@ func TestServer_DeleteLinkForDifferentDID(t *testing.T) {
 	did, err := core.ParseDID(iden.Identifier)
 	require.NoError(t, err)
 
-	schemaSrv := services.NewSchema(repositories.NewSchema(*storage), loader.HTTPFactory)
+	schemaSrv := services.NewSchema(repositories.NewSchema(*storage), loader.HTTPFactory, "http://localhost", nil)
 	importedSchema, err := schemaSrv.ImportSchema(ctx, *did, url, schemaType)
 	assert.NoError(t, err)
 
@@ -3438,11 +4482,11 @@ func TestServer_DeleteLinkForDifferentDID(t *testing.T) {
 	require.NoError(t, err)
 
 	cfg.APIUI.IssuerDID = *did2
-	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), connectionsService, linkService, NewPublisherMock(), NewPackageManagerMock(), nil)
+	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), connectionsService, linkService, NewCredentialTemplateMock(), NewImportMock(), NewChangesMock(), NewJobQueueMock(), NewJWTCredentialMock(), NewIssuerProfileMock(), NewOfferEmailMock(), NewAgentInboxMock(), NewPublisherMock(), NewPackageManagerMock(), nil)
 
 	validUntil := common.ToPointer(time.Date(2023, 8, 15, 14, 30, 45, 100, time.Local))
 	credentialExpiration := common.ToPointer(time.Date(2025, 8, 15, 14, 30, 45, 100, time.Local))
-	link, err := linkService.Save(ctx, *did, common.ToPointer(10), validUntil, importedSchema.ID, credentialExpiration, true, true, domain.CredentialSubject{"birthday": 19791109, "documentType": 12})
+	link, err := linkService.Save(ctx, *did, common.ToPointer(10), validUntil, importedSchema.ID, credentialExpiration, true, true, domain.CredentialSubject{"birthday": 19791109, "documentType": 12}, nil, nil, nil, false)
 	assert.NoError(t, err)
 	handler := getHandler(ctx, server)
 
@@ -3525,14 +4569,14 @@ func TestServer_CreateLinkQRCode(t *testing.T) {
 	connectionsRepository := repositories.NewConnections()
 	linkRepository := repositories.NewLink(*storage)
 	schemaRepository := repositories.NewSchema(*storage)
-	sessionRepository := repositories.NewSessionCached(cachex)
+	sessionRepository := repositories.NewSessionCached(cachex, 0)
 	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, claimsRepo, revocationRepository, connectionsRepository, storage, rhsp, nil, nil, pubsub.NewMock())
 	schemaLoader := loader.CachedFactory(loader.HTTPFactory, cachex)
 	claimsConf := services.ClaimCfg{
 		RHSEnabled: false,
 		Host:       "http://host",
 	}
-	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf, pubsub.NewMock())
+	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf)
 	connectionsService := services.NewConnection(connectionsRepository, storage)
 	linkService := services.NewLinkService(storage, claimsService, claimsRepo, linkRepository, schemaRepository, loader.HTTPFactory, sessionRepository, pubsub.NewMock())
 	iden, err := identityService.Create(ctx, method, blockchain, network, "polygon-test")
@@ -3541,22 +4585,23 @@ func TestServer_CreateLinkQRCode(t *testing.T) {
 	did, err := core.ParseDID(iden.Identifier)
 	require.NoError(t, err)
 
-	schemaSrv := services.NewSchema(repositories.NewSchema(*storage), loader.HTTPFactory)
+	schemaSrv := services.NewSchema(repositories.NewSchema(*storage), loader.HTTPFactory, "http://localhost", nil)
 	importedSchema, err := schemaSrv.ImportSchema(ctx, *did, url, schemaType)
 	assert.NoError(t, err)
 
 	cfg.APIUI.IssuerDID = *did
 	cfg.APIUI.ServerURL = "http://localhost/issuer-admin"
 
-	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), connectionsService, linkService, NewPublisherMock(), NewPackageManagerMock(), nil)
+	issuerProfileService := services.NewIssuerProfile(repositories.NewIssuerProfile(), storage, services.IssuerProfileCfg{DisplayName: "my issuer"})
+	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), connectionsService, linkService, NewCredentialTemplateMock(), NewImportMock(), NewChangesMock(), NewJobQueueMock(), NewJWTCredentialMock(), issuerProfileService, NewOfferEmailMock(), NewAgentInboxMock(), NewPublisherMock(), NewPackageManagerMock(), nil)
 
 	validUntil := common.ToPointer(time.Date(2023, 8, 15, 14, 30, 45, 0, time.Local))
 	credentialExpiration := common.ToPointer(time.Date(2025, 8, 15, 14, 30, 45, 0, time.Local))
-	link, err := linkService.Save(ctx, *did, common.ToPointer(10), validUntil, importedSchema.ID, credentialExpiration, true, true, domain.CredentialSubject{"birthday": 19791109, "documentType": 12})
+	link, err := linkService.Save(ctx, *did, common.ToPointer(10), validUntil, importedSchema.ID, credentialExpiration, true, true, domain.CredentialSubject{"birthday": 19791109, "documentType": 12}, nil, nil, nil, false)
 	assert.NoError(t, err)
 
 	yesterday := time.Now().Add(-24 * time.Hour)
-	linkExpired, err := linkService.Save(ctx, *did, common.ToPointer(10), &yesterday, importedSchema.ID, nil, true, true, domain.CredentialSubject{"birthday": 19791109, "documentType": 12})
+	linkExpired, err := linkService.Save(ctx, *did, common.ToPointer(10), &yesterday, importedSchema.ID, nil, true, true, domain.CredentialSubject{"birthday": 19791109, "documentType": 12}, nil, nil, nil, false)
 	require.NoError(t, err)
 
 	handler := getHandler(ctx, server)
@@ -3632,6 +4677,7 @@ func TestServer_CreateLinkQRCode(t *testing.T) {
 				assert.NotNil(t, response.SessionID)
 				assert.Equal(t, tc.expected.linkDetail.Id, response.LinkDetail.Id)
 				assert.Equal(t, tc.expected.linkDetail.SchemaType, response.LinkDetail.SchemaType)
+				assert.Equal(t, "my issuer", response.Issuer.DisplayName)
 			case http.StatusNotFound:
 				var response CreateLinkQrCode404JSONResponse
 				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
@@ -3660,14 +4706,14 @@ func TestServer_GetLinkQRCode(t *testing.T) {
 	connectionsRepository := repositories.NewConnections()
 	linkRepository := repositories.NewLink(*storage)
 	schemaRepository := repositories.NewSchema(*storage)
-	sessionRepository := repositories.NewSessionCached(cachex)
+	sessionRepository := repositories.NewSessionCached(cachex, 0)
 	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, claimsRepo, revocationRepository, connectionsRepository, storage, rhsp, nil, nil, pubsub.NewMock())
 	schemaLoader := loader.CachedFactory(loader.HTTPFactory, cachex)
 	claimsConf := services.ClaimCfg{
 		RHSEnabled: false,
 		Host:       "http://host",
 	}
-	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf, pubsub.NewMock())
+	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf)
 	connectionsService := services.NewConnection(connectionsRepository, storage)
 	linkService := services.NewLinkService(storage, claimsService, claimsRepo, linkRepository, schemaRepository, loader.HTTPFactory, sessionRepository, pubsub.NewMock())
 	iden, err := identityService.Create(ctx, method, blockchain, network, "polygon-test")
@@ -3676,18 +4722,19 @@ func TestServer_GetLinkQRCode(t *testing.T) {
 	did, err := core.ParseDID(iden.Identifier)
 	require.NoError(t, err)
 
-	schemaSrv := services.NewSchema(repositories.NewSchema(*storage), loader.HTTPFactory)
+	schemaSrv := services.NewSchema(repositories.NewSchema(*storage), loader.HTTPFactory, "http://localhost", nil)
 	importedSchema, err := schemaSrv.ImportSchema(ctx, *did, url, schemaType)
 	assert.NoError(t, err)
 
 	cfg.APIUI.IssuerDID = *did
 	cfg.APIUI.ServerURL = "http://localhost/issuer-admin"
 
-	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), connectionsService, linkService, NewPublisherMock(), NewPackageManagerMock(), nil)
+	issuerProfileService := services.NewIssuerProfile(repositories.NewIssuerProfile(), storage, services.IssuerProfileCfg{DisplayName: "my issuer"})
+	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), connectionsService, linkService, NewCredentialTemplateMock(), NewImportMock(), NewChangesMock(), NewJobQueueMock(), NewJWTCredentialMock(), issuerProfileService, NewOfferEmailMock(), NewAgentInboxMock(), NewPublisherMock(), NewPackageManagerMock(), nil)
 
 	validUntil := common.ToPointer(time.Date(2023, 8, 15, 14, 30, 45, 0, time.Local))
 	credentialExpiration := common.ToPointer(time.Date(2025, 8, 15, 14, 30, 45, 0, time.Local))
-	link, err := linkService.Save(ctx, *did, common.ToPointer(10), validUntil, importedSchema.ID, credentialExpiration, true, true, domain.CredentialSubject{"birthday": 19791109, "documentType": 12})
+	link, err := linkService.Save(ctx, *did, common.ToPointer(10), validUntil, importedSchema.ID, credentialExpiration, true, true, domain.CredentialSubject{"birthday": 19791109, "documentType": 12}, nil, nil, nil, false)
 	assert.NoError(t, err)
 	handler := getHandler(ctx, server)
 
@@ -3726,6 +4773,7 @@ func TestServer_GetLinkQRCode(t *testing.T) {
 		id        uuid.UUID
 		sessionID uuid.UUID
 		state     *linkState.State
+		wait      int
 		expected  expected
 	}
 
@@ -3767,6 +4815,17 @@ func TestServer_GetLinkQRCode(t *testing.T) {
 				status:   "pending",
 			},
 		},
+		{
+			name:      "Pending state with wait timing out",
+			sessionID: sessionID,
+			id:        link.ID,
+			state:     linkState.NewStatePending(),
+			wait:      1,
+			expected: expected{
+				httpCode: http.StatusOK,
+				status:   "pending",
+			},
+		},
 		{
 			name:      "Happy path",
 			id:        link.ID,
@@ -3779,6 +4838,19 @@ func TestServer_GetLinkQRCode(t *testing.T) {
 				httpCode:   http.StatusOK,
 			},
 		},
+		{
+			name:      "Happy path with wait",
+			id:        link.ID,
+			sessionID: sessionID,
+			state:     linkState.NewStateDone(qrcode),
+			wait:      30,
+			expected: expected{
+				linkDetail: linkDetail,
+				qrCode:     qrcode,
+				status:     "done",
+				httpCode:   http.StatusOK,
+			},
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			if tc.state != nil {
@@ -3788,6 +4860,9 @@ func TestServer_GetLinkQRCode(t *testing.T) {
 
 			rr := httptest.NewRecorder()
 			url := fmt.Sprintf("/v1/credentials/links/%s/qrcode?sessionID=%s", tc.id, tc.sessionID)
+			if tc.wait > 0 {
+				url = fmt.Sprintf("%s&wait=%d", url, tc.wait)
+			}
 			req, err := http.NewRequest(http.MethodGet, url, tests.JSONBody(t, nil))
 			require.NoError(t, err)
 			handler.ServeHTTP(rr, req)
@@ -3804,6 +4879,8 @@ func TestServer_GetLinkQRCode(t *testing.T) {
 					assert.Equal(t, tc.expected.qrCode.Body.Credentials[0].ID, response.QrCode.Body.Credentials[0].Id)
 					assert.Equal(t, tc.expected.qrCode.Body.Credentials[0].Description, response.QrCode.Body.Credentials[0].Description)
 					assert.Equal(t, tc.expected.qrCode.Body.URL, response.QrCode.Body.Url)
+					require.NotNil(t, response.QrCode.Body.Issuer)
+					assert.Equal(t, "my issuer", response.QrCode.Body.Issuer.DisplayName)
 					assert.NotNil(t, response.QrCode.Id)
 					assert.Equal(t, tc.expected.qrCode.Type, response.QrCode.Type)
 					assert.Equal(t, tc.expected.qrCode.Typ, response.QrCode.Typ)
@@ -3838,7 +4915,7 @@ func TestServer_GetStateStatus(t *testing.T) {
 		RHSEnabled: false,
 		Host:       "http://host",
 	}
-	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf, pubsub.NewMock())
+	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf)
 	connectionsService := services.NewConnection(connectionsRepository, storage)
 	schema := "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json"
 	credentialSubject := map[string]any{
@@ -3855,7 +4932,7 @@ func TestServer_GetStateStatus(t *testing.T) {
 	require.NoError(t, err)
 
 	cfg.APIUI.IssuerDID = *did
-	server := NewServer(&cfg, identityService, claimsService, NewSchemaMock(), connectionsService, NewLinkMock(), NewPublisherMock(), NewPackageManagerMock(), nil)
+	server := NewServer(&cfg, identityService, claimsService, NewSchemaMock(), connectionsService, NewLinkMock(), NewCredentialTemplateMock(), NewImportMock(), NewChangesMock(), NewJobQueueMock(), NewJWTCredentialMock(), NewIssuerProfileMock(), NewOfferEmailMock(), NewAgentInboxMock(), NewPublisherMock(), NewPackageManagerMock(), nil)
 
 	handler := getHandler(ctx, server)
 
@@ -3888,7 +4965,7 @@ func TestServer_GetStateStatus(t *testing.T) {
 			cleanUp: func() {
 				cred, err := claimsService.Save(ctx, ports.NewCreateClaimRequest(did, schema, credentialSubject, nil, typeC, nil, nil, &merklizedRootPosition, common.ToPointer(true), common.ToPointer(true), nil, true))
 				require.NoError(t, err)
-				require.NoError(t, claimsService.Revoke(ctx, cfg.APIUI.IssuerDID, uint64(cred.RevNonce), "not valid"))
+				require.NoError(t, claimsService.Revoke(ctx, cfg.APIUI.IssuerDID, uint64(cred.RevNonce), domain.RevocationUnspecified, "not valid"))
 			},
 		},
 		{
@@ -3918,6 +4995,8 @@ func TestServer_GetStateStatus(t *testing.T) {
 				var response GetStateStatus200JSONResponse
 				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
 				assert.Equal(t, tc.expected.response.PendingActions, response.PendingActions)
+				require.NotNil(t, response.EstimatedCost)
+				assert.Equal(t, "60000000", response.EstimatedCost.Cost)
 				tc.cleanUp()
 			}
 		})
@@ -3945,7 +5024,7 @@ func TestServer_GetStateTransactions(t *testing.T) {
 		RHSEnabled: false,
 		Host:       "http://host",
 	}
-	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf, pubsub.NewMock())
+	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf)
 	connectionsService := services.NewConnection(connectionsRepository, storage)
 	iden, err := identityService.Create(ctx, method, blockchain, network, "polygon-test")
 	require.NoError(t, err)
@@ -3954,7 +5033,7 @@ func TestServer_GetStateTransactions(t *testing.T) {
 	require.NoError(t, err)
 
 	cfg.APIUI.IssuerDID = *did
-	server := NewServer(&cfg, identityService, claimsService, NewSchemaMock(), connectionsService, NewLinkMock(), NewPublisherMock(), NewPackageManagerMock(), nil)
+	server := NewServer(&cfg, identityService, claimsService, NewSchemaMock(), connectionsService, NewLinkMock(), NewCredentialTemplateMock(), NewImportMock(), NewChangesMock(), NewJobQueueMock(), NewJWTCredentialMock(), NewIssuerProfileMock(), NewOfferEmailMock(), NewAgentInboxMock(), NewPublisherMock(), NewPackageManagerMock(), nil)
 
 	handler := getHandler(ctx, server)
 
@@ -4034,8 +5113,7 @@ func TestServer_GetRevocationStatus(t *testing.T) {
 		RHSEnabled: false,
 		Host:       "http://host",
 	}
-	pubSub := pubsub.NewMock()
-	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf, pubSub)
+	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf)
 	connectionsService := services.NewConnection(connectionsRepository, storage)
 	iden, err := identityService.Create(ctx, method, blockchain, network, "polygon-test")
 	require.NoError(t, err)
@@ -4044,7 +5122,7 @@ func TestServer_GetRevocationStatus(t *testing.T) {
 	require.NoError(t, err)
 
 	cfg.APIUI.IssuerDID = *did
-	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), connectionsService, NewLinkMock(), NewPublisherMock(), NewPackageManagerMock(), nil)
+	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), connectionsService, NewLinkMock(), NewCredentialTemplateMock(), NewImportMock(), NewChangesMock(), NewJobQueueMock(), NewJWTCredentialMock(), NewIssuerProfileMock(), NewOfferEmailMock(), NewAgentInboxMock(), NewPublisherMock(), NewPackageManagerMock(), nil)
 
 	credentialSubject := map[string]any{
 		"id":           "did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ",
@@ -4106,3 +5184,887 @@ func TestServer_GetRevocationStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestServer_GetRevocationList(t *testing.T) {
+	const (
+		method     = "polygonid"
+		blockchain = "polygon"
+		network    = "mumbai"
+	)
+	ctx := log.NewContext(context.Background(), log.LevelDebug, log.OutputText, os.Stdout)
+	identityRepo := repositories.NewIdentity()
+	claimsRepo := repositories.NewClaims()
+	identityStateRepo := repositories.NewIdentityState()
+	mtRepo := repositories.NewIdentityMerkleTreeRepository()
+	mtService := services.NewIdentityMerkleTrees(mtRepo)
+	revocationRepository := repositories.NewRevocation()
+	rhsp := reverse_hash.NewRhsPublisher(nil, false)
+	connectionsRepository := repositories.NewConnections()
+	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, claimsRepo, revocationRepository, connectionsRepository, storage, rhsp, nil, nil, pubsub.NewMock())
+	iden, err := identityService.Create(ctx, method, blockchain, network, "polygon-test")
+	require.NoError(t, err)
+
+	did, err := core.ParseDID(iden.Identifier)
+	require.NoError(t, err)
+	require.NoError(t, claimsRepo.RevokeNonces(ctx, storage.Pgx, did, []domain.RevNonceUint64{9, 2}, domain.RevocationUnspecified, ""))
+
+	cfg.APIUI.IssuerDID = *did
+	server := NewServer(&cfg, identityService, NewClaimsMock(), NewSchemaMock(), NewConnectionsMock(), NewLinkMock(), NewCredentialTemplateMock(), NewImportMock(), NewChangesMock(), NewJobQueueMock(), NewJWTCredentialMock(), NewIssuerProfileMock(), NewOfferEmailMock(), NewAgentInboxMock(), NewPublisherMock(), NewPackageManagerMock(), nil)
+	handler := getHandler(ctx, server)
+
+	type expected struct {
+		httpCode int
+		nonces   []uint64
+		encoded  bool
+	}
+	type testConfig struct {
+		name     string
+		query    string
+		expected expected
+	}
+
+	for _, tc := range []testConfig{
+		{
+			name: "should get the revoked nonces",
+			expected: expected{
+				httpCode: http.StatusOK,
+				nonces:   []uint64{2, 9},
+			},
+		},
+		{
+			name:  "should get the revoked nonces since now",
+			query: "?since=" + url.QueryEscape(time.Now().Add(time.Minute).Format(time.RFC3339)),
+			expected: expected{
+				httpCode: http.StatusOK,
+				nonces:   []uint64{},
+			},
+		},
+		{
+			name:  "should get the bitstring",
+			query: "?format=bitstring",
+			expected: expected{
+				httpCode: http.StatusOK,
+				encoded:  true,
+			},
+		},
+		{
+			name:  "should get an error, bitstring with since",
+			query: "?format=bitstring&since=" + url.QueryEscape(time.Now().Format(time.RFC3339)),
+			expected: expected{
+				httpCode: http.StatusBadRequest,
+			},
+		},
+		{
+			name:  "should get an error, wrong format",
+			query: "?format=csv",
+			expected: expected{
+				httpCode: http.StatusBadRequest,
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			req, err := http.NewRequest("GET", "/v1/credentials/revoked"+tc.query, nil)
+			require.NoError(t, err)
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.expected.httpCode, rr.Code)
+			if tc.expected.httpCode != http.StatusOK {
+				return
+			}
+			var response GetRevocationList200JSONResponse
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+			assert.Equal(t, did.String(), response.Issuer)
+			if tc.expected.encoded {
+				assert.Nil(t, response.Nonces)
+				require.NotNil(t, response.EncodedList)
+				assert.NotEmpty(t, *response.EncodedList)
+				return
+			}
+			assert.Nil(t, response.EncodedList)
+			require.NotNil(t, response.Nonces)
+			assert.Equal(t, tc.expected.nonces, *response.Nonces)
+		})
+	}
+}
+
+func TestServer_CredentialTemplates(t *testing.T) {
+	const (
+		method     = "polygonid"
+		blockchain = "polygon"
+		network    = "mumbai"
+		url        = "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json"
+		schemaType = "KYCAgeCredential"
+	)
+	ctx := log.NewContext(context.Background(), log.LevelDebug, log.OutputText, os.Stdout)
+	identityRepo := repositories.NewIdentity()
+	claimsRepo := repositories.NewClaims()
+	identityStateRepo := repositories.NewIdentityState()
+	mtRepo := repositories.NewIdentityMerkleTreeRepository()
+	mtService := services.NewIdentityMerkleTrees(mtRepo)
+	revocationRepository := repositories.NewRevocation()
+	rhsp := reverse_hash.NewRhsPublisher(nil, false)
+	connectionsRepository := repositories.NewConnections()
+	schemaRepository := repositories.NewSchema(*storage)
+	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, claimsRepo, revocationRepository, connectionsRepository, storage, rhsp, nil, nil, pubsub.NewMock())
+	schemaLoader := loader.CachedFactory(loader.HTTPFactory, cachex)
+	claimsConf := services.ClaimCfg{
+		RHSEnabled: false,
+		Host:       "http://host",
+	}
+	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf)
+	templateService := services.NewCredentialTemplate(repositories.NewCredentialTemplate(), schemaRepository, claimsService, storage)
+	iden, err := identityService.Create(ctx, method, blockchain, network, "polygon-test")
+	require.NoError(t, err)
+	did, err := core.ParseDID(iden.Identifier)
+	require.NoError(t, err)
+
+	schemaSrv := services.NewSchema(schemaRepository, loader.HTTPFactory, "http://localhost", nil)
+	importedSchema, err := schemaSrv.ImportSchema(ctx, *did, url, schemaType)
+	require.NoError(t, err)
+
+	cfg.APIUI.IssuerDID = *did
+	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), NewConnectionsMock(), NewLinkMock(), templateService, NewImportMock(), NewChangesMock(), NewJobQueueMock(), NewJWTCredentialMock(), NewIssuerProfileMock(), NewOfferEmailMock(), NewAgentInboxMock(), NewPublisherMock(), NewPackageManagerMock(), nil)
+	handler := getHandler(ctx, server)
+
+	serve := func(method string, path string, body any) *httptest.ResponseRecorder {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(method, path, tests.JSONBody(t, body))
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("create", func(t *testing.T) {
+		for _, tc := range []struct {
+			name     string
+			body     CreateCredentialTemplateRequest
+			httpCode int
+		}{
+			{
+				name:     "without proof types",
+				body:     CreateCredentialTemplateRequest{Name: "no proofs", SchemaID: importedSchema.ID},
+				httpCode: http.StatusBadRequest,
+			},
+			{
+				name:     "unknown schema",
+				body:     CreateCredentialTemplateRequest{Name: "unknown schema", SchemaID: uuid.New(), SignatureProof: true},
+				httpCode: http.StatusNotFound,
+			},
+			{
+				name: "valid template",
+				body: CreateCredentialTemplateRequest{
+					Name:              "KYC call center",
+					SchemaID:          importedSchema.ID,
+					CredentialSubject: &CredentialSubject{"documentType": 2},
+					ExpirationDays:    common.ToPointer(365),
+					SignatureProof:    true,
+				},
+				httpCode: http.StatusCreated,
+			},
+			{
+				name:     "duplicated name",
+				body:     CreateCredentialTemplateRequest{Name: "KYC call center", SchemaID: importedSchema.ID, SignatureProof: true},
+				httpCode: http.StatusConflict,
+			},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				rr := serve(http.MethodPost, "/v1/credentials/templates", tc.body)
+				require.Equal(t, tc.httpCode, rr.Code)
+			})
+		}
+	})
+
+	rr := serve(http.MethodGet, "/v1/credentials/templates", nil)
+	require.Equal(t, http.StatusOK, rr.Code)
+	var templates GetCredentialTemplates200JSONResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &templates))
+	require.Len(t, templates, 1)
+	template := templates[0]
+	assert.Equal(t, "KYC call center", template.Name)
+	assert.Equal(t, url, template.SchemaUrl)
+	assert.Equal(t, schemaType, template.SchemaType)
+	assert.Equal(t, common.ToPointer(365), template.ExpirationDays)
+
+	t.Run("create credential from template", func(t *testing.T) {
+		holder := "did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ"
+		rr := serve(http.MethodPost, fmt.Sprintf("/v1/credentials/from-template/%s", template.Id), CreateCredentialFromTemplateRequest{
+			CredentialSubject: CredentialSubject{"id": holder, "birthday": 19960424},
+		})
+		require.Equal(t, http.StatusCreated, rr.Code)
+		var response CreateCredentialFromTemplate201JSONResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+
+		claim, err := claimsService.GetByID(ctx, did, uuid.MustParse(response.Id))
+		require.NoError(t, err)
+		vc, err := claim.GetVerifiableCredential()
+		require.NoError(t, err)
+		assert.Equal(t, float64(2), vc.CredentialSubject["documentType"])
+		require.NotNil(t, vc.Expiration)
+		assert.True(t, vc.Expiration.After(time.Now().AddDate(0, 0, 364)))
+
+		rr = serve(http.MethodPost, fmt.Sprintf("/v1/credentials/from-template/%s", template.Id), CreateCredentialFromTemplateRequest{
+			CredentialSubject: CredentialSubject{"id": holder},
+		})
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		var badRequest CreateCredentialFromTemplate400JSONResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &badRequest))
+		require.NotNil(t, badRequest.Errors)
+		assert.Equal(t, []CredentialSubjectError{{Field: "birthday", Message: "is required"}}, *badRequest.Errors)
+
+		rr = serve(http.MethodPost, fmt.Sprintf("/v1/credentials/from-template/%s", uuid.New()), CreateCredentialFromTemplateRequest{
+			CredentialSubject: CredentialSubject{"id": holder, "birthday": 19960424},
+		})
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	rr = serve(http.MethodDelete, fmt.Sprintf("/v1/credentials/templates/%s", template.Id), nil)
+	require.Equal(t, http.StatusOK, rr.Code)
+	rr = serve(http.MethodGet, fmt.Sprintf("/v1/credentials/templates/%s", template.Id), nil)
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+}
+
+func TestServer_ImportCredentials(t *testing.T) {
+	const (
+		method     = "polygonid"
+		blockchain = "polygon"
+		network    = "mumbai"
+		url        = "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json"
+		schemaType = "KYCAgeCredential"
+	)
+	ctx := log.NewContext(context.Background(), log.LevelDebug, log.OutputText, os.Stdout)
+	identityRepo := repositories.NewIdentity()
+	claimsRepo := repositories.NewClaims()
+	identityStateRepo := repositories.NewIdentityState()
+	mtRepo := repositories.NewIdentityMerkleTreeRepository()
+	mtService := services.NewIdentityMerkleTrees(mtRepo)
+	revocationRepository := repositories.NewRevocation()
+	rhsp := reverse_hash.NewRhsPublisher(nil, false)
+	connectionsRepository := repositories.NewConnections()
+	linkRepository := repositories.NewLink(*storage)
+	schemaRepository := repositories.NewSchema(*storage)
+	sessionRepository := repositories.NewSessionCached(cachex, 0)
+	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, claimsRepo, revocationRepository, connectionsRepository, storage, rhsp, nil, nil, pubsub.NewMock())
+	schemaLoader := loader.CachedFactory(loader.HTTPFactory, cachex)
+	claimsConf := services.ClaimCfg{
+		RHSEnabled: false,
+		Host:       "http://host",
+	}
+	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf)
+	linkService := services.NewLinkService(storage, claimsService, claimsRepo, linkRepository, schemaRepository, loader.HTTPFactory, sessionRepository, pubsub.NewMock())
+	jobQueue := services.NewJobQueue(repositories.NewJob(), storage, services.JobQueueCfg{})
+	importService := services.NewImport(repositories.NewImportJob(), jobQueue, schemaRepository, claimsService, linkService, schemaLoader, storage)
+	jobQueue.Register(domain.JobKindImport, importService.Process)
+	iden, err := identityService.Create(ctx, method, blockchain, network, "polygon-test")
+	require.NoError(t, err)
+	did, err := core.ParseDID(iden.Identifier)
+	require.NoError(t, err)
+
+	schemaSrv := services.NewSchema(schemaRepository, loader.HTTPFactory, "http://localhost", nil)
+	importedSchema, err := schemaSrv.ImportSchema(ctx, *did, url, schemaType)
+	require.NoError(t, err)
+
+	cfg.APIUI.IssuerDID = *did
+	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), NewConnectionsMock(), linkService, NewCredentialTemplateMock(), importService, NewChangesMock(), jobQueue, NewJWTCredentialMock(), NewIssuerProfileMock(), NewOfferEmailMock(), NewAgentInboxMock(), NewPublisherMock(), NewPackageManagerMock(), nil)
+	handler := getHandler(ctx, server)
+
+	upload := func(fields map[string]string, file *string) *httptest.ResponseRecorder {
+		var body bytes.Buffer
+		w := multipart.NewWriter(&body)
+		for name, value := range fields {
+			require.NoError(t, w.WriteField(name, value))
+		}
+		if file != nil {
+			part, err := w.CreateFormFile("file", "credentials.csv")
+			require.NoError(t, err)
+			_, err = part.Write([]byte(*file))
+			require.NoError(t, err)
+		}
+		require.NoError(t, w.Close())
+
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodPost, "/v1/credentials/imports", &body)
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", w.FormDataContentType())
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("invalid imports", func(t *testing.T) {
+		valid := "employee,birth date,documentType\nemp-1,19960424,2\n"
+		for _, tc := range []struct {
+			name     string
+			fields   map[string]string
+			file     *string
+			httpCode int
+		}{
+			{
+				name:     "missing file",
+				fields:   map[string]string{"schemaID": importedSchema.ID.String(), "mode": "links", "signatureProof": "true"},
+				httpCode: http.StatusBadRequest,
+			},
+			{
+				name:     "unknown mode",
+				fields:   map[string]string{"schemaID": importedSchema.ID.String(), "mode": "emails", "signatureProof": "true"},
+				file:     &valid,
+				httpCode: http.StatusBadRequest,
+			},
+			{
+				name:     "unknown attribute",
+				fields:   map[string]string{"schemaID": importedSchema.ID.String(), "mode": "links", "signatureProof": "true", "referenceColumn": "employee"},
+				file:     &valid,
+				httpCode: http.StatusBadRequest,
+			},
+			{
+				name:     "credentials without holder",
+				fields:   map[string]string{"schemaID": importedSchema.ID.String(), "mode": "claims", "signatureProof": "true", "referenceColumn": "employee", "mapping": `{"birth date": "birthday"}`},
+				file:     &valid,
+				httpCode: http.StatusBadRequest,
+			},
+			{
+				name:     "unknown schema",
+				fields:   map[string]string{"schemaID": uuid.New().String(), "mode": "links", "signatureProof": "true", "referenceColumn": "employee", "mapping": `{"birth date": "birthday"}`},
+				file:     &valid,
+				httpCode: http.StatusNotFound,
+			},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				rr := upload(tc.fields, tc.file)
+				assert.Equal(t, tc.httpCode, rr.Code, rr.Body.String())
+			})
+		}
+	})
+
+	file := "employee,birth date,documentType\nemp-1,19960424,2\nemp-2,19960424,two\nemp-1,19960424,1\n"
+	rr := upload(map[string]string{
+		"schemaID":        importedSchema.ID.String(),
+		"mode":            "links",
+		"signatureProof":  "true",
+		"referenceColumn": "employee",
+		"mapping":         `{"birth date": "birthday"}`,
+	}, &file)
+	require.Equal(t, http.StatusAccepted, rr.Code, rr.Body.String())
+	var job ImportCredentials202JSONResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &job))
+	assert.Equal(t, 3, job.TotalRows)
+
+	// the rows are processed by the import job of the queue
+	require.Eventually(t, func() bool {
+		_, err := jobQueue.Work(ctx)
+		require.NoError(t, err)
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/v1/credentials/imports/%s", job.Id), nil)
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code)
+		var status GetImportJob200JSONResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &status))
+		if status.Status != ImportJobStatusCompleted {
+			return false
+		}
+		assert.Equal(t, 3, status.ProcessedRows)
+		assert.Equal(t, 2, status.FailedRows)
+		assert.Equal(t, 100, status.Progress)
+		return true
+	}, 30*time.Second, 100*time.Millisecond)
+
+	rr = httptest.NewRecorder()
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/v1/credentials/imports/%s/report?onlyErrors=true", job.Id), nil)
+	require.NoError(t, err)
+	req.SetBasicAuth(authOk())
+	handler.ServeHTTP(rr, req)
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "text/csv", rr.Header().Get("Content-Type"))
+	assert.Equal(t, "row,reference,status,credential_id,link_id,error\n"+
+		"2,emp-2,failed,,,\"documentType: \"\"two\"\" is not an integer\"\n"+
+		"3,emp-1,failed,,,reference already used in row 1\n", rr.Body.String())
+
+	t.Run("jobs", func(t *testing.T) {
+		get := func(method, path string) *httptest.ResponseRecorder {
+			rr := httptest.NewRecorder()
+			req, err := http.NewRequest(method, path, nil)
+			require.NoError(t, err)
+			req.SetBasicAuth(authOk())
+			handler.ServeHTTP(rr, req)
+			return rr
+		}
+
+		rr := get(http.MethodGet, "/v1/jobs?kind=import&status=completed")
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var jobs GetJobs200JSONResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &jobs))
+		require.Len(t, jobs, 1)
+		assert.Equal(t, domain.JobKindImport, jobs[0].Kind)
+		assert.Equal(t, JobStatusCompleted, jobs[0].Status)
+		assert.Equal(t, 1, jobs[0].Attempts)
+		assert.NotNil(t, jobs[0].FinishedAt)
+
+		rr = get(http.MethodGet, "/v1/jobs?status=dead")
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		assert.JSONEq(t, "[]", rr.Body.String())
+
+		rr = get(http.MethodGet, fmt.Sprintf("/v1/jobs/%s", jobs[0].Id))
+		assert.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		rr = get(http.MethodGet, fmt.Sprintf("/v1/jobs/%s", uuid.New()))
+		assert.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+
+		rr = get(http.MethodPost, fmt.Sprintf("/v1/jobs/%s/cancel", jobs[0].Id))
+		assert.Equal(t, http.StatusConflict, rr.Code, rr.Body.String())
+		rr = get(http.MethodPost, fmt.Sprintf("/v1/jobs/%s/retry", jobs[0].Id))
+		assert.Equal(t, http.StatusConflict, rr.Code, rr.Body.String())
+		rr = get(http.MethodPost, fmt.Sprintf("/v1/jobs/%s/retry", uuid.New()))
+		assert.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+	})
+
+	rr = httptest.NewRecorder()
+	req, err = http.NewRequest(http.MethodGet, fmt.Sprintf("/v1/credentials/imports/%s", uuid.New()), nil)
+	require.NoError(t, err)
+	req.SetBasicAuth(authOk())
+	handler.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+}
+
+func TestServer_LinkFunnel(t *testing.T) {
+	const (
+		method     = "polygonid"
+		blockchain = "polygon"
+		network    = "mumbai"
+		url        = "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json"
+		schemaType = "KYCAgeCredential"
+	)
+	ctx := log.NewContext(context.Background(), log.LevelDebug, log.OutputText, os.Stdout)
+	identityRepo := repositories.NewIdentity()
+	claimsRepo := repositories.NewClaims()
+	identityStateRepo := repositories.NewIdentityState()
+	mtRepo := repositories.NewIdentityMerkleTreeRepository()
+	mtService := services.NewIdentityMerkleTrees(mtRepo)
+	revocationRepository := repositories.NewRevocation()
+	rhsp := reverse_hash.NewRhsPublisher(nil, false)
+	connectionsRepository := repositories.NewConnections()
+	linkRepository := repositories.NewLink(*storage)
+	schemaRepository := repositories.NewSchema(*storage)
+	sessionRepository := repositories.NewSessionCached(cachex, 0)
+	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, claimsRepo, revocationRepository, connectionsRepository, storage, rhsp, nil, nil, pubsub.NewMock())
+	schemaLoader := loader.CachedFactory(loader.HTTPFactory, cachex)
+	claimsConf := services.ClaimCfg{
+		RHSEnabled: false,
+		Host:       "http://host",
+	}
+	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf)
+	linkService := services.NewLinkService(storage, claimsService, claimsRepo, linkRepository, schemaRepository, loader.HTTPFactory, sessionRepository, pubsub.NewMock())
+	iden, err := identityService.Create(ctx, method, blockchain, network, "polygon-test")
+	require.NoError(t, err)
+	did, err := core.ParseDID(iden.Identifier)
+	require.NoError(t, err)
+
+	schemaSrv := services.NewSchema(schemaRepository, loader.HTTPFactory, "http://localhost", nil)
+	importedSchema, err := schemaSrv.ImportSchema(ctx, *did, url, schemaType)
+	require.NoError(t, err)
+	link, err := linkService.Save(ctx, *did, nil, nil, importedSchema.ID, nil, true, false, domain.CredentialSubject{"birthday": 19791109, "documentType": 12}, nil, nil, nil, false)
+	require.NoError(t, err)
+
+	cfg.APIUI.IssuerDID = *did
+	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), NewConnectionsMock(), linkService, NewCredentialTemplateMock(), NewImportMock(), NewChangesMock(), NewJobQueueMock(), NewJWTCredentialMock(), NewIssuerProfileMock(), NewOfferEmailMock(), NewAgentInboxMock(), NewPublisherMock(), NewPackageManagerMock(), nil)
+	handler := getHandler(ctx, server)
+
+	serve := func(path string) *httptest.ResponseRecorder {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, path, nil)
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	for i := 0; i < 2; i++ {
+		_, err := linkService.CreateQRCode(ctx, *did, link.ID, "http://localhost", nil)
+		require.NoError(t, err)
+	}
+
+	rr := serve(fmt.Sprintf("/v1/credentials/links/%s/funnel", link.ID))
+	require.Equal(t, http.StatusOK, rr.Code)
+	var funnel GetLinkFunnel200JSONResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &funnel))
+	assert.Equal(t, link.ID, funnel.LinkID)
+	assert.Equal(t, 2, funnel.QrFetched)
+	assert.Equal(t, 0, funnel.AuthCompleted)
+	assert.Empty(t, funnel.Failures)
+
+	rr = serve(fmt.Sprintf("/v1/credentials/links/funnel?linkID=%s", link.ID))
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "text/csv", rr.Header().Get("Content-Type"))
+	lines := strings.Split(strings.TrimSpace(rr.Body.String()), "\n")
+	require.Len(t, lines, 3)
+	assert.Equal(t, "date,link_id,session,step,failure_reason", lines[0])
+	assert.Contains(t, lines[1], link.ID.String())
+	assert.Contains(t, lines[1], ",qr_fetched,")
+
+	rr = serve(fmt.Sprintf("/v1/credentials/links/%s/funnel", uuid.New()))
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+
+	rr = serve(fmt.Sprintf("/v1/credentials/links/%s/analytics?interval=hour", link.ID))
+	require.Equal(t, http.StatusOK, rr.Code)
+	var analytics GetLinkAnalytics200JSONResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &analytics))
+	assert.Equal(t, LinkAnalyticsIntervalHour, analytics.Interval)
+	require.Len(t, analytics.Buckets, 30)
+	assert.Equal(t, 2, analytics.Buckets[29].QrDisplayed)
+	assert.Equal(t, 0, analytics.Buckets[29].Issued)
+	assert.Equal(t, 0, analytics.Buckets[0].QrDisplayed)
+
+	rr = serve(fmt.Sprintf("/v1/credentials/links/%s/analytics", link.ID))
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &analytics))
+	assert.Equal(t, LinkAnalyticsIntervalDay, analytics.Interval)
+	assert.Len(t, analytics.Buckets, 30)
+
+	rr = serve(fmt.Sprintf("/v1/credentials/links/%s/analytics?interval=month", link.ID))
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	rr = serve(fmt.Sprintf("/v1/credentials/links/%s/analytics?interval=hour&from=2020-01-01T00:00:00Z", link.ID))
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	rr = serve(fmt.Sprintf("/v1/credentials/links/%s/analytics", uuid.New()))
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+}
+
+func TestServer_LinkCodes(t *testing.T) {
+	const (
+		method     = "polygonid"
+		blockchain = "polygon"
+		network    = "mumbai"
+		url        = "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json"
+		schemaType = "KYCAgeCredential"
+	)
+	ctx := log.NewContext(context.Background(), log.LevelDebug, log.OutputText, os.Stdout)
+	claimsRepo := repositories.NewClaims()
+	identityStateRepo := repositories.NewIdentityState()
+	mtRepo := repositories.NewIdentityMerkleTreeRepository()
+	mtService := services.NewIdentityMerkleTrees(mtRepo)
+	schemaRepository := repositories.NewSchema(*storage)
+	identityService := services.NewIdentity(keyStore, repositories.NewIdentity(), mtRepo, identityStateRepo, mtService, claimsRepo, repositories.NewRevocation(), repositories.NewConnections(), storage, reverse_hash.NewRhsPublisher(nil, false), nil, nil, pubsub.NewMock())
+	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, loader.CachedFactory(loader.HTTPFactory, cachex), storage, services.ClaimCfg{Host: "http://host"})
+	linkService := services.NewLinkService(storage, claimsService, claimsRepo, repositories.NewLink(*storage), schemaRepository, loader.HTTPFactory, repositories.NewSessionCached(cachex, 0), pubsub.NewMock())
+	iden, err := identityService.Create(ctx, method, blockchain, network, "polygon-test")
+	require.NoError(t, err)
+	did, err := core.ParseDID(iden.Identifier)
+	require.NoError(t, err)
+
+	importedSchema, err := services.NewSchema(schemaRepository, loader.HTTPFactory, "http://localhost", nil).ImportSchema(ctx, *did, url, schemaType)
+	require.NoError(t, err)
+	link, err := linkService.Save(ctx, *did, nil, nil, importedSchema.ID, nil, true, false, domain.CredentialSubject{"birthday": 19791109, "documentType": 12}, nil, nil, nil, true)
+	require.NoError(t, err)
+
+	cfg.APIUI.IssuerDID = *did
+	issuerProfileService := services.NewIssuerProfile(repositories.NewIssuerProfile(), storage, services.IssuerProfileCfg{DisplayName: "my issuer"})
+	server := NewServer(&cfg, NewIdentityMock(), claimsService, NewSchemaMock(), NewConnectionsMock(), linkService, NewCredentialTemplateMock(), NewImportMock(), NewChangesMock(), NewJobQueueMock(), NewJWTCredentialMock(), issuerProfileService, NewOfferEmailMock(), NewAgentInboxMock(), NewPublisherMock(), NewPackageManagerMock(), nil)
+	handler := getHandler(ctx, server)
+
+	rr := httptest.NewRecorder()
+	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("/v1/credentials/links/%s/codes", link.ID), tests.JSONBody(t, map[string]int{"count": 2}))
+	require.NoError(t, err)
+	req.SetBasicAuth(authOk())
+	handler.ServeHTTP(rr, req)
+	require.Equal(t, http.StatusCreated, rr.Code)
+	var created CreateLinkCodes201JSONResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &created))
+	require.Len(t, created.Codes, 2)
+
+	rr = httptest.NewRecorder()
+	req, err = http.NewRequest(http.MethodGet, fmt.Sprintf("/v1/credentials/links/%s/codes", link.ID), nil)
+	require.NoError(t, err)
+	req.SetBasicAuth(authOk())
+	handler.ServeHTTP(rr, req)
+	require.Equal(t, http.StatusOK, rr.Code)
+	var codes GetLinkCodes200JSONResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &codes))
+	require.Len(t, codes, 2)
+	assert.False(t, codes[0].Used)
+	assert.NotContains(t, rr.Body.String(), created.Codes[0])
+
+	qrCode := func(code string) *httptest.ResponseRecorder {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("/v1/credentials/links/%s/qrcode", link.ID), nil)
+		require.NoError(t, err)
+		if code != "" {
+			req.Header.Set("X-Link-Code", code)
+		}
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+	assert.Equal(t, http.StatusForbidden, qrCode("").Code)
+	assert.Equal(t, http.StatusForbidden, qrCode("0000-0000").Code)
+	assert.Equal(t, http.StatusOK, qrCode(created.Codes[0]).Code)
+
+	mux := chi.NewRouter()
+	RegisterPages(ctx, mux, &cfg, linkService, claimsService, issuerProfileService)
+	rr = httptest.NewRecorder()
+	req, err = http.NewRequest(http.MethodGet, fmt.Sprintf("/links/%s/page", link.ID), nil)
+	require.NoError(t, err)
+	mux.ServeHTTP(rr, req)
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), "Enter your code")
+	assert.NotContains(t, rr.Body.String(), "<svg")
+
+	rr = httptest.NewRecorder()
+	req, err = http.NewRequest(http.MethodPost, fmt.Sprintf("/links/%s/page", link.ID), strings.NewReader("code="+created.Codes[1]))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	mux.ServeHTTP(rr, req)
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), "<svg")
+}
+
+func TestServer_IssuerProfile(t *testing.T) {
+	ctx := context.Background()
+	typ, err := core.BuildDIDType(core.DIDMethodPolygonID, core.Polygon, core.Mumbai)
+	require.NoError(t, err)
+	id, err := core.IdGenesisFromIdenState(typ, big.NewInt(rand.Int63()))
+	require.NoError(t, err)
+	issuerDID, err := core.ParseDIDFromID(*id)
+	require.NoError(t, err)
+	fixture := tests.NewFixture(storage)
+	fixture.CreateIdentity(t, &domain.Identity{Identifier: issuerDID.String()})
+
+	issuerProfileService := services.NewIssuerProfile(repositories.NewIssuerProfile(), storage, services.IssuerProfileCfg{DisplayName: "my issuer", LogoURL: "https://my-issuer.com/logo.png"})
+	cfg.APIUI.IssuerDID = *issuerDID
+	server := NewServer(&cfg, NewIdentityMock(), NewClaimsMock(), NewSchemaMock(), NewConnectionsMock(), NewLinkMock(), NewCredentialTemplateMock(), NewImportMock(), NewChangesMock(), NewJobQueueMock(), NewJWTCredentialMock(), issuerProfileService, NewOfferEmailMock(), NewAgentInboxMock(), NewPublisherMock(), NewPackageManagerMock(), nil)
+	handler := getHandler(ctx, server)
+
+	serve := func(method string, body any) *httptest.ResponseRecorder {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(method, "/v1/issuer-profile", tests.JSONBody(t, body))
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	rr := serve(http.MethodGet, nil)
+	require.Equal(t, http.StatusOK, rr.Code)
+	var profile IssuerProfile
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &profile))
+	assert.Equal(t, "my issuer", profile.DisplayName)
+	assert.Equal(t, "https://my-issuer.com/logo.png", profile.LogoURL)
+	assert.False(t, profile.Overridden)
+
+	rr = serve(http.MethodPatch, UpdateIssuerProfileRequest{BackgroundColor: common.ToPointer("purple")})
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+
+	rr = serve(http.MethodPatch, UpdateIssuerProfileRequest{
+		BackgroundColor: common.ToPointer("#1a2b3c"),
+		ContactEmail:    common.ToPointer("support@my-issuer.com"),
+		LogoURL:         common.ToPointer(""),
+	})
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &profile))
+	assert.Equal(t, "my issuer", profile.DisplayName)
+	assert.Empty(t, profile.LogoURL)
+	assert.Equal(t, "#1a2b3c", profile.BackgroundColor)
+	assert.Equal(t, "support@my-issuer.com", profile.ContactEmail)
+	assert.True(t, profile.Overridden)
+
+	rr = serve(http.MethodGet, nil)
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &profile))
+	assert.Equal(t, "#1a2b3c", profile.BackgroundColor)
+	assert.True(t, profile.Overridden)
+}
+
+func TestServer_Agent(t *testing.T) {
+	ctx := log.NewContext(context.Background(), log.LevelDebug, log.OutputText, os.Stdout)
+	identityRepo := repositories.NewIdentity()
+	claimsRepo := repositories.NewClaims()
+	identityStateRepo := repositories.NewIdentityState()
+	mtRepo := repositories.NewIdentityMerkleTreeRepository()
+	mtService := services.NewIdentityMerkleTrees(mtRepo)
+	rhsp := reverse_hash.NewRhsPublisher(nil, false)
+	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, claimsRepo, repositories.NewRevocation(), repositories.NewConnections(), storage, rhsp, nil, nil, pubsub.NewMock())
+	issuerProfileService := services.NewIssuerProfile(repositories.NewIssuerProfile(), storage, services.IssuerProfileCfg{DisplayName: "my issuer"})
+	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, loader.CachedFactory(loader.HTTPFactory, cachex), storage, services.ClaimCfg{
+		Host:     "http://host",
+		Schemas:  repositories.NewSchema(*storage),
+		Profiles: issuerProfileService,
+	})
+
+	iden, err := identityService.Create(ctx, "polygonid", "polygon", "mumbai", "polygon-test")
+	require.NoError(t, err)
+	issuerDID, err := core.ParseDID(iden.Identifier)
+	require.NoError(t, err)
+	_, err = issuerProfileService.Update(ctx, *issuerDID, &ports.IssuerProfileUpdate{ContactURL: common.ToPointer("https://my-issuer.com/kyc")})
+	require.NoError(t, err)
+	schema := &domain.Schema{
+		ID:         uuid.New(),
+		IssuerDID:  *issuerDID,
+		URL:        "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json",
+		Type:       "KYCAgeCredential",
+		Attributes: domain.SchemaAttrsFromString("birthday, documentType"),
+		CreatedAt:  time.Now(),
+	}
+	schema.Hash = utils.CreateSchemaHash([]byte(schema.URL + "#" + schema.Type))
+	tests.NewFixture(storage).CreateSchema(t, ctx, schema)
+
+	encryptionKey, err := ecdsa.GenerateKey(elliptic.P256(), cryptoRand.Reader)
+	require.NoError(t, err)
+	decrypter := protocolPkg.NewDecrypter(&jose.JSONWebKeySet{Keys: []jose.JSONWebKey{{Key: encryptionKey, KeyID: "key-1"}}})
+	packageManager := iden3comm.NewPackageManager()
+	require.NoError(t, packageManager.RegisterPackers(&packers.PlainMessagePacker{}))
+
+	cfg.APIUI.IssuerDID = *issuerDID
+	server := NewServer(&cfg, identityService, claimsService, NewSchemaMock(), NewConnectionsMock(), NewLinkMock(), NewCredentialTemplateMock(), NewImportMock(), NewChangesMock(), NewJobQueueMock(), NewJWTCredentialMock(), issuerProfileService, NewOfferEmailMock(), NewAgentInboxMock(), NewPublisherMock(), packageManager, nil)
+	handler := middleware.DecryptEnvelopes(ctx, decrypter)(getHandler(ctx, server))
+
+	const holderDID = "did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ"
+	message := func(typ iden3comm.ProtocolMessage, body any) []byte {
+		payload, err := json.Marshal(map[string]any{
+			"id":   uuid.NewString(),
+			"thid": uuid.NewString(),
+			"typ":  packers.MediaTypePlainMessage,
+			"type": typ,
+			"from": holderDID,
+			"to":   issuerDID.String(),
+			"body": body,
+		})
+		require.NoError(t, err)
+		return payload
+	}
+	encrypted := func(payload []byte) []byte {
+		envelope, err := packers.NewAnoncryptPacker(nil).Pack(payload, packers.AnoncryptPackerParams{
+			RecipientKey: &jose.JSONWebKey{Key: &encryptionKey.PublicKey, KeyID: "key-1"},
+		})
+		require.NoError(t, err)
+		return envelope
+	}
+	revocationStatusRequest := message(protocol.RevocationStatusRequestMessageType, protocol.RevocationStatusRequestMessageBody{RevocationNonce: 123456789})
+	proposalRequest := message(ports.CredentialProposalRequestMessageType, ports.CredentialProposalRequestMessageBody{
+		Credentials: []ports.CredentialProposalInfo{{Type: "KYCAgeCredential"}, {Type: "KYCCountryOfResidenceCredential"}},
+	})
+
+	for _, tc := range []struct {
+		name     string
+		body     []byte
+		httpCode int
+		typ      iden3comm.ProtocolMessage
+		code     string
+	}{
+		{name: "plain revocation status request", body: revocationStatusRequest, httpCode: http.StatusOK, typ: protocol.RevocationStatusResponseMessageType},
+		{name: "encrypted revocation status request", body: encrypted(revocationStatusRequest), httpCode: http.StatusOK, typ: protocol.RevocationStatusResponseMessageType},
+		{name: "encrypted proposal request", body: encrypted(proposalRequest), httpCode: http.StatusOK, typ: ports.CredentialProposalMessageType},
+		{name: "plain credential fetch request", body: message(protocol.CredentialFetchRequestMessageType, protocol.CredentialFetchRequestMessageBody{ID: uuid.NewString()}), httpCode: http.StatusUnauthorized, code: domain.ProblemCodeUnauthorized},
+		{name: "encrypted plain credential fetch request", body: encrypted(message(protocol.CredentialFetchRequestMessageType, protocol.CredentialFetchRequestMessageBody{ID: uuid.NewString()})), httpCode: http.StatusUnauthorized, code: domain.ProblemCodeUnauthorized},
+		{name: "problem report", body: message(ports.ProblemReportMessageType, ports.ProblemReportMessageBody{Code: "e.p.xfer.cant-use-endpoint"}), httpCode: http.StatusAccepted},
+		{name: "unknown media type", body: []byte(`{"typ":"application/unknown","type":"https://iden3-communication.io/revocation/1.0/request-status"}`), httpCode: http.StatusBadRequest},
+		{name: "unknown message type", body: message("https://iden3-communication.io/proofs/1.0/request", nil), httpCode: http.StatusBadRequest},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			req, err := http.NewRequest(http.MethodPost, "/v1/agent", bytes.NewReader(tc.body))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "text/plain")
+			handler.ServeHTTP(rr, req)
+			require.Equal(t, tc.httpCode, rr.Code, rr.Body.String())
+			if tc.code != "" {
+				var report AgentProblemReport
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &report))
+				assert.Equal(t, services.ErrAgentSenderNotVerified.Error(), report.Message)
+				require.NotNil(t, report.Type)
+				assert.Equal(t, string(ports.ProblemReportMessageType), *report.Type)
+				require.NotNil(t, report.Body)
+				assert.Equal(t, tc.code, report.Body.Code)
+				assert.Equal(t, holderDID, *report.To)
+			}
+			if tc.httpCode != http.StatusOK {
+				return
+			}
+
+			var response AgentResponse
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+			assert.Equal(t, string(tc.typ), response.Type)
+			assert.Equal(t, issuerDID.String(), response.From)
+			assert.Equal(t, holderDID, response.To)
+			body, err := json.Marshal(response.Body)
+			require.NoError(t, err)
+			switch tc.typ {
+			case protocol.RevocationStatusResponseMessageType:
+				var status protocol.RevocationStatusResponseMessageBody
+				require.NoError(t, json.Unmarshal(body, &status))
+				assert.NotNil(t, status.Issuer.State)
+				assert.False(t, status.MTP.Existence)
+			case ports.CredentialProposalMessageType:
+				var proposal ports.CredentialProposalMessageBody
+				require.NoError(t, json.Unmarshal(body, &proposal))
+				require.Len(t, proposal.Proposals, 1)
+				assert.Equal(t, ports.CredentialProposal{
+					Credentials: []ports.CredentialProposalInfo{{Type: "KYCAgeCredential"}},
+					Type:        ports.CredentialProposalWebForm,
+					URL:         "https://my-issuer.com/kyc",
+					Description: "my issuer",
+				}, proposal.Proposals[0])
+			}
+		})
+	}
+
+	t.Run("agent inbox", func(t *testing.T) {
+		get := func(url string) *httptest.ResponseRecorder {
+			rr := httptest.NewRecorder()
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+			req.SetBasicAuth(authOk())
+			handler.ServeHTTP(rr, req)
+			return rr
+		}
+
+		rr := get("/v1/messages")
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var messages []AgentInboxMessage
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &messages))
+		assert.Len(t, messages, 8)
+
+		rr = get("/v1/messages?status=failed")
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &messages))
+		require.Len(t, messages, 2)
+		assert.Equal(t, string(protocol.CredentialFetchRequestMessageType), messages[0].Type)
+		assert.Equal(t, holderDID, messages[0].Sender)
+		require.NotNil(t, messages[0].ResponseType)
+		assert.Equal(t, string(ports.ProblemReportMessageType), *messages[0].ResponseType)
+		require.NotNil(t, messages[0].Error)
+		assert.Equal(t, services.ErrAgentSenderNotVerified.Error(), *messages[0].Error)
+
+		rr = get("/v1/messages?status=rejected")
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &messages))
+		require.Len(t, messages, 2)
+		assert.Equal(t, "https://iden3-communication.io/proofs/1.0/request", messages[0].Type)
+		assert.Empty(t, messages[1].Sender)
+
+		rr = get("/v1/messages/" + messages[1].Id.String() + "/envelope")
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		assert.Equal(t, `{"typ":"application/unknown","type":"https://iden3-communication.io/revocation/1.0/request-status"}`, rr.Body.String())
+		assert.Equal(t, fmt.Sprintf(`attachment; filename="%s.envelope"`, messages[1].Id), rr.Header().Get("Content-Disposition"))
+
+		rr = get("/v1/messages/" + uuid.NewString() + "/envelope")
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+		rr = get("/v1/messages?limit=0")
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("problem reports", func(t *testing.T) {
+		reports, err := claimsService.GetProblemReports(ctx, *issuerDID, ports.ProblemReportsFilter{})
+		require.NoError(t, err)
+		require.Len(t, reports, 3)
+		assert.Equal(t, domain.ProblemReportReceived, reports[0].Direction)
+		assert.Equal(t, "e.p.xfer.cant-use-endpoint", reports[0].Code)
+		assert.Equal(t, domain.ProblemReportSent, reports[1].Direction)
+		assert.Equal(t, domain.ProblemCodeUnauthorized, reports[1].Code)
+
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, "/v1/credentials/"+uuid.NewString()+"/problem-reports", nil)
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
@@ -0,0 +1,136 @@
+package api_ui
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	core "github.com/iden3/go-iden3-core"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/pkg/schema"
+)
+
+const streamFlushEvery = 100 // streamFlushEvery is the number of lines written between flushes
+
+// RegisterStreams adds the endpoints that list credentials and connections as newline delimited JSON.
+// Rows are written while they are read from the database, so these endpoints are not part of the strict server,
+// which needs the whole response before writing it.
+func RegisterStreams(ctx context.Context, mux *chi.Mux, issuerDID core.DID, auth config.APIUIAuth, claimService ports.ClaimsService, connectionsService ports.ConnectionsService) {
+	mux.Get("/v1/credentials/stream", basicAuth(auth.User, auth.Password, streamCredentials(ctx, issuerDID, claimService)))
+	mux.Get("/v1/connections/stream", basicAuth(auth.User, auth.Password, streamConnections(ctx, issuerDID, connectionsService)))
+}
+
+func streamCredentials(ctx context.Context, issuerDID core.DID, claimService ports.ClaimsService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := log.CopyFromContext(ctx, r.Context())
+		filter, err := getCredentialsFilter(ctx, queryParam(r, "did"), (*GetCredentialsParamsStatus)(queryParam(r, "status")), queryParam(r, "query"))
+		if err != nil {
+			writeJSONError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		stream, ok := newNDJSONWriter(w)
+		if !ok {
+			writeJSONError(w, http.StatusInternalServerError, "streaming is not supported")
+			return
+		}
+		err = claimService.Iterate(ctx, issuerDID, filter, func(credential *domain.Claim) error {
+			w3c, err := schema.FromClaimModelToW3CCredential(*credential)
+			if err != nil {
+				return err
+			}
+			return stream.write(credentialResponse(w3c, credential))
+		})
+		if err != nil {
+			log.Error(ctx, "streaming credentials", "err", err, "lines", stream.lines)
+		}
+		stream.close(err, "Unexpected error while streaming credentials")
+	}
+}
+
+func streamConnections(ctx context.Context, issuerDID core.DID, connectionsService ports.ConnectionsService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := log.CopyFromContext(ctx, r.Context())
+		query := ""
+		if q := queryParam(r, "query"); q != nil {
+			query = *q
+		}
+
+		stream, ok := newNDJSONWriter(w)
+		if !ok {
+			writeJSONError(w, http.StatusInternalServerError, "streaming is not supported")
+			return
+		}
+		err := connectionsService.IterateByIssuerID(ctx, issuerDID, query, func(conn *domain.Connection) error {
+			return stream.write(connectionResponse(conn, nil, nil))
+		})
+		if err != nil {
+			log.Error(ctx, "streaming connections", "err", err, "lines", stream.lines)
+		}
+		stream.close(err, "Unexpected error while streaming connections")
+	}
+}
+
+func queryParam(r *http.Request, name string) *string {
+	values, ok := r.URL.Query()[name]
+	if !ok || len(values) == 0 {
+		return nil
+	}
+	return &values[0]
+}
+
+// ndjsonWriter writes one JSON document per line. The status and headers are sent with the first line, so
+// errors found before that can still be answered with a regular error response.
+type ndjsonWriter struct {
+	w       http.ResponseWriter
+	flusher http.Flusher
+	enc     *json.Encoder
+	lines   int
+}
+
+func newNDJSONWriter(w http.ResponseWriter) (*ndjsonWriter, bool) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		return nil, false
+	}
+	return &ndjsonWriter{w: w, flusher: flusher, enc: json.NewEncoder(w)}, true
+}
+
+func (n *ndjsonWriter) write(v interface{}) error {
+	if n.lines == 0 {
+		n.writeHeader()
+	}
+	if err := n.enc.Encode(v); err != nil {
+		return err
+	}
+	n.lines++
+	if n.lines%streamFlushEvery == 0 {
+		n.flusher.Flush()
+	}
+	return nil
+}
+
+// close finishes the stream. If it failed, the error is the response when nothing was written yet and the
+// last line otherwise.
+func (n *ndjsonWriter) close(err error, message string) {
+	if err != nil {
+		if n.lines == 0 {
+			writeJSONError(n.w, http.StatusInternalServerError, message)
+			return
+		}
+		_ = n.enc.Encode(GenericErrorMessage{Message: message})
+	} else if n.lines == 0 {
+		n.writeHeader()
+	}
+	n.flusher.Flush()
+}
+
+func (n *ndjsonWriter) writeHeader() {
+	n.w.Header().Set("Content-Type", "application/x-ndjson")
+	n.w.WriteHeader(http.StatusOK)
+}
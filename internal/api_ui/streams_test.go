@@ -0,0 +1,123 @@
+package api_ui
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+)
+
+type connectionsServiceMock struct {
+	ports.ConnectionsService
+	conns []*domain.Connection
+	err   error
+}
+
+func (c *connectionsServiceMock) IterateByIssuerID(_ context.Context, _ core.DID, _ string, fn func(*domain.Connection) error) error {
+	for _, conn := range c.conns {
+		if err := fn(conn); err != nil {
+			return err
+		}
+	}
+	return c.err
+}
+
+func TestStreamConnections(t *testing.T) {
+	issuerDID, err := core.ParseDID("did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ")
+	require.NoError(t, err)
+	userDID, err := core.ParseDID("did:polygonid:polygon:mumbai:2qFDkNkWePjd6URt6kGQX14a7wVKhBZt8bpy7HZJZi")
+	require.NoError(t, err)
+	auth := config.APIUIAuth{User: "user", Password: "password"}
+
+	conns := make([]*domain.Connection, 3)
+	for i := range conns {
+		conns[i] = &domain.Connection{ID: uuid.New(), IssuerDID: *issuerDID, UserDID: *userDID, CreatedAt: time.Now()}
+	}
+
+	type expected struct {
+		httpCode int
+		lines    int
+		lastLine string
+	}
+	for _, tc := range []struct {
+		name     string
+		user     string
+		service  *connectionsServiceMock
+		expected expected
+	}{
+		{
+			name:     "no auth",
+			service:  &connectionsServiceMock{conns: conns},
+			expected: expected{httpCode: http.StatusUnauthorized, lines: 1, lastLine: `{"message":"unauthorized"}`},
+		},
+		{
+			name:     "all connections",
+			user:     auth.User,
+			service:  &connectionsServiceMock{conns: conns},
+			expected: expected{httpCode: http.StatusOK, lines: 3},
+		},
+		{
+			name:     "no connections",
+			user:     auth.User,
+			service:  &connectionsServiceMock{},
+			expected: expected{httpCode: http.StatusOK, lines: 0},
+		},
+		{
+			name:     "error before the first line",
+			user:     auth.User,
+			service:  &connectionsServiceMock{err: errors.New("db error")},
+			expected: expected{httpCode: http.StatusInternalServerError, lines: 1, lastLine: `{"message":"Unexpected error while streaming connections"}`},
+		},
+		{
+			name:     "error after the first line",
+			user:     auth.User,
+			service:  &connectionsServiceMock{conns: conns[:2], err: errors.New("db error")},
+			expected: expected{httpCode: http.StatusOK, lines: 3, lastLine: `{"message":"Unexpected error while streaming connections"}`},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			mux := chi.NewRouter()
+			RegisterStreams(context.Background(), mux, *issuerDID, auth, nil, tc.service)
+
+			req := httptest.NewRequest(http.MethodGet, "/v1/connections/stream", nil)
+			if tc.user != "" {
+				req.SetBasicAuth(tc.user, auth.Password)
+			}
+			rr := httptest.NewRecorder()
+			mux.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.expected.httpCode, rr.Code)
+			var lines []string
+			scanner := bufio.NewScanner(rr.Body)
+			for scanner.Scan() {
+				lines = append(lines, scanner.Text())
+			}
+			require.Len(t, lines, tc.expected.lines)
+			if tc.expected.lastLine != "" {
+				assert.Equal(t, tc.expected.lastLine, lines[len(lines)-1])
+				return
+			}
+			assert.Equal(t, "application/x-ndjson", rr.Header().Get("Content-Type"))
+			for i, line := range lines {
+				var resp GetConnectionResponse
+				require.NoError(t, json.Unmarshal([]byte(line), &resp))
+				assert.Equal(t, conns[i].ID.String(), resp.Id)
+				assert.Equal(t, userDID.String(), resp.UserID)
+			}
+		})
+	}
+}
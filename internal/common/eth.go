@@ -0,0 +1,29 @@
+package common
+
+import (
+	"bytes"
+
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	core "github.com/iden3/go-iden3-core"
+)
+
+// ethGenesisPadding is the number of zero bytes before the address in the genesis of an Ethereum controlled identity
+const ethGenesisPadding = 7
+
+// EthIdentityID returns the id of an identity controlled by an Ethereum address. The genesis of the id is the address
+// prefixed with zeros, so the state contract can check that the sender of a state transition owns the identity.
+func EthIdentityID(typ [2]byte, address ethCommon.Address) core.ID {
+	var genesis [27]byte
+	copy(genesis[ethGenesisPadding:], address.Bytes())
+	return core.NewID(typ, genesis)
+}
+
+// EthAddressFromID returns the Ethereum address that controls the identity. It returns false if the id was not
+// created from an Ethereum address.
+func EthAddressFromID(id core.ID) (ethCommon.Address, bool) {
+	_, genesis, _, err := core.DecomposeID(id)
+	if err != nil || !bytes.Equal(genesis[:ethGenesisPadding], make([]byte, ethGenesisPadding)) {
+		return ethCommon.Address{}, false
+	}
+	return ethCommon.BytesToAddress(genesis[ethGenesisPadding:]), true
+}
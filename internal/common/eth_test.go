@@ -0,0 +1,36 @@
+package common
+
+import (
+	"math/big"
+	"testing"
+
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	core "github.com/iden3/go-iden3-core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEthIdentityID(t *testing.T) {
+	typ, err := core.BuildDIDType(core.DIDMethodPolygonID, core.Polygon, core.Mumbai)
+	require.NoError(t, err)
+	address := ethCommon.HexToAddress("0x27E8AEB8b3E4fD5b5B6aF1dd4dC6e7f9B3F4A1C2")
+
+	id := EthIdentityID(typ, address)
+	assert.True(t, core.CheckChecksum(id))
+	assert.Equal(t, typ, id.Type())
+
+	did, err := core.ParseDIDFromID(id)
+	require.NoError(t, err)
+	parsed, err := core.ParseDID(did.String())
+	require.NoError(t, err)
+	got, ok := EthAddressFromID(parsed.ID)
+	require.True(t, ok)
+	assert.Equal(t, address, got)
+
+	state, ok := new(big.Int).SetString("b25cf54e7e648a263658416194c41ef6ae2dec101c50dfb2febc5e96eaa8711", 16)
+	require.True(t, ok)
+	genesisID, err := core.IdGenesisFromIdenState(typ, state)
+	require.NoError(t, err)
+	_, ok = EthAddressFromID(*genesisID)
+	assert.False(t, ok)
+}
@@ -3,12 +3,15 @@ package config
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"net/url"
 	"os"
+	"os/signal"
 	"path/filepath"
 	"regexp"
 	"runtime"
 	"strings"
+	"syscall"
 	"time"
 
 	core "github.com/iden3/go-iden3-core"
@@ -16,6 +19,7 @@ import (
 
 	"github.com/polygonid/sh-id-platform/internal/common"
 	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/pkg/walletlinks"
 )
 
 // CIConfigPath variable contain the CI configuration path
@@ -26,32 +30,91 @@ type Configuration struct {
 	ServerUrl                    string
 	ServerPort                   int
 	NativeProofGenerationEnabled bool
-	Database                     Database           `mapstructure:"Database"`
-	Cache                        Cache              `mapstructure:"Cache"`
-	HTTPBasicAuth                HTTPBasicAuth      `mapstructure:"HTTPBasicAuth"`
-	KeyStore                     KeyStore           `mapstructure:"KeyStore"`
-	Log                          Log                `mapstructure:"Log"`
-	ReverseHashService           ReverseHashService `mapstructure:"ReverseHashService"`
-	Ethereum                     Ethereum           `mapstructure:"Ethereum"`
-	Prover                       Prover             `mapstructure:"Prover"`
-	Circuit                      Circuit            `mapstructure:"Circuit"`
-	PublishingKeyPath            string             `mapstructure:"PublishingKeyPath"`
-	OnChainCheckStatusFrequency  time.Duration      `mapstructure:"OnChainCheckStatusFrequency"`
-	SchemaCache                  *bool              `mapstructure:"SchemaCache"`
-	APIUI                        APIUI              `mapstructure:"APIUI"`
+	Database                     Database            `mapstructure:"Database"`
+	Cache                        Cache               `mapstructure:"Cache"`
+	HTTPBasicAuth                HTTPBasicAuth       `mapstructure:"HTTPBasicAuth"`
+	KeyStore                     KeyStore            `mapstructure:"KeyStore"`
+	Log                          Log                 `mapstructure:"Log"`
+	ReverseHashService           ReverseHashService  `mapstructure:"ReverseHashService"`
+	Ethereum                     Ethereum            `mapstructure:"Ethereum"`
+	Prover                       Prover              `mapstructure:"Prover"`
+	Circuit                      Circuit             `mapstructure:"Circuit"`
+	PublishingKeyPath            string              `mapstructure:"PublishingKeyPath"`
+	OnChainCheckStatusFrequency  time.Duration       `mapstructure:"OnChainCheckStatusFrequency"`
+	ExpirationCheckFrequency     time.Duration       `mapstructure:"ExpirationCheckFrequency" tip:"Time between expired credentials checks. 0 disables the job"`
+	CredentialOfferTTL           time.Duration       `mapstructure:"CredentialOfferTTL" tip:"Time a credential offer can be used to fetch the offered credentials"`
+	AgentReplayWindow            time.Duration       `mapstructure:"AgentReplayWindow" tip:"Time the agent messages are remembered to answer their replays with the original response"`
+	IdempotencyKeyTTL            time.Duration       `mapstructure:"IdempotencyKeyTTL" tip:"Time the idempotency keys are remembered to answer the retries with the original response"`
+	DeletedRetention             time.Duration       `mapstructure:"DeletedRetention" tip:"Time the deleted credentials and connections are kept, and can be restored, before they are purged"`
+	AgentInboxRetention          time.Duration       `mapstructure:"AgentInboxRetention" tip:"Time the messages received by the agent are kept in the inbox before they are purged"`
+	ExportPassphrase             string              `mapstructure:"ExportPassphrase" tip:"Passphrase the issuer exports are encrypted with. Empty disables the exports"`
+	EncryptionKeys               string              `mapstructure:"EncryptionKeys" tip:"Path of a JWK set with the private keys that decrypt the encrypted agent messages and callbacks. Empty disables them"`
+	SchemaCache                  *bool               `mapstructure:"SchemaCache"`
+	SchemaCacheTTL               time.Duration       `mapstructure:"SchemaCacheTTL" tip:"Time the cached schemas and contexts are used before they are revalidated with their hosts"`
+	APIUI                        APIUI               `mapstructure:"APIUI"`
+	WarmUp                       WarmUp              `mapstructure:"WarmUp"`
+	FeatureFlags                 FeatureFlags        `mapstructure:"FeatureFlags"`
+	PII                          PII                 `mapstructure:"PII"`
+	CORS                         CORS                `mapstructure:"CORS"`
+	SecurityHeaders              SecurityHeaders     `mapstructure:"SecurityHeaders"`
+	RateLimit                    RateLimit           `mapstructure:"RateLimit"`
+	RequestTimeout               RequestTimeout      `mapstructure:"RequestTimeout"`
+	NetworksFile                 string              `mapstructure:"NetworksFile" tip:"Yaml file with the chain configuration of the networks supported besides the default one"`
+	SMTP                         SMTP                `mapstructure:"SMTP"`
+	Reports                      Reports             `mapstructure:"Reports"`
+	OfferEmails                  OfferEmails         `mapstructure:"OfferEmails"`
+	WalletLinks                  WalletLinks         `mapstructure:"WalletLinks"`
+	TransactionMonitor           TransactionMonitor  `mapstructure:"TransactionMonitor"`
+	Standby                      Standby             `mapstructure:"Standby"`
+	LeaderElection               LeaderElection      `mapstructure:"LeaderElection"`
+	ValidationWebhook            ValidationWebhook   `mapstructure:"ValidationWebhook"`
+	DIDResolver                  DIDResolver         `mapstructure:"DIDResolver"`
+	Publishing                   Publishing          `mapstructure:"Publishing"`
+	ProofPolicy                  ProofPolicy         `mapstructure:"ProofPolicy"`
+	IPFS                         IPFS                `mapstructure:"IPFS"`
+	Backlog                      Backlog             `mapstructure:"Backlog"`
+	RevocationDecisions          RevocationDecisions `mapstructure:"RevocationDecisions"`
+	OID4VCI                      OID4VCI             `mapstructure:"OID4VCI"`
+	JWTCredential                JWTCredential       `mapstructure:"JWTCredential"`
+	RevNonce                     RevNonce            `mapstructure:"RevNonce"`
+	GRPC                         GRPC                `mapstructure:"GRPC"`
+	EventBroker                  EventBroker         `mapstructure:"EventBroker"`
+	Outbox                       Outbox              `mapstructure:"Outbox"`
+	Jobs                         Jobs                `mapstructure:"Jobs"`
 }
 
 // Database has the database configuration
 // URL: The database connection string
+// StatementTimeout: Queries running longer than this are cancelled by the database server. 0 disables it
+// ReplicaURL: The connection string of a read replica, the listings are read from it. Empty reads them from the primary
+// MaxConns, MinConns, MaxConnLifetime, MaxConnIdleTime: Settings of the connection pools, zero keeps the pgx default
+// ListingTimeout: Listings and searches running longer than this are cancelled, so they can't hold the connections
+// the issuance needs. 0 disables it
 type Database struct {
-	URL string `mapstructure:"Url" tip:"The Datasource name locator"`
+	URL              string        `mapstructure:"Url" tip:"The Datasource name locator"`
+	StatementTimeout time.Duration `mapstructure:"StatementTimeout" tip:"Maximum duration of a query"`
+	ReplicaURL       string        `mapstructure:"ReplicaUrl" tip:"The Datasource name locator of a read replica for the listings"`
+	MaxConns         int32         `mapstructure:"MaxConns" tip:"Maximum number of connections of a pool"`
+	MinConns         int32         `mapstructure:"MinConns" tip:"Number of connections a pool keeps open"`
+	MaxConnLifetime  time.Duration `mapstructure:"MaxConnLifetime" tip:"Time after which a connection is closed"`
+	MaxConnIdleTime  time.Duration `mapstructure:"MaxConnIdleTime" tip:"Time after which an idle connection is closed"`
+	ListingTimeout   time.Duration `mapstructure:"ListingTimeout" tip:"Maximum duration of the listings and searches"`
 }
 
 // Cache configurations
 type Cache struct {
-	RedisUrl string `mapstructure:"RedisUrl" tip:"The redis url to use as a cache"`
+	RedisUrl     string        `mapstructure:"RedisUrl" tip:"The redis url to use as a cache"`
+	SessionStore string        `mapstructure:"SessionStore" tip:"Where the auth sessions are kept: redis, shared by all the replicas, or memory"`
+	SessionTTL   time.Duration `mapstructure:"SessionTTL" tip:"Time an auth session can be answered"`
+	StateTTL     time.Duration `mapstructure:"StateTTL" tip:"Time the latest identity states and revocation statuses are cached, 0 disables it"`
 }
 
+// Session stores
+const (
+	SessionStoreRedis  = "redis"
+	SessionStoreMemory = "memory"
+)
+
 // ReverseHashService contains the reverse hash service properties
 type ReverseHashService struct {
 	URL     string `mapstructure:"Url" tip:"Reverse Hash Service address"`
@@ -62,6 +125,7 @@ type ReverseHashService struct {
 type Ethereum struct {
 	URL                    string        `tip:"Ethereum url"`
 	ContractAddress        string        `tip:"Contract Address"`
+	ChainID                int64         `tip:"Chain ID of the network. If set, the node checks the RPC serves that chain"`
 	DefaultGasLimit        int           `tip:"Default Gas Limit"`
 	ConfirmationTimeout    time.Duration `tip:"Confirmation timeout"`
 	ConfirmationBlockCount int64         `tip:"Confirmation block count"`
@@ -72,6 +136,252 @@ type Ethereum struct {
 	WaitReceiptCycleTime   time.Duration `tip:"Wait Receipt Cycle Time"`
 	WaitBlockCycleTime     time.Duration `tip:"Wait Block Cycle Time"`
 	ResolverPrefix         string        `tip:"blockchain:network e.g polygon:mumbai"`
+	GasStrategy            string        `tip:"Fees of the state transactions: oracle, fixed or percentile"`
+	MaxPriorityFeePerGas   int           `tip:"Max priority fee per gas in wei of the fixed gas strategy"`
+	MaxFeePerGas           int           `tip:"Max fee per gas in wei of the fixed gas strategy"`
+	GasPercentileBlocks    int           `tip:"Number of recent blocks of the percentile gas strategy"`
+	GasPercentile          float64       `tip:"Percentile of the priority fees paid in the recent blocks of the percentile gas strategy"`
+	RelayerType            string        `tip:"Relayer of the state transitions: empty to send them with the publishing key, http or forwarder"`
+	RelayerURL             string        `tip:"Url the state transitions are posted to"`
+	RelayerAPIKey          string        `tip:"Bearer token of the relayer"`
+	RelayerForwarder       string        `tip:"EIP-2771 forwarder contract of the forwarder relayer"`
+}
+
+// FeatureFlags holds the values of the features for the identities that do not override them with the admin API.
+// The reverse hash service feature takes the value of ReverseHashService.Enabled.
+type FeatureFlags struct {
+	AsyncIssuance bool `mapstructure:"AsyncIssuance" tip:"Issue credentials in the background"`
+	OID4VCI       bool `mapstructure:"OID4VCI" tip:"Allow OpenID for Verifiable Credential Issuance"`
+	TestMode      bool `mapstructure:"TestMode" tip:"Mark identities as test identities"`
+	StatusList    bool `mapstructure:"StatusList" tip:"Add a StatusList2021 entry to the JWT credentials"`
+}
+
+// PII configures the credential subject fields that hold personal data. They are always masked in the logs and,
+// with MaskListings, in the responses that list credentials and links. Detail endpoints return the full value.
+type PII struct {
+	Fields       []string `mapstructure:"Fields" tip:"Comma separated list of sensitive credential subject fields"`
+	MaskListings bool     `mapstructure:"MaskListings" tip:"Mask the sensitive fields in the responses that list credentials and links"`
+}
+
+// WarmUp configures an optional warm-up phase run at startup. While it runs, the server reports itself as not ready
+// and rejects requests so the first calls after a deploy do not hit cold caches.
+type WarmUp struct {
+	Enabled bool          `mapstructure:"Enabled" tip:"Run a warm-up phase before accepting traffic"`
+	Timeout time.Duration `mapstructure:"Timeout" tip:"Maximum duration of the warm-up phase"`
+}
+
+// TransactionMonitor configures the resubmission of state transactions that stay pending. A stuck transaction is
+// sent again with the same nonce and its fees increased by GasBumpPercent, up to MaxAttempts submissions.
+type TransactionMonitor struct {
+	StuckTimeout   time.Duration `mapstructure:"StuckTimeout" tip:"Time a state transaction can stay pending before it is resubmitted"`
+	GasBumpPercent int           `mapstructure:"GasBumpPercent" tip:"Fee increase percentage of every resubmission, at least 10"`
+	MaxAttempts    int           `mapstructure:"MaxAttempts" tip:"Maximum number of submissions of a state transaction"`
+}
+
+// Standby configures the node as the standby of another one. The node must be connected to a streaming replica of
+// the primary database, it stays passive while the database is a replica and becomes active once it is promoted.
+type Standby struct {
+	Enabled        bool          `mapstructure:"Enabled" tip:"Run as a standby while the database is a replica"`
+	CheckFrequency time.Duration `mapstructure:"CheckFrequency" tip:"Time between checks of the database promotion"`
+}
+
+// LeaderElection configures the redis lease that lets a single replica of the pending publisher and the
+// notifications service run the background workers. Another replica takes over when the lease is not renewed.
+type LeaderElection struct {
+	LeaseTTL time.Duration `mapstructure:"LeaseTTL" tip:"Time the lease of the leader lasts without being renewed"`
+}
+
+// Publishing is the publishing policy of the identities without their own. The pending publisher checks the pending
+// changes of every identity every CheckFrequency and publishes the states that are due.
+type Publishing struct {
+	Mode             string        `mapstructure:"Mode" tip:"When states are published: manual, immediate, interval or threshold"`
+	Interval         time.Duration `mapstructure:"Interval" tip:"Minimum time between states of the interval mode, max wait of the threshold mode"`
+	Threshold        int           `mapstructure:"Threshold" tip:"Pending claims and revocations that trigger a state in threshold mode"`
+	RevocationWindow time.Duration `mapstructure:"RevocationWindow" tip:"Time the pending revocations wait to be published together. 0 publishes them as the rest of changes"`
+	CheckFrequency   time.Duration `mapstructure:"CheckFrequency" tip:"Time between checks of the pending changes"`
+}
+
+// ProofPolicy configures the proof types of the credentials. The credentials created without proof types get the
+// default ones. The credentials of a schema with a rule, matched by url or credential type, can only have the proof
+// types of the rule, the rest of schemas allow any.
+type ProofPolicy struct {
+	Default []string `mapstructure:"Default" tip:"Comma separated list of the default proof types: BJJSignature2021 and Iden3SparseMerkleTreeProof"`
+	Allowed []string `mapstructure:"Allowed" tip:"Comma separated list of schema=proof types rules, the proof types separated by |"`
+}
+
+// IPFS configures the resolution of ipfs:// schemas through an http gateway and the pinning of the built schemas.
+// Pinning is done by an IPFS node, or the Infura IPFS API, with the node pinner and by Pinata with the pinata pinner.
+type IPFS struct {
+	GatewayURL   string `mapstructure:"GatewayURL" tip:"IPFS http gateway the ipfs:// schemas are fetched from"`
+	Pinner       string `mapstructure:"Pinner" tip:"Service that pins the built schemas: empty to disable pinning, node or pinata"`
+	NodeURL      string `mapstructure:"NodeURL" tip:"IPFS node or Infura IPFS API url of the node pinner"`
+	NodeUser     string `mapstructure:"NodeUser" tip:"Basic auth user of the IPFS node, the project id in Infura"`
+	NodePassword string `mapstructure:"NodePassword" tip:"Basic auth password of the IPFS node, the project secret in Infura"`
+	PinataURL    string `mapstructure:"PinataURL" tip:"Pinata API url"`
+	PinataJWT    string `mapstructure:"PinataJWT" tip:"Pinata API JWT"`
+}
+
+// Backlog configures the thresholds over which the pending actions backlog (unpublished claims, resubmitted state
+// transactions and the age of the oldest pending action) is reported as degraded by the health endpoint.
+// A zero threshold is disabled. Alerts are emailed to the recipients when the backlog degrades and when it recovers.
+type Backlog struct {
+	MaxUnpublishedClaims int           `mapstructure:"MaxUnpublishedClaims" tip:"Maximum number of claims waiting to be published"`
+	MaxPendingRetries    int           `mapstructure:"MaxPendingRetries" tip:"Maximum number of pending state transactions that were resubmitted"`
+	MaxPendingAge        time.Duration `mapstructure:"MaxPendingAge" tip:"Maximum time an action can be waiting to be published"`
+	AlertRecipients      []string      `mapstructure:"AlertRecipients" tip:"Comma separated list of backlog alert recipients"`
+}
+
+// ValidationWebhook configures the calls to the validation webhooks of the schemas
+type ValidationWebhook struct {
+	Timeout     time.Duration `mapstructure:"Timeout" tip:"Maximum duration of a call to a validation webhook"`
+	ApprovalTTL time.Duration `mapstructure:"ApprovalTTL" tip:"Time an approval is reused for the same credential subject, 0 disables it"`
+}
+
+// DIDResolver configures the universal resolver of the DIDs, that checks the subject DIDs of the credentials and gets
+// the DID documents of the holders for the notifications. Without a URL the subject DIDs are only checked to be
+// well-formed, and the notifications use the document the holder sent when it connected.
+type DIDResolver struct {
+	URL      string        `mapstructure:"URL" tip:"Universal resolver of the subject DIDs of the credentials and of the holders. Empty disables resolution"`
+	Timeout  time.Duration `mapstructure:"Timeout" tip:"Maximum duration of a DID resolution"`
+	CacheTTL time.Duration `mapstructure:"CacheTTL" tip:"Time a resolved DID is not resolved again, 0 disables it"`
+}
+
+// RevocationDecisions configures the polling of the revocation decisions of an external system, like a fraud engine
+// or an HR system. Nothing is polled without a URL, the decisions can still be pushed to the API.
+type RevocationDecisions struct {
+	URL           string        `mapstructure:"URL" tip:"Endpoint polled for the revocation decisions of the identities. Empty disables polling"`
+	Token         string        `mapstructure:"Token" tip:"Bearer token sent to the revocation decisions endpoint"`
+	Source        string        `mapstructure:"Source" tip:"Name of the external system the polled decisions are recorded with"`
+	PollFrequency time.Duration `mapstructure:"PollFrequency" tip:"Time between polls of the revocation decisions endpoint"`
+	Timeout       time.Duration `mapstructure:"Timeout" tip:"Maximum duration of a poll of the revocation decisions endpoint"`
+}
+
+// OID4VCI configures the OpenID4VCI pre-authorized code flow the wallets without iden3comm get credentials with
+type OID4VCI struct {
+	OfferExpiration time.Duration `mapstructure:"OfferExpiration" tip:"Time the pre-authorized code of an offer can be exchanged for an access token"`
+	TokenExpiration time.Duration `mapstructure:"TokenExpiration" tip:"Time an access token can be used to get the credential of its offer"`
+}
+
+// JWTCredential configures the serialization of the credentials as JWT VCs
+type JWTCredential struct {
+	Algorithm string `mapstructure:"Algorithm" tip:"JWS algorithm of the JWT credentials: ES256 or EdDSA"`
+}
+
+// RevNonce configures the revocation nonces of the new credentials. Random nonces do not reveal how many credentials
+// an issuer has, sequential ones count them from 1 and are easier to audit.
+type RevNonce struct {
+	Strategy string `mapstructure:"Strategy" tip:"Revocation nonces of the new credentials: random or sequential"`
+}
+
+// GRPC configures the gRPC API of the issuer services. It is served with TLS and disabled when Port is 0.
+type GRPC struct {
+	Port     int    `mapstructure:"Port" tip:"Port of the gRPC API. 0 disables it"`
+	CertFile string `mapstructure:"CertFile" tip:"TLS certificate file of the gRPC API"`
+	KeyFile  string `mapstructure:"KeyFile" tip:"TLS private key file of the gRPC API"`
+}
+
+// EventBroker configures the message broker where the issuance lifecycle events are sent. Events are not sent when
+// Type is empty.
+type EventBroker struct {
+	Type        string `mapstructure:"Type" tip:"Message broker of the issuance events: kafka or nats. Empty disables the events"`
+	URL         string `mapstructure:"URL" tip:"Comma separated addresses of the kafka brokers, or url of the nats server"`
+	TopicPrefix string `mapstructure:"TopicPrefix" tip:"Prefix of the kafka topics or nats subjects of the events"`
+}
+
+// Outbox configures the relay of the events written to the outbox: the issuance lifecycle events of the broker and
+// the notifications to the holders.
+type Outbox struct {
+	CheckFrequency time.Duration `mapstructure:"CheckFrequency" tip:"How often the outbox is checked for events to send"`
+	BatchSize      int           `mapstructure:"BatchSize" tip:"Maximum number of events sent at once"`
+	Retention      time.Duration `mapstructure:"Retention" tip:"How long the sent events are kept in the outbox"`
+}
+
+// Jobs configures the background jobs queue: the bulk imports and the confirmation of the published states
+type Jobs struct {
+	CheckFrequency time.Duration `mapstructure:"CheckFrequency" tip:"How often the workers look for due jobs"`
+	MaxAttempts    int           `mapstructure:"MaxAttempts" tip:"Attempts of a job before it goes to the dead letter"`
+	LockTimeout    time.Duration `mapstructure:"LockTimeout" tip:"Time without heartbeats after which a running job is sent back to the queue"`
+	Retention      time.Duration `mapstructure:"Retention" tip:"How long the completed and cancelled jobs are kept"`
+}
+
+// CORS holds the cross-origin resource sharing configuration of the http servers.
+// When no origins are configured every origin is allowed.
+type CORS struct {
+	AllowedOrigins   []string      `mapstructure:"AllowedOrigins" tip:"Comma separated list of allowed origins. Empty allows every origin"`
+	AllowedMethods   []string      `mapstructure:"AllowedMethods" tip:"Comma separated list of allowed methods"`
+	AllowedHeaders   []string      `mapstructure:"AllowedHeaders" tip:"Comma separated list of allowed request headers"`
+	ExposedHeaders   []string      `mapstructure:"ExposedHeaders" tip:"Comma separated list of response headers exposed to the browser"`
+	AllowCredentials bool          `mapstructure:"AllowCredentials" tip:"Allow requests with credentials (cookies, authorization headers)"`
+	MaxAge           time.Duration `mapstructure:"MaxAge" tip:"How long browsers can cache preflight responses"`
+}
+
+// SecurityHeaders configures the security related headers added to every response.
+// HSTS is only sent when HSTSMaxAge is greater than 0, so it has to be enabled explicitly for deployments served
+// over https.
+type SecurityHeaders struct {
+	HSTSMaxAge            time.Duration `mapstructure:"HSTSMaxAge" tip:"Strict-Transport-Security max-age. 0 disables the header"`
+	HSTSIncludeSubdomains bool          `mapstructure:"HSTSIncludeSubdomains" tip:"Add includeSubDomains to the Strict-Transport-Security header"`
+}
+
+// RateLimit configures the requests rate limits of the http servers. Requests authenticated with the server basic auth
+// credentials take tokens from one bucket per user and the rest of requests from one bucket per client IP.
+type RateLimit struct {
+	Enabled           bool            `mapstructure:"Enabled" tip:"Limit the requests rate"`
+	TrustProxyHeaders bool            `mapstructure:"TrustProxyHeaders" tip:"Take the client IP from the X-Forwarded-For or X-Real-IP headers"`
+	Authenticated     RateLimitBucket `mapstructure:"Authenticated" tip:"Limits of the requests authenticated with basic auth"`
+	Anonymous         RateLimitBucket `mapstructure:"Anonymous" tip:"Limits of the anonymous requests, like the agent and QR code ones"`
+}
+
+// RateLimitBucket defines a token bucket
+type RateLimitBucket struct {
+	RequestsPerMinute int `mapstructure:"RequestsPerMinute" tip:"Sustained number of requests per minute"`
+	Burst             int `mapstructure:"Burst" tip:"Maximum number of requests in a burst"`
+}
+
+// OfferEmails configures the credential offers emailed to the holders from the UI API. The emails are sent from the
+// SMTP sender address, through the SMTP server or SendGrid.
+type OfferEmails struct {
+	Enabled             bool   `mapstructure:"Enabled" tip:"Send credential offers by email"`
+	Provider            string `mapstructure:"Provider" tip:"Email provider of the offers (smtp or sendgrid)"`
+	SendGridAPIKey      string `mapstructure:"SendGridAPIKey" tip:"SendGrid API key of the sendgrid provider"`
+	Subject             string `mapstructure:"Subject" tip:"Template of the subject of the offer emails"`
+	Template            string `mapstructure:"Template" tip:"Path of the html template of the offer emails. Empty uses the default one"`
+	ClaimPageURL        string `mapstructure:"ClaimPageURL" tip:"Page the claim link of the emails redirects to, with {credentialID} replaced. Empty redirects to the wallet universal link"`
+	PerRecipientPerHour int    `mapstructure:"PerRecipientPerHour" tip:"Offer emails sent to the same address per hour"`
+}
+
+// WalletLinks configures the links that open the QR code messages in the wallets of the holders. The links are urls
+// with the {message} placeholder, replaced by the base64 encoded message.
+type WalletLinks struct {
+	DeepLink      string `mapstructure:"DeepLink" tip:"Deep link of the wallets, with the {message} placeholder"`
+	UniversalLink string `mapstructure:"UniversalLink" tip:"Universal link of the wallet, with the {message} placeholder"`
+}
+
+// RequestTimeout configures how long the http servers wait for the requests of each route class before canceling
+// them with a 504 error.
+type RequestTimeout struct {
+	Read     time.Duration `mapstructure:"Read" tip:"Timeout of the reading requests"`
+	Issuance time.Duration `mapstructure:"Issuance" tip:"Timeout of the issuance and the rest of writing requests"`
+	Publish  time.Duration `mapstructure:"Publish" tip:"Timeout of the state publishing requests"`
+}
+
+// SMTP has the mail server used to send emails. User and password are optional.
+type SMTP struct {
+	Host     string `mapstructure:"Host" tip:"SMTP server host"`
+	Port     int    `mapstructure:"Port" tip:"SMTP server port"`
+	User     string `mapstructure:"User" tip:"SMTP username"`
+	Password string `mapstructure:"Password" tip:"SMTP password"`
+	From     string `mapstructure:"From" tip:"Sender address of the emails"`
+}
+
+// Reports configures the activity reports (issued and revoked credentials and failed state transitions) emailed to
+// a distribution list. Daily reports are sent every day at the given UTC hour and weekly reports on mondays.
+type Reports struct {
+	Enabled    bool     `mapstructure:"Enabled" tip:"Send scheduled reports"`
+	Frequency  string   `mapstructure:"Frequency" tip:"Report frequency (daily or weekly)"`
+	Hour       int      `mapstructure:"Hour" tip:"UTC hour of the day the report is sent"`
+	Format     string   `mapstructure:"Format" tip:"Report format (csv or html)"`
+	Recipients []string `mapstructure:"Recipients" tip:"Comma separated list of report recipients"`
 }
 
 // Prover struct
@@ -85,11 +395,38 @@ type Circuit struct {
 	Path string `tip:"Circuit path"`
 }
 
+// Key store providers
+const (
+	KeyStoreProviderVault = "vault"
+	KeyStoreProviderAWS   = "aws"
+	KeyStoreProviderGCP   = "gcp"
+	KeyStoreProviderFile  = "file"
+)
+
 // KeyStore defines the keystore
 type KeyStore struct {
+	Provider             string `tip:"Key store provider: vault, aws or file"`
 	Address              string `tip:"Keystore address"`
 	Token                string `tip:"Token"`
+	AuthMethod           string `tip:"Vault auth method: token, approle or kubernetes"`
+	AuthMountPath        string `tip:"Mount path of the vault auth method. Defaults to the name of the method"`
+	AppRoleID            string `tip:"Vault AppRole role ID"`
+	AppRoleSecretID      string `tip:"Vault AppRole secret ID"`
+	KubernetesRole       string `tip:"Vault role of the Kubernetes auth method"`
+	KubernetesTokenPath  string `tip:"Kubernetes service account token file. Defaults to the one mounted in the pod"`
 	PluginIden3MountPath string `tip:"PluginIden3MountPath"`
+	AWSRegion            string `tip:"AWS region of the KMS and Secrets Manager used by the aws provider"`
+	AWSAccessKey         string `tip:"AWS access key ID"`
+	AWSSecretKey         string `tip:"AWS secret access key"`
+	AWSSessionToken      string `tip:"AWS session token, only for temporary credentials"`
+	AWSEndpoint          string `tip:"AWS endpoint that replaces the regional ones, for local testing"`
+	ETHProvider          string `tip:"Key store provider of the Ethereum keys: vault, aws or gcp. Defaults to Provider"`
+	GCPKeyRing           string `tip:"Google Cloud KMS key ring of the Ethereum keys, projects/<project>/locations/<location>/keyRings/<ring>"`
+	GCPCredentialsFile   string `tip:"Google service account key file. If empty, the credentials are taken from the metadata server"`
+	GCPProtectionLevel   string `tip:"Protection level of the new Google Cloud KMS keys: HSM or SOFTWARE"`
+	GCPEndpoint          string `tip:"Google Cloud KMS endpoint, for local testing"`
+	FilePath             string `tip:"Directory of the encrypted key files used by the file provider"`
+	FilePassphrase       string `tip:"Passphrase the key files are encrypted with"`
 }
 
 // Log holds runtime configurations
@@ -174,6 +511,10 @@ func (c *Configuration) SanitizeAPIUI() error {
 
 	c.APIUI.IssuerDID = *issuerDID
 
+	if c.Cache.SessionStore != SessionStoreRedis && c.Cache.SessionStore != SessionStoreMemory {
+		return fmt.Errorf("unknown session store <%s>", c.Cache.SessionStore)
+	}
+
 	return nil
 }
 
@@ -234,6 +575,32 @@ func Load(fileName string) (*Configuration, error) {
 	return config, nil
 }
 
+// WatchReload loads the configuration again every time the process gets a SIGHUP and calls reload with it, until
+// ctx is done. Only the config file is read again: the environment of a running process does not change, so the
+// settings given in ISSUER_* variables keep their values. Only the runtime settings take the new values, the rest of
+// the configuration needs a restart.
+func WatchReload(ctx context.Context, fileName string, reload func(cfg *Configuration)) {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	go func() {
+		defer signal.Stop(hup)
+		for {
+			select {
+			case <-hup:
+				log.Info(ctx, "reloading the configuration")
+				cfg, err := Load(fileName)
+				if err != nil {
+					log.Error(ctx, "cannot reload the configuration", "err", err)
+					continue
+				}
+				reload(cfg)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
 // VaultTest returns the vault configuration to be used in tests.
 // The vault token is obtained from environment vars.
 // If there is not env var, it will try to parse the init.out file
@@ -287,8 +654,23 @@ func bindEnv() {
 	_ = viper.BindEnv("NativeProofGenerationEnabled", "ISSUER_NATIVE_PROOF_GENERATION_ENABLED")
 	_ = viper.BindEnv("PublishingKeyPath", "ISSUER_PUBLISH_KEY_PATH")
 	_ = viper.BindEnv("OnChainCheckStatusFrequency", "ISSUER_ONCHAIN_CHECK_STATUS_FREQUENCY")
+	_ = viper.BindEnv("ExpirationCheckFrequency", "ISSUER_CREDENTIAL_EXPIRATION_CHECK_FREQUENCY")
+	_ = viper.BindEnv("CredentialOfferTTL", "ISSUER_CREDENTIAL_OFFER_TTL")
+	_ = viper.BindEnv("AgentReplayWindow", "ISSUER_AGENT_REPLAY_WINDOW")
+	_ = viper.BindEnv("IdempotencyKeyTTL", "ISSUER_IDEMPOTENCY_KEY_TTL")
+	_ = viper.BindEnv("DeletedRetention", "ISSUER_DELETED_RETENTION")
+	_ = viper.BindEnv("AgentInboxRetention", "ISSUER_AGENT_INBOX_RETENTION")
+	_ = viper.BindEnv("ExportPassphrase", "ISSUER_EXPORT_PASSPHRASE")
+	_ = viper.BindEnv("EncryptionKeys", "ISSUER_ENCRYPTION_KEYS")
 
 	_ = viper.BindEnv("Database.URL", "ISSUER_DATABASE_URL")
+	_ = viper.BindEnv("Database.StatementTimeout", "ISSUER_DATABASE_STATEMENT_TIMEOUT")
+	_ = viper.BindEnv("Database.ReplicaURL", "ISSUER_DATABASE_REPLICA_URL")
+	_ = viper.BindEnv("Database.MaxConns", "ISSUER_DATABASE_MAX_CONNS")
+	_ = viper.BindEnv("Database.MinConns", "ISSUER_DATABASE_MIN_CONNS")
+	_ = viper.BindEnv("Database.MaxConnLifetime", "ISSUER_DATABASE_MAX_CONN_LIFETIME")
+	_ = viper.BindEnv("Database.MaxConnIdleTime", "ISSUER_DATABASE_MAX_CONN_IDLE_TIME")
+	_ = viper.BindEnv("Database.ListingTimeout", "ISSUER_DATABASE_LISTING_TIMEOUT")
 
 	_ = viper.BindEnv("Log.Level", "ISSUER_LOG_LEVEL")
 	_ = viper.BindEnv("Log.Mode", "ISSUER_LOG_MODE")
@@ -298,13 +680,33 @@ func bindEnv() {
 
 	_ = viper.BindEnv("KeyStore.Address", "ISSUER_KEY_STORE_ADDRESS")
 	_ = viper.BindEnv("KeyStore.Token", "ISSUER_KEY_STORE_TOKEN")
+	_ = viper.BindEnv("KeyStore.AuthMethod", "ISSUER_KEY_STORE_AUTH_METHOD")
+	_ = viper.BindEnv("KeyStore.AuthMountPath", "ISSUER_KEY_STORE_AUTH_MOUNT_PATH")
+	_ = viper.BindEnv("KeyStore.AppRoleID", "ISSUER_KEY_STORE_APPROLE_ROLE_ID")
+	_ = viper.BindEnv("KeyStore.AppRoleSecretID", "ISSUER_KEY_STORE_APPROLE_SECRET_ID")
+	_ = viper.BindEnv("KeyStore.KubernetesRole", "ISSUER_KEY_STORE_KUBERNETES_ROLE")
+	_ = viper.BindEnv("KeyStore.KubernetesTokenPath", "ISSUER_KEY_STORE_KUBERNETES_TOKEN_PATH")
 	_ = viper.BindEnv("KeyStore.PluginIden3MountPath", "ISSUER_KEY_STORE_PLUGIN_IDEN3_MOUNT_PATH")
+	_ = viper.BindEnv("KeyStore.Provider", "ISSUER_KEY_STORE_PROVIDER")
+	_ = viper.BindEnv("KeyStore.AWSRegion", "ISSUER_KEY_STORE_AWS_REGION")
+	_ = viper.BindEnv("KeyStore.AWSAccessKey", "ISSUER_KEY_STORE_AWS_ACCESS_KEY")
+	_ = viper.BindEnv("KeyStore.AWSSecretKey", "ISSUER_KEY_STORE_AWS_SECRET_KEY")
+	_ = viper.BindEnv("KeyStore.AWSSessionToken", "ISSUER_KEY_STORE_AWS_SESSION_TOKEN")
+	_ = viper.BindEnv("KeyStore.AWSEndpoint", "ISSUER_KEY_STORE_AWS_ENDPOINT")
+	_ = viper.BindEnv("KeyStore.ETHProvider", "ISSUER_KEY_STORE_ETH_PROVIDER")
+	_ = viper.BindEnv("KeyStore.GCPKeyRing", "ISSUER_KEY_STORE_GCP_KEY_RING")
+	_ = viper.BindEnv("KeyStore.GCPCredentialsFile", "ISSUER_KEY_STORE_GCP_CREDENTIALS_FILE")
+	_ = viper.BindEnv("KeyStore.GCPProtectionLevel", "ISSUER_KEY_STORE_GCP_PROTECTION_LEVEL")
+	_ = viper.BindEnv("KeyStore.GCPEndpoint", "ISSUER_KEY_STORE_GCP_ENDPOINT")
+	_ = viper.BindEnv("KeyStore.FilePath", "ISSUER_KEY_STORE_FILE_PATH")
+	_ = viper.BindEnv("KeyStore.FilePassphrase", "ISSUER_KEY_STORE_FILE_PASSPHRASE")
 
 	_ = viper.BindEnv("ReverseHashService.URL", "ISSUER_REVERSE_HASH_SERVICE_URL")
 	_ = viper.BindEnv("ReverseHashService.Enabled", "ISSUER_REVERSE_HASH_SERVICE_ENABLED")
 
 	_ = viper.BindEnv("Ethereum.URL", "ISSUER_ETHEREUM_URL")
 	_ = viper.BindEnv("Ethereum.ContractAddress", "ISSUER_ETHEREUM_CONTRACT_ADDRESS")
+	_ = viper.BindEnv("Ethereum.ChainID", "ISSUER_ETHEREUM_CHAIN_ID")
 	_ = viper.BindEnv("Ethereum.DefaultGasLimit", "ISSUER_ETHEREUM_DEFAULT_GAS_LIMIT")
 	_ = viper.BindEnv("Ethereum.ConfirmationTimeout", "ISSUER_ETHEREUM_CONFIRMATION_TIME_OUT")
 	_ = viper.BindEnv("Ethereum.ConfirmationBlockCount", "ISSUER_ETHEREUM_CONFIRMATION_BLOCK_COUNT")
@@ -315,14 +717,145 @@ func bindEnv() {
 	_ = viper.BindEnv("Ethereum.WaitReceiptCycleTime", "ISSUER_ETHEREUM_WAIT_RECEIPT_CYCLE_TIME")
 	_ = viper.BindEnv("Ethereum.WaitBlockCycleTime", "ISSUER_ETHEREUM_WAIT_BLOCK_CYCLE_TIME")
 	_ = viper.BindEnv("Ethereum.ResolverPrefix", "ISSUER_ETHEREUM_RESOLVER_PREFIX")
+	_ = viper.BindEnv("Ethereum.GasStrategy", "ISSUER_ETHEREUM_GAS_STRATEGY")
+	_ = viper.BindEnv("Ethereum.MaxPriorityFeePerGas", "ISSUER_ETHEREUM_MAX_PRIORITY_FEE_PER_GAS")
+	_ = viper.BindEnv("Ethereum.MaxFeePerGas", "ISSUER_ETHEREUM_MAX_FEE_PER_GAS")
+	_ = viper.BindEnv("Ethereum.GasPercentileBlocks", "ISSUER_ETHEREUM_GAS_PERCENTILE_BLOCKS")
+	_ = viper.BindEnv("Ethereum.GasPercentile", "ISSUER_ETHEREUM_GAS_PERCENTILE")
+	_ = viper.BindEnv("Ethereum.RelayerType", "ISSUER_ETHEREUM_RELAYER_TYPE")
+	_ = viper.BindEnv("Ethereum.RelayerURL", "ISSUER_ETHEREUM_RELAYER_URL")
+	_ = viper.BindEnv("Ethereum.RelayerAPIKey", "ISSUER_ETHEREUM_RELAYER_API_KEY")
+	_ = viper.BindEnv("Ethereum.RelayerForwarder", "ISSUER_ETHEREUM_RELAYER_FORWARDER")
+	_ = viper.BindEnv("NetworksFile", "ISSUER_NETWORKS_FILE")
 
 	_ = viper.BindEnv("Prover.ServerURL", "ISSUER_PROVER_SERVER_URL")
 	_ = viper.BindEnv("Prover.ResponseTimeout", "ISSUER_PROVER_TIMEOUT")
 
 	_ = viper.BindEnv("Circuit.Path", "ISSUER_CIRCUIT_PATH")
 
+	_ = viper.BindEnv("WarmUp.Enabled", "ISSUER_WARMUP_ENABLED")
+	_ = viper.BindEnv("WarmUp.Timeout", "ISSUER_WARMUP_TIMEOUT")
+
+	_ = viper.BindEnv("TransactionMonitor.StuckTimeout", "ISSUER_TX_MONITOR_STUCK_TIMEOUT")
+	_ = viper.BindEnv("TransactionMonitor.GasBumpPercent", "ISSUER_TX_MONITOR_GAS_BUMP_PERCENT")
+	_ = viper.BindEnv("TransactionMonitor.MaxAttempts", "ISSUER_TX_MONITOR_MAX_ATTEMPTS")
+
+	_ = viper.BindEnv("Standby.Enabled", "ISSUER_STANDBY_ENABLED")
+	_ = viper.BindEnv("Standby.CheckFrequency", "ISSUER_STANDBY_CHECK_FREQUENCY")
+	_ = viper.BindEnv("LeaderElection.LeaseTTL", "ISSUER_LEADER_LEASE_TTL")
+	_ = viper.BindEnv("ValidationWebhook.Timeout", "ISSUER_VALIDATION_WEBHOOK_TIMEOUT")
+	_ = viper.BindEnv("ValidationWebhook.ApprovalTTL", "ISSUER_VALIDATION_WEBHOOK_APPROVAL_TTL")
+	_ = viper.BindEnv("DIDResolver.URL", "ISSUER_DID_RESOLVER_URL")
+	_ = viper.BindEnv("DIDResolver.Timeout", "ISSUER_DID_RESOLVER_TIMEOUT")
+	_ = viper.BindEnv("DIDResolver.CacheTTL", "ISSUER_DID_RESOLVER_CACHE_TTL")
+	_ = viper.BindEnv("Publishing.Mode", "ISSUER_PUBLISHING_MODE")
+	_ = viper.BindEnv("Publishing.Interval", "ISSUER_PUBLISHING_INTERVAL")
+	_ = viper.BindEnv("Publishing.Threshold", "ISSUER_PUBLISHING_THRESHOLD")
+	_ = viper.BindEnv("Publishing.RevocationWindow", "ISSUER_PUBLISHING_REVOCATION_WINDOW")
+	_ = viper.BindEnv("Publishing.CheckFrequency", "ISSUER_PUBLISHING_CHECK_FREQUENCY")
+
+	_ = viper.BindEnv("ProofPolicy.Default", "ISSUER_PROOF_POLICY_DEFAULT")
+	_ = viper.BindEnv("ProofPolicy.Allowed", "ISSUER_PROOF_POLICY_ALLOWED")
+
+	_ = viper.BindEnv("IPFS.GatewayURL", "ISSUER_IPFS_GATEWAY_URL")
+	_ = viper.BindEnv("IPFS.Pinner", "ISSUER_IPFS_PINNER")
+	_ = viper.BindEnv("IPFS.NodeURL", "ISSUER_IPFS_NODE_URL")
+	_ = viper.BindEnv("IPFS.NodeUser", "ISSUER_IPFS_NODE_USER")
+	_ = viper.BindEnv("IPFS.NodePassword", "ISSUER_IPFS_NODE_PASSWORD")
+	_ = viper.BindEnv("IPFS.PinataURL", "ISSUER_IPFS_PINATA_URL")
+	_ = viper.BindEnv("IPFS.PinataJWT", "ISSUER_IPFS_PINATA_JWT")
+
+	_ = viper.BindEnv("Backlog.MaxUnpublishedClaims", "ISSUER_BACKLOG_MAX_UNPUBLISHED_CLAIMS")
+	_ = viper.BindEnv("Backlog.MaxPendingRetries", "ISSUER_BACKLOG_MAX_PENDING_RETRIES")
+	_ = viper.BindEnv("Backlog.MaxPendingAge", "ISSUER_BACKLOG_MAX_PENDING_AGE")
+	_ = viper.BindEnv("Backlog.AlertRecipients", "ISSUER_BACKLOG_ALERT_RECIPIENTS")
+
+	_ = viper.BindEnv("FeatureFlags.AsyncIssuance", "ISSUER_FEATURE_FLAGS_ASYNC_ISSUANCE")
+	_ = viper.BindEnv("FeatureFlags.OID4VCI", "ISSUER_FEATURE_FLAGS_OID4VCI")
+	_ = viper.BindEnv("FeatureFlags.TestMode", "ISSUER_FEATURE_FLAGS_TEST_MODE")
+	_ = viper.BindEnv("FeatureFlags.StatusList", "ISSUER_FEATURE_FLAGS_STATUS_LIST")
+
+	_ = viper.BindEnv("PII.Fields", "ISSUER_PII_FIELDS")
+	_ = viper.BindEnv("PII.MaskListings", "ISSUER_PII_MASK_LISTINGS")
+
+	_ = viper.BindEnv("CORS.AllowedOrigins", "ISSUER_CORS_ALLOWED_ORIGINS")
+	_ = viper.BindEnv("CORS.AllowedMethods", "ISSUER_CORS_ALLOWED_METHODS")
+	_ = viper.BindEnv("CORS.AllowedHeaders", "ISSUER_CORS_ALLOWED_HEADERS")
+	_ = viper.BindEnv("CORS.ExposedHeaders", "ISSUER_CORS_EXPOSED_HEADERS")
+	_ = viper.BindEnv("CORS.AllowCredentials", "ISSUER_CORS_ALLOW_CREDENTIALS")
+	_ = viper.BindEnv("CORS.MaxAge", "ISSUER_CORS_MAX_AGE")
+
+	_ = viper.BindEnv("SecurityHeaders.HSTSMaxAge", "ISSUER_HSTS_MAX_AGE")
+	_ = viper.BindEnv("SecurityHeaders.HSTSIncludeSubdomains", "ISSUER_HSTS_INCLUDE_SUBDOMAINS")
+
+	_ = viper.BindEnv("RateLimit.Enabled", "ISSUER_RATE_LIMIT_ENABLED")
+	_ = viper.BindEnv("RateLimit.TrustProxyHeaders", "ISSUER_RATE_LIMIT_TRUST_PROXY_HEADERS")
+	_ = viper.BindEnv("RateLimit.Authenticated.RequestsPerMinute", "ISSUER_RATE_LIMIT_AUTHENTICATED_RPM")
+	_ = viper.BindEnv("RateLimit.Authenticated.Burst", "ISSUER_RATE_LIMIT_AUTHENTICATED_BURST")
+	_ = viper.BindEnv("RateLimit.Anonymous.RequestsPerMinute", "ISSUER_RATE_LIMIT_ANONYMOUS_RPM")
+	_ = viper.BindEnv("RateLimit.Anonymous.Burst", "ISSUER_RATE_LIMIT_ANONYMOUS_BURST")
+
+	_ = viper.BindEnv("RequestTimeout.Read", "ISSUER_REQUEST_TIMEOUT_READ")
+	_ = viper.BindEnv("RequestTimeout.Issuance", "ISSUER_REQUEST_TIMEOUT_ISSUANCE")
+	_ = viper.BindEnv("RequestTimeout.Publish", "ISSUER_REQUEST_TIMEOUT_PUBLISH")
+
+	_ = viper.BindEnv("SMTP.Host", "ISSUER_SMTP_HOST")
+	_ = viper.BindEnv("SMTP.Port", "ISSUER_SMTP_PORT")
+	_ = viper.BindEnv("SMTP.User", "ISSUER_SMTP_USER")
+	_ = viper.BindEnv("SMTP.Password", "ISSUER_SMTP_PASSWORD")
+	_ = viper.BindEnv("SMTP.From", "ISSUER_SMTP_FROM")
+
+	_ = viper.BindEnv("Reports.Enabled", "ISSUER_REPORTS_ENABLED")
+	_ = viper.BindEnv("Reports.Frequency", "ISSUER_REPORTS_FREQUENCY")
+	_ = viper.BindEnv("Reports.Hour", "ISSUER_REPORTS_HOUR")
+	_ = viper.BindEnv("Reports.Format", "ISSUER_REPORTS_FORMAT")
+	_ = viper.BindEnv("Reports.Recipients", "ISSUER_REPORTS_RECIPIENTS")
+
+	_ = viper.BindEnv("OfferEmails.Enabled", "ISSUER_OFFER_EMAILS_ENABLED")
+	_ = viper.BindEnv("OfferEmails.Provider", "ISSUER_OFFER_EMAILS_PROVIDER")
+	_ = viper.BindEnv("OfferEmails.SendGridAPIKey", "ISSUER_OFFER_EMAILS_SENDGRID_API_KEY")
+	_ = viper.BindEnv("OfferEmails.Subject", "ISSUER_OFFER_EMAILS_SUBJECT")
+	_ = viper.BindEnv("OfferEmails.Template", "ISSUER_OFFER_EMAILS_TEMPLATE")
+	_ = viper.BindEnv("OfferEmails.ClaimPageURL", "ISSUER_OFFER_EMAILS_CLAIM_PAGE_URL")
+	_ = viper.BindEnv("OfferEmails.PerRecipientPerHour", "ISSUER_OFFER_EMAILS_PER_RECIPIENT_PER_HOUR")
+
+	_ = viper.BindEnv("WalletLinks.DeepLink", "ISSUER_WALLET_DEEP_LINK")
+	_ = viper.BindEnv("WalletLinks.UniversalLink", "ISSUER_WALLET_UNIVERSAL_LINK")
+
+	_ = viper.BindEnv("RevocationDecisions.URL", "ISSUER_REVOCATION_DECISIONS_URL")
+	_ = viper.BindEnv("RevocationDecisions.Token", "ISSUER_REVOCATION_DECISIONS_TOKEN")
+	_ = viper.BindEnv("RevocationDecisions.Source", "ISSUER_REVOCATION_DECISIONS_SOURCE")
+	_ = viper.BindEnv("RevocationDecisions.PollFrequency", "ISSUER_REVOCATION_DECISIONS_POLL_FREQUENCY")
+	_ = viper.BindEnv("RevocationDecisions.Timeout", "ISSUER_REVOCATION_DECISIONS_TIMEOUT")
+
+	_ = viper.BindEnv("OID4VCI.OfferExpiration", "ISSUER_OID4VCI_OFFER_EXPIRATION")
+	_ = viper.BindEnv("OID4VCI.TokenExpiration", "ISSUER_OID4VCI_TOKEN_EXPIRATION")
+
+	_ = viper.BindEnv("JWTCredential.Algorithm", "ISSUER_JWT_CREDENTIAL_ALGORITHM")
+	_ = viper.BindEnv("RevNonce.Strategy", "ISSUER_REV_NONCE_STRATEGY")
+
+	_ = viper.BindEnv("GRPC.Port", "ISSUER_GRPC_PORT")
+	_ = viper.BindEnv("GRPC.CertFile", "ISSUER_GRPC_TLS_CERT_FILE")
+	_ = viper.BindEnv("GRPC.KeyFile", "ISSUER_GRPC_TLS_KEY_FILE")
+
+	_ = viper.BindEnv("EventBroker.Type", "ISSUER_EVENT_BROKER_TYPE")
+	_ = viper.BindEnv("EventBroker.URL", "ISSUER_EVENT_BROKER_URL")
+	_ = viper.BindEnv("EventBroker.TopicPrefix", "ISSUER_EVENT_BROKER_TOPIC_PREFIX")
+	_ = viper.BindEnv("Outbox.CheckFrequency", "ISSUER_OUTBOX_CHECK_FREQUENCY")
+	_ = viper.BindEnv("Outbox.BatchSize", "ISSUER_OUTBOX_BATCH_SIZE")
+	_ = viper.BindEnv("Outbox.Retention", "ISSUER_OUTBOX_RETENTION")
+
+	_ = viper.BindEnv("Jobs.CheckFrequency", "ISSUER_JOBS_CHECK_FREQUENCY")
+	_ = viper.BindEnv("Jobs.MaxAttempts", "ISSUER_JOBS_MAX_ATTEMPTS")
+	_ = viper.BindEnv("Jobs.LockTimeout", "ISSUER_JOBS_LOCK_TIMEOUT")
+	_ = viper.BindEnv("Jobs.Retention", "ISSUER_JOBS_RETENTION")
+
 	_ = viper.BindEnv("Cache.RedisUrl", "ISSUER_REDIS_URL")
+	_ = viper.BindEnv("Cache.SessionStore", "ISSUER_SESSION_STORE")
+	_ = viper.BindEnv("Cache.SessionTTL", "ISSUER_SESSION_TTL")
+	_ = viper.BindEnv("Cache.StateTTL", "ISSUER_CACHE_STATE_TTL")
 	_ = viper.BindEnv("SchemaCache", "ISSUER_SCHEMA_CACHE")
+	_ = viper.BindEnv("SchemaCacheTTL", "ISSUER_SCHEMA_CACHE_TTL")
 
 	_ = viper.BindEnv("APIUI.ServerPort", "ISSUER_API_UI_SERVER_PORT")
 	_ = viper.BindEnv("APIUI.ServerURL", "ISSUER_API_UI_SERVER_URL")
@@ -357,10 +890,49 @@ func checkEnvVars(ctx context.Context, cfg *Configuration) {
 		log.Info(ctx, "ISSUER_ONCHAIN_CHECK_STATUS_FREQUENCY value is missing")
 	}
 
+	if cfg.ExpirationCheckFrequency == 0 {
+		log.Info(ctx, "ISSUER_CREDENTIAL_EXPIRATION_CHECK_FREQUENCY value is missing and expired credentials will not be processed")
+	}
+
+	if cfg.CredentialOfferTTL == 0 {
+		log.Info(ctx, "ISSUER_CREDENTIAL_OFFER_TTL value is missing and the server set up it as 24h")
+		cfg.CredentialOfferTTL = 24 * time.Hour
+	}
+
+	if cfg.AgentReplayWindow == 0 {
+		log.Info(ctx, "ISSUER_AGENT_REPLAY_WINDOW value is missing and the server set up it as 24h")
+		cfg.AgentReplayWindow = 24 * time.Hour
+	}
+
+	if cfg.IdempotencyKeyTTL == 0 {
+		log.Info(ctx, "ISSUER_IDEMPOTENCY_KEY_TTL value is missing and the server set up it as 24h")
+		cfg.IdempotencyKeyTTL = 24 * time.Hour
+	}
+
+	if cfg.DeletedRetention == 0 {
+		log.Info(ctx, "ISSUER_DELETED_RETENTION value is missing and the server set up it as 720h")
+		cfg.DeletedRetention = 30 * 24 * time.Hour
+	}
+
+	if cfg.AgentInboxRetention == 0 {
+		log.Info(ctx, "ISSUER_AGENT_INBOX_RETENTION value is missing and the server set up it as 168h")
+		cfg.AgentInboxRetention = 7 * 24 * time.Hour
+	}
+
 	if cfg.Database.URL == "" {
 		log.Info(ctx, "ISSUER_DATABASE_URL value is missing")
 	}
 
+	if !viper.IsSet("Database.StatementTimeout") {
+		log.Info(ctx, "ISSUER_DATABASE_STATEMENT_TIMEOUT value is missing and the server set up it as 30s")
+		cfg.Database.StatementTimeout = 30 * time.Second
+	}
+
+	if !viper.IsSet("Database.ListingTimeout") {
+		log.Info(ctx, "ISSUER_DATABASE_LISTING_TIMEOUT value is missing and the server set up it as 10s")
+		cfg.Database.ListingTimeout = 10 * time.Second
+	}
+
 	if cfg.HTTPBasicAuth.User == "" {
 		log.Info(ctx, "ISSUER_API_AUTH_USER value is missing")
 	}
@@ -369,16 +941,81 @@ func checkEnvVars(ctx context.Context, cfg *Configuration) {
 		log.Info(ctx, "ISSUER_API_AUTH_PASSWORD value is missing")
 	}
 
-	if cfg.KeyStore.Address == "" {
-		log.Info(ctx, "ISSUER_KEY_STORE_ADDRESS value is missing")
+	if cfg.KeyStore.Provider == "" {
+		log.Info(ctx, "ISSUER_KEY_STORE_PROVIDER value is missing and the server set up it as vault")
+		cfg.KeyStore.Provider = KeyStoreProviderVault
 	}
 
-	if cfg.KeyStore.Token == "" {
-		log.Info(ctx, "ISSUER_KEY_STORE_TOKEN value is missing")
+	switch cfg.KeyStore.Provider {
+	case KeyStoreProviderAWS:
+		if cfg.KeyStore.AWSRegion == "" {
+			log.Info(ctx, "ISSUER_KEY_STORE_AWS_REGION value is missing")
+		}
+
+		if cfg.KeyStore.AWSAccessKey == "" {
+			log.Info(ctx, "ISSUER_KEY_STORE_AWS_ACCESS_KEY value is missing")
+		}
+
+		if cfg.KeyStore.AWSSecretKey == "" {
+			log.Info(ctx, "ISSUER_KEY_STORE_AWS_SECRET_KEY value is missing")
+		}
+	case KeyStoreProviderFile:
+		if cfg.KeyStore.FilePath == "" {
+			log.Info(ctx, "ISSUER_KEY_STORE_FILE_PATH value is missing and the server set up it as ./keys")
+			cfg.KeyStore.FilePath = "./keys"
+		}
+
+		if cfg.KeyStore.FilePassphrase == "" {
+			log.Info(ctx, "ISSUER_KEY_STORE_FILE_PASSPHRASE value is missing")
+		}
+	default:
+		if cfg.KeyStore.Address == "" {
+			log.Info(ctx, "ISSUER_KEY_STORE_ADDRESS value is missing")
+		}
+
+		if cfg.KeyStore.AuthMethod == "" {
+			log.Info(ctx, "ISSUER_KEY_STORE_AUTH_METHOD value is missing and the server set up it as token")
+			cfg.KeyStore.AuthMethod = "token"
+		}
+
+		switch cfg.KeyStore.AuthMethod {
+		case "approle":
+			if cfg.KeyStore.AppRoleID == "" {
+				log.Info(ctx, "ISSUER_KEY_STORE_APPROLE_ROLE_ID value is missing")
+			}
+
+			if cfg.KeyStore.AppRoleSecretID == "" {
+				log.Info(ctx, "ISSUER_KEY_STORE_APPROLE_SECRET_ID value is missing")
+			}
+		case "kubernetes":
+			if cfg.KeyStore.KubernetesRole == "" {
+				log.Info(ctx, "ISSUER_KEY_STORE_KUBERNETES_ROLE value is missing")
+			}
+		default:
+			if cfg.KeyStore.Token == "" {
+				log.Info(ctx, "ISSUER_KEY_STORE_TOKEN value is missing")
+			}
+		}
+
+		if cfg.KeyStore.PluginIden3MountPath == "" {
+			log.Info(ctx, "ISSUER_KEY_STORE_PLUGIN_IDEN3_MOUNT_PATH value is missing")
+		}
+	}
+
+	if cfg.KeyStore.ETHProvider == "" {
+		log.Info(ctx, "ISSUER_KEY_STORE_ETH_PROVIDER value is missing and the server set up it as "+cfg.KeyStore.Provider)
+		cfg.KeyStore.ETHProvider = cfg.KeyStore.Provider
 	}
 
-	if cfg.KeyStore.PluginIden3MountPath == "" {
-		log.Info(ctx, "ISSUER_KEY_STORE_PLUGIN_IDEN3_MOUNT_PATH value is missing")
+	if cfg.KeyStore.ETHProvider == KeyStoreProviderGCP {
+		if cfg.KeyStore.GCPKeyRing == "" {
+			log.Info(ctx, "ISSUER_KEY_STORE_GCP_KEY_RING value is missing")
+		}
+
+		if cfg.KeyStore.GCPProtectionLevel == "" {
+			log.Info(ctx, "ISSUER_KEY_STORE_GCP_PROTECTION_LEVEL value is missing and the server set up it as HSM")
+			cfg.KeyStore.GCPProtectionLevel = "HSM"
+		}
 	}
 
 	if cfg.Ethereum.URL == "" {
@@ -426,7 +1063,13 @@ func checkEnvVars(ctx context.Context, cfg *Configuration) {
 	}
 
 	if cfg.Ethereum.ResolverPrefix == "" {
-		log.Info(ctx, "ISSUER_ETHEREUM_RESOLVER_PREFIX value is missing")
+		log.Info(ctx, "ISSUER_ETHEREUM_RESOLVER_PREFIX value is missing and the server set up it as polygon:mumbai")
+		cfg.Ethereum.ResolverPrefix = "polygon:mumbai"
+	}
+
+	if cfg.Ethereum.GasStrategy == "" {
+		log.Info(ctx, "ISSUER_ETHEREUM_GAS_STRATEGY value is missing and the server set up it as oracle")
+		cfg.Ethereum.GasStrategy = "oracle"
 	}
 
 	if cfg.Prover.ServerURL == "" {
@@ -445,11 +1088,227 @@ func checkEnvVars(ctx context.Context, cfg *Configuration) {
 		log.Info(ctx, "ISSUER_REDIS_URL value is missing")
 	}
 
+	if cfg.Cache.SessionStore == "" {
+		log.Info(ctx, "ISSUER_SESSION_STORE value is missing and the server set up it as redis")
+		cfg.Cache.SessionStore = SessionStoreRedis
+	}
+
+	if cfg.Cache.SessionTTL == 0 {
+		log.Info(ctx, "ISSUER_SESSION_TTL value is missing and the server set up it as 5m")
+		cfg.Cache.SessionTTL = 5 * time.Minute
+	}
+
 	if cfg.SchemaCache == nil {
 		log.Info(ctx, "ISSUER_SCHEMA_CACHE is missing and the server set up it as false")
 		cfg.SchemaCache = common.ToPointer(false)
 	}
 
+	if cfg.SchemaCacheTTL == 0 {
+		log.Info(ctx, "ISSUER_SCHEMA_CACHE_TTL value is missing and the server set up it as 24h")
+		cfg.SchemaCacheTTL = 24 * time.Hour
+	}
+
+	if cfg.WarmUp.Enabled && cfg.WarmUp.Timeout == 0 {
+		log.Info(ctx, "ISSUER_WARMUP_TIMEOUT value is missing and the server set up it as 60s")
+		cfg.WarmUp.Timeout = 60 * time.Second
+	}
+
+	if cfg.TransactionMonitor.StuckTimeout == 0 {
+		log.Info(ctx, "ISSUER_TX_MONITOR_STUCK_TIMEOUT value is missing and the server set up it as 3m")
+		cfg.TransactionMonitor.StuckTimeout = 3 * time.Minute
+	}
+
+	if cfg.TransactionMonitor.GasBumpPercent == 0 {
+		log.Info(ctx, "ISSUER_TX_MONITOR_GAS_BUMP_PERCENT value is missing and the server set up it as 20")
+		cfg.TransactionMonitor.GasBumpPercent = 20
+	} else if cfg.TransactionMonitor.GasBumpPercent < 10 {
+		log.Info(ctx, "ISSUER_TX_MONITOR_GAS_BUMP_PERCENT is below the minimum replacement increase and the server set up it as 10")
+		cfg.TransactionMonitor.GasBumpPercent = 10
+	}
+
+	if cfg.TransactionMonitor.MaxAttempts == 0 {
+		log.Info(ctx, "ISSUER_TX_MONITOR_MAX_ATTEMPTS value is missing and the server set up it as 5")
+		cfg.TransactionMonitor.MaxAttempts = 5
+	}
+
+	if cfg.Standby.Enabled && cfg.Standby.CheckFrequency == 0 {
+		log.Info(ctx, "ISSUER_STANDBY_CHECK_FREQUENCY value is missing and the server set up it as 5s")
+		cfg.Standby.CheckFrequency = 5 * time.Second
+	}
+
+	if cfg.LeaderElection.LeaseTTL == 0 {
+		log.Info(ctx, "ISSUER_LEADER_LEASE_TTL value is missing and the server set up it as 15s")
+		cfg.LeaderElection.LeaseTTL = 15 * time.Second
+	}
+
+	if cfg.ValidationWebhook.Timeout == 0 {
+		log.Info(ctx, "ISSUER_VALIDATION_WEBHOOK_TIMEOUT value is missing and the server set up it as 10s")
+		cfg.ValidationWebhook.Timeout = 10 * time.Second
+	}
+
+	if cfg.DIDResolver.URL != "" && cfg.DIDResolver.Timeout == 0 {
+		log.Info(ctx, "ISSUER_DID_RESOLVER_TIMEOUT value is missing and the server set up it as 10s")
+		cfg.DIDResolver.Timeout = 10 * time.Second
+	}
+
+	if cfg.Publishing.Mode == "" {
+		log.Info(ctx, "ISSUER_PUBLISHING_MODE value is missing and the server set up it as manual")
+		cfg.Publishing.Mode = "manual"
+	}
+
+	if cfg.Publishing.CheckFrequency == 0 {
+		log.Info(ctx, "ISSUER_PUBLISHING_CHECK_FREQUENCY value is missing and the server set up it as 30s")
+		cfg.Publishing.CheckFrequency = 30 * time.Second
+	}
+
+	if len(cfg.ProofPolicy.Default) == 0 {
+		log.Info(ctx, "ISSUER_PROOF_POLICY_DEFAULT value is missing and the server set up it as BJJSignature2021,Iden3SparseMerkleTreeProof")
+		cfg.ProofPolicy.Default = []string{"BJJSignature2021", "Iden3SparseMerkleTreeProof"}
+	}
+
+	if cfg.IPFS.GatewayURL == "" {
+		log.Info(ctx, "ISSUER_IPFS_GATEWAY_URL value is missing and the server set up it as https://ipfs.io")
+		cfg.IPFS.GatewayURL = "https://ipfs.io"
+	}
+
+	if cfg.IPFS.Pinner == "pinata" && cfg.IPFS.PinataURL == "" {
+		log.Info(ctx, "ISSUER_IPFS_PINATA_URL value is missing and the server set up it as https://api.pinata.cloud")
+		cfg.IPFS.PinataURL = "https://api.pinata.cloud"
+	}
+
+	if len(cfg.CORS.AllowedOrigins) == 0 {
+		log.Info(ctx, "ISSUER_CORS_ALLOWED_ORIGINS value is missing and the server set up it as *")
+		cfg.CORS.AllowedOrigins = []string{"*"}
+	}
+
+	if len(cfg.CORS.AllowedMethods) == 0 {
+		cfg.CORS.AllowedMethods = []string{http.MethodHead, http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete}
+	}
+
+	if len(cfg.CORS.AllowedHeaders) == 0 {
+		cfg.CORS.AllowedHeaders = []string{"*"}
+	}
+
+	if cfg.RateLimit.Enabled {
+		if cfg.RateLimit.Authenticated.RequestsPerMinute == 0 {
+			log.Info(ctx, "ISSUER_RATE_LIMIT_AUTHENTICATED_RPM value is missing and the server set up it as 600")
+			cfg.RateLimit.Authenticated.RequestsPerMinute = 600
+		}
+		if cfg.RateLimit.Authenticated.Burst == 0 {
+			log.Info(ctx, "ISSUER_RATE_LIMIT_AUTHENTICATED_BURST value is missing and the server set up it as 100")
+			cfg.RateLimit.Authenticated.Burst = 100
+		}
+		if cfg.RateLimit.Anonymous.RequestsPerMinute == 0 {
+			log.Info(ctx, "ISSUER_RATE_LIMIT_ANONYMOUS_RPM value is missing and the server set up it as 60")
+			cfg.RateLimit.Anonymous.RequestsPerMinute = 60
+		}
+		if cfg.RateLimit.Anonymous.Burst == 0 {
+			log.Info(ctx, "ISSUER_RATE_LIMIT_ANONYMOUS_BURST value is missing and the server set up it as 20")
+			cfg.RateLimit.Anonymous.Burst = 20
+		}
+	}
+
+	if cfg.RequestTimeout.Read == 0 {
+		log.Info(ctx, "ISSUER_REQUEST_TIMEOUT_READ value is missing and the server set up it as 10s")
+		cfg.RequestTimeout.Read = 10 * time.Second
+	}
+	if cfg.RequestTimeout.Issuance == 0 {
+		log.Info(ctx, "ISSUER_REQUEST_TIMEOUT_ISSUANCE value is missing and the server set up it as 30s")
+		cfg.RequestTimeout.Issuance = 30 * time.Second
+	}
+	if cfg.RequestTimeout.Publish == 0 {
+		log.Info(ctx, "ISSUER_REQUEST_TIMEOUT_PUBLISH value is missing and the server set up it as 60s")
+		cfg.RequestTimeout.Publish = 60 * time.Second
+	}
+
+	if cfg.Reports.Enabled {
+		checkReportsEnvVars(ctx, cfg)
+	}
+
+	if cfg.OfferEmails.Enabled {
+		checkOfferEmailsEnvVars(ctx, cfg)
+	}
+
+	checkWalletLinksEnvVars(ctx, cfg)
+
+	if cfg.RevocationDecisions.URL != "" {
+		checkRevocationDecisionsEnvVars(ctx, cfg)
+	}
+
+	if cfg.OID4VCI.OfferExpiration == 0 {
+		log.Info(ctx, "ISSUER_OID4VCI_OFFER_EXPIRATION value is missing and the server set up it as 24h")
+		cfg.OID4VCI.OfferExpiration = 24 * time.Hour
+	}
+	if cfg.OID4VCI.TokenExpiration == 0 {
+		log.Info(ctx, "ISSUER_OID4VCI_TOKEN_EXPIRATION value is missing and the server set up it as 10m")
+		cfg.OID4VCI.TokenExpiration = 10 * time.Minute
+	}
+
+	if cfg.JWTCredential.Algorithm == "" {
+		log.Info(ctx, "ISSUER_JWT_CREDENTIAL_ALGORITHM value is missing and the server set up it as ES256")
+		cfg.JWTCredential.Algorithm = "ES256"
+	}
+	if cfg.JWTCredential.Algorithm != "ES256" && cfg.JWTCredential.Algorithm != "EdDSA" {
+		log.Warn(ctx, "ISSUER_JWT_CREDENTIAL_ALGORITHM value is not valid and the server set up it as ES256", "algorithm", cfg.JWTCredential.Algorithm)
+		cfg.JWTCredential.Algorithm = "ES256"
+	}
+
+	if cfg.RevNonce.Strategy == "" {
+		log.Info(ctx, "ISSUER_REV_NONCE_STRATEGY value is missing and the server set up it as random")
+		cfg.RevNonce.Strategy = "random"
+	}
+	if cfg.RevNonce.Strategy != "random" && cfg.RevNonce.Strategy != "sequential" {
+		log.Warn(ctx, "ISSUER_REV_NONCE_STRATEGY value is not valid and the server set up it as random", "strategy", cfg.RevNonce.Strategy)
+		cfg.RevNonce.Strategy = "random"
+	}
+
+	if cfg.GRPC.Port != 0 && (cfg.GRPC.CertFile == "" || cfg.GRPC.KeyFile == "") {
+		log.Warn(ctx, "ISSUER_GRPC_TLS_CERT_FILE or ISSUER_GRPC_TLS_KEY_FILE value is missing and the server disabled the gRPC API")
+		cfg.GRPC.Port = 0
+	}
+
+	if cfg.EventBroker.Type != "" && cfg.EventBroker.Type != "kafka" && cfg.EventBroker.Type != "nats" {
+		log.Warn(ctx, "ISSUER_EVENT_BROKER_TYPE value is not valid and the server disabled the events", "type", cfg.EventBroker.Type)
+		cfg.EventBroker.Type = ""
+	}
+	if cfg.EventBroker.Type != "" && cfg.EventBroker.URL == "" {
+		log.Warn(ctx, "ISSUER_EVENT_BROKER_URL value is missing and the server disabled the events")
+		cfg.EventBroker.Type = ""
+	}
+	if cfg.Outbox.CheckFrequency == 0 {
+		log.Info(ctx, "ISSUER_OUTBOX_CHECK_FREQUENCY value is missing and the server set up it as 1s")
+		cfg.Outbox.CheckFrequency = time.Second
+	}
+	if cfg.Outbox.BatchSize <= 0 {
+		log.Info(ctx, "ISSUER_OUTBOX_BATCH_SIZE value is missing and the server set up it as 100")
+		cfg.Outbox.BatchSize = 100
+	}
+	if cfg.Outbox.Retention == 0 {
+		log.Info(ctx, "ISSUER_OUTBOX_RETENTION value is missing and the server set up it as 168h")
+		cfg.Outbox.Retention = 7 * 24 * time.Hour
+	}
+	if cfg.Jobs.CheckFrequency == 0 {
+		log.Info(ctx, "ISSUER_JOBS_CHECK_FREQUENCY value is missing and the server set up it as 1s")
+		cfg.Jobs.CheckFrequency = time.Second
+	}
+	if cfg.Jobs.MaxAttempts <= 0 {
+		log.Info(ctx, "ISSUER_JOBS_MAX_ATTEMPTS value is missing and the server set up it as 5")
+		cfg.Jobs.MaxAttempts = 5
+	}
+	if cfg.Jobs.LockTimeout == 0 {
+		log.Info(ctx, "ISSUER_JOBS_LOCK_TIMEOUT value is missing and the server set up it as 5m")
+		cfg.Jobs.LockTimeout = 5 * time.Minute
+	}
+	if cfg.Jobs.Retention == 0 {
+		log.Info(ctx, "ISSUER_JOBS_RETENTION value is missing and the server set up it as 168h")
+		cfg.Jobs.Retention = 7 * 24 * time.Hour
+	}
+
+	if len(cfg.Backlog.AlertRecipients) > 0 && cfg.SMTP.Port == 0 {
+		log.Info(ctx, "ISSUER_SMTP_PORT value is missing and the server set up it as 587")
+		cfg.SMTP.Port = 587
+	}
+
 	if cfg.APIUI.ServerPort == 0 {
 		log.Info(ctx, "ISSUER_API_UI_SERVER_PORT value is missing")
 	}
@@ -495,6 +1354,134 @@ func checkEnvVars(ctx context.Context, cfg *Configuration) {
 	}
 }
 
+// checkRevocationDecisionsEnvVars sets the defaults of the polling of the revocation decisions
+func checkRevocationDecisionsEnvVars(ctx context.Context, cfg *Configuration) {
+	if cfg.RevocationDecisions.Source == "" {
+		log.Info(ctx, "ISSUER_REVOCATION_DECISIONS_SOURCE value is missing and the server set up it as external")
+		cfg.RevocationDecisions.Source = "external"
+	}
+
+	if cfg.RevocationDecisions.PollFrequency == 0 {
+		log.Info(ctx, "ISSUER_REVOCATION_DECISIONS_POLL_FREQUENCY value is missing and the server set up it as 1m")
+		cfg.RevocationDecisions.PollFrequency = time.Minute
+	}
+
+	if cfg.RevocationDecisions.Timeout == 0 {
+		log.Info(ctx, "ISSUER_REVOCATION_DECISIONS_TIMEOUT value is missing and the server set up it as 30s")
+		cfg.RevocationDecisions.Timeout = 30 * time.Second
+	}
+}
+
+// checkReportsEnvVars sets the reports defaults. Reports are disabled when they cannot be sent.
+func checkReportsEnvVars(ctx context.Context, cfg *Configuration) {
+	if cfg.Reports.Frequency == "" {
+		log.Info(ctx, "ISSUER_REPORTS_FREQUENCY value is missing and the server set up it as daily")
+		cfg.Reports.Frequency = "daily"
+	}
+
+	if cfg.Reports.Format == "" {
+		log.Info(ctx, "ISSUER_REPORTS_FORMAT value is missing and the server set up it as html")
+		cfg.Reports.Format = "html"
+	}
+
+	if cfg.SMTP.Port == 0 {
+		log.Info(ctx, "ISSUER_SMTP_PORT value is missing and the server set up it as 587")
+		cfg.SMTP.Port = 587
+	}
+
+	if cfg.Reports.Frequency != "daily" && cfg.Reports.Frequency != "weekly" {
+		log.Warn(ctx, "ISSUER_REPORTS_FREQUENCY value is not valid, reports are disabled", "frequency", cfg.Reports.Frequency)
+		cfg.Reports.Enabled = false
+	}
+
+	if cfg.Reports.Format != "csv" && cfg.Reports.Format != "html" {
+		log.Warn(ctx, "ISSUER_REPORTS_FORMAT value is not valid, reports are disabled", "format", cfg.Reports.Format)
+		cfg.Reports.Enabled = false
+	}
+
+	if cfg.Reports.Hour < 0 || cfg.Reports.Hour > 23 {
+		log.Warn(ctx, "ISSUER_REPORTS_HOUR value is not valid, reports are disabled", "hour", cfg.Reports.Hour)
+		cfg.Reports.Enabled = false
+	}
+
+	if len(cfg.Reports.Recipients) == 0 {
+		log.Warn(ctx, "ISSUER_REPORTS_RECIPIENTS value is missing, reports are disabled")
+		cfg.Reports.Enabled = false
+	}
+
+	if cfg.SMTP.Host == "" || cfg.SMTP.From == "" {
+		log.Warn(ctx, "ISSUER_SMTP_HOST or ISSUER_SMTP_FROM value is missing, reports are disabled")
+		cfg.Reports.Enabled = false
+	}
+}
+
+// checkWalletLinksEnvVars sets the default wallet links, also for the links without the message placeholder
+func checkWalletLinksEnvVars(ctx context.Context, cfg *Configuration) {
+	if cfg.WalletLinks.DeepLink == "" {
+		log.Info(ctx, "ISSUER_WALLET_DEEP_LINK value is missing and the server set up it as "+walletlinks.DefaultDeepLink)
+		cfg.WalletLinks.DeepLink = walletlinks.DefaultDeepLink
+	} else if !strings.Contains(cfg.WalletLinks.DeepLink, walletlinks.Message) {
+		log.Warn(ctx, "ISSUER_WALLET_DEEP_LINK value has no {message} placeholder and the server set up it as "+walletlinks.DefaultDeepLink)
+		cfg.WalletLinks.DeepLink = walletlinks.DefaultDeepLink
+	}
+
+	if cfg.WalletLinks.UniversalLink == "" {
+		log.Info(ctx, "ISSUER_WALLET_UNIVERSAL_LINK value is missing and the server set up it as "+walletlinks.DefaultUniversalLink)
+		cfg.WalletLinks.UniversalLink = walletlinks.DefaultUniversalLink
+	} else if !strings.Contains(cfg.WalletLinks.UniversalLink, walletlinks.Message) {
+		log.Warn(ctx, "ISSUER_WALLET_UNIVERSAL_LINK value has no {message} placeholder and the server set up it as "+walletlinks.DefaultUniversalLink)
+		cfg.WalletLinks.UniversalLink = walletlinks.DefaultUniversalLink
+	}
+}
+
+// checkOfferEmailsEnvVars sets the offer emails defaults. Offer emails are disabled when they cannot be sent.
+func checkOfferEmailsEnvVars(ctx context.Context, cfg *Configuration) {
+	if cfg.OfferEmails.Provider == "" {
+		log.Info(ctx, "ISSUER_OFFER_EMAILS_PROVIDER value is missing and the server set up it as smtp")
+		cfg.OfferEmails.Provider = "smtp"
+	}
+
+	if cfg.OfferEmails.Subject == "" {
+		log.Info(ctx, "ISSUER_OFFER_EMAILS_SUBJECT value is missing and the server set up it as {{.IssuerName}} sent you a credential")
+		cfg.OfferEmails.Subject = "{{.IssuerName}} sent you a credential"
+	}
+
+	if cfg.OfferEmails.PerRecipientPerHour == 0 {
+		log.Info(ctx, "ISSUER_OFFER_EMAILS_PER_RECIPIENT_PER_HOUR value is missing and the server set up it as 3")
+		cfg.OfferEmails.PerRecipientPerHour = 3
+	}
+
+	switch cfg.OfferEmails.Provider {
+	case "smtp":
+		if cfg.SMTP.Port == 0 {
+			log.Info(ctx, "ISSUER_SMTP_PORT value is missing and the server set up it as 587")
+			cfg.SMTP.Port = 587
+		}
+		if cfg.SMTP.Host == "" {
+			log.Warn(ctx, "ISSUER_SMTP_HOST value is missing, offer emails are disabled")
+			cfg.OfferEmails.Enabled = false
+		}
+	case "sendgrid":
+		if cfg.OfferEmails.SendGridAPIKey == "" {
+			log.Warn(ctx, "ISSUER_OFFER_EMAILS_SENDGRID_API_KEY value is missing, offer emails are disabled")
+			cfg.OfferEmails.Enabled = false
+		}
+	default:
+		log.Warn(ctx, "ISSUER_OFFER_EMAILS_PROVIDER value is not valid, offer emails are disabled", "provider", cfg.OfferEmails.Provider)
+		cfg.OfferEmails.Enabled = false
+	}
+
+	if cfg.SMTP.From == "" {
+		log.Warn(ctx, "ISSUER_SMTP_FROM value is missing, offer emails are disabled")
+		cfg.OfferEmails.Enabled = false
+	}
+
+	if cfg.OfferEmails.PerRecipientPerHour < 0 {
+		log.Warn(ctx, "ISSUER_OFFER_EMAILS_PER_RECIPIENT_PER_HOUR value is not valid, offer emails are disabled", "perRecipientPerHour", cfg.OfferEmails.PerRecipientPerHour)
+		cfg.OfferEmails.Enabled = false
+	}
+}
+
 func getWorkingDirectory() string {
 	_, b, _, _ := runtime.Caller(0)
 	return filepath.Join(filepath.Dir(b), "../..") + "/"
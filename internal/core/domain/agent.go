@@ -4,11 +4,12 @@ import "github.com/iden3/iden3comm"
 
 // Agent struct
 type Agent struct {
-	ID       string                    `json:"id"`
-	Typ      iden3comm.MediaType       `json:"typ,omitempty"`
-	Type     iden3comm.ProtocolMessage `json:"type"`
-	ThreadID string                    `json:"thid,omitempty"`
-	Body     interface{}               `json:"body,omitempty"`
-	From     string                    `json:"from,omitempty"`
-	To       string                    `json:"to,omitempty"`
+	ID             string                    `json:"id"`
+	Typ            iden3comm.MediaType       `json:"typ,omitempty"`
+	Type           iden3comm.ProtocolMessage `json:"type"`
+	ThreadID       string                    `json:"thid,omitempty"`
+	ParentThreadID string                    `json:"pthid,omitempty"` // the thread of the message a problem report is about
+	Body           interface{}               `json:"body,omitempty"`
+	From           string                    `json:"from,omitempty"`
+	To             string                    `json:"to,omitempty"`
 }
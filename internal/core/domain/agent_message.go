@@ -0,0 +1,19 @@
+package domain
+
+import (
+	"time"
+
+	core "github.com/iden3/go-iden3-core"
+)
+
+// AgentMessage is a message received by the agent endpoint of an issuer, kept to answer its replays with the
+// original response. Response is nil while the message is being processed.
+type AgentMessage struct {
+	IssuerDID core.DID
+	Sender    string
+	ID        string
+	ThreadID  string
+	Type      string
+	Response  *Agent
+	CreatedAt time.Time
+}
@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// Backlog is a snapshot of the work the node has not completed yet
+type Backlog struct {
+	UnpublishedClaims int        // claims waiting to be included in a published state
+	PendingRetries    int        // pending state transactions that had to be resubmitted
+	OldestPendingAt   *time.Time // time of the oldest unpublished claim, revocation or pending state transaction
+}
+
+// BacklogThresholds are the limits over which the backlog is considered degraded. A zero value disables the limit.
+type BacklogThresholds struct {
+	MaxUnpublishedClaims int
+	MaxPendingRetries    int
+	MaxPendingAge        time.Duration
+}
+
+// Enabled returns true if any of the thresholds is set
+func (t BacklogThresholds) Enabled() bool {
+	return t.MaxUnpublishedClaims > 0 || t.MaxPendingRetries > 0 || t.MaxPendingAge > 0
+}
+
+// OldestPendingAge returns how long the oldest pending action has been waiting at the given time
+func (b *Backlog) OldestPendingAge(now time.Time) time.Duration {
+	if b.OldestPendingAt == nil {
+		return 0
+	}
+	return now.Sub(*b.OldestPendingAt)
+}
+
+// Exceeded returns a description of every threshold the backlog is over at the given time.
+// An empty result means the backlog is healthy.
+func (b *Backlog) Exceeded(t BacklogThresholds, now time.Time) []string {
+	exceeded := make([]string, 0)
+	if t.MaxUnpublishedClaims > 0 && b.UnpublishedClaims > t.MaxUnpublishedClaims {
+		exceeded = append(exceeded, fmt.Sprintf("%d unpublished claims (max %d)", b.UnpublishedClaims, t.MaxUnpublishedClaims))
+	}
+	if t.MaxPendingRetries > 0 && b.PendingRetries > t.MaxPendingRetries {
+		exceeded = append(exceeded, fmt.Sprintf("%d pending retries (max %d)", b.PendingRetries, t.MaxPendingRetries))
+	}
+	if age := b.OldestPendingAge(now); t.MaxPendingAge > 0 && age > t.MaxPendingAge {
+		exceeded = append(exceeded, fmt.Sprintf("oldest pending action waiting for %s (max %s)", age.Truncate(time.Second), t.MaxPendingAge))
+	}
+	return exceeded
+}
@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBacklogExceeded(t *testing.T) {
+	now := time.Date(2023, 5, 6, 12, 0, 0, 0, time.UTC)
+	twoHoursAgo := now.Add(-2 * time.Hour)
+	thresholds := BacklogThresholds{MaxUnpublishedClaims: 100, MaxPendingRetries: 3, MaxPendingAge: time.Hour}
+
+	for _, tc := range []struct {
+		name       string
+		backlog    Backlog
+		thresholds BacklogThresholds
+		expected   []string
+	}{
+		{
+			name:       "empty backlog",
+			backlog:    Backlog{},
+			thresholds: thresholds,
+			expected:   []string{},
+		},
+		{
+			name:       "under the thresholds",
+			backlog:    Backlog{UnpublishedClaims: 100, PendingRetries: 3, OldestPendingAt: &twoHoursAgo},
+			thresholds: BacklogThresholds{MaxUnpublishedClaims: 100, MaxPendingRetries: 3, MaxPendingAge: 3 * time.Hour},
+			expected:   []string{},
+		},
+		{
+			name:       "over every threshold",
+			backlog:    Backlog{UnpublishedClaims: 101, PendingRetries: 4, OldestPendingAt: &twoHoursAgo},
+			thresholds: thresholds,
+			expected: []string{
+				"101 unpublished claims (max 100)",
+				"4 pending retries (max 3)",
+				"oldest pending action waiting for 2h0m0s (max 1h0m0s)",
+			},
+		},
+		{
+			name:       "disabled thresholds",
+			backlog:    Backlog{UnpublishedClaims: 1000, PendingRetries: 40, OldestPendingAt: &twoHoursAgo},
+			thresholds: BacklogThresholds{},
+			expected:   []string{},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.backlog.Exceeded(tc.thresholds, now))
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Entities of the changes
+const (
+	ChangeEntityCredential = "credential"
+	ChangeEntityConnection = "connection"
+)
+
+// Operations of the changes
+const (
+	ChangeCreated  = "created"
+	ChangeUpdated  = "updated"
+	ChangeRevoked  = "revoked"
+	ChangeDeleted  = "deleted"
+	ChangeRestored = "restored"
+)
+
+// ErrInvalidChangeCursor the cursor was not returned by the changes feed
+var ErrInvalidChangeCursor = errors.New("invalid change cursor")
+
+// ChangeCursor is the position of a change in the feed: the transaction that made it and its sequence number.
+// The zero cursor is the start of the feed.
+type ChangeCursor struct {
+	Tx  uint64
+	Seq int64
+}
+
+// String returns the opaque form of the cursor given to the clients
+func (c ChangeCursor) String() string {
+	if c == (ChangeCursor{}) {
+		return ""
+	}
+	return fmt.Sprintf("%d-%d", c.Tx, c.Seq)
+}
+
+// ParseChangeCursor parses a cursor returned by the feed. An empty cursor is the start of the feed.
+func ParseChangeCursor(s string) (ChangeCursor, error) {
+	if s == "" {
+		return ChangeCursor{}, nil
+	}
+	tx, seq, found := strings.Cut(s, "-")
+	if !found {
+		return ChangeCursor{}, ErrInvalidChangeCursor
+	}
+	var cursor ChangeCursor
+	var err error
+	if cursor.Tx, err = strconv.ParseUint(tx, 10, 64); err != nil {
+		return ChangeCursor{}, ErrInvalidChangeCursor
+	}
+	if cursor.Seq, err = strconv.ParseInt(seq, 10, 64); err != nil || cursor.Seq < 0 {
+		return ChangeCursor{}, ErrInvalidChangeCursor
+	}
+	return cursor, nil
+}
+
+// Change is a credential or a connection of an issuer that was created, updated, revoked, deleted or restored
+type Change struct {
+	Cursor    ChangeCursor
+	IssuerDID string
+	Entity    string
+	EntityID  uuid.UUID
+	Operation string
+	CreatedAt time.Time
+}
@@ -0,0 +1,25 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseChangeCursor(t *testing.T) {
+	cursor, err := ParseChangeCursor("")
+	require.NoError(t, err)
+	assert.Equal(t, ChangeCursor{}, cursor)
+	assert.Equal(t, "", cursor.String())
+
+	cursor, err = ParseChangeCursor("1024-17")
+	require.NoError(t, err)
+	assert.Equal(t, ChangeCursor{Tx: 1024, Seq: 17}, cursor)
+	assert.Equal(t, "1024-17", cursor.String())
+
+	for _, invalid := range []string{"1024", "a-1", "1-b", "1--1", "-1-1"} {
+		_, err := ParseChangeCursor(invalid)
+		assert.ErrorIs(t, err, ErrInvalidChangeCursor, invalid)
+	}
+}
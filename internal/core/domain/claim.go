@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/iden3/go-circuits"
@@ -41,13 +42,28 @@ type Claim struct {
 	CredentialStatus pgtype.JSONB    `json:"credential_status"`
 	HIndex           string          `json:"-"`
 
-	MtProof bool       `json:"mt_poof"`
-	LinkID  *uuid.UUID `json:"-"`
+	MtProof   bool       `json:"mt_poof"`
+	LinkID    *uuid.UUID `json:"-"`
+	DeletedAt *time.Time `json:"-"` // DeletedAt is set while the deleted claim is kept to be restored
+}
+
+// ExpiredClaim is a claim past its expiration date that has not been marked as expired yet.
+// AutoRevoke tells whether the schema policy asks to revoke the claim once it expires.
+type ExpiredClaim struct {
+	Claim
+	AutoRevoke bool
 }
 
 // Credentials is the type of array of credential
 type Credentials []*Claim
 
+// CredentialsSummary counts credentials. A credential can be both revoked and expired.
+type CredentialsSummary struct {
+	Total   int
+	Revoked int
+	Expired int
+}
+
 // FromClaimer TODO add description
 func FromClaimer(claim *core.Claim, schemaURL, schemaType string) (*Claim, error) {
 	otherIdentifier := ""
@@ -2,12 +2,24 @@ package domain
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
 	core "github.com/iden3/go-iden3-core"
 )
 
+// Limits of the metadata of a connection
+const (
+	MaxConnectionDisplayNameLength = 100
+	MaxConnectionNotesLength       = 2000
+	MaxConnectionTags              = 20
+	MaxConnectionTagLength         = 50
+)
+
 // Connection struct
 type Connection struct {
 	ID          uuid.UUID
@@ -18,4 +30,54 @@ type Connection struct {
 	CreatedAt   time.Time
 	ModifiedAt  time.Time
 	Credentials *Credentials
+	Metadata    *ConnectionMetadata
+	DeletedAt   *time.Time // DeletedAt is set while the deleted connection is kept to be restored
+}
+
+// ConnectionMetadata is what the operators write about a connection to recognize who it belongs to.
+// Connections without metadata have a nil Metadata.
+type ConnectionMetadata struct {
+	DisplayName string
+	Notes       string
+	Tags        []string
+	ModifiedAt  time.Time
+	Version     int // Version is incremented on every update, 0 while the connection has no metadata
+}
+
+// Validate checks the lengths of the metadata
+func (m *ConnectionMetadata) Validate() error {
+	if utf8.RuneCountInString(m.DisplayName) > MaxConnectionDisplayNameLength {
+		return fmt.Errorf("the display name cannot be longer than %d characters", MaxConnectionDisplayNameLength)
+	}
+	if utf8.RuneCountInString(m.Notes) > MaxConnectionNotesLength {
+		return fmt.Errorf("the notes cannot be longer than %d characters", MaxConnectionNotesLength)
+	}
+	if len(m.Tags) > MaxConnectionTags {
+		return fmt.Errorf("a connection cannot have more than %d tags", MaxConnectionTags)
+	}
+	for _, tag := range m.Tags {
+		if utf8.RuneCountInString(tag) > MaxConnectionTagLength {
+			return fmt.Errorf("tag %q is longer than %d characters", tag, MaxConnectionTagLength)
+		}
+		if strings.ContainsAny(tag, ", ") {
+			return errors.New("tags cannot have commas or spaces")
+		}
+	}
+	return nil
+}
+
+// NormalizeConnectionTags lowercases and trims the tags, skipping the empty and repeated ones, so the same tag
+// written in different ways is only stored and filtered once.
+func NormalizeConnectionTags(tags []string) []string {
+	normalized := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		normalized = append(normalized, tag)
+	}
+	return normalized
 }
@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeConnectionTags(t *testing.T) {
+	assert.Equal(t, []string{"vip", "employee"}, NormalizeConnectionTags([]string{" VIP", "employee", "", "vip ", "Employee"}))
+	assert.Empty(t, NormalizeConnectionTags(nil))
+}
+
+func TestConnectionMetadata_Validate(t *testing.T) {
+	assert.NoError(t, (&ConnectionMetadata{DisplayName: "Alice", Notes: "met at the conference", Tags: []string{"vip"}}).Validate())
+	assert.NoError(t, (&ConnectionMetadata{}).Validate())
+	assert.Error(t, (&ConnectionMetadata{DisplayName: strings.Repeat("a", MaxConnectionDisplayNameLength+1)}).Validate())
+	assert.Error(t, (&ConnectionMetadata{Notes: strings.Repeat("a", MaxConnectionNotesLength+1)}).Validate())
+	assert.Error(t, (&ConnectionMetadata{Tags: []string{strings.Repeat("a", MaxConnectionTagLength+1)}}).Validate())
+	assert.Error(t, (&ConnectionMetadata{Tags: []string{"two words"}}).Validate())
+
+	tags := make([]string, MaxConnectionTags+1)
+	for i := range tags {
+		tags[i] = strings.Repeat("a", i+1)
+	}
+	assert.Error(t, (&ConnectionMetadata{Tags: tags}).Validate())
+}
@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-schema-processor/verifiable"
+	"github.com/jackc/pgtype"
+)
+
+// CredentialDisplay is the part of a credential shown by the listings that does not change once it is issued. It is
+// stored with the credential, so the listings don't read and convert its W3C form and its proofs.
+type CredentialDisplay struct {
+	SchemaURL    string                 `json:"schemaURL"`
+	Subject      map[string]interface{} `json:"subject"`
+	IssuanceDate *time.Time             `json:"issuanceDate,omitempty"`
+	Expiration   *time.Time             `json:"expiration,omitempty"`
+	CoreClaim    string                 `json:"coreClaim"`
+	HIndex       string                 `json:"hIndex"`
+}
+
+// NewCredentialDisplay returns the display of the claim, taken from the W3C credential in its data
+func NewCredentialDisplay(claim *Claim) (*CredentialDisplay, error) {
+	if claim.Data.Status != pgtype.Present {
+		return nil, errors.New("the claim has no credential")
+	}
+	var w3c verifiable.W3CCredential
+	if err := json.Unmarshal(claim.Data.Bytes, &w3c); err != nil {
+		return nil, err
+	}
+	coreClaim, err := claim.CoreClaim.Get().Hex()
+	if err != nil {
+		return nil, err
+	}
+	display := &CredentialDisplay{
+		SchemaURL:    claim.SchemaURL,
+		Subject:      w3c.CredentialSubject,
+		IssuanceDate: w3c.IssuanceDate,
+		Expiration:   w3c.Expiration,
+		CoreClaim:    coreClaim,
+	}
+	if hIndex, err := claim.CoreClaim.Get().HIndex(); err == nil {
+		display.HIndex = hIndex.String()
+	}
+	return display, nil
+}
+
+// CredentialRow is a credential of a listing: its display and the state that changes after it is issued
+type CredentialRow struct {
+	ID                uuid.UUID
+	SchemaHash        string
+	SchemaType        string
+	HolderDID         string
+	RevNonce          RevNonceUint64
+	Revoked           bool
+	Status            *IdentityStatus
+	HasSignatureProof bool
+	HasMTProof        bool // HasMTProof is true for the credentials issued with a merkle tree proof, published or not
+	DeletedAt         *time.Time
+	Display           CredentialDisplay
+}
+
+// ProofTypes returns the names of the proofs the credential is issued with
+func (r *CredentialRow) ProofTypes() []string {
+	proofs := make([]string, 0, 2)
+	if r.HasSignatureProof {
+		proofs = append(proofs, string(verifiable.BJJSignatureProofType))
+	}
+	if r.HasMTProof {
+		proofs = append(proofs, string(verifiable.SparseMerkleTreeProof))
+	}
+	return proofs
+}
+
+// Expired returns true if the credential expiration date is before now
+func (r *CredentialRow) Expired(now time.Time) bool {
+	return r.Display.Expiration != nil && now.After(r.Display.Expiration.UTC())
+}
@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	core "github.com/iden3/go-iden3-core"
+	"github.com/iden3/go-schema-processor/verifiable"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCredentialDisplay(t *testing.T) {
+	holderDID, err := core.ParseDID("did:polygonid:polygon:mumbai:2qCU58EJgrELNZCDkSU23dQHZsBgAGQ6kJWUd8iW2T")
+	require.NoError(t, err)
+	coreClaim, err := core.NewClaim(core.SchemaHash{1}, core.WithIndexID(holderDID.ID), core.WithRevocationNonce(1234))
+	require.NoError(t, err)
+	coreClaimHex, err := coreClaim.Hex()
+	require.NoError(t, err)
+	hIndex, err := coreClaim.HIndex()
+	require.NoError(t, err)
+
+	issuanceDate := time.Date(2023, 5, 26, 9, 30, 0, 0, time.UTC)
+	expiration := issuanceDate.AddDate(1, 0, 0)
+	w3c, err := json.Marshal(verifiable.W3CCredential{
+		Type:         []string{"VerifiableCredential", "KYCAgeCredential"},
+		IssuanceDate: &issuanceDate,
+		Expiration:   &expiration,
+		CredentialSubject: map[string]interface{}{
+			"id":       holderDID.String(),
+			"birthday": 19960424,
+		},
+	})
+	require.NoError(t, err)
+
+	claim := &Claim{SchemaURL: "https://example.com/kyc-v3.json", CoreClaim: CoreClaim(*coreClaim)}
+	_, err = NewCredentialDisplay(claim)
+	assert.Error(t, err)
+
+	require.NoError(t, claim.Data.Set(w3c))
+	display, err := NewCredentialDisplay(claim)
+	require.NoError(t, err)
+	assert.Equal(t, "https://example.com/kyc-v3.json", display.SchemaURL)
+	assert.Equal(t, holderDID.String(), display.Subject["id"])
+	assert.Equal(t, float64(19960424), display.Subject["birthday"])
+	assert.Equal(t, issuanceDate, display.IssuanceDate.UTC())
+	assert.Equal(t, expiration, display.Expiration.UTC())
+	assert.Equal(t, coreClaimHex, display.CoreClaim)
+	assert.Equal(t, hIndex.String(), display.HIndex)
+
+	row := CredentialRow{HasSignatureProof: true, Display: *display}
+	assert.Equal(t, []string{string(verifiable.BJJSignatureProofType)}, row.ProofTypes())
+	assert.False(t, row.Expired(issuanceDate))
+	assert.True(t, row.Expired(expiration.Add(time.Second)))
+}
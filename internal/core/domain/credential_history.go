@@ -0,0 +1,32 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CredentialEventType is the kind of an event of the history of a credential
+type CredentialEventType string
+
+const (
+	CredentialIssued              CredentialEventType = "issued"               // CredentialIssued the credential was issued
+	CredentialOffered             CredentialEventType = "offered"              // CredentialOffered the credential was offered to the holder
+	CredentialOfferFetched        CredentialEventType = "offer.fetched"        // CredentialOfferFetched the holder fetched the credential with the offer
+	CredentialStateIncluded       CredentialEventType = "state.included"       // CredentialStateIncluded the credential was added to a state of the issuer
+	CredentialStatePublished      CredentialEventType = "state.published"      // CredentialStatePublished the state with the credential was confirmed on chain
+	CredentialRevoked             CredentialEventType = "revoked"              // CredentialRevoked the credential was revoked
+	CredentialRevocationPublished CredentialEventType = "revocation.published" // CredentialRevocationPublished the revocation was published in a state of the issuer
+)
+
+// CredentialEvent is an event of the history of a credential. Only the fields of its type are set: the offer of the
+// offer events, the state of the state events, and the reason of the revocation.
+type CredentialEvent struct {
+	Type        CredentialEventType
+	Time        time.Time
+	OfferID     *uuid.UUID
+	State       *string
+	TxID        *string
+	Reason      *RevocationReason
+	Description *string
+}
@@ -0,0 +1,30 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+)
+
+// CredentialOffer is an offer message sent to a holder. ID is the thread id of the message.
+// Each offered credential can be fetched once and only before the offer expires.
+type CredentialOffer struct {
+	ID            uuid.UUID
+	IssuerDID     core.DID
+	CredentialIDs []uuid.UUID
+	ExpiresAt     time.Time
+	CreatedAt     time.Time
+}
+
+// NewCredentialOffer returns a credential offer for the given credentials that expires after ttl
+func NewCredentialOffer(issuerDID core.DID, ttl time.Duration, credentialIDs ...uuid.UUID) *CredentialOffer {
+	now := time.Now().UTC()
+	return &CredentialOffer{
+		ID:            uuid.New(),
+		IssuerDID:     issuerDID,
+		CredentialIDs: credentialIDs,
+		ExpiresAt:     now.Add(ttl),
+		CreatedAt:     now,
+	}
+}
@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+)
+
+// CredentialTemplate is a named set of issuance values of a schema, so the credentials can be issued supplying only
+// the fields that change from one credential to another.
+type CredentialTemplate struct {
+	ID                uuid.UUID
+	IssuerDID         core.DID
+	Name              string
+	SchemaID          uuid.UUID
+	Schema            *Schema
+	CredentialSubject CredentialSubject
+	// ExpirationDays is how long the credentials issued from the template are valid. Nil if they do not expire.
+	ExpirationDays *int
+	SignatureProof bool
+	MTProof        bool
+	CreatedAt      time.Time
+}
+
+// NewCredentialTemplate returns a new credential template
+func NewCredentialTemplate(issuerDID core.DID, name string, schemaID uuid.UUID, credentialSubject CredentialSubject, expirationDays *int, signatureProof bool, mtProof bool) *CredentialTemplate {
+	if credentialSubject == nil {
+		credentialSubject = CredentialSubject{}
+	}
+	return &CredentialTemplate{
+		ID:                uuid.New(),
+		IssuerDID:         issuerDID,
+		Name:              name,
+		SchemaID:          schemaID,
+		CredentialSubject: credentialSubject,
+		ExpirationDays:    expirationDays,
+		SignatureProof:    signatureProof,
+		MTProof:           mtProof,
+		CreatedAt:         time.Now().UTC(),
+	}
+}
+
+// Validate returns an error if the template cannot be used to issue credentials
+func (t *CredentialTemplate) Validate() error {
+	if t.Name == "" {
+		return errors.New("the template needs a name")
+	}
+	if !t.SignatureProof && !t.MTProof {
+		return errors.New("at least one proof type should be enabled")
+	}
+	if t.ExpirationDays != nil && *t.ExpirationDays <= 0 {
+		return errors.New("the expiration days must be higher than 0")
+	}
+	return nil
+}
+
+// Subject returns the credential subject of a credential issued from the template: the default fields of the
+// template with the given fields, which take precedence.
+func (t *CredentialTemplate) Subject(fields CredentialSubject) CredentialSubject {
+	subject := make(CredentialSubject, len(t.CredentialSubject)+len(fields))
+	for k, v := range t.CredentialSubject {
+		subject[k] = v
+	}
+	for k, v := range fields {
+		subject[k] = v
+	}
+	return subject
+}
+
+// Expiration returns the expiration of a credential issued from the template at the given time, or nil if it does
+// not expire.
+func (t *CredentialTemplate) Expiration(issuedAt time.Time) *time.Time {
+	if t.ExpirationDays == nil {
+		return nil
+	}
+	expiration := issuedAt.AddDate(0, 0, *t.ExpirationDays)
+	return &expiration
+}
@@ -0,0 +1,41 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+)
+
+func TestCredentialTemplate_Validate(t *testing.T) {
+	template := NewCredentialTemplate(core.DID{}, "KYC call center", uuid.New(), nil, common.ToPointer(365), true, false)
+	assert.NoError(t, template.Validate())
+
+	template.Name = ""
+	assert.Error(t, template.Validate())
+
+	template = NewCredentialTemplate(core.DID{}, "KYC call center", uuid.New(), nil, nil, false, false)
+	assert.Error(t, template.Validate())
+
+	template = NewCredentialTemplate(core.DID{}, "KYC call center", uuid.New(), nil, common.ToPointer(0), false, true)
+	assert.Error(t, template.Validate())
+}
+
+func TestCredentialTemplate_Subject(t *testing.T) {
+	template := NewCredentialTemplate(core.DID{}, "KYC call center", uuid.New(), CredentialSubject{"documentType": 1, "countryCode": 980}, nil, true, false)
+	subject := template.Subject(CredentialSubject{"id": "did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ", "countryCode": 724})
+	assert.Equal(t, CredentialSubject{"id": "did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ", "documentType": 1, "countryCode": 724}, subject)
+	assert.Equal(t, CredentialSubject{"documentType": 1, "countryCode": 980}, template.CredentialSubject, "the defaults are not modified")
+}
+
+func TestCredentialTemplate_Expiration(t *testing.T) {
+	issuedAt := time.Date(2023, 5, 8, 10, 0, 0, 0, time.UTC)
+	assert.Nil(t, NewCredentialTemplate(core.DID{}, "no expiration", uuid.New(), nil, nil, true, false).Expiration(issuedAt))
+
+	expiration := NewCredentialTemplate(core.DID{}, "one year", uuid.New(), nil, common.ToPointer(365), true, false).Expiration(issuedAt)
+	assert.Equal(t, time.Date(2024, 5, 7, 10, 0, 0, 0, time.UTC), *expiration)
+}
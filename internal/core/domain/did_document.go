@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"errors"
+	"regexp"
+	"time"
+)
+
+var (
+	didServiceIDRegexp   = regexp.MustCompile(`^[A-Za-z0-9._-]{1,64}$`)
+	didServiceTypeRegexp = regexp.MustCompile(`^[A-Za-z0-9._:-]{1,100}$`)
+)
+
+// DIDService is a service entry of the DID document of an identity, like its agent or its push service. Its id is
+// the fragment of the service id in the document, e.g. iden3-communication for <DID>#iden3-communication.
+type DIDService struct {
+	Identifier      string
+	ID              string
+	Type            string
+	ServiceEndpoint string
+	Overridden      bool
+	UpdatedAt       *time.Time
+}
+
+// Validate returns an error if the service entry cannot be published in a DID document
+func (s *DIDService) Validate() error {
+	if !didServiceIDRegexp.MatchString(s.ID) {
+		return errors.New("the service id must have up to 64 letters, digits, dots, dashes or underscores")
+	}
+	if !didServiceTypeRegexp.MatchString(s.Type) {
+		return errors.New("the service type must have up to 100 letters, digits, dots, colons, dashes or underscores")
+	}
+	if !isWebURL(s.ServiceEndpoint) {
+		return errors.New("the service endpoint must be an http or https url")
+	}
+	return nil
+}
+
+// DIDDocument is what resolving the DID of an identity returns: the state contract where its states are
+// published, the latest published state and the service entries
+type DIDDocument struct {
+	Identifier           string
+	StateContractAddress string
+	State                *IdentityState
+	Services             []*DIDService
+}
+
+// Published returns true if a state of the identity, other than the genesis one, is published on chain
+func (d *DIDDocument) Published() bool {
+	return d.State != nil && d.State.TxID != nil
+}
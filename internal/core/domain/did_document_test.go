@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDIDService_Validate(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		service DIDService
+		valid   bool
+	}{
+		{
+			name:    "agent",
+			service: DIDService{ID: "iden3-communication", Type: "iden3-communication", ServiceEndpoint: "https://issuer.com/v1/agent"},
+			valid:   true,
+		},
+		{
+			name:    "push",
+			service: DIDService{ID: "push", Type: "push-notification", ServiceEndpoint: "https://push.issuer.com/api/v1"},
+			valid:   true,
+		},
+		{name: "no id", service: DIDService{Type: "push-notification", ServiceEndpoint: "https://push.issuer.com"}},
+		{name: "id with hash", service: DIDService{ID: "#push", Type: "push-notification", ServiceEndpoint: "https://push.issuer.com"}},
+		{name: "long id", service: DIDService{ID: strings.Repeat("a", 65), Type: "push-notification", ServiceEndpoint: "https://push.issuer.com"}},
+		{name: "no type", service: DIDService{ID: "push", ServiceEndpoint: "https://push.issuer.com"}},
+		{name: "type with spaces", service: DIDService{ID: "push", Type: "push notification", ServiceEndpoint: "https://push.issuer.com"}},
+		{name: "relative endpoint", service: DIDService{ID: "push", Type: "push-notification", ServiceEndpoint: "/api/v1"}},
+		{name: "endpoint not on the web", service: DIDService{ID: "push", Type: "push-notification", ServiceEndpoint: "ws://push.issuer.com"}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.service.Validate()
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestDIDDocument_Published(t *testing.T) {
+	txID := "0x1234"
+	assert.False(t, (&DIDDocument{}).Published())
+	assert.False(t, (&DIDDocument{State: &IdentityState{}}).Published())
+	assert.True(t, (&DIDDocument{State: &IdentityState{TxID: &txID}}).Published())
+}
@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+// Issuance lifecycle events sent to the message broker
+const (
+	EventCredentialCreated = "credential.created"
+	EventCredentialRevoked = "credential.revoked"
+	EventStatePublished    = "state.published"
+	EventConnectionCreated = "connection.created"
+)
+
+// Destinations of the outbox events
+const (
+	OutboxBroker = "broker" // OutboxBroker events are issuance lifecycle events sent to the message broker
+	OutboxPubSub = "pubsub" // OutboxPubSub events are notifications published in the pubsub of the node
+)
+
+// OutboxEvent is an event kept in the outbox until it is delivered to its destination. The type of a pubsub event is
+// its topic and its payload the marshaled event.
+type OutboxEvent struct {
+	ID          int64
+	Destination string
+	Type        string
+	IssuerDID   string
+	EntityID    string
+	Payload     json.RawMessage
+	Attempts    int
+	CreatedAt   time.Time
+}
+
+// BrokerEvent is the message of an event in the broker. ID is the same on every delivery of the event, so
+// consumers can discard the duplicates.
+type BrokerEvent struct {
+	ID       string          `json:"id"`
+	Type     string          `json:"type"`
+	IssuerID string          `json:"issuerID"`
+	EntityID string          `json:"entityID"`
+	Time     time.Time       `json:"time"`
+	Data     json.RawMessage `json:"data"`
+}
+
+// BrokerEvent returns the message of the event sent to the broker
+func (e *OutboxEvent) BrokerEvent() BrokerEvent {
+	return BrokerEvent{
+		ID:       strconv.FormatInt(e.ID, 10),
+		Type:     e.Type,
+		IssuerID: e.IssuerDID,
+		EntityID: e.EntityID,
+		Time:     e.CreatedAt,
+		Data:     e.Payload,
+	}
+}
@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"time"
+)
+
+// Feature is a capability of the issuer node that can be enabled or disabled per identity
+type Feature string
+
+const (
+	// FeatureRHS credentials are issued with a reverse hash service revocation status
+	FeatureRHS Feature = "rhs"
+	// FeatureAsyncIssuance credentials are issued in the background instead of in the request
+	FeatureAsyncIssuance Feature = "async_issuance"
+	// FeatureOID4VCI credentials can be issued through OpenID for Verifiable Credential Issuance
+	FeatureOID4VCI Feature = "oid4vci"
+	// FeatureTestMode the identity is used for testing, its credentials are not meant to be trusted
+	FeatureTestMode Feature = "test_mode"
+	// FeatureStatusList the JWT credentials have an entry in the StatusList2021 of the issuer
+	FeatureStatusList Feature = "status_list"
+)
+
+// Features are all the features that can be flagged
+var Features = []Feature{FeatureRHS, FeatureAsyncIssuance, FeatureOID4VCI, FeatureTestMode, FeatureStatusList}
+
+// IsValid returns true if the feature is a known one
+func (f Feature) IsValid() bool {
+	for _, feature := range Features {
+		if f == feature {
+			return true
+		}
+	}
+	return false
+}
+
+// FeatureFlag is the value of a feature for an identity. Flags that are not overridden take the value of the
+// configuration.
+type FeatureFlag struct {
+	Identifier string
+	Feature    Feature
+	Enabled    bool
+	Overridden bool
+	UpdatedAt  *time.Time
+}
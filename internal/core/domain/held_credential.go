@@ -0,0 +1,122 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+	"github.com/iden3/go-schema-processor/verifiable"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+)
+
+// ErrHeldCredentialWithoutProof is returned when a held credential has no proof that can be used in the query circuits
+var ErrHeldCredentialWithoutProof = errors.New("credential has no signature or merkle tree proof")
+
+// HeldCredential is a credential issued by another issuer to one of the identities of this node.
+// The node keeps them in its wallet so the identity can present them in verification flows.
+type HeldCredential struct {
+	ID           uuid.UUID
+	HolderDID    core.DID
+	IssuerDID    core.DID
+	CredentialID string
+	SchemaURL    string
+	SchemaType   string
+	ExpiresAt    *time.Time
+	Revoked      bool
+	Credential   verifiable.W3CCredential
+	CreatedAt    time.Time
+}
+
+// NewHeldCredential returns a held credential for the given holder and W3C credential
+func NewHeldCredential(holderDID core.DID, credential verifiable.W3CCredential) (*HeldCredential, error) {
+	issuerDID, err := core.ParseDID(credential.Issuer)
+	if err != nil {
+		return nil, fmt.Errorf("invalid credential issuer: %w", err)
+	}
+
+	if len(credential.Context) == 0 {
+		return nil, errors.New("credential has no context")
+	}
+
+	subjectType, ok := credential.CredentialSubject["type"].(string)
+	if !ok {
+		return nil, errors.New("credential subject has no type")
+	}
+
+	return &HeldCredential{
+		ID:           uuid.New(),
+		HolderDID:    holderDID,
+		IssuerDID:    *issuerDID,
+		CredentialID: credential.ID,
+		SchemaURL:    credential.CredentialSchema.ID,
+		SchemaType:   fmt.Sprintf("%s#%s", credential.Context[len(credential.Context)-1], subjectType),
+		ExpiresAt:    credential.Expiration,
+		Credential:   credential,
+		CreatedAt:    time.Now().UTC(),
+	}, nil
+}
+
+// SubjectDID returns the DID in the credential subject id
+func (h *HeldCredential) SubjectDID() string {
+	subject, _ := h.Credential.CredentialSubject["id"].(string)
+	return subject
+}
+
+// Claim returns the held credential as a claim, so it can be used to prepare the inputs of the query circuits
+// like the claims issued by the holder itself.
+func (h *HeldCredential) Claim() (*Claim, error) {
+	var coreClaim *core.Claim
+	var sigProof *verifiable.BJJSignatureProof2021
+	var mtpProof *verifiable.Iden3SparseMerkleTreeProof
+	for _, p := range h.Credential.Proof {
+		switch proof := p.(type) {
+		case *verifiable.BJJSignatureProof2021:
+			sigProof = proof
+		case *verifiable.Iden3SparseMerkleTreeProof:
+			mtpProof = proof
+		default:
+			continue
+		}
+		if coreClaim == nil {
+			var err error
+			if coreClaim, err = p.GetCoreClaim(); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if coreClaim == nil {
+		return nil, ErrHeldCredentialWithoutProof
+	}
+
+	claim, err := FromClaimer(coreClaim, h.SchemaURL, h.SchemaType)
+	if err != nil {
+		return nil, err
+	}
+
+	claim.ID = h.ID
+	claim.Identifier = common.ToPointer(h.HolderDID.String())
+	claim.Issuer = h.IssuerDID.String()
+	claim.Revoked = h.Revoked
+	if err := claim.Data.Set(h.Credential); err != nil {
+		return nil, err
+	}
+	if err := claim.CredentialStatus.Set(h.Credential.CredentialStatus); err != nil {
+		return nil, err
+	}
+	if sigProof != nil {
+		if err := claim.SignatureProof.Set(sigProof); err != nil {
+			return nil, err
+		}
+	}
+	if mtpProof != nil {
+		if err := claim.MTPProof.Set(mtpProof); err != nil {
+			return nil, err
+		}
+		claim.MtProof = true
+	}
+
+	return claim, nil
+}
@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"testing"
+
+	core "github.com/iden3/go-iden3-core"
+	"github.com/iden3/go-schema-processor/verifiable"
+	"github.com/jackc/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHeldCredential_Claim(t *testing.T) {
+	holderDID, err := core.ParseDID("did:polygonid:polygon:mumbai:2qCU58EJgrELNZCDkSU23dQHZsBgAGQ6kJWUd8iW2T")
+	require.NoError(t, err)
+	issuerDID := "did:polygonid:polygon:mumbai:2qCU58EJgrELNZCDkSU23dQHZsBgAGZhQ56o4GE9PD"
+
+	coreClaim, err := core.NewClaim(core.SchemaHash{1}, core.WithIndexID(holderDID.ID), core.WithRevocationNonce(1234))
+	require.NoError(t, err)
+	coreClaimHex, err := coreClaim.Hex()
+	require.NoError(t, err)
+
+	newCredential := func(proofs ...verifiable.CredentialProof) verifiable.W3CCredential {
+		return verifiable.W3CCredential{
+			ID:      "https://issuer.example.com/v1/credentials/1",
+			Context: []string{"https://www.w3.org/2018/credentials/v1", "https://example.com/kyc-v3.json-ld"},
+			Type:    []string{"VerifiableCredential", "KYCAgeCredential"},
+			CredentialSubject: map[string]interface{}{
+				"id":   holderDID.String(),
+				"type": "KYCAgeCredential",
+			},
+			Issuer:           issuerDID,
+			CredentialSchema: verifiable.CredentialSchema{ID: "https://example.com/kyc-v3.json", Type: "JsonSchemaValidator2018"},
+			Proof:            proofs,
+		}
+	}
+
+	t.Run("signature proof", func(t *testing.T) {
+		held, err := NewHeldCredential(*holderDID, newCredential(&verifiable.BJJSignatureProof2021{
+			Type:      verifiable.BJJSignatureProofType,
+			CoreClaim: coreClaimHex,
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, "https://example.com/kyc-v3.json-ld#KYCAgeCredential", held.SchemaType)
+		assert.Equal(t, holderDID.String(), held.SubjectDID())
+
+		claim, err := held.Claim()
+		require.NoError(t, err)
+		assert.Equal(t, held.ID, claim.ID)
+		assert.Equal(t, holderDID.String(), *claim.Identifier)
+		assert.Equal(t, issuerDID, claim.Issuer)
+		assert.Equal(t, holderDID.String(), claim.OtherIdentifier)
+		assert.Equal(t, RevNonceUint64(1234), claim.RevNonce)
+		assert.Equal(t, pgtype.Present, claim.SignatureProof.Status)
+		assert.NotEqual(t, pgtype.Present, claim.MTPProof.Status)
+		assert.False(t, claim.MtProof)
+	})
+
+	t.Run("merkle tree proof", func(t *testing.T) {
+		held, err := NewHeldCredential(*holderDID, newCredential(&verifiable.Iden3SparseMerkleTreeProof{
+			Type:      verifiable.Iden3SparseMerkleTreeProofType,
+			CoreClaim: coreClaimHex,
+		}))
+		require.NoError(t, err)
+
+		claim, err := held.Claim()
+		require.NoError(t, err)
+		assert.True(t, claim.MtProof)
+		assert.NotEqual(t, pgtype.Present, claim.SignatureProof.Status)
+	})
+
+	t.Run("without proofs", func(t *testing.T) {
+		held, err := NewHeldCredential(*holderDID, newCredential())
+		require.NoError(t, err)
+		_, err = held.Claim()
+		assert.ErrorIs(t, err, ErrHeldCredentialWithoutProof)
+	})
+
+	t.Run("invalid issuer", func(t *testing.T) {
+		credential := newCredential()
+		credential.Issuer = "https://issuer.example.com"
+		_, err := NewHeldCredential(*holderDID, credential)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,24 @@
+package domain
+
+import (
+	"net/http"
+	"time"
+)
+
+// IdempotencyKey is a request sent with an Idempotency-Key header, kept to answer its retries with the original
+// response. Principal is the user that sent it, the keys of every user are apart. RequestHash identifies the method,
+// the url and the body of the request. Status is 0 while the request is being processed.
+type IdempotencyKey struct {
+	Principal   string
+	Key         string
+	RequestHash string
+	Status      int
+	Header      http.Header
+	Body        []byte
+	CreatedAt   time.Time
+}
+
+// Completed tells whether the response of the request is stored
+func (k *IdempotencyKey) Completed() bool {
+	return k.Status != 0
+}
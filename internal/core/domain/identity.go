@@ -2,10 +2,23 @@ package domain
 
 import core "github.com/iden3/go-iden3-core"
 
+// IdentityKeyType is the type of the key that controls the identity state
+type IdentityKeyType string
+
+const (
+	// IdentityKeyTypeBJJ identities publish their states with a proof signed with their BabyJubJub auth key
+	IdentityKeyTypeBJJ IdentityKeyType = "BJJ"
+	// IdentityKeyTypeETH identities publish their states with transactions signed with the Ethereum key of
+	// the address in their identifier
+	IdentityKeyTypeETH IdentityKeyType = "ETH"
+)
+
 // Identity struct
 type Identity struct {
 	Identifier string
 	State      IdentityState
+	KeyType    IdentityKeyType
+	Address    *string
 }
 
 // NewIdentityFromIdentifier default identity model from identity and root state
@@ -16,5 +29,6 @@ func NewIdentityFromIdentifier(id *core.DID, rootState string) *Identity {
 			Identifier: id.String(),
 			State:      &rootState,
 		},
+		KeyType: IdentityKeyTypeBJJ,
 	}
 }
@@ -0,0 +1,117 @@
+package domain
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	// identityArchiveMagic starts every identity archive and versions its format
+	identityArchiveMagic = "ISSUERX1"
+
+	identityArchiveSaltSize = 16
+	identityArchiveScryptN  = 1 << 15
+	identityArchiveScryptR  = 8
+	identityArchiveScryptP  = 1
+)
+
+var (
+	// ErrInvalidIdentityArchive the data is not an identity archive
+	ErrInvalidIdentityArchive = errors.New("invalid identity archive")
+	// ErrIdentityArchivePassphrase the archive can't be decrypted with the passphrase
+	ErrIdentityArchivePassphrase = errors.New("wrong passphrase or corrupted identity archive")
+)
+
+// SealIdentityExport compresses the export and encrypts it with AES-256-GCM and a key derived from the passphrase
+// with scrypt. The archive is the magic, the salt and the nonce followed by the encrypted export.
+func SealIdentityExport(export *IdentityExport, passphrase string) ([]byte, error) {
+	var compressed bytes.Buffer
+	zw := gzip.NewWriter(&compressed)
+	if err := json.NewEncoder(zw).Encode(export); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+
+	salt := make([]byte, identityArchiveSaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	aead, err := identityArchiveCipher(passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	archive := make([]byte, 0, len(identityArchiveMagic)+len(salt)+len(nonce)+compressed.Len()+aead.Overhead())
+	archive = append(archive, identityArchiveMagic...)
+	archive = append(archive, salt...)
+	archive = append(archive, nonce...)
+	return aead.Seal(archive, nonce, compressed.Bytes(), []byte(identityArchiveMagic)), nil
+}
+
+// OpenIdentityExport decrypts an archive sealed by SealIdentityExport
+func OpenIdentityExport(archive []byte, passphrase string) (*IdentityExport, error) {
+	if !bytes.HasPrefix(archive, []byte(identityArchiveMagic)) {
+		return nil, ErrInvalidIdentityArchive
+	}
+	archive = archive[len(identityArchiveMagic):]
+	if len(archive) < identityArchiveSaltSize {
+		return nil, ErrInvalidIdentityArchive
+	}
+	salt, archive := archive[:identityArchiveSaltSize], archive[identityArchiveSaltSize:]
+	aead, err := identityArchiveCipher(passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+	if len(archive) < aead.NonceSize() {
+		return nil, ErrInvalidIdentityArchive
+	}
+	nonce, sealed := archive[:aead.NonceSize()], archive[aead.NonceSize():]
+	compressed, err := aead.Open(nil, nonce, sealed, []byte(identityArchiveMagic))
+	if err != nil {
+		return nil, ErrIdentityArchivePassphrase
+	}
+
+	zr, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidIdentityArchive, err)
+	}
+	data, err := io.ReadAll(zr)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidIdentityArchive, err)
+	}
+	var export IdentityExport
+	if err := json.Unmarshal(data, &export); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidIdentityArchive, err)
+	}
+	return &export, nil
+}
+
+func identityArchiveCipher(passphrase string, salt []byte) (cipher.AEAD, error) {
+	if passphrase == "" {
+		return nil, errors.New("the identity archive passphrase is empty")
+	}
+	key, err := scrypt.Key([]byte(passphrase), salt, identityArchiveScryptN, identityArchiveScryptR, identityArchiveScryptP, 32)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
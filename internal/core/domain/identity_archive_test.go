@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSealIdentityExport(t *testing.T) {
+	export := &IdentityExport{
+		Version:       IdentityExportVersion,
+		SchemaVersion: 202305240930000,
+		Identifier:    "did:polygonid:polygon:mumbai:2qFpPHotk6oyaX1fcrpQFT4BMnmg8YszUwxYtaoGoe",
+		ExportedAt:    time.Date(2023, 5, 25, 9, 30, 0, 0, time.UTC),
+		Roots:         IdentityExportRoots{Claims: "1", Revocations: "0", Roots: "2"},
+		Keys:          []IdentityExportKey{{Type: "BJJ", ID: "keys/bjj", PublicKey: "abcd"}},
+		Tables:        map[string]json.RawMessage{"claims": json.RawMessage(`[{"id":"8edd8112-c415-11ed-b036-debe37e1cbd6"}]`)},
+		MerkleTrees:   []IdentityExportMerkleTree{{Type: 0, Nodes: json.RawMessage(`[]`), Roots: json.RawMessage(`[]`)}},
+	}
+
+	archive, err := SealIdentityExport(export, "a long passphrase")
+	require.NoError(t, err)
+	assert.NotContains(t, string(archive), export.Identifier)
+
+	opened, err := OpenIdentityExport(archive, "a long passphrase")
+	require.NoError(t, err)
+	assert.Equal(t, export, opened)
+
+	t.Run("wrong passphrase", func(t *testing.T) {
+		_, err := OpenIdentityExport(archive, "another passphrase")
+		assert.ErrorIs(t, err, ErrIdentityArchivePassphrase)
+	})
+
+	t.Run("tampered archive", func(t *testing.T) {
+		tampered := append([]byte{}, archive...)
+		tampered[len(tampered)-1] ^= 1
+		_, err := OpenIdentityExport(tampered, "a long passphrase")
+		assert.ErrorIs(t, err, ErrIdentityArchivePassphrase)
+	})
+
+	t.Run("not an archive", func(t *testing.T) {
+		for _, invalid := range [][]byte{nil, []byte(`{"version":1}`), []byte(identityArchiveMagic + "salt")} {
+			_, err := OpenIdentityExport(invalid, "a long passphrase")
+			assert.ErrorIs(t, err, ErrInvalidIdentityArchive)
+		}
+	})
+
+	t.Run("empty passphrase", func(t *testing.T) {
+		_, err := SealIdentityExport(export, "")
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// IdentityExportVersion is the version of the format of the identity exports
+const IdentityExportVersion = 1
+
+// IdentityMigrationStatus is the status of an identity that is being moved to another node
+type IdentityMigrationStatus string
+
+const (
+	// IdentityMigrationReadOnly the identity can't be changed until the migration is completed or aborted
+	IdentityMigrationReadOnly IdentityMigrationStatus = "read_only"
+	// IdentityMigrationCompleted the identity was moved to another node and stays read only in this one
+	IdentityMigrationCompleted IdentityMigrationStatus = "completed"
+)
+
+// IdentityMigration is the migration of an identity from this node to another one
+type IdentityMigration struct {
+	Identifier  string
+	Status      IdentityMigrationStatus
+	Target      *string
+	CreatedAt   time.Time
+	CompletedAt *time.Time
+}
+
+// IdentityExport holds everything a node needs to take over an identity. The rows of the identity tables are
+// exported as JSON, so they can be inserted as they are in a node with the same database schema version.
+// Merkle trees are exported apart, as their IDs are assigned by each node.
+type IdentityExport struct {
+	Version       int                        `json:"version"`
+	SchemaVersion int64                      `json:"schemaVersion"`
+	Identifier    string                     `json:"identifier"`
+	ExportedAt    time.Time                  `json:"exportedAt"`
+	Roots         IdentityExportRoots        `json:"roots"`
+	Keys          []IdentityExportKey        `json:"keys"`
+	Tables        map[string]json.RawMessage `json:"tables"`
+	MerkleTrees   []IdentityExportMerkleTree `json:"merkleTrees"`
+}
+
+// IdentityExportRoots are the roots of the identity merkle trees at the time of the export
+type IdentityExportRoots struct {
+	Claims      string `json:"claims"`
+	Revocations string `json:"revocations"`
+	Roots       string `json:"roots"`
+}
+
+// IdentityExportKey is a key of the identity. Private keys are not exported, the target node must have access to
+// a key with the same public key.
+type IdentityExportKey struct {
+	Type      string `json:"type"`
+	ID        string `json:"id"`
+	PublicKey string `json:"publicKey"`
+}
+
+// IdentityExportMerkleTree holds the nodes and the root of a merkle tree of the identity
+type IdentityExportMerkleTree struct {
+	Type  uint16          `json:"type"`
+	Nodes json.RawMessage `json:"nodes"`
+	Roots json.RawMessage `json:"roots"`
+}
+
+// IdentityImportResult is the verification of an imported identity
+type IdentityImportResult struct {
+	Identifier string
+	// Rows is the number of rows imported per table
+	Rows map[string]int
+	// Keys are the key IDs of the identity in this node
+	Keys  []string
+	Roots IdentityExportRoots
+	// State is the latest state of the identity
+	State *string
+}
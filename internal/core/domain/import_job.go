@@ -0,0 +1,233 @@
+package domain
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+)
+
+// MaxImportRows is the maximum number of rows of an import file
+const MaxImportRows = 10000
+
+// DefaultImportReferenceColumn is the column of the import files with the out-of-band identifier of every row
+const DefaultImportReferenceColumn = "reference"
+
+var (
+	// ErrImportEmpty the import file has no rows
+	ErrImportEmpty = errors.New("the file has no rows")
+	// ErrImportTooManyRows the import file has more than MaxImportRows rows
+	ErrImportTooManyRows = fmt.Errorf("the file has more than %d rows", MaxImportRows)
+)
+
+// ImportMode is what an import job creates from every row
+type ImportMode string
+
+const (
+	// ImportModeClaims creates a credential for the holder in the id column of the row
+	ImportModeClaims ImportMode = "claims"
+	// ImportModeLinks creates a credential link that can be used once, so the holder does not have to be known
+	ImportModeLinks ImportMode = "links"
+)
+
+// ImportJobStatus is the progress of an import job
+type ImportJobStatus string
+
+const (
+	ImportJobPending   ImportJobStatus = "pending"   // ImportJobPending the rows have not been processed yet
+	ImportJobRunning   ImportJobStatus = "running"   // ImportJobRunning the rows are being processed
+	ImportJobCompleted ImportJobStatus = "completed" // ImportJobCompleted every row was processed, some of them can have failed
+	ImportJobFailed    ImportJobStatus = "failed"    // ImportJobFailed the job stopped before processing every row
+)
+
+// ImportJob issues the credentials of a CSV file in the background. Every row creates a credential or a credential
+// link of the schema, depending on the mode, and they can be matched with the rows by the reference of the row.
+type ImportJob struct {
+	ID                   uuid.UUID
+	IssuerDID            core.DID
+	SchemaID             uuid.UUID
+	Mode                 ImportMode
+	Status               ImportJobStatus
+	SignatureProof       bool
+	MTProof              bool
+	CredentialExpiration *time.Time
+	TotalRows            int
+	ProcessedRows        int
+	FailedRows           int
+	// Error is why the job failed
+	Error      *string
+	CreatedAt  time.Time
+	FinishedAt *time.Time
+}
+
+// NewImportJob returns a new pending import job
+func NewImportJob(issuerDID core.DID, schemaID uuid.UUID, mode ImportMode, signatureProof bool, mtProof bool, credentialExpiration *time.Time) *ImportJob {
+	return &ImportJob{
+		ID:                   uuid.New(),
+		IssuerDID:            issuerDID,
+		SchemaID:             schemaID,
+		Mode:                 mode,
+		Status:               ImportJobPending,
+		SignatureProof:       signatureProof,
+		MTProof:              mtProof,
+		CredentialExpiration: credentialExpiration,
+		CreatedAt:            time.Now(),
+	}
+}
+
+// Validate checks the mode and the proof types of the job
+func (j *ImportJob) Validate() error {
+	if j.Mode != ImportModeClaims && j.Mode != ImportModeLinks {
+		return fmt.Errorf("unknown mode %q", j.Mode)
+	}
+	if !j.SignatureProof && !j.MTProof {
+		return errors.New("at least one proof type should be enabled")
+	}
+	if j.CredentialExpiration != nil && j.CredentialExpiration.Before(time.Now()) {
+		return errors.New("the credential expiration cannot be a date time prior current time")
+	}
+	return nil
+}
+
+// Progress returns the percentage of processed rows
+func (j *ImportJob) Progress() int {
+	if j.TotalRows == 0 {
+		return 100
+	}
+	return j.ProcessedRows * 100 / j.TotalRows
+}
+
+// Finish marks the job as completed, or failed if err is not nil
+func (j *ImportJob) Finish(err error) {
+	j.Status = ImportJobCompleted
+	if err != nil {
+		j.Status = ImportJobFailed
+		msg := err.Error()
+		j.Error = &msg
+	}
+	now := time.Now()
+	j.FinishedAt = &now
+}
+
+// ImportRow is a row of an import file and the result of processing it
+type ImportRow struct {
+	JobID uuid.UUID
+	// Number is the position of the row in the file, starting at 1 for the row after the header
+	Number    int
+	Reference string
+	// Fields are the non empty values of the row by schema attribute. They are only kept in the background job that
+	// processes the row.
+	Fields  map[string]string
+	ClaimID *uuid.UUID
+	LinkID  *uuid.UUID
+	Error   *string
+}
+
+// Fail sets the error of the row
+func (r *ImportRow) Fail(err error) {
+	msg := err.Error()
+	r.Error = &msg
+}
+
+// ParseImportCSV reads the rows of a CSV file whose first row is the header. Every column is a schema attribute, or
+// the one it is renamed to in mapping, except referenceColumn, whose values identify the rows and must be unique.
+// The rows without a reference, with a duplicated one or with a wrong number of values are returned with an error.
+func ParseImportCSV(content io.Reader, mapping map[string]string, referenceColumn string) ([]string, []*ImportRow, error) {
+	data, err := io.ReadAll(content)
+	if err != nil {
+		return nil, nil, err
+	}
+	// Spreadsheet applications usually add a byte order mark to the CSV files
+	data = bytes.TrimPrefix(data, []byte("\ufeff"))
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil, ErrImportEmpty
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("reading the header: %w", err)
+	}
+
+	reference := -1
+	columns := make([]string, len(header))
+	seen := make(map[string]bool, len(header))
+	for i, name := range header {
+		name = strings.TrimSpace(name)
+		if name == referenceColumn {
+			reference = i
+			continue
+		}
+		if attr, ok := mapping[name]; ok {
+			name = attr
+		}
+		if name == "" {
+			return nil, nil, fmt.Errorf("column %d has no name", i+1)
+		}
+		if seen[name] {
+			return nil, nil, fmt.Errorf("attribute %q is in more than one column", name)
+		}
+		seen[name] = true
+		columns[i] = name
+	}
+	if reference < 0 {
+		return nil, nil, fmt.Errorf("missing reference column %q", referenceColumn)
+	}
+
+	var rows []*ImportRow
+	references := make(map[string]int)
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(rows) == MaxImportRows {
+			return nil, nil, ErrImportTooManyRows
+		}
+
+		row := &ImportRow{Number: len(rows) + 1, Fields: make(map[string]string)}
+		rows = append(rows, row)
+		if len(record) != len(header) {
+			row.Fail(fmt.Errorf("expected %d values, got %d", len(header), len(record)))
+			continue
+		}
+		row.Reference = strings.TrimSpace(record[reference])
+		if row.Reference == "" {
+			row.Fail(errors.New("missing reference"))
+			continue
+		}
+		if number, ok := references[row.Reference]; ok {
+			row.Fail(fmt.Errorf("reference already used in row %d", number))
+			continue
+		}
+		references[row.Reference] = row.Number
+		for i, value := range record {
+			if i == reference || strings.TrimSpace(value) == "" {
+				continue
+			}
+			row.Fields[columns[i]] = strings.TrimSpace(value)
+		}
+	}
+	if len(rows) == 0 {
+		return nil, nil, ErrImportEmpty
+	}
+
+	attributes := make([]string, 0, len(columns)-1)
+	for i, column := range columns {
+		if i != reference {
+			attributes = append(attributes, column)
+		}
+	}
+	return attributes, rows, nil
+}
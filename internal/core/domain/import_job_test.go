@@ -0,0 +1,74 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+)
+
+func TestImportJob_Validate(t *testing.T) {
+	assert.NoError(t, NewImportJob(core.DID{}, uuid.New(), ImportModeClaims, true, false, nil).Validate())
+	assert.NoError(t, NewImportJob(core.DID{}, uuid.New(), ImportModeLinks, false, true, common.ToPointer(time.Now().Add(time.Hour))).Validate())
+	assert.Error(t, NewImportJob(core.DID{}, uuid.New(), "emails", true, false, nil).Validate())
+	assert.Error(t, NewImportJob(core.DID{}, uuid.New(), ImportModeClaims, false, false, nil).Validate())
+	assert.Error(t, NewImportJob(core.DID{}, uuid.New(), ImportModeClaims, true, false, common.ToPointer(time.Now().Add(-time.Hour))).Validate())
+}
+
+func TestImportJob_Progress(t *testing.T) {
+	job := NewImportJob(core.DID{}, uuid.New(), ImportModeLinks, true, false, nil)
+	job.TotalRows, job.ProcessedRows = 3, 1
+	assert.Equal(t, 33, job.Progress())
+
+	job.Finish(nil)
+	assert.Equal(t, ImportJobCompleted, job.Status)
+	assert.NotNil(t, job.FinishedAt)
+}
+
+func TestParseImportCSV(t *testing.T) {
+	content := "\ufeffreference,id,birth date,documentType\n" +
+		"emp-1,did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ,19960424,2\n" +
+		"emp-2,did:polygonid:polygon:mumbai:2qFDziX3k3h7To2jDJbQiXFtcozbgSNNasnHGPJHGR,, 1\n" +
+		",did:polygonid:polygon:mumbai:2qFDziX3k3h7To2jDJbQiXFtcozbgSNNasnHGPJHGR,19960424,2\n" +
+		"emp-1,did:polygonid:polygon:mumbai:2qFDziX3k3h7To2jDJbQiXFtcozbgSNNasnHGPJHGR,19960424,2\n" +
+		"emp-3,19960424\n"
+
+	attributes, rows, err := ParseImportCSV(strings.NewReader(content), map[string]string{"birth date": "birthday"}, DefaultImportReferenceColumn)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"id", "birthday", "documentType"}, attributes)
+	require.Len(t, rows, 5)
+
+	assert.Equal(t, 1, rows[0].Number)
+	assert.Equal(t, "emp-1", rows[0].Reference)
+	assert.Equal(t, map[string]string{"id": "did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ", "birthday": "19960424", "documentType": "2"}, rows[0].Fields)
+	assert.Nil(t, rows[0].Error)
+
+	assert.Equal(t, map[string]string{"id": "did:polygonid:polygon:mumbai:2qFDziX3k3h7To2jDJbQiXFtcozbgSNNasnHGPJHGR", "documentType": "1"}, rows[1].Fields, "empty values are skipped")
+	assert.Equal(t, "missing reference", *rows[2].Error)
+	assert.Equal(t, "reference already used in row 1", *rows[3].Error)
+	assert.Equal(t, "expected 4 values, got 2", *rows[4].Error)
+}
+
+func TestParseImportCSV_Errors(t *testing.T) {
+	for name, content := range map[string]string{
+		"empty file":         "",
+		"only header":        "reference,id\n",
+		"missing reference":  "id,documentType\ndid:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ,2\n",
+		"duplicated columns": "reference,documentType,documentType\nemp-1,1,2\n",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, _, err := ParseImportCSV(strings.NewReader(content), nil, DefaultImportReferenceColumn)
+			assert.Error(t, err)
+		})
+	}
+
+	content := "reference,documentType\n" + strings.Repeat("emp,1\n", MaxImportRows+1)
+	_, _, err := ParseImportCSV(strings.NewReader(content), nil, DefaultImportReferenceColumn)
+	assert.ErrorIs(t, err, ErrImportTooManyRows)
+}
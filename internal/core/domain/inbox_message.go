@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+	"github.com/iden3/iden3comm"
+)
+
+// InboxMessageStatus is the outcome of a message received by the agent
+type InboxMessageStatus string
+
+const (
+	InboxMessageProcessed InboxMessageStatus = "processed" // InboxMessageProcessed the agent answered the message
+	InboxMessageRejected  InboxMessageStatus = "rejected"  // InboxMessageRejected the message could not be unpacked or it is not a valid agent request, Error says why
+	InboxMessageFailed    InboxMessageStatus = "failed"    // InboxMessageFailed the agent could not answer the message, Error says why
+)
+
+// InboxMessage is a message received by the agent endpoint of an issuer, kept for the operators to debug the
+// exchanges with the wallets. Envelope is the body as it was received, once the transport encryption is removed. The
+// metadata is the one of the unpacked message, so it is empty when the envelope could not be unpacked.
+type InboxMessage struct {
+	ID           uuid.UUID
+	IssuerDID    core.DID
+	Sender       string
+	MessageID    string
+	ThreadID     string
+	Type         string
+	MediaType    string
+	Status       InboxMessageStatus
+	Error        string
+	ResponseType string
+	Envelope     []byte
+	CreatedAt    time.Time
+}
+
+// NewInboxMessage returns the inbox message of the envelope received by the agent of the issuer. basicMessage is nil
+// when the envelope could not be unpacked.
+func NewInboxMessage(issuerDID core.DID, envelope []byte, basicMessage *iden3comm.BasicMessage, mediaType iden3comm.MediaType) *InboxMessage {
+	message := &InboxMessage{
+		ID:        uuid.New(),
+		IssuerDID: issuerDID,
+		MediaType: string(mediaType),
+		Envelope:  envelope,
+		CreatedAt: time.Now().UTC(),
+	}
+	if basicMessage != nil {
+		message.Sender = basicMessage.From
+		message.MessageID = basicMessage.ID
+		message.ThreadID = basicMessage.ThreadID
+		message.Type = string(basicMessage.Type)
+	}
+	return message
+}
+
+// Reject records that the message could not be unpacked or it is not a valid agent request
+func (m *InboxMessage) Reject(err error) {
+	m.Status, m.Error = InboxMessageRejected, err.Error()
+}
+
+// Fail records that the agent could not answer the message, and the problem report it answered with, if any
+func (m *InboxMessage) Fail(err error, report *Agent) {
+	m.Status, m.Error = InboxMessageFailed, err.Error()
+	if report != nil {
+		m.ResponseType = string(report.Type)
+	}
+}
+
+// Process records that the agent answered the message with the response
+func (m *InboxMessage) Process(response *Agent) {
+	m.Status, m.Error = InboxMessageProcessed, ""
+	if response != nil {
+		m.ResponseType = string(response.Type)
+	}
+}
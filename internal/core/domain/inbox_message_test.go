@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+
+	core "github.com/iden3/go-iden3-core"
+	"github.com/iden3/iden3comm"
+	"github.com/iden3/iden3comm/packers"
+	"github.com/iden3/iden3comm/protocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewInboxMessage(t *testing.T) {
+	did, err := core.ParseDID("did:polygonid:polygon:mumbai:2qH7XAwYQzCp9VfhpNgeLtK2iCehDDrfMWUCEg5ig5")
+	require.NoError(t, err)
+
+	message := NewInboxMessage(*did, []byte("envelope"), &iden3comm.BasicMessage{
+		ID:       "1",
+		ThreadID: "2",
+		Type:     protocol.CredentialFetchRequestMessageType,
+		From:     "did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ",
+	}, packers.MediaTypeZKPMessage)
+	assert.Equal(t, "did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ", message.Sender)
+	assert.Equal(t, "1", message.MessageID)
+	assert.Equal(t, "2", message.ThreadID)
+	assert.Equal(t, string(protocol.CredentialFetchRequestMessageType), message.Type)
+	assert.Equal(t, string(packers.MediaTypeZKPMessage), message.MediaType)
+	assert.Equal(t, []byte("envelope"), message.Envelope)
+
+	message.Fail(errors.New("offer expired"), &Agent{Type: "https://didcomm.org/report-problem/2.0/problem-report"})
+	assert.Equal(t, InboxMessageFailed, message.Status)
+	assert.Equal(t, "offer expired", message.Error)
+	assert.Equal(t, "https://didcomm.org/report-problem/2.0/problem-report", message.ResponseType)
+
+	message.Process(&Agent{Type: protocol.CredentialIssuanceResponseMessageType})
+	assert.Equal(t, InboxMessageProcessed, message.Status)
+	assert.Empty(t, message.Error)
+	assert.Equal(t, string(protocol.CredentialIssuanceResponseMessageType), message.ResponseType)
+
+	unpacked := NewInboxMessage(*did, []byte("garbage"), nil, "")
+	unpacked.Reject(errors.New("unknown media type"))
+	assert.Equal(t, InboxMessageRejected, unpacked.Status)
+	assert.Empty(t, unpacked.Sender)
+	assert.Equal(t, "unknown media type", unpacked.Error)
+}
@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"errors"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"time"
+)
+
+var colorRegexp = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+// IssuerProfile is how the holders see an issuer: the name, logo and colors shown in the credential offers, the
+// landing pages of the links and the wallets, and how to contact it. Identities without a profile take the one of
+// the configuration.
+type IssuerProfile struct {
+	Identifier      string
+	DisplayName     string
+	LogoURL         string
+	BackgroundColor string
+	TextColor       string
+	ContactEmail    string
+	ContactURL      string
+	Overridden      bool
+	UpdatedAt       *time.Time
+}
+
+// Validate returns an error if a field of the profile is set to a value that cannot be shown
+func (p *IssuerProfile) Validate() error {
+	if len(p.DisplayName) > 100 {
+		return errors.New("the display name cannot be longer than 100 characters")
+	}
+	if p.LogoURL != "" && !isWebURL(p.LogoURL) {
+		return errors.New("the logo must be an http or https url")
+	}
+	if p.BackgroundColor != "" && !colorRegexp.MatchString(p.BackgroundColor) {
+		return errors.New("the background color must be a hex color like #1a2b3c")
+	}
+	if p.TextColor != "" && !colorRegexp.MatchString(p.TextColor) {
+		return errors.New("the text color must be a hex color like #1a2b3c")
+	}
+	if p.ContactEmail != "" {
+		if addr, err := mail.ParseAddress(p.ContactEmail); err != nil || addr.Address != p.ContactEmail {
+			return errors.New("invalid contact email")
+		}
+	}
+	if p.ContactURL != "" && !isWebURL(p.ContactURL) {
+		return errors.New("the contact url must be an http or https url")
+	}
+	return nil
+}
+
+func isWebURL(s string) bool {
+	u, err := url.ParseRequestURI(s)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
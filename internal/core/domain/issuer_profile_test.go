@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIssuerProfile_Validate(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		profile IssuerProfile
+		valid   bool
+	}{
+		{name: "empty", valid: true},
+		{
+			name: "complete",
+			profile: IssuerProfile{
+				DisplayName:     "My issuer",
+				LogoURL:         "https://my-issuer.com/logo.png",
+				BackgroundColor: "#1A2B3c",
+				TextColor:       "#ffffff",
+				ContactEmail:    "support@my-issuer.com",
+				ContactURL:      "https://my-issuer.com/contact",
+			},
+			valid: true,
+		},
+		{name: "long display name", profile: IssuerProfile{DisplayName: strings.Repeat("a", 101)}},
+		{name: "relative logo", profile: IssuerProfile{LogoURL: "/logo.png"}},
+		{name: "logo not on the web", profile: IssuerProfile{LogoURL: "ftp://my-issuer.com/logo.png"}},
+		{name: "named color", profile: IssuerProfile{BackgroundColor: "purple"}},
+		{name: "short color", profile: IssuerProfile{TextColor: "#fff"}},
+		{name: "email with name", profile: IssuerProfile{ContactEmail: "Support <support@my-issuer.com>"}},
+		{name: "invalid email", profile: IssuerProfile{ContactEmail: "support"}},
+		{name: "invalid contact url", profile: IssuerProfile{ContactURL: "my-issuer.com"}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.profile.Validate()
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
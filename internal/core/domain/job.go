@@ -0,0 +1,140 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Kinds of the background jobs
+const (
+	JobKindImport            = "import"             // JobKindImport issues the rows of an import job
+	JobKindStateConfirmation = "state.confirmation" // JobKindStateConfirmation waits for the transaction of a published state
+)
+
+const (
+	jobMinBackoff = 10 * time.Second // jobMinBackoff is the wait before the first retry of a failed job
+	jobMaxBackoff = time.Hour        // jobMaxBackoff is the longest wait between two attempts of a job
+)
+
+// ErrJobNotReady is returned, wrapped, by the handlers of the jobs that have to wait for something else, like a
+// transaction to be mined. The job runs again later and the attempt is not counted.
+var ErrJobNotReady = errors.New("job not ready")
+
+// JobStatus is the state of a background job
+type JobStatus string
+
+const (
+	JobPending   JobStatus = "pending"   // JobPending the job waits to run at RunAt
+	JobRunning   JobStatus = "running"   // JobRunning a worker is running the job
+	JobCompleted JobStatus = "completed" // JobCompleted the job finished successfully
+	JobCancelled JobStatus = "cancelled" // JobCancelled the job was cancelled before it finished
+	JobDead      JobStatus = "dead"      // JobDead the job failed every attempt, it stays in the dead letter until it is retried or cancelled
+)
+
+// Job is a unit of background work of a kind, run by the workers that handle it. A failed job runs again with an
+// exponential backoff until it fails MaxAttempts times.
+type Job struct {
+	ID          uuid.UUID
+	Kind        string
+	IssuerDID   string
+	Payload     json.RawMessage
+	Status      JobStatus
+	Attempts    int
+	MaxAttempts int
+	LastError   *string
+	RunAt       time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	FinishedAt  *time.Time
+}
+
+// NewJob returns a pending job that runs as soon as possible
+func NewJob(kind string, issuerDID string, payload any, maxAttempts int) (*Job, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	return &Job{
+		ID:          uuid.New(),
+		Kind:        kind,
+		IssuerDID:   issuerDID,
+		Payload:     data,
+		Status:      JobPending,
+		MaxAttempts: maxAttempts,
+		RunAt:       now,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}, nil
+}
+
+// Complete marks the job as completed
+func (j *Job) Complete() {
+	now := time.Now()
+	j.Status = JobCompleted
+	j.LastError = nil
+	j.UpdatedAt = now
+	j.FinishedAt = &now
+}
+
+// Fail records the failed attempt. The job runs again after the backoff of its attempts, or goes to the dead letter
+// when it has no attempts left. A job that is not ready runs again after wait, without counting the attempt.
+func (j *Job) Fail(err error, wait time.Duration) {
+	msg := err.Error()
+	j.LastError = &msg
+	j.UpdatedAt = time.Now()
+	if errors.Is(err, ErrJobNotReady) {
+		j.Attempts--
+		j.Status = JobPending
+		j.RunAt = j.UpdatedAt.Add(wait)
+		return
+	}
+	if j.Attempts >= j.MaxAttempts {
+		j.Status = JobDead
+		j.FinishedAt = &j.UpdatedAt
+		return
+	}
+	j.Status = JobPending
+	j.RunAt = j.UpdatedAt.Add(j.Backoff())
+}
+
+// Backoff returns the wait before the next attempt: it doubles with every attempt, up to an hour
+func (j *Job) Backoff() time.Duration {
+	backoff := jobMinBackoff
+	for i := 1; i < j.Attempts && backoff < jobMaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > jobMaxBackoff {
+		return jobMaxBackoff
+	}
+	return backoff
+}
+
+// Retry sends a dead or cancelled job back to the queue with all its attempts
+func (j *Job) Retry() error {
+	if j.Status != JobDead && j.Status != JobCancelled {
+		return errors.New("only dead or cancelled jobs can be retried")
+	}
+	now := time.Now()
+	j.Status = JobPending
+	j.Attempts = 0
+	j.RunAt = now
+	j.UpdatedAt = now
+	j.FinishedAt = nil
+	return nil
+}
+
+// Cancel stops a job that is not running nor finished. A dead job is cancelled to remove it from the dead letter.
+func (j *Job) Cancel() error {
+	if j.Status != JobPending && j.Status != JobDead {
+		return errors.New("only pending or dead jobs can be cancelled")
+	}
+	now := time.Now()
+	j.Status = JobCancelled
+	j.UpdatedAt = now
+	j.FinishedAt = &now
+	return nil
+}
@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJob_Fail(t *testing.T) {
+	job, err := NewJob(JobKindImport, "did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ", map[string]string{"id": "1"}, 2)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id":"1"}`, string(job.Payload))
+
+	job.Attempts = 1
+	job.Fail(errors.New("database down"), time.Minute)
+	assert.Equal(t, JobPending, job.Status)
+	assert.Equal(t, "database down", *job.LastError)
+	assert.WithinDuration(t, time.Now().Add(jobMinBackoff), job.RunAt, time.Second)
+
+	job.Attempts = 2
+	job.Fail(fmt.Errorf("%w: transaction not mined", ErrJobNotReady), time.Minute)
+	assert.Equal(t, JobPending, job.Status)
+	assert.Equal(t, 1, job.Attempts, "waiting is not an attempt")
+	assert.WithinDuration(t, time.Now().Add(time.Minute), job.RunAt, time.Second)
+
+	job.Attempts = 2
+	job.Fail(errors.New("database down"), time.Minute)
+	assert.Equal(t, JobDead, job.Status)
+	assert.NotNil(t, job.FinishedAt)
+}
+
+func TestJob_Backoff(t *testing.T) {
+	job := &Job{}
+	for attempts, expected := range map[int]time.Duration{
+		0:  10 * time.Second,
+		1:  10 * time.Second,
+		2:  20 * time.Second,
+		4:  80 * time.Second,
+		30: time.Hour,
+	} {
+		job.Attempts = attempts
+		assert.Equal(t, expected, job.Backoff(), "attempts %d", attempts)
+	}
+}
+
+func TestJob_RetryAndCancel(t *testing.T) {
+	job, err := NewJob(JobKindStateConfirmation, "", nil, 3)
+	require.NoError(t, err)
+	assert.Error(t, job.Retry(), "pending jobs are already in the queue")
+
+	job.Status = JobRunning
+	assert.Error(t, job.Cancel())
+
+	job.Status, job.Attempts = JobDead, 3
+	require.NoError(t, job.Retry())
+	assert.Equal(t, JobPending, job.Status)
+	assert.Zero(t, job.Attempts)
+	assert.Nil(t, job.FinishedAt)
+
+	require.NoError(t, job.Cancel())
+	assert.Equal(t, JobCancelled, job.Status)
+	assert.NotNil(t, job.FinishedAt)
+
+	job.Complete()
+	assert.Error(t, job.Cancel())
+	assert.Error(t, job.Retry())
+}
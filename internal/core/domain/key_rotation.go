@@ -0,0 +1,95 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+)
+
+// AuthKeyStatus is the status of an issuer auth key
+type AuthKeyStatus string
+
+const (
+	// AuthKeyStatusPending the auth claim of the key is not in a confirmed state yet
+	AuthKeyStatusPending AuthKeyStatus = "pending"
+	// AuthKeyStatusActive the auth claim of the key is in a confirmed state and it can sign credentials and states
+	AuthKeyStatusActive AuthKeyStatus = "active"
+	// AuthKeyStatusRevoked the auth claim of the key has been revoked
+	AuthKeyStatusRevoked AuthKeyStatus = "revoked"
+)
+
+// AuthKey is a BabyJubJub key of the issuer, identified by the auth claim that adds it to the claims tree
+type AuthKey struct {
+	ClaimID  uuid.UUID
+	X        string
+	Y        string
+	RevNonce uint64
+	Status   AuthKeyStatus
+}
+
+// NewAuthKey returns the auth key added by an auth claim
+func NewAuthKey(authClaim *Claim) (*AuthKey, error) {
+	coreClaim := authClaim.CoreClaim.Get()
+	if coreClaim.GetSchemaHash() != core.AuthSchemaHash {
+		return nil, errors.New("claim is not an auth claim")
+	}
+	slots := coreClaim.RawSlotsAsInts()
+
+	key := &AuthKey{
+		ClaimID:  authClaim.ID,
+		X:        slots[2].String(),
+		Y:        slots[3].String(),
+		RevNonce: uint64(authClaim.RevNonce),
+		Status:   AuthKeyStatusPending,
+	}
+	switch {
+	case authClaim.Revoked:
+		key.Status = AuthKeyStatusRevoked
+	case authClaim.Status != nil && *authClaim.Status == StatusConfirmed:
+		key.Status = AuthKeyStatusActive
+	}
+	return key, nil
+}
+
+// KeyRotationStatus is the status of an auth key rotation
+type KeyRotationStatus string
+
+const (
+	// KeyRotationStatusPending the new auth key is not confirmed yet, so the old one is still valid
+	KeyRotationStatusPending KeyRotationStatus = "pending"
+	// KeyRotationStatusCompleted the new auth key is confirmed and the old one has been revoked
+	KeyRotationStatusCompleted KeyRotationStatus = "completed"
+)
+
+// KeyRotation replaces the auth key of an issuer. The old auth claim is revoked once the state with the new one is
+// confirmed on chain, so the issuer always has a valid key.
+type KeyRotation struct {
+	ID          uuid.UUID
+	Identifier  string
+	OldClaimID  uuid.UUID
+	NewClaimID  uuid.UUID
+	Status      KeyRotationStatus
+	CreatedAt   time.Time
+	CompletedAt *time.Time
+}
+
+// NewKeyRotation returns a pending rotation from the old auth claim to the new one
+func NewKeyRotation(identifier core.DID, oldClaimID, newClaimID uuid.UUID) *KeyRotation {
+	return &KeyRotation{
+		ID:         uuid.New(),
+		Identifier: identifier.String(),
+		OldClaimID: oldClaimID,
+		NewClaimID: newClaimID,
+		Status:     KeyRotationStatusPending,
+		CreatedAt:  time.Now().UTC(),
+	}
+}
+
+// Complete marks the rotation as completed
+func (k *KeyRotation) Complete() {
+	now := time.Now().UTC()
+	k.Status = KeyRotationStatusCompleted
+	k.CompletedAt = &now
+}
@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+)
+
+func TestNewAuthKey(t *testing.T) {
+	coreClaim, err := core.NewClaim(core.AuthSchemaHash, core.WithIndexDataInts(big.NewInt(10), big.NewInt(20)), core.WithRevocationNonce(7))
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		name    string
+		revoked bool
+		status  *IdentityStatus
+		expect  AuthKeyStatus
+	}{
+		{name: "not published", expect: AuthKeyStatusPending},
+		{name: "being published", status: common.ToPointer(StatusTransacted), expect: AuthKeyStatusPending},
+		{name: "confirmed", status: common.ToPointer(StatusConfirmed), expect: AuthKeyStatusActive},
+		{name: "revoked", revoked: true, status: common.ToPointer(StatusConfirmed), expect: AuthKeyStatusRevoked},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			claim := &Claim{ID: uuid.New(), CoreClaim: CoreClaim(*coreClaim), RevNonce: 7, Revoked: tc.revoked, Status: tc.status}
+			key, err := NewAuthKey(claim)
+			require.NoError(t, err)
+			assert.Equal(t, claim.ID, key.ClaimID)
+			assert.Equal(t, "10", key.X)
+			assert.Equal(t, "20", key.Y)
+			assert.Equal(t, uint64(7), key.RevNonce)
+			assert.Equal(t, tc.expect, key.Status)
+		})
+	}
+
+	otherClaim, err := core.NewClaim(core.SchemaHash{0x01})
+	require.NoError(t, err)
+	_, err = NewAuthKey(&Claim{CoreClaim: CoreClaim(*otherClaim)})
+	assert.Error(t, err)
+}
+
+func TestKeyRotation_Complete(t *testing.T) {
+	did, err := core.ParseDID("did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ")
+	require.NoError(t, err)
+	rotation := NewKeyRotation(*did, uuid.New(), uuid.New())
+	assert.Equal(t, KeyRotationStatusPending, rotation.Status)
+	assert.Nil(t, rotation.CompletedAt)
+
+	rotation.Complete()
+	assert.Equal(t, KeyRotationStatusCompleted, rotation.Status)
+	assert.NotNil(t, rotation.CompletedAt)
+}
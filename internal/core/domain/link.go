@@ -73,7 +73,12 @@ type Link struct {
 	CredentialSubject        CredentialSubject
 	Active                   bool
 	Schema                   *Schema
-	IssuedClaims             int // TODO: Give a value when link redemption is implemented
+	IssuedClaims             int                 // TODO: Give a value when link redemption is implemented
+	ProofScope               []VerificationQuery // ProofScope are the queries the holder must prove before the credential is issued
+	Version                  int                 // Version is incremented on every update, so concurrent updates can be detected
+	SubjectExternalID        *string             // SubjectExternalID is a placeholder subject, e.g. an email, bound to the DID of the holder that claims the credential
+	AllowedDIDs              []string            // AllowedDIDs are the only holders that can claim the credential, any holder when empty
+	CodeProtected            bool                // CodeProtected links are claimed with one of their one-time codes
 }
 
 // NewLink - Constructor
@@ -99,6 +104,7 @@ func NewLink(
 		CredentialSubject:        credentialSubject,
 		Active:                   true,
 		IssuedClaims:             0,
+		Version:                  1,
 	}
 }
 
@@ -107,6 +113,19 @@ func (l *Link) IssuerCoreDID() *core.DID {
 	return common.ToPointer(core.DID(l.IssuerDID))
 }
 
+// AllowsHolder returns true if the holder can claim the credential of the link
+func (l *Link) AllowsHolder(holderDID core.DID) bool {
+	if len(l.AllowedDIDs) == 0 {
+		return true
+	}
+	for _, did := range l.AllowedDIDs {
+		if did == holderDID.String() {
+			return true
+		}
+	}
+	return false
+}
+
 // Scan - scan the value for LinkCoreDID
 func (l *LinkCoreDID) Scan(value interface{}) error {
 	didStr, ok := value.(string)
@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// linkCodeAlphabet is the Crockford base32 alphabet, without the letters that are mistaken for digits
+	linkCodeAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+	// linkCodeLength is the number of characters of a code, 40 bits
+	linkCodeLength = 8
+)
+
+// LinkCode is a one-time code of a link, handed out to a holder out of band. Only the hash of the code is stored.
+// The code is reserved by the claim session it is entered in, and used when the credential of the session is issued.
+type LinkCode struct {
+	ID        uuid.UUID
+	LinkID    uuid.UUID
+	Hash      string
+	SessionID *uuid.UUID
+	HolderDID *string // HolderDID is the holder that claimed the credential with the code
+	CreatedAt time.Time
+	UsedAt    *time.Time
+}
+
+// NewLinkCode returns a new random code of the link and the code itself, which is not kept
+func NewLinkCode(linkID uuid.UUID) (*LinkCode, string, error) {
+	random := make([]byte, linkCodeLength)
+	if _, err := rand.Read(random); err != nil {
+		return nil, "", err
+	}
+	var code strings.Builder
+	for i, b := range random {
+		if i == linkCodeLength/2 {
+			code.WriteByte('-')
+		}
+		code.WriteByte(linkCodeAlphabet[int(b)%len(linkCodeAlphabet)])
+	}
+	return &LinkCode{
+		ID:        uuid.New(),
+		LinkID:    linkID,
+		Hash:      HashLinkCode(linkID, code.String()),
+		CreatedAt: time.Now().UTC(),
+	}, code.String(), nil
+}
+
+// HashLinkCode returns the hash of a code of the link. The code is compared without the separators and in upper case,
+// and the ambiguous letters are read as the digits they look like.
+func HashLinkCode(linkID uuid.UUID, code string) string {
+	normalized := strings.NewReplacer("-", "", " ", "", "O", "0", "I", "1", "L", "1").Replace(strings.ToUpper(code))
+	hash := sha256.Sum256([]byte(linkID.String() + ":" + normalized))
+	return hex.EncodeToString(hash[:])
+}
+
+// Used returns true if a credential was issued with the code
+func (c *LinkCode) Used() bool {
+	return c.UsedAt != nil
+}
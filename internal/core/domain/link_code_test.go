@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewLinkCode(t *testing.T) {
+	linkID := uuid.New()
+	code, plain, err := NewLinkCode(linkID)
+	require.NoError(t, err)
+	assert.Regexp(t, "^[0-9A-HJKMNP-TV-Z]{4}-[0-9A-HJKMNP-TV-Z]{4}$", plain)
+	assert.Equal(t, linkID, code.LinkID)
+	assert.Len(t, code.Hash, 64)
+	assert.NotContains(t, code.Hash, strings.ReplaceAll(plain, "-", ""))
+	assert.False(t, code.Used())
+
+	_, other, err := NewLinkCode(linkID)
+	require.NoError(t, err)
+	assert.NotEqual(t, plain, other)
+}
+
+func TestHashLinkCode(t *testing.T) {
+	linkID := uuid.New()
+	hash := HashLinkCode(linkID, "AB01-CD23")
+	assert.Equal(t, hash, HashLinkCode(linkID, "ab01cd23"))
+	assert.Equal(t, HashLinkCode(linkID, "A0B1-CD11"), HashLinkCode(linkID, " aOBl cdIL "), "the ambiguous letters are read as digits")
+	assert.NotEqual(t, hash, HashLinkCode(linkID, "AB01-CD24"))
+	assert.NotEqual(t, hash, HashLinkCode(uuid.New(), "AB01-CD23"), "the codes of other links have other hashes")
+}
@@ -0,0 +1,177 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+)
+
+// LinkFunnelStep is a step of the claim of a credential from a link
+type LinkFunnelStep string
+
+const (
+	LinkFunnelQRFetched           LinkFunnelStep = "qr_fetched"           // LinkFunnelQRFetched the claim page got the authentication QR code of a new session
+	LinkFunnelAuthCompleted       LinkFunnelStep = "auth_completed"       // LinkFunnelAuthCompleted the holder authenticated with the wallet
+	LinkFunnelOfferFetched        LinkFunnelStep = "offer_fetched"        // LinkFunnelOfferFetched the claim page got the credential offer
+	LinkFunnelCredentialDelivered LinkFunnelStep = "credential_delivered" // LinkFunnelCredentialDelivered the wallet fetched the credential
+	LinkFunnelFailed              LinkFunnelStep = "failed"               // LinkFunnelFailed the session stopped, FailureReason says why
+)
+
+// LinkFunnelSteps are the steps of the claim in order, without the failures
+var LinkFunnelSteps = []LinkFunnelStep{LinkFunnelQRFetched, LinkFunnelAuthCompleted, LinkFunnelOfferFetched, LinkFunnelCredentialDelivered}
+
+// Reasons of the failed steps. They are codes instead of the error messages, so no data of the holder is stored.
+const (
+	LinkFunnelReasonExpired          = "link_expired"
+	LinkFunnelReasonLimitReached     = "link_limit_reached"
+	LinkFunnelReasonInactive         = "link_inactive"
+	LinkFunnelReasonAlreadyIssued    = "already_issued"
+	LinkFunnelReasonIssuance         = "issuance_error"
+	LinkFunnelReasonOfferExpired     = "offer_expired"
+	LinkFunnelReasonOfferRejected    = "offer_rejected"
+	LinkFunnelReasonProofRequired    = "proof_required"
+	LinkFunnelReasonSubjectBound     = "subject_bound"
+	LinkFunnelReasonHolderNotAllowed = "holder_not_allowed"
+	LinkFunnelReasonCodeRequired     = "code_required"
+)
+
+// LinkFunnelEvent is a step of a link claim session. Session is a hash of the session id, empty for the steps that
+// are not made in a session, like the delivery of the credential to the wallet.
+type LinkFunnelEvent struct {
+	ID            int64
+	IssuerDID     core.DID
+	LinkID        uuid.UUID
+	Session       string
+	Step          LinkFunnelStep
+	FailureReason *string
+	CreatedAt     time.Time
+}
+
+// NewLinkFunnelEvent returns a new step of the session of a link. sessionID can be empty.
+func NewLinkFunnelEvent(issuerDID core.DID, linkID uuid.UUID, sessionID string, step LinkFunnelStep) *LinkFunnelEvent {
+	event := &LinkFunnelEvent{
+		IssuerDID: issuerDID,
+		LinkID:    linkID,
+		Step:      step,
+		CreatedAt: time.Now().UTC(),
+	}
+	if sessionID != "" {
+		hash := sha256.Sum256([]byte(sessionID))
+		event.Session = hex.EncodeToString(hash[:16])
+	}
+	return event
+}
+
+// NewLinkFunnelFailure returns a new failed step of the session of a link
+func NewLinkFunnelFailure(issuerDID core.DID, linkID uuid.UUID, sessionID string, reason string) *LinkFunnelEvent {
+	event := NewLinkFunnelEvent(issuerDID, linkID, sessionID, LinkFunnelFailed)
+	event.FailureReason = &reason
+	return event
+}
+
+// LinkFunnel counts the sessions of a link that reached every step and the failures by reason
+type LinkFunnel struct {
+	LinkID   uuid.UUID
+	Steps    map[LinkFunnelStep]int
+	Failures map[string]int
+}
+
+// LinkAnalyticsInterval is the length of the buckets of the link analytics
+type LinkAnalyticsInterval string
+
+const (
+	LinkAnalyticsHour LinkAnalyticsInterval = "hour" // LinkAnalyticsHour buckets of an hour
+	LinkAnalyticsDay  LinkAnalyticsInterval = "day"  // LinkAnalyticsDay buckets of a day
+	LinkAnalyticsWeek LinkAnalyticsInterval = "week" // LinkAnalyticsWeek buckets of a week, from Monday
+)
+
+// Valid returns true if the interval is known
+func (i LinkAnalyticsInterval) Valid() bool {
+	return i == LinkAnalyticsHour || i == LinkAnalyticsDay || i == LinkAnalyticsWeek
+}
+
+// Truncate returns the start of the bucket of t in UTC, the same as date_trunc in postgres
+func (i LinkAnalyticsInterval) Truncate(t time.Time) time.Time {
+	t = t.UTC()
+	switch i {
+	case LinkAnalyticsHour:
+		return t.Truncate(time.Hour)
+	case LinkAnalyticsWeek:
+		day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+		return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+	default:
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	}
+}
+
+// Next returns the start of the bucket after the one that starts at start
+func (i LinkAnalyticsInterval) Next(start time.Time) time.Time {
+	switch i {
+	case LinkAnalyticsHour:
+		return start.Add(time.Hour)
+	case LinkAnalyticsWeek:
+		return start.AddDate(0, 0, 7)
+	default:
+		return start.AddDate(0, 0, 1)
+	}
+}
+
+// LinkAnalyticsBucket counts the events of a link made in a bucket. QRDisplayed are the authentication QR codes
+// shown, Authenticated the holders that scanned them, Issued the credentials fetched by the wallets and Errors the
+// failed steps.
+type LinkAnalyticsBucket struct {
+	Start         time.Time
+	QRDisplayed   int
+	Authenticated int
+	Issued        int
+	Errors        int
+}
+
+// LinkAnalytics counts the events of a link over time, in buckets of the interval from From up to To
+type LinkAnalytics struct {
+	LinkID   uuid.UUID
+	Interval LinkAnalyticsInterval
+	From     time.Time
+	To       time.Time
+	Buckets  []*LinkAnalyticsBucket
+}
+
+// NewLinkAnalytics returns the analytics of the link with a bucket for every interval between from and to, so the
+// intervals without events are zero instead of missing. From is moved to the start of its bucket.
+func NewLinkAnalytics(linkID uuid.UUID, interval LinkAnalyticsInterval, from time.Time, to time.Time) *LinkAnalytics {
+	analytics := &LinkAnalytics{
+		LinkID:   linkID,
+		Interval: interval,
+		From:     interval.Truncate(from),
+		To:       to.UTC(),
+		Buckets:  make([]*LinkAnalyticsBucket, 0),
+	}
+	for start := analytics.From; start.Before(analytics.To); start = interval.Next(start) {
+		analytics.Buckets = append(analytics.Buckets, &LinkAnalyticsBucket{Start: start})
+	}
+	return analytics
+}
+
+// Add counts the events of the step made in the bucket that starts at start. The steps without a counter, and the
+// buckets out of the analytics, are ignored.
+func (a *LinkAnalytics) Add(start time.Time, step LinkFunnelStep, count int) {
+	for _, bucket := range a.Buckets {
+		if !bucket.Start.Equal(start) {
+			continue
+		}
+		switch step {
+		case LinkFunnelQRFetched:
+			bucket.QRDisplayed += count
+		case LinkFunnelAuthCompleted:
+			bucket.Authenticated += count
+		case LinkFunnelCredentialDelivered:
+			bucket.Issued += count
+		case LinkFunnelFailed:
+			bucket.Errors += count
+		}
+		return
+	}
+}
@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewLinkFunnelEvent(t *testing.T) {
+	sessionID := uuid.NewString()
+	event := NewLinkFunnelEvent(core.DID{}, uuid.New(), sessionID, LinkFunnelQRFetched)
+	assert.Len(t, event.Session, 32)
+	assert.NotContains(t, event.Session, sessionID)
+	assert.Equal(t, event.Session, NewLinkFunnelEvent(core.DID{}, uuid.New(), sessionID, LinkFunnelAuthCompleted).Session, "the steps of a session can be grouped")
+	assert.NotEqual(t, event.Session, NewLinkFunnelEvent(core.DID{}, uuid.New(), uuid.NewString(), LinkFunnelQRFetched).Session)
+
+	assert.Empty(t, NewLinkFunnelEvent(core.DID{}, uuid.New(), "", LinkFunnelCredentialDelivered).Session)
+
+	failure := NewLinkFunnelFailure(core.DID{}, uuid.New(), sessionID, LinkFunnelReasonExpired)
+	assert.Equal(t, LinkFunnelFailed, failure.Step)
+	assert.Equal(t, LinkFunnelReasonExpired, *failure.FailureReason)
+}
+
+func TestLinkAnalyticsInterval_Truncate(t *testing.T) {
+	// Thursday
+	date := time.Date(2023, 6, 1, 14, 35, 10, 0, time.UTC)
+	assert.Equal(t, time.Date(2023, 6, 1, 14, 0, 0, 0, time.UTC), LinkAnalyticsHour.Truncate(date))
+	assert.Equal(t, time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC), LinkAnalyticsDay.Truncate(date))
+	assert.Equal(t, time.Date(2023, 5, 29, 0, 0, 0, 0, time.UTC), LinkAnalyticsWeek.Truncate(date))
+	assert.Equal(t, time.Date(2023, 5, 29, 0, 0, 0, 0, time.UTC), LinkAnalyticsWeek.Truncate(time.Date(2023, 6, 4, 23, 0, 0, 0, time.UTC)), "sunday")
+	assert.Equal(t, time.Date(2023, 6, 5, 0, 0, 0, 0, time.UTC), LinkAnalyticsWeek.Truncate(time.Date(2023, 6, 5, 0, 0, 0, 0, time.UTC)), "monday")
+	assert.Equal(t, time.Date(2023, 5, 31, 0, 0, 0, 0, time.UTC), LinkAnalyticsDay.Truncate(time.Date(2023, 6, 1, 1, 0, 0, 0, time.FixedZone("CEST", 2*3600))), "in UTC")
+	assert.False(t, LinkAnalyticsInterval("month").Valid())
+}
+
+func TestNewLinkAnalytics(t *testing.T) {
+	from := time.Date(2023, 6, 1, 10, 30, 0, 0, time.UTC)
+	analytics := NewLinkAnalytics(uuid.New(), LinkAnalyticsDay, from, from.AddDate(0, 0, 2))
+	assert.Equal(t, time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC), analytics.From)
+	require.Len(t, analytics.Buckets, 3)
+	assert.Equal(t, time.Date(2023, 6, 3, 0, 0, 0, 0, time.UTC), analytics.Buckets[2].Start)
+
+	analytics.Add(time.Date(2023, 6, 2, 0, 0, 0, 0, time.UTC), LinkFunnelQRFetched, 5)
+	analytics.Add(time.Date(2023, 6, 2, 0, 0, 0, 0, time.UTC), LinkFunnelAuthCompleted, 3)
+	analytics.Add(time.Date(2023, 6, 2, 0, 0, 0, 0, time.UTC), LinkFunnelOfferFetched, 3)
+	analytics.Add(time.Date(2023, 6, 3, 0, 0, 0, 0, time.UTC), LinkFunnelCredentialDelivered, 2)
+	analytics.Add(time.Date(2023, 6, 3, 0, 0, 0, 0, time.UTC), LinkFunnelFailed, 1)
+	analytics.Add(time.Date(2023, 6, 9, 0, 0, 0, 0, time.UTC), LinkFunnelFailed, 1)
+	assert.Equal(t, LinkAnalyticsBucket{Start: analytics.Buckets[0].Start}, *analytics.Buckets[0])
+	assert.Equal(t, LinkAnalyticsBucket{Start: analytics.Buckets[1].Start, QRDisplayed: 5, Authenticated: 3}, *analytics.Buckets[1])
+	assert.Equal(t, LinkAnalyticsBucket{Start: analytics.Buckets[2].Start, Issued: 2, Errors: 1}, *analytics.Buckets[2])
+}
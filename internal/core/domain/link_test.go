@@ -4,7 +4,9 @@ import (
 	"testing"
 	"time"
 
+	core "github.com/iden3/go-iden3-core"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/polygonid/sh-id-platform/internal/common"
 )
@@ -99,3 +101,15 @@ func TestLink_Status(t *testing.T) {
 		})
 	}
 }
+
+func TestLink_AllowsHolder(t *testing.T) {
+	holder, err := core.ParseDID("did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ")
+	require.NoError(t, err)
+	other, err := core.ParseDID("did:polygonid:polygon:mumbai:2qD6cqGpLX2dibdFuKfrPxGiybi3wKa8RbR4onw49H")
+	require.NoError(t, err)
+
+	assert.True(t, (&Link{}).AllowsHolder(*holder), "any holder when there are no allowed DIDs")
+	link := &Link{AllowedDIDs: []string{holder.String()}}
+	assert.True(t, link.AllowsHolder(*holder))
+	assert.False(t, link.AllowsHolder(*other))
+}
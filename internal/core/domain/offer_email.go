@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"errors"
+	"net/mail"
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+)
+
+// OfferEmailStatus is the delivery status of an offer email
+type OfferEmailStatus string
+
+const (
+	OfferEmailPending OfferEmailStatus = "pending" // OfferEmailPending the email is being sent
+	OfferEmailSent    OfferEmailStatus = "sent"    // OfferEmailSent the email provider accepted the email
+	OfferEmailFailed  OfferEmailStatus = "failed"  // OfferEmailFailed the email provider rejected the email, Error says why
+)
+
+// ErrInvalidOfferRecipient the recipient of an offer email is not a plain email address
+var ErrInvalidOfferRecipient = errors.New("the recipient must be an email address")
+
+// OfferEmail is a credential offer emailed to a holder. The email links to the node, which records when it is opened
+// and when the claim link is followed. ClaimedAt is when the wallet fetched the credential after the email was sent.
+type OfferEmail struct {
+	ID           uuid.UUID
+	IssuerDID    core.DID
+	CredentialID uuid.UUID
+	Recipient    string
+	Status       OfferEmailStatus
+	Error        *string
+	SentAt       *time.Time
+	OpenedAt     *time.Time
+	ClickedAt    *time.Time
+	ClaimedAt    *time.Time
+	CreatedAt    time.Time
+}
+
+// NewOfferEmail returns a pending offer email of the credential. The recipient must be an address without a name,
+// like jane@example.com.
+func NewOfferEmail(issuerDID core.DID, credentialID uuid.UUID, recipient string) (*OfferEmail, error) {
+	address, err := mail.ParseAddress(recipient)
+	if err != nil || address.Name != "" || address.Address != recipient {
+		return nil, ErrInvalidOfferRecipient
+	}
+	return &OfferEmail{
+		ID:           uuid.New(),
+		IssuerDID:    issuerDID,
+		CredentialID: credentialID,
+		Recipient:    recipient,
+		Status:       OfferEmailPending,
+		CreatedAt:    time.Now().UTC(),
+	}, nil
+}
+
+// Sent sets the email as accepted by the provider
+func (e *OfferEmail) Sent(at time.Time) {
+	e.Status = OfferEmailSent
+	e.SentAt = &at
+	e.Error = nil
+}
+
+// Failed sets the email as rejected by the provider
+func (e *OfferEmail) Failed(err error) {
+	reason := err.Error()
+	e.Status = OfferEmailFailed
+	e.Error = &reason
+}
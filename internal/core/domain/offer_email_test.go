@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewOfferEmail(t *testing.T) {
+	issuerDID, err := core.ParseDID("did:polygonid:polygon:mumbai:2qCU58EJgrELNZCDkSU23dQHZsBgAFWLNpNezo1g6b")
+	require.NoError(t, err)
+
+	email, err := NewOfferEmail(*issuerDID, uuid.New(), "jane@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, OfferEmailPending, email.Status)
+	assert.Nil(t, email.SentAt)
+
+	for _, recipient := range []string{"", "jane", "Jane <jane@example.com>", " jane@example.com", "jane@example.com, john@example.com"} {
+		_, err := NewOfferEmail(*issuerDID, uuid.New(), recipient)
+		assert.ErrorIs(t, err, ErrInvalidOfferRecipient, recipient)
+	}
+
+	email.Failed(errors.New("mailbox unavailable"))
+	assert.Equal(t, OfferEmailFailed, email.Status)
+	require.NotNil(t, email.Error)
+	assert.Equal(t, "mailbox unavailable", *email.Error)
+
+	now := time.Now()
+	email.Sent(now)
+	assert.Equal(t, OfferEmailSent, email.Status)
+	assert.Equal(t, &now, email.SentAt)
+	assert.Nil(t, email.Error)
+}
@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+)
+
+const (
+	// OID4VCIPreAuthorizedCodeGrant is the grant type of the token requests of the pre-authorized code flow
+	OID4VCIPreAuthorizedCodeGrant = "urn:ietf:params:oauth:grant-type:pre-authorized_code"
+	// OID4VCIFormat is the format of the issued credentials, JSON-LD credentials with linked data proofs
+	OID4VCIFormat = "ldp_vc"
+	// OID4VCIProofType is the type of the proofs of possession the wallets send to the credential endpoint
+	OID4VCIProofType = "jwt"
+	// OID4VCIProofJWTType is the typ header of the proofs of possession
+	OID4VCIProofJWTType = "openid4vci-proof+jwt"
+)
+
+// OID4VCICredential is the credential an OpenID4VCI offer issues. It is fixed by the issuer when the offer is created,
+// the wallet only chooses when to get it.
+type OID4VCICredential struct {
+	Schema            string         `json:"schema"`
+	Type              string         `json:"type"`
+	CredentialSubject map[string]any `json:"credentialSubject"`
+	Expiration        *time.Time     `json:"expiration,omitempty"`
+	MTProof           bool           `json:"mtProof,omitempty"`
+}
+
+// OID4VCIOffer is a credential offered to a wallet with the OpenID4VCI pre-authorized code flow. The wallet exchanges
+// the pre-authorized code for an access token, and the token for the credential. Only the hashes of the code and
+// the token are kept.
+type OID4VCIOffer struct {
+	ID                    uuid.UUID
+	IssuerDID             core.DID
+	PreAuthorizedCodeHash string
+	Credential            OID4VCICredential
+	AccessTokenHash       *string
+	TokenExpiresAt        *time.Time
+	CNonce                *string
+	ClaimID               *uuid.UUID
+	ExpiresAt             time.Time
+	CreatedAt             time.Time
+}
+
+// NewOID4VCIOffer returns a new offer of the credential and its pre-authorized code
+func NewOID4VCIOffer(issuerDID core.DID, credential OID4VCICredential, ttl time.Duration) (*OID4VCIOffer, string, error) {
+	code, err := NewOID4VCISecret()
+	if err != nil {
+		return nil, "", err
+	}
+	now := time.Now().UTC()
+	return &OID4VCIOffer{
+		ID:                    uuid.New(),
+		IssuerDID:             issuerDID,
+		PreAuthorizedCodeHash: OID4VCIHash(code),
+		Credential:            credential,
+		ExpiresAt:             now.Add(ttl),
+		CreatedAt:             now,
+	}, code, nil
+}
+
+// Expired tells whether the pre-authorized code of the offer can no longer be exchanged
+func (o *OID4VCIOffer) Expired(now time.Time) bool {
+	return now.After(o.ExpiresAt)
+}
+
+// Redeemed tells whether the pre-authorized code of the offer was already exchanged for an access token
+func (o *OID4VCIOffer) Redeemed() bool {
+	return o.AccessTokenHash != nil
+}
+
+// TokenExpired tells whether the access token of the offer can no longer be used
+func (o *OID4VCIOffer) TokenExpired(now time.Time) bool {
+	return o.TokenExpiresAt == nil || now.After(*o.TokenExpiresAt)
+}
+
+// NewOID4VCISecret returns a random url safe string for the codes, tokens and nonces of the flow
+func NewOID4VCISecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// OID4VCIHash returns the hash a code or a token is stored and looked up by
+func OID4VCIHash(secret string) string {
+	hash := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+)
+
+func TestNewOID4VCIOffer(t *testing.T) {
+	offer, code, err := NewOID4VCIOffer(core.DID{}, OID4VCICredential{Type: "KYCAgeCredential"}, time.Hour)
+	require.NoError(t, err)
+	assert.NotEqual(t, uuid.Nil, offer.ID)
+	assert.Len(t, code, 43)
+	assert.Equal(t, OID4VCIHash(code), offer.PreAuthorizedCodeHash)
+	assert.NotContains(t, offer.PreAuthorizedCodeHash, code)
+
+	_, other, err := NewOID4VCIOffer(core.DID{}, OID4VCICredential{Type: "KYCAgeCredential"}, time.Hour)
+	require.NoError(t, err)
+	assert.NotEqual(t, code, other)
+
+	now := time.Now()
+	assert.False(t, offer.Expired(now))
+	assert.True(t, offer.Expired(now.Add(2*time.Hour)))
+	assert.False(t, offer.Redeemed())
+	assert.True(t, offer.TokenExpired(now))
+
+	offer.AccessTokenHash = common.ToPointer(OID4VCIHash("token"))
+	offer.TokenExpiresAt = common.ToPointer(now.Add(10 * time.Minute))
+	assert.True(t, offer.Redeemed())
+	assert.False(t, offer.TokenExpired(now))
+	assert.True(t, offer.TokenExpired(now.Add(time.Hour)))
+}
@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+)
+
+// ProblemReportDirection tells if a problem report was sent by the agent or received from a wallet
+type ProblemReportDirection string
+
+const (
+	ProblemReportSent     ProblemReportDirection = "sent"     // ProblemReportSent the agent answered a failed request with the report
+	ProblemReportReceived ProblemReportDirection = "received" // ProblemReportReceived a wallet reported a problem to the agent
+)
+
+// Codes of the problem reports sent by the agent. They follow the DIDComm format, sorter.scope.descriptor: e is an
+// error and w a warning, and p means the protocol of the thread is abandoned.
+const (
+	ProblemCodeUnauthorized  = "e.p.msg.unauthorized"   // ProblemCodeUnauthorized the message does not prove who its sender is
+	ProblemCodeOfferConsumed = "e.p.msg.offer-consumed" // ProblemCodeOfferConsumed the credential was already fetched with the offer
+	ProblemCodeOfferExpired  = "e.p.msg.offer-expired"  // ProblemCodeOfferExpired the offer cannot be used anymore
+	ProblemCodeInProgress    = "w.p.msg.in-progress"    // ProblemCodeInProgress the message is still being processed, it can be retried later
+	ProblemCodeFailed        = "e.p.msg.request-failed" // ProblemCodeFailed the request could not be answered, the comment says why
+)
+
+// ProblemReport is a problem-report message sent to a wallet or received from it. ThreadID is the thread the problem
+// is about, like the one of a credential offer. The report is attached to the credential and the connection it
+// relates to, when they are known, for the operators to troubleshoot the exchanges with the holder.
+type ProblemReport struct {
+	ID           uuid.UUID
+	IssuerDID    core.DID
+	Direction    ProblemReportDirection
+	Holder       string
+	MessageID    string
+	ThreadID     string
+	Code         string
+	Comment      string
+	CredentialID *uuid.UUID
+	ConnectionID *uuid.UUID
+	CreatedAt    time.Time
+}
+
+// NewProblemReport returns a problem report of the thread, exchanged with the holder in the given direction
+func NewProblemReport(issuerDID core.DID, direction ProblemReportDirection, holder string, messageID string, threadID string, code string, comment string) *ProblemReport {
+	return &ProblemReport{
+		ID:        uuid.New(),
+		IssuerDID: issuerDID,
+		Direction: direction,
+		Holder:    holder,
+		MessageID: messageID,
+		ThreadID:  threadID,
+		Code:      code,
+		Comment:   comment,
+		CreatedAt: time.Now().UTC(),
+	}
+}
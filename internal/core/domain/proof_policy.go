@@ -0,0 +1,111 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/iden3/go-schema-processor/verifiable"
+)
+
+// ErrProofPolicy the proof types of the credential are not allowed by the proof policy
+var ErrProofPolicy = errors.New("proof types not allowed by the proof policy")
+
+// ProofTypes are the proofs a credential is issued with
+type ProofTypes struct {
+	Signature bool // BJJSignature2021
+	MTP       bool // Iden3SparseMerkleTreeProof
+}
+
+// ParseProofTypes parses a list of proof type names
+func ParseProofTypes(names []string) (ProofTypes, error) {
+	var proofs ProofTypes
+	for _, name := range names {
+		switch verifiable.ProofType(strings.TrimSpace(name)) {
+		case verifiable.BJJSignatureProofType:
+			proofs.Signature = true
+		case verifiable.Iden3SparseMerkleTreeProofType:
+			proofs.MTP = true
+		default:
+			return ProofTypes{}, fmt.Errorf("unknown proof type %q", name)
+		}
+	}
+	return proofs, nil
+}
+
+// Names returns the names of the proof types, as parsed by ParseProofTypes
+func (p ProofTypes) Names() []string {
+	names := make([]string, 0, 2)
+	if p.Signature {
+		names = append(names, string(verifiable.BJJSignatureProofType))
+	}
+	if p.MTP {
+		names = append(names, string(verifiable.Iden3SparseMerkleTreeProofType))
+	}
+	return names
+}
+
+// String returns the names of the proof types
+func (p ProofTypes) String() string {
+	return strings.Join(p.Names(), ", ")
+}
+
+// ProofPolicy decides the proof types of the credentials. The credentials requested without proof types get the
+// default ones of their schema, or the policy ones if the schema has none, and the ones of a schema in Allowed can
+// only have the proof types allowed for it. The schemas are matched by url or by credential type.
+type ProofPolicy struct {
+	Default ProofTypes
+	Allowed map[string]ProofTypes
+}
+
+// NewProofPolicy returns the policy of the given default proof types and rules. Every rule is a schema url or
+// credential type and its allowed proof types separated by |, like KYCAgeCredential=BJJSignature2021.
+func NewProofPolicy(defaults []string, rules []string) (*ProofPolicy, error) {
+	def, err := ParseProofTypes(defaults)
+	if err != nil {
+		return nil, err
+	}
+	if def == (ProofTypes{}) {
+		return nil, errors.New("no default proof types")
+	}
+
+	policy := &ProofPolicy{Default: def, Allowed: make(map[string]ProofTypes, len(rules))}
+	for _, rule := range rules {
+		i := strings.LastIndex(rule, "=")
+		if i <= 0 {
+			return nil, fmt.Errorf("invalid proof policy rule %q", rule)
+		}
+		allowed, err := ParseProofTypes(strings.Split(rule[i+1:], "|"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid proof policy rule %q: %w", rule, err)
+		}
+		policy.Allowed[strings.TrimSpace(rule[:i])] = allowed
+	}
+	return policy, nil
+}
+
+// Defaults returns the proof types of the credentials requested without them: the schema defaults if it has them or
+// the policy ones
+func (p *ProofPolicy) Defaults(schemaDefaults *ProofTypes) ProofTypes {
+	if schemaDefaults != nil && *schemaDefaults != (ProofTypes{}) {
+		return *schemaDefaults
+	}
+	return p.Default
+}
+
+// Check returns ErrProofPolicy if a credential of the schema cannot be issued with the proof types
+func (p *ProofPolicy) Check(schemaURL string, credentialType string, proofs ProofTypes) error {
+	if proofs == (ProofTypes{}) {
+		return fmt.Errorf("%w: at least one proof type is needed", ErrProofPolicy)
+	}
+	allowed, ok := p.Allowed[schemaURL]
+	if !ok {
+		if allowed, ok = p.Allowed[credentialType]; !ok {
+			return nil
+		}
+	}
+	if (proofs.Signature && !allowed.Signature) || (proofs.MTP && !allowed.MTP) {
+		return fmt.Errorf("%w: the credentials of %s can only have %s proofs", ErrProofPolicy, credentialType, allowed)
+	}
+	return nil
+}
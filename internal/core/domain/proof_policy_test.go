@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewProofPolicy(t *testing.T) {
+	policy, err := NewProofPolicy([]string{"BJJSignature2021"}, []string{
+		"KYCAgeCredential=Iden3SparseMerkleTreeProof",
+		"https://example.com/schemas/kyc.json=BJJSignature2021|Iden3SparseMerkleTreeProof",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, ProofTypes{Signature: true}, policy.Default)
+	assert.Equal(t, ProofTypes{MTP: true}, policy.Allowed["KYCAgeCredential"])
+	assert.Equal(t, ProofTypes{Signature: true, MTP: true}, policy.Allowed["https://example.com/schemas/kyc.json"])
+
+	_, err = NewProofPolicy(nil, nil)
+	assert.Error(t, err)
+	_, err = NewProofPolicy([]string{"EcdsaSecp256k1Signature2019"}, nil)
+	assert.Error(t, err)
+	_, err = NewProofPolicy([]string{"BJJSignature2021"}, []string{"KYCAgeCredential"})
+	assert.Error(t, err)
+	_, err = NewProofPolicy([]string{"BJJSignature2021"}, []string{"KYCAgeCredential=BJJ"})
+	assert.Error(t, err)
+}
+
+func TestProofPolicy_Check(t *testing.T) {
+	policy := &ProofPolicy{
+		Default: ProofTypes{Signature: true, MTP: true},
+		Allowed: map[string]ProofTypes{
+			"KYCAgeCredential":                     {MTP: true},
+			"https://example.com/schemas/kyc.json": {Signature: true},
+		},
+	}
+	for _, tc := range []struct {
+		name   string
+		schema string
+		typ    string
+		proofs ProofTypes
+		valid  bool
+	}{
+		{name: "schema without rules", schema: "https://example.com/schemas/other.json", typ: "Other", proofs: ProofTypes{Signature: true, MTP: true}, valid: true},
+		{name: "no proof types", schema: "https://example.com/schemas/other.json", typ: "Other"},
+		{name: "allowed by type", schema: "https://example.com/schemas/age.json", typ: "KYCAgeCredential", proofs: ProofTypes{MTP: true}, valid: true},
+		{name: "disallowed by type", schema: "https://example.com/schemas/age.json", typ: "KYCAgeCredential", proofs: ProofTypes{Signature: true, MTP: true}},
+		{name: "url rule before type rule", schema: "https://example.com/schemas/kyc.json", typ: "KYCAgeCredential", proofs: ProofTypes{Signature: true}, valid: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := policy.Check(tc.schema, tc.typ, tc.proofs)
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, ErrProofPolicy)
+			}
+		})
+	}
+}
+
+func TestProofPolicy_Defaults(t *testing.T) {
+	policy := &ProofPolicy{Default: ProofTypes{Signature: true, MTP: true}}
+	assert.Equal(t, ProofTypes{Signature: true, MTP: true}, policy.Defaults(nil))
+	assert.Equal(t, ProofTypes{Signature: true, MTP: true}, policy.Defaults(&ProofTypes{}))
+	assert.Equal(t, ProofTypes{MTP: true}, policy.Defaults(&ProofTypes{MTP: true}))
+}
+
+func TestProofTypes_Names(t *testing.T) {
+	assert.Equal(t, []string{"BJJSignature2021", "Iden3SparseMerkleTreeProof"}, ProofTypes{Signature: true, MTP: true}.Names())
+	assert.Empty(t, ProofTypes{}.Names())
+	proofs, err := ParseProofTypes(ProofTypes{MTP: true}.Names())
+	require.NoError(t, err)
+	assert.Equal(t, ProofTypes{MTP: true}, proofs)
+}
@@ -0,0 +1,47 @@
+package domain
+
+// PublicationStatus tells whether the merkle tree proof of a credential can be verified. The proof is only valid once
+// the state that includes the credential is published, and the status follows the one of the state, kept by the
+// publisher.
+type PublicationStatus string
+
+const (
+	PublicationNotRequired PublicationStatus = "not-required"    // PublicationNotRequired the credential has no merkle tree proof
+	PublicationPending     PublicationStatus = "pending-publish" // PublicationPending the state of the credential is not published or its transaction is not confirmed yet
+	PublicationPublished   PublicationStatus = "published"       // PublicationPublished the state of the credential is published, its merkle tree proof is valid
+	PublicationFailed      PublicationStatus = "failed"          // PublicationFailed the transaction of the state failed, the state is published again when it is retried
+)
+
+// NewPublicationStatus returns the publication status of a credential issued with or without a merkle tree proof, from
+// the status of its state. The credentials that are not in a state yet have a nil status.
+func NewPublicationStatus(mtp bool, state *IdentityStatus) PublicationStatus {
+	if !mtp {
+		return PublicationNotRequired
+	}
+	if state == nil {
+		return PublicationPending
+	}
+	switch *state {
+	case StatusConfirmed:
+		return PublicationPublished
+	case StatusFailed:
+		return PublicationFailed
+	default:
+		return PublicationPending
+	}
+}
+
+// PublishRequired returns true if the merkle tree proof of the credential is not valid until its state is published
+func (s PublicationStatus) PublishRequired() bool {
+	return s == PublicationPending || s == PublicationFailed
+}
+
+// PublicationStatus returns the publication status of the claim
+func (c *Claim) PublicationStatus() PublicationStatus {
+	return NewPublicationStatus(c.MtProof, c.Status)
+}
+
+// PublicationStatus returns the publication status of the credential
+func (r *CredentialRow) PublicationStatus() PublicationStatus {
+	return NewPublicationStatus(r.HasMTProof, r.Status)
+}
@@ -0,0 +1,32 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+)
+
+func TestNewPublicationStatus(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		mtp      bool
+		state    *IdentityStatus
+		expected PublicationStatus
+		required bool
+	}{
+		{name: "signature only", state: common.ToPointer(StatusConfirmed), expected: PublicationNotRequired},
+		{name: "not in a state", mtp: true, expected: PublicationPending, required: true},
+		{name: "state created", mtp: true, state: common.ToPointer(StatusCreated), expected: PublicationPending, required: true},
+		{name: "state transacted", mtp: true, state: common.ToPointer(StatusTransacted), expected: PublicationPending, required: true},
+		{name: "state confirmed", mtp: true, state: common.ToPointer(StatusConfirmed), expected: PublicationPublished},
+		{name: "state failed", mtp: true, state: common.ToPointer(StatusFailed), expected: PublicationFailed, required: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			status := NewPublicationStatus(tc.mtp, tc.state)
+			assert.Equal(t, tc.expected, status)
+			assert.Equal(t, tc.required, status.PublishRequired())
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// PublishingMode is when the pending changes of an identity are published to the chain
+type PublishingMode string
+
+const (
+	// PublishingModeManual states are only published when requested through the API
+	PublishingModeManual PublishingMode = "manual"
+	// PublishingModeImmediate states are published as soon as there are pending changes
+	PublishingModeImmediate PublishingMode = "immediate"
+	// PublishingModeInterval states are published at most once every interval
+	PublishingModeInterval PublishingMode = "interval"
+	// PublishingModeThreshold states are published once there are enough pending changes
+	PublishingModeThreshold PublishingMode = "threshold"
+)
+
+// PublishingPolicy decides when the state of an identity is published. Identities without a policy take the one of
+// the configuration.
+type PublishingPolicy struct {
+	Identifier string
+	Mode       PublishingMode
+	// Interval is the minimum time between states in interval mode. In threshold mode, if set, it is the maximum
+	// time pending changes wait for the threshold.
+	Interval  time.Duration
+	Threshold int
+	// RevocationWindow, if set, holds the pending revocations until the oldest one has waited for it, so the
+	// revocations of the window are published together. It does not apply in manual mode.
+	RevocationWindow time.Duration
+	Overridden       bool
+	UpdatedAt        *time.Time
+}
+
+// Validate returns an error if the policy lacks the values of its mode
+func (p *PublishingPolicy) Validate() error {
+	if p.RevocationWindow < 0 {
+		return errors.New("the revocation window cannot be negative")
+	}
+	switch p.Mode {
+	case PublishingModeManual, PublishingModeImmediate:
+		return nil
+	case PublishingModeInterval:
+		if p.Interval <= 0 {
+			return errors.New("interval mode needs an interval")
+		}
+		return nil
+	case PublishingModeThreshold:
+		if p.Threshold <= 0 || p.Interval < 0 {
+			return errors.New("threshold mode needs a threshold")
+		}
+		return nil
+	default:
+		return errors.New("unknown publishing mode")
+	}
+}
+
+// Due returns true if the pending changes of the identity must be published now
+func (p *PublishingPolicy) Due(pending *PendingChanges, now time.Time) bool {
+	if pending.Changes == 0 {
+		return false
+	}
+	changes := pending.Changes
+	if p.RevocationWindow > 0 && p.Mode != PublishingModeManual {
+		if pending.OldestRevocationAt != nil && now.Sub(*pending.OldestRevocationAt) >= p.RevocationWindow {
+			return true
+		}
+		// revocations still in their window are published along with the claims, but they do not trigger a state
+		changes -= pending.Revocations
+		if changes == 0 {
+			return false
+		}
+	}
+	waited := pending.LastStateAt == nil || now.Sub(*pending.LastStateAt) >= p.Interval
+	switch p.Mode {
+	case PublishingModeImmediate:
+		return true
+	case PublishingModeInterval:
+		return waited
+	case PublishingModeThreshold:
+		return changes >= p.Threshold || (p.Interval > 0 && waited)
+	default:
+		return false
+	}
+}
+
+// PendingChanges are the claims and revocations of an identity that are not in a published state yet
+type PendingChanges struct {
+	Identifier string
+	// Changes counts both the claims and the revocations
+	Changes            int
+	Revocations        int
+	OldestRevocationAt *time.Time
+	LastStateAt        *time.Time
+}
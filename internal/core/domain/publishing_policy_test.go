@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+)
+
+func TestPublishingPolicy_Validate(t *testing.T) {
+	assert.NoError(t, (&PublishingPolicy{Mode: PublishingModeManual}).Validate())
+	assert.NoError(t, (&PublishingPolicy{Mode: PublishingModeImmediate}).Validate())
+	assert.NoError(t, (&PublishingPolicy{Mode: PublishingModeInterval, Interval: time.Hour}).Validate())
+	assert.NoError(t, (&PublishingPolicy{Mode: PublishingModeThreshold, Threshold: 10}).Validate())
+	assert.Error(t, (&PublishingPolicy{Mode: PublishingModeInterval}).Validate())
+	assert.Error(t, (&PublishingPolicy{Mode: PublishingModeThreshold}).Validate())
+	assert.Error(t, (&PublishingPolicy{Mode: "hourly"}).Validate())
+	assert.Error(t, (&PublishingPolicy{Mode: PublishingModeImmediate, RevocationWindow: -time.Minute}).Validate())
+}
+
+func TestPublishingPolicy_Due(t *testing.T) {
+	now := time.Now()
+	recent := &PendingChanges{Changes: 5, LastStateAt: common.ToPointer(now.Add(-10 * time.Minute))}
+	old := &PendingChanges{Changes: 5, LastStateAt: common.ToPointer(now.Add(-2 * time.Hour))}
+	first := &PendingChanges{Changes: 1}
+	newRevocations := &PendingChanges{Changes: 2, Revocations: 2, OldestRevocationAt: common.ToPointer(now.Add(-time.Minute))}
+	oldRevocations := &PendingChanges{Changes: 2, Revocations: 2, OldestRevocationAt: common.ToPointer(now.Add(-20 * time.Minute))}
+	claimsAndRevocations := &PendingChanges{Changes: 3, Revocations: 2, OldestRevocationAt: common.ToPointer(now.Add(-time.Minute))}
+
+	for _, tc := range []struct {
+		name    string
+		policy  PublishingPolicy
+		pending *PendingChanges
+		due     bool
+	}{
+		{name: "manual", policy: PublishingPolicy{Mode: PublishingModeManual}, pending: old},
+		{name: "immediate", policy: PublishingPolicy{Mode: PublishingModeImmediate}, pending: recent, due: true},
+		{name: "no changes", policy: PublishingPolicy{Mode: PublishingModeImmediate}, pending: &PendingChanges{}},
+		{name: "interval not elapsed", policy: PublishingPolicy{Mode: PublishingModeInterval, Interval: time.Hour}, pending: recent},
+		{name: "interval elapsed", policy: PublishingPolicy{Mode: PublishingModeInterval, Interval: time.Hour}, pending: old, due: true},
+		{name: "interval without states", policy: PublishingPolicy{Mode: PublishingModeInterval, Interval: time.Hour}, pending: first, due: true},
+		{name: "threshold reached", policy: PublishingPolicy{Mode: PublishingModeThreshold, Threshold: 5}, pending: recent, due: true},
+		{name: "threshold not reached", policy: PublishingPolicy{Mode: PublishingModeThreshold, Threshold: 10}, pending: old},
+		{name: "threshold max wait", policy: PublishingPolicy{Mode: PublishingModeThreshold, Threshold: 10, Interval: time.Hour}, pending: old, due: true},
+		{name: "revocations in their window", policy: PublishingPolicy{Mode: PublishingModeImmediate, RevocationWindow: 15 * time.Minute}, pending: newRevocations},
+		{name: "revocation window elapsed", policy: PublishingPolicy{Mode: PublishingModeImmediate, RevocationWindow: 15 * time.Minute}, pending: oldRevocations, due: true},
+		{name: "revocations without window", policy: PublishingPolicy{Mode: PublishingModeImmediate}, pending: newRevocations, due: true},
+		{name: "claims with revocations in their window", policy: PublishingPolicy{Mode: PublishingModeImmediate, RevocationWindow: 15 * time.Minute}, pending: claimsAndRevocations, due: true},
+		{name: "revocations do not count for the threshold", policy: PublishingPolicy{Mode: PublishingModeThreshold, Threshold: 3, RevocationWindow: 15 * time.Minute}, pending: claimsAndRevocations},
+		{name: "manual ignores the revocation window", policy: PublishingPolicy{Mode: PublishingModeManual, RevocationWindow: 15 * time.Minute}, pending: oldRevocations},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.due, tc.policy.Due(tc.pending, now))
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"encoding/json"
+
+	"github.com/iden3/go-schema-processor/verifiable"
+)
+
+// Iden3RefreshService2023 is the type of the refresh service of the credentials refreshed through the agent endpoint
+const Iden3RefreshService2023 = "Iden3RefreshService2023"
+
+// RefreshService is the refreshService section of a credential, the endpoint where the holder asks for a new one
+type RefreshService struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+}
+
+// RefreshableCredential is a verifiable credential with its refresh service. The W3CCredential of the schema
+// processor does not have the section, so it is kept here to be stored and sent with the credential.
+type RefreshableCredential struct {
+	verifiable.W3CCredential
+	RefreshService *RefreshService `json:"refreshService,omitempty"`
+}
+
+// GetRefreshService returns the refresh service of the credential, or nil if it cannot be refreshed
+func (c *Claim) GetRefreshService() *RefreshService {
+	var doc struct {
+		RefreshService *RefreshService `json:"refreshService"`
+	}
+	if len(c.Data.Bytes) == 0 || json.Unmarshal(c.Data.Bytes, &doc) != nil {
+		return nil
+	}
+	return doc.RefreshService
+}
@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/iden3/go-schema-processor/verifiable"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClaim_GetRefreshService(t *testing.T) {
+	vc := verifiable.W3CCredential{
+		ID:                "https://issuer.example.com/v1/credentials/1",
+		Context:           []string{"https://www.w3.org/2018/credentials/v1"},
+		Type:              []string{"VerifiableCredential", "KYCAgeCredential"},
+		CredentialSubject: map[string]interface{}{"type": "KYCAgeCredential"},
+	}
+
+	var claim Claim
+	require.NoError(t, claim.Data.Set(RefreshableCredential{W3CCredential: vc}))
+	assert.Nil(t, claim.GetRefreshService())
+	assert.NotContains(t, string(claim.Data.Bytes), "refreshService")
+
+	refreshService := &RefreshService{ID: "https://issuer.example.com/v1/agent", Type: Iden3RefreshService2023}
+	require.NoError(t, claim.Data.Set(RefreshableCredential{W3CCredential: vc, RefreshService: refreshService}))
+	assert.Equal(t, refreshService, claim.GetRefreshService())
+
+	// the fields of the credential stay at the top of the document
+	var doc map[string]any
+	require.NoError(t, json.Unmarshal(claim.Data.Bytes, &doc))
+	assert.Equal(t, vc.ID, doc["id"])
+	assert.NotNil(t, doc["refreshService"])
+	stored, err := claim.GetVerifiableCredential()
+	require.NoError(t, err)
+	assert.Equal(t, vc.Type, stored.Type)
+}
@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"time"
+)
+
+// ReportFrequency defines how often a scheduled report is sent
+type ReportFrequency string
+
+const (
+	// ReportFrequencyDaily sends the report every day with the activity of the previous 24 hours
+	ReportFrequencyDaily ReportFrequency = "daily"
+	// ReportFrequencyWeekly sends the report every monday with the activity of the previous 7 days
+	ReportFrequencyWeekly ReportFrequency = "weekly"
+)
+
+// ReportFormat is the format a report is rendered in
+type ReportFormat string
+
+const (
+	// ReportFormatCSV renders the report as a CSV attachment
+	ReportFormatCSV ReportFormat = "csv"
+	// ReportFormatHTML renders the report as the HTML body of the email
+	ReportFormatHTML ReportFormat = "html"
+)
+
+// Valid returns true for the supported frequencies
+func (f ReportFrequency) Valid() bool {
+	return f == ReportFrequencyDaily || f == ReportFrequencyWeekly
+}
+
+// Period returns the length of the period covered by a report
+func (f ReportFrequency) Period() time.Duration {
+	if f == ReportFrequencyWeekly {
+		return 7 * 24 * time.Hour
+	}
+	return 24 * time.Hour
+}
+
+// Next returns the first time after now a report has to be sent. Daily reports are sent every day at the given UTC
+// hour and weekly reports on mondays at the same hour.
+func (f ReportFrequency) Next(now time.Time, hour int) time.Time {
+	now = now.UTC()
+	next := time.Date(now.Year(), now.Month(), now.Day(), hour, 0, 0, 0, time.UTC)
+	if f == ReportFrequencyWeekly {
+		next = next.AddDate(0, 0, int(time.Monday-next.Weekday()+7)%7)
+	}
+	for !next.After(now) {
+		next = next.Add(f.Period())
+	}
+	return next
+}
+
+// Report summarizes the activity of the node between From and To
+type Report struct {
+	From             time.Time
+	To               time.Time
+	Issued           []IssuanceStat
+	Revocations      []RevocationStat
+	FailedOperations []FailedOperation
+}
+
+// IssuanceStat is the number of credentials of a schema issued by an identity
+type IssuanceStat struct {
+	IssuerDID  string
+	SchemaType string
+	Count      int
+}
+
+// RevocationStat is the number of credentials revoked by an identity
+type RevocationStat struct {
+	IssuerDID string
+	Count     int
+}
+
+// FailedOperation is a state transition of an identity that failed
+type FailedOperation struct {
+	IssuerDID  string
+	State      string
+	TxID       string
+	ModifiedAt time.Time
+}
+
+// TotalIssued returns the number of credentials issued in the report period
+func (r *Report) TotalIssued() int {
+	total := 0
+	for _, s := range r.Issued {
+		total += s.Count
+	}
+	return total
+}
+
+// TotalRevoked returns the number of credentials revoked in the report period
+func (r *Report) TotalRevoked() int {
+	total := 0
+	for _, s := range r.Revocations {
+		total += s.Count
+	}
+	return total
+}
@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReportFrequencyNext(t *testing.T) {
+	// 2023-04-19 is a wednesday
+	now := time.Date(2023, 4, 19, 10, 30, 0, 0, time.UTC)
+	for _, tc := range []struct {
+		name      string
+		frequency ReportFrequency
+		hour      int
+		now       time.Time
+		expected  time.Time
+	}{
+		{
+			name:      "daily later today",
+			frequency: ReportFrequencyDaily,
+			hour:      18,
+			now:       now,
+			expected:  time.Date(2023, 4, 19, 18, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "daily tomorrow",
+			frequency: ReportFrequencyDaily,
+			hour:      6,
+			now:       now,
+			expected:  time.Date(2023, 4, 20, 6, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "daily at the exact time",
+			frequency: ReportFrequencyDaily,
+			hour:      10,
+			now:       time.Date(2023, 4, 19, 10, 0, 0, 0, time.UTC),
+			expected:  time.Date(2023, 4, 20, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "weekly next monday",
+			frequency: ReportFrequencyWeekly,
+			hour:      6,
+			now:       now,
+			expected:  time.Date(2023, 4, 24, 6, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "weekly later this monday",
+			frequency: ReportFrequencyWeekly,
+			hour:      6,
+			now:       time.Date(2023, 4, 24, 5, 0, 0, 0, time.UTC),
+			expected:  time.Date(2023, 4, 24, 6, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "weekly monday already sent",
+			frequency: ReportFrequencyWeekly,
+			hour:      6,
+			now:       time.Date(2023, 4, 24, 7, 0, 0, 0, time.UTC),
+			expected:  time.Date(2023, 5, 1, 6, 0, 0, 0, time.UTC),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.frequency.Next(tc.now, tc.hour))
+		})
+	}
+}
+
+func TestReportTotals(t *testing.T) {
+	report := Report{
+		Issued:      []IssuanceStat{{Count: 3}, {Count: 4}},
+		Revocations: []RevocationStat{{Count: 2}},
+	}
+	assert.Equal(t, 7, report.TotalIssued())
+	assert.Equal(t, 2, report.TotalRevoked())
+}
@@ -2,7 +2,10 @@ package domain
 
 import (
 	"database/sql/driver"
+	"errors"
+	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/iden3/go-circuits"
 	"github.com/iden3/go-schema-processor/verifiable"
@@ -18,6 +21,16 @@ func (r RevNonceUint64) Value() (driver.Value, error) {
 	return strconv.FormatUint(uint64(r), 10), nil
 }
 
+// RevNonceStrategy is how the revocation nonces of the new credentials of an issuer are chosen
+type RevNonceStrategy string
+
+const (
+	// RevNonceRandom nonces do not reveal how many credentials the issuer has issued
+	RevNonceRandom RevNonceStrategy = "random"
+	// RevNonceSequential nonces count the credentials of the issuer from 1, so they are easier to audit
+	RevNonceSequential RevNonceStrategy = "sequential"
+)
+
 // RevStatus status of revocation nonce
 type RevStatus int
 
@@ -28,14 +41,46 @@ const (
 	RevPublished RevStatus = 1
 )
 
+// ErrInvalidRevocationReason the revocation reason is not one of the known codes
+var ErrInvalidRevocationReason = errors.New("invalid revocation reason")
+
+// RevocationReason is the code of why a credential was revoked. The codes are the ones of the CRL reasons of X.509,
+// plus expired for the credentials revoked by the expiration policy.
+type RevocationReason string
+
+const (
+	RevocationUnspecified          RevocationReason = "unspecified"          // RevocationUnspecified no reason was given
+	RevocationKeyCompromise        RevocationReason = "keyCompromise"        // RevocationKeyCompromise the key of the holder was compromised
+	RevocationAffiliationChanged   RevocationReason = "affiliationChanged"   // RevocationAffiliationChanged the holder is no longer related to the issuer, like a former employee
+	RevocationSuperseded           RevocationReason = "superseded"           // RevocationSuperseded the credential was replaced by a new one
+	RevocationCessationOfOperation RevocationReason = "cessationOfOperation" // RevocationCessationOfOperation what the credential attests no longer exists
+	RevocationPrivilegeWithdrawn   RevocationReason = "privilegeWithdrawn"   // RevocationPrivilegeWithdrawn the holder is no longer entitled to the credential
+	RevocationExpired              RevocationReason = "expired"              // RevocationExpired the credential expired and its schema revokes the expired credentials
+)
+
+// NewRevocationReason returns the revocation reason with the given code, unspecified if it is empty
+func NewRevocationReason(code string) (RevocationReason, error) {
+	switch reason := RevocationReason(code); reason {
+	case "":
+		return RevocationUnspecified, nil
+	case RevocationUnspecified, RevocationKeyCompromise, RevocationAffiliationChanged, RevocationSuperseded,
+		RevocationCessationOfOperation, RevocationPrivilegeWithdrawn, RevocationExpired:
+		return reason, nil
+	default:
+		return "", fmt.Errorf("%w: %s", ErrInvalidRevocationReason, code)
+	}
+}
+
 // Revocation struct
 type Revocation struct {
-	ID          int64          `json:"-"`
-	Identifier  string         `json:"identifier"`
-	Nonce       RevNonceUint64 `json:"nonce"`
-	Version     uint32         `json:"version"`
-	Status      RevStatus      `json:"status"`
-	Description string         `json:"description"`
+	ID          int64            `json:"-"`
+	Identifier  string           `json:"identifier"`
+	Nonce       RevNonceUint64   `json:"nonce"`
+	Version     uint32           `json:"version"`
+	Status      RevStatus        `json:"status"`
+	Reason      RevocationReason `json:"reason"`
+	Description string           `json:"description"`
+	CreatedAt   time.Time        `json:"createdAt"`
 }
 
 // RevocationStatusToTreeState TBD
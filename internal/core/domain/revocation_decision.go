@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+)
+
+// RevocationDecisionStatus is the outcome of a revocation decision
+type RevocationDecisionStatus string
+
+const (
+	RevocationDecisionApplied  RevocationDecisionStatus = "applied"  // RevocationDecisionApplied the credential was revoked
+	RevocationDecisionRejected RevocationDecisionStatus = "rejected" // RevocationDecisionRejected the credential was not revoked, the error says why
+)
+
+// RevocationDecision is a decision to revoke a credential taken by an external system, like a fraud engine or an HR
+// system. Decisions are identified by the source and its id in the source, so a decision received again is not
+// applied twice.
+type RevocationDecision struct {
+	ID           uuid.UUID
+	IssuerDID    core.DID
+	Source       string
+	ExternalID   string
+	CredentialID *uuid.UUID
+	RevNonce     *RevNonceUint64
+	Reason       string
+	Status       RevocationDecisionStatus
+	Error        *string
+	DecidedAt    time.Time
+	CreatedAt    time.Time
+}
+
+// Reject marks the decision as rejected for the given reason
+func (d *RevocationDecision) Reject(reason string) {
+	d.Status = RevocationDecisionRejected
+	d.Error = &reason
+}
+
+// RevocationDecisionsReport reconciles the decisions received from the external systems with the applied ones
+type RevocationDecisionsReport struct {
+	Applied   int
+	Rejected  int
+	Decisions []*RevocationDecision
+}
+
+// NewRevocationDecisionsReport counts the applied and rejected decisions
+func NewRevocationDecisionsReport(decisions []*RevocationDecision) *RevocationDecisionsReport {
+	report := &RevocationDecisionsReport{Decisions: decisions}
+	for _, decision := range decisions {
+		switch decision.Status {
+		case RevocationDecisionApplied:
+			report.Applied++
+		case RevocationDecisionRejected:
+			report.Rejected++
+		}
+	}
+	return report
+}
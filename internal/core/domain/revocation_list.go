@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	core "github.com/iden3/go-iden3-core"
+)
+
+const (
+	// RevocationBitstringMinSize is the minimum number of bits of a revocation bitstring, the 16KB of a
+	// StatusList2021, so the size of the list does not tell how many credentials the issuer has
+	RevocationBitstringMinSize = 131072
+	// RevocationBitstringMaxSize is the maximum number of bits of a revocation bitstring. The random nonces are too
+	// large for it, the bitstring is meant for the sequential ones.
+	RevocationBitstringMaxSize = 1 << 24
+)
+
+// ErrRevocationBitstringTooLarge a revoked nonce is beyond the maximum size of a revocation bitstring
+var ErrRevocationBitstringTooLarge = errors.New("revoked nonces too large for a bitstring")
+
+// RevocationList is the list of the nonces revoked by an issuer, so relying parties can sync the revocations in bulk.
+// Since, if set, is the time the revocations of the list were made from. SyncedAt is the time of the list.
+type RevocationList struct {
+	IssuerDID core.DID
+	Since     *time.Time
+	SyncedAt  time.Time
+	Nonces    []RevNonceUint64
+}
+
+// Bitstring returns the revoked nonces as the encoded list of a StatusList2021: the GZIP compressed and base64url
+// encoded bitstring with the bit of every revoked nonce set. The first bit is the most significant bit of the first
+// byte. ErrRevocationBitstringTooLarge is returned if a nonce does not fit in RevocationBitstringMaxSize bits.
+func (l *RevocationList) Bitstring() (string, error) {
+	positions := make([]uint64, len(l.Nonces))
+	for i, nonce := range l.Nonces {
+		positions[i] = uint64(nonce)
+	}
+	return encodeBitstring(positions)
+}
+
+// encodeBitstring returns the GZIP compressed and base64url encoded bitstring with the bits of the positions set
+func encodeBitstring(positions []uint64) (string, error) {
+	size := RevocationBitstringMinSize
+	for _, position := range positions {
+		if position >= RevocationBitstringMaxSize {
+			return "", fmt.Errorf("%w: %d is beyond the %d bits of the bitstring", ErrRevocationBitstringTooLarge, position, RevocationBitstringMaxSize)
+		}
+		if int(position) >= size {
+			size = (int(position)/8 + 1) * 8
+		}
+	}
+
+	bits := make([]byte, size/8)
+	for _, position := range positions {
+		bits[position/8] |= 0x80 >> (position % 8)
+	}
+
+	var compressed bytes.Buffer
+	writer := gzip.NewWriter(&compressed)
+	if _, err := writer.Write(bits); err != nil {
+		return "", err
+	}
+	if err := writer.Close(); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(compressed.Bytes()), nil
+}
@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func decodeBitstring(t *testing.T, encoded string) []byte {
+	t.Helper()
+	compressed, err := base64.RawURLEncoding.DecodeString(encoded)
+	require.NoError(t, err)
+	reader, err := gzip.NewReader(bytes.NewReader(compressed))
+	require.NoError(t, err)
+	bits, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	return bits
+}
+
+func TestRevocationList_Bitstring(t *testing.T) {
+	list := RevocationList{Nonces: []RevNonceUint64{0, 9, 15}}
+	encoded, err := list.Bitstring()
+	require.NoError(t, err)
+	bits := decodeBitstring(t, encoded)
+	require.Len(t, bits, RevocationBitstringMinSize/8)
+	assert.Equal(t, byte(0b10000000), bits[0])
+	assert.Equal(t, byte(0b01000001), bits[1])
+	assert.Equal(t, make([]byte, len(bits)-2), bits[2:])
+
+	// an empty list has the minimum size
+	encoded, err = (&RevocationList{}).Bitstring()
+	require.NoError(t, err)
+	assert.Len(t, decodeBitstring(t, encoded), RevocationBitstringMinSize/8)
+
+	// the bitstring grows to the largest nonce
+	list = RevocationList{Nonces: []RevNonceUint64{RevocationBitstringMinSize + 3}}
+	encoded, err = list.Bitstring()
+	require.NoError(t, err)
+	bits = decodeBitstring(t, encoded)
+	require.Len(t, bits, RevocationBitstringMinSize/8+1)
+	assert.Equal(t, byte(0b00010000), bits[len(bits)-1])
+
+	list = RevocationList{Nonces: []RevNonceUint64{1, RevocationBitstringMaxSize}}
+	_, err = list.Bitstring()
+	assert.ErrorIs(t, err, ErrRevocationBitstringTooLarge)
+}
@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRevocationReason(t *testing.T) {
+	for _, tc := range []struct {
+		code   string
+		expect RevocationReason
+	}{
+		{code: "", expect: RevocationUnspecified},
+		{code: "unspecified", expect: RevocationUnspecified},
+		{code: "keyCompromise", expect: RevocationKeyCompromise},
+		{code: "affiliationChanged", expect: RevocationAffiliationChanged},
+		{code: "superseded", expect: RevocationSuperseded},
+		{code: "cessationOfOperation", expect: RevocationCessationOfOperation},
+		{code: "privilegeWithdrawn", expect: RevocationPrivilegeWithdrawn},
+		{code: "expired", expect: RevocationExpired},
+	} {
+		t.Run(tc.code, func(t *testing.T) {
+			reason, err := NewRevocationReason(tc.code)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expect, reason)
+		})
+	}
+
+	_, err := NewRevocationReason("KeyCompromise")
+	assert.ErrorIs(t, err, ErrInvalidRevocationReason)
+}
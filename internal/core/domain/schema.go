@@ -1,6 +1,8 @@
 package domain
 
 import (
+	"errors"
+	"fmt"
 	"strings"
 	"time"
 
@@ -16,6 +18,9 @@ const (
 	AuthBJJCredentialSchemaType    = "https://schema.iden3.io/core/jsonld/auth.jsonld#AuthBJJCredential"
 )
 
+// ErrMaxValidity the credential expires later than the maximum validity of its schema
+var ErrMaxValidity = errors.New("credential exceeds the maximum validity of the schema")
+
 // SchemaFormat type
 type SchemaFormat string
 
@@ -27,6 +32,16 @@ const (
 	JSON SchemaFormat = "json"
 )
 
+// SchemaStatus is the lifecycle status of an imported schema
+type SchemaStatus string
+
+const (
+	// SchemaStatusActive schemas can be used to issue credentials
+	SchemaStatusActive SchemaStatus = "active"
+	// SchemaStatusDeprecated schemas keep their credentials but cannot be used to issue new ones
+	SchemaStatusDeprecated SchemaStatus = "deprecated"
+)
+
 // SchemaAttrs is a collection of schema attributes
 type SchemaAttrs []string
 
@@ -48,6 +63,22 @@ func SchemaAttrsFromString(commaAttrs string) SchemaAttrs {
 	return schemaAttrs
 }
 
+// SchemaWebhook is an external service called with the credentials of a schema. Secret, if not empty, is the key used
+// to sign the requests, so the service can check they come from the node.
+type SchemaWebhook struct {
+	URL    string
+	Secret string
+}
+
+// CachedDocument describes a JSON schema or JSON-LD context kept in the document cache of the node
+type CachedDocument struct {
+	URL         string
+	ContentType string
+	ETag        string
+	Size        int
+	FetchedAt   time.Time
+}
+
 // Schema defines a domain.Schema entity
 type Schema struct {
 	ID         uuid.UUID
@@ -57,4 +88,58 @@ type Schema struct {
 	Hash       core.SchemaHash
 	Attributes SchemaAttrs
 	CreatedAt  time.Time
+
+	AutoRevokeOnExpiration bool
+	// ValidationWebhook, if set, must approve the credential subject of every credential of the schema before it is
+	// issued
+	ValidationWebhook *SchemaWebhook
+	// ExtraContexts and ExtraTypes are added to the @context and type of every credential of the schema, so issuers
+	// can use their own terms without forking the schema
+	ExtraContexts []string
+	ExtraTypes    []string
+	// DefaultProofTypes, if set, are the proof types of the credentials of the schema requested without them, instead
+	// of the default ones of the proof policy
+	DefaultProofTypes *ProofTypes
+	// MaxValidityDays, if set, is the longest time the credentials of the schema are valid since they are issued, so
+	// none of them is issued without expiration
+	MaxValidityDays *int
+	// Version is incremented on every update, so concurrent updates of the same schema can be detected
+	Version int
+	// Documents are the generated documents of the schemas built in the node. They are only set when the schema is
+	// built, the imported schemas are served by their own hosts.
+	Documents *SchemaDocuments
+	// IPFSCID and IPFSContextCID are the content identifiers of the JSON Schema and the JSON-LD context of a built
+	// schema pinned to IPFS. Empty if the documents were not pinned.
+	IPFSCID        string
+	IPFSContextCID string
+	// SchemaVersion numbers the schemas of the same type imported by an issuer, starting at 1, so importing a type
+	// again, usually from a new url, creates a new version of it
+	SchemaVersion int
+	// DeprecatedAt is set when the schema is deprecated
+	DeprecatedAt *time.Time
+}
+
+// Status returns whether the schema is active or deprecated
+func (s *Schema) Status() SchemaStatus {
+	if s.DeprecatedAt != nil {
+		return SchemaStatusDeprecated
+	}
+	return SchemaStatusActive
+}
+
+// Expiration returns the expiration of a credential of the schema issued at the given time with the requested one. The
+// credentials requested without expiration expire at the end of the maximum validity of the schema, if it has one, and
+// ErrMaxValidity is returned if the requested expiration is later.
+func (s *Schema) Expiration(issuedAt time.Time, expiration *time.Time) (*time.Time, error) {
+	if s.MaxValidityDays == nil {
+		return expiration, nil
+	}
+	maxExpiration := issuedAt.AddDate(0, 0, *s.MaxValidityDays)
+	if expiration == nil {
+		return &maxExpiration, nil
+	}
+	if expiration.After(maxExpiration) {
+		return nil, fmt.Errorf("%w: the credentials of the schema are valid for %d days at most", ErrMaxValidity, *s.MaxValidityDays)
+	}
+	return expiration, nil
 }
@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+)
+
+// Types of the attributes of a built schema
+const (
+	SchemaAttributeString  = "string"
+	SchemaAttributeInteger = "integer"
+	SchemaAttributeNumber  = "number"
+	SchemaAttributeBoolean = "boolean"
+	SchemaAttributeDate    = "date"
+)
+
+var schemaTermRegexp = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+
+// SchemaDefinition is the credential type and the credential subject attributes a schema is built from
+type SchemaDefinition struct {
+	Type        string
+	Title       string
+	Description string
+	Attributes  []SchemaAttributeDefinition
+}
+
+// SchemaAttributeDefinition is an attribute of the credential subject of a built schema
+type SchemaAttributeDefinition struct {
+	Name        string
+	Type        string
+	Title       string
+	Description string
+	Required    bool
+}
+
+// SchemaDocuments are the JSON Schema and JSON-LD context of a schema built by the node. They are served by the node
+// at the url of the schema and the jsonLdContext url of its metadata.
+type SchemaDocuments struct {
+	JSONSchema    []byte
+	JSONLDContext []byte
+}
+
+// Validate returns an error if the type or the attributes cannot be used as JSON-LD terms, or an attribute type is
+// unknown
+func (d *SchemaDefinition) Validate() error {
+	if !schemaTermRegexp.MatchString(d.Type) {
+		return fmt.Errorf("invalid type %q", d.Type)
+	}
+	if len(d.Attributes) == 0 {
+		return errors.New("no attributes")
+	}
+	names := make(map[string]bool, len(d.Attributes))
+	for _, attr := range d.Attributes {
+		if !schemaTermRegexp.MatchString(attr.Name) || attr.Name == "id" || attr.Name == "type" {
+			return fmt.Errorf("invalid attribute name %q", attr.Name)
+		}
+		if names[attr.Name] {
+			return fmt.Errorf("duplicated attribute %q", attr.Name)
+		}
+		names[attr.Name] = true
+		switch attr.Type {
+		case SchemaAttributeString, SchemaAttributeInteger, SchemaAttributeNumber, SchemaAttributeBoolean, SchemaAttributeDate:
+		default:
+			return fmt.Errorf("unknown type %q of attribute %q", attr.Type, attr.Name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSchemaDefinition_Validate(t *testing.T) {
+	valid := func() SchemaDefinition {
+		return SchemaDefinition{
+			Type: "Membership",
+			Attributes: []SchemaAttributeDefinition{
+				{Name: "level", Type: SchemaAttributeInteger, Required: true},
+				{Name: "since", Type: SchemaAttributeDate},
+			},
+		}
+	}
+	for _, tc := range []struct {
+		name   string
+		modify func(d *SchemaDefinition)
+		valid  bool
+	}{
+		{name: "valid", modify: func(d *SchemaDefinition) {}, valid: true},
+		{name: "invalid type", modify: func(d *SchemaDefinition) { d.Type = "Member ship" }},
+		{name: "no attributes", modify: func(d *SchemaDefinition) { d.Attributes = nil }},
+		{name: "reserved attribute", modify: func(d *SchemaDefinition) { d.Attributes[0].Name = "id" }},
+		{name: "invalid attribute name", modify: func(d *SchemaDefinition) { d.Attributes[0].Name = "2fa" }},
+		{name: "duplicated attribute", modify: func(d *SchemaDefinition) { d.Attributes[1].Name = "level" }},
+		{name: "unknown attribute type", modify: func(d *SchemaDefinition) { d.Attributes[1].Type = "object" }},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			d := valid()
+			tc.modify(&d)
+			if tc.valid {
+				assert.NoError(t, d.Validate())
+			} else {
+				assert.Error(t, d.Validate())
+			}
+		})
+	}
+}
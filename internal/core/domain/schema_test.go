@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+)
+
+func TestSchema_Expiration(t *testing.T) {
+	issuedAt := time.Date(2023, 6, 1, 10, 0, 0, 0, time.UTC)
+	requested := issuedAt.AddDate(0, 0, 30)
+
+	schema := Schema{}
+	expiration, err := schema.Expiration(issuedAt, nil)
+	require.NoError(t, err)
+	assert.Nil(t, expiration)
+	expiration, err = schema.Expiration(issuedAt, &requested)
+	require.NoError(t, err)
+	assert.Equal(t, &requested, expiration)
+
+	schema.MaxValidityDays = common.ToPointer(90)
+	expiration, err = schema.Expiration(issuedAt, nil)
+	require.NoError(t, err)
+	require.NotNil(t, expiration)
+	assert.Equal(t, time.Date(2023, 8, 30, 10, 0, 0, 0, time.UTC), *expiration)
+
+	expiration, err = schema.Expiration(issuedAt, &requested)
+	require.NoError(t, err)
+	assert.Equal(t, &requested, expiration)
+
+	maxExpiration := issuedAt.AddDate(0, 0, 90)
+	expiration, err = schema.Expiration(issuedAt, &maxExpiration)
+	require.NoError(t, err)
+	assert.Equal(t, &maxExpiration, expiration)
+
+	tooLate := maxExpiration.Add(time.Second)
+	_, err = schema.Expiration(issuedAt, &tooLate)
+	assert.ErrorIs(t, err, ErrMaxValidity)
+}
@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"regexp"
+	"strings"
+)
+
+// SearchFieldSchema is the field of the search terms that match the schema type of the credentials
+const SearchFieldSchema = "schema"
+
+var searchFieldRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// SearchTerm is a term of a search query. Field is empty for the terms searched in the words of the credentials,
+// schema for the schema type or the name of a credential subject field. Prefix terms match the values that begin with
+// Value.
+type SearchTerm struct {
+	Field  string
+	Value  string
+	Prefix bool
+}
+
+// IsDID returns true if the term is a DID, or the beginning of one
+func (t SearchTerm) IsDID() bool {
+	return t.Field == "" && strings.HasPrefix(t.Value, "did:")
+}
+
+// SearchQuery is a parsed search query
+type SearchQuery struct {
+	Terms []SearchTerm
+}
+
+// Words returns the terms without a field
+func (q SearchQuery) Words() []SearchTerm {
+	return q.filter(func(t SearchTerm) bool { return t.Field == "" })
+}
+
+// Fields returns the terms with a field
+func (q SearchQuery) Fields() []SearchTerm {
+	return q.filter(func(t SearchTerm) bool { return t.Field != "" })
+}
+
+func (q SearchQuery) filter(keep func(SearchTerm) bool) []SearchTerm {
+	terms := make([]SearchTerm, 0, len(q.Terms))
+	for _, t := range q.Terms {
+		if keep(t) {
+			terms = append(terms, t)
+		}
+	}
+	return terms
+}
+
+// ParseSearchQuery parses a query of terms separated by spaces or commas, like
+// `schema:KYCAgeCredential birthday:1990* did:polygonid:polygon:mumbai:2qH`. A term is a word, a DID or a field:value
+// pair, and ends in * to match the values that begin with it. The values with spaces are quoted, like
+// name:"John Smith". The DIDs are words, their method is not a field.
+func ParseSearchQuery(query string) SearchQuery {
+	var q SearchQuery
+	for _, token := range splitSearchQuery(query) {
+		term := SearchTerm{Value: token}
+		if field, value, ok := strings.Cut(token, ":"); ok && field != "did" && searchFieldRegexp.MatchString(field) {
+			term.Field, term.Value = field, value
+		}
+		if strings.HasSuffix(term.Value, "*") {
+			term.Value, term.Prefix = strings.TrimRight(term.Value, "*"), true
+		}
+		term.Value = strings.Trim(term.Value, `"`)
+		if term.Value == "" {
+			continue
+		}
+		q.Terms = append(q.Terms, term)
+	}
+	return q
+}
+
+// splitSearchQuery splits the query by the spaces and commas out of quotes
+func splitSearchQuery(query string) []string {
+	var tokens []string
+	var token strings.Builder
+	quoted := false
+	for _, r := range query {
+		switch {
+		case r == '"':
+			quoted = !quoted
+			token.WriteRune(r)
+		case !quoted && (r == ' ' || r == ',' || r == '\t' || r == '\n'):
+			if token.Len() > 0 {
+				tokens = append(tokens, token.String())
+				token.Reset()
+			}
+		default:
+			token.WriteRune(r)
+		}
+	}
+	if token.Len() > 0 {
+		tokens = append(tokens, token.String())
+	}
+	return tokens
+}
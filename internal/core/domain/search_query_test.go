@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSearchQuery(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		query string
+		terms []SearchTerm
+	}{
+		{name: "empty", query: "  ", terms: nil},
+		{name: "words", query: "kyc, age", terms: []SearchTerm{{Value: "kyc"}, {Value: "age"}}},
+		{
+			name:  "fields",
+			query: "schema:KYCAgeCredential birthday:1990*",
+			terms: []SearchTerm{{Field: SearchFieldSchema, Value: "KYCAgeCredential"}, {Field: "birthday", Value: "1990", Prefix: true}},
+		},
+		{
+			name:  "did",
+			query: "did:polygonid:polygon:mumbai:2qH",
+			terms: []SearchTerm{{Value: "did:polygonid:polygon:mumbai:2qH"}},
+		},
+		{
+			name:  "quoted value",
+			query: `name:"John Smith" doc*`,
+			terms: []SearchTerm{{Field: "name", Value: "John Smith"}, {Value: "doc", Prefix: true}},
+		},
+		{name: "not a field", query: "first-name:John", terms: []SearchTerm{{Value: "first-name:John"}}},
+		{name: "empty values", query: "birthday: * schema:", terms: nil},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.terms, ParseSearchQuery(tc.query).Terms)
+		})
+	}
+}
+
+func TestSearchQuery_WordsAndFields(t *testing.T) {
+	q := ParseSearchQuery("schema:KYCAgeCredential did:polygonid:polygon:mumbai:2qH kyc")
+	assert.Equal(t, []SearchTerm{{Value: "did:polygonid:polygon:mumbai:2qH"}, {Value: "kyc"}}, q.Words())
+	assert.Equal(t, []SearchTerm{{Field: SearchFieldSchema, Value: "KYCAgeCredential"}}, q.Fields())
+	assert.True(t, q.Words()[0].IsDID())
+	assert.False(t, q.Words()[1].IsDID())
+}
@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SessionStatus is the status of an authentication or credential link session
+type SessionStatus string
+
+const (
+	SessionStatusPending       SessionStatus = "pending"       // SessionStatusPending : the qr code was generated and the holder has not scanned it yet
+	SessionStatusAuthenticated SessionStatus = "authenticated" // SessionStatusAuthenticated : the holder has been authenticated
+	SessionStatusIssued        SessionStatus = "issued"        // SessionStatusIssued : the link credential has been issued to the holder
+	SessionStatusFailed        SessionStatus = "failed"        // SessionStatusFailed : the session can not progress anymore
+)
+
+// SessionState is the last known status of a session
+type SessionState struct {
+	SessionID uuid.UUID     `json:"sessionID"`
+	Status    SessionStatus `json:"status"`
+	Message   string        `json:"message,omitempty"`
+	UpdatedAt time.Time     `json:"updatedAt"`
+}
+
+// NewSessionState returns a session state with the given status
+func NewSessionState(sessionID uuid.UUID, status SessionStatus, message string) SessionState {
+	return SessionState{
+		SessionID: sessionID,
+		Status:    status,
+		Message:   message,
+		UpdatedAt: time.Now().UTC(),
+	}
+}
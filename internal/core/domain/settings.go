@@ -0,0 +1,149 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"net/url"
+	"sort"
+	"time"
+)
+
+// RateLimitBucket is a token bucket of the request rate limits
+type RateLimitBucket struct {
+	RequestsPerMinute int `json:"requestsPerMinute"`
+	Burst             int `json:"burst"`
+}
+
+// RateLimitSettings are the request rate limits of the http servers, one bucket for the requests authenticated with
+// basic auth and another for the anonymous ones
+type RateLimitSettings struct {
+	Enabled       bool
+	Authenticated RateLimitBucket
+	Anonymous     RateLimitBucket
+}
+
+// NotificationSettings pause the emails of the node without a restart. The emails that are not configured are not
+// sent whatever their toggle.
+type NotificationSettings struct {
+	OfferEmails   bool
+	Reports       bool
+	BacklogAlerts bool
+}
+
+// Settings are the operational settings that apply without restarting the processes. They take the value of the
+// configuration unless they are overridden with the admin API. Overridden has the names of the overridden ones.
+type Settings struct {
+	Publishing    PublishingPolicy
+	RateLimit     RateLimitSettings
+	Notifications NotificationSettings
+	RHSURL        string
+	Overridden    []string
+	UpdatedAt     *time.Time
+}
+
+// Validate returns an error if the publishing policy lacks the values of its mode, an enabled rate limit has an
+// empty bucket or the reverse hash service url is not an absolute http url
+func (s *Settings) Validate() error {
+	if err := s.Publishing.Validate(); err != nil {
+		return err
+	}
+	if s.RateLimit.Enabled {
+		for _, bucket := range []RateLimitBucket{s.RateLimit.Authenticated, s.RateLimit.Anonymous} {
+			if bucket.RequestsPerMinute <= 0 || bucket.Burst <= 0 {
+				return errors.New("the rate limits need requests per minute and a burst")
+			}
+		}
+	}
+	if s.RHSURL != "" {
+		rhsURL, err := url.ParseRequestURI(s.RHSURL)
+		if err != nil || (rhsURL.Scheme != "http" && rhsURL.Scheme != "https") || rhsURL.Host == "" {
+			return errors.New("the reverse hash service url must be an absolute http url")
+		}
+	}
+	return nil
+}
+
+// SettingsOverrides are the settings changed with the admin API, the nil ones take the configured value. The
+// publishing policy is replaced as a whole, so all its fields are set when it is overridden.
+type SettingsOverrides struct {
+	PublishingMode             *PublishingMode  `json:"publishingMode,omitempty"`
+	PublishingInterval         *time.Duration   `json:"publishingInterval,omitempty"`
+	PublishingThreshold        *int             `json:"publishingThreshold,omitempty"`
+	PublishingRevocationWindow *time.Duration   `json:"publishingRevocationWindow,omitempty"`
+	RateLimitEnabled           *bool            `json:"rateLimitEnabled,omitempty"`
+	RateLimitAuthenticated     *RateLimitBucket `json:"rateLimitAuthenticated,omitempty"`
+	RateLimitAnonymous         *RateLimitBucket `json:"rateLimitAnonymous,omitempty"`
+	OfferEmails                *bool            `json:"offerEmails,omitempty"`
+	Reports                    *bool            `json:"reports,omitempty"`
+	BacklogAlerts              *bool            `json:"backlogAlerts,omitempty"`
+	RHSURL                     *string          `json:"rhsUrl,omitempty"`
+	UpdatedAt                  *time.Time       `json:"-"`
+}
+
+// OverridePublishing overrides the publishing policy
+func (o *SettingsOverrides) OverridePublishing(policy PublishingPolicy) {
+	o.PublishingMode = &policy.Mode
+	o.PublishingInterval = &policy.Interval
+	o.PublishingThreshold = &policy.Threshold
+	o.PublishingRevocationWindow = &policy.RevocationWindow
+}
+
+// Apply returns the configured settings with the overrides
+func (o *SettingsOverrides) Apply(configured Settings) Settings {
+	settings := configured
+	if o.PublishingMode != nil {
+		settings.Publishing.Mode = *o.PublishingMode
+	}
+	if o.PublishingInterval != nil {
+		settings.Publishing.Interval = *o.PublishingInterval
+	}
+	if o.PublishingThreshold != nil {
+		settings.Publishing.Threshold = *o.PublishingThreshold
+	}
+	if o.PublishingRevocationWindow != nil {
+		settings.Publishing.RevocationWindow = *o.PublishingRevocationWindow
+	}
+	if o.RateLimitEnabled != nil {
+		settings.RateLimit.Enabled = *o.RateLimitEnabled
+	}
+	if o.RateLimitAuthenticated != nil {
+		settings.RateLimit.Authenticated = *o.RateLimitAuthenticated
+	}
+	if o.RateLimitAnonymous != nil {
+		settings.RateLimit.Anonymous = *o.RateLimitAnonymous
+	}
+	if o.OfferEmails != nil {
+		settings.Notifications.OfferEmails = *o.OfferEmails
+	}
+	if o.Reports != nil {
+		settings.Notifications.Reports = *o.Reports
+	}
+	if o.BacklogAlerts != nil {
+		settings.Notifications.BacklogAlerts = *o.BacklogAlerts
+	}
+	if o.RHSURL != nil {
+		settings.RHSURL = *o.RHSURL
+	}
+	settings.Publishing.Overridden = o.PublishingMode != nil
+	settings.Overridden = o.names()
+	settings.UpdatedAt = o.UpdatedAt
+	return settings
+}
+
+// names returns the sorted names of the overridden settings, the keys they are stored with
+func (o *SettingsOverrides) names() []string {
+	names := make([]string, 0)
+	content, err := json.Marshal(o)
+	if err != nil {
+		return names
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(content, &fields); err != nil {
+		return names
+	}
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
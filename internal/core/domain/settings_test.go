@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+)
+
+func TestSettingsOverrides_Apply(t *testing.T) {
+	configured := Settings{
+		Publishing:    PublishingPolicy{Mode: PublishingModeImmediate},
+		RateLimit:     RateLimitSettings{Enabled: true, Authenticated: RateLimitBucket{RequestsPerMinute: 600, Burst: 100}, Anonymous: RateLimitBucket{RequestsPerMinute: 60, Burst: 10}},
+		Notifications: NotificationSettings{OfferEmails: true, Reports: true, BacklogAlerts: true},
+		RHSURL:        "https://rhs.example.com",
+	}
+
+	overrides := &SettingsOverrides{}
+	settings := overrides.Apply(configured)
+	assert.Equal(t, configured.Publishing, settings.Publishing)
+	assert.Equal(t, configured.RateLimit, settings.RateLimit)
+	assert.Empty(t, settings.Overridden)
+
+	overrides.OverridePublishing(PublishingPolicy{Mode: PublishingModeInterval, Interval: time.Hour})
+	overrides.RateLimitAnonymous = &RateLimitBucket{RequestsPerMinute: 30, Burst: 5}
+	overrides.Reports = common.ToPointer(false)
+	overrides.RHSURL = common.ToPointer("")
+	settings = overrides.Apply(configured)
+	assert.Equal(t, PublishingModeInterval, settings.Publishing.Mode)
+	assert.Equal(t, time.Hour, settings.Publishing.Interval)
+	assert.True(t, settings.Publishing.Overridden)
+	assert.True(t, settings.RateLimit.Enabled)
+	assert.Equal(t, configured.RateLimit.Authenticated, settings.RateLimit.Authenticated)
+	assert.Equal(t, RateLimitBucket{RequestsPerMinute: 30, Burst: 5}, settings.RateLimit.Anonymous)
+	assert.Equal(t, NotificationSettings{OfferEmails: true, Reports: false, BacklogAlerts: true}, settings.Notifications)
+	assert.Empty(t, settings.RHSURL)
+	assert.Equal(t, []string{
+		"publishingInterval", "publishingMode", "publishingRevocationWindow", "publishingThreshold",
+		"rateLimitAnonymous", "reports", "rhsUrl",
+	}, settings.Overridden)
+	require.NoError(t, settings.Validate())
+}
+
+func TestSettings_Validate(t *testing.T) {
+	valid := Settings{
+		Publishing: PublishingPolicy{Mode: PublishingModeManual},
+		RateLimit:  RateLimitSettings{Enabled: true, Authenticated: RateLimitBucket{RequestsPerMinute: 600, Burst: 100}, Anonymous: RateLimitBucket{RequestsPerMinute: 60, Burst: 10}},
+		RHSURL:     "https://rhs.example.com",
+	}
+	require.NoError(t, valid.Validate())
+
+	for name, change := range map[string]func(s *Settings){
+		"publishing mode without interval": func(s *Settings) { s.Publishing.Mode = PublishingModeInterval },
+		"empty rate limit bucket":          func(s *Settings) { s.RateLimit.Anonymous = RateLimitBucket{} },
+		"relative rhs url":                 func(s *Settings) { s.RHSURL = "/rhs" },
+		"rhs url without http":             func(s *Settings) { s.RHSURL = "ftp://rhs.example.com" },
+	} {
+		t.Run(name, func(t *testing.T) {
+			settings := valid
+			change(&settings)
+			assert.Error(t, settings.Validate())
+		})
+	}
+
+	disabled := valid
+	disabled.RateLimit = RateLimitSettings{}
+	disabled.RHSURL = ""
+	assert.NoError(t, disabled.Validate())
+}
@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// StateTransactionStatus is the status of a state transition transaction tracked by the transaction monitor
+type StateTransactionStatus string
+
+const (
+	// StateTransactionPending the transaction has not been mined yet
+	StateTransactionPending StateTransactionStatus = "pending"
+	// StateTransactionMined one of the submissions of the transaction was mined successfully
+	StateTransactionMined StateTransactionStatus = "mined"
+	// StateTransactionFailed the transaction was reverted or its nonce was used by another transaction
+	StateTransactionFailed StateTransactionStatus = "failed"
+)
+
+// StateTransaction is a state transition sent to the state contract. Stuck transactions are resubmitted with the
+// same nonce and higher fees, so TxHashes holds every submission and TxID the latest one, or the mined one.
+type StateTransaction struct {
+	ID          uuid.UUID
+	Identifier  string
+	State       string
+	Network     string
+	TxID        string
+	TxHashes    []string
+	TxType      uint8
+	KeyType     string
+	KeyID       string
+	FromAddress string
+	ToAddress   string
+	Nonce       uint64
+	GasLimit    uint64
+	// GasTipCap is the max priority fee per gas. Legacy transactions have none.
+	GasTipCap *big.Int
+	// GasFeeCap is the max fee per gas, or the gas price of legacy transactions
+	GasFeeCap   *big.Int
+	Payload     []byte
+	Attempts    int
+	Status      StateTransactionStatus
+	Error       *string
+	CreatedAt   time.Time
+	SubmittedAt time.Time
+	UpdatedAt   time.Time
+}
+
+// PublishingCost is the estimated cost of publishing a new state. Cost is the gas limit times the max fee per gas, so
+// the actual cost is at most that.
+type PublishingCost struct {
+	GasLimit     uint64
+	MaxFeePerGas *big.Int
+	Cost         *big.Int
+}
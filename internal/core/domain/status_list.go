@@ -0,0 +1,102 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+)
+
+const (
+	// StatusList2021Context is the JSON-LD context of the StatusList2021 credentials and entries
+	StatusList2021Context = "https://w3id.org/vc/status-list/2021/v1"
+	// StatusList2021EntryType is the credentialStatus type of the credentials in a status list
+	StatusList2021EntryType = "StatusList2021Entry"
+	// StatusList2021CredentialType is the type of the credential that publishes a status list
+	StatusList2021CredentialType = "StatusList2021Credential"
+	// StatusPurposeRevocation the bits of the status list are set when the credentials are revoked
+	StatusPurposeRevocation = "revocation"
+)
+
+// ErrStatusListFull the status list of the issuer has no free index left
+var ErrStatusListFull = errors.New("status list full")
+
+// StatusListEntry is the index of a credential in the status list of its issuer. The credentials serialized for the
+// verifiers that don't support the iden3 revocation statuses get an index the first time they are serialized.
+type StatusListEntry struct {
+	IssuerDID core.DID
+	ClaimID   uuid.UUID
+	Index     uint64
+}
+
+// StatusList2021Entry is the credentialStatus of a credential in a status list
+type StatusList2021Entry struct {
+	ID                   string `json:"id"`
+	Type                 string `json:"type"`
+	StatusPurpose        string `json:"statusPurpose"`
+	StatusListIndex      string `json:"statusListIndex"`
+	StatusListCredential string `json:"statusListCredential"`
+}
+
+// Status returns the credentialStatus of the entry, pointing to the status list credential published at listURL
+func (e *StatusListEntry) Status(listURL string) *StatusList2021Entry {
+	index := strconv.FormatUint(e.Index, 10)
+	return &StatusList2021Entry{
+		ID:                   fmt.Sprintf("%s#%s", listURL, index),
+		Type:                 StatusList2021EntryType,
+		StatusPurpose:        StatusPurposeRevocation,
+		StatusListIndex:      index,
+		StatusListCredential: listURL,
+	}
+}
+
+// StatusList is the revocation status list of an issuer, with the indexes of its revoked credentials
+type StatusList struct {
+	IssuerDID core.DID
+	Revoked   []uint64
+	IssuedAt  time.Time
+}
+
+// StatusListCredential is the credential that publishes the status list of an issuer
+type StatusListCredential struct {
+	Context           []string          `json:"@context"`
+	ID                string            `json:"id"`
+	Type              []string          `json:"type"`
+	Issuer            string            `json:"issuer"`
+	IssuanceDate      time.Time         `json:"issuanceDate"`
+	CredentialSubject StatusListSubject `json:"credentialSubject"`
+}
+
+// StatusListSubject is the subject of a status list credential, the encoded bitstring
+type StatusListSubject struct {
+	ID            string `json:"id"`
+	Type          string `json:"type"`
+	StatusPurpose string `json:"statusPurpose"`
+	EncodedList   string `json:"encodedList"`
+}
+
+// Credential returns the StatusList2021Credential of the list, published at listURL. The encoded list is the
+// bitstring of the revocation lists, so it has the same sizes and ErrRevocationBitstringTooLarge is returned if an
+// index does not fit.
+func (s *StatusList) Credential(listURL string) (*StatusListCredential, error) {
+	encoded, err := encodeBitstring(s.Revoked)
+	if err != nil {
+		return nil, err
+	}
+	return &StatusListCredential{
+		Context:      []string{"https://www.w3.org/2018/credentials/v1", StatusList2021Context},
+		ID:           listURL,
+		Type:         []string{"VerifiableCredential", StatusList2021CredentialType},
+		Issuer:       s.IssuerDID.String(),
+		IssuanceDate: s.IssuedAt,
+		CredentialSubject: StatusListSubject{
+			ID:            listURL + "#list",
+			Type:          "StatusList2021",
+			StatusPurpose: StatusPurposeRevocation,
+			EncodedList:   encoded,
+		},
+	}, nil
+}
@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatusListEntry_Status(t *testing.T) {
+	entry := StatusListEntry{ClaimID: uuid.New(), Index: 94567}
+	status := entry.Status("https://issuer.example.com/v1/did/status-list")
+	assert.Equal(t, &StatusList2021Entry{
+		ID:                   "https://issuer.example.com/v1/did/status-list#94567",
+		Type:                 StatusList2021EntryType,
+		StatusPurpose:        StatusPurposeRevocation,
+		StatusListIndex:      "94567",
+		StatusListCredential: "https://issuer.example.com/v1/did/status-list",
+	}, status)
+}
+
+func TestStatusList_Credential(t *testing.T) {
+	did, err := core.ParseDID("did:polygonid:polygon:mumbai:2qH7XAwYQzCp9VfhpNgeLtK2iCehDDrfMWUCEg5ig5")
+	require.NoError(t, err)
+	issuedAt := time.Now().UTC()
+	list := StatusList{IssuerDID: *did, Revoked: []uint64{1, 8}, IssuedAt: issuedAt}
+
+	credential, err := list.Credential("https://issuer.example.com/status-list")
+	require.NoError(t, err)
+	assert.Equal(t, "https://issuer.example.com/status-list", credential.ID)
+	assert.Equal(t, "https://issuer.example.com/status-list#list", credential.CredentialSubject.ID)
+	assert.Equal(t, []string{"VerifiableCredential", StatusList2021CredentialType}, credential.Type)
+	assert.Contains(t, credential.Context, StatusList2021Context)
+	assert.Equal(t, did.String(), credential.Issuer)
+	assert.Equal(t, issuedAt, credential.IssuanceDate)
+
+	bits := decodeBitstring(t, credential.CredentialSubject.EncodedList)
+	require.Len(t, bits, RevocationBitstringMinSize/8)
+	assert.Equal(t, byte(0b01000000), bits[0])
+	assert.Equal(t, byte(0b10000000), bits[1])
+
+	list.Revoked = []uint64{RevocationBitstringMaxSize}
+	_, err = list.Credential("https://issuer.example.com/status-list")
+	assert.ErrorIs(t, err, ErrRevocationBitstringTooLarge)
+}
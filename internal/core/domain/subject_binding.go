@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+)
+
+const maxSubjectExternalIDLength = 255
+
+// ErrInvalidSubjectExternalID the placeholder subject is empty or too long
+var ErrInvalidSubjectExternalID = errors.New("the subject external id must have between 1 and 255 characters")
+
+// SubjectBinding binds a placeholder subject of an issuer, like an email or the id of a user in another system, to
+// the DID of the holder that claimed a credential issued to it. The credentials issued later to the placeholder are
+// issued to the DID.
+type SubjectBinding struct {
+	IssuerDID  core.DID
+	ExternalID string
+	HolderDID  core.DID
+	LinkID     *uuid.UUID // LinkID is the link claimed by the holder, nil if it was deleted
+	CreatedAt  time.Time
+}
+
+// NewSubjectBinding returns the binding of the placeholder subject to the holder DID
+func NewSubjectBinding(issuerDID core.DID, externalID string, holderDID core.DID, linkID *uuid.UUID) *SubjectBinding {
+	return &SubjectBinding{
+		IssuerDID:  issuerDID,
+		ExternalID: externalID,
+		HolderDID:  holderDID,
+		LinkID:     linkID,
+		CreatedAt:  time.Now().UTC(),
+	}
+}
+
+// NormalizeSubjectExternalID returns the placeholder subject without the surrounding spaces, or an error if it is
+// not valid. The placeholders are compared as they are stored, so emails are not lowercased.
+func NormalizeSubjectExternalID(externalID string) (string, error) {
+	externalID = strings.TrimSpace(externalID)
+	if externalID == "" || len(externalID) > maxSubjectExternalIDLength {
+		return "", ErrInvalidSubjectExternalID
+	}
+	return externalID, nil
+}
+
+// BoundTo returns true if the placeholder subject is bound to the holder DID
+func (b *SubjectBinding) BoundTo(holderDID core.DID) bool {
+	return b.HolderDID.String() == holderDID.String()
+}
@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeSubjectExternalID(t *testing.T) {
+	externalID, err := NormalizeSubjectExternalID("  jane@example.com ")
+	require.NoError(t, err)
+	assert.Equal(t, "jane@example.com", externalID)
+
+	_, err = NormalizeSubjectExternalID("   ")
+	assert.ErrorIs(t, err, ErrInvalidSubjectExternalID)
+	_, err = NormalizeSubjectExternalID(strings.Repeat("a", 256))
+	assert.ErrorIs(t, err, ErrInvalidSubjectExternalID)
+}
+
+func TestSubjectBinding_BoundTo(t *testing.T) {
+	issuerDID, err := core.ParseDID("did:polygonid:polygon:mumbai:2qCU58EJgrELNZCDkSU23dQHZsBgAFWLNpNezo1g6b")
+	require.NoError(t, err)
+	holderDID, err := core.ParseDID("did:polygonid:polygon:mumbai:2qCU58EJgrELNZCDkSU23dQHZsBgAGQ6kJWUd8iW2T")
+	require.NoError(t, err)
+
+	linkID := uuid.New()
+	binding := NewSubjectBinding(*issuerDID, "jane@example.com", *holderDID, &linkID)
+	assert.True(t, binding.BoundTo(*holderDID))
+	assert.False(t, binding.BoundTo(*issuerDID))
+}
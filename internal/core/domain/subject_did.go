@@ -0,0 +1,124 @@
+package domain
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	core "github.com/iden3/go-iden3-core"
+	"github.com/mr-tron/base58"
+)
+
+// DIDMethod is the method of a DID, the name after the did: prefix
+type DIDMethod string
+
+const (
+	DIDMethodPolygonID DIDMethod = "polygonid" // DIDMethodPolygonID Polygon ID identities
+	DIDMethodIden3     DIDMethod = "iden3"     // DIDMethodIden3 iden3 identities
+	DIDMethodKey       DIDMethod = "key"       // DIDMethodKey DIDs made of a public key
+	DIDMethodWeb       DIDMethod = "web"       // DIDMethodWeb DIDs whose document is hosted in a domain
+)
+
+// SubjectDIDMethods are the methods of the DIDs that can be the subject of a credential
+var SubjectDIDMethods = []DIDMethod{DIDMethodPolygonID, DIDMethodIden3, DIDMethodKey, DIDMethodWeb}
+
+// ErrInvalidSubjectDID the subject of the credential is not a DID of a supported method, or it cannot be resolved
+var ErrInvalidSubjectDID = errors.New("invalid subject did")
+
+var didRegexp = regexp.MustCompile(`^did:([a-z0-9]+):((?:[A-Za-z0-9._-]|%[0-9A-Fa-f]{2})*(?::(?:[A-Za-z0-9._-]|%[0-9A-Fa-f]{2})*)*)$`)
+
+// didKeyLengths are the lengths of the public keys of the did:key multicodecs
+var didKeyLengths = map[uint64]int{
+	0xed:   32, // ed25519-pub
+	0xec:   32, // x25519-pub
+	0xe7:   33, // secp256k1-pub, compressed
+	0x1200: 33, // p256-pub, compressed
+	0x1201: 49, // p384-pub, compressed
+	0xeb:   96, // bls12_381-g2-pub
+}
+
+// SubjectDID is the DID of the subject of a credential
+type SubjectDID struct {
+	DID    string
+	Method DIDMethod
+}
+
+// ParseSubjectDID checks that s is a well-formed DID of a supported method. The error explains what is wrong.
+func ParseSubjectDID(s string) (*SubjectDID, error) {
+	matches := didRegexp.FindStringSubmatch(s)
+	if matches == nil {
+		return nil, fmt.Errorf("%w: %q is not a did", ErrInvalidSubjectDID, s)
+	}
+	did := &SubjectDID{DID: s, Method: DIDMethod(matches[1])}
+	id := matches[2]
+
+	var err error
+	switch did.Method {
+	case DIDMethodPolygonID, DIDMethodIden3:
+		_, err = core.ParseDID(s)
+	case DIDMethodKey:
+		err = checkDIDKey(id)
+	case DIDMethodWeb:
+		err = checkDIDWeb(id)
+	default:
+		methods := make([]string, len(SubjectDIDMethods))
+		for i, method := range SubjectDIDMethods {
+			methods[i] = "did:" + string(method)
+		}
+		return nil, fmt.Errorf("%w: the did method %s is not supported, use one of %s", ErrInvalidSubjectDID, did.Method, strings.Join(methods, ", "))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed did:%s: %s", ErrInvalidSubjectDID, did.Method, err)
+	}
+	return did, nil
+}
+
+// IsIden3 returns true if the DID is the one of an iden3 identity, which can be the subject of a core claim
+func (d *SubjectDID) IsIden3() bool {
+	return d.Method == DIDMethodPolygonID || d.Method == DIDMethodIden3
+}
+
+// checkDIDKey checks that the id is a base58btc multibase encoded public key of a known type and length
+func checkDIDKey(id string) error {
+	if !strings.HasPrefix(id, "z") {
+		return errors.New("the key must be base58btc encoded, starting with z")
+	}
+	key, err := base58.Decode(id[1:])
+	if err != nil {
+		return errors.New("the key is not base58btc encoded")
+	}
+	codec, n := binary.Uvarint(key)
+	if n <= 0 {
+		return errors.New("the key has no multicodec prefix")
+	}
+	length, ok := didKeyLengths[codec]
+	if !ok {
+		return fmt.Errorf("unknown key type 0x%x", codec)
+	}
+	if len(key)-n != length {
+		return fmt.Errorf("the key of type 0x%x must have %d bytes, it has %d", codec, length, len(key)-n)
+	}
+	return nil
+}
+
+// checkDIDWeb checks that the id is a domain, with an optional port, followed by an optional path
+func checkDIDWeb(id string) error {
+	segments := strings.Split(id, ":")
+	host, err := url.PathUnescape(segments[0])
+	if err != nil {
+		return err
+	}
+	u, err := url.Parse("https://" + host)
+	if err != nil || u.Host != host || u.Hostname() == "" || !strings.Contains(u.Hostname(), ".") && u.Hostname() != "localhost" {
+		return fmt.Errorf("%q is not a domain", host)
+	}
+	for _, segment := range segments[1:] {
+		if segment == "" {
+			return errors.New("the path has an empty segment")
+		}
+	}
+	return nil
+}
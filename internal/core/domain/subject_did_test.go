@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSubjectDID(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		did    string
+		method DIDMethod
+		err    string
+	}{
+		{name: "polygonid", did: "did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ", method: DIDMethodPolygonID},
+		{name: "iden3", did: "did:iden3:polygon:mumbai:wuw5tydZ7AAd3efwEqPprnqjiNHR24jqruSPKmV1V", method: DIDMethodIden3},
+		{name: "ed25519 key", did: "did:key:z6MkhaXgBZDvotDkL5257faiztiGiC2QtKLGpbnnEGta2doK", method: DIDMethodKey},
+		{name: "secp256k1 key", did: "did:key:zQ3shokFTS3brHcDQrn82RUDfCZESWL1ZdCEJwekUDPQiYBme", method: DIDMethodKey},
+		{name: "web", did: "did:web:w3c-ccg.github.io", method: DIDMethodWeb},
+		{name: "web with port and path", did: "did:web:example.com%3A3000:user:alice", method: DIDMethodWeb},
+		{name: "not a did", did: "2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ", err: "is not a did"},
+		{name: "uppercase method", did: "did:KEY:z6MkhaXgBZDvotDkL5257faiztiGiC2QtKLGpbnnEGta2doK", err: "is not a did"},
+		{name: "unsupported method", did: "did:ethr:0xb9c5714089478a327f09197987f16f9e5d936e8a", err: "the did method ethr is not supported"},
+		{name: "wrong polygonid checksum", did: "did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANR", err: "malformed did:polygonid"},
+		{name: "key not base58btc", did: "did:key:u6MkhaXgBZDvotDkL5257faiztiGiC2QtKLGpbnnEGta2doK", err: "base58btc"},
+		{name: "truncated key", did: "did:key:z2DQUzACF64osQH8kA8qJBXuiVaTxB5A1mGKQ93L3ZWC5b8", err: "must have 32 bytes"},
+		{name: "web with invalid domain", did: "did:web:exa%20mple.com", err: "is not a domain"},
+		{name: "web with empty path", did: "did:web:example.com::alice", err: "empty segment"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			did, err := ParseSubjectDID(tc.did)
+			if tc.err != "" {
+				assert.ErrorIs(t, err, ErrInvalidSubjectDID)
+				assert.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.method, did.Method)
+			assert.Equal(t, tc.did, did.DID)
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/iden3/go-merkletree-sql/v2"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+)
+
+// TreeDiscrepancyKind is the kind of problem found verifying the merkle trees of an identity
+type TreeDiscrepancyKind string
+
+const (
+	// TreeDiscrepancyMissingNode a node referenced by the tree is not stored
+	TreeDiscrepancyMissingNode TreeDiscrepancyKind = "missing_node"
+	// TreeDiscrepancyHashMismatch the hash of a node is not the key it is stored with
+	TreeDiscrepancyHashMismatch TreeDiscrepancyKind = "hash_mismatch"
+	// TreeDiscrepancyMisplacedLeaf a leaf is not in the path of its index, or deeper than the tree levels
+	TreeDiscrepancyMisplacedLeaf TreeDiscrepancyKind = "misplaced_leaf"
+	// TreeDiscrepancyInvalidNode a node has an unknown type
+	TreeDiscrepancyInvalidNode TreeDiscrepancyKind = "invalid_node"
+	// TreeDiscrepancyStateMismatch the stored state is not the hash of its tree roots
+	TreeDiscrepancyStateMismatch TreeDiscrepancyKind = "state_mismatch"
+	// TreeDiscrepancyMissingRoot the claims root of the state is not in its roots tree
+	TreeDiscrepancyMissingRoot TreeDiscrepancyKind = "missing_root"
+	// TreeDiscrepancyPublishedState the state published on chain is not the latest confirmed state
+	TreeDiscrepancyPublishedState TreeDiscrepancyKind = "published_state_mismatch"
+)
+
+// TreeIntegrityReport is the result of the verification of the merkle trees of an identity. The trees are checked
+// from their current roots and from the roots of the latest confirmed state, which differ while there are changes
+// waiting to be published.
+type TreeIntegrityReport struct {
+	Identifier string
+	CheckedAt  time.Time
+	// State is the latest confirmed state of the identity, or its genesis state
+	State *string
+	// PublishedState is the latest state in the state contract, nil if the identity has not published a state or the
+	// contract was not checked
+	PublishedState *string
+	Trees          []TreeIntegrity
+	Discrepancies  []TreeDiscrepancy
+}
+
+// OK returns true if no discrepancies were found
+func (r *TreeIntegrityReport) OK() bool {
+	return len(r.Discrepancies) == 0
+}
+
+// TreeIntegrity is the summary of the verification of a merkle tree
+type TreeIntegrity struct {
+	Type      uint16
+	Root      string
+	StateRoot *string
+	Nodes     int
+	Leaves    int
+}
+
+// TreeDiscrepancy is a problem found verifying the merkle trees of an identity. Tree and Node are nil for the
+// problems of the state.
+type TreeDiscrepancy struct {
+	Kind    TreeDiscrepancyKind
+	Tree    *uint16
+	Node    *string
+	Message string
+}
+
+// VerifyMerkleTree walks the tree from each of the roots and checks that every node is stored, that its hash is the
+// key it is stored with and that every leaf is in the path of its index. The nodes shared by the roots are checked
+// once. Storage errors other than a missing node are returned.
+func VerifyMerkleTree(ctx context.Context, mtType uint16, mt *merkletree.MerkleTree, roots ...*merkletree.Hash) (TreeIntegrity, []TreeDiscrepancy, error) {
+	result := TreeIntegrity{Type: mtType, Root: mt.Root().Hex()}
+	var discrepancies []TreeDiscrepancy
+	discrepancy := func(kind TreeDiscrepancyKind, key *merkletree.Hash, format string, args ...interface{}) {
+		discrepancies = append(discrepancies, TreeDiscrepancy{
+			Kind:    kind,
+			Tree:    &mtType,
+			Node:    common.ToPointer(key.Hex()),
+			Message: fmt.Sprintf(format, args...),
+		})
+	}
+
+	type pending struct {
+		key   *merkletree.Hash
+		depth int
+		path  []bool
+	}
+	visited := make(map[merkletree.Hash]bool)
+	for _, root := range roots {
+		stack := []pending{{key: root}}
+		for len(stack) > 0 {
+			p := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if visited[*p.key] {
+				continue
+			}
+			visited[*p.key] = true
+
+			node, err := mt.GetNode(ctx, p.key)
+			if errors.Is(err, merkletree.ErrNotFound) {
+				discrepancy(TreeDiscrepancyMissingNode, p.key, "node at depth %d is not stored", p.depth)
+				continue
+			}
+			if err != nil {
+				return result, nil, err
+			}
+
+			// the node is rebuilt from its content, the storage may have cached its key
+			var rebuilt *merkletree.Node
+			switch node.Type {
+			case merkletree.NodeTypeEmpty:
+				if *p.key != merkletree.HashZero {
+					discrepancy(TreeDiscrepancyHashMismatch, p.key, "empty node stored with a key")
+				}
+				continue
+			case merkletree.NodeTypeMiddle:
+				rebuilt = merkletree.NewNodeMiddle(node.ChildL, node.ChildR)
+			case merkletree.NodeTypeLeaf:
+				rebuilt = merkletree.NewNodeLeaf(node.Entry[0], node.Entry[1])
+			default:
+				discrepancy(TreeDiscrepancyInvalidNode, p.key, "node type %d is unknown", node.Type)
+				continue
+			}
+			result.Nodes++
+			hash, err := rebuilt.Key()
+			if err != nil {
+				return result, nil, err
+			}
+			if *hash != *p.key {
+				discrepancy(TreeDiscrepancyHashMismatch, p.key, "node hash is %s", hash.Hex())
+				continue
+			}
+
+			if node.Type == merkletree.NodeTypeLeaf {
+				result.Leaves++
+				if p.depth > mt.MaxLevels() || !leafInPath(node.Entry[0], p.path) {
+					discrepancy(TreeDiscrepancyMisplacedLeaf, p.key, "leaf %s is not in the path of its index", node.Entry[0].Hex())
+				}
+				continue
+			}
+			if p.depth >= mt.MaxLevels() {
+				discrepancy(TreeDiscrepancyMisplacedLeaf, p.key, "middle node at depth %d, the tree has %d levels", p.depth, mt.MaxLevels())
+				continue
+			}
+			stack = append(stack,
+				pending{key: node.ChildR, depth: p.depth + 1, path: append(append([]bool{}, p.path...), true)},
+				pending{key: node.ChildL, depth: p.depth + 1, path: append(append([]bool{}, p.path...), false)},
+			)
+		}
+	}
+	return result, discrepancies, nil
+}
+
+// leafInPath returns true if the bits of the index match the path to the leaf, true being right
+func leafInPath(index *merkletree.Hash, path []bool) bool {
+	for i, right := range path {
+		if merkletree.TestBit(index[:], uint(i)) != right {
+			return false
+		}
+	}
+	return true
+}
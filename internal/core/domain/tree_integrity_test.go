@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/iden3/go-merkletree-sql/v2"
+	"github.com/iden3/go-merkletree-sql/v2/db/memory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyMerkleTree(t *testing.T) {
+	ctx := context.Background()
+	newTree := func(t *testing.T) (*merkletree.MerkleTree, *memory.Storage) {
+		t.Helper()
+		storage := memory.NewMemoryStorage()
+		mt, err := merkletree.NewMerkleTree(ctx, storage, 40)
+		require.NoError(t, err)
+		for i := int64(1); i <= 4; i++ {
+			require.NoError(t, mt.Add(ctx, big.NewInt(i), big.NewInt(i*10)))
+		}
+		return mt, storage
+	}
+
+	t.Run("valid tree", func(t *testing.T) {
+		mt, _ := newTree(t)
+		stateRoot := mt.Root()
+		require.NoError(t, mt.Add(ctx, big.NewInt(5), big.NewInt(50)))
+
+		result, discrepancies, err := VerifyMerkleTree(ctx, MerkleTreeTypeClaims, mt, mt.Root(), stateRoot)
+		require.NoError(t, err)
+		assert.Empty(t, discrepancies)
+		assert.Equal(t, mt.Root().Hex(), result.Root)
+		assert.Equal(t, 5, result.Leaves)
+	})
+
+	t.Run("empty tree", func(t *testing.T) {
+		mt, err := merkletree.NewMerkleTree(ctx, memory.NewMemoryStorage(), 40)
+		require.NoError(t, err)
+		result, discrepancies, err := VerifyMerkleTree(ctx, MerkleTreeTypeRevocations, mt, mt.Root())
+		require.NoError(t, err)
+		assert.Empty(t, discrepancies)
+		assert.Equal(t, 0, result.Nodes)
+	})
+
+	t.Run("missing node", func(t *testing.T) {
+		mt, _ := newTree(t)
+		missing, err := merkletree.NewHashFromBigInt(big.NewInt(12345))
+		require.NoError(t, err)
+
+		_, discrepancies, err := VerifyMerkleTree(ctx, MerkleTreeTypeClaims, mt, missing)
+		require.NoError(t, err)
+		require.Len(t, discrepancies, 1)
+		assert.Equal(t, TreeDiscrepancyMissingNode, discrepancies[0].Kind)
+		assert.Equal(t, missing.Hex(), *discrepancies[0].Node)
+	})
+
+	t.Run("tampered leaf", func(t *testing.T) {
+		mt, storage := newTree(t)
+		k, err := merkletree.NewHashFromBigInt(big.NewInt(2))
+		require.NoError(t, err)
+		v, err := merkletree.NewHashFromBigInt(big.NewInt(20))
+		require.NoError(t, err)
+		leafKey, err := merkletree.LeafKey(k, v)
+		require.NoError(t, err)
+		tampered, err := merkletree.NewHashFromBigInt(big.NewInt(21))
+		require.NoError(t, err)
+		require.NoError(t, storage.Put(ctx, leafKey[:], merkletree.NewNodeLeaf(k, tampered)))
+
+		_, discrepancies, err := VerifyMerkleTree(ctx, MerkleTreeTypeClaims, mt, mt.Root())
+		require.NoError(t, err)
+		require.Len(t, discrepancies, 1)
+		assert.Equal(t, TreeDiscrepancyHashMismatch, discrepancies[0].Kind)
+		assert.Equal(t, leafKey.Hex(), *discrepancies[0].Node)
+	})
+
+	t.Run("misplaced leaf", func(t *testing.T) {
+		storage := memory.NewMemoryStorage()
+		mt, err := merkletree.NewMerkleTree(ctx, storage, 40)
+		require.NoError(t, err)
+		// index 1 goes to the right of the root, the leaf is linked to the left
+		k, err := merkletree.NewHashFromBigInt(big.NewInt(1))
+		require.NoError(t, err)
+		leaf := merkletree.NewNodeLeaf(k, &merkletree.HashZero)
+		leafKey, err := leaf.Key()
+		require.NoError(t, err)
+		require.NoError(t, storage.Put(ctx, leafKey[:], leaf))
+		root := merkletree.NewNodeMiddle(leafKey, &merkletree.HashZero)
+		rootKey, err := root.Key()
+		require.NoError(t, err)
+		require.NoError(t, storage.Put(ctx, rootKey[:], root))
+
+		_, discrepancies, err := VerifyMerkleTree(ctx, MerkleTreeTypeRoots, mt, rootKey)
+		require.NoError(t, err)
+		require.Len(t, discrepancies, 1)
+		assert.Equal(t, TreeDiscrepancyMisplacedLeaf, discrepancies[0].Kind)
+	})
+}
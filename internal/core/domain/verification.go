@@ -0,0 +1,167 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-circuits"
+	core "github.com/iden3/go-iden3-core"
+	"github.com/iden3/iden3comm/packers"
+	"github.com/iden3/iden3comm/protocol"
+)
+
+// MaxVerificationQueries is the maximum number of queries of a verification request
+const MaxVerificationQueries = 10
+
+// VerificationCircuits are the circuits the holders can prove the queries with
+var VerificationCircuits = []circuits.CircuitID{circuits.AtomicQuerySigV2CircuitID, circuits.AtomicQueryMTPV2CircuitID}
+
+// verificationOperators are the operators of the predicates supported by the query circuits
+var verificationOperators = map[string]bool{"$eq": true, "$lt": true, "$gt": true, "$in": true, "$nin": true, "$ne": true}
+
+// VerificationQuery asks the holder to prove, with the circuit, that they have a credential of the type and context
+// that satisfies the predicate on the credential subject. A field without operators asks to disclose its value.
+type VerificationQuery struct {
+	ID                       uint32         `json:"id"`
+	CircuitID                string         `json:"circuitId"`
+	Context                  string         `json:"context"`
+	Type                     string         `json:"type"`
+	AllowedIssuers           []string       `json:"allowedIssuers,omitempty"`
+	CredentialSubject        map[string]any `json:"credentialSubject,omitempty"`
+	SkipClaimRevocationCheck bool           `json:"skipClaimRevocationCheck,omitempty"`
+}
+
+// Validate checks the circuit and that the predicate can be proved by it
+func (q *VerificationQuery) Validate() error {
+	supported := false
+	for _, circuit := range VerificationCircuits {
+		supported = supported || q.CircuitID == string(circuit)
+	}
+	if !supported {
+		return fmt.Errorf("circuit %q is not supported", q.CircuitID)
+	}
+	if q.Context == "" || q.Type == "" {
+		return errors.New("the context and the type of the credential are required")
+	}
+	if len(q.CredentialSubject) > 1 {
+		return errors.New("only one field of the credential subject can be queried")
+	}
+	for field, predicate := range q.CredentialSubject {
+		operators, ok := predicate.(map[string]any)
+		if !ok {
+			return fmt.Errorf("the predicate of %s must be an object with an operator", field)
+		}
+		if len(operators) > 1 {
+			return fmt.Errorf("the predicate of %s can only have one operator", field)
+		}
+		for operator := range operators {
+			if !verificationOperators[operator] {
+				return fmt.Errorf("operator %s is not supported", operator)
+			}
+		}
+	}
+	return nil
+}
+
+// VerificationRequest is a set of queries the holders are asked to prove. It is sent to them as an authorization
+// request, and their proofs are received in its callback.
+type VerificationRequest struct {
+	ID        uuid.UUID
+	IssuerDID core.DID
+	Reason    string
+	Scope     []VerificationQuery
+	CreatedAt time.Time
+	ExpiresAt *time.Time
+}
+
+// Validate checks the queries of the request and gives an id to the ones that have none
+func (r *VerificationRequest) Validate() error {
+	if len(r.Scope) == 0 {
+		return errors.New("the request has no queries")
+	}
+	return ValidateVerificationScope(r.Scope)
+}
+
+// ValidateVerificationScope checks the queries of a scope and gives an id to the ones that have none
+func ValidateVerificationScope(scope []VerificationQuery) error {
+	if len(scope) > MaxVerificationQueries {
+		return fmt.Errorf("a request cannot have more than %d queries", MaxVerificationQueries)
+	}
+	ids := make(map[uint32]bool, len(scope))
+	for i := range scope {
+		query := &scope[i]
+		if query.ID == 0 {
+			query.ID = uint32(i + 1)
+		}
+		if ids[query.ID] {
+			return fmt.Errorf("query id %d is repeated", query.ID)
+		}
+		ids[query.ID] = true
+		if err := query.Validate(); err != nil {
+			return fmt.Errorf("query %d: %w", query.ID, err)
+		}
+	}
+	return nil
+}
+
+// Expired tells whether the request can no longer be answered
+func (r *VerificationRequest) Expired(now time.Time) bool {
+	return r.ExpiresAt != nil && now.After(*r.ExpiresAt)
+}
+
+// AuthorizationRequest returns the message the holders scan to answer the request. The id of the request is the
+// thread of the message.
+func (r *VerificationRequest) AuthorizationRequest(callbackURL string) protocol.AuthorizationRequestMessage {
+	return protocol.AuthorizationRequestMessage{
+		ID:       r.ID.String(),
+		ThreadID: r.ID.String(),
+		Typ:      packers.MediaTypePlainMessage,
+		Type:     protocol.AuthorizationRequestMessageType,
+		From:     r.IssuerDID.String(),
+		Body: protocol.AuthorizationRequestMessageBody{
+			CallbackURL: callbackURL,
+			Reason:      r.Reason,
+			Scope:       ZeroKnowledgeProofRequests(r.Scope),
+		},
+	}
+}
+
+// ZeroKnowledgeProofRequests returns the scope of an authorization request that asks for the queries
+func ZeroKnowledgeProofRequests(scope []VerificationQuery) []protocol.ZeroKnowledgeProofRequest {
+	requests := make([]protocol.ZeroKnowledgeProofRequest, len(scope))
+	for i, query := range scope {
+		q := map[string]any{
+			"allowedIssuers": query.AllowedIssuers,
+			"context":        query.Context,
+			"type":           query.Type,
+		}
+		if len(query.AllowedIssuers) == 0 {
+			q["allowedIssuers"] = []string{"*"}
+		}
+		if len(query.CredentialSubject) > 0 {
+			q["credentialSubject"] = query.CredentialSubject
+		}
+		if query.SkipClaimRevocationCheck {
+			q["skipClaimRevocationCheck"] = true
+		}
+		requests[i] = protocol.ZeroKnowledgeProofRequest{ID: query.ID, CircuitID: query.CircuitID, Query: q}
+	}
+	return requests
+}
+
+// VerificationResponse is the outcome of verifying the proofs a holder sent to answer a verification request. The
+// responses of the holders are kept with their connection.
+type VerificationResponse struct {
+	ID           uuid.UUID
+	RequestID    uuid.UUID
+	IssuerDID    core.DID
+	UserDID      core.DID
+	ConnectionID *uuid.UUID
+	Verified     bool
+	Error        *string
+	Proofs       json.RawMessage
+	CreatedAt    time.Time
+}
@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+	"github.com/iden3/iden3comm/protocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerificationRequest_Validate(t *testing.T) {
+	query := func(subject map[string]any) VerificationQuery {
+		return VerificationQuery{
+			CircuitID:         "credentialAtomicQuerySigV2",
+			Context:           "https://example.com/kyc-v3.json-ld",
+			Type:              "KYCAgeCredential",
+			CredentialSubject: subject,
+		}
+	}
+
+	request := &VerificationRequest{Scope: []VerificationQuery{
+		query(map[string]any{"birthday": map[string]any{"$lt": 20000101}}),
+		query(map[string]any{"country": map[string]any{}}),
+		query(nil),
+	}}
+	require.NoError(t, request.Validate())
+	assert.Equal(t, uint32(1), request.Scope[0].ID)
+	assert.Equal(t, uint32(3), request.Scope[2].ID)
+
+	assert.Error(t, (&VerificationRequest{}).Validate())
+	assert.Error(t, (&VerificationRequest{Scope: []VerificationQuery{{ID: 1, CircuitID: "authV2", Context: "c", Type: "t"}}}).Validate())
+	assert.Error(t, (&VerificationRequest{Scope: []VerificationQuery{{ID: 1, CircuitID: "credentialAtomicQueryMTPV2"}}}).Validate())
+	assert.Error(t, (&VerificationRequest{Scope: []VerificationQuery{query(map[string]any{"birthday": 20000101})}}).Validate())
+	assert.Error(t, (&VerificationRequest{Scope: []VerificationQuery{query(map[string]any{"birthday": map[string]any{"$between": 1}})}}).Validate())
+	assert.Error(t, (&VerificationRequest{Scope: []VerificationQuery{query(map[string]any{"a": map[string]any{}, "b": map[string]any{}})}}).Validate())
+
+	repeated := &VerificationRequest{Scope: []VerificationQuery{query(nil), query(nil)}}
+	repeated.Scope[0].ID, repeated.Scope[1].ID = 7, 7
+	assert.Error(t, repeated.Validate())
+}
+
+func TestVerificationRequest_AuthorizationRequest(t *testing.T) {
+	did, err := core.ParseDID("did:polygonid:polygon:mumbai:2qM77fA6NGGWL9QEeb1dv2VA6wz5svcohgv61LZ7wB")
+	require.NoError(t, err)
+	expiresAt := time.Now().Add(time.Hour)
+	request := &VerificationRequest{
+		ID:        uuid.New(),
+		IssuerDID: *did,
+		Reason:    "age check",
+		Scope: []VerificationQuery{{
+			ID:                1,
+			CircuitID:         "credentialAtomicQuerySigV2",
+			Context:           "https://example.com/kyc-v3.json-ld",
+			Type:              "KYCAgeCredential",
+			CredentialSubject: map[string]any{"birthday": map[string]any{"$lt": 20000101}},
+		}},
+		ExpiresAt: &expiresAt,
+	}
+
+	message := request.AuthorizationRequest("https://issuer.example.com/v1/verification/callback")
+	assert.Equal(t, protocol.AuthorizationRequestMessageType, message.Type)
+	assert.Equal(t, request.ID.String(), message.ThreadID)
+	assert.Equal(t, did.String(), message.From)
+	assert.Equal(t, "age check", message.Body.Reason)
+	require.Len(t, message.Body.Scope, 1)
+	assert.Equal(t, "credentialAtomicQuerySigV2", message.Body.Scope[0].CircuitID)
+	assert.Equal(t, []string{"*"}, message.Body.Scope[0].Query["allowedIssuers"])
+	assert.Equal(t, request.Scope[0].CredentialSubject, message.Body.Scope[0].Query["credentialSubject"])
+
+	assert.False(t, request.Expired(time.Now()))
+	assert.True(t, request.Expired(expiresAt.Add(time.Second)))
+}
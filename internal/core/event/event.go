@@ -2,15 +2,24 @@ package event
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/polygonid/sh-id-platform/pkg/pubsub"
 )
 
 const (
-	CreateCredentialEvent = "createCredentialEvent" // CreateCredentialEvent create credential event
-	CreateConnectionEvent = "createConnectionEvent" // CreateConnectionEvent create connection MyEvent
+	CreateCredentialEvent  = "createCredentialEvent"  // CreateCredentialEvent create credential event
+	CreateConnectionEvent  = "createConnectionEvent"  // CreateConnectionEvent create connection MyEvent
+	CredentialExpiredEvent = "credentialExpiredEvent" // CredentialExpiredEvent credentials expired event
+	SessionStateEvent      = "sessionStateEvent"      // SessionStateEvent session state changed event. Published on the SessionStateTopic topic
+	CredentialOfferEvent   = "credentialOfferEvent"   // CredentialOfferEvent a new offer of credentials to push to the holder
 )
 
+// SessionStateTopic returns the topic where the state changes of the given session are published
+func SessionStateTopic(sessionID string) string {
+	return fmt.Sprintf("%s_%s", SessionStateEvent, sessionID)
+}
+
 // CreateCredential defines the createCredential data
 type CreateCredential struct {
 	CredentialIDs []string `json:"credentialsID"`
@@ -27,6 +36,23 @@ func (ev *CreateCredential) Unmarshal(msg pubsub.Message) error {
 	return json.Unmarshal(msg, &ev)
 }
 
+// CredentialOffer defines the credentialOffer data
+type CredentialOffer struct {
+	OfferID       string   `json:"offerID"`
+	CredentialIDs []string `json:"credentialsID"`
+	IssuerID      string   `json:"issuerID"`
+}
+
+// Marshal marshals the event into a pubsub.Message
+func (ev *CredentialOffer) Marshal() (msg pubsub.Message, err error) {
+	return json.Marshal(ev)
+}
+
+// Unmarshal creates an event from that message
+func (ev *CredentialOffer) Unmarshal(msg pubsub.Message) error {
+	return json.Unmarshal(msg, &ev)
+}
+
 // CreateConnection defines the createCredential data
 type CreateConnection struct {
 	ConnectionID string `json:"connectionID"`
@@ -42,3 +68,38 @@ func (ev *CreateConnection) Marshal() (msg pubsub.Message, err error) {
 func (ev *CreateConnection) Unmarshal(msg pubsub.Message) error {
 	return json.Unmarshal(msg, &ev)
 }
+
+// CredentialExpired defines the credentialExpired data.
+// RevokedCredentialIDs is the subset of CredentialIDs revoked because of the schema expiration policy
+type CredentialExpired struct {
+	CredentialIDs        []string `json:"credentialsID"`
+	RevokedCredentialIDs []string `json:"revokedCredentialsID"`
+	IssuerID             string   `json:"issuerID"`
+}
+
+// Marshal marshals the event into a pubsub.Message
+func (ev *CredentialExpired) Marshal() (msg pubsub.Message, err error) {
+	return json.Marshal(ev)
+}
+
+// Unmarshal creates an event from that message
+func (ev *CredentialExpired) Unmarshal(msg pubsub.Message) error {
+	return json.Unmarshal(msg, &ev)
+}
+
+// SessionState defines the sessionState data
+type SessionState struct {
+	SessionID string `json:"sessionID"`
+	Status    string `json:"status"`
+	Message   string `json:"message,omitempty"`
+}
+
+// Marshal marshals the event into a pubsub.Message
+func (ev *SessionState) Marshal() (msg pubsub.Message, err error) {
+	return json.Marshal(ev)
+}
+
+// Unmarshal creates an event from that message
+func (ev *SessionState) Unmarshal(msg pubsub.Message) error {
+	return json.Unmarshal(msg, &ev)
+}
@@ -0,0 +1,30 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// InboxFilter selects the messages received by the agent of an issuer, by the fields that are set. The newest
+// messages are returned first.
+type InboxFilter struct {
+	Sender   string
+	ThreadID string
+	Type     string
+	Status   *domain.InboxMessageStatus
+	Limit    int
+}
+
+// AgentInboxRepository defines the available methods for the repository of the messages received by the agent
+type AgentInboxRepository interface {
+	Save(ctx context.Context, conn db.Querier, message *domain.InboxMessage) error
+	GetAll(ctx context.Context, conn db.Querier, issuerDID core.DID, filter InboxFilter) ([]*domain.InboxMessage, error)
+	GetByID(ctx context.Context, conn db.Querier, issuerDID core.DID, id uuid.UUID) (*domain.InboxMessage, error)
+	DeleteBefore(ctx context.Context, conn db.Querier, before time.Time) (int64, error)
+}
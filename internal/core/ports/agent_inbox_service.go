@@ -0,0 +1,19 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// AgentInboxService keeps the messages received by the agent, for the operators to see why a wallet failed
+type AgentInboxService interface {
+	Record(ctx context.Context, message *domain.InboxMessage) error
+	GetAll(ctx context.Context, issuerDID core.DID, filter InboxFilter) ([]*domain.InboxMessage, error)
+	GetByID(ctx context.Context, issuerDID core.DID, id uuid.UUID) (*domain.InboxMessage, error)
+	Purge(ctx context.Context, retention time.Duration) error
+}
@@ -0,0 +1,16 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// AgentMessageRepository defines the available methods for the processed agent messages repository
+type AgentMessageRepository interface {
+	Reserve(ctx context.Context, conn db.Querier, message *domain.AgentMessage, since time.Time) (*domain.AgentMessage, error)
+	SaveResponse(ctx context.Context, conn db.Querier, message *domain.AgentMessage) error
+	Delete(ctx context.Context, conn db.Querier, message *domain.AgentMessage) error
+}
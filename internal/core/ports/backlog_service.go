@@ -0,0 +1,11 @@
+package ports
+
+import (
+	"context"
+)
+
+// BacklogService is the interface implemented by the backlog service.
+// It checks the pending actions of the node against the configured thresholds.
+type BacklogService interface {
+	Check(ctx context.Context) error
+}
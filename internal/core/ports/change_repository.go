@@ -0,0 +1,15 @@
+package ports
+
+import (
+	"context"
+
+	core "github.com/iden3/go-iden3-core"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// ChangeRepository defines the available methods for the changes feed repository
+type ChangeRepository interface {
+	GetSince(ctx context.Context, conn db.Querier, issuerDID core.DID, since domain.ChangeCursor, limit int) ([]domain.Change, error)
+}
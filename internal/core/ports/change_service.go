@@ -0,0 +1,14 @@
+package ports
+
+import (
+	"context"
+
+	core "github.com/iden3/go-iden3-core"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// ChangeService is the interface implemented by the changes feed service
+type ChangeService interface {
+	GetSince(ctx context.Context, issuerDID core.DID, since string, limit int) ([]domain.Change, domain.ChangeCursor, error)
+}
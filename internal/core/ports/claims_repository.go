@@ -2,6 +2,7 @@ package ports
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	core "github.com/iden3/go-iden3-core"
@@ -16,17 +17,29 @@ type ClaimsRepository interface {
 	Save(ctx context.Context, conn db.Querier, claim *domain.Claim) (uuid.UUID, error)
 	Revoke(ctx context.Context, conn db.Querier, revocation *domain.Revocation) error
 	RevokeNonce(ctx context.Context, conn db.Querier, revocation *domain.Revocation) error
+	RevokeNonces(ctx context.Context, conn db.Querier, identifier *core.DID, nonces []domain.RevNonceUint64, reason domain.RevocationReason, description string) error
+	MarkAsRevoked(ctx context.Context, conn db.Querier, identifier *core.DID, nonces []domain.RevNonceUint64) (int64, error)
 	GetByRevocationNonce(ctx context.Context, conn db.Querier, identifier *core.DID, revocationNonce domain.RevNonceUint64) (*domain.Claim, error)
+	RevNonceExists(ctx context.Context, conn db.Querier, identifier *core.DID, revocationNonce domain.RevNonceUint64) (bool, error)
+	NextRevNonce(ctx context.Context, conn db.Querier, identifier *core.DID) (domain.RevNonceUint64, error)
 	GetByIdAndIssuer(ctx context.Context, conn db.Querier, identifier *core.DID, claimID uuid.UUID) (*domain.Claim, error)
 	FindOneClaimBySchemaHash(ctx context.Context, conn db.Querier, subject *core.DID, schemaHash string) (*domain.Claim, error)
 	GetAllByIssuerID(ctx context.Context, conn db.Querier, identifier core.DID, filter *ClaimsFilter) ([]*domain.Claim, error)
+	GetAllDisplayByIssuerID(ctx context.Context, conn db.Querier, identifier core.DID, filter *ClaimsFilter) ([]*domain.CredentialRow, error)
+	IterateByIssuerID(ctx context.Context, conn db.Querier, identifier core.DID, filter *ClaimsFilter, fn func(*domain.Claim) error) error
+	GetSummaryByIssuerID(ctx context.Context, conn db.Querier, identifier core.DID, filter *ClaimsFilter) (*domain.CredentialsSummary, error)
 	GetNonRevokedByConnectionAndIssuerID(ctx context.Context, conn db.Querier, connID uuid.UUID, issuerID core.DID) ([]*domain.Claim, error)
 	GetAllByState(ctx context.Context, conn db.Querier, did *core.DID, state *merkletree.Hash) (claims []domain.Claim, err error)
 	GetAllByStateWithMTProof(ctx context.Context, conn db.Querier, did *core.DID, state *merkletree.Hash) (claims []domain.Claim, err error)
 	UpdateState(ctx context.Context, conn db.Querier, claim *domain.Claim) (int64, error)
 	GetAuthClaimsForPublishing(ctx context.Context, conn db.Querier, identifier *core.DID, publishingState string, schemaHash string) ([]*domain.Claim, error)
+	GetAuthClaimsByIssuerID(ctx context.Context, conn db.Querier, identifier core.DID, schemaHash string) ([]*domain.Claim, error)
 	UpdateClaimMTP(ctx context.Context, conn db.Querier, claim *domain.Claim) (int64, error)
 	Delete(ctx context.Context, conn db.Querier, id uuid.UUID) error
+	Restore(ctx context.Context, conn db.Querier, identifier core.DID, id uuid.UUID) error
+	PurgeDeleted(ctx context.Context, conn db.Querier, before time.Time) (int64, error)
 	GetClaimsIssuedForUser(ctx context.Context, conn db.Querier, identifier core.DID, userDID core.DID, linkID uuid.UUID) ([]*domain.Claim, error)
 	GetByStateIDWithMTPProof(ctx context.Context, conn db.Querier, did *core.DID, state string) (claims []*domain.Claim, err error)
+	GetExpired(ctx context.Context, conn db.Querier, expiredOn time.Time, limit int) ([]*domain.ExpiredClaim, error)
+	MarkAsExpired(ctx context.Context, conn db.Querier, ids []uuid.UUID) (int64, error)
 }
@@ -10,6 +10,7 @@ import (
 	core "github.com/iden3/go-iden3-core"
 	"github.com/iden3/go-schema-processor/verifiable"
 	comm "github.com/iden3/iden3comm"
+	"github.com/iden3/iden3comm/packers"
 	"github.com/iden3/iden3comm/protocol"
 
 	"github.com/polygonid/sh-id-platform/internal/common"
@@ -28,12 +29,93 @@ type CreateClaimRequest struct {
 	MerklizedRootPosition string
 	SignatureProof        bool
 	MTProof               bool
-	LinkID                *uuid.UUID
-	SingleIssuer          bool
+	// DefaultProofs is set when the request does not say the proof types, the default ones of the proof policy apply
+	DefaultProofs bool
+	LinkID        *uuid.UUID
+	SingleIssuer  bool
+	// ExtraContexts and ExtraTypes are added to the ones of the schema of the credential
+	ExtraContexts []string
+	ExtraTypes    []string
+	// RefreshService adds a refresh service pointing at the agent of the node, where the holder can get a new
+	// credential with the same validity period when this one expires
+	RefreshService bool
+	// ExpirationDays, if set, expires the credential the given days after it is issued, instead of at Expiration
+	ExpirationDays *int
+}
+
+// CredentialRefreshRequestMessageType is the message sent by a holder to the refresh service of a credential
+const CredentialRefreshRequestMessageType comm.ProtocolMessage = comm.Iden3Protocol + "credentials/1.0/refresh"
+
+// CredentialProposalRequestMessageType is the message sent by a holder to ask the issuer how to get credentials of some types
+const CredentialProposalRequestMessageType comm.ProtocolMessage = comm.Iden3Protocol + "credentials/0.1/proposal-request"
+
+// CredentialProposalMessageType is the answer to a proposal request, with the ways to get the requested credentials
+const CredentialProposalMessageType comm.ProtocolMessage = comm.Iden3Protocol + "credentials/0.1/proposal"
+
+// ProblemReportMessageType is the message that reports why a request failed, sent by the agent when it cannot answer
+// a request and by the wallets when they cannot use an answer
+const ProblemReportMessageType comm.ProtocolMessage = "https://didcomm.org/report-problem/2.0/problem-report"
+
+// CredentialProposalWebForm is the type of the proposals that send the holder to a web page of the issuer
+const CredentialProposalWebForm = "WebVerificationForm"
+
+// AgentMessageTypes are the messages the agent answers, they are published in the capabilities of the agent
+var AgentMessageTypes = []comm.ProtocolMessage{
+	protocol.CredentialFetchRequestMessageType,
+	CredentialRefreshRequestMessageType,
+	protocol.RevocationStatusRequestMessageType,
+	CredentialProposalRequestMessageType,
+	ProblemReportMessageType,
+}
+
+// AgentAuthenticatedMediaTypes are the media types that prove who the sender of a message is. The messages that
+// return credentials must come in one of them, the plain ones can only ask for public information. The encrypted
+// envelopes are opened before, and the media type is the one of the message they wrap.
+var AgentAuthenticatedMediaTypes = []comm.MediaType{packers.MediaTypeZKPMessage}
+
+// CredentialRefreshRequestMessageBody is the body of a refresh request. ID is the id of the credential to refresh.
+type CredentialRefreshRequestMessageBody struct {
+	ID     string `json:"id"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// ProblemReportMessageBody is the body of a problem report. Code is the DIDComm code of the problem and Comment a
+// description for humans.
+type ProblemReportMessageBody struct {
+	Code       string   `json:"code"`
+	Comment    string   `json:"comment,omitempty"`
+	Args       []string `json:"args,omitempty"`
+	EscalateTo string   `json:"escalate_to,omitempty"`
+}
+
+// CredentialProposalInfo is a credential of a proposal request or a proposal, by its type and JSON-LD context
+type CredentialProposalInfo struct {
+	Type    string `json:"type"`
+	Context string `json:"context,omitempty"`
+}
+
+// CredentialProposalRequestMessageBody is the body of a proposal request, with the credentials the holder wants
+type CredentialProposalRequestMessageBody struct {
+	Credentials []CredentialProposalInfo `json:"credentials"`
+}
+
+// CredentialProposal is a way to get some credentials: the holder must visit the URL
+type CredentialProposal struct {
+	Credentials []CredentialProposalInfo `json:"credentials"`
+	Type        string                   `json:"type"`
+	URL         string                   `json:"url"`
+	Description string                   `json:"description,omitempty"`
+}
+
+// CredentialProposalMessageBody is the body of a proposal. It has no proposals when the issuer does not issue any of
+// the requested credentials.
+type CredentialProposalMessageBody struct {
+	Proposals []CredentialProposal `json:"proposals"`
 }
 
 // AgentRequest struct
 type AgentRequest struct {
+	ID        string
 	Body      json.RawMessage
 	ThreadID  string
 	IssuerDID *core.DID
@@ -41,6 +123,18 @@ type AgentRequest struct {
 	ClaimID   uuid.UUID
 	Typ       comm.MediaType
 	Type      comm.ProtocolMessage
+	// MediaType is the media type of the envelope the message was unpacked from
+	MediaType comm.MediaType
+}
+
+// Authenticated returns true if the envelope of the message proves that it was sent by UserDID
+func (r *AgentRequest) Authenticated() bool {
+	for _, mediaType := range AgentAuthenticatedMediaTypes {
+		if r.MediaType == mediaType {
+			return true
+		}
+	}
+	return false
 }
 
 // ClaimsFilter struct
@@ -51,11 +145,17 @@ type ClaimsFilter struct {
 	SchemaHash      string
 	SchemaType      string
 	Subject         string
+	Subjects        []string // Subjects selects the claims of any of the holders, to load the claims of many of them at once
 	QueryField      string
 	QueryFieldValue string
 	FTSQuery        string
 	FTSAndCond      bool
 	Proofs          []verifiable.ProofType
+	IncludeDeleted  bool // IncludeDeleted selects the deleted claims too, until they are purged
+	Limit           int  // Limit and Offset page the claims sorted by issuance date, newest first. 0 returns all of them.
+	Offset          int
+	// Publication selects the claims with the publication status. Empty selects all of them.
+	Publication domain.PublicationStatus
 }
 
 // NewClaimsFilter returns a valid claims filter
@@ -92,6 +192,7 @@ func NewClaimsFilter(schemaHash, schemaType, subject, queryField, queryValue *st
 
 // NewCreateClaimRequest returns a new claim object with the given parameters
 func NewCreateClaimRequest(did *core.DID, credentialSchema string, credentialSubject map[string]any, expiration *time.Time, typ string, cVersion *uint32, subjectPos *string, merklizedRootPosition *string, sigProof *bool, mtProof *bool, linkID *uuid.UUID, singleIssuer bool) *CreateClaimRequest {
+	defaultProofs := sigProof == nil && mtProof == nil
 	if sigProof == nil {
 		sigProof = common.ToPointer(false)
 	}
@@ -107,6 +208,7 @@ func NewCreateClaimRequest(did *core.DID, credentialSchema string, credentialSub
 		Type:              typ,
 		SignatureProof:    *sigProof,
 		MTProof:           *mtProof,
+		DefaultProofs:     defaultProofs,
 	}
 	if expiration != nil {
 		req.Expiration = expiration
@@ -127,8 +229,9 @@ func NewCreateClaimRequest(did *core.DID, credentialSchema string, credentialSub
 	return req
 }
 
-// NewAgentRequest validates the inputs and returns a new AgentRequest
-func NewAgentRequest(basicMessage *comm.BasicMessage) (*AgentRequest, error) {
+// NewAgentRequest validates the inputs and returns a new AgentRequest. The media type is the one of the envelope the
+// message was unpacked from.
+func NewAgentRequest(basicMessage *comm.BasicMessage, mediaType comm.MediaType) (*AgentRequest, error) {
 	if basicMessage.To == "" {
 		return nil, fmt.Errorf("'to' field cannot be empty")
 	}
@@ -156,7 +259,7 @@ func NewAgentRequest(basicMessage *comm.BasicMessage) (*AgentRequest, error) {
 		return nil, err
 	}
 
-	if basicMessage.Type != protocol.CredentialFetchRequestMessageType && basicMessage.Type != protocol.RevocationStatusRequestMessageType {
+	if !isAgentMessageType(basicMessage.Type) {
 		return nil, fmt.Errorf("invalid type")
 	}
 
@@ -165,6 +268,7 @@ func NewAgentRequest(basicMessage *comm.BasicMessage) (*AgentRequest, error) {
 	}
 
 	return &AgentRequest{
+		ID:        basicMessage.ID,
 		Body:      basicMessage.Body,
 		UserDID:   fromDID,
 		IssuerDID: toDID,
@@ -172,22 +276,44 @@ func NewAgentRequest(basicMessage *comm.BasicMessage) (*AgentRequest, error) {
 		ClaimID:   claimID,
 		Typ:       basicMessage.Typ,
 		Type:      basicMessage.Type,
+		MediaType: mediaType,
 	}, nil
 }
 
+func isAgentMessageType(typ comm.ProtocolMessage) bool {
+	for _, messageType := range AgentMessageTypes {
+		if typ == messageType {
+			return true
+		}
+	}
+	return false
+}
+
 // ClaimsService is the interface implemented by the claim service
 type ClaimsService interface {
 	Save(ctx context.Context, claimReq *CreateClaimRequest) (*domain.Claim, error)
 	CreateCredential(ctx context.Context, req *CreateClaimRequest) (*domain.Claim, error)
-	Revoke(ctx context.Context, id core.DID, nonce uint64, description string) error
+	Revoke(ctx context.Context, id core.DID, nonce uint64, reason domain.RevocationReason, description string) error
 	GetAll(ctx context.Context, did core.DID, filter *ClaimsFilter) ([]*domain.Claim, error)
+	GetAllDisplay(ctx context.Context, did core.DID, filter *ClaimsFilter) ([]*domain.CredentialRow, error)
+	GetSummary(ctx context.Context, did core.DID, filter *ClaimsFilter) (*domain.CredentialsSummary, error)
+	Iterate(ctx context.Context, did core.DID, filter *ClaimsFilter, fn func(*domain.Claim) error) error
 	RevokeAllFromConnection(ctx context.Context, connID uuid.UUID, issuerID core.DID) error
 	GetRevocationStatus(ctx context.Context, issuerDID core.DID, nonce uint64) (*verifiable.RevocationStatus, error)
 	GetByID(ctx context.Context, issID *core.DID, id uuid.UUID) (*domain.Claim, error)
+	GetByRevocationNonce(ctx context.Context, issID *core.DID, nonce uint64) (*domain.Claim, error)
+	GetHistory(ctx context.Context, issuerDID core.DID, id uuid.UUID) ([]domain.CredentialEvent, error)
 	Agent(ctx context.Context, req *AgentRequest) (*domain.Agent, error)
+	ReportProblem(ctx context.Context, req *AgentRequest, cause error) *domain.Agent
+	GetProblemReports(ctx context.Context, issuerDID core.DID, filter ProblemReportsFilter) ([]*domain.ProblemReport, error)
 	GetAuthClaim(ctx context.Context, did *core.DID) (*domain.Claim, error)
 	GetAuthClaimForPublishing(ctx context.Context, did *core.DID, state string) (*domain.Claim, error)
 	UpdateClaimsMTPAndState(ctx context.Context, currentState *domain.IdentityState) error
 	Delete(ctx context.Context, id uuid.UUID) error
+	Restore(ctx context.Context, issuerDID core.DID, id uuid.UUID) error
+	PurgeDeleted(ctx context.Context, retention time.Duration) error
 	GetByStateIDWithMTPProof(ctx context.Context, did *core.DID, state string) ([]*domain.Claim, error)
+	ProcessExpired(ctx context.Context) error
+	CreateOffer(ctx context.Context, issuerDID core.DID, credentialIDs ...uuid.UUID) (*domain.CredentialOffer, error)
+	RenewOffer(ctx context.Context, issuerDID core.DID, credentialID uuid.UUID, notify bool) (*domain.CredentialOffer, error)
 }
@@ -2,6 +2,7 @@ package ports
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	core "github.com/iden3/go-iden3-core"
@@ -15,8 +16,12 @@ type ConnectionsRepository interface {
 	Save(ctx context.Context, conn db.Querier, connection *domain.Connection) (uuid.UUID, error)
 	Delete(ctx context.Context, conn db.Querier, id uuid.UUID, issuerDID core.DID) error
 	DeleteCredentials(ctx context.Context, conn db.Querier, id uuid.UUID, issuerID core.DID) error
+	Restore(ctx context.Context, conn db.Querier, id uuid.UUID, issuerDID core.DID) error
+	PurgeDeleted(ctx context.Context, conn db.Querier, before time.Time) (int64, error)
 	GetByIDAndIssuerID(ctx context.Context, conn db.Querier, id uuid.UUID, issuerDID core.DID) (*domain.Connection, error)
 	GetByUserID(ctx context.Context, conn db.Querier, issuerDID core.DID, userDID core.DID) (*domain.Connection, error)
-	GetAllByIssuerID(ctx context.Context, conn db.Querier, issuerDID core.DID, query string) ([]*domain.Connection, error)
-	GetAllWithCredentialsByIssuerID(ctx context.Context, conn db.Querier, issuerDID core.DID, query string) ([]*domain.Connection, error)
+	GetAllByIssuerID(ctx context.Context, conn db.Querier, issuerDID core.DID, filter *ConnectionsFilter) ([]*domain.Connection, error)
+	GetAllWithCredentialsByIssuerID(ctx context.Context, conn db.Querier, issuerDID core.DID, filter *ConnectionsFilter) ([]*domain.Connection, error)
+	IterateByIssuerID(ctx context.Context, conn db.Querier, issuerDID core.DID, filter *ConnectionsFilter, fn func(*domain.Connection) error) error
+	SaveMetadata(ctx context.Context, conn db.Querier, connectionID uuid.UUID, metadata *domain.ConnectionMetadata) error
 }
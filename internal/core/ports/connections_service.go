@@ -2,6 +2,7 @@ package ports
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	core "github.com/iden3/go-iden3-core"
@@ -13,6 +14,24 @@ import (
 type NewGetAllConnectionsRequest struct {
 	WithCredentials bool
 	Query           string
+	Tags            []string
+}
+
+// ConnectionsFilter struct. Query matches the DID of the user and the display name of the connection, Tags keeps
+// the connections that have all of them.
+type ConnectionsFilter struct {
+	Query          string
+	Tags           []string
+	IncludeDeleted bool // IncludeDeleted selects the deleted connections too, until they are purged
+}
+
+// UpdateConnectionMetadataRequest holds the metadata of a connection to change, the nil fields are kept.
+// If Version is not nil, the metadata is only updated if it is its current version.
+type UpdateConnectionMetadataRequest struct {
+	DisplayName *string
+	Notes       *string
+	Tags        *[]string
+	Version     *int
 }
 
 // DeleteRequest struct
@@ -23,15 +42,20 @@ type DeleteRequest struct {
 }
 
 // NewGetAllRequest returns the request object for obtaining all connections
-func NewGetAllRequest(withCredentials *bool, query *string) *NewGetAllConnectionsRequest {
+func NewGetAllRequest(withCredentials *bool, query *string, tags *[]string) *NewGetAllConnectionsRequest {
 	var connQuery string
 	if query != nil {
 		connQuery = *query
 	}
+	var connTags []string
+	if tags != nil {
+		connTags = *tags
+	}
 
 	return &NewGetAllConnectionsRequest{
 		WithCredentials: withCredentials != nil && *withCredentials,
 		Query:           connQuery,
+		Tags:            connTags,
 	}
 }
 
@@ -48,7 +72,11 @@ func NewDeleteRequest(connID uuid.UUID, deleteCredentials *bool, revokeCredentia
 type ConnectionsService interface {
 	Delete(ctx context.Context, id uuid.UUID, deleteCredentials bool, issuerDID core.DID) error
 	DeleteCredentials(ctx context.Context, id uuid.UUID, issuerID core.DID) error
+	Restore(ctx context.Context, id uuid.UUID, issuerDID core.DID) error
+	PurgeDeleted(ctx context.Context, retention time.Duration) error
 	GetByIDAndIssuerID(ctx context.Context, id uuid.UUID, issuerDID core.DID) (*domain.Connection, error)
 	GetByUserID(ctx context.Context, issuerDID core.DID, userID core.DID) (*domain.Connection, error)
-	GetAllByIssuerID(ctx context.Context, issuerDID core.DID, query string, withCredentials bool) ([]*domain.Connection, error)
+	GetAllByIssuerID(ctx context.Context, issuerDID core.DID, filter *ConnectionsFilter, withCredentials bool) ([]*domain.Connection, error)
+	IterateByIssuerID(ctx context.Context, issuerDID core.DID, query string, fn func(*domain.Connection) error) error
+	UpdateMetadata(ctx context.Context, id uuid.UUID, issuerDID core.DID, req *UpdateConnectionMetadataRequest) (*domain.Connection, error)
 }
@@ -0,0 +1,16 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// CredentialHistoryRepository reads the history of the credentials from the tables where every step is recorded
+type CredentialHistoryRepository interface {
+	GetByCredentialID(ctx context.Context, conn db.Querier, issuerDID core.DID, id uuid.UUID) ([]domain.CredentialEvent, error)
+}
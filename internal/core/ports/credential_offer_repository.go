@@ -0,0 +1,18 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// CredentialOfferRepository defines the available methods for credential offers repository
+type CredentialOfferRepository interface {
+	Save(ctx context.Context, conn db.Querier, offer *domain.CredentialOffer) error
+	Consume(ctx context.Context, conn db.Querier, issuerDID core.DID, offerID uuid.UUID, credentialID uuid.UUID, at time.Time) error
+}
@@ -0,0 +1,19 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// CredentialTemplateRepository defines the available methods for credential templates repository
+type CredentialTemplateRepository interface {
+	Save(ctx context.Context, conn db.Querier, template *domain.CredentialTemplate) error
+	GetByID(ctx context.Context, conn db.Querier, issuerDID core.DID, id uuid.UUID) (*domain.CredentialTemplate, error)
+	GetAll(ctx context.Context, conn db.Querier, issuerDID core.DID) ([]*domain.CredentialTemplate, error)
+	Delete(ctx context.Context, conn db.Querier, issuerDID core.DID, id uuid.UUID) error
+}
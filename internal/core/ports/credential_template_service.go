@@ -0,0 +1,21 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// CredentialTemplateService is the interface implemented by the credential templates service. It stores the
+// templates of the issuers and issues credentials from them.
+type CredentialTemplateService interface {
+	Create(ctx context.Context, template *domain.CredentialTemplate) (*domain.CredentialTemplate, error)
+	GetByID(ctx context.Context, issuerDID core.DID, id uuid.UUID) (*domain.CredentialTemplate, error)
+	GetAll(ctx context.Context, issuerDID core.DID) ([]*domain.CredentialTemplate, error)
+	Delete(ctx context.Context, issuerDID core.DID, id uuid.UUID) error
+	Issue(ctx context.Context, issuerDID core.DID, id uuid.UUID, credentialSubject domain.CredentialSubject, expiration *time.Time) (*domain.Claim, error)
+}
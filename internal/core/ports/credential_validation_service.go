@@ -0,0 +1,32 @@
+package ports
+
+import (
+	"context"
+	"time"
+)
+
+// CredentialValidationService asks the validation webhook of the schema of a credential, if it has one, to approve
+// the credential subject before the credential is signed
+type CredentialValidationService interface {
+	Validate(ctx context.Context, req *CreateClaimRequest) error
+}
+
+// CredentialValidationRequest is the body sent to a validation webhook
+type CredentialValidationRequest struct {
+	Issuer            string         `json:"issuer"`
+	Schema            string         `json:"schema"`
+	Type              string         `json:"type"`
+	CredentialSubject map[string]any `json:"credentialSubject"`
+	Expiration        *time.Time     `json:"expiration,omitempty"`
+}
+
+// CredentialValidationResult is the answer of a validation webhook. Reason explains a rejection.
+type CredentialValidationResult struct {
+	Approved bool   `json:"approved"`
+	Reason   string `json:"reason,omitempty"`
+}
+
+// ValidationWebhookGateway calls validation webhooks. The request is signed with secret, if not empty.
+type ValidationWebhookGateway interface {
+	Validate(ctx context.Context, url, secret string, req *CredentialValidationRequest) (*CredentialValidationResult, error)
+}
@@ -0,0 +1,18 @@
+package ports
+
+import (
+	"context"
+
+	core "github.com/iden3/go-iden3-core"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// DIDDocumentService is the interface implemented by the DID document service. The documents have the agent of the
+// node as a service entry unless it is replaced, and the entries added for the identity.
+type DIDDocumentService interface {
+	Get(ctx context.Context, did core.DID) (*domain.DIDDocument, error)
+	GetServices(ctx context.Context, did core.DID) ([]*domain.DIDService, error)
+	SaveService(ctx context.Context, did core.DID, service *domain.DIDService) (*domain.DIDService, error)
+	DeleteService(ctx context.Context, did core.DID, id string) error
+}
@@ -0,0 +1,17 @@
+package ports
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// DIDResolution is the answer of a DID resolver. Error is the error of its resolution metadata, like notFound.
+type DIDResolution struct {
+	Document json.RawMessage
+	Error    string
+}
+
+// DIDResolverGateway resolves DIDs with a universal resolver
+type DIDResolverGateway interface {
+	Resolve(ctx context.Context, did string) (*DIDResolution, error)
+}
@@ -0,0 +1,17 @@
+package ports
+
+import (
+	"context"
+
+	core "github.com/iden3/go-iden3-core"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// DIDServiceRepository defines the available methods for the repository of the service entries of the DID documents
+type DIDServiceRepository interface {
+	Save(ctx context.Context, conn db.Querier, service *domain.DIDService) error
+	Delete(ctx context.Context, conn db.Querier, identifier core.DID, id string) error
+	GetByIdentifier(ctx context.Context, conn db.Querier, identifier core.DID) ([]*domain.DIDService, error)
+}
@@ -0,0 +1,18 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// EventOutboxRepository defines the available methods for the outbox of the events sent to the broker and the pubsub
+type EventOutboxRepository interface {
+	Add(ctx context.Context, conn db.Querier, event *domain.OutboxEvent) error
+	GetPending(ctx context.Context, conn db.Querier, destinations []string, limit int) ([]domain.OutboxEvent, error)
+	MarkSent(ctx context.Context, conn db.Querier, ids []int64) error
+	MarkFailed(ctx context.Context, conn db.Querier, ids []int64, reason string) error
+	DeleteBefore(ctx context.Context, conn db.Querier, before time.Time, pendingDestinations []string) (int64, error)
+}
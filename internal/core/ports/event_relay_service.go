@@ -0,0 +1,26 @@
+package ports
+
+import (
+	"context"
+)
+
+// BrokerMessage is a message published in a topic of the message broker. Messages with the same key are kept in
+// order, and ID identifies the message to the brokers that discard duplicates.
+type BrokerMessage struct {
+	Topic string
+	Key   string
+	ID    string
+	Value []byte
+}
+
+// EventBroker publishes messages in a message broker. Publish returns once the broker acknowledged every message.
+type EventBroker interface {
+	Publish(ctx context.Context, messages ...BrokerMessage) error
+	Close() error
+}
+
+// EventRelayService is the interface implemented by the service that sends the outbox events to the broker
+type EventRelayService interface {
+	Relay(ctx context.Context) (int, error)
+	Purge(ctx context.Context) error
+}
@@ -0,0 +1,17 @@
+package ports
+
+import (
+	"context"
+
+	core "github.com/iden3/go-iden3-core"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// FeatureFlagRepository defines the available methods for the feature flag overrides repository
+type FeatureFlagRepository interface {
+	Save(ctx context.Context, conn db.Querier, flag *domain.FeatureFlag) error
+	Delete(ctx context.Context, conn db.Querier, identifier core.DID, feature domain.Feature) error
+	GetByIdentifier(ctx context.Context, conn db.Querier, identifier core.DID) ([]*domain.FeatureFlag, error)
+}
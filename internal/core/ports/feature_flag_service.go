@@ -0,0 +1,18 @@
+package ports
+
+import (
+	"context"
+
+	core "github.com/iden3/go-iden3-core"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// FeatureFlagService is the interface implemented by the feature flag service. Features take the value of the
+// configuration unless it is overridden for the identity.
+type FeatureFlagService interface {
+	IsEnabled(ctx context.Context, did core.DID, feature domain.Feature) bool
+	GetAll(ctx context.Context, did core.DID) ([]*domain.FeatureFlag, error)
+	Set(ctx context.Context, did core.DID, feature domain.Feature, enabled bool) (*domain.FeatureFlag, error)
+	Reset(ctx context.Context, did core.DID, feature domain.Feature) (*domain.FeatureFlag, error)
+}
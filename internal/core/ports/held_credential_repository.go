@@ -0,0 +1,19 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// HeldCredentialRepository defines the available methods for the repository of credentials held by the node identities
+type HeldCredentialRepository interface {
+	Save(ctx context.Context, conn db.Querier, credential *domain.HeldCredential) (uuid.UUID, error)
+	GetByID(ctx context.Context, conn db.Querier, holderDID core.DID, id uuid.UUID) (*domain.HeldCredential, error)
+	GetAll(ctx context.Context, conn db.Querier, holderDID core.DID, filter *HeldCredentialsFilter) ([]*domain.HeldCredential, error)
+	Delete(ctx context.Context, conn db.Querier, holderDID core.DID, id uuid.UUID) error
+}
@@ -0,0 +1,16 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// IdempotencyKeyRepository defines the available methods for the idempotency keys repository
+type IdempotencyKeyRepository interface {
+	Reserve(ctx context.Context, conn db.Querier, key *domain.IdempotencyKey, since time.Time, processingSince time.Time) (*domain.IdempotencyKey, error)
+	SaveResponse(ctx context.Context, conn db.Querier, key *domain.IdempotencyKey) error
+	Delete(ctx context.Context, conn db.Querier, key *domain.IdempotencyKey) error
+}
@@ -0,0 +1,15 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// IdempotencyService is the interface implemented by the idempotency service. It remembers the requests sent with an
+// idempotency key, so their retries get the original response instead of being processed again.
+type IdempotencyService interface {
+	Reserve(ctx context.Context, key *domain.IdempotencyKey) (*domain.IdempotencyKey, error)
+	SaveResponse(ctx context.Context, key *domain.IdempotencyKey) error
+	Release(ctx context.Context, key *domain.IdempotencyKey) error
+}
@@ -0,0 +1,23 @@
+package ports
+
+import (
+	"context"
+	"encoding/json"
+
+	core "github.com/iden3/go-iden3-core"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// IdentityMigrationRepository defines the available methods for the identity migrations repository.
+// It also exports and imports the rows of an identity.
+type IdentityMigrationRepository interface {
+	Save(ctx context.Context, conn db.Querier, migration *domain.IdentityMigration) error
+	Get(ctx context.Context, conn db.Querier, identifier core.DID) (*domain.IdentityMigration, error)
+	Delete(ctx context.Context, conn db.Querier, identifier core.DID) error
+	HasPendingTransactions(ctx context.Context, conn db.Querier, identifier core.DID) (bool, error)
+	SchemaVersion(ctx context.Context, conn db.Querier) (int64, error)
+	Export(ctx context.Context, conn db.Querier, identifier core.DID) (map[string]json.RawMessage, []domain.IdentityExportMerkleTree, error)
+	Import(ctx context.Context, conn db.Querier, export *domain.IdentityExport) (map[string]int, error)
+}
@@ -0,0 +1,25 @@
+package ports
+
+import (
+	"context"
+
+	core "github.com/iden3/go-iden3-core"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// IdentityMigrationService is the interface implemented by the identity migration service.
+// An identity is moved to another node in steps: the source node starts the migration, which makes the identity read
+// only, and exports it. The target node imports the export and verifies it. Then the migration is completed in the
+// source node, or aborted to make the identity writable again. Backup exports an identity that is not being migrated,
+// to import it in another node later.
+type IdentityMigrationService interface {
+	Start(ctx context.Context, did core.DID, target *string) (*domain.IdentityMigration, error)
+	Get(ctx context.Context, did core.DID) (*domain.IdentityMigration, error)
+	IsReadOnly(ctx context.Context, did core.DID) bool
+	Export(ctx context.Context, did core.DID) (*domain.IdentityExport, error)
+	Backup(ctx context.Context, did core.DID) (*domain.IdentityExport, error)
+	Import(ctx context.Context, export *domain.IdentityExport, keys map[string]string) (*domain.IdentityImportResult, error)
+	Complete(ctx context.Context, did core.DID) (*domain.IdentityMigration, error)
+	Abort(ctx context.Context, did core.DID) error
+}
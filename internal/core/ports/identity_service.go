@@ -2,6 +2,7 @@ package ports
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	core "github.com/iden3/go-iden3-core"
@@ -16,6 +17,7 @@ import (
 type IdentityService interface {
 	GetByDID(ctx context.Context, identifier core.DID) (*domain.Identity, error)
 	Create(ctx context.Context, DIDMethod string, Blockchain, NetworkID, hostURL string) (*domain.Identity, error)
+	CreateEthIdentity(ctx context.Context, DIDMethod string, Blockchain, NetworkID, hostURL string) (*domain.Identity, error)
 	SignClaimEntry(ctx context.Context, authClaim *domain.Claim, claimEntry *core.Claim) (*verifiable.BJJSignatureProof2021, error)
 	Get(ctx context.Context) (identities []string, err error)
 	UpdateState(ctx context.Context, did core.DID) (*domain.IdentityState, error)
@@ -32,4 +34,6 @@ type IdentityService interface {
 	CreateAuthenticationQRCode(ctx context.Context, serverURL string, issuerDID core.DID) (*protocol.AuthorizationRequestMessage, error)
 	Authenticate(ctx context.Context, message string, sessionID uuid.UUID, serverURL string, issuerDID core.DID) (*protocol.AuthorizationResponseMessage, error)
 	GetFailedState(ctx context.Context, identifier core.DID) (*domain.IdentityState, error)
+	GetRevocations(ctx context.Context, identifier core.DID, nonces []domain.RevNonceUint64) ([]*domain.Revocation, error)
+	GetRevocationList(ctx context.Context, identifier core.DID, since *time.Time) (*domain.RevocationList, error)
 }
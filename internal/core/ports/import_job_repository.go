@@ -0,0 +1,19 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// ImportJobRepository defines the available methods for import jobs repository
+type ImportJobRepository interface {
+	Save(ctx context.Context, conn db.Querier, job *domain.ImportJob) error
+	GetByID(ctx context.Context, conn db.Querier, issuerDID core.DID, id uuid.UUID) (*domain.ImportJob, error)
+	SaveRow(ctx context.Context, conn db.Querier, row *domain.ImportRow) error
+	GetRows(ctx context.Context, conn db.Querier, jobID uuid.UUID, onlyErrors bool) ([]*domain.ImportRow, error)
+}
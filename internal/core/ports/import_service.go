@@ -0,0 +1,20 @@
+package ports
+
+import (
+	"context"
+	"io"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// ImportService is the interface implemented by the import service. It issues the credentials or credential links of
+// the rows of a CSV file in the background, Process being the handler of its jobs.
+type ImportService interface {
+	Start(ctx context.Context, job *domain.ImportJob, content io.Reader, mapping map[string]string, referenceColumn string) (*domain.ImportJob, error)
+	Process(ctx context.Context, job *domain.Job) error
+	GetByID(ctx context.Context, issuerDID core.DID, id uuid.UUID) (*domain.ImportJob, error)
+	GetRows(ctx context.Context, issuerDID core.DID, id uuid.UUID, onlyErrors bool) ([]*domain.ImportRow, error)
+}
@@ -0,0 +1,16 @@
+package ports
+
+import (
+	"context"
+
+	core "github.com/iden3/go-iden3-core"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// IssuerProfileRepository defines the available methods for the issuer profiles repository
+type IssuerProfileRepository interface {
+	Save(ctx context.Context, conn db.Querier, profile *domain.IssuerProfile) error
+	GetByIdentifier(ctx context.Context, conn db.Querier, identifier core.DID) (*domain.IssuerProfile, error)
+}
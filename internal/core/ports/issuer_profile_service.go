@@ -0,0 +1,27 @@
+package ports
+
+import (
+	"context"
+
+	core "github.com/iden3/go-iden3-core"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// IssuerProfileUpdate are the fields of the profile to change. The nil ones keep their value, and the empty ones are
+// cleared.
+type IssuerProfileUpdate struct {
+	DisplayName     *string
+	LogoURL         *string
+	BackgroundColor *string
+	TextColor       *string
+	ContactEmail    *string
+	ContactURL      *string
+}
+
+// IssuerProfileService is the interface implemented by the issuer profile service. Identities without a profile
+// take the name and the logo of the configuration.
+type IssuerProfileService interface {
+	Get(ctx context.Context, did core.DID) (*domain.IssuerProfile, error)
+	Update(ctx context.Context, did core.DID, update *IssuerProfileUpdate) (*domain.IssuerProfile, error)
+}
@@ -0,0 +1,27 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// JobHandler runs a job of a kind. A job whose handler returns an error runs again later.
+type JobHandler func(ctx context.Context, job *domain.Job) error
+
+// JobQueueService is the interface implemented by the background jobs queue. Every node enqueues jobs, and runs the
+// ones of the kinds it registered a handler for.
+type JobQueueService interface {
+	Enqueue(ctx context.Context, conn db.Querier, kind string, issuerDID core.DID, payload any) (*domain.Job, error)
+	Register(kind string, handler JobHandler)
+	Work(ctx context.Context) (int, error)
+	Purge(ctx context.Context) error
+	GetAll(ctx context.Context, issuerDID core.DID, filter JobsFilter) ([]*domain.Job, error)
+	GetByID(ctx context.Context, issuerDID core.DID, id uuid.UUID) (*domain.Job, error)
+	Retry(ctx context.Context, issuerDID core.DID, id uuid.UUID) (*domain.Job, error)
+	Cancel(ctx context.Context, issuerDID core.DID, id uuid.UUID) (*domain.Job, error)
+}
@@ -0,0 +1,30 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// JobsFilter selects the jobs of an issuer, by kind and status when they are set. The newest jobs are returned first.
+type JobsFilter struct {
+	Kind   string
+	Status *domain.JobStatus
+	Limit  int
+}
+
+// JobRepository defines the available methods for the jobs queue repository
+type JobRepository interface {
+	Save(ctx context.Context, conn db.Querier, job *domain.Job) error
+	GetByID(ctx context.Context, conn db.Querier, issuerDID core.DID, id uuid.UUID) (*domain.Job, error)
+	GetAll(ctx context.Context, conn db.Querier, issuerDID core.DID, filter JobsFilter) ([]*domain.Job, error)
+	Lock(ctx context.Context, conn db.Querier, kinds []string) (*domain.Job, error)
+	Heartbeat(ctx context.Context, conn db.Querier, id uuid.UUID) error
+	Rescue(ctx context.Context, conn db.Querier, lockedBefore time.Time) (int64, error)
+	DeleteFinishedBefore(ctx context.Context, conn db.Querier, before time.Time) (int64, error)
+}
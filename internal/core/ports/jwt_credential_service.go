@@ -0,0 +1,18 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+	"gopkg.in/square/go-jose.v2"
+)
+
+// JWTCredentialService is the interface implemented by the JWT credential service. It serializes the issued
+// credentials as JWT VCs, signed with an ES256 or EdDSA key of the issuer, for the consumers that can't process
+// JSON-LD credentials and BJJ signatures. The status list of an issuer is published as a JWT VC too.
+type JWTCredentialService interface {
+	Serialize(ctx context.Context, issuerDID core.DID, id uuid.UUID) (string, error)
+	StatusList(ctx context.Context, issuerDID core.DID) (string, error)
+	PublicKeys(ctx context.Context, issuerDID core.DID) (*jose.JSONWebKeySet, error)
+}
@@ -0,0 +1,17 @@
+package ports
+
+import (
+	"context"
+
+	core "github.com/iden3/go-iden3-core"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// KeyRotationRepository defines the available methods for key rotations repository
+type KeyRotationRepository interface {
+	Save(ctx context.Context, conn db.Querier, rotation *domain.KeyRotation) error
+	GetPending(ctx context.Context, conn db.Querier) ([]*domain.KeyRotation, error)
+	GetPendingByIdentifier(ctx context.Context, conn db.Querier, identifier core.DID) (*domain.KeyRotation, error)
+}
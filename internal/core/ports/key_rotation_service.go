@@ -0,0 +1,17 @@
+package ports
+
+import (
+	"context"
+
+	core "github.com/iden3/go-iden3-core"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// KeyRotationService is the interface implemented by the key rotation service. A rotation adds a new auth key to
+// the issuer, publishes it and revokes the old one once the new state is confirmed.
+type KeyRotationService interface {
+	Rotate(ctx context.Context, did core.DID) (*domain.KeyRotation, *domain.AuthKey, error)
+	GetAuthKeys(ctx context.Context, did core.DID) ([]*domain.AuthKey, error)
+	ProcessPending(ctx context.Context) error
+}
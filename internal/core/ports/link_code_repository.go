@@ -0,0 +1,23 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// LinkCodeRepository defines the available methods for the repository of the one-time codes of the links
+type LinkCodeRepository interface {
+	Save(ctx context.Context, conn db.Querier, codes []*domain.LinkCode) error
+	GetByLink(ctx context.Context, conn db.Querier, linkID uuid.UUID) ([]*domain.LinkCode, error)
+	GetBySession(ctx context.Context, conn db.Querier, linkID uuid.UUID, sessionID uuid.UUID) (*domain.LinkCode, error)
+	Reserve(ctx context.Context, conn db.Querier, linkID uuid.UUID, hash string, sessionID uuid.UUID) error
+	Use(ctx context.Context, conn db.Querier, linkID uuid.UUID, sessionID uuid.UUID, holderDID core.DID, usedAt time.Time) error
+	SaveFailure(ctx context.Context, conn db.Querier, linkID uuid.UUID, at time.Time) error
+	CountFailures(ctx context.Context, conn db.Querier, linkID uuid.UUID, since time.Time) (int, error)
+}
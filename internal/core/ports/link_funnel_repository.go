@@ -0,0 +1,27 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// LinkFunnelFilter selects the link funnel events of an export. The nil fields do not filter.
+type LinkFunnelFilter struct {
+	LinkID *uuid.UUID
+	From   *time.Time
+	To     *time.Time
+}
+
+// LinkFunnelRepository defines the available methods for the link funnel events repository
+type LinkFunnelRepository interface {
+	Save(ctx context.Context, conn db.Querier, event *domain.LinkFunnelEvent) error
+	GetAll(ctx context.Context, conn db.Querier, issuerDID core.DID, filter LinkFunnelFilter) ([]*domain.LinkFunnelEvent, error)
+	Summary(ctx context.Context, conn db.Querier, issuerDID core.DID, linkID uuid.UUID) (*domain.LinkFunnel, error)
+	Analytics(ctx context.Context, conn db.Querier, issuerDID core.DID, linkID uuid.UUID, interval domain.LinkAnalyticsInterval, from time.Time, to time.Time) (*domain.LinkAnalytics, error)
+}
@@ -46,12 +46,19 @@ type GetQRCodeResponse struct {
 
 // LinkService - the interface that defines the available methods
 type LinkService interface {
-	Save(ctx context.Context, did core.DID, maxIssuance *int, validUntil *time.Time, schemaID uuid.UUID, credentialExpiration *time.Time, credentialSignatureProof bool, credentialMTPProof bool, credentialAttributes domain.CredentialSubject) (*domain.Link, error)
-	Activate(ctx context.Context, issuerID core.DID, linkID uuid.UUID, active bool) error
+	Save(ctx context.Context, did core.DID, maxIssuance *int, validUntil *time.Time, schemaID uuid.UUID, credentialExpiration *time.Time, credentialSignatureProof bool, credentialMTPProof bool, credentialAttributes domain.CredentialSubject, proofScope []domain.VerificationQuery, subjectExternalID *string, allowedDIDs []string, codeProtected bool) (*domain.Link, error)
+	Activate(ctx context.Context, issuerID core.DID, linkID uuid.UUID, active bool, version *int) (*domain.Link, error)
 	Delete(ctx context.Context, id uuid.UUID, did core.DID) error
 	GetByID(ctx context.Context, issuerID core.DID, id uuid.UUID) (*domain.Link, error)
 	GetAll(ctx context.Context, issuerDID core.DID, status LinkStatus, query *string) ([]domain.Link, error)
-	CreateQRCode(ctx context.Context, issuerDID core.DID, linkID uuid.UUID, serverURL string) (*CreateQRCodeResponse, error)
+	CreateQRCode(ctx context.Context, issuerDID core.DID, linkID uuid.UUID, serverURL string, code *string) (*CreateQRCodeResponse, error)
 	IssueClaim(ctx context.Context, sessionID string, issuerDID core.DID, userDID core.DID, linkID uuid.UUID, hostURL string) error
 	GetQRCode(ctx context.Context, sessionID uuid.UUID, issuerID core.DID, linkID uuid.UUID) (*GetQRCodeResponse, error)
+	WaitQRCode(ctx context.Context, sessionID uuid.UUID, issuerID core.DID, linkID uuid.UUID) (*GetQRCodeResponse, error)
+	GetFunnel(ctx context.Context, issuerDID core.DID, linkID uuid.UUID) (*domain.LinkFunnel, error)
+	ExportFunnel(ctx context.Context, issuerDID core.DID, filter LinkFunnelFilter) ([]*domain.LinkFunnelEvent, error)
+	GetAnalytics(ctx context.Context, issuerDID core.DID, linkID uuid.UUID, interval domain.LinkAnalyticsInterval, from *time.Time, to *time.Time) (*domain.LinkAnalytics, error)
+	CreateCodes(ctx context.Context, issuerDID core.DID, linkID uuid.UUID, count int) ([]string, error)
+	GetCodes(ctx context.Context, issuerDID core.DID, linkID uuid.UUID) ([]*domain.LinkCode, error)
+	GetSubjectBinding(ctx context.Context, issuerDID core.DID, externalID string) (*domain.SubjectBinding, error)
 }
@@ -2,6 +2,7 @@ package ports
 
 import (
 	"context"
+	"math/big"
 
 	core "github.com/iden3/go-iden3-core"
 
@@ -13,4 +14,5 @@ import (
 type MtService interface {
 	CreateIdentityMerkleTrees(ctx context.Context, conn db.Querier) (*domain.IdentityMerkleTrees, error)
 	GetIdentityMerkleTrees(ctx context.Context, conn db.Querier, identifier *core.DID) (*domain.IdentityMerkleTrees, error)
+	AddRevocations(ctx context.Context, conn db.Querier, identifier *core.DID, nonces []*big.Int) error
 }
@@ -0,0 +1,21 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// OfferEmailRepository defines the available methods for the repository of the credential offers sent by email
+type OfferEmailRepository interface {
+	Save(ctx context.Context, conn db.Querier, email *domain.OfferEmail) error
+	GetByID(ctx context.Context, conn db.Querier, id uuid.UUID) (*domain.OfferEmail, error)
+	GetByCredential(ctx context.Context, conn db.Querier, issuerDID core.DID, credentialID uuid.UUID) ([]*domain.OfferEmail, error)
+	Opened(ctx context.Context, conn db.Querier, id uuid.UUID, at time.Time) error
+	Clicked(ctx context.Context, conn db.Querier, id uuid.UUID, at time.Time) error
+}
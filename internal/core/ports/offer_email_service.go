@@ -0,0 +1,18 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// OfferEmailService sends the credential offers by email and tracks them
+type OfferEmailService interface {
+	Send(ctx context.Context, issuerDID core.DID, credentialID uuid.UUID, recipient string) (*domain.OfferEmail, error)
+	GetByCredential(ctx context.Context, issuerDID core.DID, credentialID uuid.UUID) ([]*domain.OfferEmail, error)
+	Open(ctx context.Context, id uuid.UUID) error
+	Claim(ctx context.Context, id uuid.UUID) (string, error)
+}
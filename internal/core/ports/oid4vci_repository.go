@@ -0,0 +1,21 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// OID4VCIRepository defines the available methods for OpenID4VCI offers repository
+type OID4VCIRepository interface {
+	Save(ctx context.Context, conn db.Querier, offer *domain.OID4VCIOffer) error
+	GetByPreAuthorizedCode(ctx context.Context, conn db.Querier, codeHash string) (*domain.OID4VCIOffer, error)
+	GetByAccessToken(ctx context.Context, conn db.Querier, tokenHash string) (*domain.OID4VCIOffer, error)
+	Redeem(ctx context.Context, conn db.Querier, id uuid.UUID, tokenHash string, tokenExpiresAt time.Time, cNonce string) error
+	SetNonce(ctx context.Context, conn db.Querier, id uuid.UUID, current string, next string) error
+	SetClaim(ctx context.Context, conn db.Querier, id uuid.UUID, claimID uuid.UUID) error
+}
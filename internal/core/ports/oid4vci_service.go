@@ -0,0 +1,113 @@
+package ports
+
+import (
+	"context"
+
+	core "github.com/iden3/go-iden3-core"
+	"github.com/iden3/go-schema-processor/verifiable"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// OID4VCICredentialDefinition describes a credential in the offers and the metadata of the issuer
+type OID4VCICredentialDefinition struct {
+	Context []string `json:"@context"`
+	Types   []string `json:"types"`
+}
+
+// OID4VCIOfferedCredential is a credential of an offer message
+type OID4VCIOfferedCredential struct {
+	Format               string                      `json:"format"`
+	CredentialDefinition OID4VCICredentialDefinition `json:"credential_definition"`
+}
+
+// OID4VCIPreAuthorizedCodeGrant is the grant of an offer message the wallet gets the access token with
+type OID4VCIPreAuthorizedCodeGrant struct {
+	PreAuthorizedCode string `json:"pre-authorized_code"`
+	UserPinRequired   bool   `json:"user_pin_required"`
+}
+
+// OID4VCICredentialOfferMessage is the credential offer the wallets scan, as defined by OpenID4VCI
+type OID4VCICredentialOfferMessage struct {
+	CredentialIssuer string                                   `json:"credential_issuer"`
+	Credentials      []OID4VCIOfferedCredential               `json:"credentials"`
+	Grants           map[string]OID4VCIPreAuthorizedCodeGrant `json:"grants"`
+}
+
+// OID4VCICredentialOffer is a created offer and the message sent to the wallet
+type OID4VCICredentialOffer struct {
+	Offer   *domain.OID4VCIOffer
+	Message *OID4VCICredentialOfferMessage
+}
+
+// OID4VCICredentialSupported is a kind of credential the issuer supports
+type OID4VCICredentialSupported struct {
+	Format                               string                     `json:"format"`
+	ID                                   string                     `json:"id"`
+	Context                              []string                   `json:"@context"`
+	Types                                []string                   `json:"types"`
+	CryptographicBindingMethodsSupported []string                   `json:"cryptographic_binding_methods_supported"`
+	CryptographicSuitesSupported         []string                   `json:"cryptographic_suites_supported"`
+	Display                              []OID4VCICredentialDisplay `json:"display,omitempty"`
+}
+
+// OID4VCICredentialDisplay is how the wallets show a kind of credential, with the colors of the issuer
+type OID4VCICredentialDisplay struct {
+	Name            string `json:"name"`
+	BackgroundColor string `json:"background_color,omitempty"`
+	TextColor       string `json:"text_color,omitempty"`
+}
+
+// OID4VCILogo is the logo of the issuer in the metadata
+type OID4VCILogo struct {
+	URL     string `json:"url"`
+	AltText string `json:"alt_text,omitempty"`
+}
+
+// OID4VCIIssuerDisplay is how the wallets show the issuer
+type OID4VCIIssuerDisplay struct {
+	Name string       `json:"name"`
+	Logo *OID4VCILogo `json:"logo,omitempty"`
+}
+
+// OID4VCIIssuerMetadata is the metadata the wallets discover the endpoints of the issuer with
+type OID4VCIIssuerMetadata struct {
+	CredentialIssuer     string                       `json:"credential_issuer"`
+	CredentialEndpoint   string                       `json:"credential_endpoint"`
+	TokenEndpoint        string                       `json:"token_endpoint"`
+	CredentialsSupported []OID4VCICredentialSupported `json:"credentials_supported"`
+	Display              []OID4VCIIssuerDisplay       `json:"display,omitempty"`
+}
+
+// OID4VCIToken is the access token a pre-authorized code is exchanged for
+type OID4VCIToken struct {
+	AccessToken     string
+	ExpiresIn       int
+	CNonce          string
+	CNonceExpiresIn int
+}
+
+// OID4VCICredentialRequest is the request of the wallet to the credential endpoint. ProofJWT is the proof that the
+// wallet holds the key the credential is bound to, signed over the last nonce of the issuer.
+type OID4VCICredentialRequest struct {
+	Format    string
+	ProofType string
+	ProofJWT  string
+}
+
+// OID4VCIIssuedCredential is the answer of the credential endpoint. The nonce is the one the next proof is signed
+// over, it is also returned when the proof is rejected.
+type OID4VCIIssuedCredential struct {
+	Credential      *verifiable.W3CCredential
+	CNonce          string
+	CNonceExpiresIn int
+}
+
+// OID4VCIService is the interface implemented by the OpenID4VCI service. It issues credentials to wallets that do not
+// speak iden3comm with the pre-authorized code flow.
+type OID4VCIService interface {
+	CreateOffer(ctx context.Context, issuerDID core.DID, credential domain.OID4VCICredential) (*OID4VCICredentialOffer, error)
+	Metadata(ctx context.Context) *OID4VCIIssuerMetadata
+	Token(ctx context.Context, grantType string, preAuthorizedCode string) (*OID4VCIToken, error)
+	Credential(ctx context.Context, accessToken string, req *OID4VCICredentialRequest) (*OID4VCIIssuedCredential, error)
+}
@@ -0,0 +1,24 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// ProblemReportsFilter selects the problem reports of a credential or of a connection. The newest reports are
+// returned first.
+type ProblemReportsFilter struct {
+	CredentialID *uuid.UUID
+	ConnectionID *uuid.UUID
+}
+
+// ProblemReportRepository defines the available methods for the repository of the problem reports of the agent
+type ProblemReportRepository interface {
+	Save(ctx context.Context, conn db.Querier, report *domain.ProblemReport) error
+	GetAll(ctx context.Context, conn db.Querier, issuerDID core.DID, filter ProblemReportsFilter) ([]*domain.ProblemReport, error)
+}
@@ -14,7 +14,8 @@ import (
 type Query struct {
 	CircuitID                string
 	Challenge                *big.Int
-	AllowedIssuers           string                 `json:"allowedIssuers"`
+	RequestID                *big.Int
+	AllowedIssuers           []string               `json:"allowedIssuers"`
 	Req                      map[string]interface{} `json:"req"`
 	Context                  string                 `json:"context"`
 	Type                     string                 `json:"type"`
@@ -13,4 +13,6 @@ type Publisher interface {
 	PublishState(ctx context.Context, identity *core.DID) (*domain.PublishedState, error)
 	RetryPublishState(ctx context.Context, identifier *core.DID) (*domain.PublishedState, error)
 	CheckTransactionStatus(ctx context.Context)
+	GetStateTransactions(ctx context.Context, identifier *core.DID) ([]*domain.StateTransaction, error)
+	EstimatePublishingCost(ctx context.Context, identifier *core.DID) (*domain.PublishingCost, error)
 }
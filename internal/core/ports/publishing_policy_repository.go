@@ -0,0 +1,19 @@
+package ports
+
+import (
+	"context"
+
+	core "github.com/iden3/go-iden3-core"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// PublishingPolicyRepository defines the available methods for the publishing policies repository
+type PublishingPolicyRepository interface {
+	Save(ctx context.Context, conn db.Querier, policy *domain.PublishingPolicy) error
+	Delete(ctx context.Context, conn db.Querier, identifier core.DID) error
+	GetByIdentifier(ctx context.Context, conn db.Querier, identifier core.DID) (*domain.PublishingPolicy, error)
+	GetAll(ctx context.Context, conn db.Querier) ([]*domain.PublishingPolicy, error)
+	GetPendingChanges(ctx context.Context, conn db.Querier) ([]*domain.PendingChanges, error)
+}
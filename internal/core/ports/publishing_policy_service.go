@@ -0,0 +1,18 @@
+package ports
+
+import (
+	"context"
+
+	core "github.com/iden3/go-iden3-core"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// PublishingPolicyService is the interface implemented by the publishing policy service. It publishes the states of
+// the identities whose pending changes are due according to their policy.
+type PublishingPolicyService interface {
+	Get(ctx context.Context, did core.DID) (*domain.PublishingPolicy, error)
+	Set(ctx context.Context, did core.DID, policy *domain.PublishingPolicy) (*domain.PublishingPolicy, error)
+	Reset(ctx context.Context, did core.DID) (*domain.PublishingPolicy, error)
+	PublishDue(ctx context.Context) error
+}
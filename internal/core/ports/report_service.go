@@ -0,0 +1,35 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// ReportService builds and sends the scheduled activity reports
+type ReportService interface {
+	Build(ctx context.Context, from, to time.Time) (*domain.Report, error)
+	Send(ctx context.Context, to time.Time) error
+}
+
+// EmailAttachment is a file attached to an email
+type EmailAttachment struct {
+	Name        string
+	ContentType string
+	Content     []byte
+}
+
+// Email is a message sent by the EmailGateway
+type Email struct {
+	To          []string
+	Subject     string
+	ContentType string
+	Body        []byte
+	Attachments []EmailAttachment
+}
+
+// EmailGateway sends emails
+type EmailGateway interface {
+	Send(ctx context.Context, email *Email) error
+}
@@ -0,0 +1,19 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	core "github.com/iden3/go-iden3-core"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// RevocationDecisionRepository defines the available methods for revocation decisions repository
+type RevocationDecisionRepository interface {
+	Save(ctx context.Context, conn db.Querier, decision *domain.RevocationDecision) error
+	GetByExternalID(ctx context.Context, conn db.Querier, issuerDID core.DID, source string, externalID string) (*domain.RevocationDecision, error)
+	GetAll(ctx context.Context, conn db.Querier, issuerDID core.DID, filter *RevocationDecisionsFilter) ([]*domain.RevocationDecision, error)
+	GetLatestDecidedAt(ctx context.Context, conn db.Querier, issuerDID core.DID, source string) (*time.Time, error)
+}
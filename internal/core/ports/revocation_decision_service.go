@@ -0,0 +1,46 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// MaxRevocationDecisions is the maximum number of decisions received in a request or a poll
+const MaxRevocationDecisions = 500
+
+// RevocationDecisionRequest is a revocation decision as sent by the external systems. The credential is identified by
+// its id or by its revocation nonce.
+type RevocationDecisionRequest struct {
+	ID              string     `json:"id"`
+	CredentialID    *uuid.UUID `json:"credentialId,omitempty"`
+	RevocationNonce *uint64    `json:"revocationNonce,omitempty"`
+	Reason          string     `json:"reason,omitempty"`
+	DecidedAt       *time.Time `json:"decidedAt,omitempty"`
+}
+
+// RevocationDecisionsFilter selects the decisions of a report
+type RevocationDecisionsFilter struct {
+	Source string
+	Status *domain.RevocationDecisionStatus
+	From   *time.Time
+	To     *time.Time
+}
+
+// RevocationDecisionsGateway fetches the revocation decisions of an issuer from an external system. Only the
+// decisions taken since the given time are fetched, all of them if it is nil.
+type RevocationDecisionsGateway interface {
+	Fetch(ctx context.Context, issuerDID core.DID, since *time.Time) ([]RevocationDecisionRequest, error)
+}
+
+// RevocationDecisionService is the interface implemented by the revocation decision service. It revokes the
+// credentials as decided by external systems, and reports which decisions were applied and which were rejected.
+type RevocationDecisionService interface {
+	Apply(ctx context.Context, issuerDID core.DID, source string, decisions []RevocationDecisionRequest) ([]*domain.RevocationDecision, error)
+	Poll(ctx context.Context) error
+	Report(ctx context.Context, issuerDID core.DID, filter *RevocationDecisionsFilter) (*domain.RevocationDecisionsReport, error)
+}
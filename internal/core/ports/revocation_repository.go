@@ -2,6 +2,7 @@ package ports
 
 import (
 	"context"
+	"time"
 
 	core "github.com/iden3/go-iden3-core"
 
@@ -12,4 +13,6 @@ import (
 // RevocationRepository interface that defines the available methods
 type RevocationRepository interface {
 	UpdateStatus(ctx context.Context, conn db.Querier, did *core.DID) ([]*domain.Revocation, error)
+	GetByNonces(ctx context.Context, conn db.Querier, did *core.DID, nonces []domain.RevNonceUint64) ([]*domain.Revocation, error)
+	GetRevokedNonces(ctx context.Context, conn db.Querier, did *core.DID, since *time.Time) ([]domain.RevNonceUint64, error)
 }
@@ -12,6 +12,9 @@ import (
 // SchemaRepository interface that define repo methods for schemas
 type SchemaRepository interface {
 	Save(ctx context.Context, schema *domain.Schema) error
+	Update(ctx context.Context, schema *domain.Schema) error
 	GetByID(ctx context.Context, issuerDID core.DID, id uuid.UUID) (*domain.Schema, error)
-	GetAll(ctx context.Context, issuerDID core.DID, query *string) ([]domain.Schema, error)
+	GetAll(ctx context.Context, issuerDID core.DID, filter *SchemasFilter) ([]domain.Schema, error)
+	GetByURL(ctx context.Context, issuerDID core.DID, url string, sType string) ([]domain.Schema, error)
+	GetDocuments(ctx context.Context, id uuid.UUID) (*domain.SchemaDocuments, error)
 }
@@ -9,9 +9,54 @@ import (
 	"github.com/polygonid/sh-id-platform/internal/core/domain"
 )
 
+// UpdateSchemaRequest holds the schema settings that can be changed once the schema is imported.
+// Nil fields are left untouched.
+type UpdateSchemaRequest struct {
+	AutoRevokeOnExpiration *bool
+	// ValidationWebhook replaces the validation webhook of the schema. An empty URL removes it.
+	ValidationWebhook *domain.SchemaWebhook
+	// ExtraContexts and ExtraTypes, if set, replace the ones added to the credentials of the schema
+	ExtraContexts *[]string
+	ExtraTypes    *[]string
+	// DefaultProofTypes, if set, replace the proof types of the credentials of the schema requested without them. An
+	// empty list removes them, so the default ones of the proof policy apply.
+	DefaultProofTypes *[]string
+	// MaxValidityDays, if set, replaces the maximum validity of the credentials of the schema. Zero removes it.
+	MaxValidityDays *int
+	// Deprecated, if set, deprecates the schema or makes it active again
+	Deprecated *bool
+	// Version, if set, must be the current version of the schema
+	Version *int
+}
+
+// SchemasFilter selects the schemas returned by GetAll. Empty fields match every schema.
+type SchemasFilter struct {
+	// Query is a full text search in the schema types and attributes
+	Query         *string
+	Status        domain.SchemaStatus
+	SchemaVersion *int
+	// URLs selects the schemas imported from any of the urls
+	URLs []string
+}
+
+// IPFSPinner adds documents to IPFS and pins them, so they are kept available. It returns the CID of the document.
+type IPFSPinner interface {
+	Pin(ctx context.Context, name string, content []byte) (string, error)
+}
+
+// SchemaDocumentCache keeps the JSON schemas and JSON-LD contexts loaded by the node, so issuance does not depend on
+// the hosts of the documents. Purge removes a document and Refresh fetches it again from its host.
+type SchemaDocumentCache interface {
+	Purge(ctx context.Context, url string) error
+	Refresh(ctx context.Context, url string) (*domain.CachedDocument, error)
+}
+
 // SchemaService defines the methods that Schema manager will expose.
 type SchemaService interface {
 	ImportSchema(ctx context.Context, issuerDID core.DID, url string, sType string) (*domain.Schema, error)
 	GetByID(ctx context.Context, issuerDID core.DID, id uuid.UUID) (*domain.Schema, error)
-	GetAll(ctx context.Context, issuerDID core.DID, query *string) ([]domain.Schema, error)
+	GetAll(ctx context.Context, issuerDID core.DID, filter *SchemasFilter) ([]domain.Schema, error)
+	Update(ctx context.Context, issuerDID core.DID, id uuid.UUID, req *UpdateSchemaRequest) (*domain.Schema, error)
+	BuildSchema(ctx context.Context, issuerDID core.DID, def *domain.SchemaDefinition, pin bool) (*domain.Schema, error)
+	GetDocuments(ctx context.Context, id uuid.UUID) (*domain.SchemaDocuments, error)
 }
@@ -3,8 +3,10 @@ package ports
 import (
 	"context"
 
+	"github.com/google/uuid"
 	"github.com/iden3/iden3comm/protocol"
 
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
 	link_state "github.com/polygonid/sh-id-platform/pkg/link"
 )
 
@@ -14,4 +16,9 @@ type SessionRepository interface {
 	Set(ctx context.Context, key string, value protocol.AuthorizationRequestMessage) error
 	SetLink(ctx context.Context, key string, value link_state.State) error
 	GetLink(ctx context.Context, key string) (link_state.State, error)
+	SetState(ctx context.Context, state domain.SessionState) error
+	GetState(ctx context.Context, sessionID uuid.UUID) (domain.SessionState, error)
+	// Acquire marks the session as used by a callback. It returns false if another callback, maybe handled by another
+	// replica of the node, already did.
+	Acquire(ctx context.Context, sessionID string) (bool, error)
 }
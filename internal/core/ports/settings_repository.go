@@ -0,0 +1,15 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// SettingsRepository defines the available methods for the runtime settings overrides repository
+type SettingsRepository interface {
+	Get(ctx context.Context, conn db.Querier) (*domain.SettingsOverrides, error)
+	Merge(ctx context.Context, conn db.Querier, overrides *domain.SettingsOverrides) (*domain.SettingsOverrides, error)
+	Delete(ctx context.Context, conn db.Querier) error
+}
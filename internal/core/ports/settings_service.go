@@ -0,0 +1,17 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// SettingsService is the interface implemented by the runtime settings service. The settings take the value of the
+// configuration unless they are overridden with the admin API, and every process applies them without a restart.
+type SettingsService interface {
+	Current(ctx context.Context) domain.Settings
+	Get(ctx context.Context) (*domain.Settings, error)
+	Update(ctx context.Context, overrides *domain.SettingsOverrides) (*domain.Settings, error)
+	Reset(ctx context.Context) (*domain.Settings, error)
+	Reload(ctx context.Context, configured domain.Settings)
+}
@@ -0,0 +1,18 @@
+package ports
+
+import (
+	"context"
+
+	core "github.com/iden3/go-iden3-core"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// StateTransactionRepository defines the available methods for state transactions repository
+type StateTransactionRepository interface {
+	Save(ctx context.Context, conn db.Querier, tx *domain.StateTransaction) error
+	GetPending(ctx context.Context, conn db.Querier) ([]*domain.StateTransaction, error)
+	GetByIdentifier(ctx context.Context, conn db.Querier, identifier core.DID, limit int) ([]*domain.StateTransaction, error)
+	UpdateIdentityState(ctx context.Context, conn db.Querier, tx *domain.StateTransaction, status *domain.IdentityStatus) error
+}
@@ -0,0 +1,17 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// StatsRepository defines the queries that aggregate the node activity in a period of time
+type StatsRepository interface {
+	IssuedCredentials(ctx context.Context, conn db.Querier, from, to time.Time) ([]domain.IssuanceStat, error)
+	Revocations(ctx context.Context, conn db.Querier, from, to time.Time) ([]domain.RevocationStat, error)
+	FailedOperations(ctx context.Context, conn db.Querier, from, to time.Time) ([]domain.FailedOperation, error)
+	Backlog(ctx context.Context, conn db.Querier) (*domain.Backlog, error)
+}
@@ -0,0 +1,19 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// StatusListRepository defines the available methods for the status list entries repository
+type StatusListRepository interface {
+	NextIndex(ctx context.Context, conn db.Querier, issuerDID core.DID) (uint64, error)
+	SaveEntry(ctx context.Context, conn db.Querier, entry *domain.StatusListEntry) error
+	GetEntry(ctx context.Context, conn db.Querier, issuerDID core.DID, claimID uuid.UUID) (*domain.StatusListEntry, error)
+	GetRevokedIndexes(ctx context.Context, conn db.Querier, issuerDID core.DID) ([]uint64, error)
+}
@@ -0,0 +1,16 @@
+package ports
+
+import (
+	"context"
+
+	core "github.com/iden3/go-iden3-core"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// SubjectBindingRepository defines the available methods for the repository of the bindings of the placeholder subjects
+type SubjectBindingRepository interface {
+	Bind(ctx context.Context, conn db.Querier, binding *domain.SubjectBinding) (*domain.SubjectBinding, error)
+	GetByExternalID(ctx context.Context, conn db.Querier, issuerDID core.DID, externalID string) (*domain.SubjectBinding, error)
+}
@@ -0,0 +1,13 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// SubjectDIDService checks that the subject of a credential is a well-formed DID of a supported method that can be
+// resolved
+type SubjectDIDService interface {
+	Check(ctx context.Context, did string) (*domain.SubjectDID, error)
+}
@@ -0,0 +1,15 @@
+package ports
+
+import (
+	"context"
+
+	core "github.com/iden3/go-iden3-core"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// TreeIntegrityService is the interface implemented by the service that verifies the merkle trees of an identity
+// against its stored and published states, after a restore or a suspected database corruption.
+type TreeIntegrityService interface {
+	Verify(ctx context.Context, did core.DID) (*domain.TreeIntegrityReport, error)
+}
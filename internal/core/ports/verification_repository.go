@@ -0,0 +1,19 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// VerificationRepository defines the available methods for verification requests and responses repository
+type VerificationRepository interface {
+	SaveRequest(ctx context.Context, conn db.Querier, request *domain.VerificationRequest) error
+	GetRequest(ctx context.Context, conn db.Querier, id uuid.UUID) (*domain.VerificationRequest, error)
+	SaveResponse(ctx context.Context, conn db.Querier, response *domain.VerificationResponse) error
+	GetResponses(ctx context.Context, conn db.Querier, issuerDID core.DID, filter *VerificationResponsesFilter) ([]*domain.VerificationResponse, error)
+}
@@ -0,0 +1,28 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+	"github.com/iden3/iden3comm/protocol"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// VerificationResponsesFilter selects the verification responses of an issuer
+type VerificationResponsesFilter struct {
+	RequestID    *uuid.UUID
+	ConnectionID *uuid.UUID
+	UserDID      *core.DID
+}
+
+// VerificationService is the interface implemented by the verification service. It creates the requests of proofs
+// sent to the holders, and verifies the proofs they answer with.
+type VerificationService interface {
+	CreateRequest(ctx context.Context, request *domain.VerificationRequest) (*domain.VerificationRequest, error)
+	GetRequest(ctx context.Context, issuerDID core.DID, id uuid.UUID) (*domain.VerificationRequest, error)
+	GetAuthorizationRequest(ctx context.Context, issuerDID core.DID, id uuid.UUID) (*protocol.AuthorizationRequestMessage, error)
+	Verify(ctx context.Context, requestID uuid.UUID, token string) (*domain.VerificationResponse, error)
+	GetResponses(ctx context.Context, issuerDID core.DID, filter *VerificationResponsesFilter) ([]*domain.VerificationResponse, error)
+}
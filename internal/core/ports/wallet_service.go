@@ -0,0 +1,27 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+	"github.com/iden3/iden3comm/protocol"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// HeldCredentialsFilter filters the credentials held by an identity
+type HeldCredentialsFilter struct {
+	SchemaType string
+	Revoked    *bool
+}
+
+// WalletService is the interface implemented by the wallet service. The wallet keeps the credentials issued
+// to the node identities by other issuers and uses them to answer verification requests.
+type WalletService interface {
+	AcceptOffer(ctx context.Context, holderDID core.DID, offer *protocol.CredentialsOfferMessage) ([]*domain.HeldCredential, error)
+	GetAll(ctx context.Context, holderDID core.DID, filter *HeldCredentialsFilter) ([]*domain.HeldCredential, error)
+	GetByID(ctx context.Context, holderDID core.DID, id uuid.UUID) (*domain.HeldCredential, error)
+	Delete(ctx context.Context, holderDID core.DID, id uuid.UUID) error
+	Present(ctx context.Context, holderDID core.DID, request *protocol.AuthorizationRequestMessage) error
+}
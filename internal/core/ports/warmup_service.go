@@ -0,0 +1,13 @@
+package ports
+
+import (
+	"context"
+
+	core "github.com/iden3/go-iden3-core"
+)
+
+// WarmUpService is the interface implemented by the warm-up service.
+// It pre-loads the hot caches used by the server before it starts accepting traffic.
+type WarmUpService interface {
+	Run(ctx context.Context, issuers ...core.DID) error
+}
@@ -0,0 +1,77 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+// ErrInboxMessageNotFound the message is not in the agent inbox of the issuer
+var ErrInboxMessageNotFound = errors.New("inbox message not found")
+
+const defaultInboxLimit = 100 // defaultInboxLimit is the number of inbox messages listed if not set
+
+type agentInbox struct {
+	repo    ports.AgentInboxRepository
+	storage *db.Storage
+}
+
+// NewAgentInbox returns a new service of the messages received by the agent
+func NewAgentInbox(repo ports.AgentInboxRepository, storage *db.Storage) ports.AgentInboxService {
+	return &agentInbox{repo: repo, storage: storage}
+}
+
+// Record keeps the message in the inbox of its issuer. The messages sent to identities that are not in the node are
+// not kept.
+func (s *agentInbox) Record(ctx context.Context, message *domain.InboxMessage) error {
+	err := s.repo.Save(ctx, s.storage.Pgx, message)
+	if errors.Is(err, repositories.ErrInboxIssuerNotFound) {
+		log.Debug(ctx, "agent message to an unknown issuer not recorded", "issuerDID", message.IssuerDID, "id", message.MessageID)
+		return nil
+	}
+	return err
+}
+
+// GetAll returns the newest messages received by the agent of the issuer
+func (s *agentInbox) GetAll(ctx context.Context, issuerDID core.DID, filter ports.InboxFilter) ([]*domain.InboxMessage, error) {
+	if filter.Limit <= 0 {
+		filter.Limit = defaultInboxLimit
+	}
+	ctx, cancel := s.storage.ListingContext(ctx)
+	defer cancel()
+	messages, err := s.repo.GetAll(ctx, s.storage.Replica, issuerDID, filter)
+	if err != nil {
+		return nil, s.storage.QueryError(ctx, "agentInbox.GetAll", err)
+	}
+	return messages, nil
+}
+
+// GetByID returns a message received by the agent of the issuer, with its envelope
+func (s *agentInbox) GetByID(ctx context.Context, issuerDID core.DID, id uuid.UUID) (*domain.InboxMessage, error) {
+	message, err := s.repo.GetByID(ctx, s.storage.Pgx, issuerDID, id)
+	if errors.Is(err, repositories.ErrInboxMessageNotFound) {
+		return nil, ErrInboxMessageNotFound
+	}
+	return message, err
+}
+
+// Purge removes the messages received longer than the retention ago
+func (s *agentInbox) Purge(ctx context.Context, retention time.Duration) error {
+	purged, err := s.repo.DeleteBefore(ctx, s.storage.Pgx, time.Now().Add(-retention))
+	if err != nil {
+		return err
+	}
+	if purged > 0 {
+		log.Info(ctx, "purged agent inbox messages", "purged", purged)
+	}
+	return nil
+}
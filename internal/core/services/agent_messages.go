@@ -0,0 +1,211 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+	"github.com/iden3/iden3comm/packers"
+	"github.com/iden3/iden3comm/protocol"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+// getAgentRevocationStatus answers a revocation status request with the status of the nonce in the latest state of
+// the issuer. The status is public, so the sender does not have to be authenticated.
+func (c *claim) getAgentRevocationStatus(ctx context.Context, basicMessage *ports.AgentRequest) (*domain.Agent, error) {
+	statusRequestBody := &protocol.RevocationStatusRequestMessageBody{}
+	if err := json.Unmarshal(basicMessage.Body, statusRequestBody); err != nil {
+		log.Error(ctx, "unmarshalling agent body", "err", err)
+		return nil, fmt.Errorf("invalid revocation status request body: %w", err)
+	}
+
+	status, err := c.GetRevocationStatus(ctx, *basicMessage.IssuerDID, statusRequestBody.RevocationNonce)
+	if err != nil {
+		log.Error(ctx, "getting revocation status", "err", err, "nonce", statusRequestBody.RevocationNonce)
+		return nil, fmt.Errorf("failed to get revocation status: %w", err)
+	}
+
+	return &domain.Agent{
+		ID:       uuid.NewString(),
+		Typ:      packers.MediaTypePlainMessage,
+		Type:     protocol.RevocationStatusResponseMessageType,
+		ThreadID: basicMessage.ThreadID,
+		Body:     protocol.RevocationStatusResponseMessageBody{RevocationStatus: *status},
+		From:     basicMessage.IssuerDID.String(),
+		To:       basicMessage.UserDID.String(),
+	}, nil
+}
+
+// getAgentProposal answers a proposal request with the contact page of the issuer profile for the requested
+// credentials whose type is the one of an active schema of the issuer. The proposal has no entries when the issuer
+// does not issue any of them or it has no contact page.
+func (c *claim) getAgentProposal(ctx context.Context, basicMessage *ports.AgentRequest) (*domain.Agent, error) {
+	proposalRequestBody := &ports.CredentialProposalRequestMessageBody{}
+	if err := json.Unmarshal(basicMessage.Body, proposalRequestBody); err != nil {
+		log.Error(ctx, "unmarshalling agent body", "err", err)
+		return nil, fmt.Errorf("invalid proposal request body: %w", err)
+	}
+	if len(proposalRequestBody.Credentials) == 0 {
+		return nil, fmt.Errorf("the proposal request has no credentials")
+	}
+
+	body := ports.CredentialProposalMessageBody{Proposals: []ports.CredentialProposal{}}
+	credentials, err := c.issuedCredentialTypes(ctx, *basicMessage.IssuerDID, proposalRequestBody.Credentials)
+	if err != nil {
+		return nil, err
+	}
+	if len(credentials) > 0 {
+		profile, err := c.cfg.Profiles.Get(ctx, *basicMessage.IssuerDID)
+		if err != nil {
+			log.Error(ctx, "loading issuer profile", "err", err, "issuerDID", basicMessage.IssuerDID)
+			return nil, err
+		}
+		if profile.ContactURL != "" {
+			body.Proposals = append(body.Proposals, ports.CredentialProposal{
+				Credentials: credentials,
+				Type:        ports.CredentialProposalWebForm,
+				URL:         profile.ContactURL,
+				Description: profile.DisplayName,
+			})
+		}
+	}
+
+	return &domain.Agent{
+		ID:       uuid.NewString(),
+		Typ:      packers.MediaTypePlainMessage,
+		Type:     ports.CredentialProposalMessageType,
+		ThreadID: basicMessage.ThreadID,
+		Body:     body,
+		From:     basicMessage.IssuerDID.String(),
+		To:       basicMessage.UserDID.String(),
+	}, nil
+}
+
+// issuedCredentialTypes returns the requested credentials whose type is the one, or one of the extra ones, of an
+// active schema of the issuer
+func (c *claim) issuedCredentialTypes(ctx context.Context, issuerDID core.DID, requested []ports.CredentialProposalInfo) ([]ports.CredentialProposalInfo, error) {
+	if c.cfg.Schemas == nil || c.cfg.Profiles == nil {
+		return nil, nil
+	}
+	schemas, err := c.cfg.Schemas.GetAll(ctx, issuerDID, &ports.SchemasFilter{Status: domain.SchemaStatusActive})
+	if err != nil {
+		log.Error(ctx, "loading schemas", "err", err, "issuerDID", issuerDID)
+		return nil, err
+	}
+	types := make(map[string]bool, len(schemas))
+	for _, schema := range schemas {
+		types[schema.Type] = true
+		for _, extraType := range schema.ExtraTypes {
+			types[extraType] = true
+		}
+	}
+
+	var credentials []ports.CredentialProposalInfo
+	for _, credential := range requested {
+		if types[credential.Type] {
+			credentials = append(credentials, credential)
+		}
+	}
+	return credentials, nil
+}
+
+// ReportProblem returns the problem report that answers the failed request, and records it attached to the
+// credential the request asked for and to the connection of the sender. A failure to record it is only logged, the
+// report is still sent.
+func (c *claim) ReportProblem(ctx context.Context, req *ports.AgentRequest, cause error) *domain.Agent {
+	report := domain.NewProblemReport(*req.IssuerDID, domain.ProblemReportSent, req.UserDID.String(), uuid.NewString(), req.ThreadID, problemReportCode(cause), cause.Error())
+	report.CredentialID = c.agentRequestCredentialID(ctx, req)
+	if err := c.problemReportRepository.Save(ctx, c.storage.Pgx, report); err != nil {
+		log.Error(ctx, "saving problem report", "err", err, "thid", req.ThreadID)
+	}
+
+	return &domain.Agent{
+		ID:             report.MessageID,
+		Typ:            packers.MediaTypePlainMessage,
+		Type:           ports.ProblemReportMessageType,
+		ThreadID:       req.ThreadID,
+		ParentThreadID: req.ThreadID,
+		Body:           ports.ProblemReportMessageBody{Code: report.Code, Comment: report.Comment},
+		From:           req.IssuerDID.String(),
+		To:             req.UserDID.String(),
+	}
+}
+
+// GetProblemReports returns the problem reports of a credential or of a connection of the issuer
+func (c *claim) GetProblemReports(ctx context.Context, issuerDID core.DID, filter ports.ProblemReportsFilter) ([]*domain.ProblemReport, error) {
+	if filter.CredentialID != nil {
+		if _, err := c.icRepo.GetByIdAndIssuer(ctx, c.storage.Pgx, &issuerDID, *filter.CredentialID); err != nil {
+			if errors.Is(err, repositories.ErrClaimDoesNotExist) {
+				return nil, ErrClaimNotFound
+			}
+			return nil, err
+		}
+	}
+	return c.problemReportRepository.GetAll(ctx, c.storage.Pgx, issuerDID, filter)
+}
+
+// receiveProblemReport records a problem reported by a wallet. It is attached to the connection of the sender, and to
+// the credentials of the offer when it is about the thread of an offer. There is nothing to answer.
+func (c *claim) receiveProblemReport(ctx context.Context, basicMessage *ports.AgentRequest) error {
+	body := &ports.ProblemReportMessageBody{}
+	if err := json.Unmarshal(basicMessage.Body, body); err != nil {
+		log.Error(ctx, "unmarshalling agent body", "err", err)
+		return fmt.Errorf("invalid problem report body: %w", err)
+	}
+	if body.Code == "" {
+		return fmt.Errorf("the problem report has no code")
+	}
+
+	log.Warn(ctx, "problem reported by a holder", "code", body.Code, "comment", body.Comment, "thid", basicMessage.ThreadID, "from", basicMessage.UserDID)
+	report := domain.NewProblemReport(*basicMessage.IssuerDID, domain.ProblemReportReceived, basicMessage.UserDID.String(), basicMessage.ID, basicMessage.ThreadID, body.Code, body.Comment)
+	if err := c.problemReportRepository.Save(ctx, c.storage.Pgx, report); err != nil {
+		log.Error(ctx, "saving problem report", "err", err, "thid", basicMessage.ThreadID)
+		return err
+	}
+	return nil
+}
+
+// agentRequestCredentialID returns the credential of the issuer that a fetch or refresh request asks for, or nil if
+// the request is not one of them or the credential does not exist
+func (c *claim) agentRequestCredentialID(ctx context.Context, req *ports.AgentRequest) *uuid.UUID {
+	if req.Type != protocol.CredentialFetchRequestMessageType && req.Type != ports.CredentialRefreshRequestMessageType {
+		return nil
+	}
+	var body struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(req.Body, &body); err != nil {
+		return nil
+	}
+	credentialID, err := uuid.Parse(body.ID)
+	if err != nil {
+		return nil
+	}
+	if _, err := c.icRepo.GetByIdAndIssuer(ctx, c.storage.Pgx, req.IssuerDID, credentialID); err != nil {
+		return nil
+	}
+	return &credentialID
+}
+
+// problemReportCode returns the code of the problem report that answers a request that failed with the error
+func problemReportCode(err error) string {
+	switch {
+	case errors.Is(err, ErrAgentSenderNotVerified):
+		return domain.ProblemCodeUnauthorized
+	case errors.Is(err, ErrCredentialOfferConsumed):
+		return domain.ProblemCodeOfferConsumed
+	case errors.Is(err, ErrCredentialOfferExpired):
+		return domain.ProblemCodeOfferExpired
+	case errors.Is(err, ErrAgentMessageInProgress):
+		return domain.ProblemCodeInProgress
+	default:
+		return domain.ProblemCodeFailed
+	}
+}
@@ -0,0 +1,109 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+// ErrBacklogDegraded is returned by Check when the backlog is over any of its thresholds
+var ErrBacklogDegraded = errors.New("pending backlog is over its thresholds")
+
+// BacklogCfg configures the backlog thresholds and who is alerted when they are crossed
+type BacklogCfg struct {
+	Thresholds      domain.BacklogThresholds
+	AlertRecipients []string
+	Settings        ports.SettingsService // Runtime settings, they can pause the alert emails. If nil, they are always sent
+}
+
+type backlog struct {
+	statsRepository ports.StatsRepository
+	emailGateway    ports.EmailGateway
+	storage         *db.Storage
+	cfg             BacklogCfg
+	mu              sync.Mutex
+	degraded        bool
+}
+
+// NewBacklog returns a new backlog service. emailGateway is optional; when nil, or when there are no alert
+// recipients, the alerts are only logged.
+func NewBacklog(statsRepository ports.StatsRepository, emailGateway ports.EmailGateway, storage *db.Storage, cfg BacklogCfg) ports.BacklogService {
+	return &backlog{
+		statsRepository: statsRepository,
+		emailGateway:    emailGateway,
+		storage:         storage,
+		cfg:             cfg,
+	}
+}
+
+// Check returns an ErrBacklogDegraded error describing the crossed thresholds if the backlog is over any of them.
+// It has the signature of a health.Pinger, so the backlog can be reported by the health endpoint.
+// An alert is raised when the backlog becomes degraded and when it recovers.
+func (b *backlog) Check(ctx context.Context) error {
+	current, err := b.statsRepository.Backlog(ctx, b.storage.Pgx)
+	if err != nil {
+		return fmt.Errorf("getting backlog: %w", err)
+	}
+
+	exceeded := current.Exceeded(b.cfg.Thresholds, time.Now())
+	degraded := len(exceeded) > 0
+
+	b.mu.Lock()
+	changed := degraded != b.degraded
+	b.degraded = degraded
+	b.mu.Unlock()
+
+	if changed {
+		// The health monitor pings with its lock held, so the email must not delay it
+		go b.alert(ctx, current, exceeded)
+	}
+
+	if degraded {
+		return fmt.Errorf("%w: %s", ErrBacklogDegraded, strings.Join(exceeded, ", "))
+	}
+	return nil
+}
+
+func (b *backlog) alert(ctx context.Context, current *domain.Backlog, exceeded []string) {
+	var subject string
+	if len(exceeded) > 0 {
+		subject = "Issuer node pending backlog is degraded"
+		log.Warn(ctx, "pending backlog is over its thresholds", "exceeded", exceeded)
+	} else {
+		subject = "Issuer node pending backlog has recovered"
+		log.Info(ctx, "pending backlog is back under its thresholds")
+	}
+
+	if b.emailGateway == nil || len(b.cfg.AlertRecipients) == 0 {
+		return
+	}
+	if b.cfg.Settings != nil && !b.cfg.Settings.Current(ctx).Notifications.BacklogAlerts {
+		return
+	}
+
+	var body strings.Builder
+	for _, e := range exceeded {
+		fmt.Fprintf(&body, "Over the threshold: %s\n", e)
+	}
+	fmt.Fprintf(&body, "Unpublished claims: %d\n", current.UnpublishedClaims)
+	fmt.Fprintf(&body, "Pending retries: %d\n", current.PendingRetries)
+	fmt.Fprintf(&body, "Oldest pending action waiting for: %s\n", current.OldestPendingAge(time.Now()).Truncate(time.Second))
+
+	err := b.emailGateway.Send(ctx, &ports.Email{
+		To:          b.cfg.AlertRecipients,
+		Subject:     subject,
+		ContentType: "text/plain",
+		Body:        []byte(body.String()),
+	})
+	if err != nil {
+		log.Error(ctx, "sending backlog alert", "err", err, "recipients", b.cfg.AlertRecipients)
+	}
+}
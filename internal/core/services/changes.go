@@ -0,0 +1,52 @@
+package services
+
+import (
+	"context"
+
+	core "github.com/iden3/go-iden3-core"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+const (
+	defaultChangesLimit = 100  // defaultChangesLimit is the number of changes returned when the request does not say it
+	maxChangesLimit     = 1000 // maxChangesLimit is the maximum number of changes returned at once
+)
+
+type changes struct {
+	repo    ports.ChangeRepository
+	storage *db.Storage
+}
+
+// NewChanges returns a new changes feed service
+func NewChanges(repo ports.ChangeRepository, storage *db.Storage) ports.ChangeService {
+	return &changes{repo: repo, storage: storage}
+}
+
+// GetSince returns up to limit changes of the issuer after the since cursor, and the cursor to continue from. The
+// cursor does not move when there are no new changes.
+func (c *changes) GetSince(ctx context.Context, issuerDID core.DID, since string, limit int) ([]domain.Change, domain.ChangeCursor, error) {
+	cursor, err := domain.ParseChangeCursor(since)
+	if err != nil {
+		return nil, domain.ChangeCursor{}, err
+	}
+	if limit <= 0 {
+		limit = defaultChangesLimit
+	}
+	if limit > maxChangesLimit {
+		limit = maxChangesLimit
+	}
+
+	result, err := c.repo.GetSince(ctx, c.storage.Replica, issuerDID, cursor, limit)
+	if err != nil {
+		log.Error(ctx, "loading changes", "err", err, "since", since)
+		return nil, domain.ChangeCursor{}, err
+	}
+	if len(result) > 0 {
+		cursor = result[len(result)-1].Cursor
+	}
+	return result, cursor, nil
+}
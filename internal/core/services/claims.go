@@ -24,10 +24,11 @@ import (
 	"github.com/polygonid/sh-id-platform/internal/core/event"
 	"github.com/polygonid/sh-id-platform/internal/core/ports"
 	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/jsonschema"
 	"github.com/polygonid/sh-id-platform/internal/loader"
 	"github.com/polygonid/sh-id-platform/internal/log"
 	"github.com/polygonid/sh-id-platform/internal/repositories"
-	"github.com/polygonid/sh-id-platform/pkg/pubsub"
+	"github.com/polygonid/sh-id-platform/pkg/cache"
 	"github.com/polygonid/sh-id-platform/pkg/rand"
 	schemaPkg "github.com/polygonid/sh-id-platform/pkg/schema"
 )
@@ -42,13 +43,42 @@ var (
 	ErrProcessSchema            = errors.New("cannot process schema")                                 // ErrProcessSchema Cannot process schema
 	ErrParseClaim               = errors.New("cannot parse claim")                                    // ErrParseClaim Cannot parse claim
 	ErrInvalidCredentialSubject = errors.New("credential subject does not match the provided schema") // ErrInvalidCredentialSubject means the credentialSubject does not match the schema provided
+	ErrCredentialOfferNotFound  = errors.New("unknown credential offer")                              // ErrCredentialOfferNotFound The credential was not offered in the fetch request thread
+	ErrCredentialOfferExpired   = errors.New("credential offer expired")                              // ErrCredentialOfferExpired The credential offer TTL elapsed
+	ErrCredentialOfferConsumed  = errors.New("credential offer already used")                         // ErrCredentialOfferConsumed The credential was already fetched with this offer
+	ErrAgentMessageInProgress   = errors.New("agent message already being processed")                 // ErrAgentMessageInProgress A replay of the message arrived before the original was answered
+	ErrAgentSenderNotVerified   = errors.New("the message must prove who its sender is")              // ErrAgentSenderNotVerified A message asking for credentials came in an envelope without a proof of the sender
+	ErrInvalidExpiration        = errors.New("invalid credential expiration")                         // ErrInvalidExpiration The expiration of the credential is set twice or the expiration days are not positive
+	ErrRevNonceCollision        = errors.New("cannot find a free revocation nonce")                   // ErrRevNonceCollision Every revocation nonce tried for the credential was already used by the issuer
+	ErrCredentialRevoked        = errors.New("the credential is revoked")                             // ErrCredentialRevoked A revoked credential can not be offered again
+	ErrCredentialWithoutHolder  = errors.New("the credential has no holder to notify")                // ErrCredentialWithoutHolder The offer of a credential without subject can not be pushed
+)
+
+const (
+	expiredBatchSize           = 100                  // expiredBatchSize is the maximum number of expired credentials loaded at once
+	revocationBatchSize        = 1000                 // revocationBatchSize is the maximum number of nonces whose revocation tree nodes are kept in memory
+	expirationRevocationReason = "credential expired" // expirationRevocationReason is the revocation description used by the expiration policy
+	defaultOfferTTL            = 24 * time.Hour       // defaultOfferTTL is the credential offer TTL when it is not configured
+	revNonceMaxAttempts        = 5                    // revNonceMaxAttempts is the maximum number of revocation nonces tried for a credential
 )
 
 // ClaimCfg claim service configuration
 type ClaimCfg struct {
-	RHSEnabled bool // ReverseHash Enabled
-	RHSUrl     string
-	Host       string
+	RHSEnabled        bool // ReverseHash Enabled
+	RHSUrl            string
+	Host              string
+	OfferTTL          time.Duration                     // Time a credential offer is valid
+	Features          ports.FeatureFlagService          // Per identity features. If nil, RHSEnabled applies to every identity
+	Validation        ports.CredentialValidationService // Validation webhooks of the schemas. If nil, credentials are not validated externally
+	Schemas           ports.SchemaRepository            // Imported schemas, with their extra contexts and types. If nil, only the ones of the request are added
+	AgentReplayWindow time.Duration                     // Time the agent messages are remembered to answer their replays with the original response. 0 disables it
+	ProofPolicy       *domain.ProofPolicy               // Default and allowed proof types. If nil, credentials are issued with both proofs by default and any proof type is allowed
+	SubjectDIDs       ports.SubjectDIDService           // Checks the subject DIDs with a resolver. If nil, they are only checked to be well-formed
+	Profiles          ports.IssuerProfileService        // Profiles of the issuers, their contact page is proposed to the holders. If nil, proposal requests get no proposals
+	StateCache        cache.Cache                       // Cache of the latest states and revocation statuses, shared by the processes that confirm states and revoke credentials. If nil, nothing is cached
+	StateCacheTTL     time.Duration                     // Time a latest state or a revocation status is cached. 0 disables it, but the cached states are still invalidated
+	RevNonceStrategy  domain.RevNonceStrategy           // How the revocation nonces of the new credentials are chosen. If empty, they are random
+	Settings          ports.SettingsService             // Runtime settings, they override RHSUrl. If nil, RHSUrl applies
 }
 
 type claim struct {
@@ -59,16 +89,38 @@ type claim struct {
 	identityStateRepository ports.IdentityStateRepository
 	storage                 *db.Storage
 	loaderFactory           loader.Factory
-	publisher               pubsub.Publisher
+	outboxRepository        ports.EventOutboxRepository
+	offerRepository         ports.CredentialOfferRepository
+	agentMessageRepository  ports.AgentMessageRepository
+	linkFunnelRepository    ports.LinkFunnelRepository
+	historyRepository       ports.CredentialHistoryRepository
+	problemReportRepository ports.ProblemReportRepository
+	states                  *stateCache
 }
 
 // NewClaim creates a new claim service
-func NewClaim(repo ports.ClaimsRepository, idenSrv ports.IdentityService, mtService ports.MtService, identityStateRepository ports.IdentityStateRepository, ld loader.Factory, storage *db.Storage, cfg ClaimCfg, ps pubsub.Publisher) ports.ClaimsService {
+func NewClaim(repo ports.ClaimsRepository, idenSrv ports.IdentityService, mtService ports.MtService, identityStateRepository ports.IdentityStateRepository, ld loader.Factory, storage *db.Storage, cfg ClaimCfg) ports.ClaimsService {
+	proofPolicy := cfg.ProofPolicy
+	if proofPolicy == nil {
+		proofPolicy = &domain.ProofPolicy{Default: domain.ProofTypes{Signature: true, MTP: true}}
+	}
 	s := &claim{
 		cfg: ClaimCfg{
-			RHSEnabled: cfg.RHSEnabled,
-			RHSUrl:     cfg.RHSUrl,
-			Host:       cfg.Host,
+			RHSEnabled:        cfg.RHSEnabled,
+			RHSUrl:            cfg.RHSUrl,
+			Host:              cfg.Host,
+			OfferTTL:          cfg.OfferTTL,
+			Features:          cfg.Features,
+			Validation:        cfg.Validation,
+			Schemas:           cfg.Schemas,
+			AgentReplayWindow: cfg.AgentReplayWindow,
+			ProofPolicy:       proofPolicy,
+			SubjectDIDs:       cfg.SubjectDIDs,
+			Profiles:          cfg.Profiles,
+			StateCache:        cfg.StateCache,
+			StateCacheTTL:     cfg.StateCacheTTL,
+			RevNonceStrategy:  cfg.RevNonceStrategy,
+			Settings:          cfg.Settings,
 		},
 		icRepo:                  repo,
 		identitySrv:             idenSrv,
@@ -76,7 +128,13 @@ func NewClaim(repo ports.ClaimsRepository, idenSrv ports.IdentityService, mtServ
 		identityStateRepository: identityStateRepository,
 		storage:                 storage,
 		loaderFactory:           ld,
-		publisher:               ps,
+		outboxRepository:        repositories.NewEventOutbox(),
+		offerRepository:         repositories.NewCredentialOffer(),
+		agentMessageRepository:  repositories.NewAgentMessage(),
+		linkFunnelRepository:    repositories.NewLinkFunnel(),
+		historyRepository:       repositories.NewCredentialHistory(),
+		problemReportRepository: repositories.NewProblemReport(),
+		states:                  &stateCache{cache: cfg.StateCache, ttl: cfg.StateCacheTTL},
 	}
 	return s
 }
@@ -86,19 +144,23 @@ func NewClaim(repo ports.ClaimsRepository, idenSrv ports.IdentityService, mtServ
 // 2.- Signature proof
 // 3.- MerkelTree proof
 func (c *claim) Save(ctx context.Context, req *ports.CreateClaimRequest) (*domain.Claim, error) {
-	claim, err := c.CreateCredential(ctx, req)
-	if err != nil {
-		return nil, err
-	}
-	claim.ID, err = c.icRepo.Save(ctx, c.storage.Pgx, claim)
-	if err != nil {
-		return nil, err
-	}
-	if req.SignatureProof {
-		err = c.publisher.Publish(ctx, event.CreateCredentialEvent, &event.CreateCredential{CredentialIDs: []string{claim.ID.String()}, IssuerID: req.DID.String()})
+	var claim *domain.Claim
+	err := withFreeRevNonce(ctx, func() error {
+		var err error
+		claim, err = c.CreateCredential(ctx, req)
 		if err != nil {
-			log.Error(ctx, "publish CreateCredentialEvent", "err", err.Error(), "credential", claim.ID.String())
+			return err
 		}
+		return c.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+			claim.ID, err = c.icRepo.Save(ctx, tx, claim)
+			if err != nil || !req.SignatureProof {
+				return err
+			}
+			return notifyInTx(ctx, tx, c.outboxRepository, event.CreateCredentialEvent, req.DID.String(), &event.CreateCredential{CredentialIDs: []string{claim.ID.String()}, IssuerID: req.DID.String()})
+		})
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return claim, nil
@@ -106,12 +168,22 @@ func (c *claim) Save(ctx context.Context, req *ports.CreateClaimRequest) (*domai
 
 // CreateCredential - Create a new Credential, but this method doesn't save it in the repository.
 func (c *claim) CreateCredential(ctx context.Context, req *ports.CreateClaimRequest) (*domain.Claim, error) {
-	if err := c.guardCreateClaimRequest(req); err != nil {
+	importedSchema, err := c.activeSchema(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.guardCreateClaimRequest(req, importedSchema); err != nil {
 		log.Warn(ctx, "validating create claim request", "req", req)
 		return nil, err
 	}
 
-	nonce, err := rand.Int64()
+	if err := c.credentialExpiration(req, importedSchema, time.Now().UTC()); err != nil {
+		log.Warn(ctx, "invalid credential expiration", "err", err, "schema", req.Schema, "type", req.Type)
+		return nil, err
+	}
+
+	nonce, err := c.revNonce(ctx, req.DID)
 	if err != nil {
 		log.Error(ctx, "create a nonce", "err", err)
 		return nil, err
@@ -129,12 +201,26 @@ func (c *claim) CreateCredential(ctx context.Context, req *ports.CreateClaimRequ
 		return nil, ErrJSONLdContext
 	}
 
+	if err := c.validateCredentialSubject(ctx, req); err != nil {
+		return nil, err
+	}
+
+	if err := c.checkSubjectDID(ctx, req); err != nil {
+		return nil, err
+	}
+
+	extensions, err := c.credentialExtensions(ctx, req, importedSchema)
+	if err != nil {
+		return nil, err
+	}
+
 	vcID, err := uuid.NewUUID()
 	if err != nil {
 		return nil, err
 	}
 
-	vc, err := c.createVC(req, vcID, jsonLdContext, nonce)
+	rhsEnabled := c.rhsEnabled(ctx, *req.DID)
+	vc, err := c.createVC(ctx, req, vcID, jsonLdContext, extensions, nonce, rhsEnabled)
 	if err != nil {
 		log.Error(ctx, "creating verifiable credential", "err", err)
 		return nil, err
@@ -167,6 +253,12 @@ func (c *claim) CreateCredential(ctx context.Context, req *ports.CreateClaimRequ
 		return nil, err
 	}
 
+	if c.cfg.Validation != nil {
+		if err := c.cfg.Validation.Validate(ctx, req); err != nil {
+			return nil, err
+		}
+	}
+
 	claim, err := domain.FromClaimer(coreClaim, req.Schema, credentialType)
 	if err != nil {
 		log.Error(ctx, "cannot obtain the claim from claimer", "err", err)
@@ -191,7 +283,7 @@ func (c *claim) CreateCredential(ctx context.Context, req *ports.CreateClaimRequ
 			return nil, err
 		}
 
-		proof.IssuerData.CredentialStatus = c.getRevocationSource(issuerDIDString, uint64(authClaim.RevNonce), req.SingleIssuer)
+		proof.IssuerData.CredentialStatus = c.getRevocationSource(ctx, issuerDIDString, uint64(authClaim.RevNonce), req.SingleIssuer, rhsEnabled)
 
 		jsonSignatureProof, err := json.Marshal(proof)
 		if err != nil {
@@ -205,7 +297,14 @@ func (c *claim) CreateCredential(ctx context.Context, req *ports.CreateClaimRequ
 		}
 	}
 
-	err = claim.Data.Set(vc)
+	credential := domain.RefreshableCredential{W3CCredential: vc}
+	if req.RefreshService {
+		credential.RefreshService = &domain.RefreshService{
+			ID:   fmt.Sprintf("%s/v1/agent", strings.TrimSuffix(c.cfg.Host, "/")),
+			Type: domain.Iden3RefreshService2023,
+		}
+	}
+	err = claim.Data.Set(credential)
 	if err != nil {
 		log.Error(ctx, "cannot set the credential", "err", err)
 		return nil, err
@@ -222,8 +321,12 @@ func (c *claim) CreateCredential(ctx context.Context, req *ports.CreateClaimRequ
 	return claim, nil
 }
 
-func (c *claim) Revoke(ctx context.Context, id core.DID, nonce uint64, description string) error {
-	return c.revoke(ctx, &id, nonce, description, c.storage.Pgx)
+func (c *claim) Revoke(ctx context.Context, id core.DID, nonce uint64, reason domain.RevocationReason, description string) error {
+	if err := c.revoke(ctx, &id, nonce, reason, description, c.storage.Pgx); err != nil {
+		return err
+	}
+	c.states.invalidate(ctx, id)
+	return nil
 }
 
 func (c *claim) RevokeAllFromConnection(ctx context.Context, connID uuid.UUID, issuerID core.DID) error {
@@ -232,16 +335,20 @@ func (c *claim) RevokeAllFromConnection(ctx context.Context, connID uuid.UUID, i
 		return err
 	}
 
-	return c.storage.Pgx.BeginFunc(ctx,
-		func(tx pgx.Tx) error {
-			for _, credential := range credentials {
-				err := c.revoke(ctx, &issuerID, uint64(credential.RevNonce), "", tx)
-				if err != nil {
-					return err
-				}
-			}
-			return nil
-		})
+	nonces := make([]domain.RevNonceUint64, len(credentials))
+	for i, credential := range credentials {
+		nonces[i] = credential.RevNonce
+	}
+	err = c.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+		return c.revokeBatch(ctx, &issuerID, nonces, domain.RevocationUnspecified, "", tx)
+	})
+	if err != nil {
+		return err
+	}
+	if len(nonces) > 0 {
+		c.states.invalidate(ctx, issuerID)
+	}
+	return nil
 }
 
 func (c *claim) Delete(ctx context.Context, id uuid.UUID) error {
@@ -256,6 +363,27 @@ func (c *claim) Delete(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
+// Restore undoes the deletion of a claim of the issuer that was not purged yet
+func (c *claim) Restore(ctx context.Context, issuerDID core.DID, id uuid.UUID) error {
+	err := c.icRepo.Restore(ctx, c.storage.Pgx, issuerDID, id)
+	if errors.Is(err, repositories.ErrClaimDoesNotExist) {
+		return ErrClaimNotFound
+	}
+	return err
+}
+
+// PurgeDeleted removes for good the claims deleted longer than the retention ago
+func (c *claim) PurgeDeleted(ctx context.Context, retention time.Duration) error {
+	purged, err := c.icRepo.PurgeDeleted(ctx, c.storage.Pgx, time.Now().Add(-retention))
+	if err != nil {
+		return err
+	}
+	if purged > 0 {
+		log.Info(ctx, "purged deleted credentials", "purged", purged)
+	}
+	return nil
+}
+
 func (c *claim) GetByID(ctx context.Context, issID *core.DID, id uuid.UUID) (*domain.Claim, error) {
 	claim, err := c.icRepo.GetByIdAndIssuer(ctx, c.storage.Pgx, issID, id)
 	if err != nil {
@@ -268,6 +396,26 @@ func (c *claim) GetByID(ctx context.Context, issID *core.DID, id uuid.UUID) (*do
 	return claim, nil
 }
 
+// GetByRevocationNonce returns the credential of the issuer with the revocation nonce
+func (c *claim) GetByRevocationNonce(ctx context.Context, issID *core.DID, nonce uint64) (*domain.Claim, error) {
+	claim, err := c.icRepo.GetByRevocationNonce(ctx, c.storage.Pgx, issID, domain.RevNonceUint64(nonce))
+	if err != nil {
+		if errors.Is(err, repositories.ErrClaimDoesNotExist) {
+			return nil, ErrClaimNotFound
+		}
+		return nil, err
+	}
+	return c.GetByID(ctx, issID, claim.ID)
+}
+
+// GetHistory returns the events of the credential of the issuer, from its issuance to its revocation, in order.
+func (c *claim) GetHistory(ctx context.Context, issuerDID core.DID, id uuid.UUID) ([]domain.CredentialEvent, error) {
+	if _, err := c.GetByID(ctx, &issuerDID, id); err != nil {
+		return nil, err
+	}
+	return c.historyRepository.GetByCredentialID(ctx, c.storage.Pgx, issuerDID, id)
+}
+
 func (c *claim) Agent(ctx context.Context, req *ports.AgentRequest) (*domain.Agent, error) {
 	exists, err := c.identitySrv.Exists(ctx, *req.IssuerDID)
 	if err != nil {
@@ -280,7 +428,65 @@ func (c *claim) Agent(ctx context.Context, req *ports.AgentRequest) (*domain.Age
 		return nil, fmt.Errorf("cannot proceed with this identity, not found")
 	}
 
-	return c.getAgentCredential(ctx, req) // at this point the type is already validated
+	// the problem reports have no answer to replay
+	if c.cfg.AgentReplayWindow == 0 || req.Type == ports.ProblemReportMessageType {
+		return c.processAgentMessage(ctx, req)
+	}
+
+	message := &domain.AgentMessage{
+		IssuerDID: *req.IssuerDID,
+		Sender:    req.UserDID.String(),
+		ID:        req.ID,
+		ThreadID:  req.ThreadID,
+		Type:      string(req.Type),
+		CreatedAt: time.Now().UTC(),
+	}
+	original, err := c.agentMessageRepository.Reserve(ctx, c.storage.Pgx, message, message.CreatedAt.Add(-c.cfg.AgentReplayWindow))
+	if err != nil {
+		log.Error(ctx, "reserving agent message", "err", err, "id", req.ID)
+		return nil, err
+	}
+	if original != nil {
+		log.Info(ctx, "agent message replayed", "id", req.ID, "thid", req.ThreadID, "originalID", original.ID)
+		if original.Response == nil {
+			return nil, ErrAgentMessageInProgress
+		}
+		return original.Response, nil
+	}
+
+	message.Response, err = c.processAgentMessage(ctx, req)
+	if err != nil {
+		if err := c.agentMessageRepository.Delete(ctx, c.storage.Pgx, message); err != nil {
+			log.Error(ctx, "releasing agent message", "err", err, "id", req.ID)
+		}
+		return nil, err
+	}
+	if err := c.agentMessageRepository.SaveResponse(ctx, c.storage.Pgx, message); err != nil {
+		log.Error(ctx, "saving agent message response", "err", err, "id", req.ID)
+	}
+	return message.Response, nil
+}
+
+func (c *claim) processAgentMessage(ctx context.Context, req *ports.AgentRequest) (*domain.Agent, error) {
+	// at this point the type is already validated
+	switch req.Type {
+	case protocol.RevocationStatusRequestMessageType:
+		return c.getAgentRevocationStatus(ctx, req)
+	case ports.CredentialProposalRequestMessageType:
+		return c.getAgentProposal(ctx, req)
+	case ports.ProblemReportMessageType:
+		return nil, c.receiveProblemReport(ctx, req)
+	}
+
+	// the credentials are only sent to the holder that proves to be their subject
+	if !req.Authenticated() {
+		log.Warn(ctx, "agent credential request without a proof of the sender", "mediaType", req.MediaType, "type", req.Type)
+		return nil, ErrAgentSenderNotVerified
+	}
+	if req.Type == ports.CredentialRefreshRequestMessageType {
+		return c.getAgentRefreshedCredential(ctx, req)
+	}
+	return c.getAgentCredential(ctx, req)
 }
 
 func (c *claim) GetAuthClaim(ctx context.Context, did *core.DID) (*domain.Claim, error) {
@@ -292,25 +498,93 @@ func (c *claim) GetAuthClaim(ctx context.Context, did *core.DID) (*domain.Claim,
 }
 
 func (c *claim) GetAll(ctx context.Context, did core.DID, filter *ports.ClaimsFilter) ([]*domain.Claim, error) {
-	claims, err := c.icRepo.GetAllByIssuerID(ctx, c.storage.Pgx, did, filter)
+	ctx, cancel := c.storage.ListingContext(ctx)
+	defer cancel()
+	claims, err := c.icRepo.GetAllByIssuerID(ctx, c.storage.Replica, did, filter)
 	if err != nil {
 		if errors.Is(err, repositories.ErrClaimDoesNotExist) {
 			return nil, ErrClaimNotFound
 		}
-		return nil, err
+		return nil, c.storage.QueryError(ctx, "claims.GetAll", err)
 	}
 
 	return claims, nil
 }
 
+// GetAllDisplay returns the display of the claims that match the filter, for the listings that don't need the
+// credentials in W3C form
+func (c *claim) GetAllDisplay(ctx context.Context, did core.DID, filter *ports.ClaimsFilter) ([]*domain.CredentialRow, error) {
+	ctx, cancel := c.storage.ListingContext(ctx)
+	defer cancel()
+	credentials, err := c.icRepo.GetAllDisplayByIssuerID(ctx, c.storage.Replica, did, filter)
+	if err != nil {
+		return nil, c.storage.QueryError(ctx, "claims.GetAllDisplay", err)
+	}
+	return credentials, nil
+}
+
+// GetSummary counts the claims that match the filter, all of them when the filter has a limit
+func (c *claim) GetSummary(ctx context.Context, did core.DID, filter *ports.ClaimsFilter) (*domain.CredentialsSummary, error) {
+	ctx, cancel := c.storage.ListingContext(ctx)
+	defer cancel()
+	summary, err := c.icRepo.GetSummaryByIssuerID(ctx, c.storage.Replica, did, filter)
+	if err != nil {
+		return nil, c.storage.QueryError(ctx, "claims.GetSummary", err)
+	}
+	return summary, nil
+}
+
+// Iterate calls fn for every claim that matches the filter without loading all of them in memory
+func (c *claim) Iterate(ctx context.Context, did core.DID, filter *ports.ClaimsFilter, fn func(*domain.Claim) error) error {
+	return c.icRepo.IterateByIssuerID(ctx, c.storage.Replica, did, filter, fn)
+}
+
+// GetRevocationStatus returns the revocation status of the nonce at the latest confirmed state of the issuer. The
+// latest state and the status at it are cached, see stateCache.
 func (c *claim) GetRevocationStatus(ctx context.Context, issuerDID core.DID, nonce uint64) (*verifiable.RevocationStatus, error) {
-	rID := new(big.Int).SetUint64(nonce)
-	revocationStatus := &verifiable.RevocationStatus{}
+	state, err := c.getLatestState(ctx, issuerDID)
+	if err != nil {
+		return nil, err
+	}
+
+	var key string
+	if state.State != nil {
+		key = revocationStatusKey(issuerDID, *state.State, nonce)
+		revocationStatus := &verifiable.RevocationStatus{}
+		if c.states.get(ctx, key, revocationStatus) {
+			return revocationStatus, nil
+		}
+	}
 
-	state, err := c.identityStateRepository.GetLatestStateByIdentifier(ctx, c.storage.Pgx, &issuerDID)
+	revocationStatus, err := c.revocationStatus(ctx, issuerDID, state, nonce)
 	if err != nil {
 		return nil, err
 	}
+	if key != "" {
+		c.states.set(ctx, key, revocationStatus)
+	}
+	return revocationStatus, nil
+}
+
+// getLatestState returns the latest confirmed state of the identity, from the cache if it is there
+func (c *claim) getLatestState(ctx context.Context, did core.DID) (*domain.IdentityState, error) {
+	key := latestStateKey(did, c.states.version(ctx, did))
+	state := &domain.IdentityState{}
+	if c.states.get(ctx, key, state) {
+		return state, nil
+	}
+	state, err := c.identityStateRepository.GetLatestStateByIdentifier(ctx, c.storage.Pgx, &did)
+	if err != nil {
+		return nil, err
+	}
+	c.states.set(ctx, key, state)
+	return state, nil
+}
+
+// revocationStatus generates the revocation or non revocation proof of the nonce in the revocation tree of the state
+func (c *claim) revocationStatus(ctx context.Context, issuerDID core.DID, state *domain.IdentityState, nonce uint64) (*verifiable.RevocationStatus, error) {
+	rID := new(big.Int).SetUint64(nonce)
+	revocationStatus := &verifiable.RevocationStatus{}
 
 	revocationStatus.Issuer.State = state.State
 	revocationStatus.Issuer.ClaimsTreeRoot = state.ClaimsTreeRoot
@@ -318,8 +592,7 @@ func (c *claim) GetRevocationStatus(ctx context.Context, issuerDID core.DID, non
 	revocationStatus.Issuer.RootOfRoots = state.RootOfRoots
 
 	if state.RevocationTreeRoot == nil {
-		var mtp *merkletree.Proof
-		mtp, err = merkletree.NewProofFromData(false, nil, nil)
+		mtp, err := merkletree.NewProofFromData(false, nil, nil)
 		if err != nil {
 			return nil, err
 		}
@@ -386,11 +659,25 @@ func (c *claim) UpdateClaimsMTPAndState(ctx context.Context, currentState *domai
 		return err
 	}
 
-	claims, err := c.icRepo.GetAllByStateWithMTProof(ctx, c.storage.Pgx, did, currState)
+	err = c.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+		return c.updateClaimsMTPAndState(ctx, tx, did, claimsTree, currState, currentState)
+	})
+	if err != nil {
+		return err
+	}
+	c.states.invalidate(ctx, *did)
+	return nil
+}
+
+// updateClaimsMTPAndState adds the MTP proofs of the state to its claims, and notifies the holders that their
+// credentials are ready
+func (c *claim) updateClaimsMTPAndState(ctx context.Context, tx pgx.Tx, did *core.DID, claimsTree *merkletree.MerkleTree, currState *merkletree.Hash, currentState *domain.IdentityState) error {
+	claims, err := c.icRepo.GetAllByStateWithMTProof(ctx, tx, did, currState)
 	if err != nil {
 		return err
 	}
 
+	credentials := make(map[string][]string)
 	for i := range claims {
 		var index *big.Int
 		var coreClaimHex string
@@ -437,7 +724,7 @@ func (c *claim) UpdateClaimsMTPAndState(ctx context.Context, currentState *domai
 		if err != nil {
 			return fmt.Errorf("failed set mtp proof: %w", err)
 		}
-		affected, err = c.icRepo.UpdateClaimMTP(ctx, c.storage.Pgx, &claims[i])
+		affected, err = c.icRepo.UpdateClaimMTP(ctx, tx, &claims[i])
 
 		if err != nil {
 			return fmt.Errorf("can't update claim mtp:  %w", err)
@@ -445,12 +732,19 @@ func (c *claim) UpdateClaimsMTPAndState(ctx context.Context, currentState *domai
 		if affected == 0 {
 			return fmt.Errorf("claim has not been updated %v", claims[i])
 		}
+		credentials[claims[i].OtherIdentifier] = append(credentials[claims[i].OtherIdentifier], claims[i].ID.String())
 	}
-	_, err = c.identityStateRepository.UpdateState(ctx, c.storage.Pgx, currentState)
+	_, err = c.identityStateRepository.UpdateState(ctx, tx, currentState)
 	if err != nil {
 		return fmt.Errorf("can't update identity state: %w", err)
 	}
 
+	for _, ids := range credentials {
+		err = notifyInTx(ctx, tx, c.outboxRepository, event.CreateCredentialEvent, currentState.Identifier, &event.CreateCredential{CredentialIDs: ids, IssuerID: currentState.Identifier})
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -458,13 +752,104 @@ func (c *claim) GetByStateIDWithMTPProof(ctx context.Context, did *core.DID, sta
 	return c.icRepo.GetByStateIDWithMTPProof(ctx, c.storage.Pgx, did, state)
 }
 
-func (c *claim) revoke(ctx context.Context, did *core.DID, nonce uint64, description string, pgx db.Querier) error {
+// ProcessExpired marks as expired the credentials past their expiration date, revoking them when their schema policy
+// says so. The holders are notified with a CredentialExpiredEvent written in the transaction that marks them.
+func (c *claim) ProcessExpired(ctx context.Context) error {
+	for {
+		expired, err := c.icRepo.GetExpired(ctx, c.storage.Pgx, time.Now(), expiredBatchSize)
+		if err != nil {
+			log.Error(ctx, "loading expired credentials", "err", err)
+			return err
+		}
+
+		processed := c.processExpired(ctx, expired)
+		log.Info(ctx, "expired credentials processed", "found", len(expired), "processed", processed)
+
+		// Stop when there is nothing left or when a whole batch failed, as the next one would return the same credentials
+		if len(expired) < expiredBatchSize || processed == 0 {
+			return nil
+		}
+	}
+}
+
+func (c *claim) processExpired(ctx context.Context, expired []*domain.ExpiredClaim) int {
+	processed := 0
+	events := make(map[string]*event.CredentialExpired)
+	toMark := make([]uuid.UUID, 0, len(expired))
+	for _, credential := range expired {
+		if !credential.AutoRevoke || credential.Revoked {
+			ev, found := events[credential.Issuer]
+			if !found {
+				ev = &event.CredentialExpired{IssuerID: credential.Issuer}
+				events[credential.Issuer] = ev
+			}
+			toMark = append(toMark, credential.ID)
+			ev.CredentialIDs = append(ev.CredentialIDs, credential.ID.String())
+			continue
+		}
+
+		if err := c.revokeExpired(ctx, credential); err != nil {
+			log.Error(ctx, "revoking expired credential", "err", err, "id", credential.ID, "issuer", credential.Issuer)
+			continue
+		}
+		processed++
+	}
+
+	if len(toMark) == 0 {
+		return processed
+	}
+	err := c.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+		if _, err := c.icRepo.MarkAsExpired(ctx, tx, toMark); err != nil {
+			return err
+		}
+		for _, ev := range events {
+			if err := notifyInTx(ctx, tx, c.outboxRepository, event.CredentialExpiredEvent, ev.IssuerID, ev); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error(ctx, "marking credentials as expired", "err", err)
+		return processed
+	}
+
+	return processed + len(toMark)
+}
+
+// revokeExpired revokes the credential, marks it as expired and notifies the holder in the same transaction
+func (c *claim) revokeExpired(ctx context.Context, credential *domain.ExpiredClaim) error {
+	issuerDID, err := core.ParseDID(credential.Issuer)
+	if err != nil {
+		return err
+	}
+
+	err = c.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+		if err := c.revoke(ctx, issuerDID, uint64(credential.RevNonce), domain.RevocationExpired, expirationRevocationReason, tx); err != nil {
+			return err
+		}
+		if _, err := c.icRepo.MarkAsExpired(ctx, tx, []uuid.UUID{credential.ID}); err != nil {
+			return err
+		}
+		id := credential.ID.String()
+		ev := &event.CredentialExpired{IssuerID: credential.Issuer, CredentialIDs: []string{id}, RevokedCredentialIDs: []string{id}}
+		return notifyInTx(ctx, tx, c.outboxRepository, event.CredentialExpiredEvent, credential.Issuer, ev)
+	})
+	if err != nil {
+		return err
+	}
+	c.states.invalidate(ctx, *issuerDID)
+	return nil
+}
+
+func (c *claim) revoke(ctx context.Context, did *core.DID, nonce uint64, reason domain.RevocationReason, description string, pgx db.Querier) error {
 	rID := new(big.Int).SetUint64(nonce)
 	revocation := domain.Revocation{
 		Identifier:  did.String(),
 		Nonce:       domain.RevNonceUint64(nonce),
 		Version:     0,
 		Status:      0,
+		Reason:      reason,
 		Description: description,
 	}
 
@@ -488,13 +873,53 @@ func (c *claim) revoke(ctx context.Context, did *core.DID, nonce uint64, descrip
 		return fmt.Errorf("error getting the claim by revocation nonce: %w", err)
 	}
 
+	// The revocation is stored before the claim is marked as revoked, so the revoked event carries its reason.
+	if err := c.icRepo.RevokeNonce(ctx, pgx, &revocation); err != nil {
+		return err
+	}
+
 	claim.Revoked = true
 	_, err = c.icRepo.Save(ctx, pgx, claim)
 	if err != nil {
 		return fmt.Errorf("error saving the claim: %w", err)
 	}
 
-	return c.icRepo.RevokeNonce(ctx, pgx, &revocation)
+	return nil
+}
+
+// revokeBatch revokes the nonces of the identity in chunks. The nodes of the revocation tree are computed in memory and
+// written with a statement per chunk, as the revocations and the revoked claims are, so the tree is not locked while
+// every nonce is added with its round trips. Revoking in a transaction revokes all the nonces or none.
+func (c *claim) revokeBatch(ctx context.Context, did *core.DID, nonces []domain.RevNonceUint64, reason domain.RevocationReason, description string, pgx db.Querier) error {
+	for start := 0; start < len(nonces); start += revocationBatchSize {
+		end := start + revocationBatchSize
+		if end > len(nonces) {
+			end = len(nonces)
+		}
+		chunk := nonces[start:end]
+
+		revNonces := make([]*big.Int, len(chunk))
+		for i, nonce := range chunk {
+			revNonces[i] = new(big.Int).SetUint64(uint64(nonce))
+		}
+		if err := c.mtService.AddRevocations(ctx, pgx, did, revNonces); err != nil {
+			return fmt.Errorf("revoking credentials %d to %d of %d: %w", start, end, len(nonces), err)
+		}
+
+		if err := c.icRepo.RevokeNonces(ctx, pgx, did, chunk, reason, description); err != nil {
+			return err
+		}
+
+		revoked, err := c.icRepo.MarkAsRevoked(ctx, pgx, did, chunk)
+		if err != nil {
+			return fmt.Errorf("error saving the claims: %w", err)
+		}
+		if revoked < int64(len(chunk)) {
+			return repositories.ErrClaimDoesNotExist
+		}
+	}
+
+	return nil
 }
 
 func (c *claim) getAgentCredential(ctx context.Context, basicMessage *ports.AgentRequest) (*domain.Agent, error) {
@@ -529,19 +954,199 @@ func (c *claim) getAgentCredential(ctx context.Context, basicMessage *ports.Agen
 		return nil, fmt.Errorf("failed to convert claim to  w3cCredential: %w", err)
 	}
 
+	if err := c.consumeOffer(ctx, *basicMessage.IssuerDID, basicMessage.ThreadID, claim.ID); err != nil {
+		log.Warn(ctx, "credential offer rejected", "err", err, "claimID", claim.ID, "thid", basicMessage.ThreadID)
+		if claim.LinkID != nil {
+			recordLinkFunnel(ctx, c.linkFunnelRepository, c.storage, domain.NewLinkFunnelFailure(*basicMessage.IssuerDID, *claim.LinkID, "", linkFunnelReason(err)))
+		}
+		return nil, err
+	}
+	if claim.LinkID != nil {
+		recordLinkFunnel(ctx, c.linkFunnelRepository, c.storage, domain.NewLinkFunnelEvent(*basicMessage.IssuerDID, *claim.LinkID, "", domain.LinkFunnelCredentialDelivered))
+	}
+
 	return &domain.Agent{
 		ID:       uuid.NewString(),
 		Typ:      packers.MediaTypePlainMessage,
 		Type:     protocol.CredentialIssuanceResponseMessageType,
 		ThreadID: basicMessage.ThreadID,
-		Body:     protocol.IssuanceMessageBody{Credential: *vc},
+		Body:     issuanceMessageBody{Credential: domain.RefreshableCredential{W3CCredential: *vc, RefreshService: claim.GetRefreshService()}},
 		From:     basicMessage.IssuerDID.String(),
 		To:       basicMessage.UserDID.String(),
 	}, err
 }
 
-func (c *claim) createVC(claimReq *ports.CreateClaimRequest, vcID uuid.UUID, jsonLdContext string, nonce uint64) (verifiable.W3CCredential, error) {
-	vCredential, err := c.newVerifiableCredential(claimReq, vcID, jsonLdContext, nonce) // create vc credential
+// CreateOffer registers a credential offer for the given credentials. The offer ID must be used as the thread id
+// of the offer message, so the holder fetch request can be matched with it.
+func (c *claim) CreateOffer(ctx context.Context, issuerDID core.DID, credentialIDs ...uuid.UUID) (*domain.CredentialOffer, error) {
+	offer := domain.NewCredentialOffer(issuerDID, c.offerTTL(), credentialIDs...)
+	if err := c.offerRepository.Save(ctx, c.storage.Pgx, offer); err != nil {
+		log.Error(ctx, "saving credential offer", "err", err, "issuerDID", issuerDID)
+		return nil, err
+	}
+	return offer, nil
+}
+
+// RenewOffer creates a new offer of an issued credential, with a new thread id, for the holders that lost the
+// original one. The credential is not issued again. With notify, the offer is pushed to the wallet of the holder by
+// the notifications service, through the push service of the connection of the holder.
+func (c *claim) RenewOffer(ctx context.Context, issuerDID core.DID, credentialID uuid.UUID, notify bool) (*domain.CredentialOffer, error) {
+	credential, err := c.GetByID(ctx, &issuerDID, credentialID)
+	if err != nil {
+		return nil, err
+	}
+	if credential.Revoked {
+		return nil, ErrCredentialRevoked
+	}
+	if notify && credential.OtherIdentifier == "" {
+		return nil, ErrCredentialWithoutHolder
+	}
+
+	offer := domain.NewCredentialOffer(issuerDID, c.offerTTL(), credential.ID)
+	err = c.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+		if err := c.offerRepository.Save(ctx, tx, offer); err != nil {
+			return err
+		}
+		if !notify {
+			return nil
+		}
+		return notifyInTx(ctx, tx, c.outboxRepository, event.CredentialOfferEvent, issuerDID.String(), &event.CredentialOffer{
+			OfferID:       offer.ID.String(),
+			CredentialIDs: []string{credential.ID.String()},
+			IssuerID:      issuerDID.String(),
+		})
+	})
+	if err != nil {
+		log.Error(ctx, "renewing credential offer", "err", err, "issuerDID", issuerDID, "credentialID", credentialID)
+		return nil, err
+	}
+	return offer, nil
+}
+
+func (c *claim) offerTTL() time.Duration {
+	if c.cfg.OfferTTL == 0 {
+		return defaultOfferTTL
+	}
+	return c.cfg.OfferTTL
+}
+
+func (c *claim) consumeOffer(ctx context.Context, issuerDID core.DID, threadID string, credentialID uuid.UUID) error {
+	offerID, err := uuid.Parse(threadID)
+	if err != nil {
+		return ErrCredentialOfferNotFound
+	}
+
+	err = c.offerRepository.Consume(ctx, c.storage.Pgx, issuerDID, offerID, credentialID, time.Now().UTC())
+	switch {
+	case errors.Is(err, repositories.ErrCredentialOfferNotFound):
+		return ErrCredentialOfferNotFound
+	case errors.Is(err, repositories.ErrCredentialOfferExpired):
+		return ErrCredentialOfferExpired
+	case errors.Is(err, repositories.ErrCredentialOfferConsumed):
+		return ErrCredentialOfferConsumed
+	}
+	return err
+}
+
+// validateCredentialSubject checks the credential subject of the request against the JSON Schema, so the attributes
+// that do not match it are reported before the credential is merklized. The subject is checked with the type added to
+// the credential.
+func (c *claim) validateCredentialSubject(ctx context.Context, req *ports.CreateClaimRequest) error {
+	schema, err := jsonschema.Load(ctx, c.loaderFactory(req.Schema))
+	if err != nil {
+		log.Error(ctx, "loading schema", "err", err, "schema", req.Schema)
+		return ErrLoadingSchema
+	}
+	subject := make(map[string]any, len(req.CredentialSubject)+1)
+	for k, v := range req.CredentialSubject {
+		subject[k] = v
+	}
+	subject["type"] = req.Type
+
+	err = schema.ValidateSubject(ctx, subject)
+	var subjectErr *jsonschema.SubjectError
+	if errors.As(err, &subjectErr) {
+		log.Warn(ctx, "credential subject does not match the schema", "err", err, "schema", req.Schema)
+		return fmt.Errorf("%w: %w", ErrInvalidCredentialSubject, subjectErr)
+	}
+	if err != nil {
+		log.Error(ctx, "validating credential subject", "err", err, "schema", req.Schema)
+		return ErrProcessSchema
+	}
+	return nil
+}
+
+// checkSubjectDID checks that the id of the credential subject, if any, is a DID that can be the subject of an iden3
+// claim. The DIDs of the other supported methods are reported as such, instead of failing when the claim is built.
+func (c *claim) checkSubjectDID(ctx context.Context, req *ports.CreateClaimRequest) error {
+	id, ok := req.CredentialSubject["id"]
+	if !ok {
+		return nil
+	}
+	s, ok := id.(string)
+	if !ok {
+		return fmt.Errorf("%w: the id of the credential subject must be a string", domain.ErrInvalidSubjectDID)
+	}
+
+	var subject *domain.SubjectDID
+	var err error
+	if c.cfg.SubjectDIDs != nil {
+		subject, err = c.cfg.SubjectDIDs.Check(ctx, s)
+	} else {
+		subject, err = domain.ParseSubjectDID(s)
+	}
+	if err != nil {
+		log.Warn(ctx, "invalid subject did", "err", err, "did", s)
+		return err
+	}
+	if !subject.IsIden3() {
+		return fmt.Errorf("%w: %s is a did:%s, the subject of the iden3 credentials must be a did:polygonid or did:iden3 identity", domain.ErrInvalidSubjectDID, s, subject.Method)
+	}
+	return nil
+}
+
+// activeSchema returns the active schema imported by the issuer with the url and type of the request, or nil if it was
+// not imported. ErrSchemaDeprecated is returned if the schema was imported and every import of it is deprecated.
+func (c *claim) activeSchema(ctx context.Context, req *ports.CreateClaimRequest) (*domain.Schema, error) {
+	if c.cfg.Schemas == nil {
+		return nil, nil
+	}
+	schemas, err := c.cfg.Schemas.GetByURL(ctx, *req.DID, req.Schema, req.Type)
+	if err != nil {
+		return nil, err
+	}
+	for i := range schemas {
+		if schemas[i].Status() == domain.SchemaStatusActive {
+			return &schemas[i], nil
+		}
+	}
+	if len(schemas) > 0 {
+		log.Warn(ctx, "issuing a credential of a deprecated schema", "schema", req.Schema, "type", req.Type)
+		return nil, ErrSchemaDeprecated
+	}
+	return nil, nil
+}
+
+// credentialExtensions returns the extra contexts and types of the imported schema of the credential, if any, followed
+// by the ones of the request. The ones of the request are validated, the ones of the schema were validated when they
+// were set.
+func (c *claim) credentialExtensions(ctx context.Context, req *ports.CreateClaimRequest, importedSchema *domain.Schema) (credentialExtensions, error) {
+	if err := validateCredentialExtensions(ctx, c.loaderFactory, req.ExtraContexts, req.ExtraTypes); err != nil {
+		log.Warn(ctx, "invalid credential extensions", "err", err)
+		return credentialExtensions{}, err
+	}
+
+	var extensions credentialExtensions
+	if importedSchema != nil {
+		extensions.contexts, extensions.types = importedSchema.ExtraContexts, importedSchema.ExtraTypes
+	}
+	extensions.contexts = appendUnique(extensions.contexts, req.ExtraContexts...)
+	extensions.types = appendUnique(extensions.types, req.ExtraTypes...)
+	return extensions, nil
+}
+
+func (c *claim) createVC(ctx context.Context, claimReq *ports.CreateClaimRequest, vcID uuid.UUID, jsonLdContext string, extensions credentialExtensions, nonce uint64, rhsEnabled bool) (verifiable.W3CCredential, error) {
+	vCredential, err := c.newVerifiableCredential(ctx, claimReq, vcID, jsonLdContext, extensions, nonce, rhsEnabled) // create vc credential
 	if err != nil {
 		return verifiable.W3CCredential{}, err
 	}
@@ -549,16 +1154,94 @@ func (c *claim) createVC(claimReq *ports.CreateClaimRequest, vcID uuid.UUID, jso
 	return vCredential, nil
 }
 
-func (c *claim) guardCreateClaimRequest(req *ports.CreateClaimRequest) error {
+// guardCreateClaimRequest checks the schema url and the proof types of the request. The requests without proof types
+// get the default ones of the imported schema, if it has them, or the ones of the proof policy.
+func (c *claim) guardCreateClaimRequest(req *ports.CreateClaimRequest, importedSchema *domain.Schema) error {
 	if _, err := url.ParseRequestURI(req.Schema); err != nil {
 		return ErrMalformedURL
 	}
+	if req.DefaultProofs {
+		var schemaDefaults *domain.ProofTypes
+		if importedSchema != nil {
+			schemaDefaults = importedSchema.DefaultProofTypes
+		}
+		proofs := c.cfg.ProofPolicy.Defaults(schemaDefaults)
+		req.SignatureProof, req.MTProof = proofs.Signature, proofs.MTP
+	}
+	return c.cfg.ProofPolicy.Check(req.Schema, req.Type, domain.ProofTypes{Signature: req.SignatureProof, MTP: req.MTProof})
+}
+
+// revNonce returns a revocation nonce the issuer has not used, chosen with the nonce strategy of the node. The nonces
+// of existing credentials are skipped, up to revNonceMaxAttempts times.
+func (c *claim) revNonce(ctx context.Context, did *core.DID) (uint64, error) {
+	for attempt := 1; attempt <= revNonceMaxAttempts; attempt++ {
+		var nonce uint64
+		if c.cfg.RevNonceStrategy == domain.RevNonceSequential {
+			next, err := c.icRepo.NextRevNonce(ctx, c.storage.Pgx, did)
+			if err != nil {
+				return 0, err
+			}
+			nonce = uint64(next)
+		} else {
+			random, err := rand.Int64()
+			if err != nil {
+				return 0, err
+			}
+			nonce = random
+		}
+		exists, err := c.icRepo.RevNonceExists(ctx, c.storage.Pgx, did, domain.RevNonceUint64(nonce))
+		if err != nil {
+			return 0, err
+		}
+		if !exists {
+			return nonce, nil
+		}
+		log.Warn(ctx, "revocation nonce already used, trying another one", "did", did.String(), "nonce", nonce, "attempt", attempt)
+	}
+	return 0, ErrRevNonceCollision
+}
+
+// withFreeRevNonce runs issue, which creates and saves a credential, again when another credential saved at the same
+// time took its revocation nonce, up to revNonceMaxAttempts times.
+func withFreeRevNonce(ctx context.Context, issue func() error) error {
+	for attempt := 1; attempt <= revNonceMaxAttempts; attempt++ {
+		err := issue()
+		if !errors.Is(err, repositories.ErrRevNonceDuplicated) {
+			return err
+		}
+		log.Warn(ctx, "revocation nonce taken by a concurrent credential, creating it again", "attempt", attempt)
+	}
+	return ErrRevNonceCollision
+}
+
+// credentialExpiration sets the expiration of the request issued at the given time. The expiration days are turned
+// into the expiration, and then the maximum validity of the imported schema, if any, applies: the credentials
+// requested without expiration get the longest one, and the ones that expire later are rejected.
+func (c *claim) credentialExpiration(req *ports.CreateClaimRequest, importedSchema *domain.Schema, issuedAt time.Time) error {
+	if req.ExpirationDays != nil {
+		if req.Expiration != nil {
+			return fmt.Errorf("%w: expiration and expiration days cannot be both set", ErrInvalidExpiration)
+		}
+		if *req.ExpirationDays <= 0 {
+			return fmt.Errorf("%w: the expiration days must be higher than 0", ErrInvalidExpiration)
+		}
+		req.Expiration = common.ToPointer(issuedAt.AddDate(0, 0, *req.ExpirationDays))
+		req.ExpirationDays = nil
+	}
+	if importedSchema == nil {
+		return nil
+	}
+	expiration, err := importedSchema.Expiration(issuedAt, req.Expiration)
+	if err != nil {
+		return err
+	}
+	req.Expiration = expiration
 	return nil
 }
 
-func (c *claim) newVerifiableCredential(claimReq *ports.CreateClaimRequest, vcID uuid.UUID, jsonLdContext string, nonce uint64) (verifiable.W3CCredential, error) {
-	credentialCtx := []string{verifiable.JSONLDSchemaW3CCredential2018, verifiable.JSONLDSchemaIden3Credential, jsonLdContext}
-	credentialType := []string{verifiable.TypeW3CVerifiableCredential, claimReq.Type}
+func (c *claim) newVerifiableCredential(ctx context.Context, claimReq *ports.CreateClaimRequest, vcID uuid.UUID, jsonLdContext string, extensions credentialExtensions, nonce uint64, rhsEnabled bool) (verifiable.W3CCredential, error) {
+	credentialCtx := appendUnique([]string{verifiable.JSONLDSchemaW3CCredential2018, verifiable.JSONLDSchemaIden3Credential, jsonLdContext}, extensions.contexts...)
+	credentialType := appendUnique([]string{verifiable.TypeW3CVerifiableCredential, claimReq.Type}, extensions.types...)
 
 	credentialSubject := claimReq.CredentialSubject
 
@@ -572,7 +1255,7 @@ func (c *claim) newVerifiableCredential(claimReq *ports.CreateClaimRequest, vcID
 
 	credentialSubject["type"] = claimReq.Type
 
-	cs := c.getRevocationSource(claimReq.DID.String(), nonce, claimReq.SingleIssuer)
+	cs := c.getRevocationSource(ctx, claimReq.DID.String(), nonce, claimReq.SingleIssuer, rhsEnabled)
 
 	issuanceDate := time.Now()
 	return verifiable.W3CCredential{
@@ -591,10 +1274,26 @@ func (c *claim) newVerifiableCredential(claimReq *ports.CreateClaimRequest, vcID
 	}, nil
 }
 
-func (c *claim) getRevocationSource(issuerDID string, nonce uint64, singleIssuer bool) interface{} {
-	if c.cfg.RHSEnabled {
+// rhsEnabled returns true if the credentials of the identity have a reverse hash service revocation status
+func (c *claim) rhsEnabled(ctx context.Context, did core.DID) bool {
+	if c.cfg.Features == nil {
+		return c.cfg.RHSEnabled
+	}
+	return c.cfg.Features.IsEnabled(ctx, did, domain.FeatureRHS)
+}
+
+// rhsURL returns the url of the reverse hash service, the one of the runtime settings if there are
+func (c *claim) rhsURL(ctx context.Context) string {
+	if c.cfg.Settings == nil {
+		return c.cfg.RHSUrl
+	}
+	return c.cfg.Settings.Current(ctx).RHSURL
+}
+
+func (c *claim) getRevocationSource(ctx context.Context, issuerDID string, nonce uint64, singleIssuer bool, rhsEnabled bool) interface{} {
+	if rhsEnabled {
 		return &verifiable.RHSCredentialStatus{
-			ID:              fmt.Sprintf("%s/node", strings.TrimSuffix(c.cfg.RHSUrl, "/")),
+			ID:              fmt.Sprintf("%s/node", strings.TrimSuffix(c.rhsURL(ctx), "/")),
 			Type:            verifiable.Iden3ReverseSparseMerkleTreeProof,
 			RevocationNonce: nonce,
 			StatusIssuer: &verifiable.CredentialStatus{
@@ -3,6 +3,8 @@ package services
 import (
 	"context"
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	core "github.com/iden3/go-iden3-core"
@@ -11,11 +13,16 @@ import (
 	"github.com/polygonid/sh-id-platform/internal/core/domain"
 	"github.com/polygonid/sh-id-platform/internal/core/ports"
 	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/log"
 	"github.com/polygonid/sh-id-platform/internal/repositories"
 )
 
-// ErrConnectionDoesNotExist connection does not exist
-var ErrConnectionDoesNotExist = errors.New("connection does not exist")
+var (
+	// ErrConnectionDoesNotExist connection does not exist
+	ErrConnectionDoesNotExist = errors.New("connection does not exist")
+	// ErrInvalidConnectionMetadata the metadata of the connection is too long or has invalid tags
+	ErrInvalidConnectionMetadata = errors.New("invalid connection metadata")
+)
 
 type connection struct {
 	connRepo ports.ConnectionsRepository
@@ -47,6 +54,27 @@ func (c *connection) DeleteCredentials(ctx context.Context, id uuid.UUID, issuer
 	return c.deleteCredentials(ctx, id, issuerID, c.storage.Pgx)
 }
 
+// Restore undoes the deletion of a connection that was not purged yet
+func (c *connection) Restore(ctx context.Context, id uuid.UUID, issuerDID core.DID) error {
+	err := c.connRepo.Restore(ctx, c.storage.Pgx, id, issuerDID)
+	if errors.Is(err, repositories.ErrConnectionDoesNotExist) {
+		return ErrConnectionDoesNotExist
+	}
+	return err
+}
+
+// PurgeDeleted removes for good the connections deleted longer than the retention ago
+func (c *connection) PurgeDeleted(ctx context.Context, retention time.Duration) error {
+	purged, err := c.connRepo.PurgeDeleted(ctx, c.storage.Pgx, time.Now().Add(-retention))
+	if err != nil {
+		return err
+	}
+	if purged > 0 {
+		log.Info(ctx, "purged deleted connections", "purged", purged)
+	}
+	return nil
+}
+
 func (c *connection) GetByIDAndIssuerID(ctx context.Context, id uuid.UUID, issuerDID core.DID) (*domain.Connection, error) {
 	conn, err := c.connRepo.GetByIDAndIssuerID(ctx, c.storage.Pgx, id, issuerDID)
 	if err != nil {
@@ -71,12 +99,67 @@ func (c *connection) GetByUserID(ctx context.Context, issuerDID core.DID, userID
 	return conn, nil
 }
 
-func (c *connection) GetAllByIssuerID(ctx context.Context, issuerDID core.DID, query string, withCredentials bool) ([]*domain.Connection, error) {
+// GetAllByIssuerID returns the connections of the issuer that match the filter. The tags are compared normalized, as
+// they are stored.
+func (c *connection) GetAllByIssuerID(ctx context.Context, issuerDID core.DID, filter *ports.ConnectionsFilter, withCredentials bool) ([]*domain.Connection, error) {
+	filter = &ports.ConnectionsFilter{Query: filter.Query, Tags: domain.NormalizeConnectionTags(filter.Tags), IncludeDeleted: filter.IncludeDeleted}
+	ctx, cancel := c.storage.ListingContext(ctx)
+	defer cancel()
+	var conns []*domain.Connection
+	var err error
 	if withCredentials {
-		return c.connRepo.GetAllWithCredentialsByIssuerID(ctx, c.storage.Pgx, issuerDID, query)
+		conns, err = c.connRepo.GetAllWithCredentialsByIssuerID(ctx, c.storage.Replica, issuerDID, filter)
+	} else {
+		conns, err = c.connRepo.GetAllByIssuerID(ctx, c.storage.Replica, issuerDID, filter)
+	}
+	if err != nil {
+		return nil, c.storage.QueryError(ctx, "connections.GetAll", err)
 	}
+	return conns, nil
+}
+
+// IterateByIssuerID calls fn for every connection of the issuer without loading all of them in memory
+func (c *connection) IterateByIssuerID(ctx context.Context, issuerDID core.DID, query string, fn func(*domain.Connection) error) error {
+	return c.connRepo.IterateByIssuerID(ctx, c.storage.Replica, issuerDID, &ports.ConnectionsFilter{Query: query}, fn)
+}
 
-	return c.connRepo.GetAllByIssuerID(ctx, c.storage.Pgx, issuerDID, query)
+// UpdateMetadata changes the metadata fields given in the request and returns the connection with its new metadata
+func (c *connection) UpdateMetadata(ctx context.Context, id uuid.UUID, issuerDID core.DID, req *ports.UpdateConnectionMetadataRequest) (*domain.Connection, error) {
+	conn, err := c.GetByIDAndIssuerID(ctx, id, issuerDID)
+	if err != nil {
+		return nil, err
+	}
+
+	var metadata domain.ConnectionMetadata
+	if conn.Metadata != nil {
+		metadata = *conn.Metadata
+	}
+	if req.Version != nil && *req.Version != metadata.Version {
+		return nil, ErrVersionMismatch
+	}
+	if req.DisplayName != nil {
+		metadata.DisplayName = *req.DisplayName
+	}
+	if req.Notes != nil {
+		metadata.Notes = *req.Notes
+	}
+	if req.Tags != nil {
+		metadata.Tags = *req.Tags
+	}
+	metadata.Tags = domain.NormalizeConnectionTags(metadata.Tags)
+	if err := metadata.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidConnectionMetadata, err)
+	}
+	metadata.ModifiedAt = time.Now().UTC()
+
+	if err := c.connRepo.SaveMetadata(ctx, c.storage.Pgx, conn.ID, &metadata); err != nil {
+		if errors.Is(err, repositories.ErrVersionConflict) {
+			return nil, ErrVersionMismatch
+		}
+		return nil, err
+	}
+	conn.Metadata = &metadata
+	return conn, nil
 }
 
 func (c *connection) delete(ctx context.Context, id uuid.UUID, issuerDID core.DID, pgx db.Querier) error {
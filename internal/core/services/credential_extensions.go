@@ -0,0 +1,70 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/polygonid/sh-id-platform/internal/loader"
+)
+
+var (
+	// ErrInvalidCredentialContext an extra context of the credential is not an url of a JSON-LD context that can be loaded
+	ErrInvalidCredentialContext = errors.New("invalid credential context")
+	// ErrInvalidCredentialType an extra type of the credential is empty or has spaces
+	ErrInvalidCredentialType = errors.New("invalid credential type")
+)
+
+// credentialExtensions are the contexts and types added to a credential besides the ones of its schema
+type credentialExtensions struct {
+	contexts []string
+	types    []string
+}
+
+// validateCredentialExtensions checks that every extra context is a JSON-LD document that can be loaded, so the
+// credentials that use it can be merklized and verified, and that the extra types are single terms.
+func validateCredentialExtensions(ctx context.Context, lf loader.Factory, contexts []string, types []string) error {
+	for _, c := range contexts {
+		u, err := url.ParseRequestURI(c)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https" && u.Scheme != "ipfs") {
+			return fmt.Errorf("%w %q: must be an http, https or ipfs url", ErrInvalidCredentialContext, c)
+		}
+		doc, _, err := lf(c).Load(ctx)
+		if err != nil {
+			return fmt.Errorf("%w %q: %s", ErrInvalidCredentialContext, c, err)
+		}
+		var jsonLD map[string]any
+		if err := json.Unmarshal(doc, &jsonLD); err != nil {
+			return fmt.Errorf("%w %q: not a JSON document", ErrInvalidCredentialContext, c)
+		}
+		if _, ok := jsonLD["@context"]; !ok {
+			return fmt.Errorf("%w %q: missing @context", ErrInvalidCredentialContext, c)
+		}
+	}
+	for _, t := range types {
+		if t == "" || strings.ContainsAny(t, " \t\n") {
+			return fmt.Errorf("%w %q", ErrInvalidCredentialType, t)
+		}
+	}
+	return nil
+}
+
+// appendUnique appends the values that are not in list yet, keeping their order
+func appendUnique(list []string, values ...string) []string {
+	for _, v := range values {
+		found := false
+		for _, l := range list {
+			if l == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			list = append(list, v)
+		}
+	}
+	return list
+}
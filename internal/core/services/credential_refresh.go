@@ -0,0 +1,155 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+	"github.com/iden3/go-schema-processor/utils"
+	"github.com/iden3/go-schema-processor/verifiable"
+	"github.com/iden3/iden3comm/packers"
+	"github.com/iden3/iden3comm/protocol"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	schemaPkg "github.com/polygonid/sh-id-platform/pkg/schema"
+)
+
+// ErrCredentialNotRefreshable the credential has no refresh service or it is revoked
+var ErrCredentialNotRefreshable = errors.New("credential cannot be refreshed")
+
+const (
+	credentialBaseContexts = 3 // the W3C, the iden3 and the schema contexts, before the extra ones
+	credentialBaseTypes    = 2 // VerifiableCredential and the schema type, before the extra ones
+)
+
+var (
+	subjectPositions = map[core.IDPosition]string{
+		core.IDPositionIndex: utils.SubjectPositionIndex,
+		core.IDPositionValue: utils.SubjectPositionValue,
+	}
+	merklizedRootPositions = map[core.MerklizedRootPosition]string{
+		core.MerklizedRootPositionIndex: utils.MerklizedRootPositionIndex,
+		core.MerklizedRootPositionValue: utils.MerklizedRootPositionValue,
+	}
+)
+
+// issuanceMessageBody is the body of an issuance response, with the refresh service of the credential
+type issuanceMessageBody struct {
+	Credential domain.RefreshableCredential `json:"credential"`
+}
+
+// getAgentRefreshedCredential issues a new credential with the data of a refreshable one of the holder. The new
+// credential has the same validity period as the old one, counted from now, and the same proofs and refresh service.
+func (c *claim) getAgentRefreshedCredential(ctx context.Context, basicMessage *ports.AgentRequest) (*domain.Agent, error) {
+	refreshRequestBody := &ports.CredentialRefreshRequestMessageBody{}
+	if err := json.Unmarshal(basicMessage.Body, refreshRequestBody); err != nil {
+		log.Error(ctx, "unmarshalling agent body", "err", err)
+		return nil, fmt.Errorf("invalid credential refresh request body: %w", err)
+	}
+
+	claimID, err := uuid.Parse(refreshRequestBody.ID)
+	if err != nil {
+		log.Error(ctx, "wrong claimID in agent request body", "err", err)
+		return nil, fmt.Errorf("invalid claim ID")
+	}
+
+	old, err := c.icRepo.GetByIdAndIssuer(ctx, c.storage.Pgx, basicMessage.IssuerDID, claimID)
+	if err != nil {
+		log.Error(ctx, "loading claim", "err", err)
+		return nil, fmt.Errorf("failed get claim by claimID: %w", err)
+	}
+
+	if old.OtherIdentifier != basicMessage.UserDID.String() {
+		err := fmt.Errorf("claim doesn't relate to sender")
+		log.Error(ctx, "claim doesn't relate to sender", err, "claimID", old.ID)
+		return nil, err
+	}
+	if old.Revoked || old.GetRefreshService() == nil {
+		log.Warn(ctx, "refresh of a credential that cannot be refreshed", "claimID", old.ID, "revoked", old.Revoked)
+		return nil, ErrCredentialNotRefreshable
+	}
+
+	vc, err := schemaPkg.FromClaimModelToW3CCredential(*old)
+	if err != nil {
+		log.Error(ctx, "creating W3 credential", "err", err)
+		return nil, fmt.Errorf("failed to convert claim to  w3cCredential: %w", err)
+	}
+
+	refreshed, err := c.Save(ctx, refreshRequest(basicMessage, old, vc))
+	if err != nil {
+		log.Error(ctx, "refreshing credential", "err", err, "claimID", old.ID)
+		return nil, err
+	}
+	log.Info(ctx, "credential refreshed", "claimID", old.ID, "newClaimID", refreshed.ID, "reason", refreshRequestBody.Reason)
+
+	credential, err := schemaPkg.FromClaimModelToW3CCredential(*refreshed)
+	if err != nil {
+		log.Error(ctx, "creating W3 credential", "err", err)
+		return nil, fmt.Errorf("failed to convert claim to  w3cCredential: %w", err)
+	}
+
+	return &domain.Agent{
+		ID:       uuid.NewString(),
+		Typ:      packers.MediaTypePlainMessage,
+		Type:     protocol.CredentialIssuanceResponseMessageType,
+		ThreadID: basicMessage.ThreadID,
+		Body:     issuanceMessageBody{Credential: domain.RefreshableCredential{W3CCredential: *credential, RefreshService: refreshed.GetRefreshService()}},
+		From:     basicMessage.IssuerDID.String(),
+		To:       basicMessage.UserDID.String(),
+	}, nil
+}
+
+// refreshRequest returns the request of a credential like the old one, valid for as long as the old one was
+func refreshRequest(basicMessage *ports.AgentRequest, old *domain.Claim, vc *verifiable.W3CCredential) *ports.CreateClaimRequest {
+	var expiration *time.Time
+	if vc.Expiration != nil && vc.IssuanceDate != nil {
+		expiration = new(time.Time)
+		*expiration = time.Now().UTC().Add(vc.Expiration.Sub(*vc.IssuanceDate))
+	}
+
+	subject := make(map[string]any, len(vc.CredentialSubject))
+	for k, v := range vc.CredentialSubject {
+		subject[k] = v
+	}
+	delete(subject, "type")
+
+	credentialType, ok := vc.CredentialSubject["type"].(string)
+	if !ok {
+		credentialType = old.SchemaType[strings.LastIndex(old.SchemaType, "#")+1:]
+	}
+
+	req := &ports.CreateClaimRequest{
+		DID:               basicMessage.IssuerDID,
+		Schema:            old.SchemaURL,
+		CredentialSubject: subject,
+		Expiration:        expiration,
+		Type:              credentialType,
+		Version:           old.Version,
+		SignatureProof:    old.SignatureProof.Bytes != nil,
+		MTProof:           old.MtProof,
+		SingleIssuer:      strings.Contains(vc.ID, "/v1/credentials/"),
+		RefreshService:    true,
+	}
+	if coreClaim := old.CoreClaim.Get(); coreClaim != nil {
+		if pos, err := coreClaim.GetIDPosition(); err == nil {
+			req.SubjectPos = subjectPositions[pos]
+		}
+		if pos, err := coreClaim.GetMerklizedPosition(); err == nil {
+			req.MerklizedRootPosition = merklizedRootPositions[pos]
+		}
+	}
+	if len(vc.Context) > credentialBaseContexts {
+		req.ExtraContexts = vc.Context[credentialBaseContexts:]
+	}
+	if len(vc.Type) > credentialBaseTypes {
+		req.ExtraTypes = vc.Type[credentialBaseTypes:]
+	}
+	return req
+}
@@ -0,0 +1,156 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+var (
+	// ErrInvalidCredentialTemplate the credential template lacks a name or proof types
+	ErrInvalidCredentialTemplate = errors.New("invalid credential template")
+	// ErrCredentialTemplateNotFound the credential template does not exist
+	ErrCredentialTemplateNotFound = errors.New("credential template not found")
+	// ErrCredentialTemplateDuplicated the issuer already has a credential template with the same name
+	ErrCredentialTemplateDuplicated = errors.New("credential template name already in use")
+)
+
+type credentialTemplate struct {
+	repo          ports.CredentialTemplateRepository
+	schemaRepo    ports.SchemaRepository
+	claimsService ports.ClaimsService
+	storage       *db.Storage
+}
+
+// NewCredentialTemplate returns a new credential templates service
+func NewCredentialTemplate(repo ports.CredentialTemplateRepository, schemaRepo ports.SchemaRepository, claimsService ports.ClaimsService, storage *db.Storage) ports.CredentialTemplateService {
+	return &credentialTemplate{
+		repo:          repo,
+		schemaRepo:    schemaRepo,
+		claimsService: claimsService,
+		storage:       storage,
+	}
+}
+
+// Create stores a new template of an imported schema. Deprecated schemas cannot be used.
+func (t *credentialTemplate) Create(ctx context.Context, template *domain.CredentialTemplate) (*domain.CredentialTemplate, error) {
+	if err := template.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidCredentialTemplate, err)
+	}
+	schema, err := t.schema(ctx, template.IssuerDID, template.SchemaID)
+	if err != nil {
+		return nil, err
+	}
+	if err := t.repo.Save(ctx, t.storage.Pgx, template); err != nil {
+		if errors.Is(err, repositories.ErrCredentialTemplateDuplicated) {
+			return nil, ErrCredentialTemplateDuplicated
+		}
+		return nil, err
+	}
+	template.Schema = schema
+	log.Info(ctx, "credential template created", "id", template.ID, "name", template.Name, "schema", schema.URL)
+	return template, nil
+}
+
+// GetByID returns the template with its schema
+func (t *credentialTemplate) GetByID(ctx context.Context, issuerDID core.DID, id uuid.UUID) (*domain.CredentialTemplate, error) {
+	template, err := t.repo.GetByID(ctx, t.storage.Pgx, issuerDID, id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrCredentialTemplateNotFound) {
+			return nil, ErrCredentialTemplateNotFound
+		}
+		return nil, err
+	}
+	template.Schema, err = t.schemaRepo.GetByID(ctx, issuerDID, template.SchemaID)
+	if err != nil {
+		return nil, err
+	}
+	return template, nil
+}
+
+// GetAll returns the templates of the issuer with their schemas
+func (t *credentialTemplate) GetAll(ctx context.Context, issuerDID core.DID) ([]*domain.CredentialTemplate, error) {
+	templates, err := t.repo.GetAll(ctx, t.storage.Replica, issuerDID)
+	if err != nil {
+		return nil, err
+	}
+	schemas := make(map[uuid.UUID]*domain.Schema)
+	for _, template := range templates {
+		schema, ok := schemas[template.SchemaID]
+		if !ok {
+			if schema, err = t.schemaRepo.GetByID(ctx, issuerDID, template.SchemaID); err != nil {
+				return nil, err
+			}
+			schemas[template.SchemaID] = schema
+		}
+		template.Schema = schema
+	}
+	return templates, nil
+}
+
+// Delete removes the template. The credentials already issued from it are not affected.
+func (t *credentialTemplate) Delete(ctx context.Context, issuerDID core.DID, id uuid.UUID) error {
+	err := t.repo.Delete(ctx, t.storage.Pgx, issuerDID, id)
+	if errors.Is(err, repositories.ErrCredentialTemplateNotFound) {
+		return ErrCredentialTemplateNotFound
+	}
+	return err
+}
+
+// Issue creates a credential from the template. The given credential subject fields are added to the default ones of
+// the template, replacing them if they are in both. If expiration is nil the expiration of the template applies.
+func (t *credentialTemplate) Issue(ctx context.Context, issuerDID core.DID, id uuid.UUID, credentialSubject domain.CredentialSubject, expiration *time.Time) (*domain.Claim, error) {
+	template, err := t.GetByID(ctx, issuerDID, id)
+	if err != nil {
+		return nil, err
+	}
+	if template.Schema.Status() == domain.SchemaStatusDeprecated {
+		return nil, ErrSchemaDeprecated
+	}
+	if expiration == nil {
+		expiration = template.Expiration(time.Now().UTC())
+	}
+
+	req := ports.NewCreateClaimRequest(&issuerDID,
+		template.Schema.URL,
+		template.Subject(credentialSubject),
+		expiration,
+		template.Schema.Type,
+		nil, nil, nil,
+		common.ToPointer(template.SignatureProof),
+		common.ToPointer(template.MTProof),
+		nil,
+		true,
+	)
+	claim, err := t.claimsService.Save(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	log.Info(ctx, "credential issued from template", "template", template.ID, "credential", claim.ID)
+	return claim, nil
+}
+
+func (t *credentialTemplate) schema(ctx context.Context, issuerDID core.DID, id uuid.UUID) (*domain.Schema, error) {
+	schema, err := t.schemaRepo.GetByID(ctx, issuerDID, id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrSchemaDoesNotExist) {
+			return nil, ErrSchemaNotFound
+		}
+		return nil, err
+	}
+	if schema.Status() == domain.SchemaStatusDeprecated {
+		return nil, ErrSchemaDeprecated
+	}
+	return schema, nil
+}
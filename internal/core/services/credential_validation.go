@@ -0,0 +1,116 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/pkg/cache"
+)
+
+const validationApprovalKeyPrefix = "validation-approval-"
+
+var (
+	// ErrCredentialRejected the validation webhook of the schema did not approve the credential subject
+	ErrCredentialRejected = errors.New("credential rejected by the validation webhook")
+	// ErrValidationWebhookUnavailable the validation webhook of the schema did not answer, so the credential cannot be issued
+	ErrValidationWebhookUnavailable = errors.New("validation webhook unavailable")
+)
+
+// CredentialValidationCfg configures the validation of credentials by the schema webhooks
+type CredentialValidationCfg struct {
+	ApprovalTTL time.Duration // Time an approval is reused for the same credential. 0 disables the cache
+}
+
+type credentialValidation struct {
+	schemaRepo ports.SchemaRepository
+	gateway    ports.ValidationWebhookGateway
+	cache      cache.Cache
+	cfg        CredentialValidationCfg
+}
+
+// NewCredentialValidation returns the service that asks the schema validation webhooks to approve credentials
+func NewCredentialValidation(schemaRepo ports.SchemaRepository, gateway ports.ValidationWebhookGateway, cache cache.Cache, cfg CredentialValidationCfg) ports.CredentialValidationService {
+	return &credentialValidation{
+		schemaRepo: schemaRepo,
+		gateway:    gateway,
+		cache:      cache,
+		cfg:        cfg,
+	}
+}
+
+// Validate returns ErrCredentialRejected, with the reason given by the webhook, if the webhook of the schema rejects
+// the credential. Credentials of schemas without webhook are always valid.
+func (v *credentialValidation) Validate(ctx context.Context, req *ports.CreateClaimRequest) error {
+	schemas, err := v.schemaRepo.GetByURL(ctx, *req.DID, req.Schema, req.Type)
+	if err != nil {
+		return err
+	}
+	var url, secret string
+	for _, schema := range schemas {
+		if schema.ValidationWebhook != nil {
+			url, secret = schema.ValidationWebhook.URL, schema.ValidationWebhook.Secret
+			break
+		}
+	}
+	if url == "" {
+		return nil
+	}
+
+	validationReq := &ports.CredentialValidationRequest{
+		Issuer:            req.DID.String(),
+		Schema:            req.Schema,
+		Type:              req.Type,
+		CredentialSubject: req.CredentialSubject,
+		Expiration:        req.Expiration,
+	}
+	key, err := approvalKey(url, validationReq)
+	if err != nil {
+		return err
+	}
+	if v.cfg.ApprovalTTL > 0 && v.cache.Exists(ctx, key) {
+		log.Debug(ctx, "credential approved by a previous validation", "schema", req.Schema)
+		return nil
+	}
+
+	result, err := v.gateway.Validate(ctx, url, secret, validationReq)
+	if err != nil {
+		log.Warn(ctx, "calling validation webhook", "err", err, "url", url, "schema", req.Schema)
+		return fmt.Errorf("%w: %s", ErrValidationWebhookUnavailable, err)
+	}
+	if !result.Approved {
+		reason := result.Reason
+		if reason == "" {
+			reason = "no reason given"
+		}
+		log.Info(ctx, "credential rejected by the validation webhook", "reason", reason, "schema", req.Schema)
+		return fmt.Errorf("%w: %s", ErrCredentialRejected, reason)
+	}
+
+	if v.cfg.ApprovalTTL > 0 {
+		if err := v.cache.Set(ctx, key, true, v.cfg.ApprovalTTL); err != nil {
+			log.Warn(ctx, "caching credential approval", "err", err)
+		}
+	}
+	return nil
+}
+
+// approvalKey identifies the approval of a credential by a webhook. Maps are encoded with sorted keys, so the same
+// credential subject always gets the same key.
+func approvalKey(url string, req *ports.CredentialValidationRequest) (string, error) {
+	body, err := json.Marshal(struct {
+		URL     string                             `json:"url"`
+		Request *ports.CredentialValidationRequest `json:"request"`
+	}{URL: url, Request: req})
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(body)
+	return validationApprovalKeyPrefix + hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,163 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	core "github.com/iden3/go-iden3-core"
+	"github.com/iden3/go-schema-processor/verifiable"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/network"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+var (
+	// ErrInvalidDIDService the service entry cannot be published in a DID document
+	ErrInvalidDIDService = errors.New("invalid did service")
+	// ErrDIDServiceNotFound the identity has no service entry with that id
+	ErrDIDServiceNotFound = errors.New("did service not found")
+	// ErrDIDDocumentIdentityNotFound the identity of the DID document does not exist
+	ErrDIDDocumentIdentityNotFound = errors.New("identity not found")
+)
+
+// DIDDocumentCfg DID document service configuration
+type DIDDocumentCfg struct {
+	ServerURL string
+}
+
+type didDocument struct {
+	repo            ports.DIDServiceRepository
+	identitySrv     ports.IdentityService
+	storage         *db.Storage
+	networkResolver *network.Resolver
+	cfg             DIDDocumentCfg
+}
+
+// NewDIDDocument returns a new DID document service
+func NewDIDDocument(repo ports.DIDServiceRepository, identitySrv ports.IdentityService, storage *db.Storage, networkResolver *network.Resolver, cfg DIDDocumentCfg) ports.DIDDocumentService {
+	return &didDocument{
+		repo:            repo,
+		identitySrv:     identitySrv,
+		storage:         storage,
+		networkResolver: networkResolver,
+		cfg:             cfg,
+	}
+}
+
+// Get returns the DID document of the identity, with its latest published state and its service entries
+func (d *didDocument) Get(ctx context.Context, did core.DID) (*domain.DIDDocument, error) {
+	services, err := d.GetServices(ctx, did)
+	if err != nil {
+		return nil, err
+	}
+	state, err := d.identitySrv.GetLatestStateByID(ctx, did)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.DIDDocument{
+		Identifier:           did.String(),
+		StateContractAddress: d.stateContractAddress(ctx, did),
+		State:                state,
+		Services:             services,
+	}, nil
+}
+
+// GetServices returns the service entries of the identity. The agent of the node is an entry unless it is replaced.
+func (d *didDocument) GetServices(ctx context.Context, did core.DID) ([]*domain.DIDService, error) {
+	if err := d.checkIdentity(ctx, did); err != nil {
+		return nil, err
+	}
+	stored, err := d.repo.GetByIdentifier(ctx, d.storage.Pgx, did)
+	if err != nil {
+		return nil, err
+	}
+
+	services := make([]*domain.DIDService, 0, len(stored)+1)
+	agent := d.agentService(did)
+	for _, service := range stored {
+		if service.ID == agent.ID {
+			agent = service
+		}
+	}
+	services = append(services, agent)
+	for _, service := range stored {
+		if service.ID != agent.ID {
+			services = append(services, service)
+		}
+	}
+	return services, nil
+}
+
+// SaveService adds the service entry to the DID document of the identity or replaces the one with the same id
+func (d *didDocument) SaveService(ctx context.Context, did core.DID, service *domain.DIDService) (*domain.DIDService, error) {
+	service.Identifier = did.String()
+	if err := service.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDIDService, err)
+	}
+
+	service.Overridden = true
+	if err := d.repo.Save(ctx, d.storage.Pgx, service); err != nil {
+		if errors.Is(err, repositories.ErrDIDServiceIdentityNotFound) {
+			return nil, ErrDIDDocumentIdentityNotFound
+		}
+		return nil, err
+	}
+	log.Info(ctx, "did service saved", "did", did.String(), "id", service.ID, "type", service.Type, "endpoint", service.ServiceEndpoint)
+	return service, nil
+}
+
+// DeleteService removes the service entry from the DID document of the identity. Removing the replacement of the
+// agent of the node publishes the agent again.
+func (d *didDocument) DeleteService(ctx context.Context, did core.DID, id string) error {
+	if err := d.repo.Delete(ctx, d.storage.Pgx, did, id); err != nil {
+		if errors.Is(err, repositories.ErrDIDServiceNotFound) {
+			return ErrDIDServiceNotFound
+		}
+		return err
+	}
+	log.Info(ctx, "did service deleted", "did", did.String(), "id", id)
+	return nil
+}
+
+func (d *didDocument) checkIdentity(ctx context.Context, did core.DID) error {
+	exists, err := d.identitySrv.Exists(ctx, did)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrDIDDocumentIdentityNotFound
+	}
+	return nil
+}
+
+func (d *didDocument) agentService(did core.DID) *domain.DIDService {
+	return &domain.DIDService{
+		Identifier:      did.String(),
+		ID:              verifiable.Iden3CommServiceType,
+		Type:            verifiable.Iden3CommServiceType,
+		ServiceEndpoint: fmt.Sprintf("%s/v1/agent", strings.TrimSuffix(d.cfg.ServerURL, "/")),
+	}
+}
+
+// stateContractAddress returns the address of the state contract of the network of the identity, prefixed with
+// the chain id when it is configured, as the did:polygonid resolver does
+func (d *didDocument) stateContractAddress(ctx context.Context, did core.DID) string {
+	if d.networkResolver == nil {
+		return ""
+	}
+	settings, err := d.networkResolver.Settings(network.Key(did))
+	if err != nil {
+		log.Warn(ctx, "cannot find the network of the identity", "err", err, "did", did.String())
+		return ""
+	}
+	if settings.ChainID == 0 {
+		return settings.ContractAddress
+	}
+	return fmt.Sprintf("%d:%s", settings.ChainID, settings.ContractAddress)
+}
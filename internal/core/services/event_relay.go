@@ -0,0 +1,178 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/pkg/pubsub"
+)
+
+const defaultEventRelayBatchSize = 100 // defaultEventRelayBatchSize is the number of events sent at once if not configured
+
+// EventRelayCfg configures the relay of the outbox events. The topic of a broker event is TopicPrefix followed by its
+// type.
+type EventRelayCfg struct {
+	TopicPrefix string
+	BatchSize   int
+	Retention   time.Duration
+}
+
+type eventRelay struct {
+	repo         ports.EventOutboxRepository
+	broker       ports.EventBroker
+	ps           pubsub.Publisher
+	storage      *db.Storage
+	cfg          EventRelayCfg
+	destinations []string
+}
+
+// NewEventRelay returns the service that delivers the events of the outbox: the issuance lifecycle events to the
+// broker and the notifications to the pubsub. Without a broker the lifecycle events are not sent, and only purged.
+func NewEventRelay(repo ports.EventOutboxRepository, broker ports.EventBroker, ps pubsub.Publisher, storage *db.Storage, cfg EventRelayCfg) ports.EventRelayService {
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = defaultEventRelayBatchSize
+	}
+	destinations := []string{domain.OutboxPubSub}
+	if broker != nil {
+		destinations = append(destinations, domain.OutboxBroker)
+	}
+	return &eventRelay{repo: repo, broker: broker, ps: ps, storage: storage, cfg: cfg, destinations: destinations}
+}
+
+// Relay delivers the pending events, oldest first, in batches, and returns the number of events delivered.
+// An event is marked as sent once the broker or the pubsub acknowledges it, so it is delivered at least once: if the
+// node stops before it is marked, it is delivered again by the next relay. Delivering stops at the first batch that fails.
+func (r *eventRelay) Relay(ctx context.Context) (int, error) {
+	sent := 0
+	for {
+		n, err := r.relayBatch(ctx)
+		sent += n
+		if err != nil || n < r.cfg.BatchSize {
+			return sent, err
+		}
+	}
+}
+
+func (r *eventRelay) relayBatch(ctx context.Context) (int, error) {
+	var sent []int64
+	var publishErr error
+	err := r.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+		events, err := r.repo.GetPending(ctx, tx, r.destinations, r.cfg.BatchSize)
+		if err != nil || len(events) == 0 {
+			return err
+		}
+
+		var failed []int64
+		sent, failed, publishErr = r.publish(ctx, events)
+
+		// The failure is recorded in the same transaction, so it is committed and the events stay pending
+		if len(failed) > 0 {
+			log.Warn(ctx, "delivering outbox events", "err", publishErr, "events", len(failed), "firstID", failed[0])
+			if err := r.repo.MarkFailed(ctx, tx, failed, publishErr.Error()); err != nil {
+				return err
+			}
+		}
+		if len(sent) == 0 {
+			return nil
+		}
+		return r.repo.MarkSent(ctx, tx, sent)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return len(sent), publishErr
+}
+
+// publish sends the notifications to the pubsub one by one and the lifecycle events to the broker at once. It returns
+// the ids of the events delivered and of the ones that failed, with the first error.
+func (r *eventRelay) publish(ctx context.Context, events []domain.OutboxEvent) (sent []int64, failed []int64, err error) {
+	var brokerIDs []int64
+	var messages []ports.BrokerMessage
+	for i := range events {
+		if events[i].Destination == domain.OutboxBroker {
+			value, err := json.Marshal(events[i].BrokerEvent())
+			if err != nil {
+				return nil, nil, err
+			}
+			brokerIDs = append(brokerIDs, events[i].ID)
+			messages = append(messages, ports.BrokerMessage{
+				Topic: r.cfg.TopicPrefix + events[i].Type,
+				Key:   events[i].IssuerDID,
+				ID:    events[i].BrokerEvent().ID,
+				Value: value,
+			})
+			continue
+		}
+
+		// Once a notification fails the next ones are not published, so they are kept in order
+		if err == nil {
+			err = r.ps.Publish(ctx, events[i].Type, outboxMessage(events[i].Payload))
+		}
+		if err != nil {
+			failed = append(failed, events[i].ID)
+			continue
+		}
+		sent = append(sent, events[i].ID)
+	}
+
+	if len(messages) == 0 {
+		return sent, failed, err
+	}
+	if brokerErr := r.broker.Publish(ctx, messages...); brokerErr != nil {
+		if err == nil {
+			err = brokerErr
+		}
+		return sent, append(failed, brokerIDs...), err
+	}
+	return append(sent, brokerIDs...), failed, err
+}
+
+// Purge deletes the events older than the retention that were sent. Without a broker the pending lifecycle events
+// are deleted too, as they will never be sent.
+func (r *eventRelay) Purge(ctx context.Context) error {
+	var pending []string
+	if r.broker == nil {
+		pending = []string{domain.OutboxBroker}
+	}
+	deleted, err := r.repo.DeleteBefore(ctx, r.storage.Pgx, time.Now().Add(-r.cfg.Retention), pending)
+	if err != nil {
+		return err
+	}
+	if deleted > 0 {
+		log.Info(ctx, "purged outbox events", "deleted", deleted)
+	}
+	return nil
+}
+
+// notifyInTx writes a notification in the outbox with the transaction of the change that caused it. The relay
+// publishes it once the transaction is committed, so it is not lost if the node stops right after the change.
+func notifyInTx(ctx context.Context, conn db.Querier, outbox ports.EventOutboxRepository, topic string, issuerID string, ev pubsub.Event) error {
+	payload, err := ev.Marshal()
+	if err != nil {
+		return err
+	}
+	return outbox.Add(ctx, conn, &domain.OutboxEvent{
+		Destination: domain.OutboxPubSub,
+		Type:        topic,
+		IssuerDID:   issuerID,
+		Payload:     json.RawMessage(payload),
+	})
+}
+
+// outboxMessage is a notification read from the outbox, already marshaled when it was written
+type outboxMessage pubsub.Message
+
+func (m outboxMessage) Marshal() (pubsub.Message, error) {
+	return pubsub.Message(m), nil
+}
+
+func (m outboxMessage) Unmarshal(pubsub.Message) error {
+	return nil
+}
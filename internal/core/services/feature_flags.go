@@ -0,0 +1,127 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	core "github.com/iden3/go-iden3-core"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+var (
+	ErrUnknownFeature              = errors.New("unknown feature")    // ErrUnknownFeature the feature can't be flagged
+	ErrFeatureFlagIdentityNotFound = errors.New("identity not found") // ErrFeatureFlagIdentityNotFound the identity of the feature flag does not exist
+)
+
+// FeatureFlagsCfg feature flags service configuration, the values of the features that are not overridden
+type FeatureFlagsCfg struct {
+	RHS           bool
+	AsyncIssuance bool
+	OID4VCI       bool
+	TestMode      bool
+	StatusList    bool
+}
+
+type featureFlags struct {
+	repo     ports.FeatureFlagRepository
+	storage  *db.Storage
+	defaults map[domain.Feature]bool
+}
+
+// NewFeatureFlags returns a new feature flag service
+func NewFeatureFlags(repo ports.FeatureFlagRepository, storage *db.Storage, cfg FeatureFlagsCfg) ports.FeatureFlagService {
+	return &featureFlags{
+		repo:    repo,
+		storage: storage,
+		defaults: map[domain.Feature]bool{
+			domain.FeatureRHS:           cfg.RHS,
+			domain.FeatureAsyncIssuance: cfg.AsyncIssuance,
+			domain.FeatureOID4VCI:       cfg.OID4VCI,
+			domain.FeatureTestMode:      cfg.TestMode,
+			domain.FeatureStatusList:    cfg.StatusList,
+		},
+	}
+}
+
+// IsEnabled returns the value of the feature for the identity. If the overrides can't be read the configured value
+// is returned, so a database error does not change the behaviour of the services.
+func (f *featureFlags) IsEnabled(ctx context.Context, did core.DID, feature domain.Feature) bool {
+	overrides, err := f.repo.GetByIdentifier(ctx, f.storage.Pgx, did)
+	if err != nil {
+		log.Warn(ctx, "cannot load feature flags, using the configured value", "err", err, "did", did.String(), "feature", feature)
+		return f.defaults[feature]
+	}
+	for _, override := range overrides {
+		if override.Feature == feature {
+			return override.Enabled
+		}
+	}
+	return f.defaults[feature]
+}
+
+// GetAll returns the value of every feature for the identity
+func (f *featureFlags) GetAll(ctx context.Context, did core.DID) ([]*domain.FeatureFlag, error) {
+	overrides, err := f.repo.GetByIdentifier(ctx, f.storage.Pgx, did)
+	if err != nil {
+		return nil, err
+	}
+
+	flags := make([]*domain.FeatureFlag, len(domain.Features))
+	for i, feature := range domain.Features {
+		flags[i] = f.defaultFlag(did, feature)
+		for _, override := range overrides {
+			if override.Feature == feature {
+				flags[i] = override
+			}
+		}
+	}
+	return flags, nil
+}
+
+// Set overrides the value of the feature for the identity
+func (f *featureFlags) Set(ctx context.Context, did core.DID, feature domain.Feature, enabled bool) (*domain.FeatureFlag, error) {
+	if !feature.IsValid() {
+		return nil, ErrUnknownFeature
+	}
+
+	flag := &domain.FeatureFlag{
+		Identifier: did.String(),
+		Feature:    feature,
+		Enabled:    enabled,
+		Overridden: true,
+	}
+	if err := f.repo.Save(ctx, f.storage.Pgx, flag); err != nil {
+		if errors.Is(err, repositories.ErrFeatureFlagIdentityNotFound) {
+			return nil, ErrFeatureFlagIdentityNotFound
+		}
+		return nil, err
+	}
+	log.Info(ctx, "feature flag overridden", "did", did.String(), "feature", feature, "enabled", enabled)
+	return flag, nil
+}
+
+// Reset removes the override of the feature, so the identity takes the configured value again
+func (f *featureFlags) Reset(ctx context.Context, did core.DID, feature domain.Feature) (*domain.FeatureFlag, error) {
+	if !feature.IsValid() {
+		return nil, ErrUnknownFeature
+	}
+
+	if err := f.repo.Delete(ctx, f.storage.Pgx, did, feature); err != nil {
+		return nil, err
+	}
+	log.Info(ctx, "feature flag reset", "did", did.String(), "feature", feature)
+	return f.defaultFlag(did, feature), nil
+}
+
+func (f *featureFlags) defaultFlag(did core.DID, feature domain.Feature) *domain.FeatureFlag {
+	return &domain.FeatureFlag{
+		Identifier: did.String(),
+		Feature:    feature,
+		Enabled:    f.defaults[feature],
+	}
+}
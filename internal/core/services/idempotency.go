@@ -0,0 +1,44 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// idempotencyLease is the time a request can be processed for. After it, the request was interrupted, e.g. the node
+// stopped, and its key can be used again.
+const idempotencyLease = 5 * time.Minute
+
+type idempotency struct {
+	repo    ports.IdempotencyKeyRepository
+	storage *db.Storage
+	ttl     time.Duration
+}
+
+// NewIdempotency returns a new idempotency service that remembers the keys for ttl
+func NewIdempotency(repo ports.IdempotencyKeyRepository, storage *db.Storage, ttl time.Duration) ports.IdempotencyService {
+	return &idempotency{repo: repo, storage: storage, ttl: ttl}
+}
+
+// Reserve records the request of the key as being processed. It returns the original request, with its response if
+// it was answered, when the key was already used.
+func (s *idempotency) Reserve(ctx context.Context, key *domain.IdempotencyKey) (*domain.IdempotencyKey, error) {
+	if key.CreatedAt.IsZero() {
+		key.CreatedAt = time.Now()
+	}
+	return s.repo.Reserve(ctx, s.storage.Pgx, key, key.CreatedAt.Add(-s.ttl), key.CreatedAt.Add(-idempotencyLease))
+}
+
+// SaveResponse stores the response of the request of the key
+func (s *idempotency) SaveResponse(ctx context.Context, key *domain.IdempotencyKey) error {
+	return s.repo.SaveResponse(ctx, s.storage.Pgx, key)
+}
+
+// Release forgets the key, so a retry of its request is processed again
+func (s *idempotency) Release(ctx context.Context, key *domain.IdempotencyKey) error {
+	return s.repo.Delete(ctx, s.storage.Pgx, key)
+}
@@ -12,6 +12,8 @@ import (
 	"strings"
 	"time"
 
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/google/uuid"
 	auth "github.com/iden3/go-iden3-auth"
 	"github.com/iden3/go-iden3-auth/pubsignals"
@@ -31,6 +33,7 @@ import (
 	"github.com/polygonid/sh-id-platform/internal/db"
 	"github.com/polygonid/sh-id-platform/internal/kms"
 	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
 	"github.com/polygonid/sh-id-platform/pkg/credentials/signature/circuit/signer"
 	"github.com/polygonid/sh-id-platform/pkg/credentials/signature/suite"
 	"github.com/polygonid/sh-id-platform/pkg/credentials/signature/suite/babyjubjub"
@@ -45,9 +48,9 @@ const (
 	authReason      = "authentication"
 )
 
-// ErrWrongDIDMetada - represents an error in the identity metadata
 var (
-	ErrWrongDIDMetada = errors.New("wrong DID Metadata")
+	ErrWrongDIDMetada     = errors.New("wrong DID Metadata")                    // ErrWrongDIDMetada - represents an error in the identity metadata
+	ErrSessionAlreadyUsed = errors.New("the session has already been answered") // ErrSessionAlreadyUsed - the callback of the session was already handled
 )
 
 type identity struct {
@@ -57,6 +60,7 @@ type identity struct {
 	claimsRepository        ports.ClaimsRepository
 	revocationRepository    ports.RevocationRepository
 	connectionsRepository   ports.ConnectionsRepository
+	outboxRepository        ports.EventOutboxRepository
 	sessionManager          ports.SessionRepository
 	storage                 *db.Storage
 	mtService               ports.MtService
@@ -77,6 +81,7 @@ func NewIdentity(kms kms.KMSType, identityRepository ports.IndentityRepository,
 		claimsRepository:        claimsRepository,
 		revocationRepository:    revocationRepository,
 		connectionsRepository:   connectionsRepository,
+		outboxRepository:        repositories.NewEventOutbox(),
 		sessionManager:          sessionRepository,
 		storage:                 storage,
 		mtService:               mtservice,
@@ -93,11 +98,21 @@ func (i *identity) GetByDID(ctx context.Context, identifier core.DID) (*domain.I
 }
 
 func (i *identity) Create(ctx context.Context, DIDMethod string, blockchain, networkID, hostURL string) (*domain.Identity, error) {
+	return i.create(ctx, domain.IdentityKeyTypeBJJ, DIDMethod, blockchain, networkID, hostURL)
+}
+
+// CreateEthIdentity creates an identity controlled by a new Ethereum key of the KMS. Its identifier is built from
+// the key address and its states are published with transactions signed with that key.
+func (i *identity) CreateEthIdentity(ctx context.Context, DIDMethod string, blockchain, networkID, hostURL string) (*domain.Identity, error) {
+	return i.create(ctx, domain.IdentityKeyTypeETH, DIDMethod, blockchain, networkID, hostURL)
+}
+
+func (i *identity) create(ctx context.Context, keyType domain.IdentityKeyType, DIDMethod string, blockchain, networkID, hostURL string) (*domain.Identity, error) {
 	var identifier *core.DID
 	var err error
 	err = i.storage.Pgx.BeginFunc(ctx,
 		func(tx pgx.Tx) error {
-			identifier, _, err = i.createIdentity(ctx, tx, DIDMethod, blockchain, networkID, hostURL)
+			identifier, _, err = i.createIdentity(ctx, tx, keyType, DIDMethod, blockchain, networkID, hostURL)
 			if err != nil {
 				if errors.Is(err, ErrWrongDIDMetada) {
 					return err
@@ -382,9 +397,25 @@ func (i *identity) Authenticate(ctx context.Context, message string, sessionID u
 	arm, err := i.verifier.FullVerify(ctx, message, authReq, pubsignals.WithAcceptedStateTransitionDelay(transitionDelay))
 	if err != nil {
 		log.Error(ctx, "authentication failed", "err", err)
+		message := "authentication failed"
+		if len(authReq.Body.Scope) > 0 {
+			message = "the proofs are not valid"
+		}
+		updateSessionState(ctx, i.sessionManager, i.pubsub, sessionID.String(), domain.SessionStatusFailed, message)
 		return nil, err
 	}
 
+	// a replayed response, or the same one sent to two nodes, must not create two connections
+	acquired, err := i.sessionManager.Acquire(ctx, sessionID.String())
+	if err != nil {
+		log.Error(ctx, "acquiring the authentication session", "err", err)
+		return nil, err
+	}
+	if !acquired {
+		log.Warn(ctx, "authentication session already answered", "sessionID", sessionID)
+		return nil, ErrSessionAlreadyUsed
+	}
+
 	issuerDoc := newDIDDocument(serverURL, issuerDID)
 	bytesIssuerDoc, err := json.Marshal(issuerDoc)
 	if err != nil {
@@ -407,17 +438,20 @@ func (i *identity) Authenticate(ctx context.Context, message string, sessionID u
 		CreatedAt:  time.Now(),
 		ModifiedAt: time.Now(),
 	}
-	connID, err := i.connectionsRepository.Save(ctx, i.storage.Pgx, conn)
+	err = i.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+		connID, err := i.connectionsRepository.Save(ctx, tx, conn)
+		if err != nil || connID != conn.ID {
+			return err
+		}
+		// a connection has been created so previously created credentials have to be sent
+		return notifyInTx(ctx, tx, i.outboxRepository, event.CreateConnectionEvent, issuerDID.String(), &event.CreateConnection{ConnectionID: connID.String(), IssuerID: issuerDID.String()})
+	})
 	if err != nil {
+		updateSessionState(ctx, i.sessionManager, i.pubsub, sessionID.String(), domain.SessionStatusFailed, "cannot create the connection")
 		return nil, err
 	}
 
-	if connID == conn.ID { // a connection has been created so previously created credentials have to be sent
-		err = i.pubsub.Publish(ctx, event.CreateConnectionEvent, &event.CreateConnection{ConnectionID: connID.String(), IssuerID: issuerDID.String()})
-		if err != nil {
-			log.Error(ctx, "sending connection notification", "err", err.Error(), "connection", connID)
-		}
-	}
+	updateSessionState(ctx, i.sessionManager, i.pubsub, sessionID.String(), domain.SessionStatusAuthenticated, "")
 
 	return arm, nil
 }
@@ -438,9 +472,13 @@ func (i *identity) CreateAuthenticationQRCode(ctx context.Context, serverURL str
 		},
 	}
 
-	err := i.sessionManager.Set(ctx, sessionID, *qrCode)
+	if err := i.sessionManager.Set(ctx, sessionID, *qrCode); err != nil {
+		return nil, err
+	}
+
+	updateSessionState(ctx, i.sessionManager, i.pubsub, sessionID, domain.SessionStatusPending, "")
 
-	return qrCode, err
+	return qrCode, nil
 }
 
 func (i *identity) update(ctx context.Context, conn db.Querier, id *core.DID, currentState domain.IdentityState) error {
@@ -458,7 +496,7 @@ func (i *identity) update(ctx context.Context, conn db.Querier, id *core.DID, cu
 		var err error
 		claims[j].IdentityState = currentState.State
 
-		affected, err := i.claimsRepository.UpdateState(ctx, i.storage.Pgx, &claims[j])
+		affected, err := i.claimsRepository.UpdateState(ctx, conn, &claims[j])
 		if err != nil {
 			return fmt.Errorf("can't update claim: %w", err)
 		}
@@ -519,7 +557,7 @@ func populateIdentityState(ctx context.Context, trees *domain.IdentityMerkleTree
 	return nil
 }
 
-func (i *identity) createIdentity(ctx context.Context, tx db.Querier, DIDMethod string, blockchain, networkID, hostURL string) (*core.DID, *big.Int, error) {
+func (i *identity) createIdentity(ctx context.Context, tx db.Querier, keyType domain.IdentityKeyType, DIDMethod string, blockchain, networkID, hostURL string) (*core.DID, *big.Int, error) {
 	mts, err := i.mtService.CreateIdentityMerkleTrees(ctx, tx)
 	if err != nil {
 		return nil, nil, fmt.Errorf("can't create identity markle tree: %w", err)
@@ -565,9 +603,21 @@ func (i *identity) createIdentity(ctx context.Context, tx db.Querier, DIDMethod
 		return nil, nil, ErrWrongDIDMetada
 	}
 
-	identifier, err := core.IdGenesisFromIdenState(didType, currentState.BigInt())
-	if err != nil {
-		return nil, nil, fmt.Errorf("can't genesis from state: %w", err)
+	var identifier *core.ID
+	var ethKey *kms.KeyID
+	var ethAddress ethCommon.Address
+	if keyType == domain.IdentityKeyTypeETH {
+		ethKeyID, address, err := i.newEthKey()
+		if err != nil {
+			return nil, nil, err
+		}
+		ethKey, ethAddress = &ethKeyID, address
+		identifier = common.ToPointer(common.EthIdentityID(didType, ethAddress))
+	} else {
+		identifier, err = core.IdGenesisFromIdenState(didType, currentState.BigInt())
+		if err != nil {
+			return nil, nil, fmt.Errorf("can't genesis from state: %w", err)
+		}
 	}
 
 	did, err := core.ParseDIDFromID(*identifier)
@@ -593,16 +643,68 @@ func (i *identity) createIdentity(ctx context.Context, tx db.Querier, DIDMethod
 	}
 
 	identity := domain.NewIdentityFromIdentifier(did, currentState.Hex())
+	if ethKey != nil {
+		if _, err = i.kms.LinkToIdentity(ctx, *ethKey, *did); err != nil {
+			return nil, nil, fmt.Errorf("can't link ethereum key to identity: %w", err)
+		}
+		identity.KeyType = domain.IdentityKeyTypeETH
+		identity.Address = common.ToPointer(ethAddress.Hex())
+	}
 	claimsTreeHex := claimsTree.Root().Hex()
 	identity.State.ClaimsTreeRoot = &claimsTreeHex
 
+	authClaimModel, err := newAuthClaimModel(did, hostURL, authClaim, pubKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mtpProof, err := i.getAuthClaimMtpProof(ctx, claimsTree, currentState, authClaim, did)
+	if err != nil {
+		return nil, nil, fmt.Errorf("can't add get current state from merkle tree: %w", err)
+	}
+
+	jsonProof, err := json.Marshal(mtpProof)
+	if err != nil {
+		return nil, nil, fmt.Errorf("can't marshal proof: %w", err)
+	}
+
+	err = authClaimModel.MTPProof.Set(jsonProof)
+	if err != nil {
+		return nil, nil, fmt.Errorf("can't set mtp proof to auth claim: %w", err)
+	}
+
+	if err = i.identityRepository.Save(ctx, tx, identity); err != nil {
+		return nil, nil, fmt.Errorf("can't save identity: %w", err)
+	}
+
+	// mark genesis state like `confirmed` state.
+	identity.State.Status = domain.StatusConfirmed
+	err = i.identityStateRepository.Save(ctx, tx, identity.State)
+	if err != nil {
+		return nil, nil, fmt.Errorf("can't save identity state: %w", err)
+	}
+
+	authClaimModel.IdentityState = identity.State.State
+	authClaimModel.Identifier = &identity.Identifier
+	authClaimModel.MtProof = true
+	_, err = i.claimsRepository.Save(ctx, tx, authClaimModel)
+	if err != nil {
+		return nil, nil, fmt.Errorf("can't save auth claim: %w", err)
+	}
+
+	return did, currentState.BigInt(), nil
+}
+
+// newAuthClaimModel returns the auth claim with its AuthBJJCredential, ready to be saved
+func newAuthClaimModel(did *core.DID, hostURL string, authClaim *core.Claim, pubKey *babyjub.PublicKey) (*domain.Claim, error) {
+	revNonce := authClaim.GetRevocationNonce()
 	claimData := make(map[string]interface{})
 	claimData["x"] = pubKey.X.String()
 	claimData["y"] = pubKey.Y.String()
 
 	marshalledClaimData, err := json.Marshal(claimData)
 	if err != nil {
-		return nil, nil, fmt.Errorf("can't marshal claim data: %w", err)
+		return nil, fmt.Errorf("can't marshal claim data: %w", err)
 	}
 
 	cr := common.CredentialRequest{
@@ -623,26 +725,26 @@ func (i *identity) createIdentity(ctx context.Context, tx db.Querier, DIDMethod
 	var schema jsonSuite.Schema
 	err = json.Unmarshal([]byte(domain.AuthBJJCredentialSchemaJSON), &schema)
 	if err != nil {
-		return nil, nil, fmt.Errorf("can't unmarshal the shema: %w", err)
+		return nil, fmt.Errorf("can't unmarshal the shema: %w", err)
 	}
 
 	var jsonLdContext string
 	if jsonLdContext, ok = schema.Metadata.Uris["jsonLdContext"].(string); !ok {
-		return nil, nil, fmt.Errorf("invalid: %w", err)
+		return nil, fmt.Errorf("invalid: %w", err)
 	}
 
 	credentialType := fmt.Sprintf("%s#%s", jsonLdContext, domain.AuthBJJCredential)
 	claimID, err := uuid.NewUUID()
 	if err != nil {
-		return nil, nil, fmt.Errorf("can't crate uuid: %w", err)
+		return nil, fmt.Errorf("can't crate uuid: %w", err)
 	}
 
 	cred, err := common.CreateCredential(did, cr, schema)
 	if err != nil {
-		return nil, nil, fmt.Errorf("can't create credential: %w", err)
+		return nil, fmt.Errorf("can't create credential: %w", err)
 	}
 
-	cred.ID = fmt.Sprintf("%s/v1/%s/claims/%s", strings.TrimSuffix(hostURL, "/"), identifier, claimID)
+	cred.ID = fmt.Sprintf("%s/v1/%s/claims/%s", strings.TrimSuffix(hostURL, "/"), did.ID, claimID)
 	cs := &verifiable.CredentialStatus{
 		ID: fmt.Sprintf("%s/v1/%s/claims/revocation/status/%d",
 			hostURL, url.QueryEscape(did.String()), revNonce),
@@ -654,61 +756,26 @@ func (i *identity) createIdentity(ctx context.Context, tx db.Querier, DIDMethod
 
 	marshaledCredential, err := json.Marshal(cred)
 	if err != nil {
-		return nil, nil, fmt.Errorf("can't marshal credential: %w", err)
+		return nil, fmt.Errorf("can't marshal credential: %w", err)
 	}
 
 	authClaimModel, err := domain.FromClaimer(authClaim, domain.AuthBJJCredentialJSONSchemaURL, credentialType)
 	if err != nil {
-		return nil, nil, fmt.Errorf("can't create authClaimModel: %w", err)
-	}
-
-	mtpProof, err := i.getAuthClaimMtpProof(ctx, claimsTree, currentState, authClaim, did)
-	if err != nil {
-		return nil, nil, fmt.Errorf("can't add get current state from merkle tree: %w", err)
+		return nil, fmt.Errorf("can't create authClaimModel: %w", err)
 	}
 
 	err = authClaimModel.Data.Set(marshaledCredential)
 	if err != nil {
-		return nil, nil, fmt.Errorf("can't set data to auth claim: %w", err)
+		return nil, fmt.Errorf("can't set data to auth claim: %w", err)
 	}
 
 	err = authClaimModel.CredentialStatus.Set(cs)
 	if err != nil {
-		return nil, nil, fmt.Errorf("can't set credential status to auth claim: %w", err)
-	}
-
-	jsonProof, err := json.Marshal(mtpProof)
-	if err != nil {
-		return nil, nil, fmt.Errorf("can't marshal proof: %w", err)
-	}
-
-	err = authClaimModel.MTPProof.Set(jsonProof)
-	if err != nil {
-		return nil, nil, fmt.Errorf("can't set mtp proof to auth claim: %w", err)
+		return nil, fmt.Errorf("can't set credential status to auth claim: %w", err)
 	}
 
 	authClaimModel.Issuer = did.String()
-
-	if err = i.identityRepository.Save(ctx, tx, identity); err != nil {
-		return nil, nil, fmt.Errorf("can't save identity: %w", err)
-	}
-
-	// mark genesis state like `confirmed` state.
-	identity.State.Status = domain.StatusConfirmed
-	err = i.identityStateRepository.Save(ctx, tx, identity.State)
-	if err != nil {
-		return nil, nil, fmt.Errorf("can't save identity state: %w", err)
-	}
-
-	authClaimModel.IdentityState = identity.State.State
-	authClaimModel.Identifier = &identity.Identifier
-	authClaimModel.MtProof = true
-	_, err = i.claimsRepository.Save(ctx, tx, authClaimModel)
-	if err != nil {
-		return nil, nil, fmt.Errorf("can't save auth claim: %w", err)
-	}
-
-	return did, currentState.BigInt(), nil
+	return authClaimModel, nil
 }
 
 func (i *identity) getAuthClaimMtpProof(ctx context.Context, claimsTree *merkletree.MerkleTree, currentState *merkletree.Hash, authClaim *core.Claim, did *core.DID) (verifiable.Iden3SparseMerkleTreeProof, error) {
@@ -796,6 +863,25 @@ func (i *identity) GetFailedState(ctx context.Context, identifier core.DID) (*do
 	return nil, nil
 }
 
+// GetRevocations returns the revocations of the identity with the given nonces, whether they are published or not
+func (i *identity) GetRevocations(ctx context.Context, identifier core.DID, nonces []domain.RevNonceUint64) ([]*domain.Revocation, error) {
+	if len(nonces) == 0 {
+		return nil, nil
+	}
+	return i.revocationRepository.GetByNonces(ctx, i.storage.Pgx, &identifier, nonces)
+}
+
+// GetRevocationList returns the nonces revoked by the identity since the given time, or all of them if it is nil,
+// whether they are published or not
+func (i *identity) GetRevocationList(ctx context.Context, identifier core.DID, since *time.Time) (*domain.RevocationList, error) {
+	syncedAt := time.Now().UTC()
+	nonces, err := i.revocationRepository.GetRevokedNonces(ctx, i.storage.Pgx, &identifier, since)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.RevocationList{IssuerDID: identifier, Since: since, SyncedAt: syncedAt, Nonces: nonces}, nil
+}
+
 // newAuthClaim generate BabyJubKeyTypeAuthorizeKSign claimL
 func newAuthClaim(key *babyjub.PublicKey) (*core.Claim, error) {
 	revNonce, err := common.RandInt64()
@@ -807,6 +893,24 @@ func newAuthClaim(key *babyjub.PublicKey) (*core.Claim, error) {
 		core.WithRevocationNonce(revNonce))
 }
 
+// newEthKey creates an Ethereum key that is not linked to any identity yet, because the identifier of the
+// identity it controls is built from its address
+func (i *identity) newEthKey() (kms.KeyID, ethCommon.Address, error) {
+	key, err := i.kms.CreateKey(kms.KeyTypeEthereum, nil)
+	if err != nil {
+		return kms.KeyID{}, ethCommon.Address{}, fmt.Errorf("can't create ethereum key: %w", err)
+	}
+	keyBytes, err := i.kms.PublicKey(key)
+	if err != nil {
+		return kms.KeyID{}, ethCommon.Address{}, fmt.Errorf("can't get ethereum public key: %w", err)
+	}
+	pubKey, err := kms.DecodeETHPubKey(keyBytes)
+	if err != nil {
+		return kms.KeyID{}, ethCommon.Address{}, fmt.Errorf("can't decode ethereum public key: %w", err)
+	}
+	return key, crypto.PubkeyToAddress(*pubKey), nil
+}
+
 func bjjPubKey(keyMS kms.KMSType, keyID kms.KeyID) (*babyjub.PublicKey, error) {
 	keyBytes, err := keyMS.PublicKey(keyID)
 	if err != nil {
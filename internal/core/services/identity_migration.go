@@ -0,0 +1,363 @@
+package services
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	core "github.com/iden3/go-iden3-core"
+	"github.com/iden3/go-merkletree-sql/v2"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/kms"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+var (
+	ErrIdentityMigrationNotFound         = errors.New("identity is not being migrated")                              // ErrIdentityMigrationNotFound the identity has no migration
+	ErrIdentityMigrationIdentityNotFound = errors.New("identity not found")                                          // ErrIdentityMigrationIdentityNotFound the identity to migrate does not exist
+	ErrIdentityMigrated                  = errors.New("identity was migrated to another node")                       // ErrIdentityMigrated the migration is already completed
+	ErrIdentityMigrationPending          = errors.New("identity has a state transition or key rotation in progress") // ErrIdentityMigrationPending the export has to wait for the pending transactions
+	ErrIdentityExportIncompatible        = errors.New("identity export is not compatible with this node")            // ErrIdentityExportIncompatible the export has another format or database schema version
+	ErrIdentityImportExists              = errors.New("identity already exists in this node")                        // ErrIdentityImportExists the imported identity already exists
+	ErrIdentityImportKey                 = errors.New("identity key is not available in this node")                  // ErrIdentityImportKey the key store of the node has no key with the exported public key
+	ErrIdentityImportVerification        = errors.New("imported identity does not match the export")                 // ErrIdentityImportVerification the imported data differs from the exported one
+)
+
+type identityMigration struct {
+	repo      ports.IdentityMigrationRepository
+	mtService ports.MtService
+	kms       kms.KMSType
+	storage   *db.Storage
+}
+
+// NewIdentityMigration returns a new identity migration service
+func NewIdentityMigration(repo ports.IdentityMigrationRepository, mtService ports.MtService, kms kms.KMSType, storage *db.Storage) ports.IdentityMigrationService {
+	return &identityMigration{
+		repo:      repo,
+		mtService: mtService,
+		kms:       kms,
+		storage:   storage,
+	}
+}
+
+// Start makes the identity read only until the migration is completed or aborted. Starting an ongoing migration
+// updates its target.
+func (m *identityMigration) Start(ctx context.Context, did core.DID, target *string) (*domain.IdentityMigration, error) {
+	migration, err := m.Get(ctx, did)
+	if err != nil && !errors.Is(err, ErrIdentityMigrationNotFound) {
+		return nil, err
+	}
+	if migration != nil && migration.Status == domain.IdentityMigrationCompleted {
+		return nil, ErrIdentityMigrated
+	}
+
+	migration = &domain.IdentityMigration{
+		Identifier: did.String(),
+		Status:     domain.IdentityMigrationReadOnly,
+		Target:     target,
+	}
+	err = m.repo.Save(ctx, m.storage.Pgx, migration)
+	if errors.Is(err, repositories.ErrIdentityMigrationIdentityNotFound) {
+		return nil, ErrIdentityMigrationIdentityNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	log.Info(ctx, "identity migration started, the identity is read only", "did", did.String())
+	return migration, nil
+}
+
+// Get returns the migration of the identity
+func (m *identityMigration) Get(ctx context.Context, did core.DID) (*domain.IdentityMigration, error) {
+	migration, err := m.repo.Get(ctx, m.storage.Pgx, did)
+	if errors.Is(err, repositories.ErrIdentityMigrationNotFound) {
+		return nil, ErrIdentityMigrationNotFound
+	}
+	return migration, err
+}
+
+// IsReadOnly returns true while the identity is being migrated and after it has been migrated. If the migration
+// can't be read the identity is considered writable, the write would fail anyway if the database is down.
+func (m *identityMigration) IsReadOnly(ctx context.Context, did core.DID) bool {
+	_, err := m.Get(ctx, did)
+	if errors.Is(err, ErrIdentityMigrationNotFound) {
+		return false
+	}
+	if err != nil {
+		log.Warn(ctx, "cannot load identity migration", "err", err, "did", did.String())
+		return false
+	}
+	return true
+}
+
+// Export returns the data of a read only identity. The identity can't have transactions in progress, the node
+// would not be able to confirm them after the identity is moved.
+func (m *identityMigration) Export(ctx context.Context, did core.DID) (*domain.IdentityExport, error) {
+	if _, err := m.Get(ctx, did); err != nil {
+		return nil, err
+	}
+	return m.export(ctx, did)
+}
+
+// Backup returns the data of an identity, as Export does, without making it read only. The export is a consistent
+// snapshot of the identity, the changes made after it are not in the backup.
+func (m *identityMigration) Backup(ctx context.Context, did core.DID) (*domain.IdentityExport, error) {
+	export, err := m.export(ctx, did)
+	if err != nil {
+		return nil, err
+	}
+	var identities []json.RawMessage
+	if err := json.Unmarshal(export.Tables["identities"], &identities); err != nil {
+		return nil, err
+	}
+	if len(identities) == 0 {
+		return nil, ErrIdentityMigrationIdentityNotFound
+	}
+	return export, nil
+}
+
+func (m *identityMigration) export(ctx context.Context, did core.DID) (*domain.IdentityExport, error) {
+	export := &domain.IdentityExport{
+		Version:    domain.IdentityExportVersion,
+		Identifier: did.String(),
+		ExportedAt: time.Now().UTC(),
+	}
+	// a read only snapshot makes the rows and the merkle trees consistent with each other
+	err := m.storage.Pgx.BeginTxFunc(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly}, func(tx pgx.Tx) error {
+		pending, err := m.repo.HasPendingTransactions(ctx, tx, did)
+		if err != nil {
+			return err
+		}
+		if pending {
+			return ErrIdentityMigrationPending
+		}
+
+		if export.SchemaVersion, err = m.repo.SchemaVersion(ctx, tx); err != nil {
+			return err
+		}
+		if export.Tables, export.MerkleTrees, err = m.repo.Export(ctx, tx, did); err != nil {
+			return err
+		}
+		export.Roots, err = m.roots(ctx, tx, did)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	keyIDs, err := m.kms.KeysByIdentity(ctx, did)
+	if err != nil {
+		return nil, fmt.Errorf("can't list identity keys: %w", err)
+	}
+	for _, keyID := range keyIDs {
+		pubKey, err := m.kms.PublicKey(keyID)
+		if err != nil {
+			return nil, fmt.Errorf("can't get public key of %s: %w", keyID.ID, err)
+		}
+		export.Keys = append(export.Keys, domain.IdentityExportKey{
+			Type:      string(keyID.Type),
+			ID:        keyID.ID,
+			PublicKey: hex.EncodeToString(pubKey),
+		})
+	}
+	return export, nil
+}
+
+// Import creates the exported identity in this node and verifies it. Keys are the IDs of the identity keys in the
+// key store of this node by exported key ID, for the keys that were imported with another ID. Those keys are linked
+// to the identity. Every other key must be accessible with the same ID.
+func (m *identityMigration) Import(ctx context.Context, export *domain.IdentityExport, keys map[string]string) (*domain.IdentityImportResult, error) {
+	if err := m.checkCompatible(ctx, export); err != nil {
+		return nil, err
+	}
+	did, err := core.ParseDID(export.Identifier)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrIdentityExportIncompatible, err)
+	}
+
+	keyIDs, err := m.keysInStore(export, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &domain.IdentityImportResult{Identifier: export.Identifier}
+	err = m.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+		rows, err := m.repo.Import(ctx, tx, export)
+		if errors.Is(err, repositories.ErrIdentityAlreadyExists) {
+			return ErrIdentityImportExists
+		}
+		if err != nil {
+			return err
+		}
+		if err := verifyRowCounts(export, rows); err != nil {
+			return err
+		}
+		result.Rows = rows
+
+		roots, err := m.roots(ctx, tx, *did)
+		if err != nil {
+			return err
+		}
+		if roots != export.Roots {
+			return fmt.Errorf("%w: merkle tree roots differ", ErrIdentityImportVerification)
+		}
+		result.Roots = roots
+		if result.State, err = identityState(roots); err != nil {
+			return err
+		}
+
+		// the keys are re-pointed last, so the identity is only linked to them when everything else is fine
+		for i, keyID := range keyIDs {
+			if keys[export.Keys[i].ID] == "" {
+				result.Keys = append(result.Keys, keyID.ID)
+				continue
+			}
+			linked, err := m.kms.LinkToIdentity(ctx, keyID, *did)
+			if err != nil {
+				return fmt.Errorf("can't link key %s to the identity: %w", keyID.ID, err)
+			}
+			result.Keys = append(result.Keys, linked.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	log.Info(ctx, "identity imported", "did", export.Identifier, "rows", result.Rows)
+	return result, nil
+}
+
+// Complete marks the migration as completed. The identity stays read only in this node.
+func (m *identityMigration) Complete(ctx context.Context, did core.DID) (*domain.IdentityMigration, error) {
+	migration, err := m.Get(ctx, did)
+	if err != nil {
+		return nil, err
+	}
+	if migration.Status == domain.IdentityMigrationCompleted {
+		return migration, nil
+	}
+
+	migration.Status = domain.IdentityMigrationCompleted
+	migration.CompletedAt = common.ToPointer(time.Now().UTC())
+	if err := m.repo.Save(ctx, m.storage.Pgx, migration); err != nil {
+		return nil, err
+	}
+	log.Info(ctx, "identity migration completed", "did", did.String(), "target", migration.Target)
+	return migration, nil
+}
+
+// Abort cancels an ongoing migration, so the identity is writable again. Completed migrations can't be aborted,
+// the identity may have changed in the target node.
+func (m *identityMigration) Abort(ctx context.Context, did core.DID) error {
+	migration, err := m.Get(ctx, did)
+	if err != nil {
+		return err
+	}
+	if migration.Status == domain.IdentityMigrationCompleted {
+		return ErrIdentityMigrated
+	}
+	if err := m.repo.Delete(ctx, m.storage.Pgx, did); err != nil {
+		return err
+	}
+	log.Info(ctx, "identity migration aborted, the identity is writable", "did", did.String())
+	return nil
+}
+
+func (m *identityMigration) checkCompatible(ctx context.Context, export *domain.IdentityExport) error {
+	if export.Version != domain.IdentityExportVersion {
+		return fmt.Errorf("%w: unsupported export version %d", ErrIdentityExportIncompatible, export.Version)
+	}
+	schemaVersion, err := m.repo.SchemaVersion(ctx, m.storage.Pgx)
+	if err != nil {
+		return err
+	}
+	if export.SchemaVersion != schemaVersion {
+		return fmt.Errorf("%w: the export database schema version is %d and the node one is %d",
+			ErrIdentityExportIncompatible, export.SchemaVersion, schemaVersion)
+	}
+	return nil
+}
+
+// keysInStore returns the key IDs of the exported keys in this node, checking they have the exported public keys
+func (m *identityMigration) keysInStore(export *domain.IdentityExport, keys map[string]string) ([]kms.KeyID, error) {
+	keyIDs := make([]kms.KeyID, 0, len(export.Keys))
+	for _, key := range export.Keys {
+		keyID := kms.KeyID{Type: kms.KeyType(key.Type), ID: key.ID}
+		if id := keys[key.ID]; id != "" {
+			keyID.ID = id
+		}
+		pubKey, err := m.kms.PublicKey(keyID)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %s", ErrIdentityImportKey, keyID.ID, err)
+		}
+		if hex.EncodeToString(pubKey) != key.PublicKey {
+			return nil, fmt.Errorf("%w: %s has another public key", ErrIdentityImportKey, keyID.ID)
+		}
+		keyIDs = append(keyIDs, keyID)
+	}
+	return keyIDs, nil
+}
+
+func (m *identityMigration) roots(ctx context.Context, conn db.Querier, did core.DID) (domain.IdentityExportRoots, error) {
+	trees, err := m.mtService.GetIdentityMerkleTrees(ctx, conn, &did)
+	if err != nil {
+		return domain.IdentityExportRoots{}, err
+	}
+	claimsTree, err := trees.ClaimsTree()
+	if err != nil {
+		return domain.IdentityExportRoots{}, err
+	}
+	revTree, err := trees.RevsTree()
+	if err != nil {
+		return domain.IdentityExportRoots{}, err
+	}
+	rootsTree, err := trees.RootsTree()
+	if err != nil {
+		return domain.IdentityExportRoots{}, err
+	}
+	return domain.IdentityExportRoots{
+		Claims:      claimsTree.Root().Hex(),
+		Revocations: revTree.Root().Hex(),
+		Roots:       rootsTree.Root().Hex(),
+	}, nil
+}
+
+// identityState returns the state of the identity with the given tree roots
+func identityState(roots domain.IdentityExportRoots) (*string, error) {
+	hashes := make([]*merkletree.Hash, 0, 3)
+	for _, root := range []string{roots.Claims, roots.Revocations, roots.Roots} {
+		hash, err := merkletree.NewHashFromHex(root)
+		if err != nil {
+			return nil, err
+		}
+		hashes = append(hashes, hash)
+	}
+	state, err := merkletree.HashElems(hashes[0].BigInt(), hashes[1].BigInt(), hashes[2].BigInt())
+	if err != nil {
+		return nil, err
+	}
+	return common.ToPointer(state.Hex()), nil
+}
+
+// verifyRowCounts checks every exported row was imported
+func verifyRowCounts(export *domain.IdentityExport, rows map[string]int) error {
+	for table, data := range export.Tables {
+		var exported []json.RawMessage
+		if err := json.Unmarshal(data, &exported); err != nil {
+			return fmt.Errorf("%w: %s rows are not an array", ErrIdentityExportIncompatible, table)
+		}
+		if rows[table] != len(exported) {
+			return fmt.Errorf("%w: %d of %d %s rows imported", ErrIdentityImportVerification, rows[table], len(exported), table)
+		}
+	}
+	return nil
+}
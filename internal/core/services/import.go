@@ -0,0 +1,356 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/jsonschema"
+	"github.com/polygonid/sh-id-platform/internal/loader"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+var (
+	// ErrInvalidImport the import file or its options cannot be used to issue credentials
+	ErrInvalidImport = errors.New("invalid import")
+	// ErrImportJobNotFound the import job does not exist
+	ErrImportJobNotFound = errors.New("import job not found")
+)
+
+type importService struct {
+	repo          ports.ImportJobRepository
+	jobs          ports.JobQueueService
+	schemaRepo    ports.SchemaRepository
+	claimsService ports.ClaimsService
+	linkService   ports.LinkService
+	loaderFactory loader.Factory
+	storage       *db.Storage
+}
+
+// importPayload is the payload of the background job of an import: the rows of the file with their values, and the
+// JSON Schema type of every column
+type importPayload struct {
+	ImportJobID uuid.UUID         `json:"importJobID"`
+	Types       map[string]string `json:"types"`
+	Rows        []importRow       `json:"rows"`
+}
+
+type importRow struct {
+	Number    int               `json:"number"`
+	Reference string            `json:"reference"`
+	Fields    map[string]string `json:"fields,omitempty"`
+	Error     *string           `json:"error,omitempty"`
+}
+
+// NewImport returns a new import service. The rows are processed by the import jobs of the queue.
+func NewImport(repo ports.ImportJobRepository, jobs ports.JobQueueService, schemaRepo ports.SchemaRepository, claimsService ports.ClaimsService, linkService ports.LinkService, loaderFactory loader.Factory, storage *db.Storage) ports.ImportService {
+	return &importService{
+		repo:          repo,
+		jobs:          jobs,
+		schemaRepo:    schemaRepo,
+		claimsService: claimsService,
+		linkService:   linkService,
+		loaderFactory: loaderFactory,
+		storage:       storage,
+	}
+}
+
+// Start checks the columns of the file against the attributes of the schema and stores the job. The rows are
+// processed by a background job of the queue, the progress of the job and the result of every row can be read while
+// they are.
+func (i *importService) Start(ctx context.Context, job *domain.ImportJob, content io.Reader, mapping map[string]string, referenceColumn string) (*domain.ImportJob, error) {
+	if err := job.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidImport, err)
+	}
+	schema, err := i.schemaRepo.GetByID(ctx, job.IssuerDID, job.SchemaID)
+	if errors.Is(err, repositories.ErrSchemaDoesNotExist) {
+		return nil, ErrSchemaNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if schema.Status() == domain.SchemaStatusDeprecated {
+		return nil, ErrSchemaDeprecated
+	}
+
+	if referenceColumn == "" {
+		referenceColumn = domain.DefaultImportReferenceColumn
+	}
+	columns, rows, err := domain.ParseImportCSV(content, mapping, referenceColumn)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidImport, err)
+	}
+
+	jsonSchema, err := jsonschema.Load(ctx, i.loaderFactory(schema.URL))
+	if err != nil {
+		log.Error(ctx, "loading schema", "err", err, "schema", schema.URL)
+		return nil, ErrLoadingSchema
+	}
+	types, err := i.attributeTypes(jsonSchema, job.Mode, columns)
+	if err != nil {
+		return nil, err
+	}
+
+	job.TotalRows = len(rows)
+	payload := importPayload{ImportJobID: job.ID, Types: types, Rows: make([]importRow, len(rows))}
+	for n, row := range rows {
+		payload.Rows[n] = importRow{Number: row.Number, Reference: row.Reference, Fields: row.Fields, Error: row.Error}
+	}
+	err = i.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+		if err := i.repo.Save(ctx, tx, job); err != nil {
+			return err
+		}
+		_, err := i.jobs.Enqueue(ctx, tx, domain.JobKindImport, job.IssuerDID, payload)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	log.Info(ctx, "import job started", "id", job.ID, "mode", job.Mode, "schema", schema.URL, "rows", job.TotalRows)
+	return job, nil
+}
+
+// Process is the handler of the import jobs of the queue. It processes the rows that have no result yet, so a job
+// that stopped resumes after the last row it stored.
+func (i *importService) Process(ctx context.Context, queued *domain.Job) error {
+	var payload importPayload
+	if err := json.Unmarshal(queued.Payload, &payload); err != nil {
+		return err
+	}
+	issuerDID, err := core.ParseDID(queued.IssuerDID)
+	if err != nil {
+		return err
+	}
+	job, err := i.repo.GetByID(ctx, i.storage.Pgx, *issuerDID, payload.ImportJobID)
+	if err != nil {
+		return err
+	}
+	if job.Status == domain.ImportJobCompleted {
+		return nil
+	}
+	schema, err := i.schemaRepo.GetByID(ctx, job.IssuerDID, job.SchemaID)
+	if err != nil {
+		return err
+	}
+	jsonSchema, err := jsonschema.Load(ctx, i.loaderFactory(schema.URL))
+	if err != nil {
+		return err
+	}
+
+	stored, err := i.repo.GetRows(ctx, i.storage.Pgx, job.ID, false)
+	if err != nil {
+		return err
+	}
+	done := make(map[int]bool, len(stored))
+	for _, row := range stored {
+		done[row.Number] = true
+	}
+	rows := make([]*domain.ImportRow, 0, len(payload.Rows)-len(stored))
+	for _, row := range payload.Rows {
+		if !done[row.Number] {
+			rows = append(rows, &domain.ImportRow{Number: row.Number, Reference: row.Reference, Fields: row.Fields, Error: row.Error})
+		}
+	}
+	return i.run(ctx, job, schema, jsonSchema, payload.Types, rows)
+}
+
+// GetByID returns the import job with its progress
+func (i *importService) GetByID(ctx context.Context, issuerDID core.DID, id uuid.UUID) (*domain.ImportJob, error) {
+	job, err := i.repo.GetByID(ctx, i.storage.Pgx, issuerDID, id)
+	if errors.Is(err, repositories.ErrImportJobNotFound) {
+		return nil, ErrImportJobNotFound
+	}
+	return job, err
+}
+
+// GetRows returns the processed rows of the import job, only the failed ones if onlyErrors is true
+func (i *importService) GetRows(ctx context.Context, issuerDID core.DID, id uuid.UUID, onlyErrors bool) ([]*domain.ImportRow, error) {
+	if _, err := i.GetByID(ctx, issuerDID, id); err != nil {
+		return nil, err
+	}
+	return i.repo.GetRows(ctx, i.storage.Replica, id, onlyErrors)
+}
+
+// attributeTypes returns the JSON Schema type of every column. The holder of the credentials is in the id column, so
+// the file must have it to issue credentials and it cannot have it to create links.
+func (i *importService) attributeTypes(schema *jsonschema.JSONSchema, mode domain.ImportMode, columns []string) (map[string]string, error) {
+	attributes, err := schema.Attributes()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrProcessSchema, err)
+	}
+	schemaTypes := make(map[string]string, len(attributes))
+	for _, attr := range attributes {
+		schemaTypes[attr.ID] = attr.Type
+	}
+
+	types := make(map[string]string, len(columns))
+	for _, column := range columns {
+		typ, ok := schemaTypes[column]
+		if !ok {
+			return nil, fmt.Errorf("%w: the schema has no attribute %q", ErrInvalidImport, column)
+		}
+		if typ == "object" || typ == "array" {
+			return nil, fmt.Errorf("%w: attribute %q is an %s and cannot be imported", ErrInvalidImport, column, typ)
+		}
+		types[column] = typ
+	}
+
+	_, hasHolder := types["id"]
+	if mode == domain.ImportModeClaims && !hasHolder {
+		return nil, fmt.Errorf("%w: the id column with the DID of the holder is required to issue credentials", ErrInvalidImport)
+	}
+	if mode == domain.ImportModeLinks && hasHolder {
+		return nil, fmt.Errorf("%w: links are issued to whoever uses them, the file cannot have an id column", ErrInvalidImport)
+	}
+	return types, nil
+}
+
+// run processes the rows in order, storing the result of every row and the progress of the job. The job fails if the
+// results cannot be stored, and the error is returned so the queue runs it again. A row that cannot be issued only
+// fails itself.
+func (i *importService) run(ctx context.Context, job *domain.ImportJob, schema *domain.Schema, jsonSchema *jsonschema.JSONSchema, types map[string]string, rows []*domain.ImportRow) error {
+	job.Status = domain.ImportJobRunning
+	job.Error = nil
+	job.FinishedAt = nil
+	err := i.repo.Save(ctx, i.storage.Pgx, job)
+	for _, row := range rows {
+		if err != nil {
+			break
+		}
+		row.JobID = job.ID
+		if row.Error == nil {
+			if err := i.process(ctx, job, schema, jsonSchema, types, row); err != nil {
+				row.Fail(err)
+			}
+		}
+		if row.Error != nil {
+			job.FailedRows++
+		}
+		if err = i.repo.SaveRow(ctx, i.storage.Pgx, row); err != nil {
+			break
+		}
+		job.ProcessedRows++
+		err = i.repo.Save(ctx, i.storage.Pgx, job)
+	}
+
+	job.Finish(err)
+	if err != nil {
+		log.Error(ctx, "import job failed", "err", err, "id", job.ID, "processed", job.ProcessedRows)
+	}
+	if saveErr := i.repo.Save(ctx, i.storage.Pgx, job); saveErr != nil {
+		log.Error(ctx, "saving import job", "err", saveErr, "id", job.ID)
+		return saveErr
+	}
+	if err != nil {
+		return err
+	}
+	log.Info(ctx, "import job finished", "id", job.ID, "status", job.Status, "rows", job.TotalRows, "failed", job.FailedRows)
+	return nil
+}
+
+// process issues the credential or creates the link of a row
+func (i *importService) process(ctx context.Context, job *domain.ImportJob, schema *domain.Schema, jsonSchema *jsonschema.JSONSchema, types map[string]string, row *domain.ImportRow) error {
+	credentialSubject := make(domain.CredentialSubject, len(row.Fields))
+	for attr, value := range row.Fields {
+		v, err := importValue(value, types[attr])
+		if err != nil {
+			return fmt.Errorf("%s: %w", attr, err)
+		}
+		credentialSubject[attr] = v
+	}
+
+	if job.Mode == domain.ImportModeLinks {
+		if err := validateLinkSubject(ctx, jsonSchema, schema.Type, credentialSubject); err != nil {
+			return err
+		}
+		link, err := i.linkService.Save(ctx, job.IssuerDID, common.ToPointer(1), nil, job.SchemaID, job.CredentialExpiration, job.SignatureProof, job.MTProof, credentialSubject, nil, nil, nil, false)
+		if err != nil {
+			return err
+		}
+		row.LinkID = &link.ID
+		return nil
+	}
+
+	req := ports.NewCreateClaimRequest(&job.IssuerDID,
+		schema.URL,
+		credentialSubject,
+		job.CredentialExpiration,
+		schema.Type,
+		nil, nil, nil,
+		common.ToPointer(job.SignatureProof),
+		common.ToPointer(job.MTProof),
+		nil,
+		true,
+	)
+	claim, err := i.claimsService.Save(ctx, req)
+	if err != nil {
+		return err
+	}
+	row.ClaimID = &claim.ID
+	return nil
+}
+
+// validateLinkSubject checks the attributes of a link row against the schema. The link service does it too but it
+// does not tell which attributes are wrong. The holder is only known when the link is used, so its requirement is
+// not checked.
+func validateLinkSubject(ctx context.Context, schema *jsonschema.JSONSchema, schemaType string, credentialSubject domain.CredentialSubject) error {
+	subject := make(map[string]any, len(credentialSubject)+1)
+	for k, v := range credentialSubject {
+		subject[k] = v
+	}
+	subject["type"] = schemaType
+
+	err := schema.ValidateSubject(ctx, subject)
+	var subjectErr *jsonschema.SubjectError
+	if !errors.As(err, &subjectErr) {
+		return err
+	}
+	fields := make([]jsonschema.FieldError, 0, len(subjectErr.Fields))
+	for _, f := range subjectErr.Fields {
+		if f.Field != "id" {
+			fields = append(fields, f)
+		}
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+	return &jsonschema.SubjectError{Fields: fields}
+}
+
+// importValue converts a value of the file to the JSON Schema type of its attribute
+func importValue(value string, typ string) (any, error) {
+	switch typ {
+	case "integer":
+		v, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not an integer", value)
+		}
+		return v, nil
+	case "number":
+		v, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a number", value)
+		}
+		return v, nil
+	case "boolean":
+		v, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a boolean", value)
+		}
+		return v, nil
+	default:
+		return value, nil
+	}
+}
@@ -0,0 +1,92 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	core "github.com/iden3/go-iden3-core"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+var (
+	// ErrInvalidIssuerProfile a field of the issuer profile has a value that cannot be shown
+	ErrInvalidIssuerProfile = errors.New("invalid issuer profile")
+	// ErrIssuerProfileIdentityNotFound the identity of the issuer profile does not exist
+	ErrIssuerProfileIdentityNotFound = errors.New("identity not found")
+)
+
+// IssuerProfileCfg is the name and the logo of the identities that do not have a profile
+type IssuerProfileCfg struct {
+	DisplayName string
+	LogoURL     string
+}
+
+type issuerProfile struct {
+	repo     ports.IssuerProfileRepository
+	storage  *db.Storage
+	defaults domain.IssuerProfile
+}
+
+// NewIssuerProfile returns a new issuer profile service
+func NewIssuerProfile(repo ports.IssuerProfileRepository, storage *db.Storage, cfg IssuerProfileCfg) ports.IssuerProfileService {
+	return &issuerProfile{
+		repo:     repo,
+		storage:  storage,
+		defaults: domain.IssuerProfile{DisplayName: cfg.DisplayName, LogoURL: cfg.LogoURL},
+	}
+}
+
+// Get returns the profile of the identity, or the configured one if it has none
+func (p *issuerProfile) Get(ctx context.Context, did core.DID) (*domain.IssuerProfile, error) {
+	profile, err := p.repo.GetByIdentifier(ctx, p.storage.Pgx, did)
+	if errors.Is(err, repositories.ErrIssuerProfileNotFound) {
+		return p.defaultProfile(did.String()), nil
+	}
+	return profile, err
+}
+
+// Update changes the given fields of the profile of the identity. The first update of an identity starts from the
+// configured profile.
+func (p *issuerProfile) Update(ctx context.Context, did core.DID, update *ports.IssuerProfileUpdate) (*domain.IssuerProfile, error) {
+	profile, err := p.Get(ctx, did)
+	if err != nil {
+		return nil, err
+	}
+	for field, value := range map[*string]*string{
+		&profile.DisplayName:     update.DisplayName,
+		&profile.LogoURL:         update.LogoURL,
+		&profile.BackgroundColor: update.BackgroundColor,
+		&profile.TextColor:       update.TextColor,
+		&profile.ContactEmail:    update.ContactEmail,
+		&profile.ContactURL:      update.ContactURL,
+	} {
+		if value != nil {
+			*field = *value
+		}
+	}
+	if err := profile.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidIssuerProfile, err)
+	}
+
+	profile.Overridden = true
+	if err := p.repo.Save(ctx, p.storage.Pgx, profile); err != nil {
+		if errors.Is(err, repositories.ErrIssuerProfileIdentityNotFound) {
+			return nil, ErrIssuerProfileIdentityNotFound
+		}
+		return nil, err
+	}
+	log.Info(ctx, "issuer profile updated", "did", did.String(), "displayName", profile.DisplayName)
+	return profile, nil
+}
+
+func (p *issuerProfile) defaultProfile(identifier string) *domain.IssuerProfile {
+	profile := p.defaults
+	profile.Identifier = identifier
+	return &profile
+}
@@ -0,0 +1,235 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+var (
+	// ErrJobNotFound the job does not exist
+	ErrJobNotFound = errors.New("job not found")
+	// ErrJobConflict the job cannot be retried or cancelled in its current status
+	ErrJobConflict = errors.New("job conflict")
+)
+
+const (
+	defaultJobMaxAttempts   = 5                  // defaultJobMaxAttempts is the number of attempts of a job if not configured
+	defaultJobLockTimeout   = 5 * time.Minute    // defaultJobLockTimeout is the time without heartbeats to rescue a job if not configured
+	defaultJobNotReadyDelay = 15 * time.Second   // defaultJobNotReadyDelay is the wait of a job that is not ready if not configured
+	defaultJobRetention     = 7 * 24 * time.Hour // defaultJobRetention is the time the finished jobs are kept if not configured
+	defaultJobsLimit        = 100                // defaultJobsLimit is the number of jobs listed if not set
+)
+
+// JobQueueCfg configures the background jobs queue
+type JobQueueCfg struct {
+	MaxAttempts   int           // Attempts of a job before it goes to the dead letter
+	LockTimeout   time.Duration // Time without heartbeats after which a running job is sent back to the queue
+	NotReadyDelay time.Duration // Wait before running again a job that was not ready
+	Retention     time.Duration // Time the completed and cancelled jobs are kept
+}
+
+type jobQueue struct {
+	repo     ports.JobRepository
+	storage  *db.Storage
+	cfg      JobQueueCfg
+	handlers map[string]ports.JobHandler
+}
+
+// NewJobQueue returns the background jobs queue stored in the database. The handlers must be registered before the
+// workers start.
+func NewJobQueue(repo ports.JobRepository, storage *db.Storage, cfg JobQueueCfg) ports.JobQueueService {
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = defaultJobMaxAttempts
+	}
+	if cfg.LockTimeout <= 0 {
+		cfg.LockTimeout = defaultJobLockTimeout
+	}
+	if cfg.NotReadyDelay <= 0 {
+		cfg.NotReadyDelay = defaultJobNotReadyDelay
+	}
+	if cfg.Retention <= 0 {
+		cfg.Retention = defaultJobRetention
+	}
+	return &jobQueue{repo: repo, storage: storage, cfg: cfg, handlers: make(map[string]ports.JobHandler)}
+}
+
+// Enqueue adds a job that runs as soon as a worker of its kind is free. It must be called with the transaction of the
+// change that needs the job, so the job only runs if the change is committed.
+func (q *jobQueue) Enqueue(ctx context.Context, conn db.Querier, kind string, issuerDID core.DID, payload any) (*domain.Job, error) {
+	job, err := domain.NewJob(kind, issuerDID.String(), payload, q.cfg.MaxAttempts)
+	if err != nil {
+		return nil, err
+	}
+	if err := q.repo.Save(ctx, conn, job); err != nil {
+		return nil, err
+	}
+	log.Info(ctx, "job enqueued", "id", job.ID, "kind", kind)
+	return job, nil
+}
+
+// Register sets the handler of the jobs of a kind. This node only runs the kinds it has a handler for.
+func (q *jobQueue) Register(kind string, handler ports.JobHandler) {
+	q.handlers[kind] = handler
+}
+
+// Work runs the due jobs of the registered kinds one after the other, until there are none left, and returns the
+// number of jobs run. The jobs of the workers that stopped are sent back to the queue first.
+func (q *jobQueue) Work(ctx context.Context) (int, error) {
+	if len(q.handlers) == 0 {
+		return 0, nil
+	}
+	rescued, err := q.repo.Rescue(ctx, q.storage.Pgx, time.Now().Add(-q.cfg.LockTimeout))
+	if err != nil {
+		return 0, err
+	}
+	if rescued > 0 {
+		log.Warn(ctx, "jobs of stopped workers sent back to the queue", "jobs", rescued)
+	}
+
+	kinds := make([]string, 0, len(q.handlers))
+	for kind := range q.handlers {
+		kinds = append(kinds, kind)
+	}
+	processed := 0
+	for ctx.Err() == nil {
+		job, err := q.repo.Lock(ctx, q.storage.Pgx, kinds)
+		if err != nil || job == nil {
+			return processed, err
+		}
+		q.run(ctx, job)
+		processed++
+	}
+	return processed, nil
+}
+
+// run runs the handler of the job while it sends heartbeats, and stores the result. The result is stored even if ctx
+// is cancelled, otherwise the job would wait to be rescued.
+func (q *jobQueue) run(ctx context.Context, job *domain.Job) {
+	ctx = log.With(ctx, "job", job.ID, "kind", job.Kind)
+	heartbeatCtx, stop := context.WithCancel(ctx)
+	go q.heartbeat(heartbeatCtx, job.ID)
+	err := q.handle(ctx, job)
+	stop()
+
+	if err == nil {
+		job.Complete()
+	} else {
+		job.Fail(err, q.cfg.NotReadyDelay)
+		switch {
+		case errors.Is(err, domain.ErrJobNotReady):
+			log.Debug(ctx, "job not ready", "reason", err, "runAt", job.RunAt)
+		case job.Status == domain.JobDead:
+			log.Error(ctx, "job failed every attempt and was sent to the dead letter", "err", err, "attempts", job.Attempts)
+		default:
+			log.Warn(ctx, "job failed", "err", err, "attempts", job.Attempts, "runAt", job.RunAt)
+		}
+	}
+	if err := q.repo.Save(log.CopyFromContext(ctx, context.Background()), q.storage.Pgx, job); err != nil {
+		log.Error(ctx, "saving job", "err", err)
+	}
+}
+
+// handle runs the handler of the job, turning its panics into errors so they do not stop the worker
+func (q *jobQueue) handle(ctx context.Context, job *domain.Job) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("job panicked: %v", r)
+		}
+	}()
+	return q.handlers[job.Kind](ctx, job)
+}
+
+func (q *jobQueue) heartbeat(ctx context.Context, id uuid.UUID) {
+	ticker := time.NewTicker(q.cfg.LockTimeout / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := q.repo.Heartbeat(ctx, q.storage.Pgx, id); err != nil {
+				log.Warn(ctx, "sending job heartbeat", "err", err)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Purge deletes the completed and cancelled jobs older than the retention
+func (q *jobQueue) Purge(ctx context.Context) error {
+	deleted, err := q.repo.DeleteFinishedBefore(ctx, q.storage.Pgx, time.Now().Add(-q.cfg.Retention))
+	if err != nil {
+		return err
+	}
+	if deleted > 0 {
+		log.Info(ctx, "purged finished jobs", "deleted", deleted)
+	}
+	return nil
+}
+
+// GetAll returns the newest jobs of the issuer. The dead letter is the list of the dead jobs.
+func (q *jobQueue) GetAll(ctx context.Context, issuerDID core.DID, filter ports.JobsFilter) ([]*domain.Job, error) {
+	if filter.Limit <= 0 {
+		filter.Limit = defaultJobsLimit
+	}
+	ctx, cancel := q.storage.ListingContext(ctx)
+	defer cancel()
+	jobs, err := q.repo.GetAll(ctx, q.storage.Replica, issuerDID, filter)
+	if err != nil {
+		return nil, q.storage.QueryError(ctx, "jobs.GetAll", err)
+	}
+	return jobs, nil
+}
+
+// GetByID returns a job of the issuer
+func (q *jobQueue) GetByID(ctx context.Context, issuerDID core.DID, id uuid.UUID) (*domain.Job, error) {
+	job, err := q.repo.GetByID(ctx, q.storage.Pgx, issuerDID, id)
+	if errors.Is(err, repositories.ErrJobNotFound) {
+		return nil, ErrJobNotFound
+	}
+	return job, err
+}
+
+// Retry sends a dead or cancelled job back to the queue with all its attempts
+func (q *jobQueue) Retry(ctx context.Context, issuerDID core.DID, id uuid.UUID) (*domain.Job, error) {
+	return q.update(ctx, issuerDID, id, (*domain.Job).Retry)
+}
+
+// Cancel stops a pending job, or removes a dead one from the dead letter
+func (q *jobQueue) Cancel(ctx context.Context, issuerDID core.DID, id uuid.UUID) (*domain.Job, error) {
+	return q.update(ctx, issuerDID, id, (*domain.Job).Cancel)
+}
+
+// update changes the job while it is locked, so no worker starts it in the meantime
+func (q *jobQueue) update(ctx context.Context, issuerDID core.DID, id uuid.UUID, change func(*domain.Job) error) (*domain.Job, error) {
+	var job *domain.Job
+	err := q.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+		var err error
+		job, err = q.repo.GetByID(ctx, tx, issuerDID, id)
+		if errors.Is(err, repositories.ErrJobNotFound) {
+			return ErrJobNotFound
+		}
+		if err != nil {
+			return err
+		}
+		if err := change(job); err != nil {
+			return fmt.Errorf("%w: %s", ErrJobConflict, err)
+		}
+		return q.repo.Save(ctx, tx, job)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return job, nil
+}
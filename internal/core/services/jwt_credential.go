@@ -0,0 +1,328 @@
+package services
+
+import (
+	"context"
+	"crypto"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+	"github.com/jackc/pgx/v4"
+	"gopkg.in/square/go-jose.v2"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/kms"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+	"github.com/polygonid/sh-id-platform/pkg/cache"
+	schemaPkg "github.com/polygonid/sh-id-platform/pkg/schema"
+)
+
+// ErrJWTCredentialIdentityNotFound the identity does not exist
+var ErrJWTCredentialIdentityNotFound = errors.New("identity not found")
+
+// errStatusListEntryTaken another request gave the credential an index first
+var errStatusListEntryTaken = errors.New("status list entry taken")
+
+const statusListKeyPrefix = "status-list-"
+
+// JWTCredentialCfg configures the JWT credentials. Algorithm is the JWS algorithm of the signatures, ES256 or EdDSA.
+type JWTCredentialCfg struct {
+	Algorithm string
+	Host      string                   // Node API url, where the status lists of the issuers are published
+	Features  ports.FeatureFlagService // Per identity features. If nil, the credentials have no status list entry
+	Cache     cache.Cache              // Cache of the signed status lists, shared with the claims service that revokes the credentials. If nil, nothing is cached
+	CacheTTL  time.Duration            // Time a signed status list is cached. 0 disables it
+}
+
+type jwtCredential struct {
+	claimsService ports.ClaimsService
+	identitySrv   ports.IdentityService
+	statusLists   ports.StatusListRepository
+	storage       *db.Storage
+	kms           kms.KMSType
+	cfg           JWTCredentialCfg
+	states        *stateCache
+}
+
+// NewJWTCredential returns a new JWT credential service
+func NewJWTCredential(claimsService ports.ClaimsService, identitySrv ports.IdentityService, statusLists ports.StatusListRepository, storage *db.Storage, kms kms.KMSType, cfg JWTCredentialCfg) ports.JWTCredentialService {
+	return &jwtCredential{
+		claimsService: claimsService,
+		identitySrv:   identitySrv,
+		statusLists:   statusLists,
+		storage:       storage,
+		kms:           kms,
+		cfg:           cfg,
+		states:        &stateCache{cache: cfg.Cache, ttl: cfg.CacheTTL},
+	}
+}
+
+func statusListKey(issuerDID core.DID, version string) string {
+	return fmt.Sprintf("%s%s-%s", statusListKeyPrefix, issuerDID.String(), version)
+}
+
+// Serialize returns the credential as a JWT VC. The credential is the vc claim without its proofs, the signature of
+// the JWT replaces them. The key of the issuer of the configured algorithm is created the first time it is needed,
+// its kid is the thumbprint of the key, which verifiers find in the public keys of the issuer.
+// With the status_list feature, the credential status is replaced by the entry of the credential in the status list
+// of the issuer, as the verifiers of JWT credentials can't check the iden3 ones.
+func (j *jwtCredential) Serialize(ctx context.Context, issuerDID core.DID, id uuid.UUID) (string, error) {
+	claim, err := j.claimsService.GetByID(ctx, &issuerDID, id)
+	if err != nil {
+		return "", err
+	}
+	credential, err := schemaPkg.FromClaimModelToW3CCredential(*claim)
+	if err != nil {
+		return "", err
+	}
+	credential.Proof = nil
+	if j.cfg.Features != nil && j.cfg.Features.IsEnabled(ctx, issuerDID, domain.FeatureStatusList) {
+		entry, err := j.statusListEntry(ctx, issuerDID, claim.ID)
+		if err != nil {
+			return "", err
+		}
+		credential.Context = append(credential.Context, domain.StatusList2021Context)
+		credential.CredentialStatus = entry.Status(j.statusListURL(issuerDID))
+	}
+
+	claims := map[string]any{
+		"iss": issuerDID.String(),
+		"jti": credential.ID,
+		"vc":  credential,
+	}
+	if subject, ok := credential.CredentialSubject["id"].(string); ok {
+		claims["sub"] = subject
+	}
+	if credential.IssuanceDate != nil {
+		claims["nbf"] = credential.IssuanceDate.Unix()
+	}
+	if credential.Expiration != nil {
+		claims["exp"] = credential.Expiration.Unix()
+	}
+	return j.sign(ctx, issuerDID, claims)
+}
+
+// StatusList returns the StatusList2021 credential of the issuer as a JWT VC, with the bits of its revoked
+// credentials set. It is built on every request, so a revocation is in the list as soon as it is made.
+func (j *jwtCredential) StatusList(ctx context.Context, issuerDID core.DID) (string, error) {
+	exists, err := j.identitySrv.Exists(ctx, issuerDID)
+	if err != nil {
+		return "", err
+	}
+	if !exists {
+		return "", ErrJWTCredentialIdentityNotFound
+	}
+	key := statusListKey(issuerDID, j.states.version(ctx, issuerDID))
+	var signed string
+	if j.states.get(ctx, key, &signed) {
+		return signed, nil
+	}
+
+	revoked, err := j.statusLists.GetRevokedIndexes(ctx, j.storage.Pgx, issuerDID)
+	if err != nil {
+		return "", err
+	}
+	list := domain.StatusList{IssuerDID: issuerDID, Revoked: revoked, IssuedAt: time.Now().UTC()}
+	listURL := j.statusListURL(issuerDID)
+	credential, err := list.Credential(listURL)
+	if err != nil {
+		return "", err
+	}
+	signed, err = j.sign(ctx, issuerDID, map[string]any{
+		"iss": issuerDID.String(),
+		"jti": listURL,
+		"iat": list.IssuedAt.Unix(),
+		"nbf": list.IssuedAt.Unix(),
+		"vc":  credential,
+	})
+	if err != nil {
+		return "", err
+	}
+	j.states.set(ctx, key, signed)
+	return signed, nil
+}
+
+// statusListEntry returns the entry of the credential in the status list of the issuer, taking the next index of the
+// list the first time. When the credential is serialized by two requests at once, the one that saves its entry last
+// gets the entry of the first one and gives back the index it took. A new entry changes the status list if the
+// credential is revoked already, so the cached status list is invalidated.
+func (j *jwtCredential) statusListEntry(ctx context.Context, issuerDID core.DID, claimID uuid.UUID) (*domain.StatusListEntry, error) {
+	entry, err := j.statusLists.GetEntry(ctx, j.storage.Pgx, issuerDID, claimID)
+	if err == nil || !errors.Is(err, repositories.ErrStatusListEntryNotFound) {
+		return entry, err
+	}
+	err = j.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+		index, err := j.statusLists.NextIndex(ctx, tx, issuerDID)
+		if err != nil {
+			return err
+		}
+		if index >= domain.RevocationBitstringMaxSize {
+			return domain.ErrStatusListFull
+		}
+		if err := j.statusLists.SaveEntry(ctx, tx, &domain.StatusListEntry{IssuerDID: issuerDID, ClaimID: claimID, Index: index}); err != nil {
+			return err
+		}
+		entry, err = j.statusLists.GetEntry(ctx, tx, issuerDID, claimID)
+		if err != nil {
+			return err
+		}
+		if entry.Index != index {
+			return errStatusListEntryTaken
+		}
+		return nil
+	})
+	if errors.Is(err, errStatusListEntryTaken) {
+		return entry, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	j.states.invalidate(ctx, issuerDID)
+	return entry, nil
+}
+
+func (j *jwtCredential) statusListURL(issuerDID core.DID) string {
+	return fmt.Sprintf("%s/v1/%s/status-list", strings.TrimSuffix(j.cfg.Host, "/"), issuerDID.String())
+}
+
+// sign returns the claims as a JWT signed with the key of the issuer
+func (j *jwtCredential) sign(ctx context.Context, issuerDID core.DID, claims map[string]any) (string, error) {
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+
+	signer, err := j.signer(ctx, issuerDID)
+	if err != nil {
+		return "", err
+	}
+	jws, err := signer.Sign(payload)
+	if err != nil {
+		return "", err
+	}
+	return jws.CompactSerialize()
+}
+
+// PublicKeys returns the keys of the JWT credentials of the issuer, of every algorithm, so the credentials signed
+// before a change of algorithm can still be verified
+func (j *jwtCredential) PublicKeys(ctx context.Context, issuerDID core.DID) (*jose.JSONWebKeySet, error) {
+	exists, err := j.identitySrv.Exists(ctx, issuerDID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrJWTCredentialIdentityNotFound
+	}
+	keyIDs, err := j.kms.KeysByIdentity(ctx, issuerDID)
+	if err != nil {
+		return nil, err
+	}
+	keys := &jose.JSONWebKeySet{Keys: []jose.JSONWebKey{}}
+	for _, keyID := range sortedKeyIDs(keyIDs) {
+		alg, ok := jwsAlgorithms[keyID.Type]
+		if !ok {
+			continue
+		}
+		key, err := j.publicKey(keyID, alg)
+		if err != nil {
+			return nil, err
+		}
+		keys.Keys = append(keys.Keys, *key)
+	}
+	return keys, nil
+}
+
+// signer returns the signer of the first key of the identity of the configured algorithm, it is created if there is none
+func (j *jwtCredential) signer(ctx context.Context, issuerDID core.DID) (jose.Signer, error) {
+	keyType, err := kms.JWSKeyType(j.cfg.Algorithm)
+	if err != nil {
+		return nil, fmt.Errorf("unsupported JWT credential algorithm %s: %w", j.cfg.Algorithm, err)
+	}
+	keyIDs, err := j.kms.KeysByIdentity(ctx, issuerDID)
+	if err != nil {
+		return nil, err
+	}
+	var keyID *kms.KeyID
+	for _, k := range sortedKeyIDs(keyIDs) {
+		if k.Type == keyType {
+			keyID = &k
+			break
+		}
+	}
+	if keyID == nil {
+		created, err := j.kms.CreateKey(keyType, &issuerDID)
+		if err != nil {
+			return nil, err
+		}
+		log.Info(ctx, "JWT credential key created", "did", issuerDID.String(), "type", keyType)
+		keyID = &created
+	}
+
+	key, err := j.publicKey(*keyID, j.cfg.Algorithm)
+	if err != nil {
+		return nil, err
+	}
+	opts := (&jose.SignerOptions{}).WithType("JWT").WithHeader(jose.HeaderKey("kid"), key.KeyID)
+	return jose.NewSigner(jose.SigningKey{
+		Algorithm: jose.SignatureAlgorithm(j.cfg.Algorithm),
+		Key:       &kmsSigner{ctx: ctx, kms: j.kms, keyID: *keyID, key: key},
+	}, opts)
+}
+
+func (j *jwtCredential) publicKey(keyID kms.KeyID, alg string) (*jose.JSONWebKey, error) {
+	pubKeyBytes, err := j.kms.PublicKey(keyID)
+	if err != nil {
+		return nil, err
+	}
+	pubKey, err := kms.JWSPublicKey(keyID.Type, pubKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+	key := &jose.JSONWebKey{Key: pubKey, Algorithm: alg, Use: "sig"}
+	thumbprint, err := key.Thumbprint(crypto.SHA256)
+	if err != nil {
+		return nil, err
+	}
+	key.KeyID = base64.RawURLEncoding.EncodeToString(thumbprint)
+	return key, nil
+}
+
+var jwsAlgorithms = map[kms.KeyType]string{
+	kms.KeyTypeP256:    kms.JWSAlgorithmES256,
+	kms.KeyTypeEd25519: kms.JWSAlgorithmEdDSA,
+}
+
+func sortedKeyIDs(keyIDs []kms.KeyID) []kms.KeyID {
+	sorted := append([]kms.KeyID(nil), keyIDs...)
+	sort.Slice(sorted, func(i, k int) bool { return sorted[i].ID < sorted[k].ID })
+	return sorted
+}
+
+// kmsSigner signs the JWS with a key of the KMS, the private key never leaves it
+type kmsSigner struct {
+	ctx   context.Context
+	kms   kms.KMSType
+	keyID kms.KeyID
+	key   *jose.JSONWebKey
+}
+
+func (s *kmsSigner) Public() *jose.JSONWebKey {
+	return s.key
+}
+
+func (s *kmsSigner) Algs() []jose.SignatureAlgorithm {
+	return []jose.SignatureAlgorithm{jose.SignatureAlgorithm(s.key.Algorithm)}
+}
+
+func (s *kmsSigner) SignPayload(payload []byte, _ jose.SignatureAlgorithm) ([]byte, error) {
+	return s.kms.Sign(s.ctx, s.keyID, payload)
+}
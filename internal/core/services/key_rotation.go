@@ -0,0 +1,232 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/kms"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+const keyRotationRevocationReason = "auth key rotated"
+
+var (
+	ErrAuthKeyNotFound    = errors.New("the identity has no auth key")            // ErrAuthKeyNotFound the identity does not exist or has no valid auth claim
+	ErrKeyRotationPending = errors.New("there is already a pending key rotation") // ErrKeyRotationPending the previous rotation of the identity has not finished yet
+)
+
+type keyRotation struct {
+	kms              kms.KMSType
+	claimsRepository ports.ClaimsRepository
+	rotations        ports.KeyRotationRepository
+	claimSrv         ports.ClaimsService
+	publisher        ports.Publisher
+	storage          *db.Storage
+	host             string
+}
+
+// NewKeyRotation returns a new key rotation service
+func NewKeyRotation(kms kms.KMSType, claimsRepository ports.ClaimsRepository, rotations ports.KeyRotationRepository, claimSrv ports.ClaimsService, publisher ports.Publisher, storage *db.Storage, host string) ports.KeyRotationService {
+	return &keyRotation{
+		kms:              kms,
+		claimsRepository: claimsRepository,
+		rotations:        rotations,
+		claimSrv:         claimSrv,
+		publisher:        publisher,
+		storage:          storage,
+		host:             host,
+	}
+}
+
+// Rotate creates a new BabyJubJub key for the identity and adds its auth claim to the next state, which is published
+// right away. The current auth key keeps signing until ProcessPending revokes it.
+func (k *keyRotation) Rotate(ctx context.Context, did core.DID) (*domain.KeyRotation, *domain.AuthKey, error) {
+	authHash, err := core.AuthSchemaHash.MarshalText()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var rotation *domain.KeyRotation
+	var newAuthClaim *domain.Claim
+	err = k.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+		_, err := k.rotations.GetPendingByIdentifier(ctx, tx, did)
+		if err == nil {
+			return ErrKeyRotationPending
+		}
+		if !errors.Is(err, repositories.ErrKeyRotationNotFound) {
+			return err
+		}
+
+		oldAuthClaim, err := k.claimsRepository.FindOneClaimBySchemaHash(ctx, tx, &did, string(authHash))
+		if err != nil {
+			if errors.Is(err, repositories.ErrClaimDoesNotExist) {
+				return ErrAuthKeyNotFound
+			}
+			return err
+		}
+
+		if newAuthClaim, err = k.createAuthClaim(did); err != nil {
+			return err
+		}
+		if newAuthClaim.ID, err = k.claimsRepository.Save(ctx, tx, newAuthClaim); err != nil {
+			return err
+		}
+
+		rotation = domain.NewKeyRotation(did, oldAuthClaim.ID, newAuthClaim.ID)
+		if err := k.rotations.Save(ctx, tx, rotation); err != nil {
+			if errors.Is(err, repositories.ErrKeyRotationPending) {
+				return ErrKeyRotationPending
+			}
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error(ctx, "rotating auth key", "err", err, "did", did.String())
+		return nil, nil, err
+	}
+
+	log.Info(ctx, "auth key rotation started", "did", did.String(), "rotation", rotation.ID, "authClaim", newAuthClaim.ID)
+	k.publish(ctx, did)
+
+	authKey, err := domain.NewAuthKey(newAuthClaim)
+	if err != nil {
+		return nil, nil, err
+	}
+	return rotation, authKey, nil
+}
+
+// GetAuthKeys returns every auth key of the identity with its status
+func (k *keyRotation) GetAuthKeys(ctx context.Context, did core.DID) ([]*domain.AuthKey, error) {
+	authClaims, err := k.authClaims(ctx, did)
+	if err != nil {
+		return nil, err
+	}
+	if len(authClaims) == 0 {
+		return nil, ErrAuthKeyNotFound
+	}
+
+	keys := make([]*domain.AuthKey, len(authClaims))
+	for i, authClaim := range authClaims {
+		if keys[i], err = domain.NewAuthKey(authClaim); err != nil {
+			return nil, err
+		}
+	}
+	return keys, nil
+}
+
+// ProcessPending moves the pending rotations forward. New auth claims that could not be published yet are published
+// again and, once the state with the new auth claim is confirmed, the old auth claim is revoked.
+func (k *keyRotation) ProcessPending(ctx context.Context) error {
+	rotations, err := k.rotations.GetPending(ctx, k.storage.Pgx)
+	if err != nil {
+		log.Error(ctx, "loading pending key rotations", "err", err)
+		return err
+	}
+
+	for _, rotation := range rotations {
+		if err := k.process(ctx, rotation); err != nil {
+			log.Error(ctx, "processing key rotation", "err", err, "rotation", rotation.ID, "did", rotation.Identifier)
+		}
+	}
+	return nil
+}
+
+func (k *keyRotation) process(ctx context.Context, rotation *domain.KeyRotation) error {
+	did, err := core.ParseDID(rotation.Identifier)
+	if err != nil {
+		return err
+	}
+
+	authClaims, err := k.authClaims(ctx, *did)
+	if err != nil {
+		return err
+	}
+	oldAuthClaim, newAuthClaim := findClaim(authClaims, rotation.OldClaimID), findClaim(authClaims, rotation.NewClaimID)
+	if oldAuthClaim == nil || newAuthClaim == nil {
+		return fmt.Errorf("auth claims of the rotation not found")
+	}
+
+	if newAuthClaim.IdentityState == nil {
+		k.publish(ctx, *did)
+		return nil
+	}
+	if newAuthClaim.Status == nil || *newAuthClaim.Status != domain.StatusConfirmed {
+		return nil
+	}
+
+	if !oldAuthClaim.Revoked {
+		if err := k.claimSrv.Revoke(ctx, *did, uint64(oldAuthClaim.RevNonce), domain.RevocationSuperseded, keyRotationRevocationReason); err != nil {
+			return fmt.Errorf("revoking old auth claim: %w", err)
+		}
+	}
+	rotation.Complete()
+	if err := k.rotations.Save(ctx, k.storage.Pgx, rotation); err != nil {
+		return err
+	}
+
+	log.Info(ctx, "auth key rotation completed", "did", rotation.Identifier, "rotation", rotation.ID)
+	k.publish(ctx, *did)
+	return nil
+}
+
+// createAuthClaim creates a BabyJubJub key bound to the identity and the auth claim that adds it to the claims tree.
+// The claim has no state, so it is added to the tree in the next state update.
+func (k *keyRotation) createAuthClaim(did core.DID) (*domain.Claim, error) {
+	keyID, err := k.kms.CreateKey(kms.KeyTypeBabyJubJub, &did)
+	if err != nil {
+		return nil, fmt.Errorf("can't create babyJubJub key: %w", err)
+	}
+
+	pubKey, err := bjjPubKey(k.kms, keyID)
+	if err != nil {
+		return nil, fmt.Errorf("can't get babyJubJub public key: %w", err)
+	}
+
+	authClaim, err := newAuthClaim(pubKey)
+	if err != nil {
+		return nil, fmt.Errorf("can't create auth claim: %w", err)
+	}
+
+	authClaimModel, err := newAuthClaimModel(&did, k.host, authClaim, pubKey)
+	if err != nil {
+		return nil, err
+	}
+	authClaimModel.Identifier = common.ToPointer(did.String())
+	authClaimModel.MtProof = true
+	return authClaimModel, nil
+}
+
+func (k *keyRotation) authClaims(ctx context.Context, did core.DID) ([]*domain.Claim, error) {
+	authHash, err := core.AuthSchemaHash.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return k.claimsRepository.GetAuthClaimsByIssuerID(ctx, k.storage.Pgx, did, string(authHash))
+}
+
+// publish publishes the identity state. A failure is not an error, the changes are included in the next state.
+func (k *keyRotation) publish(ctx context.Context, did core.DID) {
+	if _, err := k.publisher.PublishState(ctx, &did); err != nil {
+		log.Warn(ctx, "key rotation changes not published yet", "err", err, "did", did.String())
+	}
+}
+
+func findClaim(claims []*domain.Claim, id uuid.UUID) *domain.Claim {
+	for _, c := range claims {
+		if c.ID == id {
+			return c
+		}
+	}
+	return nil
+}
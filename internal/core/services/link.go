@@ -1,9 +1,12 @@
 package services
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -38,8 +41,34 @@ var (
 	ErrLinkInactive = errors.New("cannot issue a credential for an inactive link")
 	// ErrClaimAlreadyIssued - claim already issued
 	ErrClaimAlreadyIssued = errors.New("the claim was already issued for the user")
+	// ErrInvalidLinkProofScope - the queries the holders must prove cannot be proved
+	ErrInvalidLinkProofScope = errors.New("invalid proof scope")
+	// ErrLinkProofRequired - the session did not ask the holder for the proofs the link requires
+	ErrLinkProofRequired = errors.New("the holder has not proved the queries of the link")
+	// ErrVersionMismatch - the resource was updated after the version the caller expects
+	ErrVersionMismatch = errors.New("the resource has been modified, fetch it again before updating it")
+	// ErrSubjectAlreadyBound - the placeholder subject of the link was claimed by another holder
+	ErrSubjectAlreadyBound = errors.New("the subject of the link is bound to another holder")
+	// ErrSubjectNotBound - no holder has claimed a link of the placeholder subject yet
+	ErrSubjectNotBound = errors.New("the subject is not bound to a holder, issue its first credential with a link")
+	// ErrInvalidLinkAllowedDID - an allowed holder of the link is not a valid DID
+	ErrInvalidLinkAllowedDID = errors.New("invalid allowed DID")
+	// ErrHolderNotAllowed - the link only allows some holders and the holder is not one of them
+	ErrHolderNotAllowed = errors.New("the holder is not allowed to claim the credential of this link")
+	// ErrLinkAnalyticsRange - the interval or the dates of the link analytics are not valid
+	ErrLinkAnalyticsRange = fmt.Errorf("the interval must be hour, day or week, from must be before to and there can be up to %d buckets", linkAnalyticsMaxBuckets)
 )
 
+const (
+	// linkAnalyticsBuckets is the number of buckets of the link analytics when from is not set
+	linkAnalyticsBuckets = 30
+	// linkAnalyticsMaxBuckets caps the buckets of the link analytics
+	linkAnalyticsMaxBuckets = 1000
+)
+
+// linkStatePollInterval is how often the link state is checked while waiting for a session to complete
+const linkStatePollInterval = 500 * time.Millisecond
+
 // Link - represents a link in the issuer node
 type Link struct {
 	storage          *db.Storage
@@ -50,6 +79,10 @@ type Link struct {
 	loaderFactory    loader.Factory
 	sessionManager   ports.SessionRepository
 	publisher        pubsub.Publisher
+	funnelRepository ports.LinkFunnelRepository
+	outboxRepository ports.EventOutboxRepository
+	subjectBindings  ports.SubjectBindingRepository
+	codeRepository   ports.LinkCodeRepository
 }
 
 // NewLinkService - constructor
@@ -63,6 +96,10 @@ func NewLinkService(storage *db.Storage, claimsService ports.ClaimsService, clai
 		loaderFactory:    loaderFactory,
 		sessionManager:   sessionManager,
 		publisher:        publisher,
+		funnelRepository: repositories.NewLinkFunnel(),
+		outboxRepository: repositories.NewEventOutbox(),
+		subjectBindings:  repositories.NewSubjectBinding(),
+		codeRepository:   repositories.NewLinkCode(),
 	}
 }
 
@@ -77,11 +114,36 @@ func (ls *Link) Save(
 	credentialSignatureProof bool,
 	credentialMTPProof bool,
 	credentialSubject domain.CredentialSubject,
+	proofScope []domain.VerificationQuery,
+	subjectExternalID *string,
+	allowedDIDs []string,
+	codeProtected bool,
 ) (*domain.Link, error) {
+	if err := domain.ValidateVerificationScope(proofScope); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidLinkProofScope, err)
+	}
+	if subjectExternalID != nil {
+		externalID, err := domain.NormalizeSubjectExternalID(*subjectExternalID)
+		if err != nil {
+			return nil, err
+		}
+		subjectExternalID = &externalID
+	}
+	allowedDIDs, err := normalizeAllowedDIDs(allowedDIDs)
+	if err != nil {
+		return nil, err
+	}
+
 	schemaDB, err := ls.schemaRepository.GetByID(ctx, did, schemaID)
 	if err != nil {
 		return nil, err
 	}
+	if schemaDB.Status() == domain.SchemaStatusDeprecated {
+		return nil, ErrSchemaDeprecated
+	}
+	if _, err := schemaDB.Expiration(time.Now().UTC(), credentialExpiration); err != nil {
+		return nil, err
+	}
 
 	if err := ls.validateCredentialSubjectAgainstSchema(ctx, credentialSubject, schemaDB); err != nil {
 		log.Error(ctx, "validating credential subject", "err", err)
@@ -89,6 +151,10 @@ func (ls *Link) Save(
 	}
 
 	link := domain.NewLink(did, maxIssuance, validUntil, schemaID, credentialExpiration, credentialSignatureProof, credentialMTPProof, credentialSubject)
+	link.ProofScope = proofScope
+	link.SubjectExternalID = subjectExternalID
+	link.AllowedDIDs = allowedDIDs
+	link.CodeProtected = codeProtected
 	_, err = ls.linkRepository.Save(ctx, ls.storage.Pgx, link)
 	if err != nil {
 		return nil, err
@@ -99,24 +165,34 @@ func (ls *Link) Save(
 	return link, nil
 }
 
-// Activate - activates or deactivates a credential link
-func (ls *Link) Activate(ctx context.Context, issuerID core.DID, linkID uuid.UUID, active bool) error {
+// Activate - activates or deactivates a credential link.
+// If version is not nil, the link is only updated if it is the current version of the link.
+func (ls *Link) Activate(ctx context.Context, issuerID core.DID, linkID uuid.UUID, active bool, version *int) (*domain.Link, error) {
 	link, err := ls.linkRepository.GetByID(ctx, issuerID, linkID)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	if version != nil && *version != link.Version {
+		return nil, ErrVersionMismatch
 	}
 
 	if link.Active && active {
-		return ErrLinkAlreadyActive
+		return nil, ErrLinkAlreadyActive
 	}
 
 	if !link.Active && !active {
-		return ErrLinkAlreadyInactive
+		return nil, ErrLinkAlreadyInactive
 	}
 
 	link.Active = active
-	_, err = ls.linkRepository.Save(ctx, ls.storage.Pgx, link)
-	return err
+	if _, err = ls.linkRepository.Save(ctx, ls.storage.Pgx, link); err != nil {
+		if errors.Is(err, repositories.ErrVersionConflict) {
+			return nil, ErrVersionMismatch
+		}
+		return nil, err
+	}
+	return link, nil
 }
 
 // GetByID returns a link by id and issuerDID
@@ -134,7 +210,13 @@ func (ls *Link) GetByID(ctx context.Context, issuerID core.DID, id uuid.UUID) (*
 
 // GetAll returns all links from issueDID of type lType filtered by query string
 func (ls *Link) GetAll(ctx context.Context, issuerDID core.DID, status ports.LinkStatus, query *string) ([]domain.Link, error) {
-	return ls.linkRepository.GetAll(ctx, issuerDID, status, query)
+	ctx, cancel := ls.storage.ListingContext(ctx)
+	defer cancel()
+	links, err := ls.linkRepository.GetAll(ctx, issuerDID, status, query)
+	if err != nil {
+		return nil, ls.storage.QueryError(ctx, "links.GetAll", err)
+	}
+	return links, nil
 }
 
 // Delete - delete a link by id
@@ -142,8 +224,9 @@ func (ls *Link) Delete(ctx context.Context, id uuid.UUID, did core.DID) error {
 	return ls.linkRepository.Delete(ctx, id, did)
 }
 
-// CreateQRCode - generates a qr code for a link
-func (ls *Link) CreateQRCode(ctx context.Context, issuerDID core.DID, linkID uuid.UUID, serverURL string) (*ports.CreateQRCodeResponse, error) {
+// CreateQRCode - generates a qr code for a link. The code protected links need one of their codes, which is reserved
+// for the new session.
+func (ls *Link) CreateQRCode(ctx context.Context, issuerDID core.DID, linkID uuid.UUID, serverURL string, code *string) (*ports.CreateQRCodeResponse, error) {
 	link, err := ls.GetByID(ctx, issuerDID, linkID)
 	if err != nil {
 		return nil, err
@@ -154,7 +237,12 @@ func (ls *Link) CreateQRCode(ctx context.Context, issuerDID core.DID, linkID uui
 		return nil, err
 	}
 
-	sessionID := uuid.New().String()
+	session := uuid.New()
+	if err := ls.reserveCode(ctx, link, code, session); err != nil {
+		return nil, err
+	}
+
+	sessionID := session.String()
 	reqID := uuid.New().String()
 	qrCode := &protocol.AuthorizationRequestMessage{
 		From:     issuerDID.String(),
@@ -165,6 +253,7 @@ func (ls *Link) CreateQRCode(ctx context.Context, issuerDID core.DID, linkID uui
 		Body: protocol.AuthorizationRequestMessageBody{
 			CallbackURL: fmt.Sprintf("%s/v1/credentials/links/callback?sessionID=%s&linkID=%s", serverURL, sessionID, linkID.String()),
 			Reason:      authReason,
+			Scope:       domain.ZeroKnowledgeProofRequests(link.ProofScope),
 		},
 	}
 
@@ -178,6 +267,9 @@ func (ls *Link) CreateQRCode(ctx context.Context, issuerDID core.DID, linkID uui
 		return nil, err
 	}
 
+	updateSessionState(ctx, ls.sessionManager, ls.publisher, sessionID, domain.SessionStatusPending, "")
+	recordLinkFunnel(ctx, ls.funnelRepository, ls.storage, domain.NewLinkFunnelEvent(issuerDID, linkID, sessionID, domain.LinkFunnelQRFetched))
+
 	return &ports.CreateQRCodeResponse{
 		SessionID: sessionID,
 		QrCode:    qrCode,
@@ -186,7 +278,17 @@ func (ls *Link) CreateQRCode(ctx context.Context, issuerDID core.DID, linkID uui
 }
 
 // IssueClaim - Create a new claim
-func (ls *Link) IssueClaim(ctx context.Context, sessionID string, issuerDID core.DID, userDID core.DID, linkID uuid.UUID, hostURL string) error {
+func (ls *Link) IssueClaim(ctx context.Context, sessionID string, issuerDID core.DID, userDID core.DID, linkID uuid.UUID, hostURL string) (err error) {
+	recordLinkFunnel(ctx, ls.funnelRepository, ls.storage, domain.NewLinkFunnelEvent(issuerDID, linkID, sessionID, domain.LinkFunnelAuthCompleted))
+	defer func() {
+		if err != nil {
+			updateSessionState(ctx, ls.sessionManager, ls.publisher, sessionID, domain.SessionStatusFailed, err.Error())
+			recordLinkFunnel(ctx, ls.funnelRepository, ls.storage, domain.NewLinkFunnelFailure(issuerDID, linkID, sessionID, linkFunnelReason(err)))
+			return
+		}
+		updateSessionState(ctx, ls.sessionManager, ls.publisher, sessionID, domain.SessionStatusIssued, "")
+	}()
+
 	link, err := ls.linkRepository.GetByID(ctx, issuerDID, linkID)
 	if err != nil {
 		log.Error(ctx, "cannot fetch the link", "err", err)
@@ -204,9 +306,23 @@ func (ls *Link) IssueClaim(ctx context.Context, sessionID string, issuerDID core
 		return ErrClaimAlreadyIssued
 	}
 
-	if err := ls.validate(ctx, link); err != nil {
-		err := ls.sessionManager.SetLink(ctx, linkState.CredentialStateCacheKey(linkID.String(), sessionID), *linkState.NewStateError(err))
-		if err != nil {
+	if err := ls.checkProofSession(ctx, link, sessionID); err != nil {
+		return err
+	}
+
+	err = ls.validate(ctx, link)
+	if err == nil && !link.AllowsHolder(userDID) {
+		log.Info(ctx, "the holder is not allowed to claim the link", "linkID", link.ID, "user DID", userDID.String())
+		err = ErrHolderNotAllowed
+	}
+	if err == nil {
+		err = ls.checkSubjectBinding(ctx, link, userDID)
+	}
+	if err == nil {
+		err = ls.checkCode(ctx, link, sessionID)
+	}
+	if err != nil {
+		if err := ls.sessionManager.SetLink(ctx, linkState.CredentialStateCacheKey(linkID.String(), sessionID), *linkState.NewStateError(err)); err != nil {
 			log.Error(ctx, "cannot set the sate", "err", err)
 			return err
 		}
@@ -234,57 +350,79 @@ func (ls *Link) IssueClaim(ctx context.Context, sessionID string, issuerDID core
 		true,
 	)
 
-	credentialIssued, err := ls.claimsService.CreateCredential(ctx, claimReq)
-	if err != nil {
-		log.Error(ctx, "cannot create the claim", "err", err.Error())
-		return err
-	}
-
+	var credentialIssued *domain.Claim
 	var credentialIssuedID uuid.UUID
-	err = ls.storage.Pgx.BeginFunc(ctx,
-		func(tx pgx.Tx) error {
-			link.IssuedClaims += 1
-			_, err := ls.linkRepository.Save(ctx, ls.storage.Pgx, link)
+	err = withFreeRevNonce(ctx, func() error {
+		var err error
+		credentialIssued, err = ls.claimsService.CreateCredential(ctx, claimReq)
+		if err != nil {
+			log.Error(ctx, "cannot create the claim", "err", err.Error())
+			return err
+		}
+
+		return ls.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+			var err error
+			credentialIssuedID, err = ls.claimRepository.Save(ctx, tx, credentialIssued)
 			if err != nil {
 				return err
 			}
 
-			credentialIssuedID, err = ls.claimRepository.Save(ctx, ls.storage.Pgx, credentialIssued)
-			if err != nil {
-				return err
+			if link.SubjectExternalID != nil {
+				binding, err := ls.subjectBindings.Bind(ctx, tx, domain.NewSubjectBinding(issuerDID, *link.SubjectExternalID, userDID, &linkID))
+				if err != nil {
+					return err
+				}
+				// another holder claimed a link of the placeholder after it was checked
+				if !binding.BoundTo(userDID) {
+					return ErrSubjectAlreadyBound
+				}
 			}
 
-			if link.CredentialSignatureProof {
-				err = ls.publisher.Publish(ctx, event.CreateCredentialEvent, &event.CreateCredential{CredentialIDs: []string{credentialIssued.ID.String()}, IssuerID: issuerDID.String()})
+			if link.CodeProtected {
+				// the code is used once, even if the holder claims it in two sessions at the same time
+				err := ls.codeRepository.Use(ctx, tx, linkID, uuid.MustParse(sessionID), userDID, time.Now().UTC())
+				if errors.Is(err, repositories.ErrLinkCodeNotFound) {
+					return ErrLinkCodeRequired
+				}
 				if err != nil {
-					log.Error(ctx, "publish CreateCredentialEvent", "err", err.Error(), "credential", credentialIssued.ID.String())
+					return err
 				}
 			}
 
-			return nil
+			if !link.CredentialSignatureProof {
+				return nil
+			}
+			return notifyInTx(ctx, tx, ls.outboxRepository, event.CreateCredentialEvent, issuerDID.String(), &event.CreateCredential{CredentialIDs: []string{credentialIssuedID.String()}, IssuerID: issuerDID.String()})
 		})
+	})
 	if err != nil {
 		return err
 	}
 	credentialIssued.ID = credentialIssuedID
 
-	r := &linkState.QRCodeMessage{
-		ID:       uuid.NewString(),
-		Typ:      "application/iden3comm-plain-json",
-		Type:     linkState.CredentialOfferMessageType,
-		ThreadID: uuid.NewString(),
-		Body: linkState.CredentialsLinkMessageBody{
-			URL: fmt.Sprintf("%s/v1/agent", hostURL),
-			Credentials: []linkState.CredentialLink{{
-				ID:          credentialIssued.ID.String(),
-				Description: schema.Type,
-			}},
-		},
-		From: issuerDID.String(),
-		To:   userDID.String(),
-	}
-
 	if link.CredentialSignatureProof {
+		offer, err := ls.claimsService.CreateOffer(ctx, issuerDID, credentialIssued.ID)
+		if err != nil {
+			log.Error(ctx, "cannot create the credential offer", "err", err)
+			return err
+		}
+
+		r := &linkState.QRCodeMessage{
+			ID:       offer.ID.String(),
+			Typ:      "application/iden3comm-plain-json",
+			Type:     linkState.CredentialOfferMessageType,
+			ThreadID: offer.ID.String(),
+			Body: linkState.CredentialsLinkMessageBody{
+				URL: fmt.Sprintf("%s/v1/agent", hostURL),
+				Credentials: []linkState.CredentialLink{{
+					ID:          credentialIssued.ID.String(),
+					Description: schema.Type,
+				}},
+			},
+			From:        issuerDID.String(),
+			To:          userDID.String(),
+			ExpiresTime: common.ToPointer(offer.ExpiresAt.Unix()),
+		}
 		err = ls.sessionManager.SetLink(ctx, linkState.CredentialStateCacheKey(linkID.String(), sessionID), *linkState.NewStateDone(r))
 	} else {
 		err = ls.sessionManager.SetLink(ctx, linkState.CredentialStateCacheKey(linkID.String(), sessionID), *linkState.NewStatePendingPublish())
@@ -311,12 +449,122 @@ func (ls *Link) GetQRCode(ctx context.Context, sessionID uuid.UUID, issuerID cor
 		log.Error(ctx, "error fetching the link state from the cache", "err", err)
 		return nil, err
 	}
+	if linkStateInCache.Status == linkState.StatusDone {
+		recordLinkFunnel(ctx, ls.funnelRepository, ls.storage, domain.NewLinkFunnelEvent(issuerID, linkID, sessionID.String(), domain.LinkFunnelOfferFetched))
+	}
 	return &ports.GetQRCodeResponse{
 		State: &linkStateInCache,
 		Link:  link,
 	}, nil
 }
 
+// WaitQRCode - returns the link qr code as soon as the session leaves the pending status.
+// If ctx is done before that happens the latest known state is returned.
+func (ls *Link) WaitQRCode(ctx context.Context, sessionID uuid.UUID, issuerID core.DID, linkID uuid.UUID) (*ports.GetQRCodeResponse, error) {
+	resp, err := ls.GetQRCode(ctx, sessionID, issuerID, linkID)
+	if err != nil {
+		return nil, err
+	}
+
+	ticker := time.NewTicker(linkStatePollInterval)
+	defer ticker.Stop()
+	key := linkState.CredentialStateCacheKey(linkID.String(), sessionID.String())
+	for resp.State.Status == linkState.StatusPending {
+		select {
+		case <-ctx.Done():
+			return resp, nil
+		case <-ticker.C:
+		}
+		state, err := ls.sessionManager.GetLink(ctx, key)
+		if err != nil {
+			if ctx.Err() != nil {
+				return resp, nil
+			}
+			log.Error(ctx, "error fetching the link state from the cache", "err", err)
+			return nil, err
+		}
+		resp.State = &state
+	}
+	if resp.State.Status == linkState.StatusDone {
+		recordLinkFunnel(ctx, ls.funnelRepository, ls.storage, domain.NewLinkFunnelEvent(issuerID, linkID, sessionID.String(), domain.LinkFunnelOfferFetched))
+	}
+
+	return resp, nil
+}
+
+// GetFunnel counts the sessions of the link that reached every step of the claim and the failures by reason
+func (ls *Link) GetFunnel(ctx context.Context, issuerDID core.DID, linkID uuid.UUID) (*domain.LinkFunnel, error) {
+	if _, err := ls.GetByID(ctx, issuerDID, linkID); err != nil {
+		return nil, err
+	}
+	return ls.funnelRepository.Summary(ctx, ls.storage.Replica, issuerDID, linkID)
+}
+
+// ExportFunnel returns the steps of the claim sessions of the links of the issuer
+func (ls *Link) ExportFunnel(ctx context.Context, issuerDID core.DID, filter ports.LinkFunnelFilter) ([]*domain.LinkFunnelEvent, error) {
+	return ls.funnelRepository.GetAll(ctx, ls.storage.Replica, issuerDID, filter)
+}
+
+// GetAnalytics counts the events of the link in buckets of the interval between from and to. to is now by default,
+// and from is 30 intervals before to.
+func (ls *Link) GetAnalytics(ctx context.Context, issuerDID core.DID, linkID uuid.UUID, interval domain.LinkAnalyticsInterval, from *time.Time, to *time.Time) (*domain.LinkAnalytics, error) {
+	if !interval.Valid() {
+		return nil, ErrLinkAnalyticsRange
+	}
+	end := time.Now().UTC()
+	if to != nil {
+		end = *to
+	}
+	start := interval.Truncate(end)
+	if from != nil {
+		start = interval.Truncate(*from)
+	} else {
+		for i := 1; i < linkAnalyticsBuckets; i++ {
+			start = interval.Truncate(start.Add(-time.Second))
+		}
+	}
+	if !start.Before(end) {
+		return nil, ErrLinkAnalyticsRange
+	}
+	buckets := 0
+	for next := start; next.Before(end); next = interval.Next(next) {
+		if buckets++; buckets > linkAnalyticsMaxBuckets {
+			return nil, ErrLinkAnalyticsRange
+		}
+	}
+	if _, err := ls.GetByID(ctx, issuerDID, linkID); err != nil {
+		return nil, err
+	}
+	return ls.funnelRepository.Analytics(ctx, ls.storage.Replica, issuerDID, linkID, interval, start, end)
+}
+
+// checkProofSession checks, for the links that require proofs, that the session asked the holder for the queries of
+// the link. The answer of the holder has already been verified against the request of the session, so a session
+// created for another link, or for a login, cannot be used to skip the proofs.
+func (ls *Link) checkProofSession(ctx context.Context, link *domain.Link, sessionID string) error {
+	if len(link.ProofScope) == 0 {
+		return nil
+	}
+	authReq, err := ls.sessionManager.Get(ctx, sessionID)
+	if err != nil {
+		log.Warn(ctx, "link session not found", "err", err, "link", link.ID)
+		return ErrLinkProofRequired
+	}
+	requested, err := json.Marshal(authReq.Body.Scope)
+	if err != nil {
+		return err
+	}
+	required, err := json.Marshal(domain.ZeroKnowledgeProofRequests(link.ProofScope))
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(requested, required) {
+		log.Warn(ctx, "the session did not ask for the proofs of the link", "link", link.ID, "session", sessionID)
+		return ErrLinkProofRequired
+	}
+	return nil
+}
+
 func (ls *Link) validate(ctx context.Context, link *domain.Link) error {
 	if link.ValidUntil != nil && time.Now().UTC().After(*link.ValidUntil) {
 		log.Debug(ctx, "cannot issue a credential for an expired link")
@@ -336,6 +584,59 @@ func (ls *Link) validate(ctx context.Context, link *domain.Link) error {
 	return nil
 }
 
+// GetSubjectBinding returns the holder the placeholder subject is bound to, or ErrSubjectNotBound
+func (ls *Link) GetSubjectBinding(ctx context.Context, issuerDID core.DID, externalID string) (*domain.SubjectBinding, error) {
+	externalID, err := domain.NormalizeSubjectExternalID(externalID)
+	if err != nil {
+		return nil, err
+	}
+	binding, err := ls.subjectBindings.GetByExternalID(ctx, ls.storage.Pgx, issuerDID, externalID)
+	if errors.Is(err, repositories.ErrSubjectBindingNotFound) {
+		return nil, ErrSubjectNotBound
+	}
+	return binding, err
+}
+
+// normalizeAllowedDIDs parses the allowed holders of a link and removes the duplicates
+func normalizeAllowedDIDs(allowedDIDs []string) ([]string, error) {
+	if len(allowedDIDs) == 0 {
+		return nil, nil
+	}
+	normalized := make([]string, 0, len(allowedDIDs))
+	seen := make(map[string]bool, len(allowedDIDs))
+	for _, allowed := range allowedDIDs {
+		did, err := core.ParseDID(strings.TrimSpace(allowed))
+		if err != nil {
+			return nil, fmt.Errorf("%w %q: %s", ErrInvalidLinkAllowedDID, allowed, err)
+		}
+		if seen[did.String()] {
+			continue
+		}
+		seen[did.String()] = true
+		normalized = append(normalized, did.String())
+	}
+	return normalized, nil
+}
+
+// checkSubjectBinding returns ErrSubjectAlreadyBound if the placeholder subject of the link is bound to another holder
+func (ls *Link) checkSubjectBinding(ctx context.Context, link *domain.Link, userDID core.DID) error {
+	if link.SubjectExternalID == nil {
+		return nil
+	}
+	binding, err := ls.subjectBindings.GetByExternalID(ctx, ls.storage.Pgx, *link.IssuerCoreDID(), *link.SubjectExternalID)
+	if errors.Is(err, repositories.ErrSubjectBindingNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !binding.BoundTo(userDID) {
+		log.Info(ctx, "the subject of the link is bound to another holder", "linkID", link.ID)
+		return ErrSubjectAlreadyBound
+	}
+	return nil
+}
+
 func (ls *Link) validateCredentialSubjectAgainstSchema(ctx context.Context, cSubject domain.CredentialSubject, schemaDB *domain.Schema) error {
 	return jsonschema.ValidateCredentialSubject(ctx, ls.loaderFactory(schemaDB.URL), schemaDB.Type, cSubject)
 }
@@ -0,0 +1,117 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+const (
+	// linkCodesMax is the number of codes that can be created at once
+	linkCodesMax = 1000
+	// linkCodeMaxFailures is the number of wrong codes a link accepts in linkCodeFailuresWindow. The codes have 40
+	// bits, so they cannot be guessed at this rate.
+	linkCodeMaxFailures    = 20
+	linkCodeFailuresWindow = time.Hour
+)
+
+var (
+	// ErrLinkNotCodeProtected - the link is claimed without codes
+	ErrLinkNotCodeProtected = errors.New("the link is not protected by one-time codes")
+	// ErrInvalidLinkCodeCount - the number of codes to create is out of range
+	ErrInvalidLinkCodeCount = fmt.Errorf("the number of codes must be between 1 and %d", linkCodesMax)
+	// ErrLinkCodeRequired - the link is protected by one-time codes and the holder did not enter one
+	ErrLinkCodeRequired = errors.New("the link requires a one-time code")
+	// ErrInvalidLinkCode - the code entered is not an unused code of the link
+	ErrInvalidLinkCode = errors.New("the code is not valid or has already been used")
+	// ErrLinkCodeAttemptsExceeded - too many wrong codes were entered in the link, it accepts codes again later
+	ErrLinkCodeAttemptsExceeded = errors.New("too many wrong codes, try again later")
+)
+
+// CreateCodes creates count one-time codes of a code protected link and returns them. Only their hashes are stored,
+// so they cannot be returned again.
+func (ls *Link) CreateCodes(ctx context.Context, issuerDID core.DID, linkID uuid.UUID, count int) ([]string, error) {
+	if count < 1 || count > linkCodesMax {
+		return nil, ErrInvalidLinkCodeCount
+	}
+	link, err := ls.GetByID(ctx, issuerDID, linkID)
+	if err != nil {
+		return nil, err
+	}
+	if !link.CodeProtected {
+		return nil, ErrLinkNotCodeProtected
+	}
+
+	codes := make([]*domain.LinkCode, count)
+	plain := make([]string, count)
+	for i := range codes {
+		if codes[i], plain[i], err = domain.NewLinkCode(linkID); err != nil {
+			return nil, err
+		}
+	}
+	if err := ls.codeRepository.Save(ctx, ls.storage.Pgx, codes); err != nil {
+		return nil, err
+	}
+	return plain, nil
+}
+
+// GetCodes returns the codes of a link, without the codes themselves
+func (ls *Link) GetCodes(ctx context.Context, issuerDID core.DID, linkID uuid.UUID) ([]*domain.LinkCode, error) {
+	if _, err := ls.GetByID(ctx, issuerDID, linkID); err != nil {
+		return nil, err
+	}
+	return ls.codeRepository.GetByLink(ctx, ls.storage.Pgx, linkID)
+}
+
+// reserveCode reserves the code entered by the holder for the claim session of a code protected link. The wrong
+// codes are recorded, and the link stops accepting codes for a while after too many of them.
+func (ls *Link) reserveCode(ctx context.Context, link *domain.Link, code *string, sessionID uuid.UUID) error {
+	if !link.CodeProtected {
+		return nil
+	}
+	if code == nil || *code == "" {
+		return ErrLinkCodeRequired
+	}
+	now := time.Now().UTC()
+	failures, err := ls.codeRepository.CountFailures(ctx, ls.storage.Pgx, link.ID, now.Add(-linkCodeFailuresWindow))
+	if err != nil {
+		return err
+	}
+	if failures >= linkCodeMaxFailures {
+		log.Warn(ctx, "too many wrong codes entered in the link", "linkID", link.ID)
+		return ErrLinkCodeAttemptsExceeded
+	}
+	err = ls.codeRepository.Reserve(ctx, ls.storage.Pgx, link.ID, domain.HashLinkCode(link.ID, *code), sessionID)
+	if errors.Is(err, repositories.ErrLinkCodeNotFound) {
+		if err := ls.codeRepository.SaveFailure(ctx, ls.storage.Pgx, link.ID, now); err != nil {
+			return err
+		}
+		return ErrInvalidLinkCode
+	}
+	return err
+}
+
+// checkCode returns ErrLinkCodeRequired if the link is protected by codes and no code was entered in the session
+func (ls *Link) checkCode(ctx context.Context, link *domain.Link, sessionID string) error {
+	if !link.CodeProtected {
+		return nil
+	}
+	id, err := uuid.Parse(sessionID)
+	if err != nil {
+		return ErrLinkCodeRequired
+	}
+	_, err = ls.codeRepository.GetBySession(ctx, ls.storage.Pgx, link.ID, id)
+	if errors.Is(err, repositories.ErrLinkCodeNotFound) {
+		log.Info(ctx, "no code was entered in the session of the link", "linkID", link.ID)
+		return ErrLinkCodeRequired
+	}
+	return err
+}
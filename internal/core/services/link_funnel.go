@@ -0,0 +1,47 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+// recordLinkFunnel stores a step of a link claim session. The funnel is only used to analyse the claims, so an
+// event that cannot be stored is logged and does not stop the claim.
+func recordLinkFunnel(ctx context.Context, repo ports.LinkFunnelRepository, storage *db.Storage, event *domain.LinkFunnelEvent) {
+	if err := repo.Save(ctx, storage.Pgx, event); err != nil {
+		log.Warn(ctx, "saving link funnel event", "err", err, "link", event.LinkID, "step", event.Step)
+	}
+}
+
+// linkFunnelReason returns the failure reason code of an error of a link claim
+func linkFunnelReason(err error) string {
+	switch {
+	case errors.Is(err, ErrLinkAlreadyExpired):
+		return domain.LinkFunnelReasonExpired
+	case errors.Is(err, ErrLinkMaxExceeded):
+		return domain.LinkFunnelReasonLimitReached
+	case errors.Is(err, ErrLinkInactive):
+		return domain.LinkFunnelReasonInactive
+	case errors.Is(err, ErrClaimAlreadyIssued):
+		return domain.LinkFunnelReasonAlreadyIssued
+	case errors.Is(err, ErrCredentialOfferExpired):
+		return domain.LinkFunnelReasonOfferExpired
+	case errors.Is(err, ErrCredentialOfferNotFound), errors.Is(err, ErrCredentialOfferConsumed):
+		return domain.LinkFunnelReasonOfferRejected
+	case errors.Is(err, ErrLinkProofRequired):
+		return domain.LinkFunnelReasonProofRequired
+	case errors.Is(err, ErrSubjectAlreadyBound):
+		return domain.LinkFunnelReasonSubjectBound
+	case errors.Is(err, ErrHolderNotAllowed):
+		return domain.LinkFunnelReasonHolderNotAllowed
+	case errors.Is(err, ErrLinkCodeRequired):
+		return domain.LinkFunnelReasonCodeRequired
+	default:
+		return domain.LinkFunnelReasonIssuance
+	}
+}
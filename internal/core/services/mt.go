@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/rand"
 	"fmt"
+	"math/big"
 
 	core "github.com/iden3/go-iden3-core"
 	sql "github.com/iden3/go-merkletree-sql/db/pgx/v2"
@@ -109,6 +110,85 @@ func (mts *mtService) GetIdentityMerkleTrees(ctx context.Context, conn db.Querie
 	return imTrees, nil
 }
 
+// AddRevocations adds the revocation nonces to the revocation tree of the identity. The nodes the tree writes are kept
+// in memory, so every root is computed from the previous one without a round trip, and they are written with a
+// statement when all the nonces are added, along with the new root.
+func (mts *mtService) AddRevocations(ctx context.Context, conn db.Querier, identifier *core.DID, nonces []*big.Int) error {
+	imts, err := mts.imtRepo.GetByIdentifierAndTypes(ctx, conn, identifier, []uint16{MerkleTreeTypeRevocations})
+	if err != nil {
+		return fmt.Errorf("error getting merkle tree: %w", err)
+	}
+	imt := findByType(imts, MerkleTreeTypeRevocations)
+	if imt == nil {
+		return errNotFound
+	}
+
+	treeStorage := newBufferedTreeStorage(sql.NewSqlStorage(conn, imt.ID))
+	tree, err := merkletree.NewMerkleTree(ctx, treeStorage, mtDepth)
+	if err != nil {
+		return err
+	}
+	for _, nonce := range nonces {
+		// a nonce that is in the tree is revoked already
+		if err := tree.Add(ctx, nonce, big.NewInt(0)); err != nil && !errors.Is(err, merkletree.ErrEntryIndexAlreadyExists) {
+			return fmt.Errorf("cannot add revocation nonce: %d to revocation merkle tree: %w", nonce, err)
+		}
+	}
+
+	if err := mts.imtRepo.SaveNodes(ctx, conn, imt.ID, treeStorage.nodes); err != nil {
+		return fmt.Errorf("error saving the revocation tree nodes: %w", err)
+	}
+	if treeStorage.root == nil {
+		return nil
+	}
+	return treeStorage.db.SetRoot(ctx, treeStorage.root)
+}
+
+// bufferedTreeStorage is a merkle tree storage that keeps in memory the nodes and the root it is given, and reads the
+// rest from the database
+type bufferedTreeStorage struct {
+	db    merkletree.Storage
+	nodes []merkletree.KV
+	index map[string]int
+	root  *merkletree.Hash
+}
+
+func newBufferedTreeStorage(db merkletree.Storage) *bufferedTreeStorage {
+	return &bufferedTreeStorage{db: db, index: make(map[string]int)}
+}
+
+func (s *bufferedTreeStorage) Get(ctx context.Context, key []byte) (*merkletree.Node, error) {
+	if i, ok := s.index[string(key)]; ok {
+		node := s.nodes[i].V
+		return &node, nil
+	}
+	return s.db.Get(ctx, key)
+}
+
+func (s *bufferedTreeStorage) Put(_ context.Context, key []byte, node *merkletree.Node) error {
+	if i, ok := s.index[string(key)]; ok {
+		s.nodes[i].V = *node
+		return nil
+	}
+	s.index[string(key)] = len(s.nodes)
+	s.nodes = append(s.nodes, merkletree.KV{K: append([]byte(nil), key...), V: *node})
+	return nil
+}
+
+func (s *bufferedTreeStorage) GetRoot(ctx context.Context) (*merkletree.Hash, error) {
+	if s.root != nil {
+		root := *s.root
+		return &root, nil
+	}
+	return s.db.GetRoot(ctx)
+}
+
+func (s *bufferedTreeStorage) SetRoot(_ context.Context, root *merkletree.Hash) error {
+	s.root = &merkletree.Hash{}
+	copy(s.root[:], root[:])
+	return nil
+}
+
 func findByType(mts []domain.IdentityMerkleTree, tp uint16) *domain.IdentityMerkleTree {
 	for i := range mts {
 		if mts[i].Type == tp {
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	core "github.com/iden3/go-iden3-core"
@@ -14,22 +15,47 @@ import (
 	"github.com/polygonid/sh-id-platform/internal/core/event"
 	"github.com/polygonid/sh-id-platform/internal/core/ports"
 	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/pkg/cache"
 	"github.com/polygonid/sh-id-platform/pkg/notifications"
 	"github.com/polygonid/sh-id-platform/pkg/pubsub"
 )
 
+const (
+	holderDocumentKeyPrefix = "holder-did-document-"
+	// unresolvedHolderDocumentTTL is the time a holder DID that cannot be resolved, or the resolver failed to, is not
+	// resolved again, so a slow resolver stalls a single notification instead of every offer of a bulk issuance. It
+	// applies even when the resolved documents are not cached.
+	unresolvedHolderDocumentTTL = time.Minute
+)
+
+// NotificationCfg configures the resolution of the DID documents of the holders, that have their push service
+type NotificationCfg struct {
+	Resolver ports.DIDResolverGateway // If nil, the document the holder sent when it connected is used
+	Cache    cache.Cache              // If nil, the documents are resolved for every notification
+	CacheTTL time.Duration            // Time a resolved document is not resolved again. 0 resolves them every time
+}
+
+// holderDocument is the resolution of the DID document of a holder, kept in the cache. Error is set when it cannot be
+// resolved.
+type holderDocument struct {
+	Document json.RawMessage `json:"document,omitempty"`
+	Error    string          `json:"error,omitempty"`
+}
+
 type notification struct {
 	notificationGateway ports.NotificationGateway
 	connService         ports.ConnectionsService
 	credService         ports.ClaimsService
+	cfg                 NotificationCfg
 }
 
 // NewNotification returns a Notification Service
-func NewNotification(notificationGateway ports.NotificationGateway, connService ports.ConnectionsService, credService ports.ClaimsService) ports.NotificationService {
+func NewNotification(notificationGateway ports.NotificationGateway, connService ports.ConnectionsService, credService ports.ClaimsService, cfg NotificationCfg) ports.NotificationService {
 	return &notification{
 		notificationGateway: notificationGateway,
 		connService:         connService,
 		credService:         credService,
+		cfg:                 cfg,
 	}
 }
 
@@ -39,7 +65,22 @@ func (n *notification) SendCreateCredentialNotification(ctx context.Context, e p
 		return errors.New("sendCredentialNotification unexpected data type")
 	}
 
-	return n.sendCreateCredentialNotification(ctx, cEvent.IssuerID, cEvent.CredentialIDs)
+	return n.sendCreateCredentialNotification(ctx, cEvent.IssuerID, cEvent.CredentialIDs, nil)
+}
+
+// SendCredentialOfferNotification pushes to the holder an offer made by the issuer, instead of a new one
+func (n *notification) SendCredentialOfferNotification(ctx context.Context, e pubsub.Message) error {
+	var cEvent event.CredentialOffer
+	if err := cEvent.Unmarshal(e); err != nil {
+		return errors.New("sendCredentialOfferNotification unexpected data type")
+	}
+	offerID, err := uuid.Parse(cEvent.OfferID)
+	if err != nil {
+		log.Error(ctx, "sendCredentialOfferNotification: failed to parse offerID", "err", err.Error(), "issuerID", cEvent.IssuerID, "offerID", cEvent.OfferID)
+		return err
+	}
+
+	return n.sendCreateCredentialNotification(ctx, cEvent.IssuerID, cEvent.CredentialIDs, &offerID)
 }
 
 func (n *notification) SendCreateConnectionNotification(ctx context.Context, e pubsub.Message) error {
@@ -51,7 +92,7 @@ func (n *notification) SendCreateConnectionNotification(ctx context.Context, e p
 	return n.sendCreateConnectionNotification(ctx, cEvent.IssuerID, cEvent.ConnectionID)
 }
 
-func (n *notification) sendCreateCredentialNotification(ctx context.Context, issuerID string, credIDs []string) error {
+func (n *notification) sendCreateCredentialNotification(ctx context.Context, issuerID string, credIDs []string, offerID *uuid.UUID) error {
 	issuerDID, err := core.ParseDID(issuerID)
 	if err != nil {
 		log.Error(ctx, "sendCreateCredentialNotification: failed to parse issuerID", "err", err.Error(), "issuerID", issuerID)
@@ -91,7 +132,7 @@ func (n *notification) sendCreateCredentialNotification(ctx context.Context, iss
 		credentials[i] = credential
 	}
 
-	credOfferBytes, subjectDIDDoc, err := getCredentialOfferData(connection, credentials...)
+	credOfferBytes, subjectDIDDoc, err := n.getCredentialOfferData(ctx, connection, offerID, credentials...)
 	if err != nil {
 		log.Error(ctx, "sendCreateCredentialNotification: getCredentialOfferData", "err", err.Error(), "issuerID", issuerID)
 		return err
@@ -133,7 +174,7 @@ func (n *notification) sendCreateConnectionNotification(ctx context.Context, iss
 		return err
 	}
 
-	credOfferBytes, subjectDIDDoc, err := getCredentialOfferData(conn, credentials...)
+	credOfferBytes, subjectDIDDoc, err := n.getCredentialOfferData(ctx, conn, nil, credentials...)
 	if err != nil {
 		log.Error(ctx, "sendCreateConnectionNotification: getCredentialOfferData", "err", err.Error(), "issuerID", issuerID, "connID", connID)
 		return err
@@ -159,7 +200,9 @@ func (n *notification) send(ctx context.Context, credOfferBytes []byte, subjectD
 	return nil
 }
 
-func getCredentialOfferData(conn *domain.Connection, credentials ...*domain.Claim) (credOfferBytes []byte, subjectDIDDoc verifiable.DIDDocument, err error) {
+// getCredentialOfferData returns the offer of the credentials and the DID document of the holder, see holderDocument.
+// A new offer is created unless the id of one is given.
+func (n *notification) getCredentialOfferData(ctx context.Context, conn *domain.Connection, offerID *uuid.UUID, credentials ...*domain.Claim) (credOfferBytes []byte, subjectDIDDoc verifiable.DIDDocument, err error) {
 	var managedDIDDoc verifiable.DIDDocument
 	err = json.Unmarshal(conn.IssuerDoc, &managedDIDDoc)
 	if err != nil {
@@ -171,15 +214,75 @@ func getCredentialOfferData(conn *domain.Connection, credentials ...*domain.Clai
 		return nil, verifiable.DIDDocument{}, fmt.Errorf("unmarshal managedService, err: %v", err.Error())
 	}
 
-	credOfferBytes, err = notifications.NewOfferMsg(managedService.ServiceEndpoint, credentials...)
+	if len(credentials) == 0 {
+		return nil, verifiable.DIDDocument{}, errors.New("no credentials to offer")
+	}
+
+	if offerID == nil {
+		credentialIDs := make([]uuid.UUID, len(credentials))
+		for i := range credentials {
+			credentialIDs[i] = credentials[i].ID
+		}
+		offer, err := n.credService.CreateOffer(ctx, conn.IssuerDID, credentialIDs...)
+		if err != nil {
+			return nil, verifiable.DIDDocument{}, fmt.Errorf("createOffer, err: %v", err.Error())
+		}
+		offerID = &offer.ID
+	}
+
+	credOfferBytes, err = notifications.NewOfferMsg(offerID.String(), managedService.ServiceEndpoint, credentials...)
 	if err != nil {
 		return nil, verifiable.DIDDocument{}, fmt.Errorf("newOfferMsg, err: %v", err.Error())
 	}
 
-	err = json.Unmarshal(conn.UserDoc, &subjectDIDDoc)
+	err = json.Unmarshal(n.holderDocument(ctx, conn), &subjectDIDDoc)
 	if err != nil {
 		return nil, verifiable.DIDDocument{}, fmt.Errorf("unmarshal subjectDIDDoc, err: %v", err.Error())
 	}
 
 	return
 }
+
+// holderDocument returns the DID document of the holder of the connection, with its push service. It is resolved, so
+// the holders can change their push service, and the resolutions are cached for the ttl. The DIDs that cannot be
+// resolved, the empty documents and the failures of the resolver are cached for a minute, whatever the ttl. Without a
+// resolution the document the holder sent when it connected is used.
+func (n *notification) holderDocument(ctx context.Context, conn *domain.Connection) json.RawMessage {
+	if n.cfg.Resolver == nil {
+		return conn.UserDoc
+	}
+
+	did := conn.UserDID.String()
+	key := holderDocumentKeyPrefix + did
+	var resolution holderDocument
+	cached := n.cfg.Cache != nil && n.cfg.Cache.Get(ctx, key, &resolution)
+	if !cached {
+		resolved, err := n.cfg.Resolver.Resolve(ctx, did)
+		if err != nil {
+			log.Warn(ctx, "resolving holder did document", "err", err, "did", did)
+			resolution = holderDocument{Error: err.Error()}
+		} else {
+			resolution = holderDocument{Document: resolved.Document, Error: resolved.Error}
+		}
+	}
+	if resolution.Error == "" && (len(resolution.Document) == 0 || string(resolution.Document) == "null") {
+		resolution = holderDocument{Error: "empty document"}
+	}
+	if !cached && n.cfg.Cache != nil {
+		ttl := n.cfg.CacheTTL
+		if resolution.Error != "" && (ttl == 0 || ttl > unresolvedHolderDocumentTTL) {
+			ttl = unresolvedHolderDocumentTTL
+		}
+		if ttl > 0 {
+			if err := n.cfg.Cache.Set(ctx, key, resolution, ttl); err != nil {
+				log.Warn(ctx, "caching holder did document", "err", err)
+			}
+		}
+	}
+
+	if resolution.Error != "" {
+		log.Info(ctx, "holder did document cannot be resolved, using the one of the connection", "did", did, "error", resolution.Error)
+		return conn.UserDoc
+	}
+	return resolution.Document
+}
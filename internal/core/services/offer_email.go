@@ -0,0 +1,225 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+	"github.com/iden3/iden3comm/packers"
+	"github.com/iden3/iden3comm/protocol"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+	"github.com/polygonid/sh-id-platform/pkg/emails"
+	"github.com/polygonid/sh-id-platform/pkg/ratelimit"
+	"github.com/polygonid/sh-id-platform/pkg/walletlinks"
+)
+
+const offerEmailRateLimitKeyPrefix = "offer-email-"
+
+var (
+	// ErrOfferEmailsDisabled - the node is not configured to send offer emails
+	ErrOfferEmailsDisabled = errors.New("offer emails are not enabled")
+	// ErrOfferEmailsPaused - the offer emails are paused in the runtime settings
+	ErrOfferEmailsPaused = errors.New("offer emails are paused")
+	// ErrOfferEmailThrottled - too many offer emails were sent to the recipient
+	ErrOfferEmailThrottled = errors.New("too many offer emails sent to the recipient")
+	// ErrOfferEmailNotFound - the offer email does not exist
+	ErrOfferEmailNotFound = errors.New("offer email not found")
+	// ErrOfferRevokedCredential - revoked credentials cannot be offered
+	ErrOfferRevokedCredential = errors.New("the credential is revoked")
+)
+
+// OfferEmailCfg configures the offer emails. ServerURL is the public url of the UI API, the emails link to it.
+// ClaimPageURL is where the claim link redirects, with {credentialID} replaced, or the wallet universal link if empty.
+type OfferEmailCfg struct {
+	ServerURL           string
+	ClaimPageURL        string
+	PerRecipientPerHour int
+	WalletLinks         *walletlinks.Linker
+	Settings            ports.SettingsService // Runtime settings, they can pause the emails. If nil, they are always sent
+}
+
+type offerEmail struct {
+	claimsService ports.ClaimsService
+	profiles      ports.IssuerProfileService
+	repository    ports.OfferEmailRepository
+	emailGateway  ports.EmailGateway
+	templates     *emails.OfferTemplates
+	limiter       ratelimit.Limiter
+	storage       *db.Storage
+	cfg           OfferEmailCfg
+}
+
+// NewOfferEmail returns a new offer email service. Offers are not sent without an email gateway, but the emails
+// sent before keep being tracked.
+func NewOfferEmail(claimsService ports.ClaimsService, profiles ports.IssuerProfileService, repository ports.OfferEmailRepository, emailGateway ports.EmailGateway, templates *emails.OfferTemplates, limiter ratelimit.Limiter, storage *db.Storage, cfg OfferEmailCfg) ports.OfferEmailService {
+	return &offerEmail{
+		claimsService: claimsService,
+		profiles:      profiles,
+		repository:    repository,
+		emailGateway:  emailGateway,
+		templates:     templates,
+		limiter:       limiter,
+		storage:       storage,
+		cfg:           cfg,
+	}
+}
+
+// Send emails the offer of the credential to the recipient. The email is returned with the failed status if the
+// provider rejects it.
+func (o *offerEmail) Send(ctx context.Context, issuerDID core.DID, credentialID uuid.UUID, recipient string) (*domain.OfferEmail, error) {
+	if o.emailGateway == nil {
+		return nil, ErrOfferEmailsDisabled
+	}
+	if o.cfg.Settings != nil && !o.cfg.Settings.Current(ctx).Notifications.OfferEmails {
+		return nil, ErrOfferEmailsPaused
+	}
+	email, err := domain.NewOfferEmail(issuerDID, credentialID, recipient)
+	if err != nil {
+		return nil, err
+	}
+	credential, err := o.claimsService.GetByID(ctx, &issuerDID, credentialID)
+	if err != nil {
+		return nil, err
+	}
+	if credential.Revoked {
+		return nil, ErrOfferRevokedCredential
+	}
+	if err := o.throttle(ctx, issuerDID, recipient); err != nil {
+		return nil, err
+	}
+
+	profile, err := o.profiles.Get(ctx, issuerDID)
+	if err != nil {
+		return nil, err
+	}
+	subject, body, err := o.templates.Render(emails.OfferData{
+		IssuerName:      profile.DisplayName,
+		LogoURL:         profile.LogoURL,
+		BackgroundColor: profile.BackgroundColor,
+		TextColor:       profile.TextColor,
+		ContactEmail:    profile.ContactEmail,
+		ContactURL:      profile.ContactURL,
+		CredentialType:  credentialTypeName(credential.SchemaType),
+		ClaimURL:        o.trackingURL(email.ID, "claim"),
+		OpenURL:         o.trackingURL(email.ID, "open"),
+	})
+	if err != nil {
+		log.Error(ctx, "rendering offer email", "err", err)
+		return nil, err
+	}
+
+	if err := o.repository.Save(ctx, o.storage.Pgx, email); err != nil {
+		return nil, err
+	}
+	if err := o.emailGateway.Send(ctx, &ports.Email{To: []string{recipient}, Subject: subject, ContentType: "text/html", Body: body}); err != nil {
+		log.Warn(ctx, "sending offer email", "err", err, "id", email.ID)
+		email.Failed(err)
+	} else {
+		email.Sent(time.Now().UTC())
+	}
+	if err := o.repository.Save(ctx, o.storage.Pgx, email); err != nil {
+		return nil, err
+	}
+	return email, nil
+}
+
+// GetByCredential returns the offer emails of the credential, the last one first
+func (o *offerEmail) GetByCredential(ctx context.Context, issuerDID core.DID, credentialID uuid.UUID) ([]*domain.OfferEmail, error) {
+	if _, err := o.claimsService.GetByID(ctx, &issuerDID, credentialID); err != nil {
+		return nil, err
+	}
+	return o.repository.GetByCredential(ctx, o.storage.Pgx, issuerDID, credentialID)
+}
+
+// Open records that the offer email was opened
+func (o *offerEmail) Open(ctx context.Context, id uuid.UUID) error {
+	err := o.repository.Opened(ctx, o.storage.Pgx, id, time.Now().UTC())
+	if errors.Is(err, repositories.ErrOfferEmailNotFound) {
+		return ErrOfferEmailNotFound
+	}
+	return err
+}
+
+// Claim records that the claim link of the offer email was followed and returns where to redirect the holder: the
+// claim page, or the wallet universal link with a new offer of the credential
+func (o *offerEmail) Claim(ctx context.Context, id uuid.UUID) (string, error) {
+	email, err := o.repository.GetByID(ctx, o.storage.Pgx, id)
+	if errors.Is(err, repositories.ErrOfferEmailNotFound) {
+		return "", ErrOfferEmailNotFound
+	}
+	if err != nil {
+		return "", err
+	}
+	if err := o.repository.Clicked(ctx, o.storage.Pgx, id, time.Now().UTC()); err != nil {
+		return "", err
+	}
+
+	if o.cfg.ClaimPageURL != "" {
+		return strings.ReplaceAll(o.cfg.ClaimPageURL, "{credentialID}", email.CredentialID.String()), nil
+	}
+
+	credential, err := o.claimsService.GetByID(ctx, &email.IssuerDID, email.CredentialID)
+	if err != nil {
+		return "", err
+	}
+	offer, err := o.claimsService.CreateOffer(ctx, email.IssuerDID, email.CredentialID)
+	if err != nil {
+		return "", err
+	}
+	links, err := o.cfg.WalletLinks.Links(protocol.CredentialsOfferMessage{
+		ID:       offer.ID.String(),
+		Typ:      packers.MediaTypePlainMessage,
+		Type:     protocol.CredentialOfferMessageType,
+		ThreadID: offer.ID.String(),
+		Body: protocol.CredentialsOfferMessageBody{
+			URL:         fmt.Sprintf("%s/v1/agent", strings.TrimSuffix(o.cfg.ServerURL, "/")),
+			Credentials: []protocol.CredentialOffer{{ID: credential.ID.String(), Description: credentialTypeName(credential.SchemaType)}},
+		},
+		From: credential.Issuer,
+		To:   credential.OtherIdentifier,
+	})
+	if err != nil {
+		return "", err
+	}
+	return links.UniversalLink, nil
+}
+
+// throttle returns ErrOfferEmailThrottled if the recipient got the configured emails of the issuer in the last hour
+func (o *offerEmail) throttle(ctx context.Context, issuerDID core.DID, recipient string) error {
+	if o.limiter == nil || o.cfg.PerRecipientPerHour <= 0 {
+		return nil
+	}
+	limit := ratelimit.Limit{Rate: float64(o.cfg.PerRecipientPerHour) / 3600, Burst: o.cfg.PerRecipientPerHour}
+	key := offerEmailRateLimitKeyPrefix + issuerDID.String() + "-" + strings.ToLower(recipient)
+	allowed, wait, err := o.limiter.Allow(ctx, key, limit)
+	if err != nil {
+		// the offers are still sent if the limiter is not available
+		log.Warn(ctx, "checking offer email rate limit", "err", err)
+		return nil
+	}
+	if !allowed {
+		return fmt.Errorf("%w, retry in %s", ErrOfferEmailThrottled, wait.Round(time.Second))
+	}
+	return nil
+}
+
+func (o *offerEmail) trackingURL(id uuid.UUID, action string) string {
+	return fmt.Sprintf("%s/v1/offer-emails/%s/%s", strings.TrimSuffix(o.cfg.ServerURL, "/"), id, action)
+}
+
+// credentialTypeName returns the type of the credential without the context of the schema type
+func credentialTypeName(schemaType string) string {
+	if i := strings.LastIndex(schemaType, "#"); i >= 0 {
+		return schemaType[i+1:]
+	}
+	return schemaType
+}
@@ -0,0 +1,360 @@
+package services
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	core "github.com/iden3/go-iden3-core"
+	"github.com/iden3/go-schema-processor/verifiable"
+	"gopkg.in/square/go-jose.v2"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+	schemaPkg "github.com/polygonid/sh-id-platform/pkg/schema"
+)
+
+var (
+	// ErrInvalidOID4VCIOffer the credential of the offer cannot be issued
+	ErrInvalidOID4VCIOffer = errors.New("invalid offer")
+	// ErrOID4VCIIdentityNotFound the issuer of the offer does not exist
+	ErrOID4VCIIdentityNotFound = errors.New("identity not found")
+	// ErrOID4VCIUnsupportedGrantType the token request is not for the pre-authorized code flow
+	ErrOID4VCIUnsupportedGrantType = errors.New("unsupported_grant_type")
+	// ErrOID4VCIInvalidGrant the pre-authorized code does not exist, expired or was already used
+	ErrOID4VCIInvalidGrant = errors.New("invalid_grant")
+	// ErrOID4VCIInvalidToken the access token does not exist or expired
+	ErrOID4VCIInvalidToken = errors.New("invalid_token")
+	// ErrOID4VCIUnsupportedCredentialFormat the wallet asks for a format the issuer does not issue
+	ErrOID4VCIUnsupportedCredentialFormat = errors.New("unsupported_credential_format")
+	// ErrOID4VCIInvalidProof the proof of possession of the wallet is missing or not valid
+	ErrOID4VCIInvalidProof = errors.New("invalid_proof")
+)
+
+// oid4vciProofMaxAge is how old the proofs of possession of the wallets can be
+const oid4vciProofMaxAge = 5 * time.Minute
+
+// OID4VCICfg configures the OpenID4VCI facade. Host is the url of the node, which is the credential issuer of the
+// wallets. The pre-authorized codes of the offers can be exchanged during OfferExpiration, and the access tokens
+// are valid during TokenExpiration. If DisplayIssuer is set, the metadata shows its profile, as the wallets see the
+// node as a single issuer.
+type OID4VCICfg struct {
+	Host            string
+	OfferExpiration time.Duration
+	TokenExpiration time.Duration
+	DisplayIssuer   *core.DID
+	Profiles        ports.IssuerProfileService
+}
+
+type oid4vci struct {
+	repo          ports.OID4VCIRepository
+	claimsService ports.ClaimsService
+	identitySrv   ports.IdentityService
+	storage       *db.Storage
+	cfg           OID4VCICfg
+}
+
+// NewOID4VCI returns a new OpenID4VCI service
+func NewOID4VCI(repo ports.OID4VCIRepository, claimsService ports.ClaimsService, identitySrv ports.IdentityService, storage *db.Storage, cfg OID4VCICfg) ports.OID4VCIService {
+	return &oid4vci{
+		repo:          repo,
+		claimsService: claimsService,
+		identitySrv:   identitySrv,
+		storage:       storage,
+		cfg:           cfg,
+	}
+}
+
+// CreateOffer saves an offer of the credential and returns the message the wallet scans to get it
+func (o *oid4vci) CreateOffer(ctx context.Context, issuerDID core.DID, credential domain.OID4VCICredential) (*ports.OID4VCICredentialOffer, error) {
+	if _, err := url.ParseRequestURI(credential.Schema); err != nil {
+		return nil, fmt.Errorf("%w: invalid schema url", ErrInvalidOID4VCIOffer)
+	}
+	if credential.Type == "" {
+		return nil, fmt.Errorf("%w: the type is required", ErrInvalidOID4VCIOffer)
+	}
+	if id, ok := credential.CredentialSubject["id"]; ok {
+		if s, _ := id.(string); s == "" {
+			return nil, fmt.Errorf("%w: invalid credential subject id", ErrInvalidOID4VCIOffer)
+		} else if _, err := core.ParseDID(s); err != nil {
+			return nil, fmt.Errorf("%w: invalid credential subject id: %s", ErrInvalidOID4VCIOffer, err)
+		}
+	}
+	exists, err := o.identitySrv.Exists(ctx, issuerDID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrOID4VCIIdentityNotFound
+	}
+
+	offer, code, err := domain.NewOID4VCIOffer(issuerDID, credential, o.cfg.OfferExpiration)
+	if err != nil {
+		return nil, err
+	}
+	if err := o.repo.Save(ctx, o.storage.Pgx, offer); err != nil {
+		return nil, err
+	}
+	log.Info(ctx, "openid4vci offer created", "did", issuerDID.String(), "offer", offer.ID, "type", credential.Type)
+
+	return &ports.OID4VCICredentialOffer{
+		Offer: offer,
+		Message: &ports.OID4VCICredentialOfferMessage{
+			CredentialIssuer: o.cfg.Host,
+			Credentials: []ports.OID4VCIOfferedCredential{{
+				Format: domain.OID4VCIFormat,
+				CredentialDefinition: ports.OID4VCICredentialDefinition{
+					Context: []string{verifiable.JSONLDSchemaW3CCredential2018, verifiable.JSONLDSchemaIden3Credential},
+					Types:   []string{verifiable.TypeW3CVerifiableCredential, credential.Type},
+				},
+			}},
+			Grants: map[string]ports.OID4VCIPreAuthorizedCodeGrant{
+				domain.OID4VCIPreAuthorizedCodeGrant: {PreAuthorizedCode: code},
+			},
+		},
+	}, nil
+}
+
+// Metadata returns the endpoints of the node and the credentials it issues. If the profile of the displayed issuer
+// can't be read the metadata is returned without it, so the wallets can still get their credentials.
+func (o *oid4vci) Metadata(ctx context.Context) *ports.OID4VCIIssuerMetadata {
+	metadata := &ports.OID4VCIIssuerMetadata{
+		CredentialIssuer:   o.cfg.Host,
+		CredentialEndpoint: o.cfg.Host + "/v1/oid4vci/credential",
+		TokenEndpoint:      o.cfg.Host + "/v1/oid4vci/token",
+		CredentialsSupported: []ports.OID4VCICredentialSupported{{
+			Format:                               domain.OID4VCIFormat,
+			ID:                                   "Iden3Credential",
+			Context:                              []string{verifiable.JSONLDSchemaW3CCredential2018, verifiable.JSONLDSchemaIden3Credential},
+			Types:                                []string{verifiable.TypeW3CVerifiableCredential},
+			CryptographicBindingMethodsSupported: []string{"jwk", "did:jwk"},
+			CryptographicSuitesSupported:         []string{string(verifiable.BJJSignatureProofType), string(verifiable.Iden3SparseMerkleTreeProofType)},
+		}},
+	}
+	if o.cfg.DisplayIssuer == nil || o.cfg.Profiles == nil {
+		return metadata
+	}
+
+	profile, err := o.cfg.Profiles.Get(ctx, *o.cfg.DisplayIssuer)
+	if err != nil {
+		log.Warn(ctx, "cannot load the issuer profile of the openid4vci metadata", "err", err, "did", o.cfg.DisplayIssuer.String())
+		return metadata
+	}
+	if profile.DisplayName != "" {
+		display := ports.OID4VCIIssuerDisplay{Name: profile.DisplayName}
+		if profile.LogoURL != "" {
+			display.Logo = &ports.OID4VCILogo{URL: profile.LogoURL, AltText: profile.DisplayName}
+		}
+		metadata.Display = []ports.OID4VCIIssuerDisplay{display}
+	}
+	if profile.BackgroundColor != "" || profile.TextColor != "" {
+		supported := &metadata.CredentialsSupported[0]
+		supported.Display = []ports.OID4VCICredentialDisplay{{
+			Name:            supported.ID,
+			BackgroundColor: profile.BackgroundColor,
+			TextColor:       profile.TextColor,
+		}}
+	}
+	return metadata
+}
+
+// Token exchanges the pre-authorized code of an offer for an access token. A code can only be exchanged once.
+func (o *oid4vci) Token(ctx context.Context, grantType string, preAuthorizedCode string) (*ports.OID4VCIToken, error) {
+	if grantType != domain.OID4VCIPreAuthorizedCodeGrant {
+		return nil, ErrOID4VCIUnsupportedGrantType
+	}
+	offer, err := o.repo.GetByPreAuthorizedCode(ctx, o.storage.Pgx, domain.OID4VCIHash(preAuthorizedCode))
+	if errors.Is(err, repositories.ErrOID4VCIOfferNotFound) {
+		return nil, ErrOID4VCIInvalidGrant
+	}
+	if err != nil {
+		return nil, err
+	}
+	if offer.Expired(time.Now()) || offer.Redeemed() {
+		return nil, ErrOID4VCIInvalidGrant
+	}
+
+	token, err := domain.NewOID4VCISecret()
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := domain.NewOID4VCISecret()
+	if err != nil {
+		return nil, err
+	}
+	err = o.repo.Redeem(ctx, o.storage.Pgx, offer.ID, domain.OID4VCIHash(token), time.Now().Add(o.cfg.TokenExpiration), nonce)
+	if errors.Is(err, repositories.ErrOID4VCIOfferRedeemed) {
+		return nil, ErrOID4VCIInvalidGrant
+	}
+	if err != nil {
+		return nil, err
+	}
+	expiresIn := int(o.cfg.TokenExpiration.Seconds())
+	return &ports.OID4VCIToken{AccessToken: token, ExpiresIn: expiresIn, CNonce: nonce, CNonceExpiresIn: expiresIn}, nil
+}
+
+// Credential issues the credential of the offer of the access token. The wallet proves that it holds a key by signing
+// the last nonce of the offer, which is replaced on every request, so a proof cannot be used twice. The credential is
+// only created once, later requests with a valid proof get the same credential.
+func (o *oid4vci) Credential(ctx context.Context, accessToken string, req *ports.OID4VCICredentialRequest) (*ports.OID4VCIIssuedCredential, error) {
+	offer, err := o.repo.GetByAccessToken(ctx, o.storage.Pgx, domain.OID4VCIHash(accessToken))
+	if errors.Is(err, repositories.ErrOID4VCIOfferNotFound) {
+		return nil, ErrOID4VCIInvalidToken
+	}
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if offer.TokenExpired(now) || offer.CNonce == nil {
+		return nil, ErrOID4VCIInvalidToken
+	}
+	if req.Format != domain.OID4VCIFormat {
+		return nil, ErrOID4VCIUnsupportedCredentialFormat
+	}
+
+	nonce, err := domain.NewOID4VCISecret()
+	if err != nil {
+		return nil, err
+	}
+	resp := &ports.OID4VCIIssuedCredential{CNonce: nonce, CNonceExpiresIn: int(offer.TokenExpiresAt.Sub(now).Seconds())}
+	proofErr := o.verifyProof(req, *offer.CNonce, now)
+	if err := o.repo.SetNonce(ctx, o.storage.Pgx, offer.ID, *offer.CNonce, nonce); err != nil {
+		if !errors.Is(err, repositories.ErrOID4VCINonceChanged) {
+			return nil, err
+		}
+		proofErr = errors.New("the nonce was already used")
+	}
+	if proofErr != nil {
+		log.Warn(ctx, "invalid openid4vci proof", "err", proofErr, "offer", offer.ID)
+		return resp, fmt.Errorf("%w: %s", ErrOID4VCIInvalidProof, proofErr)
+	}
+
+	claim, err := o.claim(ctx, offer)
+	if err != nil {
+		return nil, err
+	}
+	credential, err := schemaPkg.FromClaimModelToW3CCredential(*claim)
+	if err != nil {
+		return nil, err
+	}
+	resp.Credential = credential
+	return resp, nil
+}
+
+// claim returns the credential of the offer, which is created with a signature proof, so the wallet gets it without
+// waiting for the state to be published
+func (o *oid4vci) claim(ctx context.Context, offer *domain.OID4VCIOffer) (*domain.Claim, error) {
+	if offer.ClaimID != nil {
+		return o.claimsService.GetByID(ctx, &offer.IssuerDID, *offer.ClaimID)
+	}
+
+	credentialSubject := make(map[string]any, len(offer.Credential.CredentialSubject))
+	for k, v := range offer.Credential.CredentialSubject {
+		credentialSubject[k] = v
+	}
+	req := ports.NewCreateClaimRequest(&offer.IssuerDID, offer.Credential.Schema, credentialSubject, offer.Credential.Expiration,
+		offer.Credential.Type, nil, nil, nil, common.ToPointer(true), common.ToPointer(offer.Credential.MTProof), nil, false)
+	claim, err := o.claimsService.Save(ctx, req)
+	if err != nil {
+		log.Error(ctx, "issuing openid4vci credential", "err", err, "offer", offer.ID)
+		return nil, err
+	}
+	if err := o.repo.SetClaim(ctx, o.storage.Pgx, offer.ID, claim.ID); err != nil {
+		return nil, err
+	}
+	log.Info(ctx, "openid4vci credential issued", "did", offer.IssuerDID.String(), "offer", offer.ID, "claim", claim.ID)
+	return claim, nil
+}
+
+// verifyProof checks the JWT proof of possession of the wallet. The key of the wallet is the jwk header or a did:jwk
+// kid, the audience is the node and the nonce is the last one of the offer.
+func (o *oid4vci) verifyProof(req *ports.OID4VCICredentialRequest, nonce string, now time.Time) error {
+	if req.ProofType != domain.OID4VCIProofType || req.ProofJWT == "" {
+		return errors.New("a jwt proof is required")
+	}
+	jws, err := jose.ParseSigned(req.ProofJWT)
+	if err != nil {
+		return fmt.Errorf("parsing the proof: %w", err)
+	}
+	if len(jws.Signatures) != 1 {
+		return errors.New("the proof must have one signature")
+	}
+	header := jws.Signatures[0].Protected
+	if typ, _ := header.ExtraHeaders[jose.HeaderType].(string); typ != domain.OID4VCIProofJWTType {
+		return fmt.Errorf("the typ of the proof must be %s", domain.OID4VCIProofJWTType)
+	}
+	key := header.JSONWebKey
+	if key == nil {
+		if key, err = didJWKKey(header.KeyID); err != nil {
+			return err
+		}
+	}
+	if !key.IsPublic() {
+		return errors.New("the key of the proof must be public")
+	}
+	payload, err := jws.Verify(key)
+	if err != nil {
+		return fmt.Errorf("verifying the proof: %w", err)
+	}
+
+	var claims struct {
+		Audience any    `json:"aud"`
+		Nonce    string `json:"nonce"`
+		IssuedAt int64  `json:"iat"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return fmt.Errorf("parsing the claims of the proof: %w", err)
+	}
+	if !audienceContains(claims.Audience, o.cfg.Host) {
+		return fmt.Errorf("the audience of the proof must be %s", o.cfg.Host)
+	}
+	if claims.Nonce != nonce {
+		return errors.New("the nonce of the proof is not the last one")
+	}
+	issuedAt := time.Unix(claims.IssuedAt, 0)
+	if issuedAt.After(now.Add(oid4vciProofMaxAge)) || issuedAt.Before(now.Add(-oid4vciProofMaxAge)) {
+		return errors.New("the proof is too old")
+	}
+	return nil
+}
+
+// didJWKKey returns the key of a did:jwk, the DID is the encoded key
+func didJWKKey(kid string) (*jose.JSONWebKey, error) {
+	did, _, _ := strings.Cut(kid, "#")
+	encoded, ok := strings.CutPrefix(did, "did:jwk:")
+	if !ok {
+		return nil, errors.New("the proof must have a jwk header or a did:jwk kid")
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("decoding did:jwk: %w", err)
+	}
+	var key jose.JSONWebKey
+	if err := key.UnmarshalJSON(raw); err != nil {
+		return nil, fmt.Errorf("decoding did:jwk: %w", err)
+	}
+	return &key, nil
+}
+
+func audienceContains(audience any, host string) bool {
+	switch aud := audience.(type) {
+	case string:
+		return aud == host
+	case []any:
+		for _, a := range aud {
+			if a == host {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/polygonid/sh-id-platform/internal/kms"
 	"github.com/polygonid/sh-id-platform/internal/loader"
 	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/network"
 	"github.com/polygonid/sh-id-platform/internal/repositories"
 	"github.com/polygonid/sh-id-platform/pkg/credentials/signature/circuit/signer"
 	"github.com/polygonid/sh-id-platform/pkg/protocol"
@@ -49,23 +50,25 @@ type Proof struct {
 	identitySrv      ports.IdentityService
 	mtService        ports.MtService
 	claimsRepository ports.ClaimsRepository
+	heldCredentials  ports.HeldCredentialRepository
 	keyProvider      *kms.KMS
 	storage          *db.Storage
-	stateContract    *abi.State
+	networkResolver  *network.Resolver
 	schemaLoader     loader.Factory
 }
 
 // NewProofService init proof service
-func NewProofService(claimSrv ports.ClaimsService, revocationSrv ports.RevocationService, identitySrv ports.IdentityService, mtService ports.MtService, claimsRepository ports.ClaimsRepository, keyProvider *kms.KMS, storage *db.Storage, stateContract *abi.State, ld loader.Factory) ports.ProofService {
+func NewProofService(claimSrv ports.ClaimsService, revocationSrv ports.RevocationService, identitySrv ports.IdentityService, mtService ports.MtService, claimsRepository ports.ClaimsRepository, heldCredentials ports.HeldCredentialRepository, keyProvider *kms.KMS, storage *db.Storage, networkResolver *network.Resolver, ld loader.Factory) ports.ProofService {
 	return &Proof{
 		claimSrv:         claimSrv,
 		revocationSrv:    revocationSrv,
 		identitySrv:      identitySrv,
 		mtService:        mtService,
 		claimsRepository: claimsRepository,
+		heldCredentials:  heldCredentials,
 		keyProvider:      keyProvider,
 		storage:          storage,
-		stateContract:    stateContract,
+		networkResolver:  networkResolver,
 		schemaLoader:     ld,
 	}
 }
@@ -167,7 +170,7 @@ func (p *Proof) prepareAtomicQuerySigV2Circuit(ctx context.Context, did *core.DI
 	}
 
 	inputs := circuits.AtomicQuerySigV2Inputs{
-		RequestID:                big.NewInt(defaultAtomicCircuitsID),
+		RequestID:                requestID(query),
 		ID:                       &did.ID,
 		ProfileNonce:             big.NewInt(0),
 		ClaimSubjectProfileNonce: big.NewInt(0),
@@ -201,13 +204,18 @@ func (p *Proof) prepareAtomicQueryMTPV2Circuit(ctx context.Context, did *core.DI
 		return nil, nil, err
 	}
 
+	issuerDID, err := core.ParseDID(claim.Issuer)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	inputs := circuits.AtomicQueryMTPV2Inputs{
-		RequestID:                big.NewInt(defaultAtomicCircuitsID),
+		RequestID:                requestID(query),
 		ID:                       &did.ID,
 		ProfileNonce:             big.NewInt(0),
 		ClaimSubjectProfileNonce: big.NewInt(0),
 		Claim: circuits.ClaimWithMTPProof{
-			IssuerID:    &did.ID, // claim.Issuer,
+			IssuerID:    &issuerDID.ID,
 			Claim:       claim.CoreClaim.Get(),
 			NonRevProof: *claimNonRevProof,
 			IncProof:    claimInc,
@@ -231,6 +239,9 @@ func (p *Proof) getClaimDataForAtomicQueryCircuit(ctx context.Context, identifie
 		}
 		var c *domain.Claim
 		c, err = p.claimSrv.GetByID(ctx, identifier, claimUUID)
+		if errors.Is(err, ErrClaimNotFound) {
+			c, err = p.getHeldClaim(ctx, identifier, claimUUID)
+		}
 		if err != nil {
 			return nil, nil, err
 		}
@@ -274,12 +285,71 @@ func (p *Proof) findClaimForQuery(ctx context.Context, identifier *core.DID, que
 		filter.Revoked = common.ToPointer(false)
 	}
 
-	claim, err := p.claimsRepository.GetAllByIssuerID(ctx, p.storage.Pgx, *identifier, filter)
-	if errors.Is(err, repositories.ErrClaimDoesNotExist) {
+	claims, err := p.claimsRepository.GetAllByIssuerID(ctx, p.storage.Pgx, *identifier, filter)
+	if err != nil && !errors.Is(err, repositories.ErrClaimDoesNotExist) {
+		return nil, err
+	}
+
+	// credentials issued to the identity by other issuers are also candidates
+	held, err := p.heldCredentials.GetAll(ctx, p.storage.Pgx, *identifier, &ports.HeldCredentialsFilter{SchemaType: filter.SchemaType, Revoked: filter.Revoked})
+	if err != nil {
+		return nil, err
+	}
+	for _, h := range held {
+		claim, err := h.Claim()
+		if err != nil {
+			log.Warn(ctx, "held credential cannot be used in proofs", "err", err, "id", h.ID)
+			continue
+		}
+		claims = append(claims, claim)
+	}
+
+	claims = filterByAllowedIssuers(claims, query.AllowedIssuers)
+	if len(claims) == 0 {
 		return nil, fmt.Errorf("claim with credential type %v was not found", query)
 	}
 
-	return claim, err
+	return claims, nil
+}
+
+func (p *Proof) getHeldClaim(ctx context.Context, identifier *core.DID, id uuid.UUID) (*domain.Claim, error) {
+	held, err := p.heldCredentials.GetByID(ctx, p.storage.Pgx, *identifier, id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrHeldCredentialDoesNotExist) {
+			return nil, ErrClaimNotFound
+		}
+		return nil, err
+	}
+	return held.Claim()
+}
+
+// filterByAllowedIssuers returns the claims issued by one of the allowed issuers. An empty list or * allows any issuer.
+func filterByAllowedIssuers(claims []*domain.Claim, allowedIssuers []string) []*domain.Claim {
+	allowed := make(map[string]bool, len(allowedIssuers))
+	for _, issuer := range allowedIssuers {
+		if issuer == "*" {
+			return claims
+		}
+		allowed[issuer] = true
+	}
+	if len(allowed) == 0 {
+		return claims
+	}
+
+	filtered := make([]*domain.Claim, 0, len(claims))
+	for _, claim := range claims {
+		if allowed[claim.Issuer] {
+			filtered = append(filtered, claim)
+		}
+	}
+	return filtered
+}
+
+func requestID(query ports.Query) *big.Int {
+	if query.RequestID != nil {
+		return query.RequestID
+	}
+	return big.NewInt(defaultAtomicCircuitsID)
 }
 
 func (p *Proof) checkRevocationStatus(ctx context.Context, claim *domain.Claim) (*verifiable.RevocationStatus, error) {
@@ -330,10 +400,13 @@ func (p *Proof) checkRevocationStatus(ctx context.Context, claim *domain.Claim)
 		return nil, err
 	}
 	if claimRs.MTP.Existence {
+		if isHeldClaim(claim) {
+			return claimRs, p.markHeldCredentialRevoked(ctx, claim)
+		}
 		// update revocation status
 		err = p.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
 			claim.Revoked = true
-			_, err = p.claimsRepository.Save(ctx, p.storage.Pgx, claim)
+			_, err = p.claimsRepository.Save(ctx, tx, claim)
 			if err != nil {
 				return fmt.Errorf("can't save claim %v", err)
 			}
@@ -349,6 +422,28 @@ func (p *Proof) checkRevocationStatus(ctx context.Context, claim *domain.Claim)
 	return claimRs, nil
 }
 
+// isHeldClaim tells whether the claim was issued to the identity by another issuer
+func isHeldClaim(claim *domain.Claim) bool {
+	return claim.Identifier != nil && *claim.Identifier != claim.Issuer
+}
+
+func (p *Proof) markHeldCredentialRevoked(ctx context.Context, claim *domain.Claim) error {
+	holderDID, err := core.ParseDID(*claim.Identifier)
+	if err != nil {
+		return err
+	}
+	held, err := p.heldCredentials.GetByID(ctx, p.storage.Pgx, *holderDID, claim.ID)
+	if err != nil {
+		return err
+	}
+	held.Revoked = true
+	claim.Revoked = true
+	if _, err := p.heldCredentials.Save(ctx, p.storage.Pgx, held); err != nil {
+		return fmt.Errorf("can't save held credential %v", err)
+	}
+	return nil
+}
+
 func (p *Proof) findNonRevokedClaim(ctx context.Context, claims []*domain.Claim) (*domain.Claim, circuits.MTProof, error) {
 	for _, claim := range claims {
 		rsClaim, err := p.checkRevocationStatus(ctx, claim)
@@ -529,7 +624,11 @@ func (p *Proof) prepareAuthV2Circuit(ctx context.Context, identifier *core.DID,
 	if err != nil {
 		return circuits.AuthV2Inputs{}, err
 	}
-	globalTree, err := populateGlobalTree(ctx, identifier, p.stateContract)
+	stateContract, err := p.networkResolver.StateContract(network.Key(*identifier))
+	if err != nil {
+		return circuits.AuthV2Inputs{}, err
+	}
+	globalTree, err := populateGlobalTree(ctx, identifier, stateContract)
 	if err != nil {
 		return circuits.AuthV2Inputs{}, err
 	}
@@ -0,0 +1,146 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	core "github.com/iden3/go-iden3-core"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+var (
+	// ErrInvalidPublishingPolicy the publishing policy lacks the values of its mode
+	ErrInvalidPublishingPolicy = errors.New("invalid publishing policy")
+	// ErrPublishingPolicyIdentityNotFound the identity of the publishing policy does not exist
+	ErrPublishingPolicyIdentityNotFound = errors.New("identity not found")
+)
+
+// PublishingPolicyCfg is the publishing policy of the identities that do not have their own
+type PublishingPolicyCfg struct {
+	Mode             domain.PublishingMode
+	Interval         time.Duration
+	Threshold        int
+	RevocationWindow time.Duration
+	// Settings overrides the configured policy at runtime. If nil, the configured policy applies.
+	Settings ports.SettingsService
+}
+
+type publishingPolicy struct {
+	repo      ports.PublishingPolicyRepository
+	publisher ports.Publisher
+	storage   *db.Storage
+	defaults  domain.PublishingPolicy
+	settings  ports.SettingsService
+}
+
+// NewPublishingPolicy returns a new publishing policy service. It fails if the configured policy is not valid.
+func NewPublishingPolicy(repo ports.PublishingPolicyRepository, publisher ports.Publisher, storage *db.Storage, cfg PublishingPolicyCfg) (ports.PublishingPolicyService, error) {
+	defaults := domain.PublishingPolicy{Mode: cfg.Mode, Interval: cfg.Interval, Threshold: cfg.Threshold, RevocationWindow: cfg.RevocationWindow}
+	if err := defaults.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidPublishingPolicy, err)
+	}
+	return &publishingPolicy{
+		repo:      repo,
+		publisher: publisher,
+		storage:   storage,
+		defaults:  defaults,
+		settings:  cfg.Settings,
+	}, nil
+}
+
+// Get returns the policy of the identity, or the configured one if it has none
+func (p *publishingPolicy) Get(ctx context.Context, did core.DID) (*domain.PublishingPolicy, error) {
+	policy, err := p.repo.GetByIdentifier(ctx, p.storage.Pgx, did)
+	if errors.Is(err, repositories.ErrPublishingPolicyNotFound) {
+		return p.defaultPolicy(ctx, did.String()), nil
+	}
+	return policy, err
+}
+
+// Set replaces the policy of the identity
+func (p *publishingPolicy) Set(ctx context.Context, did core.DID, policy *domain.PublishingPolicy) (*domain.PublishingPolicy, error) {
+	if err := policy.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidPublishingPolicy, err)
+	}
+
+	policy.Identifier = did.String()
+	policy.Overridden = true
+	if err := p.repo.Save(ctx, p.storage.Pgx, policy); err != nil {
+		if errors.Is(err, repositories.ErrPublishingPolicyIdentityNotFound) {
+			return nil, ErrPublishingPolicyIdentityNotFound
+		}
+		return nil, err
+	}
+	log.Info(ctx, "publishing policy set", "did", did.String(), "mode", policy.Mode, "interval", policy.Interval, "threshold", policy.Threshold, "revocationWindow", policy.RevocationWindow)
+	return policy, nil
+}
+
+// Reset removes the policy of the identity, so it takes the configured one again
+func (p *publishingPolicy) Reset(ctx context.Context, did core.DID) (*domain.PublishingPolicy, error) {
+	if err := p.repo.Delete(ctx, p.storage.Pgx, did); err != nil {
+		return nil, err
+	}
+	log.Info(ctx, "publishing policy reset", "did", did.String())
+	return p.defaultPolicy(ctx, did.String()), nil
+}
+
+// PublishDue publishes the state of every identity whose pending changes are due. A failure to publish an identity
+// does not stop the others, it is tried again in the next run.
+func (p *publishingPolicy) PublishDue(ctx context.Context) error {
+	pending, err := p.repo.GetPendingChanges(ctx, p.storage.Pgx)
+	if err != nil {
+		return err
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+	overrides, err := p.repo.GetAll(ctx, p.storage.Pgx)
+	if err != nil {
+		return err
+	}
+	policies := make(map[string]*domain.PublishingPolicy, len(overrides))
+	for _, policy := range overrides {
+		policies[policy.Identifier] = policy
+	}
+
+	defaults := p.defaultPolicy(ctx, "")
+	now := time.Now()
+	for _, changes := range pending {
+		policy, ok := policies[changes.Identifier]
+		if !ok {
+			policy = defaults
+		}
+		if !policy.Due(changes, now) {
+			continue
+		}
+		did, err := core.ParseDID(changes.Identifier)
+		if err != nil {
+			log.Error(ctx, "invalid identifier with pending changes", "err", err, "identifier", changes.Identifier)
+			continue
+		}
+		if _, err := p.publisher.PublishState(ctx, did); err != nil {
+			log.Warn(ctx, "cannot publish scheduled state", "err", err, "did", changes.Identifier, "mode", policy.Mode)
+			continue
+		}
+		log.Info(ctx, "scheduled state published", "did", changes.Identifier, "mode", policy.Mode, "changes", changes.Changes)
+	}
+	return nil
+}
+
+// defaultPolicy returns the policy of the identities without their own, the one of the runtime settings if there are
+func (p *publishingPolicy) defaultPolicy(ctx context.Context, identifier string) *domain.PublishingPolicy {
+	policy := p.defaults
+	if p.settings != nil {
+		policy = p.settings.Current(ctx).Publishing
+		policy.Overridden = false
+	}
+	policy.Identifier = identifier
+	return &policy
+}
@@ -0,0 +1,104 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/pkg/reports"
+)
+
+// ReportCfg configures the scheduled reports
+type ReportCfg struct {
+	Frequency  domain.ReportFrequency
+	Format     domain.ReportFormat
+	Recipients []string
+	Settings   ports.SettingsService // Runtime settings, they can pause the reports. If nil, they are always sent
+}
+
+type report struct {
+	statsRepository ports.StatsRepository
+	emailGateway    ports.EmailGateway
+	storage         *db.Storage
+	cfg             ReportCfg
+}
+
+// NewReport returns a new report service
+func NewReport(statsRepository ports.StatsRepository, emailGateway ports.EmailGateway, storage *db.Storage, cfg ReportCfg) ports.ReportService {
+	return &report{
+		statsRepository: statsRepository,
+		emailGateway:    emailGateway,
+		storage:         storage,
+		cfg:             cfg,
+	}
+}
+
+// Build returns the activity of the node between from and to
+func (r *report) Build(ctx context.Context, from, to time.Time) (*domain.Report, error) {
+	issued, err := r.statsRepository.IssuedCredentials(ctx, r.storage.Replica, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("getting issued credentials: %w", err)
+	}
+	revocations, err := r.statsRepository.Revocations(ctx, r.storage.Replica, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("getting revocations: %w", err)
+	}
+	failed, err := r.statsRepository.FailedOperations(ctx, r.storage.Replica, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("getting failed operations: %w", err)
+	}
+
+	return &domain.Report{
+		From:             from,
+		To:               to,
+		Issued:           issued,
+		Revocations:      revocations,
+		FailedOperations: failed,
+	}, nil
+}
+
+// Send emails the report of the period that finishes at the given time to the configured recipients
+func (r *report) Send(ctx context.Context, to time.Time) error {
+	if r.cfg.Settings != nil && !r.cfg.Settings.Current(ctx).Notifications.Reports {
+		log.Info(ctx, "reports are paused, the report is not sent", "to", to)
+		return nil
+	}
+	rep, err := r.Build(ctx, to.Add(-r.cfg.Frequency.Period()), to)
+	if err != nil {
+		return err
+	}
+
+	email := &ports.Email{
+		To:      r.cfg.Recipients,
+		Subject: fmt.Sprintf("Issuer node %s report %s", r.cfg.Frequency, to.UTC().Format("2006-01-02")),
+	}
+	if r.cfg.Format == domain.ReportFormatCSV {
+		content, err := reports.CSV(rep)
+		if err != nil {
+			return err
+		}
+		email.ContentType = "text/plain"
+		email.Body = []byte(reports.Summary(rep))
+		email.Attachments = []ports.EmailAttachment{{
+			Name:        fmt.Sprintf("report-%s.csv", to.UTC().Format("2006-01-02")),
+			ContentType: "text/csv",
+			Content:     content,
+		}}
+	} else {
+		email.ContentType = "text/html"
+		if email.Body, err = reports.HTML(rep); err != nil {
+			return err
+		}
+	}
+
+	if err := r.emailGateway.Send(ctx, email); err != nil {
+		log.Error(ctx, "sending report", "err", err, "recipients", r.cfg.Recipients)
+		return err
+	}
+	log.Info(ctx, "report sent", "from", rep.From, "to", rep.To, "recipients", len(r.cfg.Recipients))
+	return nil
+}
@@ -18,6 +18,7 @@ import (
 	proof "github.com/iden3/merkletree-proof"
 
 	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/network"
 	client "github.com/polygonid/sh-id-platform/pkg/http"
 	"github.com/polygonid/sh-id-platform/pkg/protocol"
 )
@@ -34,23 +35,36 @@ type StateStore interface {
 
 // Revocation TBD
 type Revocation struct {
-	eth      StateStore
-	contract common.Address
+	networkResolver *network.Resolver
 }
 
-// NewRevocationService returns the Revocation struct
-func NewRevocationService(ethStore StateStore, contract common.Address) *Revocation {
+// NewRevocationService returns the Revocation struct. The issuer states are read from the state contract of the
+// issuer network.
+func NewRevocationService(networkResolver *network.Resolver) *Revocation {
 	return &Revocation{
-		eth:      ethStore,
-		contract: contract,
+		networkResolver: networkResolver,
 	}
 }
 
+// latestState returns the latest state of the issuer published in its network
+func (r *Revocation) latestState(ctx context.Context, issuerDID *core.DID) (abi.IStateStateInfo, error) {
+	key := network.Key(*issuerDID)
+	ethStore, err := r.networkResolver.Client(key)
+	if err != nil {
+		return abi.IStateStateInfo{}, err
+	}
+	contract, err := r.networkResolver.ContractAddress(key)
+	if err != nil {
+		return abi.IStateStateInfo{}, err
+	}
+	return ethStore.GetLatestStateByID(ctx, contract, issuerDID.ID.BigInt())
+}
+
 // Status returns the current revocation status
 func (r *Revocation) Status(ctx context.Context, credStatus interface{}, issuerDID *core.DID) (*verifiable.RevocationStatus, error) {
 	switch status := credStatus.(type) {
 	case *verifiable.RHSCredentialStatus:
-		latestStateInfo, err := r.eth.GetLatestStateByID(ctx, r.contract, issuerDID.ID.BigInt())
+		latestStateInfo, err := r.latestState(ctx, issuerDID)
 		if err != nil && strings.Contains(err.Error(), protocol.ErrStateNotFound.Error()) {
 			return nil, protocol.ErrStateNotFound
 		}
@@ -118,7 +132,7 @@ func (r *Revocation) Status(ctx context.Context, credStatus interface{}, issuerD
 }
 
 func getRevocationProofFromIssuer(ctx context.Context, url string) (*verifiable.RevocationStatus, error) {
-	b, err := client.NewClient(*http.DefaultClient).Get(ctx, url)
+	b, err := client.NewClient(http.Client{}).Get(ctx, url)
 	if err != nil {
 		return nil, err
 	}
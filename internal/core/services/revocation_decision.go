@@ -0,0 +1,223 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+var (
+	// ErrInvalidRevocationDecisions the request has no source, too many decisions or decisions without id
+	ErrInvalidRevocationDecisions = errors.New("invalid revocation decisions")
+	// ErrRevocationDecisionsIdentityNotFound the issuer of the decisions does not exist
+	ErrRevocationDecisionsIdentityNotFound = errors.New("identity not found")
+)
+
+// RevocationDecisionCfg configures the polling of an external system. Nothing is polled without a gateway.
+type RevocationDecisionCfg struct {
+	Gateway ports.RevocationDecisionsGateway
+	Source  string
+}
+
+type revocationDecision struct {
+	repo        ports.RevocationDecisionRepository
+	claimsRepo  ports.ClaimsRepository
+	claims      ports.ClaimsService
+	identitySrv ports.IdentityService
+	storage     *db.Storage
+	cfg         RevocationDecisionCfg
+}
+
+// NewRevocationDecision returns a new revocation decision service
+func NewRevocationDecision(repo ports.RevocationDecisionRepository, claimsRepo ports.ClaimsRepository, claims ports.ClaimsService, identitySrv ports.IdentityService, storage *db.Storage, cfg RevocationDecisionCfg) ports.RevocationDecisionService {
+	return &revocationDecision{
+		repo:        repo,
+		claimsRepo:  claimsRepo,
+		claims:      claims,
+		identitySrv: identitySrv,
+		storage:     storage,
+		cfg:         cfg,
+	}
+}
+
+// Apply revokes the credentials of the decisions and records whether every decision was applied or rejected.
+// Decisions already received from the source are not applied again, the recorded one is returned instead, so the
+// sources can retry their requests. Decisions are rejected when their credential does not exist or is already
+// revoked, other errors stop the request without recording the decision.
+func (r *revocationDecision) Apply(ctx context.Context, issuerDID core.DID, source string, requests []ports.RevocationDecisionRequest) ([]*domain.RevocationDecision, error) {
+	if source == "" {
+		return nil, fmt.Errorf("%w: the source is required", ErrInvalidRevocationDecisions)
+	}
+	if len(requests) > ports.MaxRevocationDecisions {
+		return nil, fmt.Errorf("%w: no more than %d decisions can be sent at once", ErrInvalidRevocationDecisions, ports.MaxRevocationDecisions)
+	}
+	for i := range requests {
+		if requests[i].ID == "" {
+			return nil, fmt.Errorf("%w: decision %d has no id", ErrInvalidRevocationDecisions, i)
+		}
+	}
+	exists, err := r.identitySrv.Exists(ctx, issuerDID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrRevocationDecisionsIdentityNotFound
+	}
+
+	decisions := make([]*domain.RevocationDecision, 0, len(requests))
+	for i := range requests {
+		decision, err := r.apply(ctx, issuerDID, source, &requests[i])
+		if err != nil {
+			return nil, fmt.Errorf("applying decision %s: %w", requests[i].ID, err)
+		}
+		decisions = append(decisions, decision)
+	}
+	return decisions, nil
+}
+
+// Poll fetches the decisions of every identity taken since the latest one received from the configured source, and
+// applies them. An identity that fails does not stop the others.
+func (r *revocationDecision) Poll(ctx context.Context) error {
+	if r.cfg.Gateway == nil {
+		return nil
+	}
+	identities, err := r.identitySrv.Get(ctx)
+	if err != nil {
+		return err
+	}
+	for _, identifier := range identities {
+		did, err := core.ParseDID(identifier)
+		if err != nil {
+			log.Error(ctx, "invalid identity", "err", err, "identifier", identifier)
+			continue
+		}
+		since, err := r.repo.GetLatestDecidedAt(ctx, r.storage.Pgx, *did, r.cfg.Source)
+		if err != nil {
+			return err
+		}
+		requests, err := r.cfg.Gateway.Fetch(ctx, *did, since)
+		if err != nil {
+			log.Warn(ctx, "cannot fetch revocation decisions", "err", err, "did", identifier, "source", r.cfg.Source)
+			continue
+		}
+		if len(requests) == 0 {
+			continue
+		}
+		decisions, err := r.Apply(ctx, *did, r.cfg.Source, requests)
+		if err != nil {
+			log.Error(ctx, "applying polled revocation decisions", "err", err, "did", identifier, "source", r.cfg.Source)
+			continue
+		}
+		log.Info(ctx, "revocation decisions polled", "did", identifier, "source", r.cfg.Source, "decisions", len(decisions))
+	}
+	return nil
+}
+
+// Report returns the decisions that match the filter, with the number of applied and rejected ones
+func (r *revocationDecision) Report(ctx context.Context, issuerDID core.DID, filter *ports.RevocationDecisionsFilter) (*domain.RevocationDecisionsReport, error) {
+	decisions, err := r.repo.GetAll(ctx, r.storage.Pgx, issuerDID, filter)
+	if err != nil {
+		return nil, err
+	}
+	return domain.NewRevocationDecisionsReport(decisions), nil
+}
+
+func (r *revocationDecision) apply(ctx context.Context, issuerDID core.DID, source string, req *ports.RevocationDecisionRequest) (*domain.RevocationDecision, error) {
+	existing, err := r.repo.GetByExternalID(ctx, r.storage.Pgx, issuerDID, source, req.ID)
+	if err == nil {
+		return existing, nil
+	}
+	if !errors.Is(err, repositories.ErrRevocationDecisionNotFound) {
+		return nil, err
+	}
+
+	decision := &domain.RevocationDecision{
+		ID:           uuid.New(),
+		IssuerDID:    issuerDID,
+		Source:       source,
+		ExternalID:   req.ID,
+		CredentialID: req.CredentialID,
+		Reason:       req.Reason,
+		Status:       domain.RevocationDecisionApplied,
+		DecidedAt:    time.Now().UTC(),
+		CreatedAt:    time.Now().UTC(),
+	}
+	if req.DecidedAt != nil {
+		decision.DecidedAt = req.DecidedAt.UTC()
+	}
+	if req.RevocationNonce != nil {
+		nonce := domain.RevNonceUint64(*req.RevocationNonce)
+		decision.RevNonce = &nonce
+	}
+
+	credential, err := r.getCredential(ctx, issuerDID, req)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case req.CredentialID == nil && req.RevocationNonce == nil:
+		decision.Reject("the decision has no credential id nor revocation nonce")
+	case credential == nil:
+		decision.Reject("the credential does not exist")
+	case req.RevocationNonce != nil && uint64(credential.RevNonce) != *req.RevocationNonce:
+		decision.Reject("the revocation nonce is not the one of the credential")
+	case credential.Revoked:
+		decision.Reject("the credential is already revoked")
+	default:
+		nonce := credential.RevNonce
+		decision.CredentialID = &credential.ID
+		decision.RevNonce = &nonce
+		if err := r.claims.Revoke(ctx, issuerDID, uint64(nonce), domain.RevocationUnspecified, revocationDecisionDescription(source, req.Reason)); err != nil {
+			return nil, err
+		}
+	}
+
+	err = r.repo.Save(ctx, r.storage.Pgx, decision)
+	if errors.Is(err, repositories.ErrRevocationDecisionDuplicated) {
+		// the same decision was received in a concurrent request
+		return r.repo.GetByExternalID(ctx, r.storage.Pgx, issuerDID, source, req.ID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	log.Info(ctx, "revocation decision received", "did", issuerDID.String(), "source", source, "id", req.ID, "status", decision.Status)
+	return decision, nil
+}
+
+// getCredential returns the credential of the decision, by id if it has one or else by revocation nonce. It returns
+// nil if the credential does not exist or the decision does not identify one.
+func (r *revocationDecision) getCredential(ctx context.Context, issuerDID core.DID, req *ports.RevocationDecisionRequest) (*domain.Claim, error) {
+	var credential *domain.Claim
+	var err error
+	switch {
+	case req.CredentialID != nil:
+		credential, err = r.claimsRepo.GetByIdAndIssuer(ctx, r.storage.Pgx, &issuerDID, *req.CredentialID)
+	case req.RevocationNonce != nil:
+		credential, err = r.claimsRepo.GetByRevocationNonce(ctx, r.storage.Pgx, &issuerDID, domain.RevNonceUint64(*req.RevocationNonce))
+	default:
+		return nil, nil
+	}
+	if errors.Is(err, repositories.ErrClaimDoesNotExist) {
+		return nil, nil
+	}
+	return credential, err
+}
+
+// revocationDecisionDescription is the description of the revocations of the decisions, so they can be told apart
+// from the ones of the operators
+func revocationDecisionDescription(source, reason string) string {
+	if reason == "" {
+		return "revoked by " + source
+	}
+	return fmt.Sprintf("revoked by %s: %s", source, reason)
+}
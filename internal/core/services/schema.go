@@ -3,11 +3,15 @@ package services
 import (
 	"context"
 	"errors"
+	"fmt"
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	core "github.com/iden3/go-iden3-core"
 
+	"github.com/polygonid/sh-id-platform/internal/common"
 	"github.com/polygonid/sh-id-platform/internal/core/domain"
 	"github.com/polygonid/sh-id-platform/internal/core/ports"
 	"github.com/polygonid/sh-id-platform/internal/jsonschema"
@@ -16,14 +20,26 @@ import (
 	"github.com/polygonid/sh-id-platform/internal/repositories"
 )
 
+var (
+	ErrInvalidValidationWebhook = errors.New("invalid validation webhook url") // ErrInvalidValidationWebhook the validation webhook of a schema must be an absolute http or https url
+	ErrInvalidSchemaDefinition  = errors.New("invalid schema definition")      // ErrInvalidSchemaDefinition the schema cannot be built from the given type and attributes
+	ErrIPFSPinningDisabled      = errors.New("ipfs pinning is not configured") // ErrIPFSPinningDisabled the node has no IPFS pinner to pin the built schemas
+	ErrSchemaDeprecated         = errors.New("schema is deprecated")           // ErrSchemaDeprecated new credentials cannot be issued with a deprecated schema
+	ErrInvalidProofTypes        = errors.New("invalid proof types")            // ErrInvalidProofTypes the default proof types of a schema are unknown
+	ErrInvalidMaxValidity       = errors.New("invalid maximum validity")       // ErrInvalidMaxValidity the maximum validity of a schema must be a positive number of days
+)
+
 type schema struct {
 	repo          ports.SchemaRepository
 	loaderFactory loader.Factory
+	host          string
+	pinner        ports.IPFSPinner
 }
 
-// NewSchema is the schema service constructor
-func NewSchema(repo ports.SchemaRepository, lf loader.Factory) *schema {
-	return &schema{repo: repo, loaderFactory: lf}
+// NewSchema is the schema service constructor. The documents of the schemas built in the node are served under host
+// and, on request, pinned to IPFS with the pinner, that can be nil if the node does not pin them.
+func NewSchema(repo ports.SchemaRepository, lf loader.Factory, host string, pinner ports.IPFSPinner) *schema {
+	return &schema{repo: repo, loaderFactory: lf, host: strings.TrimSuffix(host, "/"), pinner: pinner}
 }
 
 // GetByID returns a domain.Schema by ID
@@ -38,9 +54,95 @@ func (s *schema) GetByID(ctx context.Context, issuerDID core.DID, id uuid.UUID)
 	return schema, nil
 }
 
-// GetAll return all schemas in the database that matches the query string
-func (s *schema) GetAll(ctx context.Context, issuerDID core.DID, query *string) ([]domain.Schema, error) {
-	return s.repo.GetAll(ctx, issuerDID, query)
+// GetAll return all schemas in the database that match the filter
+func (s *schema) GetAll(ctx context.Context, issuerDID core.DID, filter *ports.SchemasFilter) ([]domain.Schema, error) {
+	return s.repo.GetAll(ctx, issuerDID, filter)
+}
+
+// Update changes the settings of an already imported schema. Deprecating a schema keeps its credentials, but new ones
+// cannot be issued with it.
+func (s *schema) Update(ctx context.Context, issuerDID core.DID, id uuid.UUID, req *ports.UpdateSchemaRequest) (*domain.Schema, error) {
+	schema, err := s.GetByID(ctx, issuerDID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Version != nil && *req.Version != schema.Version {
+		return nil, ErrVersionMismatch
+	}
+
+	if req.AutoRevokeOnExpiration != nil {
+		schema.AutoRevokeOnExpiration = *req.AutoRevokeOnExpiration
+	}
+
+	if req.ValidationWebhook != nil {
+		if req.ValidationWebhook.URL == "" {
+			schema.ValidationWebhook = nil
+		} else {
+			u, err := url.Parse(req.ValidationWebhook.URL)
+			if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				return nil, ErrInvalidValidationWebhook
+			}
+			schema.ValidationWebhook = req.ValidationWebhook
+		}
+	}
+
+	if req.Deprecated != nil {
+		if !*req.Deprecated {
+			schema.DeprecatedAt = nil
+		} else if schema.DeprecatedAt == nil {
+			schema.DeprecatedAt = common.ToPointer(time.Now().UTC())
+		}
+	}
+
+	if req.DefaultProofTypes != nil {
+		proofs, err := domain.ParseProofTypes(*req.DefaultProofTypes)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidProofTypes, err)
+		}
+		schema.DefaultProofTypes = &proofs
+		if proofs == (domain.ProofTypes{}) {
+			schema.DefaultProofTypes = nil
+		}
+	}
+
+	if req.MaxValidityDays != nil {
+		switch {
+		case *req.MaxValidityDays < 0:
+			return nil, ErrInvalidMaxValidity
+		case *req.MaxValidityDays == 0:
+			schema.MaxValidityDays = nil
+		default:
+			schema.MaxValidityDays = req.MaxValidityDays
+		}
+	}
+
+	if req.ExtraContexts != nil || req.ExtraTypes != nil {
+		contexts, types := schema.ExtraContexts, schema.ExtraTypes
+		if req.ExtraContexts != nil {
+			contexts = appendUnique(nil, *req.ExtraContexts...)
+		}
+		if req.ExtraTypes != nil {
+			types = appendUnique(nil, *req.ExtraTypes...)
+		}
+		if err := validateCredentialExtensions(ctx, s.loaderFactory, contexts, types); err != nil {
+			log.Warn(ctx, "invalid schema credential extensions", "err", err, "id", id)
+			return nil, err
+		}
+		schema.ExtraContexts, schema.ExtraTypes = contexts, types
+	}
+
+	if err := s.repo.Update(ctx, schema); err != nil {
+		if errors.Is(err, repositories.ErrSchemaDoesNotExist) {
+			return nil, ErrSchemaNotFound
+		}
+		if errors.Is(err, repositories.ErrVersionConflict) {
+			return nil, ErrVersionMismatch
+		}
+		log.Error(ctx, "updating schema", "err", err, "id", id)
+		return nil, err
+	}
+	return schema, nil
 }
 
 // ImportSchema process an schema url and imports into the system
@@ -78,3 +180,71 @@ func (s *schema) ImportSchema(ctx context.Context, did core.DID, url string, sTy
 	}
 	return schema, nil
 }
+
+// BuildSchema generates the JSON Schema and the JSON-LD context of a credential with the attributes of the definition
+// and imports it. The documents are stored with the schema and served by the node at the schema url and at the
+// jsonLdContext url of the schema metadata. With pin, both documents are also pinned to IPFS and their CIDs stored
+// with the schema. The pinned documents keep the node urls, so the credentials of the schema are the same.
+func (s *schema) BuildSchema(ctx context.Context, did core.DID, def *domain.SchemaDefinition, pin bool) (*domain.Schema, error) {
+	if pin && s.pinner == nil {
+		return nil, ErrIPFSPinningDisabled
+	}
+	id := uuid.New()
+	schemaURL := fmt.Sprintf("%s/v1/schemas/%s/schema.json", s.host, id)
+	contextURL := fmt.Sprintf("%s/v1/schemas/%s/context.jsonld", s.host, id)
+
+	built, ldContext, err := jsonschema.Build(def, contextURL)
+	if err != nil {
+		log.Warn(ctx, "building schema", "err", err, "type", def.Type)
+		return nil, fmt.Errorf("%w: %s", ErrInvalidSchemaDefinition, err)
+	}
+	attributeNames, err := built.Attributes()
+	if err != nil {
+		log.Error(ctx, "processing built schema", "err", err, "type", def.Type)
+		return nil, ErrProcessSchema
+	}
+	hash, err := built.SchemaHash(def.Type)
+	if err != nil {
+		log.Error(ctx, "hashing built schema", "err", err, "type", def.Type)
+		return nil, ErrProcessSchema
+	}
+	jsonSchema, err := built.Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	schema := &domain.Schema{
+		ID:         id,
+		IssuerDID:  did,
+		URL:        schemaURL,
+		Type:       def.Type,
+		Hash:       hash,
+		Attributes: attributeNames.SchemaAttrs(),
+		CreatedAt:  time.Now(),
+		Documents:  &domain.SchemaDocuments{JSONSchema: jsonSchema, JSONLDContext: ldContext},
+	}
+	if pin {
+		if schema.IPFSContextCID, err = s.pinner.Pin(ctx, def.Type+".jsonld", ldContext); err != nil {
+			log.Error(ctx, "pinning built schema context", "err", err, "type", def.Type)
+			return nil, err
+		}
+		if schema.IPFSCID, err = s.pinner.Pin(ctx, def.Type+".json", jsonSchema); err != nil {
+			log.Error(ctx, "pinning built schema", "err", err, "type", def.Type)
+			return nil, err
+		}
+	}
+	if err := s.repo.Save(ctx, schema); err != nil {
+		log.Error(ctx, "saving built schema", "err", err)
+		return nil, err
+	}
+	return schema, nil
+}
+
+// GetDocuments returns the documents of a schema built in the node
+func (s *schema) GetDocuments(ctx context.Context, id uuid.UUID) (*domain.SchemaDocuments, error) {
+	documents, err := s.repo.GetDocuments(ctx, id)
+	if errors.Is(err, repositories.ErrSchemaDoesNotExist) {
+		return nil, ErrSchemaNotFound
+	}
+	return documents, err
+}
@@ -0,0 +1,37 @@
+package services
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/event"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/pkg/pubsub"
+)
+
+// updateSessionState stores the new state of a session and notifies it to the subscribers of the session topic.
+// Session states are informative, so errors are logged and do not interrupt the caller flow.
+func updateSessionState(ctx context.Context, sessions ports.SessionRepository, publisher pubsub.Publisher, sessionID string, status domain.SessionStatus, message string) {
+	id, err := uuid.Parse(sessionID)
+	if err != nil {
+		log.Warn(ctx, "invalid session id, session state not updated", "sessionID", sessionID, "err", err)
+		return
+	}
+
+	state := domain.NewSessionState(id, status, message)
+	if err := sessions.SetState(ctx, state); err != nil {
+		log.Error(ctx, "storing session state", "err", err, "sessionID", sessionID, "status", status)
+		return
+	}
+
+	if publisher == nil {
+		return
+	}
+	ev := &event.SessionState{SessionID: sessionID, Status: string(status), Message: message}
+	if err := publisher.Publish(ctx, event.SessionStateTopic(sessionID), ev); err != nil {
+		log.Error(ctx, "publishing session state", "err", err, "sessionID", sessionID, "status", status)
+	}
+}
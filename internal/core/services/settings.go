@@ -0,0 +1,174 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+// ErrInvalidSettings the overridden settings are not valid
+var ErrInvalidSettings = errors.New("invalid settings")
+
+// settingsRefreshInterval is how long a process uses the overrides it read before reading them again, the time the
+// changes made from another process take to apply
+const settingsRefreshInterval = 10 * time.Second
+
+type settings struct {
+	repo       ports.SettingsRepository
+	storage    *db.Storage
+	mu         sync.Mutex
+	configured domain.Settings
+	overrides  *domain.SettingsOverrides
+	loadedAt   time.Time
+	refreshing bool
+}
+
+// NewSettings returns a new runtime settings service with the configured settings
+func NewSettings(repo ports.SettingsRepository, storage *db.Storage, configured domain.Settings) ports.SettingsService {
+	return &settings{
+		repo:       repo,
+		storage:    storage,
+		configured: configured,
+		overrides:  &domain.SettingsOverrides{},
+	}
+}
+
+// ConfiguredSettings returns the operational settings of the configuration, the ones the admin API overrides without
+// a restart
+func ConfiguredSettings(cfg *config.Configuration) domain.Settings {
+	return domain.Settings{
+		Publishing: domain.PublishingPolicy{
+			Mode:             domain.PublishingMode(cfg.Publishing.Mode),
+			Interval:         cfg.Publishing.Interval,
+			Threshold:        cfg.Publishing.Threshold,
+			RevocationWindow: cfg.Publishing.RevocationWindow,
+		},
+		RateLimit: domain.RateLimitSettings{
+			Enabled:       cfg.RateLimit.Enabled,
+			Authenticated: domain.RateLimitBucket(cfg.RateLimit.Authenticated),
+			Anonymous:     domain.RateLimitBucket(cfg.RateLimit.Anonymous),
+		},
+		Notifications: domain.NotificationSettings{OfferEmails: true, Reports: true, BacklogAlerts: true},
+		RHSURL:        cfg.ReverseHashService.URL,
+	}
+}
+
+// Current returns the settings to apply. The overrides are read the first time, and read again in the background
+// when they are older than the refresh interval, so the callers, every request of the API, don't wait for the
+// database. If they can't be read the last ones are kept, so a database error does not change the behaviour of the
+// services.
+func (s *settings) Current(ctx context.Context) domain.Settings {
+	s.mu.Lock()
+	loaded := !s.loadedAt.IsZero()
+	if loaded && !s.refreshing && time.Since(s.loadedAt) > settingsRefreshInterval {
+		s.refreshing = true
+		go s.refresh(log.CopyFromContext(ctx, context.Background()), time.Now())
+	}
+	current := s.overrides.Apply(s.configured)
+	s.mu.Unlock()
+	if loaded {
+		return current
+	}
+
+	s.refresh(ctx, time.Now())
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.overrides.Apply(s.configured)
+}
+
+// refresh reads the overrides out of the lock. The ones read are dropped if others were used after the refresh
+// started, by an update or a reset.
+func (s *settings) refresh(ctx context.Context, started time.Time) {
+	ctx, cancel := context.WithTimeout(ctx, settingsRefreshInterval)
+	defer cancel()
+	overrides, err := s.repo.Get(ctx, s.storage.Pgx)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.refreshing = false
+	if s.loadedAt.After(started) {
+		return
+	}
+	s.loadedAt = time.Now()
+	if err != nil {
+		log.Warn(ctx, "cannot load the settings, using the last ones", "err", err)
+		return
+	}
+	s.overrides = overrides
+}
+
+// Get returns the settings with the overrides of the database
+func (s *settings) Get(ctx context.Context) (*domain.Settings, error) {
+	overrides, err := s.repo.Get(ctx, s.storage.Pgx)
+	if err != nil {
+		return nil, err
+	}
+	return s.use(overrides), nil
+}
+
+// Update overrides the given settings, the rest keep their value. Nothing is changed if the resulting settings are
+// not valid.
+func (s *settings) Update(ctx context.Context, overrides *domain.SettingsOverrides) (*domain.Settings, error) {
+	var merged *domain.SettingsOverrides
+	err := s.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+		var err error
+		if merged, err = s.repo.Merge(ctx, tx, overrides); err != nil {
+			return err
+		}
+		s.mu.Lock()
+		updated := merged.Apply(s.configured)
+		s.mu.Unlock()
+		if err := updated.Validate(); err != nil {
+			return fmt.Errorf("%w: %s", ErrInvalidSettings, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	updated := s.use(merged)
+	log.Info(ctx, "settings updated", "overridden", updated.Overridden)
+	return updated, nil
+}
+
+// Reset removes the overrides, so every setting takes the configured value again
+func (s *settings) Reset(ctx context.Context) (*domain.Settings, error) {
+	if err := s.repo.Delete(ctx, s.storage.Pgx); err != nil {
+		return nil, err
+	}
+	log.Info(ctx, "settings reset")
+	return s.use(&domain.SettingsOverrides{}), nil
+}
+
+// Reload replaces the configured settings, the overridden ones keep their value. The configured settings are kept
+// if the new ones are not valid.
+func (s *settings) Reload(ctx context.Context, configured domain.Settings) {
+	if err := configured.Validate(); err != nil {
+		log.Error(ctx, "the reloaded settings are not valid, keeping the previous ones", "err", err)
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.configured = configured
+	log.Info(ctx, "configured settings reloaded")
+}
+
+// use makes the overrides the current ones and returns the resulting settings
+func (s *settings) use(overrides *domain.SettingsOverrides) *domain.Settings {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.overrides = overrides
+	s.loadedAt = time.Now()
+	current := overrides.Apply(s.configured)
+	return &current
+}
@@ -0,0 +1,107 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/pkg/cache"
+)
+
+const (
+	stateVersionKeyPrefix     = "state-version-"
+	latestStateKeyPrefix      = "latest-state-"
+	revocationStatusKeyPrefix = "revocation-status-"
+)
+
+// stateCache caches the latest confirmed state of the identities and the revocation status of their credentials, so
+// the wallets polling the status endpoints don't load the trees of the issuer. A revocation status is cached with the
+// state it is generated for, that never changes. The latest state, and the signed status list of the JWT credentials,
+// are cached with the version of the identity, which changes when a new state is confirmed, a credential is revoked
+// or a credential gets an index in the status list. The values are cached in JSON, as the merkle tree proofs only keep
+// their fields in it.
+//
+// A value is cached with the version read before loading it, so a value loaded before an invalidation, and cached
+// after it, is cached with the old version and never read.
+type stateCache struct {
+	cache cache.Cache
+	ttl   time.Duration
+}
+
+func stateVersionKey(did core.DID) string {
+	return stateVersionKeyPrefix + did.String()
+}
+
+func latestStateKey(did core.DID, version string) string {
+	return fmt.Sprintf("%s%s-%s", latestStateKeyPrefix, did.String(), version)
+}
+
+func revocationStatusKey(did core.DID, state string, nonce uint64) string {
+	return fmt.Sprintf("%s%s-%s-%d", revocationStatusKeyPrefix, did.String(), state, nonce)
+}
+
+func (s *stateCache) enabled() bool {
+	return s.cache != nil && s.ttl > 0
+}
+
+// version returns the version of the identity, creating one if it has none. Only the values cached with the current
+// version of the identity are valid.
+func (s *stateCache) version(ctx context.Context, did core.DID) string {
+	if !s.enabled() {
+		return ""
+	}
+	var version string
+	if s.cache.Get(ctx, stateVersionKey(did), &version) {
+		return version
+	}
+	version = uuid.NewString()
+	if err := s.cache.Set(ctx, stateVersionKey(did), version, cache.ForEver); err != nil {
+		log.Warn(ctx, "caching identity state version", "err", err, "did", did.String())
+	}
+	return version
+}
+
+// get decodes the value cached in key into value and returns true if it is found
+func (s *stateCache) get(ctx context.Context, key string, value any) bool {
+	if !s.enabled() {
+		return false
+	}
+	var data []byte
+	if !s.cache.Get(ctx, key, &data) {
+		return false
+	}
+	if err := json.Unmarshal(data, value); err != nil {
+		log.Warn(ctx, "decoding cached identity state", "err", err, "key", key)
+		return false
+	}
+	return true
+}
+
+func (s *stateCache) set(ctx context.Context, key string, value any) {
+	if !s.enabled() {
+		return
+	}
+	data, err := json.Marshal(value)
+	if err == nil {
+		err = s.cache.Set(ctx, key, data, s.ttl)
+	}
+	if err != nil {
+		log.Warn(ctx, "caching identity state", "err", err, "key", key)
+	}
+}
+
+// invalidate gives the identity a new version, so its latest state is loaded again. It is changed even if this service
+// does not cache the states, because the services of the other processes share the cache.
+func (s *stateCache) invalidate(ctx context.Context, did core.DID) {
+	if s.cache == nil {
+		return
+	}
+	if err := s.cache.Set(ctx, stateVersionKey(did), uuid.NewString(), cache.ForEver); err != nil {
+		log.Warn(ctx, "invalidating cached identity state", "err", err, "did", did.String())
+	}
+}
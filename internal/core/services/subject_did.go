@@ -0,0 +1,78 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/pkg/cache"
+)
+
+const (
+	subjectDIDKeyPrefix = "subject-did-"
+	// unresolvedSubjectDIDTTL is the time a DID that cannot be resolved is not resolved again, shorter than the one of
+	// the resolved DIDs, because the DIDs can be published after a credential is requested
+	unresolvedSubjectDIDTTL = time.Minute
+)
+
+// ErrDIDResolverUnavailable the DID resolver did not answer, so the subject of the credential cannot be checked
+var ErrDIDResolverUnavailable = errors.New("did resolver unavailable")
+
+// SubjectDIDCfg configures the resolution of the subjects of the credentials
+type SubjectDIDCfg struct {
+	CacheTTL time.Duration // Time a resolved DID is not resolved again. 0 disables the cache
+}
+
+type subjectDID struct {
+	resolver ports.DIDResolverGateway
+	cache    cache.Cache
+	cfg      SubjectDIDCfg
+}
+
+// NewSubjectDID returns the service that checks the subjects of the credentials. If resolver is nil the DIDs are only
+// checked to be well-formed.
+func NewSubjectDID(resolver ports.DIDResolverGateway, cache cache.Cache, cfg SubjectDIDCfg) ports.SubjectDIDService {
+	return &subjectDID{
+		resolver: resolver,
+		cache:    cache,
+		cfg:      cfg,
+	}
+}
+
+// Check returns domain.ErrInvalidSubjectDID, with what is wrong, if the DID is malformed, of an unsupported method, or
+// the resolver cannot resolve it. The result of the resolution is cached.
+func (s *subjectDID) Check(ctx context.Context, did string) (*domain.SubjectDID, error) {
+	subject, err := domain.ParseSubjectDID(did)
+	if err != nil || s.resolver == nil {
+		return subject, err
+	}
+
+	key := subjectDIDKeyPrefix + did
+	var resolutionErr string
+	if s.cfg.CacheTTL == 0 || !s.cache.Get(ctx, key, &resolutionErr) {
+		resolution, err := s.resolver.Resolve(ctx, did)
+		if err != nil {
+			log.Warn(ctx, "resolving subject did", "err", err, "did", did)
+			return nil, fmt.Errorf("%w: %s", ErrDIDResolverUnavailable, err)
+		}
+		resolutionErr = resolution.Error
+		if s.cfg.CacheTTL > 0 {
+			ttl := s.cfg.CacheTTL
+			if resolutionErr != "" && ttl > unresolvedSubjectDIDTTL {
+				ttl = unresolvedSubjectDIDTTL
+			}
+			if err := s.cache.Set(ctx, key, resolutionErr, ttl); err != nil {
+				log.Warn(ctx, "caching subject did resolution", "err", err)
+			}
+		}
+	}
+	if resolutionErr != "" {
+		log.Info(ctx, "subject did cannot be resolved", "did", did, "error", resolutionErr)
+		return nil, fmt.Errorf("%w: %s cannot be resolved: %s", domain.ErrInvalidSubjectDID, did, resolutionErr)
+	}
+	return subject, nil
+}
@@ -0,0 +1,88 @@
+package services_tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+	"github.com/polygonid/sh-id-platform/pkg/cache"
+)
+
+type fakeValidationWebhook struct {
+	calls  int
+	result *ports.CredentialValidationResult
+	err    error
+}
+
+func (f *fakeValidationWebhook) Validate(_ context.Context, _, _ string, _ *ports.CredentialValidationRequest) (*ports.CredentialValidationResult, error) {
+	f.calls++
+	return f.result, f.err
+}
+
+func TestCredentialValidation_Validate(t *testing.T) {
+	const schemaURL = "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json"
+	ctx := context.Background()
+	did := core.DID{}
+	require.NoError(t, did.SetString("did:iden3:polygon:mumbai:wyFiV4w71QgWPn6bYLsZoysFay66gKtVa9kfu6yMZ"))
+
+	repo := repositories.NewSchemaInMemory()
+	require.NoError(t, repo.Save(ctx, &domain.Schema{
+		ID:                uuid.New(),
+		IssuerDID:         did,
+		URL:               schemaURL,
+		Type:              "KYCAgeCredential",
+		ValidationWebhook: &domain.SchemaWebhook{URL: "https://kyc.example.com/validate"},
+	}))
+
+	request := func(birthday int) *ports.CreateClaimRequest {
+		return &ports.CreateClaimRequest{
+			DID:               &did,
+			Schema:            schemaURL,
+			Type:              "KYCAgeCredential",
+			CredentialSubject: map[string]any{"id": "did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ", "birthday": birthday},
+		}
+	}
+
+	t.Run("approved credentials are cached", func(t *testing.T) {
+		webhook := &fakeValidationWebhook{result: &ports.CredentialValidationResult{Approved: true}}
+		validation := services.NewCredentialValidation(repo, webhook, cache.NewMemoryCache(), services.CredentialValidationCfg{ApprovalTTL: time.Minute})
+		require.NoError(t, validation.Validate(ctx, request(19960424)))
+		require.NoError(t, validation.Validate(ctx, request(19960424)))
+		assert.Equal(t, 1, webhook.calls)
+		require.NoError(t, validation.Validate(ctx, request(19960425)))
+		assert.Equal(t, 2, webhook.calls, "a different credential subject is validated again")
+	})
+
+	t.Run("rejected credentials carry the reason", func(t *testing.T) {
+		webhook := &fakeValidationWebhook{result: &ports.CredentialValidationResult{Reason: "kyc not completed"}}
+		validation := services.NewCredentialValidation(repo, webhook, cache.NewMemoryCache(), services.CredentialValidationCfg{})
+		err := validation.Validate(ctx, request(19960424))
+		assert.ErrorIs(t, err, services.ErrCredentialRejected)
+		assert.ErrorContains(t, err, "kyc not completed")
+	})
+
+	t.Run("webhook errors block the issuance", func(t *testing.T) {
+		webhook := &fakeValidationWebhook{err: errors.New("connection refused")}
+		validation := services.NewCredentialValidation(repo, webhook, cache.NewMemoryCache(), services.CredentialValidationCfg{})
+		assert.ErrorIs(t, validation.Validate(ctx, request(19960424)), services.ErrValidationWebhookUnavailable)
+	})
+
+	t.Run("schemas without webhook are not validated", func(t *testing.T) {
+		webhook := &fakeValidationWebhook{}
+		validation := services.NewCredentialValidation(repo, webhook, cache.NewMemoryCache(), services.CredentialValidationCfg{})
+		req := request(19960424)
+		req.Type = "KYCCountryOfResidenceCredential"
+		require.NoError(t, validation.Validate(ctx, req))
+		assert.Zero(t, webhook.calls)
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/google/uuid"
 	core "github.com/iden3/go-iden3-core"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/polygonid/sh-id-platform/internal/common"
 	"github.com/polygonid/sh-id-platform/internal/core/domain"
@@ -34,8 +35,8 @@ func Test_link_issueClaim(t *testing.T) {
 	connectionsRepository := repositories.NewConnections()
 	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, claimsRepo, revocationRepository, connectionsRepository, storage, rhsp, nil, nil, pubsub.NewMock())
 	schemaLoader := loader.HTTPFactory
-	sessionRepository := repositories.NewSessionCached(cachex)
-	schemaService := services.NewSchema(schemaRepository, schemaLoader)
+	sessionRepository := repositories.NewSessionCached(cachex, 0)
+	schemaService := services.NewSchema(schemaRepository, schemaLoader, "http://localhost", nil)
 	claimsConf := services.ClaimCfg{
 		RHSEnabled: false,
 		Host:       "https://host.com",
@@ -47,8 +48,7 @@ func Test_link_issueClaim(t *testing.T) {
 		identityStateRepo,
 		schemaLoader,
 		storage,
-		claimsConf,
-		pubsub.NewMock())
+		claimsConf)
 
 	identity, err := identityService.Create(ctx, method, blockchain, network, "http://localhost:3001")
 	assert.NoError(t, err)
@@ -87,12 +87,32 @@ func Test_link_issueClaim(t *testing.T) {
 	tomorrow := time.Now().Add(24 * time.Hour)
 	nextWeek := time.Now().Add(7 * 24 * time.Hour)
 
-	link, err := linkService.Save(ctx, *did, common.ToPointer(100), &tomorrow, schema.ID, &nextWeek, true, false, domain.CredentialSubject{"birthday": 19791109, "documentType": 12})
+	link, err := linkService.Save(ctx, *did, common.ToPointer(100), &tomorrow, schema.ID, &nextWeek, true, false, domain.CredentialSubject{"birthday": 19791109, "documentType": 12}, nil, nil, nil, false)
 	assert.NoError(t, err)
 
-	link2, err := linkService.Save(ctx, *did, common.ToPointer(100), &tomorrow, schema.ID, &nextWeek, false, true, domain.CredentialSubject{"birthday": 19791109, "documentType": 12})
+	link2, err := linkService.Save(ctx, *did, common.ToPointer(100), &tomorrow, schema.ID, &nextWeek, false, true, domain.CredentialSubject{"birthday": 19791109, "documentType": 12}, nil, nil, nil, false)
 	assert.NoError(t, err)
 
+	proofScope := []domain.VerificationQuery{{
+		CircuitID:         "credentialAtomicQuerySigV2",
+		Context:           "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json-ld/kyc-v3.json-ld",
+		Type:              "KYCAgeCredential",
+		CredentialSubject: map[string]any{"birthday": map[string]any{"$lt": 20050101}},
+	}}
+	link3, err := linkService.Save(ctx, *did, common.ToPointer(100), &tomorrow, schema.ID, &nextWeek, true, false, domain.CredentialSubject{"birthday": 19791109, "documentType": 12}, proofScope, nil, nil, false)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(1), link3.ProofScope[0].ID)
+
+	_, err = linkService.Save(ctx, *did, nil, nil, schema.ID, nil, true, false, domain.CredentialSubject{"birthday": 19791109, "documentType": 12}, []domain.VerificationQuery{{CircuitID: "authV2"}}, nil, nil, false)
+	assert.ErrorIs(t, err, services.ErrInvalidLinkProofScope)
+
+	link4, err := linkService.Save(ctx, *did, nil, nil, schema.ID, nil, true, false, domain.CredentialSubject{"birthday": 19791109, "documentType": 12}, nil, nil, []string{did2.String(), " " + did2.String()}, false)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{did2.String()}, link4.AllowedDIDs)
+
+	_, err = linkService.Save(ctx, *did, nil, nil, schema.ID, nil, true, false, domain.CredentialSubject{"birthday": 19791109, "documentType": 12}, nil, nil, []string{"jane@example.com"}, false)
+	assert.ErrorIs(t, err, services.ErrInvalidLinkAllowedDID)
+
 	type expected struct {
 		err          error
 		status       string
@@ -141,6 +161,24 @@ func Test_link_issueClaim(t *testing.T) {
 				issuedClaims: 1,
 			},
 		},
+		{
+			name:    "should return error the session did not ask for the proofs of the link",
+			did:     *did,
+			userDID: userDID1,
+			LinkID:  link3.ID,
+			expected: expected{
+				err: services.ErrLinkProofRequired,
+			},
+		},
+		{
+			name:    "should return error the holder is not allowed",
+			did:     *did,
+			userDID: userDID1,
+			LinkID:  link4.ID,
+			expected: expected{
+				err: services.ErrHolderNotAllowed,
+			},
+		},
 		{
 			name:    "should return error wrong did",
 			did:     *did2,
@@ -163,10 +201,14 @@ func Test_link_issueClaim(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			sessionID := uuid.New().String()
 			err := linkService.IssueClaim(ctx, sessionID, tc.did, tc.userDID, tc.LinkID, "host_url")
+			sessionState, stateErr := sessionRepository.GetState(ctx, uuid.MustParse(sessionID))
+			assert.NoError(t, stateErr)
 			if tc.expected.err != nil {
 				assert.Error(t, err)
 				assert.Equal(t, tc.expected.err, err)
+				assert.Equal(t, domain.SessionStatusFailed, sessionState.Status)
 			} else {
+				assert.Equal(t, domain.SessionStatusIssued, sessionState.Status)
 				status, err := sessionRepository.GetLink(ctx, linkState.CredentialStateCacheKey(tc.LinkID.String(), sessionID))
 				assert.NoError(t, err)
 				assert.Equal(t, tc.expected.status, status.Status)
@@ -176,4 +218,53 @@ func Test_link_issueClaim(t *testing.T) {
 			}
 		})
 	}
+
+	t.Run("should issue the credential of a link with proofs in a session of the link", func(t *testing.T) {
+		qrCode, err := linkService.CreateQRCode(ctx, *did, link3.ID, "https://host.com", nil)
+		assert.NoError(t, err)
+		assert.Len(t, qrCode.QrCode.Body.Scope, 1)
+		assert.Equal(t, "credentialAtomicQuerySigV2", qrCode.QrCode.Body.Scope[0].CircuitID)
+
+		assert.NoError(t, linkService.IssueClaim(ctx, qrCode.SessionID, *did, userDID1, link3.ID, "host_url"))
+		status, err := sessionRepository.GetLink(ctx, linkState.CredentialStateCacheKey(link3.ID.String(), qrCode.SessionID))
+		assert.NoError(t, err)
+		assert.Equal(t, "done", status.Status)
+	})
+
+	t.Run("should issue the credential of a code protected link with one of its codes", func(t *testing.T) {
+		_, err := linkService.CreateCodes(ctx, *did, link.ID, 1)
+		assert.ErrorIs(t, err, services.ErrLinkNotCodeProtected)
+
+		protected, err := linkService.Save(ctx, *did, nil, nil, schema.ID, nil, true, false, domain.CredentialSubject{"birthday": 19791109, "documentType": 12}, nil, nil, nil, true)
+		require.NoError(t, err)
+		_, err = linkService.CreateCodes(ctx, *did, protected.ID, 0)
+		assert.ErrorIs(t, err, services.ErrInvalidLinkCodeCount)
+		codes, err := linkService.CreateCodes(ctx, *did, protected.ID, 2)
+		require.NoError(t, err)
+		require.Len(t, codes, 2)
+
+		_, err = linkService.CreateQRCode(ctx, *did, protected.ID, "https://host.com", nil)
+		assert.ErrorIs(t, err, services.ErrLinkCodeRequired)
+		_, err = linkService.CreateQRCode(ctx, *did, protected.ID, "https://host.com", common.ToPointer("0000-0000"))
+		assert.ErrorIs(t, err, services.ErrInvalidLinkCode)
+		assert.ErrorIs(t, linkService.IssueClaim(ctx, uuid.NewString(), *did, userDID1, protected.ID, "host_url"), services.ErrLinkCodeRequired)
+
+		qrCode, err := linkService.CreateQRCode(ctx, *did, protected.ID, "https://host.com", &codes[0])
+		require.NoError(t, err)
+		assert.NoError(t, linkService.IssueClaim(ctx, qrCode.SessionID, *did, userDID1, protected.ID, "host_url"))
+		_, err = linkService.CreateQRCode(ctx, *did, protected.ID, "https://host.com", &codes[0])
+		assert.ErrorIs(t, err, services.ErrInvalidLinkCode, "the code was used")
+
+		used, err := linkService.GetCodes(ctx, *did, protected.ID)
+		require.NoError(t, err)
+		require.Len(t, used, 2)
+		usedCount := 0
+		for _, code := range used {
+			if code.Used() {
+				usedCount++
+				assert.Equal(t, userDID1.String(), *code.HolderDID)
+			}
+		}
+		assert.Equal(t, 1, usedCount)
+	})
 }
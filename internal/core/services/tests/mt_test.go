@@ -0,0 +1,51 @@
+package services_tests
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	core "github.com/iden3/go-iden3-core"
+	"github.com/iden3/go-merkletree-sql/v2"
+	"github.com/iden3/go-merkletree-sql/v2/db/memory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+	"github.com/polygonid/sh-id-platform/pkg/pubsub"
+	"github.com/polygonid/sh-id-platform/pkg/reverse_hash"
+)
+
+func TestMtService_AddRevocations(t *testing.T) {
+	ctx := context.Background()
+	claimsRepo := repositories.NewClaims()
+	mtRepo := repositories.NewIdentityMerkleTreeRepository()
+	mtService := services.NewIdentityMerkleTrees(mtRepo)
+	identityService := services.NewIdentity(keyStore, repositories.NewIdentity(), mtRepo, repositories.NewIdentityState(), mtService, claimsRepo, repositories.NewRevocation(), repositories.NewConnections(), storage, reverse_hash.NewRhsPublisher(nil, false), nil, nil, pubsub.NewMock())
+
+	identity, err := identityService.Create(ctx, method, blockchain, network, "http://localhost:3001")
+	require.NoError(t, err)
+	did, err := core.ParseDID(identity.Identifier)
+	require.NoError(t, err)
+
+	// the revocation tree of the identity is empty, adding the nonces one by one to a tree in memory gives its root
+	expected, err := merkletree.NewMerkleTree(ctx, memory.NewMemoryStorage(), 40)
+	require.NoError(t, err)
+	for _, nonce := range []int64{5, 9, 2, 11} {
+		require.NoError(t, expected.Add(ctx, big.NewInt(nonce), big.NewInt(0)))
+	}
+
+	require.NoError(t, mtService.AddRevocations(ctx, storage.Pgx, did, []*big.Int{big.NewInt(5), big.NewInt(9), big.NewInt(2)}))
+	// the nonces revoked already are skipped
+	require.NoError(t, mtService.AddRevocations(ctx, storage.Pgx, did, []*big.Int{big.NewInt(9), big.NewInt(11)}))
+
+	trees, err := mtService.GetIdentityMerkleTrees(ctx, storage.Pgx, did)
+	require.NoError(t, err)
+	assert.Equal(t, expected.Root().String(), trees.Trees[domain.MerkleTreeTypeRevocations].Root().String())
+
+	proof, err := trees.GenerateRevocationProof(ctx, big.NewInt(11), nil)
+	require.NoError(t, err)
+	assert.True(t, proof.Existence)
+}
@@ -2,23 +2,30 @@ package services_tests
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"math/rand"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	core "github.com/iden3/go-iden3-core"
+	"github.com/iden3/go-schema-processor/verifiable"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/polygonid/sh-id-platform/internal/common"
 	"github.com/polygonid/sh-id-platform/internal/core/domain"
 	"github.com/polygonid/sh-id-platform/internal/core/event"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
 	"github.com/polygonid/sh-id-platform/internal/core/services"
 	"github.com/polygonid/sh-id-platform/internal/db/tests"
 	"github.com/polygonid/sh-id-platform/internal/gateways"
 	"github.com/polygonid/sh-id-platform/internal/loader"
 	"github.com/polygonid/sh-id-platform/internal/log"
 	"github.com/polygonid/sh-id-platform/internal/repositories"
+	"github.com/polygonid/sh-id-platform/pkg/cache"
 	"github.com/polygonid/sh-id-platform/pkg/http"
 	"github.com/polygonid/sh-id-platform/pkg/pubsub"
 	"github.com/polygonid/sh-id-platform/pkg/reverse_hash"
@@ -45,7 +52,7 @@ func TestNotification_SendNotification(t *testing.T) {
 		RHSEnabled: false,
 		Host:       "http://host",
 	}
-	credentialsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf, pubsub.NewMock())
+	credentialsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, schemaLoader, storage, claimsConf)
 	connectionsService := services.NewConnection(connectionsRepository, storage)
 	iden, err := identityService.Create(ctx, method, blockchain, network, "polygon-test")
 	require.NoError(t, err)
@@ -57,13 +64,14 @@ func TestNotification_SendNotification(t *testing.T) {
 	require.NoError(t, err)
 
 	notificationGateway := gateways.NewPushNotificationClient(http.DefaultHTTPClientWithRetry)
-	notificationService := services.NewNotification(notificationGateway, connectionsService, credentialsService)
+	notificationService := services.NewNotification(notificationGateway, connectionsService, credentialsService, services.NotificationCfg{})
 
 	fixture := tests.NewFixture(storage)
 	credID := fixture.CreateClaim(t, &domain.Claim{
 		Identifier:      common.ToPointer(did.String()),
 		Issuer:          did.String(),
 		OtherIdentifier: userDID.String(),
+		RevNonce:        domain.RevNonceUint64(rand.Int63()),
 		HIndex:          "20060639968773997271173557722944342103398298534714534718204282267207714246564",
 	})
 
@@ -94,4 +102,144 @@ func TestNotification_SendNotification(t *testing.T) {
 		require.NoError(t, err)
 		assert.Error(t, notificationService.SendCreateCredentialNotification(ctx, message))
 	})
+
+	t.Run("should get an error, offer of an existing credential but not existing connection", func(t *testing.T) {
+		offer, err := credentialsService.RenewOffer(ctx, *did, credID, true)
+		require.NoError(t, err)
+		ev := event.CredentialOffer{OfferID: offer.ID.String(), CredentialIDs: []string{credID.String()}, IssuerID: did.String()}
+		message, err := ev.Marshal()
+		require.NoError(t, err)
+		assert.Error(t, notificationService.SendCredentialOfferNotification(ctx, message))
+	})
+
+	t.Run("should get an error, wrong offer id", func(t *testing.T) {
+		ev := event.CredentialOffer{OfferID: "wrong id", CredentialIDs: []string{credID.String()}, IssuerID: did.String()}
+		message, err := ev.Marshal()
+		require.NoError(t, err)
+		assert.Error(t, notificationService.SendCredentialOfferNotification(ctx, message))
+	})
+}
+
+type fakeDIDResolver struct {
+	calls      int
+	resolution *ports.DIDResolution
+	err        error
+}
+
+func (f *fakeDIDResolver) Resolve(_ context.Context, _ string) (*ports.DIDResolution, error) {
+	f.calls++
+	return f.resolution, f.err
+}
+
+type notificationGatewayRecorder struct {
+	documents []verifiable.DIDDocument
+}
+
+func (r *notificationGatewayRecorder) Notify(_ context.Context, _ json.RawMessage, userDIDDocument verifiable.DIDDocument) (*domain.UserNotificationResult, error) {
+	r.documents = append(r.documents, userDIDDocument)
+	return &domain.UserNotificationResult{}, nil
+}
+
+func TestNotification_HolderDocument(t *testing.T) {
+	ctx := context.Background()
+	claimsRepo := repositories.NewClaims()
+	mtRepo := repositories.NewIdentityMerkleTreeRepository()
+	mtService := services.NewIdentityMerkleTrees(mtRepo)
+	connectionsRepository := repositories.NewConnections()
+	identityService := services.NewIdentity(keyStore, repositories.NewIdentity(), mtRepo, repositories.NewIdentityState(), mtService, claimsRepo, repositories.NewRevocation(), connectionsRepository, storage, reverse_hash.NewRhsPublisher(nil, false), nil, nil, pubsub.NewMock())
+	credentialsService := services.NewClaim(claimsRepo, identityService, mtService, repositories.NewIdentityState(), loader.CachedFactory(loader.HTTPFactory, cachex), storage, services.ClaimCfg{Host: "http://host"})
+	connectionsService := services.NewConnection(connectionsRepository, storage)
+
+	iden, err := identityService.Create(ctx, method, blockchain, network, "polygon-test")
+	require.NoError(t, err)
+	did, err := core.ParseDID(iden.Identifier)
+	require.NoError(t, err)
+	userDID, err := core.ParseDID("did:polygonid:polygon:mumbai:2qFDziX3k3h7To2jDJbQiXFtcozbgSNNvQpb6TgtPE")
+	require.NoError(t, err)
+
+	fixture := tests.NewFixture(storage)
+	fixture.CreateClaim(t, &domain.Claim{
+		Identifier:      common.ToPointer(did.String()),
+		Issuer:          did.String(),
+		OtherIdentifier: userDID.String(),
+		RevNonce:        domain.RevNonceUint64(rand.Int63()),
+		HIndex:          "20060639968773997271173557722944342103398298534714534718204282267207714246565",
+	})
+	pushDocument := func(endpoint string) json.RawMessage {
+		return json.RawMessage(`{"id": "` + userDID.String() + `", "service": [{"id": "` + userDID.String() + `#push", "type": "push-notification", "serviceEndpoint": "` + endpoint + `"}]}`)
+	}
+	connID := fixture.CreateConnection(t, &domain.Connection{
+		IssuerDID: *did,
+		UserDID:   *userDID,
+		IssuerDoc: json.RawMessage(`{"id": "` + did.String() + `", "service": [{"id": "` + did.String() + `#iden3-communication", "type": "iden3-communication", "serviceEndpoint": "https://issuer.example.com/v1/agent"}]}`),
+		UserDoc:   pushDocument("https://push.example.com/connected"),
+	})
+	ev := event.CreateConnection{ConnectionID: connID.String(), IssuerID: did.String()}
+	message, err := ev.Marshal()
+	require.NoError(t, err)
+
+	endpoints := func(gateway *notificationGatewayRecorder) []string {
+		var endpoints []string
+		for _, doc := range gateway.documents {
+			endpoints = append(endpoints, doc.Service[0].(map[string]any)["serviceEndpoint"].(string))
+		}
+		return endpoints
+	}
+
+	t.Run("resolved documents are cached", func(t *testing.T) {
+		gateway := &notificationGatewayRecorder{}
+		resolver := &fakeDIDResolver{resolution: &ports.DIDResolution{Document: pushDocument("https://push.example.com/resolved")}}
+		notificationService := services.NewNotification(gateway, connectionsService, credentialsService, services.NotificationCfg{Resolver: resolver, Cache: cache.NewMemoryCache(), CacheTTL: time.Hour})
+		require.NoError(t, notificationService.SendCreateConnectionNotification(ctx, message))
+		require.NoError(t, notificationService.SendCreateConnectionNotification(ctx, message))
+		assert.Equal(t, []string{"https://push.example.com/resolved", "https://push.example.com/resolved"}, endpoints(gateway))
+		assert.Equal(t, 1, resolver.calls)
+	})
+
+	t.Run("failed resolutions use the document of the connection and are cached", func(t *testing.T) {
+		gateway := &notificationGatewayRecorder{}
+		resolver := &fakeDIDResolver{err: errors.New("timeout")}
+		notificationService := services.NewNotification(gateway, connectionsService, credentialsService, services.NotificationCfg{Resolver: resolver, Cache: cache.NewMemoryCache(), CacheTTL: time.Hour})
+		require.NoError(t, notificationService.SendCreateConnectionNotification(ctx, message))
+		require.NoError(t, notificationService.SendCreateConnectionNotification(ctx, message))
+		assert.Equal(t, []string{"https://push.example.com/connected", "https://push.example.com/connected"}, endpoints(gateway))
+		assert.Equal(t, 1, resolver.calls)
+	})
+
+	t.Run("failed resolutions are cached without a cache ttl", func(t *testing.T) {
+		gateway := &notificationGatewayRecorder{}
+		resolver := &fakeDIDResolver{err: errors.New("timeout")}
+		notificationService := services.NewNotification(gateway, connectionsService, credentialsService, services.NotificationCfg{Resolver: resolver, Cache: cache.NewMemoryCache()})
+		require.NoError(t, notificationService.SendCreateConnectionNotification(ctx, message))
+		require.NoError(t, notificationService.SendCreateConnectionNotification(ctx, message))
+		assert.Equal(t, []string{"https://push.example.com/connected", "https://push.example.com/connected"}, endpoints(gateway))
+		assert.Equal(t, 1, resolver.calls)
+	})
+
+	t.Run("resolved documents are not cached without a cache ttl", func(t *testing.T) {
+		gateway := &notificationGatewayRecorder{}
+		resolver := &fakeDIDResolver{resolution: &ports.DIDResolution{Document: pushDocument("https://push.example.com/resolved")}}
+		notificationService := services.NewNotification(gateway, connectionsService, credentialsService, services.NotificationCfg{Resolver: resolver, Cache: cache.NewMemoryCache()})
+		require.NoError(t, notificationService.SendCreateConnectionNotification(ctx, message))
+		require.NoError(t, notificationService.SendCreateConnectionNotification(ctx, message))
+		assert.Equal(t, []string{"https://push.example.com/resolved", "https://push.example.com/resolved"}, endpoints(gateway))
+		assert.Equal(t, 2, resolver.calls)
+	})
+
+	t.Run("empty documents use the document of the connection", func(t *testing.T) {
+		gateway := &notificationGatewayRecorder{}
+		resolver := &fakeDIDResolver{resolution: &ports.DIDResolution{}}
+		notificationService := services.NewNotification(gateway, connectionsService, credentialsService, services.NotificationCfg{Resolver: resolver, Cache: cache.NewMemoryCache(), CacheTTL: time.Hour})
+		require.NoError(t, notificationService.SendCreateConnectionNotification(ctx, message))
+		require.NoError(t, notificationService.SendCreateConnectionNotification(ctx, message))
+		assert.Equal(t, []string{"https://push.example.com/connected", "https://push.example.com/connected"}, endpoints(gateway))
+		assert.Equal(t, 1, resolver.calls)
+	})
+
+	t.Run("without resolver the document of the connection is used", func(t *testing.T) {
+		gateway := &notificationGatewayRecorder{}
+		notificationService := services.NewNotification(gateway, connectionsService, credentialsService, services.NotificationCfg{})
+		require.NoError(t, notificationService.SendCreateConnectionNotification(ctx, message))
+		assert.Equal(t, []string{"https://push.example.com/connected"}, endpoints(gateway))
+	})
 }
@@ -2,6 +2,7 @@ package services_tests
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -11,6 +12,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
 	"github.com/polygonid/sh-id-platform/internal/core/services"
 	"github.com/polygonid/sh-id-platform/internal/loader"
 	"github.com/polygonid/sh-id-platform/internal/repositories"
@@ -29,7 +33,7 @@ func TestSchema_ImportSchema(t *testing.T) {
 
 	expectHash := utils.CreateSchemaHash([]byte(urlLD + "#" + schemaType))
 
-	s := services.NewSchema(repo, loader.HTTPFactory)
+	s := services.NewSchema(repo, loader.HTTPFactory, "http://localhost", nil)
 	got, err := s.ImportSchema(ctx, issuerDID, url, schemaType)
 	require.NoError(t, err)
 	_, err = uuid.Parse(got.ID.String())
@@ -41,3 +45,172 @@ func TestSchema_ImportSchema(t *testing.T) {
 	assert.Len(t, got.Attributes, 3)
 	assert.InDelta(t, time.Now().UnixMilli(), got.CreatedAt.UnixMilli(), 1)
 }
+
+func TestSchema_Deprecate(t *testing.T) {
+	ctx := context.Background()
+	issuerDID := core.DID{}
+	require.NoError(t, issuerDID.SetString("did:iden3:polygon:mumbai:wyFiV4w71QgWPn6bYLsZoysFay66gKtVa9kfu6yMZ"))
+
+	repo := repositories.NewSchemaInMemory()
+	v1 := &domain.Schema{ID: uuid.New(), IssuerDID: issuerDID, URL: "https://example.com/schemas/org-v1.json", Type: "OrgCredential"}
+	v2 := &domain.Schema{ID: uuid.New(), IssuerDID: issuerDID, URL: "https://example.com/schemas/org-v2.json", Type: "OrgCredential"}
+	require.NoError(t, repo.Save(ctx, v1))
+	require.NoError(t, repo.Save(ctx, v2))
+	assert.Equal(t, 1, v1.SchemaVersion)
+	assert.Equal(t, 2, v2.SchemaVersion)
+	s := services.NewSchema(repo, loader.HTTPFactory, "http://localhost", nil)
+
+	got, err := s.Update(ctx, issuerDID, v1.ID, &ports.UpdateSchemaRequest{Deprecated: common.ToPointer(true)})
+	require.NoError(t, err)
+	assert.Equal(t, domain.SchemaStatusDeprecated, got.Status())
+	require.NotNil(t, got.DeprecatedAt)
+	deprecatedAt := *got.DeprecatedAt
+
+	got, err = s.Update(ctx, issuerDID, v1.ID, &ports.UpdateSchemaRequest{Deprecated: common.ToPointer(true)})
+	require.NoError(t, err)
+	assert.Equal(t, deprecatedAt, *got.DeprecatedAt, "deprecating it again keeps the original date")
+
+	deprecated, err := s.GetAll(ctx, issuerDID, &ports.SchemasFilter{Status: domain.SchemaStatusDeprecated})
+	require.NoError(t, err)
+	require.Len(t, deprecated, 1)
+	assert.Equal(t, v1.ID, deprecated[0].ID)
+
+	got, err = s.Update(ctx, issuerDID, v1.ID, &ports.UpdateSchemaRequest{Deprecated: common.ToPointer(false)})
+	require.NoError(t, err)
+	assert.Equal(t, domain.SchemaStatusActive, got.Status())
+	assert.Nil(t, got.DeprecatedAt)
+}
+
+func TestSchema_UpdateMaxValidity(t *testing.T) {
+	ctx := context.Background()
+	issuerDID := core.DID{}
+	require.NoError(t, issuerDID.SetString("did:iden3:polygon:mumbai:wyFiV4w71QgWPn6bYLsZoysFay66gKtVa9kfu6yMZ"))
+
+	repo := repositories.NewSchemaInMemory()
+	schema := &domain.Schema{ID: uuid.New(), IssuerDID: issuerDID, URL: "https://example.com/schemas/kyc.json", Type: "KYCCredential"}
+	require.NoError(t, repo.Save(ctx, schema))
+	s := services.NewSchema(repo, loader.HTTPFactory, "http://localhost", nil)
+
+	got, err := s.Update(ctx, issuerDID, schema.ID, &ports.UpdateSchemaRequest{MaxValidityDays: common.ToPointer(365)})
+	require.NoError(t, err)
+	require.NotNil(t, got.MaxValidityDays)
+	assert.Equal(t, 365, *got.MaxValidityDays)
+
+	_, err = s.Update(ctx, issuerDID, schema.ID, &ports.UpdateSchemaRequest{MaxValidityDays: common.ToPointer(-1)})
+	assert.ErrorIs(t, err, services.ErrInvalidMaxValidity)
+
+	// zero removes it
+	got, err = s.Update(ctx, issuerDID, schema.ID, &ports.UpdateSchemaRequest{MaxValidityDays: common.ToPointer(0)})
+	require.NoError(t, err)
+	assert.Nil(t, got.MaxValidityDays)
+}
+
+type documentLoader []byte
+
+func (d documentLoader) Load(_ context.Context) ([]byte, string, error) {
+	if d == nil {
+		return nil, "", errors.New("not found")
+	}
+	return d, "json-ld", nil
+}
+
+func TestSchema_UpdateExtraContexts(t *testing.T) {
+	const orgContext = "https://example.com/contexts/org-terms.jsonld"
+	ctx := context.Background()
+	issuerDID := core.DID{}
+	require.NoError(t, issuerDID.SetString("did:iden3:polygon:mumbai:wyFiV4w71QgWPn6bYLsZoysFay66gKtVa9kfu6yMZ"))
+
+	documents := map[string]documentLoader{
+		orgContext: documentLoader(`{"@context": {"OrgMembershipCredential": "https://example.com/vocab#OrgMembershipCredential"}}`),
+		"https://example.com/contexts/plain.json": documentLoader(`{"name": "not a context"}`),
+	}
+	factory := func(url string) loader.Loader { return documents[url] }
+
+	repo := repositories.NewSchemaInMemory()
+	schema := &domain.Schema{ID: uuid.New(), IssuerDID: issuerDID, URL: "https://example.com/schemas/org.json", Type: "OrgCredential"}
+	require.NoError(t, repo.Save(ctx, schema))
+	s := services.NewSchema(repo, factory, "http://localhost", nil)
+
+	got, err := s.Update(ctx, issuerDID, schema.ID, &ports.UpdateSchemaRequest{
+		ExtraContexts: &[]string{orgContext, orgContext},
+		ExtraTypes:    &[]string{"OrgMembershipCredential"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{orgContext}, got.ExtraContexts, "duplicates are removed")
+	assert.Equal(t, []string{"OrgMembershipCredential"}, got.ExtraTypes)
+
+	for name, req := range map[string]*ports.UpdateSchemaRequest{
+		"not an url":         {ExtraContexts: &[]string{"org-terms"}},
+		"not found":          {ExtraContexts: &[]string{"https://example.com/contexts/missing.jsonld"}},
+		"not a json-ld file": {ExtraContexts: &[]string{"https://example.com/contexts/plain.json"}},
+	} {
+		_, err := s.Update(ctx, issuerDID, schema.ID, req)
+		assert.ErrorIs(t, err, services.ErrInvalidCredentialContext, name)
+	}
+	_, err = s.Update(ctx, issuerDID, schema.ID, &ports.UpdateSchemaRequest{ExtraTypes: &[]string{"Org Membership"}})
+	assert.ErrorIs(t, err, services.ErrInvalidCredentialType)
+
+	// an empty list removes the contexts, the types are kept
+	got, err = s.Update(ctx, issuerDID, schema.ID, &ports.UpdateSchemaRequest{ExtraContexts: &[]string{}})
+	require.NoError(t, err)
+	assert.Empty(t, got.ExtraContexts)
+	assert.Equal(t, []string{"OrgMembershipCredential"}, got.ExtraTypes)
+}
+
+func TestSchema_BuildSchema(t *testing.T) {
+	ctx := context.Background()
+	issuerDID := core.DID{}
+	require.NoError(t, issuerDID.SetString("did:iden3:polygon:mumbai:wyFiV4w71QgWPn6bYLsZoysFay66gKtVa9kfu6yMZ"))
+	repo := repositories.NewSchemaInMemory()
+	s := services.NewSchema(repo, loader.HTTPFactory, "https://issuer.example.com/", nil)
+
+	def := &domain.SchemaDefinition{
+		Type: "Membership",
+		Attributes: []domain.SchemaAttributeDefinition{
+			{Name: "level", Type: domain.SchemaAttributeInteger, Required: true},
+			{Name: "since", Type: domain.SchemaAttributeDate},
+		},
+	}
+	got, err := s.BuildSchema(ctx, issuerDID, def, false)
+	require.NoError(t, err)
+	contextURL := "https://issuer.example.com/v1/schemas/" + got.ID.String() + "/context.jsonld"
+	assert.Equal(t, "https://issuer.example.com/v1/schemas/"+got.ID.String()+"/schema.json", got.URL)
+	assert.Equal(t, "Membership", got.Type)
+	assert.Equal(t, utils.CreateSchemaHash([]byte(contextURL+"#Membership")), got.Hash)
+	assert.ElementsMatch(t, domain.SchemaAttrs{"Credential Subject ID(id)", "level", "since"}, got.Attributes)
+	assert.Empty(t, got.IPFSCID)
+
+	documents, err := s.GetDocuments(ctx, got.ID)
+	require.NoError(t, err)
+	assert.Contains(t, string(documents.JSONSchema), contextURL)
+	assert.Contains(t, string(documents.JSONLDContext), contextURL+"#Membership")
+
+	_, err = s.BuildSchema(ctx, issuerDID, &domain.SchemaDefinition{Type: "Membership"}, false)
+	assert.ErrorIs(t, err, services.ErrInvalidSchemaDefinition)
+
+	_, err = s.GetDocuments(ctx, uuid.New())
+	assert.ErrorIs(t, err, services.ErrSchemaNotFound)
+
+	_, err = s.BuildSchema(ctx, issuerDID, def, true)
+	assert.ErrorIs(t, err, services.ErrIPFSPinningDisabled)
+
+	pinner := &pinnerSpy{pinned: map[string][]byte{}}
+	s = services.NewSchema(repo, loader.HTTPFactory, "https://issuer.example.com/", pinner)
+	got, err = s.BuildSchema(ctx, issuerDID, def, true)
+	require.NoError(t, err)
+	documents, err = s.GetDocuments(ctx, got.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "cid-Membership.json", got.IPFSCID)
+	assert.Equal(t, "cid-Membership.jsonld", got.IPFSContextCID)
+	assert.Equal(t, documents.JSONSchema, pinner.pinned["Membership.json"])
+	assert.Equal(t, documents.JSONLDContext, pinner.pinned["Membership.jsonld"])
+}
+
+type pinnerSpy struct {
+	pinned map[string][]byte
+}
+
+func (p *pinnerSpy) Pin(_ context.Context, name string, content []byte) (string, error) {
+	p.pinned[name] = content
+	return "cid-" + name, nil
+}
@@ -0,0 +1,83 @@
+package services_tests
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"testing"
+	"time"
+
+	core "github.com/iden3/go-iden3-core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/loader"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+	"github.com/polygonid/sh-id-platform/pkg/cache"
+	"github.com/polygonid/sh-id-platform/pkg/pubsub"
+	"github.com/polygonid/sh-id-platform/pkg/reverse_hash"
+)
+
+func TestClaim_GetRevocationStatusCached(t *testing.T) {
+	ctx := context.Background()
+	claimsRepo := repositories.NewClaims()
+	mtRepo := repositories.NewIdentityMerkleTreeRepository()
+	identityStateRepo := repositories.NewIdentityState()
+	mtService := services.NewIdentityMerkleTrees(mtRepo)
+	identityService := services.NewIdentity(keyStore, repositories.NewIdentity(), mtRepo, identityStateRepo, mtService, claimsRepo, repositories.NewRevocation(), repositories.NewConnections(), storage, reverse_hash.NewRhsPublisher(nil, false), nil, nil, pubsub.NewMock())
+	stateCache := cache.NewMemoryCache()
+	claimsService := services.NewClaim(claimsRepo, identityService, mtService, identityStateRepo, loader.CachedFactory(loader.HTTPFactory, cachex), storage, services.ClaimCfg{
+		Host:          "https://host.com",
+		StateCache:    stateCache,
+		StateCacheTTL: time.Hour,
+	})
+
+	identity, err := identityService.Create(ctx, method, blockchain, network, "http://localhost:3001")
+	require.NoError(t, err)
+	did, err := core.ParseDID(identity.Identifier)
+	require.NoError(t, err)
+
+	credential, err := claimsService.Save(ctx, ports.NewCreateClaimRequest(did, "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json", map[string]any{
+		"id":           "did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ",
+		"birthday":     19960424,
+		"documentType": 2,
+	}, common.ToPointer(time.Now().Add(time.Hour)), "KYCAgeCredential", nil, nil, nil, common.ToPointer(true), common.ToPointer(false), nil, false))
+	require.NoError(t, err)
+	nonce := uint64(credential.RevNonce)
+
+	status, err := claimsService.GetRevocationStatus(ctx, *did, nonce)
+	require.NoError(t, err)
+	assert.False(t, status.MTP.Existence)
+	require.NotNil(t, status.Issuer.State)
+	assert.Equal(t, *identity.State.State, *status.Issuer.State)
+	var version string
+	require.True(t, stateCache.Get(ctx, "state-version-"+did.String(), &version))
+	assert.True(t, stateCache.Exists(ctx, "latest-state-"+did.String()+"-"+version))
+	assert.True(t, stateCache.Exists(ctx, "revocation-status-"+did.String()+"-"+*status.Issuer.State+"-"+strconv.FormatUint(nonce, 10)))
+
+	cached, err := claimsService.GetRevocationStatus(ctx, *did, nonce)
+	require.NoError(t, err)
+	assert.Equal(t, status.Issuer, cached.Issuer)
+	assert.Equal(t, status.MTP.Existence, cached.MTP.Existence)
+	assert.Equal(t, status.MTP.AllSiblings(), cached.MTP.AllSiblings())
+
+	require.NoError(t, claimsService.Revoke(ctx, *did, nonce, domain.RevocationUnspecified, ""))
+	var newVersion string
+	require.True(t, stateCache.Get(ctx, "state-version-"+did.String(), &newVersion))
+	assert.NotEqual(t, version, newVersion)
+
+	// a request that loaded the state before the revocation and caches it after it does not replace the latest state
+	stale, err := json.Marshal(&domain.IdentityState{Identifier: did.String(), State: common.ToPointer("stale")})
+	require.NoError(t, err)
+	require.NoError(t, stateCache.Set(ctx, "latest-state-"+did.String()+"-"+version, stale, time.Hour))
+
+	// the revocation is not in the confirmed state until it is published
+	status, err = claimsService.GetRevocationStatus(ctx, *did, nonce)
+	require.NoError(t, err)
+	assert.False(t, status.MTP.Existence)
+	assert.Equal(t, *identity.State.State, *status.Issuer.State)
+}
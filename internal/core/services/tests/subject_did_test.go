@@ -0,0 +1,68 @@
+package services_tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/pkg/cache"
+)
+
+func TestSubjectDID_Check(t *testing.T) {
+	const did = "did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ"
+	ctx := context.Background()
+
+	t.Run("resolved dids are cached", func(t *testing.T) {
+		resolver := &fakeDIDResolver{resolution: &ports.DIDResolution{Document: []byte(`{"id": "` + did + `"}`)}}
+		subjectDIDs := services.NewSubjectDID(resolver, cache.NewMemoryCache(), services.SubjectDIDCfg{CacheTTL: time.Hour})
+		subject, err := subjectDIDs.Check(ctx, did)
+		require.NoError(t, err)
+		assert.Equal(t, domain.DIDMethodPolygonID, subject.Method)
+		_, err = subjectDIDs.Check(ctx, did)
+		require.NoError(t, err)
+		assert.Equal(t, 1, resolver.calls)
+	})
+
+	t.Run("unresolved dids carry the resolution error", func(t *testing.T) {
+		resolver := &fakeDIDResolver{resolution: &ports.DIDResolution{Error: "notFound"}}
+		subjectDIDs := services.NewSubjectDID(resolver, cache.NewMemoryCache(), services.SubjectDIDCfg{CacheTTL: time.Hour})
+		for i := 0; i < 2; i++ {
+			_, err := subjectDIDs.Check(ctx, "did:web:unknown.example.com")
+			assert.ErrorIs(t, err, domain.ErrInvalidSubjectDID)
+			assert.ErrorContains(t, err, "did:web:unknown.example.com cannot be resolved: notFound")
+		}
+		assert.Equal(t, 1, resolver.calls)
+	})
+
+	t.Run("malformed dids are not resolved", func(t *testing.T) {
+		resolver := &fakeDIDResolver{}
+		subjectDIDs := services.NewSubjectDID(resolver, cache.NewMemoryCache(), services.SubjectDIDCfg{})
+		_, err := subjectDIDs.Check(ctx, "did:key:not-a-key")
+		assert.ErrorIs(t, err, domain.ErrInvalidSubjectDID)
+		assert.Equal(t, 0, resolver.calls)
+	})
+
+	t.Run("resolver errors block the issuance", func(t *testing.T) {
+		resolver := &fakeDIDResolver{err: errors.New("connection refused")}
+		subjectDIDs := services.NewSubjectDID(resolver, cache.NewMemoryCache(), services.SubjectDIDCfg{CacheTTL: time.Hour})
+		_, err := subjectDIDs.Check(ctx, did)
+		assert.ErrorIs(t, err, services.ErrDIDResolverUnavailable)
+		_, err = subjectDIDs.Check(ctx, did)
+		assert.ErrorIs(t, err, services.ErrDIDResolverUnavailable)
+		assert.Equal(t, 2, resolver.calls, "failed resolutions are not cached")
+	})
+
+	t.Run("without resolver dids are only parsed", func(t *testing.T) {
+		subjectDIDs := services.NewSubjectDID(nil, cache.NewMemoryCache(), services.SubjectDIDCfg{})
+		subject, err := subjectDIDs.Check(ctx, "did:web:example.com")
+		require.NoError(t, err)
+		assert.Equal(t, domain.DIDMethodWeb, subject.Method)
+	})
+}
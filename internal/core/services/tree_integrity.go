@@ -0,0 +1,187 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	core "github.com/iden3/go-iden3-core"
+	"github.com/iden3/go-merkletree-sql/v2"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/network"
+	"github.com/polygonid/sh-id-platform/pkg/protocol"
+)
+
+// ErrTreeIntegrityIdentityNotFound the identity to verify does not exist
+var ErrTreeIntegrityIdentityNotFound = errors.New("identity not found")
+
+type treeIntegrity struct {
+	mtService               ports.MtService
+	identityStateRepository ports.IdentityStateRepository
+	networkResolver         *network.Resolver
+	storage                 *db.Storage
+}
+
+// NewTreeIntegrity returns a new tree integrity service. The published states are read from the state contract of
+// the identity network, they are not checked if networkResolver is nil.
+func NewTreeIntegrity(mtService ports.MtService, identityStateRepository ports.IdentityStateRepository, networkResolver *network.Resolver, storage *db.Storage) ports.TreeIntegrityService {
+	return &treeIntegrity{
+		mtService:               mtService,
+		identityStateRepository: identityStateRepository,
+		networkResolver:         networkResolver,
+		storage:                 storage,
+	}
+}
+
+// Verify walks the claims, revocations and roots trees of the identity from their current roots and from the roots
+// of the latest confirmed state, checks that the state is the hash of those roots, that its claims root is in its
+// roots tree and that it is the state published on chain. The problems found are returned in the report, the error
+// is for the ones that prevent the verification.
+func (t *treeIntegrity) Verify(ctx context.Context, did core.DID) (*domain.TreeIntegrityReport, error) {
+	report := &domain.TreeIntegrityReport{
+		Identifier: did.String(),
+		CheckedAt:  time.Now().UTC(),
+	}
+	var state *domain.IdentityState
+	// a read only snapshot makes the trees consistent with the state
+	err := t.storage.Pgx.BeginTxFunc(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly}, func(tx pgx.Tx) error {
+		var err error
+		state, err = t.identityStateRepository.GetLatestStateByIdentifier(ctx, tx, &did)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrTreeIntegrityIdentityNotFound
+		}
+		if err != nil {
+			return err
+		}
+		report.State = state.State
+		return t.verifyTrees(ctx, tx, did, state, report)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := t.verifyState(state, report); err != nil {
+		return nil, err
+	}
+	if t.networkResolver != nil {
+		if err := t.verifyPublishedState(ctx, did, state, report); err != nil {
+			return nil, err
+		}
+	}
+	return report, nil
+}
+
+func (t *treeIntegrity) verifyTrees(ctx context.Context, conn db.Querier, did core.DID, state *domain.IdentityState, report *domain.TreeIntegrityReport) error {
+	trees, err := t.mtService.GetIdentityMerkleTrees(ctx, conn, &did)
+	if err != nil {
+		return fmt.Errorf("can't get identity merkle trees: %w", err)
+	}
+	stateRoots := []*string{state.ClaimsTreeRoot, state.RevocationTreeRoot, state.RootOfRoots}
+	for _, mtType := range mtTypes {
+		tree := trees.Trees[mtType]
+		roots := []*merkletree.Hash{tree.Root()}
+		if stateRoots[mtType] != nil {
+			stateRoot, err := merkletree.NewHashFromHex(*stateRoots[mtType])
+			if err != nil {
+				return fmt.Errorf("invalid root in the latest state: %w", err)
+			}
+			roots = append(roots, stateRoot)
+		}
+
+		result, discrepancies, err := domain.VerifyMerkleTree(ctx, mtType, tree, roots...)
+		if err != nil {
+			return fmt.Errorf("can't verify merkle tree %d: %w", mtType, err)
+		}
+		result.StateRoot = stateRoots[mtType]
+		report.Trees = append(report.Trees, result)
+		report.Discrepancies = append(report.Discrepancies, discrepancies...)
+	}
+
+	// the claims root of every state but the genesis one is added to the roots tree before the state is calculated
+	rootOfRoots := common.StrMTHex(state.RootOfRoots)
+	if *rootOfRoots == merkletree.HashZero {
+		return nil
+	}
+	proof, _, err := trees.Trees[MerkleTreeTypeRoots].GenerateProof(ctx, common.StrMTHex(state.ClaimsTreeRoot).BigInt(), rootOfRoots)
+	if errors.Is(err, merkletree.ErrNotFound) {
+		// the missing nodes were reported by the walk
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !proof.Existence {
+		report.Discrepancies = append(report.Discrepancies, domain.TreeDiscrepancy{
+			Kind:    domain.TreeDiscrepancyMissingRoot,
+			Tree:    common.ToPointer(uint16(MerkleTreeTypeRoots)),
+			Message: fmt.Sprintf("claims root %s of the state is not in the roots tree", *state.ClaimsTreeRoot),
+		})
+	}
+	return nil
+}
+
+// verifyState checks the state is the hash of its tree roots
+func (t *treeIntegrity) verifyState(state *domain.IdentityState, report *domain.TreeIntegrityReport) error {
+	hash, err := merkletree.HashElems(
+		common.StrMTHex(state.ClaimsTreeRoot).BigInt(),
+		common.StrMTHex(state.RevocationTreeRoot).BigInt(),
+		common.StrMTHex(state.RootOfRoots).BigInt(),
+	)
+	if err != nil {
+		return err
+	}
+	if state.State == nil || *state.State != hash.Hex() {
+		report.Discrepancies = append(report.Discrepancies, domain.TreeDiscrepancy{
+			Kind:    domain.TreeDiscrepancyStateMismatch,
+			Message: fmt.Sprintf("the state is not the hash of its roots, %s", hash.Hex()),
+		})
+	}
+	return nil
+}
+
+// verifyPublishedState checks the latest state in the state contract is the latest confirmed state. The genesis
+// state is not published.
+func (t *treeIntegrity) verifyPublishedState(ctx context.Context, did core.DID, state *domain.IdentityState, report *domain.TreeIntegrityReport) error {
+	key := network.Key(did)
+	ethClient, err := t.networkResolver.Client(key)
+	if err != nil {
+		return err
+	}
+	contract, err := t.networkResolver.ContractAddress(key)
+	if err != nil {
+		return err
+	}
+	info, err := ethClient.GetLatestStateByID(ctx, contract, did.ID.BigInt())
+	if err != nil && strings.Contains(err.Error(), protocol.ErrStateNotFound.Error()) {
+		if state.PreviousState != nil {
+			report.Discrepancies = append(report.Discrepancies, domain.TreeDiscrepancy{
+				Kind:    domain.TreeDiscrepancyPublishedState,
+				Message: "the identity has confirmed states but none in the state contract",
+			})
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("can't get the published state: %w", err)
+	}
+
+	published, err := merkletree.NewHashFromBigInt(info.State)
+	if err != nil {
+		return err
+	}
+	report.PublishedState = common.ToPointer(published.Hex())
+	if state.State == nil || *state.State != published.Hex() {
+		report.Discrepancies = append(report.Discrepancies, domain.TreeDiscrepancy{
+			Kind:    domain.TreeDiscrepancyPublishedState,
+			Message: "the latest state in the state contract is not the latest confirmed state, a transaction may be waiting for confirmation",
+		})
+	}
+	return nil
+}
@@ -0,0 +1,229 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	auth "github.com/iden3/go-iden3-auth"
+	"github.com/iden3/go-iden3-auth/pubsignals"
+	core "github.com/iden3/go-iden3-core"
+	"github.com/iden3/iden3comm/protocol"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/event"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+var (
+	// ErrInvalidVerificationRequest the queries of the verification request cannot be proved
+	ErrInvalidVerificationRequest = errors.New("invalid verification request")
+	// ErrVerificationIdentityNotFound the issuer of the verification request does not exist
+	ErrVerificationIdentityNotFound = errors.New("identity not found")
+	// ErrVerificationRequestNotFound the verification request does not exist
+	ErrVerificationRequestNotFound = errors.New("verification request not found")
+	// ErrVerificationRequestExpired the verification request can no longer be answered
+	ErrVerificationRequestExpired = errors.New("verification request expired")
+	// ErrInvalidVerificationResponse the proofs of the holder are not valid
+	ErrInvalidVerificationResponse = errors.New("invalid verification response")
+)
+
+// VerificationCfg configures the verification requests. TransitionDelay is how long the proofs generated with a
+// replaced state of the holder or the issuer of their credential are accepted.
+type VerificationCfg struct {
+	Host            string
+	TransitionDelay time.Duration
+}
+
+type verification struct {
+	repo            ports.VerificationRepository
+	connectionsRepo ports.ConnectionsRepository
+	outboxRepo      ports.EventOutboxRepository
+	identitySrv     ports.IdentityService
+	verifier        *auth.Verifier
+	storage         *db.Storage
+	cfg             VerificationCfg
+}
+
+// NewVerification returns a new verification service
+func NewVerification(repo ports.VerificationRepository, connectionsRepo ports.ConnectionsRepository, identitySrv ports.IdentityService, verifier *auth.Verifier, storage *db.Storage, cfg VerificationCfg) ports.VerificationService {
+	return &verification{
+		repo:            repo,
+		connectionsRepo: connectionsRepo,
+		outboxRepo:      repositories.NewEventOutbox(),
+		identitySrv:     identitySrv,
+		verifier:        verifier,
+		storage:         storage,
+		cfg:             cfg,
+	}
+}
+
+// CreateRequest validates and saves the verification request of the issuer
+func (v *verification) CreateRequest(ctx context.Context, request *domain.VerificationRequest) (*domain.VerificationRequest, error) {
+	if err := request.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidVerificationRequest, err)
+	}
+	exists, err := v.identitySrv.Exists(ctx, request.IssuerDID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrVerificationIdentityNotFound
+	}
+
+	request.ID = uuid.New()
+	request.CreatedAt = time.Now().UTC()
+	if err := v.repo.SaveRequest(ctx, v.storage.Pgx, request); err != nil {
+		return nil, err
+	}
+	log.Info(ctx, "verification request created", "did", request.IssuerDID.String(), "id", request.ID, "queries", len(request.Scope))
+	return request, nil
+}
+
+// GetRequest returns the verification request of the issuer
+func (v *verification) GetRequest(ctx context.Context, issuerDID core.DID, id uuid.UUID) (*domain.VerificationRequest, error) {
+	request, err := v.repo.GetRequest(ctx, v.storage.Pgx, id)
+	if errors.Is(err, repositories.ErrVerificationRequestNotFound) {
+		return nil, ErrVerificationRequestNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if request.IssuerDID.String() != issuerDID.String() {
+		return nil, ErrVerificationRequestNotFound
+	}
+	return request, nil
+}
+
+// GetAuthorizationRequest returns the message the holders scan to answer the verification request
+func (v *verification) GetAuthorizationRequest(ctx context.Context, issuerDID core.DID, id uuid.UUID) (*protocol.AuthorizationRequestMessage, error) {
+	request, err := v.GetRequest(ctx, issuerDID, id)
+	if err != nil {
+		return nil, err
+	}
+	if request.Expired(time.Now()) {
+		return nil, ErrVerificationRequestExpired
+	}
+	message := request.AuthorizationRequest(v.callbackURL(request.ID))
+	return &message, nil
+}
+
+// Verify checks the proofs of the token against the queries of the request and the on-chain states, and saves the
+// outcome with the connection of the holder. The connection is created when the proofs are valid. Responses whose
+// sender cannot be read from the token are not saved.
+func (v *verification) Verify(ctx context.Context, requestID uuid.UUID, token string) (*domain.VerificationResponse, error) {
+	request, err := v.repo.GetRequest(ctx, v.storage.Pgx, requestID)
+	if errors.Is(err, repositories.ErrVerificationRequestNotFound) {
+		return nil, ErrVerificationRequestNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if request.Expired(time.Now()) {
+		return nil, ErrVerificationRequestExpired
+	}
+
+	arm, verifyErr := v.verifier.FullVerify(ctx, token, request.AuthorizationRequest(v.callbackURL(request.ID)), pubsignals.WithAcceptedStateTransitionDelay(v.cfg.TransitionDelay))
+	if arm == nil {
+		log.Warn(ctx, "invalid verification response", "err", verifyErr, "request", requestID)
+		return nil, fmt.Errorf("%w: %s", ErrInvalidVerificationResponse, verifyErr)
+	}
+	if verifyErr == nil && arm.ThreadID != request.ID.String() {
+		verifyErr = errors.New("the response is not for this request")
+	}
+	userDID, err := core.ParseDID(arm.From)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid sender: %s", ErrInvalidVerificationResponse, err)
+	}
+
+	response := &domain.VerificationResponse{
+		ID:        uuid.New(),
+		RequestID: request.ID,
+		IssuerDID: request.IssuerDID,
+		UserDID:   *userDID,
+		Verified:  verifyErr == nil,
+		CreatedAt: time.Now().UTC(),
+	}
+	if verifyErr != nil {
+		reason := verifyErr.Error()
+		response.Error = &reason
+	} else if response.Proofs, err = json.Marshal(arm.Body.Scope); err != nil {
+		return nil, err
+	}
+
+	if response.ConnectionID, err = v.connection(ctx, request.IssuerDID, *userDID, arm, response.Verified); err != nil {
+		return nil, err
+	}
+	if err := v.repo.SaveResponse(ctx, v.storage.Pgx, response); err != nil {
+		return nil, err
+	}
+	log.Info(ctx, "verification response received", "did", request.IssuerDID.String(), "request", request.ID, "verified", response.Verified)
+	if !response.Verified {
+		return response, fmt.Errorf("%w: %s", ErrInvalidVerificationResponse, *response.Error)
+	}
+	return response, nil
+}
+
+// GetResponses returns the verification responses of the issuer that match the filter
+func (v *verification) GetResponses(ctx context.Context, issuerDID core.DID, filter *ports.VerificationResponsesFilter) ([]*domain.VerificationResponse, error) {
+	ctx, cancel := v.storage.ListingContext(ctx)
+	defer cancel()
+	responses, err := v.repo.GetResponses(ctx, v.storage.Replica, issuerDID, filter)
+	if err != nil {
+		return nil, v.storage.QueryError(ctx, "verification.GetResponses", err)
+	}
+	return responses, nil
+}
+
+// connection returns the connection of the holder. When the proofs are valid the holder has proved the ownership of
+// the DID, so the connection is created if it does not exist, as the authentication does.
+func (v *verification) connection(ctx context.Context, issuerDID, userDID core.DID, arm *protocol.AuthorizationResponseMessage, verified bool) (*uuid.UUID, error) {
+	if !verified {
+		conn, err := v.connectionsRepo.GetByUserID(ctx, v.storage.Pgx, issuerDID, userDID)
+		if errors.Is(err, repositories.ErrConnectionDoesNotExist) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		return &conn.ID, nil
+	}
+
+	issuerDoc, err := json.Marshal(newDIDDocument(v.cfg.Host, issuerDID))
+	if err != nil {
+		return nil, err
+	}
+	conn := &domain.Connection{
+		ID:         uuid.New(),
+		IssuerDID:  issuerDID,
+		UserDID:    userDID,
+		IssuerDoc:  issuerDoc,
+		UserDoc:    arm.Body.DIDDoc,
+		CreatedAt:  time.Now(),
+		ModifiedAt: time.Now(),
+	}
+	var connID uuid.UUID
+	err = v.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+		var err error
+		connID, err = v.connectionsRepo.Save(ctx, tx, conn)
+		if err != nil || connID != conn.ID {
+			return err
+		}
+		return notifyInTx(ctx, tx, v.outboxRepo, event.CreateConnectionEvent, issuerDID.String(), &event.CreateConnection{ConnectionID: connID.String(), IssuerID: issuerDID.String()})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &connID, nil
+}
+
+func (v *verification) callbackURL(requestID uuid.UUID) string {
+	return fmt.Sprintf("%s/v1/verification/callback?requestID=%s", v.cfg.Host, requestID)
+}
@@ -0,0 +1,306 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+	"github.com/iden3/go-jwz"
+	"github.com/iden3/go-rapidsnark/types"
+	"github.com/iden3/go-schema-processor/verifiable"
+	"github.com/iden3/iden3comm"
+	"github.com/iden3/iden3comm/packers"
+	"github.com/iden3/iden3comm/protocol"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+	client "github.com/polygonid/sh-id-platform/pkg/http"
+)
+
+var (
+	ErrHolderNotFound             = errors.New("holder identity not found")                // ErrHolderNotFound the holder is not an identity of this node
+	ErrInvalidWalletMessage       = errors.New("invalid message")                          // ErrInvalidWalletMessage the message cannot be processed by the wallet
+	ErrHeldCredentialNotFound     = errors.New("held credential not found")                // ErrHeldCredentialNotFound the holder does not have the credential
+	ErrHeldCredentialWrongSubject = errors.New("credential was not issued to the holder")  // ErrHeldCredentialWrongSubject the credential subject is not the holder
+	ErrHeldCredentialWrongIssuer  = errors.New("credential was not issued by the offerer") // ErrHeldCredentialWrongIssuer the credential issuer is not the one that sent the offer
+)
+
+type wallet struct {
+	heldCredentials ports.HeldCredentialRepository
+	identitySrv     ports.IdentityService
+	proofSrv        ports.ProofService
+	zkGenerator     ports.ZKGenerator
+	packageManager  *iden3comm.PackageManager
+	httpClient      *client.Client
+	storage         *db.Storage
+}
+
+// NewWallet returns a new wallet service
+func NewWallet(heldCredentials ports.HeldCredentialRepository, identitySrv ports.IdentityService, proofSrv ports.ProofService, zkGenerator ports.ZKGenerator, packageManager *iden3comm.PackageManager, httpClient *client.Client, storage *db.Storage) ports.WalletService {
+	return &wallet{
+		heldCredentials: heldCredentials,
+		identitySrv:     identitySrv,
+		proofSrv:        proofSrv,
+		zkGenerator:     zkGenerator,
+		packageManager:  packageManager,
+		httpClient:      httpClient,
+		storage:         storage,
+	}
+}
+
+// AcceptOffer fetches every credential in the offer from the issuer agent and stores them in the holder wallet
+func (w *wallet) AcceptOffer(ctx context.Context, holderDID core.DID, offer *protocol.CredentialsOfferMessage) ([]*domain.HeldCredential, error) {
+	if err := w.checkHolder(ctx, holderDID); err != nil {
+		return nil, err
+	}
+
+	if offer.Type != protocol.CredentialOfferMessageType || offer.Body.URL == "" || len(offer.Body.Credentials) == 0 {
+		return nil, fmt.Errorf("%w: not a credential offer", ErrInvalidWalletMessage)
+	}
+
+	if offer.To != "" && offer.To != holderDID.String() {
+		return nil, fmt.Errorf("%w: the offer is for %s", ErrInvalidWalletMessage, offer.To)
+	}
+
+	issuerDID, err := core.ParseDID(offer.From)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid offer sender: %s", ErrInvalidWalletMessage, err)
+	}
+
+	credentials := make([]*domain.HeldCredential, 0, len(offer.Body.Credentials))
+	for _, offered := range offer.Body.Credentials {
+		w3c, err := w.fetchCredential(ctx, holderDID, *issuerDID, offer, offered.ID)
+		if err != nil {
+			log.Error(ctx, "fetching offered credential", "err", err, "issuer", offer.From, "credentialID", offered.ID)
+			return nil, err
+		}
+
+		held, err := domain.NewHeldCredential(holderDID, *w3c)
+		if err != nil {
+			return nil, err
+		}
+		if held.SubjectDID() != holderDID.String() {
+			return nil, ErrHeldCredentialWrongSubject
+		}
+		if held.IssuerDID.String() != issuerDID.String() {
+			return nil, ErrHeldCredentialWrongIssuer
+		}
+
+		held.ID, err = w.heldCredentials.Save(ctx, w.storage.Pgx, held)
+		if err != nil {
+			log.Error(ctx, "saving held credential", "err", err, "credentialID", held.CredentialID)
+			return nil, err
+		}
+		credentials = append(credentials, held)
+	}
+
+	return credentials, nil
+}
+
+func (w *wallet) GetAll(ctx context.Context, holderDID core.DID, filter *ports.HeldCredentialsFilter) ([]*domain.HeldCredential, error) {
+	ctx, cancel := w.storage.ListingContext(ctx)
+	defer cancel()
+	credentials, err := w.heldCredentials.GetAll(ctx, w.storage.Replica, holderDID, filter)
+	if err != nil {
+		return nil, w.storage.QueryError(ctx, "wallet.GetAll", err)
+	}
+	return credentials, nil
+}
+
+func (w *wallet) GetByID(ctx context.Context, holderDID core.DID, id uuid.UUID) (*domain.HeldCredential, error) {
+	held, err := w.heldCredentials.GetByID(ctx, w.storage.Pgx, holderDID, id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrHeldCredentialDoesNotExist) {
+			return nil, ErrHeldCredentialNotFound
+		}
+		return nil, err
+	}
+	return held, nil
+}
+
+func (w *wallet) Delete(ctx context.Context, holderDID core.DID, id uuid.UUID) error {
+	err := w.heldCredentials.Delete(ctx, w.storage.Pgx, holderDID, id)
+	if errors.Is(err, repositories.ErrHeldCredentialDoesNotExist) {
+		return ErrHeldCredentialNotFound
+	}
+	return err
+}
+
+// Present answers an authorization request. It generates a proof for each scope of the request with the credentials
+// held or issued by the holder and sends the authorization response to the verifier callback.
+func (w *wallet) Present(ctx context.Context, holderDID core.DID, request *protocol.AuthorizationRequestMessage) error {
+	if err := w.checkHolder(ctx, holderDID); err != nil {
+		return err
+	}
+
+	if request.Type != protocol.AuthorizationRequestMessageType || request.Body.CallbackURL == "" {
+		return fmt.Errorf("%w: not an authorization request", ErrInvalidWalletMessage)
+	}
+
+	scopes := make([]protocol.ZeroKnowledgeProofResponse, 0, len(request.Body.Scope))
+	for _, scope := range request.Body.Scope {
+		proof, err := w.proveScope(ctx, holderDID, scope)
+		if err != nil {
+			if scope.Optional != nil && *scope.Optional {
+				log.Warn(ctx, "skipping optional scope", "err", err, "scopeID", scope.ID)
+				continue
+			}
+			log.Error(ctx, "generating scope proof", "err", err, "scopeID", scope.ID)
+			return err
+		}
+		scopes = append(scopes, *proof)
+	}
+
+	threadID := request.ThreadID
+	if threadID == "" {
+		threadID = request.ID
+	}
+	response := protocol.AuthorizationResponseMessage{
+		ID:       uuid.NewString(),
+		Typ:      packers.MediaTypeZKPMessage,
+		Type:     protocol.AuthorizationResponseMessageType,
+		ThreadID: threadID,
+		Body: protocol.AuthorizationMessageResponseBody{
+			Message: request.Body.Message,
+			Scope:   scopes,
+		},
+		From: holderDID.String(),
+		To:   request.From,
+	}
+
+	if _, err := w.send(ctx, holderDID, request.Body.CallbackURL, response); err != nil {
+		log.Error(ctx, "sending authorization response", "err", err, "callback", request.Body.CallbackURL)
+		return err
+	}
+	return nil
+}
+
+func (w *wallet) checkHolder(ctx context.Context, holderDID core.DID) error {
+	exists, err := w.identitySrv.Exists(ctx, holderDID)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrHolderNotFound
+	}
+	return nil
+}
+
+func (w *wallet) fetchCredential(ctx context.Context, holderDID core.DID, issuerDID core.DID, offer *protocol.CredentialsOfferMessage, credentialID string) (*verifiable.W3CCredential, error) {
+	threadID := offer.ThreadID
+	if threadID == "" {
+		threadID = offer.ID
+	}
+	request := protocol.CredentialFetchRequestMessage{
+		ID:       uuid.NewString(),
+		Typ:      packers.MediaTypeZKPMessage,
+		Type:     protocol.CredentialFetchRequestMessageType,
+		ThreadID: threadID,
+		Body:     protocol.CredentialFetchRequestMessageBody{ID: credentialID},
+		From:     holderDID.String(),
+		To:       issuerDID.String(),
+	}
+
+	resp, err := w.send(ctx, holderDID, offer.Body.URL, request)
+	if err != nil {
+		return nil, err
+	}
+
+	var issuance protocol.CredentialIssuanceMessage
+	if err := json.Unmarshal(resp, &issuance); err != nil {
+		return nil, fmt.Errorf("%w: cannot parse the issuer response: %s", ErrInvalidWalletMessage, err)
+	}
+	if issuance.Type != protocol.CredentialIssuanceResponseMessageType {
+		return nil, fmt.Errorf("%w: unexpected issuer response type %s", ErrInvalidWalletMessage, issuance.Type)
+	}
+
+	return &issuance.Body.Credential, nil
+}
+
+func (w *wallet) proveScope(ctx context.Context, holderDID core.DID, scope protocol.ZeroKnowledgeProofRequest) (*protocol.ZeroKnowledgeProofResponse, error) {
+	query, err := toProofQuery(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	inputs, _, err := w.proofSrv.PrepareInputs(ctx, &holderDID, query)
+	if err != nil {
+		return nil, err
+	}
+
+	fullProof, err := w.zkGenerator.Generate(ctx, inputs, scope.CircuitID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &protocol.ZeroKnowledgeProofResponse{
+		ID:        scope.ID,
+		CircuitID: scope.CircuitID,
+		ZKProof: types.ZKProof{
+			Proof: &types.ProofData{
+				A:        fullProof.Proof.A,
+				B:        fullProof.Proof.B,
+				C:        fullProof.Proof.C,
+				Protocol: fullProof.Proof.Protocol,
+			},
+			PubSignals: fullProof.PubSignals,
+		},
+	}, nil
+}
+
+// send packs the message as a JWZ token of the holder and posts it to the given url
+func (w *wallet) send(ctx context.Context, holderDID core.DID, url string, message interface{}) ([]byte, error) {
+	payload, err := json.Marshal(message)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := w.packageManager.Pack(packers.MediaTypeZKPMessage, payload, packers.ZKPPackerParams{
+		SenderID:         &holderDID,
+		ProvingMethodAlg: jwz.AuthV2Groth16Alg,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return w.httpClient.Post(ctx, url, token)
+}
+
+// toProofQuery converts the query of a verifier zero knowledge proof request into a proof service query
+func toProofQuery(scope protocol.ZeroKnowledgeProofRequest) (ports.Query, error) {
+	query := ports.Query{
+		CircuitID: scope.CircuitID,
+		RequestID: new(big.Int).SetUint64(uint64(scope.ID)),
+	}
+
+	query.Context, _ = scope.Query["context"].(string)
+	query.Type, _ = scope.Query["type"].(string)
+	if query.Context == "" || query.Type == "" {
+		return query, fmt.Errorf("%w: scope %d has no credential context or type", ErrInvalidWalletMessage, scope.ID)
+	}
+
+	if req, ok := scope.Query["credentialSubject"].(map[string]interface{}); ok {
+		query.Req = req
+	}
+
+	if skip, ok := scope.Query["skipClaimRevocationCheck"].(bool); ok {
+		query.SkipClaimRevocationCheck = skip
+	}
+
+	if issuers, ok := scope.Query["allowedIssuers"].([]interface{}); ok {
+		for _, issuer := range issuers {
+			if s, ok := issuer.(string); ok {
+				query.AllowedIssuers = append(query.AllowedIssuers, s)
+			}
+		}
+	}
+
+	return query, nil
+}
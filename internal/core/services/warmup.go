@@ -0,0 +1,116 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	core "github.com/iden3/go-iden3-core"
+
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/kms"
+	"github.com/polygonid/sh-id-platform/internal/loader"
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+type warmUp struct {
+	identityService  ports.IdentityService
+	schemaRepository ports.SchemaRepository
+	loaderFactory    loader.Factory
+	kms              kms.KMSType
+}
+
+// NewWarmUp returns a new warm-up service. schemaRepository is optional; when nil, imported schemas are not preloaded.
+func NewWarmUp(identityService ports.IdentityService, schemaRepository ports.SchemaRepository, ld loader.Factory, kms kms.KMSType) ports.WarmUpService {
+	return &warmUp{
+		identityService:  identityService,
+		schemaRepository: schemaRepository,
+		loaderFactory:    ld,
+		kms:              kms,
+	}
+}
+
+// Run loads the latest state, the public keys and the imported schemas of the given issuers.
+// If no issuer is given, all the identities managed by the node are warmed up.
+// Failures are logged and skipped, as the warm-up is a best effort. Only a cancelled or expired context is returned as an error.
+func (w *warmUp) Run(ctx context.Context, issuers ...core.DID) error {
+	if len(issuers) == 0 {
+		all, err := w.allIssuers(ctx)
+		if err != nil {
+			return err
+		}
+		issuers = all
+	}
+
+	for i := range issuers {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		w.warmUpIssuer(ctx, issuers[i])
+	}
+
+	return ctx.Err()
+}
+
+func (w *warmUp) allIssuers(ctx context.Context) ([]core.DID, error) {
+	identifiers, err := w.identityService.Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting identities: %w", err)
+	}
+
+	issuers := make([]core.DID, 0, len(identifiers))
+	for _, identifier := range identifiers {
+		did, err := core.ParseDID(identifier)
+		if err != nil {
+			log.Warn(ctx, "warm-up: skipping identity with invalid did", "did", identifier, "err", err)
+			continue
+		}
+		issuers = append(issuers, *did)
+	}
+
+	return issuers, nil
+}
+
+func (w *warmUp) warmUpIssuer(ctx context.Context, issuer core.DID) {
+	if _, err := w.identityService.GetLatestStateByID(ctx, issuer); err != nil {
+		log.Warn(ctx, "warm-up: loading latest state", "did", issuer.String(), "err", err)
+	}
+
+	keyIDs, err := w.kms.KeysByIdentity(ctx, issuer)
+	if err != nil {
+		log.Warn(ctx, "warm-up: loading issuer keys", "did", issuer.String(), "err", err)
+	}
+	for _, keyID := range keyIDs {
+		if _, err := w.kms.PublicKey(keyID); err != nil {
+			log.Warn(ctx, "warm-up: loading public key", "did", issuer.String(), "keyID", keyID.ID, "err", err)
+		}
+	}
+
+	schemas := w.warmUpSchemas(ctx, issuer)
+
+	log.Info(ctx, "warm-up: issuer ready", "did", issuer.String(), "keys", len(keyIDs), "schemas", schemas)
+}
+
+// warmUpSchemas loads the issuer imported schemas through the loader so they end up in the schema cache, if any.
+// Returns the number of schemas loaded.
+func (w *warmUp) warmUpSchemas(ctx context.Context, issuer core.DID) int {
+	if w.schemaRepository == nil {
+		return 0
+	}
+
+	schemas, err := w.schemaRepository.GetAll(ctx, issuer, nil)
+	if err != nil {
+		log.Warn(ctx, "warm-up: loading schemas", "did", issuer.String(), "err", err)
+		return 0
+	}
+
+	loaded := 0
+	for _, schema := range schemas {
+		if _, _, err := w.loaderFactory(schema.URL).Load(ctx); err != nil {
+			log.Warn(ctx, "warm-up: loading schema", "did", issuer.String(), "url", schema.URL, "err", err)
+			continue
+		}
+		loaded++
+	}
+
+	return loaded
+}
@@ -0,0 +1,35 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgconn"
+
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+// queryCanceledErrorCode is the code of the statements cancelled by a timeout or a cancel request
+const queryCanceledErrorCode = "57014"
+
+// ErrQueryTimeout is returned by the operations cancelled because they took longer than their timeout
+var ErrQueryTimeout = errors.New("database query timeout")
+
+// QueryError returns ErrQueryTimeout, wrapped with the operation name, if err is the cancellation of a query that
+// took longer than the context deadline or the statement timeout, and counts it. The rest of errors, including the
+// cancellations of the requests whose client went away, are returned as they are.
+func (s *Storage) QueryError(ctx context.Context, operation string, err error) error {
+	if err == nil || errors.Is(ctx.Err(), context.Canceled) {
+		return err
+	}
+	var pgErr *pgconn.PgError
+	if !errors.Is(err, context.DeadlineExceeded) && !(errors.As(err, &pgErr) && pgErr.Code == queryCanceledErrorCode) {
+		return err
+	}
+	if s.queryTimeouts != nil {
+		s.queryTimeouts.WithLabelValues(operation).Inc()
+	}
+	log.Warn(ctx, "database query timeout", "operation", operation, "err", err)
+	return fmt.Errorf("%w: %s", ErrQueryTimeout, operation)
+}
@@ -0,0 +1,44 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/jackc/pgconn"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStorage_QueryError(t *testing.T) {
+	other := errors.New("connection refused")
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	type testConfig struct {
+		name    string
+		ctx     context.Context
+		err     error
+		timeout bool
+	}
+	for _, tc := range []testConfig{
+		{name: "no error", ctx: context.Background(), err: nil},
+		{name: "other error", ctx: context.Background(), err: other},
+		{name: "deadline exceeded", ctx: context.Background(), err: fmt.Errorf("query: %w", context.DeadlineExceeded), timeout: true},
+		{name: "statement timeout", ctx: context.Background(), err: &pgconn.PgError{Code: queryCanceledErrorCode}, timeout: true},
+		{name: "client went away", ctx: cancelled, err: &pgconn.PgError{Code: queryCanceledErrorCode}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			s := &Storage{queryTimeouts: newQueryTimeoutsCounter()}
+			err := s.QueryError(tc.ctx, "claims.GetAll", tc.err)
+			if tc.timeout {
+				assert.ErrorIs(t, err, ErrQueryTimeout)
+				assert.Equal(t, float64(1), testutil.ToFloat64(s.queryTimeouts.WithLabelValues("claims.GetAll")))
+				return
+			}
+			assert.Equal(t, tc.err, err)
+			assert.Equal(t, float64(0), testutil.ToFloat64(s.queryTimeouts.WithLabelValues("claims.GetAll")))
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package db
+
+import (
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func newQueryTimeoutsCounter() *prometheus.CounterVec {
+	return prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "issuer",
+		Subsystem: "db",
+		Name:      "query_timeouts_total",
+		Help:      "Number of database operations cancelled by a timeout, by operation.",
+	}, []string{"operation"})
+}
+
+// RegisterMetrics registers in reg the query timeouts by operation and the statistics of the connection pools, the
+// primary one and the replica one if there is a replica
+func (s *Storage) RegisterMetrics(reg prometheus.Registerer) error {
+	collectors := []prometheus.Collector{s.queryTimeouts}
+	pools := map[string]*pgxpool.Pool{"primary": s.Pgx}
+	if s.Replica != s.Pgx {
+		pools["replica"] = s.Replica
+	}
+	for name, pool := range pools {
+		collectors = append(collectors, poolCollectors(name, pool)...)
+	}
+	for _, c := range collectors {
+		if err := reg.Register(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func poolCollectors(name string, pool *pgxpool.Pool) []prometheus.Collector {
+	opts := func(metric string, help string) prometheus.Opts {
+		return prometheus.Opts{
+			Namespace:   "issuer",
+			Subsystem:   "db",
+			Name:        metric,
+			Help:        help,
+			ConstLabels: prometheus.Labels{"pool": name},
+		}
+	}
+	gauge := func(metric string, help string, value func(*pgxpool.Stat) float64) prometheus.Collector {
+		return prometheus.NewGaugeFunc(prometheus.GaugeOpts(opts(metric, help)), func() float64 { return value(pool.Stat()) })
+	}
+	counter := func(metric string, help string, value func(*pgxpool.Stat) float64) prometheus.Collector {
+		return prometheus.NewCounterFunc(prometheus.CounterOpts(opts(metric, help)), func() float64 { return value(pool.Stat()) })
+	}
+	return []prometheus.Collector{
+		gauge("max_conns", "Maximum number of connections of the pool.", func(s *pgxpool.Stat) float64 { return float64(s.MaxConns()) }),
+		gauge("total_conns", "Number of open connections of the pool.", func(s *pgxpool.Stat) float64 { return float64(s.TotalConns()) }),
+		gauge("acquired_conns", "Number of connections of the pool in use.", func(s *pgxpool.Stat) float64 { return float64(s.AcquiredConns()) }),
+		gauge("idle_conns", "Number of idle connections of the pool.", func(s *pgxpool.Stat) float64 { return float64(s.IdleConns()) }),
+		counter("empty_acquires_total", "Number of acquires that waited for a connection.", func(s *pgxpool.Stat) float64 { return float64(s.EmptyAcquireCount()) }),
+		counter("acquire_duration_seconds_total", "Time spent acquiring connections.", func(s *pgxpool.Stat) float64 { return s.AcquireDuration().Seconds() }),
+	}
+}
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE claims
+    ADD COLUMN expired bool NOT NULL DEFAULT false;
+ALTER TABLE schemas
+    ADD COLUMN auto_revoke_on_expiration bool NOT NULL DEFAULT false;
+CREATE INDEX claims_expiration_not_expired ON claims (expiration) WHERE expired = false AND expiration > 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS claims_expiration_not_expired;
+ALTER TABLE schemas DROP COLUMN auto_revoke_on_expiration;
+ALTER TABLE claims DROP COLUMN expired;
+-- +goose StatementEnd
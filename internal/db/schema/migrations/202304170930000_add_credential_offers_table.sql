@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE credential_offers
+(
+    id          uuid        NOT NULL,
+    claim_id    uuid        NOT NULL,
+    issuer_id   text        NOT NULL,
+    expires_at  timestamptz NOT NULL,
+    consumed_at timestamptz NULL,
+    created_at  timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT credential_offers_pkey PRIMARY KEY (id, claim_id),
+    CONSTRAINT credential_offers_claim_id_fkey FOREIGN KEY (claim_id) REFERENCES claims (id) ON DELETE CASCADE
+);
+CREATE INDEX credential_offers_expires_at ON credential_offers (expires_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE credential_offers;
+-- +goose StatementEnd
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE held_credentials
+(
+    id            uuid        NOT NULL,
+    identifier    text        NOT NULL,
+    credential_id text        NOT NULL,
+    issuer        text        NOT NULL,
+    schema_url    text        NOT NULL,
+    schema_type   text        NOT NULL,
+    expires_at    timestamptz NULL,
+    revoked       boolean     NOT NULL DEFAULT false,
+    credential    jsonb       NOT NULL,
+    created_at    timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT held_credentials_pkey PRIMARY KEY (id),
+    CONSTRAINT held_credentials_identifier_credential_id_key UNIQUE (identifier, credential_id),
+    CONSTRAINT held_credentials_identifier_fkey FOREIGN KEY (identifier) REFERENCES identities (identifier) ON DELETE CASCADE
+);
+CREATE INDEX held_credentials_identifier_schema_type ON held_credentials (identifier, schema_type);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE held_credentials;
+-- +goose StatementEnd
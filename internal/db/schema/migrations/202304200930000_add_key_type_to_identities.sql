@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE identities
+    ADD COLUMN key_type text NOT NULL DEFAULT 'BJJ',
+    ADD COLUMN address  text NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE identities
+    DROP COLUMN key_type,
+    DROP COLUMN address;
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE key_rotations
+(
+    id           uuid        NOT NULL,
+    identifier   text        NOT NULL,
+    old_claim_id uuid        NOT NULL,
+    new_claim_id uuid        NOT NULL,
+    status       text        NOT NULL DEFAULT 'pending',
+    created_at   timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    completed_at timestamptz NULL,
+    CONSTRAINT key_rotations_pkey PRIMARY KEY (id),
+    CONSTRAINT key_rotations_identifier_fkey FOREIGN KEY (identifier) REFERENCES identities (identifier),
+    CONSTRAINT key_rotations_old_claim_id_fkey FOREIGN KEY (old_claim_id) REFERENCES claims (id),
+    CONSTRAINT key_rotations_new_claim_id_fkey FOREIGN KEY (new_claim_id) REFERENCES claims (id)
+);
+CREATE UNIQUE INDEX key_rotations_pending_identifier ON key_rotations (identifier) WHERE status = 'pending';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE key_rotations;
+-- +goose StatementEnd
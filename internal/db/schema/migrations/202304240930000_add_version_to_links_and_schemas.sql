@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE links
+    ADD COLUMN version int NOT NULL DEFAULT 1;
+ALTER TABLE schemas
+    ADD COLUMN version int NOT NULL DEFAULT 1;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE links DROP COLUMN version;
+ALTER TABLE schemas DROP COLUMN version;
+-- +goose StatementEnd
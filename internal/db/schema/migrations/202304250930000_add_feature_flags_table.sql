@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE feature_flags
+(
+    identifier text        NOT NULL,
+    feature    text        NOT NULL,
+    enabled    boolean     NOT NULL,
+    updated_at timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT feature_flags_pkey PRIMARY KEY (identifier, feature),
+    CONSTRAINT feature_flags_identifier_fkey FOREIGN KEY (identifier) REFERENCES identities (identifier)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE feature_flags;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE identity_migrations
+(
+    identifier   text        NOT NULL,
+    status       text        NOT NULL,
+    target       text        NULL,
+    created_at   timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    completed_at timestamptz NULL,
+    CONSTRAINT identity_migrations_pkey PRIMARY KEY (identifier),
+    CONSTRAINT identity_migrations_identifier_fkey FOREIGN KEY (identifier) REFERENCES identities (identifier)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE identity_migrations;
+-- +goose StatementEnd
@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE state_transactions
+(
+    id           uuid        NOT NULL,
+    identifier   text        NOT NULL,
+    state        text        NOT NULL,
+    network      text        NOT NULL,
+    tx_id        text        NOT NULL,
+    tx_hashes    text[]      NOT NULL,
+    tx_type      smallint    NOT NULL,
+    key_type     text        NOT NULL,
+    key_id       text        NOT NULL,
+    from_address text        NOT NULL,
+    to_address   text        NOT NULL,
+    nonce        bigint      NOT NULL,
+    gas_limit    bigint      NOT NULL,
+    gas_tip_cap  numeric     NOT NULL,
+    gas_fee_cap  numeric     NOT NULL,
+    payload      bytea       NOT NULL,
+    attempts     integer     NOT NULL,
+    status       text        NOT NULL,
+    error        text        NULL,
+    created_at   timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    submitted_at timestamptz NOT NULL,
+    updated_at   timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT state_transactions_pkey PRIMARY KEY (id),
+    CONSTRAINT state_transactions_identifier_fkey FOREIGN KEY (identifier) REFERENCES identities (identifier)
+);
+CREATE INDEX state_transactions_identifier ON state_transactions (identifier, created_at);
+CREATE INDEX state_transactions_pending ON state_transactions (created_at) WHERE status = 'pending';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE state_transactions;
+-- +goose StatementEnd
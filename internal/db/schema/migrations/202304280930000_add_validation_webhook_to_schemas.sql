@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE schemas
+    ADD COLUMN validation_webhook_url text NULL,
+    ADD COLUMN validation_webhook_secret text NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE schemas
+    DROP COLUMN validation_webhook_url,
+    DROP COLUMN validation_webhook_secret;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE publishing_policies
+(
+    identifier       text        NOT NULL,
+    mode             text        NOT NULL,
+    interval_seconds integer     NOT NULL DEFAULT 0,
+    threshold        integer     NOT NULL DEFAULT 0,
+    updated_at       timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT publishing_policies_pkey PRIMARY KEY (identifier),
+    CONSTRAINT publishing_policies_identifier_fkey FOREIGN KEY (identifier) REFERENCES identities (identifier)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE publishing_policies;
+-- +goose StatementEnd
@@ -0,0 +1,129 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE claims_read_model
+(
+    id                  uuid        NOT NULL,
+    identifier          text        NOT NULL,
+    issuer              text        NULL,
+    schema_hash         text        NOT NULL,
+    schema_type         text        NOT NULL,
+    holder_did          text        NULL,
+    identity_state      varchar(64) NULL,
+    status              text        NULL,
+    revoked             bool        NOT NULL DEFAULT false,
+    expiration          int8        NOT NULL DEFAULT 0,
+    issuance_date       timestamptz NULL,
+    has_signature_proof bool        NOT NULL DEFAULT false,
+    has_mtp_proof       bool        NOT NULL DEFAULT false,
+    mtp                 bool        NOT NULL DEFAULT false,
+    ts_words            tsvector    NOT NULL DEFAULT to_tsvector(''::text),
+    CONSTRAINT claims_read_model_pkey PRIMARY KEY (id, identifier),
+    CONSTRAINT claims_read_model_claim_fkey FOREIGN KEY (id, identifier) REFERENCES claims (id, identifier) ON DELETE CASCADE
+);
+CREATE INDEX claims_read_model_identifier_holder_did ON claims_read_model (identifier, holder_did);
+CREATE INDEX claims_read_model_identity_state ON claims_read_model (identity_state);
+CREATE INDEX claims_read_model_issuer_schema_hash ON claims_read_model (issuer, schema_hash);
+CREATE INDEX claims_read_model_ts_words ON claims_read_model USING gin (ts_words);
+
+-- claims_issuance_date returns the issuanceDate of a credential, null if it has none or it is not a valid date, so a
+-- malformed credential does not fail the write of the claim
+CREATE OR REPLACE FUNCTION claims_issuance_date(data jsonb)
+    RETURNS timestamptz AS $$
+BEGIN
+    IF jsonb_typeof(data -> 'issuanceDate') IS DISTINCT FROM 'string' OR data ->> 'issuanceDate' !~ '^\d{4}-\d{2}-\d{2}' THEN
+        RETURN NULL;
+    END IF;
+    RETURN (data ->> 'issuanceDate')::timestamptz;
+EXCEPTION
+    WHEN data_exception THEN
+        RETURN NULL;
+END;
+$$
+language plpgsql STABLE;
+
+-- claims_read_model_row upserts the read model row of a claim with the status of its state and the words of its schema
+CREATE OR REPLACE FUNCTION claims_read_model_row()
+    RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO claims_read_model (id, identifier, issuer, schema_hash, schema_type, holder_did, identity_state, status,
+                                   revoked, expiration, issuance_date, has_signature_proof, has_mtp_proof, mtp, ts_words)
+    VALUES (NEW.id, NEW.identifier, NEW.issuer, NEW.schema_hash, NEW.schema_type, NEW.other_identifier, NEW.identity_state,
+            (SELECT status FROM identity_states WHERE state = NEW.identity_state),
+            COALESCE(NEW.revoked, false),
+            COALESCE(NEW.expiration, 0),
+            claims_issuance_date(NEW.data),
+            NEW.signature_proof IS NOT NULL,
+            NEW.mtp_proof IS NOT NULL,
+            COALESCE(NEW.mtp, false),
+            COALESCE((SELECT ts_words FROM schemas WHERE issuer_id = NEW.issuer AND hash = NEW.schema_hash ORDER BY created_at DESC LIMIT 1), to_tsvector(''::text)))
+    ON CONFLICT ON CONSTRAINT claims_read_model_pkey DO UPDATE SET
+        issuer = EXCLUDED.issuer,
+        schema_hash = EXCLUDED.schema_hash,
+        schema_type = EXCLUDED.schema_type,
+        holder_did = EXCLUDED.holder_did,
+        identity_state = EXCLUDED.identity_state,
+        status = EXCLUDED.status,
+        revoked = EXCLUDED.revoked,
+        expiration = EXCLUDED.expiration,
+        issuance_date = EXCLUDED.issuance_date,
+        has_signature_proof = EXCLUDED.has_signature_proof,
+        has_mtp_proof = EXCLUDED.has_mtp_proof,
+        mtp = EXCLUDED.mtp,
+        ts_words = EXCLUDED.ts_words;
+    RETURN NULL;
+END;
+$$
+language plpgsql;
+
+-- claims_read_model_status copies the status of a state to the read model rows of its claims
+CREATE OR REPLACE FUNCTION claims_read_model_status()
+    RETURNS TRIGGER AS $$
+BEGIN
+    UPDATE claims_read_model SET status = NEW.status
+    WHERE identity_state = NEW.state AND status IS DISTINCT FROM NEW.status;
+    RETURN NULL;
+END;
+$$
+language plpgsql;
+
+-- claims_read_model_words copies the words of a schema to the read model rows of its claims
+CREATE OR REPLACE FUNCTION claims_read_model_words()
+    RETURNS TRIGGER AS $$
+BEGIN
+    UPDATE claims_read_model SET ts_words = NEW.ts_words
+    WHERE issuer = NEW.issuer_id AND schema_hash = NEW.hash;
+    RETURN NULL;
+END;
+$$
+language plpgsql;
+
+CREATE TRIGGER claims_read_model_row AFTER INSERT OR UPDATE ON claims FOR EACH ROW EXECUTE PROCEDURE claims_read_model_row();
+CREATE TRIGGER claims_read_model_status AFTER INSERT OR UPDATE OF status ON identity_states FOR EACH ROW EXECUTE PROCEDURE claims_read_model_status();
+CREATE TRIGGER claims_read_model_words AFTER INSERT OR UPDATE OF ts_words ON schemas FOR EACH ROW EXECUTE PROCEDURE claims_read_model_words();
+
+INSERT INTO claims_read_model (id, identifier, issuer, schema_hash, schema_type, holder_did, identity_state, status,
+                               revoked, expiration, issuance_date, has_signature_proof, has_mtp_proof, mtp, ts_words)
+SELECT claims.id, claims.identifier, claims.issuer, claims.schema_hash, claims.schema_type, claims.other_identifier,
+       claims.identity_state, identity_states.status,
+       COALESCE(claims.revoked, false),
+       COALESCE(claims.expiration, 0),
+       claims_issuance_date(claims.data),
+       claims.signature_proof IS NOT NULL,
+       claims.mtp_proof IS NOT NULL,
+       COALESCE(claims.mtp, false),
+       COALESCE((SELECT ts_words FROM schemas WHERE issuer_id = claims.issuer AND hash = claims.schema_hash ORDER BY created_at DESC LIMIT 1), to_tsvector(''::text))
+FROM claims
+LEFT JOIN identity_states ON claims.identity_state = identity_states.state;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS claims_read_model_row ON claims;
+DROP TRIGGER IF EXISTS claims_read_model_status ON identity_states;
+DROP TRIGGER IF EXISTS claims_read_model_words ON schemas;
+DROP FUNCTION IF EXISTS claims_read_model_row();
+DROP FUNCTION IF EXISTS claims_read_model_status();
+DROP FUNCTION IF EXISTS claims_read_model_words();
+DROP FUNCTION IF EXISTS claims_issuance_date(jsonb);
+DROP TABLE IF EXISTS claims_read_model;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE schemas
+    ADD COLUMN extra_contexts text[] NOT NULL DEFAULT '{}',
+    ADD COLUMN extra_types    text[] NOT NULL DEFAULT '{}';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE schemas
+    DROP COLUMN extra_contexts,
+    DROP COLUMN extra_types;
+-- +goose StatementEnd
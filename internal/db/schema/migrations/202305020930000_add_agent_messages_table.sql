@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE agent_messages
+(
+    issuer_id  text        NOT NULL,
+    sender     text        NOT NULL,
+    message_id text        NOT NULL,
+    thread_id  text        NOT NULL DEFAULT '',
+    type       text        NOT NULL,
+    response   jsonb       NULL,
+    created_at timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT agent_messages_pkey PRIMARY KEY (issuer_id, sender, message_id),
+    CONSTRAINT agent_messages_issuer_id_fkey FOREIGN KEY (issuer_id) REFERENCES identities (identifier) ON DELETE CASCADE
+);
+CREATE UNIQUE INDEX agent_messages_thread ON agent_messages (issuer_id, sender, thread_id, type) WHERE thread_id <> '';
+CREATE INDEX agent_messages_created_at ON agent_messages (created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE agent_messages;
+-- +goose StatementEnd
@@ -0,0 +1,72 @@
+-- +goose Up
+-- +goose StatementBegin
+-- changes is the feed of the changes of the credentials and connections of every issuer, for external indexers.
+-- The rows are ordered by the transaction that made the change, so a change committed late is never skipped by a
+-- reader that already went past its sequence number.
+CREATE TABLE changes
+(
+    tx         xid8        NOT NULL DEFAULT pg_current_xact_id(),
+    seq        bigserial   NOT NULL,
+    issuer_id  text        NOT NULL,
+    entity     text        NOT NULL,
+    entity_id  uuid        NOT NULL,
+    operation  text        NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT changes_pkey PRIMARY KEY (tx, seq)
+);
+CREATE INDEX changes_issuer_id_tx_seq ON changes (issuer_id, tx, seq);
+
+-- changes_claims records the creation, revocation, update and deletion of a credential
+CREATE OR REPLACE FUNCTION changes_claims()
+    RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        INSERT INTO changes (issuer_id, entity, entity_id, operation) VALUES (NEW.identifier, 'credential', NEW.id, 'created');
+    ELSIF TG_OP = 'DELETE' THEN
+        INSERT INTO changes (issuer_id, entity, entity_id, operation) VALUES (OLD.identifier, 'credential', OLD.id, 'deleted');
+    ELSIF COALESCE(NEW.revoked, false) AND NOT COALESCE(OLD.revoked, false) THEN
+        INSERT INTO changes (issuer_id, entity, entity_id, operation) VALUES (NEW.identifier, 'credential', NEW.id, 'revoked');
+    ELSIF NEW IS DISTINCT FROM OLD THEN
+        INSERT INTO changes (issuer_id, entity, entity_id, operation) VALUES (NEW.identifier, 'credential', NEW.id, 'updated');
+    END IF;
+    RETURN NULL;
+END;
+$$
+language plpgsql;
+
+-- changes_connections records the creation, update and deletion of a connection
+CREATE OR REPLACE FUNCTION changes_connections()
+    RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        INSERT INTO changes (issuer_id, entity, entity_id, operation) VALUES (NEW.issuer_id, 'connection', NEW.id, 'created');
+    ELSIF TG_OP = 'DELETE' THEN
+        INSERT INTO changes (issuer_id, entity, entity_id, operation) VALUES (OLD.issuer_id, 'connection', OLD.id, 'deleted');
+    ELSIF NEW IS DISTINCT FROM OLD THEN
+        INSERT INTO changes (issuer_id, entity, entity_id, operation) VALUES (NEW.issuer_id, 'connection', NEW.id, 'updated');
+    END IF;
+    RETURN NULL;
+END;
+$$
+language plpgsql;
+
+CREATE TRIGGER changes_claims AFTER INSERT OR UPDATE OR DELETE ON claims
+    FOR EACH ROW EXECUTE FUNCTION changes_claims();
+CREATE TRIGGER changes_connections AFTER INSERT OR UPDATE OR DELETE ON connections
+    FOR EACH ROW EXECUTE FUNCTION changes_connections();
+
+-- the existing credentials and connections are the first changes, so the feed can be read from the start
+INSERT INTO changes (issuer_id, entity, entity_id, operation)
+SELECT identifier, 'credential', id, 'created' FROM claims;
+INSERT INTO changes (issuer_id, entity, entity_id, operation, created_at)
+SELECT issuer_id, 'connection', id, 'created', created_at FROM connections WHERE id IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS changes_connections ON connections;
+DROP TRIGGER IF EXISTS changes_claims ON claims;
+DROP FUNCTION IF EXISTS changes_connections();
+DROP FUNCTION IF EXISTS changes_claims();
+DROP TABLE IF EXISTS changes;
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE schema_documents
+(
+    schema_id      uuid NOT NULL PRIMARY KEY,
+    json_schema    json NOT NULL,
+    jsonld_context json NOT NULL,
+    CONSTRAINT schema_documents_schemas_id_fk FOREIGN KEY (schema_id) REFERENCES schemas (id) ON DELETE CASCADE
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS schema_documents;
+-- +goose StatementEnd
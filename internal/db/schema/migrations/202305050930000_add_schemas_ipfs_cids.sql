@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE schemas
+    ADD COLUMN ipfs_cid         text NULL,
+    ADD COLUMN ipfs_context_cid text NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE schemas
+    DROP COLUMN IF EXISTS ipfs_cid,
+    DROP COLUMN IF EXISTS ipfs_context_cid;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE schemas
+    ADD COLUMN schema_version integer     NOT NULL DEFAULT 1,
+    ADD COLUMN deprecated_at  timestamptz NULL;
+
+UPDATE schemas
+SET schema_version = versions.schema_version
+FROM (SELECT id, ROW_NUMBER() OVER (PARTITION BY issuer_id, type ORDER BY created_at) AS schema_version FROM schemas) AS versions
+WHERE schemas.id = versions.id;
+
+CREATE INDEX schemas_issuer_id_type ON schemas (issuer_id, type, schema_version);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS schemas_issuer_id_type;
+ALTER TABLE schemas
+    DROP COLUMN IF EXISTS schema_version,
+    DROP COLUMN IF EXISTS deprecated_at;
+-- +goose StatementEnd
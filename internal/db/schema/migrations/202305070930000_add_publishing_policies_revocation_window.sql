@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE publishing_policies ADD COLUMN revocation_window_seconds integer NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE publishing_policies DROP COLUMN revocation_window_seconds;
+-- +goose StatementEnd
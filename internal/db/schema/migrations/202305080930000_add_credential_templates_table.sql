@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE credential_templates
+(
+    id                 uuid        NOT NULL,
+    issuer_id          text        NOT NULL,
+    name               text        NOT NULL,
+    schema_id          uuid        NOT NULL,
+    credential_subject jsonb       NOT NULL,
+    expiration_days    integer     NULL,
+    signature_proof    bool        NOT NULL DEFAULT false,
+    mtp_proof          bool        NOT NULL DEFAULT false,
+    created_at         timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT credential_templates_pkey PRIMARY KEY (id),
+    CONSTRAINT credential_templates_issuer_id_name_key UNIQUE (issuer_id, name),
+    CONSTRAINT credential_templates_schema_id_fkey FOREIGN KEY (schema_id) REFERENCES schemas (id) ON DELETE CASCADE,
+    CONSTRAINT credential_templates_issuer_id_fkey FOREIGN KEY (issuer_id) REFERENCES identities (identifier)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE credential_templates;
+-- +goose StatementEnd
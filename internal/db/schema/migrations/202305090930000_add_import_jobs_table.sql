@@ -0,0 +1,41 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE import_jobs
+(
+    id                    uuid        NOT NULL,
+    issuer_id             text        NOT NULL,
+    schema_id             uuid        NOT NULL,
+    mode                  text        NOT NULL,
+    status                text        NOT NULL,
+    signature_proof       bool        NOT NULL DEFAULT false,
+    mtp_proof             bool        NOT NULL DEFAULT false,
+    credential_expiration timestamptz NULL,
+    total_rows            integer     NOT NULL DEFAULT 0,
+    processed_rows        integer     NOT NULL DEFAULT 0,
+    failed_rows           integer     NOT NULL DEFAULT 0,
+    error                 text        NULL,
+    created_at            timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    finished_at           timestamptz NULL,
+    CONSTRAINT import_jobs_pkey PRIMARY KEY (id),
+    CONSTRAINT import_jobs_schema_id_fkey FOREIGN KEY (schema_id) REFERENCES schemas (id) ON DELETE CASCADE,
+    CONSTRAINT import_jobs_issuer_id_fkey FOREIGN KEY (issuer_id) REFERENCES identities (identifier)
+);
+
+CREATE TABLE import_job_rows
+(
+    job_id     uuid    NOT NULL,
+    row_number integer NOT NULL,
+    reference  text    NOT NULL,
+    claim_id   uuid    NULL,
+    link_id    uuid    NULL,
+    error      text    NULL,
+    CONSTRAINT import_job_rows_pkey PRIMARY KEY (job_id, row_number),
+    CONSTRAINT import_job_rows_job_id_fkey FOREIGN KEY (job_id) REFERENCES import_jobs (id) ON DELETE CASCADE
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE import_job_rows;
+DROP TABLE import_jobs;
+-- +goose StatementEnd
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+-- link_funnel_events are the steps of the link claim sessions. They have no data of the holders: the session is a
+-- hash of the session id, so the steps of a session can be grouped but not traced back to it.
+CREATE TABLE link_funnel_events
+(
+    id             bigserial   NOT NULL,
+    issuer_id      text        NOT NULL,
+    link_id        uuid        NOT NULL,
+    session        text        NULL,
+    step           text        NOT NULL,
+    failure_reason text        NULL,
+    created_at     timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT link_funnel_events_pkey PRIMARY KEY (id),
+    CONSTRAINT link_funnel_events_link_id_fkey FOREIGN KEY (link_id) REFERENCES links (id) ON DELETE CASCADE
+);
+CREATE INDEX link_funnel_events_issuer_id_created_at ON link_funnel_events (issuer_id, created_at);
+CREATE INDEX link_funnel_events_link_id ON link_funnel_events (link_id);
+-- the claim page polls the session, every step but the failures is recorded once per session
+CREATE UNIQUE INDEX link_funnel_events_session_step_key ON link_funnel_events (link_id, session, step)
+    WHERE session IS NOT NULL AND step <> 'failed';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE link_funnel_events;
+-- +goose StatementEnd
@@ -0,0 +1,39 @@
+-- +goose Up
+-- +goose StatementBegin
+-- connection_metadata is what the operators write about a connection to recognize who it belongs to
+CREATE TABLE connection_metadata
+(
+    connection_id uuid        NOT NULL,
+    display_name  text        NOT NULL DEFAULT '',
+    notes         text        NOT NULL DEFAULT '',
+    tags          text[]      NOT NULL DEFAULT '{}',
+    modified_at   timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT connection_metadata_pkey PRIMARY KEY (connection_id),
+    CONSTRAINT connection_metadata_connection_id_fkey FOREIGN KEY (connection_id) REFERENCES connections (id) ON DELETE CASCADE
+);
+CREATE INDEX connection_metadata_tags ON connection_metadata USING gin (tags);
+
+-- changes_connection_metadata records the changes of the metadata as updates of the connection. The metadata is
+-- deleted with its connection, that change is already recorded by changes_connections.
+CREATE OR REPLACE FUNCTION changes_connection_metadata()
+    RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'INSERT' OR NEW IS DISTINCT FROM OLD THEN
+        INSERT INTO changes (issuer_id, entity, entity_id, operation)
+        SELECT issuer_id, 'connection', id, 'updated' FROM connections WHERE id = NEW.connection_id;
+    END IF;
+    RETURN NULL;
+END;
+$$
+language plpgsql;
+
+CREATE TRIGGER changes_connection_metadata AFTER INSERT OR UPDATE ON connection_metadata
+    FOR EACH ROW EXECUTE FUNCTION changes_connection_metadata();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS changes_connection_metadata ON connection_metadata;
+DROP FUNCTION IF EXISTS changes_connection_metadata();
+DROP TABLE IF EXISTS connection_metadata;
+-- +goose StatementEnd
@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+-- revocation_decisions are the decisions to revoke credentials received from external systems, and whether they
+-- were applied. A decision is only applied once per source.
+CREATE TABLE revocation_decisions
+(
+    id            uuid        NOT NULL,
+    issuer_id     text        NOT NULL,
+    source        text        NOT NULL,
+    external_id   text        NOT NULL,
+    credential_id uuid,
+    rev_nonce     numeric,
+    reason        text        NOT NULL DEFAULT '',
+    status        text        NOT NULL,
+    error         text,
+    decided_at    timestamptz NOT NULL,
+    created_at    timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT revocation_decisions_pkey PRIMARY KEY (id),
+    CONSTRAINT revocation_decisions_external_id_key UNIQUE (issuer_id, source, external_id),
+    CONSTRAINT revocation_decisions_issuer_id_fkey FOREIGN KEY (issuer_id) REFERENCES identities (identifier)
+);
+CREATE INDEX revocation_decisions_issuer_id_created_at ON revocation_decisions (issuer_id, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS revocation_decisions;
+-- +goose StatementEnd
@@ -0,0 +1,41 @@
+-- +goose Up
+-- +goose StatementBegin
+-- verification_requests are the proofs the issuers ask the holders for
+CREATE TABLE verification_requests
+(
+    id         uuid        NOT NULL,
+    issuer_id  text        NOT NULL,
+    reason     text        NOT NULL DEFAULT '',
+    scope      jsonb       NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at timestamptz,
+    CONSTRAINT verification_requests_pkey PRIMARY KEY (id),
+    CONSTRAINT verification_requests_issuer_id_fkey FOREIGN KEY (issuer_id) REFERENCES identities (identifier)
+);
+
+-- verification_responses are the outcome of verifying the proofs of the holders. A response is kept if its connection
+-- is deleted.
+CREATE TABLE verification_responses
+(
+    id            uuid        NOT NULL,
+    request_id    uuid        NOT NULL,
+    issuer_id     text        NOT NULL,
+    user_id       text        NOT NULL,
+    connection_id uuid,
+    verified      boolean     NOT NULL,
+    error         text,
+    proofs        jsonb,
+    created_at    timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT verification_responses_pkey PRIMARY KEY (id),
+    CONSTRAINT verification_responses_request_id_fkey FOREIGN KEY (request_id) REFERENCES verification_requests (id) ON DELETE CASCADE,
+    CONSTRAINT verification_responses_connection_id_fkey FOREIGN KEY (connection_id) REFERENCES connections (id) ON DELETE SET NULL
+);
+CREATE INDEX verification_responses_request_id ON verification_responses (request_id);
+CREATE INDEX verification_responses_issuer_id_connection_id ON verification_responses (issuer_id, connection_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS verification_responses;
+DROP TABLE IF EXISTS verification_requests;
+-- +goose StatementEnd
@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- proof_scope are the queries the holders must prove before the credential of the link is issued
+ALTER TABLE links ADD COLUMN proof_scope jsonb;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE links DROP COLUMN IF EXISTS proof_scope;
+-- +goose StatementEnd
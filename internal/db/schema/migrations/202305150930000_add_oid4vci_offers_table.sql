@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+-- oid4vci_offers are the credentials offered to wallets with the OpenID4VCI pre-authorized code flow. Only the
+-- hashes of the pre-authorized codes and the access tokens are stored.
+CREATE TABLE oid4vci_offers
+(
+    id                  uuid        NOT NULL,
+    issuer_id           text        NOT NULL,
+    pre_authorized_code text        NOT NULL,
+    credential          jsonb       NOT NULL,
+    access_token        text,
+    token_expires_at    timestamptz,
+    c_nonce             text,
+    claim_id            uuid,
+    expires_at          timestamptz NOT NULL,
+    created_at          timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT oid4vci_offers_pkey PRIMARY KEY (id),
+    CONSTRAINT oid4vci_offers_pre_authorized_code_key UNIQUE (pre_authorized_code),
+    CONSTRAINT oid4vci_offers_access_token_key UNIQUE (access_token),
+    CONSTRAINT oid4vci_offers_issuer_id_fkey FOREIGN KEY (issuer_id) REFERENCES identities (identifier),
+    CONSTRAINT oid4vci_offers_claim_id_fkey FOREIGN KEY (claim_id) REFERENCES claims (id) ON DELETE SET NULL
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS oid4vci_offers;
+-- +goose StatementEnd
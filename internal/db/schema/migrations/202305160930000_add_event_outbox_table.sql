@@ -0,0 +1,88 @@
+-- +goose Up
+-- +goose StatementBegin
+-- event_outbox keeps the issuance lifecycle events until they are sent to the message broker. The events are written
+-- by triggers in the same transaction as the change, so they are not lost if the node stops before sending them.
+CREATE TABLE event_outbox
+(
+    id         bigserial   NOT NULL,
+    event_type text        NOT NULL,
+    issuer_id  text        NOT NULL,
+    entity_id  text        NOT NULL,
+    payload    jsonb       NOT NULL,
+    attempts   int         NOT NULL DEFAULT 0,
+    last_error text        NULL,
+    created_at timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    sent_at    timestamptz NULL,
+    CONSTRAINT event_outbox_pkey PRIMARY KEY (id)
+);
+CREATE INDEX event_outbox_pending ON event_outbox (id) WHERE sent_at IS NULL;
+CREATE INDEX event_outbox_created_at ON event_outbox (created_at);
+
+-- event_outbox_claims records the creation and the revocation of a credential. Auth credentials are not issued to
+-- holders, so they have no events.
+CREATE OR REPLACE FUNCTION event_outbox_claims()
+    RETURNS TRIGGER AS $$
+BEGIN
+    IF NEW.schema_type = 'https://schema.iden3.io/core/jsonld/auth.jsonld#AuthBJJCredential' THEN
+        RETURN NULL;
+    END IF;
+    IF TG_OP = 'INSERT' THEN
+        INSERT INTO event_outbox (event_type, issuer_id, entity_id, payload)
+        VALUES ('credential.created', NEW.identifier, NEW.id::text, jsonb_build_object(
+            'credentialID', NEW.id, 'userID', COALESCE(NEW.other_identifier, ''), 'schemaURL', NEW.schema_url,
+            'schemaType', NEW.schema_type, 'revNonce', NEW.rev_nonce::text));
+    ELSIF COALESCE(NEW.revoked, false) AND NOT COALESCE(OLD.revoked, false) THEN
+        INSERT INTO event_outbox (event_type, issuer_id, entity_id, payload)
+        VALUES ('credential.revoked', NEW.identifier, NEW.id::text, jsonb_build_object(
+            'credentialID', NEW.id, 'userID', COALESCE(NEW.other_identifier, ''), 'revNonce', NEW.rev_nonce::text));
+    END IF;
+    RETURN NULL;
+END;
+$$
+language plpgsql;
+
+-- event_outbox_connections records the creation of a connection
+CREATE OR REPLACE FUNCTION event_outbox_connections()
+    RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO event_outbox (event_type, issuer_id, entity_id, payload)
+    VALUES ('connection.created', NEW.issuer_id, NEW.id::text, jsonb_build_object(
+        'connectionID', NEW.id, 'userID', NEW.user_id));
+    RETURN NULL;
+END;
+$$
+language plpgsql;
+
+-- event_outbox_states records the confirmation on chain of a state of an identity
+CREATE OR REPLACE FUNCTION event_outbox_states()
+    RETURNS TRIGGER AS $$
+BEGIN
+    IF NEW.status = 'confirmed' AND OLD.status IS DISTINCT FROM 'confirmed' THEN
+        INSERT INTO event_outbox (event_type, issuer_id, entity_id, payload)
+        VALUES ('state.published', NEW.identifier, NEW.state, jsonb_build_object(
+            'state', NEW.state, 'previousState', NEW.previous_state, 'txID', NEW.tx_id,
+            'blockNumber', NEW.block_number, 'blockTimestamp', NEW.block_timestamp));
+    END IF;
+    RETURN NULL;
+END;
+$$
+language plpgsql;
+
+CREATE TRIGGER event_outbox_claims AFTER INSERT OR UPDATE OF revoked ON claims
+    FOR EACH ROW EXECUTE FUNCTION event_outbox_claims();
+CREATE TRIGGER event_outbox_connections AFTER INSERT ON connections
+    FOR EACH ROW EXECUTE FUNCTION event_outbox_connections();
+CREATE TRIGGER event_outbox_states AFTER UPDATE OF status ON identity_states
+    FOR EACH ROW EXECUTE FUNCTION event_outbox_states();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS event_outbox_states ON identity_states;
+DROP TRIGGER IF EXISTS event_outbox_connections ON connections;
+DROP TRIGGER IF EXISTS event_outbox_claims ON claims;
+DROP FUNCTION IF EXISTS event_outbox_states();
+DROP FUNCTION IF EXISTS event_outbox_connections();
+DROP FUNCTION IF EXISTS event_outbox_claims();
+DROP TABLE IF EXISTS event_outbox;
+-- +goose StatementEnd
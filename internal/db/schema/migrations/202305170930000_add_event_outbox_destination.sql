@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+-- destination tells where the relay delivers an event: the message broker, or the pubsub of the node for the
+-- notifications to the holders. The notifications are written by the services in the transaction of the change and
+-- have no entity_id.
+ALTER TABLE event_outbox ADD COLUMN destination text NOT NULL DEFAULT 'broker';
+DROP INDEX IF EXISTS event_outbox_pending;
+CREATE INDEX event_outbox_pending ON event_outbox (destination, id) WHERE sent_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM event_outbox WHERE destination <> 'broker';
+DROP INDEX IF EXISTS event_outbox_pending;
+CREATE INDEX event_outbox_pending ON event_outbox (id) WHERE sent_at IS NULL;
+ALTER TABLE event_outbox DROP COLUMN IF EXISTS destination;
+-- +goose StatementEnd
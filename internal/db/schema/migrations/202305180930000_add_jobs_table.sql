@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+-- jobs is the queue of the background work. A worker locks the due jobs of the kinds it handles, and the running
+-- jobs whose worker stopped sending heartbeats go back to the queue.
+CREATE TABLE jobs
+(
+    id           uuid        NOT NULL,
+    kind         text        NOT NULL,
+    issuer_id    text        NOT NULL,
+    payload      jsonb       NOT NULL,
+    status       text        NOT NULL,
+    attempts     integer     NOT NULL DEFAULT 0,
+    max_attempts integer     NOT NULL,
+    last_error   text        NULL,
+    run_at       timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    locked_at    timestamptz NULL,
+    created_at   timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at   timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    finished_at  timestamptz NULL,
+    CONSTRAINT jobs_pkey PRIMARY KEY (id)
+);
+CREATE INDEX jobs_due ON jobs (kind, run_at) WHERE status = 'pending';
+CREATE INDEX jobs_running ON jobs (locked_at) WHERE status = 'running';
+CREATE INDEX jobs_issuer_id_status ON jobs (issuer_id, status, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS jobs;
+-- +goose StatementEnd
@@ -0,0 +1,64 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE revocation ADD COLUMN reason text NOT NULL DEFAULT 'unspecified';
+
+-- the revocations made by the node before the reasons were recorded have their reason in the description
+UPDATE revocation SET reason = 'expired' WHERE description = 'credential expired';
+UPDATE revocation SET reason = 'superseded' WHERE description = 'auth key rotated';
+
+-- event_outbox_claims records the creation and the revocation of a credential, with the reason of the revocation.
+-- The revocation is saved before the credential is marked as revoked, so it is found here.
+CREATE OR REPLACE FUNCTION event_outbox_claims()
+    RETURNS TRIGGER AS $$
+DECLARE
+    rev_reason      text;
+    rev_description text;
+BEGIN
+    IF NEW.schema_type = 'https://schema.iden3.io/core/jsonld/auth.jsonld#AuthBJJCredential' THEN
+        RETURN NULL;
+    END IF;
+    IF TG_OP = 'INSERT' THEN
+        INSERT INTO event_outbox (event_type, issuer_id, entity_id, payload)
+        VALUES ('credential.created', NEW.identifier, NEW.id::text, jsonb_build_object(
+            'credentialID', NEW.id, 'userID', COALESCE(NEW.other_identifier, ''), 'schemaURL', NEW.schema_url,
+            'schemaType', NEW.schema_type, 'revNonce', NEW.rev_nonce::text));
+    ELSIF COALESCE(NEW.revoked, false) AND NOT COALESCE(OLD.revoked, false) THEN
+        SELECT reason, description INTO rev_reason, rev_description
+        FROM revocation WHERE identifier = NEW.identifier AND nonce = NEW.rev_nonce
+        ORDER BY id DESC LIMIT 1;
+        INSERT INTO event_outbox (event_type, issuer_id, entity_id, payload)
+        VALUES ('credential.revoked', NEW.identifier, NEW.id::text, jsonb_build_object(
+            'credentialID', NEW.id, 'userID', COALESCE(NEW.other_identifier, ''), 'revNonce', NEW.rev_nonce::text,
+            'reason', COALESCE(rev_reason, 'unspecified'), 'description', COALESCE(rev_description, '')));
+    END IF;
+    RETURN NULL;
+END;
+$$
+language plpgsql;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION event_outbox_claims()
+    RETURNS TRIGGER AS $$
+BEGIN
+    IF NEW.schema_type = 'https://schema.iden3.io/core/jsonld/auth.jsonld#AuthBJJCredential' THEN
+        RETURN NULL;
+    END IF;
+    IF TG_OP = 'INSERT' THEN
+        INSERT INTO event_outbox (event_type, issuer_id, entity_id, payload)
+        VALUES ('credential.created', NEW.identifier, NEW.id::text, jsonb_build_object(
+            'credentialID', NEW.id, 'userID', COALESCE(NEW.other_identifier, ''), 'schemaURL', NEW.schema_url,
+            'schemaType', NEW.schema_type, 'revNonce', NEW.rev_nonce::text));
+    ELSIF COALESCE(NEW.revoked, false) AND NOT COALESCE(OLD.revoked, false) THEN
+        INSERT INTO event_outbox (event_type, issuer_id, entity_id, payload)
+        VALUES ('credential.revoked', NEW.identifier, NEW.id::text, jsonb_build_object(
+            'credentialID', NEW.id, 'userID', COALESCE(NEW.other_identifier, ''), 'revNonce', NEW.rev_nonce::text));
+    END IF;
+    RETURN NULL;
+END;
+$$
+language plpgsql;
+
+ALTER TABLE revocation DROP COLUMN reason;
+-- +goose StatementEnd
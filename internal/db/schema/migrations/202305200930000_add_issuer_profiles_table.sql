@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE issuer_profiles
+(
+    identifier       text        NOT NULL,
+    display_name     text        NOT NULL DEFAULT '',
+    logo_url         text        NOT NULL DEFAULT '',
+    background_color text        NOT NULL DEFAULT '',
+    text_color       text        NOT NULL DEFAULT '',
+    contact_email    text        NOT NULL DEFAULT '',
+    contact_url      text        NOT NULL DEFAULT '',
+    updated_at       timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT issuer_profiles_pkey PRIMARY KEY (identifier),
+    CONSTRAINT issuer_profiles_identifier_fkey FOREIGN KEY (identifier) REFERENCES identities (identifier)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE issuer_profiles;
+-- +goose StatementEnd
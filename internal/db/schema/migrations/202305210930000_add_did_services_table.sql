@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE did_services
+(
+    identifier       text        NOT NULL,
+    service_id       text        NOT NULL,
+    type             text        NOT NULL,
+    service_endpoint text        NOT NULL,
+    updated_at       timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT did_services_pkey PRIMARY KEY (identifier, service_id),
+    CONSTRAINT did_services_identifier_fkey FOREIGN KEY (identifier) REFERENCES identities (identifier)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE did_services;
+-- +goose StatementEnd
@@ -0,0 +1,107 @@
+-- +goose Up
+-- +goose StatementBegin
+-- deleted_at marks the credentials and connections deleted by the operators. They are kept, hidden, until the purge
+-- job removes them once the retention window is over, and they can be restored until then.
+ALTER TABLE claims
+    ADD COLUMN deleted_at timestamptz NULL;
+ALTER TABLE connections
+    ADD COLUMN deleted_at timestamptz NULL;
+CREATE INDEX claims_deleted_at_idx ON claims (deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX connections_deleted_at_idx ON connections (deleted_at) WHERE deleted_at IS NOT NULL;
+
+-- changes_claims records the creation, revocation, update, deletion and restoration of a credential. The purge of a
+-- deleted credential is not a change, its deletion was already recorded.
+CREATE OR REPLACE FUNCTION changes_claims()
+    RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        INSERT INTO changes (issuer_id, entity, entity_id, operation) VALUES (NEW.identifier, 'credential', NEW.id, 'created');
+    ELSIF TG_OP = 'DELETE' THEN
+        IF OLD.deleted_at IS NULL THEN
+            INSERT INTO changes (issuer_id, entity, entity_id, operation) VALUES (OLD.identifier, 'credential', OLD.id, 'deleted');
+        END IF;
+    ELSIF NEW.deleted_at IS NOT NULL AND OLD.deleted_at IS NULL THEN
+        INSERT INTO changes (issuer_id, entity, entity_id, operation) VALUES (NEW.identifier, 'credential', NEW.id, 'deleted');
+    ELSIF NEW.deleted_at IS NULL AND OLD.deleted_at IS NOT NULL THEN
+        INSERT INTO changes (issuer_id, entity, entity_id, operation) VALUES (NEW.identifier, 'credential', NEW.id, 'restored');
+    ELSIF COALESCE(NEW.revoked, false) AND NOT COALESCE(OLD.revoked, false) THEN
+        INSERT INTO changes (issuer_id, entity, entity_id, operation) VALUES (NEW.identifier, 'credential', NEW.id, 'revoked');
+    ELSIF NEW IS DISTINCT FROM OLD THEN
+        INSERT INTO changes (issuer_id, entity, entity_id, operation) VALUES (NEW.identifier, 'credential', NEW.id, 'updated');
+    END IF;
+    RETURN NULL;
+END;
+$$
+language plpgsql;
+
+-- changes_connections records the creation, update, deletion and restoration of a connection. The purge of a
+-- deleted connection is not a change, its deletion was already recorded.
+CREATE OR REPLACE FUNCTION changes_connections()
+    RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        INSERT INTO changes (issuer_id, entity, entity_id, operation) VALUES (NEW.issuer_id, 'connection', NEW.id, 'created');
+    ELSIF TG_OP = 'DELETE' THEN
+        IF OLD.deleted_at IS NULL THEN
+            INSERT INTO changes (issuer_id, entity, entity_id, operation) VALUES (OLD.issuer_id, 'connection', OLD.id, 'deleted');
+        END IF;
+    ELSIF NEW.deleted_at IS NOT NULL AND OLD.deleted_at IS NULL THEN
+        INSERT INTO changes (issuer_id, entity, entity_id, operation) VALUES (NEW.issuer_id, 'connection', NEW.id, 'deleted');
+    ELSIF NEW.deleted_at IS NULL AND OLD.deleted_at IS NOT NULL THEN
+        INSERT INTO changes (issuer_id, entity, entity_id, operation) VALUES (NEW.issuer_id, 'connection', NEW.id, 'restored');
+    ELSIF NEW IS DISTINCT FROM OLD THEN
+        INSERT INTO changes (issuer_id, entity, entity_id, operation) VALUES (NEW.issuer_id, 'connection', NEW.id, 'updated');
+    END IF;
+    RETURN NULL;
+END;
+$$
+language plpgsql;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION changes_claims()
+    RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        INSERT INTO changes (issuer_id, entity, entity_id, operation) VALUES (NEW.identifier, 'credential', NEW.id, 'created');
+    ELSIF TG_OP = 'DELETE' THEN
+        INSERT INTO changes (issuer_id, entity, entity_id, operation) VALUES (OLD.identifier, 'credential', OLD.id, 'deleted');
+    ELSIF COALESCE(NEW.revoked, false) AND NOT COALESCE(OLD.revoked, false) THEN
+        INSERT INTO changes (issuer_id, entity, entity_id, operation) VALUES (NEW.identifier, 'credential', NEW.id, 'revoked');
+    ELSIF NEW IS DISTINCT FROM OLD THEN
+        INSERT INTO changes (issuer_id, entity, entity_id, operation) VALUES (NEW.identifier, 'credential', NEW.id, 'updated');
+    END IF;
+    RETURN NULL;
+END;
+$$
+language plpgsql;
+
+CREATE OR REPLACE FUNCTION changes_connections()
+    RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        INSERT INTO changes (issuer_id, entity, entity_id, operation) VALUES (NEW.issuer_id, 'connection', NEW.id, 'created');
+    ELSIF TG_OP = 'DELETE' THEN
+        INSERT INTO changes (issuer_id, entity, entity_id, operation) VALUES (OLD.issuer_id, 'connection', OLD.id, 'deleted');
+    ELSIF NEW IS DISTINCT FROM OLD THEN
+        INSERT INTO changes (issuer_id, entity, entity_id, operation) VALUES (NEW.issuer_id, 'connection', NEW.id, 'updated');
+    END IF;
+    RETURN NULL;
+END;
+$$
+language plpgsql;
+
+-- the deleted rows were already recorded as deleted in the feed
+ALTER TABLE claims DISABLE TRIGGER changes_claims;
+ALTER TABLE connections DISABLE TRIGGER changes_connections;
+DELETE FROM claims WHERE deleted_at IS NOT NULL;
+DELETE FROM connections WHERE deleted_at IS NOT NULL;
+ALTER TABLE claims ENABLE TRIGGER changes_claims;
+ALTER TABLE connections ENABLE TRIGGER changes_connections;
+
+DROP INDEX IF EXISTS connections_deleted_at_idx;
+DROP INDEX IF EXISTS claims_deleted_at_idx;
+ALTER TABLE connections DROP COLUMN deleted_at;
+ALTER TABLE claims DROP COLUMN deleted_at;
+-- +goose StatementEnd
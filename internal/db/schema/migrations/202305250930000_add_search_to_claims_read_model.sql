@@ -0,0 +1,120 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- claims_subject_fields returns the scalar fields of a credential subject as lowercase field=value lines between line
+-- breaks, so a field is matched with LIKE '%' || E'\n' || 'field=value' || E'\n%' using a trigram index
+CREATE OR REPLACE FUNCTION claims_subject_fields(subject jsonb)
+    RETURNS text AS $$
+    SELECT COALESCE(E'\n' || string_agg(lower(key) || '=' || lower(value), E'\n' ORDER BY key) || E'\n', '')
+    FROM jsonb_each_text(CASE WHEN jsonb_typeof(subject) = 'object' THEN subject ELSE '{}'::jsonb END)
+    WHERE jsonb_typeof(subject -> key) IN ('string', 'number', 'boolean')
+$$
+language sql IMMUTABLE;
+
+-- subject_words are the words of the values of the credential subject and subject_fields its fields, see
+-- claims_subject_fields
+ALTER TABLE claims_read_model
+    ADD COLUMN subject_words  tsvector NOT NULL DEFAULT to_tsvector(''::text),
+    ADD COLUMN subject_fields text     NOT NULL DEFAULT '';
+
+UPDATE claims_read_model
+SET subject_words  = jsonb_to_tsvector('simple', COALESCE(claims.data -> 'credentialSubject', '{}'::jsonb), '["string", "numeric"]'),
+    subject_fields = claims_subject_fields(claims.data -> 'credentialSubject')
+FROM claims
+WHERE claims.id = claims_read_model.id AND claims.identifier = claims_read_model.identifier;
+
+CREATE INDEX claims_read_model_subject_words ON claims_read_model USING gin (subject_words);
+CREATE INDEX claims_read_model_subject_fields_trgm ON claims_read_model USING gin (subject_fields gin_trgm_ops);
+CREATE INDEX claims_read_model_schema_type_trgm ON claims_read_model USING gin (schema_type gin_trgm_ops);
+CREATE INDEX claims_read_model_holder_did_trgm ON claims_read_model USING gin (holder_did gin_trgm_ops);
+CREATE INDEX connections_user_id_trgm ON connections USING gin (user_id gin_trgm_ops);
+CREATE INDEX connection_metadata_display_name_trgm ON connection_metadata USING gin (display_name gin_trgm_ops);
+
+-- claims_read_model_row upserts the read model row of a claim with the status of its state, the words of its schema
+-- and the words and fields of its subject
+CREATE OR REPLACE FUNCTION claims_read_model_row()
+    RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO claims_read_model (id, identifier, issuer, schema_hash, schema_type, holder_did, identity_state, status,
+                                   revoked, expiration, issuance_date, has_signature_proof, has_mtp_proof, mtp, ts_words,
+                                   subject_words, subject_fields)
+    VALUES (NEW.id, NEW.identifier, NEW.issuer, NEW.schema_hash, NEW.schema_type, NEW.other_identifier, NEW.identity_state,
+            (SELECT status FROM identity_states WHERE state = NEW.identity_state),
+            COALESCE(NEW.revoked, false),
+            COALESCE(NEW.expiration, 0),
+            (NEW.data ->> 'issuanceDate')::timestamptz,
+            NEW.signature_proof IS NOT NULL,
+            NEW.mtp_proof IS NOT NULL,
+            COALESCE(NEW.mtp, false),
+            COALESCE((SELECT ts_words FROM schemas WHERE issuer_id = NEW.issuer AND hash = NEW.schema_hash ORDER BY created_at DESC LIMIT 1), to_tsvector(''::text)),
+            jsonb_to_tsvector('simple', COALESCE(NEW.data -> 'credentialSubject', '{}'::jsonb), '["string", "numeric"]'),
+            claims_subject_fields(NEW.data -> 'credentialSubject'))
+    ON CONFLICT ON CONSTRAINT claims_read_model_pkey DO UPDATE SET
+        issuer = EXCLUDED.issuer,
+        schema_hash = EXCLUDED.schema_hash,
+        schema_type = EXCLUDED.schema_type,
+        holder_did = EXCLUDED.holder_did,
+        identity_state = EXCLUDED.identity_state,
+        status = EXCLUDED.status,
+        revoked = EXCLUDED.revoked,
+        expiration = EXCLUDED.expiration,
+        issuance_date = EXCLUDED.issuance_date,
+        has_signature_proof = EXCLUDED.has_signature_proof,
+        has_mtp_proof = EXCLUDED.has_mtp_proof,
+        mtp = EXCLUDED.mtp,
+        ts_words = EXCLUDED.ts_words,
+        subject_words = EXCLUDED.subject_words,
+        subject_fields = EXCLUDED.subject_fields;
+    RETURN NULL;
+END;
+$$
+language plpgsql;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION claims_read_model_row()
+    RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO claims_read_model (id, identifier, issuer, schema_hash, schema_type, holder_did, identity_state, status,
+                                   revoked, expiration, issuance_date, has_signature_proof, has_mtp_proof, mtp, ts_words)
+    VALUES (NEW.id, NEW.identifier, NEW.issuer, NEW.schema_hash, NEW.schema_type, NEW.other_identifier, NEW.identity_state,
+            (SELECT status FROM identity_states WHERE state = NEW.identity_state),
+            COALESCE(NEW.revoked, false),
+            COALESCE(NEW.expiration, 0),
+            (NEW.data ->> 'issuanceDate')::timestamptz,
+            NEW.signature_proof IS NOT NULL,
+            NEW.mtp_proof IS NOT NULL,
+            COALESCE(NEW.mtp, false),
+            COALESCE((SELECT ts_words FROM schemas WHERE issuer_id = NEW.issuer AND hash = NEW.schema_hash ORDER BY created_at DESC LIMIT 1), to_tsvector(''::text)))
+    ON CONFLICT ON CONSTRAINT claims_read_model_pkey DO UPDATE SET
+        issuer = EXCLUDED.issuer,
+        schema_hash = EXCLUDED.schema_hash,
+        schema_type = EXCLUDED.schema_type,
+        holder_did = EXCLUDED.holder_did,
+        identity_state = EXCLUDED.identity_state,
+        status = EXCLUDED.status,
+        revoked = EXCLUDED.revoked,
+        expiration = EXCLUDED.expiration,
+        issuance_date = EXCLUDED.issuance_date,
+        has_signature_proof = EXCLUDED.has_signature_proof,
+        has_mtp_proof = EXCLUDED.has_mtp_proof,
+        mtp = EXCLUDED.mtp,
+        ts_words = EXCLUDED.ts_words;
+    RETURN NULL;
+END;
+$$
+language plpgsql;
+
+DROP INDEX IF EXISTS connection_metadata_display_name_trgm;
+DROP INDEX IF EXISTS connections_user_id_trgm;
+DROP INDEX IF EXISTS claims_read_model_holder_did_trgm;
+DROP INDEX IF EXISTS claims_read_model_schema_type_trgm;
+DROP INDEX IF EXISTS claims_read_model_subject_fields_trgm;
+DROP INDEX IF EXISTS claims_read_model_subject_words;
+ALTER TABLE claims_read_model
+    DROP COLUMN subject_fields,
+    DROP COLUMN subject_words;
+DROP FUNCTION IF EXISTS claims_subject_fields(jsonb);
+-- +goose StatementEnd
@@ -0,0 +1,95 @@
+-- +goose Up
+-- +goose StatementBegin
+-- display is the part of a credential shown by the listings that does not change once it is issued, written by the
+-- node when the credential is saved. It is null for the credentials saved before, which the listings convert on read.
+ALTER TABLE claims
+    ADD COLUMN display jsonb NULL;
+ALTER TABLE claims_read_model
+    ADD COLUMN display jsonb NULL;
+
+-- claims_read_model_row upserts the read model row of a claim with the status of its state, the words of its schema,
+-- the words and fields of its subject and its display
+CREATE OR REPLACE FUNCTION claims_read_model_row()
+    RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO claims_read_model (id, identifier, issuer, schema_hash, schema_type, holder_did, identity_state, status,
+                                   revoked, expiration, issuance_date, has_signature_proof, has_mtp_proof, mtp, ts_words,
+                                   subject_words, subject_fields, display)
+    VALUES (NEW.id, NEW.identifier, NEW.issuer, NEW.schema_hash, NEW.schema_type, NEW.other_identifier, NEW.identity_state,
+            (SELECT status FROM identity_states WHERE state = NEW.identity_state),
+            COALESCE(NEW.revoked, false),
+            COALESCE(NEW.expiration, 0),
+            (NEW.data ->> 'issuanceDate')::timestamptz,
+            NEW.signature_proof IS NOT NULL,
+            NEW.mtp_proof IS NOT NULL,
+            COALESCE(NEW.mtp, false),
+            COALESCE((SELECT ts_words FROM schemas WHERE issuer_id = NEW.issuer AND hash = NEW.schema_hash ORDER BY created_at DESC LIMIT 1), to_tsvector(''::text)),
+            jsonb_to_tsvector('simple', COALESCE(NEW.data -> 'credentialSubject', '{}'::jsonb), '["string", "numeric"]'),
+            claims_subject_fields(NEW.data -> 'credentialSubject'),
+            NEW.display)
+    ON CONFLICT ON CONSTRAINT claims_read_model_pkey DO UPDATE SET
+        issuer = EXCLUDED.issuer,
+        schema_hash = EXCLUDED.schema_hash,
+        schema_type = EXCLUDED.schema_type,
+        holder_did = EXCLUDED.holder_did,
+        identity_state = EXCLUDED.identity_state,
+        status = EXCLUDED.status,
+        revoked = EXCLUDED.revoked,
+        expiration = EXCLUDED.expiration,
+        issuance_date = EXCLUDED.issuance_date,
+        has_signature_proof = EXCLUDED.has_signature_proof,
+        has_mtp_proof = EXCLUDED.has_mtp_proof,
+        mtp = EXCLUDED.mtp,
+        ts_words = EXCLUDED.ts_words,
+        subject_words = EXCLUDED.subject_words,
+        subject_fields = EXCLUDED.subject_fields,
+        display = EXCLUDED.display;
+    RETURN NULL;
+END;
+$$
+language plpgsql;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION claims_read_model_row()
+    RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO claims_read_model (id, identifier, issuer, schema_hash, schema_type, holder_did, identity_state, status,
+                                   revoked, expiration, issuance_date, has_signature_proof, has_mtp_proof, mtp, ts_words,
+                                   subject_words, subject_fields)
+    VALUES (NEW.id, NEW.identifier, NEW.issuer, NEW.schema_hash, NEW.schema_type, NEW.other_identifier, NEW.identity_state,
+            (SELECT status FROM identity_states WHERE state = NEW.identity_state),
+            COALESCE(NEW.revoked, false),
+            COALESCE(NEW.expiration, 0),
+            (NEW.data ->> 'issuanceDate')::timestamptz,
+            NEW.signature_proof IS NOT NULL,
+            NEW.mtp_proof IS NOT NULL,
+            COALESCE(NEW.mtp, false),
+            COALESCE((SELECT ts_words FROM schemas WHERE issuer_id = NEW.issuer AND hash = NEW.schema_hash ORDER BY created_at DESC LIMIT 1), to_tsvector(''::text)),
+            jsonb_to_tsvector('simple', COALESCE(NEW.data -> 'credentialSubject', '{}'::jsonb), '["string", "numeric"]'),
+            claims_subject_fields(NEW.data -> 'credentialSubject'))
+    ON CONFLICT ON CONSTRAINT claims_read_model_pkey DO UPDATE SET
+        issuer = EXCLUDED.issuer,
+        schema_hash = EXCLUDED.schema_hash,
+        schema_type = EXCLUDED.schema_type,
+        holder_did = EXCLUDED.holder_did,
+        identity_state = EXCLUDED.identity_state,
+        status = EXCLUDED.status,
+        revoked = EXCLUDED.revoked,
+        expiration = EXCLUDED.expiration,
+        issuance_date = EXCLUDED.issuance_date,
+        has_signature_proof = EXCLUDED.has_signature_proof,
+        has_mtp_proof = EXCLUDED.has_mtp_proof,
+        mtp = EXCLUDED.mtp,
+        ts_words = EXCLUDED.ts_words,
+        subject_words = EXCLUDED.subject_words,
+        subject_fields = EXCLUDED.subject_fields;
+    RETURN NULL;
+END;
+$$
+language plpgsql;
+
+ALTER TABLE claims_read_model DROP COLUMN display;
+ALTER TABLE claims DROP COLUMN display;
+-- +goose StatementEnd
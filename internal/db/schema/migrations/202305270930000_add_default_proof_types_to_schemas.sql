@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+-- default_proof_types are the proof types of the credentials of the schema requested without them. NULL applies the
+-- default ones of the proof policy
+ALTER TABLE schemas
+    ADD COLUMN default_proof_types text[];
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE schemas
+    DROP COLUMN default_proof_types;
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+-- subject_external_id is the placeholder subject of the link, e.g. an email, bound to the holder that claims it
+ALTER TABLE links ADD COLUMN subject_external_id text;
+
+CREATE TABLE subject_bindings
+(
+    issuer_id   text        NOT NULL,
+    external_id text        NOT NULL,
+    holder_id   text        NOT NULL,
+    link_id     uuid,
+    created_at  timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT subject_bindings_pkey PRIMARY KEY (issuer_id, external_id),
+    CONSTRAINT subject_bindings_issuer_id_fkey FOREIGN KEY (issuer_id) REFERENCES identities (identifier),
+    CONSTRAINT subject_bindings_link_id_fkey FOREIGN KEY (link_id) REFERENCES links (id) ON DELETE SET NULL
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS subject_bindings;
+ALTER TABLE links DROP COLUMN IF EXISTS subject_external_id;
+-- +goose StatementEnd
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE offer_emails
+(
+    id         uuid        NOT NULL,
+    issuer_id  text        NOT NULL,
+    claim_id   uuid        NOT NULL,
+    recipient  text        NOT NULL,
+    status     text        NOT NULL,
+    error      text,
+    sent_at    timestamptz,
+    opened_at  timestamptz,
+    clicked_at timestamptz,
+    created_at timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT offer_emails_pkey PRIMARY KEY (id),
+    CONSTRAINT offer_emails_claim_id_fkey FOREIGN KEY (claim_id) REFERENCES claims (id) ON DELETE CASCADE
+);
+CREATE INDEX offer_emails_claim_id ON offer_emails (claim_id);
+-- the claims of the offer emails are the credential offers consumed after the email was sent
+CREATE INDEX credential_offers_claim_id_consumed_at ON credential_offers (claim_id, consumed_at) WHERE consumed_at IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS credential_offers_claim_id_consumed_at;
+DROP TABLE IF EXISTS offer_emails;
+-- +goose StatementEnd
@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- allowed_dids are the only holders that can claim the credential of the link, any holder when null
+ALTER TABLE links ADD COLUMN allowed_dids text[];
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE links DROP COLUMN IF EXISTS allowed_dids;
+-- +goose StatementEnd
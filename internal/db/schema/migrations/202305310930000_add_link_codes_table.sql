@@ -0,0 +1,39 @@
+-- +goose Up
+-- +goose StatementBegin
+-- code_protected links are only claimed with one of their one-time codes
+ALTER TABLE links ADD COLUMN code_protected boolean NOT NULL DEFAULT false;
+
+-- link_codes are the one-time codes of the links, handed out to the holders out of band. Only a hash of the codes is
+-- stored. The session is the claim session the code was entered in, and the code is used once its credential is issued.
+CREATE TABLE link_codes
+(
+    id         uuid        NOT NULL,
+    link_id    uuid        NOT NULL,
+    code_hash  text        NOT NULL,
+    session_id uuid        NULL,
+    holder_id  text        NULL,
+    created_at timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    used_at    timestamptz NULL,
+    CONSTRAINT link_codes_pkey PRIMARY KEY (id),
+    CONSTRAINT link_codes_link_id_fkey FOREIGN KEY (link_id) REFERENCES links (id) ON DELETE CASCADE
+);
+CREATE UNIQUE INDEX link_codes_link_id_code_hash_key ON link_codes (link_id, code_hash);
+
+-- link_code_failures are the wrong codes entered in the links, to limit the attempts to guess them
+CREATE TABLE link_code_failures
+(
+    id         bigserial   NOT NULL,
+    link_id    uuid        NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT link_code_failures_pkey PRIMARY KEY (id),
+    CONSTRAINT link_code_failures_link_id_fkey FOREIGN KEY (link_id) REFERENCES links (id) ON DELETE CASCADE
+);
+CREATE INDEX link_code_failures_link_id_created_at ON link_code_failures (link_id, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS link_code_failures;
+DROP TABLE IF EXISTS link_codes;
+ALTER TABLE links DROP COLUMN IF EXISTS code_protected;
+-- +goose StatementEnd
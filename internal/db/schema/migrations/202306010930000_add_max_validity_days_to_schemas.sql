@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+-- max_validity_days is the longest time the credentials of the schema are valid since they are issued. NULL lets them
+-- be issued with any expiration, or without it
+ALTER TABLE schemas
+    ADD COLUMN max_validity_days integer;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE schemas
+    DROP COLUMN max_validity_days;
+-- +goose StatementEnd
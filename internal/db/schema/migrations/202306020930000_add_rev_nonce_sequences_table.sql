@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+-- rev_nonce_sequences has the last revocation nonce taken by each issuer with the sequential nonce strategy
+CREATE TABLE rev_nonce_sequences
+(
+    identifier text PRIMARY KEY,
+    last_nonce bigint NOT NULL
+);
+
+-- the nonces of the new credentials are checked to be free, and the credentials are looked up by their nonce. It is
+-- not unique, as the issuers created before the check may already have duplicated nonces.
+CREATE INDEX claims_identifier_rev_nonce ON claims (identifier, rev_nonce);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS claims_identifier_rev_nonce;
+DROP TABLE IF EXISTS rev_nonce_sequences;
+-- +goose StatementEnd
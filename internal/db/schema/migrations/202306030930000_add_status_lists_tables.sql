@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+-- status_lists has the last status list index taken by each issuer
+CREATE TABLE status_lists
+(
+    identifier text PRIMARY KEY,
+    last_index bigint NOT NULL
+);
+
+-- status_list_entries are the indexes of the credentials in the status list of their issuer
+CREATE TABLE status_list_entries
+(
+    identifier   text   NOT NULL,
+    claim_id     uuid   NOT NULL,
+    status_index bigint NOT NULL,
+    CONSTRAINT status_list_entries_pkey PRIMARY KEY (identifier, status_index),
+    CONSTRAINT status_list_entries_claim_id_key UNIQUE (claim_id),
+    CONSTRAINT status_list_entries_claim_id_fkey FOREIGN KEY (claim_id) REFERENCES claims (id) ON DELETE CASCADE
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS status_list_entries;
+DROP TABLE IF EXISTS status_lists;
+-- +goose StatementEnd
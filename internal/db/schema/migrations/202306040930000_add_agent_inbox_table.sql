@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+-- agent_inbox has the messages received by the agent of each issuer, with their outcome
+CREATE TABLE agent_inbox
+(
+    id            uuid        NOT NULL,
+    issuer_id     text        NOT NULL,
+    sender        text        NOT NULL DEFAULT '',
+    message_id    text        NOT NULL DEFAULT '',
+    thread_id     text        NOT NULL DEFAULT '',
+    type          text        NOT NULL DEFAULT '',
+    media_type    text        NOT NULL DEFAULT '',
+    status        text        NOT NULL,
+    error         text        NOT NULL DEFAULT '',
+    response_type text        NOT NULL DEFAULT '',
+    envelope      bytea       NOT NULL,
+    created_at    timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT agent_inbox_pkey PRIMARY KEY (id),
+    CONSTRAINT agent_inbox_issuer_id_fkey FOREIGN KEY (issuer_id) REFERENCES identities (identifier) ON DELETE CASCADE
+);
+CREATE INDEX agent_inbox_issuer_id_created_at ON agent_inbox (issuer_id, created_at DESC);
+CREATE INDEX agent_inbox_created_at ON agent_inbox (created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS agent_inbox;
+-- +goose StatementEnd
@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+-- problem_reports are the problem-report messages exchanged by the agent with the wallets, with the credential and
+-- the connection they relate to
+CREATE TABLE problem_reports
+(
+    id            uuid        NOT NULL,
+    issuer_id     text        NOT NULL,
+    direction     text        NOT NULL,
+    holder        text        NOT NULL DEFAULT '',
+    message_id    text        NOT NULL DEFAULT '',
+    thread_id     text        NOT NULL DEFAULT '',
+    code          text        NOT NULL,
+    comment       text        NOT NULL DEFAULT '',
+    claim_id      uuid        NULL,
+    connection_id uuid        NULL,
+    created_at    timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT problem_reports_pkey PRIMARY KEY (id),
+    CONSTRAINT problem_reports_issuer_id_fkey FOREIGN KEY (issuer_id) REFERENCES identities (identifier) ON DELETE CASCADE,
+    CONSTRAINT problem_reports_claim_id_fkey FOREIGN KEY (claim_id) REFERENCES claims (id) ON DELETE CASCADE,
+    CONSTRAINT problem_reports_connection_id_fkey FOREIGN KEY (connection_id) REFERENCES connections (id) ON DELETE SET NULL
+);
+CREATE INDEX problem_reports_claim_id ON problem_reports (claim_id);
+CREATE INDEX problem_reports_connection_id ON problem_reports (connection_id);
+CREATE INDEX problem_reports_thread_id ON problem_reports (issuer_id, thread_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS problem_reports;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- settings has the operational settings overridden with the admin API. It has a single row, the overrides are the
+-- json of domain.SettingsOverrides
+CREATE TABLE settings
+(
+    id         boolean     NOT NULL DEFAULT true,
+    overrides  jsonb       NOT NULL,
+    updated_at timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT settings_pkey PRIMARY KEY (id),
+    CONSTRAINT settings_single_row CHECK (id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS settings;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- the keys are scoped by the user that sent the request, so two users cannot read or block the requests of each other
+ALTER TABLE idempotency_keys
+    ADD COLUMN principal text NOT NULL DEFAULT '';
+ALTER TABLE idempotency_keys DROP CONSTRAINT idempotency_keys_pkey;
+ALTER TABLE idempotency_keys
+    ADD CONSTRAINT idempotency_keys_pkey PRIMARY KEY (principal, key);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM idempotency_keys;
+ALTER TABLE idempotency_keys DROP CONSTRAINT idempotency_keys_pkey;
+ALTER TABLE idempotency_keys DROP COLUMN principal;
+ALTER TABLE idempotency_keys
+    ADD CONSTRAINT idempotency_keys_pkey PRIMARY KEY (key);
+-- +goose StatementEnd
@@ -0,0 +1,112 @@
+-- +goose Up
+-- +goose StatementBegin
+-- rev_nonce, deleted_at and subject are the columns of the claims the listings show or filter by, so the listings read
+-- the read model alone
+ALTER TABLE claims_read_model
+    ADD COLUMN rev_nonce  numeric     NULL,
+    ADD COLUMN deleted_at timestamptz NULL,
+    ADD COLUMN subject    jsonb       NULL;
+
+UPDATE claims_read_model
+SET rev_nonce  = claims.rev_nonce,
+    deleted_at = claims.deleted_at,
+    subject    = claims.data -> 'credentialSubject'
+FROM claims
+WHERE claims.id = claims_read_model.id AND claims.identifier = claims_read_model.identifier;
+
+-- claims_read_model_row upserts the read model row of a claim with the status of its state, the words of its schema,
+-- the words and fields of its subject, its display and the rest of the columns of the listings
+CREATE OR REPLACE FUNCTION claims_read_model_row()
+    RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO claims_read_model (id, identifier, issuer, schema_hash, schema_type, holder_did, identity_state, status,
+                                   revoked, expiration, issuance_date, has_signature_proof, has_mtp_proof, mtp, ts_words,
+                                   subject_words, subject_fields, display, rev_nonce, deleted_at, subject)
+    VALUES (NEW.id, NEW.identifier, NEW.issuer, NEW.schema_hash, NEW.schema_type, NEW.other_identifier, NEW.identity_state,
+            (SELECT status FROM identity_states WHERE state = NEW.identity_state),
+            COALESCE(NEW.revoked, false),
+            COALESCE(NEW.expiration, 0),
+            claims_issuance_date(NEW.data),
+            NEW.signature_proof IS NOT NULL,
+            NEW.mtp_proof IS NOT NULL,
+            COALESCE(NEW.mtp, false),
+            COALESCE((SELECT ts_words FROM schemas WHERE issuer_id = NEW.issuer AND hash = NEW.schema_hash ORDER BY created_at DESC LIMIT 1), to_tsvector(''::text)),
+            jsonb_to_tsvector('simple', COALESCE(NEW.data -> 'credentialSubject', '{}'::jsonb), '["string", "numeric"]'),
+            claims_subject_fields(NEW.data -> 'credentialSubject'),
+            NEW.display,
+            NEW.rev_nonce,
+            NEW.deleted_at,
+            NEW.data -> 'credentialSubject')
+    ON CONFLICT ON CONSTRAINT claims_read_model_pkey DO UPDATE SET
+        issuer = EXCLUDED.issuer,
+        schema_hash = EXCLUDED.schema_hash,
+        schema_type = EXCLUDED.schema_type,
+        holder_did = EXCLUDED.holder_did,
+        identity_state = EXCLUDED.identity_state,
+        status = EXCLUDED.status,
+        revoked = EXCLUDED.revoked,
+        expiration = EXCLUDED.expiration,
+        issuance_date = EXCLUDED.issuance_date,
+        has_signature_proof = EXCLUDED.has_signature_proof,
+        has_mtp_proof = EXCLUDED.has_mtp_proof,
+        mtp = EXCLUDED.mtp,
+        ts_words = EXCLUDED.ts_words,
+        subject_words = EXCLUDED.subject_words,
+        subject_fields = EXCLUDED.subject_fields,
+        display = EXCLUDED.display,
+        rev_nonce = EXCLUDED.rev_nonce,
+        deleted_at = EXCLUDED.deleted_at,
+        subject = EXCLUDED.subject;
+    RETURN NULL;
+END;
+$$
+language plpgsql;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION claims_read_model_row()
+    RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO claims_read_model (id, identifier, issuer, schema_hash, schema_type, holder_did, identity_state, status,
+                                   revoked, expiration, issuance_date, has_signature_proof, has_mtp_proof, mtp, ts_words,
+                                   subject_words, subject_fields, display)
+    VALUES (NEW.id, NEW.identifier, NEW.issuer, NEW.schema_hash, NEW.schema_type, NEW.other_identifier, NEW.identity_state,
+            (SELECT status FROM identity_states WHERE state = NEW.identity_state),
+            COALESCE(NEW.revoked, false),
+            COALESCE(NEW.expiration, 0),
+            claims_issuance_date(NEW.data),
+            NEW.signature_proof IS NOT NULL,
+            NEW.mtp_proof IS NOT NULL,
+            COALESCE(NEW.mtp, false),
+            COALESCE((SELECT ts_words FROM schemas WHERE issuer_id = NEW.issuer AND hash = NEW.schema_hash ORDER BY created_at DESC LIMIT 1), to_tsvector(''::text)),
+            jsonb_to_tsvector('simple', COALESCE(NEW.data -> 'credentialSubject', '{}'::jsonb), '["string", "numeric"]'),
+            claims_subject_fields(NEW.data -> 'credentialSubject'),
+            NEW.display)
+    ON CONFLICT ON CONSTRAINT claims_read_model_pkey DO UPDATE SET
+        issuer = EXCLUDED.issuer,
+        schema_hash = EXCLUDED.schema_hash,
+        schema_type = EXCLUDED.schema_type,
+        holder_did = EXCLUDED.holder_did,
+        identity_state = EXCLUDED.identity_state,
+        status = EXCLUDED.status,
+        revoked = EXCLUDED.revoked,
+        expiration = EXCLUDED.expiration,
+        issuance_date = EXCLUDED.issuance_date,
+        has_signature_proof = EXCLUDED.has_signature_proof,
+        has_mtp_proof = EXCLUDED.has_mtp_proof,
+        mtp = EXCLUDED.mtp,
+        ts_words = EXCLUDED.ts_words,
+        subject_words = EXCLUDED.subject_words,
+        subject_fields = EXCLUDED.subject_fields,
+        display = EXCLUDED.display;
+    RETURN NULL;
+END;
+$$
+language plpgsql;
+
+ALTER TABLE claims_read_model
+    DROP COLUMN subject,
+    DROP COLUMN deleted_at,
+    DROP COLUMN rev_nonce;
+-- +goose StatementEnd
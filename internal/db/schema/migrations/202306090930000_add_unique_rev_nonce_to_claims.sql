@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+-- the revocation nonce of a new credential is checked to be free before it is saved, so two credentials issued at the
+-- same time could still take the same nonce. The unique index rejects the second one. The nonces duplicated before
+-- the index are left out of it, as revoking them would revoke every credential sharing the nonce anyway.
+DO $$
+DECLARE
+    duplicated uuid[];
+BEGIN
+    SELECT coalesce(array_agg(id), '{}')
+    INTO duplicated
+    FROM (SELECT id, row_number() OVER (PARTITION BY identifier, rev_nonce ORDER BY id) AS position
+          FROM claims) AS numbered
+    WHERE position > 1;
+
+    IF cardinality(duplicated) = 0 THEN
+        CREATE UNIQUE INDEX claims_identifier_rev_nonce_key ON claims (identifier, rev_nonce);
+    ELSE
+        EXECUTE format('CREATE UNIQUE INDEX claims_identifier_rev_nonce_key ON claims (identifier, rev_nonce) WHERE id <> ALL (%L::uuid[])',
+                       duplicated);
+    END IF;
+END
+$$;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS claims_identifier_rev_nonce_key;
+-- +goose StatementEnd
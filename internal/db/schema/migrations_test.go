@@ -0,0 +1,27 @@
+package schema
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestMigrationsOrder checks that goose, which sorts the migrations by their numeric version, applies them in the
+// order of their file names. A migration with fewer digits than the one before it would run before the tables it
+// depends on exist, like the foreign keys to connections before its primary key.
+func TestMigrationsOrder(t *testing.T) {
+	entries, err := embedMigrations.ReadDir("migrations")
+	require.NoError(t, err)
+	require.NotEmpty(t, entries)
+
+	var previous int64
+	for _, entry := range entries {
+		version, err := strconv.ParseInt(strings.SplitN(entry.Name(), "_", 2)[0], 10, 64)
+		require.NoError(t, err, entry.Name())
+		assert.Greater(t, version, previous, "%s sorts before the migration that precedes it", entry.Name())
+		previous = version
+	}
+}
@@ -16,26 +16,86 @@ var embedMigrations embed.FS
 
 // Migrate runs migrations on the databaseURL
 func Migrate(databaseURL string) error {
-	var db *sql.DB
-	// setup database
-	db, err := sql.Open("postgres", databaseURL)
+	db, err := open(databaseURL)
+	if err != nil {
+		return err
+	}
+	defer closeDB(db)
+
+	if err := goose.Up(db, "migrations"); err != nil {
+		return fmt.Errorf("error trying to run migrations: %w", err)
+	}
+
+	return nil
+}
+
+// Pending returns the versions of the migrations that are not applied to the database of databaseURL yet, including
+// the ones with a version below the last applied one
+func Pending(databaseURL string) ([]int64, error) {
+	db, err := open(databaseURL)
 	if err != nil {
-		return fmt.Errorf("error open connection with database: %w", err)
+		return nil, err
 	}
-	defer func() {
-		if err := db.Close(); err != nil {
-			log.Error(context.Background(), "closing database", "err", err)
+	defer closeDB(db)
+
+	if _, err := goose.EnsureDBVersion(db); err != nil {
+		return nil, fmt.Errorf("error getting the database version: %w", err)
+	}
+	applied, err := appliedVersions(db)
+	if err != nil {
+		return nil, fmt.Errorf("error getting the applied migrations: %w", err)
+	}
+	migrations, err := goose.CollectMigrations("migrations", 0, goose.MaxVersion)
+	if err != nil {
+		return nil, fmt.Errorf("error collecting migrations: %w", err)
+	}
+	pending := make([]int64, 0)
+	for _, migration := range migrations {
+		if !applied[migration.Version] {
+			pending = append(pending, migration.Version)
 		}
-	}()
+	}
+	return pending, nil
+}
+
+// appliedVersions returns the versions recorded in the database. As goose does, the last row of a version tells
+// whether it is applied.
+func appliedVersions(db *sql.DB) (map[int64]bool, error) {
+	rows, err := db.Query(fmt.Sprintf(`
+		SELECT DISTINCT ON (version_id) version_id, is_applied FROM %s ORDER BY version_id, id DESC`, goose.TableName()))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	applied := make(map[int64]bool)
+	for rows.Next() {
+		var version int64
+		var isApplied bool
+		if err := rows.Scan(&version, &isApplied); err != nil {
+			return nil, err
+		}
+		applied[version] = isApplied
+	}
+	return applied, rows.Err()
+}
+
+func open(databaseURL string) (*sql.DB, error) {
+	db, err := sql.Open("postgres", databaseURL)
+	if err != nil {
+		return nil, fmt.Errorf("error open connection with database: %w", err)
+	}
 
 	goose.SetBaseFS(embedMigrations)
 	if err := goose.SetDialect("postgres"); err != nil {
-		return fmt.Errorf("error setting dialect: %w", err)
+		closeDB(db)
+		return nil, fmt.Errorf("error setting dialect: %w", err)
 	}
+	return db, nil
+}
 
-	if err := goose.Up(db, "migrations"); err != nil {
-		return fmt.Errorf("error trying to run migrations: %w", err)
+func closeDB(db *sql.DB) {
+	if err := db.Close(); err != nil {
+		log.Error(context.Background(), "closing database", "err", err)
 	}
-
-	return nil
 }
@@ -2,33 +2,104 @@ package db
 
 import (
 	"context"
+	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
 )
 
-// Storage defines the postgres storage
+// Storage defines the postgres storage.
+// Replica is a read replica of the database for the listings, which can be behind the primary by the replication lag.
+// The writes and the reads that must see them use Pgx. Replica is Pgx when the node has no replica.
 type Storage struct {
-	Pgx *pgxpool.Pool
+	Pgx            *pgxpool.Pool
+	Replica        *pgxpool.Pool
+	listingTimeout time.Duration
+	queryTimeouts  *prometheus.CounterVec
 }
 
-// NewStorage creates and returns a new Pgx storage connection
-func NewStorage(connectionString string) (*Storage, error) {
-	pgxConn, err := pgxpool.Connect(context.Background(), connectionString)
+// NewStorage creates and returns a new Pgx storage connection with the pool settings of cfg.
+// The database server cancels the statements that run longer than cfg.StatementTimeout, so a slow query cannot hold a
+// connection of the pool forever. A zero StatementTimeout keeps the server default.
+func NewStorage(connectionString string, cfg config.Database) (*Storage, error) {
+	pgxConn, err := connect(connectionString, cfg)
 	if err != nil {
 		return nil, err
 	}
 	return &Storage{
-		Pgx: pgxConn,
+		Pgx:            pgxConn,
+		Replica:        pgxConn,
+		listingTimeout: cfg.ListingTimeout,
+		queryTimeouts:  newQueryTimeoutsCounter(),
 	}, nil
 }
 
-// Ping is a wrapper for Pgx Ping
+// ConnectReplica connects the storage to a read replica of the database. It has to be called before the storage is
+// passed to the repositories, they keep a copy of it.
+func (s *Storage) ConnectReplica(connectionString string, cfg config.Database) error {
+	replica, err := connect(connectionString, cfg)
+	if err != nil {
+		return err
+	}
+	s.Replica = replica
+	return nil
+}
+
+func connect(connectionString string, cfg config.Database) (*pgxpool.Pool, error) {
+	poolCfg, err := pgxpool.ParseConfig(connectionString)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.StatementTimeout > 0 {
+		poolCfg.ConnConfig.RuntimeParams["statement_timeout"] = strconv.FormatInt(cfg.StatementTimeout.Milliseconds(), 10)
+	}
+	if cfg.MaxConns > 0 {
+		poolCfg.MaxConns = cfg.MaxConns
+	}
+	if cfg.MinConns > 0 {
+		poolCfg.MinConns = cfg.MinConns
+	}
+	if cfg.MaxConnLifetime > 0 {
+		poolCfg.MaxConnLifetime = cfg.MaxConnLifetime
+	}
+	if cfg.MaxConnIdleTime > 0 {
+		poolCfg.MaxConnIdleTime = cfg.MaxConnIdleTime
+	}
+	if poolCfg.MinConns > poolCfg.MaxConns {
+		return nil, fmt.Errorf("the pool min conns %d are more than its max conns %d", poolCfg.MinConns, poolCfg.MaxConns)
+	}
+	return pgxpool.ConnectConfig(context.Background(), poolCfg)
+}
+
+// ListingContext returns a context that is cancelled after the listing timeout, for the listings and searches, so a
+// slow one can't hold the connections the writes need. Its cancel function must be called.
+func (s *Storage) ListingContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if s.listingTimeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, s.listingTimeout)
+}
+
+// Ping is a wrapper for Pgx Ping, the replica is pinged too if there is one
 func (s *Storage) Ping(ctx context.Context) error {
-	return s.Pgx.Ping(ctx)
+	if err := s.Pgx.Ping(ctx); err != nil {
+		return err
+	}
+	if s.Replica != s.Pgx {
+		return s.Replica.Ping(ctx)
+	}
+	return nil
 }
 
 // Close all connections to database
 func (s *Storage) Close() error {
+	if s.Replica != s.Pgx {
+		s.Replica.Close()
+	}
 	s.Pgx.Close()
 	return nil
 }
@@ -3,6 +3,7 @@ package tests
 import (
 	"context"
 	"fmt"
+	"math/rand"
 	"testing"
 	"time"
 
@@ -57,8 +58,7 @@ func (f *Fixture) NewClaim(t *testing.T, identity string) *domain.Claim {
 	claimID, err := uuid.NewUUID()
 	assert.NoError(t, err)
 
-	nonce := int64(123)
-	revNonce := domain.RevNonceUint64(nonce)
+	revNonce := domain.RevNonceUint64(rand.Int63())
 	claim := &domain.Claim{
 		ID:              claimID,
 		Identifier:      &identity,
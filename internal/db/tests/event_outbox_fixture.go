@@ -0,0 +1,21 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// CountNotifications returns the number of notifications of the topic written in the outbox for the issuer
+func (f *Fixture) CountNotifications(t *testing.T, topic string, issuerID string) int {
+	t.Helper()
+	var count int
+	err := f.storage.Pgx.QueryRow(context.Background(), `
+		SELECT count(*) FROM event_outbox WHERE destination = $1 AND event_type = $2 AND issuer_id = $3`,
+		domain.OutboxPubSub, topic, issuerID).Scan(&count)
+	assert.NoError(t, err)
+	return count
+}
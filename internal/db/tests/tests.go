@@ -32,7 +32,7 @@ func NewTestStorage(cfg *config.Configuration) (*db.Storage, func(), error) {
 	ctx, cancel := context.WithTimeout(context.Background(), defaultTimeOut*time.Second)
 	defer cancel()
 
-	storage, err := db.NewStorage(cfg.Database.URL)
+	storage, err := db.NewStorage(cfg.Database.URL, cfg.Database)
 	if err != nil {
 		return nil, noopTeardown, fmt.Errorf("can't connect to database: %v", err)
 	}
@@ -52,7 +52,7 @@ func NewTestStorage(cfg *config.Configuration) (*db.Storage, func(), error) {
 
 	_ = storage.Close()
 
-	storage, err = db.NewStorage(tempURL.String())
+	storage, err = db.NewStorage(tempURL.String(), cfg.Database)
 	if err != nil {
 		return nil, noopTeardown, fmt.Errorf("can't connect to database: %v", err)
 	}
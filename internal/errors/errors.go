@@ -1,6 +1,13 @@
 package errors
 
-import "net/http"
+import (
+	stderr "errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
 
 // AuthError is a special error type used to signal an authorization error
 type AuthError struct {
@@ -12,6 +19,17 @@ func (a AuthError) Error() string {
 	return a.Err.Error()
 }
 
+// ReadOnlyError is a special error type used to signal that the identity can't be changed for a while
+type ReadOnlyError struct {
+	Err        error
+	RetryAfter time.Duration
+}
+
+// Error satisfies error interface for ReadOnlyError
+func (e ReadOnlyError) Error() string {
+	return e.Err.Error()
+}
+
 // RequestErrorHandlerFunc is a Request Error Handler that can be injected in oapi-codegen to handler errors in requests
 func RequestErrorHandlerFunc(w http.ResponseWriter, _ *http.Request, err error) {
 	http.Error(w, err.Error(), http.StatusBadRequest)
@@ -19,13 +37,23 @@ func RequestErrorHandlerFunc(w http.ResponseWriter, _ *http.Request, err error)
 
 // ResponseErrorHandlerFunc is a Response Error Handler that can be injected in oapi-codegen to handler errors in requests
 // We use it to create custom responses to some errors that may occur, like an authentication error.
+// A database query timeout is a 503, the listing can be retried when the database is less busy.
 func ResponseErrorHandlerFunc(w http.ResponseWriter, _ *http.Request, err error) {
 	w.Header().Add("Content-Type", "application/json")
-	switch err.(type) {
+	if stderr.Is(err, db.ErrQueryTimeout) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte(strconv.Quote(err.Error())))
+		return
+	}
+	switch e := err.(type) {
 	case AuthError:
 		w.WriteHeader(http.StatusUnauthorized)
 		w.Header().Add("WWW-Authenticate", `Basic realm="restricted", charset="UTF-8"`)
 		_, _ = w.Write([]byte("\"Unauthorized\""))
+	case ReadOnlyError:
+		w.Header().Add("Retry-After", strconv.Itoa(int(e.RetryAfter.Seconds())))
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte(strconv.Quote(e.Error())))
 	default:
 		w.WriteHeader(http.StatusInternalServerError)
 		_, _ = w.Write([]byte(err.Error()))
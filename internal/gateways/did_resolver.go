@@ -0,0 +1,79 @@
+package gateways
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+)
+
+// maxDIDResolutionSize limits the response read from the DID resolver
+const maxDIDResolutionSize = 1024 * 1024
+
+// didResolutionResponse is the body of the answers of a universal resolver
+type didResolutionResponse struct {
+	DIDDocument           json.RawMessage `json:"didDocument"`
+	DIDResolutionMetadata struct {
+		Error string `json:"error"`
+	} `json:"didResolutionMetadata"`
+	DIDDocumentMetadata struct {
+		Deactivated bool `json:"deactivated"`
+	} `json:"didDocumentMetadata"`
+}
+
+// DIDResolverClient resolves DIDs with the HTTP API of a universal resolver
+type DIDResolverClient struct {
+	client *http.Client
+	url    string
+}
+
+// NewDIDResolverClient returns a DID resolver gateway that calls the universal resolver at endpoint. Calls taking
+// longer than timeout are cancelled.
+func NewDIDResolverClient(endpoint string, timeout time.Duration) ports.DIDResolverGateway {
+	return &DIDResolverClient{client: &http.Client{Timeout: timeout}, url: strings.TrimSuffix(endpoint, "/")}
+}
+
+// Resolve gets the DID document with GET <endpoint>/1.0/identifiers/<did>. The DIDs the resolver cannot resolve, like
+// the unknown or deactivated ones, are returned with the error of the resolution. An error is returned only if the
+// resolver does not answer.
+func (c *DIDResolverClient) Resolve(ctx context.Context, did string) (*ports.DIDResolution, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url+"/1.0/identifiers/"+url.PathEscape(did), nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Accept", `application/ld+json;profile="https://w3id.org/did-resolution"`)
+
+	resp, err := c.client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode >= http.StatusInternalServerError && resp.StatusCode != http.StatusNotImplemented {
+		return nil, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	var result didResolutionResponse
+	decodeErr := json.NewDecoder(io.LimitReader(resp.Body, maxDIDResolutionSize)).Decode(&result)
+	switch {
+	case resp.StatusCode != http.StatusOK:
+		if result.DIDResolutionMetadata.Error != "" {
+			return &ports.DIDResolution{Error: result.DIDResolutionMetadata.Error}, nil
+		}
+		return &ports.DIDResolution{Error: strings.ToLower(http.StatusText(resp.StatusCode))}, nil
+	case decodeErr != nil:
+		return nil, fmt.Errorf("invalid response: %w", decodeErr)
+	case result.DIDResolutionMetadata.Error != "":
+		return &ports.DIDResolution{Error: result.DIDResolutionMetadata.Error}, nil
+	case result.DIDDocumentMetadata.Deactivated:
+		return &ports.DIDResolution{Error: "deactivated"}, nil
+	case len(result.DIDDocument) == 0 || string(result.DIDDocument) == "null":
+		return &ports.DIDResolution{Error: "notFound"}, nil
+	}
+	return &ports.DIDResolution{Document: result.DIDDocument}, nil
+}
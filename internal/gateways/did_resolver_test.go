@@ -0,0 +1,55 @@
+package gateways
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDIDResolverClient_Resolve(t *testing.T) {
+	const did = "did:web:example.com%3A3000:alice"
+	var received *http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		switch r.URL.Path {
+		case "/1.0/identifiers/" + did:
+			_, _ = w.Write([]byte(`{"didDocument": {"id": "did:web:example.com%3A3000:alice"}, "didResolutionMetadata": {}, "didDocumentMetadata": {}}`))
+		case "/1.0/identifiers/did:web:deactivated.com":
+			_, _ = w.Write([]byte(`{"didDocument": {"id": "did:web:deactivated.com"}, "didDocumentMetadata": {"deactivated": true}}`))
+		case "/1.0/identifiers/did:web:unknown.com":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"didDocument": null, "didResolutionMetadata": {"error": "notFound"}}`))
+		case "/1.0/identifiers/did:web:gone.com":
+			w.WriteHeader(http.StatusGone)
+		default:
+			w.WriteHeader(http.StatusBadGateway)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	resolver := NewDIDResolverClient(server.URL+"/", time.Second)
+	resolution, err := resolver.Resolve(ctx, did)
+	require.NoError(t, err)
+	assert.Empty(t, resolution.Error)
+	assert.JSONEq(t, `{"id": "did:web:example.com%3A3000:alice"}`, string(resolution.Document))
+	assert.Contains(t, received.Header.Get("Accept"), "https://w3id.org/did-resolution")
+
+	for did, resolutionErr := range map[string]string{
+		"did:web:deactivated.com": "deactivated",
+		"did:web:unknown.com":     "notFound",
+		"did:web:gone.com":        "gone",
+	} {
+		resolution, err = resolver.Resolve(ctx, did)
+		require.NoError(t, err)
+		assert.Equal(t, resolutionErr, resolution.Error, did)
+	}
+
+	_, err = resolver.Resolve(ctx, "did:web:failing.com")
+	assert.Error(t, err)
+}
@@ -0,0 +1,106 @@
+package gateways
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+)
+
+// ErrNoEmailRecipients is returned when an email has no recipients
+var ErrNoEmailRecipients = errors.New("email has no recipients")
+
+// SMTPConfig has the address and credentials of the smtp server
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	User     string
+	Password string
+	From     string
+}
+
+// SMTPClient sends emails through a smtp server
+type SMTPClient struct {
+	cfg SMTPConfig
+}
+
+// NewSMTPClient returns a new smtp email gateway
+func NewSMTPClient(cfg SMTPConfig) ports.EmailGateway {
+	return &SMTPClient{cfg: cfg}
+}
+
+// Send sends the email. Authentication is only used when a user is configured.
+func (c *SMTPClient) Send(_ context.Context, email *ports.Email) error {
+	if len(email.To) == 0 {
+		return ErrNoEmailRecipients
+	}
+
+	msg, err := buildMessage(c.cfg.From, email, time.Now())
+	if err != nil {
+		return err
+	}
+
+	var auth smtp.Auth
+	if c.cfg.User != "" {
+		auth = smtp.PlainAuth("", c.cfg.User, c.cfg.Password, c.cfg.Host)
+	}
+	addr := net.JoinHostPort(c.cfg.Host, strconv.Itoa(c.cfg.Port))
+	return smtp.SendMail(addr, auth, c.cfg.From, email.To, msg)
+}
+
+// buildMessage returns the email as a mime message. Emails with attachments are sent as multipart/mixed messages.
+func buildMessage(from string, email *ports.Email, date time.Time) ([]byte, error) {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", from)
+	fmt.Fprintf(&buf, "To: %s\r\n", strings.Join(email.To, ", "))
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", email.Subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", date.Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+
+	if len(email.Attachments) == 0 {
+		fmt.Fprintf(&buf, "Content-Type: %s; charset=utf-8\r\n\r\n", email.ContentType)
+		buf.Write(email.Body)
+		return buf.Bytes(), nil
+	}
+
+	w := multipart.NewWriter(&buf)
+	fmt.Fprintf(&buf, "Content-Type: multipart/mixed; boundary=%s\r\n\r\n", w.Boundary())
+
+	part, err := w.CreatePart(textproto.MIMEHeader{"Content-Type": {email.ContentType + "; charset=utf-8"}})
+	if err != nil {
+		return nil, err
+	}
+	if _, err := part.Write(email.Body); err != nil {
+		return nil, err
+	}
+
+	for _, attachment := range email.Attachments {
+		part, err := w.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {attachment.ContentType},
+			"Content-Transfer-Encoding": {"base64"},
+			"Content-Disposition":       {mime.FormatMediaType("attachment", map[string]string{"filename": attachment.Name})},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if _, err := part.Write([]byte(base64.StdEncoding.EncodeToString(attachment.Content))); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
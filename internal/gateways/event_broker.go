@@ -0,0 +1,108 @@
+package gateways
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/segmentio/kafka-go"
+
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+)
+
+const (
+	// EventBrokerKafka publishes the events in kafka topics
+	EventBrokerKafka = "kafka"
+	// EventBrokerNATS publishes the events in NATS JetStream subjects
+	EventBrokerNATS = "nats"
+
+	kafkaBatchTimeout = 10 * time.Millisecond
+	brokerMessageID   = "id" // brokerMessageID is the header with the id of the message
+)
+
+// NewEventBroker returns the broker of the given type, or nil if there is none. urls is a comma separated list of
+// the addresses of the kafka brokers, or of the NATS servers.
+func NewEventBroker(brokerType, urls string) (ports.EventBroker, error) {
+	switch brokerType {
+	case "":
+		return nil, nil
+	case EventBrokerKafka:
+		return newKafkaBroker(strings.Split(urls, ",")), nil
+	case EventBrokerNATS:
+		return newNATSBroker(urls)
+	default:
+		return nil, fmt.Errorf("unknown event broker type %q, use kafka or nats", brokerType)
+	}
+}
+
+// kafkaBroker publishes the messages with the acknowledgement of every in-sync replica. The messages with the same
+// key go to the same partition, so they are kept in order.
+type kafkaBroker struct {
+	writer *kafka.Writer
+}
+
+func newKafkaBroker(addrs []string) *kafkaBroker {
+	return &kafkaBroker{writer: &kafka.Writer{
+		Addr:                   kafka.TCP(addrs...),
+		Balancer:               &kafka.Hash{},
+		RequiredAcks:           kafka.RequireAll,
+		AllowAutoTopicCreation: true,
+		BatchTimeout:           kafkaBatchTimeout,
+	}}
+}
+
+func (k *kafkaBroker) Publish(ctx context.Context, messages ...ports.BrokerMessage) error {
+	msgs := make([]kafka.Message, len(messages))
+	for i, m := range messages {
+		msgs[i] = kafka.Message{
+			Topic:   m.Topic,
+			Key:     []byte(m.Key),
+			Value:   m.Value,
+			Headers: []kafka.Header{{Key: brokerMessageID, Value: []byte(m.ID)}},
+		}
+	}
+	return k.writer.WriteMessages(ctx, msgs...)
+}
+
+func (k *kafkaBroker) Close() error {
+	return k.writer.Close()
+}
+
+// natsBroker publishes the messages in JetStream, that acknowledges them once they are stored. The subjects must
+// belong to a stream. The id of every message is its JetStream message id, so the stream discards the messages
+// sent again within its duplicate window.
+type natsBroker struct {
+	conn *nats.Conn
+	js   nats.JetStreamContext
+}
+
+func newNATSBroker(urls string) (*natsBroker, error) {
+	conn, err := nats.Connect(urls, nats.Name("issuer-node"))
+	if err != nil {
+		return nil, err
+	}
+	js, err := conn.JetStream()
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return &natsBroker{conn: conn, js: js}, nil
+}
+
+func (n *natsBroker) Publish(ctx context.Context, messages ...ports.BrokerMessage) error {
+	for _, m := range messages {
+		msg := nats.NewMsg(m.Topic)
+		msg.Data = m.Value
+		msg.Header.Set(brokerMessageID, m.ID)
+		if _, err := n.js.PublishMsg(msg, nats.MsgId(m.ID), nats.Context(ctx)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (n *natsBroker) Close() error {
+	return n.conn.Drain()
+}
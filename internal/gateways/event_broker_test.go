@@ -0,0 +1,27 @@
+package gateways
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEventBroker(t *testing.T) {
+	broker, err := NewEventBroker("", "")
+	require.NoError(t, err)
+	assert.Nil(t, broker, "no broker configured")
+
+	broker, err = NewEventBroker(EventBrokerKafka, "kafka-1:9092,kafka-2:9092")
+	require.NoError(t, err, "kafka connects on the first publish")
+	kafka, ok := broker.(*kafkaBroker)
+	require.True(t, ok)
+	assert.Equal(t, "kafka-1:9092,kafka-2:9092", kafka.writer.Addr.String())
+	assert.NoError(t, broker.Close())
+
+	_, err = NewEventBroker(EventBrokerNATS, "nats://127.0.0.1:1")
+	assert.Error(t, err, "nats connects when the broker is created")
+
+	_, err = NewEventBroker("rabbitmq", "amqp://localhost")
+	assert.Error(t, err)
+}
@@ -0,0 +1,156 @@
+package gateways
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+)
+
+const (
+	// IPFSPinnerNode pins the documents with the add command of the http API of an IPFS node, or of the Infura IPFS API
+	IPFSPinnerNode = "node"
+	// IPFSPinnerPinata pins the documents with the Pinata API
+	IPFSPinnerPinata = "pinata"
+
+	ipfsTimeout         = 30 * time.Second
+	maxIPFSResponseSize = 64 * 1024
+)
+
+// NewIPFSPinner returns the pinner of the IPFS settings, or nil if pinning is disabled
+func NewIPFSPinner(settings config.IPFS) (ports.IPFSPinner, error) {
+	client := &http.Client{Timeout: ipfsTimeout}
+	switch settings.Pinner {
+	case "":
+		return nil, nil
+	case IPFSPinnerNode:
+		if settings.NodeURL == "" {
+			return nil, errors.New("the node pinner needs the url of the IPFS node")
+		}
+		return &ipfsNodePinner{
+			url:      strings.TrimSuffix(settings.NodeURL, "/"),
+			user:     settings.NodeUser,
+			password: settings.NodePassword,
+			client:   client,
+		}, nil
+	case IPFSPinnerPinata:
+		if settings.PinataJWT == "" {
+			return nil, errors.New("the pinata pinner needs a Pinata API JWT")
+		}
+		return &pinataPinner{url: strings.TrimSuffix(settings.PinataURL, "/"), jwt: settings.PinataJWT, client: client}, nil
+	default:
+		return nil, fmt.Errorf("unknown ipfs pinner %q, use node or pinata", settings.Pinner)
+	}
+}
+
+// ipfsNodePinner adds the documents to an IPFS node, which pins them
+type ipfsNodePinner struct {
+	url      string
+	user     string
+	password string
+	client   *http.Client
+}
+
+// Pin adds the document to the node with CID version 1
+func (p *ipfsNodePinner) Pin(ctx context.Context, name string, content []byte) (string, error) {
+	body, contentType, err := ipfsMultipart(name, content, nil)
+	if err != nil {
+		return "", err
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url+"/api/v0/add?pin=true&cid-version=1", body)
+	if err != nil {
+		return "", err
+	}
+	request.Header.Set("Content-Type", contentType)
+	if p.user != "" {
+		request.SetBasicAuth(p.user, p.password)
+	}
+
+	var result struct {
+		Hash string `json:"Hash"`
+	}
+	if err := doIPFSRequest(p.client, request, &result); err != nil {
+		return "", err
+	}
+	return result.Hash, nil
+}
+
+// pinataPinner uploads the documents to Pinata
+type pinataPinner struct {
+	url    string
+	jwt    string
+	client *http.Client
+}
+
+// Pin uploads the document as a file, so the CID is the one of the given content, with CID version 1
+func (p *pinataPinner) Pin(ctx context.Context, name string, content []byte) (string, error) {
+	fields := map[string]string{
+		"pinataMetadata": fmt.Sprintf(`{"name":%q}`, name),
+		"pinataOptions":  `{"cidVersion":1}`,
+	}
+	body, contentType, err := ipfsMultipart(name, content, fields)
+	if err != nil {
+		return "", err
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url+"/pinning/pinFileToIPFS", body)
+	if err != nil {
+		return "", err
+	}
+	request.Header.Set("Content-Type", contentType)
+	request.Header.Set("Authorization", "Bearer "+p.jwt)
+
+	var result struct {
+		IpfsHash string `json:"IpfsHash"`
+	}
+	if err := doIPFSRequest(p.client, request, &result); err != nil {
+		return "", err
+	}
+	return result.IpfsHash, nil
+}
+
+// ipfsMultipart returns a multipart form with the content as a file and the given fields
+func ipfsMultipart(name string, content []byte, fields map[string]string) (io.Reader, string, error) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	file, err := writer.CreateFormFile("file", name)
+	if err != nil {
+		return nil, "", err
+	}
+	if _, err := file.Write(content); err != nil {
+		return nil, "", err
+	}
+	for key, value := range fields {
+		if err := writer.WriteField(key, value); err != nil {
+			return nil, "", err
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return nil, "", err
+	}
+	return body, writer.FormDataContentType(), nil
+}
+
+func doIPFSRequest(client *http.Client, request *http.Request, result any) error {
+	resp, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxIPFSResponseSize)).Decode(result); err != nil {
+		return fmt.Errorf("invalid response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package gateways
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+)
+
+func TestNewIPFSPinner(t *testing.T) {
+	pinner, err := NewIPFSPinner(config.IPFS{})
+	require.NoError(t, err)
+	assert.Nil(t, pinner)
+
+	_, err = NewIPFSPinner(config.IPFS{Pinner: IPFSPinnerNode})
+	assert.Error(t, err)
+	_, err = NewIPFSPinner(config.IPFS{Pinner: IPFSPinnerPinata})
+	assert.Error(t, err)
+	_, err = NewIPFSPinner(config.IPFS{Pinner: "filecoin"})
+	assert.Error(t, err)
+}
+
+func TestIPFSPinner_Pin(t *testing.T) {
+	const content = `{"$schema":"http://json-schema.org/draft-07/schema#"}`
+	var received map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		file, header, err := r.FormFile("file")
+		require.NoError(t, err)
+		body, err := io.ReadAll(file)
+		require.NoError(t, err)
+		received = map[string]string{"name": header.Filename, "content": string(body), "metadata": r.FormValue("pinataMetadata")}
+
+		switch r.URL.Path {
+		case "/api/v0/add":
+			user, password, _ := r.BasicAuth()
+			if user != "project" || password != "secret" || r.URL.Query().Get("pin") != "true" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_, _ = w.Write([]byte(`{"Name":"schema.json","Hash":"bafynode","Size":"60"}`))
+		case "/pinning/pinFileToIPFS":
+			if r.Header.Get("Authorization") != "Bearer a-jwt" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_, _ = w.Write([]byte(`{"IpfsHash":"bafypinata","PinSize":60,"Timestamp":"2023-05-05T09:30:00Z"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	ctx := context.Background()
+
+	node, err := NewIPFSPinner(config.IPFS{Pinner: IPFSPinnerNode, NodeURL: server.URL + "/", NodeUser: "project", NodePassword: "secret"})
+	require.NoError(t, err)
+	cid, err := node.Pin(ctx, "schema.json", []byte(content))
+	require.NoError(t, err)
+	assert.Equal(t, "bafynode", cid)
+	assert.Equal(t, "schema.json", received["name"])
+	assert.Equal(t, content, received["content"])
+
+	pinata, err := NewIPFSPinner(config.IPFS{Pinner: IPFSPinnerPinata, PinataURL: server.URL, PinataJWT: "a-jwt"})
+	require.NoError(t, err)
+	cid, err = pinata.Pin(ctx, "context.jsonld", []byte(content))
+	require.NoError(t, err)
+	assert.Equal(t, "bafypinata", cid)
+	assert.Equal(t, content, received["content"])
+	assert.Equal(t, `{"name":"context.jsonld"}`, received["metadata"])
+
+	unauthorized, err := NewIPFSPinner(config.IPFS{Pinner: IPFSPinnerPinata, PinataURL: server.URL, PinataJWT: "another-jwt"})
+	require.NoError(t, err)
+	_, err = unauthorized.Pin(ctx, "schema.json", []byte(content))
+	assert.Error(t, err)
+}
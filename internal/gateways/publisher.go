@@ -2,12 +2,16 @@ package gateways
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math/big"
+	"strings"
 	"time"
 
+	ethCommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/google/uuid"
 	"github.com/iden3/go-circuits"
 	core "github.com/iden3/go-iden3-core"
@@ -16,12 +20,12 @@ import (
 	"github.com/jackc/pgx/v4"
 
 	"github.com/polygonid/sh-id-platform/internal/core/domain"
-	"github.com/polygonid/sh-id-platform/internal/core/event"
 	"github.com/polygonid/sh-id-platform/internal/core/ports"
 	"github.com/polygonid/sh-id-platform/internal/db"
 	"github.com/polygonid/sh-id-platform/internal/kms"
 	"github.com/polygonid/sh-id-platform/internal/log"
-	"github.com/polygonid/sh-id-platform/pkg/pubsub"
+	"github.com/polygonid/sh-id-platform/internal/network"
+	"github.com/polygonid/sh-id-platform/pkg/blockchain/eth"
 	"github.com/polygonid/sh-id-platform/pkg/sync_ttl_map"
 )
 
@@ -34,6 +38,8 @@ var (
 	ErrStateIsBeingProcessed = errors.New("the state is being processed")
 	// ErrNoFailedStatesToProcess - No fialed states to process
 	ErrNoFailedStatesToProcess = errors.New("no failed states to process")
+	// ErrNetworkNotSupported - The node cannot publish states to the network of the identity
+	ErrNetworkNotSupported = errors.New("network not supported")
 )
 
 const (
@@ -45,42 +51,109 @@ const (
 // PublisherGateway - Define the interface for publishers.
 type PublisherGateway interface {
 	PublishState(ctx context.Context, identifier *core.DID, latestState *merkletree.Hash, newState *merkletree.Hash, isOldStateGenesis bool, proof *domain.ZKProof) (*string, error)
+	PublishStateWithEthKey(ctx context.Context, identifier *core.DID, latestState *merkletree.Hash, newState *merkletree.Hash, isOldStateGenesis bool, keyID kms.KeyID) (*string, error)
+}
+
+// NetworkPublisher has the services used to publish the states of the identities of a network
+type NetworkPublisher struct {
+	Client              *eth.Client
+	TransactionService  ports.TransactionService
+	PublisherGateway    PublisherGateway
+	ConfirmationTimeout time.Duration
+}
+
+// NewNetworkPublishers returns the publishers of every network of the resolver, keyed by network. The transactions
+// they send are tracked by the monitor, if any, except the ones sent by the relayer of the network.
+func NewNetworkPublishers(resolver *network.Resolver, keyStore *kms.KMS, publishingKeyPath string, monitor *TransactionMonitor) (map[string]NetworkPublisher, error) {
+	publishers := make(map[string]NetworkPublisher)
+	for _, key := range resolver.Networks() {
+		settings, err := resolver.Settings(key)
+		if err != nil {
+			return nil, err
+		}
+		cl, err := resolver.Client(key)
+		if err != nil {
+			return nil, err
+		}
+		transactionService, err := NewTransaction(cl, settings.ConfirmationBlockCount)
+		if err != nil {
+			return nil, fmt.Errorf("creating transaction service for %s: %w", key, err)
+		}
+		publisherGateway, err := NewPublisherEthGateway(cl, ethCommon.HexToAddress(settings.ContractAddress), keyStore, publishingKeyPath, monitor)
+		if err != nil {
+			return nil, fmt.Errorf("creating publisher gateway for %s: %w", key, err)
+		}
+		var gateway PublisherGateway = publisherGateway
+		relayer, err := NewRelayer(settings, cl, keyStore, publishingKeyPath)
+		if err != nil {
+			return nil, fmt.Errorf("creating relayer for %s: %w", key, err)
+		}
+		if relayer != nil {
+			gateway = NewPublisherRelayGateway(cl, ethCommon.HexToAddress(settings.ContractAddress), relayer, publisherGateway)
+		}
+		publishers[key] = NetworkPublisher{
+			Client:              cl,
+			TransactionService:  transactionService,
+			PublisherGateway:    gateway,
+			ConfirmationTimeout: settings.ConfirmationTimeout,
+		}
+	}
+	return publishers, nil
 }
 
 type publisher struct {
-	storage               *db.Storage
-	identityService       ports.IdentityService
-	claimService          ports.ClaimsService
-	mtService             ports.MtService
-	kms                   kms.KMSType
-	transactionService    ports.TransactionService
-	confirmationTimeout   time.Duration
-	zkService             ports.ZKGenerator
-	publisherGateway      PublisherGateway
-	pendingTransactions   *sync_ttl_map.TTLMap
-	notificationPublisher pubsub.Publisher
+	storage             *db.Storage
+	identityService     ports.IdentityService
+	claimService        ports.ClaimsService
+	mtService           ports.MtService
+	kms                 kms.KMSType
+	zkService           ports.ZKGenerator
+	networks            map[string]NetworkPublisher
+	monitor             *TransactionMonitor
+	jobs                ports.JobQueueService
+	pendingTransactions *sync_ttl_map.TTLMap
+}
+
+// stateConfirmation is the payload of the job that waits for the transaction of a published state
+type stateConfirmation struct {
+	Identifier string `json:"identifier"`
+	State      string `json:"state"`
+	TxID       string `json:"txID"`
 }
 
-// NewPublisher - Constructor
-func NewPublisher(storage *db.Storage, identityService ports.IdentityService, claimService ports.ClaimsService, mtService ports.MtService, kms kms.KMSType, transactionService ports.TransactionService, zkService ports.ZKGenerator, publisherGateway PublisherGateway, confirmationTimeout time.Duration, notificationPublisher pubsub.Publisher) *publisher {
+// NewPublisher - Constructor. The transactions of the published states are confirmed by the state confirmation jobs
+// of the queue, see ConfirmState.
+func NewPublisher(storage *db.Storage, identityService ports.IdentityService, claimService ports.ClaimsService, mtService ports.MtService, kms kms.KMSType, zkService ports.ZKGenerator, networks map[string]NetworkPublisher, monitor *TransactionMonitor, jobs ports.JobQueueService) *publisher {
 	pendingTransactions := sync_ttl_map.New(ttl)
 	pendingTransactions.CleaningBackground(transactionCleanup)
 
 	return &publisher{
-		identityService:       identityService,
-		claimService:          claimService,
-		storage:               storage,
-		mtService:             mtService,
-		kms:                   kms,
-		transactionService:    transactionService,
-		zkService:             zkService,
-		publisherGateway:      publisherGateway,
-		confirmationTimeout:   confirmationTimeout,
-		pendingTransactions:   pendingTransactions,
-		notificationPublisher: notificationPublisher,
+		identityService:     identityService,
+		claimService:        claimService,
+		storage:             storage,
+		mtService:           mtService,
+		kms:                 kms,
+		zkService:           zkService,
+		networks:            networks,
+		monitor:             monitor,
+		jobs:                jobs,
+		pendingTransactions: pendingTransactions,
 	}
 }
 
+// network returns the publisher of the network of the identity
+func (p *publisher) network(identifier string) (*NetworkPublisher, error) {
+	did, err := core.ParseDID(identifier)
+	if err != nil {
+		return nil, err
+	}
+	np, ok := p.networks[network.Key(*did)]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrNetworkNotSupported, network.Key(*did))
+	}
+	return &np, nil
+}
+
 func (p *publisher) PublishState(ctx context.Context, identifier *core.DID) (*domain.PublishedState, error) {
 	idStr := identifier.String()
 	processingEntity := p.pendingTransactions.Load(idStr)
@@ -204,6 +277,110 @@ func (p *publisher) publishProof(ctx context.Context, identifier *core.DID, newS
 		return nil, err
 	}
 
+	np, err := p.network(did.String())
+	if err != nil {
+		return nil, err
+	}
+
+	identity, err := p.identityService.GetByDID(ctx, *did)
+	if err != nil {
+		return nil, err
+	}
+
+	isLatestStateGenesis := latestState.PreviousState == nil
+	var txID *string
+	if identity.KeyType == domain.IdentityKeyTypeETH {
+		keyID, err := p.ethKeyID(ctx, identity)
+		if err != nil {
+			return nil, err
+		}
+		txID, err = np.PublisherGateway.PublishStateWithEthKey(ctx, did, latestStateHash, newStateHash, isLatestStateGenesis, keyID)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		proof, err := p.stateTransitionProof(ctx, did, latestState, newState)
+		if err != nil {
+			return nil, err
+		}
+		txID, err = np.PublisherGateway.PublishState(ctx, did, latestStateHash, newStateHash, isLatestStateGenesis, proof)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	log.Info(ctx, "Success!", "TxID", txID)
+
+	// 8. Update state with txID value (block values are still default because tx is not confirmed)
+
+	newState.Status = domain.StatusTransacted
+	newState.TxID = txID
+
+	err = p.identityService.UpdateIdentityState(ctx, &newState)
+	if err != nil {
+		return nil, err
+	}
+
+	// the transaction is confirmed in the background. If the job cannot be enqueued the state is still confirmed by
+	// CheckTransactionStatus once its confirmation timeout passes.
+	_, err = p.jobs.Enqueue(ctx, p.storage.Pgx, domain.JobKindStateConfirmation, *identifier, stateConfirmation{
+		Identifier: newState.Identifier,
+		State:      *newState.State,
+		TxID:       *txID,
+	})
+	if err != nil {
+		log.Error(ctx, "cannot enqueue the confirmation of the state", "err", err, "txID", *txID)
+	}
+
+	return txID, nil
+}
+
+// ConfirmState is the handler of the state confirmation jobs. It updates the state once its transaction is confirmed,
+// and returns domain.ErrJobNotReady while it is not, so the job waits. The states not confirmed within the
+// confirmation timeout of their network are left to CheckTransactionStatus.
+func (p *publisher) ConfirmState(ctx context.Context, job *domain.Job) error {
+	var payload stateConfirmation
+	if err := json.Unmarshal(job.Payload, &payload); err != nil {
+		return err
+	}
+	states, err := p.identityService.GetTransactedStates(ctx)
+	if err != nil {
+		return err
+	}
+	var state *domain.IdentityState
+	for i := range states {
+		if states[i].Identifier == payload.Identifier && states[i].State != nil && *states[i].State == payload.State {
+			state = &states[i]
+			break
+		}
+	}
+	if state == nil {
+		log.Debug(ctx, "the state is no longer waiting for its transaction", "state", payload.State)
+		return nil
+	}
+
+	np, err := p.network(state.Identifier)
+	if err != nil {
+		return err
+	}
+	if time.Since(state.ModifiedAt) > np.ConfirmationTimeout {
+		log.Info(ctx, "the state was not confirmed in time, it is left to the transaction status check", "state", payload.State, "txID", payload.TxID)
+		return nil
+	}
+	if err := p.checkStatus(ctx, state); err != nil {
+		return fmt.Errorf("%w: %s", domain.ErrJobNotReady, err)
+	}
+	return nil
+}
+
+// stateTransitionProof generates the proof of the transition from the latest state to the new one, signed with the
+// auth key of the identity
+func (p *publisher) stateTransitionProof(ctx context.Context, did *core.DID, latestState *domain.IdentityState, newState domain.IdentityState) (*domain.ZKProof, error) {
+	newStateHash, err := merkletree.NewHashFromHex(*newState.State)
+	if err != nil {
+		return nil, err
+	}
+
 	newTreeState, err := newState.ToTreeState()
 	if err != nil {
 		return nil, err
@@ -245,12 +422,11 @@ func (p *publisher) publishProof(ctx context.Context, identifier *core.DID, newS
 		return nil, err
 	}
 
-	isLatestStateGenesis := latestState.PreviousState == nil
 	stateTransitionInputs := circuits.StateTransitionInputs{
 		ID:                &did.ID,
 		NewTreeState:      newTreeState,
 		OldTreeState:      oldTreeState,
-		IsOldStateGenesis: isLatestStateGenesis,
+		IsOldStateGenesis: latestState.PreviousState == nil,
 
 		AuthClaim:          circuitAuthClaim.Claim,
 		AuthClaimIncMtp:    circuitAuthClaim.IncProof.Proof,
@@ -271,35 +447,36 @@ func (p *publisher) publishProof(ctx context.Context, identifier *core.DID, newS
 		return nil, err
 	}
 
-	// 7. Publish state and receive txID
+	return fullProof.Proof, nil
+}
 
-	txID, err := p.publisherGateway.PublishState(ctx, did, latestStateHash, newStateHash, isLatestStateGenesis, fullProof.Proof)
+// ethKeyID returns the KMS key of the address that controls an Ethereum identity
+func (p *publisher) ethKeyID(ctx context.Context, identity *domain.Identity) (kms.KeyID, error) {
+	did, err := core.ParseDID(identity.Identifier)
 	if err != nil {
-		return nil, err
+		return kms.KeyID{}, err
 	}
-
-	log.Info(ctx, "Success!", "TxID", txID)
-
-	// 8. Update state with txID value (block values are still default because tx is not confirmed)
-
-	newState.Status = domain.StatusTransacted
-	newState.TxID = txID
-
-	err = p.identityService.UpdateIdentityState(ctx, &newState)
+	keyIDs, err := p.kms.KeysByIdentity(ctx, *did)
 	if err != nil {
-		return nil, err
+		return kms.KeyID{}, err
 	}
-
-	// add go routine that will listen for transaction status update
-
-	go func(ctx context.Context) {
-		if err := p.updateTransactionStatus(ctx, newState, *txID); err != nil {
-			log.Error(ctx, "cannot update transaction status", "err", err)
+	for _, keyID := range keyIDs {
+		if keyID.Type != kms.KeyTypeEthereum {
+			continue
 		}
-		p.pendingTransactions.Delete(identifier.String())
-	}(ctx)
-
-	return txID, nil
+		pubKeyBytes, err := p.kms.PublicKey(keyID)
+		if err != nil {
+			return kms.KeyID{}, err
+		}
+		pubKey, err := kms.DecodeETHPubKey(pubKeyBytes)
+		if err != nil {
+			return kms.KeyID{}, err
+		}
+		if identity.Address != nil && strings.EqualFold(crypto.PubkeyToAddress(*pubKey).Hex(), *identity.Address) {
+			return keyID, nil
+		}
+	}
+	return kms.KeyID{}, fmt.Errorf("ethereum key of %s not found", identity.Identifier)
 }
 
 func (p *publisher) fillAuthClaimData(ctx context.Context, identifier *core.DID, authClaim *domain.Claim, newState domain.IdentityState) (
@@ -372,41 +549,8 @@ func (p *publisher) fillAuthClaimData(ctx context.Context, identifier *core.DID,
 	return authClaimData, authClaimNewStateIncProof, nil
 }
 
-// updateTransactionStatus update identity state with transaction status
-func (p *publisher) updateTransactionStatus(ctx context.Context, state domain.IdentityState, txID string) error {
-	receipt, err := p.transactionService.WaitForTransactionReceipt(ctx, txID)
-	if err != nil {
-		log.Error(ctx, "error during receipt receiving: ", "err", err, "txID", txID)
-		return err
-	}
-
-	if receipt.Status == types.ReceiptStatusSuccessful {
-		// wait until transaction will be confirmed if transaction has enough confirmation blocks
-		log.Debug(ctx, "Waiting for confirmation", "tx", receipt.TxHash.Hex())
-		confirmed, rErr := p.transactionService.WaitForConfirmation(ctx, receipt)
-		if rErr != nil {
-			return fmt.Errorf("transaction receipt is found, but not confirmed - %s", *state.TxID)
-		}
-		if !confirmed {
-			return fmt.Errorf("transaction receipt is found, but tx is not confirmed yet - %s", *state.TxID)
-		}
-	} else {
-		// do not wait for many confirmations, just save as failed
-		log.Info(ctx, "transaction failed", "tx", *state.TxID)
-	}
-
-	err = p.updateIdentityStateTxStatus(ctx, &state, receipt)
-	if err != nil {
-		log.Error(ctx, "updating identity state", "err", err, "txID", txID)
-		return err
-	}
-
-	log.Info(ctx, "transaction status updated", "tx", txID)
-	return nil
-}
-
-func (p *publisher) updateIdentityStateTxStatus(ctx context.Context, state *domain.IdentityState, receipt *types.Receipt) error {
-	header, err := p.transactionService.GetHeaderByNumber(ctx, receipt.BlockNumber)
+func (p *publisher) updateIdentityStateTxStatus(ctx context.Context, np *NetworkPublisher, state *domain.IdentityState, receipt *types.Receipt) error {
+	header, err := np.TransactionService.GetHeaderByNumber(ctx, receipt.BlockNumber)
 	if err != nil {
 		log.Error(ctx, "couldn't find receipt block: ", "err", err, "block", receipt.BlockNumber)
 		return err
@@ -420,27 +564,8 @@ func (p *publisher) updateIdentityStateTxStatus(ctx context.Context, state *doma
 
 	if receipt.Status == types.ReceiptStatusSuccessful {
 		state.Status = domain.StatusConfirmed
+		// the holders are notified in the same transaction
 		err = p.claimService.UpdateClaimsMTPAndState(ctx, state)
-		did, err := core.ParseDID(state.Identifier)
-		if err != nil {
-			log.Error(ctx, "error getting did from state: ", "err", err, "state", state.StateID)
-			return err
-		}
-		claimsToNotify, err := p.claimService.GetByStateIDWithMTPProof(ctx, did, *state.State)
-		if err != nil {
-			log.Error(ctx, "couldn't fetch the credentials to send notifications: ", "err", err, "state", state.StateID)
-			return err
-		}
-		log.Info(ctx, "sending notifications:", "numberOfClaims", len(claimsToNotify))
-
-		grupedCredentials := groupByUserId(claimsToNotify)
-		for _, claims := range grupedCredentials {
-			err = p.notificationPublisher.Publish(ctx, event.CreateCredentialEvent, &event.CreateCredential{CredentialIDs: claims, IssuerID: state.Identifier})
-			if err != nil {
-				log.Error(ctx, "publish EventCreateCredential", "err", err.Error(), "credential", claims)
-				continue
-			}
-		}
 	} else {
 		state.Status = domain.StatusFailed
 		err = p.identityService.UpdateIdentityState(ctx, state)
@@ -454,15 +579,6 @@ func (p *publisher) updateIdentityStateTxStatus(ctx context.Context, state *doma
 	return nil
 }
 
-// groupByUserId - groups claims by user id
-func groupByUserId(claims []*domain.Claim) map[string][]string {
-	grouped := make(map[string][]string)
-	for _, c := range claims {
-		grouped[c.OtherIdentifier] = append(grouped[c.OtherIdentifier], c.ID.String())
-	}
-	return grouped
-}
-
 // CheckTransactionStatus - checks transaction status
 func (p *publisher) CheckTransactionStatus(ctx context.Context) {
 	jobIDValue, err := uuid.NewUUID()
@@ -472,6 +588,10 @@ func (p *publisher) CheckTransactionStatus(ctx context.Context) {
 	}
 	ctx = context.WithValue(ctx, jobID, jobIDValue.String())
 	log.Info(ctx, "checker status job started", "job-id", jobIDValue.String())
+	if p.monitor != nil {
+		// resubmissions and mined replacements update the transaction of the states checked below
+		p.monitor.Check(ctx)
+	}
 	// Get all issuers that have claims not included in any state
 	states, err := p.identityService.GetTransactedStates(ctx)
 	if err != nil {
@@ -483,7 +603,12 @@ func (p *publisher) CheckTransactionStatus(ctx context.Context) {
 	var toCheck []domain.IdentityState
 	for i, state := range states {
 		log.Debug(ctx, "examining state", "id", state.StateID, "identifier", state.Identifier, "prev", state.PreviousState, "created_at", state.CreatedAt, "updated_at", state.ModifiedAt)
-		if time.Now().Unix() > states[i].ModifiedAt.Add(p.confirmationTimeout).Unix() {
+		np, err := p.network(state.Identifier)
+		if err != nil {
+			log.Error(ctx, "cannot check the state transaction", "err", err, "identifier", state.Identifier)
+			continue
+		}
+		if time.Now().Unix() > states[i].ModifiedAt.Add(np.ConfirmationTimeout).Unix() {
 			toCheck = append(toCheck, states[i])
 			log.Debug(ctx, "considering state", "id", state.StateID, "identifier", state.Identifier, "prev", state.PreviousState, "created_at", state.CreatedAt, "updated_at", state.ModifiedAt)
 		}
@@ -501,16 +626,63 @@ func (p *publisher) CheckTransactionStatus(ctx context.Context) {
 	log.Info(ctx, "checker status job finished", "job-id", jobIDValue.String())
 }
 
+// GetStateTransactions returns the latest state transactions sent for the identity
+func (p *publisher) GetStateTransactions(ctx context.Context, identifier *core.DID) ([]*domain.StateTransaction, error) {
+	if p.monitor == nil {
+		return []*domain.StateTransaction{}, nil
+	}
+	return p.monitor.GetByIdentifier(ctx, *identifier)
+}
+
+// EstimatePublishingCost returns the cost of publishing a new state of the identity with the current fees. The gas
+// limit is the one of the latest state transaction of the identity, as they all cost about the same, or the default
+// gas limit of the network if it has sent none.
+func (p *publisher) EstimatePublishingCost(ctx context.Context, identifier *core.DID) (*domain.PublishingCost, error) {
+	np, err := p.network(identifier.String())
+	if err != nil {
+		return nil, err
+	}
+
+	gasLimit := uint64(np.Client.Config.DefaultGasLimit)
+	if p.monitor != nil {
+		txs, err := p.monitor.GetByIdentifier(ctx, *identifier)
+		if err != nil {
+			return nil, err
+		}
+		for _, tx := range txs {
+			if tx.GasLimit > 0 {
+				gasLimit = tx.GasLimit
+				break
+			}
+		}
+	}
+
+	_, feeCap, err := np.Client.SuggestFees(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.PublishingCost{
+		GasLimit:     gasLimit,
+		MaxFeePerGas: feeCap,
+		Cost:         new(big.Int).Mul(feeCap, new(big.Int).SetUint64(gasLimit)),
+	}, nil
+}
+
 func (p *publisher) checkStatus(ctx context.Context, state *domain.IdentityState) error {
+	np, err := p.network(state.Identifier)
+	if err != nil {
+		return err
+	}
+
 	// Get receipt and check status
-	receipt, err := p.transactionService.GetTransactionReceiptByID(ctx, *state.TxID)
+	receipt, err := np.TransactionService.GetTransactionReceiptByID(ctx, *state.TxID)
 	if err != nil {
 		log.Error(ctx, "error during receipt receiving:", "err", err, "state-id", *state.TxID)
 		return fmt.Errorf("error during receipt receiving::%s: %w", *state.TxID, err)
 	}
 
 	// Check if transaction has enough confirmation blocks
-	confirmed, err := p.transactionService.CheckConfirmation(ctx, receipt)
+	confirmed, err := np.TransactionService.CheckConfirmation(ctx, receipt)
 	if err != nil {
 		log.Error(ctx, fmt.Sprintf("transaction receipt is found, but confirmation is not checked - %s", *state.TxID), "err", err)
 		return fmt.Errorf("transaction receipt is found, but confirmation is not checked:%s - %w", *state.TxID, err)
@@ -521,7 +693,7 @@ func (p *publisher) checkStatus(ctx context.Context, state *domain.IdentityState
 		return ErrStateIsBeingProcessed
 	}
 
-	err = p.updateIdentityStateTxStatus(ctx, state, receipt)
+	err = p.updateIdentityStateTxStatus(ctx, np, state, receipt)
 	if err != nil {
 		log.Error(ctx, "error during identity state update: ", "err", err)
 		return err
@@ -2,12 +2,13 @@ package gateways
 
 import (
 	"context"
-	"crypto/ecdsa"
 	"errors"
 	"fmt"
 	"math/big"
+	"strings"
 	"sync"
 
+	ethABI "github.com/ethereum/go-ethereum/accounts/abi"
 	ethCommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -22,6 +23,18 @@ import (
 	"github.com/polygonid/sh-id-platform/pkg/blockchain/eth"
 )
 
+// transitStateEthMethodID is the transitStateGeneric method of the state contract for the identities controlled by
+// the Ethereum address in their identifier
+const transitStateEthMethodID = 1
+
+// transitStateGenericDefinition is the state contract method used to publish the states of Ethereum controlled
+// identities. It is not part of the State bindings yet.
+const transitStateGenericDefinition = `[{"inputs":[{"internalType":"uint256","name":"id","type":"uint256"},{"internalType":"uint256","name":"oldState","type":"uint256"},{"internalType":"uint256","name":"newState","type":"uint256"},{"internalType":"bool","name":"isOldStateGenesis","type":"bool"},{"internalType":"uint256","name":"methodId","type":"uint256"},{"internalType":"bytes","name":"methodParams","type":"bytes"}],"name":"transitStateGeneric","outputs":[],"stateMutability":"nonpayable","type":"function"}]`
+
+func transitStateGenericABI() (ethABI.ABI, error) {
+	return ethABI.JSON(strings.NewReader(transitStateGenericDefinition))
+}
+
 // PublisherEthGateway interact with blockchain
 type PublisherEthGateway struct {
 	rw              *sync.RWMutex
@@ -29,10 +42,12 @@ type PublisherEthGateway struct {
 	contract        ethCommon.Address
 	kms             *kms.KMS
 	publishingKeyID kms.KeyID
+	monitor         *TransactionMonitor
 }
 
-// NewPublisherEthGateway creates new instance of publishing service
-func NewPublisherEthGateway(_client *eth.Client, contract ethCommon.Address, keyStore *kms.KMS, publishingKeyPath string) (*PublisherEthGateway, error) {
+// NewPublisherEthGateway creates new instance of publishing service. The transactions sent are tracked by the monitor,
+// if any, so they are resubmitted when they get stuck.
+func NewPublisherEthGateway(_client *eth.Client, contract ethCommon.Address, keyStore *kms.KMS, publishingKeyPath string, monitor *TransactionMonitor) (*PublisherEthGateway, error) {
 	if publishingKeyPath == "" {
 		return nil, errors.New("publishing key path is required")
 	}
@@ -45,24 +60,50 @@ func NewPublisherEthGateway(_client *eth.Client, contract ethCommon.Address, key
 			Type: kms.KeyTypeEthereum,
 			ID:   publishingKeyPath,
 		},
+		monitor: monitor,
 	}, nil
 }
 
 // PublishState creates or updates state in the blockchain
 func (pb *PublisherEthGateway) PublishState(ctx context.Context, identifier *core.DID, latestState, newState *merkletree.Hash, isOldStateGenesis bool, proof *domain.ZKProof) (*string, error) {
-	pb.rw.Lock()
-	defer pb.rw.Unlock()
+	if common.CompareMerkleTreeHash(newState, latestState) {
+		return nil, errors.New("state hasn't been changed")
+	}
+
+	payload, err := transitStatePayload(identifier, latestState, newState, isOldStateGenesis, proof)
+	if err != nil {
+		return nil, err
+	}
 
+	return pb.sendTx(ctx, identifier, newState, pb.publishingKeyID, payload)
+}
+
+// PublishStateWithEthKey updates the state of an Ethereum controlled identity. The state contract checks the
+// transition against the address in the identifier, so the transaction is signed, and its gas paid, with the
+// identity key instead of the publishing key.
+func (pb *PublisherEthGateway) PublishStateWithEthKey(ctx context.Context, identifier *core.DID, latestState, newState *merkletree.Hash, isOldStateGenesis bool, keyID kms.KeyID) (*string, error) {
 	if common.CompareMerkleTreeHash(newState, latestState) {
 		return nil, errors.New("state hasn't been changed")
 	}
 
-	fromAddress, err := pb.getAddressForTxInitiator()
+	ab, err := transitStateGenericABI()
 	if err != nil {
 		return nil, err
 	}
+	payload, err := ab.Pack("transitStateGeneric", identifier.ID.BigInt(), latestState.BigInt(), newState.BigInt(), isOldStateGenesis,
+		big.NewInt(transitStateEthMethodID), []byte{})
+	if err != nil {
+		return nil, err
+	}
+
+	return pb.sendTx(ctx, identifier, newState, keyID, payload)
+}
 
-	payload, err := pb.getStatePayload(identifier, latestState, newState, isOldStateGenesis, proof)
+func (pb *PublisherEthGateway) sendTx(ctx context.Context, identifier *core.DID, newState *merkletree.Hash, keyID kms.KeyID, payload []byte) (*string, error) {
+	pb.rw.Lock()
+	defer pb.rw.Unlock()
+
+	fromAddress, err := pb.getAddressForTxInitiator(keyID)
 	if err != nil {
 		return nil, err
 	}
@@ -85,7 +126,7 @@ func (pb *PublisherEthGateway) PublishState(ctx context.Context, identifier *cor
 	s := types.LatestSignerForChainID(cid)
 
 	h := s.Hash(tx)
-	sig, err := pb.kms.Sign(ctx, pb.publishingKeyID, h[:])
+	sig, err := pb.kms.Sign(ctx, keyID, h[:])
 	if err != nil {
 		return nil, err
 	}
@@ -102,6 +143,13 @@ func (pb *PublisherEthGateway) PublishState(ctx context.Context, identifier *cor
 
 	txID := signedTx.Hash().Hex()
 
+	if pb.monitor != nil {
+		// the transaction has been sent, so a tracking failure only leaves it without resubmissions
+		if err := pb.monitor.Track(ctx, newStateTransaction(identifier, newState.Hex(), keyID, fromAddress, signedTx)); err != nil {
+			log.Error(ctx, "cannot track state transaction", "err", err, "txID", txID)
+		}
+	}
+
 	var (
 		gasTip            = signedTx.GasTipCap()
 		maxGasPricePerFee = signedTx.GasFeeCap()
@@ -111,27 +159,20 @@ func (pb *PublisherEthGateway) PublishState(ctx context.Context, identifier *cor
 	return &txID, nil
 }
 
-func (pb *PublisherEthGateway) getAddressForTxInitiator() (ethCommon.Address, error) {
-	bytesPubKey, err := pb.kms.PublicKey(pb.publishingKeyID)
+func (pb *PublisherEthGateway) getAddressForTxInitiator(keyID kms.KeyID) (ethCommon.Address, error) {
+	bytesPubKey, err := pb.kms.PublicKey(keyID)
 	if err != nil {
 		return ethCommon.Address{}, err
 	}
-	var pubKey *ecdsa.PublicKey
-	bytesPubKeyLen := 33
-	switch len(bytesPubKey) {
-	case bytesPubKeyLen:
-		pubKey, err = crypto.DecompressPubkey(bytesPubKey)
-	default:
-		pubKey, err = crypto.UnmarshalPubkey(bytesPubKey)
-	}
+	pubKey, err := kms.DecodeETHPubKey(bytesPubKey)
 	if err != nil {
 		return ethCommon.Address{}, err
 	}
-	fromAddress := crypto.PubkeyToAddress(*pubKey)
-	return fromAddress, nil
+	return crypto.PubkeyToAddress(*pubKey), nil
 }
 
-func (pb *PublisherEthGateway) getStatePayload(identifier *core.DID, latestState, newState *merkletree.Hash, isOldStateGenesis bool, proof *domain.ZKProof) ([]byte, error) {
+// transitStatePayload returns the call of the transitState method of the state contract with the proof of the transition
+func transitStatePayload(identifier *core.DID, latestState, newState *merkletree.Hash, isOldStateGenesis bool, proof *domain.ZKProof) ([]byte, error) {
 	a, b, c, err := proof.ProofToBigInts()
 	if err != nil {
 		return nil, err
@@ -0,0 +1,321 @@
+package gateways
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	ethABI "github.com/ethereum/go-ethereum/accounts/abi"
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethMath "github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	core "github.com/iden3/go-iden3-core"
+	"github.com/iden3/go-merkletree-sql/v2"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/kms"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/pkg/blockchain/eth"
+)
+
+const (
+	// RelayerHTTP posts the state transitions to a relay service, OpenZeppelin Defender or Gelato style, that signs
+	// and pays for them
+	RelayerHTTP = "http"
+	// RelayerForwarder signs the state transitions as EIP-2771 forward requests of an OpenZeppelin MinimalForwarder,
+	// and posts them to a relay service that executes them through the forwarder
+	RelayerForwarder = "forwarder"
+
+	forwarderDomainName    = "MinimalForwarder"
+	forwarderDomainVersion = "0.0.1"
+	forwarderGetNonce      = `[{"inputs":[{"internalType":"address","name":"from","type":"address"}],"name":"getNonce","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"}]`
+
+	relayTimeout          = 30 * time.Second
+	maxRelayResponseSize  = 64 * 1024
+	relayGasIncrementPerc = 25 // the gas of the forwarder call on top of the estimation
+)
+
+// RelayRequest is a state transition to be sent by a relayer
+type RelayRequest struct {
+	ChainID *big.Int
+	From    ethCommon.Address
+	To      ethCommon.Address
+	Data    []byte
+	Gas     uint64
+}
+
+// Relayer sends the state transitions on behalf of the node and returns the hash of the transaction
+type Relayer interface {
+	Relay(ctx context.Context, req *RelayRequest) (string, error)
+}
+
+// NewRelayer returns the relayer of the network settings, or nil if the network has none
+func NewRelayer(settings config.Ethereum, client *eth.Client, keyStore *kms.KMS, publishingKeyPath string) (Relayer, error) {
+	switch settings.RelayerType {
+	case "":
+		return nil, nil
+	case RelayerHTTP, RelayerForwarder:
+	default:
+		return nil, fmt.Errorf("unknown relayer type %q, use http or forwarder", settings.RelayerType)
+	}
+	if settings.RelayerURL == "" {
+		return nil, errors.New("the relayer needs an url")
+	}
+	poster := &relayPoster{url: settings.RelayerURL, apiKey: settings.RelayerAPIKey, client: &http.Client{Timeout: relayTimeout}}
+	if settings.RelayerType == RelayerHTTP {
+		return &httpRelayer{poster: poster}, nil
+	}
+
+	if !ethCommon.IsHexAddress(settings.RelayerForwarder) {
+		return nil, errors.New("the forwarder relayer needs the address of the forwarder contract")
+	}
+	if publishingKeyPath == "" {
+		return nil, errors.New("the forwarder relayer signs the requests with the publishing key, its path is required")
+	}
+	return &forwarderRelayer{
+		poster:          poster,
+		client:          client,
+		forwarder:       ethCommon.HexToAddress(settings.RelayerForwarder),
+		kms:             keyStore,
+		publishingKeyID: kms.KeyID{Type: kms.KeyTypeEthereum, ID: publishingKeyPath},
+	}, nil
+}
+
+// PublisherRelayGateway publishes the states through a relayer, so the node needs no funds in the network. The
+// states of Ethereum controlled identities are checked against the sender of the transaction, so they are still sent
+// by the direct gateway with the key of the identity.
+type PublisherRelayGateway struct {
+	client   *eth.Client
+	contract ethCommon.Address
+	relayer  Relayer
+	from     func() (ethCommon.Address, error)
+	direct   *PublisherEthGateway
+}
+
+// NewPublisherRelayGateway returns a gateway that sends the state transitions with the relayer
+func NewPublisherRelayGateway(client *eth.Client, contract ethCommon.Address, relayer Relayer, direct *PublisherEthGateway) *PublisherRelayGateway {
+	return &PublisherRelayGateway{
+		client:   client,
+		contract: contract,
+		relayer:  relayer,
+		from:     func() (ethCommon.Address, error) { return direct.getAddressForTxInitiator(direct.publishingKeyID) },
+		direct:   direct,
+	}
+}
+
+// PublishState relays the state transition of the identity
+func (pr *PublisherRelayGateway) PublishState(ctx context.Context, identifier *core.DID, latestState, newState *merkletree.Hash, isOldStateGenesis bool, proof *domain.ZKProof) (*string, error) {
+	if common.CompareMerkleTreeHash(newState, latestState) {
+		return nil, errors.New("state hasn't been changed")
+	}
+
+	payload, err := transitStatePayload(identifier, latestState, newState, isOldStateGenesis, proof)
+	if err != nil {
+		return nil, err
+	}
+	from, err := pr.from()
+	if err != nil {
+		return nil, err
+	}
+	chainID, err := pr.client.ChainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gas, err := pr.client.EstimateGas(ctx, ethereum.CallMsg{From: from, To: &pr.contract, Value: big.NewInt(0), Data: payload})
+	if err != nil {
+		return nil, fmt.Errorf("failed to estimate gas: %w", err)
+	}
+
+	txID, err := pr.relayer.Relay(ctx, &RelayRequest{
+		ChainID: chainID,
+		From:    from,
+		To:      pr.contract,
+		Data:    payload,
+		Gas:     gas + gas*relayGasIncrementPerc/100,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("relaying state transition: %w", err)
+	}
+	log.Info(ctx, "state transition relayed", "did", identifier.String(), "state", newState.Hex(), "txID", txID)
+	return &txID, nil
+}
+
+// PublishStateWithEthKey sends the state transition of an Ethereum controlled identity with its own key
+func (pr *PublisherRelayGateway) PublishStateWithEthKey(ctx context.Context, identifier *core.DID, latestState, newState *merkletree.Hash, isOldStateGenesis bool, keyID kms.KeyID) (*string, error) {
+	return pr.direct.PublishStateWithEthKey(ctx, identifier, latestState, newState, isOldStateGenesis, keyID)
+}
+
+type httpRelayer struct {
+	poster *relayPoster
+}
+
+// Relay posts the transaction to the relay service
+func (r *httpRelayer) Relay(ctx context.Context, req *RelayRequest) (string, error) {
+	return r.poster.post(ctx, map[string]any{
+		"chainId":  req.ChainID.String(),
+		"to":       req.To.Hex(),
+		"data":     hexutil.Encode(req.Data),
+		"gasLimit": req.Gas,
+	})
+}
+
+type forwarderRelayer struct {
+	poster          *relayPoster
+	client          *eth.Client
+	forwarder       ethCommon.Address
+	kms             *kms.KMS
+	publishingKeyID kms.KeyID
+}
+
+// Relay signs the forward request of the transaction with the publishing key and posts it to the relay service
+func (r *forwarderRelayer) Relay(ctx context.Context, req *RelayRequest) (string, error) {
+	nonce, err := r.nonce(ctx, req.From)
+	if err != nil {
+		return "", err
+	}
+	typedData := forwardRequestTypedData(r.forwarder, req, nonce)
+	hash, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return "", err
+	}
+	signature, err := r.kms.Sign(ctx, r.publishingKeyID, hash)
+	if err != nil {
+		return "", err
+	}
+
+	return r.poster.post(ctx, map[string]any{
+		"chainId":   req.ChainID.String(),
+		"forwarder": r.forwarder.Hex(),
+		"request":   typedData.Message,
+		"signature": hexutil.Encode(ecrecoverSignature(signature)),
+	})
+}
+
+// nonce returns the next nonce of the forward requests of the address
+func (r *forwarderRelayer) nonce(ctx context.Context, from ethCommon.Address) (*big.Int, error) {
+	ab, err := ethABI.JSON(strings.NewReader(forwarderGetNonce))
+	if err != nil {
+		return nil, err
+	}
+	data, err := ab.Pack("getNonce", from)
+	if err != nil {
+		return nil, err
+	}
+	out, err := r.client.CallContract(ctx, ethereum.CallMsg{To: &r.forwarder, Data: data})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get forwarder nonce: %w", err)
+	}
+	values, err := ab.Unpack("getNonce", out)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get forwarder nonce: %w", err)
+	}
+	return ethABI.ConvertType(values[0], new(big.Int)).(*big.Int), nil
+}
+
+// forwardRequestTypedData returns the EIP-712 ForwardRequest of the MinimalForwarder for the transaction
+func forwardRequestTypedData(forwarder ethCommon.Address, req *RelayRequest, nonce *big.Int) apitypes.TypedData {
+	return apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+				{Name: "verifyingContract", Type: "address"},
+			},
+			"ForwardRequest": {
+				{Name: "from", Type: "address"},
+				{Name: "to", Type: "address"},
+				{Name: "value", Type: "uint256"},
+				{Name: "gas", Type: "uint256"},
+				{Name: "nonce", Type: "uint256"},
+				{Name: "data", Type: "bytes"},
+			},
+		},
+		PrimaryType: "ForwardRequest",
+		Domain: apitypes.TypedDataDomain{
+			Name:              forwarderDomainName,
+			Version:           forwarderDomainVersion,
+			ChainId:           (*ethMath.HexOrDecimal256)(req.ChainID),
+			VerifyingContract: forwarder.Hex(),
+		},
+		Message: apitypes.TypedDataMessage{
+			"from":  req.From.Hex(),
+			"to":    req.To.Hex(),
+			"value": "0",
+			"gas":   new(big.Int).SetUint64(req.Gas).String(),
+			"nonce": nonce.String(),
+			"data":  hexutil.Encode(req.Data),
+		},
+	}
+}
+
+// ecrecoverSignature returns the signature with the 27 or 28 recovery id expected by ecrecover. The key store signs
+// with the 0 or 1 recovery id of the transactions.
+func ecrecoverSignature(signature []byte) []byte {
+	sig := make([]byte, len(signature))
+	copy(sig, signature)
+	if len(sig) == 65 && sig[64] < 27 {
+		sig[64] += 27
+	}
+	return sig
+}
+
+// relayPoster posts the requests to the relay service, authenticated with the api key
+type relayPoster struct {
+	url    string
+	apiKey string
+	client *http.Client
+}
+
+type relayResponse struct {
+	Hash string `json:"hash"`
+}
+
+// post sends the body to the relay service and returns the hash of the transaction it answers with
+func (p *relayPoster) post(ctx context.Context, body map[string]any) (string, error) {
+	content, err := json.Marshal(body)
+	if err != nil {
+		return "", err
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url, bytes.NewReader(content))
+	if err != nil {
+		return "", err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	if p.apiKey != "" {
+		request.Header.Set("Authorization", "Bearer "+p.apiKey)
+	}
+
+	resp, err := p.client.Do(request)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, maxRelayResponseSize))
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return "", fmt.Errorf("unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+	var relayed relayResponse
+	if err := json.Unmarshal(respBody, &relayed); err != nil {
+		return "", fmt.Errorf("invalid response: %w", err)
+	}
+	if b, err := hexutil.Decode(relayed.Hash); err != nil || len(b) != ethCommon.HashLength {
+		return "", fmt.Errorf("invalid transaction hash %q", relayed.Hash)
+	}
+	return relayed.Hash, nil
+}
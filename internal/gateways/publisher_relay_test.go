@@ -0,0 +1,95 @@
+package gateways
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+)
+
+const relayedTxHash = "0x5b4a1ad2dbe44a5a0dc4fe36e3f7d2a6a1c47eeb2df1e3d0e0b7e3d5fb3bc8d1"
+
+func TestHTTPRelayer_Relay(t *testing.T) {
+	var body map[string]any
+	var auth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth = r.Header.Get("Authorization")
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		if body["to"] == ethCommon.HexToAddress("0x01").Hex() {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = w.Write([]byte(`{"hash": "` + relayedTxHash + `"}`))
+	}))
+	defer server.Close()
+
+	relayer, err := NewRelayer(config.Ethereum{RelayerType: RelayerHTTP, RelayerURL: server.URL, RelayerAPIKey: "key"}, nil, nil, "pbkey")
+	require.NoError(t, err)
+
+	req := &RelayRequest{
+		ChainID: big.NewInt(80001),
+		To:      ethCommon.HexToAddress("0x134B1BE34911E39A8397ec6289782989729807a4"),
+		Data:    []byte{0xca, 0xfe},
+		Gas:     100000,
+	}
+	hash, err := relayer.Relay(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, relayedTxHash, hash)
+	assert.Equal(t, "Bearer key", auth)
+	assert.Equal(t, "80001", body["chainId"])
+	assert.Equal(t, "0xcafe", body["data"])
+	assert.Equal(t, float64(100000), body["gasLimit"])
+
+	req.To = ethCommon.HexToAddress("0x01")
+	_, err = relayer.Relay(context.Background(), req)
+	assert.Error(t, err)
+}
+
+func TestNewRelayer(t *testing.T) {
+	relayer, err := NewRelayer(config.Ethereum{}, nil, nil, "pbkey")
+	require.NoError(t, err)
+	assert.Nil(t, relayer)
+
+	_, err = NewRelayer(config.Ethereum{RelayerType: "gelato", RelayerURL: "http://localhost"}, nil, nil, "pbkey")
+	assert.Error(t, err)
+	_, err = NewRelayer(config.Ethereum{RelayerType: RelayerHTTP}, nil, nil, "pbkey")
+	assert.Error(t, err)
+	_, err = NewRelayer(config.Ethereum{RelayerType: RelayerForwarder, RelayerURL: "http://localhost"}, nil, nil, "pbkey")
+	assert.Error(t, err)
+}
+
+func TestForwardRequestSignature(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	from := crypto.PubkeyToAddress(key.PublicKey)
+
+	typedData := forwardRequestTypedData(ethCommon.HexToAddress("0x9A7C2d4c3bC5ab0aE7a5Fb0C4d3C2F1E0d9c8B7a"), &RelayRequest{
+		ChainID: big.NewInt(80001),
+		From:    from,
+		To:      ethCommon.HexToAddress("0x134B1BE34911E39A8397ec6289782989729807a4"),
+		Data:    []byte{0xca, 0xfe},
+		Gas:     100000,
+	}, big.NewInt(3))
+	hash, _, err := apitypes.TypedDataAndHash(typedData)
+	require.NoError(t, err)
+
+	signature, err := crypto.Sign(hash, key)
+	require.NoError(t, err)
+	sig := ecrecoverSignature(signature)
+	assert.True(t, sig[64] == 27 || sig[64] == 28)
+	assert.Equal(t, signature[64], sig[64]-27)
+
+	pub, err := crypto.SigToPub(hash, signature)
+	require.NoError(t, err)
+	assert.Equal(t, from, crypto.PubkeyToAddress(*pub))
+}
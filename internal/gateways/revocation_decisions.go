@@ -0,0 +1,78 @@
+package gateways
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	core "github.com/iden3/go-iden3-core"
+
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+)
+
+// maxRevocationDecisionsResponseSize limits the response read from the revocation decisions endpoint
+const maxRevocationDecisionsResponseSize = 1024 * 1024
+
+// revocationDecisionsResponse is the body of the answers of the revocation decisions endpoint
+type revocationDecisionsResponse struct {
+	Decisions []ports.RevocationDecisionRequest `json:"decisions"`
+}
+
+// RevocationDecisionsClient polls the revocation decisions endpoint of an external system
+type RevocationDecisionsClient struct {
+	client *http.Client
+	url    string
+	token  string
+}
+
+// NewRevocationDecisionsClient returns a revocation decisions gateway that polls the given endpoint. The token, if
+// any, is sent as a bearer token. Calls taking longer than timeout are cancelled.
+func NewRevocationDecisionsClient(endpoint, token string, timeout time.Duration) ports.RevocationDecisionsGateway {
+	return &RevocationDecisionsClient{client: &http.Client{Timeout: timeout}, url: endpoint, token: token}
+}
+
+// Fetch gets the decisions of the issuer with GET <endpoint>?issuer=<did>&since=<RFC 3339 time>. The endpoint must
+// answer a 200 with the decisions taken at or after since, and can send again decisions already received.
+func (c *RevocationDecisionsClient) Fetch(ctx context.Context, issuerDID core.DID, since *time.Time) ([]ports.RevocationDecisionRequest, error) {
+	endpoint, err := url.Parse(c.url)
+	if err != nil {
+		return nil, err
+	}
+	query := endpoint.Query()
+	query.Set("issuer", issuerDID.String())
+	if since != nil {
+		query.Set("since", since.UTC().Format(time.RFC3339Nano))
+	}
+	endpoint.RawQuery = query.Encode()
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Accept", "application/json")
+	if c.token != "" {
+		request.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := c.client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	var result revocationDecisionsResponse
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxRevocationDecisionsResponseSize)).Decode(&result); err != nil {
+		return nil, fmt.Errorf("invalid response: %w", err)
+	}
+	if len(result.Decisions) > ports.MaxRevocationDecisions {
+		return nil, fmt.Errorf("the response has more than %d decisions", ports.MaxRevocationDecisions)
+	}
+	return result.Decisions, nil
+}
@@ -0,0 +1,52 @@
+package gateways
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	core "github.com/iden3/go-iden3-core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRevocationDecisionsClient_Fetch(t *testing.T) {
+	did, err := core.ParseDID("did:iden3:polygon:mumbai:wyFiV4w71QgWPn6bYLsZoysFay66gKtVa9kfu6yMZ")
+	require.NoError(t, err)
+
+	var received *http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		if r.Header.Get("Authorization") != "Bearer a-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"decisions": [
+			{"id": "case-1", "credentialId": "0bd9fd8b-5b6d-4f1a-bb2c-2f9f06d1ad79", "reason": "fraud", "decidedAt": "2023-05-12T09:30:00Z"},
+			{"id": "case-2", "revocationNonce": 1234}
+		]}`))
+	}))
+	defer server.Close()
+
+	since := time.Date(2023, 5, 12, 9, 0, 0, 0, time.UTC)
+	decisions, err := NewRevocationDecisionsClient(server.URL+"/decisions?tenant=acme", "a-token", time.Second).Fetch(context.Background(), *did, &since)
+	require.NoError(t, err)
+	assert.Equal(t, "/decisions", received.URL.Path)
+	assert.Equal(t, "acme", received.URL.Query().Get("tenant"))
+	assert.Equal(t, did.String(), received.URL.Query().Get("issuer"))
+	assert.Equal(t, "2023-05-12T09:00:00Z", received.URL.Query().Get("since"))
+
+	require.Len(t, decisions, 2)
+	assert.Equal(t, "case-1", decisions[0].ID)
+	require.NotNil(t, decisions[0].CredentialID)
+	assert.Equal(t, "0bd9fd8b-5b6d-4f1a-bb2c-2f9f06d1ad79", decisions[0].CredentialID.String())
+	assert.Equal(t, "fraud", decisions[0].Reason)
+	require.NotNil(t, decisions[1].RevocationNonce)
+	assert.Equal(t, uint64(1234), *decisions[1].RevocationNonce)
+
+	_, err = NewRevocationDecisionsClient(server.URL, "", time.Second).Fetch(context.Background(), *did, nil)
+	assert.Error(t, err)
+	assert.False(t, received.URL.Query().Has("since"))
+}
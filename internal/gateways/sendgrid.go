@@ -0,0 +1,119 @@
+package gateways
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+)
+
+const (
+	// SendGridURL is the mail send endpoint of the SendGrid v3 API
+	SendGridURL = "https://api.sendgrid.com/v3/mail/send"
+	// maxSendGridErrorSize limits the error response read from SendGrid
+	maxSendGridErrorSize = 4 * 1024
+)
+
+// SendGridConfig has the API key and the sender address of the emails sent through SendGrid. URL is the mail send
+// endpoint, SendGridURL if empty.
+type SendGridConfig struct {
+	APIKey string
+	From   string
+	URL    string
+}
+
+// SendGridClient sends emails through the SendGrid API
+type SendGridClient struct {
+	cfg    SendGridConfig
+	client *http.Client
+}
+
+// NewSendGridClient returns a new SendGrid email gateway
+func NewSendGridClient(cfg SendGridConfig) ports.EmailGateway {
+	if cfg.URL == "" {
+		cfg.URL = SendGridURL
+	}
+	return &SendGridClient{cfg: cfg, client: &http.Client{Timeout: 30 * time.Second}}
+}
+
+type sendGridAddress struct {
+	Email string `json:"email"`
+}
+
+type sendGridPersonalization struct {
+	To []sendGridAddress `json:"to"`
+}
+
+type sendGridContent struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+type sendGridAttachment struct {
+	Content  string `json:"content"`
+	Type     string `json:"type"`
+	Filename string `json:"filename"`
+}
+
+type sendGridMessage struct {
+	Personalizations []sendGridPersonalization `json:"personalizations"`
+	From             sendGridAddress           `json:"from"`
+	Subject          string                    `json:"subject"`
+	Content          []sendGridContent         `json:"content"`
+	Attachments      []sendGridAttachment      `json:"attachments,omitempty"`
+}
+
+// Send posts the email to SendGrid. The recipients are sent in the same personalization, so they see each other as
+// they do with the smtp gateway.
+func (c *SendGridClient) Send(ctx context.Context, email *ports.Email) error {
+	if len(email.To) == 0 {
+		return ErrNoEmailRecipients
+	}
+
+	msg := sendGridMessage{
+		Personalizations: []sendGridPersonalization{{To: make([]sendGridAddress, len(email.To))}},
+		From:             sendGridAddress{Email: c.cfg.From},
+		Subject:          email.Subject,
+		Content:          []sendGridContent{{Type: email.ContentType, Value: string(email.Body)}},
+	}
+	for i, to := range email.To {
+		msg.Personalizations[0].To[i] = sendGridAddress{Email: to}
+	}
+	for _, attachment := range email.Attachments {
+		msg.Attachments = append(msg.Attachments, sendGridAttachment{
+			Content:  base64.StdEncoding.EncodeToString(attachment.Content),
+			Type:     attachment.ContentType,
+			Filename: attachment.Name,
+		})
+	}
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, c.cfg.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("Authorization", "Bearer "+c.cfg.APIKey)
+
+	resp, err := c.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	// SendGrid answers 202 when the email is queued
+	if resp.StatusCode/100 != 2 {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, maxSendGridErrorSize))
+		return fmt.Errorf("sendgrid answered with status %d: %s", resp.StatusCode, detail)
+	}
+	return nil
+}
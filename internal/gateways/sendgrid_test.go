@@ -0,0 +1,53 @@
+package gateways
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+)
+
+func TestSendGridClient_Send(t *testing.T) {
+	var received sendGridMessage
+	var authorization string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		if received.Subject == "fail" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"errors":[{"message":"invalid"}]}`))
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	client := NewSendGridClient(SendGridConfig{APIKey: "SG.key", From: "issuer@example.com", URL: server.URL})
+	email := &ports.Email{
+		To:          []string{"jane@example.com"},
+		Subject:     "Your credential",
+		ContentType: "text/html",
+		Body:        []byte("<p>Claim it</p>"),
+		Attachments: []ports.EmailAttachment{{Name: "a.txt", ContentType: "text/plain", Content: []byte("hi")}},
+	}
+	require.NoError(t, client.Send(context.Background(), email))
+	assert.Equal(t, "Bearer SG.key", authorization)
+	assert.Equal(t, "issuer@example.com", received.From.Email)
+	require.Len(t, received.Personalizations, 1)
+	assert.Equal(t, []sendGridAddress{{Email: "jane@example.com"}}, received.Personalizations[0].To)
+	assert.Equal(t, []sendGridContent{{Type: "text/html", Value: "<p>Claim it</p>"}}, received.Content)
+	assert.Equal(t, []sendGridAttachment{{Content: "aGk=", Type: "text/plain", Filename: "a.txt"}}, received.Attachments)
+
+	email.Subject = "fail"
+	err := client.Send(context.Background(), email)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "400")
+
+	assert.ErrorIs(t, client.Send(context.Background(), &ports.Email{Subject: "Your credential"}), ErrNoEmailRecipients)
+}
@@ -0,0 +1,367 @@
+package gateways
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/kms"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/network"
+	"github.com/polygonid/sh-id-platform/pkg/blockchain/eth"
+)
+
+const (
+	// minGasBumpPercent is the minimum fee increase nodes accept to replace a pending transaction
+	minGasBumpPercent = 10
+	// stateTransactionsLimit is the number of transactions returned for an identity
+	stateTransactionsLimit = 50
+)
+
+// ErrGasBumpLimit the fees of a transaction cannot be increased enough to replace it without exceeding the max gas price
+var ErrGasBumpLimit = errors.New("fee increase exceeds the max gas price of the network")
+
+// TransactionMonitorConfig configures when pending state transactions are resubmitted
+type TransactionMonitorConfig struct {
+	// StuckTimeout is the time a submission can stay pending before it is replaced
+	StuckTimeout time.Duration
+	// GasBumpPercent is the fee increase of every resubmission, at least 10
+	GasBumpPercent int
+	// MaxAttempts is the maximum number of submissions of a transaction
+	MaxAttempts int
+}
+
+// monitorClient is the part of the ethereum client used to follow and resubmit transactions
+type monitorClient interface {
+	GetTransactionReceiptByID(ctx context.Context, txID string) (*types.Receipt, error)
+	NonceAt(ctx context.Context, account ethCommon.Address) (uint64, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	SuggestFees(ctx context.Context) (*big.Int, *big.Int, error)
+	ChainID(ctx context.Context) (*big.Int, error)
+	SendRawTx(ctx context.Context, tx *types.Transaction) error
+}
+
+type monitorNetwork struct {
+	client      monitorClient
+	maxGasPrice *big.Int
+}
+
+// TransactionMonitor follows the state transactions sent by the publisher until they are mined. Transactions that stay
+// pending for too long, or whose fee cap is below the current base fee, are signed again with the same nonce and
+// higher fees, so a gas spike does not leave the identity state waiting forever.
+type TransactionMonitor struct {
+	storage  *db.Storage
+	repo     ports.StateTransactionRepository
+	kms      kms.KMSType
+	networks map[string]monitorNetwork
+	cfg      TransactionMonitorConfig
+}
+
+// NewTransactionMonitor returns a monitor for the transactions sent to every network of the resolver
+func NewTransactionMonitor(storage *db.Storage, repo ports.StateTransactionRepository, resolver *network.Resolver, kms kms.KMSType, cfg TransactionMonitorConfig) (*TransactionMonitor, error) {
+	if cfg.GasBumpPercent < minGasBumpPercent {
+		cfg.GasBumpPercent = minGasBumpPercent
+	}
+	networks := make(map[string]monitorNetwork)
+	for _, key := range resolver.Networks() {
+		cl, err := resolver.Client(key)
+		if err != nil {
+			return nil, err
+		}
+		networks[key] = monitorNetwork{client: cl, maxGasPrice: cl.Config.MaxGasPrice}
+	}
+	return &TransactionMonitor{
+		storage:  storage,
+		repo:     repo,
+		kms:      kms,
+		networks: networks,
+		cfg:      cfg,
+	}, nil
+}
+
+// Track starts following a transaction that has just been sent
+func (m *TransactionMonitor) Track(ctx context.Context, tx *domain.StateTransaction) error {
+	tx.ID = uuid.New()
+	tx.TxHashes = []string{tx.TxID}
+	tx.Attempts = 1
+	tx.Status = domain.StateTransactionPending
+	tx.SubmittedAt = time.Now()
+	return m.repo.Save(ctx, m.storage.Pgx, tx)
+}
+
+// GetByIdentifier returns the latest transactions sent for the identity
+func (m *TransactionMonitor) GetByIdentifier(ctx context.Context, identifier core.DID) ([]*domain.StateTransaction, error) {
+	return m.repo.GetByIdentifier(ctx, m.storage.Pgx, identifier, stateTransactionsLimit)
+}
+
+// Check reviews the pending transactions: mined ones are recorded, dropped ones are failed and stuck ones are
+// resubmitted with higher fees
+func (m *TransactionMonitor) Check(ctx context.Context) {
+	txs, err := m.repo.GetPending(ctx, m.storage.Pgx)
+	if err != nil {
+		log.Error(ctx, "cannot get pending state transactions", "err", err)
+		return
+	}
+	for _, tx := range txs {
+		if err := m.check(ctx, tx); err != nil {
+			log.Error(ctx, "cannot check state transaction", "err", err, "identifier", tx.Identifier, "txID", tx.TxID)
+		}
+	}
+}
+
+func (m *TransactionMonitor) check(ctx context.Context, tx *domain.StateTransaction) error {
+	nw, ok := m.networks[tx.Network]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrNetworkNotSupported, tx.Network)
+	}
+
+	receipt, hash, err := m.receipt(ctx, nw.client, tx)
+	if err != nil {
+		return err
+	}
+	if receipt != nil {
+		return m.mined(ctx, tx, hash, receipt)
+	}
+
+	nonce, err := nw.client.NonceAt(ctx, ethCommon.HexToAddress(tx.FromAddress))
+	if err != nil {
+		return err
+	}
+	if nonce > tx.Nonce {
+		// one of the submissions may have been mined after reading the receipts
+		receipt, hash, err := m.receipt(ctx, nw.client, tx)
+		if err != nil {
+			return err
+		}
+		if receipt != nil {
+			return m.mined(ctx, tx, hash, receipt)
+		}
+		return m.fail(ctx, tx, "nonce used by another transaction")
+	}
+
+	header, err := nw.client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return err
+	}
+	underpriced := header.BaseFee != nil && tx.GasFeeCap.Cmp(header.BaseFee) < 0
+	stuck := time.Since(tx.SubmittedAt) > m.cfg.StuckTimeout
+	if !underpriced && !stuck {
+		return nil
+	}
+	if tx.Attempts >= m.cfg.MaxAttempts {
+		log.Warn(ctx, "state transaction is stuck and has reached the max number of submissions", "identifier", tx.Identifier,
+			"txID", tx.TxID, "attempts", tx.Attempts)
+		return nil
+	}
+
+	suggestedTip, _, err := nw.client.SuggestFees(ctx)
+	if err != nil {
+		return err
+	}
+	tipCap, feeCap, err := bumpFees(tx, header.BaseFee, suggestedTip, m.cfg.GasBumpPercent, nw.maxGasPrice)
+	if err != nil {
+		log.Warn(ctx, "cannot resubmit state transaction", "err", err, "identifier", tx.Identifier, "txID", tx.TxID)
+		return nil
+	}
+	return m.resubmit(ctx, nw.client, tx, tipCap, feeCap)
+}
+
+// receipt returns the receipt of the submission of the transaction that was mined, if any
+func (m *TransactionMonitor) receipt(ctx context.Context, client monitorClient, tx *domain.StateTransaction) (*types.Receipt, string, error) {
+	for i := len(tx.TxHashes) - 1; i >= 0; i-- {
+		receipt, err := client.GetTransactionReceiptByID(ctx, tx.TxHashes[i])
+		if errors.Is(err, ethereum.NotFound) || errors.Is(err, eth.ErrReceiptNotReceived) {
+			continue
+		}
+		if err != nil {
+			return nil, "", err
+		}
+		return receipt, tx.TxHashes[i], nil
+	}
+	return nil, "", nil
+}
+
+// mined records the mined submission. The identity state is pointed to it, so the publisher confirms the state with
+// its receipt.
+func (m *TransactionMonitor) mined(ctx context.Context, tx *domain.StateTransaction, hash string, receipt *types.Receipt) error {
+	tx.TxID = hash
+	tx.Status = domain.StateTransactionMined
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		tx.Status = domain.StateTransactionFailed
+		tx.Error = common.ToPointer("transaction reverted")
+	}
+	err := m.storage.Pgx.BeginFunc(ctx, func(pgxTx pgx.Tx) error {
+		if err := m.repo.Save(ctx, pgxTx, tx); err != nil {
+			return err
+		}
+		return m.repo.UpdateIdentityState(ctx, pgxTx, tx, nil)
+	})
+	if err != nil {
+		return err
+	}
+	log.Info(ctx, "state transaction mined", "identifier", tx.Identifier, "txID", tx.TxID, "attempts", tx.Attempts, "status", tx.Status)
+	return nil
+}
+
+// fail records a transaction that will never be mined and fails its identity state, so it can be published again
+func (m *TransactionMonitor) fail(ctx context.Context, tx *domain.StateTransaction, reason string) error {
+	tx.Status = domain.StateTransactionFailed
+	tx.Error = &reason
+	err := m.storage.Pgx.BeginFunc(ctx, func(pgxTx pgx.Tx) error {
+		if err := m.repo.Save(ctx, pgxTx, tx); err != nil {
+			return err
+		}
+		return m.repo.UpdateIdentityState(ctx, pgxTx, tx, common.ToPointer(domain.StatusFailed))
+	})
+	if err != nil {
+		return err
+	}
+	log.Warn(ctx, "state transaction failed", "identifier", tx.Identifier, "txID", tx.TxID, "reason", reason)
+	return nil
+}
+
+// resubmit signs the transaction again with the new fees and sends it, replacing the pending submissions
+func (m *TransactionMonitor) resubmit(ctx context.Context, client monitorClient, tx *domain.StateTransaction, tipCap, feeCap *big.Int) error {
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		return err
+	}
+	to := ethCommon.HexToAddress(tx.ToAddress)
+	var data types.TxData
+	if tx.TxType == types.LegacyTxType {
+		data = &types.LegacyTx{Nonce: tx.Nonce, GasPrice: feeCap, Gas: tx.GasLimit, To: &to, Value: big.NewInt(0), Data: tx.Payload}
+	} else {
+		data = &types.DynamicFeeTx{
+			ChainID:   chainID,
+			Nonce:     tx.Nonce,
+			GasTipCap: tipCap,
+			GasFeeCap: feeCap,
+			Gas:       tx.GasLimit,
+			To:        &to,
+			Value:     big.NewInt(0),
+			Data:      tx.Payload,
+		}
+	}
+
+	unsigned := types.NewTx(data)
+	signer := types.LatestSignerForChainID(chainID)
+	h := signer.Hash(unsigned)
+	sig, err := m.kms.Sign(ctx, kms.KeyID{Type: kms.KeyType(tx.KeyType), ID: tx.KeyID}, h[:])
+	if err != nil {
+		return err
+	}
+	signedTx, err := unsigned.WithSignature(signer, sig)
+	if err != nil {
+		return fmt.Errorf("failed sign transaction: %w", err)
+	}
+	if err := client.SendRawTx(ctx, signedTx); err != nil {
+		return fmt.Errorf("resubmitting transaction: %w", err)
+	}
+
+	previous := tx.TxID
+	tx.TxID = signedTx.Hash().Hex()
+	tx.TxHashes = append(tx.TxHashes, tx.TxID)
+	tx.GasTipCap = tipCap
+	tx.GasFeeCap = feeCap
+	tx.Attempts++
+	tx.SubmittedAt = time.Now()
+	err = m.storage.Pgx.BeginFunc(ctx, func(pgxTx pgx.Tx) error {
+		if err := m.repo.Save(ctx, pgxTx, tx); err != nil {
+			return err
+		}
+		return m.repo.UpdateIdentityState(ctx, pgxTx, tx, nil)
+	})
+	if err != nil {
+		return err
+	}
+	log.Info(ctx, "state transaction resubmitted", "identifier", tx.Identifier, "previous", previous, "txID", tx.TxID,
+		"attempts", tx.Attempts, "tip", tipCap, "maxFee", feeCap)
+	return nil
+}
+
+// bumpFees returns the fees of the next submission of a transaction. Both fees are increased by the given percentage,
+// and never set below what the network currently asks for. Nodes reject replacements that increase the fees less than
+// 10%, so ErrGasBumpLimit is returned when the max gas price does not leave room for that.
+func bumpFees(tx *domain.StateTransaction, baseFee, suggestedTip *big.Int, percent int, maxGasPrice *big.Int) (tipCap, feeCap *big.Int, err error) {
+	feeCap = increase(tx.GasFeeCap, percent)
+	if tx.TxType == types.LegacyTxType {
+		if baseFee != nil {
+			feeCap = maxBig(feeCap, new(big.Int).Add(baseFee, suggestedTip))
+		}
+	} else {
+		tipCap = maxBig(increase(tx.GasTipCap, percent), suggestedTip)
+		if baseFee != nil {
+			// leave room for the base fee to double before the transaction is mined
+			feeCap = maxBig(feeCap, new(big.Int).Add(new(big.Int).Mul(baseFee, big.NewInt(2)), tipCap))
+		}
+	}
+
+	if maxGasPrice != nil && maxGasPrice.Sign() > 0 && feeCap.Cmp(maxGasPrice) > 0 {
+		feeCap = new(big.Int).Set(maxGasPrice)
+	}
+	if tipCap != nil && tipCap.Cmp(feeCap) > 0 {
+		tipCap = new(big.Int).Set(feeCap)
+	}
+
+	if feeCap.Cmp(increase(tx.GasFeeCap, minGasBumpPercent)) < 0 {
+		return nil, nil, ErrGasBumpLimit
+	}
+	if tipCap != nil && tipCap.Cmp(increase(tx.GasTipCap, minGasBumpPercent)) < 0 {
+		return nil, nil, ErrGasBumpLimit
+	}
+	return tipCap, feeCap, nil
+}
+
+// newStateTransaction returns the record of a state transition that has been sent for the identity
+func newStateTransaction(identifier *core.DID, newState string, keyID kms.KeyID, from ethCommon.Address, signedTx *types.Transaction) *domain.StateTransaction {
+	tx := &domain.StateTransaction{
+		Identifier:  identifier.String(),
+		State:       newState,
+		Network:     network.Key(*identifier),
+		TxID:        signedTx.Hash().Hex(),
+		TxType:      signedTx.Type(),
+		KeyType:     string(keyID.Type),
+		KeyID:       keyID.ID,
+		FromAddress: from.Hex(),
+		Nonce:       signedTx.Nonce(),
+		GasLimit:    signedTx.Gas(),
+		GasFeeCap:   signedTx.GasFeeCap(),
+		Payload:     signedTx.Data(),
+	}
+	if signedTx.To() != nil {
+		tx.ToAddress = signedTx.To().Hex()
+	}
+	if signedTx.Type() != types.LegacyTxType {
+		tx.GasTipCap = signedTx.GasTipCap()
+	}
+	return tx
+}
+
+// increase returns v increased by the given percentage
+func increase(v *big.Int, percent int) *big.Int {
+	if v == nil {
+		return big.NewInt(0)
+	}
+	r := new(big.Int).Mul(v, big.NewInt(int64(100+percent)))
+	return r.Div(r, big.NewInt(100))
+}
+
+func maxBig(a, b *big.Int) *big.Int {
+	if b != nil && a.Cmp(b) < 0 {
+		return b
+	}
+	return a
+}
@@ -0,0 +1,78 @@
+package gateways
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+func TestBumpFees(t *testing.T) {
+	type expected struct {
+		tipCap *big.Int
+		feeCap *big.Int
+		err    error
+	}
+	type testConfig struct {
+		name         string
+		tx           *domain.StateTransaction
+		baseFee      *big.Int
+		suggestedTip *big.Int
+		maxGasPrice  *big.Int
+		expected     expected
+	}
+	for _, tc := range []testConfig{
+		{
+			name:         "stuck transaction, fees increased by the percentage",
+			tx:           &domain.StateTransaction{TxType: types.DynamicFeeTxType, GasTipCap: big.NewInt(100), GasFeeCap: big.NewInt(1000)},
+			baseFee:      big.NewInt(200),
+			suggestedTip: big.NewInt(50),
+			expected:     expected{tipCap: big.NewInt(120), feeCap: big.NewInt(1200)},
+		},
+		{
+			name:         "underpriced transaction, fees set to the network ones",
+			tx:           &domain.StateTransaction{TxType: types.DynamicFeeTxType, GasTipCap: big.NewInt(100), GasFeeCap: big.NewInt(1000)},
+			baseFee:      big.NewInt(1500),
+			suggestedTip: big.NewInt(300),
+			expected:     expected{tipCap: big.NewInt(300), feeCap: big.NewInt(3300)},
+		},
+		{
+			name:         "fee cap limited by the max gas price",
+			tx:           &domain.StateTransaction{TxType: types.DynamicFeeTxType, GasTipCap: big.NewInt(100), GasFeeCap: big.NewInt(1000)},
+			baseFee:      big.NewInt(1500),
+			suggestedTip: big.NewInt(300),
+			maxGasPrice:  big.NewInt(2000),
+			expected:     expected{tipCap: big.NewInt(300), feeCap: big.NewInt(2000)},
+		},
+		{
+			name:         "max gas price leaves no room for a replacement",
+			tx:           &domain.StateTransaction{TxType: types.DynamicFeeTxType, GasTipCap: big.NewInt(100), GasFeeCap: big.NewInt(1000)},
+			baseFee:      big.NewInt(200),
+			suggestedTip: big.NewInt(50),
+			maxGasPrice:  big.NewInt(1050),
+			expected:     expected{err: ErrGasBumpLimit},
+		},
+		{
+			name:         "legacy transaction, only the gas price is increased",
+			tx:           &domain.StateTransaction{TxType: types.LegacyTxType, GasFeeCap: big.NewInt(1000)},
+			baseFee:      big.NewInt(200),
+			suggestedTip: big.NewInt(50),
+			expected:     expected{feeCap: big.NewInt(1200)},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			tipCap, feeCap, err := bumpFees(tc.tx, tc.baseFee, tc.suggestedTip, 20, tc.maxGasPrice)
+			if tc.expected.err != nil {
+				assert.ErrorIs(t, err, tc.expected.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected.feeCap, feeCap)
+			assert.Equal(t, tc.expected.tipCap, tipCap)
+		})
+	}
+}
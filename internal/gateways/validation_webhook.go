@@ -0,0 +1,78 @@
+package gateways
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5/middleware"
+
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+)
+
+const (
+	// ValidationWebhookSignatureHeader carries the HMAC-SHA256 of the request body, hex encoded with a sha256= prefix
+	ValidationWebhookSignatureHeader = "X-Issuer-Signature-256"
+	// maxValidationResponseSize limits the response read from a validation webhook
+	maxValidationResponseSize = 64 * 1024
+)
+
+// ValidationWebhookClient calls the validation webhooks of the schemas
+type ValidationWebhookClient struct {
+	client *http.Client
+}
+
+// NewValidationWebhookClient returns a validation webhook gateway. Calls taking longer than timeout are cancelled.
+func NewValidationWebhookClient(timeout time.Duration) ports.ValidationWebhookGateway {
+	return &ValidationWebhookClient{client: &http.Client{Timeout: timeout}}
+}
+
+// Validate posts the credential to the webhook. Any answer but a 200 with a validation result is an error, so
+// credentials are not issued while the webhook is failing.
+func (c *ValidationWebhookClient) Validate(ctx context.Context, url, secret string, req *ports.CredentialValidationRequest) (*ports.CredentialValidationResult, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	if reqID := middleware.GetReqID(ctx); reqID != "" {
+		request.Header.Set(middleware.RequestIDHeader, reqID)
+	}
+	if secret != "" {
+		request.Header.Set(ValidationWebhookSignatureHeader, "sha256="+signValidationRequest(secret, body))
+	}
+
+	resp, err := c.client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	var result ports.CredentialValidationResult
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxValidationResponseSize)).Decode(&result); err != nil {
+		return nil, fmt.Errorf("invalid response: %w", err)
+	}
+	return &result, nil
+}
+
+// signValidationRequest returns the hex encoded HMAC-SHA256 of the body
+func signValidationRequest(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
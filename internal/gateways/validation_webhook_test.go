@@ -0,0 +1,53 @@
+package gateways
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+)
+
+func TestValidationWebhookClient_Validate(t *testing.T) {
+	const secret = "a-long-random-secret"
+	var signature string
+	var received ports.CredentialValidationRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(body, &received))
+		signature = r.Header.Get(ValidationWebhookSignatureHeader)
+		assert.Equal(t, "sha256="+signValidationRequest(secret, body), signature)
+		if received.CredentialSubject["country"] == "XX" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"approved": false, "reason": "underage"}`))
+	}))
+	defer server.Close()
+
+	client := NewValidationWebhookClient(time.Second)
+	req := &ports.CredentialValidationRequest{
+		Issuer:            "did:iden3:polygon:mumbai:wyFiV4w71QgWPn6bYLsZoysFay66gKtVa9kfu6yMZ",
+		Schema:            "https://example.com/kyc.json",
+		Type:              "KYCAgeCredential",
+		CredentialSubject: map[string]any{"birthday": float64(20100101), "country": "ES"},
+	}
+	result, err := client.Validate(context.Background(), server.URL, secret, req)
+	require.NoError(t, err)
+	assert.False(t, result.Approved)
+	assert.Equal(t, "underage", result.Reason)
+	assert.Equal(t, req.CredentialSubject, received.CredentialSubject)
+	assert.NotEmpty(t, signature)
+
+	req.CredentialSubject["country"] = "XX"
+	_, err = client.Validate(context.Background(), server.URL, secret, req)
+	assert.Error(t, err)
+}
@@ -2,12 +2,16 @@ package health
 
 import (
 	"context"
+	"net/http"
+	"strconv"
 	"sync"
 	"time"
 )
 
 const (
 	DefaultPingPeriod = 5 * time.Second // DefaultPingPeriod is a recommendation to ping any service
+	readyKey          = "ready"         // readyKey is the status entry reported while a warm-up gates traffic
+	notReadyRetry     = 5 * time.Second // notReadyRetry is the Retry-After sent to clients while the server is warming up
 )
 
 // Status struct
@@ -15,6 +19,8 @@ type Status struct {
 	sync.RWMutex
 	monitors     Monitors
 	lastStatuses map[string]bool
+	gated        bool
+	ready        bool
 }
 
 // Pinger is a function that return error if cannot ping. False otherwise
@@ -28,6 +34,53 @@ func New(m Monitors) *Status {
 	return &Status{
 		monitors:     m,
 		lastStatuses: make(map[string]bool, len(m)),
+		ready:        true,
+	}
+}
+
+// NotReady marks the server as not ready to accept traffic. Requests going through ReadinessGate will be
+// rejected until SetReady is called. It is used to keep the server out of rotation while caches are warming up.
+func (s *Status) NotReady() {
+	s.Lock()
+	defer s.Unlock()
+	s.gated = true
+	s.ready = false
+}
+
+// SetReady marks the server as ready to accept traffic.
+func (s *Status) SetReady() {
+	s.Lock()
+	defer s.Unlock()
+	s.ready = true
+}
+
+// Ready tells whether the server is ready to accept traffic
+func (s *Status) Ready() bool {
+	s.RLock()
+	defer s.RUnlock()
+	return s.ready
+}
+
+// ReadinessGate is a chi middleware that rejects requests with a 503 Service Unavailable while the server is not ready.
+// Requests to any of the given paths, like the health endpoint, are always allowed.
+func (s *Status) ReadinessGate(allowed ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if s.Ready() {
+				next.ServeHTTP(w, r)
+				return
+			}
+			for _, path := range allowed {
+				if r.URL.Path == path {
+					next.ServeHTTP(w, r)
+					return
+				}
+			}
+			w.Header().Set("Retry-After", strconv.Itoa(int(notReadyRetry.Seconds())))
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(`{"message":"server is warming up"}`))
+		})
 	}
 }
 
@@ -52,7 +105,15 @@ func (s *Status) Run(ctx context.Context, t time.Duration) {
 func (s *Status) Status() map[string]bool {
 	s.RLock()
 	defer s.RUnlock()
-	return s.lastStatuses
+	if !s.gated {
+		return s.lastStatuses
+	}
+	statuses := make(map[string]bool, len(s.lastStatuses)+1)
+	for service, ok := range s.lastStatuses {
+		statuses[service] = ok
+	}
+	statuses[readyKey] = s.ready
+	return statuses
 }
 
 func (s *Status) checkStatus(ctx context.Context) {
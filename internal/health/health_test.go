@@ -0,0 +1,36 @@
+package health
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatus_ReadinessGate(t *testing.T) {
+	s := New(Monitors{})
+	handler := s.ReadinessGate("/status")(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	serve := func(path string) *httptest.ResponseRecorder {
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, path, nil))
+		return rr
+	}
+
+	assert.Equal(t, http.StatusOK, serve("/v1/identities").Code, "ready by default")
+	assert.NotContains(t, s.Status(), readyKey)
+
+	s.NotReady()
+	rr := serve("/v1/identities")
+	assert.Equal(t, http.StatusServiceUnavailable, rr.Code)
+	assert.Equal(t, "5", rr.Header().Get("Retry-After"))
+	assert.Equal(t, http.StatusOK, serve("/status").Code, "allowed paths are not gated")
+	assert.False(t, s.Status()[readyKey])
+
+	s.SetReady()
+	assert.Equal(t, http.StatusOK, serve("/v1/identities").Code)
+	assert.True(t, s.Status()[readyKey])
+}
@@ -0,0 +1,122 @@
+package jsonschema
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+const xsdNamespace = "http://www.w3.org/2001/XMLSchema#"
+
+// attributeTypes are the JSON Schema type and format and the JSON-LD type of every attribute type of the builder
+var attributeTypes = map[string]struct {
+	jsonType string
+	format   string
+	ldType   string
+}{
+	domain.SchemaAttributeString:  {jsonType: "string", ldType: "xsd:string"},
+	domain.SchemaAttributeInteger: {jsonType: "integer", ldType: "xsd:integer"},
+	domain.SchemaAttributeNumber:  {jsonType: "number", ldType: "xsd:double"},
+	domain.SchemaAttributeBoolean: {jsonType: "boolean", ldType: "xsd:boolean"},
+	domain.SchemaAttributeDate:    {jsonType: "string", format: "date", ldType: "xsd:dateTime"},
+}
+
+// Build generates the JSON Schema and the JSON-LD context of a merklized credential with the attributes of the
+// definition. The JSON Schema points at the context with its jsonLdContext metadata and the terms of the context are
+// defined under the vocabulary of the context url.
+func Build(def *domain.SchemaDefinition, contextURL string) (*JSONSchema, []byte, error) {
+	if err := def.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	properties := map[string]any{
+		"id": map[string]any{"title": "Credential Subject ID", "type": "string", "format": "uri"},
+	}
+	required := make([]string, 0, len(def.Attributes))
+	terms := map[string]any{
+		"@version":   1.1,
+		"@protected": true,
+		"id":         "@id",
+		"type":       "@type",
+		"vocab":      contextURL + "#",
+		"xsd":        xsdNamespace,
+	}
+	for _, attr := range def.Attributes {
+		t := attributeTypes[attr.Type]
+		property := map[string]any{"type": t.jsonType}
+		if t.format != "" {
+			property["format"] = t.format
+		}
+		if attr.Title != "" {
+			property["title"] = attr.Title
+		}
+		if attr.Description != "" {
+			property["description"] = attr.Description
+		}
+		properties[attr.Name] = property
+		if attr.Required {
+			required = append(required, attr.Name)
+		}
+		terms[attr.Name] = map[string]any{"@id": "vocab:" + attr.Name, "@type": t.ldType}
+	}
+
+	credentialSubject := map[string]any{"type": "object", "properties": properties}
+	if len(required) > 0 {
+		credentialSubject["required"] = required
+	}
+	content := map[string]any{
+		"$schema": "http://json-schema.org/draft-07/schema#",
+		"$metadata": map[string]any{
+			"uris": map[string]any{"jsonLdContext": contextURL},
+			"type": def.Type,
+		},
+		"type":     "object",
+		"required": []string{"@context", "id", "type", "issuanceDate", "credentialSubject", "credentialSchema", "credentialStatus", "issuer"},
+		"properties": map[string]any{
+			"@context":          map[string]any{"type": []string{"string", "array", "object"}},
+			"id":                map[string]any{"type": "string"},
+			"type":              map[string]any{"type": []string{"string", "array"}, "items": map[string]any{"type": "string"}},
+			"issuer":            map[string]any{"type": []string{"string", "object"}, "format": "uri", "required": []string{"id"}, "properties": map[string]any{"id": map[string]any{"type": "string", "format": "uri"}}},
+			"issuanceDate":      map[string]any{"type": "string", "format": "date-time"},
+			"expirationDate":    map[string]any{"type": "string", "format": "date-time"},
+			"credentialSchema":  map[string]any{"type": "object", "required": []string{"id", "type"}, "properties": map[string]any{"id": map[string]any{"type": "string", "format": "uri"}, "type": map[string]any{"type": "string"}}},
+			"credentialSubject": credentialSubject,
+		},
+	}
+	if def.Title != "" {
+		content["title"] = def.Title
+	}
+	if def.Description != "" {
+		content["description"] = def.Description
+	}
+
+	ldContext, err := json.Marshal(map[string]any{
+		"@context": []any{map[string]any{
+			"@version":   1.1,
+			"@protected": true,
+			"id":         "@id",
+			"type":       "@type",
+			def.Type:     map[string]any{"@id": contextURL + "#" + def.Type, "@context": terms},
+		}},
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("encoding json-ld context: %w", err)
+	}
+
+	// the schema goes through json like a loaded one, so its attributes are read the same way
+	raw, err := json.Marshal(content)
+	if err != nil {
+		return nil, nil, fmt.Errorf("encoding json schema: %w", err)
+	}
+	schema := &JSONSchema{content: make(map[string]any)}
+	if err := json.Unmarshal(raw, &schema.content); err != nil {
+		return nil, nil, err
+	}
+	return schema, ldContext, nil
+}
+
+// Bytes returns the json encoding of the schema
+func (s *JSONSchema) Bytes() ([]byte, error) {
+	return json.Marshal(s.content)
+}
@@ -0,0 +1,80 @@
+package jsonschema
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/iden3/go-schema-processor/utils"
+	"github.com/piprate/json-gold/ld"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// contextLoader serves a single JSON-LD context
+type contextLoader struct {
+	url      string
+	document []byte
+}
+
+func (l contextLoader) LoadDocument(u string) (*ld.RemoteDocument, error) {
+	if u != l.url {
+		return nil, ld.NewJsonLdError(ld.LoadingDocumentFailed, u)
+	}
+	var doc any
+	if err := json.Unmarshal(l.document, &doc); err != nil {
+		return nil, err
+	}
+	return &ld.RemoteDocument{DocumentURL: u, Document: doc}, nil
+}
+
+func TestBuild(t *testing.T) {
+	const contextURL = "https://issuer.example.com/v1/schemas/0d6c1d5e-0b9f-4c35-8f3b-3f7c2c0e7a11/context.jsonld"
+	def := &domain.SchemaDefinition{
+		Type:  "Membership",
+		Title: "Membership",
+		Attributes: []domain.SchemaAttributeDefinition{
+			{Name: "level", Type: domain.SchemaAttributeInteger, Title: "Level", Required: true},
+			{Name: "since", Type: domain.SchemaAttributeDate},
+			{Name: "active", Type: domain.SchemaAttributeBoolean},
+		},
+	}
+
+	schema, ldContext, err := Build(def, contextURL)
+	require.NoError(t, err)
+
+	gotContext, err := schema.JSONLdContext()
+	require.NoError(t, err)
+	assert.Equal(t, contextURL, gotContext)
+
+	hash, err := schema.SchemaHash(def.Type)
+	require.NoError(t, err)
+	assert.Equal(t, utils.CreateSchemaHash([]byte(contextURL+"#Membership")), hash)
+
+	attrs, err := schema.Attributes()
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"Credential Subject ID(id)", "Level(level)", "since", "active"}, attrs.SchemaAttrs())
+
+	t.Run("the context defines the type and the attributes", func(t *testing.T) {
+		options := ld.NewJsonLdOptions("")
+		options.DocumentLoader = contextLoader{url: contextURL, document: ldContext}
+		expanded, err := ld.NewJsonLdProcessor().Expand(map[string]any{
+			"@context": contextURL,
+			"type":     "Membership",
+			"level":    3,
+			"active":   true,
+		}, options)
+		require.NoError(t, err)
+		require.Len(t, expanded, 1)
+		subject := expanded[0].(map[string]any)
+		assert.Equal(t, []any{contextURL + "#Membership"}, subject["@type"])
+		assert.Equal(t, []any{map[string]any{"@type": "http://www.w3.org/2001/XMLSchema#integer", "@value": 3}}, subject[contextURL+"#level"])
+		assert.Equal(t, []any{map[string]any{"@type": "http://www.w3.org/2001/XMLSchema#boolean", "@value": true}}, subject[contextURL+"#active"])
+	})
+
+	t.Run("invalid definition", func(t *testing.T) {
+		_, _, err := Build(&domain.SchemaDefinition{Type: "Membership"}, contextURL)
+		assert.Error(t, err)
+	})
+}
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"regexp"
+	"strings"
 
 	core "github.com/iden3/go-iden3-core"
 	jsonSuite "github.com/iden3/go-schema-processor/json"
@@ -13,6 +15,7 @@ import (
 	"github.com/iden3/go-schema-processor/utils"
 	"github.com/mitchellh/mapstructure"
 	"github.com/piprate/json-gold/ld"
+	qri "github.com/qri-io/jsonschema"
 
 	"github.com/polygonid/sh-id-platform/internal/loader"
 )
@@ -22,6 +25,29 @@ const (
 	fakeIssuerDID = "did:polygonid:polygon:mumbai:2qH7XAwYQzCp9VfhpNgeLtK2iCehDDrfMWUCEg5ig5"
 )
 
+// requiredError matches the message of the validator for a missing required attribute
+var requiredError = regexp.MustCompile(`^"(.+)" value is required$`)
+
+// FieldError is an attribute of a credential subject that does not match the schema. Field is the dot separated path
+// of the attribute.
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+// SubjectError lists the attributes of a credential subject that do not match the schema
+type SubjectError struct {
+	Fields []FieldError
+}
+
+func (e *SubjectError) Error() string {
+	msgs := make([]string, len(e.Fields))
+	for i, f := range e.Fields {
+		msgs[i] = fmt.Sprintf("%s: %s", f.Field, f.Message)
+	}
+	return strings.Join(msgs, "; ")
+}
+
 // Attributes is a list of Attribute entities
 type Attributes []Attribute
 
@@ -139,6 +165,51 @@ func (s *JSONSchema) SchemaHash(schemaType string) (core.SchemaHash, error) {
 	return utils.CreateSchemaHash([]byte(id)), nil
 }
 
+// ValidateSubject validates the credential subject against properties.credentialSubject of the schema: the types,
+// required attributes, enums and formats of the attributes. If it does not match, a *SubjectError with every wrong
+// attribute is returned.
+func (s *JSONSchema) ValidateSubject(ctx context.Context, credentialSubject map[string]any) error {
+	props, ok := s.content["properties"].(map[string]any)
+	if !ok {
+		return errors.New("missing properties field")
+	}
+	subjectSchema, ok := props["credentialSubject"]
+	if !ok {
+		return errors.New("missing properties.credentialSubject field")
+	}
+	raw, err := json.Marshal(subjectSchema)
+	if err != nil {
+		return err
+	}
+	validator := &qri.Schema{}
+	if err := json.Unmarshal(raw, validator); err != nil {
+		return fmt.Errorf("parsing properties.credentialSubject: %w", err)
+	}
+	subject, err := json.Marshal(credentialSubject)
+	if err != nil {
+		return err
+	}
+	keyErrors, err := validator.ValidateBytes(ctx, subject)
+	if err != nil {
+		return err
+	}
+	if len(keyErrors) == 0 {
+		return nil
+	}
+
+	subjectErr := &SubjectError{Fields: make([]FieldError, 0, len(keyErrors))}
+	for _, keyErr := range keyErrors {
+		field := strings.ReplaceAll(strings.Trim(keyErr.PropertyPath, "/"), "/", ".")
+		msg := keyErr.Message
+		if match := requiredError.FindStringSubmatch(msg); match != nil {
+			field = strings.TrimPrefix(field+"."+match[1], ".")
+			msg = "is required"
+		}
+		subjectErr.Fields = append(subjectErr.Fields, FieldError{Field: field, Message: msg})
+	}
+	return subjectErr
+}
+
 // ValidateCredentialSubject validates that the given credential subject matches the given schema
 func ValidateCredentialSubject(ctx context.Context, loader loader.Loader, schemaType string, cSubject map[string]interface{}) error {
 	schema, err := Load(ctx, loader)
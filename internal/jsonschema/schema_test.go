@@ -2,9 +2,11 @@ package jsonschema
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/polygonid/sh-id-platform/internal/loader"
 	"github.com/polygonid/sh-id-platform/pkg/cache"
@@ -100,3 +102,67 @@ func TestValidateCredentialSubject(t *testing.T) {
 		})
 	}
 }
+
+func TestJSONSchema_ValidateSubject(t *testing.T) {
+	const doc = `{
+		"properties": {
+			"credentialSubject": {
+				"type": "object",
+				"required": ["level", "since"],
+				"properties": {
+					"id": {"type": "string", "format": "uri"},
+					"level": {"type": "integer", "enum": [1, 2, 3]},
+					"since": {"type": "string", "format": "date"},
+					"address": {
+						"type": "object",
+						"properties": {"country": {"type": "string"}}
+					}
+				}
+			}
+		}
+	}`
+	schema := &JSONSchema{}
+	require.NoError(t, json.Unmarshal([]byte(doc), &schema.content))
+	ctx := context.Background()
+
+	for _, tc := range []struct {
+		name     string
+		subject  map[string]any
+		expected []FieldError
+	}{
+		{
+			name:    "valid subject",
+			subject: map[string]any{"id": "did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ", "level": 2, "since": "2023-05-06"},
+		},
+		{
+			name:     "missing required attribute",
+			subject:  map[string]any{"level": 1},
+			expected: []FieldError{{Field: "since", Message: "is required"}},
+		},
+		{
+			name:    "wrong type, enum and format",
+			subject: map[string]any{"id": "polygonid", "level": "2", "since": "2023-05-06"},
+			expected: []FieldError{
+				{Field: "level", Message: `should be one of [1, 2, 3]`},
+				{Field: "level", Message: `type should be integer, got string`},
+				{Field: "id", Message: `invalid uri: uri missing scheme prefix`},
+			},
+		},
+		{
+			name:     "nested attribute",
+			subject:  map[string]any{"level": 3, "since": "2023-05-06", "address": map[string]any{"country": 34}},
+			expected: []FieldError{{Field: "address.country", Message: "type should be string, got integer"}},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := schema.ValidateSubject(ctx, tc.subject)
+			if tc.expected == nil {
+				assert.NoError(t, err)
+				return
+			}
+			var subjectErr *SubjectError
+			require.ErrorAs(t, err, &subjectErr)
+			assert.ElementsMatch(t, tc.expected, subjectErr.Fields)
+		})
+	}
+}
@@ -0,0 +1,240 @@
+package kms
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"regexp"
+	"strings"
+
+	core "github.com/iden3/go-iden3-core"
+	"github.com/iden3/go-iden3-crypto/utils"
+)
+
+// awsBJJKeyProvider keeps BabyJubJub private keys in AWS Secrets Manager. AWS KMS does not support the
+// BabyJubJub curve, so the keys are read from Secrets Manager and the data is signed locally.
+// Key IDs have the same format as the ones of the vault provider, the secret name is the key ID with the colons
+// replaced by underscores. The JWS keys are kept the same way, Ed25519 keys are not supported by AWS KMS.
+type awsBJJKeyProvider struct {
+	keyType          KeyType
+	awsCli           *awsClient
+	reIdenKeyPathHex *regexp.Regexp // RE of key path bounded to identity
+	reAnonKeyPathHex *regexp.Regexp // RE of key path not bounded to identity
+	reSecretName     *regexp.Regexp // RE of the last element of the secret name
+}
+
+// NewAWSBJJKeyProvider creates new key provider for BabyJubJub keys stored in AWS Secrets Manager
+func NewAWSBJJKeyProvider(cfg AWSConfig, keyType KeyType) (KeyProvider, error) {
+	awsCli, err := newAWSClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	keyTypeRE := regexp.QuoteMeta(string(keyType))
+	hexRE := "([a-f0-9]{" + localPublicKeyHexLength(keyType) + "})$"
+	return &awsBJJKeyProvider{
+		keyType:          keyType,
+		awsCli:           awsCli,
+		reIdenKeyPathHex: regexp.MustCompile("^(?i).*/" + keyTypeRE + ":" + hexRE),
+		reAnonKeyPathHex: regexp.MustCompile("^(?i)" + keyTypeRE + ":" + hexRE),
+		reSecretName:     regexp.MustCompile("^(?i)" + keyTypeRE + "_" + hexRE),
+	}, nil
+}
+
+func (a *awsBJJKeyProvider) New(identity *core.DID) (KeyID, error) {
+	pubKey, privKey, err := newLocalKey(a.keyType)
+	if err != nil {
+		return KeyID{}, err
+	}
+	keyID := KeyID{
+		Type: a.keyType,
+		ID:   keyPath(identity, a.keyType, pubKey),
+	}
+	return keyID, a.saveKeyMaterial(context.Background(), keyID.ID, map[string]string{
+		jsonKeyType: string(keyID.Type),
+		jsonKeyData: hex.EncodeToString(privKey),
+	})
+}
+
+// LinkToIdentity moves the secret of an unbound key to the identity. Secrets can't be renamed, so the secret is
+// copied and the old one deleted.
+func (a *awsBJJKeyProvider) LinkToIdentity(ctx context.Context, keyID KeyID, identity core.DID) (KeyID, error) {
+	if keyID.Type != a.keyType {
+		return keyID, ErrIncorrectKeyType
+	}
+
+	ss := a.reAnonKeyPathHex.FindStringSubmatch(keyID.ID)
+	if len(ss) != partsNumber {
+		return keyID, errors.New("key ID does not looks like unbound")
+	}
+
+	newKeyID := KeyID{
+		Type: keyID.Type,
+		ID:   keyPath(&identity, a.keyType, ss[1]),
+	}
+
+	keyMaterial, err := a.keyMaterial(ctx, keyID.ID)
+	if err != nil {
+		return keyID, err
+	}
+	if err := a.saveKeyMaterial(ctx, newKeyID.ID, keyMaterial); err != nil {
+		return keyID, err
+	}
+	return newKeyID, a.awsCli.call(ctx, awsServiceSecretsManager, "DeleteSecret", map[string]string{"SecretId": a.secretName(keyID.ID)}, nil)
+}
+
+// Sign signs *big.Int using poseidon algorithm.
+// data should be a little-endian bytes representation of *big.Int.
+// JWS keys sign the JWS signing input.
+func (a *awsBJJKeyProvider) Sign(ctx context.Context, keyID KeyID, data []byte) ([]byte, error) {
+	if isJWSKeyType(a.keyType) {
+		privKeyData, err := a.privateKey(ctx, keyID)
+		if err != nil {
+			return nil, err
+		}
+		return signJWS(a.keyType, privKeyData, data)
+	}
+
+	if len(data) > defaultLength {
+		return nil, errors.New("data to sign is too large")
+	}
+
+	i := new(big.Int).SetBytes(utils.SwapEndianness(data))
+	if !utils.CheckBigIntInField(i) {
+		return nil, errors.New("data to sign is too large")
+	}
+
+	privKeyData, err := a.privateKey(ctx, keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	privKey, err := decodeBJJPrivateKey(privKeyData)
+	if err != nil {
+		return nil, err
+	}
+
+	sig := privKey.SignPoseidon(i).Compress()
+	return sig[:], nil
+}
+
+func (a *awsBJJKeyProvider) ListByIdentity(ctx context.Context, identity core.DID) ([]KeyID, error) {
+	path := identityPath(&identity)
+	prefix := a.secretName(path) + "/"
+
+	var result []KeyID
+	input := map[string]interface{}{
+		"MaxResults": awsListPageSize,
+		"Filters":    []map[string]interface{}{{"Key": "name", "Values": []string{prefix}}},
+	}
+	for {
+		var output struct {
+			SecretList []struct {
+				Name string `json:"Name"`
+			} `json:"SecretList"`
+			NextToken string `json:"NextToken"`
+		}
+		if err := a.awsCli.call(ctx, awsServiceSecretsManager, "ListSecrets", input, &output); err != nil {
+			return nil, err
+		}
+		for _, secret := range output.SecretList {
+			// the name filter matches prefixes of any word of the name, so the prefix is checked again
+			name := strings.TrimPrefix(secret.Name, prefix)
+			ss := a.reSecretName.FindStringSubmatch(name)
+			if !strings.HasPrefix(secret.Name, prefix) || len(ss) != partsNumber {
+				// ignore unknown keys
+				continue
+			}
+			result = append(result, KeyID{
+				Type: a.keyType,
+				ID:   keyPath(&identity, a.keyType, ss[1]),
+			})
+		}
+		if output.NextToken == "" {
+			return result, nil
+		}
+		input["NextToken"] = output.NextToken
+	}
+}
+
+func (a *awsBJJKeyProvider) PublicKey(keyID KeyID) ([]byte, error) {
+	if keyID.Type != a.keyType {
+		return nil, errors.New("incorrect key type")
+	}
+
+	ss := a.reAnonKeyPathHex.FindStringSubmatch(keyID.ID)
+	if ss == nil {
+		ss = a.reIdenKeyPathHex.FindStringSubmatch(keyID.ID)
+	}
+	if len(ss) != partsNumber {
+		return nil, errors.New("unable to get public key from key ID")
+	}
+
+	val, err := hex.DecodeString(ss[1])
+	return val, err
+}
+
+func (a *awsBJJKeyProvider) privateKey(ctx context.Context, keyID KeyID) ([]byte, error) {
+	if keyID.Type != a.keyType {
+		return nil, ErrIncorrectKeyType
+	}
+
+	if !a.reAnonKeyPathHex.MatchString(keyID.ID) &&
+		!a.reIdenKeyPathHex.MatchString(keyID.ID) {
+		return nil, errors.New("incorrect key ID")
+	}
+
+	keyMaterial, err := a.keyMaterial(ctx, keyID.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// check key type stored in the secret is correct
+	if KeyType(keyMaterial[jsonKeyType]) != a.keyType {
+		return nil, ErrIncorrectKeyType
+	}
+
+	val, err := hex.DecodeString(keyMaterial[jsonKeyData])
+	if err != nil {
+		return nil, err
+	}
+	if len(val) != defaultLength {
+		return nil, errors.New("incorrect private key")
+	}
+
+	return val, nil
+}
+
+func (a *awsBJJKeyProvider) keyMaterial(ctx context.Context, path string) (map[string]string, error) {
+	var output struct {
+		SecretString string `json:"SecretString"`
+	}
+	err := a.awsCli.call(ctx, awsServiceSecretsManager, "GetSecretValue", map[string]string{"SecretId": a.secretName(path)}, &output)
+	if err != nil {
+		return nil, err
+	}
+
+	var keyMaterial map[string]string
+	if err := json.Unmarshal([]byte(output.SecretString), &keyMaterial); err != nil {
+		return nil, errors.New("unexpected format of key material")
+	}
+	return keyMaterial, nil
+}
+
+func (a *awsBJJKeyProvider) saveKeyMaterial(ctx context.Context, path string, keyMaterial map[string]string) error {
+	secret, err := json.Marshal(keyMaterial)
+	if err != nil {
+		return err
+	}
+	input := map[string]string{
+		"Name":         a.secretName(path),
+		"SecretString": string(secret),
+	}
+	return a.awsCli.call(ctx, awsServiceSecretsManager, "CreateSecret", input, nil)
+}
+
+func (a *awsBJJKeyProvider) secretName(path string) string {
+	return awsKeysPrefix + "/" + awsName(path)
+}
@@ -0,0 +1,235 @@
+package kms
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	awsServiceKMS            = "kms"
+	awsServiceSecretsManager = "secretsmanager"
+
+	awsSigningAlgorithm = "AWS4-HMAC-SHA256"
+	awsAmzDateFormat    = "20060102T150405Z"
+	awsDateFormat       = "20060102"
+	awsHTTPTimeout      = 10 * time.Second
+)
+
+// awsTargetPrefixes are the X-Amz-Target prefixes of the JSON APIs of each service
+var awsTargetPrefixes = map[string]string{
+	awsServiceKMS:            "TrentService",
+	awsServiceSecretsManager: "secretsmanager",
+}
+
+// AWSConfig holds the settings needed to use AWS KMS and AWS Secrets Manager
+type AWSConfig struct {
+	Region          string
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+	// Endpoint replaces the regional endpoints of both services. It is meant for local testing, e.g. localstack.
+	Endpoint string
+}
+
+// awsError is the error body returned by the AWS JSON APIs
+type awsError struct {
+	Type       string `json:"__type"`
+	Message    string `json:"message"`
+	MessageAlt string `json:"Message"`
+	StatusCode int    `json:"-"`
+}
+
+func (e *awsError) Error() string {
+	msg := e.Message
+	if msg == "" {
+		msg = e.MessageAlt
+	}
+	return fmt.Sprintf("aws error %d %s: %s", e.StatusCode, e.code(), msg)
+}
+
+// code returns the error type without the namespace, e.g. NotFoundException
+func (e *awsError) code() string {
+	if i := strings.LastIndex(e.Type, "#"); i >= 0 {
+		return e.Type[i+1:]
+	}
+	return e.Type
+}
+
+func isAWSError(err error, code string) bool {
+	var awsErr *awsError
+	return errors.As(err, &awsErr) && awsErr.code() == code
+}
+
+// awsClient calls the JSON APIs of AWS services signing the requests with Signature Version 4
+type awsClient struct {
+	cfg     AWSConfig
+	httpCli *http.Client
+	now     func() time.Time
+}
+
+func newAWSClient(cfg AWSConfig) (*awsClient, error) {
+	if cfg.Region == "" {
+		return nil, errors.New("aws region is not specified")
+	}
+	if cfg.AccessKeyID == "" || cfg.SecretAccessKey == "" {
+		return nil, errors.New("aws credentials are not specified")
+	}
+	return &awsClient{
+		cfg:     cfg,
+		httpCli: &http.Client{Timeout: awsHTTPTimeout},
+		now:     time.Now,
+	}, nil
+}
+
+func (c *awsClient) endpoint(service string) string {
+	if c.cfg.Endpoint != "" {
+		return strings.TrimSuffix(c.cfg.Endpoint, "/") + "/"
+	}
+	return fmt.Sprintf("https://%s.%s.amazonaws.com/", service, c.cfg.Region)
+}
+
+// call invokes the action of the service with the input encoded as JSON and decodes the response into output
+func (c *awsClient) call(ctx context.Context, service, action string, input, output interface{}) error {
+	body, err := json.Marshal(input)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint(service), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	req.Header.Set("X-Amz-Target", awsTargetPrefixes[service]+"."+action)
+	c.sign(req, service, body)
+
+	resp, err := c.httpCli.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		awsErr := &awsError{StatusCode: resp.StatusCode}
+		_ = json.Unmarshal(respBody, awsErr)
+		return awsErr
+	}
+	if output == nil {
+		return nil
+	}
+	return json.Unmarshal(respBody, output)
+}
+
+// sign adds the Signature Version 4 headers to the request
+func (c *awsClient) sign(req *http.Request, service string, body []byte) {
+	now := c.now().UTC()
+	amzDate := now.Format(awsAmzDateFormat)
+	scope := strings.Join([]string{now.Format(awsDateFormat), c.cfg.Region, service, "aws4_request"}, "/")
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	if c.cfg.SessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", c.cfg.SessionToken)
+	}
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	uri := req.URL.EscapedPath()
+	if uri == "" {
+		uri = "/"
+	}
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		uri,
+		canonicalQuery(req),
+		canonicalHeaders.String(),
+		signedHeaders,
+		hashHex(body),
+	}, "\n")
+	stringToSign := strings.Join([]string{awsSigningAlgorithm, amzDate, scope, hashHex([]byte(canonicalRequest))}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+c.cfg.SecretAccessKey), now.Format(awsDateFormat))
+	for _, part := range []string{c.cfg.Region, service, "aws4_request"} {
+		key = hmacSHA256(key, part)
+	}
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		awsSigningAlgorithm, c.cfg.AccessKeyID, scope, signedHeaders, signature))
+}
+
+func canonicalQuery(req *http.Request) string {
+	query := req.URL.Query()
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var parts []string
+	for _, k := range keys {
+		values := query[k]
+		sort.Strings(values)
+		for _, v := range values {
+			parts = append(parts, awsEscape(k)+"="+awsEscape(v))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+// awsEscape percent-encodes everything but the unreserved characters of RFC 3986
+func awsEscape(s string) string {
+	var b strings.Builder
+	for _, c := range []byte(s) {
+		if (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') ||
+			c == '-' || c == '_' || c == '.' || c == '~' {
+			b.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&b, "%%%02X", c)
+	}
+	return b.String()
+}
+
+func hashHex(data []byte) string {
+	h := sha256.Sum256(data)
+	return hex.EncodeToString(h[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	_, _ = h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+// awsName converts key paths to names accepted by AWS, which do not allow colons
+func awsName(s string) string {
+	return strings.ReplaceAll(s, ":", "_")
+}
@@ -0,0 +1,190 @@
+package kms
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	core "github.com/iden3/go-iden3-core"
+)
+
+const (
+	awsKeySpecSecp256k1  = "ECC_SECG_P256K1"
+	awsKeyUsageSign      = "SIGN_VERIFY"
+	awsSigningAlgECDSA   = "ECDSA_SHA_256"
+	awsMessageTypeDigest = "DIGEST"
+	awsKeysPrefix        = "iden3"
+	awsListPageSize      = 100
+	awsTagKeyType        = "iden3_key_type"
+)
+
+type awsTag struct {
+	TagKey   string `json:"TagKey"`
+	TagValue string `json:"TagValue"`
+}
+
+type awsAlias struct {
+	AliasName   string `json:"AliasName"`
+	TargetKeyID string `json:"TargetKeyId"`
+}
+
+// awsETHKeyProvider keeps Ethereum keys in AWS KMS, which signs with them without exposing the private key.
+// Keys are bound to an identity with an alias that contains the identity and the key ID.
+type awsETHKeyProvider struct {
+	keyType    KeyType
+	awsCli     *awsClient
+	publicKeys sync.Map // AWS key ID -> compressed public key
+}
+
+// NewAWSETHKeyProvider creates new key provider for Ethereum keys stored in AWS KMS
+func NewAWSETHKeyProvider(cfg AWSConfig, keyType KeyType) (KeyProvider, error) {
+	awsCli, err := newAWSClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &awsETHKeyProvider{keyType: keyType, awsCli: awsCli}, nil
+}
+
+func (a *awsETHKeyProvider) New(identity *core.DID) (KeyID, error) {
+	ctx := context.Background()
+	input := map[string]interface{}{
+		"KeySpec":     awsKeySpecSecp256k1,
+		"KeyUsage":    awsKeyUsageSign,
+		"Description": "iden3 ethereum key",
+		"Tags":        []awsTag{{TagKey: awsTagKeyType, TagValue: string(a.keyType)}},
+	}
+	var output struct {
+		KeyMetadata struct {
+			KeyID string `json:"KeyId"`
+		} `json:"KeyMetadata"`
+	}
+	if err := a.awsCli.call(ctx, awsServiceKMS, "CreateKey", input, &output); err != nil {
+		return KeyID{}, err
+	}
+
+	keyID := KeyID{Type: a.keyType, ID: output.KeyMetadata.KeyID}
+	if identity == nil {
+		return keyID, nil
+	}
+	return a.LinkToIdentity(ctx, keyID, *identity)
+}
+
+// LinkToIdentity adds an alias of the identity to the key. The key ID does not change.
+func (a *awsETHKeyProvider) LinkToIdentity(ctx context.Context, keyID KeyID, identity core.DID) (KeyID, error) {
+	if keyID.Type != a.keyType {
+		return keyID, ErrIncorrectKeyType
+	}
+
+	input := map[string]string{
+		"AliasName":   a.identityAliasPrefix(identity) + keyID.ID,
+		"TargetKeyId": keyID.ID,
+	}
+	err := a.awsCli.call(ctx, awsServiceKMS, "CreateAlias", input, nil)
+	if err != nil && !isAWSError(err, "AlreadyExistsException") {
+		return KeyID{}, err
+	}
+	return keyID, nil
+}
+
+// Sign signs the digest and returns the signature in the [R || S || V] format, where V is 0 or 1
+func (a *awsETHKeyProvider) Sign(ctx context.Context, keyID KeyID, data []byte) ([]byte, error) {
+	if keyID.Type != a.keyType {
+		return nil, ErrIncorrectKeyType
+	}
+	if len(data) != common.HashLength {
+		return nil, fmt.Errorf("data to sign should be %v bytes length", common.HashLength)
+	}
+
+	input := map[string]string{
+		"KeyId":            keyID.ID,
+		"Message":          base64.StdEncoding.EncodeToString(data),
+		"MessageType":      awsMessageTypeDigest,
+		"SigningAlgorithm": awsSigningAlgECDSA,
+	}
+	var output struct {
+		Signature string `json:"Signature"`
+	}
+	if err := a.awsCli.call(ctx, awsServiceKMS, "Sign", input, &output); err != nil {
+		return nil, err
+	}
+	derSig, err := base64.StdEncoding.DecodeString(output.Signature)
+	if err != nil {
+		return nil, err
+	}
+
+	pubKey, err := a.publicKey(ctx, keyID)
+	if err != nil {
+		return nil, err
+	}
+	return ethSignatureFromDER(data, derSig, pubKey)
+}
+
+func (a *awsETHKeyProvider) ListByIdentity(ctx context.Context, identity core.DID) ([]KeyID, error) {
+	prefix := a.identityAliasPrefix(identity)
+	var result []KeyID
+	input := map[string]interface{}{"Limit": awsListPageSize}
+	for {
+		var output struct {
+			Aliases    []awsAlias `json:"Aliases"`
+			NextMarker string     `json:"NextMarker"`
+			Truncated  bool       `json:"Truncated"`
+		}
+		if err := a.awsCli.call(ctx, awsServiceKMS, "ListAliases", input, &output); err != nil {
+			return nil, err
+		}
+		for _, alias := range output.Aliases {
+			if strings.HasPrefix(alias.AliasName, prefix) && alias.TargetKeyID != "" {
+				result = append(result, KeyID{Type: a.keyType, ID: alias.TargetKeyID})
+			}
+		}
+		if !output.Truncated || output.NextMarker == "" {
+			return result, nil
+		}
+		input["Marker"] = output.NextMarker
+	}
+}
+
+// PublicKey returns the compressed public key, the same format used by the vault plugin
+func (a *awsETHKeyProvider) PublicKey(keyID KeyID) ([]byte, error) {
+	if keyID.Type != a.keyType {
+		return nil, errors.New("incorrect key type")
+	}
+	pubKey, err := a.publicKey(context.Background(), keyID)
+	if err != nil {
+		return nil, err
+	}
+	return crypto.CompressPubkey(pubKey), nil
+}
+
+func (a *awsETHKeyProvider) publicKey(ctx context.Context, keyID KeyID) (*ecdsa.PublicKey, error) {
+	if cached, ok := a.publicKeys.Load(keyID.ID); ok {
+		return crypto.DecompressPubkey(cached.([]byte))
+	}
+
+	var output struct {
+		PublicKey string `json:"PublicKey"`
+	}
+	if err := a.awsCli.call(ctx, awsServiceKMS, "GetPublicKey", map[string]string{"KeyId": keyID.ID}, &output); err != nil {
+		return nil, err
+	}
+	der, err := base64.StdEncoding.DecodeString(output.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	pubKey, err := decodeSPKIPubKey(der)
+	if err != nil {
+		return nil, err
+	}
+	a.publicKeys.Store(keyID.ID, crypto.CompressPubkey(pubKey))
+	return pubKey, nil
+}
+
+func (a *awsETHKeyProvider) identityAliasPrefix(identity core.DID) string {
+	return "alias/" + awsKeysPrefix + "/" + awsName(identity.String()) + "/"
+}
@@ -0,0 +1,255 @@
+package kms
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeAWS implements the subset of the AWS KMS and Secrets Manager APIs used by the AWS key providers
+type fakeAWS struct {
+	t       *testing.T
+	mu      sync.Mutex
+	keys    map[string]*ecdsa.PrivateKey
+	aliases []awsAlias
+	secrets map[string]string
+}
+
+func newFakeAWS(t *testing.T) AWSConfig {
+	f := &fakeAWS{t: t, keys: map[string]*ecdsa.PrivateKey{}, secrets: map[string]string{}}
+	srv := httptest.NewServer(f)
+	t.Cleanup(srv.Close)
+	return AWSConfig{Region: "eu-west-1", AccessKeyID: "AKID", SecretAccessKey: "secret", Endpoint: srv.URL}
+}
+
+func (f *fakeAWS) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	assert.True(f.t, strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=AKID/"))
+	var in map[string]interface{}
+	require.NoError(f.t, json.NewDecoder(r.Body).Decode(&in))
+
+	var out interface{}
+	switch r.Header.Get("X-Amz-Target") {
+	case "TrentService.CreateKey":
+		key, err := crypto.GenerateKey()
+		require.NoError(f.t, err)
+		id := uuid.NewString()
+		f.keys[id] = key
+		out = map[string]interface{}{"KeyMetadata": map[string]string{"KeyId": id}}
+	case "TrentService.CreateAlias":
+		for _, alias := range f.aliases {
+			if alias.AliasName == in["AliasName"] {
+				f.fail(w, "AlreadyExistsException")
+				return
+			}
+		}
+		f.aliases = append(f.aliases, awsAlias{AliasName: in["AliasName"].(string), TargetKeyID: in["TargetKeyId"].(string)})
+		out = map[string]string{}
+	case "TrentService.ListAliases":
+		out = map[string]interface{}{"Aliases": f.aliases, "Truncated": false}
+	case "TrentService.GetPublicKey":
+		key, ok := f.keys[in["KeyId"].(string)]
+		if !ok {
+			f.fail(w, "NotFoundException")
+			return
+		}
+		der, err := asn1.Marshal(struct {
+			Algorithm pkix.AlgorithmIdentifier
+			PublicKey asn1.BitString
+		}{
+			Algorithm: pkix.AlgorithmIdentifier{Algorithm: asn1.ObjectIdentifier{1, 2, 840, 10045, 2, 1}},
+			PublicKey: asn1.BitString{Bytes: crypto.FromECDSAPub(&key.PublicKey), BitLength: 65 * 8},
+		})
+		require.NoError(f.t, err)
+		out = map[string]string{"PublicKey": base64.StdEncoding.EncodeToString(der)}
+	case "TrentService.Sign":
+		key, ok := f.keys[in["KeyId"].(string)]
+		if !ok {
+			f.fail(w, "NotFoundException")
+			return
+		}
+		digest, err := base64.StdEncoding.DecodeString(in["Message"].(string))
+		require.NoError(f.t, err)
+		r, s, err := ecdsa.Sign(rand.Reader, key, digest)
+		require.NoError(f.t, err)
+		der, err := asn1.Marshal(struct{ R, S *big.Int }{r, s})
+		require.NoError(f.t, err)
+		out = map[string]string{"Signature": base64.StdEncoding.EncodeToString(der)}
+	case "secretsmanager.CreateSecret":
+		name := in["Name"].(string)
+		if _, ok := f.secrets[name]; ok {
+			f.fail(w, "ResourceExistsException")
+			return
+		}
+		f.secrets[name] = in["SecretString"].(string)
+		out = map[string]string{"Name": name}
+	case "secretsmanager.GetSecretValue":
+		secret, ok := f.secrets[in["SecretId"].(string)]
+		if !ok {
+			f.fail(w, "ResourceNotFoundException")
+			return
+		}
+		out = map[string]string{"SecretString": secret}
+	case "secretsmanager.DeleteSecret":
+		delete(f.secrets, in["SecretId"].(string))
+		out = map[string]string{}
+	case "secretsmanager.ListSecrets":
+		prefix := in["Filters"].([]interface{})[0].(map[string]interface{})["Values"].([]interface{})[0].(string)
+		var list []map[string]string
+		for name := range f.secrets {
+			if strings.HasPrefix(name, prefix) {
+				list = append(list, map[string]string{"Name": name})
+			}
+		}
+		out = map[string]interface{}{"SecretList": list}
+	default:
+		f.fail(w, "UnknownOperationException")
+		return
+	}
+	require.NoError(f.t, json.NewEncoder(w).Encode(out))
+}
+
+func (f *fakeAWS) fail(w http.ResponseWriter, code string) {
+	w.WriteHeader(http.StatusBadRequest)
+	_, _ = w.Write([]byte(`{"__type":"com.amazonaws#` + code + `","message":"fake error"}`))
+}
+
+func TestAWSETHKeyProvider(t *testing.T) {
+	ctx := context.Background()
+	kp, err := NewAWSETHKeyProvider(newFakeAWS(t), KeyTypeEthereum)
+	require.NoError(t, err)
+
+	// unbound key
+	newKey, err := kp.New(nil)
+	require.NoError(t, err)
+	require.Equal(t, KeyTypeEthereum, newKey.Type)
+
+	did := randomDID(t)
+	keys, err := kp.ListByIdentity(ctx, did)
+	require.NoError(t, err)
+	require.Empty(t, keys)
+
+	boundKey, err := kp.LinkToIdentity(ctx, newKey, did)
+	require.NoError(t, err)
+	require.Equal(t, newKey, boundKey)
+	// linking twice is not an error
+	_, err = kp.LinkToIdentity(ctx, boundKey, did)
+	require.NoError(t, err)
+
+	// key created for the identity
+	identityKey, err := kp.New(&did)
+	require.NoError(t, err)
+
+	keys, err = kp.ListByIdentity(ctx, did)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []KeyID{boundKey, identityKey}, keys)
+
+	keys, err = kp.ListByIdentity(ctx, randomDID(t))
+	require.NoError(t, err)
+	require.Empty(t, keys)
+
+	pubKeyBytes, err := kp.PublicKey(identityKey)
+	require.NoError(t, err)
+	require.Len(t, pubKeyBytes, compressedETHPubKeyLen)
+	pubKey, err := DecodeETHPubKey(pubKeyBytes)
+	require.NoError(t, err)
+
+	// AWS returns both high and low S values, so sign a few times to cover the normalization
+	for i := 0; i < 10; i++ {
+		digest := crypto.Keccak256([]byte{byte(i)})
+		sig, err := kp.Sign(ctx, identityKey, digest)
+		require.NoError(t, err)
+		require.Len(t, sig, crypto.SignatureLength)
+		require.True(t, new(big.Int).SetBytes(sig[32:64]).Cmp(secp256k1HalfN) <= 0)
+
+		recovered, err := crypto.SigToPub(digest, sig)
+		require.NoError(t, err)
+		require.Equal(t, crypto.PubkeyToAddress(*pubKey), crypto.PubkeyToAddress(*recovered))
+	}
+
+	_, err = kp.Sign(ctx, identityKey, []byte("short"))
+	require.Error(t, err)
+	_, err = kp.Sign(ctx, KeyID{Type: KeyTypeEthereum, ID: uuid.NewString()}, crypto.Keccak256([]byte("data")))
+	require.True(t, isAWSError(err, "NotFoundException"))
+}
+
+func TestAWSBJJKeyProvider(t *testing.T) {
+	ctx := context.Background()
+	kp, err := NewAWSBJJKeyProvider(newFakeAWS(t), KeyTypeBabyJubJub)
+	require.NoError(t, err)
+
+	newKey, err := kp.New(nil)
+	require.NoError(t, err)
+	require.Equal(t, KeyTypeBabyJubJub, newKey.Type)
+
+	did := randomDID(t)
+	boundKey, err := kp.LinkToIdentity(ctx, newKey, did)
+	require.NoError(t, err)
+	require.Equal(t, keyPath(&did, KeyTypeBabyJubJub, strings.TrimPrefix(newKey.ID, "BJJ:")), boundKey.ID)
+
+	// the unbound secret was moved
+	_, err = kp.Sign(ctx, newKey, []byte{1})
+	require.True(t, isAWSError(err, "ResourceNotFoundException"))
+
+	identityKey, err := kp.New(&did)
+	require.NoError(t, err)
+
+	keys, err := kp.ListByIdentity(ctx, did)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []KeyID{boundKey, identityKey}, keys)
+
+	keys, err = kp.ListByIdentity(ctx, randomDID(t))
+	require.NoError(t, err)
+	require.Empty(t, keys)
+
+	pubKeyBytes, err := kp.PublicKey(identityKey)
+	require.NoError(t, err)
+	pubKey, err := DecodeBJJPubKey(pubKeyBytes)
+	require.NoError(t, err)
+
+	data := big.NewInt(123456)
+	sigBytes, err := kp.Sign(ctx, identityKey, BJJDigest(data))
+	require.NoError(t, err)
+	sig, err := DecodeBJJSignature(sigBytes)
+	require.NoError(t, err)
+	require.True(t, pubKey.VerifyPoseidon(data, sig))
+}
+
+// TestAWSClientSign checks the signature against the example of the AWS Signature Version 4 documentation
+func TestAWSClientSign(t *testing.T) {
+	c, err := newAWSClient(AWSConfig{
+		Region:          "us-east-1",
+		AccessKeyID:     "AKIDEXAMPLE",
+		SecretAccessKey: "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY",
+	})
+	require.NoError(t, err)
+	c.now = func() time.Time { return time.Date(2015, 8, 30, 12, 36, 0, 0, time.UTC) }
+
+	req, err := http.NewRequest(http.MethodGet, "https://iam.amazonaws.com/?Action=ListUsers&Version=2010-05-08", nil)
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+	c.sign(req, "iam", nil)
+
+	assert.Equal(t, "20150830T123600Z", req.Header.Get("X-Amz-Date"))
+	assert.Equal(t, "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/iam/aws4_request, "+
+		"SignedHeaders=content-type;host;x-amz-date, "+
+		"Signature=5d672d79c15b13162d9279b0855cfba6789a8edb4c82c400e06b5924a6f2b5d7", req.Header.Get("Authorization"))
+}
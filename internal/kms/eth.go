@@ -0,0 +1,73 @@
+package kms
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// compressedETHPubKeyLen is the length of the public keys returned by the vault plugin
+const compressedETHPubKeyLen = 33
+
+var (
+	secp256k1N     = crypto.S256().Params().N
+	secp256k1HalfN = new(big.Int).Rsh(secp256k1N, 1)
+)
+
+// DecodeETHPubKey is a helper method to convert byte representation of public
+// key, compressed or not, to *ecdsa.PublicKey
+func DecodeETHPubKey(key []byte) (*ecdsa.PublicKey, error) {
+	if len(key) == compressedETHPubKeyLen {
+		return crypto.DecompressPubkey(key)
+	}
+	return crypto.UnmarshalPubkey(key)
+}
+
+// decodeSPKIPubKey parses a DER encoded SubjectPublicKeyInfo. The x509 package does not support the secp256k1 curve.
+func decodeSPKIPubKey(der []byte) (*ecdsa.PublicKey, error) {
+	var spki struct {
+		Algorithm pkix.AlgorithmIdentifier
+		PublicKey asn1.BitString
+	}
+	rest, err := asn1.Unmarshal(der, &spki)
+	if err != nil {
+		return nil, fmt.Errorf("can't parse public key: %w", err)
+	}
+	if len(rest) != 0 {
+		return nil, errors.New("can't parse public key: trailing data")
+	}
+	return crypto.UnmarshalPubkey(spki.PublicKey.Bytes)
+}
+
+// ethSignatureFromDER converts a DER encoded ECDSA signature to the [R || S || V] format.
+// S is moved to the lower half of the curve order, as Ethereum requires, and V is found by recovering the public key.
+func ethSignatureFromDER(digest, derSig []byte, pubKey *ecdsa.PublicKey) ([]byte, error) {
+	var sig struct {
+		R, S *big.Int
+	}
+	if _, err := asn1.Unmarshal(derSig, &sig); err != nil {
+		return nil, fmt.Errorf("can't parse signature: %w", err)
+	}
+	if sig.S.Cmp(secp256k1HalfN) > 0 {
+		sig.S = new(big.Int).Sub(secp256k1N, sig.S)
+	}
+
+	ethSig := make([]byte, crypto.SignatureLength)
+	sig.R.FillBytes(ethSig[:32])
+	sig.S.FillBytes(ethSig[32:64])
+	expected := crypto.FromECDSAPub(pubKey)
+	for v := byte(0); v < 2; v++ {
+		ethSig[crypto.RecoveryIDOffset] = v
+		recovered, err := crypto.Ecrecover(digest, ethSig)
+		if err == nil && bytes.Equal(recovered, expected) {
+			return ethSig, nil
+		}
+	}
+	return nil, errors.New("can't find the recovery id of the signature")
+}
@@ -0,0 +1,535 @@
+package kms
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"math/big"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	core "github.com/iden3/go-iden3-core"
+	"github.com/iden3/go-iden3-crypto/babyjub"
+	"github.com/iden3/go-iden3-crypto/utils"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	fileKeyStoreHeader  = ".keystore"
+	fileKeyExtension    = ".json"
+	fileKeyStoreVersion = 1
+	fileScryptN         = 1 << 15
+	fileScryptR         = 8
+	fileScryptP         = 1
+	fileSaltLength      = 32
+	filePassphraseCheck = "iden3 key store"
+)
+
+// ErrFileKeyNotFound is returned when the key file does not exist
+var ErrFileKeyNotFound = errors.New("key not found")
+
+// FileConfig holds the settings of the encrypted file key store
+type FileConfig struct {
+	// Dir is the directory of the key files. It is created if it does not exist.
+	Dir string
+	// Passphrase the encryption key of the private keys is derived from
+	Passphrase string
+}
+
+// fileKeyStoreInfo is stored in the header file of the key store. It holds the parameters to derive the
+// encryption key and a known text encrypted with it, to reject a wrong passphrase on start.
+type fileKeyStoreInfo struct {
+	Version int    `json:"version"`
+	ScryptN int    `json:"scrypt_n"`
+	ScryptR int    `json:"scrypt_r"`
+	ScryptP int    `json:"scrypt_p"`
+	Salt    string `json:"salt"`
+	Nonce   string `json:"nonce"`
+	Check   string `json:"check"`
+}
+
+// fileKey is the content of a key file. Only the private key is encrypted.
+type fileKey struct {
+	KeyType    KeyType `json:"key_type"`
+	PublicKey  string  `json:"public_key"`
+	Nonce      string  `json:"nonce"`
+	Ciphertext string  `json:"ciphertext"`
+}
+
+// fileKeyStore keeps every key in its own file, so several processes can share the directory.
+// Private keys are encrypted with AES-256-GCM and a key derived from the passphrase with scrypt. The key ID is
+// the additional data of the encryption, so a key file can't be passed off as another key.
+type fileKeyStore struct {
+	dir  string
+	aead cipher.AEAD
+}
+
+func openFileKeyStore(cfg FileConfig) (*fileKeyStore, error) {
+	if cfg.Dir == "" {
+		return nil, errors.New("key store directory is not specified")
+	}
+	if cfg.Passphrase == "" {
+		return nil, errors.New("key store passphrase is not specified")
+	}
+	if err := os.MkdirAll(cfg.Dir, 0o700); err != nil {
+		return nil, err
+	}
+
+	info, err := readFileKeyStoreInfo(cfg.Dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return initFileKeyStore(cfg)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if info.Version != fileKeyStoreVersion {
+		return nil, fmt.Errorf("unsupported key store version %d", info.Version)
+	}
+
+	salt, err := hex.DecodeString(info.Salt)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := fileKeyStoreCipher(cfg.Passphrase, salt, info.ScryptN, info.ScryptR, info.ScryptP)
+	if err != nil {
+		return nil, err
+	}
+	store := &fileKeyStore{dir: cfg.Dir, aead: aead}
+	if _, err := store.decrypt(info.Nonce, info.Check, fileKeyStoreHeader); err != nil {
+		return nil, errors.New("wrong key store passphrase")
+	}
+	return store, nil
+}
+
+// initFileKeyStore creates the header of an empty key store. If another process creates it first, its header is used.
+func initFileKeyStore(cfg FileConfig) (*fileKeyStore, error) {
+	salt := make([]byte, fileSaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	aead, err := fileKeyStoreCipher(cfg.Passphrase, salt, fileScryptN, fileScryptR, fileScryptP)
+	if err != nil {
+		return nil, err
+	}
+	store := &fileKeyStore{dir: cfg.Dir, aead: aead}
+
+	nonce, check, err := store.encrypt([]byte(filePassphraseCheck), fileKeyStoreHeader)
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(fileKeyStoreInfo{
+		Version: fileKeyStoreVersion,
+		ScryptN: fileScryptN,
+		ScryptR: fileScryptR,
+		ScryptP: fileScryptP,
+		Salt:    hex.EncodeToString(salt),
+		Nonce:   nonce,
+		Check:   check,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// the header is linked from a complete temporary file, so a concurrent start never reads a partial header
+	tmp, err := writeTempFile(cfg.Dir, data)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = os.Remove(tmp) }()
+	err = os.Link(tmp, filepath.Join(cfg.Dir, fileKeyStoreHeader))
+	if errors.Is(err, fs.ErrExist) {
+		return openFileKeyStore(cfg)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return store, nil
+}
+
+func readFileKeyStoreInfo(dir string) (fileKeyStoreInfo, error) {
+	var info fileKeyStoreInfo
+	data, err := os.ReadFile(filepath.Join(dir, fileKeyStoreHeader))
+	if err != nil {
+		return info, err
+	}
+	if err := json.Unmarshal(data, &info); err != nil {
+		return info, fmt.Errorf("unexpected format of key store header: %w", err)
+	}
+	return info, nil
+}
+
+func fileKeyStoreCipher(passphrase string, salt []byte, n, r, p int) (cipher.AEAD, error) {
+	key, err := scrypt.Key([]byte(passphrase), salt, n, r, p, 32)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func (s *fileKeyStore) encrypt(plaintext []byte, keyID string) (nonce string, ciphertext string, err error) {
+	nonceBytes := make([]byte, s.aead.NonceSize())
+	if _, err := rand.Read(nonceBytes); err != nil {
+		return "", "", err
+	}
+	sealed := s.aead.Seal(nil, nonceBytes, plaintext, []byte(keyID))
+	return hex.EncodeToString(nonceBytes), hex.EncodeToString(sealed), nil
+}
+
+func (s *fileKeyStore) decrypt(nonce, ciphertext, keyID string) ([]byte, error) {
+	nonceBytes, err := hex.DecodeString(nonce)
+	if err != nil {
+		return nil, err
+	}
+	if len(nonceBytes) != s.aead.NonceSize() {
+		return nil, errors.New("incorrect nonce")
+	}
+	sealed, err := hex.DecodeString(ciphertext)
+	if err != nil {
+		return nil, err
+	}
+	return s.aead.Open(nil, nonceBytes, sealed, []byte(keyID))
+}
+
+// path returns the file of the key
+func (s *fileKeyStore) path(keyID string) (string, error) {
+	name, err := s.name(keyID)
+	if err != nil {
+		return "", err
+	}
+	return name + fileKeyExtension, nil
+}
+
+// name maps a key path to the key store directory. Colons are replaced as they are not allowed in file names on
+// every platform.
+func (s *fileKeyStore) name(keyPath string) (string, error) {
+	rel := filepath.FromSlash(awsName(keyPath))
+	if keyPath == "" || !filepath.IsLocal(rel) {
+		return "", errors.New("incorrect key ID")
+	}
+	return filepath.Join(s.dir, rel), nil
+}
+
+func (s *fileKeyStore) save(keyID KeyID, publicKey, privateKey []byte) error {
+	path, err := s.path(keyID.ID)
+	if err != nil {
+		return err
+	}
+	nonce, ciphertext, err := s.encrypt(privateKey, keyID.ID)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(fileKey{
+		KeyType:    keyID.Type,
+		PublicKey:  hex.EncodeToString(publicKey),
+		Nonce:      nonce,
+		Ciphertext: ciphertext,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	// the key is written to a temporary file and renamed, so readers never find a partial file
+	tmp, err := writeTempFile(filepath.Dir(path), data)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(tmp) }()
+	return os.Rename(tmp, path)
+}
+
+func writeTempFile(dir string, data []byte) (string, error) {
+	tmp, err := os.CreateTemp(dir, ".tmp-*")
+	if err != nil {
+		return "", err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return "", err
+	}
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name())
+		return "", err
+	}
+	return tmp.Name(), nil
+}
+
+func (s *fileKeyStore) load(keyID KeyID) (fileKey, error) {
+	var key fileKey
+	path, err := s.path(keyID.ID)
+	if err != nil {
+		return key, err
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return key, ErrFileKeyNotFound
+	}
+	if err != nil {
+		return key, err
+	}
+	if err := json.Unmarshal(data, &key); err != nil {
+		return key, errors.New("unexpected format of key file")
+	}
+	// check key type stored in the file is correct
+	if key.KeyType != keyID.Type {
+		return key, ErrIncorrectKeyType
+	}
+	return key, nil
+}
+
+func (s *fileKeyStore) privateKey(keyID KeyID) ([]byte, error) {
+	key, err := s.load(keyID)
+	if err != nil {
+		return nil, err
+	}
+	privKey, err := s.decrypt(key.Nonce, key.Ciphertext, keyID.ID)
+	if err != nil {
+		return nil, fmt.Errorf("can't decrypt key %s: %w", keyID.ID, err)
+	}
+	return privKey, nil
+}
+
+func (s *fileKeyStore) remove(keyID KeyID) error {
+	path, err := s.path(keyID.ID)
+	if err != nil {
+		return err
+	}
+	return os.Remove(path)
+}
+
+// fileKeyProvider keeps BabyJubJub, Ethereum or JWS keys in the encrypted file key store and signs locally.
+// Key IDs have the same format as the ones of the vault plugin.
+type fileKeyProvider struct {
+	keyType          KeyType
+	store            *fileKeyStore
+	reAnonKeyPathHex *regexp.Regexp // RE of key path not bounded to identity
+	reFileName       *regexp.Regexp // RE of the file name of a key bound to identity
+}
+
+// NewFileKeyProvider creates new key provider for keys stored in encrypted files. It is meant for development and
+// small deployments that don't run Vault.
+func NewFileKeyProvider(cfg FileConfig, keyType KeyType) (KeyProvider, error) {
+	store, err := openFileKeyStore(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return newFileKeyProvider(store, keyType)
+}
+
+func newFileKeyProvider(store *fileKeyStore, keyType KeyType) (KeyProvider, error) {
+	if keyType != KeyTypeBabyJubJub && keyType != KeyTypeEthereum && !isJWSKeyType(keyType) {
+		return nil, ErrIncorrectKeyType
+	}
+	keyTypeRE := regexp.QuoteMeta(string(keyType))
+	return &fileKeyProvider{
+		keyType:          keyType,
+		store:            store,
+		reAnonKeyPathHex: regexp.MustCompile("^(?i)" + keyTypeRE + ":([a-f0-9]+)$"),
+		reFileName:       regexp.MustCompile("^(?i)" + keyTypeRE + "_([a-f0-9]+)" + regexp.QuoteMeta(fileKeyExtension) + "$"),
+	}, nil
+}
+
+func (f *fileKeyProvider) New(identity *core.DID) (KeyID, error) {
+	var pubKey, privKey []byte
+	switch f.keyType {
+	case KeyTypeBabyJubJub:
+		bjjPrivKey := babyjub.NewRandPrivKey()
+		bjjPubKey := bjjPrivKey.Public().Compress()
+		pubKey = bjjPubKey[:]
+		privKey = bjjPrivKey[:]
+	case KeyTypeP256, KeyTypeEd25519:
+		var err error
+		if pubKey, privKey, err = newJWSKey(f.keyType); err != nil {
+			return KeyID{}, err
+		}
+	default:
+		ethPrivKey, err := crypto.GenerateKey()
+		if err != nil {
+			return KeyID{}, err
+		}
+		pubKey = crypto.CompressPubkey(&ethPrivKey.PublicKey)
+		privKey = crypto.FromECDSA(ethPrivKey)
+	}
+
+	keyID := KeyID{
+		Type: f.keyType,
+		ID:   keyPath(identity, f.keyType, hex.EncodeToString(pubKey)),
+	}
+	return keyID, f.store.save(keyID, pubKey, privKey)
+}
+
+// LinkToIdentity moves the file of an unbound key to the identity. The private key is encrypted again, as the
+// key ID is part of the encryption.
+func (f *fileKeyProvider) LinkToIdentity(_ context.Context, keyID KeyID, identity core.DID) (KeyID, error) {
+	if keyID.Type != f.keyType {
+		return keyID, ErrIncorrectKeyType
+	}
+
+	ss := f.reAnonKeyPathHex.FindStringSubmatch(keyID.ID)
+	if len(ss) != partsNumber {
+		return keyID, errors.New("key ID does not looks like unbound")
+	}
+
+	newKeyID := KeyID{
+		Type: keyID.Type,
+		ID:   keyPath(&identity, f.keyType, ss[1]),
+	}
+
+	key, err := f.store.load(keyID)
+	if err != nil {
+		return keyID, err
+	}
+	pubKey, err := hex.DecodeString(key.PublicKey)
+	if err != nil {
+		return keyID, err
+	}
+	privKey, err := f.store.privateKey(keyID)
+	if err != nil {
+		return keyID, err
+	}
+	if err := f.store.save(newKeyID, pubKey, privKey); err != nil {
+		return keyID, err
+	}
+	return newKeyID, f.store.remove(keyID)
+}
+
+// Sign signs *big.Int using poseidon algorithm for BabyJubJub keys, data should be a little-endian bytes
+// representation of *big.Int. Ethereum keys sign a digest and return the signature in the [R || S || V] format.
+// JWS keys sign the JWS signing input.
+func (f *fileKeyProvider) Sign(_ context.Context, keyID KeyID, data []byte) ([]byte, error) {
+	if keyID.Type != f.keyType {
+		return nil, ErrIncorrectKeyType
+	}
+
+	if isJWSKeyType(f.keyType) {
+		privKeyData, err := f.store.privateKey(keyID)
+		if err != nil {
+			return nil, err
+		}
+		return signJWS(f.keyType, privKeyData, data)
+	}
+
+	if f.keyType == KeyTypeEthereum {
+		if len(data) != common.HashLength {
+			return nil, fmt.Errorf("data to sign should be %v bytes length", common.HashLength)
+		}
+		privKeyData, err := f.store.privateKey(keyID)
+		if err != nil {
+			return nil, err
+		}
+		privKey, err := crypto.ToECDSA(privKeyData)
+		if err != nil {
+			return nil, err
+		}
+		return crypto.Sign(data, privKey)
+	}
+
+	if len(data) > defaultLength {
+		return nil, errors.New("data to sign is too large")
+	}
+	i := new(big.Int).SetBytes(utils.SwapEndianness(data))
+	if !utils.CheckBigIntInField(i) {
+		return nil, errors.New("data to sign is too large")
+	}
+
+	privKeyData, err := f.store.privateKey(keyID)
+	if err != nil {
+		return nil, err
+	}
+	privKey, err := decodeBJJPrivateKey(privKeyData)
+	if err != nil {
+		return nil, err
+	}
+	sig := privKey.SignPoseidon(i).Compress()
+	return sig[:], nil
+}
+
+func (f *fileKeyProvider) ListByIdentity(_ context.Context, identity core.DID) ([]KeyID, error) {
+	dir, err := f.store.name(identityPath(&identity))
+	if err != nil {
+		return nil, err
+	}
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var result []KeyID
+	for _, entry := range entries {
+		ss := f.reFileName.FindStringSubmatch(entry.Name())
+		if entry.IsDir() || len(ss) != partsNumber {
+			// ignore unknown keys
+			continue
+		}
+		result = append(result, KeyID{
+			Type: f.keyType,
+			ID:   keyPath(&identity, f.keyType, ss[1]),
+		})
+	}
+	return result, nil
+}
+
+// PublicKey returns the public key stored with the key. Ethereum public keys are compressed, the same format
+// used by the vault plugin.
+func (f *fileKeyProvider) PublicKey(keyID KeyID) ([]byte, error) {
+	if keyID.Type != f.keyType {
+		return nil, errors.New("incorrect key type")
+	}
+	key, err := f.store.load(keyID)
+	if err != nil {
+		return nil, err
+	}
+	return hex.DecodeString(key.PublicKey)
+}
+
+// ImportFileKey stores an existing private key in the encrypted file key store under the given key ID,
+// like the import endpoint of the vault plugin. It is used to import the key that publishes the states.
+func ImportFileKey(cfg FileConfig, keyID KeyID, privKey []byte) error {
+	store, err := openFileKeyStore(cfg)
+	if err != nil {
+		return err
+	}
+
+	var pubKey []byte
+	switch keyID.Type {
+	case KeyTypeBabyJubJub:
+		bjjPrivKey, err := decodeBJJPrivateKey(privKey)
+		if err != nil {
+			return err
+		}
+		bjjPubKey := bjjPrivKey.Public().Compress()
+		pubKey = bjjPubKey[:]
+	case KeyTypeEthereum:
+		ethPrivKey, err := crypto.ToECDSA(privKey)
+		if err != nil {
+			return err
+		}
+		pubKey = crypto.CompressPubkey(&ethPrivKey.PublicKey)
+	case KeyTypeP256, KeyTypeEd25519:
+		if pubKey, err = jwsPublicKey(keyID.Type, privKey); err != nil {
+			return err
+		}
+	default:
+		return ErrIncorrectKeyType
+	}
+	return store.save(keyID, pubKey, privKey)
+}
@@ -0,0 +1,194 @@
+package kms
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"math/big"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileKeyProvider_BJJ(t *testing.T) {
+	ctx := context.Background()
+	cfg := FileConfig{Dir: t.TempDir(), Passphrase: "secret"}
+	kp, err := NewFileKeyProvider(cfg, KeyTypeBabyJubJub)
+	require.NoError(t, err)
+
+	newKey, err := kp.New(nil)
+	require.NoError(t, err)
+	require.Equal(t, KeyTypeBabyJubJub, newKey.Type)
+
+	did := randomDID(t)
+	boundKey, err := kp.LinkToIdentity(ctx, newKey, did)
+	require.NoError(t, err)
+	require.Equal(t, keyPath(&did, KeyTypeBabyJubJub, strings.TrimPrefix(newKey.ID, "BJJ:")), boundKey.ID)
+
+	// the unbound key was moved
+	_, err = kp.Sign(ctx, newKey, []byte{1})
+	require.ErrorIs(t, err, ErrFileKeyNotFound)
+
+	identityKey, err := kp.New(&did)
+	require.NoError(t, err)
+
+	keys, err := kp.ListByIdentity(ctx, did)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []KeyID{boundKey, identityKey}, keys)
+
+	keys, err = kp.ListByIdentity(ctx, randomDID(t))
+	require.NoError(t, err)
+	require.Empty(t, keys)
+
+	// the key store is opened again, as another process would do
+	kp, err = NewFileKeyProvider(cfg, KeyTypeBabyJubJub)
+	require.NoError(t, err)
+
+	pubKeyBytes, err := kp.PublicKey(identityKey)
+	require.NoError(t, err)
+	pubKey, err := DecodeBJJPubKey(pubKeyBytes)
+	require.NoError(t, err)
+
+	data := big.NewInt(123456)
+	sigBytes, err := kp.Sign(ctx, identityKey, BJJDigest(data))
+	require.NoError(t, err)
+	sig, err := DecodeBJJSignature(sigBytes)
+	require.NoError(t, err)
+	require.True(t, pubKey.VerifyPoseidon(data, sig))
+
+	_, err = kp.Sign(ctx, KeyID{Type: KeyTypeEthereum, ID: identityKey.ID}, BJJDigest(data))
+	require.ErrorIs(t, err, ErrIncorrectKeyType)
+	_, err = kp.PublicKey(KeyID{Type: KeyTypeBabyJubJub, ID: "../outside"})
+	require.Error(t, err)
+}
+
+func TestFileKeyProvider_Ethereum(t *testing.T) {
+	ctx := context.Background()
+	cfg := FileConfig{Dir: t.TempDir(), Passphrase: "secret"}
+	kp, err := NewFileKeyProvider(cfg, KeyTypeEthereum)
+	require.NoError(t, err)
+
+	did := randomDID(t)
+	identityKey, err := kp.New(&did)
+	require.NoError(t, err)
+	require.Equal(t, KeyTypeEthereum, identityKey.Type)
+
+	keys, err := kp.ListByIdentity(ctx, did)
+	require.NoError(t, err)
+	require.Equal(t, []KeyID{identityKey}, keys)
+
+	pubKeyBytes, err := kp.PublicKey(identityKey)
+	require.NoError(t, err)
+	require.Len(t, pubKeyBytes, compressedETHPubKeyLen)
+	pubKey, err := DecodeETHPubKey(pubKeyBytes)
+	require.NoError(t, err)
+
+	digest := crypto.Keccak256([]byte("data"))
+	sig, err := kp.Sign(ctx, identityKey, digest)
+	require.NoError(t, err)
+	recovered, err := crypto.SigToPub(digest, sig)
+	require.NoError(t, err)
+	require.Equal(t, crypto.PubkeyToAddress(*pubKey), crypto.PubkeyToAddress(*recovered))
+
+	_, err = kp.Sign(ctx, identityKey, []byte("short"))
+	require.Error(t, err)
+}
+
+func TestFileKeyProvider_JWS(t *testing.T) {
+	ctx := context.Background()
+	keyStore, err := OpenFile(FileConfig{Dir: t.TempDir(), Passphrase: "secret"})
+	require.NoError(t, err)
+	did := randomDID(t)
+	data := []byte("header.payload")
+
+	p256Key, err := keyStore.CreateKey(KeyTypeP256, &did)
+	require.NoError(t, err)
+	pubKeyBytes, err := keyStore.PublicKey(p256Key)
+	require.NoError(t, err)
+	pubKey, err := JWSPublicKey(KeyTypeP256, pubKeyBytes)
+	require.NoError(t, err)
+	sig, err := keyStore.Sign(ctx, p256Key, data)
+	require.NoError(t, err)
+	require.Len(t, sig, 64)
+	digest := sha256.Sum256(data)
+	require.True(t, ecdsa.Verify(pubKey.(*ecdsa.PublicKey), digest[:], new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:])))
+
+	ed25519Key, err := keyStore.CreateKey(KeyTypeEd25519, &did)
+	require.NoError(t, err)
+	pubKeyBytes, err = keyStore.PublicKey(ed25519Key)
+	require.NoError(t, err)
+	pubKey, err = JWSPublicKey(KeyTypeEd25519, pubKeyBytes)
+	require.NoError(t, err)
+	sig, err = keyStore.Sign(ctx, ed25519Key, data)
+	require.NoError(t, err)
+	require.True(t, ed25519.Verify(pubKey.(ed25519.PublicKey), data, sig))
+
+	keys, err := keyStore.KeysByIdentity(ctx, did)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []KeyID{p256Key, ed25519Key}, keys)
+
+	keyType, err := JWSKeyType(JWSAlgorithmEdDSA)
+	require.NoError(t, err)
+	require.Equal(t, KeyTypeEd25519, keyType)
+	_, err = JWSKeyType("RS256")
+	require.ErrorIs(t, err, ErrUnknownKeyType)
+}
+
+func TestFileKeyProvider_Passphrase(t *testing.T) {
+	ctx := context.Background()
+	cfg := FileConfig{Dir: t.TempDir(), Passphrase: "secret"}
+	kp, err := NewFileKeyProvider(cfg, KeyTypeEthereum)
+	require.NoError(t, err)
+
+	_, err = NewFileKeyProvider(FileConfig{Dir: cfg.Dir, Passphrase: "wrong"}, KeyTypeEthereum)
+	require.EqualError(t, err, "wrong key store passphrase")
+
+	_, err = NewFileKeyProvider(FileConfig{Dir: cfg.Dir}, KeyTypeEthereum)
+	require.Error(t, err)
+
+	// a key file copied to another key ID can't be decrypted
+	key1, err := kp.New(nil)
+	require.NoError(t, err)
+	key2, err := kp.New(nil)
+	require.NoError(t, err)
+	path1, err := kp.(*fileKeyProvider).store.path(key1.ID)
+	require.NoError(t, err)
+	path2, err := kp.(*fileKeyProvider).store.path(key2.ID)
+	require.NoError(t, err)
+	data, err := os.ReadFile(path1)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path2, data, 0o600))
+
+	_, err = kp.Sign(ctx, key2, crypto.Keccak256([]byte("data")))
+	require.Error(t, err)
+}
+
+func TestImportFileKey(t *testing.T) {
+	ctx := context.Background()
+	cfg := FileConfig{Dir: t.TempDir(), Passphrase: "secret"}
+	privKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	keyID := KeyID{Type: KeyTypeEthereum, ID: "pbkey"}
+	require.NoError(t, ImportFileKey(cfg, keyID, crypto.FromECDSA(privKey)))
+
+	keyStore, err := OpenFile(cfg)
+	require.NoError(t, err)
+
+	pubKeyBytes, err := keyStore.PublicKey(keyID)
+	require.NoError(t, err)
+	require.Equal(t, crypto.CompressPubkey(&privKey.PublicKey), pubKeyBytes)
+
+	digest := crypto.Keccak256([]byte("data"))
+	sig, err := keyStore.Sign(ctx, keyID, digest)
+	require.NoError(t, err)
+	recovered, err := crypto.SigToPub(digest, sig)
+	require.NoError(t, err)
+	require.Equal(t, crypto.PubkeyToAddress(privKey.PublicKey), crypto.PubkeyToAddress(*recovered))
+
+	require.Error(t, ImportFileKey(cfg, KeyID{Type: KeyTypeEthereum, ID: "bad"}, []byte{1, 2, 3}))
+}
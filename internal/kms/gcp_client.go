@@ -0,0 +1,275 @@
+package kms
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	gcpKMSEndpoint      = "https://cloudkms.googleapis.com/v1/"
+	gcpMetadataTokenURL = "http://metadata.google.internal/computeMetadata/v1/instance/service-accounts/default/token"
+	gcpTokenURL         = "https://oauth2.googleapis.com/token"
+	gcpKMSScope         = "https://www.googleapis.com/auth/cloudkms"
+	gcpJWTBearerGrant   = "urn:ietf:params:oauth:grant-type:jwt-bearer"
+	gcpHTTPTimeout      = 10 * time.Second
+	gcpTokenLifetime    = time.Hour
+	gcpTokenExpiryDelta = time.Minute // gcpTokenExpiryDelta is how long before its expiration a token is renewed
+)
+
+// GCPConfig holds the settings needed to use Google Cloud KMS
+type GCPConfig struct {
+	// KeyRing is the resource name of the key ring of the keys, projects/<project>/locations/<location>/keyRings/<ring>
+	KeyRing string
+	// CredentialsFile is the JSON key file of a service account. If empty, the credentials of the service account
+	// attached to the instance are fetched from the metadata server.
+	CredentialsFile string
+	// ProtectionLevel of the new keys, HSM or SOFTWARE
+	ProtectionLevel string
+	// Endpoint replaces the Cloud KMS endpoint, for local testing
+	Endpoint string
+}
+
+// gcpError is the error body returned by the Google APIs
+type gcpError struct {
+	Err struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+		Status  string `json:"status"`
+	} `json:"error"`
+	StatusCode int `json:"-"`
+}
+
+func (e *gcpError) Error() string {
+	return fmt.Sprintf("gcp error %d %s: %s", e.StatusCode, e.Err.Status, e.Err.Message)
+}
+
+func isGCPError(err error, status string) bool {
+	var gcpErr *gcpError
+	return errors.As(err, &gcpErr) && gcpErr.Err.Status == status
+}
+
+// gcpClient calls the Cloud KMS REST API with an OAuth2 access token
+type gcpClient struct {
+	httpCli  *http.Client
+	endpoint string
+	tokens   *gcpTokenSource
+}
+
+func newGCPClient(cfg GCPConfig) (*gcpClient, error) {
+	if cfg.KeyRing == "" {
+		return nil, errors.New("gcp key ring is not specified")
+	}
+
+	httpCli := &http.Client{Timeout: gcpHTTPTimeout}
+	tokens := &gcpTokenSource{httpCli: httpCli, now: time.Now}
+	if cfg.CredentialsFile != "" {
+		if err := tokens.loadServiceAccount(cfg.CredentialsFile); err != nil {
+			return nil, err
+		}
+	}
+
+	endpoint := gcpKMSEndpoint
+	if cfg.Endpoint != "" {
+		endpoint = strings.TrimSuffix(cfg.Endpoint, "/") + "/"
+	}
+	return &gcpClient{httpCli: httpCli, endpoint: endpoint, tokens: tokens}, nil
+}
+
+// call sends the input encoded as JSON to the resource path and decodes the response into output
+func (c *gcpClient) call(ctx context.Context, method, path string, query url.Values, input, output interface{}) error {
+	var body io.Reader
+	if input != nil {
+		data, err := json.Marshal(input)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(data)
+	}
+
+	endpoint := c.endpoint + path
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, body)
+	if err != nil {
+		return err
+	}
+	token, err := c.tokens.token(ctx)
+	if err != nil {
+		return fmt.Errorf("can't get gcp access token: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	if input != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpCli.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		gcpErr := &gcpError{StatusCode: resp.StatusCode}
+		_ = json.Unmarshal(respBody, gcpErr)
+		return gcpErr
+	}
+	if output == nil {
+		return nil
+	}
+	return json.Unmarshal(respBody, output)
+}
+
+// gcpTokenSource returns OAuth2 access tokens, renewing them before they expire. Tokens are obtained with the
+// JWT bearer flow when a service account key is loaded and from the metadata server otherwise.
+type gcpTokenSource struct {
+	httpCli *http.Client
+	now     func() time.Time
+
+	clientEmail string
+	privateKey  *rsa.PrivateKey
+	tokenURL    string
+
+	mu          sync.Mutex
+	accessToken string
+	expiry      time.Time
+}
+
+func (s *gcpTokenSource) loadServiceAccount(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("can't read gcp credentials file: %w", err)
+	}
+	var sa struct {
+		ClientEmail string `json:"client_email"`
+		PrivateKey  string `json:"private_key"`
+		TokenURI    string `json:"token_uri"`
+	}
+	if err := json.Unmarshal(data, &sa); err != nil {
+		return fmt.Errorf("can't parse gcp credentials file: %w", err)
+	}
+
+	block, _ := pem.Decode([]byte(sa.PrivateKey))
+	if block == nil {
+		return errors.New("gcp credentials file has no private key")
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return fmt.Errorf("can't parse gcp service account key: %w", err)
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return errors.New("gcp service account key is not an RSA key")
+	}
+
+	s.clientEmail = sa.ClientEmail
+	s.privateKey = rsaKey
+	s.tokenURL = sa.TokenURI
+	if s.tokenURL == "" {
+		s.tokenURL = gcpTokenURL
+	}
+	return nil
+}
+
+func (s *gcpTokenSource) token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.accessToken != "" && s.now().Add(gcpTokenExpiryDelta).Before(s.expiry) {
+		return s.accessToken, nil
+	}
+
+	var req *http.Request
+	var err error
+	if s.privateKey != nil {
+		req, err = s.jwtBearerRequest(ctx)
+	} else {
+		req, err = http.NewRequestWithContext(ctx, http.MethodGet, gcpMetadataTokenURL, nil)
+		if req != nil {
+			req.Header.Set("Metadata-Flavor", "Google")
+		}
+	}
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := s.httpCli.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return "", fmt.Errorf("token request failed with status %d: %s", resp.StatusCode, body)
+	}
+
+	var tok struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&tok); err != nil {
+		return "", err
+	}
+	if tok.AccessToken == "" {
+		return "", errors.New("token response without access token")
+	}
+	s.accessToken = tok.AccessToken
+	s.expiry = s.now().Add(time.Duration(tok.ExpiresIn) * time.Second)
+	return s.accessToken, nil
+}
+
+// jwtBearerRequest returns the request that exchanges a JWT signed by the service account for an access token
+func (s *gcpTokenSource) jwtBearerRequest(ctx context.Context) (*http.Request, error) {
+	now := s.now()
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT"})
+	if err != nil {
+		return nil, err
+	}
+	claims, err := json.Marshal(map[string]interface{}{
+		"iss":   s.clientEmail,
+		"scope": gcpKMSScope,
+		"aud":   s.tokenURL,
+		"iat":   now.Unix(),
+		"exp":   now.Add(gcpTokenLifetime).Unix(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	enc := base64.RawURLEncoding
+	unsigned := enc.EncodeToString(header) + "." + enc.EncodeToString(claims)
+	digest := sha256.Sum256([]byte(unsigned))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, s.privateKey, crypto.SHA256, digest[:])
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{"grant_type": {gcpJWTBearerGrant}, "assertion": {unsigned + "." + enc.EncodeToString(sig)}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return req, nil
+}
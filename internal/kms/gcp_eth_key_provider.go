@@ -0,0 +1,262 @@
+package kms
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	core "github.com/iden3/go-iden3-core"
+)
+
+const (
+	gcpPurposeAsymmetricSign   = "ASYMMETRIC_SIGN"
+	gcpAlgorithmSecp256k1      = "EC_SIGN_SECP256K1_SHA256"
+	gcpProtectionLevelHSM      = "HSM"
+	gcpKeyVersionEnabled       = "ENABLED"
+	gcpKeyVersionGenerating    = "PENDING_GENERATION"
+	gcpFirstKeyVersion         = "/cryptoKeyVersions/1"
+	gcpKeyIDPrefix             = "iden3-eth-"
+	gcpLabelIdentity           = "iden3_identity"
+	gcpLabelKeyType            = "iden3_key_type"
+	gcpListPageSize            = 100
+	gcpKeyGenerationPollPeriod = 500 * time.Millisecond
+	gcpKeyGenerationTimeout    = 30 * time.Second
+)
+
+type gcpCryptoKey struct {
+	Name   string            `json:"name"`
+	Labels map[string]string `json:"labels"`
+}
+
+// gcpETHKeyProvider keeps Ethereum keys in Google Cloud KMS. With the HSM protection level the private keys are
+// generated in and never leave the Cloud HSM, which signs the digests.
+// The key ID is the resource name of the first version of the crypto key. Keys are bound to an identity with a
+// label holding a hash of the identity, as label values can't contain the DID.
+type gcpETHKeyProvider struct {
+	keyType         KeyType
+	keyRing         string
+	protectionLevel string
+	gcpCli          *gcpClient
+	publicKeys      sync.Map // key version name -> compressed public key
+}
+
+// NewGCPETHKeyProvider creates new key provider for Ethereum keys stored in Google Cloud KMS
+func NewGCPETHKeyProvider(cfg GCPConfig, keyType KeyType) (KeyProvider, error) {
+	gcpCli, err := newGCPClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+	protectionLevel := cfg.ProtectionLevel
+	if protectionLevel == "" {
+		protectionLevel = gcpProtectionLevelHSM
+	}
+	return &gcpETHKeyProvider{
+		keyType:         keyType,
+		keyRing:         strings.Trim(cfg.KeyRing, "/"),
+		protectionLevel: protectionLevel,
+		gcpCli:          gcpCli,
+	}, nil
+}
+
+func (g *gcpETHKeyProvider) New(identity *core.DID) (KeyID, error) {
+	ctx := context.Background()
+
+	suffix := make([]byte, 16)
+	if _, err := rand.Read(suffix); err != nil {
+		return KeyID{}, err
+	}
+
+	labels := map[string]string{gcpLabelKeyType: strings.ToLower(string(g.keyType))}
+	if identity != nil {
+		labels[gcpLabelIdentity] = g.identityLabel(*identity)
+	}
+	input := map[string]interface{}{
+		"purpose": gcpPurposeAsymmetricSign,
+		"labels":  labels,
+		"versionTemplate": map[string]string{
+			"algorithm":       gcpAlgorithmSecp256k1,
+			"protectionLevel": g.protectionLevel,
+		},
+	}
+	var output gcpCryptoKey
+	query := url.Values{"cryptoKeyId": {gcpKeyIDPrefix + hex.EncodeToString(suffix)}}
+	if err := g.gcpCli.call(ctx, http.MethodPost, g.keyRing+"/cryptoKeys", query, input, &output); err != nil {
+		return KeyID{}, err
+	}
+
+	keyID := KeyID{Type: g.keyType, ID: output.Name + gcpFirstKeyVersion}
+	return keyID, g.waitForKeyGeneration(ctx, keyID.ID)
+}
+
+// LinkToIdentity labels the key with the identity. The key ID does not change.
+func (g *gcpETHKeyProvider) LinkToIdentity(ctx context.Context, keyID KeyID, identity core.DID) (KeyID, error) {
+	if keyID.Type != g.keyType {
+		return keyID, ErrIncorrectKeyType
+	}
+
+	name, err := g.cryptoKeyName(keyID)
+	if err != nil {
+		return keyID, err
+	}
+
+	var key gcpCryptoKey
+	if err := g.gcpCli.call(ctx, http.MethodGet, name, nil, nil, &key); err != nil {
+		return KeyID{}, err
+	}
+	if key.Labels == nil {
+		key.Labels = map[string]string{}
+	}
+	key.Labels[gcpLabelIdentity] = g.identityLabel(identity)
+
+	query := url.Values{"updateMask": {"labels"}}
+	input := map[string]interface{}{"labels": key.Labels}
+	if err := g.gcpCli.call(ctx, http.MethodPatch, name, query, input, nil); err != nil {
+		return KeyID{}, err
+	}
+	return keyID, nil
+}
+
+// Sign signs the digest and returns the signature in the [R || S || V] format, where V is 0 or 1
+func (g *gcpETHKeyProvider) Sign(ctx context.Context, keyID KeyID, data []byte) ([]byte, error) {
+	if keyID.Type != g.keyType {
+		return nil, ErrIncorrectKeyType
+	}
+	if len(data) != common.HashLength {
+		return nil, fmt.Errorf("data to sign should be %v bytes length", common.HashLength)
+	}
+
+	// Cloud KMS signs the digest as given, the algorithm only sets its expected length
+	input := map[string]interface{}{
+		"digest": map[string]string{"sha256": base64.StdEncoding.EncodeToString(data)},
+	}
+	var output struct {
+		Signature string `json:"signature"`
+	}
+	if err := g.gcpCli.call(ctx, http.MethodPost, keyID.ID+":asymmetricSign", nil, input, &output); err != nil {
+		return nil, err
+	}
+	derSig, err := base64.StdEncoding.DecodeString(output.Signature)
+	if err != nil {
+		return nil, err
+	}
+
+	pubKey, err := g.publicKey(ctx, keyID)
+	if err != nil {
+		return nil, err
+	}
+	return ethSignatureFromDER(data, derSig, pubKey)
+}
+
+func (g *gcpETHKeyProvider) ListByIdentity(ctx context.Context, identity core.DID) ([]KeyID, error) {
+	var result []KeyID
+	query := url.Values{
+		"filter":   {"labels." + gcpLabelIdentity + "=" + g.identityLabel(identity)},
+		"pageSize": {fmt.Sprint(gcpListPageSize)},
+	}
+	for {
+		var output struct {
+			CryptoKeys    []gcpCryptoKey `json:"cryptoKeys"`
+			NextPageToken string         `json:"nextPageToken"`
+		}
+		if err := g.gcpCli.call(ctx, http.MethodGet, g.keyRing+"/cryptoKeys", query, nil, &output); err != nil {
+			return nil, err
+		}
+		for _, key := range output.CryptoKeys {
+			result = append(result, KeyID{Type: g.keyType, ID: key.Name + gcpFirstKeyVersion})
+		}
+		if output.NextPageToken == "" {
+			return result, nil
+		}
+		query.Set("pageToken", output.NextPageToken)
+	}
+}
+
+// PublicKey returns the compressed public key, the same format used by the vault plugin
+func (g *gcpETHKeyProvider) PublicKey(keyID KeyID) ([]byte, error) {
+	if keyID.Type != g.keyType {
+		return nil, errors.New("incorrect key type")
+	}
+	pubKey, err := g.publicKey(context.Background(), keyID)
+	if err != nil {
+		return nil, err
+	}
+	return crypto.CompressPubkey(pubKey), nil
+}
+
+func (g *gcpETHKeyProvider) publicKey(ctx context.Context, keyID KeyID) (*ecdsa.PublicKey, error) {
+	if cached, ok := g.publicKeys.Load(keyID.ID); ok {
+		return crypto.DecompressPubkey(cached.([]byte))
+	}
+
+	var output struct {
+		Pem string `json:"pem"`
+	}
+	if err := g.gcpCli.call(ctx, http.MethodGet, keyID.ID+"/publicKey", nil, nil, &output); err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode([]byte(output.Pem))
+	if block == nil {
+		return nil, errors.New("unexpected format of public key")
+	}
+	pubKey, err := decodeSPKIPubKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	g.publicKeys.Store(keyID.ID, crypto.CompressPubkey(pubKey))
+	return pubKey, nil
+}
+
+// waitForKeyGeneration waits until the key version can be used. HSM keys are generated asynchronously.
+func (g *gcpETHKeyProvider) waitForKeyGeneration(ctx context.Context, version string) error {
+	ctx, cancel := context.WithTimeout(ctx, gcpKeyGenerationTimeout)
+	defer cancel()
+
+	for {
+		var output struct {
+			State string `json:"state"`
+		}
+		if err := g.gcpCli.call(ctx, http.MethodGet, version, nil, nil, &output); err != nil {
+			return err
+		}
+		switch output.State {
+		case gcpKeyVersionEnabled:
+			return nil
+		case gcpKeyVersionGenerating:
+		default:
+			return fmt.Errorf("unexpected state of key version %s: %s", version, output.State)
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("key version %s was not generated: %w", version, ctx.Err())
+		case <-time.After(gcpKeyGenerationPollPeriod):
+		}
+	}
+}
+
+func (g *gcpETHKeyProvider) cryptoKeyName(keyID KeyID) (string, error) {
+	name := strings.TrimSuffix(keyID.ID, gcpFirstKeyVersion)
+	if name == keyID.ID || !strings.HasPrefix(name, g.keyRing+"/cryptoKeys/") {
+		return "", errors.New("incorrect key ID")
+	}
+	return name, nil
+}
+
+// identityLabel returns the label value of the identity. Label values are limited to 63 lowercase characters.
+func (g *gcpETHKeyProvider) identityLabel(identity core.DID) string {
+	h := sha256.Sum256([]byte(identity.String()))
+	return hex.EncodeToString(h[:16])
+}
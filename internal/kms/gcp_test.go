@@ -0,0 +1,263 @@
+package kms
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	ethCrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const fakeGCPKeyRing = "projects/test/locations/global/keyRings/issuer"
+
+// fakeGCP implements the subset of the Cloud KMS API and the OAuth2 token endpoint used by the GCP key provider
+type fakeGCP struct {
+	t           *testing.T
+	saKey       *rsa.PrivateKey
+	mu          sync.Mutex
+	keys        map[string]*ecdsa.PrivateKey
+	labels      map[string]map[string]string
+	pending     map[string]bool
+	tokenIssued int
+}
+
+func newFakeGCP(t *testing.T) (*fakeGCP, GCPConfig) {
+	saKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	f := &fakeGCP{
+		t:       t,
+		saKey:   saKey,
+		keys:    map[string]*ecdsa.PrivateKey{},
+		labels:  map[string]map[string]string{},
+		pending: map[string]bool{},
+	}
+	srv := httptest.NewServer(f)
+	t.Cleanup(srv.Close)
+
+	pkcs8, err := x509.MarshalPKCS8PrivateKey(saKey)
+	require.NoError(t, err)
+	credentials, err := json.Marshal(map[string]string{
+		"type":         "service_account",
+		"client_email": "issuer@test.iam.gserviceaccount.com",
+		"private_key":  string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: pkcs8})),
+		"token_uri":    srv.URL + "/token",
+	})
+	require.NoError(t, err)
+	credentialsFile := filepath.Join(t.TempDir(), "credentials.json")
+	require.NoError(t, os.WriteFile(credentialsFile, credentials, 0o600))
+
+	return f, GCPConfig{KeyRing: fakeGCPKeyRing, CredentialsFile: credentialsFile, Endpoint: srv.URL}
+}
+
+func (f *fakeGCP) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if r.URL.Path == "/token" {
+		f.token(w, r)
+		return
+	}
+	assert.Equal(f.t, "Bearer fake-token", r.Header.Get("Authorization"))
+
+	path := strings.TrimPrefix(r.URL.Path, "/")
+	keysPath := fakeGCPKeyRing + "/cryptoKeys"
+	var out interface{}
+	switch {
+	case r.Method == http.MethodPost && path == keysPath:
+		var in struct {
+			Labels          map[string]string `json:"labels"`
+			VersionTemplate struct {
+				Algorithm string `json:"algorithm"`
+			} `json:"versionTemplate"`
+		}
+		require.NoError(f.t, json.NewDecoder(r.Body).Decode(&in))
+		assert.Equal(f.t, gcpAlgorithmSecp256k1, in.VersionTemplate.Algorithm)
+		key, err := ethCrypto.GenerateKey()
+		require.NoError(f.t, err)
+		name := keysPath + "/" + r.URL.Query().Get("cryptoKeyId")
+		f.keys[name] = key
+		f.labels[name] = in.Labels
+		f.pending[name] = true
+		out = gcpCryptoKey{Name: name, Labels: in.Labels}
+	case r.Method == http.MethodGet && path == keysPath:
+		var list []gcpCryptoKey
+		filter := strings.SplitN(strings.TrimPrefix(r.URL.Query().Get("filter"), "labels."), "=", 2)
+		for name, labels := range f.labels {
+			if labels[filter[0]] == filter[1] {
+				list = append(list, gcpCryptoKey{Name: name, Labels: labels})
+			}
+		}
+		out = map[string]interface{}{"cryptoKeys": list}
+	case r.Method == http.MethodGet && strings.HasSuffix(path, gcpFirstKeyVersion):
+		name := strings.TrimSuffix(path, gcpFirstKeyVersion)
+		if !f.exists(w, name) {
+			return
+		}
+		// the first poll finds the key being generated
+		state := gcpKeyVersionEnabled
+		if f.pending[name] {
+			state = gcpKeyVersionGenerating
+			f.pending[name] = false
+		}
+		out = map[string]string{"state": state}
+	case r.Method == http.MethodGet && strings.HasSuffix(path, gcpFirstKeyVersion+"/publicKey"):
+		name := strings.TrimSuffix(path, gcpFirstKeyVersion+"/publicKey")
+		if !f.exists(w, name) {
+			return
+		}
+		der, err := asn1.Marshal(struct {
+			Algorithm pkix.AlgorithmIdentifier
+			PublicKey asn1.BitString
+		}{
+			Algorithm: pkix.AlgorithmIdentifier{Algorithm: asn1.ObjectIdentifier{1, 2, 840, 10045, 2, 1}},
+			PublicKey: asn1.BitString{Bytes: ethCrypto.FromECDSAPub(&f.keys[name].PublicKey), BitLength: 65 * 8},
+		})
+		require.NoError(f.t, err)
+		out = map[string]string{"pem": string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))}
+	case r.Method == http.MethodPost && strings.HasSuffix(path, gcpFirstKeyVersion+":asymmetricSign"):
+		name := strings.TrimSuffix(path, gcpFirstKeyVersion+":asymmetricSign")
+		if !f.exists(w, name) {
+			return
+		}
+		var in struct {
+			Digest struct {
+				SHA256 string `json:"sha256"`
+			} `json:"digest"`
+		}
+		require.NoError(f.t, json.NewDecoder(r.Body).Decode(&in))
+		digest, err := base64.StdEncoding.DecodeString(in.Digest.SHA256)
+		require.NoError(f.t, err)
+		r, s, err := ecdsa.Sign(rand.Reader, f.keys[name], digest)
+		require.NoError(f.t, err)
+		der, err := asn1.Marshal(struct{ R, S *big.Int }{r, s})
+		require.NoError(f.t, err)
+		out = map[string]string{"signature": base64.StdEncoding.EncodeToString(der)}
+	case r.Method == http.MethodGet:
+		if !f.exists(w, path) {
+			return
+		}
+		out = gcpCryptoKey{Name: path, Labels: f.labels[path]}
+	case r.Method == http.MethodPatch:
+		if !f.exists(w, path) {
+			return
+		}
+		assert.Equal(f.t, "labels", r.URL.Query().Get("updateMask"))
+		var in gcpCryptoKey
+		require.NoError(f.t, json.NewDecoder(r.Body).Decode(&in))
+		f.labels[path] = in.Labels
+		out = gcpCryptoKey{Name: path, Labels: in.Labels}
+	default:
+		f.fail(w, http.StatusNotImplemented, "UNIMPLEMENTED")
+		return
+	}
+	require.NoError(f.t, json.NewEncoder(w).Encode(out))
+}
+
+// token checks the JWT bearer assertion is signed by the service account key
+func (f *fakeGCP) token(w http.ResponseWriter, r *http.Request) {
+	require.NoError(f.t, r.ParseForm())
+	assert.Equal(f.t, gcpJWTBearerGrant, r.PostForm.Get("grant_type"))
+	parts := strings.Split(r.PostForm.Get("assertion"), ".")
+	require.Len(f.t, parts, 3)
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	require.NoError(f.t, err)
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	require.NoError(f.t, rsa.VerifyPKCS1v15(&f.saKey.PublicKey, crypto.SHA256, digest[:], sig))
+
+	f.tokenIssued++
+	require.NoError(f.t, json.NewEncoder(w).Encode(map[string]interface{}{"access_token": "fake-token", "expires_in": 3600}))
+}
+
+func (f *fakeGCP) exists(w http.ResponseWriter, name string) bool {
+	if _, ok := f.keys[name]; !ok {
+		f.fail(w, http.StatusNotFound, "NOT_FOUND")
+		return false
+	}
+	return true
+}
+
+func (f *fakeGCP) fail(w http.ResponseWriter, code int, status string) {
+	w.WriteHeader(code)
+	_, _ = fmt.Fprintf(w, `{"error":{"code":%d,"message":"fake error","status":%q}}`, code, status)
+}
+
+func TestGCPETHKeyProvider(t *testing.T) {
+	ctx := context.Background()
+	fake, cfg := newFakeGCP(t)
+	kp, err := NewGCPETHKeyProvider(cfg, KeyTypeEthereum)
+	require.NoError(t, err)
+
+	// unbound key
+	newKey, err := kp.New(nil)
+	require.NoError(t, err)
+	require.Equal(t, KeyTypeEthereum, newKey.Type)
+	require.True(t, strings.HasPrefix(newKey.ID, fakeGCPKeyRing+"/cryptoKeys/"+gcpKeyIDPrefix))
+
+	did := randomDID(t)
+	keys, err := kp.ListByIdentity(ctx, did)
+	require.NoError(t, err)
+	require.Empty(t, keys)
+
+	boundKey, err := kp.LinkToIdentity(ctx, newKey, did)
+	require.NoError(t, err)
+	require.Equal(t, newKey, boundKey)
+
+	// key created for the identity
+	identityKey, err := kp.New(&did)
+	require.NoError(t, err)
+
+	keys, err = kp.ListByIdentity(ctx, did)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []KeyID{boundKey, identityKey}, keys)
+
+	keys, err = kp.ListByIdentity(ctx, randomDID(t))
+	require.NoError(t, err)
+	require.Empty(t, keys)
+
+	pubKeyBytes, err := kp.PublicKey(identityKey)
+	require.NoError(t, err)
+	require.Len(t, pubKeyBytes, compressedETHPubKeyLen)
+	pubKey, err := DecodeETHPubKey(pubKeyBytes)
+	require.NoError(t, err)
+
+	for i := 0; i < 10; i++ {
+		digest := ethCrypto.Keccak256([]byte{byte(i)})
+		sig, err := kp.Sign(ctx, identityKey, digest)
+		require.NoError(t, err)
+		require.Len(t, sig, ethCrypto.SignatureLength)
+
+		recovered, err := ethCrypto.SigToPub(digest, sig)
+		require.NoError(t, err)
+		require.Equal(t, ethCrypto.PubkeyToAddress(*pubKey), ethCrypto.PubkeyToAddress(*recovered))
+	}
+
+	// the access token is reused
+	require.Equal(t, 1, fake.tokenIssued)
+
+	_, err = kp.Sign(ctx, identityKey, []byte("short"))
+	require.Error(t, err)
+	_, err = kp.Sign(ctx, KeyID{Type: KeyTypeEthereum, ID: fakeGCPKeyRing + "/cryptoKeys/unknown" + gcpFirstKeyVersion}, ethCrypto.Keccak256([]byte("data")))
+	require.True(t, isGCPError(err, "NOT_FOUND"))
+	_, err = kp.LinkToIdentity(ctx, KeyID{Type: KeyTypeEthereum, ID: "projects/other/cryptoKeys/key"}, did)
+	require.Error(t, err)
+}
@@ -0,0 +1,123 @@
+package kms
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"math/big"
+)
+
+// JWS algorithms of the JWS key types
+const (
+	JWSAlgorithmES256 = "ES256"
+	JWSAlgorithmEdDSA = "EdDSA"
+)
+
+const p256ScalarLength = 32
+
+// JWSKeyType returns the key type that signs with a JWS algorithm
+func JWSKeyType(alg string) (KeyType, error) {
+	switch alg {
+	case JWSAlgorithmES256:
+		return KeyTypeP256, nil
+	case JWSAlgorithmEdDSA:
+		return KeyTypeEd25519, nil
+	}
+	return "", ErrUnknownKeyType
+}
+
+// JWSPublicKey decodes the public key of a JWS key returned by PublicKey. P-256 keys are compressed points and
+// Ed25519 keys are the raw 32 bytes.
+func JWSPublicKey(keyType KeyType, pubKey []byte) (crypto.PublicKey, error) {
+	switch keyType {
+	case KeyTypeP256:
+		x, y := elliptic.UnmarshalCompressed(elliptic.P256(), pubKey)
+		if x == nil {
+			return nil, errors.New("incorrect P-256 public key")
+		}
+		return &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}, nil
+	case KeyTypeEd25519:
+		if len(pubKey) != ed25519.PublicKeySize {
+			return nil, errors.New("incorrect Ed25519 public key")
+		}
+		return ed25519.PublicKey(pubKey), nil
+	}
+	return nil, ErrIncorrectKeyType
+}
+
+func isJWSKeyType(keyType KeyType) bool {
+	return keyType == KeyTypeP256 || keyType == KeyTypeEd25519
+}
+
+// jwsPublicKeyHexLength is the length of the public keys in the key IDs
+func jwsPublicKeyHexLength(keyType KeyType) int {
+	if keyType == KeyTypeP256 {
+		return 66
+	}
+	return 64
+}
+
+// newJWSKey generates a JWS key. The private key is the P-256 scalar or the Ed25519 seed, both 32 bytes.
+func newJWSKey(keyType KeyType) (pubKey []byte, privKey []byte, err error) {
+	switch keyType {
+	case KeyTypeP256:
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			return nil, nil, err
+		}
+		return elliptic.MarshalCompressed(elliptic.P256(), key.X, key.Y), key.D.FillBytes(make([]byte, p256ScalarLength)), nil
+	case KeyTypeEd25519:
+		pub, priv, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, nil, err
+		}
+		return pub, priv.Seed(), nil
+	}
+	return nil, nil, ErrIncorrectKeyType
+}
+
+// signJWS signs the JWS signing input. ES256 signatures are the R || S values of the SHA-256 digest, 32 bytes each,
+// as JWS expects them.
+func signJWS(keyType KeyType, privKey []byte, data []byte) ([]byte, error) {
+	if len(privKey) != defaultLength {
+		return nil, errors.New("incorrect private key")
+	}
+	switch keyType {
+	case KeyTypeP256:
+		key := new(ecdsa.PrivateKey)
+		key.Curve = elliptic.P256()
+		key.D = new(big.Int).SetBytes(privKey)
+		key.X, key.Y = key.Curve.ScalarBaseMult(privKey)
+		digest := sha256.Sum256(data)
+		r, s, err := ecdsa.Sign(rand.Reader, key, digest[:])
+		if err != nil {
+			return nil, err
+		}
+		sig := make([]byte, 2*p256ScalarLength)
+		r.FillBytes(sig[:p256ScalarLength])
+		s.FillBytes(sig[p256ScalarLength:])
+		return sig, nil
+	case KeyTypeEd25519:
+		return ed25519.Sign(ed25519.NewKeyFromSeed(privKey), data), nil
+	}
+	return nil, ErrIncorrectKeyType
+}
+
+// jwsPublicKey returns the public key of a JWS private key
+func jwsPublicKey(keyType KeyType, privKey []byte) ([]byte, error) {
+	if len(privKey) != defaultLength {
+		return nil, errors.New("incorrect private key")
+	}
+	switch keyType {
+	case KeyTypeP256:
+		x, y := elliptic.P256().ScalarBaseMult(privKey)
+		return elliptic.MarshalCompressed(elliptic.P256(), x, y), nil
+	case KeyTypeEd25519:
+		return ed25519.NewKeyFromSeed(privKey).Public().(ed25519.PublicKey), nil
+	}
+	return nil, ErrIncorrectKeyType
+}
@@ -9,6 +9,9 @@ import (
 	"github.com/hashicorp/vault/api"
 	core "github.com/iden3/go-iden3-core"
 	"github.com/pkg/errors"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/providers"
 )
 
 // KMSType represents the KMS interface
@@ -51,6 +54,9 @@ type KeyType string
 const (
 	KeyTypeBabyJubJub KeyType = "BJJ"
 	KeyTypeEthereum   KeyType = "ETH"
+	// KeyTypeP256 and KeyTypeEd25519 sign JWS, with the ES256 and EdDSA algorithms
+	KeyTypeP256    KeyType = "P256"
+	KeyTypeEd25519 KeyType = "ED25519"
 )
 
 // ErrUnknownKeyType returns when we do not support this type of keys
@@ -174,6 +180,56 @@ func (k *KMS) LinkToIdentity(ctx context.Context, keyID KeyID, identity core.DID
 	return kp.LinkToIdentity(ctx, keyID, identity)
 }
 
+// OpenKeyStore returns an initialized KMS whose keys are stored in the configured key store provider.
+// The vault token is kept alive until ctx is done.
+// Ethereum keys can be kept in Google Cloud KMS instead, which does not support BabyJubJub keys.
+func OpenKeyStore(ctx context.Context, cfg config.KeyStore) (*KMS, error) {
+	var keyStore *KMS
+	var err error
+	switch cfg.Provider {
+	case config.KeyStoreProviderAWS:
+		keyStore, err = OpenAWS(AWSConfig{
+			Region:          cfg.AWSRegion,
+			AccessKeyID:     cfg.AWSAccessKey,
+			SecretAccessKey: cfg.AWSSecretKey,
+			SessionToken:    cfg.AWSSessionToken,
+			Endpoint:        cfg.AWSEndpoint,
+		})
+	case config.KeyStoreProviderFile:
+		keyStore, err = OpenFile(FileConfig{Dir: cfg.FilePath, Passphrase: cfg.FilePassphrase})
+	default:
+		vaultCli, vaultErr := providers.NewVaultClientWithAuth(ctx, providers.VaultConfig{
+			Address:             cfg.Address,
+			AuthMethod:          cfg.AuthMethod,
+			AuthMountPath:       cfg.AuthMountPath,
+			Token:               cfg.Token,
+			AppRoleID:           cfg.AppRoleID,
+			AppRoleSecretID:     cfg.AppRoleSecretID,
+			KubernetesRole:      cfg.KubernetesRole,
+			KubernetesTokenPath: cfg.KubernetesTokenPath,
+		})
+		if vaultErr != nil {
+			return nil, fmt.Errorf("cannot init vault client: %w", vaultErr)
+		}
+		keyStore, err = Open(cfg.PluginIden3MountPath, vaultCli)
+	}
+	if err != nil || cfg.ETHProvider != config.KeyStoreProviderGCP {
+		return keyStore, err
+	}
+
+	ethKeyProvider, err := NewGCPETHKeyProvider(GCPConfig{
+		KeyRing:         cfg.GCPKeyRing,
+		CredentialsFile: cfg.GCPCredentialsFile,
+		ProtectionLevel: cfg.GCPProtectionLevel,
+		Endpoint:        cfg.GCPEndpoint,
+	}, KeyTypeEthereum)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create Ethereum key provider: %+v", err)
+	}
+	keyStore.registry[KeyTypeEthereum] = ethKeyProvider
+	return keyStore, nil
+}
+
 // Open returns an initialized KMS
 func Open(pluginIden3MountPath string, vault *api.Client) (*KMS, error) {
 	bjjKeyProvider, err := NewVaultPluginIden3KeyProvider(vault, pluginIden3MountPath, KeyTypeBabyJubJub)
@@ -186,8 +242,67 @@ func Open(pluginIden3MountPath string, vault *api.Client) (*KMS, error) {
 		return nil, fmt.Errorf("cannot create Ethereum key provider: %+v", err)
 	}
 
+	keyStore, err := newKMSWithProviders(bjjKeyProvider, ethKeyProvider)
+	if err != nil {
+		return nil, err
+	}
+	// the JWS keys are kept in the KV secrets engine, as the iden3 plugin only supports BabyJubJub and Ethereum keys
+	return keyStore, registerJWSKeyProviders(keyStore, func(keyType KeyType) (KeyProvider, error) {
+		return NewVaultBJJKeyProvider(vault, keyType), nil
+	})
+}
+
+// OpenAWS returns an initialized KMS that keeps Ethereum keys in AWS KMS and BabyJubJub keys in AWS Secrets Manager
+func OpenAWS(cfg AWSConfig) (*KMS, error) {
+	bjjKeyProvider, err := NewAWSBJJKeyProvider(cfg, KeyTypeBabyJubJub)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create BabyJubJub key provider: %+v", err)
+	}
+
+	ethKeyProvider, err := NewAWSETHKeyProvider(cfg, KeyTypeEthereum)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create Ethereum key provider: %+v", err)
+	}
+
+	keyStore, err := newKMSWithProviders(bjjKeyProvider, ethKeyProvider)
+	if err != nil {
+		return nil, err
+	}
+	return keyStore, registerJWSKeyProviders(keyStore, func(keyType KeyType) (KeyProvider, error) {
+		return NewAWSBJJKeyProvider(cfg, keyType)
+	})
+}
+
+// OpenFile returns an initialized KMS that keeps the keys in encrypted files. It is meant for development and
+// small deployments that don't run Vault.
+func OpenFile(cfg FileConfig) (*KMS, error) {
+	store, err := openFileKeyStore(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open key store: %+v", err)
+	}
+
+	bjjKeyProvider, err := newFileKeyProvider(store, KeyTypeBabyJubJub)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create BabyJubJub key provider: %+v", err)
+	}
+
+	ethKeyProvider, err := newFileKeyProvider(store, KeyTypeEthereum)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create Ethereum key provider: %+v", err)
+	}
+
+	keyStore, err := newKMSWithProviders(bjjKeyProvider, ethKeyProvider)
+	if err != nil {
+		return nil, err
+	}
+	return keyStore, registerJWSKeyProviders(keyStore, func(keyType KeyType) (KeyProvider, error) {
+		return newFileKeyProvider(store, keyType)
+	})
+}
+
+func newKMSWithProviders(bjjKeyProvider, ethKeyProvider KeyProvider) (*KMS, error) {
 	keyStore := NewKMS()
-	err = keyStore.RegisterKeyProvider(KeyTypeBabyJubJub, bjjKeyProvider)
+	err := keyStore.RegisterKeyProvider(KeyTypeBabyJubJub, bjjKeyProvider)
 	if err != nil {
 		return nil, fmt.Errorf("cannot register BabyJubJub key provider: %+v", err)
 	}
@@ -199,3 +314,18 @@ func Open(pluginIden3MountPath string, vault *api.Client) (*KMS, error) {
 
 	return keyStore, nil
 }
+
+// registerJWSKeyProviders registers the providers of the P-256 and Ed25519 keys. They are stored with the
+// BabyJubJub keys and sign locally.
+func registerJWSKeyProviders(keyStore *KMS, newProvider func(KeyType) (KeyProvider, error)) error {
+	for _, keyType := range []KeyType{KeyTypeP256, KeyTypeEd25519} {
+		kp, err := newProvider(keyType)
+		if err != nil {
+			return fmt.Errorf("cannot create %s key provider: %+v", keyType, err)
+		}
+		if err := keyStore.RegisterKeyProvider(keyType, kp); err != nil {
+			return fmt.Errorf("cannot register %s key provider: %+v", keyType, err)
+		}
+	}
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math/big"
 	"regexp"
+	"strconv"
 
 	"github.com/hashicorp/vault/api"
 	core "github.com/iden3/go-iden3-core"
@@ -27,25 +28,28 @@ const (
 )
 
 // NewVaultBJJKeyProvider creates new key provider for BabyJubJub keys stored
-// in vault
+// in vault. It also keeps the JWS keys, which are signed locally as well.
 func NewVaultBJJKeyProvider(vaultCli *api.Client, keyType KeyType) KeyProvider {
 	keyTypeRE := regexp.QuoteMeta(string(keyType))
 	reIdenKeyPathHex := regexp.MustCompile("^(?i).*/" + keyTypeRE +
-		":([a-f0-9]{64})$")
+		":([a-f0-9]{" + localPublicKeyHexLength(keyType) + "})$")
 	reAnonKeyPathHex := regexp.MustCompile("^(?i)" + keyTypeRE +
-		":([a-f0-9]{64})$")
+		":([a-f0-9]{" + localPublicKeyHexLength(keyType) + "})$")
 	return &vaultBJJKeyProvider{keyType, vaultCli, reIdenKeyPathHex, reAnonKeyPathHex}
 }
 
 func (v *vaultBJJKeyProvider) New(identity *core.DID) (KeyID, error) {
-	bjjPrivKey := babyjub.NewRandPrivKey()
+	pubKey, privKey, err := newLocalKey(v.keyType)
+	if err != nil {
+		return KeyID{}, err
+	}
 	keyID := KeyID{
 		Type: v.keyType,
-		ID:   keyPath(identity, v.keyType, bjjPrivKey.Public().String()),
+		ID:   keyPath(identity, v.keyType, pubKey),
 	}
 	keyMaterial := map[string]string{
 		jsonKeyType: string(keyID.Type),
-		jsonKeyData: hex.EncodeToString(bjjPrivKey[:]),
+		jsonKeyData: hex.EncodeToString(privKey),
 	}
 	return keyID, saveKeyMaterial(v.vaultCli, keyID.ID, keyMaterial)
 }
@@ -70,7 +74,16 @@ func (v *vaultBJJKeyProvider) LinkToIdentity(_ context.Context, keyID KeyID, ide
 
 // Sign signs *big.Int using poseidon algorithm.
 // data should be a little-endian bytes representation of *big.Int.
+// JWS keys sign the JWS signing input.
 func (v *vaultBJJKeyProvider) Sign(_ context.Context, keyID KeyID, data []byte) ([]byte, error) {
+	if isJWSKeyType(v.keyType) {
+		privKeyData, err := v.privateKey(keyID)
+		if err != nil {
+			return nil, err
+		}
+		return signJWS(v.keyType, privKeyData, data)
+	}
+
 	if len(data) > defaultLength {
 		return nil, errors.New("data to sign is too large")
 	}
@@ -102,7 +115,7 @@ func (v *vaultBJJKeyProvider) ListByIdentity(_ context.Context, identity core.DI
 	}
 
 	reVaultKeyHex, err := regexp.Compile("^(?i)" +
-		regexp.QuoteMeta(string(v.keyType)) + ":([a-f0-9]{64})$")
+		regexp.QuoteMeta(string(v.keyType)) + ":([a-f0-9]{" + localPublicKeyHexLength(v.keyType) + "})$")
 	if err != nil {
 		return nil, err
 	}
@@ -192,6 +205,24 @@ func (v *vaultBJJKeyProvider) privateKey(keyID KeyID) ([]byte, error) {
 	return val, nil
 }
 
+// newLocalKey generates a key of the providers that sign locally. The public key is the hex of the compressed key.
+func newLocalKey(keyType KeyType) (pubKey string, privKey []byte, err error) {
+	if isJWSKeyType(keyType) {
+		pub, priv, err := newJWSKey(keyType)
+		return hex.EncodeToString(pub), priv, err
+	}
+	bjjPrivKey := babyjub.NewRandPrivKey()
+	return bjjPrivKey.Public().String(), bjjPrivKey[:], nil
+}
+
+// localPublicKeyHexLength is the length of the public keys in the key IDs of the providers that sign locally
+func localPublicKeyHexLength(keyType KeyType) string {
+	if isJWSKeyType(keyType) {
+		return strconv.Itoa(jwsPublicKeyHexLength(keyType))
+	}
+	return "64"
+}
+
 // DecodeBJJPubKey is a helper method to convert byte representation of public
 // key to *babyjub.PublicKey
 func DecodeBJJPubKey(key []byte) (*babyjub.PublicKey, error) {
@@ -0,0 +1,153 @@
+package leader
+
+import (
+	"context"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+const (
+	keyPrefix      = "issuer:leader:"
+	releaseTimeout = 5 * time.Second
+)
+
+// renewScript extends the lease if it is still held by the candidate
+var renewScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+// releaseScript deletes the lease if it is still held by the candidate
+var releaseScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// Elector campaigns for a lease in redis so only one of the replicas of a process runs its background workers.
+// The leader renews the lease every third of its ttl and stops its workers as soon as a renewal fails. When the
+// leader dies or loses redis, another replica takes the lease once it expires.
+type Elector struct {
+	sync.RWMutex
+	client *redis.Client
+	key    string
+	id     string
+	ttl    time.Duration
+	leader bool
+}
+
+// New returns an elector for the lease of the given name, shared by all the replicas of a process
+func New(client *redis.Client, name string, ttl time.Duration) *Elector {
+	id := uuid.NewString()
+	if host, err := os.Hostname(); err == nil {
+		id = host + "-" + id
+	}
+	return &Elector{client: client, key: keyPrefix + name, id: id, ttl: ttl}
+}
+
+// Leader tells whether the replica holds the lease
+func (e *Elector) Leader() bool {
+	e.RLock()
+	defer e.RUnlock()
+	return e.leader
+}
+
+// Run campaigns for the lease until ctx is done. Every time the replica becomes the leader it calls lead with a
+// context that is cancelled when the lease is lost, and waits for lead to return before campaigning again. The lease
+// is released then, so another replica takes over without waiting for it to expire.
+func (e *Elector) Run(ctx context.Context, lead func(ctx context.Context)) {
+	ticker := time.NewTicker(e.ttl / 3)
+	defer ticker.Stop()
+	for {
+		acquired, err := e.acquire(ctx)
+		if err != nil && ctx.Err() == nil {
+			log.Warn(ctx, "cannot acquire the leader lease", "err", err, "lease", e.key)
+		}
+		if acquired {
+			log.Info(ctx, "replica elected as leader", "lease", e.key, "id", e.id)
+			e.hold(ctx, ticker, lead)
+			e.release(ctx)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// hold runs lead while the lease is renewed and returns once lead has returned
+func (e *Elector) hold(ctx context.Context, ticker *time.Ticker, lead func(ctx context.Context)) {
+	leadCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		lead(leadCtx)
+	}()
+
+	e.setLeader(true)
+	defer e.setLeader(false)
+	for {
+		select {
+		case <-ticker.C:
+			if ctx.Err() != nil {
+				continue
+			}
+			renewed, err := e.renew(ctx)
+			if err != nil {
+				log.Warn(ctx, "cannot renew the leader lease", "err", err, "lease", e.key)
+			}
+			if !renewed {
+				log.Warn(ctx, "leader lease lost, stopping the workers", "lease", e.key)
+				cancel()
+				<-done
+				return
+			}
+		case <-done:
+			return
+		}
+	}
+}
+
+func (e *Elector) setLeader(leader bool) {
+	e.Lock()
+	e.leader = leader
+	e.Unlock()
+}
+
+func (e *Elector) acquire(ctx context.Context) (bool, error) {
+	return e.client.SetNX(ctx, e.key, e.id, e.ttl).Result()
+}
+
+func (e *Elector) renew(ctx context.Context) (bool, error) {
+	renewed, err := renewScript.Run(ctx, e.client, []string{e.key}, e.id, e.ttl.Milliseconds()).Int()
+	if err != nil {
+		return false, err
+	}
+	return renewed == 1, nil
+}
+
+// release deletes the lease with a context of its own, the one of the elector is usually done by then
+func (e *Elector) release(ctx context.Context) {
+	releaseCtx, cancel := context.WithTimeout(context.Background(), releaseTimeout)
+	defer cancel()
+	released, err := releaseScript.Run(releaseCtx, e.client, []string{e.key}, e.id).Int()
+	if err != nil {
+		log.Warn(ctx, "cannot release the leader lease", "err", err, "lease", e.key)
+		return
+	}
+	if released == 1 {
+		log.Info(ctx, "leader lease released", "lease", e.key)
+	}
+}
@@ -0,0 +1,86 @@
+package leader
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/redis"
+)
+
+func TestElector(t *testing.T) {
+	s := miniredis.RunT(t)
+	client, err := redis.Open("redis://" + s.Addr())
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, client.Close()) }()
+
+	const ttl = 30 * time.Millisecond
+	type replica struct {
+		elector *Elector
+		leading chan bool
+		cancel  context.CancelFunc
+		stopped chan struct{}
+	}
+	start := func() *replica {
+		ctx, cancel := context.WithCancel(context.Background())
+		r := &replica{elector: New(client, "workers", ttl), leading: make(chan bool, 10), cancel: cancel, stopped: make(chan struct{})}
+		go func() {
+			defer close(r.stopped)
+			r.elector.Run(ctx, func(ctx context.Context) {
+				r.leading <- true
+				<-ctx.Done()
+				r.leading <- false
+			})
+		}()
+		return r
+	}
+	expect := func(r *replica, leading bool) {
+		t.Helper()
+		select {
+		case got := <-r.leading:
+			assert.Equal(t, leading, got)
+		case <-time.After(time.Second):
+			t.Fatalf("the replica did not change its leadership to %t", leading)
+		}
+	}
+
+	first := start()
+	expect(first, true)
+	assert.True(t, first.elector.Leader())
+
+	second := start()
+	time.Sleep(3 * ttl)
+	assert.False(t, second.elector.Leader(), "a single replica leads")
+	assert.Empty(t, second.leading)
+
+	// the lease is taken by somebody else, the leader stops its workers
+	s.Set(keyPrefix+"workers", "other")
+	expect(first, false)
+	s.Del(keyPrefix + "workers")
+
+	var leader, follower *replica
+	select {
+	case <-first.leading:
+		leader, follower = first, second
+	case <-second.leading:
+		leader, follower = second, first
+	case <-time.After(time.Second):
+		t.Fatal("no replica took the free lease")
+	}
+
+	// the leader stops and releases the lease, the other replica takes over
+	leader.cancel()
+	expect(leader, false)
+	<-leader.stopped
+	expect(follower, true)
+	assert.True(t, follower.elector.Leader())
+
+	follower.cancel()
+	expect(follower, false)
+	<-follower.stopped
+	assert.False(t, s.Exists(keyPrefix+"workers"), "the lease is released on the way out")
+}
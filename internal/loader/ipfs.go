@@ -0,0 +1,19 @@
+package loader
+
+import (
+	"strings"
+)
+
+const ipfsScheme = "ipfs://"
+
+// IPFSFactory returns a function factory that rewrites ipfs:// urls to the url of the document in the given IPFS http
+// gateway. The loaders are created by the next factory.
+func IPFSFactory(gatewayURL string, next Factory) Factory {
+	gatewayURL = strings.TrimSuffix(gatewayURL, "/")
+	return func(url string) Loader {
+		if strings.HasPrefix(url, ipfsScheme) {
+			url = gatewayURL + "/ipfs/" + strings.TrimPrefix(url, ipfsScheme)
+		}
+		return next(url)
+	}
+}
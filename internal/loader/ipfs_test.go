@@ -0,0 +1,39 @@
+package loader
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIPFSFactory(t *testing.T) {
+	ctx := context.Background()
+	gateway := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/ipfs/QmSchemaCID/schema.json" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"$schema":"http://json-schema.org/draft-07/schema#"}`))
+	}))
+	defer gateway.Close()
+
+	var loaded []string
+	factory := IPFSFactory(gateway.URL+"/", func(url string) Loader {
+		loaded = append(loaded, url)
+		return HTTPFactory(url)
+	})
+
+	schema, _, err := factory("ipfs://QmSchemaCID/schema.json").Load(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, `{"$schema":"http://json-schema.org/draft-07/schema#"}`, string(schema))
+
+	_, _, err = factory("ipfs://QmUnknownCID").Load(ctx)
+	assert.Error(t, err)
+
+	factory("https://example.com/schema.json")
+	assert.Equal(t, []string{gateway.URL + "/ipfs/QmSchemaCID/schema.json", gateway.URL + "/ipfs/QmUnknownCID", "https://example.com/schema.json"}, loaded)
+}
@@ -3,12 +3,18 @@ package log
 import (
 	"context"
 	"io"
+	"sync/atomic"
 
 	"golang.org/x/exp/slog"
+
+	"github.com/polygonid/sh-id-platform/internal/pii"
 )
 
 type contextKey struct{}
 
+// sensitiveFields holds the pii.Fields masked in every log entry
+var sensitiveFields atomic.Value
+
 // Log configuration constants
 const (
 	LevelDebug = int(slog.LevelDebug) // debug level
@@ -26,8 +32,9 @@ func NewContext(ctx context.Context, level, format int, w io.Writer) context.Con
 	l.Set(slog.Level(level))
 
 	opts := slog.HandlerOptions{
-		AddSource: false,
-		Level:     &l,
+		AddSource:   false,
+		Level:       &l,
+		ReplaceAttr: maskAttr,
 	}
 	if format == OutputJSON {
 		return newContext(ctx, slog.New(opts.NewJSONHandler(w)))
@@ -35,6 +42,12 @@ func NewContext(ctx context.Context, level, format int, w io.Writer) context.Con
 	return newContext(ctx, slog.New(opts.NewTextHandler(w)))
 }
 
+// SetSensitiveFields sets the credential subject fields that hold personal data. Attributes with those names and
+// the fields with those names of maps and structs are masked in the logs of every logger.
+func SetSensitiveFields(fields []string) {
+	sensitiveFields.Store(pii.NewFields(fields))
+}
+
 // CopyFromContext is a helper function that extracts returns a new context from dest, adding
 // the log included in orig.
 func CopyFromContext(orig, dest context.Context) context.Context {
@@ -77,3 +90,29 @@ func fromContext(ctx context.Context) *slog.Logger {
 	}
 	return slog.Default()
 }
+
+func maskAttr(groups []string, a slog.Attr) slog.Attr {
+	fields, _ := sensitiveFields.Load().(pii.Fields)
+	if len(fields) == 0 {
+		return a
+	}
+	if len(groups) == 0 {
+		switch a.Key {
+		case slog.TimeKey, slog.LevelKey, slog.MessageKey, slog.SourceKey:
+			return a
+		}
+	}
+	if fields.Contains(a.Key) {
+		return slog.String(a.Key, pii.Mask)
+	}
+	if a.Value.Kind() != slog.KindAny {
+		return a
+	}
+	if _, ok := a.Value.Any().(error); ok {
+		return a
+	}
+	if masked, ok := fields.MaskValue(a.Value.Any()); ok {
+		return slog.Any(a.Key, masked)
+	}
+	return a
+}
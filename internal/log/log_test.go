@@ -0,0 +1,31 @@
+package log
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSensitiveFields(t *testing.T) {
+	SetSensitiveFields([]string{"birthday"})
+	defer SetSensitiveFields(nil)
+
+	var buf bytes.Buffer
+	ctx := NewContext(context.Background(), LevelDebug, OutputJSON, &buf)
+	Info(ctx, "creating claim",
+		"birthday", 19960424,
+		"credentialSubject", map[string]interface{}{"birthday": 19960424, "documentType": 2},
+		"err", errors.New("birthday is not valid"))
+
+	var entry map[string]interface{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+	assert.Equal(t, "creating claim", entry["msg"])
+	assert.Equal(t, "***", entry["birthday"])
+	assert.Equal(t, map[string]interface{}{"birthday": "***", "documentType": float64(2)}, entry["credentialSubject"])
+	assert.Equal(t, "birthday is not valid", entry["err"])
+}
@@ -0,0 +1,72 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/pkg/protocol"
+)
+
+// maxEnvelopeSize is the size of the largest body that is checked for an encrypted envelope. Larger bodies are let
+// through untouched.
+const maxEnvelopeSize = 1 << 20
+
+// DecryptEnvelopes returns a middleware that replaces the encrypted iden3comm envelopes sent in text/plain bodies, to
+// the agent and the callbacks, with the message they wrap. The handlers unpack and verify it as if it had been sent
+// without encryption, so a wrapped zkp-packed message still proves who its sender is. Envelopes that cannot be
+// decrypted are rejected with 400 Bad Request. If decrypter is nil the encrypted envelopes reach the handlers.
+func DecryptEnvelopes(ctx context.Context, decrypter *protocol.Decrypter) func(http.Handler) http.Handler {
+	if decrypter == nil {
+		return func(next http.Handler) http.Handler { return next }
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost || r.Body == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type")); err != nil || mediaType != "text/plain" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			body, err := io.ReadAll(io.LimitReader(r.Body, maxEnvelopeSize+1))
+			if err != nil {
+				log.Warn(ctx, "reading request body", "err", err, "path", r.URL.Path)
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"message":"cannot read the request body"}`))
+				return
+			}
+			if len(body) > maxEnvelopeSize || !protocol.IsEncrypted(body) {
+				r.Body = readCloser{Reader: io.MultiReader(bytes.NewReader(body), r.Body), Closer: r.Body}
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			payload, err := decrypter.Decrypt(body)
+			if err != nil {
+				log.Warn(ctx, "decrypting envelope", "err", err, "path", r.URL.Path)
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"message":"cannot decrypt the message"}`))
+				return
+			}
+			r.Body = readCloser{Reader: bytes.NewReader(payload), Closer: r.Body}
+			r.ContentLength = int64(len(payload))
+			r.Header.Set("Content-Length", strconv.Itoa(len(payload)))
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
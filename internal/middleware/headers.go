@@ -0,0 +1,45 @@
+// Package middleware contains the chi middlewares shared by the issuer node http servers.
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/cors"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+)
+
+// CORS returns a middleware that handles cross-origin requests with the given configuration.
+func CORS(cfg config.CORS) func(http.Handler) http.Handler {
+	return cors.Handler(cors.Options{
+		AllowedOrigins:   cfg.AllowedOrigins,
+		AllowedMethods:   cfg.AllowedMethods,
+		AllowedHeaders:   cfg.AllowedHeaders,
+		ExposedHeaders:   cfg.ExposedHeaders,
+		AllowCredentials: cfg.AllowCredentials,
+		MaxAge:           int(cfg.MaxAge.Seconds()),
+	})
+}
+
+// SecurityHeaders returns a middleware that adds the standard security headers to every response.
+// Strict-Transport-Security is only added when a max age is configured.
+func SecurityHeaders(cfg config.SecurityHeaders) func(http.Handler) http.Handler {
+	var hsts string
+	if seconds := int64(cfg.HSTSMaxAge.Seconds()); seconds > 0 {
+		hsts = fmt.Sprintf("max-age=%d", seconds)
+		if cfg.HSTSIncludeSubdomains {
+			hsts += "; includeSubDomains"
+		}
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("X-Content-Type-Options", "nosniff")
+			if hsts != "" {
+				w.Header().Set("Strict-Transport-Security", hsts)
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+)
+
+var okHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+})
+
+func TestCORS(t *testing.T) {
+	handler := CORS(config.CORS{
+		AllowedOrigins: []string{"https://ui.example.com"},
+		AllowedMethods: []string{http.MethodGet, http.MethodPost},
+		AllowedHeaders: []string{"Authorization", "Content-Type"},
+		MaxAge:         10 * time.Minute,
+	})(okHandler)
+
+	type expected struct {
+		allowOrigin string
+		maxAge      string
+	}
+	type testConfig struct {
+		name     string
+		origin   string
+		method   string
+		expected expected
+	}
+	for _, tc := range []testConfig{
+		{
+			name:     "allowed origin preflight",
+			origin:   "https://ui.example.com",
+			method:   http.MethodPost,
+			expected: expected{allowOrigin: "https://ui.example.com", maxAge: "600"},
+		},
+		{
+			name:     "not allowed origin preflight",
+			origin:   "https://evil.example.com",
+			method:   http.MethodPost,
+			expected: expected{},
+		},
+		{
+			name:     "not allowed method preflight",
+			origin:   "https://ui.example.com",
+			method:   http.MethodDelete,
+			expected: expected{},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodOptions, "/v1/identities", nil)
+			req.Header.Set("Origin", tc.origin)
+			req.Header.Set("Access-Control-Request-Method", tc.method)
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+			assert.Equal(t, tc.expected.allowOrigin, rr.Header().Get("Access-Control-Allow-Origin"))
+			assert.Equal(t, tc.expected.maxAge, rr.Header().Get("Access-Control-Max-Age"))
+		})
+	}
+}
+
+func TestSecurityHeaders(t *testing.T) {
+	serve := func(cfg config.SecurityHeaders) *httptest.ResponseRecorder {
+		rr := httptest.NewRecorder()
+		SecurityHeaders(cfg)(okHandler).ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/v1/identities", nil))
+		return rr
+	}
+
+	rr := serve(config.SecurityHeaders{})
+	assert.Equal(t, "nosniff", rr.Header().Get("X-Content-Type-Options"))
+	assert.Empty(t, rr.Header().Get("Strict-Transport-Security"))
+
+	rr = serve(config.SecurityHeaders{HSTSMaxAge: 365 * 24 * time.Hour})
+	assert.Equal(t, "max-age=31536000", rr.Header().Get("Strict-Transport-Security"))
+
+	rr = serve(config.SecurityHeaders{HSTSMaxAge: time.Hour, HSTSIncludeSubdomains: true})
+	assert.Equal(t, "max-age=3600; includeSubDomains", rr.Header().Get("Strict-Transport-Security"))
+}
@@ -0,0 +1,168 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"path"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+const (
+	// IdempotencyKeyHeader is the header with the idempotency key of a request
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader is set in the responses returned to the retries of a request
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+
+	maxIdempotencyKeyLength  = 255
+	maxIdempotentRequestSize = 1 << 20
+)
+
+// Idempotency returns a middleware that answers the retries of the POST requests to paths sent with the same
+// Idempotency-Key header with the response of the first one, instead of processing them again. The paths are
+// path.Match patterns, like /v1/*/claims. A retry that arrives while the first request is being processed gets a 409
+// and a key used with another request gets a 422. Responses with a 5xx status are not stored, so the request can be
+// retried, and neither are the ones of the handlers that panic. Only the requests with valid basic auth credentials are
+// tracked, and the keys of every user are apart. The rest are rejected by the handlers.
+func Idempotency(ctx context.Context, service ports.IdempotencyService, auth config.HTTPBasicAuth, paths ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get(IdempotencyKeyHeader)
+			if key == "" || r.Method != http.MethodPost || !matchPath(r.URL.Path, paths) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			user, ok := authenticatedUser(r, auth)
+			if !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if len(key) > maxIdempotencyKeyLength {
+				writeJSONMessage(w, http.StatusBadRequest, "the idempotency key is longer than 255 characters")
+				return
+			}
+
+			body, err := io.ReadAll(io.LimitReader(r.Body, maxIdempotentRequestSize+1))
+			if err != nil {
+				writeJSONMessage(w, http.StatusBadRequest, "cannot read the request")
+				return
+			}
+			if len(body) > maxIdempotentRequestSize {
+				writeJSONMessage(w, http.StatusRequestEntityTooLarge, "the request is too large")
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			request := &domain.IdempotencyKey{Principal: user, Key: key, RequestHash: requestHash(r, body)}
+			original, err := service.Reserve(ctx, request)
+			if err != nil {
+				log.Error(ctx, "reserving idempotency key", "err", err)
+				writeJSONMessage(w, http.StatusInternalServerError, "cannot check the idempotency key")
+				return
+			}
+			if original != nil {
+				replay(w, request, original)
+				return
+			}
+
+			// the key is released unless the response is saved, also when the handler panics
+			saved := false
+			defer func() {
+				if saved {
+					return
+				}
+				if err := service.Release(ctx, request); err != nil {
+					log.Error(ctx, "releasing idempotency key", "err", err)
+				}
+			}()
+
+			recorder := &responseRecorder{ResponseWriter: w}
+			next.ServeHTTP(recorder, r)
+
+			if recorder.status == 0 {
+				recorder.status = http.StatusOK
+			}
+			if recorder.status >= http.StatusInternalServerError {
+				return
+			}
+			// saved with the server context, the one of the request is gone when the client timed out, which is when
+			// the response is needed
+			request.Status = recorder.status
+			request.Header = w.Header().Clone()
+			request.Body = recorder.body.Bytes()
+			if err := service.SaveResponse(ctx, request); err != nil {
+				log.Error(ctx, "saving idempotent response", "err", err)
+				return
+			}
+			saved = true
+		})
+	}
+}
+
+func replay(w http.ResponseWriter, request *domain.IdempotencyKey, original *domain.IdempotencyKey) {
+	switch {
+	case original.RequestHash != request.RequestHash:
+		writeJSONMessage(w, http.StatusUnprocessableEntity, "the idempotency key was used with another request")
+	case !original.Completed():
+		writeJSONMessage(w, http.StatusConflict, "a request with the idempotency key is being processed")
+	default:
+		for k, v := range original.Header {
+			w.Header()[k] = v
+		}
+		w.Header().Set(IdempotentReplayedHeader, "true")
+		w.WriteHeader(original.Status)
+		_, _ = w.Write(original.Body)
+	}
+}
+
+// requestHash identifies the method, the url and the body of a request
+func requestHash(r *http.Request, body []byte) string {
+	h := sha256.New()
+	_, _ = io.WriteString(h, r.Method+" "+r.URL.RequestURI()+"\n")
+	_, _ = h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func matchPath(p string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, p); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func writeJSONMessage(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write([]byte(`{"message":"` + message + `"}`))
+}
+
+// responseRecorder copies the response written by a handler
+type responseRecorder struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (rr *responseRecorder) WriteHeader(status int) {
+	if rr.status == 0 {
+		rr.status = status
+	}
+	rr.ResponseWriter.WriteHeader(status)
+}
+
+func (rr *responseRecorder) Write(p []byte) (int, error) {
+	if rr.status == 0 {
+		rr.status = http.StatusOK
+	}
+	rr.body.Write(p)
+	return rr.ResponseWriter.Write(p)
+}
@@ -0,0 +1,118 @@
+package middleware
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+type memoryIdempotency struct {
+	sync.Mutex
+	keys map[string]domain.IdempotencyKey
+}
+
+func (m *memoryIdempotency) Reserve(_ context.Context, key *domain.IdempotencyKey) (*domain.IdempotencyKey, error) {
+	m.Lock()
+	defer m.Unlock()
+	if original, ok := m.keys[key.Principal+"/"+key.Key]; ok {
+		return &original, nil
+	}
+	m.keys[key.Principal+"/"+key.Key] = *key
+	return nil, nil
+}
+
+func (m *memoryIdempotency) SaveResponse(_ context.Context, key *domain.IdempotencyKey) error {
+	m.Lock()
+	defer m.Unlock()
+	m.keys[key.Principal+"/"+key.Key] = *key
+	return nil
+}
+
+func (m *memoryIdempotency) Release(_ context.Context, key *domain.IdempotencyKey) error {
+	m.Lock()
+	defer m.Unlock()
+	delete(m.keys, key.Principal+"/"+key.Key)
+	return nil
+}
+
+func TestIdempotency(t *testing.T) {
+	auth := config.HTTPBasicAuth{User: "user", Password: "password"}
+	service := &memoryIdempotency{keys: make(map[string]domain.IdempotencyKey)}
+	var calls int
+	status := http.StatusCreated
+	handler := Idempotency(context.Background(), service, auth, "/v1/*/claims", "/v1/credentials")(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(`{"id":"` + strings.Repeat("x", calls) + `","body":` + string(body) + `}`))
+	}))
+
+	serve := func(path, key, body string, authenticated bool) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+		if key != "" {
+			r.Header.Set(IdempotencyKeyHeader, key)
+		}
+		if authenticated {
+			r.SetBasicAuth(auth.User, auth.Password)
+		}
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, r)
+		return rr
+	}
+
+	first := serve("/v1/did/claims", "key-1", `{"a":1}`, true)
+	assert.Equal(t, http.StatusCreated, first.Code)
+	assert.Equal(t, 1, calls)
+
+	retry := serve("/v1/did/claims", "key-1", `{"a":1}`, true)
+	assert.Equal(t, http.StatusCreated, retry.Code)
+	assert.Equal(t, first.Body.String(), retry.Body.String(), "the retry gets the original response")
+	assert.Equal(t, "application/json", retry.Header().Get("Content-Type"))
+	assert.Equal(t, "true", retry.Header().Get(IdempotentReplayedHeader))
+	assert.Equal(t, 1, calls, "the retry is not processed")
+
+	assert.Equal(t, http.StatusUnprocessableEntity, serve("/v1/did/claims", "key-1", `{"a":2}`, true).Code, "a key used with another body")
+	assert.Equal(t, http.StatusUnprocessableEntity, serve("/v1/credentials", "key-1", `{"a":1}`, true).Code, "a key used with another path")
+
+	service.keys["user/in-progress"] = domain.IdempotencyKey{Principal: "user", Key: "in-progress", RequestHash: service.keys["user/key-1"].RequestHash}
+	assert.Equal(t, http.StatusConflict, serve("/v1/did/claims", "in-progress", `{"a":1}`, true).Code)
+
+	assert.Equal(t, http.StatusBadRequest, serve("/v1/did/claims", strings.Repeat("k", 256), `{"a":1}`, true).Code)
+
+	serve("/v1/did/claims", "", `{"a":1}`, true)
+	serve("/v1/did/claims", "key-1", `{"a":1}`, false)
+	serve("/v1/identities", "key-1", `{"a":1}`, true)
+	assert.Equal(t, 4, calls, "requests without key, credentials or to other paths are processed")
+
+	status = http.StatusInternalServerError
+	assert.Equal(t, http.StatusInternalServerError, serve("/v1/credentials", "key-2", `{}`, true).Code)
+	status = http.StatusCreated
+	assert.Equal(t, http.StatusCreated, serve("/v1/credentials", "key-2", `{}`, true).Code, "failed requests can be retried")
+	assert.Equal(t, 6, calls)
+}
+
+func TestIdempotency_Panic(t *testing.T) {
+	auth := config.HTTPBasicAuth{User: "user", Password: "password"}
+	service := &memoryIdempotency{keys: make(map[string]domain.IdempotencyKey)}
+	handler := Idempotency(context.Background(), service, auth, "/v1/credentials")(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic("handler failed")
+	}))
+
+	r := httptest.NewRequest(http.MethodPost, "/v1/credentials", strings.NewReader(`{}`))
+	r.Header.Set(IdempotencyKeyHeader, "key-1")
+	r.SetBasicAuth(auth.User, auth.Password)
+	assert.Panics(t, func() { handler.ServeHTTP(httptest.NewRecorder(), r) })
+	assert.Empty(t, service.keys, "the key of a request whose handler panics is released")
+}
@@ -0,0 +1,112 @@
+package middleware
+
+import (
+	"context"
+	"crypto/subtle"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/pkg/ratelimit"
+)
+
+// RateLimits returns the rate limits that apply to a request. It is called on every request, so the limits can
+// change at runtime.
+type RateLimits func(ctx context.Context) config.RateLimit
+
+// StaticRateLimits returns the configured rate limits
+func StaticRateLimits(cfg config.RateLimit) RateLimits {
+	return func(context.Context) config.RateLimit { return cfg }
+}
+
+// SettingsRateLimits returns the rate limits of the runtime settings. Whether the proxy headers are trusted can only
+// be configured.
+func SettingsRateLimits(settings ports.SettingsService, cfg config.RateLimit) RateLimits {
+	return func(ctx context.Context) config.RateLimit {
+		current := settings.Current(ctx).RateLimit
+		return config.RateLimit{
+			Enabled:           current.Enabled,
+			TrustProxyHeaders: cfg.TrustProxyHeaders,
+			Authenticated:     config.RateLimitBucket(current.Authenticated),
+			Anonymous:         config.RateLimitBucket(current.Anonymous),
+		}
+	}
+}
+
+// RateLimit returns a middleware that rejects with 429 Too Many Requests the requests that exceed the rate limits.
+// Requests with valid basic auth credentials are limited per user and the rest per client IP.
+// Requests to skipPaths are never limited. If the limiter fails the request is let through.
+func RateLimit(ctx context.Context, limiter ratelimit.Limiter, limits RateLimits, auth config.HTTPBasicAuth, skipPaths ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			cfg := limits(r.Context())
+			if !cfg.Enabled {
+				next.ServeHTTP(w, r)
+				return
+			}
+			for _, path := range skipPaths {
+				if r.URL.Path == path {
+					next.ServeHTTP(w, r)
+					return
+				}
+			}
+
+			authenticated := ratelimit.PerMinute(cfg.Authenticated.RequestsPerMinute, cfg.Authenticated.Burst)
+			anonymous := ratelimit.PerMinute(cfg.Anonymous.RequestsPerMinute, cfg.Anonymous.Burst)
+			key, limit := "ratelimit:ip:"+clientIP(r, cfg.TrustProxyHeaders), anonymous
+			if user, ok := authenticatedUser(r, auth); ok {
+				key, limit = "ratelimit:user:"+user, authenticated
+			}
+
+			allowed, wait, err := limiter.Allow(r.Context(), key, limit)
+			if err != nil {
+				log.Error(ctx, "rate limiter", "err", err, "key", key)
+				next.ServeHTTP(w, r)
+				return
+			}
+			if !allowed {
+				w.Header().Set("Retry-After", strconv.Itoa(int(math.Max(1, math.Ceil(wait.Seconds())))))
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusTooManyRequests)
+				_, _ = w.Write([]byte(`{"message":"too many requests"}`))
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func authenticatedUser(r *http.Request, auth config.HTTPBasicAuth) (string, bool) {
+	user, password, ok := r.BasicAuth()
+	if !ok || auth.User == "" {
+		return "", false
+	}
+	if subtle.ConstantTimeCompare([]byte(user), []byte(auth.User)) != 1 ||
+		subtle.ConstantTimeCompare([]byte(password), []byte(auth.Password)) != 1 {
+		return "", false
+	}
+	return user, true
+}
+
+func clientIP(r *http.Request, trustProxyHeaders bool) string {
+	if trustProxyHeaders {
+		if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
+			ip, _, _ := strings.Cut(forwarded, ",")
+			return strings.TrimSpace(ip)
+		}
+		if ip := r.Header.Get("X-Real-IP"); ip != "" {
+			return ip
+		}
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
@@ -0,0 +1,85 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/pkg/ratelimit"
+)
+
+func TestRateLimit(t *testing.T) {
+	cfg := config.RateLimit{
+		Enabled:       true,
+		Authenticated: config.RateLimitBucket{RequestsPerMinute: 60, Burst: 3},
+		Anonymous:     config.RateLimitBucket{RequestsPerMinute: 60, Burst: 1},
+	}
+	auth := config.HTTPBasicAuth{User: "user", Password: "password"}
+	handler := RateLimit(context.Background(), ratelimit.NewMemoryLimiter(), StaticRateLimits(cfg), auth, "/status")(okHandler)
+
+	type request struct {
+		path       string
+		remoteAddr string
+		user       string
+		password   string
+	}
+	serve := func(req request) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodGet, req.path, nil)
+		r.RemoteAddr = req.remoteAddr
+		if req.user != "" {
+			r.SetBasicAuth(req.user, req.password)
+		}
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, r)
+		return rr
+	}
+
+	anonymous := request{path: "/v1/agent", remoteAddr: "10.0.0.1:1234"}
+	assert.Equal(t, http.StatusOK, serve(anonymous).Code)
+	rr := serve(anonymous)
+	assert.Equal(t, http.StatusTooManyRequests, rr.Code)
+	assert.Equal(t, "1", rr.Header().Get("Retry-After"))
+
+	assert.Equal(t, http.StatusOK, serve(request{path: "/v1/agent", remoteAddr: "10.0.0.2:1234"}).Code, "buckets are per IP")
+	assert.Equal(t, http.StatusOK, serve(request{path: "/status", remoteAddr: "10.0.0.1:1234"}).Code, "skipped paths are not limited")
+
+	wrongPassword := request{path: "/v1/identities", remoteAddr: "10.0.0.1:1234", user: "user", password: "wrong"}
+	assert.Equal(t, http.StatusTooManyRequests, serve(wrongPassword).Code, "invalid credentials are anonymous")
+
+	authenticated := request{path: "/v1/identities", remoteAddr: "10.0.0.1:1234", user: "user", password: "password"}
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, http.StatusOK, serve(authenticated).Code)
+	}
+	assert.Equal(t, http.StatusTooManyRequests, serve(authenticated).Code)
+}
+
+func TestRateLimit_Disabled(t *testing.T) {
+	handler := RateLimit(context.Background(), ratelimit.NewMemoryLimiter(), StaticRateLimits(config.RateLimit{}), config.HTTPBasicAuth{})(okHandler)
+	for i := 0; i < 10; i++ {
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/v1/agent", nil))
+		assert.Equal(t, http.StatusOK, rr.Code)
+	}
+}
+
+func TestRateLimit_ChangedLimits(t *testing.T) {
+	cfg := config.RateLimit{}
+	limits := func(context.Context) config.RateLimit { return cfg }
+	handler := RateLimit(context.Background(), ratelimit.NewMemoryLimiter(), limits, config.HTTPBasicAuth{})(okHandler)
+	serve := func() int {
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/v1/agent", nil))
+		return rr.Code
+	}
+
+	assert.Equal(t, http.StatusOK, serve())
+	assert.Equal(t, http.StatusOK, serve())
+
+	cfg = config.RateLimit{Enabled: true, Anonymous: config.RateLimitBucket{RequestsPerMinute: 60, Burst: 1}}
+	assert.Equal(t, http.StatusOK, serve())
+	assert.Equal(t, http.StatusTooManyRequests, serve())
+}
@@ -0,0 +1,138 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+// maxRequestWait is the longest wait, in seconds, the timeout of a long poll is extended with
+const maxRequestWait = 300
+
+// Timeout returns a middleware that cancels the context of the requests that take longer than the timeout of their
+// route class and responds them with 504 Gateway Timeout:
+//   - Publish: the state publishing and retry requests.
+//   - Read: GET, HEAD and OPTIONS requests. The long polls, with a wait parameter in seconds, get the wait on top.
+//   - Issuance: the rest of requests.
+//
+// The response of the handler is buffered until it finishes, so the event streams and the skipPaths are not limited.
+func Timeout(ctx context.Context, cfg config.RequestTimeout, skipPaths ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			timeout := routeTimeout(r, cfg, skipPaths)
+			if timeout <= 0 {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			reqCtx, cancel := context.WithTimeout(r.Context(), timeout)
+			defer cancel()
+
+			tw := &timeoutWriter{header: make(http.Header)}
+			done := make(chan struct{})
+			panicked := make(chan any, 1)
+			go func() {
+				defer func() {
+					if p := recover(); p != nil {
+						panicked <- p
+					}
+				}()
+				next.ServeHTTP(tw, r.WithContext(reqCtx))
+				close(done)
+			}()
+
+			select {
+			case p := <-panicked:
+				panic(p)
+			case <-done:
+				tw.mu.Lock()
+				defer tw.mu.Unlock()
+				for k, v := range tw.header {
+					w.Header()[k] = v
+				}
+				if tw.code == 0 {
+					tw.code = http.StatusOK
+				}
+				w.WriteHeader(tw.code)
+				_, _ = w.Write(tw.buf.Bytes())
+			case <-reqCtx.Done():
+				tw.mu.Lock()
+				defer tw.mu.Unlock()
+				tw.timedOut = true
+				if !errors.Is(reqCtx.Err(), context.DeadlineExceeded) {
+					return // the client went away, there is no one to respond to
+				}
+				log.Warn(ctx, "request timeout", "method", r.Method, "path", r.URL.Path, "timeout", timeout)
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusGatewayTimeout)
+				_, _ = w.Write([]byte(`{"message":"request timeout","code":"REQUEST_TIMEOUT"}`))
+			}
+		})
+	}
+}
+
+func routeTimeout(r *http.Request, cfg config.RequestTimeout, skipPaths []string) time.Duration {
+	for _, path := range skipPaths {
+		if r.URL.Path == path {
+			return 0
+		}
+	}
+	switch {
+	case strings.HasSuffix(r.URL.Path, "/events"), strings.HasSuffix(r.URL.Path, "/stream"):
+		return 0
+	case strings.HasSuffix(r.URL.Path, "/state/publish"), strings.HasSuffix(r.URL.Path, "/state/retry"):
+		return cfg.Publish
+	case r.Method == http.MethodGet, r.Method == http.MethodHead, r.Method == http.MethodOptions:
+		if cfg.Read > 0 {
+			if wait, err := strconv.Atoi(r.URL.Query().Get("wait")); err == nil && wait > 0 {
+				if wait > maxRequestWait {
+					wait = maxRequestWait
+				}
+				return cfg.Read + time.Duration(wait)*time.Second
+			}
+		}
+		return cfg.Read
+	default:
+		return cfg.Issuance
+	}
+}
+
+// timeoutWriter buffers the response of a handler, dropping it once the request has timed out.
+type timeoutWriter struct {
+	mu       sync.Mutex
+	header   http.Header
+	buf      bytes.Buffer
+	code     int
+	timedOut bool
+}
+
+func (tw *timeoutWriter) Header() http.Header { return tw.header }
+
+func (tw *timeoutWriter) Write(p []byte) (int, error) {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	if tw.timedOut {
+		return 0, http.ErrHandlerTimeout
+	}
+	if tw.code == 0 {
+		tw.code = http.StatusOK
+	}
+	return tw.buf.Write(p)
+}
+
+func (tw *timeoutWriter) WriteHeader(code int) {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	if tw.timedOut || tw.code != 0 {
+		return
+	}
+	tw.code = code
+}
@@ -0,0 +1,95 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+)
+
+func TestTimeout(t *testing.T) {
+	cfg := config.RequestTimeout{Read: 20 * time.Millisecond, Issuance: 20 * time.Millisecond, Publish: 200 * time.Millisecond}
+	slowHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-time.After(100 * time.Millisecond):
+		}
+		w.Header().Set("X-Slow", "done")
+		w.WriteHeader(http.StatusCreated)
+	})
+	handler := Timeout(context.Background(), cfg, "/status")(slowHandler)
+
+	type expected struct {
+		code   int
+		header string
+	}
+	type testConfig struct {
+		name     string
+		method   string
+		path     string
+		expected expected
+	}
+	for _, tc := range []testConfig{
+		{
+			name:     "slow read",
+			method:   http.MethodGet,
+			path:     "/v1/credentials",
+			expected: expected{code: http.StatusGatewayTimeout},
+		},
+		{
+			name:     "slow issuance",
+			method:   http.MethodPost,
+			path:     "/v1/credentials",
+			expected: expected{code: http.StatusGatewayTimeout},
+		},
+		{
+			name:     "publish has its own timeout",
+			method:   http.MethodPost,
+			path:     "/v1/state/publish",
+			expected: expected{code: http.StatusCreated, header: "done"},
+		},
+		{
+			name:     "skipped path",
+			method:   http.MethodGet,
+			path:     "/status",
+			expected: expected{code: http.StatusCreated, header: "done"},
+		},
+		{
+			name:     "long polls get the wait on top",
+			method:   http.MethodGet,
+			path:     "/v1/credentials/links/123/qrcode?sessionID=456&wait=1",
+			expected: expected{code: http.StatusCreated, header: "done"},
+		},
+		{
+			name:     "event streams are not limited",
+			method:   http.MethodGet,
+			path:     "/v1/credentials/links/123/events",
+			expected: expected{code: http.StatusCreated, header: "done"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, httptest.NewRequest(tc.method, tc.path, nil))
+			assert.Equal(t, tc.expected.code, rr.Code)
+			assert.Equal(t, tc.expected.header, rr.Header().Get("X-Slow"))
+			if tc.expected.code == http.StatusGatewayTimeout {
+				assert.JSONEq(t, `{"message":"request timeout","code":"REQUEST_TIMEOUT"}`, rr.Body.String())
+			}
+		})
+	}
+}
+
+func TestTimeout_Panic(t *testing.T) {
+	handler := Timeout(context.Background(), config.RequestTimeout{Read: time.Second})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic("boom")
+	}))
+	assert.PanicsWithValue(t, "boom", func() {
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/v1/credentials", nil))
+	})
+}
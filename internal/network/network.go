@@ -0,0 +1,65 @@
+package network
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	core "github.com/iden3/go-iden3-core"
+)
+
+const (
+	// Privado is the privado blockchain
+	Privado core.Blockchain = "privado"
+	// Amoy is the polygon amoy test network
+	Amoy core.NetworkID = "amoy"
+	// Sepolia is the ethereum sepolia test network
+	Sepolia core.NetworkID = "sepolia"
+	// Test is the test network of the blockchains that only have main and test networks, like privado
+	Test core.NetworkID = "test"
+)
+
+// didNetworks are the blockchains and networks supported by the node on top of the ones known by go-iden3-core.
+// The values are the same ones used by the iden3 libraries, so the identifiers are compatible with other
+// implementations.
+var didNetworks = map[core.DIDNetworkFlag]byte{
+	{Blockchain: core.Polygon, NetworkID: Amoy}:     0b00010000 | 0b00000011,
+	{Blockchain: core.Ethereum, NetworkID: Sepolia}: 0b00100000 | 0b00000011,
+	{Blockchain: Privado, NetworkID: core.Main}:     0b10100000 | 0b00000001,
+	{Blockchain: Privado, NetworkID: Test}:          0b10100000 | 0b00000010,
+}
+
+var registerOnce sync.Once
+
+// RegisterDIDNetworks adds the networks of the node to the did:iden3 and did:polygonid methods, so identities can be
+// created and parsed in any of them. It is safe to call it more than once.
+func RegisterDIDNetworks() {
+	registerOnce.Do(func() {
+		for _, method := range []core.DIDMethod{core.DIDMethodIden3, core.DIDMethodPolygonID} {
+			for flag, value := range didNetworks {
+				if _, ok := core.DIDMethodNetwork[method][flag]; !ok {
+					core.DIDMethodNetwork[method][flag] = value
+				}
+			}
+		}
+	})
+}
+
+// Key returns the key of the network of the given DID, in the blockchain:network format
+func Key(did core.DID) string {
+	return NewKey(did.Blockchain, did.NetworkID)
+}
+
+// NewKey returns the key of a network, in the blockchain:network format
+func NewKey(blockchain core.Blockchain, networkID core.NetworkID) string {
+	return fmt.Sprintf("%s:%s", blockchain, networkID)
+}
+
+// ParseKey returns the blockchain and network of a key in the blockchain:network format
+func ParseKey(key string) (core.Blockchain, core.NetworkID, error) {
+	blockchain, networkID, found := strings.Cut(key, ":")
+	if !found || blockchain == "" || networkID == "" || strings.Contains(networkID, ":") {
+		return "", "", fmt.Errorf("invalid network %q, expected blockchain:network", key)
+	}
+	return core.Blockchain(blockchain), core.NetworkID(networkID), nil
+}
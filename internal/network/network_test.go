@@ -0,0 +1,246 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	core "github.com/iden3/go-iden3-core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/pkg/blockchain/eth"
+)
+
+func TestRegisterDIDNetworks(t *testing.T) {
+	RegisterDIDNetworks()
+	for _, tc := range []struct {
+		method     core.DIDMethod
+		blockchain core.Blockchain
+		network    core.NetworkID
+	}{
+		{core.DIDMethodPolygonID, core.Polygon, core.Mumbai},
+		{core.DIDMethodPolygonID, core.Polygon, Amoy},
+		{core.DIDMethodPolygonID, Privado, core.Main},
+		{core.DIDMethodIden3, core.Polygon, Amoy},
+		{core.DIDMethodIden3, core.Ethereum, Sepolia},
+		{core.DIDMethodIden3, Privado, Test},
+	} {
+		t.Run(string(tc.method)+":"+NewKey(tc.blockchain, tc.network), func(t *testing.T) {
+			typ, err := core.BuildDIDType(tc.method, tc.blockchain, tc.network)
+			require.NoError(t, err)
+			id, err := core.IdGenesisFromIdenState(typ, core.ElemBytes{}.ToInt())
+			require.NoError(t, err)
+			did, err := core.ParseDIDFromID(*id)
+			require.NoError(t, err)
+			parsed, err := core.ParseDID(did.String())
+			require.NoError(t, err)
+			assert.Equal(t, NewKey(tc.blockchain, tc.network), Key(*parsed))
+			assert.Equal(t, tc.method, parsed.Method)
+		})
+	}
+
+	_, err := core.BuildDIDType(core.DIDMethodPolygonID, core.Polygon, "unknown")
+	assert.Error(t, err)
+}
+
+func TestParseKey(t *testing.T) {
+	blockchain, networkID, err := ParseKey("polygon:amoy")
+	require.NoError(t, err)
+	assert.Equal(t, core.Polygon, blockchain)
+	assert.Equal(t, Amoy, networkID)
+
+	for _, key := range []string{"", "polygon", "polygon:", ":amoy", "polygon:amoy:main"} {
+		_, _, err := ParseKey(key)
+		assert.Error(t, err, key)
+	}
+}
+
+func TestParseNetworks(t *testing.T) {
+	RegisterDIDNetworks()
+	defaults := config.Ethereum{
+		URL:                 "http://mumbai",
+		ContractAddress:     "0x134B1BE34911E39A8397ec6289782989729807a4",
+		DefaultGasLimit:     600000,
+		ConfirmationTimeout: 10 * time.Minute,
+		ResolverPrefix:      "polygon:mumbai",
+	}
+
+	networks, err := parseNetworks([]byte(`
+polygon:
+  amoy:
+    url: http://amoy
+    contractAddress: "0x1a4cC30f2aA0377b0c3bc9848766D90cb4404124"
+    confirmationTimeout: 30s
+privado:
+  main:
+    url: http://privado
+    contractAddress: "0x975556428F077dB5877Ea2474D783D6C69233742"
+`), defaults)
+	require.NoError(t, err)
+	require.Len(t, networks, 3)
+	assert.Equal(t, defaults, networks["polygon:mumbai"])
+
+	amoy := networks["polygon:amoy"]
+	assert.Equal(t, "http://amoy", amoy.URL)
+	assert.Equal(t, "0x1a4cC30f2aA0377b0c3bc9848766D90cb4404124", amoy.ContractAddress)
+	assert.Equal(t, 30*time.Second, amoy.ConfirmationTimeout)
+	assert.Equal(t, 600000, amoy.DefaultGasLimit)
+	assert.Equal(t, "polygon:amoy", amoy.ResolverPrefix)
+	assert.Equal(t, "http://privado", networks["privado:main"].URL)
+
+	_, err = parseNetworks([]byte("polygon:\n  unknown:\n    url: http://x\n    contractAddress: \"0x01\"\n"), defaults)
+	assert.ErrorIs(t, err, ErrNetworkNotSupported)
+
+	_, err = parseNetworks([]byte("polygon:\n  amoy:\n    url: http://amoy\n"), defaults)
+	assert.Error(t, err)
+}
+
+func TestParseNetworksGasStrategy(t *testing.T) {
+	RegisterDIDNetworks()
+	defaults := config.Ethereum{
+		URL:                  "http://mumbai",
+		ContractAddress:      "0x134B1BE34911E39A8397ec6289782989729807a4",
+		ResolverPrefix:       "polygon:mumbai",
+		GasStrategy:          "fixed",
+		MaxPriorityFeePerGas: 30000000000,
+		MaxFeePerGas:         100000000000,
+	}
+
+	networks, err := parseNetworks([]byte(`
+polygon:
+  amoy:
+    url: http://amoy
+    contractAddress: "0x1a4cC30f2aA0377b0c3bc9848766D90cb4404124"
+    gasStrategy: percentile
+    gasPercentile: 60
+privado:
+  main:
+    url: http://privado
+    contractAddress: "0x975556428F077dB5877Ea2474D783D6C69233742"
+`), defaults)
+	require.NoError(t, err)
+
+	amoy, err := newClientConfig(networks["polygon:amoy"])
+	require.NoError(t, err)
+	assert.Equal(t, eth.GasStrategyPercentile, amoy.GasStrategy)
+	assert.Equal(t, float64(60), amoy.FeeHistoryPercentile)
+	assert.Zero(t, amoy.MaxFeePerGas.Int64(), "the fees of the fixed default strategy are not inherited")
+
+	privado, err := newClientConfig(networks["privado:main"])
+	require.NoError(t, err)
+	assert.Equal(t, eth.GasStrategyFixed, privado.GasStrategy)
+	assert.Equal(t, int64(100000000000), privado.MaxFeePerGas.Int64())
+
+	_, err = newClientConfig(config.Ethereum{GasStrategy: "fixed", MaxPriorityFeePerGas: 30000000000})
+	assert.Error(t, err)
+	_, err = newClientConfig(config.Ethereum{GasStrategy: "legacy"})
+	assert.Error(t, err)
+	_, err = newClientConfig(config.Ethereum{GasStrategy: "percentile", GasPercentile: 150})
+	assert.Error(t, err)
+}
+
+func TestParseNetworksChainID(t *testing.T) {
+	RegisterDIDNetworks()
+	defaults := config.Ethereum{
+		URL:             "http://mumbai",
+		ContractAddress: "0x134B1BE34911E39A8397ec6289782989729807a4",
+		ChainID:         80001,
+		ResolverPrefix:  "polygon:mumbai",
+	}
+
+	networks, err := parseNetworks([]byte(`
+polygon:
+  amoy:
+    url: http://amoy
+    contractAddress: "0x1a4cC30f2aA0377b0c3bc9848766D90cb4404124"
+    chainID: 80002
+privado:
+  main:
+    url: http://privado
+    contractAddress: "0x975556428F077dB5877Ea2474D783D6C69233742"
+`), defaults)
+	require.NoError(t, err)
+
+	mumbai, err := newClientConfig(networks["polygon:mumbai"])
+	require.NoError(t, err)
+	assert.Equal(t, int64(80001), mumbai.ChainID.Int64())
+
+	amoy, err := newClientConfig(networks["polygon:amoy"])
+	require.NoError(t, err)
+	assert.Equal(t, int64(80002), amoy.ChainID.Int64())
+
+	privado, err := newClientConfig(networks["privado:main"])
+	require.NoError(t, err)
+	assert.Nil(t, privado.ChainID, "the chain id of the default network is not inherited")
+}
+
+// fakeRPC answers the chain id and the calls to the state contract of a JSON-RPC node
+type fakeRPC struct {
+	mu       sync.Mutex
+	chainID  int64
+	contract bool
+}
+
+func (f *fakeRPC) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		ID     json.RawMessage `json:"id"`
+		Method string          `json:"method"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var result string
+	switch req.Method {
+	case "eth_chainId":
+		result = fmt.Sprintf("0x%x", f.chainID)
+	case "eth_call", "eth_getCode":
+		result = "0x"
+		if f.contract {
+			result = "0x" + strings.Repeat("0", 63) + "1"
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": req.ID, "result": result})
+}
+
+func TestResolver_Check(t *testing.T) {
+	ctx := context.Background()
+	rpc := &fakeRPC{chainID: 80001, contract: true}
+	server := httptest.NewServer(rpc)
+	defer server.Close()
+
+	resolver, err := NewResolver(ctx, &config.Configuration{Ethereum: config.Ethereum{
+		URL:                server.URL,
+		ContractAddress:    "0x134B1BE34911E39A8397ec6289782989729807a4",
+		ChainID:            80001,
+		ResolverPrefix:     "polygon:mumbai",
+		RPCResponseTimeout: time.Second,
+	}})
+	require.NoError(t, err)
+	require.NoError(t, resolver.Check(ctx, "polygon:mumbai"))
+	assert.ErrorIs(t, resolver.Check(ctx, "polygon:amoy"), ErrNetworkNotSupported)
+
+	rpc.mu.Lock()
+	rpc.contract = false
+	rpc.mu.Unlock()
+	assert.ErrorContains(t, resolver.Check(ctx, "polygon:mumbai"), "there is no state contract")
+
+	rpc.mu.Lock()
+	rpc.chainID = 1
+	rpc.mu.Unlock()
+	assert.ErrorContains(t, resolver.Check(ctx, "polygon:mumbai"), "the rpc serves chain 1 instead of 80001")
+
+	server.Close()
+	assert.ErrorContains(t, resolver.Check(ctx, "polygon:mumbai"), "the rpc does not answer")
+}
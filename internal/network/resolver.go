@@ -0,0 +1,343 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/iden3/contracts-abi/state/go/abi"
+	"github.com/iden3/go-iden3-auth/pubsignals"
+	"github.com/iden3/go-iden3-auth/state"
+	core "github.com/iden3/go-iden3-core"
+	"gopkg.in/yaml.v3"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/pkg/blockchain/eth"
+)
+
+// ErrNetworkNotSupported is returned when the node has no chain configuration for a network
+var ErrNetworkNotSupported = errors.New("network not supported by the node")
+
+// settings are the values of a network in the networks file. Empty values are taken from the default network.
+type settings struct {
+	URL                    string        `yaml:"url"`
+	ContractAddress        string        `yaml:"contractAddress"`
+	ChainID                int64         `yaml:"chainID"`
+	DefaultGasLimit        int           `yaml:"defaultGasLimit"`
+	ConfirmationTimeout    time.Duration `yaml:"confirmationTimeout"`
+	ConfirmationBlockCount int64         `yaml:"confirmationBlockCount"`
+	ReceiptTimeout         time.Duration `yaml:"receiptTimeout"`
+	MinGasPrice            int           `yaml:"minGasPrice"`
+	MaxGasPrice            int           `yaml:"maxGasPrice"`
+	RPCResponseTimeout     time.Duration `yaml:"rpcResponseTimeout"`
+	WaitReceiptCycleTime   time.Duration `yaml:"waitReceiptCycleTime"`
+	WaitBlockCycleTime     time.Duration `yaml:"waitBlockCycleTime"`
+	GasStrategy            string        `yaml:"gasStrategy"`
+	MaxPriorityFeePerGas   int           `yaml:"maxPriorityFeePerGas"`
+	MaxFeePerGas           int           `yaml:"maxFeePerGas"`
+	GasPercentileBlocks    int           `yaml:"gasPercentileBlocks"`
+	GasPercentile          float64       `yaml:"gasPercentile"`
+	RelayerType            string        `yaml:"relayerType"`
+	RelayerURL             string        `yaml:"relayerURL"`
+	RelayerAPIKey          string        `yaml:"relayerAPIKey"`
+	RelayerForwarder       string        `yaml:"relayerForwarder"`
+}
+
+// Resolver has the chain configuration and clients of every network supported by the node, so every identity
+// is published to and resolved from the chain of its own DID.
+type Resolver struct {
+	settings map[string]config.Ethereum
+	clients  map[string]*eth.Client
+	states   map[string]*abi.State
+}
+
+// NewResolver returns a resolver with the default network configured in the Ethereum settings and the networks
+// defined in the networks file, if any.
+func NewResolver(ctx context.Context, cfg *config.Configuration) (*Resolver, error) {
+	RegisterDIDNetworks()
+
+	networks := map[string]config.Ethereum{cfg.Ethereum.ResolverPrefix: cfg.Ethereum}
+	if cfg.NetworksFile != "" {
+		content, err := os.ReadFile(cfg.NetworksFile)
+		if err != nil {
+			return nil, fmt.Errorf("reading networks file: %w", err)
+		}
+		if networks, err = parseNetworks(content, cfg.Ethereum); err != nil {
+			return nil, err
+		}
+	}
+
+	r := &Resolver{
+		settings: networks,
+		clients:  make(map[string]*eth.Client, len(networks)),
+		states:   make(map[string]*abi.State, len(networks)),
+	}
+	for key, s := range networks {
+		clientConfig, err := newClientConfig(s)
+		if err != nil {
+			return nil, fmt.Errorf("network %s: %w", key, err)
+		}
+		ethClient, err := ethclient.Dial(s.URL)
+		if err != nil {
+			return nil, fmt.Errorf("dialing %s: %w", key, err)
+		}
+		r.clients[key] = eth.NewClient(ethClient, clientConfig)
+		if err := checkChainID(ctx, ethClient, clientConfig); err != nil {
+			return nil, fmt.Errorf("network %s: %w", key, err)
+		}
+		if r.states[key], err = abi.NewState(common.HexToAddress(s.ContractAddress), ethClient); err != nil {
+			return nil, fmt.Errorf("creating state contract client for %s: %w", key, err)
+		}
+		log.Info(ctx, "network configured", "network", key, "contract", s.ContractAddress, "chainID", s.ChainID, "gasStrategy", clientConfig.GasStrategy)
+	}
+
+	return r, nil
+}
+
+// newClientConfig returns the eth client configuration of a network. The fixed gas strategy needs both fees.
+func newClientConfig(s config.Ethereum) (*eth.ClientConfig, error) {
+	strategy, err := eth.ParseGasStrategy(s.GasStrategy)
+	if err != nil {
+		return nil, err
+	}
+	if strategy == eth.GasStrategyFixed && (s.MaxPriorityFeePerGas <= 0 || s.MaxFeePerGas < s.MaxPriorityFeePerGas) {
+		return nil, errors.New("the fixed gas strategy needs a max priority fee per gas and a greater max fee per gas")
+	}
+	if s.GasPercentile < 0 || s.GasPercentile > 100 {
+		return nil, fmt.Errorf("gas percentile %v is not between 0 and 100", s.GasPercentile)
+	}
+	var chainID *big.Int
+	if s.ChainID != 0 {
+		chainID = big.NewInt(s.ChainID)
+	}
+	return &eth.ClientConfig{
+		ChainID:                chainID,
+		DefaultGasLimit:        s.DefaultGasLimit,
+		ConfirmationTimeout:    s.ConfirmationTimeout,
+		ConfirmationBlockCount: s.ConfirmationBlockCount,
+		ReceiptTimeout:         s.ReceiptTimeout,
+		MinGasPrice:            big.NewInt(int64(s.MinGasPrice)),
+		MaxGasPrice:            big.NewInt(int64(s.MaxGasPrice)),
+		RPCResponseTimeout:     s.RPCResponseTimeout,
+		WaitReceiptCycleTime:   s.WaitReceiptCycleTime,
+		WaitBlockCycleTime:     s.WaitBlockCycleTime,
+		GasStrategy:            strategy,
+		MaxPriorityFeePerGas:   big.NewInt(int64(s.MaxPriorityFeePerGas)),
+		MaxFeePerGas:           big.NewInt(int64(s.MaxFeePerGas)),
+		FeeHistoryBlocks:       s.GasPercentileBlocks,
+		FeeHistoryPercentile:   s.GasPercentile,
+	}, nil
+}
+
+// checkChainID fails if the RPC of a network with a configured chain id serves another chain, so the states of the
+// identities are never published to the wrong chain. A node that does not answer is only logged, as the transactions
+// are signed for the configured chain and a node of another chain rejects them.
+func checkChainID(ctx context.Context, client *ethclient.Client, cfg *eth.ClientConfig) error {
+	if cfg.ChainID == nil {
+		return nil
+	}
+	_ctx, cancel := context.WithTimeout(ctx, cfg.RPCResponseTimeout)
+	defer cancel()
+	served, err := client.ChainID(_ctx)
+	if err != nil {
+		log.Warn(ctx, "cannot check the chain id of the network", "err", err, "chainID", cfg.ChainID)
+		return nil
+	}
+	if served.Cmp(cfg.ChainID) != 0 {
+		return fmt.Errorf("the rpc serves chain %s instead of %s", served, cfg.ChainID)
+	}
+	return nil
+}
+
+// Check fails if the RPC of a network does not answer, serves another chain than the configured one or has no state
+// contract at the configured address. Unlike NewResolver, it also fails if the RPC does not answer.
+func (r *Resolver) Check(ctx context.Context, key string) error {
+	cl, err := r.Client(key)
+	if err != nil {
+		return err
+	}
+	var served *big.Int
+	err = cl.Call(func(client *ethclient.Client) error {
+		_ctx, cancel := context.WithTimeout(ctx, cl.Config.RPCResponseTimeout)
+		defer cancel()
+		served, err = client.ChainID(_ctx)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("the rpc does not answer: %w", err)
+	}
+	if cl.Config.ChainID != nil && served.Cmp(cl.Config.ChainID) != 0 {
+		return fmt.Errorf("the rpc serves chain %s instead of %s", served, cl.Config.ChainID)
+	}
+
+	address, err := r.ContractAddress(key)
+	if err != nil {
+		return err
+	}
+	_ctx, cancel := context.WithTimeout(ctx, cl.Config.RPCResponseTimeout)
+	defer cancel()
+	if _, err := r.states[key].GetGISTRoot(&bind.CallOpts{Context: _ctx}); err != nil {
+		return fmt.Errorf("there is no state contract at %s in chain %s: %w", address, served, err)
+	}
+	return nil
+}
+
+// parseNetworks reads a networks file with the blockchain -> network -> settings structure. The default network
+// keeps its configuration unless the file overrides it.
+func parseNetworks(content []byte, defaults config.Ethereum) (map[string]config.Ethereum, error) {
+	var file map[string]map[string]settings
+	if err := yaml.Unmarshal(content, &file); err != nil {
+		return nil, fmt.Errorf("parsing networks file: %w", err)
+	}
+
+	networks := map[string]config.Ethereum{defaults.ResolverPrefix: defaults}
+	for blockchain, chainNetworks := range file {
+		for networkID, s := range chainNetworks {
+			key := NewKey(core.Blockchain(blockchain), core.NetworkID(networkID))
+			if !supported(core.Blockchain(blockchain), core.NetworkID(networkID)) {
+				return nil, fmt.Errorf("%w: %s", ErrNetworkNotSupported, key)
+			}
+			if s.URL == "" || s.ContractAddress == "" {
+				return nil, fmt.Errorf("network %s needs an url and a contract address", key)
+			}
+			networks[key] = s.merge(defaults, key)
+		}
+	}
+	return networks, nil
+}
+
+// supported returns true if any DID method can create identities in the network
+func supported(blockchain core.Blockchain, networkID core.NetworkID) bool {
+	for _, networks := range core.DIDMethodNetwork {
+		if _, ok := networks[core.DIDNetworkFlag{Blockchain: blockchain, NetworkID: networkID}]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (s settings) merge(defaults config.Ethereum, key string) config.Ethereum {
+	merged := defaults
+	merged.URL = s.URL
+	merged.ContractAddress = s.ContractAddress
+	// the chain id and the relayer of the default network belong to its own chain
+	merged.ChainID = s.ChainID
+	merged.RelayerType = s.RelayerType
+	merged.RelayerURL = s.RelayerURL
+	merged.RelayerAPIKey = s.RelayerAPIKey
+	merged.RelayerForwarder = s.RelayerForwarder
+	merged.ResolverPrefix = key
+	if s.DefaultGasLimit != 0 {
+		merged.DefaultGasLimit = s.DefaultGasLimit
+	}
+	if s.ConfirmationTimeout != 0 {
+		merged.ConfirmationTimeout = s.ConfirmationTimeout
+	}
+	if s.ConfirmationBlockCount != 0 {
+		merged.ConfirmationBlockCount = s.ConfirmationBlockCount
+	}
+	if s.ReceiptTimeout != 0 {
+		merged.ReceiptTimeout = s.ReceiptTimeout
+	}
+	if s.MinGasPrice != 0 {
+		merged.MinGasPrice = s.MinGasPrice
+	}
+	if s.MaxGasPrice != 0 {
+		merged.MaxGasPrice = s.MaxGasPrice
+	}
+	if s.RPCResponseTimeout != 0 {
+		merged.RPCResponseTimeout = s.RPCResponseTimeout
+	}
+	if s.WaitReceiptCycleTime != 0 {
+		merged.WaitReceiptCycleTime = s.WaitReceiptCycleTime
+	}
+	if s.WaitBlockCycleTime != 0 {
+		merged.WaitBlockCycleTime = s.WaitBlockCycleTime
+	}
+	if s.GasStrategy != "" {
+		// the fees of the default network belong to its own strategy
+		merged.GasStrategy = s.GasStrategy
+		merged.MaxPriorityFeePerGas = s.MaxPriorityFeePerGas
+		merged.MaxFeePerGas = s.MaxFeePerGas
+		merged.GasPercentileBlocks = s.GasPercentileBlocks
+		merged.GasPercentile = s.GasPercentile
+	}
+	return merged
+}
+
+// Networks returns the keys of the configured networks, sorted
+func (r *Resolver) Networks() []string {
+	keys := make([]string, 0, len(r.settings))
+	for key := range r.settings {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Supported returns true if the network is configured
+func (r *Resolver) Supported(blockchain core.Blockchain, networkID core.NetworkID) bool {
+	_, ok := r.settings[NewKey(blockchain, networkID)]
+	return ok
+}
+
+// Settings returns the chain configuration of a network
+func (r *Resolver) Settings(key string) (config.Ethereum, error) {
+	s, ok := r.settings[key]
+	if !ok {
+		return config.Ethereum{}, fmt.Errorf("%w: %s", ErrNetworkNotSupported, key)
+	}
+	return s, nil
+}
+
+// Client returns the eth client of a network
+func (r *Resolver) Client(key string) (*eth.Client, error) {
+	cl, ok := r.clients[key]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrNetworkNotSupported, key)
+	}
+	return cl, nil
+}
+
+// StateContract returns the state contract of a network
+func (r *Resolver) StateContract(key string) (*abi.State, error) {
+	st, ok := r.states[key]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrNetworkNotSupported, key)
+	}
+	return st, nil
+}
+
+// StateContracts returns the state contracts of every network
+func (r *Resolver) StateContracts() map[string]*abi.State {
+	return r.states
+}
+
+// ContractAddress returns the state contract address of a network
+func (r *Resolver) ContractAddress(key string) (common.Address, error) {
+	s, err := r.Settings(key)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return common.HexToAddress(s.ContractAddress), nil
+}
+
+// StateResolvers returns the state resolvers used to verify the auth responses of every network
+func (r *Resolver) StateResolvers() map[string]pubsignals.StateResolver {
+	resolvers := make(map[string]pubsignals.StateResolver, len(r.settings))
+	for key, s := range r.settings {
+		resolvers[key] = state.ETHResolver{
+			RPCUrl:          s.URL,
+			ContractAddress: common.HexToAddress(s.ContractAddress),
+		}
+	}
+	return resolvers
+}
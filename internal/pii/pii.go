@@ -0,0 +1,118 @@
+// Package pii masks the credential subject fields that hold personal data
+package pii
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// Mask replaces the value of the sensitive fields
+const Mask = "***"
+
+// Fields is a set of sensitive field names. Names are compared case-insensitively and match at any nesting level.
+type Fields map[string]struct{}
+
+// NewFields returns the set of sensitive fields. Empty names are ignored.
+func NewFields(names []string) Fields {
+	fields := make(Fields, len(names))
+	for _, name := range names {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name != "" {
+			fields[name] = struct{}{}
+		}
+	}
+	return fields
+}
+
+// Contains returns true if the field is sensitive
+func (f Fields) Contains(name string) bool {
+	_, ok := f[strings.ToLower(name)]
+	return ok
+}
+
+// MaskSubject returns a copy of the subject with the sensitive fields masked. The subject is not modified and it is
+// returned as is when there are no sensitive fields.
+func (f Fields) MaskSubject(subject map[string]interface{}) map[string]interface{} {
+	if len(f) == 0 || subject == nil {
+		return subject
+	}
+	masked, _ := f.mask(subject).(map[string]interface{})
+	return masked
+}
+
+// MaskValue masks the sensitive fields of maps, slices and structs. Structs are converted to their JSON
+// representation. The second value is false if nothing was masked, in which case the value is returned as is.
+func (f Fields) MaskValue(v interface{}) (interface{}, bool) {
+	if len(f) == 0 || v == nil {
+		return v, false
+	}
+
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return v, false
+		}
+		rv = rv.Elem()
+	}
+
+	var generic interface{}
+	switch rv.Kind() {
+	case reflect.Map, reflect.Slice, reflect.Array, reflect.Struct:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return v, false
+		}
+		if err := json.Unmarshal(data, &generic); err != nil {
+			return v, false
+		}
+	default:
+		return v, false
+	}
+
+	if !f.containsAny(generic) {
+		return v, false
+	}
+	return f.mask(generic), true
+}
+
+func (f Fields) mask(v interface{}) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		masked := make(map[string]interface{}, len(val))
+		for k, item := range val {
+			if f.Contains(k) {
+				masked[k] = Mask
+				continue
+			}
+			masked[k] = f.mask(item)
+		}
+		return masked
+	case []interface{}:
+		masked := make([]interface{}, len(val))
+		for i, item := range val {
+			masked[i] = f.mask(item)
+		}
+		return masked
+	default:
+		return v
+	}
+}
+
+func (f Fields) containsAny(v interface{}) bool {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		for k, item := range val {
+			if f.Contains(k) || f.containsAny(item) {
+				return true
+			}
+		}
+	case []interface{}:
+		for _, item := range val {
+			if f.containsAny(item) {
+				return true
+			}
+		}
+	}
+	return false
+}
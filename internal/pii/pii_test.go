@@ -0,0 +1,60 @@
+package pii
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFields_MaskSubject(t *testing.T) {
+	fields := NewFields([]string{"birthday", " Email ", ""})
+	subject := map[string]interface{}{
+		"id":       "did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ",
+		"birthday": 19960424,
+		"address": map[string]interface{}{
+			"EMAIL":   "holder@example.com",
+			"country": "ES",
+		},
+		"contacts": []interface{}{map[string]interface{}{"email": "other@example.com"}},
+	}
+
+	masked := fields.MaskSubject(subject)
+	assert.Equal(t, map[string]interface{}{
+		"id":       "did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ",
+		"birthday": Mask,
+		"address": map[string]interface{}{
+			"EMAIL":   Mask,
+			"country": "ES",
+		},
+		"contacts": []interface{}{map[string]interface{}{"email": Mask}},
+	}, masked)
+	// the subject is not modified
+	assert.Equal(t, 19960424, subject["birthday"])
+
+	assert.Equal(t, subject, NewFields(nil).MaskSubject(subject))
+	assert.Nil(t, fields.MaskSubject(nil))
+}
+
+func TestFields_MaskValue(t *testing.T) {
+	type request struct {
+		Type              string                 `json:"type"`
+		CredentialSubject map[string]interface{} `json:"credentialSubject"`
+	}
+	fields := NewFields([]string{"birthday"})
+
+	masked, ok := fields.MaskValue(&request{Type: "KYCAgeCredential", CredentialSubject: map[string]interface{}{"birthday": 19960424}})
+	assert.True(t, ok)
+	assert.Equal(t, map[string]interface{}{
+		"type":              "KYCAgeCredential",
+		"credentialSubject": map[string]interface{}{"birthday": Mask},
+	}, masked)
+
+	value := request{Type: "KYCAgeCredential", CredentialSubject: map[string]interface{}{"documentType": 2}}
+	masked, ok = fields.MaskValue(value)
+	assert.False(t, ok)
+	assert.Equal(t, value, masked)
+
+	masked, ok = fields.MaskValue("birthday")
+	assert.False(t, ok)
+	assert.Equal(t, "birthday", masked)
+}
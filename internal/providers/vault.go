@@ -1,15 +1,47 @@
 package providers
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"os"
 	"time"
 
 	"github.com/hashicorp/vault/api"
+
+	"github.com/polygonid/sh-id-platform/internal/log"
 )
 
 // HTTPClientTimeout http client timeout TODO: move to config
 const HTTPClientTimeout = 10 * time.Second
 
+// Vault auth methods
+const (
+	VaultAuthToken      = "token"
+	VaultAuthAppRole    = "approle"
+	VaultAuthKubernetes = "kubernetes"
+)
+
+const (
+	defaultKubernetesTokenPath = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+	vaultLoginRetryMin         = time.Second
+	vaultLoginRetryMax         = time.Minute
+)
+
+// VaultConfig holds the vault address and the credentials of the auth method
+type VaultConfig struct {
+	Address string
+	// AuthMethod is token, approle or kubernetes. Defaults to token.
+	AuthMethod string
+	// AuthMountPath is the mount path of the auth method. Defaults to the name of the method.
+	AuthMountPath       string
+	Token               string
+	AppRoleID           string
+	AppRoleSecretID     string
+	KubernetesRole      string
+	KubernetesTokenPath string
+}
+
 // NewVaultClient checks vault configuration and creates new vault client
 func NewVaultClient(address, token string) (*api.Client, error) {
 	if address == "" {
@@ -32,3 +64,185 @@ func NewVaultClient(address, token string) (*api.Client, error) {
 
 	return client, nil
 }
+
+// NewVaultClientWithAuth creates new vault client authenticated with the configured auth method and keeps its token
+// alive until ctx is done. Renewable tokens are renewed before they expire. When a token reaches its max TTL a new
+// one is obtained by logging in again, which is not possible for static tokens.
+func NewVaultClientWithAuth(ctx context.Context, cfg VaultConfig) (*api.Client, error) {
+	if cfg.AuthMethod == "" || cfg.AuthMethod == VaultAuthToken {
+		client, err := NewVaultClient(cfg.Address, cfg.Token)
+		if err != nil {
+			return nil, err
+		}
+		secret, err := tokenSecret(client)
+		if err != nil {
+			// the token may lack the permission to look itself up, it is used as is
+			log.Warn(ctx, "cannot look up vault token, it won't be renewed", "err", err)
+			return client, nil
+		}
+		go keepTokenAlive(ctx, client, cfg, secret)
+		return client, nil
+	}
+
+	if cfg.Address == "" {
+		return nil, errors.New("vault address is not specified")
+	}
+	config := api.DefaultConfig()
+	config.Address = cfg.Address
+	config.HttpClient.Timeout = HTTPClientTimeout
+	client, err := api.NewClient(config)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := login(client, cfg)
+	if err != nil {
+		return nil, err
+	}
+	go keepTokenAlive(ctx, client, cfg, secret)
+	return client, nil
+}
+
+// login authenticates with AppRole or Kubernetes and sets the token of the client
+func login(client *api.Client, cfg VaultConfig) (*api.Secret, error) {
+	mountPath := cfg.AuthMountPath
+	if mountPath == "" {
+		mountPath = cfg.AuthMethod
+	}
+
+	var data map[string]interface{}
+	switch cfg.AuthMethod {
+	case VaultAuthAppRole:
+		if cfg.AppRoleID == "" {
+			return nil, errors.New("vault approle role ID is not specified")
+		}
+		data = map[string]interface{}{"role_id": cfg.AppRoleID, "secret_id": cfg.AppRoleSecretID}
+	case VaultAuthKubernetes:
+		if cfg.KubernetesRole == "" {
+			return nil, errors.New("vault kubernetes role is not specified")
+		}
+		tokenPath := cfg.KubernetesTokenPath
+		if tokenPath == "" {
+			tokenPath = defaultKubernetesTokenPath
+		}
+		// the service account token is read on every login, as kubernetes rotates it
+		jwt, err := os.ReadFile(tokenPath)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read kubernetes service account token: %w", err)
+		}
+		data = map[string]interface{}{"role": cfg.KubernetesRole, "jwt": string(jwt)}
+	default:
+		return nil, fmt.Errorf("unknown vault auth method %q", cfg.AuthMethod)
+	}
+
+	// the login request must not carry a token, an expired one makes vault reject it. A clone is used so the
+	// client keeps its token for the requests in flight.
+	loginCli, err := client.Clone()
+	if err != nil {
+		return nil, err
+	}
+	secret, err := loginCli.Logical().Write("auth/"+mountPath+"/login", data)
+	if err != nil {
+		return nil, fmt.Errorf("vault %s login failed: %w", cfg.AuthMethod, err)
+	}
+	if secret == nil || secret.Auth == nil || secret.Auth.ClientToken == "" {
+		return nil, fmt.Errorf("vault %s login returned no token", cfg.AuthMethod)
+	}
+	client.SetToken(secret.Auth.ClientToken)
+	return secret, nil
+}
+
+// tokenSecret looks up the static token of the client, so it can be renewed like the ones returned by a login
+func tokenSecret(client *api.Client) (*api.Secret, error) {
+	self, err := client.Auth().Token().LookupSelf()
+	if err != nil {
+		return nil, err
+	}
+	renewable, err := self.TokenIsRenewable()
+	if err != nil {
+		return nil, err
+	}
+	ttl, err := self.TokenTTL()
+	if err != nil {
+		return nil, err
+	}
+	return &api.Secret{Auth: &api.SecretAuth{
+		ClientToken:   client.Token(),
+		Renewable:     renewable,
+		LeaseDuration: int(ttl.Seconds()),
+	}}, nil
+}
+
+// keepTokenAlive renews the token of the client while it can be renewed and logs in again when it can't
+func keepTokenAlive(ctx context.Context, client *api.Client, cfg VaultConfig, secret *api.Secret) {
+	isStatic := cfg.AuthMethod == "" || cfg.AuthMethod == VaultAuthToken
+	for {
+		if isStatic && secret.Auth.LeaseDuration == 0 {
+			// tokens without TTL, like the root token, never expire
+			return
+		}
+
+		watcher, err := client.NewLifetimeWatcher(&api.LifetimeWatcherInput{Secret: secret})
+		if err != nil {
+			log.Error(ctx, "cannot watch vault token", "err", err)
+			return
+		}
+		if err := watchToken(ctx, watcher); err != nil {
+			log.Warn(ctx, "vault token renewal stopped", "err", err)
+		}
+		if ctx.Err() != nil {
+			return
+		}
+
+		if isStatic {
+			log.Error(ctx, "vault token is about to expire and can't be renewed, set up a new token or use approle or kubernetes auth")
+			return
+		}
+		secret = loginWithBackoff(ctx, client, cfg)
+		if secret == nil {
+			return
+		}
+	}
+}
+
+// watchToken renews the token until the lease can't be extended any longer or ctx is done
+func watchToken(ctx context.Context, watcher *api.LifetimeWatcher) error {
+	go watcher.Start()
+	defer watcher.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-watcher.DoneCh():
+			return err
+		case renewal := <-watcher.RenewCh():
+			if renewal.Secret != nil && renewal.Secret.Auth != nil {
+				log.Debug(ctx, "vault token renewed", "ttl", renewal.Secret.Auth.LeaseDuration)
+			}
+		}
+	}
+}
+
+// loginWithBackoff logs in until it succeeds, doubling the wait between attempts. It returns nil when ctx is done.
+func loginWithBackoff(ctx context.Context, client *api.Client, cfg VaultConfig) *api.Secret {
+	wait := vaultLoginRetryMin
+	for {
+		secret, err := login(client, cfg)
+		if err == nil {
+			log.Info(ctx, "vault token obtained again", "method", cfg.AuthMethod)
+			return secret
+		}
+		log.Error(ctx, "cannot log in to vault", "err", err, "retryIn", wait)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(wait):
+		}
+		wait *= 2
+		if wait > vaultLoginRetryMax {
+			wait = vaultLoginRetryMax
+		}
+	}
+}
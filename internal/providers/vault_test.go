@@ -0,0 +1,140 @@
+package providers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeVault implements the login, lookup and renew endpoints of the vault token auth
+type fakeVault struct {
+	t         *testing.T
+	mu        sync.Mutex
+	logins    int
+	renewals  int
+	lastLogin map[string]interface{}
+	renewable bool
+	ttl       int
+}
+
+func newFakeVault(t *testing.T, renewable bool, ttl int) (*fakeVault, string) {
+	f := &fakeVault{t: t, renewable: renewable, ttl: ttl}
+	srv := httptest.NewServer(f)
+	t.Cleanup(srv.Close)
+	return f, srv.URL
+}
+
+func (f *fakeVault) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var out map[string]interface{}
+	switch r.URL.Path {
+	case "/v1/auth/approle/login", "/v1/auth/k8s/login":
+		assert.Empty(f.t, r.Header.Get("X-Vault-Token"))
+		f.lastLogin = map[string]interface{}{}
+		require.NoError(f.t, json.NewDecoder(r.Body).Decode(&f.lastLogin))
+		f.logins++
+		out = map[string]interface{}{"auth": f.auth(fmt.Sprintf("token-%d", f.logins))}
+	case "/v1/auth/token/lookup-self":
+		out = map[string]interface{}{"data": map[string]interface{}{"renewable": f.renewable, "ttl": f.ttl}}
+	case "/v1/auth/token/renew-self":
+		f.renewals++
+		out = map[string]interface{}{"auth": f.auth(r.Header.Get("X-Vault-Token"))}
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	require.NoError(f.t, json.NewEncoder(w).Encode(out))
+}
+
+func (f *fakeVault) auth(token string) map[string]interface{} {
+	return map[string]interface{}{"client_token": token, "renewable": f.renewable, "lease_duration": f.ttl}
+}
+
+func (f *fakeVault) counters() (logins, renewals int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.logins, f.renewals
+}
+
+func TestNewVaultClientWithAuth_AppRole(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	// the token can't be renewed and expires in a second, so the client logs in again
+	fake, address := newFakeVault(t, false, 1)
+
+	cli, err := NewVaultClientWithAuth(ctx, VaultConfig{
+		Address:         address,
+		AuthMethod:      VaultAuthAppRole,
+		AppRoleID:       "role",
+		AppRoleSecretID: "secret",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "token-1", cli.Token())
+	require.Equal(t, map[string]interface{}{"role_id": "role", "secret_id": "secret"}, fake.lastLogin)
+
+	require.Eventually(t, func() bool {
+		logins, _ := fake.counters()
+		return logins >= 2
+	}, 5*time.Second, 50*time.Millisecond)
+	require.NotEqual(t, "token-1", cli.Token())
+
+	_, err = NewVaultClientWithAuth(ctx, VaultConfig{Address: address, AuthMethod: VaultAuthAppRole})
+	require.Error(t, err)
+}
+
+func TestNewVaultClientWithAuth_Kubernetes(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	fake, address := newFakeVault(t, true, 3600)
+	tokenPath := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenPath, []byte("jwt"), 0o600))
+
+	cli, err := NewVaultClientWithAuth(ctx, VaultConfig{
+		Address:             address,
+		AuthMethod:          VaultAuthKubernetes,
+		AuthMountPath:       "k8s",
+		KubernetesRole:      "issuer",
+		KubernetesTokenPath: tokenPath,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "token-1", cli.Token())
+	require.Equal(t, map[string]interface{}{"role": "issuer", "jwt": "jwt"}, fake.lastLogin)
+
+	_, err = NewVaultClientWithAuth(ctx, VaultConfig{
+		Address:             address,
+		AuthMethod:          VaultAuthKubernetes,
+		KubernetesRole:      "issuer",
+		KubernetesTokenPath: filepath.Join(t.TempDir(), "missing"),
+	})
+	require.Error(t, err)
+}
+
+func TestNewVaultClientWithAuth_TokenRenewal(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	fake, address := newFakeVault(t, true, 1)
+
+	cli, err := NewVaultClientWithAuth(ctx, VaultConfig{Address: address, Token: "static"})
+	require.NoError(t, err)
+	require.Equal(t, "static", cli.Token())
+
+	require.Eventually(t, func() bool {
+		_, renewals := fake.counters()
+		return renewals >= 1
+	}, 5*time.Second, 50*time.Millisecond)
+	logins, _ := fake.counters()
+	require.Zero(t, logins)
+	require.Equal(t, "static", cli.Token())
+}
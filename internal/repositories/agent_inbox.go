@@ -0,0 +1,116 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+const agentInboxIssuerIDFKey = "agent_inbox_issuer_id_fkey"
+
+var (
+	// ErrInboxMessageNotFound the message is not in the inbox of the issuer
+	ErrInboxMessageNotFound = errors.New("inbox message not found")
+	// ErrInboxIssuerNotFound the message was sent to an identity that is not in the node
+	ErrInboxIssuerNotFound = errors.New("inbox issuer not found")
+)
+
+// inboxMessageColumns are the columns of the listings, the envelopes are only read one by one
+const inboxMessageColumns = `id, issuer_id, sender, message_id, thread_id, type, media_type, status, error, response_type, created_at`
+
+type agentInbox struct{}
+
+// NewAgentInbox returns a new repository of the messages received by the agent
+func NewAgentInbox() ports.AgentInboxRepository {
+	return &agentInbox{}
+}
+
+// Save inserts the message in the inbox of its issuer
+func (r *agentInbox) Save(ctx context.Context, conn db.Querier, message *domain.InboxMessage) error {
+	_, err := conn.Exec(ctx, `
+		INSERT INTO agent_inbox (id, issuer_id, sender, message_id, thread_id, type, media_type, status, error, response_type, envelope, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`,
+		message.ID, message.IssuerDID.String(), message.Sender, message.MessageID, message.ThreadID, message.Type,
+		message.MediaType, message.Status, message.Error, message.ResponseType, message.Envelope, message.CreatedAt)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.ConstraintName == agentInboxIssuerIDFKey {
+		return ErrInboxIssuerNotFound
+	}
+	return err
+}
+
+// GetAll returns the newest messages of the issuer that match the filter, without their envelopes
+func (r *agentInbox) GetAll(ctx context.Context, conn db.Querier, issuerDID core.DID, filter ports.InboxFilter) ([]*domain.InboxMessage, error) {
+	rows, err := conn.Query(ctx, `
+		SELECT `+inboxMessageColumns+`
+		FROM agent_inbox
+		WHERE issuer_id = $1 AND ($2 = '' OR sender = $2) AND ($3 = '' OR thread_id = $3) AND ($4 = '' OR type = $4)
+			AND ($5::text IS NULL OR status = $5)
+		ORDER BY created_at DESC
+		LIMIT $6`, issuerDID.String(), filter.Sender, filter.ThreadID, filter.Type, filter.Status, filter.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	messages := make([]*domain.InboxMessage, 0)
+	for rows.Next() {
+		message, err := scanInboxMessage(rows)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, message)
+	}
+	return messages, rows.Err()
+}
+
+// GetByID returns the message of the issuer, with its envelope
+func (r *agentInbox) GetByID(ctx context.Context, conn db.Querier, issuerDID core.DID, id uuid.UUID) (*domain.InboxMessage, error) {
+	var envelope []byte
+	message, err := scanInboxMessage(conn.QueryRow(ctx, `
+		SELECT `+inboxMessageColumns+`, envelope
+		FROM agent_inbox
+		WHERE id = $1 AND issuer_id = $2`, id, issuerDID.String()), &envelope)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrInboxMessageNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	message.Envelope = envelope
+	return message, nil
+}
+
+// DeleteBefore removes the messages received before the given time, and returns how many were removed
+func (r *agentInbox) DeleteBefore(ctx context.Context, conn db.Querier, before time.Time) (int64, error) {
+	cmd, err := conn.Exec(ctx, `DELETE FROM agent_inbox WHERE created_at < $1`, before)
+	if err != nil {
+		return 0, err
+	}
+	return cmd.RowsAffected(), nil
+}
+
+func scanInboxMessage(row pgx.Row, extra ...any) (*domain.InboxMessage, error) {
+	var issuerID string
+	message := &domain.InboxMessage{}
+	dest := append([]any{&message.ID, &issuerID, &message.Sender, &message.MessageID, &message.ThreadID, &message.Type,
+		&message.MediaType, &message.Status, &message.Error, &message.ResponseType, &message.CreatedAt}, extra...)
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
+	}
+	issuerDID, err := core.ParseDID(issuerID)
+	if err != nil {
+		return nil, err
+	}
+	message.IssuerDID = *issuerDID
+	return message, nil
+}
@@ -0,0 +1,83 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+type agentMessages struct{}
+
+// NewAgentMessage returns a new processed agent messages repository
+func NewAgentMessage() ports.AgentMessageRepository {
+	return &agentMessages{}
+}
+
+// Reserve records the message as being processed. If the message id, or the thread of a message of the same type,
+// was received from the sender since the given time, nothing is recorded and the original message is returned.
+// Messages received before that time are forgotten.
+func (r *agentMessages) Reserve(ctx context.Context, conn db.Querier, message *domain.AgentMessage, since time.Time) (*domain.AgentMessage, error) {
+	if _, err := conn.Exec(ctx, `DELETE FROM agent_messages WHERE created_at < $1`, since); err != nil {
+		return nil, err
+	}
+
+	cmd, err := conn.Exec(ctx, `
+		INSERT INTO agent_messages (issuer_id, sender, message_id, thread_id, type, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT DO NOTHING`,
+		message.IssuerDID.String(), message.Sender, message.ID, message.ThreadID, message.Type, message.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	if cmd.RowsAffected() > 0 {
+		return nil, nil
+	}
+
+	original := &domain.AgentMessage{IssuerDID: message.IssuerDID, Sender: message.Sender}
+	var response []byte
+	err = conn.QueryRow(ctx, `
+		SELECT message_id, thread_id, type, response, created_at
+		FROM agent_messages
+		WHERE issuer_id = $1 AND sender = $2 AND (message_id = $3 OR (thread_id <> '' AND thread_id = $4 AND type = $5))
+		LIMIT 1`,
+		message.IssuerDID.String(), message.Sender, message.ID, message.ThreadID, message.Type).
+		Scan(&original.ID, &original.ThreadID, &original.Type, &response, &original.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			// the original was forgotten in the meantime, the message can be processed
+			return nil, nil
+		}
+		return nil, err
+	}
+	if response != nil {
+		if err := json.Unmarshal(response, &original.Response); err != nil {
+			return nil, err
+		}
+	}
+	return original, nil
+}
+
+// SaveResponse stores the response of the message, to be returned to its replays
+func (r *agentMessages) SaveResponse(ctx context.Context, conn db.Querier, message *domain.AgentMessage) error {
+	response, err := json.Marshal(message.Response)
+	if err != nil {
+		return err
+	}
+	_, err = conn.Exec(ctx, `UPDATE agent_messages SET response = $4 WHERE issuer_id = $1 AND sender = $2 AND message_id = $3`,
+		message.IssuerDID.String(), message.Sender, message.ID, response)
+	return err
+}
+
+// Delete forgets the message, so it can be processed again
+func (r *agentMessages) Delete(ctx context.Context, conn db.Querier, message *domain.AgentMessage) error {
+	_, err := conn.Exec(ctx, `DELETE FROM agent_messages WHERE issuer_id = $1 AND sender = $2 AND message_id = $3`,
+		message.IssuerDID.String(), message.Sender, message.ID)
+	return err
+}
@@ -0,0 +1,51 @@
+package repositories
+
+import (
+	"context"
+	"strconv"
+
+	core "github.com/iden3/go-iden3-core"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+type changes struct{}
+
+// NewChange returns a new changes feed repository
+func NewChange() ports.ChangeRepository {
+	return &changes{}
+}
+
+// GetSince returns the changes of the issuer after the cursor, in order. Only the changes of the transactions older
+// than every running one are returned, so a transaction that commits later cannot add changes behind the cursor.
+func (r *changes) GetSince(ctx context.Context, conn db.Querier, issuerDID core.DID, since domain.ChangeCursor, limit int) ([]domain.Change, error) {
+	rows, err := conn.Query(ctx, `
+		SELECT tx::text, seq, issuer_id, entity, entity_id, operation, created_at
+		FROM changes
+		WHERE issuer_id = $1
+		  AND (tx, seq) > ($2::text::xid8, $3)
+		  AND tx < pg_snapshot_xmin(pg_current_snapshot())
+		ORDER BY tx, seq
+		LIMIT $4`,
+		issuerDID.String(), strconv.FormatUint(since.Tx, 10), since.Seq, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make([]domain.Change, 0)
+	for rows.Next() {
+		var change domain.Change
+		var tx string
+		if err := rows.Scan(&tx, &change.Cursor.Seq, &change.IssuerDID, &change.Entity, &change.EntityID, &change.Operation, &change.CreatedAt); err != nil {
+			return nil, err
+		}
+		if change.Cursor.Tx, err = strconv.ParseUint(tx, 10, 64); err != nil {
+			return nil, err
+		}
+		result = append(result, change)
+	}
+	return result, rows.Err()
+}
@@ -5,11 +5,14 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/google/uuid"
 	core "github.com/iden3/go-iden3-core"
 	"github.com/iden3/go-merkletree-sql/v2"
 	"github.com/iden3/go-schema-processor/verifiable"
+	"github.com/jackc/pgconn"
 	"github.com/jackc/pgtype"
 	"github.com/jackc/pgx/v4"
 	"github.com/labstack/gommon/log"
@@ -21,13 +24,18 @@ import (
 	"github.com/polygonid/sh-id-platform/internal/db"
 )
 
-const duplicateViolationErrorCode = "23505"
+const (
+	duplicateViolationErrorCode = "23505"
+	claimsIdentifierRevNonceKey = "claims_identifier_rev_nonce_key"
+)
 
 // ErrClaimDuplication claim duplication error
 var (
 	ErrClaimDuplication = errors.New("claim duplication error")
 	// ErrClaimDoesNotExist claim does not exist
 	ErrClaimDoesNotExist = errors.New("claim does not exist")
+	// ErrRevNonceDuplicated the revocation nonce is already taken by another credential of the issuer
+	ErrRevNonceDuplicated = errors.New("revocation nonce duplicated")
 )
 
 type claims struct{}
@@ -53,6 +61,7 @@ type dbClaim struct {
 	Status           sql.NullString
 	CredentialStatus *pgtype.JSONB
 	HIndex           sql.NullString
+	DeletedAt        *time.Time
 
 	MtProof sql.NullBool
 }
@@ -78,6 +87,7 @@ func (c *claims) Save(ctx context.Context, conn db.Querier, claim *domain.Claim)
 	if claim.CredentialStatus.Status == pgtype.Undefined {
 		claim.CredentialStatus.Status = pgtype.Null
 	}
+	display := claimDisplay(claim)
 
 	if id == uuid.Nil {
 		s := `INSERT INTO claims (identifier,
@@ -99,8 +109,9 @@ func (c *claims) Save(ctx context.Context, conn db.Querier, claim *domain.Claim)
                     core_claim,
                     index_hash,
 					mtp, 
-					link_id)
-		VALUES ($1,  $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20)
+					link_id,
+					display)
+		VALUES ($1,  $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21)
 		RETURNING id`
 
 		err = conn.QueryRow(ctx, s,
@@ -123,7 +134,8 @@ func (c *claims) Save(ctx context.Context, conn db.Querier, claim *domain.Claim)
 			claim.CoreClaim,
 			claim.HIndex,
 			claim.MtProof,
-			claim.LinkID).Scan(&id)
+			claim.LinkID,
+			display).Scan(&id)
 	} else {
 		s := `INSERT INTO claims (
 					id,
@@ -146,18 +158,19 @@ func (c *claims) Save(ctx context.Context, conn db.Querier, claim *domain.Claim)
                     core_claim,
                     index_hash,
 					mtp,
-					link_id
+					link_id,
+					display
 		)
 		VALUES (
-			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22
 		)
 		ON CONFLICT ON CONSTRAINT claims_pkey 
 		DO UPDATE SET 
 			( expiration, updatable, version, rev_nonce, signature_proof, mtp_proof, data, identity_state, 
-			other_identifier, schema_hash, schema_url, schema_type, issuer, credential_status, revoked, core_claim, mtp, link_id)
+			other_identifier, schema_hash, schema_url, schema_type, issuer, credential_status, revoked, core_claim, mtp, link_id, display)
 			= (EXCLUDED.expiration, EXCLUDED.updatable, EXCLUDED.version, EXCLUDED.rev_nonce, EXCLUDED.signature_proof,
 		EXCLUDED.mtp_proof, EXCLUDED.data, EXCLUDED.identity_state, EXCLUDED.other_identifier, EXCLUDED.schema_hash, 
-		EXCLUDED.schema_url, EXCLUDED.schema_type, EXCLUDED.issuer, EXCLUDED.credential_status, EXCLUDED.revoked, EXCLUDED.core_claim, EXCLUDED.mtp, EXCLUDED.link_id)
+		EXCLUDED.schema_url, EXCLUDED.schema_type, EXCLUDED.issuer, EXCLUDED.credential_status, EXCLUDED.revoked, EXCLUDED.core_claim, EXCLUDED.mtp, EXCLUDED.link_id, EXCLUDED.display)
 			RETURNING id`
 		err = conn.QueryRow(ctx, s,
 			claim.ID,
@@ -180,13 +193,19 @@ func (c *claims) Save(ctx context.Context, conn db.Querier, claim *domain.Claim)
 			claim.CoreClaim,
 			claim.HIndex,
 			claim.MtProof,
-			claim.LinkID).Scan(&id)
+			claim.LinkID,
+			display).Scan(&id)
 	}
 
 	if err == nil {
 		return id, nil
 	}
 
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.ConstraintName == claimsIdentifierRevNonceKey {
+		return uuid.Nil, ErrRevNonceDuplicated
+	}
+
 	pqErr, ok := err.(*pq.Error)
 	if ok {
 		if pqErr.Code == duplicateViolationErrorCode {
@@ -194,17 +213,18 @@ func (c *claims) Save(ctx context.Context, conn db.Querier, claim *domain.Claim)
 		}
 	}
 
-	log.Errorf("error saving the claim: %v", "err", err.Error())
+	log.Errorf("error saving the claim: %v", err.Error())
 	return uuid.Nil, fmt.Errorf("error saving the claim: %w", err)
 }
 
 func (c *claims) Revoke(ctx context.Context, conn db.Querier, revocation *domain.Revocation) error {
-	_, err := conn.Exec(ctx, `INSERT INTO revocation (identifier, nonce, version, status, description) VALUES($1, $2, $3, $4, $5)`,
+	_, err := conn.Exec(ctx, `INSERT INTO revocation (identifier, nonce, version, status, description, reason) VALUES($1, $2, $3, $4, $5, $6)`,
 		revocation.Identifier,
 		revocation.Nonce,
 		revocation.Version,
 		revocation.Status,
-		revocation.Description)
+		revocation.Description,
+		revocationReason(revocation.Reason))
 	if err != nil {
 		return fmt.Errorf("error revoking the claim: %w", err)
 	}
@@ -212,8 +232,9 @@ func (c *claims) Revoke(ctx context.Context, conn db.Querier, revocation *domain
 	return nil
 }
 
+// Delete marks the claim as deleted. It is kept until it is purged, so it can be restored.
 func (c *claims) Delete(ctx context.Context, conn db.Querier, id uuid.UUID) error {
-	sql := `DELETE FROM claims WHERE id = $1`
+	sql := `UPDATE claims SET deleted_at = CURRENT_TIMESTAMP WHERE id = $1 AND deleted_at IS NULL`
 	cmd, err := conn.Exec(ctx, sql, id.String())
 	if err != nil {
 		return err
@@ -226,6 +247,30 @@ func (c *claims) Delete(ctx context.Context, conn db.Querier, id uuid.UUID) erro
 	return nil
 }
 
+// Restore undoes the deletion of a claim of the issuer that was not purged yet
+func (c *claims) Restore(ctx context.Context, conn db.Querier, identifier core.DID, id uuid.UUID) error {
+	sql := `UPDATE claims SET deleted_at = NULL WHERE identifier = $1 AND id = $2 AND deleted_at IS NOT NULL`
+	cmd, err := conn.Exec(ctx, sql, identifier.String(), id)
+	if err != nil {
+		return err
+	}
+
+	if cmd.RowsAffected() == 0 {
+		return ErrClaimDoesNotExist
+	}
+
+	return nil
+}
+
+// PurgeDeleted removes for good the claims deleted before the given time
+func (c *claims) PurgeDeleted(ctx context.Context, conn db.Querier, before time.Time) (int64, error) {
+	cmd, err := conn.Exec(ctx, `DELETE FROM claims WHERE deleted_at < $1`, before)
+	if err != nil {
+		return 0, err
+	}
+	return cmd.RowsAffected(), nil
+}
+
 func (c *claims) GetByRevocationNonce(ctx context.Context, conn db.Querier, identifier *core.DID, revocationNonce domain.RevNonceUint64) (*domain.Claim, error) {
 	claim := domain.Claim{}
 	row := conn.QueryRow(
@@ -282,6 +327,31 @@ func (c *claims) GetByRevocationNonce(ctx context.Context, conn db.Querier, iden
 	return &claim, nil
 }
 
+// RevNonceExists returns whether the identity has a credential with the revocation nonce
+func (c *claims) RevNonceExists(ctx context.Context, conn db.Querier, identifier *core.DID, revocationNonce domain.RevNonceUint64) (bool, error) {
+	var exists bool
+	err := conn.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM claims WHERE identifier = $1 AND rev_nonce = $2)`,
+		identifier.String(), revocationNonce).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("error checking the revocation nonce: %w", err)
+	}
+	return exists, nil
+}
+
+// NextRevNonce takes the next revocation nonce of the sequence of the identity, starting at 1. The nonces of the
+// credentials that are not saved at the end are not taken again.
+func (c *claims) NextRevNonce(ctx context.Context, conn db.Querier, identifier *core.DID) (domain.RevNonceUint64, error) {
+	var nonce int64
+	err := conn.QueryRow(ctx, `
+		INSERT INTO rev_nonce_sequences (identifier, last_nonce) VALUES ($1, 1)
+		ON CONFLICT (identifier) DO UPDATE SET last_nonce = rev_nonce_sequences.last_nonce + 1
+		RETURNING last_nonce`, identifier.String()).Scan(&nonce)
+	if err != nil {
+		return 0, fmt.Errorf("error taking the next revocation nonce: %w", err)
+	}
+	return domain.RevNonceUint64(nonce), nil
+}
+
 func (c *claims) FindOneClaimBySchemaHash(ctx context.Context, conn db.Querier, subject *core.DID, schemaHash string) (*domain.Claim, error) {
 	var claim domain.Claim
 
@@ -305,10 +375,13 @@ func (c *claims) FindOneClaimBySchemaHash(ctx context.Context, conn db.Querier,
 		   revoked,
 		   core_claim
 		FROM claims
+		LEFT JOIN identity_states ON claims.identity_state = identity_states.state
 		WHERE claims.identifier=$1  
 				AND ( claims.other_identifier = $1 or claims.other_identifier = '') 
 				AND claims.schema_hash = $2 
-				AND claims.revoked = false`, subject.String(), schemaHash)
+				AND claims.revoked = false
+		ORDER BY identity_states.status = 'confirmed' DESC NULLS LAST, identity_states.state_id DESC NULLS LAST
+		LIMIT 1`, subject.String(), schemaHash)
 
 	err := row.Scan(&claim.ID,
 		&claim.Issuer,
@@ -338,16 +411,55 @@ func (c *claims) FindOneClaimBySchemaHash(ctx context.Context, conn db.Querier,
 
 func (c *claims) RevokeNonce(ctx context.Context, conn db.Querier, revocation *domain.Revocation) error {
 	_, err := conn.Exec(ctx,
-		`	INSERT INTO revocation (identifier, nonce, version, status, description) 
-				VALUES($1, $2, $3, $4, $5)`,
+		`	INSERT INTO revocation (identifier, nonce, version, status, description, reason) 
+				VALUES($1, $2, $3, $4, $5, $6)`,
 		revocation.Identifier,
 		revocation.Nonce,
 		revocation.Version,
 		revocation.Status,
-		revocation.Description)
+		revocation.Description,
+		revocationReason(revocation.Reason))
 	return err
 }
 
+// RevokeNonces saves the revocations of the nonces of the identity in a single statement. The nonces that are revoked
+// already keep their revocation.
+func (c *claims) RevokeNonces(ctx context.Context, conn db.Querier, identifier *core.DID, nonces []domain.RevNonceUint64, reason domain.RevocationReason, description string) error {
+	_, err := conn.Exec(ctx,
+		`INSERT INTO revocation (identifier, nonce, version, status, description, reason)
+			SELECT $1, nonce::numeric, 0, $3, $4, $5 FROM unnest($2::text[]) AS nonce
+		ON CONFLICT DO NOTHING`,
+		identifier.String(), revNoncesToStrings(nonces), domain.RevPending, description, revocationReason(reason))
+	return err
+}
+
+// revocationReason returns the reason to store, unspecified when the revocation has none
+func revocationReason(reason domain.RevocationReason) domain.RevocationReason {
+	if reason == "" {
+		return domain.RevocationUnspecified
+	}
+	return reason
+}
+
+// MarkAsRevoked flags as revoked the claims of the identity with the given revocation nonces
+func (c *claims) MarkAsRevoked(ctx context.Context, conn db.Querier, identifier *core.DID, nonces []domain.RevNonceUint64) (int64, error) {
+	res, err := conn.Exec(ctx,
+		`UPDATE claims SET revoked = true WHERE identifier = $1 AND rev_nonce = ANY($2::text[]::numeric[])`,
+		identifier.String(), revNoncesToStrings(nonces))
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected(), nil
+}
+
+func revNoncesToStrings(nonces []domain.RevNonceUint64) []string {
+	strNonces := make([]string, len(nonces))
+	for i, nonce := range nonces {
+		strNonces[i] = strconv.FormatUint(uint64(nonce), 10)
+	}
+	return strNonces
+}
+
 // GetByIdAndIssuer get claim by id
 func (c *claims) GetByIdAndIssuer(ctx context.Context, conn db.Querier, identifier *core.DID, claimID uuid.UUID) (*domain.Claim, error) {
 	claim := domain.Claim{}
@@ -367,13 +479,15 @@ func (c *claims) GetByIdAndIssuer(ctx context.Context, conn db.Querier, identifi
        				data,
        				claims.identifier,
         			identity_state,
+       				identity_states.status,
        				credential_status,
        				core_claim,
 					mtp,
 					revoked,
 					link_id
         FROM claims
-        WHERE claims.identifier = $1 AND claims.id = $2`, identifier.String(), claimID).Scan(
+        LEFT JOIN identity_states ON claims.identity_state = identity_states.state
+        WHERE claims.identifier = $1 AND claims.id = $2 AND claims.deleted_at IS NULL`, identifier.String(), claimID).Scan(
 		&claim.ID,
 		&claim.Issuer,
 		&claim.SchemaHash,
@@ -389,6 +503,7 @@ func (c *claims) GetByIdAndIssuer(ctx context.Context, conn db.Querier, identifi
 		&claim.Data,
 		&claim.Identifier,
 		&claim.IdentityState,
+		&claim.Status,
 		&claim.CredentialStatus,
 		&claim.CoreClaim,
 		&claim.MtProof,
@@ -404,7 +519,7 @@ func (c *claims) GetByIdAndIssuer(ctx context.Context, conn db.Querier, identifi
 
 // GetAllByIssuerID returns all the claims of the given issuer
 func (c *claims) GetAllByIssuerID(ctx context.Context, conn db.Querier, issuerID core.DID, filter *ports.ClaimsFilter) ([]*domain.Claim, error) {
-	query, args := buildGetAllQueryAndFilters(issuerID, filter)
+	query, args := buildGetAllQueryAndFilters(claimsListSelect, issuerID, filter)
 
 	rows, err := conn.Query(ctx, query, args...)
 	if err != nil {
@@ -419,6 +534,69 @@ func (c *claims) GetAllByIssuerID(ctx context.Context, conn db.Querier, issuerID
 	return processClaims(rows)
 }
 
+// GetAllDisplayByIssuerID returns the display of the claims of the issuer that match the filter, for the listings
+func (c *claims) GetAllDisplayByIssuerID(ctx context.Context, conn db.Querier, issuerID core.DID, filter *ports.ClaimsFilter) ([]*domain.CredentialRow, error) {
+	query, args := buildGetAllQueryAndFilters(claimsDisplaySelect, issuerID, filter)
+	rows, err := conn.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	credentials := make([]*domain.CredentialRow, 0)
+	var legacy []*domain.CredentialRow
+	for rows.Next() {
+		credential, hasDisplay, err := scanCredentialRow(rows)
+		if err != nil {
+			return nil, err
+		}
+		credentials = append(credentials, credential)
+		if !hasDisplay {
+			legacy = append(legacy, credential)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	if err := setLegacyDisplays(ctx, conn, issuerID, legacy); err != nil {
+		return nil, err
+	}
+	return credentials, nil
+}
+
+// GetSummaryByIssuerID counts the claims of the issuer that match the filter, ignoring its limit and offset
+func (c *claims) GetSummaryByIssuerID(ctx context.Context, conn db.Querier, issuerID core.DID, filter *ports.ClaimsFilter) (*domain.CredentialsSummary, error) {
+	all := *filter
+	all.Limit, all.Offset = 0, 0
+	query, args := buildGetAllQueryAndFilters(claimsSummarySelect, issuerID, &all)
+	args = append(args, time.Now().Unix())
+	query = fmt.Sprintf(`SELECT COUNT(*),
+       COUNT(*) FILTER (WHERE filtered.revoked),
+       COUNT(*) FILTER (WHERE filtered.expiration > 0 AND filtered.expiration < $%d)
+FROM (%s) AS filtered`, len(args), query)
+
+	var summary domain.CredentialsSummary
+	if err := conn.QueryRow(ctx, query, args...).Scan(&summary.Total, &summary.Revoked, &summary.Expired); err != nil {
+		return nil, err
+	}
+	return &summary, nil
+}
+
+// IterateByIssuerID calls fn for every claim of the issuer that matches the filter, reading them from a
+// server-side cursor
+func (c *claims) IterateByIssuerID(ctx context.Context, conn db.Querier, issuerID core.DID, filter *ports.ClaimsFilter, fn func(*domain.Claim) error) error {
+	query, args := buildGetAllQueryAndFilters(claimsListSelect, issuerID, filter)
+	return iterate(ctx, conn, query, args, func(rows pgx.Rows) error {
+		claim, err := scanClaim(rows)
+		if err != nil {
+			return err
+		}
+		return fn(claim)
+	})
+}
+
 func (c *claims) GetNonRevokedByConnectionAndIssuerID(ctx context.Context, conn db.Querier, connID uuid.UUID, issuerID core.DID) ([]*domain.Claim, error) {
 	query := `SELECT claims.id,
 				   issuer,
@@ -439,7 +617,8 @@ func (c *claims) GetNonRevokedByConnectionAndIssuerID(ctx context.Context, conn
 				   credential_status,
 				   core_claim,
 				   revoked,
-				   mtp
+				   mtp,
+				   claims.deleted_at
 			FROM claims
 			JOIN connections ON connections.issuer_id = claims.issuer AND connections.user_id = claims.other_identifier
 			LEFT JOIN identity_states  ON claims.identity_state = identity_states.state
@@ -644,48 +823,134 @@ func (c *claims) UpdateState(ctx context.Context, conn db.Querier, claim *domain
 	return res.RowsAffected(), nil
 }
 
+// claimDisplay returns the display stored with the claim, null if it has no credential to take it from
+func claimDisplay(claim *domain.Claim) pgtype.JSONB {
+	display := pgtype.JSONB{Status: pgtype.Null}
+	if d, err := domain.NewCredentialDisplay(claim); err == nil {
+		_ = display.Set(d)
+	}
+	return display
+}
+
+// scanCredentialRow scans the columns of claimsDisplaySelect. It returns false for the claims saved without a display,
+// see setLegacyDisplays.
+func scanCredentialRow(rows pgx.Rows) (*domain.CredentialRow, bool, error) {
+	var credential domain.CredentialRow
+	var display pgtype.JSONB
+	err := rows.Scan(
+		&credential.ID,
+		&credential.SchemaHash,
+		&credential.SchemaType,
+		&credential.HolderDID,
+		&credential.RevNonce,
+		&credential.Revoked,
+		&credential.Status,
+		&credential.HasSignatureProof,
+		&credential.HasMTProof,
+		&credential.DeletedAt,
+		&display)
+	if err != nil {
+		return nil, false, err
+	}
+	if display.Status != pgtype.Present {
+		return &credential, false, nil
+	}
+	if err := display.AssignTo(&credential.Display); err != nil {
+		return nil, false, err
+	}
+	return &credential, true, nil
+}
+
+// setLegacyDisplays sets the display of the credentials of the issuer saved without one, taking it from their
+// credential. Only the claims saved before the displays were stored have none.
+func setLegacyDisplays(ctx context.Context, conn db.Querier, issuerID core.DID, credentials []*domain.CredentialRow) error {
+	if len(credentials) == 0 {
+		return nil
+	}
+	byID := make(map[uuid.UUID]*domain.CredentialRow, len(credentials))
+	ids := make([]string, 0, len(credentials))
+	for _, credential := range credentials {
+		byID[credential.ID] = credential
+		ids = append(ids, credential.ID.String())
+	}
+
+	rows, err := conn.Query(ctx, `SELECT id, schema_url, data, core_claim FROM claims WHERE identifier = $1 AND id = ANY($2::uuid[])`, issuerID.String(), ids)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id uuid.UUID
+		var schemaURL, coreClaim sql.NullString
+		var data pgtype.JSONB
+		if err := rows.Scan(&id, &schemaURL, &data, &coreClaim); err != nil {
+			return err
+		}
+		claim := domain.Claim{SchemaURL: schemaURL.String, Data: data}
+		if err := claim.CoreClaim.Scan(coreClaim.String); err != nil {
+			return err
+		}
+		display, err := domain.NewCredentialDisplay(&claim)
+		if err != nil {
+			return err
+		}
+		byID[id].Display = *display
+	}
+	return rows.Err()
+}
+
 func processClaims(rows pgx.Rows) ([]*domain.Claim, error) {
 	claims := make([]*domain.Claim, 0)
 
 	for rows.Next() {
-		var claim domain.Claim
-		err := rows.Scan(&claim.ID,
-			&claim.Issuer,
-			&claim.SchemaHash,
-			&claim.SchemaURL,
-			&claim.SchemaType,
-			&claim.OtherIdentifier,
-			&claim.Expiration,
-			&claim.Updatable,
-			&claim.Version,
-			&claim.RevNonce,
-			&claim.SignatureProof,
-			&claim.MTPProof,
-			&claim.Data,
-			&claim.Identifier,
-			&claim.IdentityState,
-			&claim.Status,
-			&claim.CredentialStatus,
-			&claim.CoreClaim,
-			&claim.Revoked,
-			&claim.MtProof)
+		claim, err := scanClaim(rows)
 		if err != nil {
 			return nil, err
 		}
-		claims = append(claims, &claim)
+		claims = append(claims, claim)
 	}
 
 	return claims, rows.Err()
 }
 
-func buildGetAllQueryAndFilters(issuerID core.DID, filter *ports.ClaimsFilter) (string, []interface{}) {
-	query := `SELECT claims.id,
-				   issuer,
-				   schema_hash,
+func scanClaim(rows pgx.Rows) (*domain.Claim, error) {
+	var claim domain.Claim
+	err := rows.Scan(&claim.ID,
+		&claim.Issuer,
+		&claim.SchemaHash,
+		&claim.SchemaURL,
+		&claim.SchemaType,
+		&claim.OtherIdentifier,
+		&claim.Expiration,
+		&claim.Updatable,
+		&claim.Version,
+		&claim.RevNonce,
+		&claim.SignatureProof,
+		&claim.MTPProof,
+		&claim.Data,
+		&claim.Identifier,
+		&claim.IdentityState,
+		&claim.Status,
+		&claim.CredentialStatus,
+		&claim.CoreClaim,
+		&claim.Revoked,
+		&claim.MtProof,
+		&claim.DeletedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &claim, nil
+}
+
+// claimsListSelect selects the columns of the claims read by scanClaim, joining the claims of the read model rows
+const claimsListSelect = `SELECT claims.id,
+				   claims.issuer,
+				   claims.schema_hash,
 				   schema_url,
-				   schema_type,
+				   claims.schema_type,
 				   other_identifier,
-				   expiration,
+				   claims.expiration,
 				   updatable,
 				   claims.version,
 				   rev_nonce,
@@ -693,79 +958,136 @@ func buildGetAllQueryAndFilters(issuerID core.DID, filter *ports.ClaimsFilter) (
 				   mtp_proof,
 				   data,
 				   claims.identifier,
-				   identity_state,
-				   identity_states.status,
+				   claims.identity_state,
+				   claims_read_model.status,
 				   credential_status,
 				   core_claim,
-				   revoked,
-				   mtp
-			FROM claims
-			LEFT JOIN identity_states  ON claims.identity_state = identity_states.state
+				   claims.revoked,
+				   claims.mtp,
+				   claims.deleted_at
+			FROM claims_read_model
+			JOIN claims ON claims.id = claims_read_model.id AND claims.identifier = claims_read_model.identifier
+			`
+
+// claimsDisplaySelect selects the columns of the read model read by scanCredentialRow
+const claimsDisplaySelect = `SELECT claims_read_model.id,
+				   claims_read_model.schema_hash,
+				   claims_read_model.schema_type,
+				   COALESCE(claims_read_model.holder_did, ''),
+				   claims_read_model.rev_nonce,
+				   claims_read_model.revoked,
+				   claims_read_model.status,
+				   claims_read_model.has_signature_proof,
+				   claims_read_model.mtp,
+				   claims_read_model.deleted_at,
+				   claims_read_model.display
+			FROM claims_read_model
 			`
-	if filter.FTSQuery != "" {
-		query = fmt.Sprintf("%s LEFT JOIN schemas ON claims.schema_hash=schemas.hash AND claims.issuer=schemas.issuer_id ", query)
-	}
+
+// claimsSummarySelect selects the columns of the read model counted by GetSummaryByIssuerID
+const claimsSummarySelect = `SELECT claims_read_model.revoked, claims_read_model.expiration FROM claims_read_model `
+
+// buildGetAllQueryAndFilters filters the selection of the claims with the filter. The claims are filtered on the
+// claims_read_model table, kept up to date by triggers on every write, so the status of the state and the words of
+// the schema are not joined per row, and the listings read nothing else.
+func buildGetAllQueryAndFilters(selection string, issuerID core.DID, filter *ports.ClaimsFilter) (string, []interface{}) {
+	query := selection
 
 	filters := []interface{}{issuerID.String()}
-	query = fmt.Sprintf("%s WHERE claims.identifier = $%d ", query, len(filters))
+	query = fmt.Sprintf("%s WHERE claims_read_model.identifier = $%d ", query, len(filters))
 
-	query = fmt.Sprintf("%s AND claims.schema_type <> '%s' ", query, domain.AuthBJJCredentialSchemaType)
+	query = fmt.Sprintf("%s AND claims_read_model.schema_type <> '%s' ", query, domain.AuthBJJCredentialSchemaType)
+
+	if !filter.IncludeDeleted {
+		query = fmt.Sprintf("%s AND claims_read_model.deleted_at IS NULL ", query)
+	}
 
 	if filter.Self != nil && *filter.Self {
-		query = fmt.Sprintf("%s and other_identifier = '' ", query)
+		query = fmt.Sprintf("%s and claims_read_model.holder_did = '' ", query)
 	}
 	if filter.Subject != "" {
 		filters = append(filters, filter.Subject)
-		query = fmt.Sprintf("%s and other_identifier = $%d ", query, len(filters))
+		query = fmt.Sprintf("%s and claims_read_model.holder_did = $%d ", query, len(filters))
+	}
+	if len(filter.Subjects) > 0 {
+		filters = append(filters, filter.Subjects)
+		query = fmt.Sprintf("%s and claims_read_model.holder_did = ANY($%d) ", query, len(filters))
 	}
 	if filter.SchemaHash != "" {
 		filters = append(filters, fmt.Sprintf("%s%%", filter.SchemaHash))
-		query = fmt.Sprintf("%s and schema_hash like $%d", query, len(filters))
+		query = fmt.Sprintf("%s and claims_read_model.schema_hash like $%d", query, len(filters))
 	}
 	if filter.SchemaType != "" {
 		filters = append(filters, fmt.Sprintf("%%%s%%", filter.SchemaType))
-		query = fmt.Sprintf("%s and schema_type like $%d", query, len(filters))
+		query = fmt.Sprintf("%s and claims_read_model.schema_type like $%d", query, len(filters))
 	}
 	if filter.Revoked != nil {
 		filters = append(filters, *filter.Revoked)
-		query = fmt.Sprintf("%s and claims.revoked = $%d", query, len(filters))
+		query = fmt.Sprintf("%s and claims_read_model.revoked = $%d", query, len(filters))
 	}
 	if filter.QueryField != "" {
 		filters = append(filters, filter.QueryField, filter.QueryFieldValue)
-		query = fmt.Sprintf("%s and data -> 'credentialSubject'  ->>$%d = $%d ", query, len(filters)-1, len(filters))
+		query = fmt.Sprintf("%s and claims_read_model.subject ->> $%d = $%d ", query, len(filters)-1, len(filters))
 	}
 	if filter.ExpiredOn != nil {
 		t := *filter.ExpiredOn
 		filters = append(filters, t.Unix())
-		query = fmt.Sprintf("%s AND claims.expiration>0 AND claims.expiration<$%d", query, len(filters))
+		query = fmt.Sprintf("%s AND claims_read_model.expiration>0 AND claims_read_model.expiration<$%d", query, len(filters))
 	}
 	if len(filter.Proofs) > 0 {
 		for _, proof := range filter.Proofs {
 			switch proof {
 			case verifiable.BJJSignatureProofType:
-				query = fmt.Sprintf("%s AND claims.signature_proof IS NOT NULL", query)
+				query = fmt.Sprintf("%s AND claims_read_model.has_signature_proof", query)
 			case verifiable.Iden3SparseMerkleTreeProofType:
-				query = fmt.Sprintf("%s AND claims.mtp_proof IS NOT NULL", query)
+				query = fmt.Sprintf("%s AND claims_read_model.has_mtp_proof", query)
 			case domain.AnyProofType:
-				query = fmt.Sprintf("%s AND ((claims.mtp = true AND claims.mtp_proof IS NOT NULL) OR claims.signature_proof IS NOT NULL)", query)
+				query = fmt.Sprintf("%s AND ((claims_read_model.mtp AND claims_read_model.has_mtp_proof) OR claims_read_model.has_signature_proof)", query)
 			}
 		}
 	}
+	if filter.Publication != "" {
+		query = fmt.Sprintf("%s AND %s ", query, publicationCondition(filter.Publication))
+	}
 	if filter.FTSQuery != "" {
-		cond := " | "
+		// every field must match, and all the words or any of them
+		search := domain.ParseSearchQuery(filter.FTSQuery)
+		var conds []string
+		conds, filters = claimsFieldsConditions(search.Fields(), filters)
+		operator := "OR"
 		if filter.FTSAndCond {
-			cond = " & "
+			operator = "AND"
+		}
+		var words string
+		if words, filters = claimsWordsCondition(search.Words(), operator, filter.Subject == "", filters); words != "" {
+			conds = append(conds, "("+words+")")
 		}
-		filters = append(filters, fullTextSearchQuery(filter.FTSQuery, cond))
-		ftsConds := fmt.Sprintf("schemas.ts_words @@ to_tsquery($%d)", len(filters))
-		if filter.Subject == "" {
-			ftsConds += fmt.Sprintf("OR %s", buildPartialQueryDidLikes("claims.other_identifier", tokenizeQuery(filter.FTSQuery), "OR"))
+		for _, cond := range conds {
+			query = fmt.Sprintf("%s AND %s ", query, cond)
 		}
-		query = fmt.Sprintf("%s AND (%s) ", query, ftsConds)
+	}
+	if filter.Limit > 0 {
+		filters = append(filters, filter.Limit, filter.Offset)
+		query = fmt.Sprintf("%s ORDER BY claims_read_model.issuance_date DESC NULLS LAST, claims_read_model.id LIMIT $%d OFFSET $%d", query, len(filters)-1, len(filters))
 	}
 	return query, filters
 }
 
+// publicationCondition selects the claims of the read model with the publication status, see
+// domain.NewPublicationStatus
+func publicationCondition(status domain.PublicationStatus) string {
+	switch status {
+	case domain.PublicationNotRequired:
+		return "NOT claims_read_model.mtp"
+	case domain.PublicationPublished:
+		return fmt.Sprintf("claims_read_model.mtp AND claims_read_model.status = '%s'", domain.StatusConfirmed)
+	case domain.PublicationFailed:
+		return fmt.Sprintf("claims_read_model.mtp AND claims_read_model.status = '%s'", domain.StatusFailed)
+	default:
+		return fmt.Sprintf("claims_read_model.mtp AND (claims_read_model.status IS NULL OR claims_read_model.status NOT IN ('%s', '%s'))", domain.StatusConfirmed, domain.StatusFailed)
+	}
+}
+
 func (c *claims) UpdateClaimMTP(ctx context.Context, conn db.Querier, claim *domain.Claim) (int64, error) {
 	query := "UPDATE claims SET mtp_proof = $1 WHERE id = $2 AND identifier = $3"
 	res, err := conn.Exec(ctx, query, claim.MTPProof, claim.ID, claim.Identifier)
@@ -797,7 +1119,8 @@ func (c *claims) GetAuthClaimsForPublishing(ctx context.Context, conn db.Querier
        	credential_status,
        	core_claim,
        	revoked,
-		mtp
+		mtp,
+		claims.deleted_at
 	FROM claims
 	LEFT JOIN identity_states  ON claims.identity_state = identity_states.state
 	LEFT JOIN revocation  ON claims.rev_nonce = revocation.nonce AND claims.issuer = revocation.identifier
@@ -820,6 +1143,41 @@ func (c *claims) GetAuthClaimsForPublishing(ctx context.Context, conn db.Querier
 	return claims, nil
 }
 
+// GetAuthClaimsByIssuerID returns all the auth claims of the identity, revoked or not, the newest last
+func (c *claims) GetAuthClaimsByIssuerID(ctx context.Context, conn db.Querier, identifier core.DID, schemaHash string) ([]*domain.Claim, error) {
+	rows, err := conn.Query(ctx, `SELECT claims.id,
+		issuer,
+		schema_hash,
+		schema_type,
+		schema_url,
+		other_identifier,
+		expiration,
+		updatable,
+		claims.version,
+		rev_nonce,
+		signature_proof,
+		mtp_proof,
+		data,
+		claims.identifier,
+		identity_state,
+		identity_states.status,
+		credential_status,
+		core_claim,
+		revoked,
+		mtp,
+		claims.deleted_at
+	FROM claims
+	LEFT JOIN identity_states ON claims.identity_state = identity_states.state
+	WHERE claims.identifier = $1 AND claims.schema_hash = $2
+	ORDER BY identity_states.state_id NULLS LAST`, identifier.String(), schemaHash)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return processClaims(rows)
+}
+
 func (c *claims) GetClaimsIssuedForUser(ctx context.Context, conn db.Querier, identifier core.DID, userDID core.DID, linkID uuid.UUID) ([]*domain.Claim, error) {
 	query := `SELECT claims.id,
 		   issuer,
@@ -843,6 +1201,7 @@ func (c *claims) GetClaimsIssuedForUser(ctx context.Context, conn db.Querier, id
 		WHERE claims.identifier = $1 
 		AND claims.other_identifier = $2
 		AND claims.link_id = $3
+		AND claims.deleted_at IS NULL
 	`
 	rows, err := conn.Query(ctx, query, identifier.String(), userDID.String(), linkID)
 	if err != nil {
@@ -942,6 +1301,91 @@ func (c *claims) GetByStateIDWithMTPProof(ctx context.Context, conn db.Querier,
 	return claims, nil
 }
 
+// GetExpired returns up to limit claims whose expiration date is before expiredOn and that have not been marked as expired yet.
+// Each claim comes with the auto revocation policy of its schema.
+// Claims of identities that are being migrated or were migrated are skipped, the identity is read only in this node.
+func (c *claims) GetExpired(ctx context.Context, conn db.Querier, expiredOn time.Time, limit int) ([]*domain.ExpiredClaim, error) {
+	query := `SELECT claims.id,
+		   issuer,
+		   schema_hash,
+		   schema_type,
+		   schema_url,
+		   other_identifier,
+		   expiration,
+		   updatable,
+		   claims.version,
+		   rev_nonce,
+		   mtp_proof,
+		   signature_proof,
+		   data,
+		   claims.identifier,
+		   identity_state,
+		   credential_status,
+		   revoked,
+		   core_claim,
+		   EXISTS (SELECT 1 FROM schemas 
+		           WHERE schemas.hash = claims.schema_hash 
+		             AND schemas.issuer_id = claims.issuer 
+		             AND schemas.auto_revoke_on_expiration) AS auto_revoke
+		FROM claims
+		WHERE claims.expired = false
+		AND claims.expiration > 0
+		AND claims.expiration < $1
+		AND claims.schema_type <> $2
+		AND NOT EXISTS (SELECT 1 FROM identity_migrations WHERE identity_migrations.identifier = claims.identifier)
+		ORDER BY claims.expiration
+		LIMIT $3
+	`
+	rows, err := conn.Query(ctx, query, expiredOn.Unix(), domain.AuthBJJCredentialSchemaType, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	claims := make([]*domain.ExpiredClaim, 0)
+	for rows.Next() {
+		var claim domain.ExpiredClaim
+		err := rows.Scan(&claim.ID,
+			&claim.Issuer,
+			&claim.SchemaHash,
+			&claim.SchemaType,
+			&claim.SchemaURL,
+			&claim.OtherIdentifier,
+			&claim.Expiration,
+			&claim.Updatable,
+			&claim.Version,
+			&claim.RevNonce,
+			&claim.MTPProof,
+			&claim.SignatureProof,
+			&claim.Data,
+			&claim.Identifier,
+			&claim.IdentityState,
+			&claim.CredentialStatus,
+			&claim.Revoked,
+			&claim.CoreClaim,
+			&claim.AutoRevoke)
+		if err != nil {
+			return nil, err
+		}
+		claims = append(claims, &claim)
+	}
+
+	return claims, rows.Err()
+}
+
+// MarkAsExpired flags the given claims as expired
+func (c *claims) MarkAsExpired(ctx context.Context, conn db.Querier, ids []uuid.UUID) (int64, error) {
+	strIDs := make([]string, len(ids))
+	for i, id := range ids {
+		strIDs[i] = id.String()
+	}
+	res, err := conn.Exec(ctx, "UPDATE claims SET expired = true WHERE id = ANY($1::uuid[])", strIDs)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected(), nil
+}
+
 func toCredentialDomain(c *dbClaim) *domain.Claim {
 	if c.ID == nil {
 		return nil
@@ -1005,6 +1449,7 @@ func toCredentialDomain(c *dbClaim) *domain.Claim {
 	if c.MtProof.Valid {
 		credential.MtProof = c.MtProof.Bool
 	}
+	credential.DeletedAt = c.DeletedAt
 
 	return credential
 }
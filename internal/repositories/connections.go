@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -27,6 +28,32 @@ type dbConnection struct {
 	UserDoc    pgtype.JSONB
 	CreatedAt  time.Time
 	ModifiedAt time.Time
+	DeletedAt  *time.Time
+	Metadata   dbConnectionMetadata
+}
+
+// dbConnectionMetadata is left joined, all the fields are null for the connections without metadata
+type dbConnectionMetadata struct {
+	DisplayName *string
+	Notes       *string
+	Tags        pgtype.TextArray
+	ModifiedAt  *time.Time
+	Version     *int
+}
+
+// connectionColumns are the columns scanned by dbConnection.dest, to be selected from connectionsWithMetadata
+const connectionColumns = `connections.id, connections.issuer_id, connections.user_id, connections.issuer_doc,
+connections.user_doc, connections.created_at, connections.modified_at, connections.deleted_at,
+connection_metadata.display_name, connection_metadata.notes, connection_metadata.tags, connection_metadata.modified_at,
+connection_metadata.version`
+
+const connectionsWithMetadata = `connections LEFT JOIN connection_metadata ON connection_metadata.connection_id = connections.id`
+
+func (c *dbConnection) dest() []interface{} {
+	return []interface{}{
+		&c.ID, &c.IssuerDID, &c.UserDID, &c.IssuerDoc, &c.UserDoc, &c.CreatedAt, &c.ModifiedAt, &c.DeletedAt,
+		&c.Metadata.DisplayName, &c.Metadata.Notes, &c.Metadata.Tags, &c.Metadata.ModifiedAt, &c.Metadata.Version,
+	}
 }
 
 type dbConnectionWithCredentials struct {
@@ -41,20 +68,22 @@ func NewConnections() ports.ConnectionsRepository {
 	return &connections{}
 }
 
-// Save stores in the database the given connection and updates the modified at in case already exists
+// Save stores in the database the given connection and updates the modified at in case already exists. A deleted
+// connection that was not purged yet is restored.
 func (c *connections) Save(ctx context.Context, conn db.Querier, connection *domain.Connection) (uuid.UUID, error) {
 	var id uuid.UUID
 	sql := `INSERT INTO connections (id,issuer_id, user_id, issuer_doc, user_doc,created_at,modified_at)
 			VALUES($1, $2, $3, $4,$5,$6,$7) ON CONFLICT ON CONSTRAINT connections_issuer_user_key DO
-			UPDATE SET issuer_id=$2, user_id=$3, issuer_doc=$4, user_doc=$5, modified_at = $7
+			UPDATE SET issuer_id=$2, user_id=$3, issuer_doc=$4, user_doc=$5, modified_at = $7, deleted_at = NULL
 			RETURNING id`
 	err := conn.QueryRow(ctx, sql, connection.ID, connection.IssuerDID.String(), connection.UserDID.String(), connection.IssuerDoc, connection.UserDoc, connection.CreatedAt, connection.ModifiedAt).Scan(&id)
 
 	return id, err
 }
 
+// Delete marks the connection as deleted. It is kept until it is purged, so it can be restored.
 func (c *connections) Delete(ctx context.Context, conn db.Querier, id uuid.UUID, issuerDID core.DID) error {
-	sql := `DELETE FROM connections WHERE id = $1 AND issuer_id = $2`
+	sql := `UPDATE connections SET deleted_at = CURRENT_TIMESTAMP WHERE id = $1 AND issuer_id = $2 AND deleted_at IS NULL`
 	cmd, err := conn.Exec(ctx, sql, id.String(), issuerDID.String())
 	if err != nil {
 		return err
@@ -67,27 +96,45 @@ func (c *connections) Delete(ctx context.Context, conn db.Querier, id uuid.UUID,
 	return nil
 }
 
+// DeleteCredentials marks as deleted the claims issued to the user of the connection
 func (c *connections) DeleteCredentials(ctx context.Context, conn db.Querier, id uuid.UUID, issuerID core.DID) error {
-	sql := `DELETE FROM claims USING connections WHERE claims.issuer = connections.issuer_id AND claims.other_identifier = connections.user_id AND connections.id = $1 AND connections.issuer_id = $2`
+	sql := `UPDATE claims SET deleted_at = CURRENT_TIMESTAMP FROM connections WHERE claims.issuer = connections.issuer_id AND claims.other_identifier = connections.user_id AND connections.id = $1 AND connections.issuer_id = $2 AND claims.deleted_at IS NULL`
 	_, err := conn.Exec(ctx, sql, id.String(), issuerID.String())
 
 	return err
 }
 
+// Restore undoes the deletion of a connection of the issuer that was not purged yet. Its credentials are restored
+// one by one.
+func (c *connections) Restore(ctx context.Context, conn db.Querier, id uuid.UUID, issuerDID core.DID) error {
+	sql := `UPDATE connections SET deleted_at = NULL WHERE id = $1 AND issuer_id = $2 AND deleted_at IS NOT NULL`
+	cmd, err := conn.Exec(ctx, sql, id.String(), issuerDID.String())
+	if err != nil {
+		return err
+	}
+
+	if cmd.RowsAffected() == 0 {
+		return ErrConnectionDoesNotExist
+	}
+
+	return nil
+}
+
+// PurgeDeleted removes for good the connections deleted before the given time, with their metadata
+func (c *connections) PurgeDeleted(ctx context.Context, conn db.Querier, before time.Time) (int64, error) {
+	cmd, err := conn.Exec(ctx, `DELETE FROM connections WHERE deleted_at < $1`, before)
+	if err != nil {
+		return 0, err
+	}
+	return cmd.RowsAffected(), nil
+}
+
 func (c *connections) GetByIDAndIssuerID(ctx context.Context, conn db.Querier, id uuid.UUID, issuerID core.DID) (*domain.Connection, error) {
 	connection := dbConnection{}
 	err := conn.QueryRow(ctx,
-		`SELECT id, issuer_id,user_id,issuer_doc,user_doc,created_at,modified_at 
-				FROM connections 
-				WHERE connections.id = $1 AND connections.issuer_id = $2`, id.String(), issuerID.String()).Scan(
-		&connection.ID,
-		&connection.IssuerDID,
-		&connection.UserDID,
-		&connection.IssuerDoc,
-		&connection.UserDoc,
-		&connection.CreatedAt,
-		&connection.ModifiedAt,
-	)
+		`SELECT `+connectionColumns+`
+				FROM `+connectionsWithMetadata+`
+				WHERE connections.id = $1 AND connections.issuer_id = $2 AND connections.deleted_at IS NULL`, id.String(), issuerID.String()).Scan(connection.dest()...)
 	if err != nil {
 		if err == pgx.ErrNoRows {
 			return nil, ErrConnectionDoesNotExist
@@ -101,17 +148,9 @@ func (c *connections) GetByIDAndIssuerID(ctx context.Context, conn db.Querier, i
 func (c *connections) GetByUserID(ctx context.Context, conn db.Querier, issuerDID core.DID, userDID core.DID) (*domain.Connection, error) {
 	connection := dbConnection{}
 	err := conn.QueryRow(ctx,
-		`SELECT id, issuer_id,user_id,issuer_doc,user_doc,created_at,modified_at 
-				FROM connections 
-				WHERE   connections.issuer_id = $1 AND  connections.user_id = $2`, issuerDID.String(), userDID.String()).Scan(
-		&connection.ID,
-		&connection.IssuerDID,
-		&connection.UserDID,
-		&connection.IssuerDoc,
-		&connection.UserDoc,
-		&connection.CreatedAt,
-		&connection.ModifiedAt,
-	)
+		`SELECT `+connectionColumns+`
+				FROM `+connectionsWithMetadata+`
+				WHERE   connections.issuer_id = $1 AND  connections.user_id = $2 AND connections.deleted_at IS NULL`, issuerDID.String(), userDID.String()).Scan(connection.dest()...)
 	if err != nil {
 		if err == pgx.ErrNoRows {
 			return nil, ErrConnectionDoesNotExist
@@ -122,31 +161,17 @@ func (c *connections) GetByUserID(ctx context.Context, conn db.Querier, issuerDI
 	return toConnectionDomain(&connection)
 }
 
-func (c *connections) GetAllByIssuerID(ctx context.Context, conn db.Querier, issuerDID core.DID, query string) ([]*domain.Connection, error) {
-	all := `SELECT id, issuer_id,user_id,issuer_doc,user_doc,created_at,modified_at 
-FROM connections 
-WHERE connections.issuer_id = $1`
-
-	if query != "" {
-		dids := tokenizeQuery(query)
-		if len(dids) > 0 {
-			all += " AND (" + buildPartialQueryDidLikes("connections.user_id", dids, "OR") + ")"
-		}
-	}
-
-	rows, err := conn.Query(ctx, all, issuerDID.String())
+func (c *connections) GetAllByIssuerID(ctx context.Context, conn db.Querier, issuerDID core.DID, filter *ports.ConnectionsFilter) ([]*domain.Connection, error) {
+	sqlQuery, args := buildGetAllConnectionsQuery(issuerDID, filter)
+	rows, err := conn.Query(ctx, sqlQuery, args...)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
 	domainConns := make([]*domain.Connection, 0)
-	dbConn := dbConnection{}
 	for rows.Next() {
-		if err := rows.Scan(&dbConn.ID, &dbConn.IssuerDID, &dbConn.UserDID, &dbConn.IssuerDoc, &dbConn.UserDoc, &dbConn.CreatedAt, &dbConn.ModifiedAt); err != nil {
-			return nil, err
-		}
-		domainConn, err := toConnectionDomain(&dbConn)
+		domainConn, err := scanConnection(rows)
 		if err != nil {
 			return nil, err
 		}
@@ -156,8 +181,109 @@ WHERE connections.issuer_id = $1`
 	return domainConns, nil
 }
 
-func (c *connections) GetAllWithCredentialsByIssuerID(ctx context.Context, conn db.Querier, issuerDID core.DID, query string) ([]*domain.Connection, error) {
-	sqlQuery, filters := buildGetAllWithCredentialsQueryAndFilters(issuerDID, query)
+// IterateByIssuerID calls fn for every connection of the issuer, reading them from a server-side cursor
+func (c *connections) IterateByIssuerID(ctx context.Context, conn db.Querier, issuerDID core.DID, filter *ports.ConnectionsFilter, fn func(*domain.Connection) error) error {
+	sqlQuery, args := buildGetAllConnectionsQuery(issuerDID, filter)
+	return iterate(ctx, conn, sqlQuery, args, func(rows pgx.Rows) error {
+		domainConn, err := scanConnection(rows)
+		if err != nil {
+			return err
+		}
+		return fn(domainConn)
+	})
+}
+
+func buildGetAllConnectionsQuery(issuerDID core.DID, filter *ports.ConnectionsFilter) (string, []interface{}) {
+	all := `SELECT ` + connectionColumns + `
+FROM ` + connectionsWithMetadata + `
+WHERE connections.issuer_id = $1`
+	args := []interface{}{issuerDID.String()}
+
+	if !filter.IncludeDeleted {
+		all += " AND connections.deleted_at IS NULL"
+	}
+	if filter.Query != "" {
+		var conds []string
+		conds, args = connectionsSearchConditions(domain.ParseSearchQuery(filter.Query), false, filter.IncludeDeleted, args)
+		for _, cond := range conds {
+			all += " AND " + cond
+		}
+	}
+	if len(filter.Tags) > 0 {
+		args = append(args, filter.Tags)
+		all += fmt.Sprintf(" AND connection_metadata.tags @> $%d", len(args))
+	}
+	return all, args
+}
+
+// connectionsSearchConditions returns the conditions of a search on the connections, and the arguments they use after
+// the ones in args. The connections match any of the words, in their DID, their display name or, with schemaWords, the
+// words of the schemas of the credentials joined, and have a credential that matches all the fields.
+func connectionsSearchConditions(search domain.SearchQuery, schemaWords bool, includeDeleted bool, args []interface{}) ([]string, []interface{}) {
+	var conds []string
+	words := make([]string, 0, len(search.Words()))
+	for _, word := range search.Words() {
+		args = append(args, likeContains(word.Value))
+		wordConds := []string{
+			fmt.Sprintf("connections.user_id ILIKE $%d", len(args)),
+			fmt.Sprintf("connection_metadata.display_name ILIKE $%d", len(args)),
+		}
+		if tsQuery := fullTextSearchQuery(word.Value, " & "); schemaWords && tsQuery != "" && !word.IsDID() {
+			args = append(args, tsQuery)
+			wordConds = append(wordConds, fmt.Sprintf("schemas.ts_words @@ to_tsquery($%d)", len(args)))
+		}
+		words = append(words, strings.Join(wordConds, " OR "))
+	}
+	if len(words) > 0 {
+		conds = append(conds, "("+strings.Join(words, " OR ")+")")
+	}
+
+	if fields := search.Fields(); len(fields) > 0 {
+		var fieldConds []string
+		fieldConds, args = claimsFieldsConditions(fields, args)
+		if !includeDeleted {
+			fieldConds = append(fieldConds, "claims_read_model.deleted_at IS NULL")
+		}
+		conds = append(conds, `EXISTS (SELECT 1 FROM claims_read_model
+			WHERE claims_read_model.identifier = connections.issuer_id AND claims_read_model.holder_did = connections.user_id
+			AND `+strings.Join(fieldConds, " AND ")+")")
+	}
+	return conds, args
+}
+
+func scanConnection(rows pgx.Rows) (*domain.Connection, error) {
+	dbConn := dbConnection{}
+	if err := rows.Scan(dbConn.dest()...); err != nil {
+		return nil, err
+	}
+	return toConnectionDomain(&dbConn)
+}
+
+// SaveMetadata inserts or replaces the metadata of the connection if its version is the one of the metadata, 0 for
+// a connection without metadata, and sets the new version in the metadata
+func (c *connections) SaveMetadata(ctx context.Context, conn db.Querier, connectionID uuid.UUID, metadata *domain.ConnectionMetadata) error {
+	var version int
+	err := conn.QueryRow(ctx, `
+		INSERT INTO connection_metadata (connection_id, display_name, notes, tags, modified_at, version)
+		VALUES ($1, $2, $3, $4, $5, 1)
+		ON CONFLICT (connection_id) DO UPDATE
+		SET display_name = $2, notes = $3, tags = $4, modified_at = $5, version = connection_metadata.version + 1
+		WHERE connection_metadata.version = $6
+		RETURNING version`,
+		connectionID, metadata.DisplayName, metadata.Notes, metadata.Tags, metadata.ModifiedAt, metadata.Version).Scan(&version)
+	// the conflict update is skipped when the metadata was updated after it was read
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrVersionConflict
+	}
+	if err != nil {
+		return err
+	}
+	metadata.Version = version
+	return nil
+}
+
+func (c *connections) GetAllWithCredentialsByIssuerID(ctx context.Context, conn db.Querier, issuerDID core.DID, filter *ports.ConnectionsFilter) ([]*domain.Connection, error) {
+	sqlQuery, filters := buildGetAllWithCredentialsQueryAndFilters(issuerDID, filter)
 	rows, err := conn.Query(ctx, sqlQuery, filters...)
 	if err != nil {
 		return nil, err
@@ -167,14 +293,9 @@ func (c *connections) GetAllWithCredentialsByIssuerID(ctx context.Context, conn
 	return toConnectionsWithCredentials(rows)
 }
 
-func buildGetAllWithCredentialsQueryAndFilters(issuerDID core.DID, query string) (string, []interface{}) {
-	sqlQuery := `SELECT connections.id, 
-       			   connections.issuer_id,
-       			   connections.user_id,
-       			   connections.issuer_doc,
-       			   connections.user_doc,
-       			   connections.created_at,
-       			   connections.modified_at,
+func buildGetAllWithCredentialsQueryAndFilters(issuerDID core.DID, filter *ports.ConnectionsFilter) (string, []interface{}) {
+	query := filter.Query
+	sqlQuery := `SELECT ` + connectionColumns + `,
 				   claims.id,
 				   claims.issuer,
 				   claims.schema_hash,
@@ -193,10 +314,16 @@ func buildGetAllWithCredentialsQueryAndFilters(issuerDID core.DID, query string)
 				   identity_states.status,
 				   claims.credential_status,
 				   claims.core_claim,
-				   claims.mtp
-	FROM connections 
+				   claims.mtp,
+				   claims.deleted_at
+	FROM ` + connectionsWithMetadata + `
 	LEFT JOIN claims
-	ON connections.issuer_id = claims.issuer AND connections.user_id = claims.other_identifier
+	ON connections.issuer_id = claims.issuer AND connections.user_id = claims.other_identifier`
+
+	if !filter.IncludeDeleted {
+		sqlQuery += " AND claims.deleted_at IS NULL"
+	}
+	sqlQuery += `
 	LEFT JOIN identity_states  ON claims.identity_state = identity_states.state`
 
 	if query != "" {
@@ -206,15 +333,19 @@ func buildGetAllWithCredentialsQueryAndFilters(issuerDID core.DID, query string)
 	filters := []interface{}{issuerDID.String()}
 
 	sqlQuery = fmt.Sprintf("%s WHERE connections.issuer_id = $%d", sqlQuery, len(filters))
+	if !filter.IncludeDeleted {
+		sqlQuery += " AND connections.deleted_at IS NULL"
+	}
 	if query != "" {
-		filters = append(filters, fullTextSearchQuery(query, " | "))
-		ftsConds := fmt.Sprintf("(schemas.ts_words @@ to_tsquery($%d))", len(filters))
-
-		dids := tokenizeQuery(query)
-		if len(dids) > 0 {
-			ftsConds += " OR " + buildPartialQueryDidLikes("connections.user_id", dids, "OR")
+		var conds []string
+		conds, filters = connectionsSearchConditions(domain.ParseSearchQuery(query), true, filter.IncludeDeleted, filters)
+		for _, cond := range conds {
+			sqlQuery += fmt.Sprintf(" AND %s ", cond)
 		}
-		sqlQuery += fmt.Sprintf(" AND (%s) ", ftsConds)
+	}
+	if len(filter.Tags) > 0 {
+		filters = append(filters, filter.Tags)
+		sqlQuery += fmt.Sprintf(" AND connection_metadata.tags @> $%d ", len(filters))
 	}
 
 	sqlQuery += " ORDER BY connections.id DESC"
@@ -227,14 +358,7 @@ func toConnectionsWithCredentials(rows pgx.Rows) ([]*domain.Connection, error) {
 
 	for rows.Next() {
 		var dbConn dbConnectionWithCredentials
-		err := rows.Scan(
-			&dbConn.dbConnection.ID,
-			&dbConn.IssuerDID,
-			&dbConn.UserDID,
-			&dbConn.IssuerDoc,
-			&dbConn.UserDoc,
-			&dbConn.CreatedAt,
-			&dbConn.ModifiedAt,
+		err := rows.Scan(append(dbConn.dbConnection.dest(),
 			&dbConn.dbClaim.ID,
 			&dbConn.Issuer,
 			&dbConn.SchemaHash,
@@ -253,7 +377,8 @@ func toConnectionsWithCredentials(rows pgx.Rows) ([]*domain.Connection, error) {
 			&dbConn.Status,
 			&dbConn.CredentialStatus,
 			&dbConn.CoreClaim,
-			&dbConn.MtProof)
+			&dbConn.MtProof,
+			&dbConn.dbClaim.DeletedAt)...)
 		if err != nil {
 			return nil, err
 		}
@@ -307,6 +432,7 @@ func toConnectionDomain(c *dbConnection) (*domain.Connection, error) {
 		UserDID:    *usrDID,
 		CreatedAt:  c.CreatedAt,
 		ModifiedAt: c.ModifiedAt,
+		DeletedAt:  c.DeletedAt,
 	}
 
 	if err := c.UserDoc.AssignTo(&conn.UserDoc); err != nil {
@@ -317,5 +443,20 @@ func toConnectionDomain(c *dbConnection) (*domain.Connection, error) {
 		return nil, fmt.Errorf("parsing user IssuerDoc from connection: %w", err)
 	}
 
+	if c.Metadata.ModifiedAt != nil {
+		conn.Metadata = &domain.ConnectionMetadata{
+			DisplayName: *c.Metadata.DisplayName,
+			Notes:       *c.Metadata.Notes,
+			Tags:        make([]string, 0, len(c.Metadata.Tags.Elements)),
+			ModifiedAt:  *c.Metadata.ModifiedAt,
+		}
+		if c.Metadata.Version != nil {
+			conn.Metadata.Version = *c.Metadata.Version
+		}
+		if err := c.Metadata.Tags.AssignTo(&conn.Metadata.Tags); err != nil {
+			return nil, fmt.Errorf("parsing tags from connection: %w", err)
+		}
+	}
+
 	return conn, nil
 }
@@ -0,0 +1,72 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+type credentialHistory struct{}
+
+// NewCredentialHistory returns a new credential history repository
+func NewCredentialHistory() ports.CredentialHistoryRepository {
+	return &credentialHistory{}
+}
+
+// GetByCredentialID returns the events of the credential of the issuer, oldest first: its issuance, the offers and
+// their fetches, the inclusion in a state and its publication, and the revocation and its publication. The history
+// is built from the credential, its offers, its state and its revocation, so it has no events of a credential that
+// does not exist.
+func (r *credentialHistory) GetByCredentialID(ctx context.Context, conn db.Querier, issuerDID core.DID, id uuid.UUID) ([]domain.CredentialEvent, error) {
+	rows, err := conn.Query(ctx, `
+		WITH credential AS (
+			SELECT id, identifier, rev_nonce, identity_state, (data->>'issuanceDate')::timestamptz AS issued_at
+			FROM claims
+			WHERE id = $1 AND identifier = $2
+		)
+		SELECT event_type, time, offer_id, state, tx_id, reason, description FROM (
+		SELECT 1 AS step, 'issued' AS event_type, issued_at AS time, NULL::uuid AS offer_id, NULL AS state, NULL AS tx_id, NULL AS reason, NULL AS description
+		FROM credential WHERE issued_at IS NOT NULL
+		UNION ALL
+		SELECT 2, 'offered', o.created_at, o.id, NULL, NULL, NULL, NULL
+		FROM credential c JOIN credential_offers o ON o.claim_id = c.id AND o.issuer_id = c.identifier
+		UNION ALL
+		SELECT 3, 'offer.fetched', o.consumed_at, o.id, NULL, NULL, NULL, NULL
+		FROM credential c JOIN credential_offers o ON o.claim_id = c.id AND o.issuer_id = c.identifier
+		WHERE o.consumed_at IS NOT NULL
+		UNION ALL
+		SELECT 4, 'state.included', s.created_at, NULL, s.state, NULL, NULL, NULL
+		FROM credential c JOIN identity_states s ON s.identifier = c.identifier AND s.state = c.identity_state
+		UNION ALL
+		SELECT 5, 'state.published', COALESCE(to_timestamp(s.block_timestamp), s.modified_at), NULL, s.state, s.tx_id, NULL, NULL
+		FROM credential c JOIN identity_states s ON s.identifier = c.identifier AND s.state = c.identity_state
+		WHERE s.status = 'confirmed'
+		UNION ALL
+		SELECT 6, 'revoked', r.created_at, NULL, NULL, NULL, r.reason, r.description
+		FROM credential c JOIN revocation r ON r.identifier = c.identifier AND r.nonce = c.rev_nonce
+		UNION ALL
+		SELECT 7, 'revocation.published', r.modified_at, NULL, NULL, NULL, NULL, NULL
+		FROM credential c JOIN revocation r ON r.identifier = c.identifier AND r.nonce = c.rev_nonce
+		WHERE r.status = $3
+		) AS events
+		ORDER BY time, step`, id, issuerDID.String(), domain.RevPublished)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	events := make([]domain.CredentialEvent, 0)
+	for rows.Next() {
+		var event domain.CredentialEvent
+		if err := rows.Scan(&event.Type, &event.Time, &event.OfferID, &event.State, &event.TxID, &event.Reason, &event.Description); err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, rows.Err()
+}
@@ -0,0 +1,72 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+var (
+	// ErrCredentialOfferNotFound the credential was not offered in the given offer
+	ErrCredentialOfferNotFound = errors.New("credential offer not found")
+	// ErrCredentialOfferExpired the credential offer expired
+	ErrCredentialOfferExpired = errors.New("credential offer expired")
+	// ErrCredentialOfferConsumed the credential was already fetched with the given offer
+	ErrCredentialOfferConsumed = errors.New("credential offer already used")
+)
+
+type credentialOffers struct{}
+
+// NewCredentialOffer returns a new credential offers repository
+func NewCredentialOffer() ports.CredentialOfferRepository {
+	return &credentialOffers{}
+}
+
+// Save stores one row per offered credential
+func (r *credentialOffers) Save(ctx context.Context, conn db.Querier, offer *domain.CredentialOffer) error {
+	const sql = `INSERT INTO credential_offers (id, claim_id, issuer_id, expires_at, created_at) VALUES ($1, $2, $3, $4, $5)`
+	for _, credentialID := range offer.CredentialIDs {
+		if _, err := conn.Exec(ctx, sql, offer.ID, credentialID, offer.IssuerDID.String(), offer.ExpiresAt, offer.CreatedAt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Consume marks the credential offer as used. It fails if the offer does not exist, it is expired or was already used.
+func (r *credentialOffers) Consume(ctx context.Context, conn db.Querier, issuerDID core.DID, offerID uuid.UUID, credentialID uuid.UUID, at time.Time) error {
+	cmd, err := conn.Exec(ctx, `
+		UPDATE credential_offers SET consumed_at = $4
+		WHERE id = $1 AND claim_id = $2 AND issuer_id = $3 AND consumed_at IS NULL AND expires_at > $4`,
+		offerID, credentialID, issuerDID.String(), at)
+	if err != nil {
+		return err
+	}
+	if cmd.RowsAffected() > 0 {
+		return nil
+	}
+
+	// Nothing was updated, find out why
+	var expiresAt time.Time
+	var consumedAt *time.Time
+	err = conn.QueryRow(ctx, `SELECT expires_at, consumed_at FROM credential_offers WHERE id = $1 AND claim_id = $2 AND issuer_id = $3`,
+		offerID, credentialID, issuerDID.String()).Scan(&expiresAt, &consumedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrCredentialOfferNotFound
+		}
+		return err
+	}
+	if consumedAt != nil {
+		return ErrCredentialOfferConsumed
+	}
+	return ErrCredentialOfferExpired
+}
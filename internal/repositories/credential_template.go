@@ -0,0 +1,124 @@
+package repositories
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+const credentialTemplatesNameKey = "credential_templates_issuer_id_name_key"
+
+var (
+	// ErrCredentialTemplateNotFound the credential template does not exist
+	ErrCredentialTemplateNotFound = errors.New("credential template not found")
+	// ErrCredentialTemplateDuplicated the issuer already has a credential template with the same name
+	ErrCredentialTemplateDuplicated = errors.New("credential template name already in use")
+)
+
+type credentialTemplates struct{}
+
+// NewCredentialTemplate returns a new credential templates repository
+func NewCredentialTemplate() ports.CredentialTemplateRepository {
+	return &credentialTemplates{}
+}
+
+// Save inserts the credential template
+func (r *credentialTemplates) Save(ctx context.Context, conn db.Querier, template *domain.CredentialTemplate) error {
+	credentialSubject := pgtype.JSONB{}
+	if err := credentialSubject.Set(template.CredentialSubject); err != nil {
+		return fmt.Errorf("cannot set credential subject values: %w", err)
+	}
+
+	_, err := conn.Exec(ctx, `
+		INSERT INTO credential_templates (id, issuer_id, name, schema_id, credential_subject, expiration_days, signature_proof, mtp_proof, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+		template.ID, template.IssuerDID.String(), template.Name, template.SchemaID, credentialSubject, template.ExpirationDays,
+		template.SignatureProof, template.MTProof, template.CreatedAt)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.ConstraintName == credentialTemplatesNameKey {
+		return ErrCredentialTemplateDuplicated
+	}
+	return err
+}
+
+// GetByID returns the credential template of the issuer
+func (r *credentialTemplates) GetByID(ctx context.Context, conn db.Querier, issuerDID core.DID, id uuid.UUID) (*domain.CredentialTemplate, error) {
+	row := conn.QueryRow(ctx, `
+		SELECT id, issuer_id, name, schema_id, credential_subject, expiration_days, signature_proof, mtp_proof, created_at
+		FROM credential_templates
+		WHERE id = $1 AND issuer_id = $2`, id, issuerDID.String())
+	template, err := scanCredentialTemplate(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrCredentialTemplateNotFound
+	}
+	return template, err
+}
+
+// GetAll returns the credential templates of the issuer sorted by name
+func (r *credentialTemplates) GetAll(ctx context.Context, conn db.Querier, issuerDID core.DID) ([]*domain.CredentialTemplate, error) {
+	rows, err := conn.Query(ctx, `
+		SELECT id, issuer_id, name, schema_id, credential_subject, expiration_days, signature_proof, mtp_proof, created_at
+		FROM credential_templates
+		WHERE issuer_id = $1
+		ORDER BY name`, issuerDID.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	templates := make([]*domain.CredentialTemplate, 0)
+	for rows.Next() {
+		template, err := scanCredentialTemplate(rows)
+		if err != nil {
+			return nil, err
+		}
+		templates = append(templates, template)
+	}
+	return templates, rows.Err()
+}
+
+// Delete removes the credential template of the issuer
+func (r *credentialTemplates) Delete(ctx context.Context, conn db.Querier, issuerDID core.DID, id uuid.UUID) error {
+	cmd, err := conn.Exec(ctx, `DELETE FROM credential_templates WHERE id = $1 AND issuer_id = $2`, id, issuerDID.String())
+	if err != nil {
+		return err
+	}
+	if cmd.RowsAffected() == 0 {
+		return ErrCredentialTemplateNotFound
+	}
+	return nil
+}
+
+func scanCredentialTemplate(row pgx.Row) (*domain.CredentialTemplate, error) {
+	var template domain.CredentialTemplate
+	var issuerDID string
+	var credentialSubject pgtype.JSONB
+	if err := row.Scan(&template.ID, &issuerDID, &template.Name, &template.SchemaID, &credentialSubject, &template.ExpirationDays,
+		&template.SignatureProof, &template.MTProof, &template.CreatedAt); err != nil {
+		return nil, err
+	}
+	did, err := core.ParseDID(issuerDID)
+	if err != nil {
+		return nil, err
+	}
+	template.IssuerDID = *did
+
+	d := json.NewDecoder(bytes.NewReader(credentialSubject.Bytes))
+	d.UseNumber()
+	if err := d.Decode(&template.CredentialSubject); err != nil {
+		return nil, fmt.Errorf("parsing credential subject: %w", err)
+	}
+	return &template, nil
+}
@@ -0,0 +1,53 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+const (
+	cursorName      = "stream_cursor"
+	cursorBatchSize = 500 // cursorBatchSize is the number of rows fetched from the cursor on every round trip
+)
+
+// iterate runs the query in a server-side cursor and calls scan for every row, fetching cursorBatchSize rows at a
+// time. Rows are only fetched after the previous batch has been consumed, so a slow consumer slows down the
+// database reads instead of piling up rows in memory.
+func iterate(ctx context.Context, conn db.Querier, query string, args []interface{}, scan func(rows pgx.Rows) error) error {
+	return conn.BeginFunc(ctx, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, fmt.Sprintf("DECLARE %s NO SCROLL CURSOR FOR %s", cursorName, query), args...); err != nil {
+			return err
+		}
+		fetch := fmt.Sprintf("FETCH FORWARD %d FROM %s", cursorBatchSize, cursorName)
+		for {
+			n, err := fetchBatch(ctx, tx, fetch, scan)
+			if err != nil {
+				return err
+			}
+			if n < cursorBatchSize {
+				return nil
+			}
+		}
+	})
+}
+
+func fetchBatch(ctx context.Context, tx pgx.Tx, fetch string, scan func(rows pgx.Rows) error) (int, error) {
+	rows, err := tx.Query(ctx, fetch)
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	n := 0
+	for rows.Next() {
+		if err := scan(rows); err != nil {
+			return n, err
+		}
+		n++
+	}
+	return n, rows.Err()
+}
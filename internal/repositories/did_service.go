@@ -0,0 +1,79 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	core "github.com/iden3/go-iden3-core"
+	"github.com/jackc/pgconn"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+const didServicesIdentifierFKey = "did_services_identifier_fkey"
+
+var (
+	// ErrDIDServiceNotFound the identity has no service entry with that id
+	ErrDIDServiceNotFound = errors.New("did service not found")
+	// ErrDIDServiceIdentityNotFound the identity of the service entry does not exist
+	ErrDIDServiceIdentityNotFound = errors.New("identity not found")
+)
+
+type didServices struct{}
+
+// NewDIDService returns a new repository of the service entries of the DID documents
+func NewDIDService() ports.DIDServiceRepository {
+	return &didServices{}
+}
+
+// Save inserts the service entry of the identity or replaces the one with the same id
+func (r *didServices) Save(ctx context.Context, conn db.Querier, service *domain.DIDService) error {
+	err := conn.QueryRow(ctx, `
+		INSERT INTO did_services (identifier, service_id, type, service_endpoint, updated_at)
+		VALUES ($1, $2, $3, $4, CURRENT_TIMESTAMP)
+		ON CONFLICT (identifier, service_id) DO UPDATE SET type = $3, service_endpoint = $4, updated_at = CURRENT_TIMESTAMP
+		RETURNING updated_at`,
+		service.Identifier, service.ID, service.Type, service.ServiceEndpoint).Scan(&service.UpdatedAt)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.ConstraintName == didServicesIdentifierFKey {
+		return ErrDIDServiceIdentityNotFound
+	}
+	return err
+}
+
+// Delete removes the service entry of the identity
+func (r *didServices) Delete(ctx context.Context, conn db.Querier, identifier core.DID, id string) error {
+	tag, err := conn.Exec(ctx, `DELETE FROM did_services WHERE identifier = $1 AND service_id = $2`, identifier.String(), id)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrDIDServiceNotFound
+	}
+	return nil
+}
+
+// GetByIdentifier returns the service entries of the identity
+func (r *didServices) GetByIdentifier(ctx context.Context, conn db.Querier, identifier core.DID) ([]*domain.DIDService, error) {
+	rows, err := conn.Query(ctx, `
+		SELECT identifier, service_id, type, service_endpoint, updated_at
+		FROM did_services
+		WHERE identifier = $1
+		ORDER BY service_id`, identifier.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	services := make([]*domain.DIDService, 0)
+	for rows.Next() {
+		service := &domain.DIDService{Overridden: true}
+		if err := rows.Scan(&service.Identifier, &service.ID, &service.Type, &service.ServiceEndpoint, &service.UpdatedAt); err != nil {
+			return nil, err
+		}
+		services = append(services, service)
+	}
+	return services, rows.Err()
+}
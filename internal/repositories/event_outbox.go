@@ -0,0 +1,79 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+type eventOutbox struct{}
+
+// NewEventOutbox returns a new repository of the outbox of the events sent to the broker and the pubsub
+func NewEventOutbox() ports.EventOutboxRepository {
+	return &eventOutbox{}
+}
+
+// Add writes an event in the outbox. It must be called with the transaction of the change that caused the event, so
+// the event is only delivered if the change is committed.
+func (r *eventOutbox) Add(ctx context.Context, conn db.Querier, event *domain.OutboxEvent) error {
+	return conn.QueryRow(ctx, `
+		INSERT INTO event_outbox (destination, event_type, issuer_id, entity_id, payload)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at`, event.Destination, event.Type, event.IssuerDID, event.EntityID, event.Payload).Scan(&event.ID, &event.CreatedAt)
+}
+
+// GetPending returns the oldest events of the given destinations that were not sent yet. The events are locked until
+// the end of the transaction of conn, and the events locked by other transactions are skipped, so several relays
+// never send the same events at once.
+func (r *eventOutbox) GetPending(ctx context.Context, conn db.Querier, destinations []string, limit int) ([]domain.OutboxEvent, error) {
+	rows, err := conn.Query(ctx, `
+		SELECT id, destination, event_type, issuer_id, entity_id, payload, attempts, created_at
+		FROM event_outbox
+		WHERE sent_at IS NULL AND destination = ANY($1)
+		ORDER BY id
+		LIMIT $2
+		FOR UPDATE SKIP LOCKED`, destinations, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	events := make([]domain.OutboxEvent, 0)
+	for rows.Next() {
+		var event domain.OutboxEvent
+		if err := rows.Scan(&event.ID, &event.Destination, &event.Type, &event.IssuerDID, &event.EntityID, &event.Payload, &event.Attempts, &event.CreatedAt); err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, rows.Err()
+}
+
+// MarkSent records that the events were delivered
+func (r *eventOutbox) MarkSent(ctx context.Context, conn db.Querier, ids []int64) error {
+	_, err := conn.Exec(ctx, `
+		UPDATE event_outbox SET sent_at = CURRENT_TIMESTAMP, attempts = attempts + 1, last_error = NULL
+		WHERE id = ANY($1)`, ids)
+	return err
+}
+
+// MarkFailed records a failed attempt to send the events. They are sent again by the next relay.
+func (r *eventOutbox) MarkFailed(ctx context.Context, conn db.Querier, ids []int64, reason string) error {
+	_, err := conn.Exec(ctx, `UPDATE event_outbox SET attempts = attempts + 1, last_error = $2 WHERE id = ANY($1)`, ids, reason)
+	return err
+}
+
+// DeleteBefore deletes the sent events created before the given time, and the pending ones too if their destination
+// is one of pendingDestinations. It returns the number of deleted events.
+func (r *eventOutbox) DeleteBefore(ctx context.Context, conn db.Querier, before time.Time, pendingDestinations []string) (int64, error) {
+	tag, err := conn.Exec(ctx, `
+		DELETE FROM event_outbox
+		WHERE created_at < $1 AND (sent_at IS NOT NULL OR destination = ANY($2))`, before, pendingDestinations)
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
@@ -0,0 +1,69 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	core "github.com/iden3/go-iden3-core"
+	"github.com/jackc/pgconn"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+const featureFlagsIdentifierFKey = "feature_flags_identifier_fkey"
+
+// ErrFeatureFlagIdentityNotFound the identity of the feature flag does not exist
+var ErrFeatureFlagIdentityNotFound = errors.New("identity not found")
+
+type featureFlags struct{}
+
+// NewFeatureFlag returns a new feature flag overrides repository
+func NewFeatureFlag() ports.FeatureFlagRepository {
+	return &featureFlags{}
+}
+
+// Save inserts the override of the feature for the identity or updates its value
+func (r *featureFlags) Save(ctx context.Context, conn db.Querier, flag *domain.FeatureFlag) error {
+	err := conn.QueryRow(ctx, `
+		INSERT INTO feature_flags (identifier, feature, enabled, updated_at)
+		VALUES ($1, $2, $3, CURRENT_TIMESTAMP)
+		ON CONFLICT (identifier, feature) DO UPDATE SET enabled = $3, updated_at = CURRENT_TIMESTAMP
+		RETURNING updated_at`,
+		flag.Identifier, flag.Feature, flag.Enabled).Scan(&flag.UpdatedAt)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.ConstraintName == featureFlagsIdentifierFKey {
+		return ErrFeatureFlagIdentityNotFound
+	}
+	return err
+}
+
+// Delete removes the override of the feature for the identity. It is not an error if there is no override.
+func (r *featureFlags) Delete(ctx context.Context, conn db.Querier, identifier core.DID, feature domain.Feature) error {
+	_, err := conn.Exec(ctx, `DELETE FROM feature_flags WHERE identifier = $1 AND feature = $2`, identifier.String(), feature)
+	return err
+}
+
+// GetByIdentifier returns the overrides of the identity
+func (r *featureFlags) GetByIdentifier(ctx context.Context, conn db.Querier, identifier core.DID) ([]*domain.FeatureFlag, error) {
+	rows, err := conn.Query(ctx, `
+		SELECT identifier, feature, enabled, updated_at
+		FROM feature_flags
+		WHERE identifier = $1
+		ORDER BY feature`, identifier.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	flags := make([]*domain.FeatureFlag, 0)
+	for rows.Next() {
+		flag := &domain.FeatureFlag{Overridden: true}
+		if err := rows.Scan(&flag.Identifier, &flag.Feature, &flag.Enabled, &flag.UpdatedAt); err != nil {
+			return nil, err
+		}
+		flags = append(flags, flag)
+	}
+	return flags, rows.Err()
+}
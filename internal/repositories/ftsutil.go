@@ -4,11 +4,13 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
 )
 
 var (
 	onlyLettersAndNumbers = regexp.MustCompile(`[^a-zA-Z0-9 ]+`)
-	didCharacters         = regexp.MustCompile(`[^a-zA-Z0-9:]+`)
+	likeEscaper           = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
 )
 
 // fullTextSearchQuery accepts a query with a list of words and returns a tsquery that includes words that
@@ -28,18 +30,6 @@ func fullTextSearchQuery(query string, operator string) string {
 	return strings.Join(terms, operator)
 }
 
-func tokenizeQuery(query string) []string {
-	words := strings.Split(strings.ReplaceAll(query, ",", " "), " ")
-	terms := make([]string, 0, len(words))
-	for _, word := range words {
-		word = strings.TrimSpace(word)
-		if word != "" && !inArray(word, terms) {
-			terms = append(terms, word)
-		}
-	}
-	return terms
-}
-
 // getDIDFromQuery searches for words that begin with "did:" and returns the first occurrence. Empty string otherwise
 func getDIDFromQuery(query string) string {
 	words := strings.Split(strings.ReplaceAll(query, ",", " "), " ")
@@ -51,30 +41,65 @@ func getDIDFromQuery(query string) string {
 	return ""
 }
 
-// buildPartialQueryDidLikes accepts a list of words and returns a SQL LIKE sentence to match this words against a field.
-// Example:
-// field:= dbfield; words := []string{"word1", "word2"}; operator := "OR"
-//
-// returns "dbfield ILIKE '%word1%' OR did ILIKE '%word2%'"
-func buildPartialQueryDidLikes(field string, words []string, cond string) string {
-	conditions := make([]string, 0, len(words))
+// likeContains returns the LIKE pattern of the values that contain s
+func likeContains(s string) string {
+	return "%" + likeEscape(s) + "%"
+}
+
+// likeEscape escapes the wildcards of LIKE in s
+func likeEscape(s string) string {
+	return likeEscaper.Replace(s)
+}
+
+// claimsWordsCondition returns the condition of the words of a search on the claims_read_model columns, and the
+// arguments it uses after the ones in args. A word is searched in the words of the schema and the subject of the
+// credential and, with holderDID, in the DID of its holder, where the DIDs are searched too. The words are joined
+// by operator, AND or OR. It returns an empty condition if there is nothing to search.
+func claimsWordsCondition(words []domain.SearchTerm, operator string, holderDID bool, args []interface{}) (string, []interface{}) {
+	conds := make([]string, 0, len(words))
 	for _, word := range words {
-		if word != "" {
-			conditions = append(conditions, fmt.Sprintf("%s ILIKE '%%%s%%'", field, escapeDID(word)))
+		wordConds := make([]string, 0, 3)
+		if tsQuery := fullTextSearchQuery(word.Value, " & "); tsQuery != "" && !word.IsDID() {
+			args = append(args, tsQuery)
+			wordConds = append(wordConds,
+				fmt.Sprintf("claims_read_model.ts_words @@ to_tsquery($%d)", len(args)),
+				fmt.Sprintf("claims_read_model.subject_words @@ to_tsquery('simple', $%d)", len(args)))
+		}
+		if holderDID {
+			args = append(args, likeContains(word.Value))
+			wordConds = append(wordConds, fmt.Sprintf("claims_read_model.holder_did ILIKE $%d", len(args)))
+		}
+		if len(wordConds) > 0 {
+			conds = append(conds, "("+strings.Join(wordConds, " OR ")+")")
 		}
 	}
-	return strings.Join(conditions, " "+cond+" ")
+	return strings.Join(conds, " "+operator+" "), args
 }
 
-func escapeDID(s string) string {
-	return didCharacters.ReplaceAllString(s, "")
-}
-
-func inArray(needle string, haystack []string) bool {
-	for _, word := range haystack {
-		if needle == word {
-			return true
+// claimsFieldsConditions returns the conditions of the fields of a search on the claims_read_model columns, and the
+// arguments they use after the ones in args. The schema field is compared with the schema type and the rest with the
+// fields of the credential subject, ignoring the case.
+func claimsFieldsConditions(fields []domain.SearchTerm, args []interface{}) ([]string, []interface{}) {
+	conds := make([]string, 0, len(fields))
+	for _, field := range fields {
+		if field.Field == domain.SearchFieldSchema {
+			pattern := likeEscape(field.Value)
+			if field.Prefix {
+				pattern += "%"
+			}
+			args = append(args, pattern)
+			conds = append(conds, fmt.Sprintf("claims_read_model.schema_type ILIKE $%d", len(args)))
+			continue
+		}
+		// subject_fields has a field=value line for every field of the subject, see claims_subject_fields
+		pattern := "%\n" + likeEscape(strings.ToLower(field.Field+"="+field.Value))
+		if field.Prefix {
+			pattern += "%"
+		} else {
+			pattern += "\n%"
 		}
+		args = append(args, pattern)
+		conds = append(conds, fmt.Sprintf("claims_read_model.subject_fields LIKE $%d", len(args)))
 	}
-	return false
+	return conds, args
 }
@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
 )
 
 func TestFullTextSearchQuery(t *testing.T) {
@@ -76,111 +78,29 @@ func TestGetDIDFromQuery(t *testing.T) {
 	}
 }
 
-func TestTokenizeQuery(t *testing.T) {
-	type testConfig struct {
-		name   string
-		input  string
-		expect []string
-	}
-	for _, tc := range []testConfig{
-		{
-			name:   "empty string",
-			input:  "",
-			expect: []string{},
-		},
-		{
-			name:   "one word",
-			input:  "word",
-			expect: []string{"word"},
-		},
-		{
-			name:   "one word with spaces",
-			input:  "    word   ",
-			expect: []string{"word"},
-		},
-		{
-			name:   "some  words with spaces",
-			input:  "    word1   word2 word3",
-			expect: []string{"word1", "word2", "word3"},
-		},
-		{
-			name:   "some  words with spaces and commas",
-			input:  "word1,    word2, word3",
-			expect: []string{"word1", "word2", "word3"},
-		},
-		{
-			name:   "repeated words are filtered",
-			input:  "one two three one two three",
-			expect: []string{"one", "two", "three"},
-		},
-	} {
-		t.Run(tc.name, func(t *testing.T) {
-			assert.Equal(t, tc.expect, tokenizeQuery(tc.input))
-		})
-	}
+func TestClaimsWordsCondition(t *testing.T) {
+	search := domain.ParseSearchQuery("kyc did:polygonid:polygon 100%")
+
+	cond, args := claimsWordsCondition(search.Words(), "AND", true, []interface{}{"issuer"})
+	assert.Equal(t, "(claims_read_model.ts_words @@ to_tsquery($2) OR claims_read_model.subject_words @@ to_tsquery('simple', $2) OR claims_read_model.holder_did ILIKE $3)"+
+		" AND (claims_read_model.holder_did ILIKE $4)"+
+		" AND (claims_read_model.ts_words @@ to_tsquery($5) OR claims_read_model.subject_words @@ to_tsquery('simple', $5) OR claims_read_model.holder_did ILIKE $6)", cond)
+	assert.Equal(t, []interface{}{"issuer", "(kyc:* | kyc)", "%kyc%", "%did:polygonid:polygon%", "(100:* | 100)", `%100\%%`}, args)
+
+	// without the holder the DIDs are not searched
+	cond, args = claimsWordsCondition(domain.ParseSearchQuery("did:polygonid:polygon").Words(), "OR", false, nil)
+	assert.Empty(t, cond)
+	assert.Empty(t, args)
 }
 
-func TestBuildPartialQueryDidLikes(t *testing.T) {
-	type testConfig struct {
-		name   string
-		field  string
-		input  []string
-		cond   string
-		expect string
-	}
-	for _, tc := range []testConfig{
-		{
-			name:   "nil input",
-			field:  "did",
-			input:  nil,
-			cond:   "OR",
-			expect: "",
-		},
-		{
-			name:   "Empty list",
-			field:  "did",
-			input:  []string{},
-			cond:   "OR",
-			expect: "",
-		},
-		{
-			name:   "One item",
-			field:  "did",
-			input:  []string{"lala"},
-			cond:   "OR",
-			expect: "did ILIKE '%lala%'",
-		},
-		{
-			name:   "One item, with chars not valid in a did",
-			field:  "did",
-			input:  []string{"valid$^;#:@()notvalid"},
-			cond:   "OR",
-			expect: "did ILIKE '%valid:notvalid%'",
-		},
-		{
-			name:   "sql injection",
-			field:  "did",
-			input:  []string{";DROP TABLE users;"},
-			cond:   "OR",
-			expect: "did ILIKE '%DROPTABLEusers%'",
-		},
-		{
-			name:   "Some items",
-			field:  "did",
-			input:  []string{"search1", "search2", "search3"},
-			cond:   "OR",
-			expect: "did ILIKE '%search1%' OR did ILIKE '%search2%' OR did ILIKE '%search3%'",
-		},
-		{
-			name:   "Some items. Empty words are filtered",
-			field:  "did",
-			input:  []string{"", "search1", "", "", "search2", "", "search3", ""},
-			cond:   "OR",
-			expect: "did ILIKE '%search1%' OR did ILIKE '%search2%' OR did ILIKE '%search3%'",
-		},
-	} {
-		t.Run(tc.name, func(t *testing.T) {
-			assert.Equal(t, tc.expect, buildPartialQueryDidLikes(tc.field, tc.input, tc.cond))
-		})
-	}
+func TestClaimsFieldsConditions(t *testing.T) {
+	search := domain.ParseSearchQuery("schema:KYCAge* birthday:1990* first_name:John")
+
+	conds, args := claimsFieldsConditions(search.Fields(), []interface{}{"issuer"})
+	assert.Equal(t, []string{
+		"claims_read_model.schema_type ILIKE $2",
+		"claims_read_model.subject_fields LIKE $3",
+		"claims_read_model.subject_fields LIKE $4",
+	}, conds)
+	assert.Equal(t, []interface{}{"issuer", "KYCAge%", "%\nbirthday=1990%", "%\nfirst\\_name=john\n%"}, args)
 }
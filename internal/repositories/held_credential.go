@@ -0,0 +1,186 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// ErrHeldCredentialDoesNotExist held credential does not exist
+var ErrHeldCredentialDoesNotExist = errors.New("held credential does not exist")
+
+type dbHeldCredential struct {
+	ID           uuid.UUID
+	Identifier   string
+	CredentialID string
+	Issuer       string
+	SchemaURL    string
+	SchemaType   string
+	ExpiresAt    *time.Time
+	Revoked      bool
+	Credential   pgtype.JSONB
+	CreatedAt    time.Time
+}
+
+type heldCredentials struct{}
+
+// NewHeldCredential returns a new held credentials repository
+func NewHeldCredential() ports.HeldCredentialRepository {
+	return &heldCredentials{}
+}
+
+// Save stores the held credential. If the holder already has a credential with the same credential id it is replaced
+// and the id of the existing row is returned.
+func (r *heldCredentials) Save(ctx context.Context, conn db.Querier, credential *domain.HeldCredential) (uuid.UUID, error) {
+	var data pgtype.JSONB
+	if err := data.Set(credential.Credential); err != nil {
+		return uuid.Nil, err
+	}
+
+	var id uuid.UUID
+	sql := `INSERT INTO held_credentials (id, identifier, credential_id, issuer, schema_url, schema_type, expires_at, revoked, credential, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) ON CONFLICT ON CONSTRAINT held_credentials_identifier_credential_id_key DO
+			UPDATE SET issuer = $4, schema_url = $5, schema_type = $6, expires_at = $7, revoked = $8, credential = $9
+			RETURNING id`
+	err := conn.QueryRow(ctx, sql,
+		credential.ID,
+		credential.HolderDID.String(),
+		credential.CredentialID,
+		credential.IssuerDID.String(),
+		credential.SchemaURL,
+		credential.SchemaType,
+		credential.ExpiresAt,
+		credential.Revoked,
+		data,
+		credential.CreatedAt).Scan(&id)
+
+	return id, err
+}
+
+func (r *heldCredentials) GetByID(ctx context.Context, conn db.Querier, holderDID core.DID, id uuid.UUID) (*domain.HeldCredential, error) {
+	var credential dbHeldCredential
+	err := conn.QueryRow(ctx, `
+		SELECT id, identifier, credential_id, issuer, schema_url, schema_type, expires_at, revoked, credential, created_at
+		FROM held_credentials
+		WHERE id = $1 AND identifier = $2`, id, holderDID.String()).Scan(
+		&credential.ID,
+		&credential.Identifier,
+		&credential.CredentialID,
+		&credential.Issuer,
+		&credential.SchemaURL,
+		&credential.SchemaType,
+		&credential.ExpiresAt,
+		&credential.Revoked,
+		&credential.Credential,
+		&credential.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrHeldCredentialDoesNotExist
+		}
+		return nil, err
+	}
+
+	return toHeldCredentialDomain(&credential)
+}
+
+func (r *heldCredentials) GetAll(ctx context.Context, conn db.Querier, holderDID core.DID, filter *ports.HeldCredentialsFilter) ([]*domain.HeldCredential, error) {
+	where := []string{"identifier = $1"}
+	args := []interface{}{holderDID.String()}
+	if filter != nil {
+		if filter.SchemaType != "" {
+			args = append(args, filter.SchemaType)
+			where = append(where, fmt.Sprintf("schema_type = $%d", len(args)))
+		}
+		if filter.Revoked != nil {
+			args = append(args, *filter.Revoked)
+			where = append(where, fmt.Sprintf("revoked = $%d", len(args)))
+		}
+	}
+
+	rows, err := conn.Query(ctx, `
+		SELECT id, identifier, credential_id, issuer, schema_url, schema_type, expires_at, revoked, credential, created_at
+		FROM held_credentials
+		WHERE `+strings.Join(where, " AND ")+`
+		ORDER BY created_at DESC`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	credentials := make([]*domain.HeldCredential, 0)
+	for rows.Next() {
+		var credential dbHeldCredential
+		if err := rows.Scan(
+			&credential.ID,
+			&credential.Identifier,
+			&credential.CredentialID,
+			&credential.Issuer,
+			&credential.SchemaURL,
+			&credential.SchemaType,
+			&credential.ExpiresAt,
+			&credential.Revoked,
+			&credential.Credential,
+			&credential.CreatedAt); err != nil {
+			return nil, err
+		}
+		held, err := toHeldCredentialDomain(&credential)
+		if err != nil {
+			return nil, err
+		}
+		credentials = append(credentials, held)
+	}
+
+	return credentials, rows.Err()
+}
+
+func (r *heldCredentials) Delete(ctx context.Context, conn db.Querier, holderDID core.DID, id uuid.UUID) error {
+	cmd, err := conn.Exec(ctx, `DELETE FROM held_credentials WHERE id = $1 AND identifier = $2`, id, holderDID.String())
+	if err != nil {
+		return err
+	}
+
+	if cmd.RowsAffected() == 0 {
+		return ErrHeldCredentialDoesNotExist
+	}
+
+	return nil
+}
+
+func toHeldCredentialDomain(c *dbHeldCredential) (*domain.HeldCredential, error) {
+	holderDID, err := core.ParseDID(c.Identifier)
+	if err != nil {
+		return nil, err
+	}
+	issuerDID, err := core.ParseDID(c.Issuer)
+	if err != nil {
+		return nil, err
+	}
+
+	held := &domain.HeldCredential{
+		ID:           c.ID,
+		HolderDID:    *holderDID,
+		IssuerDID:    *issuerDID,
+		CredentialID: c.CredentialID,
+		SchemaURL:    c.SchemaURL,
+		SchemaType:   c.SchemaType,
+		ExpiresAt:    c.ExpiresAt,
+		Revoked:      c.Revoked,
+		CreatedAt:    c.CreatedAt,
+	}
+	if err := c.Credential.AssignTo(&held.Credential); err != nil {
+		return nil, err
+	}
+
+	return held, nil
+}
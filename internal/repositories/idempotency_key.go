@@ -0,0 +1,81 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+type idempotencyKeys struct{}
+
+// NewIdempotencyKey returns a new idempotency keys repository
+func NewIdempotencyKey() ports.IdempotencyKeyRepository {
+	return &idempotencyKeys{}
+}
+
+// Reserve records the request of the key as being processed. If the key was used since the given time, nothing is
+// recorded and the original request is returned. Keys used before that time are forgotten, and so are the ones whose
+// request is still being processed since before processingSince, as it was interrupted.
+func (r *idempotencyKeys) Reserve(ctx context.Context, conn db.Querier, key *domain.IdempotencyKey, since time.Time, processingSince time.Time) (*domain.IdempotencyKey, error) {
+	if _, err := conn.Exec(ctx, `DELETE FROM idempotency_keys WHERE created_at < $1 OR (status IS NULL AND created_at < $2)`, since, processingSince); err != nil {
+		return nil, err
+	}
+
+	cmd, err := conn.Exec(ctx, `
+		INSERT INTO idempotency_keys (principal, key, request_hash, created_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT DO NOTHING`,
+		key.Principal, key.Key, key.RequestHash, key.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	if cmd.RowsAffected() > 0 {
+		return nil, nil
+	}
+
+	original := &domain.IdempotencyKey{Principal: key.Principal, Key: key.Key}
+	var status *int
+	var header []byte
+	err = conn.QueryRow(ctx, `SELECT request_hash, status, headers, body, created_at FROM idempotency_keys WHERE principal = $1 AND key = $2`, key.Principal, key.Key).
+		Scan(&original.RequestHash, &status, &header, &original.Body, &original.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			// the original was forgotten in the meantime, the request can be processed
+			return nil, nil
+		}
+		return nil, err
+	}
+	if status != nil {
+		original.Status = *status
+	}
+	if header != nil {
+		if err := json.Unmarshal(header, &original.Header); err != nil {
+			return nil, err
+		}
+	}
+	return original, nil
+}
+
+// SaveResponse stores the response of the request, to be returned to its retries
+func (r *idempotencyKeys) SaveResponse(ctx context.Context, conn db.Querier, key *domain.IdempotencyKey) error {
+	header, err := json.Marshal(key.Header)
+	if err != nil {
+		return err
+	}
+	_, err = conn.Exec(ctx, `UPDATE idempotency_keys SET status = $3, headers = $4, body = $5 WHERE principal = $1 AND key = $2`,
+		key.Principal, key.Key, key.Status, header, key.Body)
+	return err
+}
+
+// Delete forgets the key, so its request can be processed again
+func (r *idempotencyKeys) Delete(ctx context.Context, conn db.Querier, key *domain.IdempotencyKey) error {
+	_, err := conn.Exec(ctx, `DELETE FROM idempotency_keys WHERE principal = $1 AND key = $2`, key.Principal, key.Key)
+	return err
+}
@@ -19,7 +19,11 @@ func NewIdentity() ports.IndentityRepository {
 }
 
 func (i *identity) Save(ctx context.Context, conn db.Querier, identity *domain.Identity) error {
-	_, err := conn.Exec(ctx, `INSERT INTO identities (identifier) VALUES ($1)`, identity.Identifier)
+	keyType := identity.KeyType
+	if keyType == "" {
+		keyType = domain.IdentityKeyTypeBJJ
+	}
+	_, err := conn.Exec(ctx, `INSERT INTO identities (identifier, key_type, address) VALUES ($1, $2, $3)`, identity.Identifier, keyType, identity.Address)
 	return err
 }
 
@@ -29,6 +33,8 @@ func (i *identity) GetByID(ctx context.Context, conn db.Querier, identifier core
 	}
 	row := conn.QueryRow(ctx,
 		`SELECT  identities.identifier,
+       					identities.key_type,
+       					identities.address,
        					state_id,
    						state,           
     					root_of_roots,
@@ -50,6 +56,8 @@ func (i *identity) GetByID(ctx context.Context, conn db.Querier, identifier core
 				ORDER BY state_id DESC LIMIT 1`, identifier.String())
 
 	err := row.Scan(&identity.Identifier,
+		&identity.KeyType,
+		&identity.Address,
 		&identity.State.StateID,
 		&identity.State.State,
 		&identity.State.RootOfRoots,
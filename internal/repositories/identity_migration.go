@@ -0,0 +1,263 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	core "github.com/iden3/go-iden3-core"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+const (
+	identityMigrationsIdentifierFKey = "identity_migrations_identifier_fkey"
+	identitiesPKey                   = "identities_pkey"
+)
+
+var (
+	// ErrIdentityMigrationNotFound the identity is not being migrated
+	ErrIdentityMigrationNotFound = errors.New("identity migration not found")
+	// ErrIdentityMigrationIdentityNotFound the identity of the migration does not exist
+	ErrIdentityMigrationIdentityNotFound = errors.New("identity not found")
+	// ErrIdentityAlreadyExists the imported identity already exists
+	ErrIdentityAlreadyExists = errors.New("identity already exists")
+)
+
+// identityTable is a table with rows of an identity
+type identityTable struct {
+	name   string
+	column string // column with the identifier
+	// generated is the identity column of the table. It is not exported, the importing node generates new values.
+	generated string
+}
+
+// identityTables are the tables exported with an identity, in the order they are imported to satisfy the foreign keys
+var identityTables = []identityTable{
+	{name: "identities", column: "identifier"},
+	{name: "identity_states", column: "identifier", generated: "state_id"},
+	{name: "revocation", column: "identifier", generated: "id"},
+	{name: "schemas", column: "issuer_id"},
+	{name: "links", column: "issuer_id"},
+	{name: "claims", column: "identifier"},
+	{name: "connections", column: "issuer_id"},
+	{name: "credential_offers", column: "issuer_id"},
+	{name: "held_credentials", column: "identifier"},
+	{name: "key_rotations", column: "identifier"},
+	{name: "feature_flags", column: "identifier"},
+	{name: "state_transactions", column: "identifier"},
+	{name: "publishing_policies", column: "identifier"},
+	{name: "rev_nonce_sequences", column: "identifier"},
+	{name: "status_lists", column: "identifier"},
+	{name: "status_list_entries", column: "identifier"},
+}
+
+type identityMigrations struct{}
+
+// NewIdentityMigration returns a new identity migrations repository
+func NewIdentityMigration() ports.IdentityMigrationRepository {
+	return &identityMigrations{}
+}
+
+// Save inserts the migration of the identity or updates its status
+func (r *identityMigrations) Save(ctx context.Context, conn db.Querier, migration *domain.IdentityMigration) error {
+	err := conn.QueryRow(ctx, `
+		INSERT INTO identity_migrations (identifier, status, target, completed_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (identifier) DO UPDATE SET status = $2, target = $3, completed_at = $4
+		RETURNING created_at`,
+		migration.Identifier, migration.Status, migration.Target, migration.CompletedAt).Scan(&migration.CreatedAt)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.ConstraintName == identityMigrationsIdentifierFKey {
+		return ErrIdentityMigrationIdentityNotFound
+	}
+	return err
+}
+
+// Get returns the migration of the identity
+func (r *identityMigrations) Get(ctx context.Context, conn db.Querier, identifier core.DID) (*domain.IdentityMigration, error) {
+	migration := &domain.IdentityMigration{}
+	err := conn.QueryRow(ctx, `
+		SELECT identifier, status, target, created_at, completed_at
+		FROM identity_migrations
+		WHERE identifier = $1`, identifier.String()).Scan(
+		&migration.Identifier, &migration.Status, &migration.Target, &migration.CreatedAt, &migration.CompletedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrIdentityMigrationNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return migration, nil
+}
+
+// Delete removes the migration of the identity
+func (r *identityMigrations) Delete(ctx context.Context, conn db.Querier, identifier core.DID) error {
+	_, err := conn.Exec(ctx, `DELETE FROM identity_migrations WHERE identifier = $1`, identifier.String())
+	return err
+}
+
+// HasPendingTransactions returns true if a state of the identity is waiting for its transaction to be confirmed or
+// a key rotation is waiting for its state to be published
+func (r *identityMigrations) HasPendingTransactions(ctx context.Context, conn db.Querier, identifier core.DID) (bool, error) {
+	var pending bool
+	err := conn.QueryRow(ctx, `
+		SELECT EXISTS (SELECT 1 FROM identity_states WHERE identifier = $1 AND status = $2)
+		    OR EXISTS (SELECT 1 FROM key_rotations WHERE identifier = $1 AND status = $3)`,
+		identifier.String(), domain.StatusTransacted, domain.KeyRotationStatusPending).Scan(&pending)
+	return pending, err
+}
+
+// SchemaVersion returns the version of the last database migration applied
+func (r *identityMigrations) SchemaVersion(ctx context.Context, conn db.Querier) (int64, error) {
+	var version int64
+	err := conn.QueryRow(ctx, `SELECT COALESCE(MAX(version_id), 0) FROM goose_db_version WHERE is_applied`).Scan(&version)
+	return version, err
+}
+
+// Export returns the rows of the identity as JSON arrays by table, and its merkle trees
+func (r *identityMigrations) Export(ctx context.Context, conn db.Querier, identifier core.DID) (map[string]json.RawMessage, []domain.IdentityExportMerkleTree, error) {
+	tables := make(map[string]json.RawMessage, len(identityTables))
+	for _, table := range identityTables {
+		var rows []byte
+		// the rows are sorted by the identity column, so the imported ones get their new values in the same order
+		orderBy := ""
+		if table.generated != "" {
+			orderBy = " ORDER BY " + table.generated
+		}
+		query := fmt.Sprintf(`SELECT COALESCE(jsonb_agg(to_jsonb(t) - $2::text%s), '[]'::jsonb) FROM %s t WHERE %s = $1`,
+			orderBy, table.name, table.column)
+		if err := conn.QueryRow(ctx, query, identifier.String(), table.generated).Scan(&rows); err != nil {
+			return nil, nil, fmt.Errorf("exporting %s: %w", table.name, err)
+		}
+		tables[table.name] = json.RawMessage(rows)
+	}
+
+	mts, err := conn.Query(ctx, `SELECT id, type FROM identity_mts WHERE identifier = $1 ORDER BY type`, identifier.String())
+	if err != nil {
+		return nil, nil, err
+	}
+	type mtRow struct {
+		id     int64
+		mtType uint16
+	}
+	var mtRows []mtRow
+	for mts.Next() {
+		var row mtRow
+		if err := mts.Scan(&row.id, &row.mtType); err != nil {
+			mts.Close()
+			return nil, nil, err
+		}
+		mtRows = append(mtRows, row)
+	}
+	mts.Close()
+	if err := mts.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	trees := make([]domain.IdentityExportMerkleTree, 0, len(mtRows))
+	for _, row := range mtRows {
+		var nodes, roots []byte
+		err := conn.QueryRow(ctx, `
+			SELECT (SELECT COALESCE(jsonb_agg(to_jsonb(n) - 'mt_id'), '[]'::jsonb) FROM mt_nodes n WHERE mt_id = $1),
+			       (SELECT COALESCE(jsonb_agg(to_jsonb(r) - 'mt_id'), '[]'::jsonb) FROM mt_roots r WHERE mt_id = $1)`,
+			row.id).Scan(&nodes, &roots)
+		if err != nil {
+			return nil, nil, fmt.Errorf("exporting merkle tree %d: %w", row.mtType, err)
+		}
+		trees = append(trees, domain.IdentityExportMerkleTree{Type: row.mtType, Nodes: nodes, Roots: roots})
+	}
+	return tables, trees, nil
+}
+
+// Import inserts the rows of the export and returns the number of rows inserted by table.
+// The database must have the same schema version as the exporting one.
+func (r *identityMigrations) Import(ctx context.Context, conn db.Querier, export *domain.IdentityExport) (map[string]int, error) {
+	inserted := make(map[string]int, len(identityTables))
+	for _, table := range identityTables {
+		rows, ok := export.Tables[table.name]
+		if !ok {
+			return nil, fmt.Errorf("export has no %s rows", table.name)
+		}
+		columns, err := r.columns(ctx, conn, table.name, table.generated)
+		if err != nil {
+			return nil, err
+		}
+		query := fmt.Sprintf(`INSERT INTO %s (%s) SELECT %s FROM jsonb_populate_recordset(NULL::%s, $1)`,
+			table.name, columns, columns, table.name)
+		tag, err := conn.Exec(ctx, query, []byte(rows))
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.ConstraintName == identitiesPKey {
+			return nil, ErrIdentityAlreadyExists
+		}
+		if err != nil {
+			return nil, fmt.Errorf("importing %s: %w", table.name, err)
+		}
+		inserted[table.name] = int(tag.RowsAffected())
+	}
+
+	nodeColumns, err := r.columns(ctx, conn, "mt_nodes", "mt_id")
+	if err != nil {
+		return nil, err
+	}
+	rootColumns, err := r.columns(ctx, conn, "mt_roots", "mt_id")
+	if err != nil {
+		return nil, err
+	}
+	for _, tree := range export.MerkleTrees {
+		var mtID int64
+		err := conn.QueryRow(ctx, `INSERT INTO identity_mts (identifier, type) VALUES ($1, $2) RETURNING id`,
+			export.Identifier, tree.Type).Scan(&mtID)
+		if err != nil {
+			return nil, fmt.Errorf("importing merkle tree %d: %w", tree.Type, err)
+		}
+		query := fmt.Sprintf(`INSERT INTO mt_nodes (mt_id, %s) SELECT $1, %s FROM jsonb_populate_recordset(NULL::mt_nodes, $2)`,
+			nodeColumns, nodeColumns)
+		tag, err := conn.Exec(ctx, query, mtID, []byte(tree.Nodes))
+		if err != nil {
+			return nil, fmt.Errorf("importing merkle tree %d nodes: %w", tree.Type, err)
+		}
+		inserted["mt_nodes"] += int(tag.RowsAffected())
+		query = fmt.Sprintf(`INSERT INTO mt_roots (mt_id, %s) SELECT $1, %s FROM jsonb_populate_recordset(NULL::mt_roots, $2)`,
+			rootColumns, rootColumns)
+		if _, err := conn.Exec(ctx, query, mtID, []byte(tree.Roots)); err != nil {
+			return nil, fmt.Errorf("importing merkle tree %d root: %w", tree.Type, err)
+		}
+	}
+	return inserted, nil
+}
+
+// columns returns the quoted column names of the table but the excluded one, separated by commas
+func (r *identityMigrations) columns(ctx context.Context, conn db.Querier, table, exclude string) (string, error) {
+	rows, err := conn.Query(ctx, `
+		SELECT column_name
+		FROM information_schema.columns
+		WHERE table_schema = current_schema() AND table_name = $1 AND column_name <> $2
+		ORDER BY ordinal_position`, table, exclude)
+	if err != nil {
+		return "", err
+	}
+	defer rows.Close()
+
+	var columns []string
+	for rows.Next() {
+		var column string
+		if err := rows.Scan(&column); err != nil {
+			return "", err
+		}
+		columns = append(columns, pgx.Identifier{column}.Sanitize())
+	}
+	if err := rows.Err(); err != nil {
+		return "", err
+	}
+	if len(columns) == 0 {
+		return "", fmt.Errorf("table %s not found", table)
+	}
+	return strings.Join(columns, ", "), nil
+}
@@ -0,0 +1,94 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// ErrImportJobNotFound the import job does not exist
+var ErrImportJobNotFound = errors.New("import job not found")
+
+type importJobs struct{}
+
+// NewImportJob returns a new import jobs repository
+func NewImportJob() ports.ImportJobRepository {
+	return &importJobs{}
+}
+
+// Save inserts the import job or updates its progress
+func (r *importJobs) Save(ctx context.Context, conn db.Querier, job *domain.ImportJob) error {
+	_, err := conn.Exec(ctx, `
+		INSERT INTO import_jobs (id, issuer_id, schema_id, mode, status, signature_proof, mtp_proof, credential_expiration,
+		                         total_rows, processed_rows, failed_rows, error, created_at, finished_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+		ON CONFLICT (id) DO UPDATE
+		SET status = $5, processed_rows = $10, failed_rows = $11, error = $12, finished_at = $14`,
+		job.ID, job.IssuerDID.String(), job.SchemaID, job.Mode, job.Status, job.SignatureProof, job.MTProof, job.CredentialExpiration,
+		job.TotalRows, job.ProcessedRows, job.FailedRows, job.Error, job.CreatedAt, job.FinishedAt)
+	return err
+}
+
+// GetByID returns the import job of the issuer
+func (r *importJobs) GetByID(ctx context.Context, conn db.Querier, issuerDID core.DID, id uuid.UUID) (*domain.ImportJob, error) {
+	var job domain.ImportJob
+	var issuer string
+	err := conn.QueryRow(ctx, `
+		SELECT id, issuer_id, schema_id, mode, status, signature_proof, mtp_proof, credential_expiration,
+		       total_rows, processed_rows, failed_rows, error, created_at, finished_at
+		FROM import_jobs
+		WHERE id = $1 AND issuer_id = $2`, id, issuerDID.String()).Scan(
+		&job.ID, &issuer, &job.SchemaID, &job.Mode, &job.Status, &job.SignatureProof, &job.MTProof, &job.CredentialExpiration,
+		&job.TotalRows, &job.ProcessedRows, &job.FailedRows, &job.Error, &job.CreatedAt, &job.FinishedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrImportJobNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	did, err := core.ParseDID(issuer)
+	if err != nil {
+		return nil, err
+	}
+	job.IssuerDID = *did
+	return &job, nil
+}
+
+// SaveRow inserts the result of processing a row of an import job
+func (r *importJobs) SaveRow(ctx context.Context, conn db.Querier, row *domain.ImportRow) error {
+	_, err := conn.Exec(ctx, `
+		INSERT INTO import_job_rows (job_id, row_number, reference, claim_id, link_id, error)
+		VALUES ($1, $2, $3, $4, $5, $6)`,
+		row.JobID, row.Number, row.Reference, row.ClaimID, row.LinkID, row.Error)
+	return err
+}
+
+// GetRows returns the processed rows of an import job sorted by number, only the failed ones if onlyErrors is true
+func (r *importJobs) GetRows(ctx context.Context, conn db.Querier, jobID uuid.UUID, onlyErrors bool) ([]*domain.ImportRow, error) {
+	rows, err := conn.Query(ctx, `
+		SELECT job_id, row_number, reference, claim_id, link_id, error
+		FROM import_job_rows
+		WHERE job_id = $1 AND (NOT $2 OR error IS NOT NULL)
+		ORDER BY row_number`, jobID, onlyErrors)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make([]*domain.ImportRow, 0)
+	for rows.Next() {
+		var row domain.ImportRow
+		if err := rows.Scan(&row.JobID, &row.Number, &row.Reference, &row.ClaimID, &row.LinkID, &row.Error); err != nil {
+			return nil, err
+		}
+		result = append(result, &row)
+	}
+	return result, rows.Err()
+}
@@ -0,0 +1,64 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	core "github.com/iden3/go-iden3-core"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+const issuerProfilesIdentifierFKey = "issuer_profiles_identifier_fkey"
+
+var (
+	// ErrIssuerProfileNotFound the identity has no profile of its own
+	ErrIssuerProfileNotFound = errors.New("issuer profile not found")
+	// ErrIssuerProfileIdentityNotFound the identity of the profile does not exist
+	ErrIssuerProfileIdentityNotFound = errors.New("identity not found")
+)
+
+type issuerProfiles struct{}
+
+// NewIssuerProfile returns a new issuer profiles repository
+func NewIssuerProfile() ports.IssuerProfileRepository {
+	return &issuerProfiles{}
+}
+
+// Save inserts the profile of the identity or replaces it
+func (r *issuerProfiles) Save(ctx context.Context, conn db.Querier, profile *domain.IssuerProfile) error {
+	err := conn.QueryRow(ctx, `
+		INSERT INTO issuer_profiles (identifier, display_name, logo_url, background_color, text_color, contact_email, contact_url, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, CURRENT_TIMESTAMP)
+		ON CONFLICT (identifier) DO UPDATE SET display_name = $2, logo_url = $3, background_color = $4, text_color = $5,
+			contact_email = $6, contact_url = $7, updated_at = CURRENT_TIMESTAMP
+		RETURNING updated_at`,
+		profile.Identifier, profile.DisplayName, profile.LogoURL, profile.BackgroundColor, profile.TextColor,
+		profile.ContactEmail, profile.ContactURL).Scan(&profile.UpdatedAt)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.ConstraintName == issuerProfilesIdentifierFKey {
+		return ErrIssuerProfileIdentityNotFound
+	}
+	return err
+}
+
+// GetByIdentifier returns the profile of the identity
+func (r *issuerProfiles) GetByIdentifier(ctx context.Context, conn db.Querier, identifier core.DID) (*domain.IssuerProfile, error) {
+	profile := domain.IssuerProfile{Overridden: true}
+	err := conn.QueryRow(ctx, `
+		SELECT identifier, display_name, logo_url, background_color, text_color, contact_email, contact_url, updated_at
+		FROM issuer_profiles
+		WHERE identifier = $1`, identifier.String()).Scan(&profile.Identifier, &profile.DisplayName, &profile.LogoURL,
+		&profile.BackgroundColor, &profile.TextColor, &profile.ContactEmail, &profile.ContactURL, &profile.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrIssuerProfileNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &profile, nil
+}
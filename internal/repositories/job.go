@@ -0,0 +1,138 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// ErrJobNotFound the job does not exist
+var ErrJobNotFound = errors.New("job not found")
+
+const jobColumns = `id, kind, issuer_id, payload, status, attempts, max_attempts, last_error, run_at, created_at, updated_at, finished_at`
+
+type jobs struct{}
+
+// NewJob returns a new jobs queue repository
+func NewJob() ports.JobRepository {
+	return &jobs{}
+}
+
+// Save inserts the job or updates its state. The job is unlocked unless it is running.
+func (r *jobs) Save(ctx context.Context, conn db.Querier, job *domain.Job) error {
+	_, err := conn.Exec(ctx, `
+		INSERT INTO jobs (id, kind, issuer_id, payload, status, attempts, max_attempts, last_error, run_at, created_at, updated_at, finished_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		ON CONFLICT (id) DO UPDATE
+		SET status = $5, attempts = $6, last_error = $8, run_at = $9, updated_at = $11, finished_at = $12,
+		    locked_at = CASE WHEN $5 = 'running' THEN jobs.locked_at END`,
+		job.ID, job.Kind, job.IssuerDID, job.Payload, job.Status, job.Attempts, job.MaxAttempts, job.LastError,
+		job.RunAt, job.CreatedAt, job.UpdatedAt, job.FinishedAt)
+	return err
+}
+
+// GetByID returns the job of the issuer. The job is locked until the end of the transaction of conn, so no worker
+// starts it while it is changed.
+func (r *jobs) GetByID(ctx context.Context, conn db.Querier, issuerDID core.DID, id uuid.UUID) (*domain.Job, error) {
+	job, err := scanJob(conn.QueryRow(ctx, `
+		SELECT `+jobColumns+`
+		FROM jobs
+		WHERE id = $1 AND issuer_id = $2
+		FOR UPDATE`, id, issuerDID.String()))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrJobNotFound
+	}
+	return job, err
+}
+
+// GetAll returns the newest jobs of the issuer that match the filter
+func (r *jobs) GetAll(ctx context.Context, conn db.Querier, issuerDID core.DID, filter ports.JobsFilter) ([]*domain.Job, error) {
+	rows, err := conn.Query(ctx, `
+		SELECT `+jobColumns+`
+		FROM jobs
+		WHERE issuer_id = $1 AND ($2 = '' OR kind = $2) AND ($3::text IS NULL OR status = $3)
+		ORDER BY created_at DESC
+		LIMIT $4`, issuerDID.String(), filter.Kind, filter.Status, filter.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make([]*domain.Job, 0)
+	for rows.Next() {
+		job, err := scanJob(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, job)
+	}
+	return result, rows.Err()
+}
+
+// Lock starts the oldest due job of the given kinds, counting the attempt, and returns it. It returns nil if there is
+// none. The jobs being locked by other workers are skipped, so a job is never started twice.
+func (r *jobs) Lock(ctx context.Context, conn db.Querier, kinds []string) (*domain.Job, error) {
+	job, err := scanJob(conn.QueryRow(ctx, `
+		UPDATE jobs
+		SET status = 'running', attempts = attempts + 1, locked_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP
+		WHERE id = (
+			SELECT id FROM jobs
+			WHERE status = 'pending' AND kind = ANY($1) AND run_at <= CURRENT_TIMESTAMP
+			ORDER BY run_at
+			LIMIT 1
+			FOR UPDATE SKIP LOCKED)
+		RETURNING `+jobColumns, kinds))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	return job, err
+}
+
+// Heartbeat records that the worker of a running job is alive
+func (r *jobs) Heartbeat(ctx context.Context, conn db.Querier, id uuid.UUID) error {
+	_, err := conn.Exec(ctx, `UPDATE jobs SET locked_at = CURRENT_TIMESTAMP WHERE id = $1 AND status = 'running'`, id)
+	return err
+}
+
+// Rescue sends back to the queue the running jobs whose last heartbeat is older than lockedBefore, as their worker
+// stopped. The ones without attempts left go to the dead letter. It returns the number of rescued jobs.
+func (r *jobs) Rescue(ctx context.Context, conn db.Querier, lockedBefore time.Time) (int64, error) {
+	tag, err := conn.Exec(ctx, `
+		UPDATE jobs
+		SET status = CASE WHEN attempts >= max_attempts THEN 'dead' ELSE 'pending' END,
+		    finished_at = CASE WHEN attempts >= max_attempts THEN CURRENT_TIMESTAMP END,
+		    last_error = 'the worker stopped while running the job',
+		    run_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP, locked_at = NULL
+		WHERE status = 'running' AND locked_at < $1`, lockedBefore)
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
+
+// DeleteFinishedBefore deletes the completed and cancelled jobs that finished before the given time. The dead jobs
+// are kept until they are retried or cancelled. It returns the number of deleted jobs.
+func (r *jobs) DeleteFinishedBefore(ctx context.Context, conn db.Querier, before time.Time) (int64, error) {
+	tag, err := conn.Exec(ctx, `DELETE FROM jobs WHERE status IN ('completed', 'cancelled') AND finished_at < $1`, before)
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
+
+func scanJob(row pgx.Row) (*domain.Job, error) {
+	var job domain.Job
+	if err := row.Scan(&job.ID, &job.Kind, &job.IssuerDID, &job.Payload, &job.Status, &job.Attempts, &job.MaxAttempts,
+		&job.LastError, &job.RunAt, &job.CreatedAt, &job.UpdatedAt, &job.FinishedAt); err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
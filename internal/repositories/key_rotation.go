@@ -0,0 +1,92 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	core "github.com/iden3/go-iden3-core"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+const keyRotationsPendingIndex = "key_rotations_pending_identifier"
+
+var (
+	// ErrKeyRotationNotFound there is no pending key rotation for the identity
+	ErrKeyRotationNotFound = errors.New("key rotation not found")
+	// ErrKeyRotationPending the identity already has a pending key rotation
+	ErrKeyRotationPending = errors.New("there is already a pending key rotation")
+)
+
+type keyRotations struct{}
+
+// NewKeyRotation returns a new key rotations repository
+func NewKeyRotation() ports.KeyRotationRepository {
+	return &keyRotations{}
+}
+
+// Save inserts the rotation or updates its status and completion date
+func (r *keyRotations) Save(ctx context.Context, conn db.Querier, rotation *domain.KeyRotation) error {
+	_, err := conn.Exec(ctx, `
+		INSERT INTO key_rotations (id, identifier, old_claim_id, new_claim_id, status, created_at, completed_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (id) DO UPDATE SET status = $5, completed_at = $7`,
+		rotation.ID, rotation.Identifier, rotation.OldClaimID, rotation.NewClaimID, rotation.Status, rotation.CreatedAt, rotation.CompletedAt)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.ConstraintName == keyRotationsPendingIndex {
+		return ErrKeyRotationPending
+	}
+	return err
+}
+
+// GetPending returns the pending rotations of every identity
+func (r *keyRotations) GetPending(ctx context.Context, conn db.Querier) ([]*domain.KeyRotation, error) {
+	rows, err := conn.Query(ctx, `
+		SELECT id, identifier, old_claim_id, new_claim_id, status, created_at, completed_at
+		FROM key_rotations
+		WHERE status = $1
+		ORDER BY created_at`, domain.KeyRotationStatusPending)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	rotations := make([]*domain.KeyRotation, 0)
+	for rows.Next() {
+		rotation, err := scanKeyRotation(rows)
+		if err != nil {
+			return nil, err
+		}
+		rotations = append(rotations, rotation)
+	}
+	return rotations, rows.Err()
+}
+
+// GetPendingByIdentifier returns the pending rotation of the identity
+func (r *keyRotations) GetPendingByIdentifier(ctx context.Context, conn db.Querier, identifier core.DID) (*domain.KeyRotation, error) {
+	row := conn.QueryRow(ctx, `
+		SELECT id, identifier, old_claim_id, new_claim_id, status, created_at, completed_at
+		FROM key_rotations
+		WHERE identifier = $1 AND status = $2`, identifier.String(), domain.KeyRotationStatusPending)
+	rotation, err := scanKeyRotation(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrKeyRotationNotFound
+		}
+		return nil, err
+	}
+	return rotation, nil
+}
+
+func scanKeyRotation(row pgx.Row) (*domain.KeyRotation, error) {
+	var rotation domain.KeyRotation
+	err := row.Scan(&rotation.ID, &rotation.Identifier, &rotation.OldClaimID, &rotation.NewClaimID, &rotation.Status, &rotation.CreatedAt, &rotation.CompletedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &rotation, nil
+}
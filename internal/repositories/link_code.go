@@ -0,0 +1,122 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// ErrLinkCodeNotFound the link has no unused code with the hash, or reserved by the session
+var ErrLinkCodeNotFound = errors.New("link code not found")
+
+type linkCodes struct{}
+
+// NewLinkCode returns a new repository of the one-time codes of the links
+func NewLinkCode() ports.LinkCodeRepository {
+	return &linkCodes{}
+}
+
+// Save inserts the codes in a single statement
+func (r *linkCodes) Save(ctx context.Context, conn db.Querier, codes []*domain.LinkCode) error {
+	ids := make([]uuid.UUID, len(codes))
+	linkIDs := make([]uuid.UUID, len(codes))
+	hashes := make([]string, len(codes))
+	createdAt := make([]time.Time, len(codes))
+	for i, code := range codes {
+		ids[i], linkIDs[i], hashes[i], createdAt[i] = code.ID, code.LinkID, code.Hash, code.CreatedAt
+	}
+	_, err := conn.Exec(ctx, `
+		INSERT INTO link_codes (id, link_id, code_hash, created_at)
+		SELECT * FROM unnest($1::uuid[], $2::uuid[], $3::text[], $4::timestamptz[])`, ids, linkIDs, hashes, createdAt)
+	return err
+}
+
+// GetByLink returns the codes of the link, the last created first
+func (r *linkCodes) GetByLink(ctx context.Context, conn db.Querier, linkID uuid.UUID) ([]*domain.LinkCode, error) {
+	rows, err := conn.Query(ctx, `
+		SELECT id, link_id, code_hash, session_id, holder_id, created_at, used_at
+		FROM link_codes
+		WHERE link_id = $1
+		ORDER BY created_at DESC, id`, linkID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	codes := make([]*domain.LinkCode, 0)
+	for rows.Next() {
+		code := &domain.LinkCode{}
+		if err := rows.Scan(&code.ID, &code.LinkID, &code.Hash, &code.SessionID, &code.HolderDID, &code.CreatedAt, &code.UsedAt); err != nil {
+			return nil, err
+		}
+		codes = append(codes, code)
+	}
+	return codes, rows.Err()
+}
+
+// GetBySession returns the unused code of the link reserved by the session
+func (r *linkCodes) GetBySession(ctx context.Context, conn db.Querier, linkID uuid.UUID, sessionID uuid.UUID) (*domain.LinkCode, error) {
+	code := &domain.LinkCode{}
+	err := conn.QueryRow(ctx, `
+		SELECT id, link_id, code_hash, session_id, holder_id, created_at, used_at
+		FROM link_codes
+		WHERE link_id = $1 AND session_id = $2 AND used_at IS NULL`, linkID, sessionID).Scan(
+		&code.ID, &code.LinkID, &code.Hash, &code.SessionID, &code.HolderDID, &code.CreatedAt, &code.UsedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrLinkCodeNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return code, nil
+}
+
+// Reserve assigns the unused code of the link with the hash to the session. A code entered again in another session
+// moves to the new one, so a holder can start the claim again.
+func (r *linkCodes) Reserve(ctx context.Context, conn db.Querier, linkID uuid.UUID, hash string, sessionID uuid.UUID) error {
+	cmd, err := conn.Exec(ctx, `
+		UPDATE link_codes SET session_id = $3
+		WHERE link_id = $1 AND code_hash = $2 AND used_at IS NULL`, linkID, hash, sessionID)
+	if err != nil {
+		return err
+	}
+	if cmd.RowsAffected() == 0 {
+		return ErrLinkCodeNotFound
+	}
+	return nil
+}
+
+// Use marks the code reserved by the session as used by the holder
+func (r *linkCodes) Use(ctx context.Context, conn db.Querier, linkID uuid.UUID, sessionID uuid.UUID, holderDID core.DID, usedAt time.Time) error {
+	cmd, err := conn.Exec(ctx, `
+		UPDATE link_codes SET used_at = $4, holder_id = $3
+		WHERE link_id = $1 AND session_id = $2 AND used_at IS NULL`, linkID, sessionID, holderDID.String(), usedAt)
+	if err != nil {
+		return err
+	}
+	if cmd.RowsAffected() == 0 {
+		return ErrLinkCodeNotFound
+	}
+	return nil
+}
+
+// SaveFailure records a wrong code entered in the link
+func (r *linkCodes) SaveFailure(ctx context.Context, conn db.Querier, linkID uuid.UUID, at time.Time) error {
+	_, err := conn.Exec(ctx, `INSERT INTO link_code_failures (link_id, created_at) VALUES ($1, $2)`, linkID, at)
+	return err
+}
+
+// CountFailures returns how many wrong codes were entered in the link since the given time
+func (r *linkCodes) CountFailures(ctx context.Context, conn db.Querier, linkID uuid.UUID, since time.Time) (int, error) {
+	var count int
+	err := conn.QueryRow(ctx, `SELECT COUNT(*) FROM link_code_failures WHERE link_id = $1 AND created_at >= $2`, linkID, since).Scan(&count)
+	return count, err
+}
@@ -0,0 +1,121 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+type linkFunnel struct{}
+
+// NewLinkFunnel returns a new link funnel events repository
+func NewLinkFunnel() ports.LinkFunnelRepository {
+	return &linkFunnel{}
+}
+
+// Save inserts the event. The steps of a session other than the failures are only inserted the first time.
+func (r *linkFunnel) Save(ctx context.Context, conn db.Querier, event *domain.LinkFunnelEvent) error {
+	var session *string
+	if event.Session != "" {
+		session = &event.Session
+	}
+	_, err := conn.Exec(ctx, `
+		INSERT INTO link_funnel_events (issuer_id, link_id, session, step, failure_reason, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT DO NOTHING`,
+		event.IssuerDID.String(), event.LinkID, session, event.Step, event.FailureReason, event.CreatedAt)
+	return err
+}
+
+// GetAll returns the events of the links of the issuer sorted by date
+func (r *linkFunnel) GetAll(ctx context.Context, conn db.Querier, issuerDID core.DID, filter ports.LinkFunnelFilter) ([]*domain.LinkFunnelEvent, error) {
+	rows, err := conn.Query(ctx, `
+		SELECT id, link_id, COALESCE(session, ''), step, failure_reason, created_at
+		FROM link_funnel_events
+		WHERE issuer_id = $1
+		  AND ($2::uuid IS NULL OR link_id = $2)
+		  AND ($3::timestamptz IS NULL OR created_at >= $3)
+		  AND ($4::timestamptz IS NULL OR created_at < $4)
+		ORDER BY created_at, id`, issuerDID.String(), filter.LinkID, filter.From, filter.To)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	events := make([]*domain.LinkFunnelEvent, 0)
+	for rows.Next() {
+		event := &domain.LinkFunnelEvent{IssuerDID: issuerDID}
+		if err := rows.Scan(&event.ID, &event.LinkID, &event.Session, &event.Step, &event.FailureReason, &event.CreatedAt); err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, rows.Err()
+}
+
+// Summary counts the events of the link by step, and the failures by reason
+func (r *linkFunnel) Summary(ctx context.Context, conn db.Querier, issuerDID core.DID, linkID uuid.UUID) (*domain.LinkFunnel, error) {
+	rows, err := conn.Query(ctx, `
+		SELECT step, COALESCE(failure_reason, ''), COUNT(*)
+		FROM link_funnel_events
+		WHERE issuer_id = $1 AND link_id = $2
+		GROUP BY step, failure_reason`, issuerDID.String(), linkID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	funnel := &domain.LinkFunnel{
+		LinkID:   linkID,
+		Steps:    make(map[domain.LinkFunnelStep]int),
+		Failures: make(map[string]int),
+	}
+	for _, step := range domain.LinkFunnelSteps {
+		funnel.Steps[step] = 0
+	}
+	for rows.Next() {
+		var step domain.LinkFunnelStep
+		var reason string
+		var count int
+		if err := rows.Scan(&step, &reason, &count); err != nil {
+			return nil, err
+		}
+		if step == domain.LinkFunnelFailed {
+			funnel.Failures[reason] += count
+			continue
+		}
+		funnel.Steps[step] += count
+	}
+	return funnel, rows.Err()
+}
+
+// Analytics counts the events of the link in buckets of the interval, from the bucket of from up to to
+func (r *linkFunnel) Analytics(ctx context.Context, conn db.Querier, issuerDID core.DID, linkID uuid.UUID, interval domain.LinkAnalyticsInterval, from time.Time, to time.Time) (*domain.LinkAnalytics, error) {
+	analytics := domain.NewLinkAnalytics(linkID, interval, from, to)
+	rows, err := conn.Query(ctx, `
+		SELECT date_trunc($3::text, created_at AT TIME ZONE 'UTC'), step, COUNT(*)
+		FROM link_funnel_events
+		WHERE issuer_id = $1 AND link_id = $2 AND created_at >= $4 AND created_at < $5
+		GROUP BY 1, 2`, issuerDID.String(), linkID, string(interval), analytics.From, analytics.To)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var start time.Time
+		var step domain.LinkFunnelStep
+		var count int
+		if err := rows.Scan(&start, &step, &count); err != nil {
+			return nil, err
+		}
+		analytics.Add(start, step, count)
+	}
+	return analytics, rows.Err()
+}
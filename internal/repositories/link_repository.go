@@ -24,6 +24,9 @@ var (
 
 	// ErrLinkDoesNotExist link does not exist
 	ErrLinkDoesNotExist = errors.New("link does not exist")
+
+	// ErrVersionConflict the row was updated by someone else after it was read
+	ErrVersionConflict = errors.New("version conflict")
 )
 
 type link struct {
@@ -42,19 +45,41 @@ func (l link) Save(ctx context.Context, conn db.Querier, link *domain.Link) (*uu
 	if err := pgAttrs.Set(link.CredentialSubject); err != nil {
 		return nil, fmt.Errorf("cannot set credential subject values: %w", err)
 	}
+	var proofScope []byte
+	if len(link.ProofScope) > 0 {
+		var err error
+		if proofScope, err = json.Marshal(link.ProofScope); err != nil {
+			return nil, fmt.Errorf("cannot set proof scope: %w", err)
+		}
+	}
+	// the links any holder can claim have no allowed DIDs instead of an empty list
+	var allowedDIDs []string
+	if len(link.AllowedDIDs) > 0 {
+		allowedDIDs = link.AllowedDIDs
+	}
 
 	var id uuid.UUID
-	sql := `INSERT INTO links (id, issuer_id, max_issuance, valid_until, schema_id, credential_expiration, credential_signature_proof, credential_mtp_proof, credential_attributes, active)
-			VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) ON CONFLICT (id) DO
-			UPDATE SET issuer_id=$2, max_issuance=$3, valid_until=$4, schema_id=$5, credential_expiration=$6, credential_signature_proof=$7, credential_mtp_proof=$8, credential_attributes=$9, active=$10 
-			RETURNING id`
+	var version int
+	sql := `INSERT INTO links (id, issuer_id, max_issuance, valid_until, schema_id, credential_expiration, credential_signature_proof, credential_mtp_proof, credential_attributes, active, version, proof_scope, subject_external_id, allowed_dids, code_protected)
+			VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15) ON CONFLICT (id) DO
+			UPDATE SET issuer_id=$2, max_issuance=$3, valid_until=$4, schema_id=$5, credential_expiration=$6, credential_signature_proof=$7, credential_mtp_proof=$8, credential_attributes=$9, active=$10, proof_scope=$12, subject_external_id=$13, allowed_dids=$14, code_protected=$15, version=links.version + 1
+			WHERE links.version = $11
+			RETURNING id, version`
 	err := conn.QueryRow(ctx, sql, link.ID, link.IssuerCoreDID().String(), link.MaxIssuance, link.ValidUntil, link.SchemaID, link.CredentialExpiration, link.CredentialSignatureProof,
-		link.CredentialMTPProof, pgAttrs, link.Active).Scan(&id)
+		link.CredentialMTPProof, pgAttrs, link.Active, link.Version, proofScope, link.SubjectExternalID, allowedDIDs, link.CodeProtected).Scan(&id, &version)
 
 	if err != nil && strings.Contains(err.Error(), `table "links" violates foreign key constraint "links_schemas_id_key"`) {
 		return nil, errorShemaNotFound
 	}
-	return &id, err
+	// the conflict update is skipped when the link was updated after it was read
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrVersionConflict
+	}
+	if err != nil {
+		return nil, err
+	}
+	link.Version = version
+	return &id, nil
 }
 
 func (l link) GetByID(ctx context.Context, issuerDID core.DID, id uuid.UUID) (*domain.Link, error) {
@@ -70,6 +95,11 @@ SELECT links.id,
        links.credential_mtp_proof, 
        links.credential_attributes, 
        links.active, 
+       links.version,
+       links.proof_scope,
+       links.subject_external_id,
+       links.allowed_dids,
+       links.code_protected,
        count(claims.id) as issued_claims,
        schemas.id as schema_id,
        schemas.issuer_id as schema_issuer_id,
@@ -77,7 +107,9 @@ SELECT links.id,
        schemas.type,
        schemas.hash,
        schemas.attributes, 
-       schemas.created_at
+       schemas.created_at,
+       schemas.auto_revoke_on_expiration,
+       schemas.version
 FROM links
 LEFT JOIN schemas ON schemas.id = links.schema_id AND schemas.issuer_id = links.issuer_id
 LEFT JOIN claims ON claims.link_id = links.id AND claims.identifier = links.issuer_id
@@ -87,6 +119,7 @@ GROUP BY links.id, schemas.id
 	link := domain.Link{}
 	s := dbSchema{}
 	var credentialSubject pgtype.JSONB
+	var proofScope []byte
 	err := l.conn.Pgx.QueryRow(ctx, sql, id, issuerDID.String()).Scan(
 		&link.ID,
 		&link.IssuerDID,
@@ -99,6 +132,11 @@ GROUP BY links.id, schemas.id
 		&link.CredentialMTPProof,
 		&credentialSubject,
 		&link.Active,
+		&link.Version,
+		&proofScope,
+		&link.SubjectExternalID,
+		&link.AllowedDIDs,
+		&link.CodeProtected,
 		&link.IssuedClaims,
 		&s.ID,
 		&s.IssuerID,
@@ -107,6 +145,8 @@ GROUP BY links.id, schemas.id
 		&s.Hash,
 		&s.Attributes,
 		&s.CreatedAt,
+		&s.AutoRevokeOnExpiration,
+		&s.Version,
 	)
 	if err == pgx.ErrNoRows {
 		return nil, ErrLinkDoesNotExist
@@ -120,6 +160,9 @@ GROUP BY links.id, schemas.id
 	if err := d.Decode(&link.CredentialSubject); err != nil {
 		return nil, fmt.Errorf("parsing credential attributes: %w", err)
 	}
+	if err := unmarshalProofScope(proofScope, &link); err != nil {
+		return nil, err
+	}
 	link.Schema, err = toSchemaDomain(&s)
 	if err != nil {
 		return nil, fmt.Errorf("parsing link schema: %w", err)
@@ -140,6 +183,11 @@ SELECT links.id,
        links.credential_mtp_proof, 
        links.credential_attributes, 
        links.active,
+       links.version,
+       links.proof_scope,
+       links.subject_external_id,
+       links.allowed_dids,
+       links.code_protected,
        count(claims.id) as issued_claims,
        schemas.id as schema_id,
        schemas.issuer_id as schema_issuer_id,
@@ -147,7 +195,9 @@ SELECT links.id,
        schemas.type,
        schemas.hash,
        schemas.attributes, 
-       schemas.created_at
+       schemas.created_at,
+       schemas.auto_revoke_on_expiration,
+       schemas.version
 FROM links
 LEFT JOIN schemas ON schemas.id = links.schema_id
 LEFT JOIN claims ON claims.link_id = links.id AND claims.identifier = links.issuer_id
@@ -175,7 +225,7 @@ WHERE links.issuer_id = $1
 	if query != nil {
 		q = fullTextSearchQuery(*query, " | ")
 	}
-	rows, err := l.conn.Pgx.Query(ctx, sql, issuerDID.String(), time.Now(), q)
+	rows, err := l.conn.Replica.Query(ctx, sql, issuerDID.String(), time.Now(), q)
 	if err != nil {
 		return nil, err
 	}
@@ -185,6 +235,7 @@ WHERE links.issuer_id = $1
 	link := domain.Link{}
 	links := make([]domain.Link, 0)
 	var credentialAttributes pgtype.JSONB
+	var proofScope []byte
 	for rows.Next() {
 		if err := rows.Scan(
 			&link.ID,
@@ -197,6 +248,11 @@ WHERE links.issuer_id = $1
 			&link.CredentialSignatureProof,
 			&link.CredentialMTPProof, &credentialAttributes,
 			&link.Active,
+			&link.Version,
+			&proofScope,
+			&link.SubjectExternalID,
+			&link.AllowedDIDs,
+			&link.CodeProtected,
 			&link.IssuedClaims,
 			&schema.ID,
 			&schema.IssuerID,
@@ -205,6 +261,8 @@ WHERE links.issuer_id = $1
 			&schema.Hash,
 			&schema.Attributes,
 			&schema.CreatedAt,
+			&schema.AutoRevokeOnExpiration,
+			&schema.Version,
 		); err != nil {
 			return nil, err
 		}
@@ -212,6 +270,9 @@ WHERE links.issuer_id = $1
 		if err := credentialAttributes.AssignTo(&link.CredentialSubject); err != nil {
 			return nil, fmt.Errorf("parsing credential attributes: %w", err)
 		}
+		if err := unmarshalProofScope(proofScope, &link); err != nil {
+			return nil, err
+		}
 
 		link.Schema, err = toSchemaDomain(&schema)
 		if err != nil {
@@ -236,3 +297,15 @@ func (l link) Delete(ctx context.Context, id uuid.UUID, issuerDID core.DID) erro
 	}
 	return nil
 }
+
+// unmarshalProofScope sets the proof scope of the link, which is null for the links that do not require proofs
+func unmarshalProofScope(raw []byte, link *domain.Link) error {
+	link.ProofScope = nil
+	if len(raw) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(raw, &link.ProofScope); err != nil {
+		return fmt.Errorf("parsing proof scope: %w", err)
+	}
+	return nil
+}
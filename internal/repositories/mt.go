@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	core "github.com/iden3/go-iden3-core"
+	"github.com/iden3/go-merkletree-sql/v2"
 	"github.com/jackc/pgtype"
 
 	"github.com/polygonid/sh-id-platform/internal/core/domain"
@@ -78,3 +79,34 @@ func (mt *identityMerkleTreeRepository) GetByIdentifierAndTypes(ctx context.Cont
 
 	return trees, nil
 }
+
+// SaveNodes writes the nodes of the merkle tree with a statement, replacing the ones that have the same key
+func (mt *identityMerkleTreeRepository) SaveNodes(ctx context.Context, conn db.Querier, mtID uint64, nodes []merkletree.KV) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	keys := make([][]byte, len(nodes))
+	types := make([]int16, len(nodes))
+	childrenL := make([][]byte, len(nodes))
+	childrenR := make([][]byte, len(nodes))
+	entries := make([][]byte, len(nodes))
+	for i, node := range nodes {
+		keys[i] = node.K
+		types[i] = int16(node.V.Type)
+		if node.V.ChildL != nil {
+			childrenL[i] = node.V.ChildL[:]
+		}
+		if node.V.ChildR != nil {
+			childrenR[i] = node.V.ChildR[:]
+		}
+		if node.V.Entry[0] != nil && node.V.Entry[1] != nil {
+			entries[i] = append(node.V.Entry[0][:], node.V.Entry[1][:]...)
+		}
+	}
+	_, err := conn.Exec(ctx,
+		`INSERT INTO mt_nodes (mt_id, key, type, child_l, child_r, entry)
+			SELECT $1, * FROM unnest($2::bytea[], $3::smallint[], $4::bytea[], $5::bytea[], $6::bytea[])
+		ON CONFLICT (mt_id, key) DO UPDATE SET type = excluded.type, child_l = excluded.child_l, child_r = excluded.child_r, entry = excluded.entry`,
+		mtID, keys, types, childrenL, childrenR, entries)
+	return err
+}
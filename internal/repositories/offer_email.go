@@ -0,0 +1,111 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// ErrOfferEmailNotFound the offer email does not exist
+var ErrOfferEmailNotFound = errors.New("offer email not found")
+
+// offerEmailColumns reads the claim of the email from the credential offers fetched after it was sent
+const offerEmailColumns = `
+	offer_emails.id, offer_emails.issuer_id, offer_emails.claim_id, offer_emails.recipient, offer_emails.status,
+	offer_emails.error, offer_emails.sent_at, offer_emails.opened_at, offer_emails.clicked_at,
+	(SELECT min(credential_offers.consumed_at) FROM credential_offers
+	 WHERE credential_offers.claim_id = offer_emails.claim_id AND credential_offers.consumed_at >= offer_emails.created_at),
+	offer_emails.created_at`
+
+type offerEmails struct{}
+
+// NewOfferEmail returns a new repository of the credential offers sent by email
+func NewOfferEmail() ports.OfferEmailRepository {
+	return &offerEmails{}
+}
+
+// Save inserts the offer email or updates its delivery status
+func (r *offerEmails) Save(ctx context.Context, conn db.Querier, email *domain.OfferEmail) error {
+	_, err := conn.Exec(ctx, `
+		INSERT INTO offer_emails (id, issuer_id, claim_id, recipient, status, error, sent_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (id) DO UPDATE SET status = $5, error = $6, sent_at = $7`,
+		email.ID, email.IssuerDID.String(), email.CredentialID, email.Recipient, email.Status, email.Error, email.SentAt, email.CreatedAt)
+	return err
+}
+
+// GetByID returns the offer email
+func (r *offerEmails) GetByID(ctx context.Context, conn db.Querier, id uuid.UUID) (*domain.OfferEmail, error) {
+	email, err := scanOfferEmail(conn.QueryRow(ctx, `SELECT `+offerEmailColumns+` FROM offer_emails WHERE offer_emails.id = $1`, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrOfferEmailNotFound
+	}
+	return email, err
+}
+
+// GetByCredential returns the offer emails of the credential, the last one first
+func (r *offerEmails) GetByCredential(ctx context.Context, conn db.Querier, issuerDID core.DID, credentialID uuid.UUID) ([]*domain.OfferEmail, error) {
+	rows, err := conn.Query(ctx, `SELECT `+offerEmailColumns+`
+		FROM offer_emails
+		WHERE offer_emails.issuer_id = $1 AND offer_emails.claim_id = $2
+		ORDER BY offer_emails.created_at DESC`, issuerDID.String(), credentialID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	emails := make([]*domain.OfferEmail, 0)
+	for rows.Next() {
+		email, err := scanOfferEmail(rows)
+		if err != nil {
+			return nil, err
+		}
+		emails = append(emails, email)
+	}
+	return emails, rows.Err()
+}
+
+// Opened records the first time the offer email is opened
+func (r *offerEmails) Opened(ctx context.Context, conn db.Querier, id uuid.UUID, at time.Time) error {
+	return r.track(ctx, conn, `UPDATE offer_emails SET opened_at = coalesce(opened_at, $2) WHERE id = $1`, id, at)
+}
+
+// Clicked records the first time the claim link of the offer email is followed. A click also means the email was
+// opened, even if its images were blocked.
+func (r *offerEmails) Clicked(ctx context.Context, conn db.Querier, id uuid.UUID, at time.Time) error {
+	return r.track(ctx, conn, `UPDATE offer_emails SET clicked_at = coalesce(clicked_at, $2), opened_at = coalesce(opened_at, $2) WHERE id = $1`, id, at)
+}
+
+func (r *offerEmails) track(ctx context.Context, conn db.Querier, sql string, id uuid.UUID, at time.Time) error {
+	cmd, err := conn.Exec(ctx, sql, id, at)
+	if err != nil {
+		return err
+	}
+	if cmd.RowsAffected() == 0 {
+		return ErrOfferEmailNotFound
+	}
+	return nil
+}
+
+func scanOfferEmail(row pgx.Row) (*domain.OfferEmail, error) {
+	var issuerID string
+	email := &domain.OfferEmail{}
+	if err := row.Scan(&email.ID, &issuerID, &email.CredentialID, &email.Recipient, &email.Status, &email.Error,
+		&email.SentAt, &email.OpenedAt, &email.ClickedAt, &email.ClaimedAt, &email.CreatedAt); err != nil {
+		return nil, err
+	}
+	issuerDID, err := core.ParseDID(issuerID)
+	if err != nil {
+		return nil, err
+	}
+	email.IssuerDID = *issuerDID
+	return email, nil
+}
@@ -0,0 +1,113 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+var (
+	// ErrOID4VCIOfferNotFound the offer does not exist
+	ErrOID4VCIOfferNotFound = errors.New("offer not found")
+	// ErrOID4VCIOfferRedeemed the pre-authorized code of the offer was already exchanged
+	ErrOID4VCIOfferRedeemed = errors.New("offer already redeemed")
+	// ErrOID4VCINonceChanged the nonce of the offer is not the expected one, it was used by another request
+	ErrOID4VCINonceChanged = errors.New("nonce changed")
+)
+
+type oid4vci struct{}
+
+// NewOID4VCI returns a new OpenID4VCI offers repository
+func NewOID4VCI() ports.OID4VCIRepository {
+	return &oid4vci{}
+}
+
+// Save inserts the offer
+func (r *oid4vci) Save(ctx context.Context, conn db.Querier, offer *domain.OID4VCIOffer) error {
+	credential, err := json.Marshal(offer.Credential)
+	if err != nil {
+		return err
+	}
+	_, err = conn.Exec(ctx, `
+		INSERT INTO oid4vci_offers (id, issuer_id, pre_authorized_code, credential, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)`,
+		offer.ID, offer.IssuerDID.String(), offer.PreAuthorizedCodeHash, credential, offer.ExpiresAt, offer.CreatedAt)
+	return err
+}
+
+// GetByPreAuthorizedCode returns the offer of the hash of a pre-authorized code
+func (r *oid4vci) GetByPreAuthorizedCode(ctx context.Context, conn db.Querier, codeHash string) (*domain.OID4VCIOffer, error) {
+	return r.get(ctx, conn, "pre_authorized_code", codeHash)
+}
+
+// GetByAccessToken returns the offer of the hash of an access token
+func (r *oid4vci) GetByAccessToken(ctx context.Context, conn db.Querier, tokenHash string) (*domain.OID4VCIOffer, error) {
+	return r.get(ctx, conn, "access_token", tokenHash)
+}
+
+// Redeem sets the access token of the offer, unless its pre-authorized code was already exchanged
+func (r *oid4vci) Redeem(ctx context.Context, conn db.Querier, id uuid.UUID, tokenHash string, tokenExpiresAt time.Time, cNonce string) error {
+	cmd, err := conn.Exec(ctx, `
+		UPDATE oid4vci_offers SET access_token = $2, token_expires_at = $3, c_nonce = $4
+		WHERE id = $1 AND access_token IS NULL`, id, tokenHash, tokenExpiresAt, cNonce)
+	if err != nil {
+		return err
+	}
+	if cmd.RowsAffected() == 0 {
+		return ErrOID4VCIOfferRedeemed
+	}
+	return nil
+}
+
+// SetNonce replaces the nonce of the offer, unless it is no longer the current one
+func (r *oid4vci) SetNonce(ctx context.Context, conn db.Querier, id uuid.UUID, current string, next string) error {
+	cmd, err := conn.Exec(ctx, `UPDATE oid4vci_offers SET c_nonce = $3 WHERE id = $1 AND c_nonce = $2`, id, current, next)
+	if err != nil {
+		return err
+	}
+	if cmd.RowsAffected() == 0 {
+		return ErrOID4VCINonceChanged
+	}
+	return nil
+}
+
+// SetClaim sets the credential issued for the offer
+func (r *oid4vci) SetClaim(ctx context.Context, conn db.Querier, id uuid.UUID, claimID uuid.UUID) error {
+	_, err := conn.Exec(ctx, `UPDATE oid4vci_offers SET claim_id = $2 WHERE id = $1`, id, claimID)
+	return err
+}
+
+func (r *oid4vci) get(ctx context.Context, conn db.Querier, column string, hash string) (*domain.OID4VCIOffer, error) {
+	var offer domain.OID4VCIOffer
+	var issuer string
+	var credential []byte
+	err := conn.QueryRow(ctx, `
+		SELECT id, issuer_id, pre_authorized_code, credential, access_token, token_expires_at, c_nonce, claim_id, expires_at, created_at
+		FROM oid4vci_offers
+		WHERE `+column+` = $1`, hash).Scan(&offer.ID, &issuer, &offer.PreAuthorizedCodeHash, &credential, &offer.AccessTokenHash,
+		&offer.TokenExpiresAt, &offer.CNonce, &offer.ClaimID, &offer.ExpiresAt, &offer.CreatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrOID4VCIOfferNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	did, err := core.ParseDID(issuer)
+	if err != nil {
+		return nil, err
+	}
+	offer.IssuerDID = *did
+	if err := json.Unmarshal(credential, &offer.Credential); err != nil {
+		return nil, err
+	}
+	return &offer, nil
+}
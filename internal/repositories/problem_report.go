@@ -0,0 +1,74 @@
+package repositories
+
+import (
+	"context"
+
+	core "github.com/iden3/go-iden3-core"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+const problemReportColumns = `id, issuer_id, direction, holder, message_id, thread_id, code, comment, claim_id, connection_id, created_at`
+
+type problemReports struct{}
+
+// NewProblemReport returns a new repository of the problem reports of the agent
+func NewProblemReport() ports.ProblemReportRepository {
+	return &problemReports{}
+}
+
+// Save inserts the problem report. It is attached to the connection of its holder with the issuer, if there is one.
+func (r *problemReports) Save(ctx context.Context, conn db.Querier, report *domain.ProblemReport) error {
+	return conn.QueryRow(ctx, `
+		INSERT INTO problem_reports (id, issuer_id, direction, holder, message_id, thread_id, code, comment, claim_id, connection_id, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9,
+			(SELECT id FROM connections WHERE issuer_id = $2 AND user_id = $4 AND deleted_at IS NULL), $10)
+		RETURNING connection_id`,
+		report.ID, report.IssuerDID.String(), report.Direction, report.Holder, report.MessageID, report.ThreadID,
+		report.Code, report.Comment, report.CredentialID, report.CreatedAt).Scan(&report.ConnectionID)
+}
+
+// GetAll returns the problem reports of the issuer that match the filter. The reports of a credential are the ones
+// attached to it and the ones of the threads of its offers.
+func (r *problemReports) GetAll(ctx context.Context, conn db.Querier, issuerDID core.DID, filter ports.ProblemReportsFilter) ([]*domain.ProblemReport, error) {
+	rows, err := conn.Query(ctx, `
+		SELECT `+problemReportColumns+`
+		FROM problem_reports
+		WHERE issuer_id = $1
+			AND ($2::uuid IS NULL OR claim_id = $2 OR thread_id IN (
+				SELECT id::text FROM credential_offers WHERE claim_id = $2 AND issuer_id = $1))
+			AND ($3::uuid IS NULL OR connection_id = $3)
+		ORDER BY created_at DESC`, issuerDID.String(), filter.CredentialID, filter.ConnectionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	reports := make([]*domain.ProblemReport, 0)
+	for rows.Next() {
+		report, err := scanProblemReport(rows)
+		if err != nil {
+			return nil, err
+		}
+		reports = append(reports, report)
+	}
+	return reports, rows.Err()
+}
+
+func scanProblemReport(row pgx.Row) (*domain.ProblemReport, error) {
+	var issuerID string
+	report := &domain.ProblemReport{}
+	if err := row.Scan(&report.ID, &issuerID, &report.Direction, &report.Holder, &report.MessageID, &report.ThreadID,
+		&report.Code, &report.Comment, &report.CredentialID, &report.ConnectionID, &report.CreatedAt); err != nil {
+		return nil, err
+	}
+	issuerDID, err := core.ParseDID(issuerID)
+	if err != nil {
+		return nil, err
+	}
+	report.IssuerDID = *issuerDID
+	return report, nil
+}
@@ -0,0 +1,137 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	core "github.com/iden3/go-iden3-core"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+const publishingPoliciesIdentifierFKey = "publishing_policies_identifier_fkey"
+
+var (
+	// ErrPublishingPolicyNotFound the identity has no publishing policy of its own
+	ErrPublishingPolicyNotFound = errors.New("publishing policy not found")
+	// ErrPublishingPolicyIdentityNotFound the identity of the publishing policy does not exist
+	ErrPublishingPolicyIdentityNotFound = errors.New("identity not found")
+)
+
+type publishingPolicies struct{}
+
+// NewPublishingPolicy returns a new publishing policies repository
+func NewPublishingPolicy() ports.PublishingPolicyRepository {
+	return &publishingPolicies{}
+}
+
+// Save inserts the policy of the identity or replaces it
+func (r *publishingPolicies) Save(ctx context.Context, conn db.Querier, policy *domain.PublishingPolicy) error {
+	err := conn.QueryRow(ctx, `
+		INSERT INTO publishing_policies (identifier, mode, interval_seconds, threshold, revocation_window_seconds, updated_at)
+		VALUES ($1, $2, $3, $4, $5, CURRENT_TIMESTAMP)
+		ON CONFLICT (identifier) DO UPDATE SET mode = $2, interval_seconds = $3, threshold = $4, revocation_window_seconds = $5, updated_at = CURRENT_TIMESTAMP
+		RETURNING updated_at`,
+		policy.Identifier, policy.Mode, int(policy.Interval.Seconds()), policy.Threshold, int(policy.RevocationWindow.Seconds())).Scan(&policy.UpdatedAt)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.ConstraintName == publishingPoliciesIdentifierFKey {
+		return ErrPublishingPolicyIdentityNotFound
+	}
+	return err
+}
+
+// Delete removes the policy of the identity. It is not an error if there is no policy.
+func (r *publishingPolicies) Delete(ctx context.Context, conn db.Querier, identifier core.DID) error {
+	_, err := conn.Exec(ctx, `DELETE FROM publishing_policies WHERE identifier = $1`, identifier.String())
+	return err
+}
+
+// GetByIdentifier returns the policy of the identity
+func (r *publishingPolicies) GetByIdentifier(ctx context.Context, conn db.Querier, identifier core.DID) (*domain.PublishingPolicy, error) {
+	row := conn.QueryRow(ctx, `
+		SELECT identifier, mode, interval_seconds, threshold, revocation_window_seconds, updated_at
+		FROM publishing_policies
+		WHERE identifier = $1`, identifier.String())
+	policy, err := scanPublishingPolicy(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrPublishingPolicyNotFound
+	}
+	return policy, err
+}
+
+// GetAll returns the policies of every identity that has one
+func (r *publishingPolicies) GetAll(ctx context.Context, conn db.Querier) ([]*domain.PublishingPolicy, error) {
+	rows, err := conn.Query(ctx, `
+		SELECT identifier, mode, interval_seconds, threshold, revocation_window_seconds, updated_at
+		FROM publishing_policies`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	policies := make([]*domain.PublishingPolicy, 0)
+	for rows.Next() {
+		policy, err := scanPublishingPolicy(rows)
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, policy)
+	}
+	return policies, rows.Err()
+}
+
+// GetPendingChanges returns the number of claims and revocations waiting for a new state of every identity that can
+// publish one, and when the oldest of those revocations was requested. Identities with a state being published, or that failed to be published, are left out, as well as the
+// identities that are migrated.
+func (r *publishingPolicies) GetPendingChanges(ctx context.Context, conn db.Querier) ([]*domain.PendingChanges, error) {
+	rows, err := conn.Query(ctx, `
+		WITH pending AS
+		(
+			SELECT issuer AS identifier, COUNT(*) AS claims, 0 AS revocations, NULL::timestamptz AS oldest_revocation_at
+			FROM claims
+			WHERE identity_state ISNULL AND identifier = issuer
+			GROUP BY issuer
+				UNION ALL
+			SELECT identifier, 0 AS claims, COUNT(*) AS revocations, MIN(created_at) AS oldest_revocation_at
+			FROM revocation
+			WHERE status = 0
+			GROUP BY identifier
+		)
+		SELECT pending.identifier, SUM(pending.claims + pending.revocations)::integer, SUM(pending.revocations)::integer,
+			MIN(pending.oldest_revocation_at),
+			(SELECT MAX(created_at) FROM identity_states WHERE identity_states.identifier = pending.identifier)
+		FROM pending
+		WHERE NOT EXISTS (SELECT 1 FROM identity_states WHERE identity_states.identifier = pending.identifier AND status IN ('transacted', 'failed'))
+			AND NOT EXISTS (SELECT 1 FROM identity_migrations WHERE identity_migrations.identifier = pending.identifier)
+		GROUP BY pending.identifier`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	pending := make([]*domain.PendingChanges, 0)
+	for rows.Next() {
+		var changes domain.PendingChanges
+		if err := rows.Scan(&changes.Identifier, &changes.Changes, &changes.Revocations, &changes.OldestRevocationAt, &changes.LastStateAt); err != nil {
+			return nil, err
+		}
+		pending = append(pending, &changes)
+	}
+	return pending, rows.Err()
+}
+
+func scanPublishingPolicy(row pgx.Row) (*domain.PublishingPolicy, error) {
+	policy := domain.PublishingPolicy{Overridden: true}
+	var intervalSeconds, revocationWindowSeconds int
+	if err := row.Scan(&policy.Identifier, &policy.Mode, &intervalSeconds, &policy.Threshold, &revocationWindowSeconds, &policy.UpdatedAt); err != nil {
+		return nil, err
+	}
+	policy.Interval = time.Duration(intervalSeconds) * time.Second
+	policy.RevocationWindow = time.Duration(revocationWindowSeconds) * time.Second
+	return &policy, nil
+}
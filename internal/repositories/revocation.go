@@ -2,6 +2,7 @@ package repositories
 
 import (
 	"context"
+	"time"
 
 	core "github.com/iden3/go-iden3-core"
 
@@ -19,7 +20,7 @@ func NewRevocation() ports.RevocationRepository {
 
 func (r *revocation) UpdateStatus(ctx context.Context, conn db.Querier, did *core.DID) ([]*domain.Revocation, error) {
 	rows, err := conn.Query(ctx, `UPDATE revocation SET status = $2 WHERE identifier = $1 AND status = $3
-RETURNING identifier, nonce, version, status, description`,
+RETURNING identifier, nonce, version, status, reason, description, created_at`,
 		did.String(), domain.RevPublished, domain.RevPending)
 	if err != nil {
 		return nil, err
@@ -29,7 +30,7 @@ RETURNING identifier, nonce, version, status, description`,
 	var revs []*domain.Revocation
 	for rows.Next() {
 		var revoke domain.Revocation
-		if err = rows.Scan(&revoke.Identifier, &revoke.Nonce, &revoke.Version, &revoke.Status, &revoke.Description); err != nil {
+		if err = rows.Scan(&revoke.Identifier, &revoke.Nonce, &revoke.Version, &revoke.Status, &revoke.Reason, &revoke.Description, &revoke.CreatedAt); err != nil {
 			return nil, err
 		}
 		revs = append(revs, &revoke)
@@ -40,3 +41,47 @@ RETURNING identifier, nonce, version, status, description`,
 
 	return revs, nil
 }
+
+// GetByNonces returns the revocations of the identity with the given nonces. Nonces that were not revoked have none.
+func (r *revocation) GetByNonces(ctx context.Context, conn db.Querier, did *core.DID, nonces []domain.RevNonceUint64) ([]*domain.Revocation, error) {
+	rows, err := conn.Query(ctx, `SELECT identifier, nonce, version, status, reason, description, created_at FROM revocation
+WHERE identifier = $1 AND nonce = ANY($2::text[]::numeric[])`,
+		did.String(), revNoncesToStrings(nonces))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	revs := make([]*domain.Revocation, 0, len(nonces))
+	for rows.Next() {
+		var revoke domain.Revocation
+		if err = rows.Scan(&revoke.Identifier, &revoke.Nonce, &revoke.Version, &revoke.Status, &revoke.Reason, &revoke.Description, &revoke.CreatedAt); err != nil {
+			return nil, err
+		}
+		revs = append(revs, &revoke)
+	}
+	return revs, rows.Err()
+}
+
+// GetRevokedNonces returns the nonces revoked by the identity at or after since, or all of them if it is nil, in
+// ascending order
+func (r *revocation) GetRevokedNonces(ctx context.Context, conn db.Querier, did *core.DID, since *time.Time) ([]domain.RevNonceUint64, error) {
+	rows, err := conn.Query(ctx, `SELECT DISTINCT nonce FROM revocation
+WHERE identifier = $1 AND ($2::timestamptz IS NULL OR created_at >= $2)
+ORDER BY nonce`,
+		did.String(), since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	nonces := make([]domain.RevNonceUint64, 0)
+	for rows.Next() {
+		var nonce domain.RevNonceUint64
+		if err = rows.Scan(&nonce); err != nil {
+			return nil, err
+		}
+		nonces = append(nonces, nonce)
+	}
+	return nonces, rows.Err()
+}
@@ -0,0 +1,128 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	core "github.com/iden3/go-iden3-core"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+var (
+	// ErrRevocationDecisionNotFound the source has not sent a decision with that id
+	ErrRevocationDecisionNotFound = errors.New("revocation decision not found")
+	// ErrRevocationDecisionDuplicated the source already sent a decision with that id
+	ErrRevocationDecisionDuplicated = errors.New("revocation decision already received")
+)
+
+const revocationDecisionColumns = `id, issuer_id, source, external_id, credential_id, rev_nonce, reason, status, error, decided_at, created_at`
+
+type revocationDecisions struct{}
+
+// NewRevocationDecision returns a new revocation decisions repository
+func NewRevocationDecision() ports.RevocationDecisionRepository {
+	return &revocationDecisions{}
+}
+
+// Save inserts the decision. It returns ErrRevocationDecisionDuplicated if the source already sent a decision with
+// the same id.
+func (r *revocationDecisions) Save(ctx context.Context, conn db.Querier, decision *domain.RevocationDecision) error {
+	var nonce *uint64
+	if decision.RevNonce != nil {
+		n := uint64(*decision.RevNonce)
+		nonce = &n
+	}
+	tag, err := conn.Exec(ctx, `
+		INSERT INTO revocation_decisions (`+revocationDecisionColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		ON CONFLICT (issuer_id, source, external_id) DO NOTHING`,
+		decision.ID, decision.IssuerDID.String(), decision.Source, decision.ExternalID, decision.CredentialID, nonce,
+		decision.Reason, decision.Status, decision.Error, decision.DecidedAt, decision.CreatedAt)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrRevocationDecisionDuplicated
+	}
+	return nil
+}
+
+// GetByExternalID returns the decision of the source with the given id
+func (r *revocationDecisions) GetByExternalID(ctx context.Context, conn db.Querier, issuerDID core.DID, source string, externalID string) (*domain.RevocationDecision, error) {
+	row := conn.QueryRow(ctx, `
+		SELECT `+revocationDecisionColumns+`
+		FROM revocation_decisions
+		WHERE issuer_id = $1 AND source = $2 AND external_id = $3`, issuerDID.String(), source, externalID)
+	decision, err := scanRevocationDecision(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrRevocationDecisionNotFound
+	}
+	return decision, err
+}
+
+// GetAll returns the decisions of the issuer that match the filter, sorted by reception
+func (r *revocationDecisions) GetAll(ctx context.Context, conn db.Querier, issuerDID core.DID, filter *ports.RevocationDecisionsFilter) ([]*domain.RevocationDecision, error) {
+	var status *string
+	if filter.Status != nil {
+		s := string(*filter.Status)
+		status = &s
+	}
+	rows, err := conn.Query(ctx, `
+		SELECT `+revocationDecisionColumns+`
+		FROM revocation_decisions
+		WHERE issuer_id = $1
+		  AND ($2 = '' OR source = $2)
+		  AND ($3::text IS NULL OR status = $3)
+		  AND ($4::timestamptz IS NULL OR created_at >= $4)
+		  AND ($5::timestamptz IS NULL OR created_at < $5)
+		ORDER BY created_at, id`, issuerDID.String(), filter.Source, status, filter.From, filter.To)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	decisions := make([]*domain.RevocationDecision, 0)
+	for rows.Next() {
+		decision, err := scanRevocationDecision(rows)
+		if err != nil {
+			return nil, err
+		}
+		decisions = append(decisions, decision)
+	}
+	return decisions, rows.Err()
+}
+
+// GetLatestDecidedAt returns when the latest decision received from the source was taken, nil if there is none
+func (r *revocationDecisions) GetLatestDecidedAt(ctx context.Context, conn db.Querier, issuerDID core.DID, source string) (*time.Time, error) {
+	var latest *time.Time
+	err := conn.QueryRow(ctx, `
+		SELECT MAX(decided_at) FROM revocation_decisions WHERE issuer_id = $1 AND source = $2`,
+		issuerDID.String(), source).Scan(&latest)
+	return latest, err
+}
+
+func scanRevocationDecision(row pgx.Row) (*domain.RevocationDecision, error) {
+	var decision domain.RevocationDecision
+	var issuer, status string
+	var nonce *uint64
+	if err := row.Scan(&decision.ID, &issuer, &decision.Source, &decision.ExternalID, &decision.CredentialID, &nonce,
+		&decision.Reason, &status, &decision.Error, &decision.DecidedAt, &decision.CreatedAt); err != nil {
+		return nil, err
+	}
+	did, err := core.ParseDID(issuer)
+	if err != nil {
+		return nil, err
+	}
+	decision.IssuerDID = *did
+	decision.Status = domain.RevocationDecisionStatus(status)
+	if nonce != nil {
+		n := domain.RevNonceUint64(*nonce)
+		decision.RevNonce = &n
+	}
+	return &decision, nil
+}
@@ -7,6 +7,7 @@ import (
 	core "github.com/iden3/go-iden3-core"
 
 	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
 )
 
 type schemaInMemory struct {
@@ -19,6 +20,26 @@ func NewSchemaInMemory() *schemaInMemory {
 }
 
 func (s *schemaInMemory) Save(_ context.Context, schema *domain.Schema) error {
+	schema.Version = 1
+	schema.SchemaVersion = 1
+	for _, stored := range s.schemas {
+		if stored.IssuerDID.String() == schema.IssuerDID.String() && stored.Type == schema.Type && stored.SchemaVersion >= schema.SchemaVersion {
+			schema.SchemaVersion = stored.SchemaVersion + 1
+		}
+	}
+	s.schemas[schema.ID] = *schema
+	return nil
+}
+
+func (s *schemaInMemory) Update(_ context.Context, schema *domain.Schema) error {
+	stored, found := s.schemas[schema.ID]
+	if !found {
+		return ErrSchemaDoesNotExist
+	}
+	if stored.Version != schema.Version {
+		return ErrVersionConflict
+	}
+	schema.Version++
 	s.schemas[schema.ID] = *schema
 	return nil
 }
@@ -30,13 +51,35 @@ func (s *schemaInMemory) GetByID(_ context.Context, _ core.DID, id uuid.UUID) (*
 	return nil, ErrSchemaDoesNotExist
 }
 
-// GetAll returns all. WARNING: query param will not work in the same way as DB repo
-func (s *schemaInMemory) GetAll(_ context.Context, _ core.DID, _ *string) ([]domain.Schema, error) {
-	schemas := make([]domain.Schema, len(s.schemas))
-	i := 0
+// GetAll returns all the schemas with the status and version of the filter. WARNING: the query of the filter is
+// ignored
+func (s *schemaInMemory) GetAll(_ context.Context, _ core.DID, filter *ports.SchemasFilter) ([]domain.Schema, error) {
+	schemas := make([]domain.Schema, 0, len(s.schemas))
+	for _, schema := range s.schemas {
+		if filter != nil && filter.Status != "" && schema.Status() != filter.Status {
+			continue
+		}
+		if filter != nil && filter.SchemaVersion != nil && schema.SchemaVersion != *filter.SchemaVersion {
+			continue
+		}
+		schemas = append(schemas, schema)
+	}
+	return schemas, nil
+}
+
+func (s *schemaInMemory) GetDocuments(_ context.Context, id uuid.UUID) (*domain.SchemaDocuments, error) {
+	if schema, found := s.schemas[id]; found && schema.Documents != nil {
+		return schema.Documents, nil
+	}
+	return nil, ErrSchemaDoesNotExist
+}
+
+func (s *schemaInMemory) GetByURL(_ context.Context, issuerDID core.DID, url string, sType string) ([]domain.Schema, error) {
+	schemas := make([]domain.Schema, 0)
 	for _, schema := range s.schemas {
-		schemas[i] = schema
-		i++
+		if schema.IssuerDID.String() == issuerDID.String() && schema.URL == url && schema.Type == sType {
+			schemas = append(schemas, schema)
+		}
 	}
 	return schemas, nil
 }
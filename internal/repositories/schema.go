@@ -12,6 +12,7 @@ import (
 	"github.com/jackc/pgx/v4"
 
 	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
 	"github.com/polygonid/sh-id-platform/internal/db"
 )
 
@@ -26,6 +27,19 @@ type dbSchema struct {
 	Hash       string
 	Attributes string
 	CreatedAt  time.Time
+
+	AutoRevokeOnExpiration  bool
+	Version                 int
+	ValidationWebhookURL    *string
+	ValidationWebhookSecret *string
+	ExtraContexts           []string
+	ExtraTypes              []string
+	DefaultProofTypes       []string
+	MaxValidityDays         *int
+	IPFSCID                 *string
+	IPFSContextCID          *string
+	SchemaVersion           int
+	DeprecatedAt            *time.Time
 }
 
 type schema struct {
@@ -37,24 +51,79 @@ func NewSchema(conn db.Storage) *schema {
 	return &schema{conn: conn}
 }
 
-// Save stores a new entry in schemas table
+// Save stores a new entry in schemas table, with its documents if it was built in the node
 func (r *schema) Save(ctx context.Context, s *domain.Schema) error {
-	const insertSchema = `INSERT INTO schemas (id, issuer_id, url, type, attributes, hash, ts_words, created_at) VALUES($1, $2::text, $3::text, $4::text, $5::text, $6::text, to_tsvector($7::text), $8);`
+	const insertSchema = `INSERT INTO schemas (id, issuer_id, url, type, attributes, hash, ts_words, created_at, auto_revoke_on_expiration, validation_webhook_url, validation_webhook_secret, extra_contexts, extra_types, default_proof_types, max_validity_days, ipfs_cid, ipfs_context_cid, schema_version)
+		VALUES($1, $2::text, $3::text, $4::text, $5::text, $6::text, to_tsvector($7::text), $8, $9, $10, $11, $12, $13, $14, $15, $16, $17,
+			(SELECT COALESCE(MAX(schema_version), 0) + 1 FROM schemas WHERE issuer_id = $2::text AND type = $4::text))
+		RETURNING version, schema_version;`
 	hash, err := s.Hash.MarshalText()
 	if err != nil {
 		return err
 	}
-	_, err = r.conn.Pgx.Exec(
-		ctx,
-		insertSchema,
-		s.ID,
-		s.IssuerDID.String(),
-		s.URL,
-		s.Type,
-		s.Attributes.String(),
-		string(hash),
-		r.toFullTextSearchDocument(s.Type, s.Attributes),
-		s.CreatedAt)
+	webhookURL, webhookSecret := webhookColumns(s.ValidationWebhook)
+	return r.conn.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+		// Serializes the imports of the same type, so each one gets its own schema version
+		if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtext($1))`, s.IssuerDID.String()+"#"+s.Type); err != nil {
+			return err
+		}
+		err := tx.QueryRow(
+			ctx,
+			insertSchema,
+			s.ID,
+			s.IssuerDID.String(),
+			s.URL,
+			s.Type,
+			s.Attributes.String(),
+			string(hash),
+			r.toFullTextSearchDocument(s.Type, s.Attributes),
+			s.CreatedAt,
+			s.AutoRevokeOnExpiration,
+			webhookURL,
+			webhookSecret,
+			extensionColumn(s.ExtraContexts),
+			extensionColumn(s.ExtraTypes),
+			proofTypesColumn(s.DefaultProofTypes),
+			s.MaxValidityDays,
+			nullableString(s.IPFSCID),
+			nullableString(s.IPFSContextCID)).Scan(&s.Version, &s.SchemaVersion)
+		if err != nil || s.Documents == nil {
+			return err
+		}
+		_, err = tx.Exec(ctx, `INSERT INTO schema_documents (schema_id, json_schema, jsonld_context) VALUES ($1, $2, $3)`,
+			s.ID, s.Documents.JSONSchema, s.Documents.JSONLDContext)
+		return err
+	})
+}
+
+// GetDocuments returns the documents of a schema built in the node. They are public, so the schema is looked up by
+// its id alone.
+func (r *schema) GetDocuments(ctx context.Context, id uuid.UUID) (*domain.SchemaDocuments, error) {
+	var documents domain.SchemaDocuments
+	err := r.conn.Pgx.QueryRow(ctx, `SELECT json_schema::text, jsonld_context::text FROM schema_documents WHERE schema_id = $1`, id).
+		Scan(&documents.JSONSchema, &documents.JSONLDContext)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrSchemaDoesNotExist
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &documents, nil
+}
+
+// Update stores the mutable settings of an existing schema. The update only succeeds if the version of the schema
+// has not changed since it was read, and then the version is incremented.
+func (r *schema) Update(ctx context.Context, s *domain.Schema) error {
+	const updateSchema = `UPDATE schemas SET auto_revoke_on_expiration = $3, validation_webhook_url = $5, validation_webhook_secret = $6, extra_contexts = $7, extra_types = $8, deprecated_at = $9, default_proof_types = $10, max_validity_days = $11, version = version + 1 WHERE issuer_id = $1 AND id = $2 AND version = $4 RETURNING version`
+	webhookURL, webhookSecret := webhookColumns(s.ValidationWebhook)
+	err := r.conn.Pgx.QueryRow(ctx, updateSchema, s.IssuerDID.String(), s.ID, s.AutoRevokeOnExpiration, s.Version, webhookURL, webhookSecret,
+		extensionColumn(s.ExtraContexts), extensionColumn(s.ExtraTypes), s.DeprecatedAt, proofTypesColumn(s.DefaultProofTypes), s.MaxValidityDays).Scan(&s.Version)
+	if errors.Is(err, pgx.ErrNoRows) {
+		if _, err := r.GetByID(ctx, s.IssuerDID, s.ID); err != nil {
+			return err
+		}
+		return ErrVersionConflict
+	}
 	return err
 }
 
@@ -68,34 +137,49 @@ func (r *schema) toFullTextSearchDocument(sType string, attrs domain.SchemaAttrs
 	return sb.String()
 }
 
-// GetAll returns all the schemas that match any of the words that are included in the query string.
-// For each word, it will search for attributes that start with it or include it following postgres full text search tokenization
-func (r *schema) GetAll(ctx context.Context, issuerDID core.DID, query *string) ([]domain.Schema, error) {
-	const all = `SELECT id, issuer_id, url, type, attributes, hash, created_at
+// GetAll returns the schemas that match the filter, newest first. The query of the filter matches the schemas with
+// any of its words. For each word, it will search for attributes that start with it or include it following postgres
+// full text search tokenization
+func (r *schema) GetAll(ctx context.Context, issuerDID core.DID, filter *ports.SchemasFilter) ([]domain.Schema, error) {
+	var sql strings.Builder
+	sql.WriteString(`SELECT id, issuer_id, url, type, attributes, hash, created_at, auto_revoke_on_expiration, version, validation_webhook_url,
+		validation_webhook_secret, extra_contexts, extra_types, default_proof_types, max_validity_days, ipfs_cid, ipfs_context_cid, schema_version, deprecated_at
 	FROM schemas
-	WHERE issuer_id=$1
-	ORDER BY created_at DESC`
-	const allFTS = `
-SELECT id, issuer_id, url, type, attributes, hash, created_at 
-FROM schemas 
-WHERE issuer_id=$1 AND ts_words @@ to_tsquery($2)
-ORDER BY created_at DESC`
-	var err error
-	var rows pgx.Rows
-
-	if query != nil && *query != "" {
-		rows, err = r.conn.Pgx.Query(ctx, allFTS, issuerDID.String(), fullTextSearchQuery(*query, " | "))
-	} else {
-		rows, err = r.conn.Pgx.Query(ctx, all, issuerDID.String())
+	WHERE issuer_id=$1`)
+	args := []interface{}{issuerDID.String()}
+	if filter != nil {
+		if filter.Query != nil && *filter.Query != "" {
+			args = append(args, fullTextSearchQuery(*filter.Query, " | "))
+			sql.WriteString(fmt.Sprintf(" AND ts_words @@ to_tsquery($%d)", len(args)))
+		}
+		switch filter.Status {
+		case domain.SchemaStatusActive:
+			sql.WriteString(" AND deprecated_at IS NULL")
+		case domain.SchemaStatusDeprecated:
+			sql.WriteString(" AND deprecated_at IS NOT NULL")
+		}
+		if filter.SchemaVersion != nil {
+			args = append(args, *filter.SchemaVersion)
+			sql.WriteString(fmt.Sprintf(" AND schema_version = $%d", len(args)))
+		}
+		if len(filter.URLs) > 0 {
+			args = append(args, filter.URLs)
+			sql.WriteString(fmt.Sprintf(" AND url = ANY($%d)", len(args)))
+		}
 	}
+	sql.WriteString(" ORDER BY created_at DESC")
+
+	rows, err := r.conn.Replica.Query(ctx, sql.String(), args...)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 	schemaCol := make([]domain.Schema, 0)
-	s := dbSchema{}
 	for rows.Next() {
-		if err := rows.Scan(&s.ID, &s.IssuerID, &s.URL, &s.Type, &s.Attributes, &s.Hash, &s.CreatedAt); err != nil {
+		s := dbSchema{}
+		if err := rows.Scan(&s.ID, &s.IssuerID, &s.URL, &s.Type, &s.Attributes, &s.Hash, &s.CreatedAt, &s.AutoRevokeOnExpiration, &s.Version,
+			&s.ValidationWebhookURL, &s.ValidationWebhookSecret, &s.ExtraContexts, &s.ExtraTypes, &s.DefaultProofTypes, &s.MaxValidityDays, &s.IPFSCID, &s.IPFSContextCID,
+			&s.SchemaVersion, &s.DeprecatedAt); err != nil {
 			return nil, err
 		}
 		item, err := toSchemaDomain(&s)
@@ -109,13 +193,16 @@ ORDER BY created_at DESC`
 
 // GetByID searches and returns an schema by id
 func (r *schema) GetByID(ctx context.Context, issuerDID core.DID, id uuid.UUID) (*domain.Schema, error) {
-	const byID = `SELECT id, issuer_id, url, type, attributes, hash, created_at 
+	const byID = `SELECT id, issuer_id, url, type, attributes, hash, created_at, auto_revoke_on_expiration, version, validation_webhook_url,
+		validation_webhook_secret, extra_contexts, extra_types, default_proof_types, max_validity_days, ipfs_cid, ipfs_context_cid, schema_version, deprecated_at
 		FROM schemas 
 		WHERE issuer_id = $1 AND id=$2`
 
 	s := dbSchema{}
 	row := r.conn.Pgx.QueryRow(ctx, byID, issuerDID.String(), id)
-	err := row.Scan(&s.ID, &s.IssuerID, &s.URL, &s.Type, &s.Attributes, &s.Hash, &s.CreatedAt)
+	err := row.Scan(&s.ID, &s.IssuerID, &s.URL, &s.Type, &s.Attributes, &s.Hash, &s.CreatedAt, &s.AutoRevokeOnExpiration, &s.Version,
+		&s.ValidationWebhookURL, &s.ValidationWebhookSecret, &s.ExtraContexts, &s.ExtraTypes, &s.DefaultProofTypes, &s.MaxValidityDays, &s.IPFSCID, &s.IPFSContextCID,
+		&s.SchemaVersion, &s.DeprecatedAt)
 	if err == pgx.ErrNoRows {
 		return nil, ErrSchemaDoesNotExist
 	}
@@ -125,6 +212,70 @@ func (r *schema) GetByID(ctx context.Context, issuerDID core.DID, id uuid.UUID)
 	return toSchemaDomain(&s)
 }
 
+// GetByURL returns the schemas imported with the given url and type, newest first
+func (r *schema) GetByURL(ctx context.Context, issuerDID core.DID, url string, sType string) ([]domain.Schema, error) {
+	const byURL = `SELECT id, issuer_id, url, type, attributes, hash, created_at, auto_revoke_on_expiration, version, validation_webhook_url,
+		validation_webhook_secret, extra_contexts, extra_types, default_proof_types, max_validity_days, ipfs_cid, ipfs_context_cid, schema_version, deprecated_at
+		FROM schemas
+		WHERE issuer_id = $1 AND url = $2 AND type = $3
+		ORDER BY created_at DESC`
+
+	rows, err := r.conn.Pgx.Query(ctx, byURL, issuerDID.String(), url, sType)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	schemaCol := make([]domain.Schema, 0)
+	for rows.Next() {
+		s := dbSchema{}
+		if err := rows.Scan(&s.ID, &s.IssuerID, &s.URL, &s.Type, &s.Attributes, &s.Hash, &s.CreatedAt, &s.AutoRevokeOnExpiration, &s.Version,
+			&s.ValidationWebhookURL, &s.ValidationWebhookSecret, &s.ExtraContexts, &s.ExtraTypes, &s.DefaultProofTypes, &s.MaxValidityDays, &s.IPFSCID, &s.IPFSContextCID,
+			&s.SchemaVersion, &s.DeprecatedAt); err != nil {
+			return nil, err
+		}
+		item, err := toSchemaDomain(&s)
+		if err != nil {
+			return nil, err
+		}
+		schemaCol = append(schemaCol, *item)
+	}
+	return schemaCol, rows.Err()
+}
+
+func webhookColumns(webhook *domain.SchemaWebhook) (url *string, secret *string) {
+	if webhook == nil {
+		return nil, nil
+	}
+	if webhook.Secret != "" {
+		secret = &webhook.Secret
+	}
+	return &webhook.URL, secret
+}
+
+// extensionColumn stores a nil list of extra contexts or types as an empty array, as the columns are not nullable
+func extensionColumn(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
+
+// proofTypesColumn stores the schema without default proof types as NULL
+func proofTypesColumn(proofs *domain.ProofTypes) []string {
+	if proofs == nil {
+		return nil
+	}
+	return proofs.Names()
+}
+
+// nullableString stores an empty string as NULL
+func nullableString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
 func toSchemaDomain(s *dbSchema) (*domain.Schema, error) {
 	issuerDID, err := core.ParseDID(s.IssuerID)
 	if err != nil {
@@ -134,7 +285,14 @@ func toSchemaDomain(s *dbSchema) (*domain.Schema, error) {
 	if err != nil {
 		return nil, fmt.Errorf("parsing hash from schema: %w", err)
 	}
-	return &domain.Schema{
+	var webhook *domain.SchemaWebhook
+	if s.ValidationWebhookURL != nil {
+		webhook = &domain.SchemaWebhook{URL: *s.ValidationWebhookURL}
+		if s.ValidationWebhookSecret != nil {
+			webhook.Secret = *s.ValidationWebhookSecret
+		}
+	}
+	schema := &domain.Schema{
 		ID:         s.ID,
 		IssuerDID:  *issuerDID,
 		URL:        s.URL,
@@ -142,5 +300,28 @@ func toSchemaDomain(s *dbSchema) (*domain.Schema, error) {
 		Hash:       schemaHash,
 		Attributes: domain.SchemaAttrsFromString(s.Attributes),
 		CreatedAt:  s.CreatedAt,
-	}, nil
+
+		AutoRevokeOnExpiration: s.AutoRevokeOnExpiration,
+		Version:                s.Version,
+		ValidationWebhook:      webhook,
+		ExtraContexts:          s.ExtraContexts,
+		ExtraTypes:             s.ExtraTypes,
+		MaxValidityDays:        s.MaxValidityDays,
+		SchemaVersion:          s.SchemaVersion,
+		DeprecatedAt:           s.DeprecatedAt,
+	}
+	if s.DefaultProofTypes != nil {
+		proofs, err := domain.ParseProofTypes(s.DefaultProofTypes)
+		if err != nil {
+			return nil, fmt.Errorf("parsing default proof types from schema: %w", err)
+		}
+		schema.DefaultProofTypes = &proofs
+	}
+	if s.IPFSCID != nil {
+		schema.IPFSCID = *s.IPFSCID
+	}
+	if s.IPFSContextCID != nil {
+		schema.IPFSContextCID = *s.IPFSContextCID
+	}
+	return schema, nil
 }
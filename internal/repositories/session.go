@@ -2,11 +2,15 @@ package repositories
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/iden3/iden3comm/protocol"
 
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
 	"github.com/polygonid/sh-id-platform/internal/core/ports"
 	"github.com/polygonid/sh-id-platform/pkg/cache"
 	link_state "github.com/polygonid/sh-id-platform/pkg/link"
@@ -16,13 +20,22 @@ const (
 	defaultTTL = 5 * time.Minute
 )
 
+// ErrSessionStateNotFound session state not found
+var ErrSessionStateNotFound = errors.New("session state not found")
+
 type cached struct {
 	cache cache.Cache
+	ttl   time.Duration
+	mu    sync.Mutex
 }
 
-// NewSessionCached returns a new cached manager
-func NewSessionCached(c cache.Cache) ports.SessionRepository {
-	return &cached{cache: c}
+// NewSessionCached returns a new cached manager. The sessions are kept for 5 minutes if the ttl is 0. Acquire is only
+// safe within a process, use NewSessionRedis when the node has several replicas.
+func NewSessionCached(c cache.Cache, ttl time.Duration) ports.SessionRepository {
+	if ttl == 0 {
+		ttl = defaultTTL
+	}
+	return &cached{cache: c, ttl: ttl}
 }
 
 // Get returns the cached session
@@ -38,12 +51,12 @@ func (c *cached) Get(ctx context.Context, key string) (protocol.AuthorizationReq
 
 // Set stores the given session information
 func (c *cached) Set(ctx context.Context, key string, value protocol.AuthorizationRequestMessage) error {
-	return c.cache.Set(ctx, key, value, defaultTTL)
+	return c.cache.Set(ctx, key, value, c.ttl)
 }
 
 // SetLink - stores the given session information
 func (c *cached) SetLink(ctx context.Context, key string, value link_state.State) error {
-	return c.cache.Set(ctx, key, value, defaultTTL)
+	return c.cache.Set(ctx, key, value, c.ttl)
 }
 
 func (c *cached) GetLink(ctx context.Context, key string) (link_state.State, error) {
@@ -54,3 +67,36 @@ func (c *cached) GetLink(ctx context.Context, key string) (link_state.State, err
 	}
 	return message, nil
 }
+
+// SetState - stores the last state of a session
+func (c *cached) SetState(ctx context.Context, state domain.SessionState) error {
+	return c.cache.Set(ctx, sessionStateKey(state.SessionID), state, c.ttl)
+}
+
+// GetState - returns the last state of a session
+func (c *cached) GetState(ctx context.Context, sessionID uuid.UUID) (domain.SessionState, error) {
+	var state domain.SessionState
+	found := c.cache.Get(ctx, sessionStateKey(sessionID), &state)
+	if !found {
+		return state, ErrSessionStateNotFound
+	}
+	return state, nil
+}
+
+// Acquire marks the session as used
+func (c *cached) Acquire(ctx context.Context, sessionID string) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.cache.Exists(ctx, sessionAcquiredKey(sessionID)) {
+		return false, nil
+	}
+	return true, c.cache.Set(ctx, sessionAcquiredKey(sessionID), time.Now().UTC(), c.ttl)
+}
+
+func sessionStateKey(sessionID uuid.UUID) string {
+	return fmt.Sprintf("session_state_%s", sessionID)
+}
+
+func sessionAcquiredKey(sessionID string) string {
+	return fmt.Sprintf("session_acquired_%s", sessionID)
+}
@@ -0,0 +1,105 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"github.com/iden3/iden3comm/protocol"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	link_state "github.com/polygonid/sh-id-platform/pkg/link"
+)
+
+const sessionKeyPrefix = "issuer:sessions:"
+
+type sessionRedis struct {
+	client *redis.Client
+	ttl    time.Duration
+}
+
+// NewSessionRedis returns a session repository that keeps the sessions in Redis for the given ttl, 5 minutes if it
+// is 0. Every replica of the node sees the same sessions, so the callback of a QR code can land on any of them.
+func NewSessionRedis(client *redis.Client, ttl time.Duration) ports.SessionRepository {
+	if ttl == 0 {
+		ttl = defaultTTL
+	}
+	return &sessionRedis{client: client, ttl: ttl}
+}
+
+// Get returns the authorization request of a session
+func (s *sessionRedis) Get(ctx context.Context, key string) (protocol.AuthorizationRequestMessage, error) {
+	var message protocol.AuthorizationRequestMessage
+	if err := s.get(ctx, key, &message); err != nil {
+		if errors.Is(err, redis.Nil) {
+			return message, fmt.Errorf("authorization request not found")
+		}
+		return message, err
+	}
+	return message, nil
+}
+
+// Set stores the authorization request of a session
+func (s *sessionRedis) Set(ctx context.Context, key string, value protocol.AuthorizationRequestMessage) error {
+	return s.set(ctx, key, value)
+}
+
+// SetLink stores the state of the credential of a link session
+func (s *sessionRedis) SetLink(ctx context.Context, key string, value link_state.State) error {
+	return s.set(ctx, key, value)
+}
+
+// GetLink returns the state of the credential of a link session
+func (s *sessionRedis) GetLink(ctx context.Context, key string) (link_state.State, error) {
+	var state link_state.State
+	if err := s.get(ctx, key, &state); err != nil {
+		if errors.Is(err, redis.Nil) {
+			return state, fmt.Errorf("link state not found")
+		}
+		return state, err
+	}
+	return state, nil
+}
+
+// SetState stores the last state of a session
+func (s *sessionRedis) SetState(ctx context.Context, state domain.SessionState) error {
+	return s.set(ctx, sessionStateKey(state.SessionID), state)
+}
+
+// GetState returns the last state of a session
+func (s *sessionRedis) GetState(ctx context.Context, sessionID uuid.UUID) (domain.SessionState, error) {
+	var state domain.SessionState
+	if err := s.get(ctx, sessionStateKey(sessionID), &state); err != nil {
+		if errors.Is(err, redis.Nil) {
+			return state, ErrSessionStateNotFound
+		}
+		return state, err
+	}
+	return state, nil
+}
+
+// Acquire marks the session as used with SET NX, so only one replica gets true for it
+func (s *sessionRedis) Acquire(ctx context.Context, sessionID string) (bool, error) {
+	return s.client.SetNX(ctx, sessionKeyPrefix+sessionAcquiredKey(sessionID), time.Now().UTC().Format(time.RFC3339), s.ttl).Result()
+}
+
+func (s *sessionRedis) get(ctx context.Context, key string, value any) error {
+	content, err := s.client.Get(ctx, sessionKeyPrefix+key).Bytes()
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(content, value)
+}
+
+func (s *sessionRedis) set(ctx context.Context, key string, value any) error {
+	content, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return s.client.Set(ctx, sessionKeyPrefix+key, content, s.ttl).Err()
+}
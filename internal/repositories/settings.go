@@ -0,0 +1,60 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+type settings struct{}
+
+// NewSettings returns a new runtime settings overrides repository
+func NewSettings() ports.SettingsRepository {
+	return &settings{}
+}
+
+// Get returns the overridden settings, none if they were never overridden
+func (r *settings) Get(ctx context.Context, conn db.Querier) (*domain.SettingsOverrides, error) {
+	overrides, err := scanSettingsOverrides(conn.QueryRow(ctx, `SELECT overrides, updated_at FROM settings`))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return &domain.SettingsOverrides{}, nil
+	}
+	return overrides, err
+}
+
+// Merge adds the overrides to the stored ones, replacing the settings overridden before, and returns the result
+func (r *settings) Merge(ctx context.Context, conn db.Querier, overrides *domain.SettingsOverrides) (*domain.SettingsOverrides, error) {
+	content, err := json.Marshal(overrides)
+	if err != nil {
+		return nil, err
+	}
+	return scanSettingsOverrides(conn.QueryRow(ctx, `
+		INSERT INTO settings (id, overrides, updated_at)
+		VALUES (true, $1, CURRENT_TIMESTAMP)
+		ON CONFLICT (id) DO UPDATE SET overrides = settings.overrides || EXCLUDED.overrides, updated_at = CURRENT_TIMESTAMP
+		RETURNING overrides, updated_at`, content))
+}
+
+// Delete removes the overrides, so every setting takes the configured value again
+func (r *settings) Delete(ctx context.Context, conn db.Querier) error {
+	_, err := conn.Exec(ctx, `DELETE FROM settings`)
+	return err
+}
+
+func scanSettingsOverrides(row pgx.Row) (*domain.SettingsOverrides, error) {
+	var content []byte
+	overrides := &domain.SettingsOverrides{}
+	if err := row.Scan(&content, &overrides.UpdatedAt); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(content, overrides); err != nil {
+		return nil, err
+	}
+	return overrides, nil
+}
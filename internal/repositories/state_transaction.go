@@ -0,0 +1,111 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	core "github.com/iden3/go-iden3-core"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+const stateTransactionColumns = `id, identifier, state, network, tx_id, tx_hashes, tx_type, key_type, key_id, from_address,
+	to_address, nonce, gas_limit, gas_tip_cap::text, gas_fee_cap::text, payload, attempts, status, error, created_at,
+	submitted_at, updated_at`
+
+type stateTransactions struct{}
+
+// NewStateTransaction returns a new state transactions repository
+func NewStateTransaction() ports.StateTransactionRepository {
+	return &stateTransactions{}
+}
+
+// Save inserts the transaction or updates its submissions and status
+func (r *stateTransactions) Save(ctx context.Context, conn db.Querier, tx *domain.StateTransaction) error {
+	tipCap := "0"
+	if tx.GasTipCap != nil {
+		tipCap = tx.GasTipCap.String()
+	}
+	return conn.QueryRow(ctx, `
+		INSERT INTO state_transactions (id, identifier, state, network, tx_id, tx_hashes, tx_type, key_type, key_id,
+			from_address, to_address, nonce, gas_limit, gas_tip_cap, gas_fee_cap, payload, attempts, status, error,
+			submitted_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14::numeric, $15::numeric, $16, $17, $18, $19, $20)
+		ON CONFLICT (id) DO UPDATE SET tx_id = $5, tx_hashes = $6, gas_tip_cap = $14::numeric, gas_fee_cap = $15::numeric,
+			attempts = $17, status = $18, error = $19, submitted_at = $20, updated_at = CURRENT_TIMESTAMP
+		RETURNING created_at, updated_at`,
+		tx.ID, tx.Identifier, tx.State, tx.Network, tx.TxID, tx.TxHashes, int16(tx.TxType), tx.KeyType, tx.KeyID,
+		tx.FromAddress, tx.ToAddress, int64(tx.Nonce), int64(tx.GasLimit), tipCap, tx.GasFeeCap.String(), tx.Payload,
+		tx.Attempts, tx.Status, tx.Error, tx.SubmittedAt).Scan(&tx.CreatedAt, &tx.UpdatedAt)
+}
+
+// GetPending returns the transactions that have not been mined yet, oldest first
+func (r *stateTransactions) GetPending(ctx context.Context, conn db.Querier) ([]*domain.StateTransaction, error) {
+	rows, err := conn.Query(ctx, `SELECT `+stateTransactionColumns+`
+		FROM state_transactions
+		WHERE status = $1
+		ORDER BY created_at`, domain.StateTransactionPending)
+	if err != nil {
+		return nil, err
+	}
+	return scanStateTransactions(rows)
+}
+
+// GetByIdentifier returns the latest transactions of the identity, newest first
+func (r *stateTransactions) GetByIdentifier(ctx context.Context, conn db.Querier, identifier core.DID, limit int) ([]*domain.StateTransaction, error) {
+	rows, err := conn.Query(ctx, `SELECT `+stateTransactionColumns+`
+		FROM state_transactions
+		WHERE identifier = $1
+		ORDER BY created_at DESC
+		LIMIT $2`, identifier.String(), limit)
+	if err != nil {
+		return nil, err
+	}
+	return scanStateTransactions(rows)
+}
+
+// UpdateIdentityState points the identity state of the transaction to its current hash, so the state is confirmed with
+// the submission that was mined. If status is not nil, the status of the state is also updated while it is transacted.
+func (r *stateTransactions) UpdateIdentityState(ctx context.Context, conn db.Querier, tx *domain.StateTransaction, status *domain.IdentityStatus) error {
+	_, err := conn.Exec(ctx, `
+		UPDATE identity_states
+		SET tx_id = $3, status = COALESCE($4, status)
+		WHERE identifier = $1 AND state = $2 AND status = $5`,
+		tx.Identifier, tx.State, tx.TxID, status, domain.StatusTransacted)
+	return err
+}
+
+func scanStateTransactions(rows pgx.Rows) ([]*domain.StateTransaction, error) {
+	defer rows.Close()
+	txs := make([]*domain.StateTransaction, 0)
+	for rows.Next() {
+		var (
+			tx              domain.StateTransaction
+			txType          int16
+			nonce, gasLimit int64
+			tipCap, feeCap  string
+		)
+		err := rows.Scan(&tx.ID, &tx.Identifier, &tx.State, &tx.Network, &tx.TxID, &tx.TxHashes, &txType, &tx.KeyType,
+			&tx.KeyID, &tx.FromAddress, &tx.ToAddress, &nonce, &gasLimit, &tipCap, &feeCap, &tx.Payload, &tx.Attempts,
+			&tx.Status, &tx.Error, &tx.CreatedAt, &tx.SubmittedAt, &tx.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+		tx.TxType = uint8(txType)
+		tx.Nonce = uint64(nonce)
+		tx.GasLimit = uint64(gasLimit)
+		var ok bool
+		if tx.GasTipCap, ok = new(big.Int).SetString(tipCap, 10); !ok {
+			return nil, fmt.Errorf("invalid gas tip cap %q", tipCap)
+		}
+		if tx.GasFeeCap, ok = new(big.Int).SetString(feeCap, 10); !ok {
+			return nil, fmt.Errorf("invalid gas fee cap %q", feeCap)
+		}
+		txs = append(txs, &tx)
+	}
+	return txs, rows.Err()
+}
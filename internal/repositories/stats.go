@@ -0,0 +1,109 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+type stats struct{}
+
+// NewStats returns a new stats repository
+func NewStats() ports.StatsRepository {
+	return &stats{}
+}
+
+// IssuedCredentials returns the number of credentials issued by each identity and schema between from and to.
+// The auth credentials created with the identities are not counted.
+func (s *stats) IssuedCredentials(ctx context.Context, conn db.Querier, from, to time.Time) ([]domain.IssuanceStat, error) {
+	const sql = `SELECT coalesce(issuer, ''), schema_type, count(*)
+		FROM claims
+		WHERE schema_type <> $3
+		AND (data->>'issuanceDate')::timestamptz >= $1
+		AND (data->>'issuanceDate')::timestamptz < $2
+		GROUP BY 1, 2
+		ORDER BY 1, 2`
+	rows, err := conn.Query(ctx, sql, from, to, domain.AuthBJJCredentialSchemaType)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make([]domain.IssuanceStat, 0)
+	for rows.Next() {
+		var stat domain.IssuanceStat
+		if err := rows.Scan(&stat.IssuerDID, &stat.SchemaType, &stat.Count); err != nil {
+			return nil, err
+		}
+		result = append(result, stat)
+	}
+	return result, rows.Err()
+}
+
+// Revocations returns the number of credentials revoked by each identity between from and to
+func (s *stats) Revocations(ctx context.Context, conn db.Querier, from, to time.Time) ([]domain.RevocationStat, error) {
+	const sql = `SELECT coalesce(identifier, ''), count(*)
+		FROM revocation
+		WHERE created_at >= $1 AND created_at < $2
+		GROUP BY 1
+		ORDER BY 1`
+	rows, err := conn.Query(ctx, sql, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make([]domain.RevocationStat, 0)
+	for rows.Next() {
+		var stat domain.RevocationStat
+		if err := rows.Scan(&stat.IssuerDID, &stat.Count); err != nil {
+			return nil, err
+		}
+		result = append(result, stat)
+	}
+	return result, rows.Err()
+}
+
+// FailedOperations returns the state transitions that failed between from and to
+func (s *stats) FailedOperations(ctx context.Context, conn db.Querier, from, to time.Time) ([]domain.FailedOperation, error) {
+	const sql = `SELECT coalesce(identifier, ''), state, coalesce(tx_id, ''), modified_at
+		FROM identity_states
+		WHERE status = $3 AND modified_at >= $1 AND modified_at < $2
+		ORDER BY modified_at`
+	rows, err := conn.Query(ctx, sql, from, to, domain.StatusFailed)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make([]domain.FailedOperation, 0)
+	for rows.Next() {
+		var op domain.FailedOperation
+		if err := rows.Scan(&op.IssuerDID, &op.State, &op.TxID, &op.ModifiedAt); err != nil {
+			return nil, err
+		}
+		result = append(result, op)
+	}
+	return result, rows.Err()
+}
+
+// Backlog returns the number of claims waiting to be published, the number of pending state transactions that were
+// resubmitted and the time of the oldest of those actions, including the pending revocations.
+func (s *stats) Backlog(ctx context.Context, conn db.Querier) (*domain.Backlog, error) {
+	const sql = `SELECT
+		(SELECT count(*) FROM claims WHERE identity_state ISNULL AND identifier = issuer),
+		(SELECT count(*) FROM state_transactions WHERE status = $1 AND attempts > 1),
+		LEAST(
+			(SELECT min((data->>'issuanceDate')::timestamptz) FROM claims WHERE identity_state ISNULL AND identifier = issuer),
+			(SELECT min(created_at) FROM revocation WHERE status = 0),
+			(SELECT min(created_at) FROM state_transactions WHERE status = $1)
+		)`
+	var backlog domain.Backlog
+	if err := conn.QueryRow(ctx, sql, domain.StateTransactionPending).Scan(&backlog.UnpublishedClaims, &backlog.PendingRetries, &backlog.OldestPendingAt); err != nil {
+		return nil, err
+	}
+	return &backlog, nil
+}
@@ -0,0 +1,88 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// ErrStatusListEntryNotFound the credential has no index in the status list of its issuer
+var ErrStatusListEntryNotFound = errors.New("status list entry not found")
+
+type statusLists struct{}
+
+// NewStatusList returns a new repository of the status list entries of the credentials
+func NewStatusList() ports.StatusListRepository {
+	return &statusLists{}
+}
+
+// NextIndex takes the next index of the status list of the issuer, the first one is 0
+func (r *statusLists) NextIndex(ctx context.Context, conn db.Querier, issuerDID core.DID) (uint64, error) {
+	var index int64
+	err := conn.QueryRow(ctx, `
+		INSERT INTO status_lists (identifier, last_index) VALUES ($1, 0)
+		ON CONFLICT (identifier) DO UPDATE SET last_index = status_lists.last_index + 1
+		RETURNING last_index`, issuerDID.String()).Scan(&index)
+	if err != nil {
+		return 0, err
+	}
+	return uint64(index), nil
+}
+
+// SaveEntry inserts the index of the credential in the status list of its issuer. A credential that has an index
+// already keeps it.
+func (r *statusLists) SaveEntry(ctx context.Context, conn db.Querier, entry *domain.StatusListEntry) error {
+	_, err := conn.Exec(ctx, `
+		INSERT INTO status_list_entries (identifier, claim_id, status_index) VALUES ($1, $2, $3)
+		ON CONFLICT (claim_id) DO NOTHING`,
+		entry.IssuerDID.String(), entry.ClaimID, int64(entry.Index))
+	return err
+}
+
+// GetEntry returns the index of the credential in the status list of the issuer
+func (r *statusLists) GetEntry(ctx context.Context, conn db.Querier, issuerDID core.DID, claimID uuid.UUID) (*domain.StatusListEntry, error) {
+	var index int64
+	err := conn.QueryRow(ctx, `
+		SELECT status_index FROM status_list_entries WHERE identifier = $1 AND claim_id = $2`,
+		issuerDID.String(), claimID).Scan(&index)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrStatusListEntryNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &domain.StatusListEntry{IssuerDID: issuerDID, ClaimID: claimID, Index: uint64(index)}, nil
+}
+
+// GetRevokedIndexes returns the indexes of the status list of the issuer whose credentials are revoked, in ascending
+// order. A credential is revoked when its revocation nonce is in the revocations of the issuer.
+func (r *statusLists) GetRevokedIndexes(ctx context.Context, conn db.Querier, issuerDID core.DID) ([]uint64, error) {
+	rows, err := conn.Query(ctx, `
+		SELECT status_list_entries.status_index
+		FROM status_list_entries
+		JOIN claims ON claims.id = status_list_entries.claim_id
+		WHERE status_list_entries.identifier = $1 AND EXISTS (
+			SELECT 1 FROM revocation WHERE revocation.identifier = claims.identifier AND revocation.nonce = claims.rev_nonce)
+		ORDER BY status_list_entries.status_index`, issuerDID.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	indexes := make([]uint64, 0)
+	for rows.Next() {
+		var index int64
+		if err := rows.Scan(&index); err != nil {
+			return nil, err
+		}
+		indexes = append(indexes, uint64(index))
+	}
+	return indexes, rows.Err()
+}
@@ -0,0 +1,67 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	core "github.com/iden3/go-iden3-core"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// ErrSubjectBindingNotFound the placeholder subject is not bound to a holder
+var ErrSubjectBindingNotFound = errors.New("subject binding not found")
+
+type subjectBindings struct{}
+
+// NewSubjectBinding returns a new repository of the bindings of the placeholder subjects
+func NewSubjectBinding() ports.SubjectBindingRepository {
+	return &subjectBindings{}
+}
+
+// Bind stores the binding of the placeholder subject, unless it is bound already, and returns the binding stored.
+// The stored binding is returned in the same statement, so concurrent claims of the placeholder get the same holder.
+func (r *subjectBindings) Bind(ctx context.Context, conn db.Querier, binding *domain.SubjectBinding) (*domain.SubjectBinding, error) {
+	row := conn.QueryRow(ctx, `
+		INSERT INTO subject_bindings (issuer_id, external_id, holder_id, link_id, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (issuer_id, external_id) DO UPDATE SET issuer_id = subject_bindings.issuer_id
+		RETURNING issuer_id, external_id, holder_id, link_id, created_at`,
+		binding.IssuerDID.String(), binding.ExternalID, binding.HolderDID.String(), binding.LinkID, binding.CreatedAt)
+	return scanSubjectBinding(row)
+}
+
+// GetByExternalID returns the binding of the placeholder subject of the issuer
+func (r *subjectBindings) GetByExternalID(ctx context.Context, conn db.Querier, issuerDID core.DID, externalID string) (*domain.SubjectBinding, error) {
+	row := conn.QueryRow(ctx, `
+		SELECT issuer_id, external_id, holder_id, link_id, created_at
+		FROM subject_bindings
+		WHERE issuer_id = $1 AND external_id = $2`, issuerDID.String(), externalID)
+	binding, err := scanSubjectBinding(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrSubjectBindingNotFound
+	}
+	return binding, err
+}
+
+func scanSubjectBinding(row pgx.Row) (*domain.SubjectBinding, error) {
+	var issuerID, holderID string
+	binding := &domain.SubjectBinding{}
+	if err := row.Scan(&issuerID, &binding.ExternalID, &holderID, &binding.LinkID, &binding.CreatedAt); err != nil {
+		return nil, err
+	}
+	issuerDID, err := core.ParseDID(issuerID)
+	if err != nil {
+		return nil, err
+	}
+	holderDID, err := core.ParseDID(holderID)
+	if err != nil {
+		return nil, err
+	}
+	binding.IssuerDID = *issuerDID
+	binding.HolderDID = *holderDID
+	return binding, nil
+}
@@ -0,0 +1,89 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+	"github.com/iden3/iden3comm"
+	"github.com/iden3/iden3comm/packers"
+	"github.com/iden3/iden3comm/protocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db/tests"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+func TestAgentInbox(t *testing.T) {
+	ctx := context.Background()
+	fixture := tests.NewFixture(storage)
+	repo := repositories.NewAgentInbox()
+
+	typ, err := core.BuildDIDType(core.DIDMethodPolygonID, core.Polygon, core.Mumbai)
+	require.NoError(t, err)
+	id, err := core.IdGenesisFromIdenState(typ, big.NewInt(rand.Int63()))
+	require.NoError(t, err)
+	did, err := core.ParseDIDFromID(*id)
+	require.NoError(t, err)
+	fixture.CreateIdentity(t, &domain.Identity{Identifier: did.String()})
+
+	const holderDID = "did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ"
+	thread := uuid.NewString()
+	fetch := domain.NewInboxMessage(*did, []byte("fetch"), &iden3comm.BasicMessage{
+		ID: uuid.NewString(), ThreadID: thread, Type: protocol.CredentialFetchRequestMessageType, From: holderDID,
+	}, packers.MediaTypeZKPMessage)
+	fetch.Fail(errors.New("credential offer expired"), nil)
+	fetch.CreatedAt = time.Now().Add(-time.Hour)
+	status := domain.NewInboxMessage(*did, []byte("status"), &iden3comm.BasicMessage{
+		ID: uuid.NewString(), ThreadID: uuid.NewString(), Type: protocol.RevocationStatusRequestMessageType, From: holderDID,
+	}, packers.MediaTypePlainMessage)
+	status.Process(&domain.Agent{Type: protocol.RevocationStatusResponseMessageType})
+	garbage := domain.NewInboxMessage(*did, []byte("garbage"), nil, "")
+	garbage.Reject(errors.New("unknown media type"))
+	for _, message := range []*domain.InboxMessage{fetch, status, garbage} {
+		require.NoError(t, repo.Save(ctx, storage.Pgx, message))
+	}
+
+	unknownDID, err := core.ParseDID(holderDID)
+	require.NoError(t, err)
+	assert.ErrorIs(t, repo.Save(ctx, storage.Pgx, domain.NewInboxMessage(*unknownDID, []byte("lost"), nil, "")), repositories.ErrInboxIssuerNotFound)
+
+	messages, err := repo.GetAll(ctx, storage.Pgx, *did, ports.InboxFilter{Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, messages, 3)
+	assert.Equal(t, fetch.ID, messages[2].ID)
+	assert.Nil(t, messages[0].Envelope)
+
+	failed := domain.InboxMessageFailed
+	messages, err = repo.GetAll(ctx, storage.Pgx, *did, ports.InboxFilter{Sender: holderDID, ThreadID: thread, Status: &failed, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, messages, 1)
+	assert.Equal(t, string(protocol.CredentialFetchRequestMessageType), messages[0].Type)
+	assert.Equal(t, "credential offer expired", messages[0].Error)
+
+	messages, err = repo.GetAll(ctx, storage.Pgx, *did, ports.InboxFilter{Type: string(protocol.RevocationStatusRequestMessageType), Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, messages, 1)
+	assert.Equal(t, string(protocol.RevocationStatusResponseMessageType), messages[0].ResponseType)
+
+	message, err := repo.GetByID(ctx, storage.Pgx, *did, garbage.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("garbage"), message.Envelope)
+	assert.Equal(t, domain.InboxMessageRejected, message.Status)
+	_, err = repo.GetByID(ctx, storage.Pgx, *did, uuid.New())
+	assert.ErrorIs(t, err, repositories.ErrInboxMessageNotFound)
+
+	_, err = repo.DeleteBefore(ctx, storage.Pgx, time.Now().Add(-time.Minute))
+	require.NoError(t, err)
+	messages, err = repo.GetAll(ctx, storage.Pgx, *did, ports.InboxFilter{Limit: 10})
+	require.NoError(t, err)
+	assert.Len(t, messages, 2)
+}
@@ -0,0 +1,86 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	core "github.com/iden3/go-iden3-core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db/tests"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+func TestAgentMessage_Reserve(t *testing.T) {
+	ctx := context.Background()
+	repo := repositories.NewAgentMessage()
+	idStr := "did:polygonid:polygon:mumbai:2qMZrfBsXuGFTwSqkqYki78zF3pe1vtXoqH4yRLsfs"
+	did, err := core.ParseDID(idStr)
+	require.NoError(t, err)
+	fixture := tests.NewFixture(storage)
+	fixture.CreateIdentity(t, &domain.Identity{Identifier: idStr})
+
+	now := time.Now().UTC()
+	newMessage := func(id, thid string) *domain.AgentMessage {
+		return &domain.AgentMessage{
+			IssuerDID: *did,
+			Sender:    "did:polygonid:polygon:mumbai:2qFDziX3k3h7To2jDJbQiXFtcozbgSNNasvvBfMPS7",
+			ID:        id,
+			ThreadID:  thid,
+			Type:      "https://iden3-communication.io/credentials/1.0/fetch-request",
+			CreatedAt: now,
+		}
+	}
+	since := now.Add(-time.Hour)
+
+	message := newMessage("3c4a8fc2-0b87-4b44-9d5a-47a26bd7e6f7", "0b6d1e9e-3c2c-4a33-b7a4-9a1a3c9e5b11")
+	original, err := repo.Reserve(ctx, storage.Pgx, message, since)
+	require.NoError(t, err)
+	assert.Nil(t, original)
+
+	t.Run("replay before the response is saved", func(t *testing.T) {
+		original, err := repo.Reserve(ctx, storage.Pgx, message, since)
+		require.NoError(t, err)
+		require.NotNil(t, original)
+		assert.Nil(t, original.Response)
+	})
+
+	message.Response = &domain.Agent{ID: "response", ThreadID: message.ThreadID, Body: map[string]any{"credential": "vc"}}
+	require.NoError(t, repo.SaveResponse(ctx, storage.Pgx, message))
+
+	t.Run("replay with the same id", func(t *testing.T) {
+		original, err := repo.Reserve(ctx, storage.Pgx, message, since)
+		require.NoError(t, err)
+		require.NotNil(t, original)
+		require.NotNil(t, original.Response)
+		assert.Equal(t, "response", original.Response.ID)
+	})
+
+	t.Run("replay with another id in the same thread", func(t *testing.T) {
+		original, err := repo.Reserve(ctx, storage.Pgx, newMessage("7a0d3d1e-26c2-4f2b-8a3f-bb2e5f1c7d90", message.ThreadID), since)
+		require.NoError(t, err)
+		require.NotNil(t, original)
+		assert.Equal(t, message.ID, original.ID)
+	})
+
+	t.Run("messages older than the window are forgotten", func(t *testing.T) {
+		original, err := repo.Reserve(ctx, storage.Pgx, message, now.Add(time.Minute))
+		require.NoError(t, err)
+		assert.Nil(t, original)
+	})
+
+	t.Run("deleted messages can be processed again", func(t *testing.T) {
+		other := newMessage("c5d1b0a8-51e4-4f3e-9e55-0c7f0e4e9f12", "")
+		require.NoError(t, repo.Delete(ctx, storage.Pgx, other))
+		original, err := repo.Reserve(ctx, storage.Pgx, other, since)
+		require.NoError(t, err)
+		assert.Nil(t, original)
+		require.NoError(t, repo.Delete(ctx, storage.Pgx, other))
+		original, err = repo.Reserve(ctx, storage.Pgx, other, since)
+		require.NoError(t, err)
+		assert.Nil(t, original)
+	})
+}
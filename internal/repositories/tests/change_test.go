@@ -0,0 +1,65 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	core "github.com/iden3/go-iden3-core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db/tests"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+func TestChange_GetSince(t *testing.T) {
+	ctx := context.Background()
+	changesRepo := repositories.NewChange()
+	claimsRepo := repositories.NewClaims()
+	idStr := "did:polygonid:polygon:mumbai:2qGjTUuxZKqKS4Q8UmxHUPw55g15QgEVGnj6Wkq8Vk"
+	did, err := core.ParseDID(idStr)
+	require.NoError(t, err)
+	fixture := tests.NewFixture(storage)
+	fixture.CreateIdentity(t, &domain.Identity{Identifier: idStr})
+
+	claim := fixture.NewClaim(t, idStr)
+	claim.RevNonce = 2001
+	claimID := fixture.CreateClaim(t, claim)
+	_, err = claimsRepo.MarkAsRevoked(ctx, storage.Pgx, did, []domain.RevNonceUint64{2001})
+	require.NoError(t, err)
+
+	var credentialChanges []domain.Change
+	changes, err := changesRepo.GetSince(ctx, storage.Pgx, *did, domain.ChangeCursor{}, 100)
+	require.NoError(t, err)
+	for _, change := range changes {
+		assert.Equal(t, idStr, change.IssuerDID)
+		if change.EntityID == claimID {
+			credentialChanges = append(credentialChanges, change)
+		}
+	}
+	require.Len(t, credentialChanges, 2)
+	assert.Equal(t, domain.ChangeEntityCredential, credentialChanges[0].Entity)
+	assert.Equal(t, domain.ChangeCreated, credentialChanges[0].Operation)
+	assert.Equal(t, domain.ChangeRevoked, credentialChanges[1].Operation)
+
+	t.Run("changes after the cursor", func(t *testing.T) {
+		after, err := changesRepo.GetSince(ctx, storage.Pgx, *did, credentialChanges[0].Cursor, 100)
+		require.NoError(t, err)
+		require.NotEmpty(t, after)
+		assert.Equal(t, credentialChanges[1].Cursor, after[0].Cursor)
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		limited, err := changesRepo.GetSince(ctx, storage.Pgx, *did, domain.ChangeCursor{}, 1)
+		require.NoError(t, err)
+		require.Len(t, limited, 1)
+		assert.Equal(t, changes[0].Cursor, limited[0].Cursor)
+	})
+
+	t.Run("no changes after the last one", func(t *testing.T) {
+		after, err := changesRepo.GetSince(ctx, storage.Pgx, *did, changes[len(changes)-1].Cursor, 100)
+		require.NoError(t, err)
+		assert.Empty(t, after)
+	})
+}
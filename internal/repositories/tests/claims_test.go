@@ -3,6 +3,7 @@ package tests
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"testing"
 	"time"
 
@@ -148,6 +149,104 @@ func TestRevokeNonce(t *testing.T) {
 	})
 }
 
+func TestRevokeNonces(t *testing.T) {
+	// given
+	ctx := context.Background()
+	claimsRepo := repositories.NewClaims()
+	idStr := "did:polygonid:polygon:mumbai:2qKY1SHMNsKGgBw4QojVc5gR9BRAzm2ZPeeUZCbcBH"
+	did, err := core.ParseDID(idStr)
+	require.NoError(t, err)
+	fixture := tests.NewFixture(storage)
+	fixture.CreateIdentity(t, &domain.Identity{Identifier: idStr})
+
+	nonces := []domain.RevNonceUint64{1001, 1002, 1003}
+	for _, nonce := range nonces {
+		claim := fixture.NewClaim(t, idStr)
+		claim.RevNonce = nonce
+		fixture.CreateClaim(t, claim)
+	}
+
+	// when and then
+	t.Run("should flag the claims of the nonces as revoked", func(t *testing.T) {
+		revoked, err := claimsRepo.MarkAsRevoked(ctx, storage.Pgx, did, []domain.RevNonceUint64{1001, 1003, 1004})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), revoked)
+
+		var stillValid int
+		require.NoError(t, storage.Pgx.QueryRow(ctx,
+			`SELECT count(*) FROM claims WHERE identifier = $1 AND revoked = false`, idStr).Scan(&stillValid))
+		assert.Equal(t, 1, stillValid)
+	})
+
+	t.Run("should save the revocations of the nonces", func(t *testing.T) {
+		require.NoError(t, claimsRepo.RevokeNonces(ctx, storage.Pgx, did, nonces, domain.RevocationCessationOfOperation, "bulk"))
+		var count int
+		require.NoError(t, storage.Pgx.QueryRow(ctx,
+			`SELECT count(*) FROM revocation WHERE identifier = $1 AND description = 'bulk' AND reason = 'cessationOfOperation'`, idStr).Scan(&count))
+		assert.Equal(t, len(nonces), count)
+	})
+
+	t.Run("should keep the revocations of the nonces revoked already", func(t *testing.T) {
+		require.NoError(t, claimsRepo.RevokeNonces(ctx, storage.Pgx, did, []domain.RevNonceUint64{1002, 1005}, domain.RevocationUnspecified, "again"))
+		var count int
+		require.NoError(t, storage.Pgx.QueryRow(ctx,
+			`SELECT count(*) FROM revocation WHERE identifier = $1 AND description = 'bulk'`, idStr).Scan(&count))
+		assert.Equal(t, len(nonces), count)
+		require.NoError(t, storage.Pgx.QueryRow(ctx,
+			`SELECT count(*) FROM revocation WHERE identifier = $1 AND description = 'again'`, idStr).Scan(&count))
+		assert.Equal(t, 1, count)
+	})
+}
+
+func TestRevNonces(t *testing.T) {
+	ctx := context.Background()
+	claimsRepo := repositories.NewClaims()
+	fixture := tests.NewFixture(storage)
+
+	typ, err := core.BuildDIDType(core.DIDMethodPolygonID, core.Polygon, core.Mumbai)
+	require.NoError(t, err)
+	id, err := core.IdGenesisFromIdenState(typ, big.NewInt(rand.Int63()))
+	require.NoError(t, err)
+	did, err := core.ParseDIDFromID(*id)
+	require.NoError(t, err)
+	fixture.CreateIdentity(t, &domain.Identity{Identifier: did.String()})
+
+	claim := fixture.NewClaim(t, did.String())
+	claim.RevNonce = 2
+	fixture.CreateClaim(t, claim)
+
+	exists, err := claimsRepo.RevNonceExists(ctx, storage.Pgx, did, 2)
+	require.NoError(t, err)
+	assert.True(t, exists)
+	exists, err = claimsRepo.RevNonceExists(ctx, storage.Pgx, did, 3)
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	// the sequence of each identity starts at 1
+	for _, expected := range []domain.RevNonceUint64{1, 2, 3} {
+		nonce, err := claimsRepo.NextRevNonce(ctx, storage.Pgx, did)
+		require.NoError(t, err)
+		assert.Equal(t, expected, nonce)
+	}
+
+	// a nonce taken by a credential saved at the same time is rejected
+	duplicated := fixture.NewClaim(t, did.String())
+	duplicated.RevNonce = 2
+	_, err = claimsRepo.Save(ctx, storage.Pgx, duplicated)
+	assert.ErrorIs(t, err, repositories.ErrRevNonceDuplicated)
+
+	// and it is free for other identities
+	otherID, err := core.IdGenesisFromIdenState(typ, big.NewInt(rand.Int63()))
+	require.NoError(t, err)
+	otherDID, err := core.ParseDIDFromID(*otherID)
+	require.NoError(t, err)
+	fixture.CreateIdentity(t, &domain.Identity{Identifier: otherDID.String()})
+	other := fixture.NewClaim(t, otherDID.String())
+	other.RevNonce = 2
+	_, err = claimsRepo.Save(ctx, storage.Pgx, other)
+	assert.NoError(t, err)
+}
+
 func TestGetAllByConnectionAndIssuerID(t *testing.T) {
 	fixture := tests.NewFixture(storage)
 
@@ -166,7 +265,7 @@ func TestGetAllByConnectionAndIssuerID(t *testing.T) {
 		OtherIdentifier: userDID.String(),
 		Expiration:      0,
 		Version:         0,
-		RevNonce:        0,
+		RevNonce:        domain.RevNonceUint64(rand.Int63()),
 		CoreClaim:       domain.CoreClaim{},
 		Status:          nil,
 	})
@@ -182,7 +281,7 @@ func TestGetAllByConnectionAndIssuerID(t *testing.T) {
 		OtherIdentifier: userDID.String(),
 		Expiration:      0,
 		Version:         0,
-		RevNonce:        0,
+		RevNonce:        domain.RevNonceUint64(rand.Int63()),
 		CoreClaim:       domain.CoreClaim{},
 		Status:          nil,
 		Revoked:         true,
@@ -236,6 +335,7 @@ func TestGetAllByIssuerID(t *testing.T) {
 	jsonB := &pgtype.JSONB{}
 	require.NoError(t, jsonB.Set(`{"type": "BJJSignature2021", "coreClaim": "c9b2370371b7fa8b3dab2a5ba81b68382a00000000000000000000000000000002129c52957a73ea89144dc455d28e074cd7e23ae3e5bf86d4aa56d20cd60e0074da1e21d2c4d8fc28e2e3809ed51c333d68ef4dffd31508176ab84863e8fc1a0000000000000000000000000000000000000000000000000000000000000000682561f1000000006f0535010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000", "signature": "fb179bc43ca2c8ce4eb97549d847415bcb759f4d7c8bb3aa008700716abb2b06853349d75571fdc3018023cce9d1e6756eb102b4b44a17555d49fc8371af1300", "issuerData": {"id": "did:polygonid:polygon:mumbai:2qL68in3FNbimFK6gka8hPZz475z31nqPJdqBeTsQr", "mtp": {"siblings": [], "existence": true}, "state": {"value": "e6a67b3bcca7e424f657f41ddaae87f772f502de49d1cfe7f9abd11a4822611d", "claimsTreeRoot": "8375a237f1597b74b17f33cce0638e93a7be9175028836ae9f54f08dd2976a2f"}, "authCoreClaim": "cca3371a6cb1b715004407e325bd993c000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000f5287a7ac420b7c2b1b7aa28446c52df4dda6f7e4a127fbd1272d78853c4e01a3359f10f7fef6a358b83740146445dc55f143109bf1f6a090edf7d7c7b8e651c0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000", "credentialStatus": {"id": "https://aeb5-2a0c-5a84-e10a-5200-71e6-4d79-d127-c4dd.eu.ngrok.io/v1/did%3Apolygonid%3Apolygon%3Amumbai%3A2qL68in3FNbimFK6gka8hPZz475z31nqPJdqBeTsQr/claims/revocation/status/0", "type": "SparseMerkleTreeProof", "revocationNonce": 0}}}`))
 	c := &domain.Claim{
+		RevNonce:        domain.RevNonceUint64(rand.Int63()),
 		ID:              uuid.New(),
 		Identifier:      common.ToPointer(issuerDID.String()),
 		Issuer:          issuerDID.String(),
@@ -278,6 +378,16 @@ func TestGetAllByIssuerID(t *testing.T) {
 			filter:   ports.ClaimsFilter{Subject: userDID.String()},
 			expected: 1,
 		},
+		{
+			name:     "filter.Subjects should return the entry of any of them",
+			filter:   ports.ClaimsFilter{Subjects: []string{"did:iden3:polygon:mumbai:unknown", userDID.String()}},
+			expected: 1,
+		},
+		{
+			name:     "filter.Subjects without the user",
+			filter:   ports.ClaimsFilter{Subjects: []string{"did:iden3:polygon:mumbai:unknown"}},
+			expected: 0,
+		},
 		{
 			name:     "no mtp proof for this user",
 			filter:   ports.ClaimsFilter{Subject: userDID.String(), Proofs: []verifiable.ProofType{verifiable.Iden3SparseMerkleTreeProofType}},
@@ -344,7 +454,7 @@ func TestGetClaimsIssuedForUserID(t *testing.T) {
 		OtherIdentifier: userDID.String(),
 		Expiration:      0,
 		Version:         0,
-		RevNonce:        0,
+		RevNonce:        domain.RevNonceUint64(rand.Int63()),
 		CoreClaim:       domain.CoreClaim{},
 		Status:          nil,
 		HIndex:          HIndex,
@@ -380,3 +490,372 @@ func TestGetClaimsIssuedForUserID(t *testing.T) {
 		})
 	}
 }
+
+func TestGetExpiredAndMarkAsExpired(t *testing.T) {
+	ctx := context.Background()
+	fixture := tests.NewFixture(storage)
+	idStr := "did:polygonid:polygon:mumbai:2qCU58EJgrELNZCDkSU23dQHZsBgAFuJnzjXKkBZxB"
+	fixture.CreateIdentity(t, &domain.Identity{Identifier: idStr})
+	did, err := core.ParseDID(idStr)
+	require.NoError(t, err)
+
+	schemaHash, err := core.NewSchemaHashFromHex("ca938857241db9451ea329256b9c06e5")
+	require.NoError(t, err)
+	fixture.CreateSchema(t, ctx, &domain.Schema{
+		ID:                     uuid.New(),
+		IssuerDID:              *did,
+		URL:                    "https://an.url.org/expiration.json",
+		Type:                   "ExpirationTest",
+		Hash:                   schemaHash,
+		CreatedAt:              time.Now(),
+		AutoRevokeOnExpiration: true,
+	})
+
+	newClaim := func(expiration time.Time, schemaHash string) uuid.UUID {
+		claim := fixture.NewClaim(t, idStr)
+		claim.SchemaType = "ExpirationTest"
+		claim.SchemaHash = schemaHash
+		claim.Expiration = expiration.Unix()
+		claim.RevNonce = domain.RevNonceUint64(rand.Int63())
+		return fixture.CreateClaim(t, claim)
+	}
+
+	expiredWithPolicy := newClaim(time.Now().Add(-time.Hour), "ca938857241db9451ea329256b9c06e5")
+	expiredNoPolicy := newClaim(time.Now().Add(-time.Hour), "11111111111111111111111111111111")
+	notExpired := newClaim(time.Now().Add(time.Hour), "ca938857241db9451ea329256b9c06e5")
+
+	claimsRepo := repositories.NewClaims()
+	expiredByID := func() map[uuid.UUID]*domain.ExpiredClaim {
+		expired, err := claimsRepo.GetExpired(ctx, storage.Pgx, time.Now(), 10000)
+		require.NoError(t, err)
+		byID := make(map[uuid.UUID]*domain.ExpiredClaim)
+		for _, c := range expired {
+			byID[c.ID] = c
+		}
+		return byID
+	}
+
+	found := expiredByID()
+	require.Contains(t, found, expiredWithPolicy)
+	require.Contains(t, found, expiredNoPolicy)
+	assert.NotContains(t, found, notExpired)
+	assert.True(t, found[expiredWithPolicy].AutoRevoke)
+	assert.False(t, found[expiredNoPolicy].AutoRevoke)
+
+	affected, err := claimsRepo.MarkAsExpired(ctx, storage.Pgx, []uuid.UUID{expiredWithPolicy, expiredNoPolicy})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), affected)
+
+	found = expiredByID()
+	assert.NotContains(t, found, expiredWithPolicy)
+	assert.NotContains(t, found, expiredNoPolicy)
+}
+
+func TestGetAllByIssuerIDReadModel(t *testing.T) {
+	ctx := context.Background()
+	fixture := tests.NewFixture(storage)
+	idStr := "did:polygonid:polygon:mumbai:2qHtzzxS7uazdumnyZEdf74CNo3MptdW6ytxxwbPMW"
+	fixture.CreateIdentity(t, &domain.Identity{Identifier: idStr})
+	did, err := core.ParseDID(idStr)
+	require.NoError(t, err)
+
+	claim := fixture.NewClaim(t, idStr)
+	claim.SchemaType = "ReadModelTest"
+	claim.SchemaHash = "4f0bfa6bcb3e1ec7e3c3b2b5d7ae1a3c"
+	claim.RevNonce = domain.RevNonceUint64(rand.Int63())
+	fixture.CreateClaim(t, claim)
+
+	claimsRepo := repositories.NewClaims()
+	search := &ports.ClaimsFilter{FTSQuery: "favouriteColour"}
+	claims, err := claimsRepo.GetAllByIssuerID(ctx, storage.Pgx, *did, search)
+	require.NoError(t, err)
+	assert.Len(t, claims, 0)
+
+	// the words of a schema created after the claim are searchable too
+	schemaHash, err := core.NewSchemaHashFromHex(claim.SchemaHash)
+	require.NoError(t, err)
+	fixture.CreateSchema(t, ctx, &domain.Schema{
+		ID:         uuid.New(),
+		IssuerDID:  *did,
+		URL:        "https://an.url.org/read-model.json",
+		Type:       "ReadModelTest",
+		Hash:       schemaHash,
+		Attributes: domain.SchemaAttrs{"favouriteColour"},
+		CreatedAt:  time.Now(),
+	})
+	claims, err = claimsRepo.GetAllByIssuerID(ctx, storage.Pgx, *did, search)
+	require.NoError(t, err)
+	require.Len(t, claims, 1)
+	assert.Nil(t, claims[0].Status)
+
+	// the status follows the state of the claim
+	stateRepo := repositories.NewIdentityState()
+	state := domain.IdentityState{Identifier: idStr, State: common.ToPointer(fmt.Sprintf("%064d", rand.Int63())), Status: domain.StatusCreated}
+	require.NoError(t, stateRepo.Save(ctx, storage.Pgx, state))
+	claim.IdentityState = state.State
+	_, err = claimsRepo.UpdateState(ctx, storage.Pgx, claim)
+	require.NoError(t, err)
+
+	claims, err = claimsRepo.GetAllByIssuerID(ctx, storage.Pgx, *did, search)
+	require.NoError(t, err)
+	require.Len(t, claims, 1)
+	require.NotNil(t, claims[0].Status)
+	assert.Equal(t, domain.StatusCreated, *claims[0].Status)
+
+	state.Status = domain.StatusConfirmed
+	_, err = stateRepo.UpdateState(ctx, storage.Pgx, &state)
+	require.NoError(t, err)
+
+	claims, err = claimsRepo.GetAllByIssuerID(ctx, storage.Pgx, *did, search)
+	require.NoError(t, err)
+	require.Len(t, claims, 1)
+	require.NotNil(t, claims[0].Status)
+	assert.Equal(t, domain.StatusConfirmed, *claims[0].Status)
+
+	// deleting the claim hides it until it is restored
+	require.NoError(t, claimsRepo.Delete(ctx, storage.Pgx, claim.ID))
+	claims, err = claimsRepo.GetAllByIssuerID(ctx, storage.Pgx, *did, search)
+	require.NoError(t, err)
+	assert.Len(t, claims, 0)
+	_, err = claimsRepo.GetByIdAndIssuer(ctx, storage.Pgx, did, claim.ID)
+	assert.ErrorIs(t, err, repositories.ErrClaimDoesNotExist)
+
+	withDeleted := *search
+	withDeleted.IncludeDeleted = true
+	claims, err = claimsRepo.GetAllByIssuerID(ctx, storage.Pgx, *did, &withDeleted)
+	require.NoError(t, err)
+	require.Len(t, claims, 1)
+	assert.NotNil(t, claims[0].DeletedAt)
+	assert.ErrorIs(t, claimsRepo.Delete(ctx, storage.Pgx, claim.ID), repositories.ErrClaimDoesNotExist)
+
+	require.NoError(t, claimsRepo.Restore(ctx, storage.Pgx, *did, claim.ID))
+	claims, err = claimsRepo.GetAllByIssuerID(ctx, storage.Pgx, *did, search)
+	require.NoError(t, err)
+	require.Len(t, claims, 1)
+	assert.Nil(t, claims[0].DeletedAt)
+	assert.ErrorIs(t, claimsRepo.Restore(ctx, storage.Pgx, *did, claim.ID), repositories.ErrClaimDoesNotExist)
+
+	// only the claims deleted before the retention window are purged
+	require.NoError(t, claimsRepo.Delete(ctx, storage.Pgx, claim.ID))
+	purged, err := claimsRepo.PurgeDeleted(ctx, storage.Pgx, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), purged)
+	purged, err = claimsRepo.PurgeDeleted(ctx, storage.Pgx, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, purged, int64(1))
+	assert.ErrorIs(t, claimsRepo.Restore(ctx, storage.Pgx, *did, claim.ID), repositories.ErrClaimDoesNotExist)
+}
+
+func TestGetAllByIssuerIDFieldedSearch(t *testing.T) {
+	ctx := context.Background()
+	fixture := tests.NewFixture(storage)
+	idStr := "did:polygonid:polygon:mumbai:2qCU58EJgrELNZCDkSU23dQHZsBgAFWLNpNezo1g6b"
+	fixture.CreateIdentity(t, &domain.Identity{Identifier: idStr})
+	did, err := core.ParseDID(idStr)
+	require.NoError(t, err)
+
+	claim := fixture.NewClaim(t, idStr)
+	claim.SchemaType = "KYCAgeCredential"
+	claim.RevNonce = domain.RevNonceUint64(rand.Int63())
+	fixture.CreateClaim(t, claim)
+
+	claimsRepo := repositories.NewClaims()
+	for _, tc := range []struct {
+		query string
+		found bool
+	}{
+		{query: "schema:KYCAgeCredential", found: true},
+		{query: "schema:kycage*", found: true},
+		{query: "schema:KYCAge", found: false},
+		{query: "birthday:19960424", found: true},
+		{query: "schema:KYCAgeCredential birthday:1996*", found: true},
+		{query: "schema:KYCAgeCredential birthday:1990*", found: false},
+		{query: "documentType:2 2qE1BZ7gcmEoP2Kpp", found: true},
+		{query: "19960424", found: true},
+		{query: "unknownField:1", found: false},
+	} {
+		t.Run(tc.query, func(t *testing.T) {
+			claims, err := claimsRepo.GetAllByIssuerID(ctx, storage.Pgx, *did, &ports.ClaimsFilter{FTSQuery: tc.query})
+			require.NoError(t, err)
+			if tc.found {
+				require.Len(t, claims, 1)
+				assert.Equal(t, claim.ID, claims[0].ID)
+			} else {
+				assert.Len(t, claims, 0)
+			}
+		})
+	}
+}
+
+func TestGetSummaryByIssuerID(t *testing.T) {
+	ctx := context.Background()
+	fixture := tests.NewFixture(storage)
+	idStr := "did:polygonid:polygon:mumbai:2qGjmBqpEFZVxL4ogBoEq7jFzBCPpUZ8sDVpBFmiwq"
+	fixture.CreateIdentity(t, &domain.Identity{Identifier: idStr})
+	did, err := core.ParseDID(idStr)
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		claim := fixture.NewClaim(t, idStr)
+		claim.SchemaType = "SummaryTest"
+		claim.RevNonce = domain.RevNonceUint64(rand.Int63())
+		claim.Revoked = i == 0
+		if i == 1 {
+			claim.Expiration = time.Now().Add(-time.Hour).Unix()
+		}
+		fixture.CreateClaim(t, claim)
+	}
+
+	claimsRepo := repositories.NewClaims()
+	summary, err := claimsRepo.GetSummaryByIssuerID(ctx, storage.Pgx, *did, &ports.ClaimsFilter{Limit: 1})
+	require.NoError(t, err)
+	assert.Equal(t, &domain.CredentialsSummary{Total: 3, Revoked: 1, Expired: 1}, summary)
+
+	var ids []uuid.UUID
+	for offset := 0; offset < 4; offset++ {
+		claims, err := claimsRepo.GetAllByIssuerID(ctx, storage.Pgx, *did, &ports.ClaimsFilter{Limit: 1, Offset: offset})
+		require.NoError(t, err)
+		if offset == 3 {
+			assert.Len(t, claims, 0)
+			continue
+		}
+		require.Len(t, claims, 1)
+		ids = append(ids, claims[0].ID)
+	}
+	assert.Len(t, ids, 3)
+	assert.NotEqual(t, ids[0], ids[1])
+	assert.NotEqual(t, ids[1], ids[2])
+}
+
+func TestGetAllDisplayByIssuerID(t *testing.T) {
+	ctx := context.Background()
+	fixture := tests.NewFixture(storage)
+	idStr := "did:polygonid:polygon:mumbai:2qCU58EJgrELNZCDkSU23dQHZsBgAJ1niKpDTmKDgk"
+	fixture.CreateIdentity(t, &domain.Identity{Identifier: idStr})
+	did, err := core.ParseDID(idStr)
+	require.NoError(t, err)
+
+	claim := fixture.NewClaim(t, idStr)
+	claim.SchemaType = "DisplayTest"
+	claim.RevNonce = domain.RevNonceUint64(rand.Int63())
+	fixture.CreateClaim(t, claim)
+
+	claimsRepo := repositories.NewClaims()
+	filter := &ports.ClaimsFilter{FTSQuery: "schema:DisplayTest"}
+	credentials, err := claimsRepo.GetAllDisplayByIssuerID(ctx, storage.Pgx, *did, filter)
+	require.NoError(t, err)
+	require.Len(t, credentials, 1)
+	display := credentials[0].Display
+	assert.Equal(t, claim.ID, credentials[0].ID)
+	assert.Equal(t, claim.OtherIdentifier, credentials[0].HolderDID)
+	assert.Equal(t, claim.RevNonce, credentials[0].RevNonce)
+	assert.Equal(t, claim.SchemaURL, display.SchemaURL)
+	assert.Equal(t, claim.OtherIdentifier, display.Subject["id"])
+	assert.NotNil(t, display.IssuanceDate)
+
+	// the credentials saved without a display are converted on read
+	_, err = storage.Pgx.Exec(ctx, "UPDATE claims SET display = NULL WHERE id = $1", claim.ID)
+	require.NoError(t, err)
+	credentials, err = claimsRepo.GetAllDisplayByIssuerID(ctx, storage.Pgx, *did, filter)
+	require.NoError(t, err)
+	require.Len(t, credentials, 1)
+	assert.Equal(t, display, credentials[0].Display)
+
+	// the fields of the subject and the deletions are read from the read model
+	credentials, err = claimsRepo.GetAllDisplayByIssuerID(ctx, storage.Pgx, *did, &ports.ClaimsFilter{QueryField: "documentType", QueryFieldValue: "2"})
+	require.NoError(t, err)
+	require.Len(t, credentials, 1)
+	assert.Equal(t, claim.ID, credentials[0].ID)
+	require.NoError(t, claimsRepo.Delete(ctx, storage.Pgx, claim.ID))
+	credentials, err = claimsRepo.GetAllDisplayByIssuerID(ctx, storage.Pgx, *did, filter)
+	require.NoError(t, err)
+	assert.Empty(t, credentials)
+	credentials, err = claimsRepo.GetAllDisplayByIssuerID(ctx, storage.Pgx, *did, &ports.ClaimsFilter{FTSQuery: "schema:DisplayTest", IncludeDeleted: true})
+	require.NoError(t, err)
+	require.Len(t, credentials, 1)
+	assert.NotNil(t, credentials[0].DeletedAt)
+}
+
+func TestClaimsReadModelIssuanceDate(t *testing.T) {
+	ctx := context.Background()
+	fixture := tests.NewFixture(storage)
+	idStr := "did:polygonid:polygon:mumbai:2qCU58EJgrELNZCDkSU23dQHZsBgAGxM1PZzCqmCzQ"
+	fixture.CreateIdentity(t, &domain.Identity{Identifier: idStr})
+
+	issuanceDate := func(claim *domain.Claim) *time.Time {
+		var date *time.Time
+		err := storage.Pgx.QueryRow(ctx, "SELECT issuance_date FROM claims_read_model WHERE id = $1", claim.ID).Scan(&date)
+		require.NoError(t, err)
+		return date
+	}
+	for _, date := range []any{"not a date", "2023-02-30T10:00:00Z", 1677000000, nil} {
+		claim := fixture.NewClaim(t, idStr)
+		claim.RevNonce = domain.RevNonceUint64(rand.Int63())
+		var data map[string]any
+		require.NoError(t, claim.Data.AssignTo(&data))
+		data["issuanceDate"] = date
+		require.NoError(t, claim.Data.Set(data))
+		fixture.CreateClaim(t, claim)
+		assert.Nil(t, issuanceDate(claim), date)
+	}
+
+	claim := fixture.NewClaim(t, idStr)
+	claim.RevNonce = domain.RevNonceUint64(rand.Int63())
+	fixture.CreateClaim(t, claim)
+	assert.NotNil(t, issuanceDate(claim))
+}
+
+func TestGetAllByIssuerIDPublication(t *testing.T) {
+	ctx := context.Background()
+	fixture := tests.NewFixture(storage)
+	idStr := "did:polygonid:polygon:mumbai:2qCU58EJgrELNZCDkSU23dQHZsBgAHGFJYEAjpZ34U"
+	fixture.CreateIdentity(t, &domain.Identity{Identifier: idStr})
+	did, err := core.ParseDID(idStr)
+	require.NoError(t, err)
+
+	state := domain.IdentityState{
+		Identifier: idStr,
+		State:      common.ToPointer(fmt.Sprintf("%064x", rand.Uint64())),
+		Status:     domain.StatusTransacted,
+	}
+	require.NoError(t, repositories.NewIdentityState().Save(ctx, storage.Pgx, state))
+
+	signed := fixture.NewClaim(t, idStr)
+	signed.RevNonce = domain.RevNonceUint64(rand.Int63())
+	fixture.CreateClaim(t, signed)
+	unpublished := fixture.NewClaim(t, idStr)
+	unpublished.RevNonce = domain.RevNonceUint64(rand.Int63())
+	unpublished.MtProof = true
+	fixture.CreateClaim(t, unpublished)
+	published := fixture.NewClaim(t, idStr)
+	published.RevNonce = domain.RevNonceUint64(rand.Int63())
+	published.MtProof = true
+	published.IdentityState = state.State
+	fixture.CreateClaim(t, published)
+
+	claimsRepo := repositories.NewClaims()
+	ids := func(status domain.PublicationStatus) []uuid.UUID {
+		credentials, err := claimsRepo.GetAllDisplayByIssuerID(ctx, storage.Pgx, *did, &ports.ClaimsFilter{Publication: status})
+		require.NoError(t, err)
+		res := make([]uuid.UUID, len(credentials))
+		for i := range credentials {
+			res[i] = credentials[i].ID
+			assert.Equal(t, status, credentials[i].PublicationStatus())
+		}
+		return res
+	}
+	assert.ElementsMatch(t, []uuid.UUID{signed.ID}, ids(domain.PublicationNotRequired))
+	assert.ElementsMatch(t, []uuid.UUID{unpublished.ID, published.ID}, ids(domain.PublicationPending))
+
+	// the status of the state is kept by the publisher
+	state.Status = domain.StatusFailed
+	_, err = repositories.NewIdentityState().UpdateState(ctx, storage.Pgx, &state)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []uuid.UUID{published.ID}, ids(domain.PublicationFailed))
+
+	state.Status = domain.StatusConfirmed
+	_, err = repositories.NewIdentityState().UpdateState(ctx, storage.Pgx, &state)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []uuid.UUID{published.ID}, ids(domain.PublicationPublished))
+	assert.ElementsMatch(t, []uuid.UUID{unpublished.ID}, ids(domain.PublicationPending))
+}
@@ -3,6 +3,9 @@ package tests
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"math/big"
+	"math/rand"
 	"testing"
 	"time"
 
@@ -13,6 +16,7 @@ import (
 
 	"github.com/polygonid/sh-id-platform/internal/common"
 	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
 	"github.com/polygonid/sh-id-platform/internal/db/tests"
 	"github.com/polygonid/sh-id-platform/internal/repositories"
 )
@@ -109,6 +113,41 @@ func TestDelete(t *testing.T) {
 
 	t.Run("should delete an existing connection", func(t *testing.T) {
 		assert.NoError(t, connectionsRepo.Delete(context.Background(), storage.Pgx, conn, *issuerDID))
+		_, err := connectionsRepo.GetByIDAndIssuerID(context.Background(), storage.Pgx, conn, *issuerDID)
+		assert.ErrorIs(t, err, repositories.ErrConnectionDoesNotExist)
+	})
+
+	t.Run("should list the deleted connection if asked to", func(t *testing.T) {
+		deletedAt := func(filter *ports.ConnectionsFilter) (bool, *time.Time) {
+			conns, err := connectionsRepo.GetAllByIssuerID(context.Background(), storage.Pgx, *issuerDID, filter)
+			require.NoError(t, err)
+			for _, c := range conns {
+				if c.ID == conn {
+					return true, c.DeletedAt
+				}
+			}
+			return false, nil
+		}
+		found, at := deletedAt(&ports.ConnectionsFilter{IncludeDeleted: true})
+		assert.True(t, found)
+		assert.NotNil(t, at)
+		found, _ = deletedAt(&ports.ConnectionsFilter{})
+		assert.False(t, found)
+	})
+
+	t.Run("should restore the deleted connection", func(t *testing.T) {
+		require.NoError(t, connectionsRepo.Restore(context.Background(), storage.Pgx, conn, *issuerDID))
+		restored, err := connectionsRepo.GetByIDAndIssuerID(context.Background(), storage.Pgx, conn, *issuerDID)
+		require.NoError(t, err)
+		assert.Nil(t, restored.DeletedAt)
+		assert.ErrorIs(t, connectionsRepo.Restore(context.Background(), storage.Pgx, conn, *issuerDID), repositories.ErrConnectionDoesNotExist)
+	})
+
+	t.Run("should purge the connections deleted before the retention window", func(t *testing.T) {
+		require.NoError(t, connectionsRepo.Delete(context.Background(), storage.Pgx, conn, *issuerDID))
+		_, err := connectionsRepo.PurgeDeleted(context.Background(), storage.Pgx, time.Now().Add(time.Minute))
+		require.NoError(t, err)
+		assert.ErrorIs(t, connectionsRepo.Restore(context.Background(), storage.Pgx, conn, *issuerDID), repositories.ErrConnectionDoesNotExist)
 	})
 }
 
@@ -132,54 +171,105 @@ func TestConnectionsGetAllByIssuerID(t *testing.T) {
 	})
 
 	t.Run("should get 0 connections for a non existing issuerDID", func(t *testing.T) {
-		conns, err := connectionsRepo.GetAllByIssuerID(ctx, storage.Pgx, *userDID, "")
+		conns, err := connectionsRepo.GetAllByIssuerID(ctx, storage.Pgx, *userDID, &ports.ConnectionsFilter{})
 		require.NoError(t, err)
 		assert.Equal(t, 0, len(conns))
 	})
 
 	t.Run("should get 1 connection for a the given issuerDID and no query", func(t *testing.T) {
-		conns, err := connectionsRepo.GetAllByIssuerID(ctx, storage.Pgx, *issuerDID, "")
+		conns, err := connectionsRepo.GetAllByIssuerID(ctx, storage.Pgx, *issuerDID, &ports.ConnectionsFilter{})
 		require.NoError(t, err)
 		assert.Equal(t, len(conns), 1)
 	})
 
 	t.Run("should get 1 connection for a the given issuerDID and valid query, just beginning", func(t *testing.T) {
-		conns, err := connectionsRepo.GetAllByIssuerID(ctx, storage.Pgx, *issuerDID, "did:")
+		conns, err := connectionsRepo.GetAllByIssuerID(ctx, storage.Pgx, *issuerDID, &ports.ConnectionsFilter{Query: "did:"})
 		require.NoError(t, err)
 		assert.Equal(t, len(conns), 1)
 	})
 
 	t.Run("should get 1 connection for a the given issuerDID and valid query, full did", func(t *testing.T) {
-		conns, err := connectionsRepo.GetAllByIssuerID(ctx, storage.Pgx, *issuerDID, "did:polygonid:polygon:mumbai:2qH7XAwYQzCp9VfhpNgeLtK2iCehDDrfMWUCEg5ig5")
+		conns, err := connectionsRepo.GetAllByIssuerID(ctx, storage.Pgx, *issuerDID, &ports.ConnectionsFilter{Query: "did:polygonid:polygon:mumbai:2qH7XAwYQzCp9VfhpNgeLtK2iCehDDrfMWUCEg5ig5"})
 		require.NoError(t, err)
 		assert.Equal(t, len(conns), 1)
 	})
 
 	t.Run("should get 1 connection for a the given issuerDID and valid query, part of did", func(t *testing.T) {
-		conns, err := connectionsRepo.GetAllByIssuerID(ctx, storage.Pgx, *issuerDID, "did:polygonid:polygon:mumbai:2qH7XAw")
+		conns, err := connectionsRepo.GetAllByIssuerID(ctx, storage.Pgx, *issuerDID, &ports.ConnectionsFilter{Query: "did:polygonid:polygon:mumbai:2qH7XAw"})
 		require.NoError(t, err)
 		assert.Equal(t, len(conns), 1)
 	})
 
 	t.Run("should get 1 connection for a the given issuerDID and a query with some chars in the middle of a string", func(t *testing.T) {
-		conns, err := connectionsRepo.GetAllByIssuerID(ctx, storage.Pgx, *issuerDID, "H7XAw")
+		conns, err := connectionsRepo.GetAllByIssuerID(ctx, storage.Pgx, *issuerDID, &ports.ConnectionsFilter{Query: "H7XAw"})
 		require.NoError(t, err)
 		assert.Equal(t, len(conns), 1)
 	})
 
 	t.Run("should get 1 connection for a the given issuerDID and a query with some chars in the middle of a string and other words", func(t *testing.T) {
-		conns, err := connectionsRepo.GetAllByIssuerID(ctx, storage.Pgx, *issuerDID, "H7XAw other words")
+		conns, err := connectionsRepo.GetAllByIssuerID(ctx, storage.Pgx, *issuerDID, &ports.ConnectionsFilter{Query: "H7XAw other words"})
 		require.NoError(t, err)
 		assert.Equal(t, len(conns), 1)
 	})
 
 	t.Run("should get 0 connections for a the given issuerDID and non existing userDID", func(t *testing.T) {
-		conns, err := connectionsRepo.GetAllByIssuerID(ctx, storage.Pgx, *issuerDID, "did:polygonid:polygon:mumbai:2qH7XAwnonexisting")
+		conns, err := connectionsRepo.GetAllByIssuerID(ctx, storage.Pgx, *issuerDID, &ports.ConnectionsFilter{Query: "did:polygonid:polygon:mumbai:2qH7XAwnonexisting"})
 		require.NoError(t, err)
 		assert.Equal(t, len(conns), 0)
 	})
 }
 
+func TestConnectionsIterateByIssuerID(t *testing.T) {
+	ctx := context.Background()
+	connectionsRepo := repositories.NewConnections()
+	fixture := tests.NewFixture(storage)
+
+	typ, err := core.BuildDIDType(core.DIDMethodIden3, core.Polygon, core.Mumbai)
+	require.NoError(t, err)
+	newDID := func(state int64) *core.DID {
+		id, err := core.IdGenesisFromIdenState(typ, big.NewInt(state))
+		require.NoError(t, err)
+		did, err := core.ParseDIDFromID(*id)
+		require.NoError(t, err)
+		return did
+	}
+	issuerDID := newDID(rand.Int63())
+
+	// More connections than the cursor fetches in a round trip
+	const total = 501
+	for i := 0; i < total; i++ {
+		_ = fixture.CreateConnection(t, &domain.Connection{
+			IssuerDID:  *issuerDID,
+			UserDID:    *newDID(rand.Int63()),
+			CreatedAt:  time.Now(),
+			ModifiedAt: time.Now(),
+		})
+	}
+
+	ids := make(map[uuid.UUID]bool, total)
+	err = connectionsRepo.IterateByIssuerID(ctx, storage.Pgx, *issuerDID, &ports.ConnectionsFilter{}, func(conn *domain.Connection) error {
+		assert.Equal(t, issuerDID.String(), conn.IssuerDID.String())
+		ids[conn.ID] = true
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Len(t, ids, total)
+
+	count := 0
+	err = connectionsRepo.IterateByIssuerID(ctx, storage.Pgx, *issuerDID, &ports.ConnectionsFilter{Query: "nonexisting"}, func(conn *domain.Connection) error {
+		count++
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+
+	errStop := errors.New("stop")
+	err = connectionsRepo.IterateByIssuerID(ctx, storage.Pgx, *issuerDID, &ports.ConnectionsFilter{}, func(conn *domain.Connection) error {
+		return errStop
+	})
+	assert.ErrorIs(t, err, errStop)
+}
+
 func TestDeleteConnectionCredentials(t *testing.T) {
 	connectionsRepo := repositories.NewConnections()
 	fixture := tests.NewFixture(storage)
@@ -199,6 +289,7 @@ func TestDeleteConnectionCredentials(t *testing.T) {
 	})
 
 	fixture.CreateClaim(t, &domain.Claim{
+		RevNonce:        domain.RevNonceUint64(rand.Int63()),
 		Identifier:      common.ToPointer(issuerDID.String()),
 		Issuer:          issuerDID.String(),
 		OtherIdentifier: userDID.String(),
@@ -206,6 +297,7 @@ func TestDeleteConnectionCredentials(t *testing.T) {
 	})
 
 	fixture.CreateClaim(t, &domain.Claim{
+		RevNonce:        domain.RevNonceUint64(rand.Int63()),
 		Identifier:      common.ToPointer(issuerDID.String()),
 		Issuer:          issuerDID.String(),
 		OtherIdentifier: userDID.String(),
@@ -242,6 +334,7 @@ func TestGetByUserID(t *testing.T) {
 	})
 
 	fixture.CreateClaim(t, &domain.Claim{
+		RevNonce:        domain.RevNonceUint64(rand.Int63()),
 		Identifier:      common.ToPointer(issuerDID.String()),
 		Issuer:          issuerDID.String(),
 		OtherIdentifier: userDID.String(),
@@ -249,6 +342,7 @@ func TestGetByUserID(t *testing.T) {
 	})
 
 	fixture.CreateClaim(t, &domain.Claim{
+		RevNonce:        domain.RevNonceUint64(rand.Int63()),
 		Identifier:      common.ToPointer(issuerDID.String()),
 		Issuer:          issuerDID.String(),
 		OtherIdentifier: userDID.String(),
@@ -265,3 +359,76 @@ func TestGetByUserID(t *testing.T) {
 		assert.NotNil(t, conn)
 	})
 }
+
+func TestConnectionsMetadata(t *testing.T) {
+	ctx := context.Background()
+	connectionsRepo := repositories.NewConnections()
+	fixture := tests.NewFixture(storage)
+
+	typ, err := core.BuildDIDType(core.DIDMethodIden3, core.Polygon, core.Mumbai)
+	require.NoError(t, err)
+	newDID := func() *core.DID {
+		id, err := core.IdGenesisFromIdenState(typ, big.NewInt(rand.Int63()))
+		require.NoError(t, err)
+		did, err := core.ParseDIDFromID(*id)
+		require.NoError(t, err)
+		return did
+	}
+	issuerDID := newDID()
+	aliceID := fixture.CreateConnection(t, &domain.Connection{ID: uuid.New(), IssuerDID: *issuerDID, UserDID: *newDID(), CreatedAt: time.Now(), ModifiedAt: time.Now()})
+	bobID := fixture.CreateConnection(t, &domain.Connection{ID: uuid.New(), IssuerDID: *issuerDID, UserDID: *newDID(), CreatedAt: time.Now(), ModifiedAt: time.Now()})
+
+	conn, err := connectionsRepo.GetByIDAndIssuerID(ctx, storage.Pgx, aliceID, *issuerDID)
+	require.NoError(t, err)
+	assert.Nil(t, conn.Metadata)
+
+	require.NoError(t, connectionsRepo.SaveMetadata(ctx, storage.Pgx, aliceID, &domain.ConnectionMetadata{DisplayName: "Alice Smith", Notes: "met at the conference", Tags: []string{"vip", "employee"}, ModifiedAt: time.Now()}))
+	require.NoError(t, connectionsRepo.SaveMetadata(ctx, storage.Pgx, bobID, &domain.ConnectionMetadata{DisplayName: "Bob", Tags: []string{"employee"}, ModifiedAt: time.Now()}))
+
+	conn, err = connectionsRepo.GetByIDAndIssuerID(ctx, storage.Pgx, aliceID, *issuerDID)
+	require.NoError(t, err)
+	require.NotNil(t, conn.Metadata)
+	assert.Equal(t, "Alice Smith", conn.Metadata.DisplayName)
+	assert.Equal(t, "met at the conference", conn.Metadata.Notes)
+	assert.Equal(t, []string{"vip", "employee"}, conn.Metadata.Tags)
+	assert.Equal(t, 1, conn.Metadata.Version)
+
+	for name, tc := range map[string]struct {
+		filter   ports.ConnectionsFilter
+		expected []uuid.UUID
+	}{
+		"all":                  {filter: ports.ConnectionsFilter{}, expected: []uuid.UUID{aliceID, bobID}},
+		"display name":         {filter: ports.ConnectionsFilter{Query: "smith"}, expected: []uuid.UUID{aliceID}},
+		"one tag":              {filter: ports.ConnectionsFilter{Tags: []string{"employee"}}, expected: []uuid.UUID{aliceID, bobID}},
+		"all the tags":         {filter: ports.ConnectionsFilter{Tags: []string{"employee", "vip"}}, expected: []uuid.UUID{aliceID}},
+		"display name and tag": {filter: ports.ConnectionsFilter{Query: "bob", Tags: []string{"vip"}}, expected: []uuid.UUID{}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			filter := tc.filter
+			conns, err := connectionsRepo.GetAllByIssuerID(ctx, storage.Pgx, *issuerDID, &filter)
+			require.NoError(t, err)
+			ids := make([]uuid.UUID, 0, len(conns))
+			for _, conn := range conns {
+				ids = append(ids, conn.ID)
+			}
+			assert.ElementsMatch(t, tc.expected, ids)
+
+			conns, err = connectionsRepo.GetAllWithCredentialsByIssuerID(ctx, storage.Pgx, *issuerDID, &filter)
+			require.NoError(t, err)
+			assert.Len(t, conns, len(tc.expected))
+		})
+	}
+
+	assert.ErrorIs(t, connectionsRepo.SaveMetadata(ctx, storage.Pgx, aliceID, &domain.ConnectionMetadata{Tags: []string{}, ModifiedAt: time.Now()}), repositories.ErrVersionConflict,
+		"the metadata read before the last update is stale")
+	metadata := &domain.ConnectionMetadata{Tags: []string{}, ModifiedAt: time.Now(), Version: 1}
+	require.NoError(t, connectionsRepo.SaveMetadata(ctx, storage.Pgx, aliceID, metadata))
+	assert.Equal(t, 2, metadata.Version)
+	conn, err = connectionsRepo.GetByIDAndIssuerID(ctx, storage.Pgx, aliceID, *issuerDID)
+	require.NoError(t, err)
+	assert.Equal(t, "", conn.Metadata.DisplayName)
+	assert.Empty(t, conn.Metadata.Tags)
+	assert.Equal(t, 2, conn.Metadata.Version)
+
+	require.NoError(t, connectionsRepo.Delete(ctx, storage.Pgx, aliceID, *issuerDID))
+}
@@ -0,0 +1,50 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/rand"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db/tests"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+func TestCredentialOfferConsume(t *testing.T) {
+	ctx := context.Background()
+	fixture := tests.NewFixture(storage)
+	idStr := "did:polygonid:polygon:mumbai:2qCU58EJgrELNZCDkSU23dQHZsBgAG4uSmKqkshDJw"
+	fixture.CreateIdentity(t, &domain.Identity{Identifier: idStr})
+	did, err := core.ParseDID(idStr)
+	require.NoError(t, err)
+
+	newClaim := func() uuid.UUID {
+		claim := fixture.NewClaim(t, idStr)
+		claim.RevNonce = domain.RevNonceUint64(rand.Int63())
+		return fixture.CreateClaim(t, claim)
+	}
+	credential1, credential2 := newClaim(), newClaim()
+
+	offersRepo := repositories.NewCredentialOffer()
+	offer := domain.NewCredentialOffer(*did, time.Hour, credential1, credential2)
+	require.NoError(t, offersRepo.Save(ctx, storage.Pgx, offer))
+	expiredOffer := domain.NewCredentialOffer(*did, -time.Minute, credential1)
+	require.NoError(t, offersRepo.Save(ctx, storage.Pgx, expiredOffer))
+
+	otherDID, err := core.ParseDID("did:polygonid:polygon:mumbai:2qCU58EJgrELNZCDkSU23dQHZsBgAGEW6XvAC1Crfh")
+	require.NoError(t, err)
+
+	now := time.Now().UTC()
+	assert.NoError(t, offersRepo.Consume(ctx, storage.Pgx, *did, offer.ID, credential1, now))
+	assert.ErrorIs(t, offersRepo.Consume(ctx, storage.Pgx, *did, offer.ID, credential1, now), repositories.ErrCredentialOfferConsumed)
+	assert.NoError(t, offersRepo.Consume(ctx, storage.Pgx, *did, offer.ID, credential2, now))
+	assert.ErrorIs(t, offersRepo.Consume(ctx, storage.Pgx, *did, expiredOffer.ID, credential1, now), repositories.ErrCredentialOfferExpired)
+	assert.ErrorIs(t, offersRepo.Consume(ctx, storage.Pgx, *did, uuid.New(), credential1, now), repositories.ErrCredentialOfferNotFound)
+	assert.ErrorIs(t, offersRepo.Consume(ctx, storage.Pgx, *otherDID, expiredOffer.ID, credential1, now), repositories.ErrCredentialOfferNotFound)
+}
@@ -0,0 +1,74 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db/tests"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+func TestCredentialTemplates(t *testing.T) {
+	ctx := context.Background()
+	fixture := tests.NewFixture(storage)
+
+	typ, err := core.BuildDIDType(core.DIDMethodPolygonID, core.Polygon, core.Mumbai)
+	require.NoError(t, err)
+	id, err := core.IdGenesisFromIdenState(typ, big.NewInt(rand.Int63()))
+	require.NoError(t, err)
+	did, err := core.ParseDIDFromID(*id)
+	require.NoError(t, err)
+	fixture.CreateIdentity(t, &domain.Identity{Identifier: did.String()})
+
+	schemaHash, err := core.NewSchemaHashFromHex("ca938857241db9451ea329256b9c06e5")
+	require.NoError(t, err)
+	schemaID := uuid.New()
+	fixture.CreateSchema(t, ctx, &domain.Schema{
+		ID:        schemaID,
+		IssuerDID: *did,
+		URL:       "https://an.url.org/template.json",
+		Type:      "TemplateTest",
+		Hash:      schemaHash,
+		CreatedAt: time.Now(),
+	})
+
+	repo := repositories.NewCredentialTemplate()
+	template := domain.NewCredentialTemplate(*did, "KYC call center", schemaID, domain.CredentialSubject{"documentType": 2}, common.ToPointer(365), true, false)
+	require.NoError(t, repo.Save(ctx, storage.Pgx, template))
+	other := domain.NewCredentialTemplate(*did, "Age call center", schemaID, nil, nil, false, true)
+	require.NoError(t, repo.Save(ctx, storage.Pgx, other))
+
+	duplicated := domain.NewCredentialTemplate(*did, "KYC call center", schemaID, nil, nil, true, false)
+	assert.ErrorIs(t, repo.Save(ctx, storage.Pgx, duplicated), repositories.ErrCredentialTemplateDuplicated)
+
+	stored, err := repo.GetByID(ctx, storage.Pgx, *did, template.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "KYC call center", stored.Name)
+	assert.Equal(t, schemaID, stored.SchemaID)
+	assert.Equal(t, domain.CredentialSubject{"documentType": json.Number("2")}, stored.CredentialSubject)
+	assert.Equal(t, common.ToPointer(365), stored.ExpirationDays)
+	assert.True(t, stored.SignatureProof)
+	assert.False(t, stored.MTProof)
+
+	all, err := repo.GetAll(ctx, storage.Pgx, *did)
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+	assert.Equal(t, "Age call center", all[0].Name)
+	assert.Nil(t, all[0].ExpirationDays)
+
+	require.NoError(t, repo.Delete(ctx, storage.Pgx, *did, template.ID))
+	_, err = repo.GetByID(ctx, storage.Pgx, *did, template.ID)
+	assert.ErrorIs(t, err, repositories.ErrCredentialTemplateNotFound)
+	assert.ErrorIs(t, repo.Delete(ctx, storage.Pgx, *did, template.ID), repositories.ErrCredentialTemplateNotFound)
+}
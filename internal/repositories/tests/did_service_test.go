@@ -0,0 +1,60 @@
+package tests
+
+import (
+	"context"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	core "github.com/iden3/go-iden3-core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db/tests"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+func TestDIDServices(t *testing.T) {
+	ctx := context.Background()
+	fixture := tests.NewFixture(storage)
+
+	typ, err := core.BuildDIDType(core.DIDMethodPolygonID, core.Polygon, core.Mumbai)
+	require.NoError(t, err)
+	id, err := core.IdGenesisFromIdenState(typ, big.NewInt(rand.Int63()))
+	require.NoError(t, err)
+	did, err := core.ParseDIDFromID(*id)
+	require.NoError(t, err)
+
+	repo := repositories.NewDIDService()
+	push := &domain.DIDService{Identifier: did.String(), ID: "push", Type: "push-notification", ServiceEndpoint: "https://push.issuer.com"}
+	assert.ErrorIs(t, repo.Save(ctx, storage.Pgx, push), repositories.ErrDIDServiceIdentityNotFound)
+
+	fixture.CreateIdentity(t, &domain.Identity{Identifier: did.String()})
+	services, err := repo.GetByIdentifier(ctx, storage.Pgx, *did)
+	require.NoError(t, err)
+	assert.Empty(t, services)
+
+	require.NoError(t, repo.Save(ctx, storage.Pgx, push))
+	require.NotNil(t, push.UpdatedAt)
+	require.NoError(t, repo.Save(ctx, storage.Pgx, &domain.DIDService{Identifier: did.String(), ID: "iden3-communication", Type: "iden3-communication", ServiceEndpoint: "https://agent.issuer.com/v1/agent"}))
+	// saving again replaces the entry
+	push.ServiceEndpoint = "https://push.issuer.com/api/v1"
+	require.NoError(t, repo.Save(ctx, storage.Pgx, push))
+
+	services, err = repo.GetByIdentifier(ctx, storage.Pgx, *did)
+	require.NoError(t, err)
+	require.Len(t, services, 2)
+	assert.Equal(t, "iden3-communication", services[0].ID)
+	assert.Equal(t, "https://agent.issuer.com/v1/agent", services[0].ServiceEndpoint)
+	assert.True(t, services[0].Overridden)
+	assert.Equal(t, "push", services[1].ID)
+	assert.Equal(t, "https://push.issuer.com/api/v1", services[1].ServiceEndpoint)
+
+	require.NoError(t, repo.Delete(ctx, storage.Pgx, *did, "iden3-communication"))
+	assert.ErrorIs(t, repo.Delete(ctx, storage.Pgx, *did, "iden3-communication"), repositories.ErrDIDServiceNotFound)
+	services, err = repo.GetByIdentifier(ctx, storage.Pgx, *did)
+	require.NoError(t, err)
+	require.Len(t, services, 1)
+	assert.Equal(t, "push", services[0].ID)
+}
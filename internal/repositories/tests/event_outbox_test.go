@@ -0,0 +1,113 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	core "github.com/iden3/go-iden3-core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db/tests"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+func TestEventOutbox(t *testing.T) {
+	ctx := context.Background()
+	outboxRepo := repositories.NewEventOutbox()
+	claimsRepo := repositories.NewClaims()
+	idStr := "did:polygonid:polygon:mumbai:2qLQGgjpP5Yq7r7jbRrQZbWy8ikADvxamSLB7CqR4F"
+	did, err := core.ParseDID(idStr)
+	require.NoError(t, err)
+	fixture := tests.NewFixture(storage)
+	fixture.CreateIdentity(t, &domain.Identity{Identifier: idStr})
+
+	claim := fixture.NewClaim(t, idStr)
+	claim.RevNonce = 3001
+	claimID := fixture.CreateClaim(t, claim)
+	_, err = claimsRepo.MarkAsRevoked(ctx, storage.Pgx, did, []domain.RevNonceUint64{3001})
+	require.NoError(t, err)
+
+	issuerEvents := func(t *testing.T) []domain.OutboxEvent {
+		t.Helper()
+		pending, err := outboxRepo.GetPending(ctx, storage.Pgx, []string{domain.OutboxBroker}, 1000)
+		require.NoError(t, err)
+		var events []domain.OutboxEvent
+		for _, event := range pending {
+			if event.IssuerDID == idStr {
+				events = append(events, event)
+			}
+		}
+		return events
+	}
+
+	events := issuerEvents(t)
+	require.Len(t, events, 2)
+	assert.Equal(t, domain.OutboxBroker, events[0].Destination)
+	assert.Equal(t, domain.EventCredentialCreated, events[0].Type)
+	assert.Equal(t, claimID.String(), events[0].EntityID)
+	assert.Equal(t, domain.EventCredentialRevoked, events[1].Type)
+	var payload struct {
+		CredentialID string `json:"credentialID"`
+		RevNonce     string `json:"revNonce"`
+	}
+	require.NoError(t, json.Unmarshal(events[1].Payload, &payload))
+	assert.Equal(t, claimID.String(), payload.CredentialID)
+	assert.Equal(t, "3001", payload.RevNonce)
+
+	t.Run("failed events stay pending", func(t *testing.T) {
+		require.NoError(t, outboxRepo.MarkFailed(ctx, storage.Pgx, []int64{events[0].ID}, "broker down"))
+		pending := issuerEvents(t)
+		require.Len(t, pending, 2)
+		assert.Equal(t, 1, pending[0].Attempts)
+	})
+
+	t.Run("sent events are not pending", func(t *testing.T) {
+		require.NoError(t, outboxRepo.MarkSent(ctx, storage.Pgx, []int64{events[0].ID}))
+		pending := issuerEvents(t)
+		require.Len(t, pending, 1)
+		assert.Equal(t, events[1].ID, pending[0].ID)
+	})
+
+	t.Run("delete sent events", func(t *testing.T) {
+		_, err := outboxRepo.DeleteBefore(ctx, storage.Pgx, time.Now().Add(time.Minute), nil)
+		require.NoError(t, err)
+		require.Len(t, issuerEvents(t), 1, "pending events are kept")
+
+		_, err = outboxRepo.DeleteBefore(ctx, storage.Pgx, time.Now().Add(time.Minute), []string{domain.OutboxBroker})
+		require.NoError(t, err)
+		assert.Empty(t, issuerEvents(t))
+	})
+	t.Run("notifications", func(t *testing.T) {
+		notification := &domain.OutboxEvent{
+			Destination: domain.OutboxPubSub,
+			Type:        "createCredentialEvent",
+			IssuerDID:   idStr,
+			Payload:     json.RawMessage(`{"credentialsID":["` + claimID.String() + `"],"issuerID":"` + idStr + `"}`),
+		}
+		require.NoError(t, outboxRepo.Add(ctx, storage.Pgx, notification))
+		assert.NotZero(t, notification.ID)
+		assert.Empty(t, issuerEvents(t), "notifications are not sent to the broker")
+
+		pending, err := outboxRepo.GetPending(ctx, storage.Pgx, []string{domain.OutboxPubSub}, 1000)
+		require.NoError(t, err)
+		var found *domain.OutboxEvent
+		for i := range pending {
+			if pending[i].ID == notification.ID {
+				found = &pending[i]
+			}
+		}
+		require.NotNil(t, found)
+		assert.Equal(t, domain.OutboxPubSub, found.Destination)
+		assert.JSONEq(t, string(notification.Payload), string(found.Payload))
+
+		_, err = outboxRepo.DeleteBefore(ctx, storage.Pgx, time.Now().Add(time.Minute), []string{domain.OutboxBroker})
+		require.NoError(t, err)
+		pending, err = outboxRepo.GetPending(ctx, storage.Pgx, []string{domain.OutboxPubSub}, 1000)
+		require.NoError(t, err)
+		assert.Contains(t, pending, *found, "pending notifications are kept")
+	})
+}
@@ -0,0 +1,60 @@
+package tests
+
+import (
+	"context"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	core "github.com/iden3/go-iden3-core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db/tests"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+func TestFeatureFlags(t *testing.T) {
+	ctx := context.Background()
+	fixture := tests.NewFixture(storage)
+
+	typ, err := core.BuildDIDType(core.DIDMethodPolygonID, core.Polygon, core.Mumbai)
+	require.NoError(t, err)
+	id, err := core.IdGenesisFromIdenState(typ, big.NewInt(rand.Int63()))
+	require.NoError(t, err)
+	did, err := core.ParseDIDFromID(*id)
+	require.NoError(t, err)
+
+	repo := repositories.NewFeatureFlag()
+	flag := &domain.FeatureFlag{Identifier: did.String(), Feature: domain.FeatureTestMode, Enabled: true}
+	assert.ErrorIs(t, repo.Save(ctx, storage.Pgx, flag), repositories.ErrFeatureFlagIdentityNotFound)
+
+	fixture.CreateIdentity(t, &domain.Identity{Identifier: did.String()})
+	flags, err := repo.GetByIdentifier(ctx, storage.Pgx, *did)
+	require.NoError(t, err)
+	assert.Empty(t, flags)
+
+	require.NoError(t, repo.Save(ctx, storage.Pgx, flag))
+	require.NotNil(t, flag.UpdatedAt)
+	require.NoError(t, repo.Save(ctx, storage.Pgx, &domain.FeatureFlag{Identifier: did.String(), Feature: domain.FeatureRHS, Enabled: true}))
+	// saving again updates the value
+	flag.Enabled = false
+	require.NoError(t, repo.Save(ctx, storage.Pgx, flag))
+
+	flags, err = repo.GetByIdentifier(ctx, storage.Pgx, *did)
+	require.NoError(t, err)
+	require.Len(t, flags, 2)
+	assert.Equal(t, domain.FeatureRHS, flags[0].Feature)
+	assert.True(t, flags[0].Enabled)
+	assert.True(t, flags[0].Overridden)
+	assert.Equal(t, domain.FeatureTestMode, flags[1].Feature)
+	assert.False(t, flags[1].Enabled)
+
+	require.NoError(t, repo.Delete(ctx, storage.Pgx, *did, domain.FeatureRHS))
+	require.NoError(t, repo.Delete(ctx, storage.Pgx, *did, domain.FeatureOID4VCI))
+	flags, err = repo.GetByIdentifier(ctx, storage.Pgx, *did)
+	require.NoError(t, err)
+	require.Len(t, flags, 1)
+	assert.Equal(t, domain.FeatureTestMode, flags[0].Feature)
+}
@@ -0,0 +1,82 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+	"github.com/iden3/go-schema-processor/verifiable"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db/tests"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+func TestHeldCredentials(t *testing.T) {
+	ctx := context.Background()
+	fixture := tests.NewFixture(storage)
+	idStr := "did:polygonid:polygon:mumbai:2qCU58EJgrELNZCDkSU23dQHZsBgAGQ6kJWUd8iW2T"
+	fixture.CreateIdentity(t, &domain.Identity{Identifier: idStr})
+	holderDID, err := core.ParseDID(idStr)
+	require.NoError(t, err)
+
+	newHeld := func(credentialID, subjectType string) *domain.HeldCredential {
+		held, err := domain.NewHeldCredential(*holderDID, verifiable.W3CCredential{
+			ID:                credentialID,
+			Context:           []string{"https://www.w3.org/2018/credentials/v1", "https://example.com/kyc-v3.json-ld"},
+			Type:              []string{"VerifiableCredential", subjectType},
+			CredentialSubject: map[string]interface{}{"id": idStr, "type": subjectType},
+			Issuer:            "did:polygonid:polygon:mumbai:2qCU58EJgrELNZCDkSU23dQHZsBgAGZhQ56o4GE9PD",
+			CredentialSchema:  verifiable.CredentialSchema{ID: "https://example.com/kyc-v3.json", Type: "JsonSchemaValidator2018"},
+		})
+		require.NoError(t, err)
+		return held
+	}
+
+	repo := repositories.NewHeldCredential()
+	held := newHeld("https://issuer.example.com/v1/credentials/1", "KYCAgeCredential")
+	id, err := repo.Save(ctx, storage.Pgx, held)
+	require.NoError(t, err)
+	assert.Equal(t, held.ID, id)
+
+	other := newHeld("https://issuer.example.com/v1/credentials/2", "KYCCountryOfResidenceCredential")
+	_, err = repo.Save(ctx, storage.Pgx, other)
+	require.NoError(t, err)
+
+	// saving the same credential again updates the existing row
+	again := newHeld("https://issuer.example.com/v1/credentials/1", "KYCAgeCredential")
+	again.Revoked = true
+	id, err = repo.Save(ctx, storage.Pgx, again)
+	require.NoError(t, err)
+	assert.Equal(t, held.ID, id)
+
+	got, err := repo.GetByID(ctx, storage.Pgx, *holderDID, held.ID)
+	require.NoError(t, err)
+	assert.True(t, got.Revoked)
+	assert.Equal(t, held.CredentialID, got.Credential.ID)
+	assert.Equal(t, held.SchemaType, got.SchemaType)
+
+	all, err := repo.GetAll(ctx, storage.Pgx, *holderDID, nil)
+	require.NoError(t, err)
+	assert.Len(t, all, 2)
+
+	all, err = repo.GetAll(ctx, storage.Pgx, *holderDID, &ports.HeldCredentialsFilter{SchemaType: other.SchemaType})
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+	assert.Equal(t, other.ID, all[0].ID)
+
+	all, err = repo.GetAll(ctx, storage.Pgx, *holderDID, &ports.HeldCredentialsFilter{Revoked: common.ToPointer(false)})
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+	assert.Equal(t, other.ID, all[0].ID)
+
+	require.NoError(t, repo.Delete(ctx, storage.Pgx, *holderDID, other.ID))
+	assert.ErrorIs(t, repo.Delete(ctx, storage.Pgx, *holderDID, other.ID), repositories.ErrHeldCredentialDoesNotExist)
+	_, err = repo.GetByID(ctx, storage.Pgx, *holderDID, uuid.New())
+	assert.ErrorIs(t, err, repositories.ErrHeldCredentialDoesNotExist)
+}
@@ -0,0 +1,83 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+func TestIdempotencyKey_Reserve(t *testing.T) {
+	ctx := context.Background()
+	repo := repositories.NewIdempotencyKey()
+	now := time.Now().UTC()
+	since := now.Add(-time.Hour)
+
+	key := &domain.IdempotencyKey{Principal: "user", Key: uuid.NewString(), RequestHash: "hash", CreatedAt: now}
+	original, err := repo.Reserve(ctx, storage.Pgx, key, since, since)
+	require.NoError(t, err)
+	assert.Nil(t, original)
+
+	t.Run("retry before the response is saved", func(t *testing.T) {
+		original, err := repo.Reserve(ctx, storage.Pgx, key, since, since)
+		require.NoError(t, err)
+		require.NotNil(t, original)
+		assert.False(t, original.Completed())
+		assert.Equal(t, "hash", original.RequestHash)
+	})
+
+	t.Run("the keys of other users are apart", func(t *testing.T) {
+		other := &domain.IdempotencyKey{Principal: "other", Key: key.Key, RequestHash: "other hash", CreatedAt: now}
+		original, err := repo.Reserve(ctx, storage.Pgx, other, since, since)
+		require.NoError(t, err)
+		assert.Nil(t, original)
+		require.NoError(t, repo.Delete(ctx, storage.Pgx, other))
+	})
+
+	t.Run("interrupted requests are forgotten after the lease", func(t *testing.T) {
+		interrupted := &domain.IdempotencyKey{Principal: "user", Key: uuid.NewString(), RequestHash: "hash", CreatedAt: now.Add(-10 * time.Minute)}
+		original, err := repo.Reserve(ctx, storage.Pgx, interrupted, since, since)
+		require.NoError(t, err)
+		assert.Nil(t, original)
+
+		interrupted.CreatedAt = now
+		original, err = repo.Reserve(ctx, storage.Pgx, interrupted, since, now.Add(-5*time.Minute))
+		require.NoError(t, err)
+		assert.Nil(t, original)
+	})
+
+	key.Status = http.StatusCreated
+	key.Header = http.Header{"Content-Type": []string{"application/json"}}
+	key.Body = []byte(`{"id":"credential"}`)
+	require.NoError(t, repo.SaveResponse(ctx, storage.Pgx, key))
+
+	t.Run("retry after the response is saved", func(t *testing.T) {
+		original, err := repo.Reserve(ctx, storage.Pgx, key, since, since)
+		require.NoError(t, err)
+		require.NotNil(t, original)
+		assert.True(t, original.Completed())
+		assert.Equal(t, http.StatusCreated, original.Status)
+		assert.Equal(t, "application/json", original.Header.Get("Content-Type"))
+		assert.Equal(t, key.Body, original.Body)
+	})
+
+	t.Run("keys older than the ttl are forgotten", func(t *testing.T) {
+		original, err := repo.Reserve(ctx, storage.Pgx, key, now.Add(time.Minute), since)
+		require.NoError(t, err)
+		assert.Nil(t, original)
+	})
+
+	t.Run("deleted keys can be used again", func(t *testing.T) {
+		require.NoError(t, repo.Delete(ctx, storage.Pgx, key))
+		original, err := repo.Reserve(ctx, storage.Pgx, key, since, since)
+		require.NoError(t, err)
+		assert.Nil(t, original)
+	})
+}
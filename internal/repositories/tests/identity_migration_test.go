@@ -0,0 +1,112 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	core "github.com/iden3/go-iden3-core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db/tests"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+func TestIdentityMigration(t *testing.T) {
+	ctx := context.Background()
+	fixture := tests.NewFixture(storage)
+
+	typ, err := core.BuildDIDType(core.DIDMethodPolygonID, core.Polygon, core.Mumbai)
+	require.NoError(t, err)
+	id, err := core.IdGenesisFromIdenState(typ, big.NewInt(rand.Int63()))
+	require.NoError(t, err)
+	did, err := core.ParseDIDFromID(*id)
+	require.NoError(t, err)
+
+	repo := repositories.NewIdentityMigration()
+	migration := &domain.IdentityMigration{Identifier: did.String(), Status: domain.IdentityMigrationReadOnly}
+	assert.ErrorIs(t, repo.Save(ctx, storage.Pgx, migration), repositories.ErrIdentityMigrationIdentityNotFound)
+
+	fixture.CreateIdentity(t, &domain.Identity{Identifier: did.String()})
+	_, err = repo.Get(ctx, storage.Pgx, *did)
+	assert.ErrorIs(t, err, repositories.ErrIdentityMigrationNotFound)
+
+	migration.Target = common.ToPointer("https://issuer-2.example.com")
+	require.NoError(t, repo.Save(ctx, storage.Pgx, migration))
+	assert.False(t, migration.CreatedAt.IsZero())
+	got, err := repo.Get(ctx, storage.Pgx, *did)
+	require.NoError(t, err)
+	assert.Equal(t, domain.IdentityMigrationReadOnly, got.Status)
+	assert.Equal(t, migration.Target, got.Target)
+	assert.Nil(t, got.CompletedAt)
+
+	pending, err := repo.HasPendingTransactions(ctx, storage.Pgx, *did)
+	require.NoError(t, err)
+	assert.False(t, pending)
+	state := domain.IdentityState{Identifier: did.String(), State: common.ToPointer("state"), Status: domain.StatusTransacted}
+	require.NoError(t, repositories.NewIdentityState().Save(ctx, storage.Pgx, state))
+	pending, err = repo.HasPendingTransactions(ctx, storage.Pgx, *did)
+	require.NoError(t, err)
+	assert.True(t, pending)
+
+	schemaVersion, err := repo.SchemaVersion(ctx, storage.Pgx)
+	require.NoError(t, err)
+	assert.Positive(t, schemaVersion)
+
+	claim := fixture.NewClaim(t, did.String())
+	fixture.CreateClaim(t, claim)
+
+	tables, trees, err := repo.Export(ctx, storage.Pgx, *did)
+	require.NoError(t, err)
+	assert.Empty(t, trees)
+	var rows []map[string]interface{}
+	require.NoError(t, json.Unmarshal(tables["identities"], &rows))
+	require.Len(t, rows, 1)
+	assert.Equal(t, did.String(), rows[0]["identifier"])
+	require.NoError(t, json.Unmarshal(tables["identity_states"], &rows))
+	require.Len(t, rows, 1)
+	assert.NotContains(t, rows[0], "state_id")
+	require.NoError(t, json.Unmarshal(tables["claims"], &rows))
+	require.Len(t, rows, 1)
+	assert.Equal(t, claim.ID.String(), rows[0]["id"])
+	require.NoError(t, json.Unmarshal(tables["feature_flags"], &rows))
+	assert.Empty(t, rows)
+
+	export := &domain.IdentityExport{Identifier: did.String(), Tables: tables, MerkleTrees: trees}
+	_, err = repo.Import(ctx, storage.Pgx, export)
+	assert.ErrorIs(t, err, repositories.ErrIdentityAlreadyExists)
+
+	// the identity is imported back once its rows are removed, as a new node would do
+	tx, err := storage.Pgx.Begin(ctx)
+	require.NoError(t, err)
+	defer func() { _ = tx.Rollback(ctx) }()
+	for _, query := range []string{
+		`DELETE FROM identity_migrations WHERE identifier = $1`,
+		`DELETE FROM claims WHERE identifier = $1`,
+		`DELETE FROM identity_states WHERE identifier = $1`,
+		`DELETE FROM identities WHERE identifier = $1`,
+	} {
+		_, err := tx.Exec(ctx, query, did.String())
+		require.NoError(t, err)
+	}
+	imported, err := repo.Import(ctx, tx, export)
+	require.NoError(t, err)
+	assert.Equal(t, 1, imported["identities"])
+	assert.Equal(t, 1, imported["identity_states"])
+	assert.Equal(t, 1, imported["claims"])
+	assert.Equal(t, 0, imported["links"])
+
+	importedTables, _, err := repo.Export(ctx, tx, *did)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(tables["claims"]), string(importedTables["claims"]))
+	require.NoError(t, tx.Rollback(ctx))
+
+	require.NoError(t, repo.Delete(ctx, storage.Pgx, *did))
+	_, err = repo.Get(ctx, storage.Pgx, *did)
+	assert.ErrorIs(t, err, repositories.ErrIdentityMigrationNotFound)
+}
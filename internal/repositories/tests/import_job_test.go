@@ -0,0 +1,85 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db/tests"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+func TestImportJobs(t *testing.T) {
+	ctx := context.Background()
+	fixture := tests.NewFixture(storage)
+
+	typ, err := core.BuildDIDType(core.DIDMethodPolygonID, core.Polygon, core.Mumbai)
+	require.NoError(t, err)
+	id, err := core.IdGenesisFromIdenState(typ, big.NewInt(rand.Int63()))
+	require.NoError(t, err)
+	did, err := core.ParseDIDFromID(*id)
+	require.NoError(t, err)
+	fixture.CreateIdentity(t, &domain.Identity{Identifier: did.String()})
+
+	schemaHash, err := core.NewSchemaHashFromHex("ca938857241db9451ea329256b9c06e5")
+	require.NoError(t, err)
+	schemaID := uuid.New()
+	fixture.CreateSchema(t, ctx, &domain.Schema{
+		ID:        schemaID,
+		IssuerDID: *did,
+		URL:       "https://an.url.org/import.json",
+		Type:      "ImportTest",
+		Hash:      schemaHash,
+		CreatedAt: time.Now(),
+	})
+
+	repo := repositories.NewImportJob()
+	job := domain.NewImportJob(*did, schemaID, domain.ImportModeLinks, true, false, common.ToPointer(time.Now().Add(time.Hour).UTC().Truncate(time.Second)))
+	job.TotalRows = 2
+	require.NoError(t, repo.Save(ctx, storage.Pgx, job))
+
+	linkID := uuid.New()
+	require.NoError(t, repo.SaveRow(ctx, storage.Pgx, &domain.ImportRow{JobID: job.ID, Number: 1, Reference: "emp-1", LinkID: &linkID}))
+	failed := &domain.ImportRow{JobID: job.ID, Number: 2, Reference: "emp-2"}
+	failed.Fail(errors.New("documentType: invalid type"))
+	require.NoError(t, repo.SaveRow(ctx, storage.Pgx, failed))
+
+	job.ProcessedRows, job.FailedRows = 2, 1
+	job.Finish(nil)
+	require.NoError(t, repo.Save(ctx, storage.Pgx, job))
+
+	stored, err := repo.GetByID(ctx, storage.Pgx, *did, job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.ImportModeLinks, stored.Mode)
+	assert.Equal(t, domain.ImportJobCompleted, stored.Status)
+	assert.Equal(t, 2, stored.TotalRows)
+	assert.Equal(t, 2, stored.ProcessedRows)
+	assert.Equal(t, 1, stored.FailedRows)
+	assert.NotNil(t, stored.FinishedAt)
+	assert.True(t, job.CredentialExpiration.Equal(*stored.CredentialExpiration))
+
+	rows, err := repo.GetRows(ctx, storage.Pgx, job.ID, false)
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	assert.Equal(t, "emp-1", rows[0].Reference)
+	assert.Equal(t, &linkID, rows[0].LinkID)
+	assert.Nil(t, rows[0].Error)
+
+	rows, err = repo.GetRows(ctx, storage.Pgx, job.ID, true)
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	assert.Equal(t, "documentType: invalid type", *rows[0].Error)
+
+	_, err = repo.GetByID(ctx, storage.Pgx, *did, uuid.New())
+	assert.ErrorIs(t, err, repositories.ErrImportJobNotFound)
+}
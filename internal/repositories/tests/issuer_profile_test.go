@@ -0,0 +1,50 @@
+package tests
+
+import (
+	"context"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	core "github.com/iden3/go-iden3-core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db/tests"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+func TestIssuerProfiles(t *testing.T) {
+	ctx := context.Background()
+	fixture := tests.NewFixture(storage)
+
+	typ, err := core.BuildDIDType(core.DIDMethodPolygonID, core.Polygon, core.Mumbai)
+	require.NoError(t, err)
+	id, err := core.IdGenesisFromIdenState(typ, big.NewInt(rand.Int63()))
+	require.NoError(t, err)
+	did, err := core.ParseDIDFromID(*id)
+	require.NoError(t, err)
+
+	repo := repositories.NewIssuerProfile()
+	profile := &domain.IssuerProfile{Identifier: did.String(), DisplayName: "My issuer", LogoURL: "https://my-issuer.com/logo.png"}
+	assert.ErrorIs(t, repo.Save(ctx, storage.Pgx, profile), repositories.ErrIssuerProfileIdentityNotFound)
+
+	fixture.CreateIdentity(t, &domain.Identity{Identifier: did.String()})
+	_, err = repo.GetByIdentifier(ctx, storage.Pgx, *did)
+	assert.ErrorIs(t, err, repositories.ErrIssuerProfileNotFound)
+
+	require.NoError(t, repo.Save(ctx, storage.Pgx, profile))
+	require.NotNil(t, profile.UpdatedAt)
+	// saving again replaces the profile
+	profile.LogoURL, profile.BackgroundColor, profile.ContactEmail = "", "#1a2b3c", "support@my-issuer.com"
+	require.NoError(t, repo.Save(ctx, storage.Pgx, profile))
+
+	stored, err := repo.GetByIdentifier(ctx, storage.Pgx, *did)
+	require.NoError(t, err)
+	assert.Equal(t, "My issuer", stored.DisplayName)
+	assert.Empty(t, stored.LogoURL)
+	assert.Equal(t, "#1a2b3c", stored.BackgroundColor)
+	assert.Equal(t, "support@my-issuer.com", stored.ContactEmail)
+	assert.True(t, stored.Overridden)
+}
@@ -0,0 +1,97 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+func TestJob(t *testing.T) {
+	const kind = "test.repository"
+	ctx := context.Background()
+	jobRepo := repositories.NewJob()
+	idStr := "did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ"
+	did, err := core.ParseDID(idStr)
+	require.NoError(t, err)
+
+	first, err := domain.NewJob(kind, idStr, map[string]int{"row": 1}, 2)
+	require.NoError(t, err)
+	require.NoError(t, jobRepo.Save(ctx, storage.Pgx, first))
+	later, err := domain.NewJob(kind, idStr, map[string]int{"row": 2}, 2)
+	require.NoError(t, err)
+	later.RunAt = time.Now().Add(time.Hour)
+	require.NoError(t, jobRepo.Save(ctx, storage.Pgx, later))
+
+	t.Run("lock the due jobs", func(t *testing.T) {
+		locked, err := jobRepo.Lock(ctx, storage.Pgx, []string{kind})
+		require.NoError(t, err)
+		require.NotNil(t, locked)
+		assert.Equal(t, first.ID, locked.ID)
+		assert.Equal(t, domain.JobRunning, locked.Status)
+		assert.Equal(t, 1, locked.Attempts)
+		assert.JSONEq(t, `{"row":1}`, string(locked.Payload))
+
+		locked, err = jobRepo.Lock(ctx, storage.Pgx, []string{kind})
+		require.NoError(t, err)
+		assert.Nil(t, locked, "the other job is not due")
+	})
+
+	t.Run("rescue the jobs of stopped workers", func(t *testing.T) {
+		rescued, err := jobRepo.Rescue(ctx, storage.Pgx, time.Now().Add(-time.Minute))
+		require.NoError(t, err)
+		assert.Zero(t, rescued, "the worker is alive")
+
+		require.NoError(t, jobRepo.Heartbeat(ctx, storage.Pgx, first.ID))
+		_, err = jobRepo.Rescue(ctx, storage.Pgx, time.Now().Add(time.Minute))
+		require.NoError(t, err)
+		job, err := jobRepo.GetByID(ctx, storage.Pgx, *did, first.ID)
+		require.NoError(t, err)
+		assert.Equal(t, domain.JobPending, job.Status)
+		assert.NotNil(t, job.LastError)
+	})
+
+	t.Run("fail until the dead letter", func(t *testing.T) {
+		locked, err := jobRepo.Lock(ctx, storage.Pgx, []string{kind})
+		require.NoError(t, err)
+		require.NotNil(t, locked)
+		assert.Equal(t, 2, locked.Attempts)
+		locked.Fail(errors.New("failed"), time.Second)
+		require.NoError(t, jobRepo.Save(ctx, storage.Pgx, locked))
+
+		dead := domain.JobDead
+		jobs, err := jobRepo.GetAll(ctx, storage.Pgx, *did, ports.JobsFilter{Kind: kind, Status: &dead, Limit: 10})
+		require.NoError(t, err)
+		require.Len(t, jobs, 1)
+		assert.Equal(t, first.ID, jobs[0].ID)
+		assert.Equal(t, "failed", *jobs[0].LastError)
+
+		jobs, err = jobRepo.GetAll(ctx, storage.Pgx, *did, ports.JobsFilter{Kind: kind, Limit: 10})
+		require.NoError(t, err)
+		assert.Len(t, jobs, 2)
+	})
+
+	t.Run("delete the finished jobs", func(t *testing.T) {
+		require.NoError(t, later.Cancel())
+		require.NoError(t, jobRepo.Save(ctx, storage.Pgx, later))
+
+		_, err := jobRepo.DeleteFinishedBefore(ctx, storage.Pgx, time.Now().Add(time.Minute))
+		require.NoError(t, err)
+		_, err = jobRepo.GetByID(ctx, storage.Pgx, *did, later.ID)
+		assert.ErrorIs(t, err, repositories.ErrJobNotFound)
+		_, err = jobRepo.GetByID(ctx, storage.Pgx, *did, first.ID)
+		assert.NoError(t, err, "dead jobs are kept")
+	})
+
+	_, err = jobRepo.GetByID(ctx, storage.Pgx, *did, uuid.New())
+	assert.ErrorIs(t, err, repositories.ErrJobNotFound)
+}
@@ -0,0 +1,75 @@
+package tests
+
+import (
+	"context"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	core "github.com/iden3/go-iden3-core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db/tests"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+func TestKeyRotations(t *testing.T) {
+	ctx := context.Background()
+	fixture := tests.NewFixture(storage)
+
+	typ, err := core.BuildDIDType(core.DIDMethodPolygonID, core.Polygon, core.Mumbai)
+	require.NoError(t, err)
+	id, err := core.IdGenesisFromIdenState(typ, big.NewInt(rand.Int63()))
+	require.NoError(t, err)
+	did, err := core.ParseDIDFromID(*id)
+	require.NoError(t, err)
+	fixture.CreateIdentity(t, &domain.Identity{Identifier: did.String()})
+
+	newClaim := func() *domain.Claim {
+		claim := fixture.NewClaim(t, did.String())
+		claim.RevNonce = domain.RevNonceUint64(rand.Int63())
+		claim.ID = fixture.CreateClaim(t, claim)
+		return claim
+	}
+	oldClaim, newClaim1, newClaim2 := newClaim(), newClaim(), newClaim()
+
+	repo := repositories.NewKeyRotation()
+	_, err = repo.GetPendingByIdentifier(ctx, storage.Pgx, *did)
+	assert.ErrorIs(t, err, repositories.ErrKeyRotationNotFound)
+
+	rotation := domain.NewKeyRotation(*did, oldClaim.ID, newClaim1.ID)
+	require.NoError(t, repo.Save(ctx, storage.Pgx, rotation))
+	assert.ErrorIs(t, repo.Save(ctx, storage.Pgx, domain.NewKeyRotation(*did, oldClaim.ID, newClaim2.ID)), repositories.ErrKeyRotationPending)
+
+	pending, err := repo.GetPendingByIdentifier(ctx, storage.Pgx, *did)
+	require.NoError(t, err)
+	assert.Equal(t, rotation.ID, pending.ID)
+	assert.Equal(t, newClaim1.ID, pending.NewClaimID)
+	assert.Equal(t, domain.KeyRotationStatusPending, pending.Status)
+	assert.Nil(t, pending.CompletedAt)
+
+	all, err := repo.GetPending(ctx, storage.Pgx)
+	require.NoError(t, err)
+	assert.Contains(t, rotationIDs(all), rotation.ID.String())
+
+	rotation.Complete()
+	require.NoError(t, repo.Save(ctx, storage.Pgx, rotation))
+	_, err = repo.GetPendingByIdentifier(ctx, storage.Pgx, *did)
+	assert.ErrorIs(t, err, repositories.ErrKeyRotationNotFound)
+	all, err = repo.GetPending(ctx, storage.Pgx)
+	require.NoError(t, err)
+	assert.NotContains(t, rotationIDs(all), rotation.ID.String())
+
+	// once completed, a new rotation can start
+	require.NoError(t, repo.Save(ctx, storage.Pgx, domain.NewKeyRotation(*did, newClaim1.ID, newClaim2.ID)))
+}
+
+func rotationIDs(rotations []*domain.KeyRotation) []string {
+	res := make([]string, len(rotations))
+	for i, r := range rotations {
+		res[i] = r.ID.String()
+	}
+	return res
+}
@@ -0,0 +1,79 @@
+package tests
+
+import (
+	"context"
+	"math/big"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+func TestLinkCodes(t *testing.T) {
+	ctx := context.Background()
+	typ, err := core.BuildDIDType(core.DIDMethodPolygonID, core.Polygon, core.Mumbai)
+	require.NoError(t, err)
+	id, err := core.IdGenesisFromIdenState(typ, big.NewInt(rand.Int63()))
+	require.NoError(t, err)
+	did, err := core.ParseDIDFromID(*id)
+	require.NoError(t, err)
+	_, err = storage.Pgx.Exec(ctx, "INSERT INTO identities (identifier) VALUES ($1)", did.String())
+	require.NoError(t, err)
+	schemaID := insertSchemaForLink(ctx, did.String(), repositories.NewSchema(*storage), t)
+
+	link := domain.NewLink(*did, nil, nil, schemaID, nil, true, false, domain.CredentialSubject{})
+	link.CodeProtected = true
+	_, err = repositories.NewLink(*storage).Save(ctx, storage.Pgx, link)
+	require.NoError(t, err)
+	fetched, err := repositories.NewLink(*storage).GetByID(ctx, *did, link.ID)
+	require.NoError(t, err)
+	assert.True(t, fetched.CodeProtected)
+
+	repo := repositories.NewLinkCode()
+	first, firstCode, err := domain.NewLinkCode(link.ID)
+	require.NoError(t, err)
+	second, _, err := domain.NewLinkCode(link.ID)
+	require.NoError(t, err)
+	second.CreatedAt = second.CreatedAt.Add(time.Second)
+	require.NoError(t, repo.Save(ctx, storage.Pgx, []*domain.LinkCode{first, second}))
+
+	codes, err := repo.GetByLink(ctx, storage.Pgx, link.ID)
+	require.NoError(t, err)
+	require.Len(t, codes, 2)
+	assert.Equal(t, second.ID, codes[0].ID)
+	assert.Equal(t, first.Hash, codes[1].Hash)
+	assert.Nil(t, codes[1].SessionID)
+
+	session, otherSession := uuid.New(), uuid.New()
+	assert.ErrorIs(t, repo.Reserve(ctx, storage.Pgx, link.ID, domain.HashLinkCode(link.ID, "0000-0000"), session), repositories.ErrLinkCodeNotFound)
+	require.NoError(t, repo.Reserve(ctx, storage.Pgx, link.ID, domain.HashLinkCode(link.ID, firstCode), otherSession))
+	// entered again in a new session, the code moves to it
+	require.NoError(t, repo.Reserve(ctx, storage.Pgx, link.ID, domain.HashLinkCode(link.ID, firstCode), session))
+	_, err = repo.GetBySession(ctx, storage.Pgx, link.ID, otherSession)
+	assert.ErrorIs(t, err, repositories.ErrLinkCodeNotFound)
+	reserved, err := repo.GetBySession(ctx, storage.Pgx, link.ID, session)
+	require.NoError(t, err)
+	assert.Equal(t, first.ID, reserved.ID)
+
+	require.NoError(t, repo.Use(ctx, storage.Pgx, link.ID, session, *did, time.Now()))
+	assert.ErrorIs(t, repo.Use(ctx, storage.Pgx, link.ID, session, *did, time.Now()), repositories.ErrLinkCodeNotFound, "a code is used once")
+	assert.ErrorIs(t, repo.Reserve(ctx, storage.Pgx, link.ID, domain.HashLinkCode(link.ID, firstCode), otherSession), repositories.ErrLinkCodeNotFound)
+	codes, err = repo.GetByLink(ctx, storage.Pgx, link.ID)
+	require.NoError(t, err)
+	assert.True(t, codes[1].Used())
+	require.NotNil(t, codes[1].HolderDID)
+	assert.Equal(t, did.String(), *codes[1].HolderDID)
+
+	require.NoError(t, repo.SaveFailure(ctx, storage.Pgx, link.ID, time.Now().Add(-2*time.Hour)))
+	require.NoError(t, repo.SaveFailure(ctx, storage.Pgx, link.ID, time.Now()))
+	failures, err := repo.CountFailures(ctx, storage.Pgx, link.ID, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 1, failures)
+}
@@ -0,0 +1,98 @@
+package tests
+
+import (
+	"context"
+	"math/big"
+	"math/rand"
+	"testing"
+	"time"
+
+	core "github.com/iden3/go-iden3-core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+func TestLinkFunnel(t *testing.T) {
+	ctx := context.Background()
+	typ, err := core.BuildDIDType(core.DIDMethodPolygonID, core.Polygon, core.Mumbai)
+	require.NoError(t, err)
+	id, err := core.IdGenesisFromIdenState(typ, big.NewInt(rand.Int63()))
+	require.NoError(t, err)
+	did, err := core.ParseDIDFromID(*id)
+	require.NoError(t, err)
+	_, err = storage.Pgx.Exec(ctx, "INSERT INTO identities (identifier) VALUES ($1)", did.String())
+	require.NoError(t, err)
+	schemaID := insertSchemaForLink(ctx, did.String(), repositories.NewSchema(*storage), t)
+
+	linkStore := repositories.NewLink(*storage)
+	link := domain.NewLink(*did, nil, nil, schemaID, nil, true, false, domain.CredentialSubject{})
+	_, err = linkStore.Save(ctx, storage.Pgx, link)
+	require.NoError(t, err)
+	other := domain.NewLink(*did, nil, nil, schemaID, nil, true, false, domain.CredentialSubject{})
+	_, err = linkStore.Save(ctx, storage.Pgx, other)
+	require.NoError(t, err)
+
+	repo := repositories.NewLinkFunnel()
+	for _, event := range []*domain.LinkFunnelEvent{
+		domain.NewLinkFunnelEvent(*did, link.ID, "session-1", domain.LinkFunnelQRFetched),
+		domain.NewLinkFunnelEvent(*did, link.ID, "session-1", domain.LinkFunnelAuthCompleted),
+		domain.NewLinkFunnelEvent(*did, link.ID, "session-1", domain.LinkFunnelOfferFetched),
+		domain.NewLinkFunnelEvent(*did, link.ID, "session-1", domain.LinkFunnelOfferFetched),
+		domain.NewLinkFunnelEvent(*did, link.ID, "", domain.LinkFunnelCredentialDelivered),
+		domain.NewLinkFunnelEvent(*did, link.ID, "session-2", domain.LinkFunnelQRFetched),
+		domain.NewLinkFunnelEvent(*did, link.ID, "session-2", domain.LinkFunnelAuthCompleted),
+		domain.NewLinkFunnelFailure(*did, link.ID, "session-2", domain.LinkFunnelReasonAlreadyIssued),
+		domain.NewLinkFunnelFailure(*did, link.ID, "session-2", domain.LinkFunnelReasonAlreadyIssued),
+		domain.NewLinkFunnelEvent(*did, other.ID, "session-3", domain.LinkFunnelQRFetched),
+	} {
+		require.NoError(t, repo.Save(ctx, storage.Pgx, event))
+	}
+
+	funnel, err := repo.Summary(ctx, storage.Pgx, *did, link.ID)
+	require.NoError(t, err)
+	assert.Equal(t, map[domain.LinkFunnelStep]int{
+		domain.LinkFunnelQRFetched:           2,
+		domain.LinkFunnelAuthCompleted:       2,
+		domain.LinkFunnelOfferFetched:        1,
+		domain.LinkFunnelCredentialDelivered: 1,
+	}, funnel.Steps)
+	assert.Equal(t, map[string]int{domain.LinkFunnelReasonAlreadyIssued: 2}, funnel.Failures)
+
+	events, err := repo.GetAll(ctx, storage.Pgx, *did, ports.LinkFunnelFilter{})
+	require.NoError(t, err)
+	assert.Len(t, events, 9)
+
+	events, err = repo.GetAll(ctx, storage.Pgx, *did, ports.LinkFunnelFilter{LinkID: &other.ID})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, domain.LinkFunnelQRFetched, events[0].Step)
+	assert.NotEmpty(t, events[0].Session)
+
+	events, err = repo.GetAll(ctx, storage.Pgx, *did, ports.LinkFunnelFilter{From: common.ToPointer(time.Now().Add(time.Hour))})
+	require.NoError(t, err)
+	assert.Empty(t, events)
+
+	old := domain.NewLinkFunnelEvent(*did, link.ID, "session-4", domain.LinkFunnelQRFetched)
+	old.CreatedAt = old.CreatedAt.Add(-24 * time.Hour)
+	require.NoError(t, repo.Save(ctx, storage.Pgx, old))
+
+	now := time.Now().UTC()
+	analytics, err := repo.Analytics(ctx, storage.Pgx, *did, link.ID, domain.LinkAnalyticsHour, now.Add(-2*time.Hour), now.Add(time.Minute))
+	require.NoError(t, err)
+	require.Len(t, analytics.Buckets, 3)
+	assert.Equal(t, domain.LinkAnalyticsBucket{Start: now.Truncate(time.Hour), QRDisplayed: 2, Authenticated: 2, Issued: 1, Errors: 2}, *analytics.Buckets[2])
+	assert.Equal(t, domain.LinkAnalyticsBucket{Start: now.Truncate(time.Hour).Add(-time.Hour)}, *analytics.Buckets[1])
+
+	analytics, err = repo.Analytics(ctx, storage.Pgx, *did, link.ID, domain.LinkAnalyticsDay, now.Add(-48*time.Hour), now.Add(time.Minute))
+	require.NoError(t, err)
+	total := 0
+	for _, bucket := range analytics.Buckets {
+		total += bucket.QRDisplayed
+	}
+	assert.Equal(t, 3, total)
+}
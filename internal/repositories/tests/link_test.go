@@ -3,6 +3,8 @@ package tests
 import (
 	"context"
 	"encoding/json"
+	"math/big"
+	"math/rand"
 	"testing"
 	"time"
 
@@ -53,6 +55,25 @@ func TestSaveLink(t *testing.T) {
 	respCred, err := json.Marshal(linkFetched.CredentialSubject)
 	require.NoError(t, err)
 	assert.Equal(t, tcCred, respCred)
+	assert.Nil(t, linkFetched.ProofScope)
+	assert.Nil(t, linkFetched.AllowedDIDs)
+
+	gated := domain.NewLink(did, nil, nil, schemaID, nil, true, false, domain.CredentialSubject{"birthday": 19790911, "documentType": 1})
+	gated.ProofScope = []domain.VerificationQuery{{
+		ID:                1,
+		CircuitID:         "credentialAtomicQueryMTPV2",
+		Context:           "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json-ld/kyc-v3.json-ld",
+		Type:              "KYCAgeCredential",
+		AllowedIssuers:    []string{didStr},
+		CredentialSubject: map[string]any{"birthday": map[string]any{"$lt": float64(20050101)}},
+	}}
+	gated.AllowedDIDs = []string{didStr}
+	gatedID, err := linkStore.Save(ctx, storage.Pgx, gated)
+	require.NoError(t, err)
+	gatedFetched, err := linkStore.GetByID(ctx, did, *gatedID)
+	require.NoError(t, err)
+	assert.Equal(t, gated.ProofScope, gatedFetched.ProofScope)
+	assert.Equal(t, gated.AllowedDIDs, gatedFetched.AllowedDIDs)
 
 	didStr2 := "did:polygonid:polygon:mumbai:2qFrLQA6R1bfUTxjRnZEN9st77g6ZN2c7Vw1Dq6Vpp"
 	_, err = storage.Pgx.Exec(ctx, "INSERT INTO identities (identifier) VALUES ($1)", didStr2)
@@ -113,6 +134,47 @@ func insertSchemaForLink(ctx context.Context, didStr string, store ports.SchemaR
 	return s.ID
 }
 
+func TestSaveLinkVersion(t *testing.T) {
+	ctx := context.Background()
+	typ, err := core.BuildDIDType(core.DIDMethodPolygonID, core.Polygon, core.Mumbai)
+	require.NoError(t, err)
+	id, err := core.IdGenesisFromIdenState(typ, big.NewInt(rand.Int63()))
+	require.NoError(t, err)
+	did, err := core.ParseDIDFromID(*id)
+	require.NoError(t, err)
+	_, err = storage.Pgx.Exec(ctx, "INSERT INTO identities (identifier) VALUES ($1)", did.String())
+	require.NoError(t, err)
+	schemaID := insertSchemaForLink(ctx, did.String(), repositories.NewSchema(*storage), t)
+
+	linkStore := repositories.NewLink(*storage)
+	link := domain.NewLink(*did, nil, nil, schemaID, nil, true, false, domain.CredentialSubject{})
+	_, err = linkStore.Save(ctx, storage.Pgx, link)
+	require.NoError(t, err)
+	assert.Equal(t, 1, link.Version)
+
+	read1, err := linkStore.GetByID(ctx, *did, link.ID)
+	require.NoError(t, err)
+	read2, err := linkStore.GetByID(ctx, *did, link.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, read1.Version)
+
+	read1.Active = false
+	_, err = linkStore.Save(ctx, storage.Pgx, read1)
+	require.NoError(t, err)
+	assert.Equal(t, 2, read1.Version)
+
+	// read2 is stale, so it can't overwrite the update of read1
+	read2.MaxIssuance = common.ToPointer(5)
+	_, err = linkStore.Save(ctx, storage.Pgx, read2)
+	assert.ErrorIs(t, err, repositories.ErrVersionConflict)
+
+	linkFetched, err := linkStore.GetByID(ctx, *did, link.ID)
+	require.NoError(t, err)
+	assert.False(t, linkFetched.Active)
+	assert.Nil(t, linkFetched.MaxIssuance)
+	assert.Equal(t, 2, linkFetched.Version)
+}
+
 func TestGetLinkById(t *testing.T) {
 	ctx := context.Background()
 	didStr := "did:polygonid:polygon:mumbai:2qP8C6HFRANi79HDdnak4b2QJeGewKWbQBYakNXJTh"
@@ -202,7 +264,7 @@ func TestGetAll(t *testing.T) {
 				OtherIdentifier: "did:polygonid:polygon:mumbai:2qP8KN3KRwBi37jB2ENXrWxhTo3pefaU5u5BFPbjYo",
 				Expiration:      0,
 				Version:         0,
-				RevNonce:        0,
+				RevNonce:        domain.RevNonceUint64(rand.Int63()),
 				CoreClaim:       domain.CoreClaim{},
 				Status:          nil,
 				HIndex:          HIndex,
@@ -0,0 +1,87 @@
+package tests
+
+import (
+	"context"
+	"math/big"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db/tests"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+func TestOfferEmails(t *testing.T) {
+	ctx := context.Background()
+	fixture := tests.NewFixture(storage)
+
+	typ, err := core.BuildDIDType(core.DIDMethodPolygonID, core.Polygon, core.Mumbai)
+	require.NoError(t, err)
+	id, err := core.IdGenesisFromIdenState(typ, big.NewInt(rand.Int63()))
+	require.NoError(t, err)
+	did, err := core.ParseDIDFromID(*id)
+	require.NoError(t, err)
+	fixture.CreateIdentity(t, &domain.Identity{Identifier: did.String()})
+
+	claim := fixture.NewClaim(t, did.String())
+	claim.RevNonce = domain.RevNonceUint64(rand.Int63())
+	credentialID := fixture.CreateClaim(t, claim)
+
+	repo := repositories.NewOfferEmail()
+	first, err := domain.NewOfferEmail(*did, credentialID, "jane@example.com")
+	require.NoError(t, err)
+	first.CreatedAt = first.CreatedAt.Add(-time.Minute)
+	require.NoError(t, repo.Save(ctx, storage.Pgx, first))
+	first.Failed(assert.AnError)
+	require.NoError(t, repo.Save(ctx, storage.Pgx, first))
+
+	second, err := domain.NewOfferEmail(*did, credentialID, "jane@example.com")
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(ctx, storage.Pgx, second))
+	second.Sent(time.Now().UTC())
+	require.NoError(t, repo.Save(ctx, storage.Pgx, second))
+
+	fetched, err := repo.GetByID(ctx, storage.Pgx, first.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.OfferEmailFailed, fetched.Status)
+	require.NotNil(t, fetched.Error)
+	assert.Equal(t, assert.AnError.Error(), *fetched.Error)
+	assert.Nil(t, fetched.SentAt)
+
+	_, err = repo.GetByID(ctx, storage.Pgx, uuid.New())
+	assert.ErrorIs(t, err, repositories.ErrOfferEmailNotFound)
+	assert.ErrorIs(t, repo.Opened(ctx, storage.Pgx, uuid.New(), time.Now()), repositories.ErrOfferEmailNotFound)
+
+	// a click records the open too, and only the first of each is kept
+	clickedAt := time.Now().UTC().Truncate(time.Millisecond)
+	require.NoError(t, repo.Clicked(ctx, storage.Pgx, second.ID, clickedAt))
+	require.NoError(t, repo.Opened(ctx, storage.Pgx, second.ID, clickedAt.Add(time.Minute)))
+	fetched, err = repo.GetByID(ctx, storage.Pgx, second.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.OfferEmailSent, fetched.Status)
+	assert.NotNil(t, fetched.SentAt)
+	require.NotNil(t, fetched.OpenedAt)
+	require.NotNil(t, fetched.ClickedAt)
+	assert.True(t, clickedAt.Equal(*fetched.OpenedAt))
+	assert.True(t, clickedAt.Equal(*fetched.ClickedAt))
+	assert.Nil(t, fetched.ClaimedAt)
+
+	offersRepo := repositories.NewCredentialOffer()
+	offer := domain.NewCredentialOffer(*did, time.Hour, credentialID)
+	require.NoError(t, offersRepo.Save(ctx, storage.Pgx, offer))
+	require.NoError(t, offersRepo.Consume(ctx, storage.Pgx, *did, offer.ID, credentialID, time.Now().UTC()))
+
+	emails, err := repo.GetByCredential(ctx, storage.Pgx, *did, credentialID)
+	require.NoError(t, err)
+	require.Len(t, emails, 2)
+	assert.Equal(t, second.ID, emails[0].ID)
+	assert.Equal(t, first.ID, emails[1].ID)
+	assert.NotNil(t, emails[0].ClaimedAt)
+	assert.NotNil(t, emails[1].ClaimedAt)
+}
@@ -0,0 +1,83 @@
+package tests
+
+import (
+	"context"
+	"math/big"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db/tests"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+func TestOID4VCIOffers(t *testing.T) {
+	ctx := context.Background()
+	fixture := tests.NewFixture(storage)
+
+	typ, err := core.BuildDIDType(core.DIDMethodPolygonID, core.Polygon, core.Mumbai)
+	require.NoError(t, err)
+	id, err := core.IdGenesisFromIdenState(typ, big.NewInt(rand.Int63()))
+	require.NoError(t, err)
+	issuerDID, err := core.ParseDIDFromID(*id)
+	require.NoError(t, err)
+	didStr := issuerDID.String()
+	fixture.CreateIdentity(t, &domain.Identity{Identifier: didStr})
+
+	repo := repositories.NewOID4VCI()
+	_, err = repo.GetByPreAuthorizedCode(ctx, storage.Pgx, domain.OID4VCIHash("unknown"))
+	assert.ErrorIs(t, err, repositories.ErrOID4VCIOfferNotFound)
+
+	credential := domain.OID4VCICredential{
+		Schema:            "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json",
+		Type:              "KYCAgeCredential",
+		CredentialSubject: map[string]any{"birthday": float64(19960424)},
+		MTProof:           true,
+	}
+	offer, code, err := domain.NewOID4VCIOffer(*issuerDID, credential, time.Hour)
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(ctx, storage.Pgx, offer))
+
+	got, err := repo.GetByPreAuthorizedCode(ctx, storage.Pgx, domain.OID4VCIHash(code))
+	require.NoError(t, err)
+	assert.Equal(t, offer.ID, got.ID)
+	assert.Equal(t, didStr, got.IssuerDID.String())
+	assert.Equal(t, credential, got.Credential)
+	assert.False(t, got.Redeemed())
+
+	tokenHash := domain.OID4VCIHash("token")
+	require.NoError(t, repo.Redeem(ctx, storage.Pgx, offer.ID, tokenHash, time.Now().Add(time.Minute), "nonce"))
+	assert.ErrorIs(t, repo.Redeem(ctx, storage.Pgx, offer.ID, domain.OID4VCIHash("other"), time.Now().Add(time.Minute), "nonce"), repositories.ErrOID4VCIOfferRedeemed)
+
+	got, err = repo.GetByAccessToken(ctx, storage.Pgx, tokenHash)
+	require.NoError(t, err)
+	assert.Equal(t, offer.ID, got.ID)
+	assert.True(t, got.Redeemed())
+	assert.Equal(t, "nonce", *got.CNonce)
+
+	require.NoError(t, repo.SetNonce(ctx, storage.Pgx, offer.ID, "nonce", "next"))
+	assert.ErrorIs(t, repo.SetNonce(ctx, storage.Pgx, offer.ID, "nonce", "other"), repositories.ErrOID4VCINonceChanged)
+
+	claimID := fixture.CreateClaim(t, &domain.Claim{
+		RevNonce:        domain.RevNonceUint64(rand.Int63()),
+		ID:              uuid.New(),
+		Identifier:      &didStr,
+		Issuer:          didStr,
+		SchemaHash:      "ca938857241db9451ea329256b9c06e5",
+		SchemaURL:       credential.Schema,
+		SchemaType:      credential.Type,
+		OtherIdentifier: "",
+		HIndex:          uuid.New().String(),
+	})
+	require.NoError(t, repo.SetClaim(ctx, storage.Pgx, offer.ID, claimID))
+	got, err = repo.GetByAccessToken(ctx, storage.Pgx, tokenHash)
+	require.NoError(t, err)
+	assert.Equal(t, "next", *got.CNonce)
+	assert.Equal(t, claimID, *got.ClaimID)
+}
@@ -0,0 +1,77 @@
+package tests
+
+import (
+	"context"
+	"math/big"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db/tests"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+func TestProblemReports(t *testing.T) {
+	ctx := context.Background()
+	fixture := tests.NewFixture(storage)
+	repo := repositories.NewProblemReport()
+
+	typ, err := core.BuildDIDType(core.DIDMethodPolygonID, core.Polygon, core.Mumbai)
+	require.NoError(t, err)
+	id, err := core.IdGenesisFromIdenState(typ, big.NewInt(rand.Int63()))
+	require.NoError(t, err)
+	did, err := core.ParseDIDFromID(*id)
+	require.NoError(t, err)
+	fixture.CreateIdentity(t, &domain.Identity{Identifier: did.String()})
+
+	holderDID, err := core.ParseDID("did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ")
+	require.NoError(t, err)
+	connID := fixture.CreateConnection(t, &domain.Connection{
+		IssuerDID:  *did,
+		UserDID:    *holderDID,
+		CreatedAt:  time.Now(),
+		ModifiedAt: time.Now(),
+	})
+	credentialID := fixture.CreateClaim(t, fixture.NewClaim(t, did.String()))
+	offer := domain.NewCredentialOffer(*did, time.Hour, credentialID)
+	require.NoError(t, repositories.NewCredentialOffer().Save(ctx, storage.Pgx, offer))
+
+	// the agent answered a fetch of the credential, and the holder reported a problem in the thread of the offer
+	sent := domain.NewProblemReport(*did, domain.ProblemReportSent, holderDID.String(), uuid.NewString(), uuid.NewString(), domain.ProblemCodeOfferExpired, "offer expired")
+	sent.CredentialID = common.ToPointer(credentialID)
+	sent.CreatedAt = time.Now().Add(-time.Minute)
+	received := domain.NewProblemReport(*did, domain.ProblemReportReceived, holderDID.String(), uuid.NewString(), offer.ID.String(), "e.p.xfer.cant-use-endpoint", "")
+	other := domain.NewProblemReport(*did, domain.ProblemReportSent, "did:polygonid:polygon:mumbai:2qH7XAwYQzCp9VfhpNgeLtK2iCehDDrfMWUCEg5ig5", uuid.NewString(), uuid.NewString(), domain.ProblemCodeUnauthorized, "")
+	for _, report := range []*domain.ProblemReport{sent, received, other} {
+		require.NoError(t, repo.Save(ctx, storage.Pgx, report))
+	}
+	require.NotNil(t, sent.ConnectionID)
+	assert.Equal(t, connID, *sent.ConnectionID)
+	assert.Nil(t, other.ConnectionID)
+
+	reports, err := repo.GetAll(ctx, storage.Pgx, *did, ports.ProblemReportsFilter{CredentialID: &credentialID})
+	require.NoError(t, err)
+	require.Len(t, reports, 2)
+	assert.Equal(t, received.ID, reports[0].ID)
+	assert.Equal(t, domain.ProblemReportReceived, reports[0].Direction)
+	assert.Nil(t, reports[0].CredentialID)
+	assert.Equal(t, sent.ID, reports[1].ID)
+	assert.Equal(t, domain.ProblemCodeOfferExpired, reports[1].Code)
+	assert.Equal(t, "offer expired", reports[1].Comment)
+
+	reports, err = repo.GetAll(ctx, storage.Pgx, *did, ports.ProblemReportsFilter{ConnectionID: &connID})
+	require.NoError(t, err)
+	assert.Len(t, reports, 2)
+
+	reports, err = repo.GetAll(ctx, storage.Pgx, *did, ports.ProblemReportsFilter{})
+	require.NoError(t, err)
+	assert.Len(t, reports, 3)
+}
@@ -0,0 +1,105 @@
+package tests
+
+import (
+	"context"
+	"math/big"
+	"math/rand"
+	"testing"
+	"time"
+
+	core "github.com/iden3/go-iden3-core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db/tests"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+func TestPublishingPolicies(t *testing.T) {
+	ctx := context.Background()
+	fixture := tests.NewFixture(storage)
+
+	typ, err := core.BuildDIDType(core.DIDMethodPolygonID, core.Polygon, core.Mumbai)
+	require.NoError(t, err)
+	id, err := core.IdGenesisFromIdenState(typ, big.NewInt(rand.Int63()))
+	require.NoError(t, err)
+	did, err := core.ParseDIDFromID(*id)
+	require.NoError(t, err)
+
+	repo := repositories.NewPublishingPolicy()
+	policy := &domain.PublishingPolicy{Identifier: did.String(), Mode: domain.PublishingModeInterval, Interval: time.Hour}
+	assert.ErrorIs(t, repo.Save(ctx, storage.Pgx, policy), repositories.ErrPublishingPolicyIdentityNotFound)
+
+	fixture.CreateIdentity(t, &domain.Identity{Identifier: did.String()})
+	_, err = repo.GetByIdentifier(ctx, storage.Pgx, *did)
+	assert.ErrorIs(t, err, repositories.ErrPublishingPolicyNotFound)
+
+	require.NoError(t, repo.Save(ctx, storage.Pgx, policy))
+	require.NotNil(t, policy.UpdatedAt)
+	// saving again replaces the policy
+	policy.Mode, policy.Threshold, policy.RevocationWindow = domain.PublishingModeThreshold, 10, 15*time.Minute
+	require.NoError(t, repo.Save(ctx, storage.Pgx, policy))
+
+	stored, err := repo.GetByIdentifier(ctx, storage.Pgx, *did)
+	require.NoError(t, err)
+	assert.Equal(t, domain.PublishingModeThreshold, stored.Mode)
+	assert.Equal(t, time.Hour, stored.Interval)
+	assert.Equal(t, 10, stored.Threshold)
+	assert.Equal(t, 15*time.Minute, stored.RevocationWindow)
+	assert.True(t, stored.Overridden)
+
+	all, err := repo.GetAll(ctx, storage.Pgx)
+	require.NoError(t, err)
+	assert.Contains(t, identifiersOf(all), did.String())
+
+	require.NoError(t, repo.Delete(ctx, storage.Pgx, *did))
+	_, err = repo.GetByIdentifier(ctx, storage.Pgx, *did)
+	assert.ErrorIs(t, err, repositories.ErrPublishingPolicyNotFound)
+}
+
+func TestPublishingPolicies_GetPendingChanges(t *testing.T) {
+	ctx := context.Background()
+	fixture := tests.NewFixture(storage)
+
+	typ, err := core.BuildDIDType(core.DIDMethodPolygonID, core.Polygon, core.Mumbai)
+	require.NoError(t, err)
+	id, err := core.IdGenesisFromIdenState(typ, big.NewInt(rand.Int63()))
+	require.NoError(t, err)
+	did, err := core.ParseDIDFromID(*id)
+	require.NoError(t, err)
+	fixture.CreateIdentity(t, &domain.Identity{Identifier: did.String()})
+
+	claim := fixture.NewClaim(t, did.String())
+	fixture.CreateClaim(t, claim)
+	claim = fixture.NewClaim(t, did.String())
+	claim.RevNonce = domain.RevNonceUint64(rand.Int63())
+	fixture.CreateClaim(t, claim)
+	require.NoError(t, repositories.NewClaims().Revoke(ctx, storage.Pgx, &domain.Revocation{
+		Identifier: did.String(),
+		Nonce:      claim.RevNonce,
+		Status:     domain.RevPending,
+	}))
+
+	pending, err := repositories.NewPublishingPolicy().GetPendingChanges(ctx, storage.Pgx)
+	require.NoError(t, err)
+	var changes *domain.PendingChanges
+	for _, p := range pending {
+		if p.Identifier == did.String() {
+			changes = p
+		}
+	}
+	require.NotNil(t, changes)
+	assert.Equal(t, 3, changes.Changes)
+	assert.Equal(t, 1, changes.Revocations)
+	assert.NotNil(t, changes.OldestRevocationAt)
+	assert.Nil(t, changes.LastStateAt)
+}
+
+func identifiersOf(policies []*domain.PublishingPolicy) []string {
+	identifiers := make([]string, len(policies))
+	for i, policy := range policies {
+		identifiers[i] = policy.Identifier
+	}
+	return identifiers
+}
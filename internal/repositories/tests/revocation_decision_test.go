@@ -0,0 +1,104 @@
+package tests
+
+import (
+	"context"
+	"math/big"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db/tests"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+func TestRevocationDecisions(t *testing.T) {
+	ctx := context.Background()
+	fixture := tests.NewFixture(storage)
+
+	typ, err := core.BuildDIDType(core.DIDMethodPolygonID, core.Polygon, core.Mumbai)
+	require.NoError(t, err)
+	id, err := core.IdGenesisFromIdenState(typ, big.NewInt(rand.Int63()))
+	require.NoError(t, err)
+	did, err := core.ParseDIDFromID(*id)
+	require.NoError(t, err)
+	fixture.CreateIdentity(t, &domain.Identity{Identifier: did.String()})
+
+	repo := repositories.NewRevocationDecision()
+	latest, err := repo.GetLatestDecidedAt(ctx, storage.Pgx, *did, "fraud-engine")
+	require.NoError(t, err)
+	assert.Nil(t, latest)
+
+	decidedAt := time.Now().Add(-time.Hour).UTC().Truncate(time.Microsecond)
+	nonce := domain.RevNonceUint64(rand.Uint64())
+	applied := &domain.RevocationDecision{
+		ID:           uuid.New(),
+		IssuerDID:    *did,
+		Source:       "fraud-engine",
+		ExternalID:   "case-1",
+		CredentialID: common.ToPointer(uuid.New()),
+		RevNonce:     &nonce,
+		Reason:       "fraud",
+		Status:       domain.RevocationDecisionApplied,
+		DecidedAt:    decidedAt,
+		CreatedAt:    time.Now().UTC(),
+	}
+	require.NoError(t, repo.Save(ctx, storage.Pgx, applied))
+	rejected := &domain.RevocationDecision{
+		ID:         uuid.New(),
+		IssuerDID:  *did,
+		Source:     "hr",
+		ExternalID: "case-1",
+		Status:     domain.RevocationDecisionApplied,
+		DecidedAt:  decidedAt.Add(time.Minute),
+		CreatedAt:  time.Now().UTC(),
+	}
+	rejected.Reject("the credential does not exist")
+	require.NoError(t, repo.Save(ctx, storage.Pgx, rejected))
+
+	// the same id of the same source is only saved once
+	duplicated := *applied
+	duplicated.ID = uuid.New()
+	assert.ErrorIs(t, repo.Save(ctx, storage.Pgx, &duplicated), repositories.ErrRevocationDecisionDuplicated)
+
+	stored, err := repo.GetByExternalID(ctx, storage.Pgx, *did, "fraud-engine", "case-1")
+	require.NoError(t, err)
+	assert.Equal(t, applied.ID, stored.ID)
+	assert.Equal(t, applied.CredentialID, stored.CredentialID)
+	require.NotNil(t, stored.RevNonce)
+	assert.Equal(t, nonce, *stored.RevNonce)
+	assert.Equal(t, domain.RevocationDecisionApplied, stored.Status)
+	assert.Nil(t, stored.Error)
+	assert.True(t, decidedAt.Equal(stored.DecidedAt))
+	_, err = repo.GetByExternalID(ctx, storage.Pgx, *did, "fraud-engine", "case-2")
+	assert.ErrorIs(t, err, repositories.ErrRevocationDecisionNotFound)
+
+	latest, err = repo.GetLatestDecidedAt(ctx, storage.Pgx, *did, "hr")
+	require.NoError(t, err)
+	require.NotNil(t, latest)
+	assert.True(t, rejected.DecidedAt.Equal(*latest))
+
+	all, err := repo.GetAll(ctx, storage.Pgx, *did, &ports.RevocationDecisionsFilter{})
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+	assert.Equal(t, applied.ID, all[0].ID)
+	require.NotNil(t, all[1].Error)
+	assert.Equal(t, "the credential does not exist", *all[1].Error)
+
+	status := domain.RevocationDecisionRejected
+	all, err = repo.GetAll(ctx, storage.Pgx, *did, &ports.RevocationDecisionsFilter{Status: &status})
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+	assert.Equal(t, rejected.ID, all[0].ID)
+
+	all, err = repo.GetAll(ctx, storage.Pgx, *did, &ports.RevocationDecisionsFilter{Source: "fraud-engine", To: common.ToPointer(time.Now().Add(-time.Minute))})
+	require.NoError(t, err)
+	assert.Len(t, all, 0)
+}
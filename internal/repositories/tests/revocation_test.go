@@ -0,0 +1,50 @@
+package tests
+
+import (
+	"context"
+	"math/big"
+	"math/rand"
+	"testing"
+	"time"
+
+	core "github.com/iden3/go-iden3-core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db/tests"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+func TestGetRevokedNonces(t *testing.T) {
+	ctx := context.Background()
+	fixture := tests.NewFixture(storage)
+	claimsRepo := repositories.NewClaims()
+	revocationRepo := repositories.NewRevocation()
+
+	typ, err := core.BuildDIDType(core.DIDMethodPolygonID, core.Polygon, core.Mumbai)
+	require.NoError(t, err)
+	id, err := core.IdGenesisFromIdenState(typ, big.NewInt(rand.Int63()))
+	require.NoError(t, err)
+	did, err := core.ParseDIDFromID(*id)
+	require.NoError(t, err)
+	fixture.CreateIdentity(t, &domain.Identity{Identifier: did.String()})
+
+	nonces, err := revocationRepo.GetRevokedNonces(ctx, storage.Pgx, did, nil)
+	require.NoError(t, err)
+	assert.Empty(t, nonces)
+
+	require.NoError(t, claimsRepo.RevokeNonces(ctx, storage.Pgx, did, []domain.RevNonceUint64{7, 3}, domain.RevocationUnspecified, ""))
+	_, err = storage.Pgx.Exec(ctx, `UPDATE revocation SET created_at = now() - interval '1 day' WHERE identifier = $1`, did.String())
+	require.NoError(t, err)
+	require.NoError(t, claimsRepo.RevokeNonces(ctx, storage.Pgx, did, []domain.RevNonceUint64{5}, domain.RevocationUnspecified, ""))
+
+	nonces, err = revocationRepo.GetRevokedNonces(ctx, storage.Pgx, did, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []domain.RevNonceUint64{3, 5, 7}, nonces)
+
+	since := time.Now().Add(-time.Hour)
+	nonces, err = revocationRepo.GetRevokedNonces(ctx, storage.Pgx, did, &since)
+	require.NoError(t, err)
+	assert.Equal(t, []domain.RevNonceUint64{5}, nonces)
+}
@@ -53,6 +53,110 @@ func TestGetSchema(t *testing.T) {
 	assert.InDelta(t, schema1.CreatedAt.UnixMilli(), schema2.CreatedAt.UnixMilli(), 10)
 }
 
+func TestUpdateSchemaVersion(t *testing.T) {
+	ctx := context.Background()
+	store := repositories.NewSchema(*storage)
+	did := core.DID{}
+	require.NoError(t, did.SetString("did:iden3:polygon:mumbai:wyFiV4w71QgWPn6bYLsZoysFay66gKtVa9kfu6yMZ"))
+	schema := &domain.Schema{
+		ID:         uuid.New(),
+		IssuerDID:  did,
+		URL:        "https://an.url.org/index.html",
+		Type:       "schemaType",
+		Hash:       core.NewSchemaHashFromInt(big.NewInt(rand.Int63())),
+		Attributes: domain.SchemaAttrs{"field1"},
+		CreatedAt:  time.Now(),
+	}
+	require.NoError(t, store.Save(ctx, schema))
+	assert.Equal(t, 1, schema.Version)
+
+	stale, err := store.GetByID(ctx, did, schema.ID)
+	require.NoError(t, err)
+
+	schema.AutoRevokeOnExpiration = true
+	require.NoError(t, store.Update(ctx, schema))
+	assert.Equal(t, 2, schema.Version)
+
+	stale.AutoRevokeOnExpiration = false
+	assert.ErrorIs(t, store.Update(ctx, stale), repositories.ErrVersionConflict)
+
+	stored, err := store.GetByID(ctx, did, schema.ID)
+	require.NoError(t, err)
+	assert.True(t, stored.AutoRevokeOnExpiration)
+	assert.Equal(t, 2, stored.Version)
+
+	schema.ID = uuid.New()
+	assert.ErrorIs(t, store.Update(ctx, schema), repositories.ErrSchemaDoesNotExist)
+}
+
+func TestUpdateSchemaDefaultProofTypes(t *testing.T) {
+	ctx := context.Background()
+	store := repositories.NewSchema(*storage)
+	did := core.DID{}
+	require.NoError(t, did.SetString("did:iden3:polygon:mumbai:wyFiV4w71QgWPn6bYLsZoysFay66gKtVa9kfu6yMZ"))
+	schema := &domain.Schema{
+		ID:         uuid.New(),
+		IssuerDID:  did,
+		URL:        "https://an.url.org/proofs.json",
+		Type:       "schemaType",
+		Hash:       core.NewSchemaHashFromInt(big.NewInt(rand.Int63())),
+		Attributes: domain.SchemaAttrs{"field1"},
+		CreatedAt:  time.Now(),
+	}
+	require.NoError(t, store.Save(ctx, schema))
+	stored, err := store.GetByID(ctx, did, schema.ID)
+	require.NoError(t, err)
+	assert.Nil(t, stored.DefaultProofTypes)
+
+	schema.DefaultProofTypes = &domain.ProofTypes{Signature: true}
+	require.NoError(t, store.Update(ctx, schema))
+	stored, err = store.GetByID(ctx, did, schema.ID)
+	require.NoError(t, err)
+	assert.Equal(t, &domain.ProofTypes{Signature: true}, stored.DefaultProofTypes)
+
+	schema.DefaultProofTypes = nil
+	require.NoError(t, store.Update(ctx, schema))
+	stored, err = store.GetByID(ctx, did, schema.ID)
+	require.NoError(t, err)
+	assert.Nil(t, stored.DefaultProofTypes)
+}
+
+func TestUpdateSchemaMaxValidityDays(t *testing.T) {
+	ctx := context.Background()
+	store := repositories.NewSchema(*storage)
+	did := core.DID{}
+	require.NoError(t, did.SetString("did:iden3:polygon:mumbai:wyFiV4w71QgWPn6bYLsZoysFay66gKtVa9kfu6yMZ"))
+	schema := &domain.Schema{
+		ID:              uuid.New(),
+		IssuerDID:       did,
+		URL:             "https://an.url.org/validity.json",
+		Type:            "schemaType",
+		Hash:            core.NewSchemaHashFromInt(big.NewInt(rand.Int63())),
+		Attributes:      domain.SchemaAttrs{"field1"},
+		CreatedAt:       time.Now(),
+		MaxValidityDays: common.ToPointer(365),
+	}
+	require.NoError(t, store.Save(ctx, schema))
+	stored, err := store.GetByID(ctx, did, schema.ID)
+	require.NoError(t, err)
+	require.NotNil(t, stored.MaxValidityDays)
+	assert.Equal(t, 365, *stored.MaxValidityDays)
+
+	schema.MaxValidityDays = nil
+	require.NoError(t, store.Update(ctx, schema))
+	stored, err = store.GetByID(ctx, did, schema.ID)
+	require.NoError(t, err)
+	assert.Nil(t, stored.MaxValidityDays)
+
+	schema.MaxValidityDays = common.ToPointer(90)
+	require.NoError(t, store.Update(ctx, schema))
+	schemas, err := store.GetByURL(ctx, did, schema.URL, schema.Type)
+	require.NoError(t, err)
+	require.Len(t, schemas, 1)
+	require.NotNil(t, schemas[0].MaxValidityDays)
+	assert.Equal(t, 90, *schemas[0].MaxValidityDays)
+}
+
 func TestGetAllFullTextSearch(t *testing.T) {
 	rand.NewSource(time.Now().Unix())
 	ctx := context.Background()
@@ -197,7 +301,7 @@ func TestGetAllFullTextSearch(t *testing.T) {
 		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
-			collection, err := store.GetAll(ctx, did, tc.query)
+			collection, err := store.GetAll(ctx, did, &ports.SchemasFilter{Query: tc.query})
 			require.NoError(t, err)
 			require.Len(t, collection, len(tc.expected.collection))
 			for i := range collection {
@@ -206,6 +310,16 @@ func TestGetAllFullTextSearch(t *testing.T) {
 			}
 		})
 	}
+
+	t.Run("Filter by urls", func(t *testing.T) {
+		collection, err := store.GetAll(ctx, did, &ports.SchemasFilter{URLs: []string{
+			"url is not important in this test but need to be unique 0",
+			"not imported",
+		}})
+		require.NoError(t, err)
+		require.Len(t, collection, 1)
+		assert.Equal(t, "age", collection[0].Type)
+	})
 }
 
 func insertSchemaGetAllData(t *testing.T, ctx context.Context, did core.DID, store ports.SchemaRepository) {
@@ -231,3 +345,144 @@ func insertSchemaGetAllData(t *testing.T, ctx context.Context, did core.DID, sto
 		time.Sleep(2 * time.Millisecond)
 	}
 }
+
+func TestSchemaValidationWebhook(t *testing.T) {
+	ctx := context.Background()
+	store := repositories.NewSchema(*storage)
+	did := core.DID{}
+	require.NoError(t, did.SetString("did:iden3:polygon:mumbai:wyFiV4w71QgWPn6bYLsZoysFay66gKtVa9kfu6yMZ"))
+	url := fmt.Sprintf("https://an.url.org/%s.json", uuid.NewString())
+	schema := &domain.Schema{
+		ID:         uuid.New(),
+		IssuerDID:  did,
+		URL:        url,
+		Type:       "schemaType",
+		Hash:       core.NewSchemaHashFromInt(big.NewInt(rand.Int63())),
+		Attributes: domain.SchemaAttrs{"field1"},
+		CreatedAt:  time.Now(),
+	}
+	require.NoError(t, store.Save(ctx, schema))
+
+	schema.ValidationWebhook = &domain.SchemaWebhook{URL: "https://kyc.example.com/validate", Secret: "secret"}
+	require.NoError(t, store.Update(ctx, schema))
+
+	schemas, err := store.GetByURL(ctx, did, url, "schemaType")
+	require.NoError(t, err)
+	require.Len(t, schemas, 1)
+	assert.Equal(t, schema.ValidationWebhook, schemas[0].ValidationWebhook)
+
+	schemas, err = store.GetByURL(ctx, did, url, "otherType")
+	require.NoError(t, err)
+	assert.Empty(t, schemas)
+
+	schema.ValidationWebhook = nil
+	require.NoError(t, store.Update(ctx, schema))
+	stored, err := store.GetByID(ctx, did, schema.ID)
+	require.NoError(t, err)
+	assert.Nil(t, stored.ValidationWebhook)
+}
+
+func TestSchemaDocuments(t *testing.T) {
+	ctx := context.Background()
+	store := repositories.NewSchema(*storage)
+	did := core.DID{}
+	require.NoError(t, did.SetString("did:iden3:polygon:mumbai:wyFiV4w71QgWPn6bYLsZoysFay66gKtVa9kfu6yMZ"))
+
+	documents := &domain.SchemaDocuments{
+		JSONSchema:    []byte(`{"$metadata":{"type":"Membership"},"type":"object"}`),
+		JSONLDContext: []byte(`{"@context":[{"@version":1.1}]}`),
+	}
+	built := &domain.Schema{
+		ID:         uuid.New(),
+		IssuerDID:  did,
+		URL:        "https://issuer.example.com/v1/schemas/membership/schema.json",
+		Type:       "Membership",
+		Hash:       core.NewSchemaHashFromInt(big.NewInt(rand.Int63())),
+		Attributes: domain.SchemaAttrs{"level"},
+		CreatedAt:  time.Now(),
+		Documents:  documents,
+
+		IPFSCID:        "bafkreigh2akiscaildcqabsyg3dfr6chu3fgpregiymsck7e7aqa4s52zy",
+		IPFSContextCID: "bafkreihdwdcefgh4dqkjv67uzcmw7ojee6xedzdetojuzjevtenxquvyku",
+	}
+	require.NoError(t, store.Save(ctx, built))
+
+	got, err := store.GetDocuments(ctx, built.ID)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(documents.JSONSchema), string(got.JSONSchema))
+	assert.JSONEq(t, string(documents.JSONLDContext), string(got.JSONLDContext))
+
+	schema, err := store.GetByID(ctx, did, built.ID)
+	require.NoError(t, err)
+	assert.Equal(t, built.IPFSCID, schema.IPFSCID)
+	assert.Equal(t, built.IPFSContextCID, schema.IPFSContextCID)
+
+	t.Run("imported schemas have no documents", func(t *testing.T) {
+		imported := &domain.Schema{
+			ID:         uuid.New(),
+			IssuerDID:  did,
+			URL:        "https://an.url.org/index.html",
+			Type:       "schemaType",
+			Hash:       core.NewSchemaHashFromInt(big.NewInt(rand.Int63())),
+			Attributes: domain.SchemaAttrs{"field1"},
+			CreatedAt:  time.Now(),
+		}
+		require.NoError(t, store.Save(ctx, imported))
+		_, err := store.GetDocuments(ctx, imported.ID)
+		assert.ErrorIs(t, err, repositories.ErrSchemaDoesNotExist)
+		schema, err := store.GetByID(ctx, did, imported.ID)
+		require.NoError(t, err)
+		assert.Empty(t, schema.IPFSCID)
+	})
+}
+
+func TestSchemaLifecycle(t *testing.T) {
+	ctx := context.Background()
+	store := repositories.NewSchema(*storage)
+	did := core.DID{}
+	require.NoError(t, did.SetString("did:iden3:polygon:mumbai:wyFiV4w71QgWPn6bYLsZoysFay66gKtVa9kfu6yMZ"))
+
+	sType := "Lifecycle" + uuid.NewString()
+	versions := make([]*domain.Schema, 2)
+	for i := range versions {
+		versions[i] = &domain.Schema{
+			ID:         uuid.New(),
+			IssuerDID:  did,
+			URL:        fmt.Sprintf("https://an.url.org/lifecycle-v%d.json", i+1),
+			Type:       sType,
+			Hash:       core.NewSchemaHashFromInt(big.NewInt(rand.Int63())),
+			Attributes: domain.SchemaAttrs{"field1"},
+			CreatedAt:  time.Now(),
+		}
+		require.NoError(t, store.Save(ctx, versions[i]))
+		assert.Equal(t, i+1, versions[i].SchemaVersion)
+	}
+
+	versions[0].DeprecatedAt = common.ToPointer(time.Now().UTC())
+	require.NoError(t, store.Update(ctx, versions[0]))
+	stored, err := store.GetByID(ctx, did, versions[0].ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.SchemaStatusDeprecated, stored.Status())
+	assert.Equal(t, 1, stored.SchemaVersion)
+
+	ids := func(filter *ports.SchemasFilter) []uuid.UUID {
+		collection, err := store.GetAll(ctx, did, filter)
+		require.NoError(t, err)
+		ids := make([]uuid.UUID, 0)
+		for _, schema := range collection {
+			if schema.Type == sType {
+				ids = append(ids, schema.ID)
+			}
+		}
+		return ids
+	}
+	assert.Equal(t, []uuid.UUID{versions[1].ID, versions[0].ID}, ids(nil))
+	assert.Equal(t, []uuid.UUID{versions[0].ID}, ids(&ports.SchemasFilter{Status: domain.SchemaStatusDeprecated}))
+	assert.Equal(t, []uuid.UUID{versions[1].ID}, ids(&ports.SchemasFilter{Status: domain.SchemaStatusActive}))
+	assert.Equal(t, []uuid.UUID{versions[1].ID}, ids(&ports.SchemasFilter{SchemaVersion: common.ToPointer(2)}))
+	assert.Empty(t, ids(&ports.SchemasFilter{Status: domain.SchemaStatusActive, SchemaVersion: common.ToPointer(1)}))
+
+	versions[0].DeprecatedAt = nil
+	require.NoError(t, store.Update(ctx, versions[0]))
+	assert.Equal(t, []uuid.UUID{versions[1].ID, versions[0].ID}, ids(&ports.SchemasFilter{Status: domain.SchemaStatusActive}))
+}
@@ -0,0 +1,113 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
+	"github.com/iden3/iden3comm/protocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/redis"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+	"github.com/polygonid/sh-id-platform/pkg/cache"
+	link_state "github.com/polygonid/sh-id-platform/pkg/link"
+)
+
+func TestSessionCached(t *testing.T) {
+	testSessionRepository(t, repositories.NewSessionCached(cache.NewMemoryCache(), 0))
+}
+
+func TestSessionRedis(t *testing.T) {
+	s := miniredis.RunT(t)
+	client, err := redis.Open("redis://" + s.Addr())
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, client.Close()) }()
+
+	testSessionRepository(t, repositories.NewSessionRedis(client, time.Minute))
+
+	t.Run("sessions expire", func(t *testing.T) {
+		ctx := context.Background()
+		sessions := repositories.NewSessionRedis(client, time.Minute)
+		require.NoError(t, sessions.Set(ctx, "expiring", protocol.AuthorizationRequestMessage{ID: "1"}))
+		s.FastForward(2 * time.Minute)
+		_, err := sessions.Get(ctx, "expiring")
+		assert.Error(t, err)
+	})
+
+	t.Run("a session is shared by the replicas", func(t *testing.T) {
+		ctx := context.Background()
+		replica1 := repositories.NewSessionRedis(client, time.Minute)
+		replica2 := repositories.NewSessionRedis(client, time.Minute)
+		sessionID := uuid.NewString()
+
+		require.NoError(t, replica1.Set(ctx, sessionID, protocol.AuthorizationRequestMessage{ID: "1"}))
+		message, err := replica2.Get(ctx, sessionID)
+		require.NoError(t, err)
+		assert.Equal(t, "1", message.ID)
+
+		acquired, err := replica2.Acquire(ctx, sessionID)
+		require.NoError(t, err)
+		assert.True(t, acquired)
+		acquired, err = replica1.Acquire(ctx, sessionID)
+		require.NoError(t, err)
+		assert.False(t, acquired)
+	})
+}
+
+func testSessionRepository(t *testing.T, sessions ports.SessionRepository) {
+	t.Helper()
+	ctx := context.Background()
+
+	t.Run("authorization request", func(t *testing.T) {
+		sessionID := uuid.NewString()
+		_, err := sessions.Get(ctx, sessionID)
+		assert.Error(t, err)
+
+		request := protocol.AuthorizationRequestMessage{ID: uuid.NewString(), Body: protocol.AuthorizationRequestMessageBody{CallbackURL: "https://issuer.example/callback"}}
+		require.NoError(t, sessions.Set(ctx, sessionID, request))
+		got, err := sessions.Get(ctx, sessionID)
+		require.NoError(t, err)
+		assert.Equal(t, request, got)
+	})
+
+	t.Run("link state", func(t *testing.T) {
+		key := link_state.CredentialStateCacheKey(uuid.NewString(), uuid.NewString())
+		_, err := sessions.GetLink(ctx, key)
+		assert.Error(t, err)
+
+		require.NoError(t, sessions.SetLink(ctx, key, *link_state.NewStatePending()))
+		got, err := sessions.GetLink(ctx, key)
+		require.NoError(t, err)
+		assert.Equal(t, link_state.StatusPending, got.Status)
+	})
+
+	t.Run("session state", func(t *testing.T) {
+		sessionID := uuid.New()
+		_, err := sessions.GetState(ctx, sessionID)
+		assert.ErrorIs(t, err, repositories.ErrSessionStateNotFound)
+
+		state := domain.SessionState{SessionID: sessionID, Status: domain.SessionStatusAuthenticated}
+		require.NoError(t, sessions.SetState(ctx, state))
+		got, err := sessions.GetState(ctx, sessionID)
+		require.NoError(t, err)
+		assert.Equal(t, sessionID, got.SessionID)
+		assert.Equal(t, domain.SessionStatusAuthenticated, got.Status)
+	})
+
+	t.Run("acquire", func(t *testing.T) {
+		sessionID := uuid.NewString()
+		acquired, err := sessions.Acquire(ctx, sessionID)
+		require.NoError(t, err)
+		assert.True(t, acquired)
+
+		acquired, err = sessions.Acquire(ctx, sessionID)
+		require.NoError(t, err)
+		assert.False(t, acquired)
+	})
+}
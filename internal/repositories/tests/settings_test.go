@@ -0,0 +1,54 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+func TestSettings(t *testing.T) {
+	ctx := context.Background()
+	repo := repositories.NewSettings()
+	require.NoError(t, repo.Delete(ctx, storage.Pgx))
+	t.Cleanup(func() { require.NoError(t, repo.Delete(ctx, storage.Pgx)) })
+
+	overrides, err := repo.Get(ctx, storage.Pgx)
+	require.NoError(t, err)
+	assert.Equal(t, &domain.SettingsOverrides{}, overrides)
+
+	first := &domain.SettingsOverrides{
+		RateLimitAnonymous: &domain.RateLimitBucket{RequestsPerMinute: 30, Burst: 5},
+		Reports:            common.ToPointer(false),
+	}
+	merged, err := repo.Merge(ctx, storage.Pgx, first)
+	require.NoError(t, err)
+	require.NotNil(t, merged.UpdatedAt)
+	assert.Equal(t, first.RateLimitAnonymous, merged.RateLimitAnonymous)
+
+	// merging again keeps the settings that are not given and replaces the rest
+	second := &domain.SettingsOverrides{Reports: common.ToPointer(true), RHSURL: common.ToPointer("https://rhs.example.com")}
+	second.OverridePublishing(domain.PublishingPolicy{Mode: domain.PublishingModeInterval, Interval: time.Hour})
+	_, err = repo.Merge(ctx, storage.Pgx, second)
+	require.NoError(t, err)
+
+	overrides, err = repo.Get(ctx, storage.Pgx)
+	require.NoError(t, err)
+	assert.Equal(t, first.RateLimitAnonymous, overrides.RateLimitAnonymous)
+	assert.Equal(t, common.ToPointer(true), overrides.Reports)
+	assert.Equal(t, common.ToPointer("https://rhs.example.com"), overrides.RHSURL)
+	assert.Equal(t, common.ToPointer(domain.PublishingModeInterval), overrides.PublishingMode)
+	assert.Equal(t, common.ToPointer(time.Hour), overrides.PublishingInterval)
+	assert.Nil(t, overrides.OfferEmails)
+
+	require.NoError(t, repo.Delete(ctx, storage.Pgx))
+	overrides, err = repo.Get(ctx, storage.Pgx)
+	require.NoError(t, err)
+	assert.Equal(t, &domain.SettingsOverrides{}, overrides)
+}
@@ -0,0 +1,96 @@
+package tests
+
+import (
+	"context"
+	"math/big"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db/tests"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+func TestStateTransactions(t *testing.T) {
+	ctx := context.Background()
+	fixture := tests.NewFixture(storage)
+
+	typ, err := core.BuildDIDType(core.DIDMethodPolygonID, core.Polygon, core.Mumbai)
+	require.NoError(t, err)
+	id, err := core.IdGenesisFromIdenState(typ, big.NewInt(rand.Int63()))
+	require.NoError(t, err)
+	did, err := core.ParseDIDFromID(*id)
+	require.NoError(t, err)
+	fixture.CreateIdentity(t, &domain.Identity{Identifier: did.String()})
+
+	state := domain.IdentityState{Identifier: did.String(), State: common.ToPointer("state"), TxID: common.ToPointer("0x01"), Status: domain.StatusTransacted}
+	require.NoError(t, repositories.NewIdentityState().Save(ctx, storage.Pgx, state))
+
+	repo := repositories.NewStateTransaction()
+	tx := &domain.StateTransaction{
+		ID:          uuid.New(),
+		Identifier:  did.String(),
+		State:       "state",
+		Network:     "polygon:mumbai",
+		TxID:        "0x01",
+		TxHashes:    []string{"0x01"},
+		TxType:      2,
+		KeyType:     "ETH",
+		KeyID:       "pbkey",
+		FromAddress: "0x0000000000000000000000000000000000000001",
+		ToAddress:   "0x0000000000000000000000000000000000000002",
+		Nonce:       7,
+		GasLimit:    300000,
+		GasTipCap:   big.NewInt(1500000000),
+		GasFeeCap:   new(big.Int).Mul(big.NewInt(300), big.NewInt(1000000000000)),
+		Payload:     []byte{0x01, 0x02},
+		Attempts:    1,
+		Status:      domain.StateTransactionPending,
+		SubmittedAt: time.Now(),
+	}
+	require.NoError(t, repo.Save(ctx, storage.Pgx, tx))
+
+	pending, err := repo.GetPending(ctx, storage.Pgx)
+	require.NoError(t, err)
+	var got *domain.StateTransaction
+	for _, p := range pending {
+		if p.ID == tx.ID {
+			got = p
+		}
+	}
+	require.NotNil(t, got)
+	assert.Equal(t, tx.GasFeeCap, got.GasFeeCap)
+	assert.Equal(t, tx.GasTipCap, got.GasTipCap)
+	assert.Equal(t, tx.Nonce, got.Nonce)
+	assert.Equal(t, tx.Payload, got.Payload)
+
+	// a resubmission replaces the transaction of the identity state
+	tx.TxID = "0x02"
+	tx.TxHashes = append(tx.TxHashes, tx.TxID)
+	tx.Attempts++
+	require.NoError(t, repo.Save(ctx, storage.Pgx, tx))
+	require.NoError(t, repo.UpdateIdentityState(ctx, storage.Pgx, tx, nil))
+	states, err := repositories.NewIdentityState().GetStatesByStatusAndIssuerID(ctx, storage.Pgx, domain.StatusTransacted, *did)
+	require.NoError(t, err)
+	require.Len(t, states, 1)
+	assert.Equal(t, "0x02", *states[0].TxID)
+
+	tx.Status = domain.StateTransactionFailed
+	tx.Error = common.ToPointer("nonce used by another transaction")
+	require.NoError(t, repo.Save(ctx, storage.Pgx, tx))
+	require.NoError(t, repo.UpdateIdentityState(ctx, storage.Pgx, tx, common.ToPointer(domain.StatusFailed)))
+
+	txs, err := repo.GetByIdentifier(ctx, storage.Pgx, *did, 10)
+	require.NoError(t, err)
+	require.Len(t, txs, 1)
+	assert.Equal(t, []string{"0x01", "0x02"}, txs[0].TxHashes)
+	assert.Equal(t, 2, txs[0].Attempts)
+	assert.Equal(t, domain.StateTransactionFailed, txs[0].Status)
+}
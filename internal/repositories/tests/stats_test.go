@@ -0,0 +1,73 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/rand"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db/tests"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+func TestStats(t *testing.T) {
+	ctx := context.Background()
+	fixture := tests.NewFixture(storage)
+	idStr := "did:polygonid:polygon:mumbai:2qCU58EJgrELNZCDkSU23dQHZsBgAGjJ3qh7VPjnjy"
+	fixture.CreateIdentity(t, &domain.Identity{Identifier: idStr})
+	for i := 0; i < 2; i++ {
+		claim := fixture.NewClaim(t, idStr)
+		claim.RevNonce = domain.RevNonceUint64(rand.Int63())
+		fixture.CreateClaim(t, claim)
+	}
+
+	state := domain.IdentityState{
+		Identifier: idStr,
+		State:      common.ToPointer("a4b3c6e1c1d2e9f5b8d7a0c3e4f7a2b1c8d9e0f1a2b3c4d5e6f7a8b9c0d1e2f3"),
+		Status:     domain.StatusFailed,
+	}
+	require.NoError(t, repositories.NewIdentityState().Save(ctx, storage.Pgx, state))
+
+	statsRepo := repositories.NewStats()
+	from, to := time.Now().Add(-time.Hour), time.Now().Add(time.Hour)
+
+	issued, err := statsRepo.IssuedCredentials(ctx, storage.Pgx, from, to)
+	require.NoError(t, err)
+	assert.Contains(t, issued, domain.IssuanceStat{IssuerDID: idStr, SchemaType: "AuthBJJCredential", Count: 2})
+
+	issued, err = statsRepo.IssuedCredentials(ctx, storage.Pgx, to, to.Add(time.Hour))
+	require.NoError(t, err)
+	assert.NotContains(t, issued, domain.IssuanceStat{IssuerDID: idStr, SchemaType: "AuthBJJCredential", Count: 2})
+
+	failed, err := statsRepo.FailedOperations(ctx, storage.Pgx, from, to)
+	require.NoError(t, err)
+	found := false
+	for _, op := range failed {
+		if op.IssuerDID == idStr && op.State == *state.State {
+			found = true
+		}
+	}
+	assert.True(t, found)
+}
+
+func TestStats_Backlog(t *testing.T) {
+	ctx := context.Background()
+	fixture := tests.NewFixture(storage)
+	idStr := "did:polygonid:polygon:mumbai:2qDnNVjLvgQtPzKMsRPWvG3LxQQ3P6BjjjigBzKZ5m"
+	fixture.CreateIdentity(t, &domain.Identity{Identifier: idStr})
+	claim := fixture.NewClaim(t, idStr)
+	claim.RevNonce = domain.RevNonceUint64(rand.Int63())
+	fixture.CreateClaim(t, claim)
+
+	backlog, err := repositories.NewStats().Backlog(ctx, storage.Pgx)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, backlog.UnpublishedClaims, 1)
+	assert.GreaterOrEqual(t, backlog.PendingRetries, 0)
+	require.NotNil(t, backlog.OldestPendingAt)
+	assert.True(t, backlog.OldestPendingAt.Before(time.Now()))
+}
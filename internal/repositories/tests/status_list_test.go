@@ -0,0 +1,64 @@
+package tests
+
+import (
+	"context"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db/tests"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+func TestStatusList(t *testing.T) {
+	ctx := context.Background()
+	fixture := tests.NewFixture(storage)
+	claimsRepo := repositories.NewClaims()
+	repo := repositories.NewStatusList()
+
+	typ, err := core.BuildDIDType(core.DIDMethodPolygonID, core.Polygon, core.Mumbai)
+	require.NoError(t, err)
+	id, err := core.IdGenesisFromIdenState(typ, big.NewInt(rand.Int63()))
+	require.NoError(t, err)
+	did, err := core.ParseDIDFromID(*id)
+	require.NoError(t, err)
+	fixture.CreateIdentity(t, &domain.Identity{Identifier: did.String()})
+
+	claimIDs := make([]uuid.UUID, 0, 3)
+	for _, nonce := range []domain.RevNonceUint64{11, 12, 13} {
+		claim := fixture.NewClaim(t, did.String())
+		claim.RevNonce = nonce
+		claimIDs = append(claimIDs, fixture.CreateClaim(t, claim))
+	}
+
+	// the indexes of each issuer start at 0
+	for i, claimID := range claimIDs {
+		index, err := repo.NextIndex(ctx, storage.Pgx, *did)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(i), index)
+		require.NoError(t, repo.SaveEntry(ctx, storage.Pgx, &domain.StatusListEntry{IssuerDID: *did, ClaimID: claimID, Index: index}))
+	}
+
+	// a credential keeps the index it has
+	require.NoError(t, repo.SaveEntry(ctx, storage.Pgx, &domain.StatusListEntry{IssuerDID: *did, ClaimID: claimIDs[1], Index: 7}))
+	entry, err := repo.GetEntry(ctx, storage.Pgx, *did, claimIDs[1])
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), entry.Index)
+	_, err = repo.GetEntry(ctx, storage.Pgx, *did, uuid.New())
+	assert.ErrorIs(t, err, repositories.ErrStatusListEntryNotFound)
+
+	revoked, err := repo.GetRevokedIndexes(ctx, storage.Pgx, *did)
+	require.NoError(t, err)
+	assert.Empty(t, revoked)
+
+	require.NoError(t, claimsRepo.RevokeNonces(ctx, storage.Pgx, did, []domain.RevNonceUint64{13, 11}, domain.RevocationUnspecified, ""))
+	revoked, err = repo.GetRevokedIndexes(ctx, storage.Pgx, *did)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{0, 2}, revoked)
+}
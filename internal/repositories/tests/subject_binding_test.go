@@ -0,0 +1,74 @@
+package tests
+
+import (
+	"context"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	core "github.com/iden3/go-iden3-core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db/tests"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+func TestSubjectBindings(t *testing.T) {
+	ctx := context.Background()
+	fixture := tests.NewFixture(storage)
+
+	randomDID := func() core.DID {
+		typ, err := core.BuildDIDType(core.DIDMethodPolygonID, core.Polygon, core.Mumbai)
+		require.NoError(t, err)
+		id, err := core.IdGenesisFromIdenState(typ, big.NewInt(rand.Int63()))
+		require.NoError(t, err)
+		did, err := core.ParseDIDFromID(*id)
+		require.NoError(t, err)
+		return *did
+	}
+	issuerDID := randomDID()
+	holderDID := randomDID()
+	otherHolderDID := randomDID()
+	fixture.CreateIdentity(t, &domain.Identity{Identifier: issuerDID.String()})
+
+	schemaID := insertSchemaForLink(ctx, issuerDID.String(), repositories.NewSchema(*storage), t)
+	linkStore := repositories.NewLink(*storage)
+	link := domain.NewLink(issuerDID, common.ToPointer(1), nil, schemaID, nil, true, false, domain.CredentialSubject{"birthday": 19790911, "documentType": 1})
+	link.SubjectExternalID = common.ToPointer("jane@example.com")
+	_, err := linkStore.Save(ctx, storage.Pgx, link)
+	require.NoError(t, err)
+	linkFetched, err := linkStore.GetByID(ctx, issuerDID, link.ID)
+	require.NoError(t, err)
+	require.NotNil(t, linkFetched.SubjectExternalID)
+	assert.Equal(t, "jane@example.com", *linkFetched.SubjectExternalID)
+
+	repo := repositories.NewSubjectBinding()
+	_, err = repo.GetByExternalID(ctx, storage.Pgx, issuerDID, "jane@example.com")
+	assert.ErrorIs(t, err, repositories.ErrSubjectBindingNotFound)
+
+	binding, err := repo.Bind(ctx, storage.Pgx, domain.NewSubjectBinding(issuerDID, "jane@example.com", holderDID, &link.ID))
+	require.NoError(t, err)
+	assert.True(t, binding.BoundTo(holderDID))
+	require.NotNil(t, binding.LinkID)
+	assert.Equal(t, link.ID, *binding.LinkID)
+
+	// the placeholder keeps the first holder it is bound to
+	binding, err = repo.Bind(ctx, storage.Pgx, domain.NewSubjectBinding(issuerDID, "jane@example.com", otherHolderDID, nil))
+	require.NoError(t, err)
+	assert.True(t, binding.BoundTo(holderDID))
+
+	binding, err = repo.GetByExternalID(ctx, storage.Pgx, issuerDID, "jane@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, issuerDID.String(), binding.IssuerDID.String())
+	assert.Equal(t, holderDID.String(), binding.HolderDID.String())
+
+	// deleting the link keeps the binding
+	require.NoError(t, linkStore.Delete(ctx, link.ID, issuerDID))
+	binding, err = repo.GetByExternalID(ctx, storage.Pgx, issuerDID, "jane@example.com")
+	require.NoError(t, err)
+	assert.Nil(t, binding.LinkID)
+	assert.True(t, binding.BoundTo(holderDID))
+}
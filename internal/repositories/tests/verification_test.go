@@ -0,0 +1,126 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db/tests"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+func TestVerifications(t *testing.T) {
+	ctx := context.Background()
+	fixture := tests.NewFixture(storage)
+
+	typ, err := core.BuildDIDType(core.DIDMethodPolygonID, core.Polygon, core.Mumbai)
+	require.NoError(t, err)
+	newDID := func() *core.DID {
+		id, err := core.IdGenesisFromIdenState(typ, big.NewInt(rand.Int63()))
+		require.NoError(t, err)
+		did, err := core.ParseDIDFromID(*id)
+		require.NoError(t, err)
+		return did
+	}
+	issuerDID, userDID, otherUserDID := newDID(), newDID(), newDID()
+	fixture.CreateIdentity(t, &domain.Identity{Identifier: issuerDID.String()})
+	connID := fixture.CreateConnection(t, &domain.Connection{
+		ID:         uuid.New(),
+		IssuerDID:  *issuerDID,
+		UserDID:    *userDID,
+		CreatedAt:  time.Now(),
+		ModifiedAt: time.Now(),
+	})
+
+	repo := repositories.NewVerification()
+	_, err = repo.GetRequest(ctx, storage.Pgx, uuid.New())
+	assert.ErrorIs(t, err, repositories.ErrVerificationRequestNotFound)
+
+	request := &domain.VerificationRequest{
+		ID:        uuid.New(),
+		IssuerDID: *issuerDID,
+		Reason:    "age check",
+		Scope: []domain.VerificationQuery{{
+			ID:                1,
+			CircuitID:         "credentialAtomicQuerySigV2",
+			Context:           "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json-ld/kyc-v3.json-ld",
+			Type:              "KYCAgeCredential",
+			CredentialSubject: map[string]any{"birthday": map[string]any{"$lt": float64(20000101)}},
+		}},
+		CreatedAt: time.Now().UTC().Truncate(time.Microsecond),
+		ExpiresAt: common.ToPointer(time.Now().Add(time.Hour).UTC().Truncate(time.Microsecond)),
+	}
+	require.NoError(t, repo.SaveRequest(ctx, storage.Pgx, request))
+	got, err := repo.GetRequest(ctx, storage.Pgx, request.ID)
+	require.NoError(t, err)
+	assert.Equal(t, request.IssuerDID.String(), got.IssuerDID.String())
+	assert.Equal(t, request.Reason, got.Reason)
+	assert.Equal(t, request.Scope, got.Scope)
+	assert.True(t, request.ExpiresAt.Equal(*got.ExpiresAt))
+
+	failure := "proof is not valid"
+	failed := &domain.VerificationResponse{
+		ID:           uuid.New(),
+		RequestID:    request.ID,
+		IssuerDID:    *issuerDID,
+		UserDID:      *userDID,
+		ConnectionID: &connID,
+		Error:        &failure,
+		CreatedAt:    time.Now().Add(-time.Minute).UTC(),
+	}
+	verified := &domain.VerificationResponse{
+		ID:           uuid.New(),
+		RequestID:    request.ID,
+		IssuerDID:    *issuerDID,
+		UserDID:      *userDID,
+		ConnectionID: &connID,
+		Verified:     true,
+		Proofs:       json.RawMessage(`[{"id": 1, "circuitId": "credentialAtomicQuerySigV2"}]`),
+		CreatedAt:    time.Now().UTC(),
+	}
+	unknown := &domain.VerificationResponse{
+		ID:        uuid.New(),
+		RequestID: request.ID,
+		IssuerDID: *issuerDID,
+		UserDID:   *otherUserDID,
+		Error:     &failure,
+		CreatedAt: time.Now().UTC(),
+	}
+	for _, response := range []*domain.VerificationResponse{failed, verified, unknown} {
+		require.NoError(t, repo.SaveResponse(ctx, storage.Pgx, response))
+	}
+
+	responses, err := repo.GetResponses(ctx, storage.Pgx, *issuerDID, &ports.VerificationResponsesFilter{RequestID: &request.ID})
+	require.NoError(t, err)
+	assert.Len(t, responses, 3)
+
+	responses, err = repo.GetResponses(ctx, storage.Pgx, *issuerDID, &ports.VerificationResponsesFilter{ConnectionID: &connID})
+	require.NoError(t, err)
+	require.Len(t, responses, 2)
+	assert.Equal(t, verified.ID, responses[0].ID)
+	assert.True(t, responses[0].Verified)
+	assert.JSONEq(t, string(verified.Proofs), string(responses[0].Proofs))
+	assert.Equal(t, failed.ID, responses[1].ID)
+	assert.Equal(t, &failure, responses[1].Error)
+	assert.Empty(t, responses[1].Proofs)
+
+	responses, err = repo.GetResponses(ctx, storage.Pgx, *issuerDID, &ports.VerificationResponsesFilter{UserDID: otherUserDID})
+	require.NoError(t, err)
+	require.Len(t, responses, 1)
+	assert.Nil(t, responses[0].ConnectionID)
+
+	responses, err = repo.GetResponses(ctx, storage.Pgx, *otherUserDID, &ports.VerificationResponsesFilter{})
+	require.NoError(t, err)
+	assert.Empty(t, responses)
+}
@@ -0,0 +1,121 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// ErrVerificationRequestNotFound the verification request does not exist
+var ErrVerificationRequestNotFound = errors.New("verification request not found")
+
+type verifications struct{}
+
+// NewVerification returns a new verification requests and responses repository
+func NewVerification() ports.VerificationRepository {
+	return &verifications{}
+}
+
+// SaveRequest inserts the verification request
+func (r *verifications) SaveRequest(ctx context.Context, conn db.Querier, request *domain.VerificationRequest) error {
+	scope, err := json.Marshal(request.Scope)
+	if err != nil {
+		return err
+	}
+	_, err = conn.Exec(ctx, `
+		INSERT INTO verification_requests (id, issuer_id, reason, scope, created_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6)`,
+		request.ID, request.IssuerDID.String(), request.Reason, scope, request.CreatedAt, request.ExpiresAt)
+	return err
+}
+
+// GetRequest returns the verification request
+func (r *verifications) GetRequest(ctx context.Context, conn db.Querier, id uuid.UUID) (*domain.VerificationRequest, error) {
+	var request domain.VerificationRequest
+	var issuer string
+	var scope []byte
+	err := conn.QueryRow(ctx, `
+		SELECT id, issuer_id, reason, scope, created_at, expires_at
+		FROM verification_requests
+		WHERE id = $1`, id).Scan(&request.ID, &issuer, &request.Reason, &scope, &request.CreatedAt, &request.ExpiresAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrVerificationRequestNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	did, err := core.ParseDID(issuer)
+	if err != nil {
+		return nil, err
+	}
+	request.IssuerDID = *did
+	if err := json.Unmarshal(scope, &request.Scope); err != nil {
+		return nil, err
+	}
+	return &request, nil
+}
+
+// SaveResponse inserts the outcome of a verification
+func (r *verifications) SaveResponse(ctx context.Context, conn db.Querier, response *domain.VerificationResponse) error {
+	var proofs []byte
+	if len(response.Proofs) > 0 {
+		proofs = response.Proofs
+	}
+	_, err := conn.Exec(ctx, `
+		INSERT INTO verification_responses (id, request_id, issuer_id, user_id, connection_id, verified, error, proofs, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+		response.ID, response.RequestID, response.IssuerDID.String(), response.UserDID.String(), response.ConnectionID,
+		response.Verified, response.Error, proofs, response.CreatedAt)
+	return err
+}
+
+// GetResponses returns the verification responses of the issuer that match the filter, newest first
+func (r *verifications) GetResponses(ctx context.Context, conn db.Querier, issuerDID core.DID, filter *ports.VerificationResponsesFilter) ([]*domain.VerificationResponse, error) {
+	var userDID *string
+	if filter.UserDID != nil {
+		did := filter.UserDID.String()
+		userDID = &did
+	}
+	rows, err := conn.Query(ctx, `
+		SELECT id, request_id, issuer_id, user_id, connection_id, verified, error, proofs, created_at
+		FROM verification_responses
+		WHERE issuer_id = $1
+		  AND ($2::uuid IS NULL OR request_id = $2)
+		  AND ($3::uuid IS NULL OR connection_id = $3)
+		  AND ($4::text IS NULL OR user_id = $4)
+		ORDER BY created_at DESC, id`, issuerDID.String(), filter.RequestID, filter.ConnectionID, userDID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	responses := make([]*domain.VerificationResponse, 0)
+	for rows.Next() {
+		var response domain.VerificationResponse
+		var issuer, user string
+		var proofs []byte
+		if err := rows.Scan(&response.ID, &response.RequestID, &issuer, &user, &response.ConnectionID, &response.Verified,
+			&response.Error, &proofs, &response.CreatedAt); err != nil {
+			return nil, err
+		}
+		issuerDID, err := core.ParseDID(issuer)
+		if err != nil {
+			return nil, err
+		}
+		userDID, err := core.ParseDID(user)
+		if err != nil {
+			return nil, err
+		}
+		response.IssuerDID, response.UserDID, response.Proofs = *issuerDID, *userDID, proofs
+		responses = append(responses, &response)
+	}
+	return responses, rows.Err()
+}
@@ -0,0 +1,163 @@
+package standby
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+// ErrNotPromoted the database is still replaying the primary after the promotion timeout
+var ErrNotPromoted = errors.New("database is still a replica")
+
+type recoveryChecker func(ctx context.Context) (bool, error)
+
+// Node tells whether the node runs as the standby of another one.
+// A standby node is connected to a streaming replica of the primary database, which replays its WAL, and optionally to
+// a replica of its redis, which holds the sessions. It serves reads but rejects writes and does not run background
+// jobs. Once the database is promoted the node becomes active without a restart.
+type Node struct {
+	sync.RWMutex
+	inRecovery recoveryChecker
+	standby    bool
+	active     chan struct{}
+}
+
+// New returns the node role. With standby enabled the node is a standby while its database is a replica, so nodes that
+// keep the setting after a promotion start as active ones.
+func New(ctx context.Context, storage *db.Storage, enabled bool) (*Node, error) {
+	return newNode(ctx, enabled, func(ctx context.Context) (bool, error) {
+		return inRecovery(ctx, storage)
+	})
+}
+
+func newNode(ctx context.Context, enabled bool, checker recoveryChecker) (*Node, error) {
+	n := &Node{inRecovery: checker, active: make(chan struct{})}
+	if enabled {
+		recovery, err := checker(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !recovery {
+			log.Warn(ctx, "standby mode is enabled but the database is not a replica, starting as active")
+		}
+		n.standby = recovery
+	}
+	if !n.standby {
+		close(n.active)
+	}
+	return n, nil
+}
+
+// Standby tells whether the node is a standby
+func (n *Node) Standby() bool {
+	n.RLock()
+	defer n.RUnlock()
+	return n.standby
+}
+
+// Active returns a channel that is closed when the node is active, right away if it is not a standby
+func (n *Node) Active() <-chan struct{} {
+	return n.active
+}
+
+// Run checks the database every period until it is promoted
+func (n *Node) Run(ctx context.Context, period time.Duration) {
+	if !n.Standby() {
+		return
+	}
+	log.Info(ctx, "node running as standby, waiting for the database to be promoted")
+	go func() {
+		ticker := time.NewTicker(period)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if n.check(ctx) {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// check returns true once the database has been promoted and the node marked as active
+func (n *Node) check(ctx context.Context) bool {
+	recovery, err := n.inRecovery(ctx)
+	if err != nil {
+		log.Warn(ctx, "cannot check the database role", "err", err)
+		return false
+	}
+	if recovery {
+		return false
+	}
+	n.Lock()
+	n.standby = false
+	n.Unlock()
+	close(n.active)
+	log.Info(ctx, "database promoted, node is active")
+	return true
+}
+
+// Promote turns the replica database into a primary, waiting up to timeout for it to finish replaying the WAL it has
+// received, and stops the replication of the redis server, if it is a replica.
+func Promote(ctx context.Context, storage *db.Storage, rdb *redis.Client, timeout time.Duration) error {
+	recovery, err := inRecovery(ctx, storage)
+	if err != nil {
+		return err
+	}
+	if recovery {
+		var promoted bool
+		if err := storage.Pgx.QueryRow(ctx, `SELECT pg_promote(true, $1)`, int(timeout.Seconds())).Scan(&promoted); err != nil {
+			return fmt.Errorf("promoting database: %w", err)
+		}
+		if !promoted {
+			return ErrNotPromoted
+		}
+		log.Info(ctx, "database promoted")
+	} else {
+		log.Info(ctx, "database is already a primary")
+	}
+
+	if rdb == nil {
+		return nil
+	}
+	info, err := rdb.Info(ctx, "replication").Result()
+	if err != nil {
+		return fmt.Errorf("getting redis role: %w", err)
+	}
+	if !isRedisReplica(info) {
+		log.Info(ctx, "redis is already a primary")
+		return nil
+	}
+	if err := rdb.SlaveOf(ctx, "NO", "ONE").Err(); err != nil {
+		return fmt.Errorf("promoting redis: %w", err)
+	}
+	log.Info(ctx, "redis promoted")
+	return nil
+}
+
+func inRecovery(ctx context.Context, storage *db.Storage) (bool, error) {
+	var recovery bool
+	err := storage.Pgx.QueryRow(ctx, `SELECT pg_is_in_recovery()`).Scan(&recovery)
+	return recovery, err
+}
+
+// isRedisReplica tells whether the replication section of redis INFO belongs to a replica
+func isRedisReplica(info string) bool {
+	for _, line := range strings.Split(info, "\n") {
+		if strings.TrimSpace(line) == "role:slave" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,56 @@
+package standby
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNode(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var recovery atomic.Bool
+	recovery.Store(true)
+	checker := func(ctx context.Context) (bool, error) { return recovery.Load(), nil }
+
+	disabled, err := newNode(ctx, false, checker)
+	require.NoError(t, err)
+	assert.False(t, disabled.Standby(), "a node without standby mode is active even with a replica database")
+	select {
+	case <-disabled.Active():
+	default:
+		t.Fatal("active channel of an active node must be closed")
+	}
+
+	node, err := newNode(ctx, true, checker)
+	require.NoError(t, err)
+	assert.True(t, node.Standby())
+	node.Run(ctx, 10*time.Millisecond)
+	select {
+	case <-node.Active():
+		t.Fatal("standby must not be active before the promotion")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	recovery.Store(false)
+	select {
+	case <-node.Active():
+	case <-time.After(time.Second):
+		t.Fatal("node not active after the promotion")
+	}
+	assert.False(t, node.Standby())
+
+	promoted, err := newNode(ctx, true, checker)
+	require.NoError(t, err)
+	assert.False(t, promoted.Standby(), "standby mode with a promoted database starts as active")
+}
+
+func TestIsRedisReplica(t *testing.T) {
+	assert.True(t, isRedisReplica("# Replication\r\nrole:slave\r\nmaster_host:10.0.0.1\r\n"))
+	assert.False(t, isRedisReplica("# Replication\r\nrole:master\r\nconnected_slaves:1\r\n"))
+}
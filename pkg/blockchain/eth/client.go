@@ -54,6 +54,7 @@ type Client struct {
 
 // ClientConfig eth client config
 type ClientConfig struct {
+	ChainID                *big.Int      `json:"chain_id"` // if nil, it is asked to the node
 	ReceiptTimeout         time.Duration `json:"receipt_timeout"`
 	ConfirmationTimeout    time.Duration `json:"confirmation_timeout"`
 	ConfirmationBlockCount int64         `json:"confirmation_block_count"`
@@ -63,6 +64,11 @@ type ClientConfig struct {
 	RPCResponseTimeout     time.Duration `json:"rpc_response_time_out"`
 	WaitReceiptCycleTime   time.Duration `json:"wait_receipt_cycle_time_out"`
 	WaitBlockCycleTime     time.Duration `json:"wait_block_cycle_time_out"`
+	GasStrategy            GasStrategy   `json:"gas_strategy"`
+	MaxPriorityFeePerGas   *big.Int      `json:"max_priority_fee_per_gas"` // fixed strategy
+	MaxFeePerGas           *big.Int      `json:"max_fee_per_gas"`          // fixed strategy
+	FeeHistoryBlocks       int           `json:"fee_history_blocks"`       // percentile strategy
+	FeeHistoryPercentile   float64       `json:"fee_history_percentile"`   // percentile strategy
 }
 
 // NewClient creates a Client instance.
@@ -230,8 +236,11 @@ func (c *Client) CurrentBlock(ctx context.Context) (*big.Int, error) {
 	return header.Number, nil
 }
 
-// ChainID get chain id.
+// ChainID get chain id. The configured one is returned without asking the node.
 func (c *Client) ChainID(ctx context.Context) (*big.Int, error) {
+	if c.Config.ChainID != nil {
+		return new(big.Int).Set(c.Config.ChainID), nil
+	}
 	_ctx, cancel := context.WithTimeout(ctx, c.Config.RPCResponseTimeout)
 	defer cancel()
 	cid, err := c.client.ChainID(_ctx)
@@ -304,7 +313,7 @@ type TransactionParams struct {
 	Payload     []byte
 }
 
-// CreateRawTx raw transaction.
+// CreateRawTx raw transaction. The fees are computed with the gas strategy of the client unless the params set them.
 func (c *Client) CreateRawTx(ctx context.Context, txParams TransactionParams) (*types.Transaction, error) {
 	if txParams.Nonce == nil {
 		_ctx, cancel := context.WithTimeout(ctx, c.Config.RPCResponseTimeout)
@@ -329,6 +338,22 @@ func (c *Client) CreateRawTx(ctx context.Context, txParams TransactionParams) (*
 		return nil, fmt.Errorf("failed to estimate gas: %v", err)
 	}
 
+	if txParams.BaseFee == nil && txParams.GasTips == nil {
+		tipCap, feeCap, err := c.SuggestFees(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return types.NewTx(&types.DynamicFeeTx{
+			To:        &txParams.ToAddress,
+			Nonce:     *txParams.Nonce,
+			Gas:       gasLimit,
+			Value:     big.NewInt(0),
+			Data:      txParams.Payload,
+			GasTipCap: tipCap,
+			GasFeeCap: feeCap,
+		}), nil
+	}
+
 	latestBlockHeader, err := c.HeaderByNumber(ctx, nil)
 	if err != nil {
 		return nil, err
@@ -347,16 +372,9 @@ func (c *Client) CreateRawTx(ctx context.Context, txParams TransactionParams) (*
 	}
 
 	if txParams.GasTips == nil {
-		_ctx3, cancel3 := context.WithTimeout(ctx, c.Config.RPCResponseTimeout)
-		defer cancel3()
-		gasTip, err := c.client.SuggestGasTipCap(_ctx3)
-		// since hardhad doesn't support 'eth_maxPriorityFeePerGas' rpc call.
-		// we should hardcode 0 as a mainer tips. More information: https://github.com/NomicFoundation/hardhat/issues/1664#issuecomment-1149006010
-		if err != nil && strings.Contains(err.Error(), "eth_maxPriorityFeePerGas not found") {
-			log.Error(ctx, "failed get suggest gas tip: %s. use 0 instead", "err", err)
-			gasTip = big.NewInt(0)
-		} else if err != nil {
-			return nil, fmt.Errorf("failed get suggest gas tip: %v", err)
+		gasTip, err := c.SuggestGasTip(ctx)
+		if err != nil {
+			return nil, err
 		}
 		txParams.GasTips = gasTip
 	}
@@ -377,6 +395,43 @@ func (c *Client) CreateRawTx(ctx context.Context, txParams TransactionParams) (*
 	return tx, nil
 }
 
+// SuggestGasTip returns the suggested max priority fee per gas
+func (c *Client) SuggestGasTip(ctx context.Context) (*big.Int, error) {
+	_ctx, cancel := context.WithTimeout(ctx, c.Config.RPCResponseTimeout)
+	defer cancel()
+	gasTip, err := c.client.SuggestGasTipCap(_ctx)
+	// since hardhad doesn't support 'eth_maxPriorityFeePerGas' rpc call.
+	// we should hardcode 0 as a mainer tips. More information: https://github.com/NomicFoundation/hardhat/issues/1664#issuecomment-1149006010
+	if err != nil && strings.Contains(err.Error(), "eth_maxPriorityFeePerGas not found") {
+		log.Error(ctx, "failed get suggest gas tip: %s. use 0 instead", "err", err)
+		return big.NewInt(0), nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed get suggest gas tip: %v", err)
+	}
+	return gasTip, nil
+}
+
+// NonceAt returns the nonce of the account in the latest block, that is the number of its mined transactions
+func (c *Client) NonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	_ctx, cancel := context.WithTimeout(ctx, c.Config.RPCResponseTimeout)
+	defer cancel()
+	return c.client.NonceAt(_ctx, account, nil)
+}
+
+// EstimateGas returns the gas needed by a call
+func (c *Client) EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error) {
+	_ctx, cancel := context.WithTimeout(ctx, c.Config.RPCResponseTimeout)
+	defer cancel()
+	return c.client.EstimateGas(_ctx, msg)
+}
+
+// CallContract executes a read only call in the latest block
+func (c *Client) CallContract(ctx context.Context, msg ethereum.CallMsg) ([]byte, error) {
+	_ctx, cancel := context.WithTimeout(ctx, c.Config.RPCResponseTimeout)
+	defer cancel()
+	return c.client.CallContract(_ctx, msg, nil)
+}
+
 // SendRawTx send raw transaction.
 func (c *Client) SendRawTx(ctx context.Context, tx *types.Transaction) error {
 	_ctx, cancel := context.WithTimeout(ctx, c.Config.RPCResponseTimeout)
@@ -0,0 +1,138 @@
+package eth
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/consensus/misc"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+// GasStrategy selects how the fees of the dynamic fee transactions are computed
+type GasStrategy string
+
+const (
+	// GasStrategyOracle takes the priority fee suggested by the node and adds 25% to the next base fee
+	GasStrategyOracle GasStrategy = "oracle"
+	// GasStrategyFixed always uses the configured max priority fee and max fee per gas
+	GasStrategyFixed GasStrategy = "fixed"
+	// GasStrategyPercentile takes the median of a percentile of the priority fees paid in the recent blocks, and
+	// leaves room for the base fee to double
+	GasStrategyPercentile GasStrategy = "percentile"
+
+	defaultFeeHistoryBlocks     = 20
+	defaultFeeHistoryPercentile = 50
+)
+
+// ParseGasStrategy returns the gas strategy with the given name. An empty name is the oracle strategy.
+func ParseGasStrategy(name string) (GasStrategy, error) {
+	switch s := GasStrategy(name); s {
+	case "":
+		return GasStrategyOracle, nil
+	case GasStrategyOracle, GasStrategyFixed, GasStrategyPercentile:
+		return s, nil
+	default:
+		return "", fmt.Errorf("unknown gas strategy %q, use oracle, fixed or percentile", name)
+	}
+}
+
+// SuggestFees returns the max priority fee and the max fee per gas of a new transaction with the gas strategy of the
+// client. The max fee never exceeds the max gas price, if it is configured.
+func (c *Client) SuggestFees(ctx context.Context) (tipCap *big.Int, feeCap *big.Int, err error) {
+	switch c.Config.GasStrategy {
+	case GasStrategyFixed:
+		tipCap, feeCap = new(big.Int).Set(c.Config.MaxPriorityFeePerGas), new(big.Int).Set(c.Config.MaxFeePerGas)
+	case GasStrategyPercentile:
+		tipCap, feeCap, err = c.percentileFees(ctx)
+	default:
+		tipCap, feeCap, err = c.oracleFees(ctx)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tipCap, feeCap = capFees(tipCap, feeCap, c.Config.MaxGasPrice)
+	log.Debug(ctx, "suggested fees", "strategy", c.Config.GasStrategy, "tip", tipCap, "maxFee", feeCap)
+	return tipCap, feeCap, nil
+}
+
+func (c *Client) oracleFees(ctx context.Context) (*big.Int, *big.Int, error) {
+	latestBlockHeader, err := c.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	// since ETH and Polygon blockchain already supports London fork.
+	// no need set special block.
+	baseFee := misc.CalcBaseFee(&params.ChainConfig{LondonBlock: big.NewInt(1)}, latestBlockHeader)
+
+	// add 25% to baseFee. baseFee always small value.
+	// since we use dynamic fee transactions we will get not used gas back.
+	b := math.Round(float64(baseFee.Int64()) * feeIncrement)
+	baseFee = big.NewInt(int64(b))
+
+	gasTip, err := c.SuggestGasTip(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return gasTip, new(big.Int).Add(baseFee, gasTip), nil
+}
+
+func (c *Client) percentileFees(ctx context.Context) (*big.Int, *big.Int, error) {
+	blocks := c.Config.FeeHistoryBlocks
+	if blocks <= 0 {
+		blocks = defaultFeeHistoryBlocks
+	}
+	percentile := c.Config.FeeHistoryPercentile
+	if percentile <= 0 {
+		percentile = defaultFeeHistoryPercentile
+	}
+
+	_ctx, cancel := context.WithTimeout(ctx, c.Config.RPCResponseTimeout)
+	defer cancel()
+	history, err := c.client.FeeHistory(_ctx, uint64(blocks), nil, []float64{percentile})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get fee history: %w", err)
+	}
+	return feesFromHistory(history)
+}
+
+// feesFromHistory returns the median of the rewards of the blocks as the priority fee, so a single block with high
+// fees does not raise it, and twice the base fee of the next block plus the priority fee as the max fee.
+func feesFromHistory(history *ethereum.FeeHistory) (*big.Int, *big.Int, error) {
+	if history == nil || len(history.BaseFee) == 0 {
+		return nil, nil, fmt.Errorf("empty fee history")
+	}
+	rewards := make([]*big.Int, 0, len(history.Reward))
+	for _, blockRewards := range history.Reward {
+		if len(blockRewards) > 0 && blockRewards[0] != nil {
+			rewards = append(rewards, blockRewards[0])
+		}
+	}
+	tipCap := big.NewInt(0)
+	if len(rewards) > 0 {
+		sort.Slice(rewards, func(i, j int) bool { return rewards[i].Cmp(rewards[j]) < 0 })
+		tipCap = new(big.Int).Set(rewards[len(rewards)/2])
+	}
+
+	// the last base fee of the history is the one of the next block
+	nextBaseFee := history.BaseFee[len(history.BaseFee)-1]
+	feeCap := new(big.Int).Add(new(big.Int).Mul(nextBaseFee, big.NewInt(2)), tipCap)
+	return tipCap, feeCap, nil
+}
+
+// capFees limits the max fee to maxGasPrice, when it is set, and the priority fee to the max fee
+func capFees(tipCap, feeCap, maxGasPrice *big.Int) (*big.Int, *big.Int) {
+	if maxGasPrice != nil && maxGasPrice.Sign() > 0 && feeCap.Cmp(maxGasPrice) > 0 {
+		feeCap = new(big.Int).Set(maxGasPrice)
+	}
+	if tipCap.Cmp(feeCap) > 0 {
+		tipCap = new(big.Int).Set(feeCap)
+	}
+	return tipCap, feeCap
+}
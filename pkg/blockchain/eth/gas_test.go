@@ -0,0 +1,57 @@
+package eth
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseGasStrategy(t *testing.T) {
+	strategy, err := ParseGasStrategy("")
+	require.NoError(t, err)
+	assert.Equal(t, GasStrategyOracle, strategy)
+
+	strategy, err = ParseGasStrategy("percentile")
+	require.NoError(t, err)
+	assert.Equal(t, GasStrategyPercentile, strategy)
+
+	_, err = ParseGasStrategy("legacy")
+	assert.Error(t, err)
+}
+
+func TestFeesFromHistory(t *testing.T) {
+	history := &ethereum.FeeHistory{
+		Reward: [][]*big.Int{
+			{big.NewInt(30)},
+			{big.NewInt(1000)}, // spike
+			{big.NewInt(35)},
+			{big.NewInt(32)},
+			{},
+		},
+		BaseFee: []*big.Int{big.NewInt(90), big.NewInt(100), big.NewInt(110), big.NewInt(100), big.NewInt(95), big.NewInt(105)},
+	}
+	tipCap, feeCap, err := feesFromHistory(history)
+	require.NoError(t, err)
+	assert.Equal(t, int64(35), tipCap.Int64())
+	assert.Equal(t, int64(2*105+35), feeCap.Int64())
+
+	_, _, err = feesFromHistory(&ethereum.FeeHistory{})
+	assert.Error(t, err)
+}
+
+func TestCapFees(t *testing.T) {
+	tipCap, feeCap := capFees(big.NewInt(50), big.NewInt(300), big.NewInt(200))
+	assert.Equal(t, int64(50), tipCap.Int64())
+	assert.Equal(t, int64(200), feeCap.Int64())
+
+	tipCap, feeCap = capFees(big.NewInt(250), big.NewInt(300), big.NewInt(200))
+	assert.Equal(t, int64(200), tipCap.Int64())
+	assert.Equal(t, int64(200), feeCap.Int64())
+
+	tipCap, feeCap = capFees(big.NewInt(50), big.NewInt(300), big.NewInt(0))
+	assert.Equal(t, int64(50), tipCap.Int64())
+	assert.Equal(t, int64(300), feeCap.Int64())
+}
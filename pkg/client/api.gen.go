@@ -0,0 +1,11465 @@
+// Package client provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen version v1.12.4 DO NOT EDIT.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+	uuid "github.com/google/uuid"
+	verifiable "github.com/iden3/go-schema-processor/verifiable"
+)
+
+const (
+	BasicAuthScopes = "basicAuth.Scopes"
+)
+
+// Defines values for AgentInboxMessageStatus.
+const (
+	AgentInboxMessageStatusFailed    AgentInboxMessageStatus = "failed"
+	AgentInboxMessageStatusProcessed AgentInboxMessageStatus = "processed"
+	AgentInboxMessageStatusRejected  AgentInboxMessageStatus = "rejected"
+)
+
+// Defines values for AuthKeyStatus.
+const (
+	AuthKeyStatusActive  AuthKeyStatus = "active"
+	AuthKeyStatusPending AuthKeyStatus = "pending"
+	AuthKeyStatusRevoked AuthKeyStatus = "revoked"
+)
+
+// Defines values for CreateIdentityRequestDidMetadataType.
+const (
+	BJJ CreateIdentityRequestDidMetadataType = "BJJ"
+	ETH CreateIdentityRequestDidMetadataType = "ETH"
+)
+
+// Defines values for FeatureFlagFeature.
+const (
+	AsyncIssuance FeatureFlagFeature = "async_issuance"
+	Oid4vci       FeatureFlagFeature = "oid4vci"
+	Rhs           FeatureFlagFeature = "rhs"
+	StatusList    FeatureFlagFeature = "status_list"
+	TestMode      FeatureFlagFeature = "test_mode"
+)
+
+// Defines values for IdentityMigrationStatus.
+const (
+	Completed IdentityMigrationStatus = "completed"
+	ReadOnly  IdentityMigrationStatus = "read_only"
+)
+
+// Defines values for PublishingPolicyMode.
+const (
+	PublishingPolicyModeImmediate PublishingPolicyMode = "immediate"
+	PublishingPolicyModeInterval  PublishingPolicyMode = "interval"
+	PublishingPolicyModeManual    PublishingPolicyMode = "manual"
+	PublishingPolicyModeThreshold PublishingPolicyMode = "threshold"
+)
+
+// Defines values for RevocationDecisionStatus.
+const (
+	RevocationDecisionStatusApplied  RevocationDecisionStatus = "applied"
+	RevocationDecisionStatusRejected RevocationDecisionStatus = "rejected"
+)
+
+// Defines values for RevocationReasonCode.
+const (
+	AffiliationChanged   RevocationReasonCode = "affiliationChanged"
+	CessationOfOperation RevocationReasonCode = "cessationOfOperation"
+	Expired              RevocationReasonCode = "expired"
+	KeyCompromise        RevocationReasonCode = "keyCompromise"
+	PrivilegeWithdrawn   RevocationReasonCode = "privilegeWithdrawn"
+	Superseded           RevocationReasonCode = "superseded"
+	Unspecified          RevocationReasonCode = "unspecified"
+)
+
+// Defines values for RotateAuthKeyResponseStatus.
+const (
+	RotateAuthKeyResponseStatusCompleted RotateAuthKeyResponseStatus = "completed"
+	RotateAuthKeyResponseStatusPending   RotateAuthKeyResponseStatus = "pending"
+)
+
+// Defines values for SetPublishingPolicyRequestMode.
+const (
+	SetPublishingPolicyRequestModeImmediate SetPublishingPolicyRequestMode = "immediate"
+	SetPublishingPolicyRequestModeInterval  SetPublishingPolicyRequestMode = "interval"
+	SetPublishingPolicyRequestModeManual    SetPublishingPolicyRequestMode = "manual"
+	SetPublishingPolicyRequestModeThreshold SetPublishingPolicyRequestMode = "threshold"
+)
+
+// Defines values for StateTransactionStatus.
+const (
+	StateTransactionStatusFailed  StateTransactionStatus = "failed"
+	StateTransactionStatusMined   StateTransactionStatus = "mined"
+	StateTransactionStatusPending StateTransactionStatus = "pending"
+)
+
+// Defines values for TreeDiscrepancyKind.
+const (
+	HashMismatch           TreeDiscrepancyKind = "hash_mismatch"
+	InvalidNode            TreeDiscrepancyKind = "invalid_node"
+	MisplacedLeaf          TreeDiscrepancyKind = "misplaced_leaf"
+	MissingNode            TreeDiscrepancyKind = "missing_node"
+	MissingRoot            TreeDiscrepancyKind = "missing_root"
+	PublishedStateMismatch TreeDiscrepancyKind = "published_state_mismatch"
+	StateMismatch          TreeDiscrepancyKind = "state_mismatch"
+)
+
+// Defines values for TreeDiscrepancyTree.
+const (
+	TreeDiscrepancyTreeClaims      TreeDiscrepancyTree = "claims"
+	TreeDiscrepancyTreeRevocations TreeDiscrepancyTree = "revocations"
+	TreeDiscrepancyTreeRoots       TreeDiscrepancyTree = "roots"
+)
+
+// Defines values for TreeIntegrityType.
+const (
+	TreeIntegrityTypeClaims      TreeIntegrityType = "claims"
+	TreeIntegrityTypeRevocations TreeIntegrityType = "revocations"
+	TreeIntegrityTypeRoots       TreeIntegrityType = "roots"
+)
+
+// Defines values for VerificationQueryCircuitId.
+const (
+	CredentialAtomicQueryMTPV2 VerificationQueryCircuitId = "credentialAtomicQueryMTPV2"
+	CredentialAtomicQuerySigV2 VerificationQueryCircuitId = "credentialAtomicQuerySigV2"
+)
+
+// Defines values for GetRevocationListParamsFormat.
+const (
+	Bitstring GetRevocationListParamsFormat = "bitstring"
+	List      GetRevocationListParamsFormat = "list"
+)
+
+// Defines values for GetAgentMessagesParamsStatus.
+const (
+	GetAgentMessagesParamsStatusFailed    GetAgentMessagesParamsStatus = "failed"
+	GetAgentMessagesParamsStatusProcessed GetAgentMessagesParamsStatus = "processed"
+	GetAgentMessagesParamsStatusRejected  GetAgentMessagesParamsStatus = "rejected"
+)
+
+// Defines values for GetRevocationDecisionsReportParamsStatus.
+const (
+	Applied  GetRevocationDecisionsReportParamsStatus = "applied"
+	Rejected GetRevocationDecisionsReportParamsStatus = "rejected"
+)
+
+// AgentCapabilities defines model for AgentCapabilities.
+type AgentCapabilities struct {
+	Accepts AgentMessages `json:"accepts"`
+
+	// Agent URL of the agent endpoint
+	Agent string `json:"agent"`
+
+	// Circuits Circuits to pack the messages for the agent and to prove the issued credentials
+	Circuits    []string               `json:"circuits"`
+	Credentials CredentialCapabilities `json:"credentials"`
+
+	// Networks Blockchains and networks of the identities of the node
+	Networks []string      `json:"networks"`
+	Sends    AgentMessages `json:"sends"`
+}
+
+// AgentInboxMessage defines model for AgentInboxMessage.
+type AgentInboxMessage struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Error Why the message failed or was rejected
+	Error     *string   `json:"error,omitempty"`
+	Id        uuid.UUID `json:"id"`
+	MediaType string    `json:"mediaType"`
+	MessageID string    `json:"messageID"`
+
+	// ResponseType Type of the message the agent answered with
+	ResponseType *string `json:"responseType,omitempty"`
+
+	// Sender DID of the sender, empty if the message could not be unpacked
+	Sender   string                  `json:"sender"`
+	Status   AgentInboxMessageStatus `json:"status"`
+	ThreadID string                  `json:"threadID"`
+	Type     string                  `json:"type"`
+}
+
+// AgentInboxMessageStatus defines model for AgentInboxMessage.Status.
+type AgentInboxMessageStatus string
+
+// AgentMessages defines model for AgentMessages.
+type AgentMessages struct {
+	MediaTypes []string `json:"mediaTypes"`
+	Messages   []string `json:"messages"`
+}
+
+// AgentProblemReport The error of a failed agent request. When the request was unpacked, it is a problem-report message addressed
+// to the sender, in the thread of the request, with the code and the description of the problem. The message
+// is the description, for the clients that do not read problem reports.
+type AgentProblemReport struct {
+	Body           *ProblemReportBody `json:"body,omitempty"`
+	From           *string            `json:"from,omitempty"`
+	Id             *string            `json:"id,omitempty"`
+	Message        string             `json:"message"`
+	ParentThreadID *string            `json:"parentThreadID,omitempty"`
+	ThreadID       *string            `json:"threadID,omitempty"`
+	To             *string            `json:"to,omitempty"`
+	Typ            *string            `json:"typ,omitempty"`
+	Type           *string            `json:"type,omitempty"`
+}
+
+// AgentResponse defines model for AgentResponse.
+type AgentResponse struct {
+	Body     interface{} `json:"body"`
+	From     string      `json:"from"`
+	Id       string      `json:"id"`
+	ThreadID string      `json:"threadID"`
+	To       string      `json:"to"`
+	Typ      string      `json:"typ"`
+	Type     string      `json:"type"`
+}
+
+// ApplyRevocationDecisionsRequest defines model for ApplyRevocationDecisionsRequest.
+type ApplyRevocationDecisionsRequest struct {
+	Decisions []RevocationDecisionRequest `json:"decisions"`
+
+	// Source Name of the external system that took the decisions
+	Source string `json:"source"`
+}
+
+// AuthKey defines model for AuthKey.
+type AuthKey struct {
+	// Id ID of the auth claim of the key
+	Id       uuid.UUID     `json:"id"`
+	RevNonce uint64        `json:"revNonce"`
+	Status   AuthKeyStatus `json:"status"`
+	X        string        `json:"x"`
+	Y        string        `json:"y"`
+}
+
+// AuthKeyStatus defines model for AuthKey.Status.
+type AuthKeyStatus string
+
+// AuthorizationRequest defines model for AuthorizationRequest.
+type AuthorizationRequest struct {
+	Body struct {
+		CallbackUrl string `json:"callbackUrl"`
+		Reason      string `json:"reason"`
+		Scope       []struct {
+			CircuitId string                 `json:"circuitId"`
+			Id        uint32                 `json:"id"`
+			Query     map[string]interface{} `json:"query"`
+		} `json:"scope"`
+	} `json:"body"`
+	From string `json:"from"`
+	Id   string `json:"id"`
+	Thid string `json:"thid"`
+	Typ  string `json:"typ"`
+	Type string `json:"type"`
+}
+
+// AuthorizationRequestMessage defines model for AuthorizationRequestMessage.
+type AuthorizationRequestMessage struct {
+	Body struct {
+		CallbackUrl string  `json:"callbackUrl"`
+		Message     *string `json:"message,omitempty"`
+		Reason      *string `json:"reason,omitempty"`
+		Scope       []struct {
+			CircuitId string                 `json:"circuitId"`
+			Id        uint32                 `json:"id"`
+			Optional  *bool                  `json:"optional,omitempty"`
+			Query     map[string]interface{} `json:"query"`
+		} `json:"scope"`
+	} `json:"body"`
+	From string  `json:"from"`
+	Id   string  `json:"id"`
+	Thid *string `json:"thid,omitempty"`
+	To   *string `json:"to,omitempty"`
+	Typ  string  `json:"typ"`
+	Type string  `json:"type"`
+}
+
+// CachedDocument defines model for CachedDocument.
+type CachedDocument struct {
+	ContentType string `json:"contentType"`
+
+	// Etag ETag of the document, used to revalidate it once it is older than ISSUER_SCHEMA_CACHE_TTL
+	Etag      string    `json:"etag"`
+	FetchedAt time.Time `json:"fetchedAt"`
+	Size      int       `json:"size"`
+	Url       string    `json:"url"`
+}
+
+// CreateClaimRequest defines model for CreateClaimRequest.
+type CreateClaimRequest struct {
+	CredentialSchema  string                 `json:"credentialSchema"`
+	CredentialSubject map[string]interface{} `json:"credentialSubject"`
+
+	// Expiration Expiration of the credential, in seconds since the epoch. If the schema imported by the identity has a
+	// maximum validity, it cannot be later, and the credentials created without expiration expire at the end of it.
+	Expiration *int64 `json:"expiration,omitempty"`
+
+	// ExpirationDays Expires the credential the given days after it is issued. It cannot be set with expiration.
+	ExpirationDays *int `json:"expirationDays,omitempty"`
+
+	// ExtraContexts JSON-LD contexts added to the @context of the credential, after the ones of the schema. They must be loadable.
+	ExtraContexts *[]string `json:"extraContexts,omitempty"`
+
+	// ExtraTypes Types added to the type of the credential, after the ones of the schema. They should be defined in the contexts.
+	ExtraTypes            *[]string `json:"extraTypes,omitempty"`
+	MerklizedRootPosition *string   `json:"merklizedRootPosition,omitempty"`
+
+	// MtProof Issue the credential with an Iden3SparseMerkleTreeProof. If neither proof is set, the default proof types of the schema, or the ones of the proof policy, apply.
+	MtProof *bool `json:"mtProof,omitempty"`
+
+	// RefreshService Adds a refresh service pointing at the agent of the node, where the holder gets a new credential, valid for the same period, when this one expires.
+	RefreshService *bool   `json:"refreshService,omitempty"`
+	RevNonce       *uint64 `json:"revNonce,omitempty"`
+
+	// SignatureProof Issue the credential with a BJJSignature2021 proof. If neither proof is set, the default proof types of the schema, or the ones of the proof policy, apply.
+	SignatureProof  *bool   `json:"signatureProof,omitempty"`
+	SubjectPosition *string `json:"subjectPosition,omitempty"`
+	Type            string  `json:"type"`
+	Version         *uint32 `json:"version,omitempty"`
+}
+
+// CreateClaimResponse defines model for CreateClaimResponse.
+type CreateClaimResponse struct {
+	Id string `json:"id"`
+}
+
+// CreateIdentityRequest defines model for CreateIdentityRequest.
+type CreateIdentityRequest struct {
+	DidMetadata struct {
+		// Blockchain Blockchain of the identity, for example polygon, eth or privado. The node must have the chain configured
+		Blockchain string `json:"blockchain"`
+
+		// Method DID method, polygonid or iden3
+		Method string `json:"method"`
+
+		// Network Network of the blockchain, for example main, mumbai, amoy, sepolia or test
+		Network string `json:"network"`
+
+		// Type Key that controls the identity state. BJJ (default) identities publish their states with a proof signed with their auth key.
+		// ETH identities are built from the address of a new Ethereum key of the node and publish their states with transactions signed with it,
+		// so the address needs funds in the network. Their credentials can be verified once the first state is published.
+		Type *CreateIdentityRequestDidMetadataType `json:"type,omitempty"`
+	} `json:"didMetadata"`
+}
+
+// CreateIdentityRequestDidMetadataType Key that controls the identity state. BJJ (default) identities publish their states with a proof signed with their auth key.
+// ETH identities are built from the address of a new Ethereum key of the node and publish their states with transactions signed with it,
+// so the address needs funds in the network. Their credentials can be verified once the first state is published.
+type CreateIdentityRequestDidMetadataType string
+
+// CreateIdentityResponse defines model for CreateIdentityResponse.
+type CreateIdentityResponse struct {
+	// Address Ethereum address that controls the identity, only for ETH identities
+	Address    *string        `json:"address,omitempty"`
+	Identifier *string        `json:"identifier,omitempty"`
+	State      *IdentityState `json:"state,omitempty"`
+}
+
+// CreateOID4VCIOfferRequest defines model for CreateOID4VCIOfferRequest.
+type CreateOID4VCIOfferRequest struct {
+	CredentialSchema string `json:"credentialSchema"`
+
+	// CredentialSubject The id is optional, it must be an iden3 DID if set
+	CredentialSubject map[string]interface{} `json:"credentialSubject"`
+	Expiration        *int64                 `json:"expiration,omitempty"`
+
+	// MtProof Adds an Iden3SparseMerkleTreeProof, which is only valid once the state is published
+	MtProof *bool  `json:"mtProof,omitempty"`
+	Type    string `json:"type"`
+}
+
+// CreateVerificationRequest defines model for CreateVerificationRequest.
+type CreateVerificationRequest struct {
+	// ExpiresAt Time after which the request can no longer be answered
+	ExpiresAt *time.Time          `json:"expiresAt,omitempty"`
+	Reason    *string             `json:"reason,omitempty"`
+	Scope     []VerificationQuery `json:"scope"`
+}
+
+// CredentialCapabilities defines model for CredentialCapabilities.
+type CredentialCapabilities struct {
+	Formats     []string `json:"formats"`
+	ProofTypes  []string `json:"proofTypes"`
+	SchemaTypes []string `json:"schemaTypes"`
+	StatusTypes []string `json:"statusTypes"`
+}
+
+// CredentialErrorMessage defines model for CredentialErrorMessage.
+type CredentialErrorMessage struct {
+	Errors  *[]CredentialSubjectError `json:"errors,omitempty"`
+	Message string                    `json:"message"`
+}
+
+// CredentialSchema defines model for CredentialSchema.
+type CredentialSchema struct {
+	Id   string `json:"id"`
+	Type string `json:"type"`
+}
+
+// CredentialSubjectError defines model for CredentialSubjectError.
+type CredentialSubjectError struct {
+	// Field Dot separated path of the attribute in the credential subject
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// DIDDocument defines model for DIDDocument.
+type DIDDocument struct {
+	Context            []string             `json:"@context"`
+	Id                 string               `json:"id"`
+	Service            []DIDDocumentService `json:"service"`
+	VerificationMethod []DIDStateInfo       `json:"verificationMethod"`
+}
+
+// DIDDocumentService defines model for DIDDocumentService.
+type DIDDocumentService struct {
+	Id              string `json:"id"`
+	ServiceEndpoint string `json:"serviceEndpoint"`
+	Type            string `json:"type"`
+}
+
+// DIDResolution defines model for DIDResolution.
+type DIDResolution struct {
+	Context               string                 `json:"@context"`
+	DidDocument           DIDDocument            `json:"didDocument"`
+	DidDocumentMetadata   map[string]interface{} `json:"didDocumentMetadata"`
+	DidResolutionMetadata struct {
+		ContentType string `json:"contentType"`
+	} `json:"didResolutionMetadata"`
+}
+
+// DIDService defines model for DIDService.
+type DIDService struct {
+	Id              string     `json:"id"`
+	Overridden      bool       `json:"overridden"`
+	ServiceEndpoint string     `json:"serviceEndpoint"`
+	Type            string     `json:"type"`
+	UpdatedAt       *time.Time `json:"updatedAt,omitempty"`
+}
+
+// DIDStateInfo defines model for DIDStateInfo.
+type DIDStateInfo struct {
+	Controller           string               `json:"controller"`
+	Id                   string               `json:"id"`
+	Info                 *DIDStateInfoDetails `json:"info,omitempty"`
+	Published            bool                 `json:"published"`
+	StateContractAddress string               `json:"stateContractAddress"`
+	Type                 string               `json:"type"`
+}
+
+// DIDStateInfoDetails defines model for DIDStateInfoDetails.
+type DIDStateInfoDetails struct {
+	CreatedAtBlock     *string `json:"createdAtBlock,omitempty"`
+	CreatedAtTimestamp *string `json:"createdAtTimestamp,omitempty"`
+	Id                 string  `json:"id"`
+	State              string  `json:"state"`
+}
+
+// ExportIssuerRequest defines model for ExportIssuerRequest.
+type ExportIssuerRequest struct {
+	Identifier string `json:"identifier"`
+}
+
+// FeatureFlag defines model for FeatureFlag.
+type FeatureFlag struct {
+	Enabled    bool               `json:"enabled"`
+	Feature    FeatureFlagFeature `json:"feature"`
+	Overridden bool               `json:"overridden"`
+	UpdatedAt  *time.Time         `json:"updatedAt,omitempty"`
+}
+
+// FeatureFlagFeature defines model for FeatureFlag.Feature.
+type FeatureFlagFeature string
+
+// GenericErrorMessage defines model for GenericErrorMessage.
+type GenericErrorMessage struct {
+	Message string `json:"message"`
+}
+
+// GenericMessage defines model for GenericMessage.
+type GenericMessage struct {
+	Message string `json:"message"`
+}
+
+// GetClaimQrCodeResponse defines model for GetClaimQrCodeResponse.
+type GetClaimQrCodeResponse struct {
+	Body struct {
+		Credentials []struct {
+			Description string `json:"description"`
+			Id          string `json:"id"`
+		} `json:"credentials"`
+
+		// Issuer Profile of the issuer the wallets show with the offer
+		Issuer *IssuerDescription `json:"issuer,omitempty"`
+		Url    string             `json:"url"`
+	} `json:"body"`
+
+	// ExpiresTime Unix time in seconds after which the offer can not be used to fetch the credential. The offer can be used only once.
+	ExpiresTime *int64 `json:"expires_time,omitempty"`
+	From        string `json:"from"`
+	Id          string `json:"id"`
+	Thid        string `json:"thid"`
+	To          string `json:"to"`
+	Typ         string `json:"typ"`
+	Type        string `json:"type"`
+}
+
+// GetClaimResponse defines model for GetClaimResponse.
+type GetClaimResponse struct {
+	Context           []string               `json:"@context"`
+	CredentialSchema  CredentialSchema       `json:"credentialSchema"`
+	CredentialStatus  interface{}            `json:"credentialStatus"`
+	CredentialSubject map[string]interface{} `json:"credentialSubject"`
+	Expiration        *time.Time             `json:"expiration,omitempty"`
+	Id                string                 `json:"id"`
+	IssuanceDate      *time.Time             `json:"issuanceDate,omitempty"`
+	Issuer            string                 `json:"issuer"`
+	Proof             interface{}            `json:"proof"`
+	RefreshService    *RefreshService        `json:"refreshService,omitempty"`
+	Type              []string               `json:"type"`
+}
+
+// GetClaimsResponse defines model for GetClaimsResponse.
+type GetClaimsResponse = []GetClaimResponse
+
+// GetHeldCredentialsResponse defines model for GetHeldCredentialsResponse.
+type GetHeldCredentialsResponse = []HeldCredential
+
+// Health defines model for Health.
+type Health map[string]bool
+
+// HeldCredential defines model for HeldCredential.
+type HeldCredential struct {
+	CreatedAt    time.Time        `json:"createdAt"`
+	Credential   GetClaimResponse `json:"credential"`
+	CredentialId string           `json:"credentialId"`
+	ExpiresAt    *time.Time       `json:"expiresAt,omitempty"`
+	Id           uuid.UUID        `json:"id"`
+	Issuer       string           `json:"issuer"`
+	Revoked      bool             `json:"revoked"`
+	SchemaType   string           `json:"schemaType"`
+	SchemaUrl    string           `json:"schemaUrl"`
+}
+
+// IdentityExport defines model for IdentityExport.
+type IdentityExport struct {
+	ExportedAt    time.Time                  `json:"exportedAt"`
+	Identifier    string                     `json:"identifier"`
+	Keys          []IdentityExportKey        `json:"keys"`
+	MerkleTrees   []IdentityExportMerkleTree `json:"merkleTrees"`
+	Roots         IdentityRoots              `json:"roots"`
+	SchemaVersion int64                      `json:"schemaVersion"`
+
+	// Tables Rows of the identity by table
+	Tables  map[string]json.RawMessage `json:"tables"`
+	Version int                        `json:"version"`
+}
+
+// IdentityExportKey defines model for IdentityExportKey.
+type IdentityExportKey struct {
+	Id        string `json:"id"`
+	PublicKey string `json:"publicKey"`
+	Type      string `json:"type"`
+}
+
+// IdentityExportMerkleTree defines model for IdentityExportMerkleTree.
+type IdentityExportMerkleTree struct {
+	Nodes json.RawMessage `json:"nodes"`
+	Roots json.RawMessage `json:"roots"`
+	Type  uint16          `json:"type"`
+}
+
+// IdentityMigration defines model for IdentityMigration.
+type IdentityMigration struct {
+	CompletedAt *time.Time              `json:"completedAt,omitempty"`
+	CreatedAt   time.Time               `json:"createdAt"`
+	Identifier  string                  `json:"identifier"`
+	Status      IdentityMigrationStatus `json:"status"`
+	Target      *string                 `json:"target,omitempty"`
+}
+
+// IdentityMigrationStatus defines model for IdentityMigration.Status.
+type IdentityMigrationStatus string
+
+// IdentityRoots defines model for IdentityRoots.
+type IdentityRoots struct {
+	Claims      string `json:"claims"`
+	Revocations string `json:"revocations"`
+	Roots       string `json:"roots"`
+}
+
+// IdentityState defines model for IdentityState.
+type IdentityState struct {
+	BlockNumber        *int      `json:"blockNumber,omitempty"`
+	BlockTimestamp     *int      `json:"blockTimestamp,omitempty"`
+	ClaimsTreeRoot     *string   `json:"claimsTreeRoot,omitempty"`
+	CreatedAt          time.Time `json:"createdAt"`
+	Identifier         string    `json:"-"`
+	ModifiedAt         time.Time `json:"modifiedAt"`
+	PreviousState      *string   `json:"previousState,omitempty"`
+	RevocationTreeRoot *string   `json:"revocationTreeRoot,omitempty"`
+	RootOfRoots        *string   `json:"rootOfRoots,omitempty"`
+	State              *string   `json:"state,omitempty"`
+	StateID            int64     `json:"-"`
+	Status             string    `json:"status"`
+	TxID               *string   `json:"txID,omitempty"`
+}
+
+// ImportIdentityRequest defines model for ImportIdentityRequest.
+type ImportIdentityRequest struct {
+	Export IdentityExport `json:"export"`
+
+	// Keys Key IDs in this node by exported key ID, for the keys imported with another ID
+	Keys *map[string]string `json:"keys,omitempty"`
+}
+
+// ImportIdentityResponse defines model for ImportIdentityResponse.
+type ImportIdentityResponse struct {
+	Identifier string        `json:"identifier"`
+	Keys       []string      `json:"keys"`
+	Roots      IdentityRoots `json:"roots"`
+
+	// Rows Number of rows imported by table
+	Rows  map[string]int `json:"rows"`
+	State *string        `json:"state,omitempty"`
+}
+
+// IssuerDescription Profile of the issuer the wallets show with the offer
+type IssuerDescription struct {
+	BackgroundColor *string `json:"backgroundColor,omitempty"`
+	ContactEmail    *string `json:"contactEmail,omitempty"`
+	ContactURL      *string `json:"contactURL,omitempty"`
+	DisplayName     string  `json:"displayName"`
+	Logo            string  `json:"logo"`
+	TextColor       *string `json:"textColor,omitempty"`
+}
+
+// JSONWebKeySet defines model for JSONWebKeySet.
+type JSONWebKeySet struct {
+	Keys []map[string]interface{} `json:"keys"`
+}
+
+// NotificationSettings defines model for NotificationSettings.
+type NotificationSettings struct {
+	BacklogAlerts bool `json:"backlogAlerts"`
+	OfferEmails   bool `json:"offerEmails"`
+	Reports       bool `json:"reports"`
+}
+
+// OID4VCICredentialRequest defines model for OID4VCICredentialRequest.
+type OID4VCICredentialRequest struct {
+	Format string `json:"format"`
+	Proof  *struct {
+		Jwt       *string `json:"jwt,omitempty"`
+		ProofType string  `json:"proof_type"`
+	} `json:"proof,omitempty"`
+}
+
+// OID4VCICredentialResponse defines model for OID4VCICredentialResponse.
+type OID4VCICredentialResponse struct {
+	CNonce          string                   `json:"c_nonce"`
+	CNonceExpiresIn int                      `json:"c_nonce_expires_in"`
+	Credential      verifiable.W3CCredential `json:"credential"`
+	Format          string                   `json:"format"`
+}
+
+// OID4VCIError defines model for OID4VCIError.
+type OID4VCIError struct {
+	CNonce           *string `json:"c_nonce,omitempty"`
+	CNonceExpiresIn  *int    `json:"c_nonce_expires_in,omitempty"`
+	Error            string  `json:"error"`
+	ErrorDescription *string `json:"error_description,omitempty"`
+}
+
+// OID4VCIIssuerDisplay defines model for OID4VCIIssuerDisplay.
+type OID4VCIIssuerDisplay struct {
+	Logo *struct {
+		AltText *string `json:"alt_text,omitempty"`
+		Url     string  `json:"url"`
+	} `json:"logo,omitempty"`
+	Name string `json:"name"`
+}
+
+// OID4VCIIssuerMetadata defines model for OID4VCIIssuerMetadata.
+type OID4VCIIssuerMetadata struct {
+	CredentialEndpoint   string                   `json:"credential_endpoint"`
+	CredentialIssuer     string                   `json:"credential_issuer"`
+	CredentialsSupported []map[string]interface{} `json:"credentials_supported"`
+
+	// Display Name and logo of the issuer of the node, from its profile
+	Display       *[]OID4VCIIssuerDisplay `json:"display,omitempty"`
+	TokenEndpoint string                  `json:"token_endpoint"`
+}
+
+// OID4VCIOffer defines model for OID4VCIOffer.
+type OID4VCIOffer struct {
+	// CredentialOffer Credential offer with the pre-authorized code, as defined by OpenID4VCI
+	CredentialOffer map[string]interface{} `json:"credentialOffer"`
+
+	// CredentialOfferUri The credential offer, in the uri the wallets scan
+	CredentialOfferUri string    `json:"credentialOfferUri"`
+	ExpiresAt          time.Time `json:"expiresAt"`
+	Id                 uuid.UUID `json:"id"`
+}
+
+// OID4VCITokenRequest defines model for OID4VCITokenRequest.
+type OID4VCITokenRequest struct {
+	GrantType         string `json:"grant_type"`
+	PreAuthorizedCode string `json:"pre-authorized_code"`
+}
+
+// OID4VCITokenResponse defines model for OID4VCITokenResponse.
+type OID4VCITokenResponse struct {
+	AccessToken     string `json:"access_token"`
+	CNonce          string `json:"c_nonce"`
+	CNonceExpiresIn int    `json:"c_nonce_expires_in"`
+	ExpiresIn       int    `json:"expires_in"`
+	TokenType       string `json:"token_type"`
+}
+
+// ProblemReportBody defines model for ProblemReportBody.
+type ProblemReportBody struct {
+	Code    string  `json:"code"`
+	Comment *string `json:"comment,omitempty"`
+}
+
+// PublishIdentityStateResponse defines model for PublishIdentityStateResponse.
+type PublishIdentityStateResponse struct {
+	ClaimsTreeRoot     *string `json:"claimsTreeRoot,omitempty"`
+	RevocationTreeRoot *string `json:"revocationTreeRoot,omitempty"`
+	RootOfRoots        *string `json:"rootOfRoots,omitempty"`
+	State              *string `json:"state,omitempty"`
+	TxID               *string `json:"txID,omitempty"`
+}
+
+// PublishingPolicy defines model for PublishingPolicy.
+type PublishingPolicy struct {
+	IntervalMinutes         *int                 `json:"intervalMinutes,omitempty"`
+	Mode                    PublishingPolicyMode `json:"mode"`
+	Overridden              bool                 `json:"overridden"`
+	RevocationWindowMinutes *int                 `json:"revocationWindowMinutes,omitempty"`
+	Threshold               *int                 `json:"threshold,omitempty"`
+	UpdatedAt               *time.Time           `json:"updatedAt,omitempty"`
+}
+
+// PublishingPolicyMode defines model for PublishingPolicy.Mode.
+type PublishingPolicyMode string
+
+// RateLimitBucket defines model for RateLimitBucket.
+type RateLimitBucket struct {
+	Burst             int `json:"burst"`
+	RequestsPerMinute int `json:"requestsPerMinute"`
+}
+
+// RateLimitSettings defines model for RateLimitSettings.
+type RateLimitSettings struct {
+	Anonymous     RateLimitBucket `json:"anonymous"`
+	Authenticated RateLimitBucket `json:"authenticated"`
+	Enabled       bool            `json:"enabled"`
+}
+
+// RefreshCachedDocumentRequest defines model for RefreshCachedDocumentRequest.
+type RefreshCachedDocumentRequest struct {
+	Url string `json:"url"`
+}
+
+// RefreshService defines model for RefreshService.
+type RefreshService struct {
+	Id   string `json:"id"`
+	Type string `json:"type"`
+}
+
+// RenewCredentialOfferRequest defines model for RenewCredentialOfferRequest.
+type RenewCredentialOfferRequest struct {
+	// Notify Pushes the new offer to the wallet of the holder
+	Notify *bool `json:"notify,omitempty"`
+}
+
+// RevocationDecision defines model for RevocationDecision.
+type RevocationDecision struct {
+	CreatedAt    time.Time  `json:"createdAt"`
+	CredentialId *uuid.UUID `json:"credentialId,omitempty"`
+	DecidedAt    time.Time  `json:"decidedAt"`
+
+	// Error Why the decision was rejected
+	Error           *string                  `json:"error,omitempty"`
+	ExternalId      string                   `json:"externalId"`
+	Id              uuid.UUID                `json:"id"`
+	Reason          string                   `json:"reason"`
+	RevocationNonce *uint64                  `json:"revocationNonce,omitempty"`
+	Source          string                   `json:"source"`
+	Status          RevocationDecisionStatus `json:"status"`
+}
+
+// RevocationDecisionStatus defines model for RevocationDecision.Status.
+type RevocationDecisionStatus string
+
+// RevocationDecisionRequest defines model for RevocationDecisionRequest.
+type RevocationDecisionRequest struct {
+	CredentialId *uuid.UUID `json:"credentialId,omitempty"`
+
+	// DecidedAt When the decision was taken, the time it is received if not set
+	DecidedAt *time.Time `json:"decidedAt,omitempty"`
+
+	// Id ID of the decision in the source
+	Id              string  `json:"id"`
+	Reason          *string `json:"reason,omitempty"`
+	RevocationNonce *uint64 `json:"revocationNonce,omitempty"`
+}
+
+// RevocationDecisionsReport defines model for RevocationDecisionsReport.
+type RevocationDecisionsReport struct {
+	Applied   int                  `json:"applied"`
+	Decisions []RevocationDecision `json:"decisions"`
+	Rejected  int                  `json:"rejected"`
+}
+
+// RevocationList defines model for RevocationList.
+type RevocationList struct {
+	// EncodedList GZIP compressed and base64url encoded bitstring of the revoked nonces, only in the bitstring format
+	EncodedList *string `json:"encodedList,omitempty"`
+	Issuer      string  `json:"issuer"`
+
+	// Nonces Revoked nonces in ascending order, only in the list format
+	Nonces *[]uint64  `json:"nonces,omitempty"`
+	Since  *time.Time `json:"since,omitempty"`
+
+	// SyncedAt Time of the list, the next sync can pass it as since
+	SyncedAt time.Time `json:"syncedAt"`
+}
+
+// RevocationReasonCode defines model for RevocationReasonCode.
+type RevocationReasonCode string
+
+// RevocationStatusResponse defines model for RevocationStatusResponse.
+type RevocationStatusResponse struct {
+	Issuer struct {
+		ClaimsTreeRoot     *string `json:"claimsTreeRoot,omitempty"`
+		RevocationTreeRoot *string `json:"revocationTreeRoot,omitempty"`
+		RootOfRoots        *string `json:"rootOfRoots,omitempty"`
+		State              *string `json:"state,omitempty"`
+	} `json:"issuer"`
+	Mtp struct {
+		Existence bool `json:"existence"`
+		NodeAux   *struct {
+			Key   *string `json:"key,omitempty"`
+			Value *string `json:"value,omitempty"`
+		} `json:"node_aux,omitempty"`
+		Siblings *[]string `json:"siblings"`
+	} `json:"mtp"`
+}
+
+// RevokeClaimResponse defines model for RevokeClaimResponse.
+type RevokeClaimResponse struct {
+	Message string `json:"message"`
+}
+
+// RotateAuthKeyResponse defines model for RotateAuthKeyResponse.
+type RotateAuthKeyResponse struct {
+	Id     uuid.UUID                   `json:"id"`
+	Key    AuthKey                     `json:"key"`
+	Status RotateAuthKeyResponseStatus `json:"status"`
+}
+
+// RotateAuthKeyResponseStatus defines model for RotateAuthKeyResponse.Status.
+type RotateAuthKeyResponseStatus string
+
+// SetDIDServiceRequest defines model for SetDIDServiceRequest.
+type SetDIDServiceRequest struct {
+	ServiceEndpoint string `json:"serviceEndpoint"`
+	Type            string `json:"type"`
+}
+
+// SetFeatureFlagRequest defines model for SetFeatureFlagRequest.
+type SetFeatureFlagRequest struct {
+	Enabled bool `json:"enabled"`
+}
+
+// SetPublishingPolicyRequest defines model for SetPublishingPolicyRequest.
+type SetPublishingPolicyRequest struct {
+	IntervalMinutes         *int                           `json:"intervalMinutes,omitempty"`
+	Mode                    SetPublishingPolicyRequestMode `json:"mode"`
+	RevocationWindowMinutes *int                           `json:"revocationWindowMinutes,omitempty"`
+	Threshold               *int                           `json:"threshold,omitempty"`
+}
+
+// SetPublishingPolicyRequestMode defines model for SetPublishingPolicyRequest.Mode.
+type SetPublishingPolicyRequestMode string
+
+// Settings defines model for Settings.
+type Settings struct {
+	Notifications NotificationSettings `json:"notifications"`
+
+	// Overridden Names of the overridden settings
+	Overridden []string          `json:"overridden"`
+	Publishing PublishingPolicy  `json:"publishing"`
+	RateLimit  RateLimitSettings `json:"rateLimit"`
+	RhsUrl     string            `json:"rhsUrl"`
+	UpdatedAt  *time.Time        `json:"updatedAt,omitempty"`
+}
+
+// StartIdentityMigrationRequest defines model for StartIdentityMigrationRequest.
+type StartIdentityMigrationRequest struct {
+	// Target Node the identity is moved to, for the record
+	Target *string `json:"target,omitempty"`
+}
+
+// StateIntegrityReport defines model for StateIntegrityReport.
+type StateIntegrityReport struct {
+	CheckedAt     time.Time         `json:"checkedAt"`
+	Discrepancies []TreeDiscrepancy `json:"discrepancies"`
+	Identifier    string            `json:"identifier"`
+	Ok            bool              `json:"ok"`
+
+	// PublishedState latest state in the state contract, missing if the identity has not published a state
+	PublishedState *string `json:"publishedState,omitempty"`
+
+	// State latest confirmed state, or the genesis state
+	State *string         `json:"state,omitempty"`
+	Trees []TreeIntegrity `json:"trees"`
+}
+
+// StateTransaction defines model for StateTransaction.
+type StateTransaction struct {
+	Attempts  int       `json:"attempts"`
+	CreatedAt time.Time `json:"createdAt"`
+	Error     *string   `json:"error,omitempty"`
+
+	// GasFeeCap max fee per gas in wei, or the gas price of legacy transactions
+	GasFeeCap string `json:"gasFeeCap"`
+
+	// GasTipCap max priority fee per gas in wei, legacy transactions have none
+	GasTipCap   *string                `json:"gasTipCap,omitempty"`
+	Id          uuid.UUID              `json:"id"`
+	Nonce       uint64                 `json:"nonce"`
+	State       string                 `json:"state"`
+	Status      StateTransactionStatus `json:"status"`
+	SubmittedAt time.Time              `json:"submittedAt"`
+	TxHashes    []string               `json:"txHashes"`
+	TxID        string                 `json:"txID"`
+}
+
+// StateTransactionStatus defines model for StateTransaction.Status.
+type StateTransactionStatus string
+
+// TreeDiscrepancy defines model for TreeDiscrepancy.
+type TreeDiscrepancy struct {
+	Kind    TreeDiscrepancyKind  `json:"kind"`
+	Message string               `json:"message"`
+	Node    *string              `json:"node,omitempty"`
+	Tree    *TreeDiscrepancyTree `json:"tree,omitempty"`
+}
+
+// TreeDiscrepancyKind defines model for TreeDiscrepancy.Kind.
+type TreeDiscrepancyKind string
+
+// TreeDiscrepancyTree defines model for TreeDiscrepancy.Tree.
+type TreeDiscrepancyTree string
+
+// TreeIntegrity defines model for TreeIntegrity.
+type TreeIntegrity struct {
+	Leaves int    `json:"leaves"`
+	Nodes  int    `json:"nodes"`
+	Root   string `json:"root"`
+
+	// StateRoot root of the tree in the latest confirmed state
+	StateRoot *string           `json:"stateRoot,omitempty"`
+	Type      TreeIntegrityType `json:"type"`
+}
+
+// TreeIntegrityType defines model for TreeIntegrity.Type.
+type TreeIntegrityType string
+
+// UpdateSettingsRequest The publishing policy is replaced as a whole, the rate limit and notification settings one by one
+type UpdateSettingsRequest struct {
+	Notifications *struct {
+		BacklogAlerts *bool `json:"backlogAlerts,omitempty"`
+		OfferEmails   *bool `json:"offerEmails,omitempty"`
+		Reports       *bool `json:"reports,omitempty"`
+	} `json:"notifications,omitempty"`
+	Publishing *SetPublishingPolicyRequest `json:"publishing,omitempty"`
+	RateLimit  *struct {
+		Anonymous     *RateLimitBucket `json:"anonymous,omitempty"`
+		Authenticated *RateLimitBucket `json:"authenticated,omitempty"`
+		Enabled       *bool            `json:"enabled,omitempty"`
+	} `json:"rateLimit,omitempty"`
+
+	// RhsUrl Url of the reverse hash service in the revocation status of the new credentials
+	RhsUrl *string `json:"rhsUrl,omitempty"`
+}
+
+// VerificationQuery defines model for VerificationQuery.
+type VerificationQuery struct {
+	// AllowedIssuers Issuers of the credentials accepted, any if not set
+	AllowedIssuers *[]string                  `json:"allowedIssuers,omitempty"`
+	CircuitId      VerificationQueryCircuitId `json:"circuitId"`
+	Context        string                     `json:"context"`
+
+	// CredentialSubject Predicate on a field of the credential subject. A field without operator asks to disclose it.
+	CredentialSubject *map[string]interface{} `json:"credentialSubject,omitempty"`
+
+	// Id Id of the query in the request, its position if not set
+	Id                       *uint32 `json:"id,omitempty"`
+	SkipClaimRevocationCheck *bool   `json:"skipClaimRevocationCheck,omitempty"`
+	Type                     string  `json:"type"`
+}
+
+// VerificationQueryCircuitId defines model for VerificationQuery.CircuitId.
+type VerificationQueryCircuitId string
+
+// VerificationRequest defines model for VerificationRequest.
+type VerificationRequest struct {
+	CreatedAt time.Time           `json:"createdAt"`
+	ExpiresAt *time.Time          `json:"expiresAt,omitempty"`
+	Id        uuid.UUID           `json:"id"`
+	Reason    string              `json:"reason"`
+	Scope     []VerificationQuery `json:"scope"`
+}
+
+// VerificationResponse defines model for VerificationResponse.
+type VerificationResponse struct {
+	ConnectionId *uuid.UUID `json:"connectionId,omitempty"`
+	CreatedAt    time.Time  `json:"createdAt"`
+
+	// Error Why the proofs are not valid
+	Error *string   `json:"error,omitempty"`
+	Id    uuid.UUID `json:"id"`
+
+	// Proofs Proofs of the queries sent by the holder
+	Proofs    *[]map[string]interface{} `json:"proofs,omitempty"`
+	RequestId uuid.UUID                 `json:"requestId"`
+	UserDID   string                    `json:"userDID"`
+	Verified  bool                      `json:"verified"`
+}
+
+// WalletLinks Links that open the message of a QR code in the wallet of the holder
+type WalletLinks struct {
+	DeepLink      string `json:"deepLink"`
+	UniversalLink string `json:"universalLink"`
+}
+
+// IdempotencyKey defines model for idempotencyKey.
+type IdempotencyKey = string
+
+// PathAgentMessage defines model for pathAgentMessage.
+type PathAgentMessage = uuid.UUID
+
+// PathClaim defines model for pathClaim.
+type PathClaim = string
+
+// PathDIDServiceID defines model for pathDIDServiceID.
+type PathDIDServiceID = string
+
+// PathFeature defines model for pathFeature.
+type PathFeature = string
+
+// PathHeldCredential defines model for pathHeldCredential.
+type PathHeldCredential = uuid.UUID
+
+// PathIdentifier defines model for pathIdentifier.
+type PathIdentifier = string
+
+// PathNonce defines model for pathNonce.
+type PathNonce = int64
+
+// PathVerificationRequest defines model for pathVerificationRequest.
+type PathVerificationRequest = uuid.UUID
+
+// PublishNow defines model for publishNow.
+type PublishNow = bool
+
+// RevocationDescription defines model for revocationDescription.
+type RevocationDescription = string
+
+// RevocationReason defines model for revocationReason.
+type RevocationReason = RevocationReasonCode
+
+// N400 defines model for 400.
+type N400 = GenericErrorMessage
+
+// N401 defines model for 401.
+type N401 = GenericErrorMessage
+
+// N404 defines model for 404.
+type N404 = GenericErrorMessage
+
+// N409 defines model for 409.
+type N409 = GenericErrorMessage
+
+// N410 defines model for 410.
+type N410 = GenericErrorMessage
+
+// N422 defines model for 422.
+type N422 = GenericErrorMessage
+
+// N500 defines model for 500.
+type N500 = GenericErrorMessage
+
+// N500CreateIdentity defines model for 500-CreateIdentity.
+type N500CreateIdentity struct {
+	Code      *int    `json:"code,omitempty"`
+	Error     *string `json:"error,omitempty"`
+	RequestID *string `json:"requestID,omitempty"`
+}
+
+// AgentTextBody defines parameters for Agent.
+type AgentTextBody = string
+
+// OID4VCICredentialParams defines parameters for OID4VCICredential.
+type OID4VCICredentialParams struct {
+	Authorization string `json:"Authorization"`
+}
+
+// PurgeCachedDocumentParams defines parameters for PurgeCachedDocument.
+type PurgeCachedDocumentParams struct {
+	// Url Url of the document
+	Url string `form:"url" json:"url"`
+}
+
+// VerificationCallbackTextBody defines parameters for VerificationCallback.
+type VerificationCallbackTextBody = string
+
+// VerificationCallbackParams defines parameters for VerificationCallback.
+type VerificationCallbackParams struct {
+	RequestID uuid.UUID `form:"requestID" json:"requestID"`
+}
+
+// GetClaimsParams defines parameters for GetClaims.
+type GetClaimsParams struct {
+	// SchemaType Filter per schema type. Example - KYCAgeCredential
+	SchemaType *string `form:"schemaType,omitempty" json:"schemaType,omitempty"`
+
+	// SchemaHash Filter per schema hash. Example - c9b2370371b7fa8b3dab2a5ba81b6838
+	SchemaHash *string `form:"schemaHash,omitempty" json:"schemaHash,omitempty"`
+
+	// Subject Filter per subject. Example - did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ
+	Subject *string `form:"subject,omitempty" json:"subject,omitempty"`
+
+	// Revoked Filter per claims revoked or not - Example - true.
+	Revoked *bool `form:"revoked,omitempty" json:"revoked,omitempty"`
+
+	// Self Filter per retrieve claims of the provided identifier. Example - true
+	Self *bool `form:"self,omitempty" json:"self,omitempty"`
+
+	// QueryField Filter this field inside the data of the claim
+	QueryField *string `form:"query_field,omitempty" json:"query_field,omitempty"`
+
+	// QueryValue Filter this value inside the data of the claim for the specified field in query_field
+	QueryValue *string `form:"query_value,omitempty" json:"query_value,omitempty"`
+}
+
+// CreateClaimParams defines parameters for CreateClaim.
+type CreateClaimParams struct {
+	// IdempotencyKey Unique key of the request, up to 255 characters. A retry with the same key gets the response of the first
+	// request instead of being processed again, for 24h by default. A retry that arrives while the first request is
+	// being processed gets a 409, and a key used with another request gets a 422.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// RevokeClaimParams defines parameters for RevokeClaim.
+type RevokeClaimParams struct {
+	// PublishNow Publish the state right after the revocation, without waiting for the publishing policy
+	PublishNow *PublishNow `form:"publishNow,omitempty" json:"publishNow,omitempty"`
+
+	// Reason Reason code of the revocation, unspecified by default
+	Reason *RevocationReason `form:"reason,omitempty" json:"reason,omitempty"`
+
+	// Description Free text description of the revocation
+	Description *RevocationDescription `form:"description,omitempty" json:"description,omitempty"`
+
+	// IdempotencyKey Unique key of the request, up to 255 characters. A retry with the same key gets the response of the first
+	// request instead of being processed again, for 24h by default. A retry that arrives while the first request is
+	// being processed gets a 409, and a key used with another request gets a 422.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// GetRevocationListParams defines parameters for GetRevocationList.
+type GetRevocationListParams struct {
+	// Since Returns only the nonces revoked at this time or later
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+
+	// Format Format of the list, list by default
+	Format *GetRevocationListParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetRevocationListParamsFormat defines parameters for GetRevocationList.
+type GetRevocationListParamsFormat string
+
+// GetAgentMessagesParams defines parameters for GetAgentMessages.
+type GetAgentMessagesParams struct {
+	// Sender Only the messages from this DID.
+	Sender *string `form:"sender,omitempty" json:"sender,omitempty"`
+
+	// ThreadID Only the messages of this thread, like the thread of a credential offer.
+	ThreadID *string `form:"threadID,omitempty" json:"threadID,omitempty"`
+
+	// Type Only the messages of this type.
+	Type *string `form:"type,omitempty" json:"type,omitempty"`
+
+	// Status Only the messages with this outcome.
+	Status *GetAgentMessagesParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Limit Maximum number of messages returned.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetAgentMessagesParamsStatus defines parameters for GetAgentMessages.
+type GetAgentMessagesParamsStatus string
+
+// GetRevocationDecisionsReportParams defines parameters for GetRevocationDecisionsReport.
+type GetRevocationDecisionsReportParams struct {
+	// Source Only the decisions of this source
+	Source *string `form:"source,omitempty" json:"source,omitempty"`
+
+	// Status Only the decisions with this outcome
+	Status *GetRevocationDecisionsReportParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// From Only the decisions received at or after this time
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Only the decisions received before this time
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// GetRevocationDecisionsReportParamsStatus defines parameters for GetRevocationDecisionsReport.
+type GetRevocationDecisionsReportParamsStatus string
+
+// GetVerificationResponsesParams defines parameters for GetVerificationResponses.
+type GetVerificationResponsesParams struct {
+	// RequestId Only the responses to this request
+	RequestId *uuid.UUID `form:"requestId,omitempty" json:"requestId,omitempty"`
+
+	// ConnectionId Only the responses of the holder of this connection
+	ConnectionId *uuid.UUID `form:"connectionId,omitempty" json:"connectionId,omitempty"`
+
+	// UserDID Only the responses of this holder
+	UserDID *string `form:"userDID,omitempty" json:"userDID,omitempty"`
+}
+
+// GetHeldCredentialsParams defines parameters for GetHeldCredentials.
+type GetHeldCredentialsParams struct {
+	// SchemaType Filter by the credential schema type, as context#type
+	SchemaType *string `form:"schemaType,omitempty" json:"schemaType,omitempty"`
+
+	// Revoked Filter by revocation status
+	Revoked *bool `form:"revoked,omitempty" json:"revoked,omitempty"`
+}
+
+// ExportIssuerJSONRequestBody defines body for ExportIssuer for application/json ContentType.
+type ExportIssuerJSONRequestBody = ExportIssuerRequest
+
+// UpdateSettingsJSONRequestBody defines body for UpdateSettings for application/json ContentType.
+type UpdateSettingsJSONRequestBody = UpdateSettingsRequest
+
+// AgentTextRequestBody defines body for Agent for text/plain ContentType.
+type AgentTextRequestBody = AgentTextBody
+
+// CreateIdentityJSONRequestBody defines body for CreateIdentity for application/json ContentType.
+type CreateIdentityJSONRequestBody = CreateIdentityRequest
+
+// ImportIdentityJSONRequestBody defines body for ImportIdentity for application/json ContentType.
+type ImportIdentityJSONRequestBody = ImportIdentityRequest
+
+// OID4VCICredentialJSONRequestBody defines body for OID4VCICredential for application/json ContentType.
+type OID4VCICredentialJSONRequestBody = OID4VCICredentialRequest
+
+// OID4VCITokenFormdataRequestBody defines body for OID4VCIToken for application/x-www-form-urlencoded ContentType.
+type OID4VCITokenFormdataRequestBody = OID4VCITokenRequest
+
+// RefreshCachedDocumentJSONRequestBody defines body for RefreshCachedDocument for application/json ContentType.
+type RefreshCachedDocumentJSONRequestBody = RefreshCachedDocumentRequest
+
+// VerificationCallbackTextRequestBody defines body for VerificationCallback for text/plain ContentType.
+type VerificationCallbackTextRequestBody = VerificationCallbackTextBody
+
+// CreateClaimJSONRequestBody defines body for CreateClaim for application/json ContentType.
+type CreateClaimJSONRequestBody = CreateClaimRequest
+
+// RenewClaimOfferJSONRequestBody defines body for RenewClaimOffer for application/json ContentType.
+type RenewClaimOfferJSONRequestBody = RenewCredentialOfferRequest
+
+// SetDIDServiceJSONRequestBody defines body for SetDIDService for application/json ContentType.
+type SetDIDServiceJSONRequestBody = SetDIDServiceRequest
+
+// SetFeatureFlagJSONRequestBody defines body for SetFeatureFlag for application/json ContentType.
+type SetFeatureFlagJSONRequestBody = SetFeatureFlagRequest
+
+// StartIdentityMigrationJSONRequestBody defines body for StartIdentityMigration for application/json ContentType.
+type StartIdentityMigrationJSONRequestBody = StartIdentityMigrationRequest
+
+// CreateOID4VCIOfferJSONRequestBody defines body for CreateOID4VCIOffer for application/json ContentType.
+type CreateOID4VCIOfferJSONRequestBody = CreateOID4VCIOfferRequest
+
+// SetPublishingPolicyJSONRequestBody defines body for SetPublishingPolicy for application/json ContentType.
+type SetPublishingPolicyJSONRequestBody = SetPublishingPolicyRequest
+
+// ApplyRevocationDecisionsJSONRequestBody defines body for ApplyRevocationDecisions for application/json ContentType.
+type ApplyRevocationDecisionsJSONRequestBody = ApplyRevocationDecisionsRequest
+
+// CreateVerificationRequestJSONRequestBody defines body for CreateVerificationRequest for application/json ContentType.
+type CreateVerificationRequestJSONRequestBody = CreateVerificationRequest
+
+// AcceptCredentialOfferJSONRequestBody defines body for AcceptCredentialOffer for application/json ContentType.
+type AcceptCredentialOfferJSONRequestBody = GetClaimQrCodeResponse
+
+// PresentCredentialsJSONRequestBody defines body for PresentCredentials for application/json ContentType.
+type PresentCredentialsJSONRequestBody = AuthorizationRequestMessage
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// GetDocumentation request
+	GetDocumentation(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAgentCapabilities request
+	GetAgentCapabilities(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetOID4VCIIssuerMetadata request
+	GetOID4VCIIssuerMetadata(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetFavicon request
+	GetFavicon(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetYaml request
+	GetYaml(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Health request
+	Health(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExportIssuer request with any body
+	ExportIssuerWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ExportIssuer(ctx context.Context, body ExportIssuerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ResetSettings request
+	ResetSettings(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSettings request
+	GetSettings(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateSettings request with any body
+	UpdateSettingsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateSettings(ctx context.Context, body UpdateSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Agent request with any body
+	AgentWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AgentWithTextBody(ctx context.Context, body AgentTextRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetIdentities request
+	GetIdentities(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateIdentity request with any body
+	CreateIdentityWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateIdentity(ctx context.Context, body CreateIdentityJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ImportIdentity request with any body
+	ImportIdentityWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ImportIdentity(ctx context.Context, body ImportIdentityJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// OID4VCICredential request with any body
+	OID4VCICredentialWithBody(ctx context.Context, params *OID4VCICredentialParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	OID4VCICredential(ctx context.Context, params *OID4VCICredentialParams, body OID4VCICredentialJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// OID4VCIToken request with any body
+	OID4VCITokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	OID4VCITokenWithFormdataBody(ctx context.Context, body OID4VCITokenFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PurgeCachedDocument request
+	PurgeCachedDocument(ctx context.Context, params *PurgeCachedDocumentParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RefreshCachedDocument request with any body
+	RefreshCachedDocumentWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RefreshCachedDocument(ctx context.Context, body RefreshCachedDocumentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// VerificationCallback request with any body
+	VerificationCallbackWithBody(ctx context.Context, params *VerificationCallbackParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	VerificationCallbackWithTextBody(ctx context.Context, params *VerificationCallbackParams, body VerificationCallbackTextRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetClaims request
+	GetClaims(ctx context.Context, identifier PathIdentifier, params *GetClaimsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateClaim request with any body
+	CreateClaimWithBody(ctx context.Context, identifier PathIdentifier, params *CreateClaimParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateClaim(ctx context.Context, identifier PathIdentifier, params *CreateClaimParams, body CreateClaimJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetClaimByNonce request
+	GetClaimByNonce(ctx context.Context, identifier PathIdentifier, nonce PathNonce, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetRevocationStatus request
+	GetRevocationStatus(ctx context.Context, identifier PathIdentifier, nonce PathNonce, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RevokeClaim request
+	RevokeClaim(ctx context.Context, identifier PathIdentifier, nonce PathNonce, params *RevokeClaimParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetRevocationList request
+	GetRevocationList(ctx context.Context, identifier PathIdentifier, params *GetRevocationListParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetClaim request
+	GetClaim(ctx context.Context, identifier PathIdentifier, id PathClaim, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RenewClaimOffer request with any body
+	RenewClaimOfferWithBody(ctx context.Context, identifier PathIdentifier, id PathClaim, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RenewClaimOffer(ctx context.Context, identifier PathIdentifier, id PathClaim, body RenewClaimOfferJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetClaimQrCode request
+	GetClaimQrCode(ctx context.Context, identifier PathIdentifier, id PathClaim, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetClaimWalletLinks request
+	GetClaimWalletLinks(ctx context.Context, identifier PathIdentifier, id PathClaim, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ResolveDIDDocument request
+	ResolveDIDDocument(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDIDServices request
+	GetDIDServices(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteDIDService request
+	DeleteDIDService(ctx context.Context, identifier PathIdentifier, serviceId PathDIDServiceID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetDIDService request with any body
+	SetDIDServiceWithBody(ctx context.Context, identifier PathIdentifier, serviceId PathDIDServiceID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetDIDService(ctx context.Context, identifier PathIdentifier, serviceId PathDIDServiceID, body SetDIDServiceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetFeatureFlags request
+	GetFeatureFlags(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ResetFeatureFlag request
+	ResetFeatureFlag(ctx context.Context, identifier PathIdentifier, feature PathFeature, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetFeatureFlag request with any body
+	SetFeatureFlagWithBody(ctx context.Context, identifier PathIdentifier, feature PathFeature, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetFeatureFlag(ctx context.Context, identifier PathIdentifier, feature PathFeature, body SetFeatureFlagJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetJWTCredentialKeys request
+	GetJWTCredentialKeys(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAuthKeys request
+	GetAuthKeys(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RotateAuthKey request
+	RotateAuthKey(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAgentMessages request
+	GetAgentMessages(ctx context.Context, identifier PathIdentifier, params *GetAgentMessagesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAgentMessageEnvelope request
+	GetAgentMessageEnvelope(ctx context.Context, identifier PathIdentifier, id PathAgentMessage, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AbortIdentityMigration request
+	AbortIdentityMigration(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetIdentityMigration request
+	GetIdentityMigration(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StartIdentityMigration request with any body
+	StartIdentityMigrationWithBody(ctx context.Context, identifier PathIdentifier, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	StartIdentityMigration(ctx context.Context, identifier PathIdentifier, body StartIdentityMigrationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CompleteIdentityMigration request
+	CompleteIdentityMigration(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExportIdentity request
+	ExportIdentity(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateOID4VCIOffer request with any body
+	CreateOID4VCIOfferWithBody(ctx context.Context, identifier PathIdentifier, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateOID4VCIOffer(ctx context.Context, identifier PathIdentifier, body CreateOID4VCIOfferJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ResetPublishingPolicy request
+	ResetPublishingPolicy(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPublishingPolicy request
+	GetPublishingPolicy(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetPublishingPolicy request with any body
+	SetPublishingPolicyWithBody(ctx context.Context, identifier PathIdentifier, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetPublishingPolicy(ctx context.Context, identifier PathIdentifier, body SetPublishingPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ApplyRevocationDecisions request with any body
+	ApplyRevocationDecisionsWithBody(ctx context.Context, identifier PathIdentifier, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ApplyRevocationDecisions(ctx context.Context, identifier PathIdentifier, body ApplyRevocationDecisionsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetRevocationDecisionsReport request
+	GetRevocationDecisionsReport(ctx context.Context, identifier PathIdentifier, params *GetRevocationDecisionsReportParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetStateIntegrity request
+	GetStateIntegrity(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PublishIdentityState request
+	PublishIdentityState(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetStateTransactions request
+	GetStateTransactions(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetStatusListCredential request
+	GetStatusListCredential(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateVerificationRequest request with any body
+	CreateVerificationRequestWithBody(ctx context.Context, identifier PathIdentifier, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateVerificationRequest(ctx context.Context, identifier PathIdentifier, body CreateVerificationRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetVerificationRequest request
+	GetVerificationRequest(ctx context.Context, identifier PathIdentifier, id PathVerificationRequest, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetVerificationRequestQrCode request
+	GetVerificationRequestQrCode(ctx context.Context, identifier PathIdentifier, id PathVerificationRequest, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetVerificationResponses request
+	GetVerificationResponses(ctx context.Context, identifier PathIdentifier, params *GetVerificationResponsesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetHeldCredentials request
+	GetHeldCredentials(ctx context.Context, identifier PathIdentifier, params *GetHeldCredentialsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteHeldCredential request
+	DeleteHeldCredential(ctx context.Context, identifier PathIdentifier, id PathHeldCredential, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetHeldCredential request
+	GetHeldCredential(ctx context.Context, identifier PathIdentifier, id PathHeldCredential, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AcceptCredentialOffer request with any body
+	AcceptCredentialOfferWithBody(ctx context.Context, identifier PathIdentifier, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AcceptCredentialOffer(ctx context.Context, identifier PathIdentifier, body AcceptCredentialOfferJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PresentCredentials request with any body
+	PresentCredentialsWithBody(ctx context.Context, identifier PathIdentifier, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PresentCredentials(ctx context.Context, identifier PathIdentifier, body PresentCredentialsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) GetDocumentation(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDocumentationRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAgentCapabilities(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAgentCapabilitiesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetOID4VCIIssuerMetadata(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOID4VCIIssuerMetadataRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetFavicon(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetFaviconRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetYaml(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetYamlRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Health(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHealthRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ExportIssuerWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportIssuerRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ExportIssuer(ctx context.Context, body ExportIssuerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportIssuerRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ResetSettings(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewResetSettingsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetSettings(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSettingsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateSettingsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSettingsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateSettings(ctx context.Context, body UpdateSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSettingsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AgentWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAgentRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AgentWithTextBody(ctx context.Context, body AgentTextRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAgentRequestWithTextBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetIdentities(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetIdentitiesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateIdentityWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateIdentityRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateIdentity(ctx context.Context, body CreateIdentityJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateIdentityRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ImportIdentityWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportIdentityRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ImportIdentity(ctx context.Context, body ImportIdentityJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportIdentityRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) OID4VCICredentialWithBody(ctx context.Context, params *OID4VCICredentialParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewOID4VCICredentialRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) OID4VCICredential(ctx context.Context, params *OID4VCICredentialParams, body OID4VCICredentialJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewOID4VCICredentialRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) OID4VCITokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewOID4VCITokenRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) OID4VCITokenWithFormdataBody(ctx context.Context, body OID4VCITokenFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewOID4VCITokenRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PurgeCachedDocument(ctx context.Context, params *PurgeCachedDocumentParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPurgeCachedDocumentRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RefreshCachedDocumentWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRefreshCachedDocumentRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RefreshCachedDocument(ctx context.Context, body RefreshCachedDocumentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRefreshCachedDocumentRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) VerificationCallbackWithBody(ctx context.Context, params *VerificationCallbackParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVerificationCallbackRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) VerificationCallbackWithTextBody(ctx context.Context, params *VerificationCallbackParams, body VerificationCallbackTextRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVerificationCallbackRequestWithTextBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetClaims(ctx context.Context, identifier PathIdentifier, params *GetClaimsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetClaimsRequest(c.Server, identifier, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateClaimWithBody(ctx context.Context, identifier PathIdentifier, params *CreateClaimParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateClaimRequestWithBody(c.Server, identifier, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateClaim(ctx context.Context, identifier PathIdentifier, params *CreateClaimParams, body CreateClaimJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateClaimRequest(c.Server, identifier, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetClaimByNonce(ctx context.Context, identifier PathIdentifier, nonce PathNonce, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetClaimByNonceRequest(c.Server, identifier, nonce)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetRevocationStatus(ctx context.Context, identifier PathIdentifier, nonce PathNonce, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRevocationStatusRequest(c.Server, identifier, nonce)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RevokeClaim(ctx context.Context, identifier PathIdentifier, nonce PathNonce, params *RevokeClaimParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRevokeClaimRequest(c.Server, identifier, nonce, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetRevocationList(ctx context.Context, identifier PathIdentifier, params *GetRevocationListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRevocationListRequest(c.Server, identifier, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetClaim(ctx context.Context, identifier PathIdentifier, id PathClaim, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetClaimRequest(c.Server, identifier, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RenewClaimOfferWithBody(ctx context.Context, identifier PathIdentifier, id PathClaim, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRenewClaimOfferRequestWithBody(c.Server, identifier, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RenewClaimOffer(ctx context.Context, identifier PathIdentifier, id PathClaim, body RenewClaimOfferJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRenewClaimOfferRequest(c.Server, identifier, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetClaimQrCode(ctx context.Context, identifier PathIdentifier, id PathClaim, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetClaimQrCodeRequest(c.Server, identifier, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetClaimWalletLinks(ctx context.Context, identifier PathIdentifier, id PathClaim, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetClaimWalletLinksRequest(c.Server, identifier, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ResolveDIDDocument(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewResolveDIDDocumentRequest(c.Server, identifier)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetDIDServices(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDIDServicesRequest(c.Server, identifier)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteDIDService(ctx context.Context, identifier PathIdentifier, serviceId PathDIDServiceID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteDIDServiceRequest(c.Server, identifier, serviceId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetDIDServiceWithBody(ctx context.Context, identifier PathIdentifier, serviceId PathDIDServiceID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetDIDServiceRequestWithBody(c.Server, identifier, serviceId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetDIDService(ctx context.Context, identifier PathIdentifier, serviceId PathDIDServiceID, body SetDIDServiceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetDIDServiceRequest(c.Server, identifier, serviceId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetFeatureFlags(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetFeatureFlagsRequest(c.Server, identifier)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ResetFeatureFlag(ctx context.Context, identifier PathIdentifier, feature PathFeature, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewResetFeatureFlagRequest(c.Server, identifier, feature)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetFeatureFlagWithBody(ctx context.Context, identifier PathIdentifier, feature PathFeature, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetFeatureFlagRequestWithBody(c.Server, identifier, feature, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetFeatureFlag(ctx context.Context, identifier PathIdentifier, feature PathFeature, body SetFeatureFlagJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetFeatureFlagRequest(c.Server, identifier, feature, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetJWTCredentialKeys(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetJWTCredentialKeysRequest(c.Server, identifier)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAuthKeys(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAuthKeysRequest(c.Server, identifier)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RotateAuthKey(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRotateAuthKeyRequest(c.Server, identifier)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAgentMessages(ctx context.Context, identifier PathIdentifier, params *GetAgentMessagesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAgentMessagesRequest(c.Server, identifier, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAgentMessageEnvelope(ctx context.Context, identifier PathIdentifier, id PathAgentMessage, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAgentMessageEnvelopeRequest(c.Server, identifier, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AbortIdentityMigration(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAbortIdentityMigrationRequest(c.Server, identifier)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetIdentityMigration(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetIdentityMigrationRequest(c.Server, identifier)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) StartIdentityMigrationWithBody(ctx context.Context, identifier PathIdentifier, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStartIdentityMigrationRequestWithBody(c.Server, identifier, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) StartIdentityMigration(ctx context.Context, identifier PathIdentifier, body StartIdentityMigrationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStartIdentityMigrationRequest(c.Server, identifier, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CompleteIdentityMigration(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCompleteIdentityMigrationRequest(c.Server, identifier)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ExportIdentity(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportIdentityRequest(c.Server, identifier)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateOID4VCIOfferWithBody(ctx context.Context, identifier PathIdentifier, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateOID4VCIOfferRequestWithBody(c.Server, identifier, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateOID4VCIOffer(ctx context.Context, identifier PathIdentifier, body CreateOID4VCIOfferJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateOID4VCIOfferRequest(c.Server, identifier, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ResetPublishingPolicy(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewResetPublishingPolicyRequest(c.Server, identifier)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetPublishingPolicy(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPublishingPolicyRequest(c.Server, identifier)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetPublishingPolicyWithBody(ctx context.Context, identifier PathIdentifier, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetPublishingPolicyRequestWithBody(c.Server, identifier, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetPublishingPolicy(ctx context.Context, identifier PathIdentifier, body SetPublishingPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetPublishingPolicyRequest(c.Server, identifier, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ApplyRevocationDecisionsWithBody(ctx context.Context, identifier PathIdentifier, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApplyRevocationDecisionsRequestWithBody(c.Server, identifier, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ApplyRevocationDecisions(ctx context.Context, identifier PathIdentifier, body ApplyRevocationDecisionsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApplyRevocationDecisionsRequest(c.Server, identifier, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetRevocationDecisionsReport(ctx context.Context, identifier PathIdentifier, params *GetRevocationDecisionsReportParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRevocationDecisionsReportRequest(c.Server, identifier, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetStateIntegrity(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetStateIntegrityRequest(c.Server, identifier)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PublishIdentityState(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPublishIdentityStateRequest(c.Server, identifier)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetStateTransactions(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetStateTransactionsRequest(c.Server, identifier)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetStatusListCredential(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetStatusListCredentialRequest(c.Server, identifier)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateVerificationRequestWithBody(ctx context.Context, identifier PathIdentifier, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateVerificationRequestRequestWithBody(c.Server, identifier, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateVerificationRequest(ctx context.Context, identifier PathIdentifier, body CreateVerificationRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateVerificationRequestRequest(c.Server, identifier, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetVerificationRequest(ctx context.Context, identifier PathIdentifier, id PathVerificationRequest, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetVerificationRequestRequest(c.Server, identifier, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetVerificationRequestQrCode(ctx context.Context, identifier PathIdentifier, id PathVerificationRequest, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetVerificationRequestQrCodeRequest(c.Server, identifier, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetVerificationResponses(ctx context.Context, identifier PathIdentifier, params *GetVerificationResponsesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetVerificationResponsesRequest(c.Server, identifier, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetHeldCredentials(ctx context.Context, identifier PathIdentifier, params *GetHeldCredentialsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetHeldCredentialsRequest(c.Server, identifier, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteHeldCredential(ctx context.Context, identifier PathIdentifier, id PathHeldCredential, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteHeldCredentialRequest(c.Server, identifier, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetHeldCredential(ctx context.Context, identifier PathIdentifier, id PathHeldCredential, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetHeldCredentialRequest(c.Server, identifier, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AcceptCredentialOfferWithBody(ctx context.Context, identifier PathIdentifier, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAcceptCredentialOfferRequestWithBody(c.Server, identifier, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AcceptCredentialOffer(ctx context.Context, identifier PathIdentifier, body AcceptCredentialOfferJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAcceptCredentialOfferRequest(c.Server, identifier, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PresentCredentialsWithBody(ctx context.Context, identifier PathIdentifier, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPresentCredentialsRequestWithBody(c.Server, identifier, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PresentCredentials(ctx context.Context, identifier PathIdentifier, body PresentCredentialsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPresentCredentialsRequest(c.Server, identifier, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetDocumentationRequest generates requests for GetDocumentation
+func NewGetDocumentationRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAgentCapabilitiesRequest generates requests for GetAgentCapabilities
+func NewGetAgentCapabilitiesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/.well-known/agent-capabilities")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetOID4VCIIssuerMetadataRequest generates requests for GetOID4VCIIssuerMetadata
+func NewGetOID4VCIIssuerMetadataRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/.well-known/openid-credential-issuer")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetFaviconRequest generates requests for GetFavicon
+func NewGetFaviconRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/favicon.ico")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetYamlRequest generates requests for GetYaml
+func NewGetYamlRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/static/docs/api/api.yaml")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewHealthRequest generates requests for Health
+func NewHealthRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/status")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewExportIssuerRequest calls the generic ExportIssuer builder with application/json body
+func NewExportIssuerRequest(server string, body ExportIssuerJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewExportIssuerRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewExportIssuerRequestWithBody generates requests for ExportIssuer with any type of body
+func NewExportIssuerRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/admin/export")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewResetSettingsRequest generates requests for ResetSettings
+func NewResetSettingsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/admin/settings")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSettingsRequest generates requests for GetSettings
+func NewGetSettingsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/admin/settings")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateSettingsRequest calls the generic UpdateSettings builder with application/json body
+func NewUpdateSettingsRequest(server string, body UpdateSettingsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateSettingsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUpdateSettingsRequestWithBody generates requests for UpdateSettings with any type of body
+func NewUpdateSettingsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/admin/settings")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAgentRequestWithTextBody calls the generic Agent builder with text/plain body
+func NewAgentRequestWithTextBody(server string, body AgentTextRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyReader = strings.NewReader(string(body))
+	return NewAgentRequestWithBody(server, "text/plain", bodyReader)
+}
+
+// NewAgentRequestWithBody generates requests for Agent with any type of body
+func NewAgentRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/agent")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetIdentitiesRequest generates requests for GetIdentities
+func NewGetIdentitiesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/identities")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateIdentityRequest calls the generic CreateIdentity builder with application/json body
+func NewCreateIdentityRequest(server string, body CreateIdentityJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateIdentityRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateIdentityRequestWithBody generates requests for CreateIdentity with any type of body
+func NewCreateIdentityRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/identities")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewImportIdentityRequest calls the generic ImportIdentity builder with application/json body
+func NewImportIdentityRequest(server string, body ImportIdentityJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewImportIdentityRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewImportIdentityRequestWithBody generates requests for ImportIdentity with any type of body
+func NewImportIdentityRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/migration/import")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewOID4VCICredentialRequest calls the generic OID4VCICredential builder with application/json body
+func NewOID4VCICredentialRequest(server string, params *OID4VCICredentialParams, body OID4VCICredentialJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewOID4VCICredentialRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewOID4VCICredentialRequestWithBody generates requests for OID4VCICredential with any type of body
+func NewOID4VCICredentialRequestWithBody(server string, params *OID4VCICredentialParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/oid4vci/credential")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	var headerParam0 string
+
+	headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, params.Authorization)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Authorization", headerParam0)
+
+	return req, nil
+}
+
+// NewOID4VCITokenRequestWithFormdataBody calls the generic OID4VCIToken builder with application/x-www-form-urlencoded body
+func NewOID4VCITokenRequestWithFormdataBody(server string, body OID4VCITokenFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewOID4VCITokenRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewOID4VCITokenRequestWithBody generates requests for OID4VCIToken with any type of body
+func NewOID4VCITokenRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/oid4vci/token")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPurgeCachedDocumentRequest generates requests for PurgeCachedDocument
+func NewPurgeCachedDocumentRequest(server string, params *PurgeCachedDocumentParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/schema-cache")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "url", runtime.ParamLocationQuery, params.Url); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRefreshCachedDocumentRequest calls the generic RefreshCachedDocument builder with application/json body
+func NewRefreshCachedDocumentRequest(server string, body RefreshCachedDocumentJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRefreshCachedDocumentRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewRefreshCachedDocumentRequestWithBody generates requests for RefreshCachedDocument with any type of body
+func NewRefreshCachedDocumentRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/schema-cache/refresh")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewVerificationCallbackRequestWithTextBody calls the generic VerificationCallback builder with text/plain body
+func NewVerificationCallbackRequestWithTextBody(server string, params *VerificationCallbackParams, body VerificationCallbackTextRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyReader = strings.NewReader(string(body))
+	return NewVerificationCallbackRequestWithBody(server, params, "text/plain", bodyReader)
+}
+
+// NewVerificationCallbackRequestWithBody generates requests for VerificationCallback with any type of body
+func NewVerificationCallbackRequestWithBody(server string, params *VerificationCallbackParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/verification/callback")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "requestID", runtime.ParamLocationQuery, params.RequestID); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetClaimsRequest generates requests for GetClaims
+func NewGetClaimsRequest(server string, identifier PathIdentifier, params *GetClaimsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "identifier", runtime.ParamLocationPath, identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/%s/claims", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.SchemaType != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "schemaType", runtime.ParamLocationQuery, *params.SchemaType); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.SchemaHash != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "schemaHash", runtime.ParamLocationQuery, *params.SchemaHash); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Subject != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "subject", runtime.ParamLocationQuery, *params.Subject); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Revoked != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "revoked", runtime.ParamLocationQuery, *params.Revoked); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Self != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "self", runtime.ParamLocationQuery, *params.Self); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.QueryField != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "query_field", runtime.ParamLocationQuery, *params.QueryField); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.QueryValue != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "query_value", runtime.ParamLocationQuery, *params.QueryValue); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateClaimRequest calls the generic CreateClaim builder with application/json body
+func NewCreateClaimRequest(server string, identifier PathIdentifier, params *CreateClaimParams, body CreateClaimJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateClaimRequestWithBody(server, identifier, params, "application/json", bodyReader)
+}
+
+// NewCreateClaimRequestWithBody generates requests for CreateClaim with any type of body
+func NewCreateClaimRequestWithBody(server string, identifier PathIdentifier, params *CreateClaimParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "identifier", runtime.ParamLocationPath, identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/%s/claims", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params.IdempotencyKey != nil {
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Idempotency-Key", headerParam0)
+	}
+
+	return req, nil
+}
+
+// NewGetClaimByNonceRequest generates requests for GetClaimByNonce
+func NewGetClaimByNonceRequest(server string, identifier PathIdentifier, nonce PathNonce) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "identifier", runtime.ParamLocationPath, identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "nonce", runtime.ParamLocationPath, nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/%s/claims/nonce/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetRevocationStatusRequest generates requests for GetRevocationStatus
+func NewGetRevocationStatusRequest(server string, identifier PathIdentifier, nonce PathNonce) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "identifier", runtime.ParamLocationPath, identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "nonce", runtime.ParamLocationPath, nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/%s/claims/revocation/status/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRevokeClaimRequest generates requests for RevokeClaim
+func NewRevokeClaimRequest(server string, identifier PathIdentifier, nonce PathNonce, params *RevokeClaimParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "identifier", runtime.ParamLocationPath, identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "nonce", runtime.ParamLocationPath, nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/%s/claims/revoke/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.PublishNow != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "publishNow", runtime.ParamLocationQuery, *params.PublishNow); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Reason != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "reason", runtime.ParamLocationQuery, *params.Reason); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Description != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "description", runtime.ParamLocationQuery, *params.Description); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.IdempotencyKey != nil {
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Idempotency-Key", headerParam0)
+	}
+
+	return req, nil
+}
+
+// NewGetRevocationListRequest generates requests for GetRevocationList
+func NewGetRevocationListRequest(server string, identifier PathIdentifier, params *GetRevocationListParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "identifier", runtime.ParamLocationPath, identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/%s/claims/revoked", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Since != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since", runtime.ParamLocationQuery, *params.Since); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Format != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetClaimRequest generates requests for GetClaim
+func NewGetClaimRequest(server string, identifier PathIdentifier, id PathClaim) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "identifier", runtime.ParamLocationPath, identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/%s/claims/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRenewClaimOfferRequest calls the generic RenewClaimOffer builder with application/json body
+func NewRenewClaimOfferRequest(server string, identifier PathIdentifier, id PathClaim, body RenewClaimOfferJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRenewClaimOfferRequestWithBody(server, identifier, id, "application/json", bodyReader)
+}
+
+// NewRenewClaimOfferRequestWithBody generates requests for RenewClaimOffer with any type of body
+func NewRenewClaimOfferRequestWithBody(server string, identifier PathIdentifier, id PathClaim, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "identifier", runtime.ParamLocationPath, identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/%s/claims/%s/offer", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetClaimQrCodeRequest generates requests for GetClaimQrCode
+func NewGetClaimQrCodeRequest(server string, identifier PathIdentifier, id PathClaim) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "identifier", runtime.ParamLocationPath, identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/%s/claims/%s/qrcode", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetClaimWalletLinksRequest generates requests for GetClaimWalletLinks
+func NewGetClaimWalletLinksRequest(server string, identifier PathIdentifier, id PathClaim) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "identifier", runtime.ParamLocationPath, identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/%s/claims/%s/wallet-links", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewResolveDIDDocumentRequest generates requests for ResolveDIDDocument
+func NewResolveDIDDocumentRequest(server string, identifier PathIdentifier) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "identifier", runtime.ParamLocationPath, identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/%s/did-document", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetDIDServicesRequest generates requests for GetDIDServices
+func NewGetDIDServicesRequest(server string, identifier PathIdentifier) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "identifier", runtime.ParamLocationPath, identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/%s/did-document/services", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteDIDServiceRequest generates requests for DeleteDIDService
+func NewDeleteDIDServiceRequest(server string, identifier PathIdentifier, serviceId PathDIDServiceID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "identifier", runtime.ParamLocationPath, identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "serviceId", runtime.ParamLocationPath, serviceId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/%s/did-document/services/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSetDIDServiceRequest calls the generic SetDIDService builder with application/json body
+func NewSetDIDServiceRequest(server string, identifier PathIdentifier, serviceId PathDIDServiceID, body SetDIDServiceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetDIDServiceRequestWithBody(server, identifier, serviceId, "application/json", bodyReader)
+}
+
+// NewSetDIDServiceRequestWithBody generates requests for SetDIDService with any type of body
+func NewSetDIDServiceRequestWithBody(server string, identifier PathIdentifier, serviceId PathDIDServiceID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "identifier", runtime.ParamLocationPath, identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "serviceId", runtime.ParamLocationPath, serviceId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/%s/did-document/services/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetFeatureFlagsRequest generates requests for GetFeatureFlags
+func NewGetFeatureFlagsRequest(server string, identifier PathIdentifier) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "identifier", runtime.ParamLocationPath, identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/%s/features", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewResetFeatureFlagRequest generates requests for ResetFeatureFlag
+func NewResetFeatureFlagRequest(server string, identifier PathIdentifier, feature PathFeature) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "identifier", runtime.ParamLocationPath, identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "feature", runtime.ParamLocationPath, feature)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/%s/features/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSetFeatureFlagRequest calls the generic SetFeatureFlag builder with application/json body
+func NewSetFeatureFlagRequest(server string, identifier PathIdentifier, feature PathFeature, body SetFeatureFlagJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetFeatureFlagRequestWithBody(server, identifier, feature, "application/json", bodyReader)
+}
+
+// NewSetFeatureFlagRequestWithBody generates requests for SetFeatureFlag with any type of body
+func NewSetFeatureFlagRequestWithBody(server string, identifier PathIdentifier, feature PathFeature, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "identifier", runtime.ParamLocationPath, identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "feature", runtime.ParamLocationPath, feature)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/%s/features/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetJWTCredentialKeysRequest generates requests for GetJWTCredentialKeys
+func NewGetJWTCredentialKeysRequest(server string, identifier PathIdentifier) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "identifier", runtime.ParamLocationPath, identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/%s/jwks", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAuthKeysRequest generates requests for GetAuthKeys
+func NewGetAuthKeysRequest(server string, identifier PathIdentifier) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "identifier", runtime.ParamLocationPath, identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/%s/keys", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRotateAuthKeyRequest generates requests for RotateAuthKey
+func NewRotateAuthKeyRequest(server string, identifier PathIdentifier) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "identifier", runtime.ParamLocationPath, identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/%s/keys", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAgentMessagesRequest generates requests for GetAgentMessages
+func NewGetAgentMessagesRequest(server string, identifier PathIdentifier, params *GetAgentMessagesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "identifier", runtime.ParamLocationPath, identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/%s/messages", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Sender != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sender", runtime.ParamLocationQuery, *params.Sender); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.ThreadID != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "threadID", runtime.ParamLocationQuery, *params.ThreadID); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Type != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "type", runtime.ParamLocationQuery, *params.Type); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Status != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAgentMessageEnvelopeRequest generates requests for GetAgentMessageEnvelope
+func NewGetAgentMessageEnvelopeRequest(server string, identifier PathIdentifier, id PathAgentMessage) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "identifier", runtime.ParamLocationPath, identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/%s/messages/%s/envelope", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAbortIdentityMigrationRequest generates requests for AbortIdentityMigration
+func NewAbortIdentityMigrationRequest(server string, identifier PathIdentifier) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "identifier", runtime.ParamLocationPath, identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/%s/migration", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetIdentityMigrationRequest generates requests for GetIdentityMigration
+func NewGetIdentityMigrationRequest(server string, identifier PathIdentifier) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "identifier", runtime.ParamLocationPath, identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/%s/migration", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewStartIdentityMigrationRequest calls the generic StartIdentityMigration builder with application/json body
+func NewStartIdentityMigrationRequest(server string, identifier PathIdentifier, body StartIdentityMigrationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewStartIdentityMigrationRequestWithBody(server, identifier, "application/json", bodyReader)
+}
+
+// NewStartIdentityMigrationRequestWithBody generates requests for StartIdentityMigration with any type of body
+func NewStartIdentityMigrationRequestWithBody(server string, identifier PathIdentifier, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "identifier", runtime.ParamLocationPath, identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/%s/migration", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCompleteIdentityMigrationRequest generates requests for CompleteIdentityMigration
+func NewCompleteIdentityMigrationRequest(server string, identifier PathIdentifier) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "identifier", runtime.ParamLocationPath, identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/%s/migration/complete", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewExportIdentityRequest generates requests for ExportIdentity
+func NewExportIdentityRequest(server string, identifier PathIdentifier) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "identifier", runtime.ParamLocationPath, identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/%s/migration/export", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateOID4VCIOfferRequest calls the generic CreateOID4VCIOffer builder with application/json body
+func NewCreateOID4VCIOfferRequest(server string, identifier PathIdentifier, body CreateOID4VCIOfferJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateOID4VCIOfferRequestWithBody(server, identifier, "application/json", bodyReader)
+}
+
+// NewCreateOID4VCIOfferRequestWithBody generates requests for CreateOID4VCIOffer with any type of body
+func NewCreateOID4VCIOfferRequestWithBody(server string, identifier PathIdentifier, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "identifier", runtime.ParamLocationPath, identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/%s/oid4vci/offers", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewResetPublishingPolicyRequest generates requests for ResetPublishingPolicy
+func NewResetPublishingPolicyRequest(server string, identifier PathIdentifier) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "identifier", runtime.ParamLocationPath, identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/%s/publishing-policy", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetPublishingPolicyRequest generates requests for GetPublishingPolicy
+func NewGetPublishingPolicyRequest(server string, identifier PathIdentifier) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "identifier", runtime.ParamLocationPath, identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/%s/publishing-policy", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSetPublishingPolicyRequest calls the generic SetPublishingPolicy builder with application/json body
+func NewSetPublishingPolicyRequest(server string, identifier PathIdentifier, body SetPublishingPolicyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetPublishingPolicyRequestWithBody(server, identifier, "application/json", bodyReader)
+}
+
+// NewSetPublishingPolicyRequestWithBody generates requests for SetPublishingPolicy with any type of body
+func NewSetPublishingPolicyRequestWithBody(server string, identifier PathIdentifier, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "identifier", runtime.ParamLocationPath, identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/%s/publishing-policy", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewApplyRevocationDecisionsRequest calls the generic ApplyRevocationDecisions builder with application/json body
+func NewApplyRevocationDecisionsRequest(server string, identifier PathIdentifier, body ApplyRevocationDecisionsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewApplyRevocationDecisionsRequestWithBody(server, identifier, "application/json", bodyReader)
+}
+
+// NewApplyRevocationDecisionsRequestWithBody generates requests for ApplyRevocationDecisions with any type of body
+func NewApplyRevocationDecisionsRequestWithBody(server string, identifier PathIdentifier, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "identifier", runtime.ParamLocationPath, identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/%s/revocation-decisions", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetRevocationDecisionsReportRequest generates requests for GetRevocationDecisionsReport
+func NewGetRevocationDecisionsReportRequest(server string, identifier PathIdentifier, params *GetRevocationDecisionsReportParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "identifier", runtime.ParamLocationPath, identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/%s/revocation-decisions/report", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Source != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "source", runtime.ParamLocationQuery, *params.Source); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Status != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.From != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.To != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetStateIntegrityRequest generates requests for GetStateIntegrity
+func NewGetStateIntegrityRequest(server string, identifier PathIdentifier) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "identifier", runtime.ParamLocationPath, identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/%s/state/integrity", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPublishIdentityStateRequest generates requests for PublishIdentityState
+func NewPublishIdentityStateRequest(server string, identifier PathIdentifier) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "identifier", runtime.ParamLocationPath, identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/%s/state/publish", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetStateTransactionsRequest generates requests for GetStateTransactions
+func NewGetStateTransactionsRequest(server string, identifier PathIdentifier) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "identifier", runtime.ParamLocationPath, identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/%s/state/transactions", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetStatusListCredentialRequest generates requests for GetStatusListCredential
+func NewGetStatusListCredentialRequest(server string, identifier PathIdentifier) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "identifier", runtime.ParamLocationPath, identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/%s/status-list", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateVerificationRequestRequest calls the generic CreateVerificationRequest builder with application/json body
+func NewCreateVerificationRequestRequest(server string, identifier PathIdentifier, body CreateVerificationRequestJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateVerificationRequestRequestWithBody(server, identifier, "application/json", bodyReader)
+}
+
+// NewCreateVerificationRequestRequestWithBody generates requests for CreateVerificationRequest with any type of body
+func NewCreateVerificationRequestRequestWithBody(server string, identifier PathIdentifier, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "identifier", runtime.ParamLocationPath, identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/%s/verification/requests", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetVerificationRequestRequest generates requests for GetVerificationRequest
+func NewGetVerificationRequestRequest(server string, identifier PathIdentifier, id PathVerificationRequest) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "identifier", runtime.ParamLocationPath, identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/%s/verification/requests/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetVerificationRequestQrCodeRequest generates requests for GetVerificationRequestQrCode
+func NewGetVerificationRequestQrCodeRequest(server string, identifier PathIdentifier, id PathVerificationRequest) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "identifier", runtime.ParamLocationPath, identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/%s/verification/requests/%s/qrcode", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetVerificationResponsesRequest generates requests for GetVerificationResponses
+func NewGetVerificationResponsesRequest(server string, identifier PathIdentifier, params *GetVerificationResponsesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "identifier", runtime.ParamLocationPath, identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/%s/verification/responses", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.RequestId != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "requestId", runtime.ParamLocationQuery, *params.RequestId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.ConnectionId != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "connectionId", runtime.ParamLocationQuery, *params.ConnectionId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.UserDID != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "userDID", runtime.ParamLocationQuery, *params.UserDID); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetHeldCredentialsRequest generates requests for GetHeldCredentials
+func NewGetHeldCredentialsRequest(server string, identifier PathIdentifier, params *GetHeldCredentialsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "identifier", runtime.ParamLocationPath, identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/%s/wallet/credentials", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.SchemaType != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "schemaType", runtime.ParamLocationQuery, *params.SchemaType); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Revoked != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "revoked", runtime.ParamLocationQuery, *params.Revoked); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteHeldCredentialRequest generates requests for DeleteHeldCredential
+func NewDeleteHeldCredentialRequest(server string, identifier PathIdentifier, id PathHeldCredential) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "identifier", runtime.ParamLocationPath, identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/%s/wallet/credentials/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetHeldCredentialRequest generates requests for GetHeldCredential
+func NewGetHeldCredentialRequest(server string, identifier PathIdentifier, id PathHeldCredential) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "identifier", runtime.ParamLocationPath, identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/%s/wallet/credentials/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAcceptCredentialOfferRequest calls the generic AcceptCredentialOffer builder with application/json body
+func NewAcceptCredentialOfferRequest(server string, identifier PathIdentifier, body AcceptCredentialOfferJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAcceptCredentialOfferRequestWithBody(server, identifier, "application/json", bodyReader)
+}
+
+// NewAcceptCredentialOfferRequestWithBody generates requests for AcceptCredentialOffer with any type of body
+func NewAcceptCredentialOfferRequestWithBody(server string, identifier PathIdentifier, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "identifier", runtime.ParamLocationPath, identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/%s/wallet/offers", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPresentCredentialsRequest calls the generic PresentCredentials builder with application/json body
+func NewPresentCredentialsRequest(server string, identifier PathIdentifier, body PresentCredentialsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPresentCredentialsRequestWithBody(server, identifier, "application/json", bodyReader)
+}
+
+// NewPresentCredentialsRequestWithBody generates requests for PresentCredentials with any type of body
+func NewPresentCredentialsRequestWithBody(server string, identifier PathIdentifier, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "identifier", runtime.ParamLocationPath, identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/%s/wallet/presentations", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetDocumentation request
+	GetDocumentationWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDocumentationResult, error)
+
+	// GetAgentCapabilities request
+	GetAgentCapabilitiesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAgentCapabilitiesResult, error)
+
+	// GetOID4VCIIssuerMetadata request
+	GetOID4VCIIssuerMetadataWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOID4VCIIssuerMetadataResult, error)
+
+	// GetFavicon request
+	GetFaviconWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetFaviconResult, error)
+
+	// GetYaml request
+	GetYamlWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetYamlResult, error)
+
+	// Health request
+	HealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthResult, error)
+
+	// ExportIssuer request with any body
+	ExportIssuerWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ExportIssuerResult, error)
+
+	ExportIssuerWithResponse(ctx context.Context, body ExportIssuerJSONRequestBody, reqEditors ...RequestEditorFn) (*ExportIssuerResult, error)
+
+	// ResetSettings request
+	ResetSettingsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ResetSettingsResult, error)
+
+	// GetSettings request
+	GetSettingsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSettingsResult, error)
+
+	// UpdateSettings request with any body
+	UpdateSettingsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSettingsResult, error)
+
+	UpdateSettingsWithResponse(ctx context.Context, body UpdateSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSettingsResult, error)
+
+	// Agent request with any body
+	AgentWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AgentResult, error)
+
+	AgentWithTextBodyWithResponse(ctx context.Context, body AgentTextRequestBody, reqEditors ...RequestEditorFn) (*AgentResult, error)
+
+	// GetIdentities request
+	GetIdentitiesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetIdentitiesResult, error)
+
+	// CreateIdentity request with any body
+	CreateIdentityWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateIdentityResult, error)
+
+	CreateIdentityWithResponse(ctx context.Context, body CreateIdentityJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateIdentityResult, error)
+
+	// ImportIdentity request with any body
+	ImportIdentityWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportIdentityResult, error)
+
+	ImportIdentityWithResponse(ctx context.Context, body ImportIdentityJSONRequestBody, reqEditors ...RequestEditorFn) (*ImportIdentityResult, error)
+
+	// OID4VCICredential request with any body
+	OID4VCICredentialWithBodyWithResponse(ctx context.Context, params *OID4VCICredentialParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*OID4VCICredentialResult, error)
+
+	OID4VCICredentialWithResponse(ctx context.Context, params *OID4VCICredentialParams, body OID4VCICredentialJSONRequestBody, reqEditors ...RequestEditorFn) (*OID4VCICredentialResult, error)
+
+	// OID4VCIToken request with any body
+	OID4VCITokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*OID4VCITokenResult, error)
+
+	OID4VCITokenWithFormdataBodyWithResponse(ctx context.Context, body OID4VCITokenFormdataRequestBody, reqEditors ...RequestEditorFn) (*OID4VCITokenResult, error)
+
+	// PurgeCachedDocument request
+	PurgeCachedDocumentWithResponse(ctx context.Context, params *PurgeCachedDocumentParams, reqEditors ...RequestEditorFn) (*PurgeCachedDocumentResult, error)
+
+	// RefreshCachedDocument request with any body
+	RefreshCachedDocumentWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RefreshCachedDocumentResult, error)
+
+	RefreshCachedDocumentWithResponse(ctx context.Context, body RefreshCachedDocumentJSONRequestBody, reqEditors ...RequestEditorFn) (*RefreshCachedDocumentResult, error)
+
+	// VerificationCallback request with any body
+	VerificationCallbackWithBodyWithResponse(ctx context.Context, params *VerificationCallbackParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*VerificationCallbackResult, error)
+
+	VerificationCallbackWithTextBodyWithResponse(ctx context.Context, params *VerificationCallbackParams, body VerificationCallbackTextRequestBody, reqEditors ...RequestEditorFn) (*VerificationCallbackResult, error)
+
+	// GetClaims request
+	GetClaimsWithResponse(ctx context.Context, identifier PathIdentifier, params *GetClaimsParams, reqEditors ...RequestEditorFn) (*GetClaimsResult, error)
+
+	// CreateClaim request with any body
+	CreateClaimWithBodyWithResponse(ctx context.Context, identifier PathIdentifier, params *CreateClaimParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateClaimResult, error)
+
+	CreateClaimWithResponse(ctx context.Context, identifier PathIdentifier, params *CreateClaimParams, body CreateClaimJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateClaimResult, error)
+
+	// GetClaimByNonce request
+	GetClaimByNonceWithResponse(ctx context.Context, identifier PathIdentifier, nonce PathNonce, reqEditors ...RequestEditorFn) (*GetClaimByNonceResult, error)
+
+	// GetRevocationStatus request
+	GetRevocationStatusWithResponse(ctx context.Context, identifier PathIdentifier, nonce PathNonce, reqEditors ...RequestEditorFn) (*GetRevocationStatusResult, error)
+
+	// RevokeClaim request
+	RevokeClaimWithResponse(ctx context.Context, identifier PathIdentifier, nonce PathNonce, params *RevokeClaimParams, reqEditors ...RequestEditorFn) (*RevokeClaimResult, error)
+
+	// GetRevocationList request
+	GetRevocationListWithResponse(ctx context.Context, identifier PathIdentifier, params *GetRevocationListParams, reqEditors ...RequestEditorFn) (*GetRevocationListResult, error)
+
+	// GetClaim request
+	GetClaimWithResponse(ctx context.Context, identifier PathIdentifier, id PathClaim, reqEditors ...RequestEditorFn) (*GetClaimResult, error)
+
+	// RenewClaimOffer request with any body
+	RenewClaimOfferWithBodyWithResponse(ctx context.Context, identifier PathIdentifier, id PathClaim, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RenewClaimOfferResult, error)
+
+	RenewClaimOfferWithResponse(ctx context.Context, identifier PathIdentifier, id PathClaim, body RenewClaimOfferJSONRequestBody, reqEditors ...RequestEditorFn) (*RenewClaimOfferResult, error)
+
+	// GetClaimQrCode request
+	GetClaimQrCodeWithResponse(ctx context.Context, identifier PathIdentifier, id PathClaim, reqEditors ...RequestEditorFn) (*GetClaimQrCodeResult, error)
+
+	// GetClaimWalletLinks request
+	GetClaimWalletLinksWithResponse(ctx context.Context, identifier PathIdentifier, id PathClaim, reqEditors ...RequestEditorFn) (*GetClaimWalletLinksResult, error)
+
+	// ResolveDIDDocument request
+	ResolveDIDDocumentWithResponse(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*ResolveDIDDocumentResult, error)
+
+	// GetDIDServices request
+	GetDIDServicesWithResponse(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*GetDIDServicesResult, error)
+
+	// DeleteDIDService request
+	DeleteDIDServiceWithResponse(ctx context.Context, identifier PathIdentifier, serviceId PathDIDServiceID, reqEditors ...RequestEditorFn) (*DeleteDIDServiceResult, error)
+
+	// SetDIDService request with any body
+	SetDIDServiceWithBodyWithResponse(ctx context.Context, identifier PathIdentifier, serviceId PathDIDServiceID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetDIDServiceResult, error)
+
+	SetDIDServiceWithResponse(ctx context.Context, identifier PathIdentifier, serviceId PathDIDServiceID, body SetDIDServiceJSONRequestBody, reqEditors ...RequestEditorFn) (*SetDIDServiceResult, error)
+
+	// GetFeatureFlags request
+	GetFeatureFlagsWithResponse(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*GetFeatureFlagsResult, error)
+
+	// ResetFeatureFlag request
+	ResetFeatureFlagWithResponse(ctx context.Context, identifier PathIdentifier, feature PathFeature, reqEditors ...RequestEditorFn) (*ResetFeatureFlagResult, error)
+
+	// SetFeatureFlag request with any body
+	SetFeatureFlagWithBodyWithResponse(ctx context.Context, identifier PathIdentifier, feature PathFeature, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetFeatureFlagResult, error)
+
+	SetFeatureFlagWithResponse(ctx context.Context, identifier PathIdentifier, feature PathFeature, body SetFeatureFlagJSONRequestBody, reqEditors ...RequestEditorFn) (*SetFeatureFlagResult, error)
+
+	// GetJWTCredentialKeys request
+	GetJWTCredentialKeysWithResponse(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*GetJWTCredentialKeysResult, error)
+
+	// GetAuthKeys request
+	GetAuthKeysWithResponse(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*GetAuthKeysResult, error)
+
+	// RotateAuthKey request
+	RotateAuthKeyWithResponse(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*RotateAuthKeyResult, error)
+
+	// GetAgentMessages request
+	GetAgentMessagesWithResponse(ctx context.Context, identifier PathIdentifier, params *GetAgentMessagesParams, reqEditors ...RequestEditorFn) (*GetAgentMessagesResult, error)
+
+	// GetAgentMessageEnvelope request
+	GetAgentMessageEnvelopeWithResponse(ctx context.Context, identifier PathIdentifier, id PathAgentMessage, reqEditors ...RequestEditorFn) (*GetAgentMessageEnvelopeResult, error)
+
+	// AbortIdentityMigration request
+	AbortIdentityMigrationWithResponse(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*AbortIdentityMigrationResult, error)
+
+	// GetIdentityMigration request
+	GetIdentityMigrationWithResponse(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*GetIdentityMigrationResult, error)
+
+	// StartIdentityMigration request with any body
+	StartIdentityMigrationWithBodyWithResponse(ctx context.Context, identifier PathIdentifier, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StartIdentityMigrationResult, error)
+
+	StartIdentityMigrationWithResponse(ctx context.Context, identifier PathIdentifier, body StartIdentityMigrationJSONRequestBody, reqEditors ...RequestEditorFn) (*StartIdentityMigrationResult, error)
+
+	// CompleteIdentityMigration request
+	CompleteIdentityMigrationWithResponse(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*CompleteIdentityMigrationResult, error)
+
+	// ExportIdentity request
+	ExportIdentityWithResponse(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*ExportIdentityResult, error)
+
+	// CreateOID4VCIOffer request with any body
+	CreateOID4VCIOfferWithBodyWithResponse(ctx context.Context, identifier PathIdentifier, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOID4VCIOfferResult, error)
+
+	CreateOID4VCIOfferWithResponse(ctx context.Context, identifier PathIdentifier, body CreateOID4VCIOfferJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOID4VCIOfferResult, error)
+
+	// ResetPublishingPolicy request
+	ResetPublishingPolicyWithResponse(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*ResetPublishingPolicyResult, error)
+
+	// GetPublishingPolicy request
+	GetPublishingPolicyWithResponse(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*GetPublishingPolicyResult, error)
+
+	// SetPublishingPolicy request with any body
+	SetPublishingPolicyWithBodyWithResponse(ctx context.Context, identifier PathIdentifier, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetPublishingPolicyResult, error)
+
+	SetPublishingPolicyWithResponse(ctx context.Context, identifier PathIdentifier, body SetPublishingPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*SetPublishingPolicyResult, error)
+
+	// ApplyRevocationDecisions request with any body
+	ApplyRevocationDecisionsWithBodyWithResponse(ctx context.Context, identifier PathIdentifier, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApplyRevocationDecisionsResult, error)
+
+	ApplyRevocationDecisionsWithResponse(ctx context.Context, identifier PathIdentifier, body ApplyRevocationDecisionsJSONRequestBody, reqEditors ...RequestEditorFn) (*ApplyRevocationDecisionsResult, error)
+
+	// GetRevocationDecisionsReport request
+	GetRevocationDecisionsReportWithResponse(ctx context.Context, identifier PathIdentifier, params *GetRevocationDecisionsReportParams, reqEditors ...RequestEditorFn) (*GetRevocationDecisionsReportResult, error)
+
+	// GetStateIntegrity request
+	GetStateIntegrityWithResponse(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*GetStateIntegrityResult, error)
+
+	// PublishIdentityState request
+	PublishIdentityStateWithResponse(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*PublishIdentityStateResult, error)
+
+	// GetStateTransactions request
+	GetStateTransactionsWithResponse(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*GetStateTransactionsResult, error)
+
+	// GetStatusListCredential request
+	GetStatusListCredentialWithResponse(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*GetStatusListCredentialResult, error)
+
+	// CreateVerificationRequest request with any body
+	CreateVerificationRequestWithBodyWithResponse(ctx context.Context, identifier PathIdentifier, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateVerificationRequestResult, error)
+
+	CreateVerificationRequestWithResponse(ctx context.Context, identifier PathIdentifier, body CreateVerificationRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateVerificationRequestResult, error)
+
+	// GetVerificationRequest request
+	GetVerificationRequestWithResponse(ctx context.Context, identifier PathIdentifier, id PathVerificationRequest, reqEditors ...RequestEditorFn) (*GetVerificationRequestResult, error)
+
+	// GetVerificationRequestQrCode request
+	GetVerificationRequestQrCodeWithResponse(ctx context.Context, identifier PathIdentifier, id PathVerificationRequest, reqEditors ...RequestEditorFn) (*GetVerificationRequestQrCodeResult, error)
+
+	// GetVerificationResponses request
+	GetVerificationResponsesWithResponse(ctx context.Context, identifier PathIdentifier, params *GetVerificationResponsesParams, reqEditors ...RequestEditorFn) (*GetVerificationResponsesResult, error)
+
+	// GetHeldCredentials request
+	GetHeldCredentialsWithResponse(ctx context.Context, identifier PathIdentifier, params *GetHeldCredentialsParams, reqEditors ...RequestEditorFn) (*GetHeldCredentialsResult, error)
+
+	// DeleteHeldCredential request
+	DeleteHeldCredentialWithResponse(ctx context.Context, identifier PathIdentifier, id PathHeldCredential, reqEditors ...RequestEditorFn) (*DeleteHeldCredentialResult, error)
+
+	// GetHeldCredential request
+	GetHeldCredentialWithResponse(ctx context.Context, identifier PathIdentifier, id PathHeldCredential, reqEditors ...RequestEditorFn) (*GetHeldCredentialResult, error)
+
+	// AcceptCredentialOffer request with any body
+	AcceptCredentialOfferWithBodyWithResponse(ctx context.Context, identifier PathIdentifier, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AcceptCredentialOfferResult, error)
+
+	AcceptCredentialOfferWithResponse(ctx context.Context, identifier PathIdentifier, body AcceptCredentialOfferJSONRequestBody, reqEditors ...RequestEditorFn) (*AcceptCredentialOfferResult, error)
+
+	// PresentCredentials request with any body
+	PresentCredentialsWithBodyWithResponse(ctx context.Context, identifier PathIdentifier, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PresentCredentialsResult, error)
+
+	PresentCredentialsWithResponse(ctx context.Context, identifier PathIdentifier, body PresentCredentialsJSONRequestBody, reqEditors ...RequestEditorFn) (*PresentCredentialsResult, error)
+}
+
+type GetDocumentationResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDocumentationResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDocumentationResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAgentCapabilitiesResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AgentCapabilities
+	JSON500      *GenericErrorMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAgentCapabilitiesResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAgentCapabilitiesResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOID4VCIIssuerMetadataResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OID4VCIIssuerMetadata
+	JSON500      *GenericErrorMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOID4VCIIssuerMetadataResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOID4VCIIssuerMetadataResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetFaviconResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetFaviconResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetFaviconResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetYamlResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetYamlResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetYamlResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HealthResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Health
+	JSON500      *GenericErrorMessage
+	JSON503      *Health
+}
+
+// Status returns HTTPResponse.Status
+func (r HealthResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HealthResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExportIssuerResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *GenericErrorMessage
+	JSON401      *GenericErrorMessage
+	JSON404      *GenericErrorMessage
+	JSON409      *GenericErrorMessage
+	JSON500      *GenericErrorMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportIssuerResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportIssuerResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ResetSettingsResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Settings
+	JSON401      *GenericErrorMessage
+	JSON500      *GenericErrorMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r ResetSettingsResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ResetSettingsResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSettingsResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Settings
+	JSON401      *GenericErrorMessage
+	JSON500      *GenericErrorMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSettingsResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSettingsResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateSettingsResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Settings
+	JSON400      *GenericErrorMessage
+	JSON401      *GenericErrorMessage
+	JSON500      *GenericErrorMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateSettingsResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateSettingsResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AgentResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AgentResponse
+	JSON400      *AgentProblemReport
+	JSON401      *AgentProblemReport
+	JSON409      *AgentProblemReport
+	JSON410      *AgentProblemReport
+	JSON500      *GenericErrorMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r AgentResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AgentResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetIdentitiesResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]string
+	JSON401      *GenericErrorMessage
+	JSON500      *GenericErrorMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r GetIdentitiesResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetIdentitiesResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateIdentityResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *CreateIdentityResponse
+	JSON400      *GenericErrorMessage
+	JSON401      *GenericErrorMessage
+	JSON500      *struct {
+		Code      *int    `json:"code,omitempty"`
+		Error     *string `json:"error,omitempty"`
+		RequestID *string `json:"requestID,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateIdentityResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateIdentityResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ImportIdentityResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ImportIdentityResponse
+	JSON400      *GenericErrorMessage
+	JSON401      *GenericErrorMessage
+	JSON409      *GenericErrorMessage
+	JSON422      *GenericErrorMessage
+	JSON500      *GenericErrorMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r ImportIdentityResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ImportIdentityResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type OID4VCICredentialResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OID4VCICredentialResponse
+	JSON400      *OID4VCIError
+	JSON401      *OID4VCIError
+	JSON500      *GenericErrorMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r OID4VCICredentialResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r OID4VCICredentialResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type OID4VCITokenResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OID4VCITokenResponse
+	JSON400      *OID4VCIError
+	JSON500      *GenericErrorMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r OID4VCITokenResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r OID4VCITokenResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PurgeCachedDocumentResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GenericMessage
+	JSON400      *GenericErrorMessage
+	JSON401      *GenericErrorMessage
+	JSON404      *GenericErrorMessage
+	JSON500      *GenericErrorMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r PurgeCachedDocumentResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PurgeCachedDocumentResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RefreshCachedDocumentResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CachedDocument
+	JSON400      *GenericErrorMessage
+	JSON401      *GenericErrorMessage
+	JSON422      *GenericErrorMessage
+	JSON500      *GenericErrorMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r RefreshCachedDocumentResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RefreshCachedDocumentResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type VerificationCallbackResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *VerificationResponse
+	JSON400      *GenericErrorMessage
+	JSON404      *GenericErrorMessage
+	JSON410      *GenericErrorMessage
+	JSON500      *GenericErrorMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r VerificationCallbackResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r VerificationCallbackResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetClaimsResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetClaimsResponse
+	JSON400      *GenericErrorMessage
+	JSON401      *GenericErrorMessage
+	JSON500      *GenericErrorMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r GetClaimsResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetClaimsResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateClaimResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *CreateClaimResponse
+	JSON400      *CredentialErrorMessage
+	JSON401      *GenericErrorMessage
+	JSON409      *GenericErrorMessage
+	JSON422      *GenericErrorMessage
+	JSON500      *GenericErrorMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateClaimResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateClaimResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetClaimByNonceResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetClaimResponse
+	JSON400      *GenericErrorMessage
+	JSON401      *GenericErrorMessage
+	JSON404      *GenericErrorMessage
+	JSON500      *GenericErrorMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r GetClaimByNonceResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetClaimByNonceResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetRevocationStatusResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RevocationStatusResponse
+	JSON400      *GenericErrorMessage
+	JSON500      *GenericErrorMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRevocationStatusResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRevocationStatusResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RevokeClaimResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *RevokeClaimResponse
+	JSON400      *GenericErrorMessage
+	JSON401      *GenericErrorMessage
+	JSON404      *GenericErrorMessage
+	JSON409      *GenericErrorMessage
+	JSON422      *GenericErrorMessage
+	JSON500      *GenericErrorMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r RevokeClaimResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RevokeClaimResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetRevocationListResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RevocationList
+	JSON400      *GenericErrorMessage
+	JSON422      *GenericErrorMessage
+	JSON500      *GenericErrorMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRevocationListResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRevocationListResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetClaimResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetClaimResponse
+	JSON400      *GenericErrorMessage
+	JSON401      *GenericErrorMessage
+	JSON404      *GenericErrorMessage
+	JSON500      *GenericErrorMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r GetClaimResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetClaimResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RenewClaimOfferResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *GetClaimQrCodeResponse
+	JSON400      *GenericErrorMessage
+	JSON401      *GenericErrorMessage
+	JSON404      *GenericErrorMessage
+	JSON500      *GenericErrorMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r RenewClaimOfferResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RenewClaimOfferResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetClaimQrCodeResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetClaimQrCodeResponse
+	JSON400      *GenericErrorMessage
+	JSON404      *GenericErrorMessage
+	JSON500      *GenericErrorMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r GetClaimQrCodeResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetClaimQrCodeResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetClaimWalletLinksResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WalletLinks
+	JSON400      *GenericErrorMessage
+	JSON404      *GenericErrorMessage
+	JSON500      *GenericErrorMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r GetClaimWalletLinksResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetClaimWalletLinksResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ResolveDIDDocumentResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DIDResolution
+	JSON400      *GenericErrorMessage
+	JSON404      *GenericErrorMessage
+	JSON500      *GenericErrorMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r ResolveDIDDocumentResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ResolveDIDDocumentResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDIDServicesResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]DIDService
+	JSON400      *GenericErrorMessage
+	JSON401      *GenericErrorMessage
+	JSON404      *GenericErrorMessage
+	JSON500      *GenericErrorMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDIDServicesResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDIDServicesResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteDIDServiceResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GenericMessage
+	JSON400      *GenericErrorMessage
+	JSON401      *GenericErrorMessage
+	JSON404      *GenericErrorMessage
+	JSON500      *GenericErrorMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteDIDServiceResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteDIDServiceResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetDIDServiceResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DIDService
+	JSON400      *GenericErrorMessage
+	JSON401      *GenericErrorMessage
+	JSON404      *GenericErrorMessage
+	JSON500      *GenericErrorMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r SetDIDServiceResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetDIDServiceResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetFeatureFlagsResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]FeatureFlag
+	JSON400      *GenericErrorMessage
+	JSON401      *GenericErrorMessage
+	JSON500      *GenericErrorMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r GetFeatureFlagsResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetFeatureFlagsResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ResetFeatureFlagResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FeatureFlag
+	JSON400      *GenericErrorMessage
+	JSON401      *GenericErrorMessage
+	JSON500      *GenericErrorMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r ResetFeatureFlagResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ResetFeatureFlagResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetFeatureFlagResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FeatureFlag
+	JSON400      *GenericErrorMessage
+	JSON401      *GenericErrorMessage
+	JSON404      *GenericErrorMessage
+	JSON500      *GenericErrorMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r SetFeatureFlagResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetFeatureFlagResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetJWTCredentialKeysResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *JSONWebKeySet
+	JSON400      *GenericErrorMessage
+	JSON404      *GenericErrorMessage
+	JSON500      *GenericErrorMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r GetJWTCredentialKeysResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetJWTCredentialKeysResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAuthKeysResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]AuthKey
+	JSON400      *GenericErrorMessage
+	JSON401      *GenericErrorMessage
+	JSON404      *GenericErrorMessage
+	JSON500      *GenericErrorMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAuthKeysResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAuthKeysResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RotateAuthKeyResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *RotateAuthKeyResponse
+	JSON400      *GenericErrorMessage
+	JSON401      *GenericErrorMessage
+	JSON404      *GenericErrorMessage
+	JSON409      *GenericErrorMessage
+	JSON500      *GenericErrorMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r RotateAuthKeyResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RotateAuthKeyResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAgentMessagesResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]AgentInboxMessage
+	JSON400      *GenericErrorMessage
+	JSON401      *GenericErrorMessage
+	JSON500      *GenericErrorMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAgentMessagesResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAgentMessagesResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAgentMessageEnvelopeResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *GenericErrorMessage
+	JSON401      *GenericErrorMessage
+	JSON404      *GenericErrorMessage
+	JSON500      *GenericErrorMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAgentMessageEnvelopeResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAgentMessageEnvelopeResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AbortIdentityMigrationResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GenericMessage
+	JSON400      *GenericErrorMessage
+	JSON401      *GenericErrorMessage
+	JSON404      *GenericErrorMessage
+	JSON409      *GenericErrorMessage
+	JSON500      *GenericErrorMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r AbortIdentityMigrationResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AbortIdentityMigrationResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetIdentityMigrationResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *IdentityMigration
+	JSON400      *GenericErrorMessage
+	JSON401      *GenericErrorMessage
+	JSON404      *GenericErrorMessage
+	JSON500      *GenericErrorMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r GetIdentityMigrationResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetIdentityMigrationResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StartIdentityMigrationResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *IdentityMigration
+	JSON400      *GenericErrorMessage
+	JSON401      *GenericErrorMessage
+	JSON404      *GenericErrorMessage
+	JSON409      *GenericErrorMessage
+	JSON500      *GenericErrorMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r StartIdentityMigrationResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StartIdentityMigrationResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CompleteIdentityMigrationResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *IdentityMigration
+	JSON400      *GenericErrorMessage
+	JSON401      *GenericErrorMessage
+	JSON404      *GenericErrorMessage
+	JSON500      *GenericErrorMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r CompleteIdentityMigrationResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CompleteIdentityMigrationResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExportIdentityResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *IdentityExport
+	JSON400      *GenericErrorMessage
+	JSON401      *GenericErrorMessage
+	JSON404      *GenericErrorMessage
+	JSON409      *GenericErrorMessage
+	JSON500      *GenericErrorMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportIdentityResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportIdentityResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateOID4VCIOfferResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *OID4VCIOffer
+	JSON400      *GenericErrorMessage
+	JSON401      *GenericErrorMessage
+	JSON404      *GenericErrorMessage
+	JSON500      *GenericErrorMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateOID4VCIOfferResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateOID4VCIOfferResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ResetPublishingPolicyResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PublishingPolicy
+	JSON400      *GenericErrorMessage
+	JSON401      *GenericErrorMessage
+	JSON500      *GenericErrorMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r ResetPublishingPolicyResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ResetPublishingPolicyResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPublishingPolicyResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PublishingPolicy
+	JSON400      *GenericErrorMessage
+	JSON401      *GenericErrorMessage
+	JSON500      *GenericErrorMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPublishingPolicyResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPublishingPolicyResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetPublishingPolicyResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PublishingPolicy
+	JSON400      *GenericErrorMessage
+	JSON401      *GenericErrorMessage
+	JSON404      *GenericErrorMessage
+	JSON500      *GenericErrorMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r SetPublishingPolicyResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetPublishingPolicyResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApplyRevocationDecisionsResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RevocationDecisionsReport
+	JSON400      *GenericErrorMessage
+	JSON401      *GenericErrorMessage
+	JSON404      *GenericErrorMessage
+	JSON500      *GenericErrorMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r ApplyRevocationDecisionsResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApplyRevocationDecisionsResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetRevocationDecisionsReportResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RevocationDecisionsReport
+	JSON400      *GenericErrorMessage
+	JSON401      *GenericErrorMessage
+	JSON500      *GenericErrorMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRevocationDecisionsReportResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRevocationDecisionsReportResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetStateIntegrityResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StateIntegrityReport
+	JSON400      *GenericErrorMessage
+	JSON401      *GenericErrorMessage
+	JSON404      *GenericErrorMessage
+	JSON500      *GenericErrorMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r GetStateIntegrityResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetStateIntegrityResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PublishIdentityStateResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GenericErrorMessage
+	JSON202      *PublishIdentityStateResponse
+	JSON400      *GenericErrorMessage
+	JSON401      *GenericErrorMessage
+	JSON500      *GenericErrorMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r PublishIdentityStateResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PublishIdentityStateResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetStateTransactionsResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]StateTransaction
+	JSON400      *GenericErrorMessage
+	JSON401      *GenericErrorMessage
+	JSON500      *GenericErrorMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r GetStateTransactionsResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetStateTransactionsResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetStatusListCredentialResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *GenericErrorMessage
+	JSON404      *GenericErrorMessage
+	JSON500      *GenericErrorMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r GetStatusListCredentialResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetStatusListCredentialResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateVerificationRequestResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *VerificationRequest
+	JSON400      *GenericErrorMessage
+	JSON401      *GenericErrorMessage
+	JSON404      *GenericErrorMessage
+	JSON500      *GenericErrorMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateVerificationRequestResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateVerificationRequestResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetVerificationRequestResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *VerificationRequest
+	JSON400      *GenericErrorMessage
+	JSON401      *GenericErrorMessage
+	JSON404      *GenericErrorMessage
+	JSON500      *GenericErrorMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r GetVerificationRequestResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetVerificationRequestResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetVerificationRequestQrCodeResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuthorizationRequest
+	JSON400      *GenericErrorMessage
+	JSON401      *GenericErrorMessage
+	JSON404      *GenericErrorMessage
+	JSON410      *GenericErrorMessage
+	JSON500      *GenericErrorMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r GetVerificationRequestQrCodeResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetVerificationRequestQrCodeResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetVerificationResponsesResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]VerificationResponse
+	JSON400      *GenericErrorMessage
+	JSON401      *GenericErrorMessage
+	JSON500      *GenericErrorMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r GetVerificationResponsesResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetVerificationResponsesResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetHeldCredentialsResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetHeldCredentialsResponse
+	JSON400      *GenericErrorMessage
+	JSON401      *GenericErrorMessage
+	JSON500      *GenericErrorMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r GetHeldCredentialsResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetHeldCredentialsResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteHeldCredentialResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GenericErrorMessage
+	JSON400      *GenericErrorMessage
+	JSON401      *GenericErrorMessage
+	JSON404      *GenericErrorMessage
+	JSON500      *GenericErrorMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteHeldCredentialResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteHeldCredentialResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetHeldCredentialResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HeldCredential
+	JSON400      *GenericErrorMessage
+	JSON401      *GenericErrorMessage
+	JSON404      *GenericErrorMessage
+	JSON500      *GenericErrorMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r GetHeldCredentialResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetHeldCredentialResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AcceptCredentialOfferResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *GetHeldCredentialsResponse
+	JSON400      *GenericErrorMessage
+	JSON401      *GenericErrorMessage
+	JSON404      *GenericErrorMessage
+	JSON500      *GenericErrorMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r AcceptCredentialOfferResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AcceptCredentialOfferResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PresentCredentialsResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GenericErrorMessage
+	JSON400      *GenericErrorMessage
+	JSON401      *GenericErrorMessage
+	JSON404      *GenericErrorMessage
+	JSON500      *GenericErrorMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r PresentCredentialsResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PresentCredentialsResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetDocumentationWithResponse request returning *GetDocumentationResult
+func (c *ClientWithResponses) GetDocumentationWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDocumentationResult, error) {
+	rsp, err := c.GetDocumentation(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDocumentationResult(rsp)
+}
+
+// GetAgentCapabilitiesWithResponse request returning *GetAgentCapabilitiesResult
+func (c *ClientWithResponses) GetAgentCapabilitiesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAgentCapabilitiesResult, error) {
+	rsp, err := c.GetAgentCapabilities(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAgentCapabilitiesResult(rsp)
+}
+
+// GetOID4VCIIssuerMetadataWithResponse request returning *GetOID4VCIIssuerMetadataResult
+func (c *ClientWithResponses) GetOID4VCIIssuerMetadataWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOID4VCIIssuerMetadataResult, error) {
+	rsp, err := c.GetOID4VCIIssuerMetadata(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOID4VCIIssuerMetadataResult(rsp)
+}
+
+// GetFaviconWithResponse request returning *GetFaviconResult
+func (c *ClientWithResponses) GetFaviconWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetFaviconResult, error) {
+	rsp, err := c.GetFavicon(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetFaviconResult(rsp)
+}
+
+// GetYamlWithResponse request returning *GetYamlResult
+func (c *ClientWithResponses) GetYamlWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetYamlResult, error) {
+	rsp, err := c.GetYaml(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetYamlResult(rsp)
+}
+
+// HealthWithResponse request returning *HealthResult
+func (c *ClientWithResponses) HealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthResult, error) {
+	rsp, err := c.Health(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHealthResult(rsp)
+}
+
+// ExportIssuerWithBodyWithResponse request with arbitrary body returning *ExportIssuerResult
+func (c *ClientWithResponses) ExportIssuerWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ExportIssuerResult, error) {
+	rsp, err := c.ExportIssuerWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportIssuerResult(rsp)
+}
+
+func (c *ClientWithResponses) ExportIssuerWithResponse(ctx context.Context, body ExportIssuerJSONRequestBody, reqEditors ...RequestEditorFn) (*ExportIssuerResult, error) {
+	rsp, err := c.ExportIssuer(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportIssuerResult(rsp)
+}
+
+// ResetSettingsWithResponse request returning *ResetSettingsResult
+func (c *ClientWithResponses) ResetSettingsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ResetSettingsResult, error) {
+	rsp, err := c.ResetSettings(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseResetSettingsResult(rsp)
+}
+
+// GetSettingsWithResponse request returning *GetSettingsResult
+func (c *ClientWithResponses) GetSettingsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSettingsResult, error) {
+	rsp, err := c.GetSettings(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSettingsResult(rsp)
+}
+
+// UpdateSettingsWithBodyWithResponse request with arbitrary body returning *UpdateSettingsResult
+func (c *ClientWithResponses) UpdateSettingsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSettingsResult, error) {
+	rsp, err := c.UpdateSettingsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateSettingsResult(rsp)
+}
+
+func (c *ClientWithResponses) UpdateSettingsWithResponse(ctx context.Context, body UpdateSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSettingsResult, error) {
+	rsp, err := c.UpdateSettings(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateSettingsResult(rsp)
+}
+
+// AgentWithBodyWithResponse request with arbitrary body returning *AgentResult
+func (c *ClientWithResponses) AgentWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AgentResult, error) {
+	rsp, err := c.AgentWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAgentResult(rsp)
+}
+
+func (c *ClientWithResponses) AgentWithTextBodyWithResponse(ctx context.Context, body AgentTextRequestBody, reqEditors ...RequestEditorFn) (*AgentResult, error) {
+	rsp, err := c.AgentWithTextBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAgentResult(rsp)
+}
+
+// GetIdentitiesWithResponse request returning *GetIdentitiesResult
+func (c *ClientWithResponses) GetIdentitiesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetIdentitiesResult, error) {
+	rsp, err := c.GetIdentities(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetIdentitiesResult(rsp)
+}
+
+// CreateIdentityWithBodyWithResponse request with arbitrary body returning *CreateIdentityResult
+func (c *ClientWithResponses) CreateIdentityWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateIdentityResult, error) {
+	rsp, err := c.CreateIdentityWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateIdentityResult(rsp)
+}
+
+func (c *ClientWithResponses) CreateIdentityWithResponse(ctx context.Context, body CreateIdentityJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateIdentityResult, error) {
+	rsp, err := c.CreateIdentity(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateIdentityResult(rsp)
+}
+
+// ImportIdentityWithBodyWithResponse request with arbitrary body returning *ImportIdentityResult
+func (c *ClientWithResponses) ImportIdentityWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportIdentityResult, error) {
+	rsp, err := c.ImportIdentityWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportIdentityResult(rsp)
+}
+
+func (c *ClientWithResponses) ImportIdentityWithResponse(ctx context.Context, body ImportIdentityJSONRequestBody, reqEditors ...RequestEditorFn) (*ImportIdentityResult, error) {
+	rsp, err := c.ImportIdentity(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportIdentityResult(rsp)
+}
+
+// OID4VCICredentialWithBodyWithResponse request with arbitrary body returning *OID4VCICredentialResult
+func (c *ClientWithResponses) OID4VCICredentialWithBodyWithResponse(ctx context.Context, params *OID4VCICredentialParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*OID4VCICredentialResult, error) {
+	rsp, err := c.OID4VCICredentialWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseOID4VCICredentialResult(rsp)
+}
+
+func (c *ClientWithResponses) OID4VCICredentialWithResponse(ctx context.Context, params *OID4VCICredentialParams, body OID4VCICredentialJSONRequestBody, reqEditors ...RequestEditorFn) (*OID4VCICredentialResult, error) {
+	rsp, err := c.OID4VCICredential(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseOID4VCICredentialResult(rsp)
+}
+
+// OID4VCITokenWithBodyWithResponse request with arbitrary body returning *OID4VCITokenResult
+func (c *ClientWithResponses) OID4VCITokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*OID4VCITokenResult, error) {
+	rsp, err := c.OID4VCITokenWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseOID4VCITokenResult(rsp)
+}
+
+func (c *ClientWithResponses) OID4VCITokenWithFormdataBodyWithResponse(ctx context.Context, body OID4VCITokenFormdataRequestBody, reqEditors ...RequestEditorFn) (*OID4VCITokenResult, error) {
+	rsp, err := c.OID4VCITokenWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseOID4VCITokenResult(rsp)
+}
+
+// PurgeCachedDocumentWithResponse request returning *PurgeCachedDocumentResult
+func (c *ClientWithResponses) PurgeCachedDocumentWithResponse(ctx context.Context, params *PurgeCachedDocumentParams, reqEditors ...RequestEditorFn) (*PurgeCachedDocumentResult, error) {
+	rsp, err := c.PurgeCachedDocument(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePurgeCachedDocumentResult(rsp)
+}
+
+// RefreshCachedDocumentWithBodyWithResponse request with arbitrary body returning *RefreshCachedDocumentResult
+func (c *ClientWithResponses) RefreshCachedDocumentWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RefreshCachedDocumentResult, error) {
+	rsp, err := c.RefreshCachedDocumentWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRefreshCachedDocumentResult(rsp)
+}
+
+func (c *ClientWithResponses) RefreshCachedDocumentWithResponse(ctx context.Context, body RefreshCachedDocumentJSONRequestBody, reqEditors ...RequestEditorFn) (*RefreshCachedDocumentResult, error) {
+	rsp, err := c.RefreshCachedDocument(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRefreshCachedDocumentResult(rsp)
+}
+
+// VerificationCallbackWithBodyWithResponse request with arbitrary body returning *VerificationCallbackResult
+func (c *ClientWithResponses) VerificationCallbackWithBodyWithResponse(ctx context.Context, params *VerificationCallbackParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*VerificationCallbackResult, error) {
+	rsp, err := c.VerificationCallbackWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVerificationCallbackResult(rsp)
+}
+
+func (c *ClientWithResponses) VerificationCallbackWithTextBodyWithResponse(ctx context.Context, params *VerificationCallbackParams, body VerificationCallbackTextRequestBody, reqEditors ...RequestEditorFn) (*VerificationCallbackResult, error) {
+	rsp, err := c.VerificationCallbackWithTextBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVerificationCallbackResult(rsp)
+}
+
+// GetClaimsWithResponse request returning *GetClaimsResult
+func (c *ClientWithResponses) GetClaimsWithResponse(ctx context.Context, identifier PathIdentifier, params *GetClaimsParams, reqEditors ...RequestEditorFn) (*GetClaimsResult, error) {
+	rsp, err := c.GetClaims(ctx, identifier, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetClaimsResult(rsp)
+}
+
+// CreateClaimWithBodyWithResponse request with arbitrary body returning *CreateClaimResult
+func (c *ClientWithResponses) CreateClaimWithBodyWithResponse(ctx context.Context, identifier PathIdentifier, params *CreateClaimParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateClaimResult, error) {
+	rsp, err := c.CreateClaimWithBody(ctx, identifier, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateClaimResult(rsp)
+}
+
+func (c *ClientWithResponses) CreateClaimWithResponse(ctx context.Context, identifier PathIdentifier, params *CreateClaimParams, body CreateClaimJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateClaimResult, error) {
+	rsp, err := c.CreateClaim(ctx, identifier, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateClaimResult(rsp)
+}
+
+// GetClaimByNonceWithResponse request returning *GetClaimByNonceResult
+func (c *ClientWithResponses) GetClaimByNonceWithResponse(ctx context.Context, identifier PathIdentifier, nonce PathNonce, reqEditors ...RequestEditorFn) (*GetClaimByNonceResult, error) {
+	rsp, err := c.GetClaimByNonce(ctx, identifier, nonce, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetClaimByNonceResult(rsp)
+}
+
+// GetRevocationStatusWithResponse request returning *GetRevocationStatusResult
+func (c *ClientWithResponses) GetRevocationStatusWithResponse(ctx context.Context, identifier PathIdentifier, nonce PathNonce, reqEditors ...RequestEditorFn) (*GetRevocationStatusResult, error) {
+	rsp, err := c.GetRevocationStatus(ctx, identifier, nonce, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRevocationStatusResult(rsp)
+}
+
+// RevokeClaimWithResponse request returning *RevokeClaimResult
+func (c *ClientWithResponses) RevokeClaimWithResponse(ctx context.Context, identifier PathIdentifier, nonce PathNonce, params *RevokeClaimParams, reqEditors ...RequestEditorFn) (*RevokeClaimResult, error) {
+	rsp, err := c.RevokeClaim(ctx, identifier, nonce, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRevokeClaimResult(rsp)
+}
+
+// GetRevocationListWithResponse request returning *GetRevocationListResult
+func (c *ClientWithResponses) GetRevocationListWithResponse(ctx context.Context, identifier PathIdentifier, params *GetRevocationListParams, reqEditors ...RequestEditorFn) (*GetRevocationListResult, error) {
+	rsp, err := c.GetRevocationList(ctx, identifier, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRevocationListResult(rsp)
+}
+
+// GetClaimWithResponse request returning *GetClaimResult
+func (c *ClientWithResponses) GetClaimWithResponse(ctx context.Context, identifier PathIdentifier, id PathClaim, reqEditors ...RequestEditorFn) (*GetClaimResult, error) {
+	rsp, err := c.GetClaim(ctx, identifier, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetClaimResult(rsp)
+}
+
+// RenewClaimOfferWithBodyWithResponse request with arbitrary body returning *RenewClaimOfferResult
+func (c *ClientWithResponses) RenewClaimOfferWithBodyWithResponse(ctx context.Context, identifier PathIdentifier, id PathClaim, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RenewClaimOfferResult, error) {
+	rsp, err := c.RenewClaimOfferWithBody(ctx, identifier, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRenewClaimOfferResult(rsp)
+}
+
+func (c *ClientWithResponses) RenewClaimOfferWithResponse(ctx context.Context, identifier PathIdentifier, id PathClaim, body RenewClaimOfferJSONRequestBody, reqEditors ...RequestEditorFn) (*RenewClaimOfferResult, error) {
+	rsp, err := c.RenewClaimOffer(ctx, identifier, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRenewClaimOfferResult(rsp)
+}
+
+// GetClaimQrCodeWithResponse request returning *GetClaimQrCodeResult
+func (c *ClientWithResponses) GetClaimQrCodeWithResponse(ctx context.Context, identifier PathIdentifier, id PathClaim, reqEditors ...RequestEditorFn) (*GetClaimQrCodeResult, error) {
+	rsp, err := c.GetClaimQrCode(ctx, identifier, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetClaimQrCodeResult(rsp)
+}
+
+// GetClaimWalletLinksWithResponse request returning *GetClaimWalletLinksResult
+func (c *ClientWithResponses) GetClaimWalletLinksWithResponse(ctx context.Context, identifier PathIdentifier, id PathClaim, reqEditors ...RequestEditorFn) (*GetClaimWalletLinksResult, error) {
+	rsp, err := c.GetClaimWalletLinks(ctx, identifier, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetClaimWalletLinksResult(rsp)
+}
+
+// ResolveDIDDocumentWithResponse request returning *ResolveDIDDocumentResult
+func (c *ClientWithResponses) ResolveDIDDocumentWithResponse(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*ResolveDIDDocumentResult, error) {
+	rsp, err := c.ResolveDIDDocument(ctx, identifier, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseResolveDIDDocumentResult(rsp)
+}
+
+// GetDIDServicesWithResponse request returning *GetDIDServicesResult
+func (c *ClientWithResponses) GetDIDServicesWithResponse(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*GetDIDServicesResult, error) {
+	rsp, err := c.GetDIDServices(ctx, identifier, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDIDServicesResult(rsp)
+}
+
+// DeleteDIDServiceWithResponse request returning *DeleteDIDServiceResult
+func (c *ClientWithResponses) DeleteDIDServiceWithResponse(ctx context.Context, identifier PathIdentifier, serviceId PathDIDServiceID, reqEditors ...RequestEditorFn) (*DeleteDIDServiceResult, error) {
+	rsp, err := c.DeleteDIDService(ctx, identifier, serviceId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteDIDServiceResult(rsp)
+}
+
+// SetDIDServiceWithBodyWithResponse request with arbitrary body returning *SetDIDServiceResult
+func (c *ClientWithResponses) SetDIDServiceWithBodyWithResponse(ctx context.Context, identifier PathIdentifier, serviceId PathDIDServiceID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetDIDServiceResult, error) {
+	rsp, err := c.SetDIDServiceWithBody(ctx, identifier, serviceId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetDIDServiceResult(rsp)
+}
+
+func (c *ClientWithResponses) SetDIDServiceWithResponse(ctx context.Context, identifier PathIdentifier, serviceId PathDIDServiceID, body SetDIDServiceJSONRequestBody, reqEditors ...RequestEditorFn) (*SetDIDServiceResult, error) {
+	rsp, err := c.SetDIDService(ctx, identifier, serviceId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetDIDServiceResult(rsp)
+}
+
+// GetFeatureFlagsWithResponse request returning *GetFeatureFlagsResult
+func (c *ClientWithResponses) GetFeatureFlagsWithResponse(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*GetFeatureFlagsResult, error) {
+	rsp, err := c.GetFeatureFlags(ctx, identifier, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetFeatureFlagsResult(rsp)
+}
+
+// ResetFeatureFlagWithResponse request returning *ResetFeatureFlagResult
+func (c *ClientWithResponses) ResetFeatureFlagWithResponse(ctx context.Context, identifier PathIdentifier, feature PathFeature, reqEditors ...RequestEditorFn) (*ResetFeatureFlagResult, error) {
+	rsp, err := c.ResetFeatureFlag(ctx, identifier, feature, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseResetFeatureFlagResult(rsp)
+}
+
+// SetFeatureFlagWithBodyWithResponse request with arbitrary body returning *SetFeatureFlagResult
+func (c *ClientWithResponses) SetFeatureFlagWithBodyWithResponse(ctx context.Context, identifier PathIdentifier, feature PathFeature, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetFeatureFlagResult, error) {
+	rsp, err := c.SetFeatureFlagWithBody(ctx, identifier, feature, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetFeatureFlagResult(rsp)
+}
+
+func (c *ClientWithResponses) SetFeatureFlagWithResponse(ctx context.Context, identifier PathIdentifier, feature PathFeature, body SetFeatureFlagJSONRequestBody, reqEditors ...RequestEditorFn) (*SetFeatureFlagResult, error) {
+	rsp, err := c.SetFeatureFlag(ctx, identifier, feature, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetFeatureFlagResult(rsp)
+}
+
+// GetJWTCredentialKeysWithResponse request returning *GetJWTCredentialKeysResult
+func (c *ClientWithResponses) GetJWTCredentialKeysWithResponse(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*GetJWTCredentialKeysResult, error) {
+	rsp, err := c.GetJWTCredentialKeys(ctx, identifier, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetJWTCredentialKeysResult(rsp)
+}
+
+// GetAuthKeysWithResponse request returning *GetAuthKeysResult
+func (c *ClientWithResponses) GetAuthKeysWithResponse(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*GetAuthKeysResult, error) {
+	rsp, err := c.GetAuthKeys(ctx, identifier, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAuthKeysResult(rsp)
+}
+
+// RotateAuthKeyWithResponse request returning *RotateAuthKeyResult
+func (c *ClientWithResponses) RotateAuthKeyWithResponse(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*RotateAuthKeyResult, error) {
+	rsp, err := c.RotateAuthKey(ctx, identifier, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRotateAuthKeyResult(rsp)
+}
+
+// GetAgentMessagesWithResponse request returning *GetAgentMessagesResult
+func (c *ClientWithResponses) GetAgentMessagesWithResponse(ctx context.Context, identifier PathIdentifier, params *GetAgentMessagesParams, reqEditors ...RequestEditorFn) (*GetAgentMessagesResult, error) {
+	rsp, err := c.GetAgentMessages(ctx, identifier, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAgentMessagesResult(rsp)
+}
+
+// GetAgentMessageEnvelopeWithResponse request returning *GetAgentMessageEnvelopeResult
+func (c *ClientWithResponses) GetAgentMessageEnvelopeWithResponse(ctx context.Context, identifier PathIdentifier, id PathAgentMessage, reqEditors ...RequestEditorFn) (*GetAgentMessageEnvelopeResult, error) {
+	rsp, err := c.GetAgentMessageEnvelope(ctx, identifier, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAgentMessageEnvelopeResult(rsp)
+}
+
+// AbortIdentityMigrationWithResponse request returning *AbortIdentityMigrationResult
+func (c *ClientWithResponses) AbortIdentityMigrationWithResponse(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*AbortIdentityMigrationResult, error) {
+	rsp, err := c.AbortIdentityMigration(ctx, identifier, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAbortIdentityMigrationResult(rsp)
+}
+
+// GetIdentityMigrationWithResponse request returning *GetIdentityMigrationResult
+func (c *ClientWithResponses) GetIdentityMigrationWithResponse(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*GetIdentityMigrationResult, error) {
+	rsp, err := c.GetIdentityMigration(ctx, identifier, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetIdentityMigrationResult(rsp)
+}
+
+// StartIdentityMigrationWithBodyWithResponse request with arbitrary body returning *StartIdentityMigrationResult
+func (c *ClientWithResponses) StartIdentityMigrationWithBodyWithResponse(ctx context.Context, identifier PathIdentifier, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StartIdentityMigrationResult, error) {
+	rsp, err := c.StartIdentityMigrationWithBody(ctx, identifier, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStartIdentityMigrationResult(rsp)
+}
+
+func (c *ClientWithResponses) StartIdentityMigrationWithResponse(ctx context.Context, identifier PathIdentifier, body StartIdentityMigrationJSONRequestBody, reqEditors ...RequestEditorFn) (*StartIdentityMigrationResult, error) {
+	rsp, err := c.StartIdentityMigration(ctx, identifier, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStartIdentityMigrationResult(rsp)
+}
+
+// CompleteIdentityMigrationWithResponse request returning *CompleteIdentityMigrationResult
+func (c *ClientWithResponses) CompleteIdentityMigrationWithResponse(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*CompleteIdentityMigrationResult, error) {
+	rsp, err := c.CompleteIdentityMigration(ctx, identifier, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCompleteIdentityMigrationResult(rsp)
+}
+
+// ExportIdentityWithResponse request returning *ExportIdentityResult
+func (c *ClientWithResponses) ExportIdentityWithResponse(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*ExportIdentityResult, error) {
+	rsp, err := c.ExportIdentity(ctx, identifier, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportIdentityResult(rsp)
+}
+
+// CreateOID4VCIOfferWithBodyWithResponse request with arbitrary body returning *CreateOID4VCIOfferResult
+func (c *ClientWithResponses) CreateOID4VCIOfferWithBodyWithResponse(ctx context.Context, identifier PathIdentifier, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOID4VCIOfferResult, error) {
+	rsp, err := c.CreateOID4VCIOfferWithBody(ctx, identifier, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateOID4VCIOfferResult(rsp)
+}
+
+func (c *ClientWithResponses) CreateOID4VCIOfferWithResponse(ctx context.Context, identifier PathIdentifier, body CreateOID4VCIOfferJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOID4VCIOfferResult, error) {
+	rsp, err := c.CreateOID4VCIOffer(ctx, identifier, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateOID4VCIOfferResult(rsp)
+}
+
+// ResetPublishingPolicyWithResponse request returning *ResetPublishingPolicyResult
+func (c *ClientWithResponses) ResetPublishingPolicyWithResponse(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*ResetPublishingPolicyResult, error) {
+	rsp, err := c.ResetPublishingPolicy(ctx, identifier, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseResetPublishingPolicyResult(rsp)
+}
+
+// GetPublishingPolicyWithResponse request returning *GetPublishingPolicyResult
+func (c *ClientWithResponses) GetPublishingPolicyWithResponse(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*GetPublishingPolicyResult, error) {
+	rsp, err := c.GetPublishingPolicy(ctx, identifier, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPublishingPolicyResult(rsp)
+}
+
+// SetPublishingPolicyWithBodyWithResponse request with arbitrary body returning *SetPublishingPolicyResult
+func (c *ClientWithResponses) SetPublishingPolicyWithBodyWithResponse(ctx context.Context, identifier PathIdentifier, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetPublishingPolicyResult, error) {
+	rsp, err := c.SetPublishingPolicyWithBody(ctx, identifier, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetPublishingPolicyResult(rsp)
+}
+
+func (c *ClientWithResponses) SetPublishingPolicyWithResponse(ctx context.Context, identifier PathIdentifier, body SetPublishingPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*SetPublishingPolicyResult, error) {
+	rsp, err := c.SetPublishingPolicy(ctx, identifier, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetPublishingPolicyResult(rsp)
+}
+
+// ApplyRevocationDecisionsWithBodyWithResponse request with arbitrary body returning *ApplyRevocationDecisionsResult
+func (c *ClientWithResponses) ApplyRevocationDecisionsWithBodyWithResponse(ctx context.Context, identifier PathIdentifier, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApplyRevocationDecisionsResult, error) {
+	rsp, err := c.ApplyRevocationDecisionsWithBody(ctx, identifier, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApplyRevocationDecisionsResult(rsp)
+}
+
+func (c *ClientWithResponses) ApplyRevocationDecisionsWithResponse(ctx context.Context, identifier PathIdentifier, body ApplyRevocationDecisionsJSONRequestBody, reqEditors ...RequestEditorFn) (*ApplyRevocationDecisionsResult, error) {
+	rsp, err := c.ApplyRevocationDecisions(ctx, identifier, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApplyRevocationDecisionsResult(rsp)
+}
+
+// GetRevocationDecisionsReportWithResponse request returning *GetRevocationDecisionsReportResult
+func (c *ClientWithResponses) GetRevocationDecisionsReportWithResponse(ctx context.Context, identifier PathIdentifier, params *GetRevocationDecisionsReportParams, reqEditors ...RequestEditorFn) (*GetRevocationDecisionsReportResult, error) {
+	rsp, err := c.GetRevocationDecisionsReport(ctx, identifier, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRevocationDecisionsReportResult(rsp)
+}
+
+// GetStateIntegrityWithResponse request returning *GetStateIntegrityResult
+func (c *ClientWithResponses) GetStateIntegrityWithResponse(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*GetStateIntegrityResult, error) {
+	rsp, err := c.GetStateIntegrity(ctx, identifier, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetStateIntegrityResult(rsp)
+}
+
+// PublishIdentityStateWithResponse request returning *PublishIdentityStateResult
+func (c *ClientWithResponses) PublishIdentityStateWithResponse(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*PublishIdentityStateResult, error) {
+	rsp, err := c.PublishIdentityState(ctx, identifier, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePublishIdentityStateResult(rsp)
+}
+
+// GetStateTransactionsWithResponse request returning *GetStateTransactionsResult
+func (c *ClientWithResponses) GetStateTransactionsWithResponse(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*GetStateTransactionsResult, error) {
+	rsp, err := c.GetStateTransactions(ctx, identifier, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetStateTransactionsResult(rsp)
+}
+
+// GetStatusListCredentialWithResponse request returning *GetStatusListCredentialResult
+func (c *ClientWithResponses) GetStatusListCredentialWithResponse(ctx context.Context, identifier PathIdentifier, reqEditors ...RequestEditorFn) (*GetStatusListCredentialResult, error) {
+	rsp, err := c.GetStatusListCredential(ctx, identifier, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetStatusListCredentialResult(rsp)
+}
+
+// CreateVerificationRequestWithBodyWithResponse request with arbitrary body returning *CreateVerificationRequestResult
+func (c *ClientWithResponses) CreateVerificationRequestWithBodyWithResponse(ctx context.Context, identifier PathIdentifier, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateVerificationRequestResult, error) {
+	rsp, err := c.CreateVerificationRequestWithBody(ctx, identifier, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateVerificationRequestResult(rsp)
+}
+
+func (c *ClientWithResponses) CreateVerificationRequestWithResponse(ctx context.Context, identifier PathIdentifier, body CreateVerificationRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateVerificationRequestResult, error) {
+	rsp, err := c.CreateVerificationRequest(ctx, identifier, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateVerificationRequestResult(rsp)
+}
+
+// GetVerificationRequestWithResponse request returning *GetVerificationRequestResult
+func (c *ClientWithResponses) GetVerificationRequestWithResponse(ctx context.Context, identifier PathIdentifier, id PathVerificationRequest, reqEditors ...RequestEditorFn) (*GetVerificationRequestResult, error) {
+	rsp, err := c.GetVerificationRequest(ctx, identifier, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetVerificationRequestResult(rsp)
+}
+
+// GetVerificationRequestQrCodeWithResponse request returning *GetVerificationRequestQrCodeResult
+func (c *ClientWithResponses) GetVerificationRequestQrCodeWithResponse(ctx context.Context, identifier PathIdentifier, id PathVerificationRequest, reqEditors ...RequestEditorFn) (*GetVerificationRequestQrCodeResult, error) {
+	rsp, err := c.GetVerificationRequestQrCode(ctx, identifier, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetVerificationRequestQrCodeResult(rsp)
+}
+
+// GetVerificationResponsesWithResponse request returning *GetVerificationResponsesResult
+func (c *ClientWithResponses) GetVerificationResponsesWithResponse(ctx context.Context, identifier PathIdentifier, params *GetVerificationResponsesParams, reqEditors ...RequestEditorFn) (*GetVerificationResponsesResult, error) {
+	rsp, err := c.GetVerificationResponses(ctx, identifier, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetVerificationResponsesResult(rsp)
+}
+
+// GetHeldCredentialsWithResponse request returning *GetHeldCredentialsResult
+func (c *ClientWithResponses) GetHeldCredentialsWithResponse(ctx context.Context, identifier PathIdentifier, params *GetHeldCredentialsParams, reqEditors ...RequestEditorFn) (*GetHeldCredentialsResult, error) {
+	rsp, err := c.GetHeldCredentials(ctx, identifier, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetHeldCredentialsResult(rsp)
+}
+
+// DeleteHeldCredentialWithResponse request returning *DeleteHeldCredentialResult
+func (c *ClientWithResponses) DeleteHeldCredentialWithResponse(ctx context.Context, identifier PathIdentifier, id PathHeldCredential, reqEditors ...RequestEditorFn) (*DeleteHeldCredentialResult, error) {
+	rsp, err := c.DeleteHeldCredential(ctx, identifier, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteHeldCredentialResult(rsp)
+}
+
+// GetHeldCredentialWithResponse request returning *GetHeldCredentialResult
+func (c *ClientWithResponses) GetHeldCredentialWithResponse(ctx context.Context, identifier PathIdentifier, id PathHeldCredential, reqEditors ...RequestEditorFn) (*GetHeldCredentialResult, error) {
+	rsp, err := c.GetHeldCredential(ctx, identifier, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetHeldCredentialResult(rsp)
+}
+
+// AcceptCredentialOfferWithBodyWithResponse request with arbitrary body returning *AcceptCredentialOfferResult
+func (c *ClientWithResponses) AcceptCredentialOfferWithBodyWithResponse(ctx context.Context, identifier PathIdentifier, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AcceptCredentialOfferResult, error) {
+	rsp, err := c.AcceptCredentialOfferWithBody(ctx, identifier, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAcceptCredentialOfferResult(rsp)
+}
+
+func (c *ClientWithResponses) AcceptCredentialOfferWithResponse(ctx context.Context, identifier PathIdentifier, body AcceptCredentialOfferJSONRequestBody, reqEditors ...RequestEditorFn) (*AcceptCredentialOfferResult, error) {
+	rsp, err := c.AcceptCredentialOffer(ctx, identifier, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAcceptCredentialOfferResult(rsp)
+}
+
+// PresentCredentialsWithBodyWithResponse request with arbitrary body returning *PresentCredentialsResult
+func (c *ClientWithResponses) PresentCredentialsWithBodyWithResponse(ctx context.Context, identifier PathIdentifier, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PresentCredentialsResult, error) {
+	rsp, err := c.PresentCredentialsWithBody(ctx, identifier, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePresentCredentialsResult(rsp)
+}
+
+func (c *ClientWithResponses) PresentCredentialsWithResponse(ctx context.Context, identifier PathIdentifier, body PresentCredentialsJSONRequestBody, reqEditors ...RequestEditorFn) (*PresentCredentialsResult, error) {
+	rsp, err := c.PresentCredentials(ctx, identifier, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePresentCredentialsResult(rsp)
+}
+
+// ParseGetDocumentationResult parses an HTTP response from a GetDocumentationWithResponse call
+func ParseGetDocumentationResult(rsp *http.Response) (*GetDocumentationResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDocumentationResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetAgentCapabilitiesResult parses an HTTP response from a GetAgentCapabilitiesWithResponse call
+func ParseGetAgentCapabilitiesResult(rsp *http.Response) (*GetAgentCapabilitiesResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAgentCapabilitiesResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AgentCapabilities
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetOID4VCIIssuerMetadataResult parses an HTTP response from a GetOID4VCIIssuerMetadataWithResponse call
+func ParseGetOID4VCIIssuerMetadataResult(rsp *http.Response) (*GetOID4VCIIssuerMetadataResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetOID4VCIIssuerMetadataResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OID4VCIIssuerMetadata
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetFaviconResult parses an HTTP response from a GetFaviconWithResponse call
+func ParseGetFaviconResult(rsp *http.Response) (*GetFaviconResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetFaviconResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetYamlResult parses an HTTP response from a GetYamlWithResponse call
+func ParseGetYamlResult(rsp *http.Response) (*GetYamlResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetYamlResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseHealthResult parses an HTTP response from a HealthWithResponse call
+func ParseHealthResult(rsp *http.Response) (*HealthResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &HealthResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Health
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Health
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseExportIssuerResult parses an HTTP response from a ExportIssuerWithResponse call
+func ParseExportIssuerResult(rsp *http.Response) (*ExportIssuerResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExportIssuerResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseResetSettingsResult parses an HTTP response from a ResetSettingsWithResponse call
+func ParseResetSettingsResult(rsp *http.Response) (*ResetSettingsResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ResetSettingsResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Settings
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSettingsResult parses an HTTP response from a GetSettingsWithResponse call
+func ParseGetSettingsResult(rsp *http.Response) (*GetSettingsResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSettingsResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Settings
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateSettingsResult parses an HTTP response from a UpdateSettingsWithResponse call
+func ParseUpdateSettingsResult(rsp *http.Response) (*UpdateSettingsResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateSettingsResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Settings
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAgentResult parses an HTTP response from a AgentWithResponse call
+func ParseAgentResult(rsp *http.Response) (*AgentResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AgentResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AgentResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest AgentProblemReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest AgentProblemReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest AgentProblemReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 410:
+		var dest AgentProblemReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON410 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetIdentitiesResult parses an HTTP response from a GetIdentitiesWithResponse call
+func ParseGetIdentitiesResult(rsp *http.Response) (*GetIdentitiesResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetIdentitiesResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateIdentityResult parses an HTTP response from a CreateIdentityWithResponse call
+func ParseCreateIdentityResult(rsp *http.Response) (*CreateIdentityResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateIdentityResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CreateIdentityResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Code      *int    `json:"code,omitempty"`
+			Error     *string `json:"error,omitempty"`
+			RequestID *string `json:"requestID,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseImportIdentityResult parses an HTTP response from a ImportIdentityWithResponse call
+func ParseImportIdentityResult(rsp *http.Response) (*ImportIdentityResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ImportIdentityResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ImportIdentityResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseOID4VCICredentialResult parses an HTTP response from a OID4VCICredentialWithResponse call
+func ParseOID4VCICredentialResult(rsp *http.Response) (*OID4VCICredentialResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &OID4VCICredentialResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OID4VCICredentialResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest OID4VCIError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest OID4VCIError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseOID4VCITokenResult parses an HTTP response from a OID4VCITokenWithResponse call
+func ParseOID4VCITokenResult(rsp *http.Response) (*OID4VCITokenResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &OID4VCITokenResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OID4VCITokenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest OID4VCIError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePurgeCachedDocumentResult parses an HTTP response from a PurgeCachedDocumentWithResponse call
+func ParsePurgeCachedDocumentResult(rsp *http.Response) (*PurgeCachedDocumentResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PurgeCachedDocumentResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GenericMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRefreshCachedDocumentResult parses an HTTP response from a RefreshCachedDocumentWithResponse call
+func ParseRefreshCachedDocumentResult(rsp *http.Response) (*RefreshCachedDocumentResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RefreshCachedDocumentResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CachedDocument
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseVerificationCallbackResult parses an HTTP response from a VerificationCallbackWithResponse call
+func ParseVerificationCallbackResult(rsp *http.Response) (*VerificationCallbackResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &VerificationCallbackResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest VerificationResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 410:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON410 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetClaimsResult parses an HTTP response from a GetClaimsWithResponse call
+func ParseGetClaimsResult(rsp *http.Response) (*GetClaimsResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetClaimsResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetClaimsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateClaimResult parses an HTTP response from a CreateClaimWithResponse call
+func ParseCreateClaimResult(rsp *http.Response) (*CreateClaimResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateClaimResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CreateClaimResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest CredentialErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetClaimByNonceResult parses an HTTP response from a GetClaimByNonceWithResponse call
+func ParseGetClaimByNonceResult(rsp *http.Response) (*GetClaimByNonceResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetClaimByNonceResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetClaimResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetRevocationStatusResult parses an HTTP response from a GetRevocationStatusWithResponse call
+func ParseGetRevocationStatusResult(rsp *http.Response) (*GetRevocationStatusResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetRevocationStatusResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RevocationStatusResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRevokeClaimResult parses an HTTP response from a RevokeClaimWithResponse call
+func ParseRevokeClaimResult(rsp *http.Response) (*RevokeClaimResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RevokeClaimResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest RevokeClaimResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetRevocationListResult parses an HTTP response from a GetRevocationListWithResponse call
+func ParseGetRevocationListResult(rsp *http.Response) (*GetRevocationListResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetRevocationListResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RevocationList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetClaimResult parses an HTTP response from a GetClaimWithResponse call
+func ParseGetClaimResult(rsp *http.Response) (*GetClaimResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetClaimResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetClaimResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRenewClaimOfferResult parses an HTTP response from a RenewClaimOfferWithResponse call
+func ParseRenewClaimOfferResult(rsp *http.Response) (*RenewClaimOfferResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RenewClaimOfferResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest GetClaimQrCodeResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetClaimQrCodeResult parses an HTTP response from a GetClaimQrCodeWithResponse call
+func ParseGetClaimQrCodeResult(rsp *http.Response) (*GetClaimQrCodeResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetClaimQrCodeResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetClaimQrCodeResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetClaimWalletLinksResult parses an HTTP response from a GetClaimWalletLinksWithResponse call
+func ParseGetClaimWalletLinksResult(rsp *http.Response) (*GetClaimWalletLinksResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetClaimWalletLinksResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WalletLinks
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseResolveDIDDocumentResult parses an HTTP response from a ResolveDIDDocumentWithResponse call
+func ParseResolveDIDDocumentResult(rsp *http.Response) (*ResolveDIDDocumentResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ResolveDIDDocumentResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DIDResolution
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDIDServicesResult parses an HTTP response from a GetDIDServicesWithResponse call
+func ParseGetDIDServicesResult(rsp *http.Response) (*GetDIDServicesResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDIDServicesResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []DIDService
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteDIDServiceResult parses an HTTP response from a DeleteDIDServiceWithResponse call
+func ParseDeleteDIDServiceResult(rsp *http.Response) (*DeleteDIDServiceResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteDIDServiceResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GenericMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSetDIDServiceResult parses an HTTP response from a SetDIDServiceWithResponse call
+func ParseSetDIDServiceResult(rsp *http.Response) (*SetDIDServiceResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetDIDServiceResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DIDService
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetFeatureFlagsResult parses an HTTP response from a GetFeatureFlagsWithResponse call
+func ParseGetFeatureFlagsResult(rsp *http.Response) (*GetFeatureFlagsResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetFeatureFlagsResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []FeatureFlag
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseResetFeatureFlagResult parses an HTTP response from a ResetFeatureFlagWithResponse call
+func ParseResetFeatureFlagResult(rsp *http.Response) (*ResetFeatureFlagResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ResetFeatureFlagResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FeatureFlag
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSetFeatureFlagResult parses an HTTP response from a SetFeatureFlagWithResponse call
+func ParseSetFeatureFlagResult(rsp *http.Response) (*SetFeatureFlagResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetFeatureFlagResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FeatureFlag
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetJWTCredentialKeysResult parses an HTTP response from a GetJWTCredentialKeysWithResponse call
+func ParseGetJWTCredentialKeysResult(rsp *http.Response) (*GetJWTCredentialKeysResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetJWTCredentialKeysResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest JSONWebKeySet
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAuthKeysResult parses an HTTP response from a GetAuthKeysWithResponse call
+func ParseGetAuthKeysResult(rsp *http.Response) (*GetAuthKeysResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAuthKeysResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []AuthKey
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRotateAuthKeyResult parses an HTTP response from a RotateAuthKeyWithResponse call
+func ParseRotateAuthKeyResult(rsp *http.Response) (*RotateAuthKeyResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RotateAuthKeyResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest RotateAuthKeyResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAgentMessagesResult parses an HTTP response from a GetAgentMessagesWithResponse call
+func ParseGetAgentMessagesResult(rsp *http.Response) (*GetAgentMessagesResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAgentMessagesResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []AgentInboxMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAgentMessageEnvelopeResult parses an HTTP response from a GetAgentMessageEnvelopeWithResponse call
+func ParseGetAgentMessageEnvelopeResult(rsp *http.Response) (*GetAgentMessageEnvelopeResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAgentMessageEnvelopeResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAbortIdentityMigrationResult parses an HTTP response from a AbortIdentityMigrationWithResponse call
+func ParseAbortIdentityMigrationResult(rsp *http.Response) (*AbortIdentityMigrationResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AbortIdentityMigrationResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GenericMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetIdentityMigrationResult parses an HTTP response from a GetIdentityMigrationWithResponse call
+func ParseGetIdentityMigrationResult(rsp *http.Response) (*GetIdentityMigrationResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetIdentityMigrationResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest IdentityMigration
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseStartIdentityMigrationResult parses an HTTP response from a StartIdentityMigrationWithResponse call
+func ParseStartIdentityMigrationResult(rsp *http.Response) (*StartIdentityMigrationResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StartIdentityMigrationResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest IdentityMigration
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCompleteIdentityMigrationResult parses an HTTP response from a CompleteIdentityMigrationWithResponse call
+func ParseCompleteIdentityMigrationResult(rsp *http.Response) (*CompleteIdentityMigrationResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CompleteIdentityMigrationResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest IdentityMigration
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseExportIdentityResult parses an HTTP response from a ExportIdentityWithResponse call
+func ParseExportIdentityResult(rsp *http.Response) (*ExportIdentityResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExportIdentityResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest IdentityExport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateOID4VCIOfferResult parses an HTTP response from a CreateOID4VCIOfferWithResponse call
+func ParseCreateOID4VCIOfferResult(rsp *http.Response) (*CreateOID4VCIOfferResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateOID4VCIOfferResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest OID4VCIOffer
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseResetPublishingPolicyResult parses an HTTP response from a ResetPublishingPolicyWithResponse call
+func ParseResetPublishingPolicyResult(rsp *http.Response) (*ResetPublishingPolicyResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ResetPublishingPolicyResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PublishingPolicy
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetPublishingPolicyResult parses an HTTP response from a GetPublishingPolicyWithResponse call
+func ParseGetPublishingPolicyResult(rsp *http.Response) (*GetPublishingPolicyResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPublishingPolicyResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PublishingPolicy
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSetPublishingPolicyResult parses an HTTP response from a SetPublishingPolicyWithResponse call
+func ParseSetPublishingPolicyResult(rsp *http.Response) (*SetPublishingPolicyResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetPublishingPolicyResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PublishingPolicy
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseApplyRevocationDecisionsResult parses an HTTP response from a ApplyRevocationDecisionsWithResponse call
+func ParseApplyRevocationDecisionsResult(rsp *http.Response) (*ApplyRevocationDecisionsResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ApplyRevocationDecisionsResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RevocationDecisionsReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetRevocationDecisionsReportResult parses an HTTP response from a GetRevocationDecisionsReportWithResponse call
+func ParseGetRevocationDecisionsReportResult(rsp *http.Response) (*GetRevocationDecisionsReportResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetRevocationDecisionsReportResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RevocationDecisionsReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetStateIntegrityResult parses an HTTP response from a GetStateIntegrityWithResponse call
+func ParseGetStateIntegrityResult(rsp *http.Response) (*GetStateIntegrityResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetStateIntegrityResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StateIntegrityReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePublishIdentityStateResult parses an HTTP response from a PublishIdentityStateWithResponse call
+func ParsePublishIdentityStateResult(rsp *http.Response) (*PublishIdentityStateResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PublishIdentityStateResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest PublishIdentityStateResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetStateTransactionsResult parses an HTTP response from a GetStateTransactionsWithResponse call
+func ParseGetStateTransactionsResult(rsp *http.Response) (*GetStateTransactionsResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetStateTransactionsResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []StateTransaction
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetStatusListCredentialResult parses an HTTP response from a GetStatusListCredentialWithResponse call
+func ParseGetStatusListCredentialResult(rsp *http.Response) (*GetStatusListCredentialResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetStatusListCredentialResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateVerificationRequestResult parses an HTTP response from a CreateVerificationRequestWithResponse call
+func ParseCreateVerificationRequestResult(rsp *http.Response) (*CreateVerificationRequestResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateVerificationRequestResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest VerificationRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetVerificationRequestResult parses an HTTP response from a GetVerificationRequestWithResponse call
+func ParseGetVerificationRequestResult(rsp *http.Response) (*GetVerificationRequestResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetVerificationRequestResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest VerificationRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetVerificationRequestQrCodeResult parses an HTTP response from a GetVerificationRequestQrCodeWithResponse call
+func ParseGetVerificationRequestQrCodeResult(rsp *http.Response) (*GetVerificationRequestQrCodeResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetVerificationRequestQrCodeResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuthorizationRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 410:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON410 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetVerificationResponsesResult parses an HTTP response from a GetVerificationResponsesWithResponse call
+func ParseGetVerificationResponsesResult(rsp *http.Response) (*GetVerificationResponsesResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetVerificationResponsesResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []VerificationResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetHeldCredentialsResult parses an HTTP response from a GetHeldCredentialsWithResponse call
+func ParseGetHeldCredentialsResult(rsp *http.Response) (*GetHeldCredentialsResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetHeldCredentialsResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetHeldCredentialsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteHeldCredentialResult parses an HTTP response from a DeleteHeldCredentialWithResponse call
+func ParseDeleteHeldCredentialResult(rsp *http.Response) (*DeleteHeldCredentialResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteHeldCredentialResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetHeldCredentialResult parses an HTTP response from a GetHeldCredentialWithResponse call
+func ParseGetHeldCredentialResult(rsp *http.Response) (*GetHeldCredentialResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetHeldCredentialResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HeldCredential
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAcceptCredentialOfferResult parses an HTTP response from a AcceptCredentialOfferWithResponse call
+func ParseAcceptCredentialOfferResult(rsp *http.Response) (*AcceptCredentialOfferResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AcceptCredentialOfferResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest GetHeldCredentialsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePresentCredentialsResult parses an HTTP response from a PresentCredentialsWithResponse call
+func ParsePresentCredentialsResult(rsp *http.Response) (*PresentCredentialsResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PresentCredentialsResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GenericErrorMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
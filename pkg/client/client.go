@@ -0,0 +1,120 @@
+// Package client is a Go SDK for the issuer node core API.
+//
+// The typed request and response methods live in api.gen.go and are generated from api/api.yaml
+// with `make client`. This file adds authentication, retries and idempotency support on top of them.
+package client
+
+import (
+	"context"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// IdempotencyKeyHeader is the header used to mark a request as safe to be retried
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// idempotentPaths are the path.Match patterns of the POST requests the server deduplicates by their Idempotency-Key:
+// creating and revoking claims. The server would process the retries of the rest again.
+var idempotentPaths = []string{"/v1/*/claims", "/v1/*/claims/revoke/*"}
+
+const (
+	defaultRetryMax     = 3
+	defaultRetryWaitMin = 500 * time.Millisecond
+	defaultRetryWaitMax = 5 * time.Second
+)
+
+// New returns a client for the issuer node core API located at server.
+// Every request is authenticated with the given basic auth credentials and failed requests
+// are retried with the default retry policy. Use WithRetries or WithHTTPClient to change it.
+func New(server, user, password string, opts ...ClientOption) (*ClientWithResponses, error) {
+	defaults := []ClientOption{
+		WithRetries(defaultRetryMax, defaultRetryWaitMin, defaultRetryWaitMax),
+		WithRequestEditorFn(BasicAuth(user, password)),
+	}
+	return NewClientWithResponses(server, append(defaults, opts...)...)
+}
+
+// WithRetries retries failed requests up to retryMax times, waiting between waitMin and waitMax
+// with exponential backoff. Connection errors, 429 and 5xx responses are retried.
+// POST requests that create or revoke claims are only retried when they carry an Idempotency-Key header, the rest of
+// the POST and PATCH requests are never retried.
+func WithRetries(retryMax int, waitMin, waitMax time.Duration) ClientOption {
+	return func(c *Client) error {
+		rc := retryablehttp.NewClient()
+		rc.RetryMax = retryMax
+		rc.RetryWaitMin = waitMin
+		rc.RetryWaitMax = waitMax
+		rc.Logger = nil
+		c.Client = &retryDoer{
+			retryable: rc.StandardClient(),
+			single:    &http.Client{Transport: rc.HTTPClient.Transport},
+		}
+		return nil
+	}
+}
+
+// BasicAuth returns a RequestEditorFn that authenticates the request with the given credentials
+func BasicAuth(user, password string) RequestEditorFn {
+	return func(_ context.Context, req *http.Request) error {
+		req.SetBasicAuth(user, password)
+		return nil
+	}
+}
+
+// WithIdempotencyKey returns a RequestEditorFn that sets the Idempotency-Key header.
+// Reuse the same key when repeating a request to let the server deduplicate it.
+func WithIdempotencyKey(key string) RequestEditorFn {
+	return func(_ context.Context, req *http.Request) error {
+		req.Header.Set(IdempotencyKeyHeader, key)
+		return nil
+	}
+}
+
+// NewIdempotencyKey returns a new random idempotency key
+func NewIdempotencyKey() string {
+	return uuid.NewString()
+}
+
+type retryDoer struct {
+	retryable HttpRequestDoer
+	single    HttpRequestDoer
+}
+
+func (d *retryDoer) Do(req *http.Request) (*http.Response, error) {
+	if isIdempotent(req) {
+		return d.retryable.Do(req)
+	}
+	return d.single.Do(req)
+}
+
+func isIdempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodPost:
+		return req.Header.Get(IdempotencyKeyHeader) != "" && deduplicated(req.URL.Path)
+	case http.MethodPatch:
+		return false
+	default:
+		return true
+	}
+}
+
+// deduplicated returns true if the end of the request path, after the base path of the server, matches one of the
+// idempotent paths
+func deduplicated(p string) bool {
+	segments := strings.Split(p, "/")
+	for _, pattern := range idempotentPaths {
+		n := strings.Count(pattern, "/")
+		if len(segments) <= n {
+			continue
+		}
+		if ok, _ := path.Match(pattern, "/"+strings.Join(segments[len(segments)-n:], "/")); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,85 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Retries(t *testing.T) {
+	var calls int32
+	var lastIdempotencyKey string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, password, ok := r.BasicAuth()
+		if !ok || user != "user" || password != "password" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		lastIdempotencyKey = r.Header.Get(IdempotencyKeyHeader)
+		if atomic.AddInt32(&calls, 1)%2 == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodGet {
+			_, _ = w.Write([]byte(`["did:polygonid:polygon:mumbai:2qCU58EJgrELNZCDkSU23dQHZsBgAFuJnzjXKkBZxB"]`))
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"identifier":"did:polygonid:polygon:mumbai:2qCU58EJgrELNZCDkSU23dQHZsBgAFuJnzjXKkBZxB","state":{}}`))
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	cli, err := New(server.URL, "user", "password", WithRetries(2, time.Millisecond, time.Millisecond))
+	require.NoError(t, err)
+
+	t.Run("idempotent request is retried", func(t *testing.T) {
+		atomic.StoreInt32(&calls, 0)
+		resp, err := cli.GetIdentitiesWithResponse(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode())
+		assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("post without idempotency key is not retried", func(t *testing.T) {
+		atomic.StoreInt32(&calls, 0)
+		resp, err := cli.CreateIdentityWithResponse(ctx, CreateIdentityJSONRequestBody{})
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode())
+		assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("claim creation with idempotency key is retried", func(t *testing.T) {
+		atomic.StoreInt32(&calls, 0)
+		key := NewIdempotencyKey()
+		resp, err := cli.CreateClaimWithResponse(ctx, "did:polygonid:polygon:mumbai:2qCU58EJgrELNZCDkSU23dQHZsBgAFuJnzjXKkBZxB", &CreateClaimParams{IdempotencyKey: &key}, CreateClaimJSONRequestBody{})
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, resp.StatusCode())
+		assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+		assert.Equal(t, key, lastIdempotencyKey)
+	})
+
+	t.Run("claim revocation with idempotency key is retried", func(t *testing.T) {
+		atomic.StoreInt32(&calls, 0)
+		key := NewIdempotencyKey()
+		resp, err := cli.RevokeClaimWithResponse(ctx, "did:polygonid:polygon:mumbai:2qCU58EJgrELNZCDkSU23dQHZsBgAFuJnzjXKkBZxB", 51, &RevokeClaimParams{IdempotencyKey: &key})
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, resp.StatusCode())
+		assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("post to other paths with idempotency key is not retried", func(t *testing.T) {
+		atomic.StoreInt32(&calls, 0)
+		resp, err := cli.CreateIdentityWithResponse(ctx, CreateIdentityJSONRequestBody{}, WithIdempotencyKey(NewIdempotencyKey()))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode())
+		assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	})
+}
@@ -0,0 +1,88 @@
+// Package emails renders the emails sent to the holders.
+package emails
+
+import (
+	"bytes"
+	htmltemplate "html/template"
+	"os"
+	"strings"
+	texttemplate "text/template"
+)
+
+// DefaultOfferTemplate is the html template of the offer emails when no other is configured
+const DefaultOfferTemplate = `<!DOCTYPE html>
+<html>
+<body style="margin:0;padding:0;font-family:Arial,Helvetica,sans-serif;">
+<table width="100%" cellpadding="0" cellspacing="0" style="background-color:{{or .BackgroundColor "#f5f5f5"}};color:{{or .TextColor "#13111c"}};">
+<tr><td align="center" style="padding:32px 16px;">
+{{- if .LogoURL}}
+<img src="{{.LogoURL}}" alt="{{.IssuerName}}" height="64" style="display:block;margin-bottom:24px;">
+{{- end}}
+<h2 style="margin:0 0 16px;">{{.IssuerName}} sent you a credential</h2>
+<p style="margin:0 0 24px;">Your {{.CredentialType}} credential is ready. Open the link on the phone with your wallet, or on another device to scan its QR code with the wallet.</p>
+<a href="{{.ClaimURL}}" style="display:inline-block;padding:12px 24px;border-radius:6px;background-color:#6c47ff;color:#ffffff;text-decoration:none;">Claim credential</a>
+{{- if or .ContactEmail .ContactURL}}
+<p style="margin:32px 0 0;font-size:12px;">Questions? Contact {{.IssuerName}}{{with .ContactEmail}} at <a href="mailto:{{.}}">{{.}}</a>{{end}}{{with .ContactURL}} on <a href="{{.}}">{{.}}</a>{{end}}.</p>
+{{- end}}
+</td></tr>
+</table>
+<img src="{{.OpenURL}}" width="1" height="1" alt="" style="display:block;">
+</body>
+</html>
+`
+
+// OfferData is what the offer templates can show: the issuer profile, the type of the credential and the links of
+// the node. ClaimURL must be the target of the claim link and OpenURL the source of an image, to track the email.
+type OfferData struct {
+	IssuerName      string
+	LogoURL         string
+	BackgroundColor string
+	TextColor       string
+	ContactEmail    string
+	ContactURL      string
+	CredentialType  string
+	ClaimURL        string
+	OpenURL         string
+}
+
+// OfferTemplates render the subject and the html body of the offer emails
+type OfferTemplates struct {
+	subject *texttemplate.Template
+	body    *htmltemplate.Template
+}
+
+// NewOfferTemplates parses the subject template and the body template of the file at bodyPath, or the default one
+// if bodyPath is empty
+func NewOfferTemplates(subject string, bodyPath string) (*OfferTemplates, error) {
+	subjectTemplate, err := texttemplate.New("subject").Parse(subject)
+	if err != nil {
+		return nil, err
+	}
+	body := DefaultOfferTemplate
+	if bodyPath != "" {
+		content, err := os.ReadFile(bodyPath)
+		if err != nil {
+			return nil, err
+		}
+		body = string(content)
+	}
+	bodyTemplate, err := htmltemplate.New("body").Parse(body)
+	if err != nil {
+		return nil, err
+	}
+	return &OfferTemplates{subject: subjectTemplate, body: bodyTemplate}, nil
+}
+
+// Render returns the subject and the html body of the email. Line breaks are removed from the subject, so a
+// template cannot add headers.
+func (t *OfferTemplates) Render(data OfferData) (string, []byte, error) {
+	var subject strings.Builder
+	if err := t.subject.Execute(&subject, data); err != nil {
+		return "", nil, err
+	}
+	var body bytes.Buffer
+	if err := t.body.Execute(&body, data); err != nil {
+		return "", nil, err
+	}
+	return strings.Join(strings.Fields(subject.String()), " "), body.Bytes(), nil
+}
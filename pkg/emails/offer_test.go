@@ -0,0 +1,54 @@
+package emails
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testOfferData() OfferData {
+	return OfferData{
+		IssuerName:     "Acme <Corp>",
+		LogoURL:        "https://acme.com/logo.png",
+		ContactEmail:   "help@acme.com",
+		CredentialType: "KYCAgeCredential",
+		ClaimURL:       "https://issuer.acme.com/v1/offer-emails/8edd8112-c415-11ed-b036-debe37e1cbd6/claim",
+		OpenURL:        "https://issuer.acme.com/v1/offer-emails/8edd8112-c415-11ed-b036-debe37e1cbd6/open",
+	}
+}
+
+func TestOfferTemplates_Render(t *testing.T) {
+	templates, err := NewOfferTemplates("{{.IssuerName}} sent you\r\nBcc: a credential", "")
+	require.NoError(t, err)
+
+	subject, body, err := templates.Render(testOfferData())
+	require.NoError(t, err)
+	assert.Equal(t, "Acme <Corp> sent you Bcc: a credential", subject)
+	html := string(body)
+	assert.Contains(t, html, "Acme &lt;Corp&gt; sent you a credential")
+	assert.Contains(t, html, `href="https://issuer.acme.com/v1/offer-emails/8edd8112-c415-11ed-b036-debe37e1cbd6/claim"`)
+	assert.Contains(t, html, `src="https://issuer.acme.com/v1/offer-emails/8edd8112-c415-11ed-b036-debe37e1cbd6/open"`)
+	assert.Contains(t, html, `src="https://acme.com/logo.png"`)
+	assert.Contains(t, html, "mailto:help@acme.com")
+	assert.Contains(t, html, "background-color:#f5f5f5")
+}
+
+func TestOfferTemplates_RenderFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "offer.html")
+	require.NoError(t, os.WriteFile(path, []byte(`<a href="{{.ClaimURL}}">{{.CredentialType}}</a>`), 0o600))
+	templates, err := NewOfferTemplates("Your credential", path)
+	require.NoError(t, err)
+
+	subject, body, err := templates.Render(testOfferData())
+	require.NoError(t, err)
+	assert.Equal(t, "Your credential", subject)
+	assert.Equal(t, `<a href="https://issuer.acme.com/v1/offer-emails/8edd8112-c415-11ed-b036-debe37e1cbd6/claim">KYCAgeCredential</a>`, string(body))
+
+	_, err = NewOfferTemplates("Your credential", filepath.Join(t.TempDir(), "missing.html"))
+	assert.Error(t, err)
+	_, err = NewOfferTemplates("{{.IssuerName", "")
+	assert.Error(t, err)
+}
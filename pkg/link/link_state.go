@@ -33,13 +33,14 @@ type CredentialsLinkMessageBody struct {
 
 // QRCodeMessage - TODO
 type QRCodeMessage struct {
-	ID       string                     `json:"id"`
-	Typ      string                     `json:"typ,omitempty"`
-	Type     string                     `json:"type"`
-	ThreadID string                     `json:"thid,omitempty"`
-	Body     CredentialsLinkMessageBody `json:"body,omitempty"`
-	From     string                     `json:"from,omitempty"`
-	To       string                     `json:"to,omitempty"`
+	ID          string                     `json:"id"`
+	Typ         string                     `json:"typ,omitempty"`
+	Type        string                     `json:"type"`
+	ThreadID    string                     `json:"thid,omitempty"`
+	Body        CredentialsLinkMessageBody `json:"body,omitempty"`
+	From        string                     `json:"from,omitempty"`
+	To          string                     `json:"to,omitempty"`
+	ExpiresTime *int64                     `json:"expires_time,omitempty"`
 }
 
 // State - Link state.
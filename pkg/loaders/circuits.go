@@ -81,3 +81,24 @@ func (l *Circuits) getPathToFile(circuitID circuits.CircuitID, fileName string)
 	}
 	return data, nil
 }
+
+// queryVerificationKeyFile is the verification key file of the circuits but authV2
+const queryVerificationKeyFile = "verification_key.json"
+
+// VerificationKeys loads the verification keys of the circuits to verify the proofs of the holders.
+type VerificationKeys struct {
+	circuits *Circuits
+}
+
+// NewVerificationKeys create loader that returns the verification keys of the circuits.
+func NewVerificationKeys(basePath string) *VerificationKeys {
+	return &VerificationKeys{circuits: NewCircuits(basePath)}
+}
+
+// Load verification key by circuit ID. The key of authV2 has its own file name.
+func (l *VerificationKeys) Load(circuitID circuits.CircuitID) ([]byte, error) {
+	if circuitID == circuits.AuthV2CircuitID {
+		return l.circuits.LoadVerificationKey(circuitID)
+	}
+	return l.circuits.getPathToFile(circuitID, queryVerificationKeyFile)
+}
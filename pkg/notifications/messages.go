@@ -5,7 +5,6 @@ import (
 	"errors"
 	"strings"
 
-	"github.com/google/uuid"
 	"github.com/iden3/iden3comm/packers"
 	"github.com/iden3/iden3comm/protocol"
 
@@ -16,19 +15,19 @@ const (
 	schemaParts = 2
 )
 
-// NewOfferMsg returns an offer message
-func NewOfferMsg(fetchURL string, credentials ...*domain.Claim) ([]byte, error) {
+// NewOfferMsg returns an offer message. offerID is used as the message thread id so the
+// holder fetch requests can be matched with the offer.
+func NewOfferMsg(offerID string, fetchURL string, credentials ...*domain.Claim) ([]byte, error) {
 	if len(credentials) == 0 {
 		return nil, errors.New("no claims provided")
 	}
 
 	credentialOffers := toProtocolCredentialOffer(credentials)
-	msgID := uuid.NewString()
 	credOffer := &protocol.CredentialsOfferMessage{
-		ID:       msgID,
+		ID:       offerID,
 		Typ:      packers.MediaTypePlainMessage,
 		Type:     protocol.CredentialOfferMessageType,
-		ThreadID: msgID,
+		ThreadID: offerID,
 		Body: protocol.CredentialsOfferMessageBody{
 			URL:         fetchURL,
 			Credentials: credentialOffers,
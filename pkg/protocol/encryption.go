@@ -0,0 +1,85 @@
+package protocol
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/iden3/iden3comm/packers"
+	"gopkg.in/square/go-jose.v2"
+)
+
+// ErrUnknownEncryptionKey the envelope was encrypted with a key the issuer does not have
+var ErrUnknownEncryptionKey = errors.New("unknown encryption key")
+
+// Decrypter opens the encrypted iden3comm envelopes, the anoncrypt JWEs of the application/iden3comm-encrypted-json
+// media type, with the private keys of the issuer. The payload is the message packed with another media type: the
+// senders wrap a zkp-packed message to be authenticated, or a plain one to stay anonymous.
+type Decrypter struct {
+	keys *jose.JSONWebKeySet
+}
+
+// NewDecrypter returns a Decrypter with the given keys, they are chosen by the kid of the envelopes
+func NewDecrypter(keys *jose.JSONWebKeySet) *Decrypter {
+	return &Decrypter{keys: keys}
+}
+
+// LoadEncryptionKeys reads a JWK set with the private keys of the encrypted messages. Every key must be an EC private
+// key with a kid, the senders set it in the header of the messages.
+func LoadEncryptionKeys(path string) (*jose.JSONWebKeySet, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading encryption keys: %w", err)
+	}
+	keys := &jose.JSONWebKeySet{}
+	if err := json.Unmarshal(content, keys); err != nil {
+		return nil, fmt.Errorf("parsing encryption keys: %w", err)
+	}
+	if len(keys.Keys) == 0 {
+		return nil, errors.New("the encryption key set is empty")
+	}
+	for _, key := range keys.Keys {
+		if key.KeyID == "" || key.IsPublic() {
+			return nil, errors.New("the encryption keys must be private keys with a kid")
+		}
+	}
+	return keys, nil
+}
+
+// IsEncrypted returns true if the envelope is an encrypted iden3comm message
+func IsEncrypted(envelope []byte) bool {
+	_, err := parseEncrypted(envelope)
+	return err == nil
+}
+
+// Decrypt returns the payload of an encrypted envelope
+func (d *Decrypter) Decrypt(envelope []byte) ([]byte, error) {
+	jwe, err := parseEncrypted(envelope)
+	if err != nil {
+		return nil, err
+	}
+	keys := d.keys.Key(jwe.Header.KeyID)
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("%w %q", ErrUnknownEncryptionKey, jwe.Header.KeyID)
+	}
+	payload, err := jwe.Decrypt(keys[0].Key)
+	if err != nil {
+		return nil, fmt.Errorf("decrypting envelope: %w", err)
+	}
+	return payload, nil
+}
+
+// parseEncrypted parses the envelope as a JWE of the encrypted media type. Like the iden3comm package manager, it
+// accepts the envelopes wrapped in double quotes.
+func parseEncrypted(envelope []byte) (*jose.JSONWebEncryption, error) {
+	jwe, err := jose.ParseEncrypted(strings.Trim(strings.TrimSpace(string(envelope)), `"`))
+	if err != nil {
+		return nil, err
+	}
+	if typ, _ := jwe.Header.ExtraHeaders[jose.HeaderType].(string); typ != string(packers.MediaTypeEncryptedMessage) {
+		return nil, fmt.Errorf("the envelope media type is %q", typ)
+	}
+	return jwe, nil
+}
@@ -16,8 +16,9 @@ import (
 	"github.com/polygonid/sh-id-platform/pkg/loaders"
 )
 
-// InitPackageManager initializes the iden3comm package manager
-func InitPackageManager(ctx context.Context, stateContract *abi.State, zkProofService ports.ProofService, circuitsPath string) (*iden3comm.PackageManager, error) {
+// InitPackageManager initializes the iden3comm package manager. The state contracts are keyed by network and used
+// to verify the messages of senders from every network.
+func InitPackageManager(ctx context.Context, stateContracts map[string]*abi.State, zkProofService ports.ProofService, circuitsPath string) (*iden3comm.PackageManager, error) {
 	circuitsLoaderService := loaders.NewCircuits(circuitsPath)
 
 	authV2Set, err := circuitsLoaderService.Load(circuits.AuthV2CircuitID)
@@ -35,7 +36,7 @@ func InitPackageManager(ctx context.Context, stateContract *abi.State, zkProofSe
 
 	verifications := make(map[jwz.ProvingMethodAlg]packers.VerificationParams)
 	verifications[jwz.AuthV2Groth16Alg] = packers.NewVerificationParams(authV2Set.VerificationKey,
-		stateVerificationHandler(stateContract))
+		stateVerificationHandler(stateContracts))
 
 	zkpPackerV2 := packers.NewZKPPacker(
 		provers,
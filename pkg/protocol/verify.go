@@ -8,8 +8,11 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/iden3/contracts-abi/state/go/abi"
 	"github.com/iden3/go-circuits"
+	core "github.com/iden3/go-iden3-core"
 	"github.com/iden3/iden3comm/packers"
 	"github.com/pkg/errors"
+
+	"github.com/polygonid/sh-id-platform/internal/network"
 )
 
 // ErrStateNotFound issuer state is genesis state.
@@ -17,11 +20,12 @@ var (
 	ErrStateNotFound = errors.New("Identity does not exist")
 )
 
-func stateVerificationHandler(ethStateContract *abi.State) packers.VerificationHandlerFunc {
+// stateVerificationHandler verifies the proofs against the state contract of the network of the sender
+func stateVerificationHandler(stateContracts map[string]*abi.State) packers.VerificationHandlerFunc {
 	return func(id circuits.CircuitID, pubsignals []string) error {
 		switch id {
 		case circuits.AuthV2CircuitID:
-			return authV2CircuitStateVerification(ethStateContract, pubsignals)
+			return authV2CircuitStateVerification(stateContracts, pubsignals)
 		default:
 			return errors.Errorf("'%s' unknow circuit ID", id)
 		}
@@ -29,7 +33,7 @@ func stateVerificationHandler(ethStateContract *abi.State) packers.VerificationH
 }
 
 // authV2CircuitStateVerification `authV2` circuit state verification
-func authV2CircuitStateVerification(contract *abi.State, pubsignals []string) error {
+func authV2CircuitStateVerification(stateContracts map[string]*abi.State, pubsignals []string) error {
 	bytePubsig, err := json.Marshal(pubsignals)
 	if err != nil {
 		return err
@@ -41,6 +45,15 @@ func authV2CircuitStateVerification(contract *abi.State, pubsignals []string) er
 		return err
 	}
 
+	userDID, err := core.ParseDIDFromID(*authPubSignals.UserID)
+	if err != nil {
+		return err
+	}
+	contract, ok := stateContracts[network.Key(*userDID)]
+	if !ok {
+		return errors.Errorf("network %s is not supported", network.Key(*userDID))
+	}
+
 	globalState := authPubSignals.GISTRoot.BigInt()
 	globalStateInfo, err := contract.GetGISTRootInfo(&bind.CallOpts{}, globalState)
 	if err != nil {
@@ -0,0 +1,78 @@
+package qrcode
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockTables(t *testing.T) {
+	for version := 1; version <= 40; version++ {
+		for level := Low; level <= High; level++ {
+			numBlocks := blocks[level][version-1]
+			shortBlockLen := rawDataModules(version) / 8 / numBlocks
+			assert.Greater(t, shortBlockLen-eccPerBlock[level][version-1], 0, "version %d level %d", version, level)
+			assert.Less(t, rawDataModules(version)/8%numBlocks, numBlocks)
+		}
+	}
+	assert.Equal(t, 19, dataCodewords(1, Low))
+	assert.Equal(t, 9, dataCodewords(1, High))
+	assert.Equal(t, 223, dataCodewords(15, High))
+	assert.Equal(t, 2956, dataCodewords(40, Low))
+	assert.Equal(t, 1276, dataCodewords(40, High))
+}
+
+func TestEncode(t *testing.T) {
+	code, err := Encode([]byte("iden3comm://?i_m=eyJpZCI6ImY3YzZjZGY5LTg3OGUtNDBjMy04OWYxLTg1YmYxZmI4MDg2NSJ9"), Medium)
+	require.NoError(t, err)
+	assert.Equal(t, 5, code.Version)
+	assert.Equal(t, 37, code.Size)
+
+	// finder patterns in three corners
+	for _, corner := range [][2]int{{0, 0}, {code.Size - 7, 0}, {0, code.Size - 7}} {
+		for i := 0; i < 7; i++ {
+			assert.True(t, code.Dark(corner[0]+i, corner[1]))
+			assert.True(t, code.Dark(corner[0], corner[1]+i))
+		}
+		assert.False(t, code.Dark(corner[0]+1, corner[1]+1))
+		assert.True(t, code.Dark(corner[0]+3, corner[1]+3))
+	}
+	assert.False(t, code.Dark(-1, 0))
+	assert.False(t, code.Dark(code.Size, 0))
+
+	// both copies of the format information hold the level and the mask
+	var first, second int
+	for i := 0; i <= 5; i++ {
+		first |= bit(code.Dark(8, i)) << i
+	}
+	first |= bit(code.Dark(8, 7))<<6 | bit(code.Dark(8, 8))<<7 | bit(code.Dark(7, 8))<<8
+	for i := 9; i < 15; i++ {
+		first |= bit(code.Dark(14-i, 8)) << i
+	}
+	for i := 0; i < 8; i++ {
+		second |= bit(code.Dark(code.Size-1-i, 8)) << i
+	}
+	for i := 8; i < 15; i++ {
+		second |= bit(code.Dark(8, code.Size-15+i)) << i
+	}
+	assert.Equal(t, first, second)
+	assert.Equal(t, formatBits[Medium], (first^0x5412)>>13)
+
+	svg := code.SVG(4)
+	assert.True(t, strings.HasPrefix(svg, `<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 45 45"`))
+	assert.Contains(t, svg, "M4,4h1v1h-1z")
+
+	_, err = Encode([]byte(strings.Repeat("x", 2953)), Low)
+	assert.NoError(t, err)
+	_, err = Encode([]byte(strings.Repeat("x", 2954)), Low)
+	assert.ErrorIs(t, err, ErrDataTooLong)
+}
+
+func bit(dark bool) int {
+	if dark {
+		return 1
+	}
+	return 0
+}
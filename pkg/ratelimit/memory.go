@@ -0,0 +1,51 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+type bucket struct {
+	tokens float64
+	ts     time.Time
+}
+
+type memory struct {
+	mu      sync.Mutex
+	buckets map[string]*bucket
+	now     func() time.Time
+}
+
+// NewMemoryLimiter returns a rate limiter that keeps the buckets in memory. Buckets are not shared between
+// processes, so it is only suitable for a single server or for testing.
+func NewMemoryLimiter() Limiter {
+	return &memory{
+		buckets: make(map[string]*bucket),
+		now:     time.Now,
+	}
+}
+
+// Allow takes a token from the in memory bucket identified by key
+func (m *memory) Allow(_ context.Context, key string, limit Limit) (bool, time.Duration, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	b, found := m.buckets[key]
+	if !found {
+		b = &bucket{tokens: float64(limit.Burst), ts: now}
+		m.buckets[key] = b
+	}
+
+	b.tokens = math.Min(float64(limit.Burst), b.tokens+now.Sub(b.ts).Seconds()*limit.Rate)
+	b.ts = now
+	if b.tokens >= 1 {
+		b.tokens--
+		return true, 0, nil
+	}
+
+	wait := time.Duration(math.Ceil((1 - b.tokens) / limit.Rate * float64(time.Second)))
+	return false, wait, nil
+}
@@ -0,0 +1,25 @@
+// Package ratelimit implements token bucket rate limiters.
+package ratelimit
+
+import (
+	"context"
+	"time"
+)
+
+// Limit defines a token bucket. The bucket holds at most Burst tokens and it is refilled with Rate tokens per second.
+type Limit struct {
+	Rate  float64
+	Burst int
+}
+
+// PerMinute returns a limit that allows requests per minute with the given burst
+func PerMinute(requests int, burst int) Limit {
+	return Limit{Rate: float64(requests) / 60, Burst: burst}
+}
+
+// Limiter interface propose an interface that any rate limiter should adhere
+type Limiter interface {
+	// Allow takes a token from the bucket identified by key. If the bucket is empty it returns false and
+	// the time to wait until a new token is available.
+	Allow(ctx context.Context, key string, limit Limit) (bool, time.Duration, error)
+}
@@ -0,0 +1,57 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/redis"
+)
+
+func TestMemoryLimiter(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	limiter := &memory{buckets: make(map[string]*bucket), now: func() time.Time { return now }}
+	limit := PerMinute(60, 2)
+
+	testLimiter(t, limiter, limit)
+
+	now = now.Add(time.Second)
+	allowed, _, err := limiter.Allow(ctx, "key", limit)
+	require.NoError(t, err)
+	assert.True(t, allowed, "a token is refilled after a second")
+}
+
+func TestRedisLimiter(t *testing.T) {
+	s := miniredis.RunT(t)
+	client, err := redis.Open("redis://" + s.Addr())
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, client.Close()) }()
+
+	testLimiter(t, NewRedisLimiter(client), PerMinute(60, 2))
+}
+
+func testLimiter(t *testing.T, limiter Limiter, limit Limit) {
+	t.Helper()
+	ctx := context.Background()
+
+	for i := 0; i < limit.Burst; i++ {
+		allowed, _, err := limiter.Allow(ctx, "key", limit)
+		require.NoError(t, err)
+		assert.True(t, allowed, "burst request %d", i)
+	}
+
+	allowed, wait, err := limiter.Allow(ctx, "key", limit)
+	require.NoError(t, err)
+	assert.False(t, allowed)
+	assert.Greater(t, wait, time.Duration(0))
+	assert.LessOrEqual(t, wait, time.Second)
+
+	allowed, _, err = limiter.Allow(ctx, "other", limit)
+	require.NoError(t, err)
+	assert.True(t, allowed, "buckets are per key")
+}
@@ -0,0 +1,132 @@
+package reports
+
+import (
+	"bytes"
+	"encoding/csv"
+	"html/template"
+	"strconv"
+	"time"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+const dateFormat = "2006-01-02 15:04 MST"
+
+var htmlTemplate = template.Must(template.New("report").Funcs(template.FuncMap{
+	"date": func(t time.Time) string { return t.UTC().Format(dateFormat) },
+}).Parse(`<!DOCTYPE html>
+<html>
+<body>
+<h2>Issuer node activity</h2>
+<p>From {{date .From}} to {{date .To}}</p>
+<h3>Issued credentials: {{.TotalIssued}}</h3>
+{{- if .Issued}}
+<table border="1" cellpadding="4" cellspacing="0">
+<tr><th>Issuer</th><th>Schema type</th><th>Credentials</th></tr>
+{{- range .Issued}}
+<tr><td>{{.IssuerDID}}</td><td>{{.SchemaType}}</td><td>{{.Count}}</td></tr>
+{{- end}}
+</table>
+{{- end}}
+<h3>Revoked credentials: {{.TotalRevoked}}</h3>
+{{- if .Revocations}}
+<table border="1" cellpadding="4" cellspacing="0">
+<tr><th>Issuer</th><th>Credentials</th></tr>
+{{- range .Revocations}}
+<tr><td>{{.IssuerDID}}</td><td>{{.Count}}</td></tr>
+{{- end}}
+</table>
+{{- end}}
+<h3>Failed operations: {{len .FailedOperations}}</h3>
+{{- if .FailedOperations}}
+<table border="1" cellpadding="4" cellspacing="0">
+<tr><th>Issuer</th><th>State</th><th>Transaction</th><th>Date</th></tr>
+{{- range .FailedOperations}}
+<tr><td>{{.IssuerDID}}</td><td>{{.State}}</td><td>{{.TxID}}</td><td>{{date .ModifiedAt}}</td></tr>
+{{- end}}
+</table>
+{{- end}}
+</body>
+</html>
+`))
+
+// HTML renders the report as an html document
+func HTML(report *domain.Report) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := htmlTemplate.Execute(&buf, report); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// CSV renders the report as a csv document. Every row starts with the section it belongs to (issued, revoked or
+// failed) so the three sections can be loaded in the same sheet.
+func CSV(report *domain.Report) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	records := [][]string{{"section", "issuer", "schema_type", "count", "state", "tx_id", "date"}}
+	for _, s := range report.Issued {
+		records = append(records, []string{"issued", s.IssuerDID, s.SchemaType, strconv.Itoa(s.Count), "", "", ""})
+	}
+	for _, s := range report.Revocations {
+		records = append(records, []string{"revoked", s.IssuerDID, "", strconv.Itoa(s.Count), "", "", ""})
+	}
+	for _, op := range report.FailedOperations {
+		records = append(records, []string{"failed", op.IssuerDID, "", "", op.State, op.TxID, op.ModifiedAt.UTC().Format(time.RFC3339)})
+	}
+	if err := w.WriteAll(records); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// ImportCSV renders the processed rows of an import job as a csv document, with the credential or the link created
+// from every row or the reason it failed
+func ImportCSV(rows []*domain.ImportRow) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	records := [][]string{{"row", "reference", "status", "credential_id", "link_id", "error"}}
+	for _, row := range rows {
+		record := []string{strconv.Itoa(row.Number), row.Reference, "ok", "", "", ""}
+		if row.ClaimID != nil {
+			record[3] = row.ClaimID.String()
+		}
+		if row.LinkID != nil {
+			record[4] = row.LinkID.String()
+		}
+		if row.Error != nil {
+			record[2], record[5] = "failed", *row.Error
+		}
+		records = append(records, record)
+	}
+	if err := w.WriteAll(records); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// LinkFunnelCSV renders the steps of the link claim sessions as a csv document
+func LinkFunnelCSV(events []*domain.LinkFunnelEvent) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	records := [][]string{{"date", "link_id", "session", "step", "failure_reason"}}
+	for _, event := range events {
+		reason := ""
+		if event.FailureReason != nil {
+			reason = *event.FailureReason
+		}
+		records = append(records, []string{event.CreatedAt.UTC().Format(time.RFC3339), event.LinkID.String(), event.Session, string(event.Step), reason})
+	}
+	if err := w.WriteAll(records); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Summary returns a plain text summary of the report
+func Summary(report *domain.Report) string {
+	return "Issuer node activity from " + report.From.UTC().Format(dateFormat) + " to " + report.To.UTC().Format(dateFormat) + "\n\n" +
+		"Issued credentials: " + strconv.Itoa(report.TotalIssued()) + "\n" +
+		"Revoked credentials: " + strconv.Itoa(report.TotalRevoked()) + "\n" +
+		"Failed operations: " + strconv.Itoa(len(report.FailedOperations)) + "\n"
+}
@@ -0,0 +1,91 @@
+package reports
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+const issuerDID = "did:polygonid:polygon:mumbai:2qCU58EJgrELNZCDkSU23dQHZsBgAFuJnzjXKkBZxB"
+
+func testReport() *domain.Report {
+	to := time.Date(2023, 4, 19, 6, 0, 0, 0, time.UTC)
+	return &domain.Report{
+		From: to.Add(-24 * time.Hour),
+		To:   to,
+		Issued: []domain.IssuanceStat{
+			{IssuerDID: issuerDID, SchemaType: "https://example.com/kyc.jsonld#KYCAgeCredential", Count: 3},
+		},
+		Revocations: []domain.RevocationStat{{IssuerDID: issuerDID, Count: 1}},
+		FailedOperations: []domain.FailedOperation{
+			{IssuerDID: issuerDID, State: "abcd", TxID: "0x01", ModifiedAt: to.Add(-time.Hour)},
+		},
+	}
+}
+
+func TestCSV(t *testing.T) {
+	out, err := CSV(testReport())
+	require.NoError(t, err)
+	expected := "section,issuer,schema_type,count,state,tx_id,date\n" +
+		"issued," + issuerDID + ",https://example.com/kyc.jsonld#KYCAgeCredential,3,,,\n" +
+		"revoked," + issuerDID + ",,1,,,\n" +
+		"failed," + issuerDID + ",,,abcd,0x01,2023-04-19T05:00:00Z\n"
+	assert.Equal(t, expected, string(out))
+}
+
+func TestHTML(t *testing.T) {
+	report := testReport()
+	report.Issued[0].SchemaType = "<script>"
+	out, err := HTML(report)
+	require.NoError(t, err)
+	assert.Contains(t, string(out), "From 2023-04-18 06:00 UTC to 2023-04-19 06:00 UTC")
+	assert.Contains(t, string(out), "<h3>Issued credentials: 3</h3>")
+	assert.Contains(t, string(out), "<h3>Revoked credentials: 1</h3>")
+	assert.Contains(t, string(out), "<h3>Failed operations: 1</h3>")
+	assert.Contains(t, string(out), "&lt;script&gt;")
+	assert.NotContains(t, string(out), "<script>")
+
+	empty, err := HTML(&domain.Report{From: report.From, To: report.To})
+	require.NoError(t, err)
+	assert.Contains(t, string(empty), "<h3>Issued credentials: 0</h3>")
+	assert.NotContains(t, string(empty), "<table")
+}
+
+func TestSummary(t *testing.T) {
+	assert.Equal(t, "Issuer node activity from 2023-04-18 06:00 UTC to 2023-04-19 06:00 UTC\n\n"+
+		"Issued credentials: 3\nRevoked credentials: 1\nFailed operations: 1\n", Summary(testReport()))
+}
+
+func TestImportCSV(t *testing.T) {
+	claimID := uuid.MustParse("8edd8112-c415-11ed-b036-debe37e1cbd6")
+	failed := "documentType: \"two\" is not an integer"
+	out, err := ImportCSV([]*domain.ImportRow{
+		{Number: 1, Reference: "emp-1", ClaimID: &claimID},
+		{Number: 2, Reference: "emp-2", Error: &failed},
+	})
+	require.NoError(t, err)
+	expected := "row,reference,status,credential_id,link_id,error\n" +
+		"1,emp-1,ok,8edd8112-c415-11ed-b036-debe37e1cbd6,,\n" +
+		"2,emp-2,failed,,,\"documentType: \"\"two\"\" is not an integer\"\n"
+	assert.Equal(t, expected, string(out))
+}
+
+func TestLinkFunnelCSV(t *testing.T) {
+	linkID := uuid.MustParse("8edd8112-c415-11ed-b036-debe37e1cbd6")
+	reason := domain.LinkFunnelReasonAlreadyIssued
+	at := time.Date(2023, 5, 10, 9, 30, 0, 0, time.UTC)
+	out, err := LinkFunnelCSV([]*domain.LinkFunnelEvent{
+		{LinkID: linkID, Session: "5d41402abc4b2a76", Step: domain.LinkFunnelQRFetched, CreatedAt: at},
+		{LinkID: linkID, Session: "5d41402abc4b2a76", Step: domain.LinkFunnelFailed, FailureReason: &reason, CreatedAt: at.Add(time.Minute)},
+	})
+	require.NoError(t, err)
+	expected := "date,link_id,session,step,failure_reason\n" +
+		"2023-05-10T09:30:00Z,8edd8112-c415-11ed-b036-debe37e1cbd6,5d41402abc4b2a76,qr_fetched,\n" +
+		"2023-05-10T09:31:00Z,8edd8112-c415-11ed-b036-debe37e1cbd6,5d41402abc4b2a76,failed,already_issued\n"
+	assert.Equal(t, expected, string(out))
+}
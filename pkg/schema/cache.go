@@ -0,0 +1,227 @@
+package schema
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/loader"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/pkg/cache"
+)
+
+const (
+	documentLoadTimeout = 30 * time.Second
+	maxDocumentSize     = 10 * 1024 * 1024
+)
+
+// ErrDocumentNotCached is returned when purging a document that is not in the cache
+var ErrDocumentNotCached = errors.New("document not cached")
+
+// cachedDocument is a cache entry. The entries are kept after the ttl, so they can be revalidated with their ETag
+// and served while their host is down.
+type cachedDocument struct {
+	Body        []byte
+	ContentType string
+	ETag        string
+	FetchedAt   time.Time
+}
+
+// DocumentCache is an http.RoundTripper that keeps the documents fetched with GET in a cache shared by the node
+// processes. Documents younger than ttl are served from the cache, the older ones are revalidated with their ETag
+// and, if their host fails, served stale.
+//
+// JSON schemas are loaded with Factory. JSON-LD contexts are loaded by the merklization and validation of the
+// credentials with http.DefaultClient, so the cache must be its transport.
+type DocumentCache struct {
+	cache cache.Cache
+	ttl   time.Duration
+	next  http.RoundTripper
+}
+
+// NewDocumentCache returns a document cache that fetches the documents with next
+func NewDocumentCache(c cache.Cache, ttl time.Duration, next http.RoundTripper) *DocumentCache {
+	return &DocumentCache{cache: c, ttl: ttl, next: next}
+}
+
+// RoundTrip answers GET requests from the cache and sends the rest to the next transport
+func (d *DocumentCache) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		return d.next.RoundTrip(req)
+	}
+	ctx := req.Context()
+	u := req.URL.String()
+
+	var doc cachedDocument
+	found := d.cache.Get(ctx, d.key(u), &doc)
+	if found && time.Since(doc.FetchedAt) < d.ttl {
+		return doc.response(req), nil
+	}
+
+	etag := ""
+	if found {
+		etag = doc.ETag
+	}
+	fetched, resp, err := d.fetch(req, etag)
+	switch {
+	case err != nil && found:
+		log.Warn(ctx, "serving stale document", "err", err, "url", u)
+		return doc.response(req), nil
+	case err != nil:
+		return nil, err
+	case resp != nil:
+		return resp, nil
+	case fetched == nil:
+		doc.FetchedAt = time.Now()
+		fetched = &doc
+	}
+	d.store(ctx, u, fetched)
+	return fetched.response(req), nil
+}
+
+// Factory returns a loader of the JSON schema at url that goes through the cache
+func (d *DocumentCache) Factory(url string) loader.Loader {
+	return &cachedLoader{url: url, client: &http.Client{Transport: d, Timeout: documentLoadTimeout}}
+}
+
+// Purge removes a document from the cache
+func (d *DocumentCache) Purge(ctx context.Context, url string) error {
+	if !d.cache.Exists(ctx, d.key(url)) {
+		return ErrDocumentNotCached
+	}
+	return d.cache.Delete(ctx, d.key(url))
+}
+
+// Refresh fetches a document from its host, even if it is fresh, and replaces the cached one
+func (d *DocumentCache) Refresh(ctx context.Context, url string) (*domain.CachedDocument, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+	doc, resp, err := d.fetch(req, "")
+	if err != nil {
+		return nil, err
+	}
+	if resp != nil {
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	d.store(ctx, url, doc)
+	return &domain.CachedDocument{
+		URL:         url,
+		ContentType: doc.ContentType,
+		ETag:        doc.ETag,
+		Size:        len(doc.Body),
+		FetchedAt:   doc.FetchedAt,
+	}, nil
+}
+
+// fetch gets the document from its host. It returns the new document on a 200, nil on a 304 to the etag, and the
+// response of the host, that is not cached, on any other status. Server errors are errors, so stale documents are
+// served instead.
+func (d *DocumentCache) fetch(req *http.Request, etag string) (*cachedDocument, *http.Response, error) {
+	req = req.Clone(req.Context())
+	if etag != "" {
+		req.Header.Set("If-None-Match", etag)
+	}
+	resp, err := d.next.RoundTrip(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	switch {
+	case resp.StatusCode == http.StatusNotModified && etag != "":
+		_ = resp.Body.Close()
+		return nil, nil, nil
+	case resp.StatusCode >= http.StatusInternalServerError:
+		_ = resp.Body.Close()
+		return nil, nil, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	case resp.StatusCode != http.StatusOK:
+		return nil, resp, nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxDocumentSize+1))
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(body) > maxDocumentSize {
+		return nil, nil, fmt.Errorf("document larger than %d bytes", maxDocumentSize)
+	}
+	return &cachedDocument{
+		Body:        body,
+		ContentType: resp.Header.Get("Content-Type"),
+		ETag:        resp.Header.Get("ETag"),
+		FetchedAt:   time.Now(),
+	}, nil, nil
+}
+
+func (d *DocumentCache) store(ctx context.Context, url string, doc *cachedDocument) {
+	if err := d.cache.Set(ctx, d.key(url), *doc, cache.ForEver); err != nil {
+		log.Warn(ctx, "caching document", "err", err, "url", url)
+	}
+}
+
+func (d *DocumentCache) key(url string) string {
+	return fmt.Sprintf("document-%s", url)
+}
+
+func (doc *cachedDocument) response(req *http.Request) *http.Response {
+	header := http.Header{}
+	if doc.ContentType != "" {
+		header.Set("Content-Type", doc.ContentType)
+	}
+	if doc.ETag != "" {
+		header.Set("ETag", doc.ETag)
+	}
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(doc.Body)),
+		ContentLength: int64(len(doc.Body)),
+		Request:       req,
+	}
+}
+
+// cachedLoader loads a JSON schema like the http loader of the schema processor, through the document cache
+type cachedLoader struct {
+	url    string
+	client *http.Client
+}
+
+// Load returns the schema and the extension of the file of the url
+func (l *cachedLoader) Load(ctx context.Context) (schema []byte, extension string, err error) {
+	u, err := url.Parse(l.url)
+	if err != nil {
+		return nil, "", err
+	}
+	segments := strings.Split(u.Path, "/")
+	last := segments[len(segments)-1]
+	extension = last[strings.Index(last, ".")+1:]
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), http.NoBody)
+	if err != nil {
+		return nil, "", err
+	}
+	resp, err := l.client.Do(req)
+	if err != nil {
+		return nil, "", fmt.Errorf("http request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, "", fmt.Errorf("request failed with status code %v", resp.StatusCode)
+	}
+	schema, err = io.ReadAll(resp.Body)
+	return schema, extension, err
+}
@@ -0,0 +1,106 @@
+package schema
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/pkg/cache"
+)
+
+const kycContext = `{"@context":{"KYCAgeCredential":{"@id":"https://example.com/kyc#KYCAgeCredential"}}}`
+
+func TestDocumentCache(t *testing.T) {
+	ctx := context.Background()
+	var requests, notModified atomic.Int32
+	var down atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if down.Load() {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		if r.URL.Path != "/kyc.jsonld" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			notModified.Add(1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("Content-Type", "application/ld+json")
+		w.Header().Set("ETag", `"v1"`)
+		_, _ = w.Write([]byte(kycContext))
+	}))
+	defer server.Close()
+
+	documents := NewDocumentCache(cache.NewMemoryCache(), time.Hour, http.DefaultTransport)
+	client := &http.Client{Transport: documents}
+	get := func(url string) (int, string) {
+		resp, err := client.Get(url)
+		require.NoError(t, err)
+		defer func() { _ = resp.Body.Close() }()
+		body := make([]byte, 1024)
+		n, _ := resp.Body.Read(body)
+		return resp.StatusCode, string(body[:n])
+	}
+
+	for i := 0; i < 3; i++ {
+		status, body := get(server.URL + "/kyc.jsonld")
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, kycContext, body)
+	}
+	assert.Equal(t, int32(1), requests.Load(), "fresh documents are served from the cache")
+
+	status, _ := get(server.URL + "/missing.jsonld")
+	assert.Equal(t, http.StatusNotFound, status)
+
+	t.Run("stale documents are revalidated and served while the host is down", func(t *testing.T) {
+		stale := NewDocumentCache(documents.cache, 0, http.DefaultTransport)
+		staleClient := &http.Client{Transport: stale}
+		resp, err := staleClient.Get(server.URL + "/kyc.jsonld")
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+		assert.Equal(t, int32(1), notModified.Load())
+
+		down.Store(true)
+		defer down.Store(false)
+		resp, err = staleClient.Get(server.URL + "/kyc.jsonld")
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("schemas are loaded through the cache", func(t *testing.T) {
+		before := requests.Load()
+		schema, ext, err := documents.Factory(server.URL + "/kyc.jsonld").Load(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, kycContext, string(schema))
+		assert.Equal(t, "jsonld", ext)
+		assert.Equal(t, before, requests.Load())
+	})
+
+	t.Run("purge and refresh", func(t *testing.T) {
+		doc, err := documents.Refresh(ctx, server.URL+"/kyc.jsonld")
+		require.NoError(t, err)
+		assert.Equal(t, `"v1"`, doc.ETag)
+		assert.Equal(t, "application/ld+json", doc.ContentType)
+		assert.Equal(t, len(kycContext), doc.Size)
+
+		_, err = documents.Refresh(ctx, server.URL+"/missing.jsonld")
+		assert.Error(t, err)
+
+		require.NoError(t, documents.Purge(ctx, server.URL+"/kyc.jsonld"))
+		assert.ErrorIs(t, documents.Purge(ctx, server.URL+"/kyc.jsonld"), ErrDocumentNotCached)
+		before := requests.Load()
+		get(server.URL + "/kyc.jsonld")
+		assert.Equal(t, before+1, requests.Load())
+	})
+}
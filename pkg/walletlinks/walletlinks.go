@@ -0,0 +1,55 @@
+package walletlinks
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/url"
+	"strings"
+)
+
+const (
+	// Message is replaced in the wallet links by the base64 encoded message
+	Message = "{message}"
+	// DefaultDeepLink opens the message in any iden3comm wallet installed in the device
+	DefaultDeepLink = "iden3comm://?i_m=" + Message
+	// DefaultUniversalLink opens the message in the Polygon ID wallet, or its web page if it is not installed
+	DefaultUniversalLink = "https://wallet.polygonid.com/#i_m=" + Message
+)
+
+// Links are the links that hand a message to the wallet of the holder, so a mobile browser opens it without scanning
+// the QR code
+type Links struct {
+	DeepLink      string
+	UniversalLink string
+}
+
+// Linker builds the wallet links of the messages from the url schemes of the deployment. The schemes are urls with
+// the {message} placeholder.
+type Linker struct {
+	deepLink      string
+	universalLink string
+}
+
+// NewLinker returns a Linker with the given schemes, the empty ones are the defaults
+func NewLinker(deepLink, universalLink string) *Linker {
+	if deepLink == "" {
+		deepLink = DefaultDeepLink
+	}
+	if universalLink == "" {
+		universalLink = DefaultUniversalLink
+	}
+	return &Linker{deepLink: deepLink, universalLink: universalLink}
+}
+
+// Links returns the wallet links of the message, encoded as the JSON of the QR code the wallet scans
+func (l *Linker) Links(message any) (*Links, error) {
+	raw, err := json.Marshal(message)
+	if err != nil {
+		return nil, err
+	}
+	encoded := url.QueryEscape(base64.StdEncoding.EncodeToString(raw))
+	return &Links{
+		DeepLink:      strings.ReplaceAll(l.deepLink, Message, encoded),
+		UniversalLink: strings.ReplaceAll(l.universalLink, Message, encoded),
+	}, nil
+}
@@ -0,0 +1,34 @@
+package walletlinks
+
+import (
+	"encoding/base64"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLinker_Links(t *testing.T) {
+	message := map[string]string{"id": "f7c6cdf9-878e-40c3-89f1-85bf1fb80865", "type": "https://iden3-communication.io/credentials/1.0/offer"}
+
+	links, err := NewLinker("", "").Links(message)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(links.DeepLink, "iden3comm://?i_m="))
+	require.True(t, strings.HasPrefix(links.UniversalLink, "https://wallet.polygonid.com/#i_m="))
+
+	encoded, err := url.QueryUnescape(strings.TrimPrefix(links.DeepLink, "iden3comm://?i_m="))
+	require.NoError(t, err)
+	raw, err := base64.StdEncoding.DecodeString(encoded)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id":"f7c6cdf9-878e-40c3-89f1-85bf1fb80865","type":"https://iden3-communication.io/credentials/1.0/offer"}`, string(raw))
+	assert.Equal(t, strings.TrimPrefix(links.DeepLink, "iden3comm://?i_m="), strings.TrimPrefix(links.UniversalLink, "https://wallet.polygonid.com/#i_m="))
+
+	links, err = NewLinker("mywallet://open?request={message}&v=1", "https://wallet.example.com/{message}").Links(message)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(links.DeepLink, "mywallet://open?request="))
+	assert.True(t, strings.HasSuffix(links.DeepLink, "&v=1"))
+	assert.True(t, strings.HasPrefix(links.UniversalLink, "https://wallet.example.com/"))
+	assert.NotContains(t, links.UniversalLink, Message)
+}